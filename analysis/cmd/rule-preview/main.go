@@ -0,0 +1,129 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command rule-preview previews a draft LUCI Analysis failure association
+// rule, printing the number of recent failures it matches, their impact
+// and samples of them.
+//
+// By default, the rule is previewed by the LUCI Analysis server using
+// the Rules.Preview RPC. With -local-dir, the rule is instead evaluated
+// locally against chunk files (*.binarypb) downloaded from the chunk
+// GCS bucket, without contacting any server.
+//
+// Example:
+//
+//	rule-preview -project chromium -rule 'reason LIKE "%timed out%"' -days 3
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/auth/client/authcli"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/hardcoded/chromeinfra"
+
+	"go.chromium.org/luci/analysis/internal/clustering/rules/preview"
+	pb "go.chromium.org/luci/analysis/proto/v1"
+)
+
+var (
+	host = flag.String("host", "luci-analysis.appspot.com",
+		"Host of the LUCI Analysis server to preview the rule with")
+	project    = flag.String("project", "", "LUCI project to preview the rule in")
+	rule       = flag.String("rule", "", "Failure association rule predicate to preview, e.g. 'test = \"ninja://test\"'")
+	days       = flag.Int("days", 1, "Number of days of failures to preview the rule against (1-7)")
+	sampleSize = flag.Int("sample-size", 10, "Maximum number of matched failures to print")
+	localDir   = flag.String("local-dir", "",
+		"If set, evaluate the rule locally against the chunk files in this directory, instead of using the server")
+)
+
+func main() {
+	ctx := context.Background()
+	ctx = gologger.StdConfig.Use(ctx)
+	if err := run(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	authFlags := authcli.Flags{}
+	authFlags.Register(flag.CommandLine, chromeinfra.DefaultAuthOptions())
+
+	flag.Parse()
+
+	if *project == "" {
+		return errors.New("-project is required")
+	}
+	if *rule == "" {
+		return errors.New("-rule is required")
+	}
+
+	var response *pb.PreviewRuleResponse
+	if *localDir != "" {
+		logging.Infof(ctx, "Evaluating rule against chunks in %s...", *localDir)
+		result, err := preview.Run(ctx, &preview.DirSource{Dir: *localDir}, preview.Options{
+			Project:    *project,
+			Rule:       *rule,
+			Since:      time.Now().Add(-time.Duration(*days) * 24 * time.Hour),
+			SampleSize: *sampleSize,
+		})
+		if err != nil {
+			return err
+		}
+		response = preview.ToResponse(*project, result)
+	} else {
+		opts, err := authFlags.Options()
+		if err != nil {
+			return err
+		}
+		authenticator := auth.NewAuthenticator(ctx, auth.SilentLogin, opts)
+		client, err := authenticator.Client()
+		if err != nil {
+			return err
+		}
+		rules := pb.NewRulesPRPCClient(&prpc.Client{C: client, Host: *host})
+
+		logging.Infof(ctx, "Previewing rule with %s...", *host)
+		response, err = rules.Preview(ctx, &pb.PreviewRuleRequest{
+			Parent:         fmt.Sprintf("projects/%s", *project),
+			RuleDefinition: *rule,
+			Days:           int32(*days),
+			SampleSize:     int32(*sampleSize),
+		})
+		if err != nil {
+			return errors.Annotate(err, "preview rule").Err()
+		}
+	}
+
+	if response.Truncated {
+		logging.Warningf(ctx, "Too many failures to scan; results are based on a sample.")
+	}
+	out, err := protojson.MarshalOptions{Multiline: true}.Marshal(response)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s\n", out)
+	return nil
+}
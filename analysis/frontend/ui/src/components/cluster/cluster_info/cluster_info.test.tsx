@@ -80,4 +80,25 @@ describe('test ClusterInfo component', () => {
     // eslint-disable-next-line @typescript-eslint/no-non-null-assertion
     expect(screen.getByText(mockCluster.title!)).toBeInTheDocument();
   });
+
+  it('Given stack trace based cluster then should display the data', async () => {
+    const project = 'chromium';
+    const algorithm = 'stacktrace-v1';
+    const id = '14ee3dde813f66adc0595e4a21aa1743';
+    const mockCluster = getMockCluster(id, project, algorithm, 'ninja://chrome/android');
+
+    mockGetCluster(project, algorithm, id, mockCluster);
+
+    renderWithRouterAndClient(
+        <ClusterContextProvider project={project} clusterAlgorithm={algorithm} clusterId={id}>
+          <ClusterInfo />
+        </ClusterContextProvider>,
+    );
+
+    await screen.findByText('Stack trace cluster');
+    await screen.findByTestId('cluster-definition');
+
+    // eslint-disable-next-line @typescript-eslint/no-non-null-assertion
+    expect(screen.getByText(mockCluster.title!)).toBeInTheDocument();
+  });
 });
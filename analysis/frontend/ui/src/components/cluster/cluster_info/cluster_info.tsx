@@ -94,6 +94,8 @@ const ClusterInfo = () => {
     criteriaName = 'Test name cluster';
   } else if (clusterAlgorithm.startsWith('reason-')) {
     criteriaName = 'Failure reason cluster';
+  } else if (clusterAlgorithm.startsWith('stacktrace-')) {
+    criteriaName = 'Stack trace cluster';
   }
 
   return (
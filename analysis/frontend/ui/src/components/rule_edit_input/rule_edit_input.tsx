@@ -41,7 +41,7 @@ const RuleEditInput = ({
         inputProps={{ 'data-testid': 'rule-input' }}/>
       <small>
         Supported is AND, OR, =,{'<>'}, NOT, IN, LIKE, parentheses and&nbsp;
-        <a href="https://cloud.google.com/bigquery/docs/reference/standard-sql/functions-and-operators#regexp_contains">REGEXP_CONTAINS</a>.
+        <a href="https://cloud.google.com/bigquery/docs/reference/standard-sql/functions-and-operators#regexp_contains">REGEXP_CONTAINS</a> and STACK_FRAMES.
         Valid identifiers are <em>test</em> and <em>reason</em>. <Link href="/help#rules" target="_blank">More information</Link>.
       </small>
     </>
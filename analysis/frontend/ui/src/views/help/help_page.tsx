@@ -88,7 +88,9 @@ const HelpPage = () => {
             boolean expressions, with the following limitations:
             <ul>
               <li>The only valid operators are <em>AND</em>, <em>OR</em>, <em>NOT</em>, =, &lt;&gt;, LIKE and parantheses.</li>
-              <li>The only valid function is <Link href="https://cloud.google.com/bigquery/docs/reference/standard-sql/functions-and-operators#regexp_contains">REGEXP_CONTAINS</Link>.</li>
+              <li>The only valid functions are <Link href="https://cloud.google.com/bigquery/docs/reference/standard-sql/functions-and-operators#regexp_contains">REGEXP_CONTAINS</Link> and
+                STACK_FRAMES. <em>STACK_FRAMES(reason, &quot;frame1&quot;, &quot;frame2&quot;, ...)</em> matches failures whose
+                stack trace (Go, Python, Java or sanitizer format) starts with frames matching the given LIKE patterns, innermost frame first.</li>
               <li>The only valid identifiers are <em>test</em> and <em>reason</em>.</li>
               <li>Strings must use double quotes.</li>
             </ul>
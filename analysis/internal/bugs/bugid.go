@@ -26,6 +26,12 @@ const MonorailSystem = "monorail"
 // BuganizerSystem is the name of the buganizer bug tracker system.
 const BuganizerSystem = "buganizer"
 
+// GitHubSystem is the name of the GitHub Issues bug tracker system.
+const GitHubSystem = "github"
+
+// JiraSystem is the name of the Jira bug tracker system.
+const JiraSystem = "jira"
+
 // MonorailBugIDRe matches identifiers of monorail bugs, like
 // "{monorail_project}/{numeric_id}".
 var MonorailBugIDRe = regexp.MustCompile(`^([a-z0-9\-_]+)/([1-9][0-9]*)$`)
@@ -34,14 +40,24 @@ var MonorailBugIDRe = regexp.MustCompile(`^([a-z0-9\-_]+)/([1-9][0-9]*)$`)
 // the b/), like 1234567890.
 var BuganizerBugIDRe = regexp.MustCompile(`^([1-9][0-9]*)$`)
 
+// GitHubBugIDRe matches identifiers of GitHub issues, like
+// "{owner}/{repository}/{number}".
+var GitHubBugIDRe = regexp.MustCompile(`^([a-zA-Z0-9](?:[a-zA-Z0-9\-]{0,38}))/([a-zA-Z0-9_.\-]{1,100})/([1-9][0-9]*)$`)
+
+// JiraBugIDRe matches identifiers of Jira issues, which are issue
+// keys like "{PROJECT_KEY}-{number}".
+var JiraBugIDRe = regexp.MustCompile(`^([A-Z][A-Z0-9_]{0,254})-([1-9][0-9]*)$`)
+
 // BugID represents the identity of a bug managed by LUCI Analysis.
 type BugID struct {
-	// System is the bug tracking system of the bug. This is either
-	// "monorail" or "buganizer".
+	// System is the bug tracking system of the bug. This is one of
+	// "monorail", "buganizer", "github" or "jira".
 	System string `json:"system"`
 	// ID is the bug tracking system-specific identity of the bug.
 	// For monorail, the scheme is {project}/{numeric_id}, for
-	// buganizer the scheme is {numeric_id}.
+	// buganizer the scheme is {numeric_id}, for GitHub the scheme is
+	// {owner}/{repository}/{number} and for Jira the scheme is the
+	// issue key, {project_key}-{number}.
 	ID string `json:"id"`
 }
 
@@ -57,6 +73,14 @@ func (b *BugID) Validate() error {
 		if !BuganizerBugIDRe.MatchString(b.ID) {
 			return fmt.Errorf("invalid buganizer bug ID %q", b.ID)
 		}
+	case GitHubSystem:
+		if !GitHubBugIDRe.MatchString(b.ID) {
+			return fmt.Errorf("invalid github bug ID %q", b.ID)
+		}
+	case JiraSystem:
+		if !JiraBugIDRe.MatchString(b.ID) {
+			return fmt.Errorf("invalid jira bug ID %q", b.ID)
+		}
 	default:
 		return fmt.Errorf("invalid bug tracking system %q", b.System)
 	}
@@ -76,6 +100,33 @@ func (b *BugID) MonorailProjectAndID() (project, id string, err error) {
 	return m[1], m[2], nil
 }
 
+// GitHubRepositoryAndNumber returns the GitHub repository (as
+// "{owner}/{repository}") and issue number of the given bug.
+// If the bug is not a GitHub issue or is invalid, an error is returned.
+func (b *BugID) GitHubRepositoryAndNumber() (repository, number string, err error) {
+	if b.System != GitHubSystem {
+		return "", "", errors.New("not a github bug")
+	}
+	m := GitHubBugIDRe.FindStringSubmatch(b.ID)
+	if m == nil {
+		return "", "", errors.New("not a valid github bug ID")
+	}
+	return m[1] + "/" + m[2], m[3], nil
+}
+
+// JiraProjectKey returns the key of the Jira project of the given bug.
+// If the bug is not a Jira issue or is invalid, an error is returned.
+func (b *BugID) JiraProjectKey() (string, error) {
+	if b.System != JiraSystem {
+		return "", errors.New("not a jira bug")
+	}
+	m := JiraBugIDRe.FindStringSubmatch(b.ID)
+	if m == nil {
+		return "", errors.New("not a valid jira bug ID")
+	}
+	return m[1], nil
+}
+
 func (b BugID) String() string {
 	return fmt.Sprintf("%s:%s", b.System, b.ID)
 }
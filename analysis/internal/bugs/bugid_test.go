@@ -37,5 +37,30 @@ func TestValidate(t *testing.T) {
 			err := id.Validate()
 			So(err, ShouldErrLike, `invalid monorail bug ID`)
 		})
+		Convey("GitHub", func() {
+			id.System = GitHubSystem
+			id.ID = "chromium/luci-go/123"
+			So(id.Validate(), ShouldBeNil)
+
+			repository, number, err := id.GitHubRepositoryAndNumber()
+			So(err, ShouldBeNil)
+			So(repository, ShouldEqual, "chromium/luci-go")
+			So(number, ShouldEqual, "123")
+
+			id.ID = "chromium/123"
+			So(id.Validate(), ShouldErrLike, `invalid github bug ID`)
+		})
+		Convey("Jira", func() {
+			id.System = JiraSystem
+			id.ID = "PROJ-123"
+			So(id.Validate(), ShouldBeNil)
+
+			key, err := id.JiraProjectKey()
+			So(err, ShouldBeNil)
+			So(key, ShouldEqual, "PROJ")
+
+			id.ID = "proj-123"
+			So(id.Validate(), ShouldErrLike, `invalid jira bug ID`)
+		})
 	})
 }
@@ -0,0 +1,83 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// AllowList is the set of GitHub repositories each LUCI project may file
+// issues in, keyed by LUCI project.
+//
+// The GitHub token of the service is only ever sent to these repositories,
+// regardless of what the project configuration says.
+type AllowList map[string][]Repository
+
+// ParseAllowList parses a comma-separated list of
+// "<luci-project>:<hostname>/<owner>/<repository>" entries.
+func ParseAllowList(s string) (AllowList, error) {
+	allowList := AllowList{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		project, repo, ok := strings.Cut(entry, ":")
+		parts := strings.Split(repo, "/")
+		if !ok || project == "" || len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, errors.Reason("bad entry %q, want <luci-project>:<hostname>/<owner>/<repository>", entry).Err()
+		}
+		allowList[project] = append(allowList[project], Repository{
+			Hostname: parts[0],
+			Owner:    parts[1],
+			Name:     parts[2],
+		})
+	}
+	return allowList, nil
+}
+
+// allows returns whether the repository is in the list.
+func allows(repos []Repository, repo Repository) bool {
+	for _, r := range repos {
+		if sameHost(r.Hostname, repo.Hostname) &&
+			strings.EqualFold(r.Owner, repo.Owner) &&
+			strings.EqualFold(r.Name, repo.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsHost returns whether some repository in the list is on the host.
+func allowsHost(repos []Repository, hostname string) bool {
+	for _, r := range repos {
+		if sameHost(r.Hostname, hostname) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameHost(a, b string) bool {
+	if a == "" {
+		a = DefaultHostname
+	}
+	if b == "" {
+		b = DefaultHostname
+	}
+	return strings.EqualFold(a, b)
+}
@@ -0,0 +1,383 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/server/secrets"
+)
+
+// DefaultHostname is the hostname of the public GitHub instance.
+const DefaultHostname = "github.com"
+
+// listPageSize is the number of items to request per page when listing
+// comments and events. This is the maximum allowed by GitHub.
+const listPageSize = 100
+
+// requestTimeout is the timeout of a single request to the GitHub API.
+const requestTimeout = time.Minute
+
+// Repository identifies a GitHub repository.
+type Repository struct {
+	// Hostname is the hostname of the GitHub instance, e.g. "github.com".
+	Hostname string
+	// Owner is the user or organisation owning the repository.
+	Owner string
+	// Name is the name of the repository.
+	Name string
+}
+
+func (r Repository) String() string {
+	return fmt.Sprintf("%s/%s/%s", r.Hostname, r.Owner, r.Name)
+}
+
+// The states of GitHub issues.
+const (
+	StateOpen   = "open"
+	StateClosed = "closed"
+)
+
+// The reasons for the state of GitHub issues.
+const (
+	StateReasonCompleted  = "completed"
+	StateReasonNotPlanned = "not_planned"
+	StateReasonReopened   = "reopened"
+	StateReasonDuplicate  = "duplicate"
+)
+
+// Issue is a GitHub issue.
+type Issue struct {
+	Number      int64     `json:"number"`
+	Title       string    `json:"title"`
+	Body        string    `json:"body"`
+	State       string    `json:"state"`
+	StateReason string    `json:"state_reason"`
+	Labels      []Label   `json:"labels"`
+	Assignees   []User    `json:"assignees"`
+	ClosedAt    time.Time `json:"closed_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// HasLabel returns whether the issue has the given label.
+func (i *Issue) HasLabel(name string) bool {
+	for _, l := range i.Labels {
+		if l.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Label is a label on a GitHub issue.
+type Label struct {
+	Name string `json:"name"`
+}
+
+// User is a GitHub user.
+type User struct {
+	Login string `json:"login"`
+}
+
+// Comment is a comment on a GitHub issue.
+type Comment struct {
+	ID        int64     `json:"id"`
+	Body      string    `json:"body"`
+	User      User      `json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// The types of issue events used by LUCI Analysis.
+const (
+	EventLabeled  = "labeled"
+	EventClosed   = "closed"
+	EventReopened = "reopened"
+)
+
+// Event is an event in the timeline of a GitHub issue.
+type Event struct {
+	ID    int64  `json:"id"`
+	Event string `json:"event"`
+	Actor User   `json:"actor"`
+	// Label is the label that was added or removed. Only set for
+	// "labeled" and "unlabeled" events.
+	Label     *Label    `json:"label,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateIssueRequest is a request to create a GitHub issue.
+type CreateIssueRequest struct {
+	Title  string   `json:"title"`
+	Body   string   `json:"body"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+// UpdateIssueRequest is a request to update a GitHub issue. Only
+// fields which are set are updated.
+type UpdateIssueRequest struct {
+	State       string    `json:"state,omitempty"`
+	StateReason string    `json:"state_reason,omitempty"`
+	Labels      *[]string `json:"labels,omitempty"`
+}
+
+// Client is the interface to GitHub Issues used by LUCI Analysis.
+// It allows the GitHub REST API to be substituted with a fake in tests.
+type Client interface {
+	// SelfLogin returns the login of the user LUCI Analysis authenticates
+	// to the given GitHub instance as. This is used to distinguish actions
+	// taken by LUCI Analysis from user actions.
+	SelfLogin(ctx context.Context, hostname string) (string, error)
+	// GetIssue retrieves the given issue. If the issue does not exist,
+	// an error tagged with NotFoundTag is returned.
+	GetIssue(ctx context.Context, repo Repository, number int64) (*Issue, error)
+	// CreateIssue creates a new issue.
+	CreateIssue(ctx context.Context, repo Repository, req *CreateIssueRequest) (*Issue, error)
+	// UpdateIssue updates the given issue.
+	UpdateIssue(ctx context.Context, repo Repository, number int64, req *UpdateIssueRequest) (*Issue, error)
+	// AddLabels adds the given labels to the issue. Existing labels
+	// are retained.
+	AddLabels(ctx context.Context, repo Repository, number int64, labels []string) error
+	// CreateComment posts a comment on the given issue.
+	CreateComment(ctx context.Context, repo Repository, number int64, body string) error
+	// ListComments lists the comments on the given issue, oldest first.
+	ListComments(ctx context.Context, repo Repository, number int64) ([]*Comment, error)
+	// ListEvents lists the events on the given issue, oldest first.
+	ListEvents(ctx context.Context, repo Repository, number int64) ([]*Event, error)
+}
+
+// NotFoundTag is used to tag errors returned when an issue does not exist.
+var NotFoundTag = errors.BoolTag{Key: errors.NewTagKey("github issue not found")}
+
+// RESTClient implements Client using the GitHub REST API.
+type RESTClient struct {
+	// HTTPClient is the client used to make requests.
+	HTTPClient *http.Client
+	// Token is the token used to authenticate to GitHub.
+	Token string
+	// Allowed are the repositories the client may access. Requests for
+	// other repositories fail without being sent.
+	Allowed []Repository
+}
+
+// NewRESTClient returns a new client for the GitHub REST API, which
+// authenticates with the token stored in the secret configured on the
+// GitHub module.
+//
+// The client can only access repositories the GitHub module allows for
+// the given LUCI project.
+func NewRESTClient(ctx context.Context, project string) (*RESTClient, error) {
+	secretName, _ := ctx.Value(&TokenSecretKey).(string)
+	if secretName == "" {
+		return nil, errors.New("GitHub token secret is required for REST client")
+	}
+	allowList, _ := ctx.Value(&AllowListKey).(AllowList)
+	secret, err := secrets.StoredSecret(ctx, secretName)
+	if err != nil {
+		return nil, errors.Annotate(err, "read GitHub token").Err()
+	}
+	return &RESTClient{
+		HTTPClient: &http.Client{Timeout: requestTimeout},
+		Token:      string(bytes.TrimSpace(secret.Active)),
+		Allowed:    allowList[project],
+	}, nil
+}
+
+// checkRepo returns an error if the client may not access the repository.
+func (c *RESTClient) checkRepo(repo Repository) error {
+	if !allows(c.Allowed, repo) {
+		return errors.Reason("GitHub repository %s is not allowed for this project", repo).Err()
+	}
+	return nil
+}
+
+// apiBaseURL returns the base URL of the REST API of the given GitHub
+// instance.
+func apiBaseURL(hostname string) string {
+	if hostname == "" || hostname == DefaultHostname {
+		return "https://api.github.com"
+	}
+	// GitHub Enterprise Server.
+	return fmt.Sprintf("https://%s/api/v3", hostname)
+}
+
+func issuePath(repo Repository, number int64) string {
+	return fmt.Sprintf("/repos/%s/%s/issues/%v", url.PathEscape(repo.Owner), url.PathEscape(repo.Name), number)
+}
+
+// SelfLogin implements Client.
+func (c *RESTClient) SelfLogin(ctx context.Context, hostname string) (string, error) {
+	if !allowsHost(c.Allowed, hostname) {
+		return "", errors.Reason("GitHub host %q is not allowed for this project", hostname).Err()
+	}
+	var user User
+	if err := c.call(ctx, http.MethodGet, apiBaseURL(hostname)+"/user", nil, &user); err != nil {
+		return "", err
+	}
+	return user.Login, nil
+}
+
+// GetIssue implements Client.
+func (c *RESTClient) GetIssue(ctx context.Context, repo Repository, number int64) (*Issue, error) {
+	if err := c.checkRepo(repo); err != nil {
+		return nil, err
+	}
+	issue := &Issue{}
+	if err := c.call(ctx, http.MethodGet, apiBaseURL(repo.Hostname)+issuePath(repo, number), nil, issue); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// CreateIssue implements Client.
+func (c *RESTClient) CreateIssue(ctx context.Context, repo Repository, req *CreateIssueRequest) (*Issue, error) {
+	if err := c.checkRepo(repo); err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("%s/repos/%s/%s/issues", apiBaseURL(repo.Hostname), url.PathEscape(repo.Owner), url.PathEscape(repo.Name))
+	issue := &Issue{}
+	if err := c.call(ctx, http.MethodPost, u, req, issue); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// UpdateIssue implements Client.
+func (c *RESTClient) UpdateIssue(ctx context.Context, repo Repository, number int64, req *UpdateIssueRequest) (*Issue, error) {
+	if err := c.checkRepo(repo); err != nil {
+		return nil, err
+	}
+	issue := &Issue{}
+	if err := c.call(ctx, http.MethodPatch, apiBaseURL(repo.Hostname)+issuePath(repo, number), req, issue); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+// AddLabels implements Client.
+func (c *RESTClient) AddLabels(ctx context.Context, repo Repository, number int64, labels []string) error {
+	if err := c.checkRepo(repo); err != nil {
+		return err
+	}
+	body := struct {
+		Labels []string `json:"labels"`
+	}{Labels: labels}
+	return c.call(ctx, http.MethodPost, apiBaseURL(repo.Hostname)+issuePath(repo, number)+"/labels", body, nil)
+}
+
+// CreateComment implements Client.
+func (c *RESTClient) CreateComment(ctx context.Context, repo Repository, number int64, body string) error {
+	if err := c.checkRepo(repo); err != nil {
+		return err
+	}
+	req := struct {
+		Body string `json:"body"`
+	}{Body: body}
+	return c.call(ctx, http.MethodPost, apiBaseURL(repo.Hostname)+issuePath(repo, number)+"/comments", req, nil)
+}
+
+// ListComments implements Client.
+func (c *RESTClient) ListComments(ctx context.Context, repo Repository, number int64) ([]*Comment, error) {
+	if err := c.checkRepo(repo); err != nil {
+		return nil, err
+	}
+	var result []*Comment
+	for page := 1; ; page++ {
+		var comments []*Comment
+		u := fmt.Sprintf("%s%s/comments?per_page=%v&page=%v", apiBaseURL(repo.Hostname), issuePath(repo, number), listPageSize, page)
+		if err := c.call(ctx, http.MethodGet, u, nil, &comments); err != nil {
+			return nil, err
+		}
+		result = append(result, comments...)
+		if len(comments) < listPageSize {
+			return result, nil
+		}
+	}
+}
+
+// ListEvents implements Client.
+func (c *RESTClient) ListEvents(ctx context.Context, repo Repository, number int64) ([]*Event, error) {
+	if err := c.checkRepo(repo); err != nil {
+		return nil, err
+	}
+	var result []*Event
+	for page := 1; ; page++ {
+		var events []*Event
+		u := fmt.Sprintf("%s%s/events?per_page=%v&page=%v", apiBaseURL(repo.Hostname), issuePath(repo, number), listPageSize, page)
+		if err := c.call(ctx, http.MethodGet, u, nil, &events); err != nil {
+			return nil, err
+		}
+		result = append(result, events...)
+		if len(events) < listPageSize {
+			return result, nil
+		}
+	}
+}
+
+// call makes a request to the GitHub REST API. If request is non-nil, it
+// is sent as the JSON body of the request. If response is non-nil, the
+// JSON response is decoded into it.
+func (c *RESTClient) call(ctx context.Context, method, url string, request, response any) error {
+	var body io.Reader
+	if request != nil {
+		blob, err := json.Marshal(request)
+		if err != nil {
+			return errors.Annotate(err, "marshal request").Err()
+		}
+		body = bytes.NewReader(blob)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return transient.Tag.Apply(errors.Annotate(err, "%s %s", method, url).Err())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err := errors.Reason("%s %s: HTTP %v: %s", method, url, resp.StatusCode, bytes.TrimSpace(msg)).Err()
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			err = NotFoundTag.Apply(err)
+		case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests:
+			err = transient.Tag.Apply(err)
+		}
+		return err
+	}
+	if response != nil {
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			return errors.Annotate(err, "decode response").Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRESTClient(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ParseAllowList`, t, func() {
+		allowList, err := ParseAllowList("proj-a:github.com/owner/repo, proj-a:ghe.example.com/o/r,proj-b:github.com/owner/other")
+		So(err, ShouldBeNil)
+		So(allowList, ShouldResemble, AllowList{
+			"proj-a": {
+				{Hostname: "github.com", Owner: "owner", Name: "repo"},
+				{Hostname: "ghe.example.com", Owner: "o", Name: "r"},
+			},
+			"proj-b": {
+				{Hostname: "github.com", Owner: "owner", Name: "other"},
+			},
+		})
+
+		allowList, err = ParseAllowList("")
+		So(err, ShouldBeNil)
+		So(allowList, ShouldBeEmpty)
+
+		_, err = ParseAllowList("github.com/owner/repo")
+		So(err, ShouldErrLike, "bad entry")
+		_, err = ParseAllowList("proj:github.com/owner")
+		So(err, ShouldErrLike, "bad entry")
+	})
+
+	Convey(`With a fake server`, t, func() {
+		ctx := context.Background()
+
+		var requests []string
+		srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Header.Get("Authorization")+" "+r.URL.Path)
+			w.Write([]byte(`{"number": 1, "login": "analysis"}`))
+		}))
+		defer srv.Close()
+		host := strings.TrimPrefix(srv.URL, "https://")
+
+		allowed := Repository{Hostname: host, Owner: "owner", Name: "repo"}
+		c := &RESTClient{
+			HTTPClient: srv.Client(),
+			Token:      "token",
+			Allowed:    []Repository{allowed},
+		}
+
+		Convey(`Accesses allowed repositories`, func() {
+			issue, err := c.GetIssue(ctx, allowed, 1)
+			So(err, ShouldBeNil)
+			So(issue.Number, ShouldEqual, 1)
+
+			login, err := c.SelfLogin(ctx, host)
+			So(err, ShouldBeNil)
+			So(login, ShouldEqual, "analysis")
+
+			So(requests, ShouldResemble, []string{
+				"Bearer token /api/v3/repos/owner/repo/issues/1",
+				"Bearer token /api/v3/user",
+			})
+		})
+
+		Convey(`Doesn't send requests for other repositories`, func() {
+			_, err := c.GetIssue(ctx, Repository{Hostname: host, Owner: "owner", Name: "other"}, 1)
+			So(err, ShouldErrLike, "is not allowed")
+			err = c.CreateComment(ctx, Repository{Hostname: "evil.example.com", Owner: "owner", Name: "repo"}, 1, "hi")
+			So(err, ShouldErrLike, "is not allowed")
+			_, err = c.SelfLogin(ctx, "evil.example.com")
+			So(err, ShouldErrLike, "is not allowed")
+			So(requests, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+)
+
+// FakeSelfLogin is the login the fake client authenticates as.
+const FakeSelfLogin = "luci-analysis-bot"
+
+// FakeIssue is an issue in the fake issue store, together with its
+// comments and events.
+type FakeIssue struct {
+	Repository Repository
+	Issue      *Issue
+	Comments   []*Comment
+	Events     []*Event
+}
+
+// FakeClient is an implementation of Client that fakes the actions
+// performed using an in-memory store.
+type FakeClient struct {
+	mu sync.Mutex
+	// Issues are the issues in the store, in order of creation.
+	Issues []*FakeIssue
+	// Defines a custom error to return when attempting to create
+	// an issue comment. Use this to test failed updates.
+	CreateCommentError error
+	nextEventID        int64
+}
+
+// NewFakeClient returns a new fake client with an empty issue store.
+func NewFakeClient() *FakeClient {
+	return &FakeClient{}
+}
+
+// AddIssue adds an issue to the store, as if it were created by a user.
+func (c *FakeClient) AddIssue(repo Repository, issue *Issue) *FakeIssue {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi := &FakeIssue{Repository: repo, Issue: issue}
+	c.Issues = append(c.Issues, fi)
+	return fi
+}
+
+// find returns the issue with the given repository and number,
+// or nil if it does not exist. c.mu must be held.
+func (c *FakeClient) find(repo Repository, number int64) *FakeIssue {
+	for _, fi := range c.Issues {
+		if fi.Repository.Owner == repo.Owner && fi.Repository.Name == repo.Name && fi.Issue.Number == number {
+			return fi
+		}
+	}
+	return nil
+}
+
+func (c *FakeClient) get(repo Repository, number int64) (*FakeIssue, error) {
+	fi := c.find(repo, number)
+	if fi == nil {
+		return nil, NotFoundTag.Apply(errors.Reason("issue %s#%v not found", repo, number).Err())
+	}
+	return fi, nil
+}
+
+func (c *FakeClient) addEvent(ctx context.Context, fi *FakeIssue, event string, label *Label) {
+	c.nextEventID++
+	fi.Events = append(fi.Events, &Event{
+		ID:        c.nextEventID,
+		Event:     event,
+		Actor:     User{Login: FakeSelfLogin},
+		Label:     label,
+		CreatedAt: clock.Now(ctx),
+	})
+}
+
+func (c *FakeClient) addLabels(ctx context.Context, fi *FakeIssue, labels []string) {
+	for _, l := range labels {
+		if !fi.Issue.HasLabel(l) {
+			fi.Issue.Labels = append(fi.Issue.Labels, Label{Name: l})
+			c.addEvent(ctx, fi, EventLabeled, &Label{Name: l})
+		}
+	}
+}
+
+// SelfLogin implements Client.
+func (c *FakeClient) SelfLogin(ctx context.Context, hostname string) (string, error) {
+	return FakeSelfLogin, nil
+}
+
+// GetIssue implements Client.
+func (c *FakeClient) GetIssue(ctx context.Context, repo Repository, number int64) (*Issue, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(repo, number)
+	if err != nil {
+		return nil, err
+	}
+	return copyIssue(fi.Issue), nil
+}
+
+// CreateIssue implements Client.
+func (c *FakeClient) CreateIssue(ctx context.Context, repo Repository, req *CreateIssueRequest) (*Issue, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var number int64 = 1
+	for _, fi := range c.Issues {
+		if fi.Repository.Owner == repo.Owner && fi.Repository.Name == repo.Name && fi.Issue.Number >= number {
+			number = fi.Issue.Number + 1
+		}
+	}
+	fi := &FakeIssue{
+		Repository: repo,
+		Issue: &Issue{
+			Number:    number,
+			Title:     req.Title,
+			Body:      req.Body,
+			State:     StateOpen,
+			UpdatedAt: clock.Now(ctx),
+		},
+	}
+	c.Issues = append(c.Issues, fi)
+	c.addLabels(ctx, fi, req.Labels)
+	return copyIssue(fi.Issue), nil
+}
+
+// UpdateIssue implements Client.
+func (c *FakeClient) UpdateIssue(ctx context.Context, repo Repository, number int64, req *UpdateIssueRequest) (*Issue, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(repo, number)
+	if err != nil {
+		return nil, err
+	}
+	now := clock.Now(ctx)
+	if req.State != "" && req.State != fi.Issue.State {
+		fi.Issue.State = req.State
+		if req.State == StateClosed {
+			fi.Issue.ClosedAt = now
+			c.addEvent(ctx, fi, EventClosed, nil)
+		} else {
+			fi.Issue.ClosedAt = time.Time{}
+			c.addEvent(ctx, fi, EventReopened, nil)
+		}
+	}
+	if req.StateReason != "" {
+		fi.Issue.StateReason = req.StateReason
+	}
+	if req.Labels != nil {
+		var labels []Label
+		for _, l := range *req.Labels {
+			labels = append(labels, Label{Name: l})
+			if !fi.Issue.HasLabel(l) {
+				c.addEvent(ctx, fi, EventLabeled, &Label{Name: l})
+			}
+		}
+		fi.Issue.Labels = labels
+	}
+	fi.Issue.UpdatedAt = now
+	return copyIssue(fi.Issue), nil
+}
+
+// AddLabels implements Client.
+func (c *FakeClient) AddLabels(ctx context.Context, repo Repository, number int64, labels []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(repo, number)
+	if err != nil {
+		return err
+	}
+	c.addLabels(ctx, fi, labels)
+	return nil
+}
+
+// CreateComment implements Client.
+func (c *FakeClient) CreateComment(ctx context.Context, repo Repository, number int64, body string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.CreateCommentError != nil {
+		return c.CreateCommentError
+	}
+	fi, err := c.get(repo, number)
+	if err != nil {
+		return err
+	}
+	fi.Comments = append(fi.Comments, &Comment{
+		ID:        int64(len(fi.Comments) + 1),
+		Body:      body,
+		User:      User{Login: FakeSelfLogin},
+		CreatedAt: clock.Now(ctx),
+	})
+	return nil
+}
+
+// ListComments implements Client.
+func (c *FakeClient) ListComments(ctx context.Context, repo Repository, number int64) ([]*Comment, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(repo, number)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Comment, 0, len(fi.Comments))
+	for _, comment := range fi.Comments {
+		item := *comment
+		result = append(result, &item)
+	}
+	return result, nil
+}
+
+// ListEvents implements Client.
+func (c *FakeClient) ListEvents(ctx context.Context, repo Repository, number int64) ([]*Event, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(repo, number)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Event, 0, len(fi.Events))
+	for _, e := range fi.Events {
+		item := *e
+		result = append(result, &item)
+	}
+	return result, nil
+}
+
+func copyIssue(issue *Issue) *Issue {
+	result := *issue
+	result.Labels = append([]Label(nil), issue.Labels...)
+	result.Assignees = append([]User(nil), issue.Assignees...)
+	return &result
+}
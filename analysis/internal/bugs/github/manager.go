@@ -0,0 +1,639 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package github contains GitHub Issues-specific logic for
+// creating and updating bugs.
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/analysis/internal/bugs"
+	"go.chromium.org/luci/analysis/internal/clustering"
+	configpb "go.chromium.org/luci/analysis/proto/config"
+)
+
+// DuplicateLabel is the label used to mark issues as duplicates, on
+// GitHub instances that do not support the "duplicate" state reason.
+const DuplicateLabel = "duplicate"
+
+// defaultPriorityLabelPrefix is the prefix of priority labels used if
+// none is configured.
+const defaultPriorityLabelPrefix = "P"
+
+var (
+	// duplicateOfURLRe matches comments marking an issue as a duplicate
+	// of an issue identified by URL, e.g.
+	// "Duplicate of https://github.com/owner/repo/issues/123".
+	duplicateOfURLRe = regexp.MustCompile(`(?i)duplicate of\s+https?://[^/\s]+/([\w.\-]+)/([\w.\-]+)/issues/([1-9][0-9]*)`)
+	// duplicateOfRefRe matches comments marking an issue as a duplicate
+	// of an issue identified by reference, e.g. "Duplicate of #123" or
+	// "Duplicate of owner/repo#123".
+	duplicateOfRefRe = regexp.MustCompile(`(?i)duplicate of\s+(?:([\w.\-]+)/([\w.\-]+))?#([1-9][0-9]*)`)
+)
+
+// BugManager controls the creation of, and updates to, GitHub issues
+// for clusters.
+type BugManager struct {
+	client Client
+	// The UI Base URL, e.g. "https://luci-analysis.appspot.com".
+	uiBaseURL string
+	// The LUCI Project.
+	project string
+	// The GitHub configuration to use.
+	githubCfg *configpb.GitHubProject
+	// The repository issues are filed in.
+	repo Repository
+	// The policy applyer instance used to apply bug management policy.
+	policyApplyer bugs.PolicyApplyer
+	// Simulate, if set, tells BugManager not to make mutating changes
+	// to GitHub but only log the changes it would make. Must be set
+	// when running locally as requests made from developer systems will
+	// appear as that user, which breaks the detection of user-made
+	// priority changes vs system-made priority changes.
+	Simulate bool
+}
+
+// NewBugManager initialises a new bug manager, using the specified
+// GitHub client.
+func NewBugManager(client Client, uiBaseURL, project string, projectCfg *configpb.ProjectConfig) (*BugManager, error) {
+	githubCfg := projectCfg.BugManagement.GetGithub()
+	if githubCfg == nil {
+		return nil, errors.Reason("github configuration not set").Err()
+	}
+	// All priorities from P0 to P4 have a corresponding label, so
+	// there is no need to round up low priorities.
+	policyApplyer, err := bugs.NewPolicyApplyer(projectCfg.BugManagement.GetPolicies(), configpb.BuganizerPriority_P4)
+	if err != nil {
+		return nil, err
+	}
+	hostname := githubCfg.Hostname
+	if hostname == "" {
+		hostname = DefaultHostname
+	}
+	return &BugManager{
+		client:    client,
+		uiBaseURL: uiBaseURL,
+		project:   project,
+		githubCfg: githubCfg,
+		repo: Repository{
+			Hostname: hostname,
+			Owner:    githubCfg.Owner,
+			Name:     githubCfg.Repository,
+		},
+		policyApplyer: policyApplyer,
+		Simulate:      false,
+	}, nil
+}
+
+// Create creates a new bug for the given request, returning its name, or
+// any encountered error.
+func (m *BugManager) Create(ctx context.Context, request bugs.BugCreateRequest) bugs.BugCreateResponse {
+	var response bugs.BugCreateResponse
+	response.Simulated = m.Simulate
+	response.PolicyActivationsNotified = make(map[bugs.PolicyID]struct{})
+
+	req, err := m.prepareNew(request.RuleID, request.ActivePolicyIDs, request.Description)
+	if err != nil {
+		response.Error = errors.Annotate(err, "prepare new issue").Err()
+		return response
+	}
+
+	var bugID string
+	if m.Simulate {
+		logging.Debugf(ctx, "Would create GitHub issue in %s: %+v", m.repo, req)
+		bugID = m.bugID(12345678)
+	} else {
+		issue, err := m.client.CreateIssue(ctx, m.repo, req)
+		if err != nil {
+			response.Error = errors.Annotate(err, "create issue in github").Err()
+			return response
+		}
+		bugID = m.bugID(issue.Number)
+		bugs.BugsCreatedCounter.Add(ctx, 1, m.project, "github")
+	}
+	// A bug was filed.
+	response.ID = bugID
+
+	response.PolicyActivationsNotified, err = m.notifyPolicyActivation(ctx, request.RuleID, bugID, request.ActivePolicyIDs)
+	if err != nil {
+		response.Error = errors.Annotate(err, "notify policy activations").Err()
+		return response
+	}
+	return response
+}
+
+func (m *BugManager) prepareNew(ruleID string, activePolicyIDs map[bugs.PolicyID]struct{}, description *clustering.ClusterDescription) (*CreateIssueRequest, error) {
+	priority, verified := m.policyApplyer.RecommendedPriorityAndVerified(activePolicyIDs)
+	if verified {
+		return nil, errors.Reason("issue is recommended to be verified from time of creation; are no policies active?").Err()
+	}
+	labels := append([]string(nil), m.githubCfg.Labels...)
+	labels = append(labels, m.priorityLabel(priority))
+
+	ruleURL := bugs.RuleURL(m.uiBaseURL, m.project, ruleID)
+	return &CreateIssueRequest{
+		Title:  bugs.GenerateBugSummary(description.Title),
+		Body:   m.policyApplyer.NewIssueDescription(description, activePolicyIDs, m.uiBaseURL, ruleURL),
+		Labels: labels,
+	}, nil
+}
+
+// notifyPolicyActivation notifies that the given policies have activated.
+//
+// This method supports partial success; it returns the set of policies
+// which were successfully notified even if an error is encountered and
+// returned.
+func (m *BugManager) notifyPolicyActivation(ctx context.Context, ruleID, bugID string, policyIDsToNotify map[bugs.PolicyID]struct{}) (map[bugs.PolicyID]struct{}, error) {
+	policiesNotified := make(map[bugs.PolicyID]struct{})
+
+	_, number, err := m.parseBugID(bugID)
+	if err != nil {
+		return policiesNotified, err
+	}
+
+	// Notify policies which have activated in descending priority order.
+	sortedPolicyIDToNotify := m.policyApplyer.SortPolicyIDsByPriorityDescending(policyIDsToNotify)
+	for _, policyID := range sortedPolicyIDToNotify {
+		templateInput := bugs.TemplateInput{
+			RuleURL: bugs.RuleURL(m.uiBaseURL, m.project, ruleID),
+			BugID:   bugs.NewTemplateBugID(bugs.BugID{System: bugs.GitHubSystem, ID: bugID}),
+		}
+		comment, err := m.policyApplyer.PolicyActivatedComment(policyID, m.uiBaseURL, templateInput)
+		if err != nil {
+			return policiesNotified, errors.Annotate(err, "prepare policy activated comment for policy %q", policyID).Err()
+		}
+		if labels := m.labelsForPolicy(policyID); len(labels) > 0 {
+			if err := m.addLabels(ctx, number, labels); err != nil {
+				return policiesNotified, errors.Annotate(err, "add labels for policy %q", policyID).Err()
+			}
+		}
+		// Only post a comment if the policy has specified one.
+		if comment != "" {
+			if err := m.createComment(ctx, number, comment); err != nil {
+				return policiesNotified, errors.Annotate(err, "post policy activated comment for policy %q", policyID).Err()
+			}
+		}
+		// Policy activation successfully notified.
+		policiesNotified[policyID] = struct{}{}
+	}
+	return policiesNotified, nil
+}
+
+// Update updates the specified list of bugs.
+func (m *BugManager) Update(ctx context.Context, request []bugs.BugUpdateRequest) ([]bugs.BugUpdateResponse, error) {
+	var responses []bugs.BugUpdateResponse
+	for _, req := range request {
+		issue, err := m.fetchIssue(ctx, req.Bug)
+		if err != nil {
+			return nil, err
+		}
+		if issue == nil {
+			// The bug does not exist, or is in a different repository
+			// to the repository configured for this project. Take
+			// no action.
+			responses = append(responses, bugs.BugUpdateResponse{
+				IsDuplicate:               false,
+				IsDuplicateAndAssigned:    false,
+				ShouldArchive:             false,
+				PolicyActivationsNotified: map[bugs.PolicyID]struct{}{},
+			})
+			logging.Warningf(ctx, "GitHub issue %s not found, skipping.", req.Bug.ID)
+			continue
+		}
+		responses = append(responses, m.updateIssue(ctx, req, issue))
+	}
+	return responses, nil
+}
+
+func (m *BugManager) updateIssue(ctx context.Context, request bugs.BugUpdateRequest, issue *Issue) bugs.BugUpdateResponse {
+	var response bugs.BugUpdateResponse
+	response.PolicyActivationsNotified = map[bugs.PolicyID]struct{}{}
+
+	// Ensure we have at least one minute of time available, to reduce
+	// the likelihood of the context timing out part way through
+	// an update. See the monorail bug manager for details.
+	if err := bugs.EnsureTimeToDeadline(ctx, time.Minute); err != nil {
+		response.Error = err
+		return response
+	}
+
+	if isDuplicate(issue) {
+		response.IsDuplicate = true
+		response.IsDuplicateAndAssigned = len(issue.Assignees) > 0
+	}
+	response.ShouldArchive = shouldArchiveRule(issue, clock.Now(ctx), request.IsManagingBug)
+	response.DisableRulePriorityUpdates = false // Set below if necessary.
+
+	if response.IsDuplicate || response.ShouldArchive {
+		return response
+	}
+
+	if !request.BugManagementState.RuleAssociationNotified {
+		ruleURL := bugs.RuleURL(m.uiBaseURL, m.project, request.RuleID)
+		comment := bugs.RuleAssociatedCommentary(ruleURL).ToComment()
+		if err := m.createComment(ctx, issue.Number, comment); err != nil {
+			response.Error = errors.Annotate(err, "create rule associated comment").Err()
+			return response
+		}
+		response.RuleAssociationNotified = true
+	}
+
+	// Identify which policies have activated for the first time and notify them (if any).
+	policyIDsToNotify := bugs.ActivePoliciesPendingNotification(request.BugManagementState)
+
+	var err error
+	response.PolicyActivationsNotified, err = m.notifyPolicyActivation(ctx, request.RuleID, request.Bug.ID, policyIDsToNotify)
+	if err != nil {
+		response.Error = errors.Annotate(err, "notify policy activations").Err()
+		return response
+	}
+
+	// Apply priority and verified updates, as necessary. This should occur
+	// after we have notified about policy activation, as that is the more
+	// logical order for someone reading the bug.
+	opts := bugs.BugOptions{
+		State:              request.BugManagementState,
+		IsManagingPriority: request.IsManagingBugPriority,
+		ExistingPriority:   m.issuePriority(issue),
+		ExistingVerified:   isVerified(issue),
+	}
+	if !request.IsManagingBug || !m.policyApplyer.NeedsPriorityOrVerifiedUpdate(opts) {
+		return response
+	}
+
+	hasManuallySetPriority := false
+	if request.IsManagingBugPriority {
+		hasManuallySetPriority, err = m.hasManuallySetPriority(ctx, issue.Number, request.IsManagingBugPriorityLastUpdated)
+		if err != nil {
+			response.Error = errors.Annotate(err, "determine if priority manually set").Err()
+			return response
+		}
+	}
+	opts.IsManagingPriority = request.IsManagingBugPriority && !hasManuallySetPriority
+
+	change, err := m.policyApplyer.PreparePriorityAndVerifiedChange(opts, m.uiBaseURL)
+	if err != nil {
+		response.Error = errors.Annotate(err, "prepare priority/verified update").Err()
+		return response
+	}
+	response.DisableRulePriorityUpdates = hasManuallySetPriority
+
+	update := &UpdateIssueRequest{}
+	if change.UpdatePriority {
+		labels := m.withPriorityLabel(issue, change.Priority)
+		update.Labels = &labels
+	}
+	if change.UpdateVerified {
+		if change.ShouldBeVerified {
+			update.State = StateClosed
+			update.StateReason = StateReasonCompleted
+		} else {
+			update.State = StateOpen
+			update.StateReason = StateReasonReopened
+		}
+	}
+	if change.UpdatePriority || change.UpdateVerified {
+		if err := m.updateIssueFields(ctx, issue.Number, update); err != nil {
+			response.Error = errors.Annotate(err, "update github issue").Err()
+			return response
+		}
+	}
+
+	var commentary bugs.Commentary
+	if change.UpdateVerified || change.UpdatePriority {
+		commentary = change.Justification
+	}
+	if hasManuallySetPriority {
+		commentary = bugs.MergeCommentary(commentary, bugs.ManualPriorityUpdateCommentary())
+	}
+	if len(commentary.Bodies) > 0 {
+		commentary.Footers = append(commentary.Footers, fmt.Sprintf(bugs.LinkTemplate, bugs.RuleURL(m.uiBaseURL, m.project, request.RuleID)))
+		if err := m.createComment(ctx, issue.Number, commentary.ToComment()); err != nil {
+			response.Error = errors.Annotate(err, "create priority/verified update comment").Err()
+			return response
+		}
+	}
+	return response
+}
+
+// shouldArchiveRule determines if the rule managing the given issue should
+// be archived.
+func shouldArchiveRule(issue *Issue, now time.Time, isManaging bool) bool {
+	if isManaging {
+		// If LUCI Analysis is managing the bug,
+		// more than 30 days since the issue was verified.
+		return isVerified(issue) && now.Sub(issue.ClosedAt).Hours() >= 30*24
+	}
+	// If the user is managing the bug,
+	// more than 30 days since the issue was closed.
+	return issue.State == StateClosed && now.Sub(issue.ClosedAt).Hours() >= 30*24
+}
+
+// isVerified returns whether the issue was closed as completed, which is
+// the closest GitHub Issues equivalent of the verified status.
+func isVerified(issue *Issue) bool {
+	return issue.State == StateClosed && !isDuplicate(issue) &&
+		(issue.StateReason == StateReasonCompleted || issue.StateReason == "")
+}
+
+// isDuplicate returns whether the issue was closed as a duplicate.
+func isDuplicate(issue *Issue) bool {
+	return issue.State == StateClosed &&
+		(issue.StateReason == StateReasonDuplicate || issue.HasLabel(DuplicateLabel))
+}
+
+// hasManuallySetPriority returns whether a user other than LUCI Analysis
+// has set a priority label on the issue since isManagingBugPriority was
+// last updated on the rule.
+func (m *BugManager) hasManuallySetPriority(ctx context.Context, number int64, isManagingBugPriorityLastUpdated time.Time) (bool, error) {
+	self, err := m.client.SelfLogin(ctx, m.repo.Hostname)
+	if err != nil {
+		return false, errors.Annotate(err, "get self login").Err()
+	}
+	events, err := m.client.ListEvents(ctx, m.repo, number)
+	if err != nil {
+		return false, errors.Annotate(err, "list events").Err()
+	}
+	for i := len(events) - 1; i >= 0; i-- {
+		e := events[i]
+		if e.Event != EventLabeled || e.Label == nil {
+			continue
+		}
+		if _, ok := m.parsePriorityLabel(e.Label.Name); !ok {
+			continue
+		}
+		if e.Actor.Login == self {
+			// The most recent priority change was made by LUCI Analysis.
+			return false, nil
+		}
+		return e.CreatedAt.After(isManagingBugPriorityLastUpdated), nil
+	}
+	// No manual changes to priority indicates the bug is still under
+	// automatic control.
+	return false, nil
+}
+
+// GetMergedInto reads the bug (if any) the given bug was merged into.
+// If the given bug is not merged into another bug, this returns nil.
+func (m *BugManager) GetMergedInto(ctx context.Context, bug bugs.BugID) (*bugs.BugID, error) {
+	if bug.System != bugs.GitHubSystem {
+		// Indicates an implementation error with the caller.
+		panic("github bug manager can only deal with github bugs")
+	}
+	issue, err := m.fetchIssue(ctx, bug)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil || !isDuplicate(issue) {
+		return nil, nil
+	}
+	comments, err := m.client.ListComments(ctx, m.repo, issue.Number)
+	if err != nil {
+		return nil, errors.Annotate(err, "list comments").Err()
+	}
+	return m.mergedIntoBug(comments)
+}
+
+// mergedIntoBug finds the issue the most recent "Duplicate of ..." comment
+// refers to.
+func (m *BugManager) mergedIntoBug(comments []*Comment) (*bugs.BugID, error) {
+	for i := len(comments) - 1; i >= 0; i-- {
+		body := comments[i].Body
+		match := duplicateOfURLRe.FindStringSubmatch(body)
+		if match == nil {
+			match = duplicateOfRefRe.FindStringSubmatch(body)
+		}
+		if match == nil {
+			continue
+		}
+		owner, name, number := match[1], match[2], match[3]
+		if owner == "" {
+			// A reference to an issue in the same repository.
+			owner, name = m.repo.Owner, m.repo.Name
+		}
+		result := &bugs.BugID{
+			System: bugs.GitHubSystem,
+			ID:     fmt.Sprintf("%s/%s/%s", owner, name, number),
+		}
+		if err := result.Validate(); err != nil {
+			return nil, errors.Annotate(err, "resolving canonical merged into bug").Err()
+		}
+		return result, nil
+	}
+	// The issue was marked as a duplicate without saying of what. The user
+	// should manually resolve the situation.
+	return nil, errors.New("issue is a duplicate but no \"Duplicate of\" comment was found")
+}
+
+// UpdateDuplicateSource updates the source bug of a duplicate
+// bug pair (source bug, destination bug).
+// It either comments on the source bug or, if an error is set,
+// re-opens it so that the duplicate is not processed again.
+func (m *BugManager) UpdateDuplicateSource(ctx context.Context, request bugs.UpdateDuplicateSourceRequest) error {
+	if request.BugDetails.Bug.System != bugs.GitHubSystem {
+		// Indicates an implementation error with the caller.
+		panic("github bug manager can only deal with github bugs")
+	}
+	_, number, err := m.parseBugID(request.BugDetails.Bug.ID)
+	if err != nil {
+		return err
+	}
+	var comment string
+	if request.ErrorMessage != "" {
+		issue, err := m.client.GetIssue(ctx, m.repo, number)
+		if err != nil {
+			return errors.Annotate(err, "get issue").Err()
+		}
+		var labels []string
+		for _, l := range issue.Labels {
+			if l.Name != DuplicateLabel {
+				labels = append(labels, l.Name)
+			}
+		}
+		update := &UpdateIssueRequest{
+			State:       StateOpen,
+			StateReason: StateReasonReopened,
+			Labels:      &labels,
+		}
+		if err := m.updateIssueFields(ctx, number, update); err != nil {
+			return errors.Annotate(err, "failed to update duplicate source github issue %s", request.BugDetails.Bug.ID).Err()
+		}
+		ruleLink := fmt.Sprintf(bugs.LinkTemplate, bugs.RuleURL(m.uiBaseURL, m.project, request.BugDetails.RuleID))
+		comment = strings.Join([]string{request.ErrorMessage, ruleLink}, "\n\n")
+	} else {
+		bugLink := bugs.RuleURL(m.uiBaseURL, m.project, request.DestinationRuleID)
+		comment = fmt.Sprintf(bugs.SourceBugRuleUpdatedTemplate, bugLink)
+	}
+	if err := m.createComment(ctx, number, comment); err != nil {
+		return errors.Annotate(err, "failed to comment on duplicate source github issue %s", request.BugDetails.Bug.ID).Err()
+	}
+	return nil
+}
+
+// fetchIssue fetches the GitHub issue for the given bug. If the issue does
+// not exist, or is in a repository other than the one configured for this
+// LUCI project, nil is returned.
+func (m *BugManager) fetchIssue(ctx context.Context, bug bugs.BugID) (*Issue, error) {
+	if bug.System != bugs.GitHubSystem {
+		// Indicates an implementation error with the caller.
+		panic("github bug manager can only deal with github bugs")
+	}
+	sameRepo, number, err := m.parseBugID(bug.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !sameRepo {
+		// Only query bugs from the same repository as what has
+		// been configured for the LUCI Project.
+		return nil, nil
+	}
+	issue, err := m.client.GetIssue(ctx, m.repo, number)
+	if err != nil {
+		if NotFoundTag.In(err) {
+			return nil, nil
+		}
+		return nil, errors.Annotate(err, "get issue %s", bug.ID).Err()
+	}
+	return issue, nil
+}
+
+// parseBugID parses a bug ID like "{owner}/{repository}/{number}",
+// returning whether the bug is in the repository configured for this
+// project and the issue number.
+func (m *BugManager) parseBugID(bugID string) (sameRepo bool, number int64, err error) {
+	match := bugs.GitHubBugIDRe.FindStringSubmatch(bugID)
+	if match == nil {
+		return false, 0, fmt.Errorf("invalid bug %q", bugID)
+	}
+	number, err = strconv.ParseInt(match[3], 10, 64)
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid bug %q", bugID)
+	}
+	sameRepo = strings.EqualFold(match[1], m.repo.Owner) && strings.EqualFold(match[2], m.repo.Name)
+	return sameRepo, number, nil
+}
+
+// bugID returns the bug ID for the given issue number in the configured
+// repository.
+func (m *BugManager) bugID(number int64) string {
+	return fmt.Sprintf("%s/%s/%v", m.repo.Owner, m.repo.Name, number)
+}
+
+func (m *BugManager) priorityLabelPrefix() string {
+	if m.githubCfg.PriorityLabelPrefix != "" {
+		return m.githubCfg.PriorityLabelPrefix
+	}
+	return defaultPriorityLabelPrefix
+}
+
+// priorityLabel returns the label used to record the given priority.
+func (m *BugManager) priorityLabel(priority configpb.BuganizerPriority) string {
+	return m.priorityLabelPrefix() + priority.String()
+}
+
+// parsePriorityLabel returns the priority recorded by the given label,
+// if it is a priority label.
+func (m *BugManager) parsePriorityLabel(label string) (configpb.BuganizerPriority, bool) {
+	suffix, ok := strings.CutPrefix(label, m.priorityLabelPrefix())
+	if !ok {
+		return configpb.BuganizerPriority_BUGANIZER_PRIORITY_UNSPECIFIED, false
+	}
+	value, ok := configpb.BuganizerPriority_value[suffix]
+	if !ok || value == int32(configpb.BuganizerPriority_BUGANIZER_PRIORITY_UNSPECIFIED) {
+		return configpb.BuganizerPriority_BUGANIZER_PRIORITY_UNSPECIFIED, false
+	}
+	return configpb.BuganizerPriority(value), true
+}
+
+// issuePriority returns the priority of the given issue. If the issue
+// has multiple priority labels, the highest priority is returned. If the
+// issue has no priority label, BUGANIZER_PRIORITY_UNSPECIFIED is returned.
+func (m *BugManager) issuePriority(issue *Issue) configpb.BuganizerPriority {
+	result := configpb.BuganizerPriority_BUGANIZER_PRIORITY_UNSPECIFIED
+	for _, l := range issue.Labels {
+		if p, ok := m.parsePriorityLabel(l.Name); ok {
+			if result == configpb.BuganizerPriority_BUGANIZER_PRIORITY_UNSPECIFIED || p < result {
+				result = p
+			}
+		}
+	}
+	return result
+}
+
+// withPriorityLabel returns the labels of the issue, with any existing
+// priority labels replaced by the label for the given priority.
+func (m *BugManager) withPriorityLabel(issue *Issue, priority configpb.BuganizerPriority) []string {
+	var result []string
+	for _, l := range issue.Labels {
+		if _, ok := m.parsePriorityLabel(l.Name); !ok {
+			result = append(result, l.Name)
+		}
+	}
+	return append(result, m.priorityLabel(priority))
+}
+
+func (m *BugManager) labelsForPolicy(policyID bugs.PolicyID) []string {
+	policy := m.policyApplyer.PolicyByID(policyID)
+	if policy == nil {
+		// Policy no longer configured.
+		return nil
+	}
+	return policy.BugTemplate.GetGithub().GetLabels()
+}
+
+func (m *BugManager) createComment(ctx context.Context, number int64, body string) error {
+	if m.Simulate {
+		logging.Debugf(ctx, "Would comment on GitHub issue %s#%v: %s", m.repo, number, body)
+		return nil
+	}
+	if err := m.client.CreateComment(ctx, m.repo, number, body); err != nil {
+		return err
+	}
+	bugs.BugsUpdatedCounter.Add(ctx, 1, m.project, "github")
+	return nil
+}
+
+func (m *BugManager) addLabels(ctx context.Context, number int64, labels []string) error {
+	if m.Simulate {
+		logging.Debugf(ctx, "Would add labels to GitHub issue %s#%v: %v", m.repo, number, labels)
+		return nil
+	}
+	if err := m.client.AddLabels(ctx, m.repo, number, labels); err != nil {
+		return err
+	}
+	bugs.BugsUpdatedCounter.Add(ctx, 1, m.project, "github")
+	return nil
+}
+
+func (m *BugManager) updateIssueFields(ctx context.Context, number int64, update *UpdateIssueRequest) error {
+	if m.Simulate {
+		logging.Debugf(ctx, "Would update GitHub issue %s#%v: %+v", m.repo, number, update)
+		return nil
+	}
+	if _, err := m.client.UpdateIssue(ctx, m.repo, number, update); err != nil {
+		return err
+	}
+	bugs.BugsUpdatedCounter.Add(ctx, 1, m.project, "github")
+	return nil
+}
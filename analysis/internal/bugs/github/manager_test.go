@@ -0,0 +1,272 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/analysis/internal/bugs"
+	bugspb "go.chromium.org/luci/analysis/internal/bugs/proto"
+	"go.chromium.org/luci/analysis/internal/clustering"
+	"go.chromium.org/luci/analysis/internal/config"
+	configpb "go.chromium.org/luci/analysis/proto/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func labelNames(issue *Issue) []string {
+	var result []string
+	for _, l := range issue.Labels {
+		result = append(result, l.Name)
+	}
+	return result
+}
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	Convey("With Bug Manager", t, func() {
+		ctx := context.Background()
+		now := time.Date(2040, time.January, 1, 2, 3, 4, 5, time.UTC)
+		ctx, tc := testclock.UseTime(ctx, now)
+
+		client := NewFakeClient()
+		repo := Repository{Hostname: DefaultHostname, Owner: "chromium", Name: "luci-go"}
+
+		policyA := config.CreatePlaceholderBugManagementPolicy("policy-a")
+		policyA.HumanReadableName = "Problem A"
+		policyA.Priority = configpb.BuganizerPriority_P4
+		policyA.BugTemplate.Github = &configpb.BugManagementPolicy_BugTemplate_GitHub{
+			Labels: []string{"policy-a-label"},
+		}
+
+		policyB := config.CreatePlaceholderBugManagementPolicy("policy-b")
+		policyB.HumanReadableName = "Problem B"
+		policyB.Priority = configpb.BuganizerPriority_P0
+
+		projectCfg := &configpb.ProjectConfig{
+			BugManagement: &configpb.BugManagement{
+				DefaultBugSystem: configpb.BugSystem_GITHUB,
+				Github: &configpb.GitHubProject{
+					Owner:               "chromium",
+					Repository:          "luci-go",
+					Labels:              []string{"luci-analysis"},
+					PriorityLabelPrefix: "priority: ",
+				},
+				Policies: []*configpb.BugManagementPolicy{policyA, policyB},
+			},
+		}
+
+		bm, err := NewBugManager(client, "https://luci-analysis-test.appspot.com", "luciproject", projectCfg)
+		So(err, ShouldBeNil)
+
+		Convey("Create", func() {
+			request := bugs.BugCreateRequest{
+				RuleID: "new-rule-id",
+				Description: &clustering.ClusterDescription{
+					Title:       "ClusterID",
+					Description: "Tests are failing with reason: Some failure reason.",
+				},
+				ActivePolicyIDs: map[bugs.PolicyID]struct{}{"policy-a": {}},
+			}
+
+			response := bm.Create(ctx, request)
+			So(response.Error, ShouldBeNil)
+			So(response.Simulated, ShouldBeFalse)
+			So(response.ID, ShouldEqual, "chromium/luci-go/1")
+			So(response.PolicyActivationsNotified, ShouldResemble, map[bugs.PolicyID]struct{}{"policy-a": {}})
+
+			So(client.Issues, ShouldHaveLength, 1)
+			fi := client.Issues[0]
+			So(fi.Issue.Title, ShouldEqual, "Tests are failing: ClusterID")
+			So(fi.Issue.Body, ShouldContainSubstring, "Tests are failing with reason: Some failure reason.")
+			So(fi.Issue.Body, ShouldContainSubstring, "https://luci-analysis-test.appspot.com/p/luciproject/rules/new-rule-id")
+			So(labelNames(fi.Issue), ShouldResemble, []string{"luci-analysis", "priority: P4", "policy-a-label"})
+			So(fi.Comments, ShouldHaveLength, 1)
+			So(fi.Comments[0].Body, ShouldContainSubstring, "Policy ID: policy-a")
+
+			Convey("Simulated", func() {
+				bm.Simulate = true
+				response := bm.Create(ctx, request)
+				So(response.Error, ShouldBeNil)
+				So(response.Simulated, ShouldBeTrue)
+				So(client.Issues, ShouldHaveLength, 1)
+			})
+			Convey("With no active policies", func() {
+				request.ActivePolicyIDs = nil
+				response := bm.Create(ctx, request)
+				So(response.Error, ShouldErrLike, "recommended to be verified")
+				So(response.ID, ShouldBeEmpty)
+			})
+		})
+		Convey("Update", func() {
+			fi := client.AddIssue(repo, &Issue{
+				Number: 7,
+				Title:  "Tests are failing",
+				State:  StateOpen,
+				Labels: []Label{{Name: "luci-analysis"}, {Name: "priority: P4"}},
+			})
+			activationTime := now.Add(-time.Hour)
+			state := &bugspb.BugManagementState{
+				RuleAssociationNotified: true,
+				PolicyState: map[string]*bugspb.BugManagementState_PolicyState{
+					"policy-a": {
+						IsActive:           true,
+						LastActivationTime: timestamppb.New(activationTime),
+						ActivationNotified: true,
+					},
+					"policy-b": {},
+				},
+			}
+			request := bugs.BugUpdateRequest{
+				Bug:                              bugs.BugID{System: bugs.GitHubSystem, ID: "chromium/luci-go/7"},
+				IsManagingBug:                    true,
+				IsManagingBugPriority:            true,
+				IsManagingBugPriorityLastUpdated: now.Add(-2 * time.Hour),
+				RuleID:                           "rule-id",
+				BugManagementState:               state,
+			}
+			update := func() bugs.BugUpdateResponse {
+				responses, err := bm.Update(ctx, []bugs.BugUpdateRequest{request})
+				So(err, ShouldBeNil)
+				So(responses, ShouldHaveLength, 1)
+				So(responses[0].Error, ShouldBeNil)
+				return responses[0]
+			}
+
+			Convey("No change", func() {
+				response := update()
+				So(response, ShouldResemble, bugs.BugUpdateResponse{
+					PolicyActivationsNotified: map[bugs.PolicyID]struct{}{},
+				})
+				So(fi.Comments, ShouldBeEmpty)
+			})
+			Convey("Rule association notified", func() {
+				state.RuleAssociationNotified = false
+				response := update()
+				So(response.RuleAssociationNotified, ShouldBeTrue)
+				So(fi.Comments, ShouldHaveLength, 1)
+				So(fi.Comments[0].Body, ShouldContainSubstring, "This bug has been associated with failures in LUCI Analysis.")
+			})
+			Convey("Priority increases when policy activates", func() {
+				state.PolicyState["policy-b"] = &bugspb.BugManagementState_PolicyState{
+					IsActive:           true,
+					LastActivationTime: timestamppb.New(activationTime),
+				}
+				response := update()
+				So(response.PolicyActivationsNotified, ShouldResemble, map[bugs.PolicyID]struct{}{"policy-b": {}})
+				So(labelNames(fi.Issue), ShouldResemble, []string{"luci-analysis", "priority: P0"})
+				So(fi.Comments, ShouldHaveLength, 2)
+				So(fi.Comments[0].Body, ShouldContainSubstring, "Policy ID: policy-b")
+				So(fi.Comments[1].Body, ShouldContainSubstring, "The bug priority has been set to P0.")
+
+				Convey("Unless the user manually set the priority", func() {
+					// Undo the change, then have a user set the priority.
+					state.PolicyState["policy-b"].ActivationNotified = true
+					fi.Issue.Labels = []Label{{Name: "priority: P4"}}
+					fi.Events = append(fi.Events, &Event{
+						Event:     EventLabeled,
+						Actor:     User{Login: "user"},
+						Label:     &Label{Name: "priority: P4"},
+						CreatedAt: now.Add(-time.Minute),
+					})
+
+					response := update()
+					So(response.DisableRulePriorityUpdates, ShouldBeTrue)
+					So(labelNames(fi.Issue), ShouldResemble, []string{"priority: P4"})
+					So(fi.Comments, ShouldHaveLength, 3)
+					So(fi.Comments[2].Body, ShouldContainSubstring, "The bug priority has been manually set.")
+				})
+			})
+			Convey("Verified when policies deactivate", func() {
+				state.PolicyState["policy-a"].IsActive = false
+				state.PolicyState["policy-a"].LastDeactivationTime = timestamppb.New(now.Add(-time.Minute))
+				response := update()
+				So(response.ShouldArchive, ShouldBeFalse)
+				So(fi.Issue.State, ShouldEqual, StateClosed)
+				So(fi.Issue.StateReason, ShouldEqual, StateReasonCompleted)
+				So(fi.Comments, ShouldHaveLength, 1)
+				So(fi.Comments[0].Body, ShouldContainSubstring, "The bug has been verified.")
+
+				Convey("Re-opened when policies re-activate", func() {
+					state.PolicyState["policy-a"].IsActive = true
+					state.PolicyState["policy-a"].LastActivationTime = timestamppb.New(now)
+					update()
+					So(fi.Issue.State, ShouldEqual, StateOpen)
+					So(fi.Comments, ShouldHaveLength, 2)
+					So(fi.Comments[1].Body, ShouldContainSubstring, "The bug has been re-opened.")
+				})
+				Convey("Rule archived after 30 days", func() {
+					tc.Add(30 * 24 * time.Hour)
+					response := update()
+					So(response.ShouldArchive, ShouldBeTrue)
+				})
+			})
+			Convey("Duplicate", func() {
+				fi.Issue.State = StateClosed
+				fi.Issue.StateReason = StateReasonNotPlanned
+				fi.Issue.Labels = append(fi.Issue.Labels, Label{Name: DuplicateLabel})
+				fi.Comments = append(fi.Comments, &Comment{Body: "Duplicate of #3", User: User{Login: "user"}})
+
+				response := update()
+				So(response.IsDuplicate, ShouldBeTrue)
+				So(response.IsDuplicateAndAssigned, ShouldBeFalse)
+
+				mergedInto, err := bm.GetMergedInto(ctx, request.Bug)
+				So(err, ShouldBeNil)
+				So(mergedInto, ShouldResemble, &bugs.BugID{System: bugs.GitHubSystem, ID: "chromium/luci-go/3"})
+
+				Convey("Into another repository", func() {
+					fi.Comments = append(fi.Comments, &Comment{Body: "Duplicate of https://github.com/other/repo/issues/12"})
+					mergedInto, err := bm.GetMergedInto(ctx, request.Bug)
+					So(err, ShouldBeNil)
+					So(mergedInto, ShouldResemble, &bugs.BugID{System: bugs.GitHubSystem, ID: "other/repo/12"})
+				})
+				Convey("UpdateDuplicateSource with error", func() {
+					err := bm.UpdateDuplicateSource(ctx, bugs.UpdateDuplicateSourceRequest{
+						BugDetails:   bugs.DuplicateBugDetails{RuleID: "rule-id", Bug: request.Bug},
+						ErrorMessage: "Some error.",
+					})
+					So(err, ShouldBeNil)
+					So(fi.Issue.State, ShouldEqual, StateOpen)
+					So(fi.Issue.HasLabel(DuplicateLabel), ShouldBeFalse)
+					So(fi.Comments[len(fi.Comments)-1].Body, ShouldContainSubstring, "Some error.")
+				})
+				Convey("UpdateDuplicateSource", func() {
+					err := bm.UpdateDuplicateSource(ctx, bugs.UpdateDuplicateSourceRequest{
+						BugDetails:        bugs.DuplicateBugDetails{RuleID: "rule-id", Bug: request.Bug},
+						DestinationRuleID: "destination-rule-id",
+					})
+					So(err, ShouldBeNil)
+					So(fi.Issue.State, ShouldEqual, StateClosed)
+					So(fi.Comments[len(fi.Comments)-1].Body, ShouldContainSubstring, "rules/destination-rule-id")
+				})
+			})
+			Convey("Issue in other repository is skipped", func() {
+				request.Bug.ID = "other/repo/7"
+				response := update()
+				So(response.IsDuplicate, ShouldBeFalse)
+				So(response.ShouldArchive, ShouldBeFalse)
+			})
+		})
+	})
+}
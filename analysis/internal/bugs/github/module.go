@@ -0,0 +1,134 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"flag"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/module"
+)
+
+// The modes for the GitHub integration client.
+// `ModeDisable` is the default mode, and ignores GitHub issues.
+// `ModeProvided` uses the GitHub REST API.
+const (
+	ModeDisable  = "disable"
+	ModeProvided = "provided"
+)
+
+// ModuleName can be used to refer to this module when declaring dependencies.
+var ModuleName = module.RegisterName("go.chromium.org/luci/analysis/internal/bugs/github")
+
+// ModuleOptions contain configuration for the GitHub module.
+type ModuleOptions struct {
+	// Option for the GitHub integration, defaults to `disable`.
+	// Acceptable values are:
+	// `disable` - Indicates that GitHub integration should not be used.
+	// `provided` - Indicates that GitHub integration should use the REST API.
+	GitHubClientMode string
+
+	// The name of the secret holding the token used to authenticate to
+	// GitHub, e.g. "sm://github-token".
+	GitHubTokenSecret string
+
+	// The GitHub repositories LUCI projects may file issues in, as a
+	// comma-separated list of "<luci-project>:<hostname>/<owner>/<repository>".
+	// The GitHub token is never sent to other repositories.
+	GitHubAllowedRepos string
+}
+
+// Register registers the command line flags.
+func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	f.StringVar(
+		&o.GitHubClientMode,
+		"github-mode",
+		o.GitHubClientMode,
+		"The GitHub integration client mode to use.",
+	)
+	f.StringVar(
+		&o.GitHubTokenSecret,
+		"github-token-secret",
+		o.GitHubTokenSecret,
+		"The secret holding the token used to authenticate to GitHub.",
+	)
+	f.StringVar(
+		&o.GitHubAllowedRepos,
+		"github-allowed-repos",
+		o.GitHubAllowedRepos,
+		"Comma-separated list of GitHub repositories LUCI projects may file issues in, "+
+			"as <luci-project>:<hostname>/<owner>/<repository>.",
+	)
+}
+
+// NewModule returns a server module that sets context values for GitHub
+// integration.
+func NewModule(opts *ModuleOptions) module.Module {
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	return &githubModule{opts: opts}
+}
+
+// NewModuleFromFlags is a variant of NewModule that initializes options through
+// command line flags.
+//
+// Calling this function registers flags in flag.CommandLine. They are usually
+// parsed in server.Main(...).
+func NewModuleFromFlags() module.Module {
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
+}
+
+// githubModule implements module.Module.
+type githubModule struct {
+	opts *ModuleOptions
+}
+
+// Name is part of module.Module interface.
+func (*githubModule) Name() module.Name {
+	return ModuleName
+}
+
+// Dependencies is part of module.Module interface.
+func (*githubModule) Dependencies() []module.Dependency {
+	return nil
+}
+
+// ClientModeKey the key to get the value for GitHub integration
+// mode from the Context.
+var ClientModeKey = "go.chromium.org/luci/analysis/internal/bugs/github:githubMode"
+
+// TokenSecretKey the key to get the name of the secret holding the
+// GitHub token from the Context.
+var TokenSecretKey = "go.chromium.org/luci/analysis/internal/bugs/github:githubTokenSecret"
+
+// AllowListKey the key to get the AllowList of GitHub repositories
+// from the Context.
+var AllowListKey = "go.chromium.org/luci/analysis/internal/bugs/github:githubAllowList"
+
+// Initialize is part of module.Module interface.
+func (m *githubModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
+	allowList, err := ParseAllowList(m.opts.GitHubAllowedRepos)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad -github-allowed-repos").Err()
+	}
+	ctx = context.WithValue(ctx, &ClientModeKey, m.opts.GitHubClientMode)
+	ctx = context.WithValue(ctx, &TokenSecretKey, m.opts.GitHubTokenSecret)
+	ctx = context.WithValue(ctx, &AllowListKey, allowList)
+	return ctx, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jira
+
+import (
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/analysis/internal/bugs"
+)
+
+// Project identifies a Jira project.
+type Project struct {
+	// Hostname is the hostname of the Jira instance.
+	Hostname string
+	// Key is the key of the Jira project, e.g. "PROJ".
+	Key string
+}
+
+// AllowList is the set of Jira projects each LUCI project may file issues
+// in, keyed by LUCI project.
+//
+// The Jira credentials of the service are only ever sent to the hosts of
+// these projects and used for their issues, regardless of what the project
+// configuration says.
+type AllowList map[string][]Project
+
+// ParseAllowList parses a comma-separated list of
+// "<luci-project>:<hostname>/<jira-project-key>" entries.
+func ParseAllowList(s string) (AllowList, error) {
+	allowList := AllowList{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		project, jiraProject, ok := strings.Cut(entry, ":")
+		parts := strings.Split(jiraProject, "/")
+		if !ok || project == "" || len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Reason("bad entry %q, want <luci-project>:<hostname>/<jira-project-key>", entry).Err()
+		}
+		allowList[project] = append(allowList[project], Project{
+			Hostname: parts[0],
+			Key:      parts[1],
+		})
+	}
+	return allowList, nil
+}
+
+// allows returns whether the Jira project is in the list.
+func allows(projects []Project, host, key string) bool {
+	for _, p := range projects {
+		if strings.EqualFold(p.Hostname, host) && p.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsHost returns whether some Jira project in the list is on the host.
+func allowsHost(projects []Project, host string) bool {
+	for _, p := range projects {
+		if strings.EqualFold(p.Hostname, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// issueProjectKey returns the key of the Jira project of the issue with
+// the given key.
+func issueProjectKey(key string) (string, error) {
+	bug := bugs.BugID{System: bugs.JiraSystem, ID: key}
+	return bug.JiraProjectKey()
+}
@@ -0,0 +1,517 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/server/secrets"
+)
+
+// The status categories of Jira issues. Unlike statuses, which are
+// configured per Jira instance, status categories are fixed.
+const (
+	StatusCategoryNew           = "new"
+	StatusCategoryIndeterminate = "indeterminate"
+	StatusCategoryDone          = "done"
+)
+
+// DuplicateResolution is the resolution of issues closed as duplicates.
+const DuplicateResolution = "Duplicate"
+
+// DuplicateLinkType is the name of the link type used to link an issue
+// to the issue it duplicates.
+const DuplicateLinkType = "Duplicate"
+
+// Issue is a Jira issue.
+type Issue struct {
+	// Key is the issue key, e.g. "PROJ-123".
+	Key     string
+	Summary string
+	// Status is the name of the issue status, e.g. "In Progress".
+	Status string
+	// StatusCategory is the key of the status category of the issue
+	// status, e.g. "done".
+	StatusCategory string
+	// Resolution is the name of the resolution of the issue, e.g. "Done".
+	// Empty if the issue is unresolved.
+	Resolution string
+	// ResolutionDate is the time the issue was resolved.
+	ResolutionDate time.Time
+	// Priority is the name of the priority of the issue, e.g. "High".
+	Priority string
+	Labels   []string
+	// Assignee identifies the user the issue is assigned to, if any.
+	Assignee string
+	// Links are the links from this issue to other issues.
+	Links []IssueLink
+}
+
+// IssueLink is a link from one Jira issue to another.
+type IssueLink struct {
+	// Type is the name of the link type, e.g. "Duplicate".
+	Type string
+	// Outward is whether the link is outward from this issue, e.g.
+	// this issue "duplicates" the other issue, as opposed to
+	// "is duplicated by".
+	Outward bool
+	// Key is the key of the other issue.
+	Key string
+}
+
+// ChangelogEntry is a set of changes made to an issue by one user at
+// one time.
+type ChangelogEntry struct {
+	// Author identifies the user who made the change.
+	Author  string
+	Created time.Time
+	Items   []ChangeItem
+}
+
+// ChangeItem is a change to one field of an issue.
+type ChangeItem struct {
+	Field string
+	From  string
+	To    string
+}
+
+// CreateIssueRequest is a request to create a Jira issue.
+type CreateIssueRequest struct {
+	ProjectKey  string
+	IssueType   string
+	Summary     string
+	Description string
+	Priority    string
+	Labels      []string
+}
+
+// UpdateIssueRequest is a request to update a Jira issue. Only fields
+// which are set are updated.
+type UpdateIssueRequest struct {
+	// Priority is the name of the priority to set.
+	Priority string
+	// AddLabels are labels to add. Existing labels are retained.
+	AddLabels []string
+}
+
+// Client is the interface to Jira used by LUCI Analysis.
+// It allows the Jira REST API to be substituted with a fake in tests.
+type Client interface {
+	// Myself identifies the user LUCI Analysis authenticates to the
+	// given Jira instance as. This is used to distinguish actions taken
+	// by LUCI Analysis from user actions.
+	Myself(ctx context.Context, host string) (string, error)
+	// GetIssue retrieves the given issue. If the issue does not exist,
+	// an error tagged with NotFoundTag is returned.
+	GetIssue(ctx context.Context, host, key string) (*Issue, error)
+	// CreateIssue creates a new issue, returning its key.
+	CreateIssue(ctx context.Context, host string, req *CreateIssueRequest) (string, error)
+	// UpdateIssue updates the given issue.
+	UpdateIssue(ctx context.Context, host, key string, req *UpdateIssueRequest) error
+	// Transition transitions the issue to a status in the given status
+	// category, using the first transition available to such a status.
+	Transition(ctx context.Context, host, key, statusCategory string) error
+	// AddComment posts a comment on the given issue.
+	AddComment(ctx context.Context, host, key, body string) error
+	// GetChangelog returns the changelog of the given issue, oldest first.
+	GetChangelog(ctx context.Context, host, key string) ([]*ChangelogEntry, error)
+}
+
+// NotFoundTag is used to tag errors returned when an issue does not exist.
+var NotFoundTag = errors.BoolTag{Key: errors.NewTagKey("jira issue not found")}
+
+// RESTClient implements Client using the Jira REST API (version 2).
+type RESTClient struct {
+	// HTTPClient is the client used to make requests.
+	HTTPClient *http.Client
+	// User is the user to authenticate as, using basic authentication
+	// with Token as the password (Jira Cloud API tokens). If empty,
+	// Token is used as a bearer token (Jira Data Center personal
+	// access tokens).
+	User string
+	// Token is the token used to authenticate to Jira.
+	Token string
+	// Allowed are the Jira projects the client may access. Requests for
+	// issues in other projects fail without being sent.
+	Allowed []Project
+}
+
+// NewRESTClient returns a new client for the Jira REST API, which
+// authenticates with the credentials configured on the Jira module.
+//
+// The client can only access Jira projects the Jira module allows for the
+// given LUCI project.
+func NewRESTClient(ctx context.Context, project string) (*RESTClient, error) {
+	secretName, _ := ctx.Value(&TokenSecretKey).(string)
+	if secretName == "" {
+		return nil, errors.New("Jira token secret is required for REST client")
+	}
+	secret, err := secrets.StoredSecret(ctx, secretName)
+	if err != nil {
+		return nil, errors.Annotate(err, "read Jira token").Err()
+	}
+	user, _ := ctx.Value(&UserKey).(string)
+	allowList, _ := ctx.Value(&AllowListKey).(AllowList)
+	return &RESTClient{
+		HTTPClient: &http.Client{Timeout: requestTimeout},
+		User:       user,
+		Token:      string(bytes.TrimSpace(secret.Active)),
+		Allowed:    allowList[project],
+	}, nil
+}
+
+// requestTimeout is the timeout of a single request to the Jira API.
+const requestTimeout = time.Minute
+
+// checkProject returns an error if the client may not access the given
+// Jira project.
+func (c *RESTClient) checkProject(host, projectKey string) error {
+	if !allows(c.Allowed, host, projectKey) {
+		return errors.Reason("Jira project %s/%s is not allowed for this project", host, projectKey).Err()
+	}
+	return nil
+}
+
+// checkIssue returns an error if the client may not access the issue with
+// the given key.
+func (c *RESTClient) checkIssue(host, key string) error {
+	projectKey, err := issueProjectKey(key)
+	if err != nil {
+		return errors.Annotate(err, "issue %q", key).Err()
+	}
+	return c.checkProject(host, projectKey)
+}
+
+// jiraTimeLayout is the layout of timestamps returned by the Jira API.
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// jiraTime is a timestamp in the format returned by the Jira API.
+type jiraTime time.Time
+
+func (t *jiraTime) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = jiraTime{}
+		return nil
+	}
+	parsed, err := time.Parse(jiraTimeLayout, s)
+	if err != nil {
+		return err
+	}
+	*t = jiraTime(parsed)
+	return nil
+}
+
+type named struct {
+	Name string `json:"name,omitempty"`
+}
+
+type userJSON struct {
+	AccountID string `json:"accountId"`
+	Name      string `json:"name"`
+}
+
+// id returns the identifier of the user. Jira Cloud identifies users by
+// account ID, Jira Data Center by name.
+func (u *userJSON) id() string {
+	if u == nil {
+		return ""
+	}
+	if u.AccountID != "" {
+		return u.AccountID
+	}
+	return u.Name
+}
+
+type issueJSON struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary string `json:"summary"`
+		Status  struct {
+			Name           string `json:"name"`
+			StatusCategory struct {
+				Key string `json:"key"`
+			} `json:"statusCategory"`
+		} `json:"status"`
+		Resolution     *named    `json:"resolution"`
+		ResolutionDate jiraTime  `json:"resolutiondate"`
+		Priority       *named    `json:"priority"`
+		Labels         []string  `json:"labels"`
+		Assignee       *userJSON `json:"assignee"`
+		IssueLinks     []struct {
+			Type         named `json:"type"`
+			OutwardIssue *struct {
+				Key string `json:"key"`
+			} `json:"outwardIssue"`
+			InwardIssue *struct {
+				Key string `json:"key"`
+			} `json:"inwardIssue"`
+		} `json:"issuelinks"`
+	} `json:"fields"`
+	Changelog struct {
+		Histories []struct {
+			Author  *userJSON `json:"author"`
+			Created jiraTime  `json:"created"`
+			Items   []struct {
+				Field      string `json:"field"`
+				FromString string `json:"fromString"`
+				ToString   string `json:"toString"`
+			} `json:"items"`
+		} `json:"histories"`
+	} `json:"changelog"`
+}
+
+func (j *issueJSON) toIssue() *Issue {
+	issue := &Issue{
+		Key:            j.Key,
+		Summary:        j.Fields.Summary,
+		Status:         j.Fields.Status.Name,
+		StatusCategory: j.Fields.Status.StatusCategory.Key,
+		ResolutionDate: time.Time(j.Fields.ResolutionDate),
+		Labels:         j.Fields.Labels,
+		Assignee:       j.Fields.Assignee.id(),
+	}
+	if j.Fields.Resolution != nil {
+		issue.Resolution = j.Fields.Resolution.Name
+	}
+	if j.Fields.Priority != nil {
+		issue.Priority = j.Fields.Priority.Name
+	}
+	for _, l := range j.Fields.IssueLinks {
+		switch {
+		case l.OutwardIssue != nil:
+			issue.Links = append(issue.Links, IssueLink{Type: l.Type.Name, Outward: true, Key: l.OutwardIssue.Key})
+		case l.InwardIssue != nil:
+			issue.Links = append(issue.Links, IssueLink{Type: l.Type.Name, Outward: false, Key: l.InwardIssue.Key})
+		}
+	}
+	return issue
+}
+
+func issueURL(host, key string) string {
+	return fmt.Sprintf("https://%s/rest/api/2/issue/%s", host, url.PathEscape(key))
+}
+
+// Myself implements Client.
+func (c *RESTClient) Myself(ctx context.Context, host string) (string, error) {
+	if !allowsHost(c.Allowed, host) {
+		return "", errors.Reason("Jira host %q is not allowed for this project", host).Err()
+	}
+	var user userJSON
+	if err := c.call(ctx, http.MethodGet, fmt.Sprintf("https://%s/rest/api/2/myself", host), nil, &user); err != nil {
+		return "", err
+	}
+	return user.id(), nil
+}
+
+// GetIssue implements Client.
+func (c *RESTClient) GetIssue(ctx context.Context, host, key string) (*Issue, error) {
+	if err := c.checkIssue(host, key); err != nil {
+		return nil, err
+	}
+	var j issueJSON
+	u := issueURL(host, key) + "?fields=summary,status,resolution,resolutiondate,priority,labels,assignee,issuelinks"
+	if err := c.call(ctx, http.MethodGet, u, nil, &j); err != nil {
+		return nil, err
+	}
+	return j.toIssue(), nil
+}
+
+// CreateIssue implements Client.
+func (c *RESTClient) CreateIssue(ctx context.Context, host string, req *CreateIssueRequest) (string, error) {
+	if err := c.checkProject(host, req.ProjectKey); err != nil {
+		return "", err
+	}
+	type projectRef struct {
+		Key string `json:"key"`
+	}
+	body := struct {
+		Fields struct {
+			Project     projectRef `json:"project"`
+			IssueType   named      `json:"issuetype"`
+			Summary     string     `json:"summary"`
+			Description string     `json:"description"`
+			Priority    *named     `json:"priority,omitempty"`
+			Labels      []string   `json:"labels,omitempty"`
+		} `json:"fields"`
+	}{}
+	body.Fields.Project.Key = req.ProjectKey
+	body.Fields.IssueType.Name = req.IssueType
+	body.Fields.Summary = req.Summary
+	body.Fields.Description = req.Description
+	if req.Priority != "" {
+		body.Fields.Priority = &named{Name: req.Priority}
+	}
+	body.Fields.Labels = req.Labels
+
+	var resp struct {
+		Key string `json:"key"`
+	}
+	if err := c.call(ctx, http.MethodPost, fmt.Sprintf("https://%s/rest/api/2/issue", host), body, &resp); err != nil {
+		return "", err
+	}
+	return resp.Key, nil
+}
+
+// UpdateIssue implements Client.
+func (c *RESTClient) UpdateIssue(ctx context.Context, host, key string, req *UpdateIssueRequest) error {
+	if err := c.checkIssue(host, key); err != nil {
+		return err
+	}
+	type labelOp struct {
+		Add string `json:"add"`
+	}
+	body := struct {
+		Fields map[string]any       `json:"fields,omitempty"`
+		Update map[string][]labelOp `json:"update,omitempty"`
+	}{}
+	if req.Priority != "" {
+		body.Fields = map[string]any{"priority": named{Name: req.Priority}}
+	}
+	if len(req.AddLabels) > 0 {
+		var ops []labelOp
+		for _, l := range req.AddLabels {
+			ops = append(ops, labelOp{Add: l})
+		}
+		body.Update = map[string][]labelOp{"labels": ops}
+	}
+	return c.call(ctx, http.MethodPut, issueURL(host, key), body, nil)
+}
+
+// Transition implements Client.
+func (c *RESTClient) Transition(ctx context.Context, host, key, statusCategory string) error {
+	if err := c.checkIssue(host, key); err != nil {
+		return err
+	}
+	var resp struct {
+		Transitions []struct {
+			ID string `json:"id"`
+			To struct {
+				StatusCategory struct {
+					Key string `json:"key"`
+				} `json:"statusCategory"`
+			} `json:"to"`
+		} `json:"transitions"`
+	}
+	if err := c.call(ctx, http.MethodGet, issueURL(host, key)+"/transitions", nil, &resp); err != nil {
+		return err
+	}
+	for _, t := range resp.Transitions {
+		if t.To.StatusCategory.Key != statusCategory {
+			continue
+		}
+		body := map[string]any{"transition": map[string]string{"id": t.ID}}
+		return c.call(ctx, http.MethodPost, issueURL(host, key)+"/transitions", body, nil)
+	}
+	return errors.Reason("no transition available to a status in category %q", statusCategory).Err()
+}
+
+// AddComment implements Client.
+func (c *RESTClient) AddComment(ctx context.Context, host, key, body string) error {
+	if err := c.checkIssue(host, key); err != nil {
+		return err
+	}
+	req := struct {
+		Body string `json:"body"`
+	}{Body: body}
+	return c.call(ctx, http.MethodPost, issueURL(host, key)+"/comment", req, nil)
+}
+
+// GetChangelog implements Client.
+func (c *RESTClient) GetChangelog(ctx context.Context, host, key string) ([]*ChangelogEntry, error) {
+	if err := c.checkIssue(host, key); err != nil {
+		return nil, err
+	}
+	var j issueJSON
+	if err := c.call(ctx, http.MethodGet, issueURL(host, key)+"?fields=none&expand=changelog", nil, &j); err != nil {
+		return nil, err
+	}
+	var result []*ChangelogEntry
+	for _, h := range j.Changelog.Histories {
+		entry := &ChangelogEntry{
+			Author:  h.Author.id(),
+			Created: time.Time(h.Created),
+		}
+		for _, item := range h.Items {
+			entry.Items = append(entry.Items, ChangeItem{Field: item.Field, From: item.FromString, To: item.ToString})
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+// call makes a request to the Jira REST API. If request is non-nil, it
+// is sent as the JSON body of the request. If response is non-nil, the
+// JSON response is decoded into it.
+func (c *RESTClient) call(ctx context.Context, method, url string, request, response any) error {
+	var body io.Reader
+	if request != nil {
+		blob, err := json.Marshal(request)
+		if err != nil {
+			return errors.Annotate(err, "marshal request").Err()
+		}
+		body = bytes.NewReader(blob)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.User != "" {
+		req.SetBasicAuth(c.User, c.Token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return transient.Tag.Apply(errors.Annotate(err, "%s %s", method, url).Err())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		err := errors.Reason("%s %s: HTTP %v: %s", method, url, resp.StatusCode, strings.TrimSpace(string(msg))).Err()
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			err = NotFoundTag.Apply(err)
+		case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests:
+			err = transient.Tag.Apply(err)
+		}
+		return err
+	}
+	if response != nil {
+		if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+			return errors.Annotate(err, "decode response").Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRESTClient(t *testing.T) {
+	t.Parallel()
+
+	Convey(`ParseAllowList`, t, func() {
+		allowList, err := ParseAllowList("proj-a:jira.example.com/PROJ, proj-b:jira.example.com/OTHER")
+		So(err, ShouldBeNil)
+		So(allowList, ShouldResemble, AllowList{
+			"proj-a": {{Hostname: "jira.example.com", Key: "PROJ"}},
+			"proj-b": {{Hostname: "jira.example.com", Key: "OTHER"}},
+		})
+
+		_, err = ParseAllowList("jira.example.com/PROJ")
+		So(err, ShouldErrLike, "bad entry")
+		_, err = ParseAllowList("proj:jira.example.com")
+		So(err, ShouldErrLike, "bad entry")
+	})
+
+	Convey(`With a fake server`, t, func() {
+		ctx := context.Background()
+
+		var requests []string
+		srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Header.Get("Authorization")+" "+r.Method+" "+r.URL.Path)
+			w.Write([]byte(`{"key": "PROJ-1", "name": "analysis"}`))
+		}))
+		defer srv.Close()
+		host := strings.TrimPrefix(srv.URL, "https://")
+
+		c := &RESTClient{
+			HTTPClient: srv.Client(),
+			Token:      "token",
+			Allowed:    []Project{{Hostname: host, Key: "PROJ"}},
+		}
+
+		Convey(`Accesses allowed projects`, func() {
+			issue, err := c.GetIssue(ctx, host, "PROJ-1")
+			So(err, ShouldBeNil)
+			So(issue.Key, ShouldEqual, "PROJ-1")
+
+			key, err := c.CreateIssue(ctx, host, &CreateIssueRequest{ProjectKey: "PROJ"})
+			So(err, ShouldBeNil)
+			So(key, ShouldEqual, "PROJ-1")
+
+			So(requests, ShouldResemble, []string{
+				"Bearer token GET /rest/api/2/issue/PROJ-1",
+				"Bearer token POST /rest/api/2/issue",
+			})
+		})
+
+		Convey(`Doesn't send requests for other projects`, func() {
+			_, err := c.GetIssue(ctx, host, "OTHER-1")
+			So(err, ShouldErrLike, "is not allowed")
+			_, err = c.CreateIssue(ctx, host, &CreateIssueRequest{ProjectKey: "OTHER"})
+			So(err, ShouldErrLike, "is not allowed")
+			err = c.AddComment(ctx, "evil.example.com", "PROJ-1", "hi")
+			So(err, ShouldErrLike, "is not allowed")
+			_, err = c.Myself(ctx, "evil.example.com")
+			So(err, ShouldErrLike, "is not allowed")
+			So(requests, ShouldBeEmpty)
+		})
+	})
+}
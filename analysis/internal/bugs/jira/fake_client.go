@@ -0,0 +1,218 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+)
+
+// FakeSelf is the identity the fake client authenticates as.
+const FakeSelf = "luci-analysis-account-id"
+
+// FakeIssue is an issue in the fake issue store, together with its
+// comments and changelog.
+type FakeIssue struct {
+	Issue     *Issue
+	Comments  []string
+	Changelog []*ChangelogEntry
+}
+
+// FakeClient is an implementation of Client that fakes the actions
+// performed using an in-memory store.
+type FakeClient struct {
+	mu sync.Mutex
+	// Issues are the issues in the store, in order of creation.
+	Issues []*FakeIssue
+	// Defines a custom error to return when attempting to create
+	// an issue comment. Use this to test failed updates.
+	AddCommentError error
+}
+
+// NewFakeClient returns a new fake client with an empty issue store.
+func NewFakeClient() *FakeClient {
+	return &FakeClient{}
+}
+
+// AddIssue adds an issue to the store, as if it were created by a user.
+func (c *FakeClient) AddIssue(issue *Issue) *FakeIssue {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi := &FakeIssue{Issue: issue}
+	c.Issues = append(c.Issues, fi)
+	return fi
+}
+
+func (c *FakeClient) get(key string) (*FakeIssue, error) {
+	for _, fi := range c.Issues {
+		if fi.Issue.Key == key {
+			return fi, nil
+		}
+	}
+	return nil, NotFoundTag.Apply(errors.Reason("issue %s not found", key).Err())
+}
+
+func (fi *FakeIssue) recordChange(now time.Time, field, from, to string) {
+	fi.Changelog = append(fi.Changelog, &ChangelogEntry{
+		Author:  FakeSelf,
+		Created: now,
+		Items:   []ChangeItem{{Field: field, From: from, To: to}},
+	})
+}
+
+// Myself implements Client.
+func (c *FakeClient) Myself(ctx context.Context, host string) (string, error) {
+	return FakeSelf, nil
+}
+
+// GetIssue implements Client.
+func (c *FakeClient) GetIssue(ctx context.Context, host, key string) (*Issue, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(key)
+	if err != nil {
+		return nil, err
+	}
+	return copyIssue(fi.Issue), nil
+}
+
+// CreateIssue implements Client.
+func (c *FakeClient) CreateIssue(ctx context.Context, host string, req *CreateIssueRequest) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	number := 1
+	for _, fi := range c.Issues {
+		if strings.HasPrefix(fi.Issue.Key, req.ProjectKey+"-") {
+			number++
+		}
+	}
+	key := fmt.Sprintf("%s-%v", req.ProjectKey, number)
+	c.Issues = append(c.Issues, &FakeIssue{
+		Issue: &Issue{
+			Key:            key,
+			Summary:        req.Summary,
+			Status:         "To Do",
+			StatusCategory: StatusCategoryNew,
+			Priority:       req.Priority,
+			Labels:         append([]string(nil), req.Labels...),
+		},
+		Comments: []string{req.Description},
+	})
+	return key, nil
+}
+
+// UpdateIssue implements Client.
+func (c *FakeClient) UpdateIssue(ctx context.Context, host, key string, req *UpdateIssueRequest) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(key)
+	if err != nil {
+		return err
+	}
+	now := clock.Now(ctx)
+	if req.Priority != "" && req.Priority != fi.Issue.Priority {
+		fi.recordChange(now, "priority", fi.Issue.Priority, req.Priority)
+		fi.Issue.Priority = req.Priority
+	}
+	for _, l := range req.AddLabels {
+		found := false
+		for _, existing := range fi.Issue.Labels {
+			if existing == l {
+				found = true
+			}
+		}
+		if !found {
+			fi.Issue.Labels = append(fi.Issue.Labels, l)
+		}
+	}
+	return nil
+}
+
+// Transition implements Client.
+func (c *FakeClient) Transition(ctx context.Context, host, key, statusCategory string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(key)
+	if err != nil {
+		return err
+	}
+	now := clock.Now(ctx)
+	var status string
+	switch statusCategory {
+	case StatusCategoryNew:
+		status = "To Do"
+		fi.Issue.Resolution = ""
+		fi.Issue.ResolutionDate = time.Time{}
+	case StatusCategoryIndeterminate:
+		status = "In Progress"
+		fi.Issue.Resolution = ""
+		fi.Issue.ResolutionDate = time.Time{}
+	case StatusCategoryDone:
+		status = "Done"
+		fi.Issue.Resolution = "Done"
+		fi.Issue.ResolutionDate = now
+	default:
+		return errors.Reason("no transition available to a status in category %q", statusCategory).Err()
+	}
+	fi.recordChange(now, "status", fi.Issue.Status, status)
+	fi.Issue.Status = status
+	fi.Issue.StatusCategory = statusCategory
+	return nil
+}
+
+// AddComment implements Client.
+func (c *FakeClient) AddComment(ctx context.Context, host, key, body string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.AddCommentError != nil {
+		return c.AddCommentError
+	}
+	fi, err := c.get(key)
+	if err != nil {
+		return err
+	}
+	fi.Comments = append(fi.Comments, body)
+	return nil
+}
+
+// GetChangelog implements Client.
+func (c *FakeClient) GetChangelog(ctx context.Context, host, key string) ([]*ChangelogEntry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fi, err := c.get(key)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*ChangelogEntry, 0, len(fi.Changelog))
+	for _, e := range fi.Changelog {
+		item := *e
+		item.Items = append([]ChangeItem(nil), e.Items...)
+		result = append(result, &item)
+	}
+	return result, nil
+}
+
+func copyIssue(issue *Issue) *Issue {
+	result := *issue
+	result.Labels = append([]string(nil), issue.Labels...)
+	result.Links = append([]IssueLink(nil), issue.Links...)
+	return &result
+}
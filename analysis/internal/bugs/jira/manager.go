@@ -0,0 +1,524 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jira contains Jira-specific logic for creating and updating bugs.
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/analysis/internal/bugs"
+	"go.chromium.org/luci/analysis/internal/clustering"
+	configpb "go.chromium.org/luci/analysis/proto/config"
+)
+
+// defaultIssueType is the type of issues created if none is configured.
+const defaultIssueType = "Bug"
+
+// defaultPriorities maps LUCI Analysis priorities to the names of the
+// priorities in a default Jira configuration.
+var defaultPriorities = map[configpb.BuganizerPriority]string{
+	configpb.BuganizerPriority_P0: "Highest",
+	configpb.BuganizerPriority_P1: "High",
+	configpb.BuganizerPriority_P2: "Medium",
+	configpb.BuganizerPriority_P3: "Low",
+	configpb.BuganizerPriority_P4: "Lowest",
+}
+
+// BugManager controls the creation of, and updates to, Jira issues
+// for clusters.
+type BugManager struct {
+	client Client
+	// The UI Base URL, e.g. "https://luci-analysis.appspot.com".
+	uiBaseURL string
+	// The LUCI Project.
+	project string
+	// The Jira configuration to use.
+	jiraCfg *configpb.JiraProject
+	// priorities maps LUCI Analysis priorities to Jira priority names.
+	priorities map[configpb.BuganizerPriority]string
+	// The policy applyer instance used to apply bug management policy.
+	policyApplyer bugs.PolicyApplyer
+	// Simulate, if set, tells BugManager not to make mutating changes
+	// to Jira but only log the changes it would make. Must be set
+	// when running locally as requests made from developer systems will
+	// appear as that user, which breaks the detection of user-made
+	// priority changes vs system-made priority changes.
+	Simulate bool
+}
+
+// NewBugManager initialises a new bug manager, using the specified
+// Jira client.
+func NewBugManager(client Client, uiBaseURL, project string, projectCfg *configpb.ProjectConfig) (*BugManager, error) {
+	jiraCfg := projectCfg.BugManagement.GetJira()
+	if jiraCfg == nil {
+		return nil, errors.Reason("jira configuration not set").Err()
+	}
+	// Priorities which are not configured use the default mapping.
+	priorities := make(map[configpb.BuganizerPriority]string)
+	for p, name := range defaultPriorities {
+		priorities[p] = name
+	}
+	for _, p := range jiraCfg.Priorities {
+		priorities[p.Priority] = p.Name
+	}
+	// All priorities from P0 to P4 have a corresponding Jira priority, so
+	// there is no need to round up low priorities.
+	policyApplyer, err := bugs.NewPolicyApplyer(projectCfg.BugManagement.GetPolicies(), configpb.BuganizerPriority_P4)
+	if err != nil {
+		return nil, err
+	}
+	return &BugManager{
+		client:        client,
+		uiBaseURL:     uiBaseURL,
+		project:       project,
+		jiraCfg:       jiraCfg,
+		priorities:    priorities,
+		policyApplyer: policyApplyer,
+		Simulate:      false,
+	}, nil
+}
+
+// Create creates a new bug for the given request, returning its name, or
+// any encountered error.
+func (m *BugManager) Create(ctx context.Context, request bugs.BugCreateRequest) bugs.BugCreateResponse {
+	var response bugs.BugCreateResponse
+	response.Simulated = m.Simulate
+	response.PolicyActivationsNotified = make(map[bugs.PolicyID]struct{})
+
+	req, err := m.prepareNew(request.RuleID, request.ActivePolicyIDs, request.Description)
+	if err != nil {
+		response.Error = errors.Annotate(err, "prepare new issue").Err()
+		return response
+	}
+
+	var key string
+	if m.Simulate {
+		logging.Debugf(ctx, "Would create Jira issue on %s: %+v", m.jiraCfg.Hostname, req)
+		key = m.jiraCfg.ProjectKey + "-12345678"
+	} else {
+		key, err = m.client.CreateIssue(ctx, m.jiraCfg.Hostname, req)
+		if err != nil {
+			response.Error = errors.Annotate(err, "create issue in jira").Err()
+			return response
+		}
+		bugs.BugsCreatedCounter.Add(ctx, 1, m.project, "jira")
+	}
+	// A bug was filed.
+	response.ID = key
+
+	response.PolicyActivationsNotified, err = m.notifyPolicyActivation(ctx, request.RuleID, key, request.ActivePolicyIDs)
+	if err != nil {
+		response.Error = errors.Annotate(err, "notify policy activations").Err()
+		return response
+	}
+	return response
+}
+
+func (m *BugManager) prepareNew(ruleID string, activePolicyIDs map[bugs.PolicyID]struct{}, description *clustering.ClusterDescription) (*CreateIssueRequest, error) {
+	priority, verified := m.policyApplyer.RecommendedPriorityAndVerified(activePolicyIDs)
+	if verified {
+		return nil, errors.Reason("issue is recommended to be verified from time of creation; are no policies active?").Err()
+	}
+	issueType := m.jiraCfg.IssueType
+	if issueType == "" {
+		issueType = defaultIssueType
+	}
+	ruleURL := bugs.RuleURL(m.uiBaseURL, m.project, ruleID)
+	return &CreateIssueRequest{
+		ProjectKey:  m.jiraCfg.ProjectKey,
+		IssueType:   issueType,
+		Summary:     bugs.GenerateBugSummary(description.Title),
+		Description: m.policyApplyer.NewIssueDescription(description, activePolicyIDs, m.uiBaseURL, ruleURL),
+		Priority:    m.priorities[priority],
+		Labels:      append([]string(nil), m.jiraCfg.Labels...),
+	}, nil
+}
+
+// notifyPolicyActivation notifies that the given policies have activated.
+//
+// This method supports partial success; it returns the set of policies
+// which were successfully notified even if an error is encountered and
+// returned.
+func (m *BugManager) notifyPolicyActivation(ctx context.Context, ruleID, key string, policyIDsToNotify map[bugs.PolicyID]struct{}) (map[bugs.PolicyID]struct{}, error) {
+	policiesNotified := make(map[bugs.PolicyID]struct{})
+
+	// Notify policies which have activated in descending priority order.
+	sortedPolicyIDToNotify := m.policyApplyer.SortPolicyIDsByPriorityDescending(policyIDsToNotify)
+	for _, policyID := range sortedPolicyIDToNotify {
+		templateInput := bugs.TemplateInput{
+			RuleURL: bugs.RuleURL(m.uiBaseURL, m.project, ruleID),
+			BugID:   bugs.NewTemplateBugID(bugs.BugID{System: bugs.JiraSystem, ID: key}),
+		}
+		comment, err := m.policyApplyer.PolicyActivatedComment(policyID, m.uiBaseURL, templateInput)
+		if err != nil {
+			return policiesNotified, errors.Annotate(err, "prepare policy activated comment for policy %q", policyID).Err()
+		}
+		if labels := m.labelsForPolicy(policyID); len(labels) > 0 {
+			if err := m.updateIssueFields(ctx, key, &UpdateIssueRequest{AddLabels: labels}); err != nil {
+				return policiesNotified, errors.Annotate(err, "add labels for policy %q", policyID).Err()
+			}
+		}
+		// Only post a comment if the policy has specified one.
+		if comment != "" {
+			if err := m.addComment(ctx, key, comment); err != nil {
+				return policiesNotified, errors.Annotate(err, "post policy activated comment for policy %q", policyID).Err()
+			}
+		}
+		// Policy activation successfully notified.
+		policiesNotified[policyID] = struct{}{}
+	}
+	return policiesNotified, nil
+}
+
+// Update updates the specified list of bugs.
+func (m *BugManager) Update(ctx context.Context, request []bugs.BugUpdateRequest) ([]bugs.BugUpdateResponse, error) {
+	var responses []bugs.BugUpdateResponse
+	for _, req := range request {
+		issue, err := m.fetchIssue(ctx, req.Bug)
+		if err != nil {
+			return nil, err
+		}
+		if issue == nil {
+			// The bug does not exist, or is in a different Jira project
+			// to the project configured for this LUCI project. Take
+			// no action.
+			responses = append(responses, bugs.BugUpdateResponse{
+				IsDuplicate:               false,
+				IsDuplicateAndAssigned:    false,
+				ShouldArchive:             false,
+				PolicyActivationsNotified: map[bugs.PolicyID]struct{}{},
+			})
+			logging.Warningf(ctx, "Jira issue %s not found, skipping.", req.Bug.ID)
+			continue
+		}
+		responses = append(responses, m.updateIssue(ctx, req, issue))
+	}
+	return responses, nil
+}
+
+func (m *BugManager) updateIssue(ctx context.Context, request bugs.BugUpdateRequest, issue *Issue) bugs.BugUpdateResponse {
+	var response bugs.BugUpdateResponse
+	response.PolicyActivationsNotified = map[bugs.PolicyID]struct{}{}
+
+	// Ensure we have at least one minute of time available, to reduce
+	// the likelihood of the context timing out part way through
+	// an update. See the monorail bug manager for details.
+	if err := bugs.EnsureTimeToDeadline(ctx, time.Minute); err != nil {
+		response.Error = err
+		return response
+	}
+
+	if isDuplicate(issue) {
+		response.IsDuplicate = true
+		response.IsDuplicateAndAssigned = issue.Assignee != ""
+	}
+	response.ShouldArchive = shouldArchiveRule(issue, clock.Now(ctx), request.IsManagingBug)
+	response.DisableRulePriorityUpdates = false // Set below if necessary.
+
+	if response.IsDuplicate || response.ShouldArchive {
+		return response
+	}
+
+	if !request.BugManagementState.RuleAssociationNotified {
+		ruleURL := bugs.RuleURL(m.uiBaseURL, m.project, request.RuleID)
+		comment := bugs.RuleAssociatedCommentary(ruleURL).ToComment()
+		if err := m.addComment(ctx, issue.Key, comment); err != nil {
+			response.Error = errors.Annotate(err, "create rule associated comment").Err()
+			return response
+		}
+		response.RuleAssociationNotified = true
+	}
+
+	// Identify which policies have activated for the first time and notify them (if any).
+	policyIDsToNotify := bugs.ActivePoliciesPendingNotification(request.BugManagementState)
+
+	var err error
+	response.PolicyActivationsNotified, err = m.notifyPolicyActivation(ctx, request.RuleID, issue.Key, policyIDsToNotify)
+	if err != nil {
+		response.Error = errors.Annotate(err, "notify policy activations").Err()
+		return response
+	}
+
+	// Apply priority and verified updates, as necessary. This should occur
+	// after we have notified about policy activation, as that is the more
+	// logical order for someone reading the bug.
+	opts := bugs.BugOptions{
+		State:              request.BugManagementState,
+		IsManagingPriority: request.IsManagingBugPriority,
+		ExistingPriority:   m.toLUCIPriority(issue.Priority),
+		ExistingVerified:   isVerified(issue),
+	}
+	if !request.IsManagingBug || !m.policyApplyer.NeedsPriorityOrVerifiedUpdate(opts) {
+		return response
+	}
+
+	hasManuallySetPriority := false
+	if request.IsManagingBugPriority {
+		hasManuallySetPriority, err = m.hasManuallySetPriority(ctx, issue.Key, request.IsManagingBugPriorityLastUpdated)
+		if err != nil {
+			response.Error = errors.Annotate(err, "determine if priority manually set").Err()
+			return response
+		}
+	}
+	opts.IsManagingPriority = request.IsManagingBugPriority && !hasManuallySetPriority
+
+	change, err := m.policyApplyer.PreparePriorityAndVerifiedChange(opts, m.uiBaseURL)
+	if err != nil {
+		response.Error = errors.Annotate(err, "prepare priority/verified update").Err()
+		return response
+	}
+	response.DisableRulePriorityUpdates = hasManuallySetPriority
+
+	if change.UpdatePriority {
+		update := &UpdateIssueRequest{Priority: m.priorities[change.Priority]}
+		if err := m.updateIssueFields(ctx, issue.Key, update); err != nil {
+			response.Error = errors.Annotate(err, "update jira issue priority").Err()
+			return response
+		}
+	}
+	if change.UpdateVerified {
+		statusCategory := StatusCategoryNew
+		if change.ShouldBeVerified {
+			statusCategory = StatusCategoryDone
+		}
+		if err := m.transition(ctx, issue.Key, statusCategory); err != nil {
+			response.Error = errors.Annotate(err, "transition jira issue").Err()
+			return response
+		}
+	}
+
+	var commentary bugs.Commentary
+	if change.UpdateVerified || change.UpdatePriority {
+		commentary = change.Justification
+	}
+	if hasManuallySetPriority {
+		commentary = bugs.MergeCommentary(commentary, bugs.ManualPriorityUpdateCommentary())
+	}
+	if len(commentary.Bodies) > 0 {
+		commentary.Footers = append(commentary.Footers, fmt.Sprintf(bugs.LinkTemplate, bugs.RuleURL(m.uiBaseURL, m.project, request.RuleID)))
+		if err := m.addComment(ctx, issue.Key, commentary.ToComment()); err != nil {
+			response.Error = errors.Annotate(err, "create priority/verified update comment").Err()
+			return response
+		}
+	}
+	return response
+}
+
+// shouldArchiveRule determines if the rule managing the given issue should
+// be archived.
+func shouldArchiveRule(issue *Issue, now time.Time, isManaging bool) bool {
+	if isManaging {
+		// If LUCI Analysis is managing the bug,
+		// more than 30 days since the issue was verified.
+		return isVerified(issue) && now.Sub(issue.ResolutionDate).Hours() >= 30*24
+	}
+	// If the user is managing the bug,
+	// more than 30 days since the issue was resolved.
+	return issue.StatusCategory == StatusCategoryDone && now.Sub(issue.ResolutionDate).Hours() >= 30*24
+}
+
+// isVerified returns whether the issue is in a done status, other than
+// as a duplicate. This is the closest Jira equivalent of the verified
+// status.
+func isVerified(issue *Issue) bool {
+	return issue.StatusCategory == StatusCategoryDone && !isDuplicate(issue)
+}
+
+// isDuplicate returns whether the issue was resolved as a duplicate.
+func isDuplicate(issue *Issue) bool {
+	return issue.StatusCategory == StatusCategoryDone && issue.Resolution == DuplicateResolution
+}
+
+// hasManuallySetPriority returns whether a user other than LUCI Analysis
+// has set the priority of the issue since isManagingBugPriority was
+// last updated on the rule.
+func (m *BugManager) hasManuallySetPriority(ctx context.Context, key string, isManagingBugPriorityLastUpdated time.Time) (bool, error) {
+	self, err := m.client.Myself(ctx, m.jiraCfg.Hostname)
+	if err != nil {
+		return false, errors.Annotate(err, "get self").Err()
+	}
+	changelog, err := m.client.GetChangelog(ctx, m.jiraCfg.Hostname, key)
+	if err != nil {
+		return false, errors.Annotate(err, "get changelog").Err()
+	}
+	for i := len(changelog) - 1; i >= 0; i-- {
+		entry := changelog[i]
+		changedPriority := false
+		for _, item := range entry.Items {
+			if strings.EqualFold(item.Field, "priority") {
+				changedPriority = true
+			}
+		}
+		if !changedPriority {
+			continue
+		}
+		if entry.Author == self {
+			// The most recent priority change was made by LUCI Analysis.
+			return false, nil
+		}
+		return entry.Created.After(isManagingBugPriorityLastUpdated), nil
+	}
+	// No manual changes to priority indicates the bug is still under
+	// automatic control.
+	return false, nil
+}
+
+// GetMergedInto reads the bug (if any) the given bug was merged into.
+// If the given bug is not merged into another bug, this returns nil.
+func (m *BugManager) GetMergedInto(ctx context.Context, bug bugs.BugID) (*bugs.BugID, error) {
+	issue, err := m.fetchIssue(ctx, bug)
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil || !isDuplicate(issue) {
+		return nil, nil
+	}
+	for _, link := range issue.Links {
+		if link.Type != DuplicateLinkType || !link.Outward {
+			continue
+		}
+		result := &bugs.BugID{System: bugs.JiraSystem, ID: link.Key}
+		if err := result.Validate(); err != nil {
+			return nil, errors.Annotate(err, "resolving canonical merged into bug").Err()
+		}
+		return result, nil
+	}
+	// The issue was resolved as a duplicate without linking the issue it
+	// duplicates. The user should manually resolve the situation.
+	return nil, errors.New("issue is a duplicate but has no duplicate link")
+}
+
+// UpdateDuplicateSource updates the source bug of a duplicate
+// bug pair (source bug, destination bug).
+// It either comments on the source bug or, if an error is set,
+// re-opens it so that the duplicate is not processed again.
+func (m *BugManager) UpdateDuplicateSource(ctx context.Context, request bugs.UpdateDuplicateSourceRequest) error {
+	if request.BugDetails.Bug.System != bugs.JiraSystem {
+		// Indicates an implementation error with the caller.
+		panic("jira bug manager can only deal with jira bugs")
+	}
+	key := request.BugDetails.Bug.ID
+	var comment string
+	if request.ErrorMessage != "" {
+		if err := m.transition(ctx, key, StatusCategoryNew); err != nil {
+			return errors.Annotate(err, "failed to update duplicate source jira issue %s", key).Err()
+		}
+		ruleLink := fmt.Sprintf(bugs.LinkTemplate, bugs.RuleURL(m.uiBaseURL, m.project, request.BugDetails.RuleID))
+		comment = strings.Join([]string{request.ErrorMessage, ruleLink}, "\n\n")
+	} else {
+		bugLink := bugs.RuleURL(m.uiBaseURL, m.project, request.DestinationRuleID)
+		comment = fmt.Sprintf(bugs.SourceBugRuleUpdatedTemplate, bugLink)
+	}
+	if err := m.addComment(ctx, key, comment); err != nil {
+		return errors.Annotate(err, "failed to comment on duplicate source jira issue %s", key).Err()
+	}
+	return nil
+}
+
+// fetchIssue fetches the Jira issue for the given bug. If the issue does
+// not exist, or is in a Jira project other than the one configured for
+// this LUCI project, nil is returned.
+func (m *BugManager) fetchIssue(ctx context.Context, bug bugs.BugID) (*Issue, error) {
+	if bug.System != bugs.JiraSystem {
+		// Indicates an implementation error with the caller.
+		panic("jira bug manager can only deal with jira bugs")
+	}
+	projectKey, err := bug.JiraProjectKey()
+	if err != nil {
+		return nil, err
+	}
+	if projectKey != m.jiraCfg.ProjectKey {
+		// Only query bugs from the same Jira project as what has
+		// been configured for the LUCI Project.
+		return nil, nil
+	}
+	issue, err := m.client.GetIssue(ctx, m.jiraCfg.Hostname, bug.ID)
+	if err != nil {
+		if NotFoundTag.In(err) {
+			return nil, nil
+		}
+		return nil, errors.Annotate(err, "get issue %s", bug.ID).Err()
+	}
+	return issue, nil
+}
+
+// toLUCIPriority returns the LUCI Analysis priority corresponding to the
+// given Jira priority name. If several priorities map to the same name,
+// the highest is returned. If the name is not mapped,
+// BUGANIZER_PRIORITY_UNSPECIFIED is returned.
+func (m *BugManager) toLUCIPriority(name string) configpb.BuganizerPriority {
+	result := configpb.BuganizerPriority_BUGANIZER_PRIORITY_UNSPECIFIED
+	for p, n := range m.priorities {
+		if !strings.EqualFold(n, name) {
+			continue
+		}
+		if result == configpb.BuganizerPriority_BUGANIZER_PRIORITY_UNSPECIFIED || p < result {
+			result = p
+		}
+	}
+	return result
+}
+
+func (m *BugManager) labelsForPolicy(policyID bugs.PolicyID) []string {
+	policy := m.policyApplyer.PolicyByID(policyID)
+	if policy == nil {
+		// Policy no longer configured.
+		return nil
+	}
+	return policy.BugTemplate.GetJira().GetLabels()
+}
+
+func (m *BugManager) addComment(ctx context.Context, key, body string) error {
+	if m.Simulate {
+		logging.Debugf(ctx, "Would comment on Jira issue %s: %s", key, body)
+		return nil
+	}
+	if err := m.client.AddComment(ctx, m.jiraCfg.Hostname, key, body); err != nil {
+		return err
+	}
+	bugs.BugsUpdatedCounter.Add(ctx, 1, m.project, "jira")
+	return nil
+}
+
+func (m *BugManager) updateIssueFields(ctx context.Context, key string, update *UpdateIssueRequest) error {
+	if m.Simulate {
+		logging.Debugf(ctx, "Would update Jira issue %s: %+v", key, update)
+		return nil
+	}
+	if err := m.client.UpdateIssue(ctx, m.jiraCfg.Hostname, key, update); err != nil {
+		return err
+	}
+	bugs.BugsUpdatedCounter.Add(ctx, 1, m.project, "jira")
+	return nil
+}
+
+func (m *BugManager) transition(ctx context.Context, key, statusCategory string) error {
+	if m.Simulate {
+		logging.Debugf(ctx, "Would transition Jira issue %s to status category %q", key, statusCategory)
+		return nil
+	}
+	if err := m.client.Transition(ctx, m.jiraCfg.Hostname, key, statusCategory); err != nil {
+		return err
+	}
+	bugs.BugsUpdatedCounter.Add(ctx, 1, m.project, "jira")
+	return nil
+}
@@ -0,0 +1,278 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jira
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/analysis/internal/bugs"
+	bugspb "go.chromium.org/luci/analysis/internal/bugs/proto"
+	"go.chromium.org/luci/analysis/internal/clustering"
+	"go.chromium.org/luci/analysis/internal/config"
+	configpb "go.chromium.org/luci/analysis/proto/config"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	Convey("With Bug Manager", t, func() {
+		ctx := context.Background()
+		now := time.Date(2040, time.January, 1, 2, 3, 4, 5, time.UTC)
+		ctx, tc := testclock.UseTime(ctx, now)
+
+		client := NewFakeClient()
+
+		policyA := config.CreatePlaceholderBugManagementPolicy("policy-a")
+		policyA.HumanReadableName = "Problem A"
+		policyA.Priority = configpb.BuganizerPriority_P4
+		policyA.BugTemplate.Jira = &configpb.BugManagementPolicy_BugTemplate_Jira{
+			Labels: []string{"policy-a-label"},
+		}
+
+		policyB := config.CreatePlaceholderBugManagementPolicy("policy-b")
+		policyB.HumanReadableName = "Problem B"
+		policyB.Priority = configpb.BuganizerPriority_P0
+
+		projectCfg := &configpb.ProjectConfig{
+			BugManagement: &configpb.BugManagement{
+				DefaultBugSystem: configpb.BugSystem_JIRA,
+				Jira: &configpb.JiraProject{
+					Hostname:   "example.atlassian.net",
+					ProjectKey: "PROJ",
+					Labels:     []string{"luci-analysis"},
+				},
+				Policies: []*configpb.BugManagementPolicy{policyA, policyB},
+			},
+		}
+
+		bm, err := NewBugManager(client, "https://luci-analysis-test.appspot.com", "luciproject", projectCfg)
+		So(err, ShouldBeNil)
+
+		Convey("Create", func() {
+			request := bugs.BugCreateRequest{
+				RuleID: "new-rule-id",
+				Description: &clustering.ClusterDescription{
+					Title:       "ClusterID",
+					Description: "Tests are failing with reason: Some failure reason.",
+				},
+				ActivePolicyIDs: map[bugs.PolicyID]struct{}{"policy-a": {}},
+			}
+
+			response := bm.Create(ctx, request)
+			So(response.Error, ShouldBeNil)
+			So(response.Simulated, ShouldBeFalse)
+			So(response.ID, ShouldEqual, "PROJ-1")
+			So(response.PolicyActivationsNotified, ShouldResemble, map[bugs.PolicyID]struct{}{"policy-a": {}})
+
+			So(client.Issues, ShouldHaveLength, 1)
+			fi := client.Issues[0]
+			So(fi.Issue.Summary, ShouldEqual, "Tests are failing: ClusterID")
+			So(fi.Issue.Priority, ShouldEqual, "Lowest")
+			So(fi.Issue.Labels, ShouldResemble, []string{"luci-analysis", "policy-a-label"})
+			So(fi.Comments, ShouldHaveLength, 2)
+			So(fi.Comments[0], ShouldContainSubstring, "Tests are failing with reason: Some failure reason.")
+			So(fi.Comments[0], ShouldContainSubstring, "https://luci-analysis-test.appspot.com/p/luciproject/rules/new-rule-id")
+			So(fi.Comments[1], ShouldContainSubstring, "Policy ID: policy-a")
+
+			Convey("Simulated", func() {
+				bm.Simulate = true
+				response := bm.Create(ctx, request)
+				So(response.Error, ShouldBeNil)
+				So(response.Simulated, ShouldBeTrue)
+				So(client.Issues, ShouldHaveLength, 1)
+			})
+			Convey("With custom priorities", func() {
+				projectCfg.BugManagement.Jira.Priorities = []*configpb.JiraProject_PriorityMapping{
+					{Priority: configpb.BuganizerPriority_P3, Name: "Minor"},
+					{Priority: configpb.BuganizerPriority_P4, Name: "Trivial"},
+				}
+				bm, err := NewBugManager(client, "https://luci-analysis-test.appspot.com", "luciproject", projectCfg)
+				So(err, ShouldBeNil)
+
+				response := bm.Create(ctx, request)
+				So(response.Error, ShouldBeNil)
+				So(response.ID, ShouldEqual, "PROJ-2")
+				So(client.Issues[1].Issue.Priority, ShouldEqual, "Trivial")
+			})
+			Convey("With no active policies", func() {
+				request.ActivePolicyIDs = nil
+				response := bm.Create(ctx, request)
+				So(response.Error, ShouldErrLike, "recommended to be verified")
+				So(response.ID, ShouldBeEmpty)
+			})
+		})
+		Convey("Update", func() {
+			fi := client.AddIssue(&Issue{
+				Key:            "PROJ-7",
+				Summary:        "Tests are failing",
+				Status:         "To Do",
+				StatusCategory: StatusCategoryNew,
+				Priority:       "Lowest",
+				Labels:         []string{"luci-analysis"},
+			})
+			activationTime := now.Add(-time.Hour)
+			state := &bugspb.BugManagementState{
+				RuleAssociationNotified: true,
+				PolicyState: map[string]*bugspb.BugManagementState_PolicyState{
+					"policy-a": {
+						IsActive:           true,
+						LastActivationTime: timestamppb.New(activationTime),
+						ActivationNotified: true,
+					},
+					"policy-b": {},
+				},
+			}
+			request := bugs.BugUpdateRequest{
+				Bug:                              bugs.BugID{System: bugs.JiraSystem, ID: "PROJ-7"},
+				IsManagingBug:                    true,
+				IsManagingBugPriority:            true,
+				IsManagingBugPriorityLastUpdated: now.Add(-2 * time.Hour),
+				RuleID:                           "rule-id",
+				BugManagementState:               state,
+			}
+			update := func() bugs.BugUpdateResponse {
+				responses, err := bm.Update(ctx, []bugs.BugUpdateRequest{request})
+				So(err, ShouldBeNil)
+				So(responses, ShouldHaveLength, 1)
+				So(responses[0].Error, ShouldBeNil)
+				return responses[0]
+			}
+
+			Convey("No change", func() {
+				response := update()
+				So(response, ShouldResemble, bugs.BugUpdateResponse{
+					PolicyActivationsNotified: map[bugs.PolicyID]struct{}{},
+				})
+				So(fi.Comments, ShouldBeEmpty)
+			})
+			Convey("Rule association notified", func() {
+				state.RuleAssociationNotified = false
+				response := update()
+				So(response.RuleAssociationNotified, ShouldBeTrue)
+				So(fi.Comments, ShouldHaveLength, 1)
+				So(fi.Comments[0], ShouldContainSubstring, "This bug has been associated with failures in LUCI Analysis.")
+			})
+			Convey("Priority increases when policy activates", func() {
+				state.PolicyState["policy-b"] = &bugspb.BugManagementState_PolicyState{
+					IsActive:           true,
+					LastActivationTime: timestamppb.New(activationTime),
+				}
+				response := update()
+				So(response.PolicyActivationsNotified, ShouldResemble, map[bugs.PolicyID]struct{}{"policy-b": {}})
+				So(fi.Issue.Priority, ShouldEqual, "Highest")
+				So(fi.Comments, ShouldHaveLength, 2)
+				So(fi.Comments[0], ShouldContainSubstring, "Policy ID: policy-b")
+				So(fi.Comments[1], ShouldContainSubstring, "The bug priority has been set to P0.")
+
+				Convey("Unless the user manually set the priority", func() {
+					state.PolicyState["policy-b"].ActivationNotified = true
+					fi.Issue.Priority = "Lowest"
+					fi.Changelog = append(fi.Changelog, &ChangelogEntry{
+						Author:  "user-account-id",
+						Created: now.Add(-time.Minute),
+						Items:   []ChangeItem{{Field: "priority", From: "Highest", To: "Lowest"}},
+					})
+
+					response := update()
+					So(response.DisableRulePriorityUpdates, ShouldBeTrue)
+					So(fi.Issue.Priority, ShouldEqual, "Lowest")
+					So(fi.Comments, ShouldHaveLength, 3)
+					So(fi.Comments[2], ShouldContainSubstring, "The bug priority has been manually set.")
+				})
+			})
+			Convey("Verified when policies deactivate", func() {
+				state.PolicyState["policy-a"].IsActive = false
+				state.PolicyState["policy-a"].LastDeactivationTime = timestamppb.New(now.Add(-time.Minute))
+				response := update()
+				So(response.ShouldArchive, ShouldBeFalse)
+				So(fi.Issue.StatusCategory, ShouldEqual, StatusCategoryDone)
+				So(fi.Comments, ShouldHaveLength, 1)
+				So(fi.Comments[0], ShouldContainSubstring, "The bug has been verified.")
+
+				Convey("Re-opened when policies re-activate", func() {
+					state.PolicyState["policy-a"].IsActive = true
+					state.PolicyState["policy-a"].LastActivationTime = timestamppb.New(now)
+					update()
+					So(fi.Issue.StatusCategory, ShouldEqual, StatusCategoryNew)
+					So(fi.Comments, ShouldHaveLength, 2)
+					So(fi.Comments[1], ShouldContainSubstring, "The bug has been re-opened.")
+				})
+				Convey("Rule archived after 30 days", func() {
+					tc.Add(30 * 24 * time.Hour)
+					response := update()
+					So(response.ShouldArchive, ShouldBeTrue)
+				})
+			})
+			Convey("Duplicate", func() {
+				fi.Issue.Status = "Closed"
+				fi.Issue.StatusCategory = StatusCategoryDone
+				fi.Issue.Resolution = DuplicateResolution
+				fi.Issue.ResolutionDate = now
+				fi.Issue.Links = []IssueLink{
+					{Type: "Relates", Outward: true, Key: "PROJ-2"},
+					{Type: DuplicateLinkType, Outward: true, Key: "OTHER-3"},
+				}
+
+				response := update()
+				So(response.IsDuplicate, ShouldBeTrue)
+				So(response.IsDuplicateAndAssigned, ShouldBeFalse)
+
+				mergedInto, err := bm.GetMergedInto(ctx, request.Bug)
+				So(err, ShouldBeNil)
+				So(mergedInto, ShouldResemble, &bugs.BugID{System: bugs.JiraSystem, ID: "OTHER-3"})
+
+				Convey("Without duplicate link", func() {
+					fi.Issue.Links = nil
+					_, err := bm.GetMergedInto(ctx, request.Bug)
+					So(err, ShouldErrLike, "no duplicate link")
+				})
+				Convey("UpdateDuplicateSource with error", func() {
+					err := bm.UpdateDuplicateSource(ctx, bugs.UpdateDuplicateSourceRequest{
+						BugDetails:   bugs.DuplicateBugDetails{RuleID: "rule-id", Bug: request.Bug},
+						ErrorMessage: "Some error.",
+					})
+					So(err, ShouldBeNil)
+					So(fi.Issue.StatusCategory, ShouldEqual, StatusCategoryNew)
+					So(fi.Issue.Resolution, ShouldBeEmpty)
+					So(fi.Comments[len(fi.Comments)-1], ShouldContainSubstring, "Some error.")
+				})
+				Convey("UpdateDuplicateSource", func() {
+					err := bm.UpdateDuplicateSource(ctx, bugs.UpdateDuplicateSourceRequest{
+						BugDetails:        bugs.DuplicateBugDetails{RuleID: "rule-id", Bug: request.Bug},
+						DestinationRuleID: "destination-rule-id",
+					})
+					So(err, ShouldBeNil)
+					So(fi.Issue.StatusCategory, ShouldEqual, StatusCategoryDone)
+					So(fi.Comments[len(fi.Comments)-1], ShouldContainSubstring, "rules/destination-rule-id")
+				})
+			})
+			Convey("Issue in other Jira project is skipped", func() {
+				request.Bug.ID = "OTHER-7"
+				response := update()
+				So(response.IsDuplicate, ShouldBeFalse)
+				So(response.ShouldArchive, ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jira
+
+import (
+	"context"
+	"flag"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/module"
+)
+
+// The modes for the Jira integration client.
+// `ModeDisable` is the default mode, and ignores Jira issues.
+// `ModeProvided` uses the Jira REST API.
+const (
+	ModeDisable  = "disable"
+	ModeProvided = "provided"
+)
+
+// ModuleName can be used to refer to this module when declaring dependencies.
+var ModuleName = module.RegisterName("go.chromium.org/luci/analysis/internal/bugs/jira")
+
+// ModuleOptions contain configuration for the Jira module.
+type ModuleOptions struct {
+	// Option for the Jira integration, defaults to `disable`.
+	// Acceptable values are:
+	// `disable` - Indicates that Jira integration should not be used.
+	// `provided` - Indicates that Jira integration should use the REST API.
+	JiraClientMode string
+
+	// The name of the secret holding the token used to authenticate to
+	// Jira, e.g. "sm://jira-token".
+	JiraTokenSecret string
+
+	// The user to authenticate to Jira as, with the token as password.
+	// If unset, the token is used as a bearer token.
+	JiraUser string
+
+	// The Jira projects LUCI projects may file issues in, as a
+	// comma-separated list of "<luci-project>:<hostname>/<jira-project-key>".
+	// The Jira credentials are never sent to other hosts or used for issues
+	// in other Jira projects.
+	JiraAllowedProjects string
+}
+
+// Register registers the command line flags.
+func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	f.StringVar(
+		&o.JiraClientMode,
+		"jira-mode",
+		o.JiraClientMode,
+		"The Jira integration client mode to use.",
+	)
+	f.StringVar(
+		&o.JiraTokenSecret,
+		"jira-token-secret",
+		o.JiraTokenSecret,
+		"The secret holding the token used to authenticate to Jira.",
+	)
+	f.StringVar(
+		&o.JiraUser,
+		"jira-user",
+		o.JiraUser,
+		"The user to authenticate to Jira as. If unset, the token is used as a bearer token.",
+	)
+	f.StringVar(
+		&o.JiraAllowedProjects,
+		"jira-allowed-projects",
+		o.JiraAllowedProjects,
+		"Comma-separated list of Jira projects LUCI projects may file issues in, "+
+			"as <luci-project>:<hostname>/<jira-project-key>.",
+	)
+}
+
+// NewModule returns a server module that sets context values for Jira
+// integration.
+func NewModule(opts *ModuleOptions) module.Module {
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	return &jiraModule{opts: opts}
+}
+
+// NewModuleFromFlags is a variant of NewModule that initializes options through
+// command line flags.
+//
+// Calling this function registers flags in flag.CommandLine. They are usually
+// parsed in server.Main(...).
+func NewModuleFromFlags() module.Module {
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
+}
+
+// jiraModule implements module.Module.
+type jiraModule struct {
+	opts *ModuleOptions
+}
+
+// Name is part of module.Module interface.
+func (*jiraModule) Name() module.Name {
+	return ModuleName
+}
+
+// Dependencies is part of module.Module interface.
+func (*jiraModule) Dependencies() []module.Dependency {
+	return nil
+}
+
+// ClientModeKey the key to get the value for Jira integration
+// mode from the Context.
+var ClientModeKey = "go.chromium.org/luci/analysis/internal/bugs/jira:jiraMode"
+
+// TokenSecretKey the key to get the name of the secret holding the
+// Jira token from the Context.
+var TokenSecretKey = "go.chromium.org/luci/analysis/internal/bugs/jira:jiraTokenSecret"
+
+// UserKey the key to get the user to authenticate to Jira as
+// from the Context.
+var UserKey = "go.chromium.org/luci/analysis/internal/bugs/jira:jiraUser"
+
+// AllowListKey the key to get the AllowList of Jira projects from the
+// Context.
+var AllowListKey = "go.chromium.org/luci/analysis/internal/bugs/jira:jiraAllowList"
+
+// Initialize is part of module.Module interface.
+func (m *jiraModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
+	allowList, err := ParseAllowList(m.opts.JiraAllowedProjects)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad -jira-allowed-projects").Err()
+	}
+	ctx = context.WithValue(ctx, &ClientModeKey, m.opts.JiraClientMode)
+	ctx = context.WithValue(ctx, &TokenSecretKey, m.opts.JiraTokenSecret)
+	ctx = context.WithValue(ctx, &UserKey, m.opts.JiraUser)
+	ctx = context.WithValue(ctx, &AllowListKey, allowList)
+	return ctx, nil
+}
@@ -83,10 +83,33 @@ func (t Template) Validate() error {
 				},
 			},
 		},
+		{
+			name: "github",
+			input: TemplateInput{
+				RuleURL: "https://luci-analysis-deployment/some/url",
+				BugID: TemplateBugID{
+					id: BugID{
+						System: GitHubSystem,
+						ID:     "owner/repository/1234567890123",
+					},
+				},
+			},
+		}, {
+			name: "jira",
+			input: TemplateInput{
+				RuleURL: "https://luci-analysis-deployment/some/url",
+				BugID: TemplateBugID{
+					id: BugID{
+						System: JiraSystem,
+						ID:     "PROJECT-1234567890123",
+					},
+				},
+			},
+		},
 		{
 			// Reserve the ability to extend to other bug-filing systems; the
 			// template should handle this gracefully.
-			name: "neither buganizer nor monorail",
+			name: "none of the supported systems",
 			input: TemplateInput{
 				RuleURL: "https://luci-analysis-deployment/some/url",
 				BugID: TemplateBugID{
@@ -169,3 +192,38 @@ func (b TemplateBugID) BuganizerBugID() (string, error) {
 	}
 	return b.id.ID, nil
 }
+
+// IsGitHub returns whether the bug is a GitHub issue.
+func (b TemplateBugID) IsGitHub() bool {
+	return b.id.System == GitHubSystem
+}
+
+// GitHubRepository returns the repository of a GitHub issue, as
+// "{owner}/{repository}" (e.g. "chromium/luci-go").
+// Errors if the bug is not a GitHub issue.
+func (b TemplateBugID) GitHubRepository() (string, error) {
+	repository, _, err := b.id.GitHubRepositoryAndNumber()
+	return repository, err
+}
+
+// GitHubIssueNumber returns the number of a GitHub issue
+// (e.g. "123" for chromium/luci-go#123).
+// Errors if the bug is not a GitHub issue.
+func (b TemplateBugID) GitHubIssueNumber() (string, error) {
+	_, number, err := b.id.GitHubRepositoryAndNumber()
+	return number, err
+}
+
+// IsJira returns whether the bug is a Jira issue.
+func (b TemplateBugID) IsJira() bool {
+	return b.id.System == JiraSystem
+}
+
+// JiraIssueKey returns the key of a Jira issue (e.g. "PROJ-123").
+// Errors if the bug is not a Jira issue.
+func (b TemplateBugID) JiraIssueKey() (string, error) {
+	if b.id.System != JiraSystem {
+		return "", errors.New("not a jira bug")
+	}
+	return b.id.ID, nil
+}
@@ -25,6 +25,8 @@ import (
 	"go.chromium.org/luci/analysis/internal/analysis"
 	"go.chromium.org/luci/analysis/internal/bugs"
 	"go.chromium.org/luci/analysis/internal/bugs/buganizer"
+	"go.chromium.org/luci/analysis/internal/bugs/github"
+	"go.chromium.org/luci/analysis/internal/bugs/jira"
 	"go.chromium.org/luci/analysis/internal/bugs/monorail"
 	"go.chromium.org/luci/analysis/internal/clustering/runs"
 	"go.chromium.org/luci/analysis/internal/config"
@@ -44,6 +46,8 @@ type UpdateOptions struct {
 	AnalysisClient       AnalysisClient
 	MonorailClient       *monorail.Client
 	BuganizerClient      buganizer.Client
+	GitHubClient         github.Client
+	JiraClient           jira.Client
 	SimulateBugUpdates   bool
 	MaxBugsFiledPerRun   int
 	UpdateRuleBatchSize  int
@@ -111,6 +115,34 @@ func UpdateBugsForProject(ctx context.Context, opts UpdateOptions) (retErr error
 		mgrs[bugs.BuganizerSystem] = buganizerBugManager
 	}
 
+	if projectCfg.Config.BugManagement.GetGithub() != nil {
+		if opts.GitHubClient == nil {
+			return errors.New("githubClient cannot be nil")
+		}
+		// Create GitHub bug manager.
+		githubBugManager, err := github.NewBugManager(opts.GitHubClient, opts.UIBaseURL, opts.Project, projectCfg.Config)
+		if err != nil {
+			return errors.Annotate(err, "create github bug manager").Err()
+		}
+
+		githubBugManager.Simulate = opts.SimulateBugUpdates
+		mgrs[bugs.GitHubSystem] = githubBugManager
+	}
+
+	if projectCfg.Config.BugManagement.GetJira() != nil {
+		if opts.JiraClient == nil {
+			return errors.New("jiraClient cannot be nil")
+		}
+		// Create Jira bug manager.
+		jiraBugManager, err := jira.NewBugManager(opts.JiraClient, opts.UIBaseURL, opts.Project, projectCfg.Config)
+		if err != nil {
+			return errors.Annotate(err, "create jira bug manager").Err()
+		}
+
+		jiraBugManager.Simulate = opts.SimulateBugUpdates
+		mgrs[bugs.JiraSystem] = jiraBugManager
+	}
+
 	if len(mgrs) == 0 {
 		// No bug managers configured.
 		return nil
@@ -1084,7 +1084,7 @@ func (b *BugUpdater) createBug(ctx context.Context, cs *analysis.Cluster) (creat
 		if err != nil {
 			return false, errors.Annotate(err, "extracting buganizer component").Err()
 		}
-	} else {
+	} else if system == bugs.MonorailSystem {
 		request.MonorailComponents = extractMonorailComponents(cs)
 	}
 
@@ -1142,8 +1142,19 @@ func (b *BugUpdater) createBug(ctx context.Context, cs *analysis.Cluster) (creat
 func (b *BugUpdater) routeToBugSystem(cs *analysis.Cluster) (string, error) {
 	hasMonorail := b.projectCfg.Config.BugManagement.GetMonorail() != nil
 	hasBuganizer := b.projectCfg.Config.BugManagement.GetBuganizer() != nil
+	hasGitHub := b.projectCfg.Config.BugManagement.GetGithub() != nil
+	hasJira := b.projectCfg.Config.BugManagement.GetJira() != nil
 	defaultSystem := b.projectCfg.Config.BugManagement.GetDefaultBugSystem()
 
+	// GitHub and Jira have no components to route bugs by, so they
+	// are used only when they are the default bug system.
+	if defaultSystem == configpb.BugSystem_GITHUB && hasGitHub {
+		return bugs.GitHubSystem, nil
+	}
+	if defaultSystem == configpb.BugSystem_JIRA && hasJira {
+		return bugs.JiraSystem, nil
+	}
+
 	if !hasMonorail && !hasBuganizer {
 		return "", errors.New("at least one bug filing system need to be configured")
 	}
@@ -1233,9 +1244,14 @@ func extractMonorailComponents(cs *analysis.Cluster) []string {
 }
 
 func defaultBugSystemName(defaultSystem configpb.BugSystem) string {
-	if defaultSystem == configpb.BugSystem_BUGANIZER {
+	switch defaultSystem {
+	case configpb.BugSystem_BUGANIZER:
 		return bugs.BuganizerSystem
-	} else {
+	case configpb.BugSystem_GITHUB:
+		return bugs.GitHubSystem
+	case configpb.BugSystem_JIRA:
+		return bugs.JiraSystem
+	default:
 		return bugs.MonorailSystem
 	}
 }
@@ -59,6 +59,24 @@ func RuleForMonorailBugURL(uiBaseURL string, issueID string) string {
 	return fmt.Sprintf("%s/b/%s", uiBaseURL, issueID)
 }
 
+// GitHubIssueURL returns the link to the given GitHub issue.
+// hostname is the GitHub host, e.g. "github.com".
+// issueID is of the form "<owner>/<repository>/<number>".
+func GitHubIssueURL(hostname, issueID string) string {
+	b := BugID{System: GitHubSystem, ID: issueID}
+	repository, number, err := b.GitHubRepositoryAndNumber()
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("https://%s/%s/issues/%s", hostname, repository, number)
+}
+
+// JiraIssueURL returns the link to the given Jira issue.
+// hostname is the Jira host, e.g. "example.atlassian.net".
+func JiraIssueURL(hostname, issueKey string) string {
+	return fmt.Sprintf("https://%s/browse/%s", hostname, issueKey)
+}
+
 func PolicyActivatedHelpURL(uiBaseURL string) string {
 	return fmt.Sprintf("%s/help#policy-activated", uiBaseURL)
 }
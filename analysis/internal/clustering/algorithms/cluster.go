@@ -21,6 +21,7 @@ import (
 	"go.chromium.org/luci/analysis/internal/clustering"
 	"go.chromium.org/luci/analysis/internal/clustering/algorithms/failurereason"
 	"go.chromium.org/luci/analysis/internal/clustering/algorithms/rulesalgorithm"
+	"go.chromium.org/luci/analysis/internal/clustering/algorithms/stacktrace"
 	"go.chromium.org/luci/analysis/internal/clustering/algorithms/testname"
 	"go.chromium.org/luci/analysis/internal/clustering/rules"
 	"go.chromium.org/luci/analysis/internal/clustering/rules/cache"
@@ -60,7 +61,8 @@ type Algorithm interface {
 // when deleting an algorithm without rolling its value (plus one)
 // into the constant.)
 const AlgorithmsVersion = 1 + failurereason.AlgorithmVersion +
-	testname.AlgorithmVersion + rulesalgorithm.AlgorithmVersion
+	testname.AlgorithmVersion + rulesalgorithm.AlgorithmVersion +
+	stacktrace.AlgorithmVersion
 
 // suggestingAlgorithms is the set of clustering algorithms used by
 // LUCI Analysis to generate suggested clusters.
@@ -70,6 +72,7 @@ const AlgorithmsVersion = 1 + failurereason.AlgorithmVersion +
 var suggestingAlgorithms = []Algorithm{
 	&failurereason.Algorithm{},
 	&testname.Algorithm{},
+	&stacktrace.Algorithm{},
 }
 
 // rulesAlgorithm is the rules-based clustering algorithm used by
@@ -26,6 +26,7 @@ import (
 	"go.chromium.org/luci/analysis/internal/clustering"
 	"go.chromium.org/luci/analysis/internal/clustering/algorithms/failurereason"
 	"go.chromium.org/luci/analysis/internal/clustering/algorithms/rulesalgorithm"
+	"go.chromium.org/luci/analysis/internal/clustering/algorithms/stacktrace"
 	"go.chromium.org/luci/analysis/internal/clustering/algorithms/testname"
 	"go.chromium.org/luci/analysis/internal/clustering/rules"
 	"go.chromium.org/luci/analysis/internal/clustering/rules/cache"
@@ -229,6 +230,7 @@ func upToDateScenario(size int) *scenario {
 		Algorithms: map[string]struct{}{
 			failurereason.AlgorithmName:  {},
 			rulesalgorithm.AlgorithmName: {},
+			stacktrace.AlgorithmName:     {},
 			testname.AlgorithmName:       {},
 		},
 		Clusters: [][]clustering.ClusterID{
@@ -258,6 +260,7 @@ func upToDateScenario(size int) *scenario {
 		Algorithms: map[string]struct{}{
 			failurereason.AlgorithmName:  {},
 			rulesalgorithm.AlgorithmName: {},
+			stacktrace.AlgorithmName:     {},
 			testname.AlgorithmName:       {},
 		},
 		Clusters: [][]clustering.ClusterID{
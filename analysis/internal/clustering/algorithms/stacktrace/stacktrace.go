@@ -0,0 +1,154 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stacktrace contains the stack trace clustering algorithm
+// for LUCI Analysis.
+//
+// This algorithm clusters crashes and panics by the innermost frames of
+// the stack trace in the failure reason, so that the same crash clusters
+// together even if the error messages differ.
+package stacktrace
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"go.chromium.org/luci/analysis/internal/clustering"
+	"go.chromium.org/luci/analysis/internal/clustering/stackframes"
+	"go.chromium.org/luci/analysis/internal/config/compiledcfg"
+)
+
+// AlgorithmVersion is the version of the clustering algorithm. The algorithm
+// version should be incremented whenever existing test results may be
+// clustered differently (i.e. Cluster(f) returns a different value for some
+// f that may have been already ingested).
+const AlgorithmVersion = 1
+
+// AlgorithmName is the identifier for the clustering algorithm.
+// LUCI Analysis requires all clustering algorithms to have a unique
+// identifier. Must match the pattern ^[a-z0-9-.]{1,32}$.
+//
+// The AlgorithmName must encode the algorithm version, so that each version
+// of an algorithm has a different name.
+var AlgorithmName = fmt.Sprintf("%sv%v", clustering.StackTraceAlgorithmPrefix, AlgorithmVersion)
+
+// TopFrames is the number of innermost stack frames failures are clustered by.
+// Changing it requires incrementing AlgorithmVersion.
+const TopFrames = 3
+
+// BugTemplate is the template for the content of bugs created for stack
+// trace clusters. A list of test IDs is included to improve searchability
+// by test name.
+var BugTemplate = template.Must(template.New("stackTraceTemplate").Parse(
+	`This bug is for all test failures with a stack trace starting with the following frames:
+{{range .Frames}}- {{.}}
+{{end}}
+The following test(s) were observed to have matching failures at this time (at most five examples listed):
+{{range .TestIDs}}- {{.}}
+{{end}}`))
+
+// likeEscapeRewriter escapes \, % and _ so that they are not interpreted by
+// LIKE pattern matching.
+var likeEscapeRewriter = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Algorithm represents an instance of the stack trace clustering algorithm.
+type Algorithm struct{}
+
+// Name returns the identifier of the clustering algorithm.
+func (a *Algorithm) Name() string {
+	return AlgorithmName
+}
+
+// frames returns the frames that define the cluster of the given failure,
+// or nil if it does not have a stack trace.
+func frames(failure *clustering.Failure) []string {
+	return stackframes.Top(failure.Reason.GetPrimaryErrorMessage(), TopFrames)
+}
+
+// Cluster clusters the given test failure and returns its cluster ID (if it
+// can be clustered) or nil otherwise.
+func (a *Algorithm) Cluster(config *compiledcfg.ProjectConfig, failure *clustering.Failure) []byte {
+	fs := frames(failure)
+	if len(fs) == 0 {
+		return nil
+	}
+	// sha256 hash the frames. Frames never contain new lines.
+	h := sha256.Sum256([]byte(strings.Join(fs, "\n")))
+	// Take first 16 bytes as the ID. (Risk of collision is
+	// so low as to not warrant full 32 bytes.)
+	return h[0:16]
+}
+
+// ClusterDescription returns a description of the cluster, for use when
+// filing bugs, with the help of the given example failure.
+func (a *Algorithm) ClusterDescription(config *compiledcfg.ProjectConfig, summary *clustering.ClusterSummary) (*clustering.ClusterDescription, error) {
+	fs := frames(&summary.Example)
+	if len(fs) == 0 {
+		return nil, errors.New("cluster summary must contain example with a stack trace")
+	}
+	type templateData struct {
+		Frames  []string
+		TestIDs []string
+	}
+	var input templateData
+	for _, f := range fs {
+		input.Frames = append(input.Frames, clustering.EscapeToGraphical(f))
+	}
+	for _, t := range summary.TopTests {
+		input.TestIDs = append(input.TestIDs, clustering.EscapeToGraphical(t))
+	}
+	var b bytes.Buffer
+	if err := BugTemplate.Execute(&b, input); err != nil {
+		return nil, err
+	}
+
+	return &clustering.ClusterDescription{
+		Title:       fmt.Sprintf("Crash in %s", input.Frames[0]),
+		Description: b.String(),
+	}, nil
+}
+
+// ClusterTitle returns a definition of the cluster, typically in
+// the form of an unhashed clustering key which is common
+// across all test results in a cluster. For display on the cluster
+// page or cluster listing.
+func (a *Algorithm) ClusterTitle(config *compiledcfg.ProjectConfig, example *clustering.Failure) string {
+	fs := frames(example)
+	if len(fs) == 0 {
+		return ""
+	}
+	return clustering.EscapeToGraphical(strings.Join(fs, " <- "))
+}
+
+// FailureAssociationRule returns a failure association rule that
+// captures the definition of cluster containing the given example.
+func (a *Algorithm) FailureAssociationRule(config *compiledcfg.ProjectConfig, example *clustering.Failure) string {
+	fs := frames(example)
+	if len(fs) == 0 {
+		return ""
+	}
+	args := make([]string, 0, len(fs)+1)
+	args = append(args, "reason")
+	for _, f := range fs {
+		// Escape the frame as a LIKE pattern in a string literal. Double-quoted
+		// go string literals are also valid rule string literals.
+		args = append(args, strconv.QuoteToGraphic(likeEscapeRewriter.Replace(f)))
+	}
+	return fmt.Sprintf("STACK_FRAMES(%s)", strings.Join(args, ", "))
+}
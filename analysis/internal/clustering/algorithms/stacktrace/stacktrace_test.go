@@ -0,0 +1,126 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stacktrace
+
+import (
+	"testing"
+
+	"go.chromium.org/luci/analysis/internal/clustering"
+	"go.chromium.org/luci/analysis/internal/clustering/rules/lang"
+	"go.chromium.org/luci/analysis/internal/config/compiledcfg"
+	configpb "go.chromium.org/luci/analysis/proto/config"
+	pb "go.chromium.org/luci/analysis/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAlgorithm(t *testing.T) {
+	failure := func(reason string) *clustering.Failure {
+		return &clustering.Failure{
+			TestID: "ninja://test_name",
+			Reason: &pb.FailureReason{PrimaryErrorMessage: reason},
+		}
+	}
+	goPanic := failure(`panic: assignment to entry in nil map
+
+goroutine 7 [running]:
+example.com/pkg/store.(*Store).Put(0xc000010000, {0x1, 0x2})
+	/src/pkg/store/store.go:42 +0x1d
+example.com/pkg/server.handle_request(0xc000010000)
+	/src/pkg/server/server.go:12 +0x8a
+example.com/pkg/server.Serve()
+	/src/pkg/server/server.go:7 +0x25
+main.main()
+	/src/main.go:8 +0x25`)
+	otherPanic := failure(`panic: runtime error: invalid memory address or nil pointer dereference
+
+goroutine 12 [running]:
+example.com/pkg/store.(*Store).Put(0xc000ffff00, {0x3, 0x4})
+	/other/checkout/pkg/store/store.go:45 +0x2f
+example.com/pkg/server.handle_request(0xc000ffff00)
+	/other/checkout/pkg/server/server.go:13 +0x8b
+example.com/pkg/server.Serve()
+	/other/checkout/pkg/server/server.go:7 +0x25
+main.run()
+	/other/checkout/main.go:20 +0x25`)
+
+	cfg, err := compiledcfg.NewConfig(&configpb.ProjectConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey(`Name`, t, func() {
+		// Algorithm name should be valid.
+		a := &Algorithm{}
+		So(clustering.AlgorithmRe.MatchString(a.Name()), ShouldBeTrue)
+	})
+	Convey(`Cluster`, t, func() {
+		a := &Algorithm{}
+		Convey(`Does not cluster test result without stack trace`, func() {
+			So(a.Cluster(cfg, &clustering.Failure{}), ShouldBeNil)
+			So(a.Cluster(cfg, failure("Expected 1, got 2.")), ShouldBeNil)
+		})
+		Convey(`ID of appropriate length`, func() {
+			id := a.Cluster(cfg, goPanic)
+			// IDs may be 16 bytes at most.
+			So(len(id), ShouldBeGreaterThan, 0)
+			So(len(id), ShouldBeLessThanOrEqualTo, clustering.MaxClusterIDBytes)
+		})
+		Convey(`Same ID for same top frames with different messages`, func() {
+			So(a.Cluster(cfg, otherPanic), ShouldResemble, a.Cluster(cfg, goPanic))
+		})
+		Convey(`Different ID for different frames`, func() {
+			id := a.Cluster(cfg, failure("Exception\n\tat com.example.A.a(A.java:1)"))
+			So(id, ShouldNotResemble, a.Cluster(cfg, goPanic))
+		})
+	})
+	Convey(`Failure Association Rule`, t, func() {
+		a := &Algorithm{}
+		rule := a.FailureAssociationRule(cfg, goPanic)
+		So(rule, ShouldEqual, `STACK_FRAMES(reason, "store.(*Store).Put", "server.handle\\_request", "server.Serve")`)
+
+		// Test the rule is valid and matches the failures in the cluster.
+		expr, err := lang.Parse(rule)
+		So(err, ShouldBeNil)
+		So(expr.Evaluate(goPanic), ShouldBeTrue)
+		So(expr.Evaluate(otherPanic), ShouldBeTrue)
+		So(expr.Evaluate(failure("Exception\n\tat com.example.A.a(A.java:1)")), ShouldBeFalse)
+
+		So(a.FailureAssociationRule(cfg, failure("no stack")), ShouldBeEmpty)
+	})
+	Convey(`Cluster Title`, t, func() {
+		a := &Algorithm{}
+		So(a.ClusterTitle(cfg, goPanic), ShouldEqual, "store.(*Store).Put <- server.handle_request <- server.Serve")
+	})
+	Convey(`Cluster Description`, t, func() {
+		a := &Algorithm{}
+		summary := &clustering.ClusterSummary{
+			Example:  *goPanic,
+			TopTests: []string{"ninja://test_name_one/", "ninja://test_name_two/"},
+		}
+		description, err := a.ClusterDescription(cfg, summary)
+		So(err, ShouldBeNil)
+		So(description.Title, ShouldEqual, "Crash in store.(*Store).Put")
+		So(description.Description, ShouldEqual, `This bug is for all test failures with a stack trace starting with the following frames:
+- store.(*Store).Put
+- server.handle_request
+- server.Serve
+
+The following test(s) were observed to have matching failures at this time (at most five examples listed):
+- ninja://test_name_one/
+- ninja://test_name_two/
+`)
+	})
+}
@@ -39,6 +39,10 @@ const TestNameAlgorithmPrefix = "testname-"
 // of the failure reason clustering algorithm.
 const FailureReasonAlgorithmPrefix = "reason-"
 
+// StackTraceAlgorithmPrefix is the algorithm name prefix used by all versions
+// of the stack trace clustering algorithm.
+const StackTraceAlgorithmPrefix = "stacktrace-"
+
 // ClusterID represents the identity of a cluster. The LUCI Project is
 // omitted as it is assumed to be implicit from the context.
 type ClusterID struct {
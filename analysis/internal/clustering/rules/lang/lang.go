@@ -51,6 +51,13 @@
 // - String is the production rule for a double-quoted string literal.
 // The precise definitions of which are omitted here but found in the
 // implementation.
+//
+// Besides REGEXP_CONTAINS, the language has the STACK_FRAMES(value, frame, ...)
+// function, which has no BigQuery equivalent. It matches if the innermost
+// stack frames of the stack trace in value (see package stackframes) match
+// the given LIKE patterns, in order. For example:
+//
+//	STACK_FRAMES(reason, "sub.(*Type).Method", "sub.%")
 package lang
 
 import (
@@ -66,6 +73,7 @@ import (
 	"go.chromium.org/luci/common/errors"
 
 	"go.chromium.org/luci/analysis/internal/clustering"
+	"go.chromium.org/luci/analysis/internal/clustering/stackframes"
 )
 
 type validator struct {
@@ -308,6 +316,46 @@ func (f *boolFunction) evaluator(v *validator) boolEval {
 			value := valueEval(f)
 			return re.MatchString(value)
 		}
+	case "stack_frames":
+		if len(f.Args) < 2 {
+			v.reportError(fmt.Errorf("invalid number of arguments to STACK_FRAMES: got %v, want at least 2", len(f.Args)))
+			return nil
+		}
+		valueEval := f.Args[0].evaluator(v)
+		var frameRes []*regexp.Regexp
+		for _, arg := range f.Args[1:] {
+			// As for REGEXP_CONTAINS, the frame patterns must be constants so
+			// that they can be compiled once.
+			pattern, ok := arg.asConstant(v)
+			if !ok {
+				v.reportError(fmt.Errorf("expected frame arguments to STACK_FRAMES to be constant LIKE patterns"))
+				return nil
+			}
+			regexpPattern, err := likePatternToRegexp(pattern)
+			if err != nil {
+				v.reportError(err)
+				return nil
+			}
+			re, err := regexp.Compile(regexpPattern)
+			if err != nil {
+				v.reportError(fmt.Errorf("invalid frame pattern: %s", pattern))
+				return nil
+			}
+			frameRes = append(frameRes, re)
+		}
+
+		return func(f failure) bool {
+			frames := stackframes.Top(valueEval(f), len(frameRes))
+			if len(frames) < len(frameRes) {
+				return false
+			}
+			for i, re := range frameRes {
+				if !re.MatchString(frames[i]) {
+					return false
+				}
+			}
+			return true
+		}
 	default:
 		v.reportError(fmt.Errorf("undefined function: %q", f.Function))
 		return nil
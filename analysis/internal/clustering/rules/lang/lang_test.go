@@ -58,6 +58,7 @@ func TestRules(t *testing.T) {
 				`not test like "%arc%"`,
 				`regexp_contains (test, "^arc\\.")`,
 				`not regexp_contains(test, "^arc\\.")`,
+				`stack_frames(reason, "pkg.Func", "pkg.%")`,
 				`test = "arc.Boot" AND reason LIKE "%failed%"`,
 			}
 			for _, v := range validInputs {
@@ -80,6 +81,8 @@ func TestRules(t *testing.T) {
 				`regexp_contains(test, test)`, // Use of non-constant regexp pattern.
 				`regexp_contains(test)`,       // Incorrect argument count.
 				`bad_func(test, test)`,        // Undeclared function.
+				`stack_frames(reason)`,        // Incorrect argument count.
+				`stack_frames(reason, test)`,  // Use of non-constant frame pattern.
 				`reason NOTLIKE "%failed%"`,   // Bad operator.
 			}
 			for _, v := range invalidInputs {
@@ -184,6 +187,17 @@ func TestRules(t *testing.T) {
 		Convey(`Boolean Function`, func() {
 			So(eval(`REGEXP_CONTAINS(test, "tast\\.arc\\..*")`, boot), ShouldBeTrue)
 			So(eval(`REGEXP_CONTAINS(test, "tast\\.arc\\..*")`, dbus), ShouldBeFalse)
+
+			crash := &clustering.Failure{
+				Reason: &analysispb.FailureReason{PrimaryErrorMessage: "java.lang.NullPointerException\n" +
+					"\tat com.example.Foo.bar(Foo.java:12)\n" +
+					"\tat com.example.Foo_Test.testBar(Foo_Test.java:30)"},
+			}
+			So(eval(`STACK_FRAMES(reason, "com.example.Foo.bar")`, crash), ShouldBeTrue)
+			So(eval(`STACK_FRAMES(reason, "com.example.Foo.bar", "com.example.Foo\\_Test.%")`, crash), ShouldBeTrue)
+			So(eval(`STACK_FRAMES(reason, "com.example.Foo.baz")`, crash), ShouldBeFalse)
+			So(eval(`STACK_FRAMES(reason, "%", "%", "%")`, crash), ShouldBeFalse)
+			So(eval(`STACK_FRAMES(reason, "%")`, boot), ShouldBeFalse)
 		})
 		Convey(`Boolean Factor`, func() {
 			So(eval(`NOT TRUE`, boot), ShouldBeFalse)
@@ -0,0 +1,147 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preview
+
+import (
+	"fmt"
+
+	"go.chromium.org/luci/analysis/internal/analysis/metrics"
+	cpb "go.chromium.org/luci/analysis/internal/clustering/proto"
+	pb "go.chromium.org/luci/analysis/proto/v1"
+)
+
+// metricEvaluator computes a cluster metric in-process. It mirrors
+// the FilterSQL and CountSQL of the metric's definition in package
+// metrics, which are used to compute the metric in BigQuery.
+type metricEvaluator struct {
+	// id is the identifier of the metric.
+	id metrics.ID
+	// filter returns whether the failure is counted by the metric.
+	// If nil, all failures are counted.
+	filter func(f *cpb.Failure) bool
+	// key returns the distinct item the failure counts towards, or ""
+	// if the failure does not count towards any item. If nil, each
+	// failure is counted.
+	key func(f *cpb.Failure) string
+}
+
+// metricEvaluators are the metrics which can be computed from failures
+// alone. Metrics which need other data, such as failure attributes,
+// are not supported.
+var metricEvaluators = []metricEvaluator{
+	{
+		id: metrics.HumanClsFailedPresubmit.ID,
+		filter: func(f *cpb.Failure) bool {
+			return f.IsIngestedInvocationBlocked && len(f.Exonerations) == 0 &&
+				f.BuildStatus == pb.BuildStatus_BUILD_STATUS_FAILURE && f.GetBuildCritical() &&
+				f.PresubmitRun.GetMode() == pb.PresubmitRunMode_FULL_RUN &&
+				f.PresubmitRun.GetOwner() == "user" &&
+				f.PresubmitRun.GetStatus() == pb.PresubmitRunStatus_PRESUBMIT_RUN_STATUS_FAILED
+		},
+		key: func(f *cpb.Failure) string {
+			cls := f.Sources.GetChangelists()
+			if len(cls) == 0 {
+				return ""
+			}
+			return fmt.Sprintf("%s/%v", cls[0].Host, cls[0].Change)
+		},
+	},
+	{
+		id: metrics.CriticalFailuresExonerated.ID,
+		filter: func(f *cpb.Failure) bool {
+			if !f.GetBuildCritical() {
+				return false
+			}
+			for _, e := range f.Exonerations {
+				if e.Reason == pb.ExonerationReason_OCCURS_ON_OTHER_CLS {
+					return true
+				}
+			}
+			return false
+		},
+	},
+	{
+		id: metrics.TestRunsFailed.ID,
+		filter: func(f *cpb.Failure) bool {
+			return f.IsTestRunBlocked
+		},
+		key: func(f *cpb.Failure) string {
+			return f.TestRunId
+		},
+	},
+	{
+		id: metrics.Failures.ID,
+	},
+	{
+		id: metrics.BuildsFailedDueToFlakyTests.ID,
+		filter: func(f *cpb.Failure) bool {
+			return len(f.BuildGardenerRotations) > 0 &&
+				f.IsIngestedInvocationBlocked &&
+				len(f.Exonerations) == 0 &&
+				f.TestVariantBranch.GetFlakyVerdicts_24H() > 0
+		},
+		key: func(f *cpb.Failure) string {
+			return f.IngestedInvocationId
+		},
+	},
+}
+
+// metricCounters accumulates the values of metrics over failures.
+type metricCounters struct {
+	counts map[metrics.ID]int64
+	keys   map[metrics.ID]map[string]struct{}
+}
+
+func newMetricCounters() *metricCounters {
+	c := &metricCounters{
+		counts: make(map[metrics.ID]int64),
+		keys:   make(map[metrics.ID]map[string]struct{}),
+	}
+	for _, m := range metricEvaluators {
+		if m.key != nil {
+			c.keys[m.id] = make(map[string]struct{})
+		}
+	}
+	return c
+}
+
+// add counts the given failure towards each metric.
+func (c *metricCounters) add(f *cpb.Failure) {
+	for _, m := range metricEvaluators {
+		if m.filter != nil && !m.filter(f) {
+			continue
+		}
+		if m.key == nil {
+			c.counts[m.id]++
+			continue
+		}
+		if k := m.key(f); k != "" {
+			c.keys[m.id][k] = struct{}{}
+		}
+	}
+}
+
+// values returns the value of each metric.
+func (c *metricCounters) values() map[metrics.ID]int64 {
+	result := make(map[metrics.ID]int64)
+	for _, m := range metricEvaluators {
+		if m.key == nil {
+			result[m.id] = c.counts[m.id]
+		} else {
+			result[m.id] = int64(len(c.keys[m.id]))
+		}
+	}
+	return result
+}
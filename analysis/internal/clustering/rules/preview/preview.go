@@ -0,0 +1,218 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package preview evaluates draft failure association rules against
+// recently ingested test failures, so that a rule can be checked to
+// match the intended failures before it is saved.
+//
+// Rules are evaluated in-process using package lang, over chunks of
+// failures read from a Source. In production, these are the chunks
+// archived for re-clustering, found using the clustering state. For
+// local use and testing, chunk files can be read from a directory,
+// without the need for Spanner (or its emulator) or BigQuery.
+package preview
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/analysis/internal/analysis/metrics"
+	"go.chromium.org/luci/analysis/internal/clustering"
+	cpb "go.chromium.org/luci/analysis/internal/clustering/proto"
+	"go.chromium.org/luci/analysis/internal/clustering/rules/lang"
+)
+
+// DefaultMaxChunks is the default maximum number of chunks scanned
+// in one preview.
+const DefaultMaxChunks = 1000
+
+// Chunk is a chunk of test failures to evaluate a rule against.
+type Chunk struct {
+	// Failures are the failures in the chunk.
+	Failures []*cpb.Failure
+	// Clusters records the clusters each failure was in as at the last
+	// (re-)clustering, i.e. Clusters[i] are the clusters of Failures[i].
+	// Nil if the clustering of the chunk is not known.
+	Clusters [][]clustering.ClusterID
+}
+
+// Source provides chunks of test failures.
+type Source interface {
+	// ReadChunks calls f for each chunk of test failures in the given
+	// LUCI project which may contain failures partitioned at or after
+	// since. If f returns an error, iteration stops and the error
+	// is returned.
+	ReadChunks(ctx context.Context, project string, since time.Time, f func(*Chunk) error) error
+}
+
+// Options specifies the rule to preview, and the failures to preview
+// it against.
+type Options struct {
+	// Project is the LUCI project to read failures from.
+	Project string
+	// Rule is the failure association rule predicate to evaluate,
+	// in the syntax of package lang.
+	Rule string
+	// Since is the earliest partition time of failures to evaluate
+	// the rule against.
+	Since time.Time
+	// Realms, if set, restricts evaluation to failures in the
+	// given realms.
+	Realms []string
+	// SampleSize is the maximum number of matched failures to return.
+	SampleSize int
+	// MaxChunks is the maximum number of chunks to scan. If unset,
+	// DefaultMaxChunks is used.
+	MaxChunks int
+}
+
+// RuleOverlap records the number of failures matched by the previewed
+// rule which are also matched by an existing rule.
+type RuleOverlap struct {
+	// RuleID is the identifier of the existing rule.
+	RuleID string
+	// Failures is the number of failures matched by both rules.
+	Failures int64
+}
+
+// Result is the result of previewing a rule.
+type Result struct {
+	// ChunksScanned is the number of chunks read.
+	ChunksScanned int
+	// FailuresScanned is the number of failures the rule was
+	// evaluated against.
+	FailuresScanned int64
+	// Truncated is set if the scan stopped because MaxChunks was
+	// reached. As chunk IDs are uniformly distributed, the scanned
+	// failures are then a sample of all failures in the period.
+	Truncated bool
+	// Metrics are the values of cluster metrics over the matched
+	// failures. Only metrics that can be computed from the failures
+	// alone are included.
+	Metrics map[metrics.ID]int64
+	// DistinctTests is the number of distinct test IDs among the
+	// matched failures.
+	DistinctTests int64
+	// OverlappingRules are the existing rules which matched some of
+	// the same failures, in descending order of the number of
+	// failures matched by both.
+	OverlappingRules []RuleOverlap
+	// SampleFailures are (up to SampleSize) failures matched by the rule.
+	SampleFailures []*cpb.Failure
+}
+
+// errMaxChunks is used to stop iteration when the maximum number of
+// chunks has been scanned.
+var errMaxChunks = errors.New("maximum chunks scanned")
+
+// Run evaluates the rule in opts against the failures read from src.
+// If the rule is invalid, an error tagged with InvalidRuleTag is returned.
+func Run(ctx context.Context, src Source, opts Options) (*Result, error) {
+	expr, err := lang.Parse(opts.Rule)
+	if err != nil {
+		return nil, InvalidRuleTag.Apply(errors.Annotate(err, "parse rule").Err())
+	}
+	maxChunks := opts.MaxChunks
+	if maxChunks <= 0 {
+		maxChunks = DefaultMaxChunks
+	}
+	var realms map[string]struct{}
+	if opts.Realms != nil {
+		realms = make(map[string]struct{})
+		for _, r := range opts.Realms {
+			realms[r] = struct{}{}
+		}
+	}
+
+	result := &Result{}
+	counters := newMetricCounters()
+	tests := make(map[string]struct{})
+	overlap := make(map[string]int64)
+
+	err = src.ReadChunks(ctx, opts.Project, opts.Since, func(chunk *Chunk) error {
+		if result.ChunksScanned >= maxChunks {
+			return errMaxChunks
+		}
+		result.ChunksScanned++
+		for i, f := range chunk.Failures {
+			if f.PartitionTime.AsTime().Before(opts.Since) {
+				continue
+			}
+			if realms != nil {
+				if _, ok := realms[f.Realm]; !ok {
+					continue
+				}
+			}
+			result.FailuresScanned++
+			if !expr.Evaluate(clustering.FailureFromProto(f)) {
+				continue
+			}
+			counters.add(f)
+			tests[f.TestId] = struct{}{}
+			if chunk.Clusters != nil {
+				for _, ruleID := range ruleIDs(chunk.Clusters[i]) {
+					overlap[ruleID]++
+				}
+			}
+			if len(result.SampleFailures) < opts.SampleSize {
+				result.SampleFailures = append(result.SampleFailures, proto.Clone(f).(*cpb.Failure))
+			}
+		}
+		return nil
+	})
+	if err == errMaxChunks {
+		result.Truncated = true
+	} else if err != nil {
+		return nil, errors.Annotate(err, "read chunks").Err()
+	}
+
+	result.Metrics = counters.values()
+	result.DistinctTests = int64(len(tests))
+	for ruleID, count := range overlap {
+		result.OverlappingRules = append(result.OverlappingRules, RuleOverlap{RuleID: ruleID, Failures: count})
+	}
+	sort.Slice(result.OverlappingRules, func(i, j int) bool {
+		a, b := result.OverlappingRules[i], result.OverlappingRules[j]
+		if a.Failures != b.Failures {
+			return a.Failures > b.Failures
+		}
+		return a.RuleID < b.RuleID
+	})
+	return result, nil
+}
+
+// InvalidRuleTag is used to tag errors caused by an invalid rule.
+var InvalidRuleTag = errors.BoolTag{Key: errors.NewTagKey("invalid rule")}
+
+// ruleIDs returns the distinct IDs of the rules among the given clusters.
+func ruleIDs(clusters []clustering.ClusterID) []string {
+	var result []string
+	seen := make(map[string]struct{})
+	for _, c := range clusters {
+		if !c.IsBugCluster() {
+			continue
+		}
+		if _, ok := seen[c.ID]; ok {
+			continue
+		}
+		seen[c.ID] = struct{}{}
+		result = append(result, c.ID)
+	}
+	return result
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preview
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/analysis/internal/analysis/metrics"
+	"go.chromium.org/luci/analysis/internal/clustering"
+	"go.chromium.org/luci/analysis/internal/clustering/algorithms/testname"
+	cpb "go.chromium.org/luci/analysis/internal/clustering/proto"
+	pb "go.chromium.org/luci/analysis/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeSource is a Source which provides chunks held in memory.
+type fakeSource struct {
+	chunks []*Chunk
+}
+
+// ReadChunks implements Source.
+func (s *fakeSource) ReadChunks(ctx context.Context, project string, since time.Time, f func(*Chunk) error) error {
+	for _, c := range s.chunks {
+		if err := f(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	Convey("Run", t, func() {
+		ctx := context.Background()
+		now := time.Date(2040, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		newFailure := func(testID, reason string) *cpb.Failure {
+			return &cpb.Failure{
+				PartitionTime:        timestamppb.New(now.Add(-time.Hour)),
+				Realm:                "testproject:realm",
+				TestId:               testID,
+				FailureReason:        &pb.FailureReason{PrimaryErrorMessage: reason},
+				TestRunId:            "run-" + testID,
+				IngestedInvocationId: "inv-" + testID,
+			}
+		}
+		ruleCluster := func(ruleID string) clustering.ClusterID {
+			return clustering.ClusterID{Algorithm: "rules-v2", ID: ruleID}
+		}
+		testNameCluster := clustering.ClusterID{Algorithm: testname.AlgorithmName, ID: "0123456789abcdef"}
+
+		old := newFailure("ninja://test_a", "timeout")
+		old.PartitionTime = timestamppb.New(now.Add(-48 * time.Hour))
+		otherRealm := newFailure("ninja://test_a", "timeout")
+		otherRealm.Realm = "testproject:other"
+
+		src := &fakeSource{
+			chunks: []*Chunk{
+				{
+					Failures: []*cpb.Failure{
+						newFailure("ninja://test_a", "timeout"),
+						newFailure("ninja://test_b", "crash"),
+						old,
+					},
+					Clusters: [][]clustering.ClusterID{
+						{ruleCluster("rule-1"), testNameCluster},
+						{ruleCluster("rule-2")},
+						{ruleCluster("rule-1")},
+					},
+				},
+				{
+					Failures: []*cpb.Failure{
+						newFailure("ninja://test_a", "timeout"),
+						otherRealm,
+					},
+				},
+			},
+		}
+		opts := Options{
+			Project:    "testproject",
+			Rule:       `reason LIKE "%timeout%"`,
+			Since:      now.Add(-24 * time.Hour),
+			SampleSize: 10,
+		}
+
+		Convey("Matches failures", func() {
+			result, err := Run(ctx, src, opts)
+			So(err, ShouldBeNil)
+			So(result.ChunksScanned, ShouldEqual, 2)
+			So(result.FailuresScanned, ShouldEqual, 4)
+			So(result.Truncated, ShouldBeFalse)
+			So(result.Metrics[metrics.Failures.ID], ShouldEqual, 3)
+			So(result.Metrics[metrics.TestRunsFailed.ID], ShouldEqual, 0)
+			So(result.DistinctTests, ShouldEqual, 1)
+			So(result.OverlappingRules, ShouldResemble, []RuleOverlap{
+				{RuleID: "rule-1", Failures: 1},
+			})
+			So(result.SampleFailures, ShouldHaveLength, 3)
+			So(result.SampleFailures[0], ShouldResembleProto, src.chunks[0].Failures[0])
+
+			response := ToResponse("testproject", result)
+			So(response.FailuresScanned, ShouldEqual, 4)
+			So(response.Metrics["failures"], ShouldEqual, 3)
+			So(response.OverlappingRules, ShouldResembleProto, []*pb.PreviewRuleResponse_OverlappingRule{
+				{Rule: "projects/testproject/rules/rule-1", Failures: 1},
+			})
+			So(response.SampleFailures, ShouldHaveLength, 3)
+			So(response.SampleFailures[0].TestId, ShouldEqual, "ninja://test_a")
+		})
+		Convey("Restricted to realms", func() {
+			opts.Realms = []string{"testproject:realm"}
+			result, err := Run(ctx, src, opts)
+			So(err, ShouldBeNil)
+			So(result.FailuresScanned, ShouldEqual, 3)
+			So(result.Metrics[metrics.Failures.ID], ShouldEqual, 2)
+		})
+		Convey("Samples limited", func() {
+			opts.SampleSize = 1
+			result, err := Run(ctx, src, opts)
+			So(err, ShouldBeNil)
+			So(result.SampleFailures, ShouldHaveLength, 1)
+		})
+		Convey("Truncated", func() {
+			opts.MaxChunks = 1
+			result, err := Run(ctx, src, opts)
+			So(err, ShouldBeNil)
+			So(result.ChunksScanned, ShouldEqual, 1)
+			So(result.Truncated, ShouldBeTrue)
+			So(result.Metrics[metrics.Failures.ID], ShouldEqual, 1)
+		})
+		Convey("Distinct metrics", func() {
+			for _, f := range src.chunks[0].Failures {
+				f.IsTestRunBlocked = true
+			}
+			src.chunks[1].Failures[0].IsTestRunBlocked = true
+			src.chunks[1].Failures[0].TestRunId = "run-other"
+
+			opts.Rule = `TRUE`
+			result, err := Run(ctx, src, opts)
+			So(err, ShouldBeNil)
+			So(result.Metrics[metrics.Failures.ID], ShouldEqual, 4)
+			// The recent failures of test_a and test_b in chunk 0
+			// and the failure in chunk 1 were in distinct test runs.
+			So(result.Metrics[metrics.TestRunsFailed.ID], ShouldEqual, 3)
+			So(result.DistinctTests, ShouldEqual, 2)
+			So(result.OverlappingRules, ShouldResemble, []RuleOverlap{
+				{RuleID: "rule-1", Failures: 1},
+				{RuleID: "rule-2", Failures: 1},
+			})
+		})
+		Convey("Invalid rule", func() {
+			opts.Rule = `reason LIKE`
+			_, err := Run(ctx, src, opts)
+			So(err, ShouldErrLike, "parse rule")
+			So(InvalidRuleTag.In(err), ShouldBeTrue)
+		})
+	})
+}
+
+func TestDirSource(t *testing.T) {
+	Convey("DirSource", t, func() {
+		ctx := context.Background()
+		dir := t.TempDir()
+
+		chunk := &cpb.Chunk{
+			Failures: []*cpb.Failure{
+				{
+					PartitionTime: timestamppb.New(time.Date(2040, time.January, 1, 0, 0, 0, 0, time.UTC)),
+					TestId:        "ninja://test_a",
+				},
+			},
+		}
+		b, err := proto.Marshal(chunk)
+		So(err, ShouldBeNil)
+		So(os.WriteFile(filepath.Join(dir, "chunk-b.binarypb"), b, 0600), ShouldBeNil)
+		So(os.WriteFile(filepath.Join(dir, "chunk-a.binarypb"), b, 0600), ShouldBeNil)
+		So(os.WriteFile(filepath.Join(dir, "README"), []byte("not a chunk"), 0600), ShouldBeNil)
+
+		src := &DirSource{Dir: dir}
+		var chunks []*Chunk
+		err = src.ReadChunks(ctx, "testproject", time.Time{}, func(c *Chunk) error {
+			chunks = append(chunks, c)
+			return nil
+		})
+		So(err, ShouldBeNil)
+		So(chunks, ShouldHaveLength, 2)
+		So(chunks[0].Failures, ShouldHaveLength, 1)
+		So(chunks[0].Failures[0], ShouldResembleProto, chunk.Failures[0])
+		So(chunks[0].Clusters, ShouldBeNil)
+
+		Convey("Invalid chunk", func() {
+			So(os.WriteFile(filepath.Join(dir, "chunk-c.binarypb"), []byte("\xff"), 0600), ShouldBeNil)
+			err := src.ReadChunks(ctx, "testproject", time.Time{}, func(c *Chunk) error {
+				return nil
+			})
+			So(err, ShouldErrLike, "unmarshal chunk file chunk-c.binarypb")
+		})
+	})
+}
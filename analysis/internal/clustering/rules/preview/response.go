@@ -0,0 +1,53 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preview
+
+import (
+	"fmt"
+
+	pb "go.chromium.org/luci/analysis/proto/v1"
+)
+
+// ToResponse converts the result of previewing a rule in the given
+// LUCI project to its API representation.
+func ToResponse(project string, result *Result) *pb.PreviewRuleResponse {
+	response := &pb.PreviewRuleResponse{
+		FailuresScanned:  result.FailuresScanned,
+		Truncated:        result.Truncated,
+		Metrics:          make(map[string]int64),
+		DistinctTests:    result.DistinctTests,
+		OverlappingRules: make([]*pb.PreviewRuleResponse_OverlappingRule, 0, len(result.OverlappingRules)),
+		SampleFailures:   make([]*pb.PreviewRuleResponse_SampleFailure, 0, len(result.SampleFailures)),
+	}
+	for id, value := range result.Metrics {
+		response.Metrics[id.String()] = value
+	}
+	for _, o := range result.OverlappingRules {
+		response.OverlappingRules = append(response.OverlappingRules, &pb.PreviewRuleResponse_OverlappingRule{
+			Rule:     fmt.Sprintf("projects/%s/rules/%s", project, o.RuleID),
+			Failures: o.Failures,
+		})
+	}
+	for _, f := range result.SampleFailures {
+		response.SampleFailures = append(response.SampleFailures, &pb.PreviewRuleResponse_SampleFailure{
+			TestId:        f.TestId,
+			Variant:       f.Variant,
+			FailureReason: f.FailureReason,
+			Realm:         f.Realm,
+			PartitionTime: f.PartitionTime,
+		})
+	}
+	return response
+}
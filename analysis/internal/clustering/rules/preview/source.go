@@ -0,0 +1,158 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preview
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/sync/parallel"
+	"go.chromium.org/luci/server/span"
+
+	"go.chromium.org/luci/analysis/internal/clustering/chunkstore"
+	cpb "go.chromium.org/luci/analysis/internal/clustering/proto"
+	"go.chromium.org/luci/analysis/internal/clustering/state"
+	"go.chromium.org/luci/analysis/internal/config"
+)
+
+// stateBatchSize is the number of clustering state entries read at once.
+const stateBatchSize = 100
+
+// chunkReadWorkers is the number of chunks read from the chunk store
+// in parallel.
+const chunkReadWorkers = 10
+
+// ChunkStore is the interface for the blob store archiving chunks of test
+// results for later re-clustering.
+type ChunkStore interface {
+	// Get retrieves the chunk with the specified object ID and returns it.
+	Get(ctx context.Context, project, objectID string) (*cpb.Chunk, error)
+}
+
+// StoredSource reads the chunks of failures archived for re-clustering.
+// The clustering state in Spanner is used to find the chunks, and
+// the clusters their failures are in.
+type StoredSource struct{}
+
+// NewStoredSource initialises a new StoredSource, reading chunks
+// from the chunk store configured in the service configuration.
+func NewStoredSource() *StoredSource {
+	return &StoredSource{}
+}
+
+// ReadChunks implements Source. The most recently partitioned chunks
+// are read first.
+func (s *StoredSource) ReadChunks(ctx context.Context, project string, since time.Time, f func(*Chunk) error) error {
+	cfg, err := config.Get(ctx)
+	if err != nil {
+		return errors.Annotate(err, "read config").Err()
+	}
+	store, err := chunkstore.NewClient(ctx, cfg.ChunkGcsBucket)
+	if err != nil {
+		return errors.Annotate(err, "create chunk store").Err()
+	}
+	defer store.Close()
+	return readStoredChunks(ctx, store, project, since, f)
+}
+
+// readStoredChunks reads the chunks partitioned at or after since from
+// the given store, most recently partitioned first.
+func readStoredChunks(ctx context.Context, store ChunkStore, project string, since time.Time, f func(*Chunk) error) error {
+	var after *state.Entry
+	for {
+		entries, err := state.ReadRecentSince(span.Single(ctx), project, since, after, stateBatchSize)
+		if err != nil {
+			return errors.Annotate(err, "read clustering state").Err()
+		}
+		chunks := make([]*Chunk, len(entries))
+		err = parallel.WorkPool(chunkReadWorkers, func(c chan<- func() error) {
+			for i, e := range entries {
+				i, e := i, e
+				c <- func() error {
+					chunk, err := store.Get(ctx, project, e.ObjectID)
+					if err != nil {
+						return errors.Annotate(err, "read chunk %s", e.ChunkID).Err()
+					}
+					chunks[i] = &Chunk{Failures: chunk.Failures}
+					if len(e.Clustering.Clusters) == len(chunk.Failures) {
+						chunks[i].Clusters = e.Clustering.Clusters
+					}
+					return nil
+				}
+			}
+		})
+		if err != nil {
+			return err
+		}
+		for _, c := range chunks {
+			if err := f(c); err != nil {
+				return err
+			}
+		}
+		if len(entries) < stateBatchSize {
+			return nil
+		}
+		after = entries[len(entries)-1]
+	}
+}
+
+// DirSource reads chunks from files in a local directory. Each file
+// with the extension ".binarypb" must contain a binary-encoded
+// cluster.Chunk proto, such as those archived in GCS for re-clustering.
+// The clustering of the chunks is not known.
+type DirSource struct {
+	// Dir is the directory to read chunk files from. All chunks are
+	// assumed to belong to the previewed LUCI project.
+	Dir string
+}
+
+// ReadChunks implements Source. Chunks are read in file name order.
+func (s *DirSource) ReadChunks(ctx context.Context, project string, since time.Time, f func(*Chunk) error) error {
+	dirEntries, err := os.ReadDir(s.Dir)
+	if err != nil {
+		return errors.Annotate(err, "read directory").Err()
+	}
+	var names []string
+	for _, e := range dirEntries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".binarypb") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		b, err := os.ReadFile(filepath.Join(s.Dir, name))
+		if err != nil {
+			return errors.Annotate(err, "read chunk file %s", name).Err()
+		}
+		chunk := &cpb.Chunk{}
+		if err := proto.Unmarshal(b, chunk); err != nil {
+			return errors.Annotate(err, "unmarshal chunk file %s", name).Err()
+		}
+		if err := f(&Chunk{Failures: chunk.Failures}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stackframes extracts and normalises stack frames from failure
+// messages.
+//
+// The following stack trace formats are recognised:
+//   - Go panics and goroutine dumps,
+//   - Python tracebacks,
+//   - Java (and other JVM language) exception stack traces,
+//   - C/C++ stack traces printed by sanitizers (ASan, TSan, MSan, UBSan).
+//
+// Frames are normalised down to the name of the function, so that the same
+// crash produces the same frames regardless of file paths, line numbers,
+// addresses and argument values.
+package stackframes
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+var (
+	// goFrameRe matches the function line of a Go stack frame, e.g.
+	// "go.chromium.org/luci/pkg.(*Type).Method(0xc000010000, {0x1, 0x2})".
+	goFrameRe = regexp.MustCompile(`^\s*((?:[\w.~-]+/)*[\w.~-]+\.[\w.*()\[\]$-]+?)\(.*\)$`)
+	// goFileRe matches the file line following a Go stack frame, e.g.
+	// "	/path/to/file.go:123 +0x1d".
+	goFileRe = regexp.MustCompile(`^\s+\S+\.go:\d+`)
+	// pythonFrameRe matches a Python traceback frame, e.g.
+	// `  File "/path/to/module.py", line 12, in function`.
+	pythonFrameRe = regexp.MustCompile(`^\s*File "([^"]+)", line \d+, in (\S+)`)
+	// javaFrameRe matches a Java stack frame, e.g.
+	// "	at com.example.Class$Inner.method(Class.java:12)".
+	javaFrameRe = regexp.MustCompile(`^\s*at ([\w$.<>/]+)\(.*\)$`)
+	// sanitizerFrameRe matches a frame printed by a sanitizer, e.g.
+	// "    #0 0x4f6c21 in ns::Class::Method(int) /path/to/file.cc:12:3".
+	sanitizerFrameRe = regexp.MustCompile(`^\s*#\d+ 0x[0-9a-fA-F]+ in (.+?)(?: [^ ]+:\d+(?::\d+)?| \(.*\+0x[0-9a-fA-F]+\))?$`)
+
+	// javaAnonRe matches numbered anonymous classes and lambdas in Java.
+	javaAnonRe = regexp.MustCompile(`\$\d+`)
+	// goGenericRe matches the elided type parameters of generic Go functions.
+	goGenericRe = regexp.MustCompile(`\[\.\.\.\]`)
+	// goFuncLitRe matches the numbered function literals in Go, e.g. "func1.2".
+	goFuncLitRe = regexp.MustCompile(`\.func\d+(\.\d+)*`)
+)
+
+// ignoredNativePrefixes are prefixes of native (C/C++) frames that are part
+// of the crash reporting machinery or the C library rather than the code under
+// test. Identifiers with these prefixes are reserved for the implementation,
+// so they can't clash with user code.
+var ignoredNativePrefixes = []string{
+	// Sanitizers.
+	"__asan",
+	"__tsan",
+	"__msan",
+	"__ubsan",
+	"__sanitizer",
+	"__interceptor",
+	// C library.
+	"__libc_start",
+}
+
+// ignoredNativeFuncs are native functions that are part of the C runtime.
+var ignoredNativeFuncs = map[string]bool{
+	"_start": true,
+}
+
+// isIgnoredGo returns true if the Go function, given with the import path of
+// its package, is part of the Go runtime or the testing package rather than
+// the code under test.
+//
+// Packages under runtime/ (e.g. runtime/debug) are part of the runtime too.
+func isIgnoredGo(fn string) bool {
+	return strings.HasPrefix(fn, "runtime.") ||
+		strings.HasPrefix(fn, "runtime/") ||
+		fn == "testing.tRunner" ||
+		strings.HasPrefix(fn, "testing.tRunner.")
+}
+
+// isIgnoredNative returns true if the native function is part of the sanitizers
+// or the C runtime rather than the code under test.
+func isIgnoredNative(fn string) bool {
+	if ignoredNativeFuncs[fn] {
+		return true
+	}
+	for _, p := range ignoredNativePrefixes {
+		if strings.HasPrefix(fn, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Extract returns the normalised stack frames found in the given text,
+// innermost (most recent call) first.
+//
+// Returns nil if the text contains no recognised stack trace.
+func Extract(text string) []string {
+	lines := strings.Split(text, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], "\r")
+	}
+
+	var frames []string
+	var python []string
+	for i, line := range lines {
+		if m := sanitizerFrameRe.FindStringSubmatch(line); m != nil {
+			if fn := stripParams(m[1]); !isIgnoredNative(fn) {
+				frames = appendFrame(frames, fn)
+			}
+			continue
+		}
+		if m := javaFrameRe.FindStringSubmatch(line); m != nil {
+			frames = appendFrame(frames, javaAnonRe.ReplaceAllString(m[1], "$$"))
+			continue
+		}
+		if m := pythonFrameRe.FindStringSubmatch(line); m != nil {
+			module := strings.TrimSuffix(path.Base(strings.ReplaceAll(m[1], `\`, "/")), ".py")
+			python = append(python, module+"."+m[2])
+			continue
+		}
+		if i+1 < len(lines) && goFileRe.MatchString(lines[i+1]) {
+			// Runtime frames are recognised by the import path, so check them
+			// before it is dropped.
+			if m := goFrameRe.FindStringSubmatch(line); m != nil && !isIgnoredGo(m[1]) {
+				frames = appendFrame(frames, normaliseGo(m[1]))
+			}
+		}
+	}
+	if len(frames) == 0 && len(python) > 0 {
+		// Python tracebacks list the most recent call last.
+		for i := len(python) - 1; i >= 0; i-- {
+			frames = appendFrame(frames, python[i])
+		}
+	}
+	return frames
+}
+
+// Top returns at most n innermost normalised frames of the stack trace in
+// the given text.
+func Top(text string, n int) []string {
+	frames := Extract(text)
+	if len(frames) > n {
+		frames = frames[:n]
+	}
+	return frames
+}
+
+func appendFrame(frames []string, frame string) []string {
+	frame = strings.TrimSpace(frame)
+	if frame == "" {
+		return frames
+	}
+	return append(frames, frame)
+}
+
+// normaliseGo drops the import path of the package of a Go function, as well
+// as type parameters and the numbering of function literals.
+func normaliseGo(fn string) string {
+	if i := strings.LastIndex(fn, "/"); i >= 0 {
+		fn = fn[i+1:]
+	}
+	fn = goGenericRe.ReplaceAllString(fn, "")
+	return goFuncLitRe.ReplaceAllString(fn, ".func")
+}
+
+// stripParams drops the parameter list from a C++ function signature, e.g.
+// "ns::Class::Method(int, char const*) const" becomes "ns::Class::Method".
+func stripParams(fn string) string {
+	fn = strings.ReplaceAll(fn, "(anonymous namespace)", "{anonymous}")
+	fn = strings.TrimSuffix(fn, " const")
+	if !strings.HasSuffix(fn, ")") {
+		return fn
+	}
+	depth := 0
+	for i := len(fn) - 1; i >= 0; i-- {
+		switch fn[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				return fn[:i]
+			}
+		}
+	}
+	return fn
+}
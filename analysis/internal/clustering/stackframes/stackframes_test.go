@@ -0,0 +1,116 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stackframes
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExtract(t *testing.T) {
+	Convey(`Extract`, t, func() {
+		Convey(`No stack trace`, func() {
+			So(Extract("Expected 1, got 2."), ShouldBeNil)
+			So(Extract(""), ShouldBeNil)
+		})
+		Convey(`Go`, func() {
+			text := `panic: runtime error: index out of range [5] with length 3
+
+goroutine 7 [running]:
+go.chromium.org/luci/pkg/sub.(*Type).Method(0xc000010000, {0x1, 0x2})
+	/home/user/luci/pkg/sub/file.go:123 +0x1d
+go.chromium.org/luci/pkg/sub.Generic[...](...)
+	/home/user/luci/pkg/sub/file.go:12
+go.chromium.org/luci/pkg.TestSomething.func1.2()
+	/home/user/luci/pkg/file_test.go:45 +0x8a
+testing.tRunner(0xc000102b60, 0x6b4c08)
+	/usr/local/go/src/testing/testing.go:1595 +0xff`
+			So(Extract(text), ShouldResemble, []string{
+				"sub.(*Type).Method",
+				"sub.Generic",
+				"pkg.TestSomething.func",
+			})
+		})
+		Convey(`Go runtime frames are skipped`, func() {
+			text := `goroutine 1 [running]:
+runtime/debug.Stack()
+	/usr/local/go/src/runtime/debug/stack.go:24 +0x5e
+panic({0x4a2a40?, 0xc00001c030?})
+	/usr/local/go/src/runtime/panic.go:914 +0x21f
+main.main()
+	/tmp/main.go:8 +0x25`
+			So(Extract(text), ShouldResemble, []string{"main.main"})
+		})
+		Convey(`User frames resembling runtime frames are kept`, func() {
+			text := `goroutine 1 [running]:
+example.com/panicky.Handle()
+	/tmp/panicky/handle.go:8 +0x25
+example.com/runtime.Start()
+	/tmp/runtime/start.go:8 +0x25
+testing.tRunnerHelper()
+	/tmp/testing/helper.go:8 +0x25`
+			So(Extract(text), ShouldResemble, []string{
+				"panicky.Handle",
+				"runtime.Start",
+				"testing.tRunnerHelper",
+			})
+
+			text = `    #0 0x4f6c21 in _startServer() /src/server.cc:12:3
+    #1 0x4f6d02 in panicHandler() /src/server.cc:40:5
+    #2 0x7f1e2a in _start (/tmp/server+0x2409a)`
+			So(Extract(text), ShouldResemble, []string{"_startServer", "panicHandler"})
+		})
+		Convey(`Python`, func() {
+			text := `Traceback (most recent call last):
+  File "/b/s/w/ir/test.py", line 10, in test_foo
+    self.helper()
+  File "/b/s/w/ir/lib/helper.py", line 52, in helper
+    raise ValueError("bad value 42")
+ValueError: bad value 42`
+			So(Extract(text), ShouldResemble, []string{"helper.helper", "test.test_foo"})
+		})
+		Convey(`Java`, func() {
+			text := `java.lang.NullPointerException: oops
+	at com.example.Foo$1.run(Foo.java:12)
+	at com.example.Foo.lambda$start$0(Foo.java:30)
+	at java.base/java.lang.Thread.run(Thread.java:833)`
+			So(Extract(text), ShouldResemble, []string{
+				"com.example.Foo$.run",
+				"com.example.Foo.lambda$start$",
+				"java.base/java.lang.Thread.run",
+			})
+		})
+		Convey(`Sanitizer`, func() {
+			text := `==1234==ERROR: AddressSanitizer: heap-use-after-free on address 0x602000000010
+READ of size 4 at 0x602000000010 thread T0
+    #0 0x4f6c21 in ns::Class::Method(int, std::string const&) const /src/foo.cc:12:3
+    #1 0x4f6d02 in (anonymous namespace)::Helper() /src/foo.cc:40:5
+    #2 0x7f1e2a in __libc_start_main (/lib/x86_64-linux-gnu/libc.so.6+0x2409a)`
+			So(Extract(text), ShouldResemble, []string{
+				"ns::Class::Method",
+				"{anonymous}::Helper",
+			})
+		})
+	})
+	Convey(`Top`, t, func() {
+		text := `Exception
+	at a.A.a(A.java:1)
+	at b.B.b(B.java:2)
+	at c.C.c(C.java:3)`
+		So(Top(text, 2), ShouldResemble, []string{"a.A.a", "b.B.b"})
+		So(Top(text, 5), ShouldHaveLength, 3)
+	})
+}
@@ -237,16 +237,42 @@ func ReadNextN(ctx context.Context, project string, opts ReadNextOptions, n int)
 	return readWhere(ctx, project, whereClause, params, n)
 }
 
+// ReadRecentSince reads the next n clustering state entries with a
+// partition time at or after since, most recently partitioned first
+// (ties are broken by ChunkID). To read the first page, pass nil as
+// after. To read subsequent pages, pass the last entry of the previous
+// page.
+//
+// Only entries in the requested time range are scanned, by means of
+// the ClusteringStateByPartitionTime index.
+func ReadRecentSince(ctx context.Context, project string, since time.Time, after *Entry, n int) ([]*Entry, error) {
+	whereClause := `PartitionTime >= @since`
+	params := map[string]any{
+		"since": since,
+	}
+	if after != nil {
+		whereClause += ` AND (PartitionTime < @afterPartitionTime
+			OR (PartitionTime = @afterPartitionTime AND ChunkId > @afterChunkID))`
+		params["afterPartitionTime"] = after.PartitionTime
+		params["afterChunkID"] = after.ChunkID
+	}
+	return readQuery(ctx, project, "ClusteringState@{FORCE_INDEX=ClusteringStateByPartitionTime}", whereClause, "PartitionTime DESC, ChunkId", params, n)
+}
+
 func readWhere(ctx context.Context, project, whereClause string, params map[string]any, limit int) ([]*Entry, error) {
+	return readQuery(ctx, project, "ClusteringState", whereClause, "ChunkId", params, limit)
+}
+
+func readQuery(ctx context.Context, project, from, whereClause, orderBy string, params map[string]any, limit int) ([]*Entry, error) {
 	stmt := spanner.NewStatement(`
 		SELECT
 		  ChunkId, PartitionTime, ObjectId,
 		  AlgorithmsVersion,
 		  ConfigVersion, RulesVersion,
 		  LastUpdated, Clusters
-		FROM ClusteringState
+		FROM ` + from + `
 		WHERE Project = @project AND (` + whereClause + `)
-		ORDER BY ChunkId
+		ORDER BY ` + orderBy + `
 		LIMIT @limit
 	`)
 	for k, v := range params {
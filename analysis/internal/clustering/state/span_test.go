@@ -300,6 +300,50 @@ func TestSpanner(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(rows, ShouldBeEmpty)
 		})
+		Convey(`ReadRecentSince`, func() {
+			since := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+			entries := []*Entry{
+				// Should be read.
+				NewEntry(0).WithPartitionTime(since).Build(),
+				NewEntry(1).WithPartitionTime(since.Add(time.Hour)).Build(),
+				NewEntry(2).WithPartitionTime(since.Add(2 * time.Hour)).Build(),
+				NewEntry(3).WithPartitionTime(since.Add(2 * time.Hour)).Build(),
+				// Should not be read (partitioned before since).
+				NewEntry(4).WithPartitionTime(since.Add(-time.Nanosecond)).Build(),
+				// Should not be read (other project).
+				NewEntry(5).WithPartitionTime(since).WithProject("other").Build(),
+			}
+			commitTime, err := CreateEntriesForTesting(ctx, entries)
+			So(err, ShouldBeNil)
+			for _, e := range entries {
+				e.LastUpdated = commitTime.In(time.UTC)
+			}
+
+			// Most recently partitioned first, ties broken by chunk ID.
+			expectedEntries := []*Entry{entries[0], entries[1], entries[2], entries[3]}
+			sort.Slice(expectedEntries, func(i, j int) bool {
+				a, b := expectedEntries[i], expectedEntries[j]
+				if !a.PartitionTime.Equal(b.PartitionTime) {
+					return a.PartitionTime.After(b.PartitionTime)
+				}
+				return a.ChunkID < b.ChunkID
+			})
+
+			// Reads first page.
+			rows, err := ReadRecentSince(span.Single(ctx), testProject, since, nil, 3)
+			So(err, ShouldBeNil)
+			So(rows, ShouldResemble, expectedEntries[0:3])
+
+			// Read second page.
+			rows, err = ReadRecentSince(span.Single(ctx), testProject, since, rows[2], 3)
+			So(err, ShouldBeNil)
+			So(rows, ShouldResemble, expectedEntries[3:])
+
+			// Read empty last page.
+			rows, err = ReadRecentSince(span.Single(ctx), testProject, since, rows[0], 3)
+			So(err, ShouldBeNil)
+			So(rows, ShouldBeEmpty)
+		})
 		Convey(`EstimateChunks`, func() {
 			Convey(`Less than 100 chunks`, func() {
 				est, err := EstimateChunks(span.Single(ctx), testProject)
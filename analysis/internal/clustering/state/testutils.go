@@ -94,6 +94,12 @@ func (b *EntryBuilder) WithProject(project string) *EntryBuilder {
 	return b
 }
 
+// WithPartitionTime specifies the partition time for the entry.
+func (b *EntryBuilder) WithPartitionTime(partitionTime time.Time) *EntryBuilder {
+	b.entry.PartitionTime = partitionTime
+	return b
+}
+
 // WithAlgorithmsVersion specifies the algorithms version for the entry.
 func (b *EntryBuilder) WithAlgorithmsVersion(version int64) *EntryBuilder {
 	b.entry.Clustering.AlgorithmsVersion = version
@@ -103,6 +103,29 @@ var (
 	monorailLabelRE             = regexp.MustCompile(`^[a-zA-Z0-9\-]+$`)
 	monorailLabelMaxLengthBytes = 60
 
+	// https://docs.github.com/en/get-started/using-github/github-glossary
+	// Owner names are at most 39 alphanumeric characters or single hyphens,
+	// repository names at most 100 alphanumeric characters, '-', '_' or '.'.
+	githubOwnerRE                  = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9\-]{0,38})$`)
+	githubOwnerMaxLengthBytes      = 39
+	githubRepositoryRE             = regexp.MustCompile(`^[a-zA-Z0-9_.\-]{1,100}$`)
+	githubRepositoryMaxLengthBytes = 100
+
+	// githubLabelRE matches valid GitHub labels. GitHub labels may contain
+	// spaces and punctuation, and are limited to 50 characters.
+	githubLabelRE             = regexp.MustCompile(`^[[:print:]]+$`)
+	githubLabelMaxLengthBytes = 50
+
+	// jiraProjectKeyRE matches valid Jira project keys in the default
+	// Jira project key format.
+	jiraProjectKeyRE             = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,254}$`)
+	jiraProjectKeyMaxLengthBytes = 255
+
+	// jiraLabelRE matches valid Jira labels. Jira labels may not contain
+	// spaces.
+	jiraLabelRE             = regexp.MustCompile(`^[[:graph:]]+$`)
+	jiraLabelMaxLengthBytes = 255
+
 	unspecifiedMessage = "must be specified"
 )
 
@@ -539,12 +562,22 @@ func validateBugManagement(ctx *validation.Context, bm *configpb.BugManagement)
 		ctx.Errorf("buganizer section is required when the default_bug_system is Buganizer")
 		return
 	}
-	if bm.Buganizer != nil || bm.Monorail != nil {
-		// Default bug system must be specified if either Buganizer or Monorail is configured.
+	if bm.DefaultBugSystem == configpb.BugSystem_GITHUB && bm.Github == nil {
+		ctx.Errorf("github section is required when the default_bug_system is GitHub")
+		return
+	}
+	if bm.DefaultBugSystem == configpb.BugSystem_JIRA && bm.Jira == nil {
+		ctx.Errorf("jira section is required when the default_bug_system is Jira")
+		return
+	}
+	if bm.Buganizer != nil || bm.Monorail != nil || bm.Github != nil || bm.Jira != nil {
+		// Default bug system must be specified if any bug system is configured.
 		validateDefaultBugSystem(ctx, bm.DefaultBugSystem)
 	}
 	validateBuganizer(ctx, bm.Buganizer)
 	validateMonorail(ctx, bm.Monorail)
+	validateGitHub(ctx, bm.Github)
+	validateJira(ctx, bm.Jira)
 }
 
 func validateDefaultBugSystem(ctx *validation.Context, value configpb.BugSystem) {
@@ -582,6 +615,84 @@ func validateMonorail(ctx *validation.Context, cfg *configpb.MonorailProject) {
 	validateStringConfig(ctx, "monorail_hostname", cfg.MonorailHostname, hostnameRE, hostnameMaxLengthBytes)
 }
 
+func validateGitHub(ctx *validation.Context, cfg *configpb.GitHubProject) {
+	ctx.Enter("github")
+	defer ctx.Exit()
+
+	if cfg == nil {
+		// Allow non-existent github section.
+		return
+	}
+
+	if cfg.Hostname != "" {
+		// Hostname is optional, and defaults to github.com.
+		validateStringConfig(ctx, "hostname", cfg.Hostname, hostnameRE, hostnameMaxLengthBytes)
+	}
+	validateStringConfig(ctx, "owner", cfg.Owner, githubOwnerRE, githubOwnerMaxLengthBytes)
+	validateStringConfig(ctx, "repository", cfg.Repository, githubRepositoryRE, githubRepositoryMaxLengthBytes)
+	validateIssueLabels(ctx, cfg.Labels, githubLabelRE, githubLabelMaxLengthBytes)
+	if cfg.PriorityLabelPrefix != "" {
+		// Priority label prefix is optional, and defaults to "P".
+		// Leave space for the priority ("P0") in the label.
+		validateStringConfig(ctx, "priority_label_prefix", cfg.PriorityLabelPrefix, printableASCIIRE, githubLabelMaxLengthBytes-2)
+	}
+}
+
+func validateJira(ctx *validation.Context, cfg *configpb.JiraProject) {
+	ctx.Enter("jira")
+	defer ctx.Exit()
+
+	if cfg == nil {
+		// Allow non-existent jira section.
+		return
+	}
+
+	validateStringConfig(ctx, "hostname", cfg.Hostname, hostnameRE, hostnameMaxLengthBytes)
+	validateStringConfig(ctx, "project_key", cfg.ProjectKey, jiraProjectKeyRE, jiraProjectKeyMaxLengthBytes)
+	if cfg.IssueType != "" {
+		// Issue type is optional, and defaults to "Bug".
+		validateStringConfig(ctx, "issue_type", cfg.IssueType, printableASCIIRE, standardMaxLengthBytes)
+	}
+	validateIssueLabels(ctx, cfg.Labels, jiraLabelRE, jiraLabelMaxLengthBytes)
+	validateJiraPriorities(ctx, cfg.Priorities)
+}
+
+func validateJiraPriorities(ctx *validation.Context, priorities []*configpb.JiraProject_PriorityMapping) {
+	ctx.Enter("priorities")
+	defer ctx.Exit()
+
+	seenPriorities := map[configpb.BuganizerPriority]struct{}{}
+	for i, p := range priorities {
+		ctx.Enter("[%v]", i)
+		validateBuganizerPriority(ctx, p.Priority)
+		if _, ok := seenPriorities[p.Priority]; ok {
+			ctx.Errorf("priority %v appears in collection more than once", p.Priority)
+		}
+		seenPriorities[p.Priority] = struct{}{}
+		validateStringConfig(ctx, "name", p.Name, printableASCIIRE, standardMaxLengthBytes)
+		ctx.Exit()
+	}
+}
+
+// validateIssueLabels validates labels to apply to GitHub or Jira issues.
+func validateIssueLabels(ctx *validation.Context, labels []string, re *regexp.Regexp, maxLengthBytes int) {
+	ctx.Enter("labels")
+	defer ctx.Exit()
+	if len(labels) > 5 {
+		ctx.Errorf("exceeds maximum of 5 labels")
+	}
+	seenLabels := map[string]struct{}{}
+	for i, label := range labels {
+		validateStringConfig(ctx, fmt.Sprintf("[%v]", i), label, re, maxLengthBytes)
+		if _, ok := seenLabels[label]; ok {
+			ctx.Enter("[%v]", i)
+			ctx.Errorf("label %q appears in collection more than once", label)
+			ctx.Exit()
+		}
+		seenLabels[label] = struct{}{}
+	}
+}
+
 func validateBugManagementPolicies(ctx *validation.Context, policies []*configpb.BugManagementPolicy) {
 	ctx.Enter("policies")
 	defer ctx.Exit()
@@ -730,6 +841,8 @@ func validateBugManagementPolicyBugTemplate(ctx *validation.Context, t *configpb
 	validateCommentTemplate(ctx, t.CommentTemplate)
 	validateBugManagementPolicyBugTemplateBuganizer(ctx, t.Buganizer)
 	validateBugManagementPolicyBugTemplateMonorail(ctx, t.Monorail)
+	validateBugManagementPolicyBugTemplateGitHub(ctx, t.Github)
+	validateBugManagementPolicyBugTemplateJira(ctx, t.Jira)
 }
 
 func validateCommentTemplate(ctx *validation.Context, t string) {
@@ -844,6 +957,30 @@ func validateMonorailLabels(ctx *validation.Context, labels []string) {
 	}
 }
 
+func validateBugManagementPolicyBugTemplateGitHub(ctx *validation.Context, g *configpb.BugManagementPolicy_BugTemplate_GitHub) {
+	ctx.Enter("github")
+	defer ctx.Exit()
+
+	if g == nil {
+		// It is valid not specify github-specific template options.
+		return
+	}
+
+	validateIssueLabels(ctx, g.Labels, githubLabelRE, githubLabelMaxLengthBytes)
+}
+
+func validateBugManagementPolicyBugTemplateJira(ctx *validation.Context, j *configpb.BugManagementPolicy_BugTemplate_Jira) {
+	ctx.Enter("jira")
+	defer ctx.Exit()
+
+	if j == nil {
+		// It is valid not specify jira-specific template options.
+		return
+	}
+
+	validateIssueLabels(ctx, j.Labels, jiraLabelRE, jiraLabelMaxLengthBytes)
+}
+
 func validateTestStabilityCriteria(ctx *validation.Context, t *configpb.TestStabilityCriteria) {
 	ctx.Enter("test_stability_criteria")
 	defer ctx.Exit()
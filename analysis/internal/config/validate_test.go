@@ -366,6 +366,120 @@ func TestProjectConfigValidator(t *testing.T) {
 				})
 			})
 		})
+		Convey("github", func() {
+			bm.Github = &configpb.GitHubProject{
+				Owner:      "chromium",
+				Repository: "luci-go",
+				Labels:     []string{"luci-analysis"},
+			}
+			g := bm.Github
+			path := `bug_management / github`
+			Convey("valid", func() {
+				So(validate(project, cfg), ShouldBeNil)
+
+				bm.DefaultBugSystem = configpb.BugSystem_GITHUB
+				So(validate(project, cfg), ShouldBeNil)
+			})
+			Convey("required if github is default bug system", func() {
+				bm.DefaultBugSystem = configpb.BugSystem_GITHUB
+				bm.Github = nil
+				So(validate(project, cfg), ShouldErrLike, `(bug_management): github section is required when the default_bug_system is GitHub`)
+			})
+			Convey("owner", func() {
+				path := path + ` / owner`
+				Convey("unset", func() {
+					g.Owner = ""
+					So(validate(project, cfg), ShouldErrLike, `(`+path+`): must be specified`)
+				})
+				Convey("invalid", func() {
+					g.Owner = "-chromium"
+					So(validate(project, cfg), ShouldErrLike, `(`+path+`): does not match pattern`)
+				})
+			})
+			Convey("repository", func() {
+				path := path + ` / repository`
+				Convey("unset", func() {
+					g.Repository = ""
+					So(validate(project, cfg), ShouldErrLike, `(`+path+`): must be specified`)
+				})
+				Convey("invalid", func() {
+					g.Repository = "luci/go"
+					So(validate(project, cfg), ShouldErrLike, `(`+path+`): does not match pattern`)
+				})
+			})
+			Convey("hostname invalid", func() {
+				g.Hostname = "<>"
+				So(validate(project, cfg), ShouldErrLike, `(`+path+` / hostname): does not match pattern`)
+			})
+			Convey("labels", func() {
+				path := path + ` / labels`
+				Convey("duplicate", func() {
+					g.Labels = []string{"a", "a"}
+					So(validate(project, cfg), ShouldErrLike, `(`+path+` / [1]): label "a" appears in collection more than once`)
+				})
+				Convey("too long", func() {
+					g.Labels = []string{strings.Repeat("a", 51)}
+					So(validate(project, cfg), ShouldErrLike, `(`+path+` / [0]): exceeds maximum allowed length of 50 bytes`)
+				})
+			})
+		})
+		Convey("jira", func() {
+			bm.Jira = &configpb.JiraProject{
+				Hostname:   "example.atlassian.net",
+				ProjectKey: "PROJ",
+				Labels:     []string{"luci-analysis"},
+				Priorities: []*configpb.JiraProject_PriorityMapping{
+					{Priority: configpb.BuganizerPriority_P0, Name: "Blocker"},
+				},
+			}
+			j := bm.Jira
+			path := `bug_management / jira`
+			Convey("valid", func() {
+				So(validate(project, cfg), ShouldBeNil)
+
+				bm.DefaultBugSystem = configpb.BugSystem_JIRA
+				So(validate(project, cfg), ShouldBeNil)
+			})
+			Convey("required if jira is default bug system", func() {
+				bm.DefaultBugSystem = configpb.BugSystem_JIRA
+				bm.Jira = nil
+				So(validate(project, cfg), ShouldErrLike, `(bug_management): jira section is required when the default_bug_system is Jira`)
+			})
+			Convey("hostname unset", func() {
+				j.Hostname = ""
+				So(validate(project, cfg), ShouldErrLike, `(`+path+` / hostname): must be specified`)
+			})
+			Convey("project key", func() {
+				path := path + ` / project_key`
+				Convey("unset", func() {
+					j.ProjectKey = ""
+					So(validate(project, cfg), ShouldErrLike, `(`+path+`): must be specified`)
+				})
+				Convey("invalid", func() {
+					j.ProjectKey = "proj"
+					So(validate(project, cfg), ShouldErrLike, `(`+path+`): does not match pattern`)
+				})
+			})
+			Convey("labels may not contain spaces", func() {
+				j.Labels = []string{"luci analysis"}
+				So(validate(project, cfg), ShouldErrLike, `(`+path+` / labels / [0]): does not match pattern`)
+			})
+			Convey("priorities", func() {
+				path := path + ` / priorities / [0]`
+				Convey("priority unset", func() {
+					j.Priorities[0].Priority = configpb.BuganizerPriority_BUGANIZER_PRIORITY_UNSPECIFIED
+					So(validate(project, cfg), ShouldErrLike, `(`+path+` / priority): must be specified`)
+				})
+				Convey("name unset", func() {
+					j.Priorities[0].Name = ""
+					So(validate(project, cfg), ShouldErrLike, `(`+path+` / name): must be specified`)
+				})
+				Convey("duplicate", func() {
+					j.Priorities = append(j.Priorities, &configpb.JiraProject_PriorityMapping{Priority: configpb.BuganizerPriority_P0, Name: "Highest"})
+					So(validate(project, cfg), ShouldErrLike, `(bug_management / jira / priorities / [1]): priority P0 appears in collection more than once`)
+				})
+			})
+		})
 		Convey("policies", func() {
 			policy := bm.Policies[0]
 			path := "bug_management / policies"
@@ -692,7 +806,7 @@ func TestProjectConfigValidator(t *testing.T) {
 						bugTemplate.CommentTemplate = "{{if .BugID.IsMonorail}}{{.BugID.MonorailBugID}}{{else}}{{.BugID.BuganizerBugID}}{{end}}"
 
 						err := validate(project, cfg)
-						So(err, ShouldErrLike, `(`+path+`): validate template: test case "neither buganizer nor monorail"`)
+						So(err, ShouldErrLike, `(`+path+`): validate template: test case "github"`)
 						So(err, ShouldErrLike, `error calling BuganizerBugID: not a buganizer bug`)
 					})
 				})
@@ -33,6 +33,8 @@ import (
 
 	"go.chromium.org/luci/analysis/internal/analysis"
 	"go.chromium.org/luci/analysis/internal/bugs/buganizer"
+	"go.chromium.org/luci/analysis/internal/bugs/github"
+	"go.chromium.org/luci/analysis/internal/bugs/jira"
 	"go.chromium.org/luci/analysis/internal/bugs/monorail"
 	"go.chromium.org/luci/analysis/internal/bugs/updater"
 	"go.chromium.org/luci/analysis/internal/clustering/runs"
@@ -147,6 +149,16 @@ func (h Handler) UpdateBugs(ctx context.Context, task *taskspb.UpdateBugs) (retE
 		defer buganizerClient.Close()
 	}
 
+	githubClient, err := createGitHubClient(ctx, task.Project)
+	if err != nil {
+		return errors.Annotate(err, "creating a github client").Err()
+	}
+
+	jiraClient, err := createJiraClient(ctx, task.Project)
+	if err != nil {
+		return errors.Annotate(err, "creating a jira client").Err()
+	}
+
 	analysisClient, err := analysis.NewClient(ctx, h.GCPProject)
 	if err != nil {
 		return err
@@ -171,6 +183,8 @@ func (h Handler) UpdateBugs(ctx context.Context, task *taskspb.UpdateBugs) (retE
 		AnalysisClient:       analysisClient,
 		MonorailClient:       monorailClient,
 		BuganizerClient:      buganizerClient,
+		GitHubClient:         githubClient,
+		JiraClient:           jiraClient,
 		SimulateBugUpdates:   h.Simulate,
 		MaxBugsFiledPerRun:   1,
 		UpdateRuleBatchSize:  1000,
@@ -229,3 +243,35 @@ func createBuganizerClient(ctx context.Context) (buganizer.Client, error) {
 
 	return buganizerClient, err
 }
+
+func createGitHubClient(ctx context.Context, project string) (github.Client, error) {
+	mode, _ := ctx.Value(&github.ClientModeKey).(string)
+	switch mode {
+	case github.ModeProvided:
+		client, err := github.NewRESTClient(ctx, project)
+		if err != nil {
+			return nil, errors.Annotate(err, "create new github client").Err()
+		}
+		return client, nil
+	case github.ModeDisable, "":
+		return nil, nil
+	default:
+		return nil, errors.New("Unrecognized github-mode value used.")
+	}
+}
+
+func createJiraClient(ctx context.Context, project string) (jira.Client, error) {
+	mode, _ := ctx.Value(&jira.ClientModeKey).(string)
+	switch mode {
+	case jira.ModeProvided:
+		client, err := jira.NewRESTClient(ctx, project)
+		if err != nil {
+			return nil, errors.Annotate(err, "create new jira client").Err()
+		}
+		return client, nil
+	case jira.ModeDisable, "":
+		return nil, nil
+	default:
+		return nil, errors.New("Unrecognized jira-mode value used.")
+	}
+}
@@ -174,6 +174,10 @@ CREATE TABLE ClusteringState (
 ) PRIMARY KEY (Project, ChunkId)
 , ROW DELETION POLICY (OLDER_THAN(PartitionTime, INTERVAL 90 DAY));
 
+-- Used to read the most recently partitioned chunks, e.g. to preview
+-- failure association rules.
+CREATE INDEX ClusteringStateByPartitionTime ON ClusteringState(Project, PartitionTime DESC);
+
 -- ReclusteringRuns contains details of runs used to re-cluster test results.
 CREATE TABLE ReclusteringRuns (
   -- The LUCI Project.
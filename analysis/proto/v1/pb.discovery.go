@@ -12,5338 +12,6044 @@ func init() {
 			"luci.analysis.v1.BuganizerTester", "luci.analysis.v1.Clusters", "luci.analysis.v1.Metrics", "luci.analysis.v1.Projects", "luci.analysis.v1.Rules", "luci.analysis.v1.TestHistory", "luci.analysis.v1.TestVariantBranches", "luci.analysis.v1.TestVariants",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 109, 116, 100, 73,
-			117, 32, 168, 247, 149, 74, 133, 84, 82, 234, 169, 186, 75, 149,
-			213, 221, 21, 149, 85, 213, 146, 170, 82, 169, 143, 170, 238, 166,
-			170, 104, 24, 149, 148, 93, 157, 141, 74, 170, 145, 84, 52, 221,
-			28, 90, 253, 50, 51, 82, 249, 186, 94, 190, 151, 188, 247, 82,
-			234, 44, 232, 177, 7, 6, 123, 48, 199, 195, 128, 155, 129, 197,
-			224, 182, 49, 216, 128, 207, 26, 175, 193, 172, 25, 207, 177, 215,
-			246, 206, 97, 189, 3, 246, 96, 27, 175, 205, 204, 217, 217, 131,
-			241, 44, 198, 95, 128, 7, 99, 140, 25, 246, 220, 27, 31, 239,
-			101, 74, 213, 93, 96, 218, 243, 99, 169, 211, 125, 148, 55, 94,
-			196, 141, 136, 27, 55, 110, 220, 136, 184, 247, 6, 249, 6, 33,
-			15, 238, 4, 165, 90, 51, 12, 90, 110, 167, 85, 10, 194, 157,
-			57, 175, 83, 115, 231, 28, 223, 241, 186, 145, 27, 205, 181, 195,
-			32, 14, 230, 118, 23, 230, 170, 157, 29, 199, 119, 111, 178, 112,
-			59, 102, 81, 204, 194, 18, 126, 177, 115, 144, 189, 36, 179, 151,
-			118, 23, 10, 121, 50, 185, 28, 50, 39, 102, 155, 78, 171, 237,
-			177, 74, 20, 117, 216, 6, 123, 109, 135, 69, 113, 225, 126, 114,
-			244, 128, 111, 81, 59, 240, 35, 102, 31, 37, 89, 23, 18, 182,
-			221, 250, 164, 70, 181, 105, 99, 99, 16, 225, 74, 125, 241, 159,
-			107, 100, 236, 178, 108, 192, 22, 214, 111, 251, 100, 124, 31, 46,
-			251, 76, 169, 191, 61, 165, 91, 53, 38, 127, 246, 182, 242, 242,
-			198, 21, 6, 46, 159, 123, 124, 225, 246, 72, 117, 73, 166, 180,
-			171, 143, 252, 235, 44, 201, 216, 230, 232, 192, 9, 141, 252, 239,
-			38, 209, 70, 108, 99, 116, 192, 94, 252, 132, 73, 151, 131, 118,
-			55, 116, 119, 154, 49, 93, 156, 95, 60, 71, 183, 154, 140, 174,
-			94, 95, 174, 208, 165, 78, 220, 12, 194, 168, 68, 8, 93, 117,
-			107, 204, 143, 88, 157, 118, 252, 58, 11, 105, 220, 100, 116, 169,
-			237, 212, 32, 39, 255, 82, 164, 175, 100, 97, 228, 6, 62, 93,
-			44, 205, 211, 105, 200, 80, 16, 159, 10, 51, 151, 8, 237, 6,
-			29, 218, 114, 186, 212, 15, 98, 218, 137, 24, 141, 155, 110, 68,
-			27, 174, 199, 40, 123, 186, 198, 218, 49, 117, 125, 90, 11, 90,
-			109, 207, 117, 252, 26, 163, 123, 110, 220, 196, 90, 4, 142, 18,
-			161, 143, 9, 12, 65, 53, 118, 92, 159, 58, 180, 22, 180, 187,
-			52, 104, 164, 179, 81, 39, 38, 132, 226, 191, 102, 28, 183, 47,
-			206, 205, 237, 237, 237, 149, 28, 108, 41, 167, 18, 207, 23, 205,
-			173, 86, 150, 203, 107, 155, 229, 217, 197, 210, 60, 33, 244, 186,
-			239, 177, 40, 162, 33, 123, 109, 199, 13, 89, 157, 86, 187, 212,
-			105, 183, 61, 183, 230, 84, 61, 70, 61, 103, 143, 6, 33, 117,
-			118, 66, 198, 234, 52, 14, 160, 173, 123, 161, 27, 187, 254, 78,
-			145, 70, 65, 35, 222, 115, 66, 70, 104, 221, 141, 226, 208, 173,
-			118, 226, 30, 50, 201, 150, 185, 81, 79, 134, 192, 167, 142, 79,
-			11, 75, 155, 180, 178, 89, 160, 151, 151, 54, 43, 155, 69, 66,
-			31, 173, 108, 61, 188, 126, 125, 139, 62, 186, 180, 177, 177, 180,
-			182, 85, 41, 111, 210, 245, 13, 186, 188, 190, 182, 82, 217, 170,
-			172, 175, 109, 210, 245, 135, 232, 210, 218, 99, 244, 21, 149, 181,
-			149, 34, 101, 110, 220, 100, 33, 101, 79, 183, 67, 104, 125, 16,
-			82, 23, 8, 200, 234, 37, 66, 55, 25, 235, 169, 190, 17, 240,
-			230, 68, 109, 86, 115, 27, 110, 141, 122, 142, 191, 211, 113, 118,
-			24, 221, 9, 118, 89, 232, 187, 254, 14, 109, 179, 176, 229, 70,
-			48, 136, 17, 117, 252, 58, 161, 158, 219, 114, 99, 39, 198, 132,
-			125, 61, 42, 17, 146, 37, 154, 110, 27, 185, 129, 163, 240, 43,
-			107, 27, 246, 192, 195, 100, 136, 232, 217, 97, 254, 115, 139, 232,
-			153, 1, 219, 188, 99, 96, 82, 203, 63, 76, 151, 104, 196, 194,
-			93, 183, 6, 237, 114, 98, 218, 14, 131, 93, 183, 206, 34, 234,
-			80, 152, 196, 80, 191, 235, 199, 44, 108, 56, 53, 222, 90, 156,
-			99, 55, 93, 22, 98, 250, 78, 136, 237, 40, 17, 66, 136, 145,
-			25, 208, 108, 227, 142, 236, 17, 114, 145, 152, 153, 1, 125, 192,
-			54, 142, 232, 175, 202, 207, 82, 62, 101, 0, 101, 132, 211, 134,
-			226, 156, 133, 190, 208, 144, 197, 157, 208, 143, 168, 27, 79, 69,
-			212, 173, 151, 8, 25, 33, 22, 148, 213, 108, 227, 72, 230, 136,
-			132, 116, 219, 56, 50, 57, 47, 33, 195, 54, 142, 92, 218, 34,
-			247, 17, 221, 28, 176, 141, 99, 3, 39, 243, 103, 168, 152, 177,
-			192, 196, 200, 11, 53, 172, 147, 211, 54, 85, 41, 111, 168, 9,
-			232, 143, 101, 41, 89, 36, 186, 169, 217, 230, 61, 3, 39, 180,
-			252, 189, 84, 206, 100, 224, 94, 44, 15, 189, 119, 14, 42, 175,
-			105, 182, 113, 79, 246, 4, 57, 75, 76, 83, 131, 142, 82, 253,
-			142, 252, 61, 56, 69, 221, 186, 100, 126, 222, 132, 186, 42, 56,
-			66, 44, 200, 108, 65, 238, 65, 9, 105, 182, 65, 179, 57, 9,
-			25, 182, 65, 39, 14, 87, 51, 40, 39, 206, 145, 79, 46, 144,
-			227, 59, 65, 176, 227, 49, 46, 57, 170, 157, 198, 92, 236, 182,
-			88, 20, 59, 173, 182, 144, 174, 99, 60, 67, 73, 102, 40, 92,
-			34, 67, 91, 50, 143, 61, 73, 6, 35, 86, 11, 252, 122, 36,
-			229, 165, 0, 237, 195, 196, 242, 29, 63, 136, 38, 117, 170, 77,
-			91, 27, 28, 184, 252, 38, 141, 76, 212, 130, 86, 169, 15, 233,
-			229, 81, 133, 242, 26, 36, 93, 211, 30, 95, 20, 89, 118, 2,
-			96, 89, 156, 197, 73, 27, 187, 109, 22, 205, 221, 240, 131, 61,
-			63, 105, 111, 187, 250, 183, 154, 246, 62, 221, 184, 114, 237, 242,
-			135, 245, 123, 174, 240, 210, 215, 68, 145, 210, 163, 204, 243, 94,
-			1, 5, 182, 160, 236, 35, 255, 126, 142, 12, 218, 214, 61, 3,
-			111, 215, 52, 242, 169, 17, 148, 138, 247, 12, 216, 139, 191, 62,
-			66, 177, 68, 45, 240, 232, 229, 78, 163, 193, 194, 136, 206, 82,
-			142, 107, 42, 162, 117, 39, 118, 56, 187, 214, 154, 142, 191, 131,
-			12, 219, 114, 98, 210, 35, 74, 231, 95, 34, 10, 208, 138, 95,
-			43, 81, 186, 228, 121, 20, 191, 129, 160, 129, 185, 128, 19, 21,
-			228, 84, 116, 113, 110, 174, 206, 118, 153, 23, 180, 89, 24, 73,
-			154, 212, 130, 22, 239, 105, 45, 240, 102, 171, 188, 17, 115, 132,
-			208, 13, 166, 4, 137, 139, 98, 164, 142, 18, 213, 245, 105, 20,
-			116, 194, 26, 231, 247, 170, 235, 59, 97, 23, 219, 21, 21, 185,
-			56, 13, 66, 252, 27, 116, 98, 66, 91, 65, 29, 164, 0, 206,
-			169, 34, 117, 66, 198, 39, 127, 12, 108, 36, 102, 102, 157, 207,
-			83, 96, 176, 70, 224, 121, 193, 30, 48, 41, 12, 169, 203, 5,
-			2, 202, 188, 22, 139, 47, 10, 137, 123, 166, 175, 97, 17, 176,
-			167, 104, 81, 45, 168, 51, 218, 234, 68, 49, 204, 67, 144, 222,
-			128, 213, 169, 6, 187, 12, 165, 56, 82, 133, 192, 218, 224, 214,
-			88, 145, 175, 13, 158, 27, 197, 56, 63, 82, 53, 250, 245, 190,
-			230, 212, 221, 168, 230, 57, 110, 139, 133, 165, 91, 53, 194, 245,
-			211, 180, 144, 141, 104, 135, 65, 189, 83, 99, 73, 59, 72, 210,
-			144, 127, 80, 59, 8, 21, 189, 171, 7, 181, 78, 139, 249, 92,
-			124, 66, 145, 185, 32, 164, 1, 10, 236, 150, 19, 179, 208, 117,
-			188, 40, 33, 181, 92, 239, 82, 171, 8, 74, 59, 209, 169, 53,
-			33, 234, 1, 177, 239, 180, 80, 112, 164, 121, 203, 15, 146, 111,
-			72, 119, 55, 142, 160, 71, 62, 71, 21, 132, 17, 46, 157, 85,
-			166, 196, 22, 243, 235, 65, 8, 18, 40, 132, 70, 180, 130, 152,
-			81, 78, 147, 56, 162, 117, 22, 186, 187, 172, 78, 27, 97, 208,
-			34, 156, 10, 114, 149, 147, 28, 148, 172, 35, 237, 208, 5, 198,
-			10, 129, 119, 252, 212, 18, 2, 90, 195, 214, 195, 149, 77, 186,
-			185, 254, 208, 214, 163, 75, 27, 101, 90, 217, 164, 215, 54, 214,
-			95, 89, 89, 41, 175, 208, 203, 143, 209, 173, 135, 203, 116, 121,
-			253, 218, 99, 27, 149, 43, 15, 111, 209, 135, 215, 87, 87, 202,
-			27, 155, 116, 105, 109, 5, 214, 187, 173, 141, 202, 229, 235, 91,
-			235, 27, 155, 68, 173, 145, 240, 5, 214, 190, 242, 171, 174, 109,
-			148, 55, 113, 97, 172, 92, 189, 182, 90, 41, 175, 164, 150, 203,
-			34, 173, 172, 45, 175, 94, 95, 169, 172, 93, 41, 210, 203, 215,
-			183, 232, 218, 250, 22, 161, 171, 149, 171, 149, 173, 242, 10, 221,
-			90, 47, 98, 181, 251, 203, 193, 194, 122, 181, 188, 177, 252, 240,
-			210, 218, 214, 210, 229, 202, 106, 101, 235, 49, 172, 240, 161, 202,
-			214, 26, 84, 246, 208, 250, 6, 161, 75, 244, 218, 210, 198, 86,
-			101, 249, 250, 234, 210, 6, 189, 118, 125, 227, 218, 250, 102, 153,
-			66, 207, 86, 42, 155, 203, 171, 75, 149, 171, 229, 149, 18, 173,
-			172, 209, 181, 117, 90, 126, 101, 121, 109, 139, 110, 62, 188, 180,
-			186, 218, 219, 81, 66, 215, 31, 93, 43, 111, 136, 101, 93, 117,
-			147, 94, 46, 211, 213, 202, 210, 229, 213, 50, 84, 133, 253, 92,
-			169, 108, 148, 151, 183, 160, 67, 201, 175, 229, 202, 74, 121, 109,
-			107, 105, 181, 72, 232, 230, 181, 242, 114, 101, 105, 181, 72, 203,
-			175, 42, 95, 189, 182, 186, 180, 241, 88, 81, 32, 221, 44, 255,
-			211, 235, 229, 181, 173, 202, 210, 42, 93, 89, 186, 186, 116, 165,
-			188, 73, 167, 95, 136, 42, 215, 54, 214, 151, 175, 111, 148, 175,
-			66, 171, 215, 31, 162, 155, 215, 47, 111, 110, 85, 182, 174, 111,
-			149, 233, 149, 245, 245, 21, 36, 246, 102, 121, 227, 149, 149, 229,
-			242, 230, 37, 186, 186, 190, 137, 4, 187, 190, 89, 46, 18, 186,
-			178, 180, 181, 132, 85, 95, 219, 88, 127, 168, 178, 181, 121, 9,
-			126, 95, 190, 190, 89, 65, 194, 85, 214, 182, 202, 27, 27, 215,
-			175, 129, 250, 50, 67, 31, 94, 127, 180, 252, 202, 242, 6, 93,
-			94, 186, 190, 89, 94, 65, 10, 175, 175, 65, 111, 129, 87, 202,
-			235, 27, 143, 1, 90, 160, 3, 142, 64, 145, 62, 250, 112, 121,
-			235, 225, 242, 6, 16, 21, 169, 181, 4, 100, 216, 220, 218, 168,
-			44, 111, 165, 179, 173, 111, 208, 173, 245, 141, 45, 146, 234, 39,
-			93, 43, 95, 89, 173, 92, 41, 175, 45, 151, 225, 243, 58, 160,
-			121, 180, 178, 89, 158, 161, 75, 27, 149, 77, 200, 80, 193, 138,
-			233, 163, 75, 143, 81, 80, 185, 214, 31, 194, 129, 186, 190, 89,
-			38, 252, 119, 138, 117, 139, 56, 158, 180, 242, 16, 93, 90, 121,
-			101, 5, 90, 46, 114, 95, 91, 223, 220, 172, 8, 118, 65, 178,
-			45, 63, 44, 104, 174, 180, 35, 58, 48, 41, 180, 163, 194, 192,
-			37, 212, 142, 78, 243, 159, 60, 241, 228, 192, 113, 76, 60, 206,
-			127, 242, 196, 83, 3, 21, 169, 71, 193, 79, 158, 120, 122, 160,
-			136, 137, 26, 255, 201, 19, 239, 29, 152, 195, 68, 241, 147, 39,
-			78, 13, 20, 48, 145, 240, 159, 60, 113, 122, 224, 4, 38, 158,
-			226, 63, 255, 239, 187, 81, 169, 201, 252, 176, 6, 75, 95, 254,
-			119, 238, 166, 75, 84, 45, 189, 40, 32, 89, 196, 252, 24, 116,
-			169, 118, 224, 250, 168, 159, 195, 10, 75, 93, 191, 206, 218, 204,
-			175, 51, 31, 197, 162, 227, 119, 121, 250, 205, 192, 71, 105, 226,
-			5, 53, 199, 35, 180, 230, 120, 204, 175, 59, 97, 145, 50, 31,
-			164, 127, 157, 58, 17, 234, 236, 29, 94, 78, 104, 7, 40, 75,
-			27, 161, 83, 75, 86, 12, 249, 1, 22, 4, 80, 21, 16, 134,
-			21, 51, 240, 184, 80, 68, 237, 135, 35, 114, 97, 41, 245, 156,
-			216, 221, 101, 32, 212, 28, 159, 178, 118, 80, 107, 82, 39, 166,
-			215, 183, 150, 105, 203, 173, 251, 40, 209, 3, 159, 208, 71, 28,
-			191, 3, 203, 192, 66, 145, 46, 92, 120, 96, 190, 40, 5, 117,
-			59, 12, 60, 214, 142, 221, 26, 189, 18, 178, 157, 32, 116, 29,
-			95, 181, 158, 238, 53, 221, 90, 147, 178, 167, 99, 6, 109, 66,
-			1, 125, 64, 174, 170, 83, 187, 177, 231, 132, 144, 35, 160, 93,
-			230, 132, 52, 240, 65, 17, 195, 37, 191, 229, 250, 29, 84, 74,
-			67, 70, 239, 159, 87, 253, 243, 2, 127, 167, 68, 87, 153, 211,
-			78, 186, 28, 50, 90, 136, 90, 204, 9, 89, 189, 64, 163, 128,
-			47, 192, 126, 64, 61, 230, 180, 137, 200, 70, 99, 220, 147, 184,
-			17, 245, 25, 3, 186, 130, 174, 140, 170, 72, 27, 214, 86, 190,
-			160, 119, 34, 174, 81, 190, 122, 241, 252, 108, 51, 232, 132, 212,
-			115, 125, 230, 132, 132, 34, 246, 215, 76, 63, 191, 210, 1, 227,
-			57, 135, 57, 103, 80, 138, 55, 25, 13, 81, 203, 129, 45, 91,
-			24, 180, 232, 252, 252, 252, 194, 44, 254, 183, 53, 63, 127, 17,
-			255, 123, 28, 186, 126, 225, 194, 133, 11, 179, 11, 139, 179, 231,
-			22, 182, 22, 207, 93, 188, 239, 194, 197, 251, 46, 148, 46, 200,
-			127, 143, 151, 232, 229, 46, 129, 129, 140, 67, 183, 134, 42, 111,
-			44, 186, 136, 216, 139, 116, 143, 81, 230, 71, 157, 80, 236, 16,
-			246, 24, 173, 1, 149, 3, 127, 151, 133, 49, 31, 95, 190, 40,
-			209, 87, 111, 60, 180, 76, 232, 185, 115, 231, 46, 36, 125, 129,
-			157, 158, 203, 226, 6, 106, 136, 97, 163, 6, 255, 67, 142, 82,
-			252, 116, 60, 3, 26, 27, 163, 80, 179, 191, 131, 27, 218, 147,
-			180, 252, 52, 170, 219, 17, 33, 242, 39, 93, 184, 72, 151, 131,
-			86, 187, 19, 179, 212, 92, 192, 10, 175, 173, 111, 86, 94, 69,
-			159, 4, 202, 76, 207, 60, 89, 18, 42, 79, 146, 73, 41, 159,
-			151, 248, 151, 68, 121, 142, 88, 188, 45, 6, 120, 26, 139, 175,
-			93, 95, 93, 157, 153, 57, 48, 31, 242, 251, 244, 252, 204, 165,
-			84, 155, 22, 95, 168, 77, 59, 44, 6, 44, 65, 163, 238, 116,
-			83, 109, 139, 226, 176, 83, 139, 177, 130, 93, 199, 163, 241, 174,
-			168, 177, 39, 251, 189, 241, 110, 145, 98, 131, 46, 125, 183, 93,
-			218, 45, 197, 187, 0, 61, 95, 143, 120, 166, 78, 196, 106, 244,
-			12, 93, 152, 159, 239, 237, 225, 185, 91, 246, 240, 81, 215, 63,
-			183, 72, 159, 188, 194, 226, 205, 110, 20, 179, 22, 124, 94, 138,
-			30, 114, 61, 182, 213, 59, 16, 15, 85, 86, 203, 91, 149, 171,
-			101, 218, 136, 69, 51, 110, 85, 230, 222, 70, 44, 91, 122, 189,
-			178, 182, 117, 255, 121, 26, 187, 181, 27, 17, 125, 144, 78, 79,
-			79, 243, 148, 153, 70, 92, 170, 239, 61, 236, 238, 52, 87, 156,
-			24, 75, 205, 208, 151, 190, 148, 158, 91, 156, 161, 175, 167, 248,
-			109, 53, 216, 147, 159, 36, 221, 230, 230, 232, 18, 180, 183, 30,
-			236, 69, 136, 18, 38, 203, 194, 252, 124, 74, 134, 69, 37, 149,
-			129, 75, 169, 133, 251, 247, 79, 35, 133, 13, 138, 47, 220, 127,
-			254, 252, 249, 7, 206, 221, 63, 159, 136, 141, 42, 107, 4, 33,
-			163, 215, 125, 247, 105, 137, 229, 194, 3, 243, 253, 88, 74, 223,
-			221, 96, 78, 243, 254, 211, 233, 105, 78, 148, 57, 28, 44, 248,
-			55, 67, 103, 211, 205, 121, 1, 14, 6, 60, 64, 46, 137, 231,
-			116, 10, 15, 50, 192, 76, 15, 3, 156, 191, 37, 3, 60, 226,
-			236, 58, 244, 73, 62, 144, 165, 90, 39, 12, 153, 31, 67, 150,
-			171, 174, 231, 185, 81, 138, 1, 64, 154, 210, 22, 166, 210, 7,
-			233, 173, 11, 60, 15, 155, 211, 7, 147, 212, 146, 207, 246, 46,
-			119, 92, 175, 206, 194, 233, 25, 232, 216, 166, 160, 144, 168, 130,
-			19, 102, 70, 28, 53, 81, 74, 33, 207, 26, 239, 187, 235, 199,
-			208, 115, 145, 147, 119, 93, 116, 27, 41, 48, 83, 170, 2, 102,
-			108, 75, 66, 131, 251, 94, 128, 6, 21, 63, 138, 29, 63, 46,
-			249, 193, 94, 170, 219, 34, 149, 250, 193, 30, 125, 144, 246, 228,
-			121, 222, 158, 38, 13, 127, 225, 46, 251, 193, 94, 105, 135, 197,
-			101, 96, 54, 158, 54, 61, 147, 234, 121, 111, 239, 69, 102, 0,
-			166, 111, 209, 211, 251, 111, 217, 83, 49, 94, 82, 207, 160, 215,
-			186, 113, 147, 111, 36, 122, 24, 45, 61, 80, 211, 51, 253, 92,
-			120, 133, 197, 203, 201, 184, 79, 207, 160, 172, 127, 100, 115, 125,
-			141, 94, 117, 218, 109, 215, 223, 33, 132, 86, 124, 158, 194, 119,
-			237, 69, 84, 3, 82, 116, 234, 182, 113, 169, 235, 81, 92, 248,
-			210, 33, 116, 6, 130, 11, 208, 119, 180, 254, 240, 170, 64, 119,
-			113, 64, 109, 41, 114, 52, 60, 21, 42, 43, 188, 14, 244, 134,
-			103, 102, 95, 215, 10, 252, 184, 249, 204, 236, 235, 234, 78, 247,
-			153, 173, 215, 193, 226, 253, 204, 197, 215, 181, 92, 255, 153, 139,
-			175, 139, 88, 237, 153, 87, 151, 94, 7, 234, 18, 76, 217, 103,
-			94, 243, 120, 129, 208, 189, 38, 11, 25, 229, 165, 1, 145, 227,
-			237, 57, 221, 72, 30, 10, 178, 186, 208, 5, 26, 160, 5, 212,
-			221, 29, 55, 142, 64, 169, 241, 24, 21, 53, 21, 41, 86, 85,
-			36, 148, 87, 86, 164, 88, 91, 17, 23, 91, 172, 18, 245, 146,
-			155, 44, 12, 102, 219, 78, 189, 206, 183, 143, 241, 94, 32, 177,
-			49, 167, 214, 228, 58, 153, 212, 227, 64, 255, 19, 34, 165, 40,
-			52, 40, 88, 200, 119, 2, 218, 105, 163, 154, 32, 139, 78, 187,
-			37, 86, 18, 137, 11, 7, 107, 123, 51, 69, 130, 245, 7, 109,
-			142, 153, 215, 84, 120, 188, 64, 163, 78, 163, 225, 62, 13, 250,
-			168, 91, 195, 83, 63, 24, 69, 224, 3, 212, 68, 167, 11, 215,
-			183, 150, 11, 51, 151, 122, 82, 9, 87, 24, 249, 33, 111, 137,
-			46, 81, 126, 252, 197, 153, 33, 194, 61, 185, 123, 147, 133, 52,
-			106, 6, 29, 175, 46, 73, 217, 137, 24, 106, 147, 211, 78, 164,
-			106, 171, 211, 106, 151, 64, 51, 102, 96, 0, 124, 216, 5, 251,
-			92, 165, 217, 207, 74, 64, 72, 167, 167, 170, 182, 19, 70, 73,
-			53, 85, 70, 40, 234, 116, 160, 225, 212, 240, 48, 188, 26, 196,
-			77, 172, 19, 202, 242, 67, 3, 217, 135, 104, 95, 59, 64, 237,
-			13, 26, 141, 136, 197, 168, 174, 61, 20, 132, 148, 241, 185, 86,
-			164, 133, 197, 249, 133, 7, 96, 117, 88, 184, 111, 107, 126, 225,
-			226, 185, 249, 139, 11, 247, 149, 230, 23, 30, 47, 8, 238, 142,
-			40, 194, 106, 121, 105, 59, 81, 76, 40, 230, 196, 250, 3, 63,
-			209, 155, 239, 43, 82, 192, 86, 18, 19, 200, 217, 117, 54, 107,
-			161, 219, 142, 139, 160, 237, 246, 168, 106, 14, 133, 229, 145, 6,
-			213, 167, 88, 45, 230, 90, 30, 168, 142, 156, 217, 57, 63, 34,
-			251, 131, 184, 170, 59, 97, 157, 208, 87, 199, 65, 101, 115, 125,
-			19, 39, 217, 244, 204, 1, 10, 106, 169, 21, 220, 116, 61, 207,
-			193, 217, 197, 252, 217, 235, 155, 115, 245, 160, 22, 205, 61, 202,
-			170, 115, 73, 83, 230, 54, 88, 131, 133, 204, 175, 177, 185, 43,
-			94, 80, 117, 188, 237, 117, 108, 67, 52, 7, 13, 154, 75, 85,
-			50, 131, 103, 87, 205, 160, 94, 130, 206, 112, 73, 83, 196, 121,
-			206, 155, 68, 159, 4, 141, 17, 136, 94, 146, 63, 158, 148, 29,
-			130, 174, 86, 153, 236, 45, 171, 147, 3, 187, 72, 232, 171, 159,
-			140, 226, 176, 129, 69, 83, 61, 10, 106, 81, 169, 205, 37, 27,
-			244, 101, 113, 206, 115, 171, 161, 19, 118, 81, 237, 46, 53, 227,
-			150, 119, 18, 127, 201, 178, 51, 120, 230, 66, 20, 35, 203, 74,
-			162, 54, 171, 209, 169, 211, 143, 205, 158, 110, 205, 158, 174, 111,
-			157, 126, 248, 226, 233, 171, 23, 79, 111, 150, 78, 55, 30, 159,
-			42, 209, 85, 247, 6, 219, 115, 35, 134, 219, 28, 32, 80, 50,
-			74, 157, 136, 113, 108, 143, 4, 117, 7, 153, 117, 42, 162, 175,
-			126, 178, 178, 185, 46, 149, 154, 135, 184, 176, 170, 11, 112, 122,
-			230, 201, 215, 76, 147, 244, 141, 202, 83, 65, 157, 143, 4, 252,
-			152, 197, 253, 130, 211, 118, 113, 64, 100, 42, 223, 69, 240, 182,
-			206, 237, 199, 141, 253, 148, 21, 156, 94, 92, 57, 189, 184, 66,
-			232, 12, 16, 82, 221, 239, 240, 178, 49, 11, 105, 205, 105, 227,
-			4, 9, 26, 116, 135, 249, 44, 228, 7, 230, 74, 230, 71, 92,
-			44, 43, 250, 227, 217, 249, 48, 63, 125, 55, 127, 88, 203, 142,
-			147, 247, 106, 196, 52, 7, 244, 1, 219, 124, 139, 166, 31, 206,
-			255, 43, 141, 110, 36, 59, 92, 201, 251, 65, 3, 89, 30, 105,
-			28, 185, 126, 45, 173, 101, 145, 131, 213, 44, 122, 181, 19, 197,
-			192, 11, 207, 183, 45, 34, 7, 237, 139, 30, 167, 174, 95, 243,
-			58, 145, 187, 11, 27, 197, 67, 196, 130, 230, 89, 216, 190, 65,
-			9, 106, 0, 102, 199, 36, 104, 0, 104, 79, 144, 63, 225, 157,
-			209, 108, 243, 109, 154, 110, 231, 255, 64, 163, 107, 129, 63, 235,
-			179, 29, 190, 15, 238, 217, 77, 59, 114, 215, 8, 27, 201, 131,
-			119, 211, 107, 162, 160, 218, 96, 238, 58, 94, 135, 69, 252, 72,
-			50, 65, 134, 7, 167, 81, 236, 122, 30, 109, 58, 187, 140, 250,
-			233, 58, 17, 181, 40, 72, 248, 238, 141, 111, 208, 27, 65, 8,
-			27, 99, 121, 122, 208, 79, 48, 177, 105, 44, 138, 255, 201, 1,
-			68, 209, 44, 236, 167, 36, 138, 134, 221, 206, 30, 146, 160, 1,
-			96, 110, 92, 221, 100, 252, 238, 155, 13, 66, 251, 175, 50, 234,
-			44, 66, 241, 16, 132, 183, 186, 203, 184, 74, 198, 97, 139, 176,
-			162, 50, 110, 178, 216, 126, 9, 49, 27, 174, 199, 38, 53, 106,
-			76, 15, 47, 158, 234, 191, 171, 40, 245, 150, 192, 251, 130, 13,
-			44, 81, 248, 130, 73, 38, 14, 248, 106, 219, 196, 244, 157, 22,
-			195, 43, 146, 161, 13, 252, 109, 79, 146, 193, 182, 83, 187, 225,
-			236, 48, 188, 33, 25, 218, 144, 160, 125, 15, 33, 242, 172, 165,
-			214, 157, 52, 168, 49, 61, 180, 145, 74, 177, 207, 146, 241, 118,
-			167, 234, 185, 181, 237, 84, 54, 66, 141, 105, 107, 35, 199, 63,
-			172, 36, 153, 167, 200, 216, 30, 115, 110, 164, 179, 14, 99, 214,
-			81, 72, 78, 101, 92, 38, 35, 45, 22, 69, 206, 14, 219, 134,
-			229, 107, 210, 196, 222, 211, 125, 189, 239, 239, 249, 176, 40, 181,
-			213, 109, 51, 123, 137, 12, 49, 191, 211, 226, 24, 172, 91, 208,
-			175, 236, 119, 90, 253, 88, 178, 80, 76, 160, 24, 20, 23, 134,
-			147, 25, 68, 48, 181, 15, 193, 38, 255, 222, 143, 67, 150, 179,
-			151, 201, 16, 30, 221, 68, 110, 224, 79, 14, 34, 146, 211, 7,
-			140, 34, 243, 234, 253, 40, 146, 114, 246, 253, 100, 144, 43, 31,
-			209, 100, 150, 106, 211, 195, 139, 119, 29, 200, 8, 235, 60, 207,
-			134, 204, 108, 87, 72, 142, 223, 126, 108, 195, 66, 187, 237, 250,
-			141, 96, 114, 8, 17, 28, 223, 223, 17, 204, 184, 28, 212, 89,
-			197, 111, 4, 27, 163, 81, 15, 108, 223, 73, 50, 81, 215, 143,
-			157, 167, 39, 71, 144, 67, 4, 84, 248, 68, 134, 140, 221, 14,
-			139, 93, 34, 86, 3, 122, 57, 169, 127, 39, 52, 224, 101, 122,
-			137, 152, 249, 46, 137, 184, 68, 134, 125, 22, 197, 172, 206, 57,
-			194, 184, 77, 158, 34, 188, 208, 126, 150, 50, 191, 43, 150, 122,
-			21, 25, 83, 77, 218, 198, 67, 39, 193, 155, 115, 47, 212, 146,
-			82, 89, 150, 219, 128, 98, 27, 163, 172, 7, 182, 87, 8, 9,
-			124, 22, 52, 182, 235, 172, 230, 77, 102, 111, 65, 165, 117, 200,
-			178, 143, 74, 1, 79, 173, 121, 246, 133, 132, 213, 6, 111, 193,
-			41, 87, 249, 36, 219, 199, 109, 215, 201, 168, 188, 84, 20, 61,
-			27, 194, 70, 148, 94, 176, 103, 27, 162, 24, 239, 216, 161, 48,
-			13, 218, 39, 137, 74, 216, 70, 182, 34, 40, 133, 70, 100, 226,
-			154, 211, 98, 249, 155, 100, 180, 151, 60, 246, 97, 98, 69, 177,
-			19, 198, 200, 133, 214, 6, 7, 236, 28, 49, 152, 95, 23, 247,
-			192, 240, 211, 254, 39, 73, 135, 13, 236, 240, 189, 251, 71, 180,
-			7, 115, 127, 191, 243, 15, 144, 67, 61, 29, 184, 221, 170, 11,
-			175, 39, 119, 28, 136, 218, 126, 21, 57, 220, 241, 213, 129, 42,
-			171, 111, 243, 170, 38, 255, 223, 193, 91, 240, 220, 245, 116, 110,
-			142, 101, 99, 162, 179, 63, 241, 204, 80, 246, 139, 131, 185, 31,
-			252, 193, 31, 252, 65, 189, 240, 235, 25, 114, 248, 160, 57, 115,
-			224, 244, 189, 147, 100, 252, 78, 171, 202, 66, 36, 146, 181, 33,
-			32, 123, 137, 88, 158, 83, 101, 222, 164, 73, 181, 233, 209, 197,
-			179, 183, 53, 43, 75, 171, 80, 100, 131, 151, 180, 95, 70, 76,
-			33, 162, 1, 195, 153, 219, 195, 0, 115, 105, 3, 203, 217, 199,
-			200, 16, 252, 229, 188, 145, 193, 54, 103, 33, 1, 248, 194, 206,
-			147, 44, 63, 57, 103, 114, 105, 83, 48, 48, 86, 157, 53, 156,
-			142, 23, 111, 163, 218, 128, 12, 63, 180, 49, 34, 18, 95, 9,
-			105, 246, 113, 50, 204, 103, 149, 235, 215, 217, 211, 40, 61, 173,
-			13, 62, 209, 42, 144, 2, 213, 63, 21, 5, 190, 100, 77, 172,
-			2, 18, 176, 250, 7, 250, 5, 247, 221, 7, 119, 111, 223, 92,
-			154, 34, 99, 92, 155, 216, 150, 187, 206, 201, 113, 170, 77, 103,
-			55, 70, 121, 242, 186, 72, 45, 124, 92, 39, 38, 10, 150, 49,
-			50, 188, 245, 216, 181, 242, 246, 202, 250, 245, 203, 171, 229, 156,
-			102, 143, 18, 130, 9, 15, 173, 174, 47, 109, 229, 116, 5, 227,
-			1, 91, 206, 80, 5, 248, 137, 99, 206, 76, 103, 56, 183, 152,
-			179, 236, 28, 25, 225, 8, 42, 175, 42, 175, 220, 127, 62, 151,
-			233, 77, 57, 183, 152, 27, 180, 15, 145, 33, 76, 185, 188, 190,
-			190, 154, 203, 42, 156, 155, 91, 27, 149, 181, 43, 185, 33, 133,
-			243, 202, 198, 250, 245, 107, 57, 162, 48, 92, 45, 111, 110, 46,
-			93, 41, 231, 134, 85, 142, 203, 143, 109, 149, 55, 115, 35, 61,
-			205, 58, 183, 152, 59, 164, 170, 40, 175, 93, 191, 154, 27, 181,
-			199, 201, 33, 94, 133, 108, 196, 88, 95, 210, 253, 231, 115, 185,
-			164, 33, 28, 203, 120, 79, 194, 253, 231, 115, 118, 97, 153, 88,
-			200, 134, 182, 77, 70, 87, 151, 46, 151, 87, 183, 215, 241, 134,
-			112, 105, 53, 167, 37, 105, 27, 229, 127, 122, 189, 178, 81, 94,
-			201, 233, 233, 180, 107, 229, 165, 173, 242, 74, 206, 40, 212, 200,
-			225, 131, 4, 234, 129, 83, 40, 197, 11, 250, 45, 120, 1, 113,
-			245, 243, 66, 225, 143, 117, 50, 113, 192, 162, 114, 96, 37, 47,
-			39, 22, 231, 101, 190, 204, 206, 28, 184, 58, 33, 103, 239, 91,
-			106, 177, 92, 90, 213, 48, 110, 161, 106, 0, 138, 125, 12, 251,
-			154, 125, 194, 159, 175, 143, 247, 223, 206, 250, 136, 105, 223, 217,
-			34, 96, 29, 176, 8, 92, 34, 227, 251, 16, 221, 182, 48, 126,
-			163, 70, 38, 111, 69, 156, 23, 16, 137, 122, 143, 72, 188, 212,
-			79, 193, 19, 183, 30, 132, 125, 99, 253, 179, 26, 185, 243, 96,
-			149, 242, 192, 54, 188, 140, 100, 248, 17, 130, 24, 239, 253, 107,
-			215, 85, 252, 220, 63, 216, 162, 84, 122, 181, 55, 110, 165, 23,
-			242, 214, 236, 107, 233, 155, 117, 114, 199, 129, 200, 15, 108, 232,
-			221, 132, 184, 126, 187, 19, 115, 213, 137, 75, 226, 33, 76, 65,
-			225, 5, 82, 182, 19, 171, 239, 6, 126, 39, 60, 9, 51, 188,
-			36, 105, 168, 137, 13, 189, 231, 22, 61, 221, 199, 152, 243, 36,
-			87, 243, 92, 230, 199, 219, 81, 28, 50, 167, 229, 250, 59, 184,
-			212, 100, 47, 90, 13, 199, 139, 216, 198, 24, 255, 188, 41, 191,
-			66, 9, 100, 160, 48, 85, 34, 211, 83, 130, 127, 86, 37, 10,
-			111, 27, 34, 195, 41, 5, 220, 62, 65, 70, 158, 114, 118, 157,
-			109, 185, 169, 226, 148, 24, 134, 180, 107, 98, 99, 53, 79, 14,
-			99, 150, 160, 19, 179, 112, 187, 230, 57, 81, 132, 68, 203, 98,
-			86, 27, 190, 173, 195, 167, 101, 249, 197, 190, 143, 76, 96, 137,
-			86, 199, 139, 221, 182, 199, 182, 97, 155, 23, 225, 146, 163, 90,
-			54, 14, 57, 174, 138, 12, 208, 162, 200, 94, 33, 119, 99, 49,
-			113, 106, 193, 182, 217, 107, 59, 142, 23, 109, 59, 126, 125, 187,
-			233, 68, 205, 201, 195, 128, 224, 178, 62, 169, 109, 28, 133, 140,
-			87, 68, 190, 50, 102, 91, 242, 235, 15, 59, 81, 211, 190, 72,
-			238, 68, 44, 252, 12, 122, 187, 214, 100, 181, 27, 219, 157, 184,
-			241, 146, 201, 99, 233, 250, 177, 133, 252, 116, 107, 25, 178, 92,
-			143, 27, 47, 177, 55, 201, 8, 12, 70, 203, 189, 201, 182, 27,
-			65, 136, 107, 232, 232, 1, 162, 41, 69, 193, 210, 186, 40, 112,
-			53, 168, 179, 139, 214, 230, 181, 114, 121, 101, 99, 88, 98, 121,
-			40, 8, 129, 161, 118, 2, 69, 224, 97, 206, 80, 59, 129, 36,
-			239, 125, 100, 162, 86, 227, 125, 118, 107, 219, 98, 51, 22, 77,
-			230, 122, 136, 85, 171, 93, 225, 25, 4, 143, 71, 246, 5, 114,
-			71, 66, 172, 116, 193, 241, 125, 189, 236, 47, 122, 31, 153, 104,
-			119, 247, 23, 180, 123, 106, 108, 119, 251, 139, 61, 64, 14, 183,
-			155, 237, 253, 229, 206, 164, 203, 217, 237, 102, 187, 191, 224, 105,
-			220, 153, 135, 12, 143, 96, 39, 143, 164, 179, 167, 62, 216, 37,
-			146, 171, 213, 182, 153, 239, 84, 61, 182, 237, 132, 204, 119, 162,
-			201, 227, 152, 217, 140, 195, 14, 219, 24, 173, 213, 202, 248, 113,
-			9, 191, 217, 103, 200, 120, 80, 125, 170, 198, 57, 114, 187, 29,
-			178, 134, 251, 244, 228, 41, 36, 239, 24, 124, 64, 126, 188, 134,
-			201, 246, 12, 201, 213, 162, 166, 19, 182, 81, 36, 71, 109, 167,
-			198, 38, 79, 243, 172, 60, 125, 77, 38, 195, 140, 136, 246, 220,
-			70, 44, 49, 78, 241, 25, 129, 105, 2, 219, 52, 201, 1, 37,
-			122, 42, 158, 198, 108, 163, 237, 102, 59, 93, 239, 73, 114, 8,
-			114, 38, 149, 206, 112, 197, 173, 221, 76, 213, 120, 158, 220, 9,
-			153, 90, 44, 118, 234, 78, 236, 164, 114, 23, 49, 55, 144, 253,
-			170, 248, 216, 211, 206, 176, 83, 237, 42, 198, 154, 229, 237, 132,
-			52, 201, 90, 47, 154, 114, 94, 184, 72, 70, 210, 124, 111, 15,
-			17, 206, 249, 57, 13, 148, 160, 229, 245, 21, 80, 95, 30, 47,
-			231, 116, 80, 163, 86, 43, 91, 229, 237, 141, 235, 107, 91, 149,
-			171, 229, 156, 145, 82, 236, 31, 49, 179, 247, 230, 166, 64, 107,
-			24, 237, 221, 169, 217, 47, 37, 71, 228, 177, 74, 196, 226, 237,
-			61, 55, 196, 9, 217, 114, 248, 226, 168, 248, 231, 176, 200, 181,
-			201, 226, 71, 221, 80, 28, 151, 218, 171, 228, 184, 31, 108, 203,
-			195, 233, 237, 228, 64, 107, 219, 169, 213, 88, 20, 5, 124, 33,
-			84, 88, 238, 242, 131, 77, 145, 57, 89, 33, 150, 68, 214, 62,
-			246, 53, 110, 197, 190, 199, 200, 80, 203, 105, 111, 51, 63, 14,
-			187, 168, 159, 103, 55, 178, 45, 167, 93, 6, 248, 31, 101, 155,
-			244, 136, 153, 53, 115, 214, 35, 102, 214, 202, 101, 30, 49, 179,
-			153, 220, 224, 35, 102, 54, 155, 27, 122, 196, 204, 14, 229, 72,
-			225, 237, 38, 25, 73, 107, 240, 176, 33, 170, 225, 26, 166, 161,
-			148, 59, 249, 188, 250, 126, 105, 25, 22, 183, 139, 25, 174, 46,
-			111, 240, 146, 160, 88, 0, 251, 49, 174, 158, 100, 55, 4, 100,
-			95, 33, 153, 167, 34, 196, 157, 65, 220, 7, 157, 6, 166, 112,
-			63, 178, 137, 200, 135, 30, 217, 220, 94, 91, 223, 184, 186, 180,
-			186, 33, 138, 219, 71, 137, 233, 57, 55, 187, 189, 203, 32, 38,
-			217, 37, 50, 214, 241, 119, 89, 232, 54, 92, 86, 223, 198, 92,
-			99, 233, 92, 163, 201, 215, 85, 200, 127, 155, 195, 120, 148, 152,
-			123, 204, 185, 209, 187, 88, 97, 210, 139, 56, 157, 230, 136, 133,
-			244, 181, 9, 17, 20, 206, 13, 216, 89, 98, 46, 175, 111, 192,
-			148, 202, 145, 17, 158, 186, 125, 173, 82, 94, 46, 231, 244, 194,
-			125, 36, 195, 137, 6, 211, 77, 145, 45, 55, 32, 64, 129, 67,
-			147, 95, 175, 95, 189, 92, 222, 200, 233, 251, 152, 165, 16, 145,
-			145, 180, 38, 255, 143, 179, 157, 255, 223, 52, 50, 156, 210, 204,
-			65, 165, 114, 60, 47, 216, 219, 118, 60, 215, 137, 4, 43, 17,
-			76, 90, 130, 148, 219, 29, 186, 127, 164, 73, 102, 229, 50, 133,
-			159, 208, 72, 174, 95, 53, 238, 107, 166, 246, 63, 178, 153, 133,
-			247, 104, 100, 180, 87, 31, 238, 107, 222, 137, 255, 161, 205, 251,
-			127, 116, 114, 168, 71, 11, 190, 221, 214, 189, 150, 140, 187, 117,
-			214, 106, 7, 49, 243, 107, 221, 109, 143, 237, 50, 111, 178, 128,
-			66, 102, 255, 177, 100, 79, 13, 165, 74, 82, 110, 21, 138, 93,
-			156, 168, 172, 148, 175, 94, 91, 223, 42, 175, 45, 63, 182, 125,
-			125, 237, 21, 107, 235, 143, 174, 109, 228, 220, 190, 108, 47, 226,
-			180, 191, 70, 114, 253, 141, 178, 143, 144, 131, 154, 149, 27, 176,
-			39, 200, 216, 218, 250, 246, 102, 101, 165, 188, 93, 126, 232, 161,
-			242, 242, 214, 38, 63, 57, 81, 185, 183, 122, 38, 120, 225, 221,
-			6, 153, 56, 160, 37, 246, 146, 216, 243, 240, 109, 216, 236, 237,
-			180, 190, 4, 90, 199, 53, 39, 140, 197, 22, 105, 134, 0, 149,
-			252, 24, 132, 107, 40, 78, 164, 248, 70, 104, 44, 73, 231, 135,
-			82, 69, 98, 183, 131, 200, 141, 221, 93, 182, 237, 250, 242, 248,
-			10, 54, 70, 230, 70, 78, 126, 169, 248, 177, 202, 45, 111, 201,
-			82, 185, 45, 116, 145, 201, 201, 47, 42, 247, 9, 50, 82, 15,
-			58, 160, 45, 242, 124, 176, 214, 104, 27, 195, 60, 77, 101, 17,
-			251, 128, 228, 220, 108, 100, 99, 152, 167, 241, 44, 83, 100, 204,
-			217, 217, 9, 1, 185, 68, 196, 119, 54, 163, 42, 25, 51, 230,
-			31, 33, 89, 73, 7, 88, 236, 129, 18, 219, 109, 190, 93, 215,
-			167, 135, 54, 178, 190, 252, 120, 130, 140, 184, 209, 118, 114, 13,
-			160, 83, 125, 58, 187, 49, 236, 70, 234, 8, 181, 240, 179, 58,
-			25, 237, 189, 198, 176, 87, 72, 214, 11, 184, 143, 137, 184, 67,
-			155, 126, 129, 155, 143, 210, 170, 200, 191, 161, 74, 230, 127, 91,
-			35, 89, 153, 108, 223, 73, 204, 182, 19, 55, 17, 157, 117, 89,
-			207, 105, 27, 8, 67, 122, 212, 118, 124, 100, 1, 145, 14, 48,
-			140, 171, 199, 156, 58, 110, 155, 130, 86, 139, 249, 113, 36, 199,
-			85, 164, 47, 139, 100, 251, 44, 25, 143, 67, 199, 245, 122, 242,
-			154, 152, 55, 39, 63, 168, 204, 23, 201, 81, 137, 183, 206, 98,
-			167, 214, 100, 245, 164, 80, 6, 143, 71, 142, 136, 12, 43, 226,
-			187, 44, 91, 248, 140, 70, 198, 229, 70, 175, 174, 136, 117, 149,
-			16, 199, 247, 131, 56, 77, 174, 253, 172, 188, 175, 92, 105, 73,
-			21, 218, 72, 33, 200, 183, 8, 73, 190, 220, 146, 108, 199, 201,
-			176, 184, 163, 194, 139, 78, 126, 52, 64, 120, 18, 236, 8, 237,
-			195, 196, 170, 178, 29, 215, 23, 39, 207, 28, 144, 7, 56, 166,
-			58, 192, 185, 252, 207, 14, 246, 230, 202, 245, 29, 79, 68, 15,
-			107, 143, 207, 190, 160, 63, 87, 162, 221, 246, 184, 114, 229, 251,
-			93, 185, 54, 88, 195, 99, 120, 61, 253, 200, 127, 250, 180, 78,
-			6, 109, 107, 106, 224, 93, 131, 26, 249, 240, 24, 250, 113, 77,
-			125, 223, 143, 235, 251, 126, 92, 223, 247, 227, 250, 190, 31, 215,
-			247, 253, 184, 190, 239, 199, 245, 221, 251, 113, 45, 254, 39, 93,
-			68, 66, 184, 72, 111, 48, 63, 14, 252, 127, 146, 8, 118, 58,
-			253, 10, 76, 162, 175, 116, 194, 186, 51, 67, 40, 189, 236, 68,
-			220, 189, 63, 8, 221, 29, 215, 119, 188, 253, 11, 80, 157, 69,
-			238, 142, 143, 22, 164, 116, 211, 241, 159, 114, 186, 244, 74, 147,
-			181, 156, 61, 39, 46, 210, 71, 88, 163, 65, 87, 152, 227, 23,
-			19, 227, 207, 72, 186, 225, 136, 179, 161, 148, 193, 155, 235, 49,
-			202, 215, 203, 42, 151, 130, 117, 214, 112, 125, 33, 224, 26, 65,
-			199, 71, 219, 43, 190, 34, 99, 238, 168, 4, 19, 96, 215, 241,
-			220, 122, 58, 89, 26, 53, 198, 161, 227, 71, 30, 90, 150, 214,
-			221, 144, 213, 98, 175, 139, 118, 169, 244, 0, 115, 38, 162, 164,
-			136, 227, 119, 133, 76, 116, 125, 190, 132, 130, 176, 156, 102, 165,
-			157, 146, 202, 19, 114, 117, 8, 68, 26, 117, 91, 237, 32, 140,
-			163, 25, 229, 37, 55, 163, 188, 228, 206, 14, 172, 72, 223, 55,
-			248, 201, 19, 139, 137, 239, 91, 81, 249, 190, 205, 14, 44, 72,
-			223, 55, 248, 201, 19, 75, 3, 15, 72, 39, 59, 248, 201, 19,
-			231, 18, 223, 55, 248, 201, 19, 231, 19, 207, 187, 121, 229, 121,
-			119, 126, 224, 46, 242, 3, 68, 207, 14, 225, 207, 124, 76, 251,
-			77, 199, 248, 194, 83, 229, 6, 201, 45, 247, 166, 112, 192, 138,
-			218, 140, 213, 105, 149, 213, 28, 88, 194, 67, 165, 152, 204, 86,
-			129, 29, 8, 117, 188, 157, 32, 116, 227, 102, 43, 162, 245, 192,
-			159, 138, 233, 94, 16, 222, 160, 245, 14, 26, 149, 87, 131, 32,
-			142, 226, 144, 155, 167, 151, 8, 121, 10, 93, 243, 204, 151, 12,
-			92, 212, 242, 79, 224, 184, 75, 245, 1, 67, 100, 184, 30, 154,
-			68, 250, 148, 95, 209, 236, 27, 155, 77, 22, 227, 146, 225, 184,
-			190, 180, 72, 230, 109, 39, 156, 1, 168, 27, 115, 11, 228, 40,
-			21, 164, 224, 37, 217, 163, 100, 88, 24, 73, 26, 23, 244, 105,
-			12, 20, 48, 160, 15, 152, 0, 17, 9, 101, 108, 227, 194, 240,
-			61, 18, 210, 108, 227, 194, 241, 147, 18, 50, 108, 227, 194, 189,
-			83, 100, 142, 71, 58, 120, 112, 224, 113, 45, 127, 146, 174, 8,
-			214, 228, 190, 127, 173, 182, 199, 98, 150, 102, 187, 84, 152, 131,
-			7, 179, 199, 200, 5, 25, 230, 224, 101, 250, 177, 66, 145, 51,
-			38, 172, 133, 197, 30, 55, 191, 48, 8, 226, 148, 82, 18, 135,
-			140, 169, 160, 7, 38, 148, 77, 135, 64, 120, 217, 240, 120, 42,
-			4, 194, 203, 236, 59, 83, 33, 16, 94, 118, 52, 79, 206, 96,
-			149, 154, 109, 188, 92, 191, 167, 112, 55, 69, 150, 45, 52, 130,
-			160, 80, 196, 63, 165, 170, 19, 22, 138, 148, 197, 53, 21, 88,
-			65, 51, 33, 179, 130, 44, 219, 120, 185, 170, 3, 58, 242, 114,
-			251, 168, 132, 12, 219, 120, 249, 93, 119, 147, 243, 88, 135, 110,
-			27, 151, 245, 19, 249, 41, 186, 38, 87, 119, 49, 28, 56, 25,
-			184, 41, 183, 154, 212, 170, 54, 221, 132, 98, 10, 178, 108, 227,
-			178, 170, 13, 154, 125, 217, 190, 75, 66, 134, 109, 92, 62, 78,
-			201, 63, 197, 218, 12, 219, 88, 209, 167, 243, 43, 20, 45, 37,
-			120, 125, 232, 238, 136, 134, 129, 73, 165, 162, 13, 66, 217, 81,
-			102, 128, 92, 95, 66, 149, 74, 53, 197, 48, 1, 167, 130, 44,
-			219, 88, 25, 150, 17, 37, 12, 205, 54, 86, 198, 11, 18, 130,
-			218, 79, 79, 145, 155, 216, 20, 211, 54, 174, 232, 247, 230, 91,
-			253, 77, 217, 99, 206, 141, 219, 107, 72, 137, 155, 181, 115, 85,
-			105, 22, 21, 118, 144, 172, 45, 87, 132, 5, 161, 129, 239, 117,
-			75, 116, 37, 144, 241, 101, 84, 155, 77, 172, 92, 65, 150, 109,
-			92, 81, 109, 54, 53, 219, 184, 50, 78, 37, 100, 216, 198, 149,
-			147, 167, 201, 253, 216, 102, 203, 54, 30, 209, 139, 249, 25, 212,
-			246, 227, 160, 61, 139, 231, 50, 61, 210, 53, 45, 131, 85, 125,
-			150, 9, 5, 21, 148, 177, 141, 71, 134, 243, 18, 210, 108, 227,
-			145, 99, 83, 18, 50, 108, 227, 145, 51, 103, 113, 214, 105, 122,
-			198, 54, 94, 161, 207, 138, 79, 25, 19, 32, 137, 36, 3, 223,
-			196, 172, 211, 244, 140, 102, 27, 175, 56, 62, 45, 33, 195, 54,
-			94, 113, 182, 40, 144, 12, 218, 198, 170, 94, 18, 159, 6, 77,
-			128, 36, 146, 193, 140, 109, 172, 14, 159, 144, 144, 102, 27, 171,
-			133, 25, 9, 25, 182, 177, 90, 156, 21, 72, 178, 182, 113, 85,
-			33, 201, 154, 0, 73, 36, 217, 140, 109, 92, 29, 62, 46, 33,
-			205, 54, 174, 82, 137, 36, 107, 216, 198, 85, 133, 100, 200, 54,
-			214, 245, 147, 226, 211, 144, 9, 144, 68, 50, 148, 177, 141, 245,
-			97, 57, 13, 135, 52, 219, 88, 63, 34, 59, 55, 100, 216, 198,
-			250, 137, 2, 249, 111, 26, 98, 33, 182, 113, 93, 159, 203, 255,
-			87, 141, 110, 113, 66, 51, 175, 46, 69, 91, 164, 92, 66, 122,
-			214, 28, 167, 10, 107, 13, 176, 144, 90, 127, 83, 123, 151, 84,
-			24, 161, 200, 105, 48, 175, 75, 67, 214, 130, 221, 11, 14, 36,
-			243, 99, 55, 100, 162, 26, 185, 108, 53, 157, 176, 5, 98, 52,
-			236, 248, 177, 219, 98, 132, 54, 58, 190, 240, 114, 113, 99, 21,
-			127, 40, 89, 38, 34, 58, 59, 139, 73, 233, 86, 37, 222, 186,
-			192, 168, 184, 242, 139, 125, 98, 11, 29, 158, 130, 192, 139, 20,
-			11, 17, 19, 186, 173, 160, 140, 109, 92, 31, 150, 18, 133, 104,
-			182, 113, 61, 127, 70, 66, 134, 109, 92, 159, 45, 145, 215, 32,
-			181, 134, 109, 227, 49, 253, 158, 252, 53, 92, 49, 184, 133, 168,
-			154, 244, 41, 129, 203, 63, 119, 218, 98, 218, 9, 107, 111, 244,
-			61, 198, 108, 139, 5, 84, 60, 56, 112, 174, 160, 154, 53, 108,
-			2, 126, 5, 89, 182, 241, 152, 18, 68, 195, 154, 109, 60, 102,
-			79, 74, 200, 176, 141, 199, 142, 221, 77, 166, 136, 110, 234, 182,
-			249, 154, 129, 103, 180, 252, 177, 158, 165, 64, 232, 49, 232, 31,
-			35, 150, 0, 144, 101, 175, 201, 30, 65, 254, 209, 97, 9, 120,
-			66, 63, 134, 248, 116, 20, 234, 79, 136, 154, 117, 20, 234, 79,
-			136, 154, 117, 20, 234, 79, 8, 161, 174, 163, 80, 127, 226, 104,
-			94, 32, 209, 108, 227, 73, 253, 140, 248, 4, 82, 251, 73, 133,
-			68, 203, 216, 198, 147, 130, 147, 117, 148, 218, 79, 210, 211, 18,
-			50, 108, 227, 201, 233, 25, 129, 68, 183, 13, 71, 76, 7, 29,
-			133, 177, 163, 144, 192, 164, 117, 20, 18, 168, 206, 17, 211, 65,
-			71, 97, 236, 136, 233, 128, 64, 77, 63, 43, 62, 129, 24, 173,
-			41, 36, 70, 198, 54, 106, 66, 68, 232, 40, 70, 107, 199, 238,
-			149, 16, 148, 155, 57, 35, 144, 152, 182, 81, 23, 34, 66, 71,
-			185, 86, 87, 72, 204, 140, 109, 212, 133, 136, 208, 81, 174, 213,
-			133, 136, 208, 81, 174, 213, 207, 22, 201, 8, 32, 49, 6, 108,
-			179, 161, 223, 48, 248, 55, 3, 168, 215, 32, 147, 228, 24, 201,
-			0, 4, 100, 223, 49, 239, 46, 140, 192, 110, 84, 217, 225, 143,
-			146, 65, 254, 209, 132, 175, 35, 9, 108, 217, 198, 206, 33, 59,
-			129, 53, 219, 216, 153, 152, 76, 96, 195, 54, 118, 142, 221, 165,
-			144, 107, 182, 209, 52, 143, 21, 70, 104, 249, 233, 253, 200, 97,
-			120, 154, 41, 228, 176, 172, 54, 83, 200, 97, 136, 154, 19, 119,
-			38, 176, 97, 27, 205, 163, 121, 114, 72, 32, 215, 109, 227, 41,
-			115, 78, 125, 6, 98, 61, 149, 66, 7, 67, 245, 212, 161, 66,
-			2, 107, 182, 241, 212, 201, 51, 9, 108, 216, 198, 83, 179, 37,
-			65, 105, 203, 54, 60, 53, 230, 32, 209, 61, 69, 105, 144, 232,
-			158, 152, 142, 58, 74, 116, 47, 47, 199, 28, 36, 186, 167, 198,
-			60, 99, 27, 190, 62, 39, 62, 129, 68, 247, 21, 18, 144, 232,
-			190, 98, 28, 144, 232, 62, 149, 124, 10, 18, 221, 87, 45, 25,
-			180, 141, 182, 46, 217, 1, 36, 122, 91, 33, 1, 137, 222, 86,
-			45, 1, 137, 222, 206, 159, 144, 144, 97, 27, 237, 83, 167, 201,
-			135, 53, 28, 116, 205, 54, 59, 250, 211, 70, 254, 199, 53, 138,
-			118, 89, 32, 22, 228, 209, 21, 141, 157, 29, 202, 173, 167, 162,
-			18, 221, 56, 32, 85, 197, 109, 147, 199, 14, 32, 190, 80, 72,
-			98, 204, 49, 117, 42, 204, 221, 248, 213, 18, 30, 57, 45, 181,
-			97, 73, 33, 22, 153, 90, 78, 23, 15, 138, 104, 176, 203, 66,
-			207, 105, 11, 49, 163, 27, 48, 208, 29, 114, 68, 112, 13, 42,
-			131, 187, 183, 96, 73, 174, 238, 237, 170, 97, 230, 10, 223, 174,
-			226, 26, 174, 242, 237, 42, 150, 228, 74, 223, 174, 98, 73, 84,
-			251, 246, 110, 193, 146, 92, 207, 219, 75, 33, 7, 150, 220, 75,
-			33, 135, 150, 238, 41, 150, 228, 218, 222, 158, 18, 62, 89, 219,
-			232, 234, 69, 49, 30, 176, 140, 118, 213, 200, 193, 50, 218, 29,
-			158, 148, 144, 102, 27, 221, 163, 83, 18, 50, 108, 163, 123, 230,
-			44, 121, 3, 142, 28, 172, 163, 175, 215, 79, 231, 59, 9, 253,
-			248, 234, 132, 7, 68, 210, 185, 114, 255, 248, 200, 225, 57, 104,
-			40, 96, 251, 183, 227, 238, 50, 159, 159, 64, 97, 72, 61, 92,
-			148, 88, 194, 19, 129, 95, 147, 42, 141, 142, 203, 247, 235, 85,
-			227, 135, 44, 219, 120, 189, 18, 191, 176, 124, 191, 222, 150, 156,
-			12, 203, 247, 235, 11, 167, 200, 48, 1, 169, 99, 253, 192, 192,
-			15, 105, 26, 10, 119, 16, 107, 63, 144, 189, 155, 172, 19, 211,
-			52, 244, 1, 219, 252, 231, 154, 126, 49, 191, 196, 247, 54, 194,
-			25, 50, 14, 66, 38, 23, 117, 220, 163, 212, 3, 22, 193, 86,
-			41, 100, 181, 96, 199, 119, 111, 50, 218, 100, 33, 43, 97, 104,
-			59, 169, 152, 30, 34, 22, 32, 52, 17, 163, 2, 51, 0, 14,
-			223, 35, 65, 13, 192, 227, 231, 36, 104, 0, 120, 255, 5, 242,
-			56, 52, 204, 178, 205, 55, 105, 250, 209, 252, 85, 186, 140, 150,
-			104, 17, 238, 172, 80, 205, 99, 180, 214, 137, 226, 160, 149, 180,
-			201, 79, 152, 93, 40, 177, 110, 148, 176, 120, 186, 93, 32, 108,
-			13, 107, 0, 144, 31, 154, 228, 21, 91, 208, 142, 55, 105, 135,
-			198, 37, 168, 3, 120, 199, 36, 57, 71, 64, 154, 103, 222, 172,
-			13, 252, 133, 166, 229, 79, 247, 44, 148, 137, 46, 130, 46, 113,
-			106, 12, 209, 189, 13, 246, 89, 111, 214, 178, 119, 145, 81, 98,
-			154, 166, 57, 96, 103, 126, 68, 211, 63, 164, 25, 88, 129, 137,
-			222, 111, 63, 162, 13, 14, 147, 77, 146, 49, 133, 251, 219, 143,
-			106, 230, 225, 252, 50, 157, 231, 142, 176, 146, 165, 96, 22, 135,
-			33, 134, 122, 164, 235, 97, 29, 182, 241, 17, 221, 99, 110, 200,
-			191, 53, 93, 24, 28, 183, 230, 120, 176, 137, 143, 2, 31, 116,
-			149, 49, 50, 200, 145, 106, 136, 117, 44, 73, 208, 33, 193, 158,
-			32, 163, 162, 90, 205, 54, 255, 149, 102, 78, 168, 12, 26, 79,
-			24, 77, 18, 116, 72, 24, 183, 201, 158, 40, 161, 219, 230, 219,
-			52, 115, 34, 191, 67, 215, 130, 152, 62, 238, 238, 60, 238, 236,
-			72, 119, 236, 18, 85, 30, 107, 74, 64, 197, 206, 13, 70, 23,
-			230, 105, 181, 27, 179, 168, 68, 233, 245, 136, 209, 148, 193, 49,
-			117, 27, 132, 42, 95, 181, 148, 194, 227, 185, 55, 152, 215, 77,
-			117, 134, 59, 213, 165, 154, 198, 155, 50, 110, 171, 206, 24, 182,
-			249, 118, 205, 60, 172, 50, 128, 108, 125, 123, 186, 251, 134, 14,
-			9, 246, 132, 234, 140, 105, 155, 239, 248, 158, 117, 230, 220, 226,
-			237, 119, 6, 216, 227, 29, 233, 206, 128, 50, 246, 142, 116, 103,
-			44, 219, 252, 55, 154, 121, 135, 202, 96, 105, 152, 144, 75, 18,
-			116, 72, 152, 56, 172, 74, 100, 108, 243, 157, 233, 18, 25, 13,
-			19, 146, 18, 25, 29, 18, 82, 37, 6, 109, 243, 93, 154, 105,
-			171, 12, 131, 26, 38, 28, 74, 18, 116, 72, 200, 141, 171, 18,
-			89, 219, 252, 159, 210, 36, 206, 106, 152, 144, 144, 56, 171, 67,
-			130, 61, 65, 62, 175, 137, 34, 67, 182, 249, 19, 192, 217, 159,
-			209, 232, 150, 179, 51, 91, 103, 24, 185, 146, 213, 169, 186, 240,
-			44, 17, 122, 37, 12, 58, 137, 119, 127, 114, 3, 143, 234, 46,
-			200, 207, 68, 41, 118, 125, 225, 175, 93, 162, 15, 7, 123, 108,
-			151, 133, 69, 126, 140, 119, 142, 96, 140, 77, 166, 110, 4, 34,
-			233, 197, 205, 93, 35, 171, 76, 185, 114, 163, 92, 67, 241, 187,
-			131, 21, 239, 225, 222, 130, 251, 238, 98, 116, 205, 56, 100, 78,
-			44, 62, 10, 121, 237, 68, 180, 227, 99, 56, 67, 145, 146, 26,
-			206, 33, 13, 59, 153, 12, 231, 144, 14, 9, 227, 19, 100, 86,
-			80, 129, 216, 230, 115, 154, 121, 103, 225, 110, 186, 202, 252, 157,
-			184, 121, 48, 29, 84, 121, 162, 97, 254, 100, 236, 136, 14, 9,
-			19, 119, 144, 147, 2, 225, 176, 109, 190, 15, 200, 58, 65, 215,
-			216, 30, 16, 101, 87, 197, 120, 77, 208, 12, 107, 152, 43, 105,
-			215, 176, 14, 9, 227, 137, 0, 24, 177, 205, 159, 78, 51, 205,
-			136, 134, 9, 201, 128, 142, 232, 144, 96, 39, 76, 115, 200, 54,
-			223, 159, 22, 25, 135, 52, 76, 72, 152, 230, 144, 14, 9, 185,
-			132, 149, 71, 109, 243, 3, 154, 121, 68, 101, 24, 213, 48, 97,
-			60, 73, 208, 33, 225, 240, 157, 170, 196, 152, 109, 254, 76, 186,
-			196, 152, 134, 9, 73, 137, 49, 29, 18, 14, 223, 73, 166, 68,
-			137, 156, 109, 254, 172, 102, 222, 81, 56, 2, 115, 50, 234, 153,
-			202, 252, 224, 78, 150, 204, 105, 152, 51, 233, 96, 78, 135, 4,
-			251, 176, 66, 53, 110, 155, 31, 188, 45, 84, 227, 26, 230, 76,
-			80, 141, 235, 144, 128, 180, 2, 161, 175, 217, 153, 159, 211, 244,
-			95, 80, 66, 31, 132, 235, 207, 105, 131, 35, 228, 12, 214, 4,
-			250, 147, 249, 63, 107, 230, 145, 124, 254, 150, 66, 95, 86, 134,
-			234, 18, 100, 182, 147, 4, 29, 18, 238, 144, 68, 3, 133, 201,
-			252, 249, 132, 104, 168, 3, 65, 66, 82, 2, 100, 249, 207, 167,
-			75, 232, 182, 249, 145, 116, 9, 64, 241, 145, 116, 9, 158, 227,
-			142, 59, 113, 205, 52, 161, 189, 191, 168, 233, 199, 120, 119, 112,
-			101, 255, 69, 185, 178, 155, 232, 33, 253, 139, 218, 240, 184, 4,
-			53, 0, 237, 59, 37, 104, 0, 120, 52, 47, 48, 105, 182, 249,
-			81, 77, 191, 75, 124, 212, 76, 4, 37, 38, 205, 2, 112, 56,
-			39, 65, 204, 60, 126, 68, 130, 6, 128, 249, 99, 2, 147, 110,
-			155, 31, 75, 218, 4, 18, 253, 99, 9, 38, 144, 137, 31, 75,
-			48, 65, 181, 31, 211, 198, 101, 155, 96, 193, 248, 24, 180, 233,
-			61, 26, 162, 50, 108, 243, 151, 65, 223, 248, 81, 141, 86, 26,
-			84, 249, 78, 193, 208, 68, 44, 22, 119, 149, 62, 99, 117, 169,
-			209, 69, 44, 46, 81, 200, 139, 49, 33, 240, 51, 222, 91, 202,
-			146, 60, 60, 70, 82, 86, 157, 79, 251, 168, 230, 43, 239, 157,
-			34, 77, 251, 254, 128, 226, 158, 248, 6, 149, 100, 95, 12, 19,
-			155, 167, 192, 12, 128, 195, 99, 18, 212, 0, 204, 29, 150, 32,
-			246, 229, 200, 36, 249, 95, 117, 236, 154, 105, 155, 191, 166, 233,
-			52, 255, 65, 29, 15, 235, 228, 30, 31, 90, 203, 252, 78, 11,
-			155, 28, 137, 86, 186, 81, 207, 29, 40, 198, 54, 232, 182, 25,
-			239, 169, 252, 130, 1, 34, 194, 88, 184, 162, 59, 116, 170, 52,
-			85, 4, 197, 208, 141, 104, 163, 227, 121, 221, 217, 215, 118, 28,
-			15, 205, 33, 75, 148, 174, 199, 77, 22, 242, 136, 7, 203, 103,
-			207, 206, 194, 106, 72, 163, 90, 128, 17, 95, 104, 216, 241, 196,
-			42, 41, 239, 77, 27, 174, 184, 252, 197, 245, 128, 7, 34, 105,
-			184, 97, 196, 207, 142, 184, 219, 41, 111, 177, 212, 190, 160, 221,
-			36, 233, 21, 18, 221, 9, 107, 77, 86, 199, 96, 46, 126, 146,
-			15, 117, 90, 230, 99, 96, 12, 17, 221, 4, 18, 195, 32, 192,
-			152, 118, 194, 220, 121, 70, 81, 221, 228, 148, 83, 160, 5, 160,
-			98, 114, 88, 198, 127, 77, 179, 37, 247, 153, 6, 128, 247, 28,
-			39, 255, 12, 137, 110, 217, 230, 111, 106, 250, 241, 124, 91, 196,
-			253, 144, 234, 233, 243, 211, 153, 86, 153, 235, 239, 136, 184, 118,
-			72, 190, 10, 144, 149, 240, 192, 0, 187, 124, 5, 76, 118, 14,
-			142, 239, 179, 16, 22, 39, 197, 117, 170, 237, 150, 137, 13, 80,
-			32, 182, 71, 181, 29, 20, 138, 223, 212, 236, 188, 4, 13, 0,
-			239, 190, 135, 188, 141, 115, 76, 198, 54, 127, 75, 211, 79, 229,
-			223, 192, 57, 198, 239, 180, 88, 232, 214, 36, 163, 168, 131, 190,
-			158, 211, 188, 152, 61, 29, 39, 241, 9, 197, 233, 47, 239, 25,
-			42, 67, 226, 168, 184, 26, 4, 30, 115, 128, 14, 133, 56, 236,
-			176, 2, 48, 124, 1, 141, 239, 10, 34, 135, 8, 6, 215, 87,
-			143, 240, 63, 228, 213, 192, 23, 220, 19, 76, 195, 100, 100, 81,
-			205, 105, 115, 210, 56, 126, 151, 238, 57, 221, 25, 89, 25, 168,
-			106, 125, 136, 150, 85, 126, 222, 44, 110, 55, 130, 57, 233, 203,
-			30, 164, 11, 139, 47, 65, 30, 18, 153, 20, 61, 51, 38, 210,
-			68, 129, 22, 128, 138, 158, 160, 110, 253, 150, 102, 31, 151, 160,
-			1, 96, 225, 36, 249, 23, 92, 184, 12, 218, 230, 167, 53, 253,
-			68, 126, 23, 102, 17, 138, 4, 216, 232, 69, 226, 56, 178, 206,
-			158, 230, 33, 33, 208, 129, 82, 142, 112, 250, 166, 168, 219, 102,
-			83, 17, 77, 28, 153, 9, 63, 124, 167, 233, 227, 87, 151, 159,
-			223, 129, 174, 202, 233, 238, 196, 188, 132, 234, 194, 160, 137, 205,
-			80, 160, 5, 160, 146, 143, 160, 255, 125, 90, 27, 151, 98, 121,
-			208, 0, 240, 56, 37, 127, 199, 187, 144, 181, 205, 223, 131, 46,
-			252, 153, 198, 67, 229, 36, 156, 43, 91, 192, 35, 0, 33, 81,
-			133, 212, 132, 13, 239, 190, 27, 178, 146, 144, 36, 4, 166, 124,
-			72, 155, 14, 207, 234, 208, 130, 242, 24, 45, 136, 157, 29, 76,
-			211, 4, 127, 81, 244, 9, 63, 77, 137, 168, 22, 132, 238, 9,
-			245, 14, 4, 72, 41, 45, 109, 48, 162, 120, 157, 213, 59, 53,
-			97, 106, 193, 109, 74, 0, 213, 84, 196, 219, 95, 237, 202, 96,
-			49, 40, 141, 92, 140, 137, 83, 115, 90, 204, 91, 118, 162, 100,
-			46, 101, 77, 236, 188, 2, 45, 0, 213, 216, 131, 26, 252, 123,
-			137, 28, 200, 26, 0, 222, 67, 197, 18, 53, 100, 155, 159, 213,
-			244, 83, 226, 227, 144, 137, 160, 196, 52, 148, 1, 112, 88, 174,
-			110, 160, 73, 126, 86, 155, 148, 92, 52, 100, 0, 88, 56, 73,
-			62, 58, 132, 168, 136, 109, 254, 185, 166, 159, 206, 191, 127, 8,
-			73, 24, 118, 88, 34, 76, 84, 28, 163, 130, 60, 125, 47, 148,
-			232, 163, 32, 253, 212, 23, 197, 38, 50, 7, 144, 8, 180, 93,
-			167, 118, 35, 162, 124, 222, 214, 24, 5, 117, 52, 172, 99, 216,
-			125, 188, 9, 131, 66, 226, 56, 152, 35, 236, 115, 193, 69, 81,
-			150, 180, 133, 23, 144, 139, 93, 149, 97, 0, 56, 188, 168, 230,
-			220, 29, 7, 132, 70, 238, 14, 74, 140, 128, 6, 94, 93, 54,
-			175, 38, 182, 249, 34, 8, 189, 104, 13, 34, 71, 75, 127, 17,
-			41, 63, 205, 109, 32, 24, 197, 156, 137, 40, 215, 206, 49, 38,
-			88, 33, 234, 250, 113, 147, 197, 110, 173, 192, 191, 23, 133, 73,
-			209, 190, 246, 185, 113, 68, 163, 192, 67, 67, 43, 156, 57, 211,
-			204, 169, 53, 101, 147, 84, 23, 121, 161, 29, 22, 71, 88, 2,
-			42, 82, 85, 240, 26, 102, 74, 116, 83, 166, 136, 70, 69, 148,
-			61, 237, 70, 113, 114, 115, 38, 111, 34, 240, 88, 135, 55, 169,
-			206, 47, 198, 164, 27, 26, 202, 175, 165, 107, 149, 131, 144, 41,
-			253, 33, 172, 179, 16, 118, 14, 141, 24, 100, 191, 231, 209, 66,
-			200, 28, 79, 244, 52, 146, 145, 166, 228, 122, 200, 183, 43, 197,
-			125, 163, 38, 15, 113, 68, 152, 124, 168, 56, 98, 45, 199, 135,
-			30, 113, 155, 187, 34, 17, 209, 118, 252, 192, 159, 13, 89, 155,
-			199, 178, 239, 197, 43, 67, 113, 225, 24, 169, 81, 83, 155, 51,
-			152, 81, 184, 255, 34, 125, 225, 192, 84, 85, 117, 22, 59, 174,
-			7, 156, 182, 215, 100, 202, 4, 11, 37, 195, 94, 24, 196, 44,
-			197, 207, 176, 82, 248, 65, 140, 23, 37, 110, 36, 237, 34, 58,
-			17, 107, 116, 60, 100, 142, 48, 232, 248, 245, 217, 56, 116, 241,
-			190, 62, 117, 191, 206, 47, 88, 144, 44, 181, 192, 143, 220, 8,
-			141, 160, 233, 30, 35, 40, 135, 247, 245, 169, 127, 112, 169, 227,
-			69, 65, 145, 178, 93, 6, 67, 25, 116, 118, 154, 66, 219, 129,
-			177, 147, 97, 203, 120, 176, 169, 62, 58, 108, 137, 233, 201, 208,
-			156, 203, 241, 188, 174, 184, 87, 117, 252, 88, 89, 37, 196, 201,
-			97, 91, 205, 241, 167, 96, 78, 50, 207, 163, 110, 67, 157, 51,
-			185, 233, 187, 153, 32, 196, 120, 186, 126, 167, 85, 164, 81, 0,
-			45, 65, 214, 16, 35, 33, 199, 147, 244, 119, 2, 24, 3, 183,
-			202, 139, 125, 124, 29, 73, 46, 4, 49, 204, 231, 136, 231, 236,
-			20, 211, 205, 235, 82, 199, 11, 153, 83, 239, 170, 97, 36, 9,
-			18, 84, 2, 159, 236, 245, 22, 127, 82, 201, 78, 98, 162, 212,
-			82, 160, 5, 160, 210, 92, 97, 171, 251, 231, 90, 78, 74, 60,
-			98, 0, 88, 56, 69, 10, 4, 180, 173, 204, 151, 181, 129, 191,
-			214, 180, 252, 225, 158, 163, 55, 217, 155, 97, 98, 152, 160, 199,
-			124, 89, 203, 222, 133, 194, 214, 130, 61, 202, 87, 228, 126, 192,
-			194, 61, 202, 87, 100, 213, 22, 238, 81, 190, 34, 197, 182, 133,
-			123, 148, 175, 200, 61, 138, 133, 123, 148, 175, 200, 61, 138, 5,
-			155, 133, 175, 74, 177, 109, 225, 30, 229, 171, 9, 38, 45, 3,
-			160, 16, 219, 22, 238, 81, 190, 42, 197, 182, 133, 123, 148, 175,
-			130, 216, 158, 34, 186, 153, 177, 51, 127, 163, 13, 252, 107, 93,
-			203, 31, 77, 119, 194, 79, 52, 111, 209, 19, 208, 32, 254, 70,
-			203, 242, 157, 77, 6, 122, 242, 117, 217, 147, 12, 246, 228, 235,
-			178, 254, 12, 246, 228, 235, 178, 39, 25, 236, 201, 215, 101, 79,
-			50, 216, 147, 175, 203, 158, 100, 160, 39, 223, 208, 244, 146, 248,
-			8, 61, 249, 70, 130, 9, 122, 242, 13, 109, 248, 164, 4, 49,
-			243, 169, 25, 9, 26, 0, 22, 103, 5, 38, 221, 54, 191, 169,
-			233, 50, 47, 108, 43, 190, 153, 96, 2, 157, 241, 155, 218, 176,
-			108, 4, 84, 251, 77, 237, 200, 61, 18, 52, 0, 60, 81, 32,
-			31, 1, 5, 51, 99, 12, 216, 153, 55, 234, 250, 15, 233, 70,
-			254, 39, 244, 3, 174, 62, 164, 194, 201, 79, 209, 82, 151, 20,
-			226, 88, 237, 160, 139, 15, 230, 199, 161, 219, 119, 203, 1, 68,
-			62, 240, 138, 163, 239, 134, 131, 174, 5, 49, 147, 230, 184, 124,
-			198, 214, 221, 40, 118, 253, 90, 204, 53, 135, 231, 141, 205, 194,
-			171, 116, 98, 92, 78, 161, 180, 188, 16, 161, 81, 167, 214, 148,
-			159, 80, 96, 57, 237, 118, 24, 180, 67, 215, 137, 249, 29, 183,
-			208, 150, 177, 197, 226, 150, 219, 245, 227, 115, 139, 132, 214, 131,
-			150, 227, 250, 98, 26, 101, 12, 24, 227, 55, 234, 228, 24, 185,
-			139, 100, 0, 4, 6, 249, 23, 250, 190, 251, 23, 216, 187, 103,
-			248, 149, 32, 124, 30, 73, 18, 44, 72, 56, 100, 39, 9, 26,
-			36, 76, 76, 38, 9, 6, 36, 28, 187, 75, 213, 160, 217, 230,
-			155, 116, 188, 132, 57, 168, 6, 96, 164, 55, 165, 107, 128, 141,
-			251, 155, 210, 53, 104, 136, 96, 226, 206, 36, 193, 128, 132, 163,
-			121, 126, 251, 149, 1, 158, 120, 139, 174, 207, 31, 124, 251, 117,
-			75, 22, 232, 253, 208, 207, 10, 4, 116, 58, 156, 91, 226, 123,
-			63, 59, 80, 208, 159, 29, 241, 104, 205, 173, 88, 131, 164, 120,
-			131, 51, 48, 108, 192, 223, 162, 43, 110, 135, 13, 248, 91, 244,
-			225, 187, 36, 168, 1, 120, 247, 89, 9, 98, 207, 74, 115, 196,
-			195, 126, 154, 182, 249, 86, 93, 63, 149, 127, 34, 169, 46, 105,
-			225, 45, 111, 140, 66, 198, 53, 218, 3, 47, 133, 200, 65, 183,
-			66, 188, 114, 147, 87, 167, 64, 11, 64, 37, 43, 96, 211, 250,
-			86, 93, 108, 84, 50, 184, 105, 125, 171, 94, 56, 73, 22, 136,
-			110, 14, 218, 153, 31, 211, 7, 222, 165, 247, 219, 135, 241, 118,
-			202, 187, 14, 46, 186, 132, 212, 130, 77, 195, 143, 233, 89, 174,
-			236, 14, 2, 83, 62, 171, 11, 169, 53, 136, 76, 248, 172, 108,
-			201, 32, 178, 224, 179, 178, 37, 131, 200, 128, 207, 234, 66, 106,
-			13, 34, 251, 61, 171, 11, 169, 53, 8, 204, 247, 14, 93, 156,
-			17, 13, 34, 179, 189, 35, 193, 4, 172, 246, 14, 93, 236, 92,
-			6, 145, 209, 222, 161, 139, 51, 162, 65, 100, 179, 119, 232, 226,
-			140, 104, 16, 164, 214, 59, 117, 125, 90, 124, 132, 193, 120, 103,
-			130, 9, 207, 205, 245, 97, 217, 98, 168, 246, 157, 250, 93, 39,
-			37, 104, 0, 120, 239, 20, 57, 69, 116, 51, 107, 103, 126, 92,
-			31, 248, 73, 93, 203, 223, 217, 67, 29, 225, 156, 46, 8, 2,
-			155, 129, 31, 215, 179, 247, 96, 229, 89, 32, 200, 123, 36, 65,
-			178, 72, 144, 247, 200, 202, 179, 72, 144, 247, 72, 130, 100, 145,
-			32, 239, 145, 4, 201, 34, 65, 222, 35, 9, 146, 133, 150, 189,
-			87, 215, 139, 226, 35, 16, 228, 189, 9, 38, 16, 227, 239, 213,
-			135, 169, 4, 49, 243, 137, 41, 9, 26, 0, 158, 57, 43, 48,
-			233, 182, 249, 156, 174, 223, 43, 62, 2, 65, 158, 75, 48, 1,
-			65, 158, 211, 135, 143, 74, 80, 3, 48, 127, 66, 130, 6, 128,
-			167, 78, 147, 121, 162, 155, 67, 118, 230, 167, 245, 129, 255, 69,
-			215, 242, 133, 62, 27, 146, 184, 25, 212, 101, 144, 195, 52, 113,
-			96, 127, 243, 211, 122, 246, 110, 108, 200, 16, 16, 231, 253, 146,
-			56, 67, 72, 156, 247, 203, 134, 12, 33, 113, 222, 47, 137, 51,
-			132, 196, 121, 191, 36, 206, 16, 18, 231, 253, 64, 156, 119, 106,
-			136, 74, 179, 205, 15, 234, 250, 137, 252, 191, 212, 104, 197, 71,
-			219, 75, 191, 46, 205, 48, 241, 92, 5, 103, 25, 110, 152, 89,
-			196, 15, 137, 14, 60, 81, 217, 115, 186, 212, 137, 8, 61, 48,
-			188, 146, 58, 100, 41, 210, 106, 39, 150, 158, 14, 13, 208, 89,
-			131, 253, 182, 51, 188, 161, 48, 80, 31, 76, 122, 5, 156, 251,
-			193, 164, 87, 26, 182, 219, 190, 75, 130, 6, 128, 199, 169, 160,
-			143, 110, 155, 31, 210, 245, 130, 248, 8, 3, 245, 161, 4, 147,
-			110, 1, 168, 48, 1, 5, 62, 164, 219, 119, 75, 208, 0, 144,
-			158, 16, 152, 12, 219, 252, 57, 93, 63, 45, 62, 130, 44, 251,
-			185, 4, 19, 200, 178, 159, 211, 135, 39, 37, 168, 1, 120, 148,
-			74, 16, 203, 158, 60, 69, 202, 136, 201, 180, 205, 159, 215, 245,
-			7, 242, 15, 208, 138, 116, 191, 139, 64, 83, 229, 187, 55, 202,
-			99, 131, 192, 102, 133, 71, 219, 144, 233, 210, 62, 90, 214, 105,
-			114, 60, 10, 180, 0, 20, 90, 225, 16, 10, 169, 159, 215, 115,
-			178, 9, 32, 164, 126, 30, 154, 32, 192, 44, 128, 167, 239, 151,
-			224, 32, 128, 243, 247, 137, 6, 90, 182, 249, 11, 7, 53, 144,
-			71, 39, 217, 223, 64, 145, 222, 223, 64, 203, 68, 60, 10, 68,
-			180, 170, 129, 160, 118, 254, 66, 210, 64, 203, 0, 80, 53, 208,
-			202, 2, 168, 26, 104, 13, 2, 56, 127, 31, 249, 200, 40, 209,
-			77, 98, 103, 126, 95, 31, 248, 29, 67, 91, 92, 163, 15, 254,
-			195, 255, 17, 42, 124, 78, 201, 226, 127, 60, 68, 203, 176, 121,
-			85, 150, 111, 137, 69, 36, 247, 4, 130, 197, 3, 67, 132, 22,
-			100, 184, 26, 234, 196, 220, 7, 46, 61, 51, 8, 125, 10, 247,
-			19, 202, 75, 45, 74, 45, 78, 124, 75, 128, 238, 70, 113, 0,
-			139, 144, 220, 186, 214, 105, 228, 185, 59, 205, 216, 235, 210, 186,
-			219, 192, 96, 191, 49, 172, 83, 176, 21, 117, 186, 242, 52, 139,
-			54, 93, 216, 227, 55, 112, 39, 86, 23, 42, 87, 203, 241, 221,
-			118, 199, 195, 205, 161, 58, 46, 146, 3, 2, 218, 153, 180, 0,
-			0, 68, 7, 91, 0, 56, 209, 62, 11, 0, 70, 207, 8, 210,
-			164, 112, 137, 94, 166, 242, 202, 21, 183, 203, 112, 213, 21, 167,
-			8, 220, 16, 91, 6, 179, 45, 242, 55, 63, 210, 187, 52, 84,
-			18, 208, 52, 34, 57, 225, 148, 202, 70, 171, 196, 3, 192, 51,
-			167, 206, 55, 192, 104, 64, 1, 31, 40, 143, 159, 203, 247, 116,
-			126, 79, 35, 147, 19, 236, 154, 227, 121, 248, 82, 225, 126, 71,
-			224, 82, 250, 192, 15, 229, 15, 142, 167, 18, 95, 226, 222, 161,
-			22, 6, 81, 132, 71, 3, 251, 73, 64, 31, 101, 252, 84, 60,
-			245, 216, 35, 30, 241, 4, 180, 29, 240, 81, 224, 167, 115, 41,
-			26, 97, 36, 237, 61, 70, 49, 106, 60, 117, 82, 71, 25, 69,
-			32, 21, 231, 14, 254, 26, 13, 84, 138, 99, 24, 241, 166, 85,
-			25, 243, 9, 167, 27, 191, 207, 141, 2, 204, 147, 194, 14, 194,
-			24, 201, 94, 194, 96, 244, 210, 104, 73, 94, 187, 35, 175, 244,
-			142, 182, 112, 181, 170, 53, 131, 136, 225, 145, 15, 119, 20, 139,
-			46, 18, 122, 6, 119, 249, 50, 35, 111, 25, 158, 16, 74, 83,
-			26, 220, 47, 40, 187, 13, 24, 98, 143, 201, 55, 37, 249, 153,
-			99, 72, 40, 165, 65, 136, 15, 137, 10, 255, 189, 32, 228, 215,
-			114, 79, 183, 89, 232, 226, 21, 179, 39, 235, 40, 34, 33, 133,
-			241, 143, 104, 242, 125, 243, 243, 243, 243, 128, 37, 110, 134, 120,
-			90, 128, 239, 172, 200, 195, 124, 113, 243, 208, 13, 58, 220, 79,
-			44, 121, 88, 5, 146, 234, 65, 234, 41, 78, 6, 72, 248, 200,
-			34, 106, 108, 133, 18, 93, 162, 5, 165, 231, 233, 117, 85, 216,
-			149, 71, 77, 65, 126, 236, 62, 55, 53, 71, 123, 87, 133, 13,
-			106, 74, 63, 33, 4, 178, 51, 118, 65, 51, 101, 179, 45, 199,
-			21, 163, 90, 237, 52, 102, 119, 48, 128, 247, 172, 26, 193, 217,
-			144, 237, 184, 81, 28, 118, 83, 238, 55, 216, 249, 64, 106, 168,
-			41, 83, 52, 101, 208, 182, 233, 182, 218, 94, 87, 58, 232, 65,
-			215, 209, 81, 14, 227, 120, 163, 170, 139, 78, 42, 128, 135, 71,
-			10, 119, 119, 217, 236, 50, 109, 123, 157, 29, 215, 159, 193, 174,
-			244, 20, 217, 99, 213, 200, 141, 25, 157, 118, 27, 212, 217, 117,
-			92, 207, 169, 122, 108, 70, 152, 252, 134, 108, 42, 162, 126, 0,
-			200, 124, 241, 82, 40, 123, 186, 237, 161, 60, 10, 246, 144, 236,
-			48, 215, 124, 252, 34, 40, 223, 42, 209, 235, 81, 7, 79, 108,
-			224, 59, 178, 15, 22, 198, 112, 245, 116, 95, 151, 74, 104, 192,
-			204, 109, 148, 96, 131, 193, 246, 141, 147, 112, 18, 5, 68, 129,
-			159, 162, 9, 54, 139, 15, 111, 181, 75, 219, 157, 88, 198, 171,
-			23, 226, 34, 234, 84, 103, 123, 108, 153, 240, 22, 131, 207, 8,
-			57, 189, 35, 238, 213, 66, 131, 6, 103, 59, 116, 143, 140, 4,
-			211, 242, 231, 116, 46, 18, 241, 248, 234, 119, 224, 12, 139, 129,
-			195, 49, 245, 100, 32, 197, 148, 184, 9, 116, 35, 202, 31, 238,
-			68, 179, 110, 92, 10, 184, 252, 0, 153, 0, 195, 161, 94, 21,
-			149, 140, 24, 177, 152, 118, 218, 130, 51, 156, 78, 28, 180, 156,
-			216, 173, 33, 133, 157, 8, 29, 175, 196, 169, 191, 228, 17, 174,
-			52, 18, 205, 54, 127, 95, 207, 78, 112, 219, 115, 2, 90, 227,
-			231, 116, 253, 100, 254, 79, 52, 186, 201, 98, 126, 156, 136, 79,
-			113, 136, 232, 68, 226, 225, 5, 140, 152, 196, 162, 212, 250, 36,
-			174, 0, 220, 72, 158, 10, 138, 134, 17, 218, 246, 156, 26, 174,
-			129, 151, 187, 242, 158, 169, 152, 50, 207, 150, 136, 93, 124, 114,
-			160, 206, 181, 63, 121, 78, 16, 52, 98, 144, 112, 174, 159, 218,
-			218, 171, 163, 179, 158, 242, 234, 156, 205, 15, 194, 22, 118, 27,
-			111, 81, 57, 91, 168, 151, 170, 228, 206, 95, 104, 172, 92, 1,
-			33, 168, 29, 127, 78, 234, 35, 4, 181, 227, 207, 73, 237, 143,
-			160, 118, 252, 57, 221, 190, 71, 130, 6, 128, 39, 10, 228, 43,
-			58, 146, 76, 179, 205, 207, 235, 250, 217, 252, 231, 117, 186, 28,
-			248, 113, 24, 120, 251, 111, 31, 247, 66, 167, 221, 102, 33, 39,
-			37, 18, 47, 77, 58, 225, 254, 90, 234, 179, 87, 119, 98, 145,
-			21, 73, 41, 79, 32, 197, 82, 223, 87, 96, 10, 16, 198, 137,
-			78, 61, 61, 35, 119, 10, 160, 112, 239, 49, 64, 16, 241, 103,
-			203, 148, 87, 71, 106, 149, 224, 235, 190, 90, 54, 123, 91, 82,
-			105, 208, 3, 162, 192, 137, 99, 28, 144, 4, 242, 42, 24, 150,
-			30, 188, 181, 196, 163, 102, 201, 35, 242, 114, 136, 244, 56, 222,
-			73, 190, 21, 119, 206, 174, 31, 185, 117, 177, 214, 242, 85, 67,
-			146, 12, 163, 213, 113, 100, 106, 188, 64, 239, 255, 124, 50, 94,
-			160, 247, 127, 62, 25, 47, 13, 71, 196, 190, 87, 130, 6, 128,
-			51, 103, 200, 207, 24, 56, 94, 186, 109, 126, 73, 215, 47, 229,
-			223, 105, 64, 207, 120, 152, 50, 217, 3, 197, 238, 168, 63, 137,
-			33, 66, 207, 115, 207, 75, 221, 35, 208, 136, 181, 29, 252, 89,
-			2, 202, 112, 15, 51, 46, 15, 64, 71, 76, 72, 44, 36, 75,
-			81, 28, 40, 227, 18, 45, 38, 238, 129, 36, 39, 226, 48, 157,
-			110, 53, 59, 252, 181, 148, 136, 169, 251, 120, 208, 56, 252, 32,
-			62, 115, 48, 217, 36, 189, 144, 82, 252, 194, 29, 109, 98, 15,
-			10, 249, 87, 162, 137, 129, 215, 190, 178, 188, 38, 105, 216, 69,
-			82, 140, 26, 7, 61, 236, 247, 66, 124, 151, 102, 59, 114, 91,
-			124, 39, 124, 138, 248, 184, 193, 110, 232, 75, 201, 32, 195, 222,
-			227, 75, 114, 147, 64, 112, 75, 246, 37, 61, 119, 82, 130, 6,
-			128, 247, 78, 75, 48, 11, 224, 204, 69, 9, 14, 2, 120, 254,
-			2, 153, 65, 14, 48, 108, 243, 47, 116, 189, 156, 63, 198, 21,
-			62, 33, 24, 235, 1, 195, 59, 135, 38, 55, 69, 226, 37, 97,
-			55, 247, 23, 73, 35, 12, 11, 64, 213, 8, 216, 205, 253, 133,
-			158, 155, 149, 32, 34, 158, 95, 148, 96, 22, 192, 115, 43, 104,
-			20, 4, 32, 126, 62, 191, 76, 254, 43, 23, 28, 166, 109, 126,
-			77, 215, 95, 150, 255, 191, 116, 113, 113, 173, 110, 250, 82, 156,
-			184, 248, 252, 172, 168, 244, 124, 130, 234, 208, 30, 63, 253, 198,
-			183, 197, 161, 79, 160, 91, 226, 85, 145, 3, 106, 119, 204, 90,
-			109, 212, 145, 90, 14, 223, 97, 136, 149, 193, 193, 75, 168, 235,
-			91, 15, 205, 190, 132, 224, 93, 61, 141, 216, 107, 59, 120, 67,
-			136, 123, 111, 241, 10, 16, 191, 25, 36, 84, 132, 120, 75, 249,
-			123, 242, 70, 213, 3, 165, 43, 151, 72, 194, 96, 78, 202, 126,
-			88, 168, 114, 156, 185, 248, 171, 47, 81, 82, 121, 111, 221, 145,
-			188, 139, 18, 227, 211, 116, 32, 43, 101, 141, 6, 168, 36, 162,
-			115, 137, 198, 137, 117, 123, 160, 165, 8, 159, 37, 53, 134, 38,
-			39, 180, 2, 45, 0, 213, 24, 194, 118, 248, 107, 122, 78, 74,
-			11, 216, 14, 127, 77, 159, 57, 43, 193, 44, 128, 197, 7, 37,
-			56, 8, 224, 3, 47, 37, 171, 48, 130, 230, 128, 157, 249, 134,
-			174, 255, 119, 221, 200, 191, 148, 170, 24, 37, 74, 240, 137, 59,
-			180, 131, 92, 102, 229, 14, 45, 114, 111, 170, 118, 162, 229, 242,
-			55, 244, 193, 9, 178, 2, 252, 194, 45, 151, 255, 78, 55, 71,
-			11, 231, 21, 242, 196, 155, 20, 139, 3, 66, 177, 149, 42, 170,
-			151, 124, 184, 86, 205, 207, 146, 137, 48, 85, 254, 59, 221, 28,
-			74, 18, 116, 72, 24, 57, 68, 174, 136, 122, 52, 219, 252, 123,
-			221, 180, 243, 25, 238, 240, 89, 152, 67, 235, 219, 36, 206, 200,
-			122, 27, 159, 120, 84, 38, 160, 66, 46, 241, 137, 46, 141, 53,
-			137, 176, 113, 254, 123, 93, 24, 44, 18, 97, 227, 252, 247, 122,
-			110, 92, 85, 165, 219, 230, 183, 116, 243, 142, 194, 3, 233, 46,
-			213, 153, 120, 30, 71, 112, 214, 170, 27, 51, 21, 166, 162, 111,
-			72, 37, 102, 104, 244, 183, 116, 97, 198, 73, 132, 205, 242, 183,
-			244, 137, 195, 120, 40, 67, 64, 90, 124, 91, 215, 47, 137, 113,
-			179, 76, 4, 37, 11, 88, 25, 0, 197, 21, 19, 193, 3, 135,
-			111, 235, 147, 167, 36, 104, 0, 56, 37, 101, 137, 149, 5, 80,
-			201, 18, 107, 16, 192, 243, 23, 200, 231, 249, 44, 206, 216, 230,
-			15, 25, 122, 33, 255, 123, 122, 162, 49, 93, 9, 250, 244, 37,
-			254, 44, 225, 119, 166, 47, 85, 26, 52, 224, 49, 77, 138, 251,
-			144, 138, 197, 51, 29, 234, 162, 55, 166, 7, 234, 163, 179, 104,
-			51, 82, 117, 34, 150, 214, 69, 148, 194, 133, 87, 165, 180, 237,
-			196, 205, 34, 117, 27, 42, 154, 71, 137, 23, 77, 217, 122, 164,
-			75, 69, 177, 19, 115, 38, 216, 175, 43, 8, 52, 120, 233, 114,
-			48, 150, 254, 182, 244, 20, 150, 190, 135, 74, 90, 168, 25, 156,
-			49, 145, 200, 10, 180, 0, 84, 235, 125, 70, 3, 80, 156, 243,
-			17, 52, 15, 250, 33, 227, 248, 9, 242, 251, 38, 14, 208, 160,
-			109, 190, 195, 208, 47, 229, 255, 189, 73, 55, 185, 209, 178, 136,
-			124, 42, 151, 226, 168, 247, 164, 197, 245, 249, 42, 238, 57, 254,
-			78, 199, 217, 97, 47, 167, 180, 32, 34, 161, 22, 84, 17, 110,
-			127, 136, 247, 186, 50, 148, 8, 190, 212, 217, 133, 249, 24, 187,
-			53, 80, 213, 233, 198, 181, 101, 26, 241, 71, 249, 240, 4, 168,
-			139, 133, 146, 154, 208, 243, 153, 17, 138, 26, 105, 175, 152, 143,
-			246, 53, 131, 78, 171, 176, 3, 117, 30, 202, 197, 241, 196, 142,
-			45, 154, 41, 17, 122, 165, 191, 83, 123, 76, 28, 159, 224, 166,
-			232, 134, 235, 215, 249, 67, 179, 92, 253, 144, 47, 10, 5, 126,
-			202, 0, 28, 111, 250, 156, 208, 235, 74, 147, 103, 60, 237, 233,
-			143, 198, 68, 14, 168, 140, 83, 99, 143, 91, 0, 112, 19, 138,
-			148, 169, 185, 235, 211, 134, 179, 27, 160, 233, 20, 159, 233, 162,
-			225, 226, 213, 156, 222, 229, 44, 77, 81, 190, 13, 189, 53, 73,
-			67, 124, 24, 178, 72, 132, 72, 82, 118, 108, 1, 69, 171, 183,
-			18, 165, 235, 232, 60, 91, 103, 98, 227, 206, 247, 224, 17, 172,
-			31, 251, 216, 128, 219, 180, 19, 220, 190, 186, 53, 55, 134, 77,
-			4, 222, 218, 179, 22, 55, 63, 232, 36, 139, 202, 160, 137, 108,
-			165, 64, 11, 64, 181, 168, 12, 106, 0, 42, 237, 100, 208, 0,
-			80, 105, 39, 131, 89, 0, 149, 68, 25, 68, 14, 61, 127, 65,
-			72, 174, 172, 109, 254, 27, 67, 151, 43, 78, 214, 68, 80, 214,
-			147, 181, 0, 84, 245, 100, 53, 0, 115, 167, 37, 104, 0, 56,
-			125, 70, 130, 136, 234, 236, 75, 37, 56, 8, 224, 253, 151, 68,
-			61, 67, 182, 249, 78, 67, 73, 200, 33, 19, 65, 89, 207, 144,
-			5, 160, 170, 103, 72, 3, 80, 245, 103, 200, 0, 80, 245, 103,
-			40, 11, 160, 234, 207, 208, 32, 128, 170, 63, 196, 54, 223, 101,
-			232, 178, 17, 196, 68, 80, 214, 67, 44, 0, 85, 61, 176, 29,
-			125, 151, 145, 147, 146, 152, 24, 0, 78, 205, 72, 48, 11, 224,
-			25, 217, 100, 50, 8, 224, 125, 23, 201, 95, 243, 189, 235, 176,
-			109, 254, 132, 161, 47, 230, 191, 160, 209, 74, 212, 19, 40, 68,
-			50, 226, 203, 9, 229, 79, 254, 0, 11, 6, 92, 126, 197, 78,
-			184, 195, 98, 144, 186, 113, 35, 8, 91, 194, 208, 10, 214, 110,
-			214, 114, 99, 200, 159, 56, 76, 168, 51, 91, 194, 149, 253, 93,
-			22, 118, 81, 95, 76, 43, 176, 120, 160, 229, 198, 74, 72, 203,
-			37, 219, 235, 82, 119, 199, 15, 66, 86, 191, 36, 179, 67, 121,
-			66, 61, 230, 68, 113, 218, 216, 12, 221, 37, 228, 74, 142, 53,
-			201, 46, 112, 53, 204, 75, 109, 93, 135, 77, 236, 181, 2, 45,
-			0, 21, 61, 135, 53, 0, 115, 121, 9, 26, 0, 222, 125, 92,
-			130, 89, 0, 233, 130, 4, 7, 1, 60, 59, 79, 126, 0, 201,
-			57, 98, 155, 239, 51, 244, 7, 242, 175, 165, 60, 90, 115, 36,
-			141, 135, 240, 242, 9, 67, 55, 171, 173, 171, 8, 18, 114, 80,
-			156, 22, 113, 170, 138, 135, 128, 12, 77, 202, 120, 108, 149, 157,
-			125, 250, 18, 32, 91, 62, 123, 86, 117, 109, 196, 196, 22, 40,
-			208, 2, 80, 117, 109, 68, 3, 80, 220, 18, 16, 125, 196, 0,
-			240, 164, 156, 9, 35, 89, 0, 239, 189, 95, 130, 131, 0, 46,
-			220, 71, 222, 206, 89, 229, 144, 109, 254, 172, 161, 207, 228, 223,
-			152, 58, 230, 8, 228, 73, 24, 173, 137, 141, 16, 143, 8, 45,
-			4, 135, 139, 48, 30, 224, 225, 78, 8, 54, 187, 169, 34, 228,
-			160, 14, 245, 173, 241, 37, 46, 177, 184, 109, 147, 148, 85, 170,
-			187, 135, 76, 108, 148, 2, 45, 0, 213, 34, 119, 72, 3, 208,
-			150, 51, 240, 144, 1, 224, 189, 211, 164, 130, 253, 25, 181, 205,
-			15, 27, 250, 116, 254, 18, 85, 113, 165, 145, 156, 251, 218, 116,
-			73, 86, 27, 73, 251, 108, 177, 176, 171, 102, 140, 154, 136, 75,
-			129, 22, 128, 170, 25, 163, 26, 128, 118, 65, 130, 6, 128, 167,
-			167, 184, 105, 51, 209, 199, 108, 243, 23, 12, 110, 218, 156, 156,
-			246, 208, 205, 61, 183, 17, 167, 215, 54, 156, 26, 232, 241, 181,
-			255, 28, 8, 244, 190, 101, 105, 36, 138, 70, 31, 33, 3, 149,
-			8, 88, 127, 170, 52, 197, 117, 253, 142, 95, 103, 97, 84, 11,
-			66, 166, 2, 200, 113, 251, 146, 64, 14, 154, 180, 249, 142, 230,
-			162, 110, 171, 26, 120, 17, 145, 155, 77, 97, 185, 25, 39, 91,
-			139, 136, 15, 46, 87, 127, 138, 220, 36, 11, 155, 168, 78, 241,
-			133, 221, 45, 191, 118, 32, 207, 87, 141, 170, 69, 18, 112, 204,
-			68, 154, 40, 208, 2, 80, 209, 115, 76, 3, 80, 157, 45, 141,
-			25, 0, 158, 56, 137, 102, 238, 68, 207, 217, 230, 199, 96, 88,
-			219, 9, 151, 182, 155, 237, 219, 229, 78, 200, 186, 143, 1, 200,
-			1, 92, 185, 34, 198, 201, 141, 40, 236, 16, 187, 170, 237, 57,
-			19, 27, 160, 64, 11, 64, 213, 246, 156, 6, 160, 226, 133, 156,
-			1, 224, 233, 41, 242, 171, 124, 142, 141, 219, 230, 39, 12, 253,
-			116, 254, 35, 26, 110, 39, 82, 244, 198, 131, 4, 30, 121, 80,
-			133, 125, 67, 150, 13, 26, 7, 55, 90, 53, 145, 36, 109, 220,
-			55, 138, 234, 83, 175, 194, 138, 234, 102, 218, 140, 25, 38, 6,
-			48, 67, 204, 194, 86, 18, 43, 72, 53, 66, 117, 126, 220, 196,
-			14, 40, 208, 2, 80, 117, 126, 92, 3, 208, 150, 162, 116, 220,
-			0, 176, 112, 138, 252, 159, 188, 243, 182, 109, 254, 170, 161, 151,
-			242, 191, 242, 15, 232, 188, 140, 35, 175, 168, 64, 246, 143, 212,
-			11, 82, 33, 57, 113, 75, 19, 130, 40, 74, 220, 6, 33, 108,
-			19, 59, 163, 64, 11, 64, 69, 8, 91, 3, 208, 150, 186, 128,
-			109, 0, 120, 118, 150, 252, 6, 39, 196, 132, 109, 254, 38, 72,
-			132, 143, 190, 16, 33, 228, 120, 5, 13, 26, 118, 170, 221, 127,
-			0, 19, 8, 107, 203, 239, 138, 13, 176, 234, 126, 145, 56, 97,
-			98, 39, 20, 104, 1, 168, 8, 48, 161, 1, 168, 166, 240, 132,
-			1, 224, 137, 147, 228, 245, 216, 255, 195, 182, 249, 127, 24, 250,
-			197, 188, 255, 93, 121, 125, 19, 117, 37, 209, 27, 144, 81, 174,
-			187, 133, 117, 121, 215, 44, 239, 40, 210, 46, 226, 68, 63, 108,
-			98, 245, 10, 204, 0, 56, 44, 155, 122, 88, 3, 80, 184, 136,
-			19, 253, 176, 1, 224, 253, 23, 200, 15, 107, 196, 48, 65, 51,
-			251, 148, 161, 31, 205, 223, 252, 7, 251, 136, 127, 247, 189, 64,
-			13, 210, 26, 128, 150, 8, 135, 114, 130, 14, 229, 159, 50, 132,
-			67, 57, 65, 135, 242, 79, 25, 119, 76, 242, 235, 140, 33, 219,
-			252, 109, 67, 31, 229, 5, 135, 6, 0, 26, 230, 175, 102, 146,
-			33, 40, 152, 6, 117, 1, 142, 16, 221, 28, 182, 51, 159, 49,
-			6, 126, 204, 212, 16, 13, 168, 77, 159, 49, 178, 119, 146, 63,
-			180, 136, 105, 14, 235, 3, 182, 249, 5, 67, 127, 89, 254, 183,
-			44, 16, 196, 184, 17, 72, 93, 102, 37, 118, 246, 11, 242, 4,
-			3, 114, 165, 61, 95, 27, 61, 94, 74, 242, 96, 43, 181, 234,
-			96, 14, 117, 77, 49, 11, 58, 163, 19, 187, 85, 23, 163, 237,
-			168, 227, 173, 62, 236, 68, 189, 175, 141, 215, 99, 194, 37, 55,
-			185, 224, 228, 79, 195, 166, 194, 212, 113, 63, 246, 139, 148, 86,
-			226, 169, 136, 122, 44, 138, 8, 101, 141, 134, 91, 115, 209, 111,
-			171, 9, 26, 29, 219, 99, 33, 109, 48, 39, 238, 132, 44, 226,
-			71, 214, 48, 148, 176, 212, 162, 38, 203, 31, 96, 238, 11, 205,
-			167, 236, 231, 229, 129, 46, 123, 218, 193, 32, 122, 61, 215, 199,
-			84, 101, 127, 40, 8, 232, 235, 120, 120, 80, 49, 111, 111, 241,
-			74, 4, 125, 16, 169, 45, 94, 221, 79, 241, 216, 121, 24, 128,
-			150, 243, 52, 126, 121, 166, 215, 198, 148, 165, 110, 251, 65, 103,
-			231, 151, 229, 64, 6, 217, 60, 110, 154, 125, 41, 69, 208, 72,
-			88, 254, 97, 214, 244, 80, 17, 244, 96, 234, 229, 110, 220, 149,
-			162, 229, 147, 236, 55, 108, 106, 113, 131, 42, 117, 224, 75, 60,
-			166, 26, 234, 18, 210, 162, 161, 42, 236, 79, 35, 126, 31, 16,
-			225, 78, 161, 223, 246, 31, 171, 188, 172, 172, 195, 197, 158, 64,
-			94, 223, 113, 161, 198, 45, 78, 226, 61, 188, 3, 142, 67, 183,
-			166, 98, 218, 226, 232, 51, 191, 17, 132, 53, 177, 159, 143, 15,
-			10, 164, 39, 4, 196, 48, 222, 124, 125, 65, 10, 136, 97, 188,
-			249, 250, 130, 212, 177, 135, 241, 232, 240, 11, 70, 110, 74, 130,
-			6, 128, 103, 206, 74, 48, 11, 160, 56, 27, 29, 214, 7, 6,
-			1, 124, 224, 165, 228, 207, 52, 156, 52, 154, 109, 254, 169, 161,
-			63, 148, 255, 207, 26, 93, 225, 247, 68, 92, 131, 73, 157, 60,
-			136, 83, 32, 245, 250, 116, 161, 158, 186, 72, 40, 80, 249, 200,
-			71, 234, 57, 117, 12, 11, 219, 240, 220, 90, 44, 221, 35, 249,
-			137, 178, 196, 36, 237, 52, 164, 247, 22, 74, 33, 7, 131, 77,
-			209, 22, 40, 156, 42, 120, 154, 80, 132, 196, 188, 101, 78, 228,
-			178, 240, 18, 245, 217, 158, 56, 132, 16, 143, 161, 239, 6, 174,
-			100, 23, 113, 167, 146, 106, 100, 65, 209, 81, 51, 177, 183, 10,
-			180, 0, 84, 116, 212, 144, 22, 185, 57, 9, 26, 0, 46, 158,
-			147, 96, 22, 192, 243, 101, 9, 14, 2, 248, 242, 21, 242, 151,
-			156, 142, 186, 109, 254, 149, 161, 47, 228, 255, 75, 178, 173, 149,
-			236, 253, 162, 237, 108, 83, 115, 232, 59, 220, 206, 138, 221, 44,
-			185, 237, 237, 108, 138, 237, 121, 255, 117, 19, 59, 172, 64, 11,
-			64, 69, 74, 96, 171, 191, 146, 59, 218, 97, 188, 247, 249, 43,
-			227, 238, 123, 36, 152, 5, 240, 248, 188, 4, 7, 1, 60, 51,
-			199, 133, 250, 144, 109, 126, 197, 208, 39, 112, 109, 24, 134, 181,
-			225, 43, 198, 240, 8, 207, 137, 107, 67, 26, 212, 5, 200, 243,
-			226, 199, 81, 209, 132, 33, 173, 15, 212, 5, 200, 243, 34, 52,
-			110, 139, 143, 186, 214, 11, 202, 175, 63, 153, 193, 177, 53, 108,
-			243, 95, 154, 250, 61, 249, 183, 101, 64, 147, 81, 30, 58, 114,
-			124, 249, 204, 239, 189, 205, 79, 233, 135, 78, 27, 237, 242, 187,
-			92, 20, 137, 113, 35, 144, 46, 221, 184, 164, 187, 146, 74, 225,
-			98, 152, 66, 202, 75, 95, 193, 186, 91, 221, 54, 43, 82, 12,
-			139, 15, 63, 95, 6, 233, 219, 124, 70, 61, 72, 23, 46, 145,
-			68, 105, 169, 167, 93, 172, 188, 32, 184, 17, 97, 232, 13, 137,
-			78, 52, 248, 170, 211, 70, 227, 78, 124, 44, 71, 74, 248, 180,
-			148, 151, 15, 235, 244, 202, 245, 36, 135, 227, 81, 209, 44, 122,
-			131, 117, 69, 35, 246, 101, 81, 13, 22, 27, 179, 7, 233, 162,
-			200, 246, 12, 255, 163, 132, 106, 111, 131, 250, 122, 71, 104, 165,
-			47, 170, 5, 26, 211, 53, 131, 32, 226, 130, 52, 117, 76, 193,
-			199, 69, 54, 255, 65, 84, 1, 212, 12, 169, 118, 98, 212, 168,
-			169, 67, 125, 30, 161, 4, 198, 198, 237, 153, 134, 234, 28, 55,
-			14, 104, 19, 52, 6, 248, 118, 131, 117, 185, 99, 187, 48, 196,
-			231, 4, 79, 93, 172, 45, 93, 171, 160, 118, 133, 190, 14, 251,
-			98, 112, 224, 53, 154, 52, 186, 193, 216, 170, 78, 68, 168, 219,
-			72, 124, 49, 249, 12, 60, 216, 191, 12, 93, 51, 214, 183, 202,
-			23, 101, 4, 73, 113, 216, 169, 84, 233, 190, 8, 186, 116, 137,
-			155, 32, 72, 181, 7, 185, 138, 71, 220, 35, 114, 111, 204, 221,
-			84, 5, 2, 33, 67, 165, 113, 152, 219, 234, 57, 86, 229, 167,
-			223, 98, 135, 34, 23, 38, 97, 205, 150, 44, 80, 134, 137, 83,
-			68, 129, 22, 128, 74, 26, 24, 26, 128, 185, 163, 18, 196, 249,
-			116, 215, 221, 228, 148, 152, 239, 63, 98, 234, 135, 10, 71, 240,
-			14, 220, 115, 99, 208, 46, 196, 29, 89, 213, 99, 68, 76, 88,
-			3, 178, 169, 153, 15, 24, 211, 160, 46, 192, 227, 2, 227, 91,
-			0, 163, 141, 24, 125, 199, 15, 182, 157, 104, 27, 48, 75, 100,
-			38, 228, 80, 165, 77, 173, 23, 212, 5, 184, 142, 147, 223, 180,
-			205, 183, 154, 223, 187, 128, 80, 195, 220, 57, 33, 161, 149, 153,
-			1, 112, 88, 202, 70, 116, 78, 48, 143, 203, 85, 7, 157, 19,
-			76, 17, 16, 106, 216, 178, 205, 183, 155, 47, 78, 64, 168, 97,
-			208, 223, 223, 110, 10, 253, 125, 24, 245, 247, 183, 155, 66, 127,
-			31, 70, 253, 253, 237, 230, 29, 147, 168, 134, 143, 216, 153, 119,
-			152, 3, 223, 20, 106, 248, 136, 102, 155, 239, 48, 179, 135, 201,
-			79, 235, 196, 52, 71, 64, 13, 127, 183, 169, 151, 242, 207, 234,
-			72, 49, 124, 186, 42, 97, 86, 121, 199, 134, 6, 92, 103, 207,
-			246, 223, 155, 11, 125, 221, 73, 12, 104, 201, 45, 28, 234, 133,
-			201, 102, 211, 241, 129, 252, 202, 158, 104, 15, 152, 185, 68, 213,
-			94, 70, 94, 83, 16, 69, 152, 42, 243, 130, 61, 169, 121, 244,
-			238, 71, 187, 44, 78, 166, 111, 98, 240, 16, 180, 153, 124, 247,
-			0, 90, 3, 107, 39, 163, 179, 179, 52, 10, 194, 176, 91, 164,
-			123, 108, 202, 243, 40, 74, 248, 128, 59, 62, 213, 25, 58, 71,
-			162, 161, 107, 7, 84, 116, 121, 83, 115, 130, 143, 250, 8, 106,
-			116, 239, 150, 76, 48, 130, 81, 193, 222, 109, 10, 111, 142, 17,
-			212, 232, 222, 109, 138, 56, 29, 35, 168, 209, 189, 219, 60, 154,
-			151, 96, 22, 192, 99, 179, 18, 28, 4, 240, 222, 34, 6, 93,
-			25, 49, 7, 236, 204, 143, 155, 250, 251, 76, 30, 116, 101, 4,
-			239, 171, 127, 220, 28, 132, 185, 145, 1, 16, 198, 231, 189, 166,
-			57, 150, 31, 83, 231, 20, 45, 140, 62, 138, 119, 180, 35, 226,
-			42, 250, 189, 166, 153, 74, 208, 33, 225, 208, 40, 154, 72, 140,
-			240, 171, 232, 231, 76, 113, 129, 60, 34, 110, 148, 159, 51, 205,
-			108, 146, 160, 67, 194, 240, 136, 42, 161, 219, 230, 79, 153, 34,
-			204, 206, 136, 184, 24, 254, 41, 83, 92, 12, 143, 136, 139, 225,
-			159, 50, 39, 14, 147, 207, 113, 46, 210, 108, 243, 131, 166, 126,
-			44, 255, 31, 116, 49, 239, 208, 255, 89, 12, 151, 184, 179, 23,
-			86, 65, 220, 241, 85, 10, 207, 118, 232, 182, 240, 153, 27, 169,
-			15, 162, 129, 41, 138, 18, 234, 240, 29, 146, 218, 76, 237, 99,
-			45, 62, 222, 176, 179, 41, 209, 13, 71, 172, 244, 142, 175, 176,
-			195, 222, 99, 47, 116, 165, 117, 34, 70, 34, 148, 17, 79, 18,
-			203, 34, 198, 25, 168, 152, 118, 105, 115, 194, 208, 233, 226, 145,
-			8, 143, 174, 133, 107, 128, 50, 190, 245, 250, 99, 34, 85, 189,
-			160, 90, 162, 21, 233, 105, 94, 228, 226, 89, 94, 121, 129, 100,
-			142, 121, 188, 114, 116, 38, 199, 91, 52, 97, 128, 134, 170, 176,
-			184, 190, 227, 68, 75, 69, 238, 225, 28, 131, 14, 25, 9, 243,
-			161, 67, 134, 148, 214, 35, 220, 33, 195, 204, 73, 230, 67, 135,
-			12, 96, 190, 119, 91, 56, 48, 186, 109, 254, 146, 169, 159, 203,
-			191, 197, 194, 129, 225, 47, 199, 41, 235, 28, 113, 80, 195, 18,
-			155, 196, 77, 84, 71, 56, 133, 212, 113, 150, 176, 21, 15, 68,
-			20, 4, 225, 205, 158, 94, 149, 96, 215, 173, 94, 11, 193, 206,
-			67, 185, 251, 207, 211, 42, 206, 172, 152, 237, 132, 142, 135, 180,
-			111, 184, 79, 203, 112, 40, 132, 78, 187, 126, 124, 255, 249, 34,
-			237, 136, 191, 145, 248, 139, 153, 48, 65, 252, 154, 41, 81, 186,
-			148, 10, 97, 39, 59, 162, 158, 115, 35, 60, 34, 145, 224, 15,
-			28, 176, 116, 127, 184, 181, 141, 220, 243, 32, 213, 35, 234, 5,
-			60, 98, 0, 232, 205, 46, 90, 210, 112, 163, 31, 224, 215, 166,
-			211, 6, 49, 178, 199, 227, 17, 120, 160, 110, 36, 129, 34, 68,
-			4, 6, 113, 42, 76, 27, 94, 192, 213, 110, 110, 80, 158, 84,
-			91, 34, 116, 19, 5, 90, 23, 190, 170, 183, 230, 212, 110, 64,
-			116, 2, 183, 165, 61, 251, 56, 86, 79, 55, 94, 248, 46, 16,
-			165, 36, 164, 190, 21, 184, 233, 105, 65, 198, 188, 65, 227, 4,
-			214, 116, 118, 221, 32, 76, 249, 85, 160, 224, 224, 99, 69, 168,
-			122, 18, 15, 93, 63, 123, 244, 31, 21, 45, 59, 230, 135, 17,
-			61, 50, 87, 249, 0, 7, 98, 176, 211, 183, 230, 220, 160, 142,
-			155, 213, 58, 117, 60, 243, 231, 230, 208, 59, 65, 176, 83, 106,
-			57, 113, 179, 84, 1, 62, 80, 106, 200, 8, 110, 74, 126, 41,
-			97, 108, 61, 3, 160, 56, 2, 28, 65, 153, 243, 75, 166, 61,
-			41, 65, 3, 192, 99, 119, 73, 48, 11, 224, 221, 139, 18, 28,
-			4, 112, 122, 65, 72, 85, 205, 206, 124, 220, 212, 127, 77, 73,
-			85, 152, 36, 31, 55, 7, 15, 97, 208, 172, 17, 30, 202, 234,
-			151, 77, 211, 206, 31, 17, 7, 168, 169, 91, 110, 238, 11, 197,
-			5, 29, 143, 99, 245, 203, 137, 240, 228, 113, 172, 126, 217, 204,
-			141, 147, 25, 129, 74, 179, 205, 95, 1, 84, 71, 17, 213, 62,
-			158, 139, 82, 200, 52, 158, 55, 65, 6, 130, 247, 87, 210, 200,
-			116, 219, 252, 213, 3, 145, 37, 22, 198, 178, 44, 84, 252, 171,
-			105, 100, 188, 112, 110, 156, 252, 1, 18, 1, 8, 246, 199, 166,
-			126, 54, 255, 201, 81, 105, 163, 145, 114, 158, 168, 170, 45, 136,
-			231, 220, 116, 189, 238, 203, 41, 93, 117, 110, 118, 229, 149, 162,
-			186, 81, 20, 26, 200, 44, 144, 69, 134, 106, 229, 110, 1, 45,
-			230, 248, 34, 246, 197, 158, 52, 174, 227, 118, 165, 169, 157, 22,
-			250, 243, 224, 74, 207, 107, 43, 114, 9, 226, 98, 20, 32, 145,
-			111, 42, 74, 162, 227, 160, 80, 20, 238, 164, 162, 125, 213, 78,
-			44, 21, 97, 174, 200, 113, 107, 18, 46, 151, 249, 105, 157, 224,
-			251, 30, 172, 66, 190, 214, 98, 110, 17, 159, 224, 195, 198, 138,
-			208, 79, 24, 165, 137, 159, 136, 200, 227, 55, 97, 35, 13, 221,
-			119, 208, 247, 7, 122, 219, 183, 71, 112, 66, 70, 27, 33, 99,
-			252, 180, 29, 119, 54, 42, 22, 4, 106, 68, 132, 50, 103, 135,
-			133, 176, 203, 247, 128, 170, 210, 53, 167, 55, 82, 137, 114, 195,
-			81, 250, 158, 244, 153, 81, 134, 126, 68, 173, 29, 61, 135, 252,
-			176, 81, 138, 58, 59, 59, 44, 146, 225, 71, 122, 78, 164, 28,
-			124, 99, 4, 84, 39, 151, 241, 160, 61, 14, 238, 165, 0, 79,
-			79, 123, 122, 162, 213, 96, 164, 206, 32, 20, 199, 162, 169, 169,
-			93, 13, 130, 27, 55, 24, 227, 241, 175, 130, 93, 22, 54, 97,
-			44, 226, 110, 91, 236, 158, 69, 60, 242, 30, 107, 54, 119, 159,
-			0, 145, 166, 160, 212, 225, 198, 134, 113, 234, 5, 1, 63, 102,
-			97, 67, 220, 215, 56, 126, 207, 61, 69, 80, 135, 13, 173, 227,
-			121, 210, 6, 22, 67, 177, 224, 65, 42, 13, 89, 203, 73, 249,
-			66, 150, 40, 125, 168, 19, 194, 48, 128, 222, 0, 172, 22, 50,
-			167, 62, 27, 57, 13, 166, 194, 171, 147, 84, 101, 110, 186, 61,
-			169, 71, 19, 120, 131, 47, 161, 221, 77, 44, 45, 242, 100, 101,
-			128, 13, 133, 49, 244, 157, 31, 119, 41, 183, 9, 94, 33, 178,
-			115, 173, 19, 114, 127, 50, 92, 115, 60, 30, 146, 164, 23, 33,
-			48, 189, 235, 119, 24, 225, 126, 39, 24, 147, 131, 50, 25, 61,
-			88, 176, 101, 137, 244, 248, 250, 247, 239, 86, 247, 239, 173, 241,
-			73, 111, 21, 226, 67, 106, 85, 220, 125, 136, 224, 82, 118, 179,
-			219, 235, 160, 193, 173, 226, 221, 168, 136, 93, 2, 182, 168, 68,
-			21, 62, 111, 221, 155, 172, 62, 61, 35, 21, 173, 158, 217, 77,
-			176, 238, 144, 197, 157, 80, 48, 36, 6, 27, 17, 251, 228, 222,
-			169, 216, 116, 34, 138, 111, 64, 225, 20, 232, 105, 89, 234, 216,
-			222, 103, 208, 97, 39, 236, 42, 103, 132, 64, 26, 186, 29, 128,
-			19, 119, 14, 194, 153, 44, 224, 54, 126, 124, 126, 187, 62, 15,
-			56, 35, 150, 41, 124, 226, 11, 163, 83, 1, 97, 138, 32, 231,
-			153, 163, 52, 194, 118, 39, 108, 7, 220, 250, 2, 8, 67, 228,
-			204, 0, 117, 195, 239, 95, 27, 197, 129, 41, 146, 59, 122, 94,
-			122, 19, 117, 134, 173, 162, 183, 196, 226, 61, 1, 55, 78, 83,
-			92, 94, 37, 164, 172, 177, 82, 99, 35, 165, 101, 111, 51, 16,
-			181, 120, 253, 235, 12, 247, 96, 56, 35, 154, 225, 226, 67, 114,
-			61, 77, 193, 163, 198, 51, 104, 176, 124, 134, 60, 95, 182, 94,
-			217, 36, 229, 25, 143, 96, 211, 115, 128, 214, 4, 93, 184, 202,
-			152, 47, 40, 142, 199, 249, 88, 104, 113, 126, 113, 161, 200, 57,
-			76, 204, 118, 90, 11, 194, 144, 213, 98, 31, 208, 98, 229, 145,
-			228, 38, 110, 151, 140, 206, 173, 226, 245, 154, 52, 245, 145, 41,
-			128, 195, 60, 148, 53, 1, 173, 133, 78, 212, 228, 142, 177, 160,
-			70, 114, 197, 12, 182, 168, 252, 253, 161, 52, 54, 55, 130, 149,
-			202, 79, 45, 59, 180, 211, 14, 252, 100, 70, 209, 173, 245, 149,
-			245, 233, 234, 220, 226, 194, 194, 133, 249, 251, 23, 22, 206, 205,
-			92, 164, 194, 32, 135, 63, 103, 164, 246, 23, 216, 23, 21, 63,
-			149, 235, 26, 134, 137, 11, 171, 2, 45, 0, 149, 62, 110, 104,
-			0, 138, 200, 134, 35, 120, 122, 242, 199, 230, 17, 169, 197, 24,
-			89, 0, 143, 158, 145, 224, 32, 128, 167, 102, 200, 71, 52, 92,
-			178, 77, 219, 252, 162, 169, 63, 144, 255, 73, 141, 246, 61, 223,
-			252, 157, 17, 84, 216, 7, 73, 219, 187, 94, 79, 66, 52, 151,
-			65, 156, 168, 73, 243, 106, 106, 234, 117, 137, 118, 24, 52, 221,
-			170, 216, 147, 5, 161, 156, 67, 142, 207, 183, 211, 50, 104, 51,
-			239, 128, 201, 91, 172, 64, 11, 64, 69, 11, 83, 3, 80, 196,
-			202, 25, 193, 211, 145, 47, 154, 133, 83, 18, 204, 2, 40, 156,
-			142, 71, 208, 12, 252, 139, 230, 252, 125, 228, 139, 156, 22, 150,
-			109, 254, 165, 169, 47, 228, 255, 40, 109, 121, 6, 203, 212, 139,
-			118, 64, 47, 175, 70, 162, 239, 238, 120, 94, 132, 117, 188, 45,
-			107, 179, 30, 134, 178, 76, 236, 170, 2, 177, 231, 138, 136, 150,
-			6, 96, 78, 30, 39, 88, 6, 128, 226, 112, 126, 4, 13, 169,
-			255, 210, 20, 135, 243, 35, 104, 72, 253, 151, 230, 153, 57, 242,
-			114, 164, 97, 198, 54, 191, 98, 234, 197, 252, 194, 119, 254, 230,
-			13, 199, 151, 49, 17, 131, 2, 45, 0, 85, 211, 50, 26, 128,
-			138, 215, 51, 6, 128, 71, 142, 74, 48, 11, 96, 254, 172, 4,
-			7, 1, 60, 125, 6, 15, 234, 70, 64, 97, 255, 218, 247, 240,
-			160, 110, 4, 141, 71, 191, 150, 52, 117, 48, 3, 224, 176, 164,
-			211, 160, 6, 160, 56, 168, 27, 65, 227, 209, 175, 201, 131, 186,
-			17, 203, 54, 255, 246, 69, 58, 168, 27, 177, 6, 0, 185, 56,
-			168, 27, 193, 131, 186, 191, 149, 7, 117, 35, 120, 80, 247, 183,
-			230, 29, 147, 228, 46, 104, 199, 144, 109, 254, 157, 169, 31, 42,
-			140, 137, 183, 100, 234, 244, 41, 212, 187, 57, 166, 161, 1, 248,
-			44, 14, 62, 71, 240, 118, 37, 13, 234, 2, 28, 33, 186, 121,
-			200, 206, 124, 203, 28, 120, 163, 197, 143, 252, 14, 105, 182, 249,
-			45, 51, 123, 24, 41, 127, 8, 246, 62, 223, 254, 30, 82, 254,
-			16, 158, 142, 125, 91, 82, 254, 16, 158, 142, 125, 91, 82, 254,
-			16, 238, 160, 190, 45, 41, 127, 8, 79, 199, 190, 45, 41, 127,
-			200, 178, 205, 55, 88, 47, 14, 229, 15, 1, 229, 223, 96, 9,
-			202, 31, 66, 202, 191, 193, 18, 148, 63, 132, 148, 127, 131, 37,
-			142, 72, 71, 237, 204, 155, 172, 129, 119, 9, 122, 141, 106, 182,
-			249, 38, 43, 59, 65, 158, 36, 166, 57, 10, 244, 122, 179, 165,
-			211, 252, 6, 183, 83, 232, 53, 182, 145, 102, 11, 248, 32, 59,
-			109, 241, 135, 215, 146, 227, 80, 254, 248, 5, 62, 210, 21, 39,
-			158, 60, 68, 198, 23, 197, 182, 140, 34, 1, 223, 108, 233, 10,
-			180, 0, 20, 179, 108, 20, 9, 248, 102, 43, 119, 76, 130, 6,
-			128, 247, 28, 39, 127, 162, 97, 243, 52, 219, 124, 171, 165, 47,
-			228, 255, 32, 145, 147, 34, 106, 208, 139, 120, 143, 201, 93, 239,
-			94, 92, 41, 137, 215, 249, 138, 70, 154, 137, 253, 84, 160, 5,
-			160, 162, 145, 134, 84, 16, 66, 114, 20, 79, 193, 222, 106, 9,
-			33, 57, 138, 151, 193, 111, 181, 132, 144, 28, 197, 203, 224, 183,
-			90, 103, 230, 240, 254, 97, 116, 200, 54, 223, 102, 61, 207, 253,
-			195, 40, 204, 187, 183, 89, 98, 162, 141, 226, 188, 75, 131, 186,
-			0, 215, 113, 52, 116, 219, 124, 214, 250, 222, 77, 174, 81, 60,
-			36, 121, 54, 233, 55, 136, 244, 103, 173, 97, 217, 51, 24, 253,
-			103, 45, 49, 185, 70, 241, 144, 228, 89, 75, 76, 174, 81, 203,
-			54, 223, 249, 34, 77, 174, 81, 152, 92, 239, 148, 147, 107, 20,
-			39, 215, 59, 229, 228, 26, 197, 201, 245, 78, 57, 185, 198, 236,
-			204, 187, 173, 129, 159, 17, 147, 107, 76, 179, 205, 119, 91, 217,
-			73, 242, 117, 96, 223, 49, 152, 93, 207, 1, 251, 126, 177, 143,
-			125, 249, 198, 248, 69, 103, 98, 94, 207, 139, 125, 31, 159, 10,
-			239, 37, 6, 118, 12, 39, 253, 115, 114, 96, 199, 112, 210, 63,
-			39, 25, 122, 12, 39, 253, 115, 146, 161, 199, 112, 210, 63, 39,
-			25, 122, 12, 239, 20, 158, 147, 12, 61, 134, 119, 10, 207, 1,
-			67, 175, 35, 73, 53, 219, 124, 223, 247, 144, 7, 199, 112, 238,
-			189, 47, 105, 170, 150, 1, 112, 88, 54, 70, 195, 250, 4, 15,
-			142, 225, 220, 123, 159, 228, 193, 49, 203, 54, 63, 240, 34, 241,
-			224, 24, 240, 224, 7, 36, 15, 142, 33, 15, 126, 64, 242, 224,
-			24, 242, 224, 7, 36, 15, 230, 236, 204, 7, 173, 129, 127, 43,
-			120, 48, 167, 217, 230, 7, 173, 236, 157, 228, 143, 12, 98, 154,
-			57, 224, 193, 143, 90, 250, 98, 218, 26, 36, 241, 40, 126, 17,
-			25, 80, 84, 242, 98, 115, 159, 244, 197, 41, 145, 197, 47, 106,
-			120, 152, 112, 145, 242, 120, 81, 42, 254, 199, 130, 10, 252, 113,
-			110, 81, 198, 154, 74, 130, 251, 171, 71, 206, 149, 234, 184, 113,
-			109, 153, 80, 74, 27, 161, 211, 98, 123, 65, 120, 163, 68, 233,
-			163, 140, 58, 237, 192, 11, 118, 128, 147, 240, 173, 151, 192, 9,
-			235, 98, 163, 29, 165, 30, 37, 9, 104, 208, 9, 35, 230, 237,
-			178, 72, 220, 242, 83, 186, 199, 184, 107, 149, 12, 218, 201, 207,
-			170, 208, 73, 8, 195, 241, 86, 209, 37, 9, 178, 213, 89, 205,
-			21, 167, 77, 242, 110, 79, 190, 138, 11, 136, 196, 195, 184, 130,
-			117, 115, 56, 203, 62, 42, 89, 55, 135, 179, 236, 163, 114, 150,
-			229, 112, 150, 125, 84, 206, 178, 28, 206, 178, 143, 90, 194, 149,
-			35, 135, 179, 236, 163, 150, 112, 229, 200, 225, 44, 251, 168, 117,
-			118, 30, 103, 89, 14, 102, 217, 199, 191, 135, 179, 44, 135, 179,
-			236, 227, 73, 83, 97, 150, 125, 92, 206, 178, 28, 206, 178, 143,
-			203, 89, 150, 195, 89, 246, 113, 57, 203, 114, 150, 109, 126, 226,
-			69, 154, 101, 57, 152, 101, 159, 144, 179, 44, 135, 179, 236, 19,
-			114, 150, 229, 112, 150, 125, 66, 206, 178, 113, 59, 243, 239, 172,
-			129, 63, 18, 179, 108, 92, 179, 205, 127, 103, 101, 239, 32, 127,
-			0, 179, 108, 28, 102, 217, 39, 97, 150, 253, 231, 180, 205, 21,
-			30, 233, 189, 200, 38, 87, 80, 199, 139, 111, 113, 37, 252, 118,
-			255, 255, 54, 197, 198, 113, 138, 125, 82, 242, 237, 56, 78, 177,
-			79, 202, 41, 54, 142, 83, 236, 147, 114, 138, 141, 227, 20, 251,
-			164, 156, 98, 227, 56, 197, 62, 41, 167, 216, 56, 78, 177, 79,
-			194, 20, 251, 50, 40, 7, 227, 230, 128, 157, 249, 148, 165, 255,
-			71, 203, 232, 53, 212, 19, 231, 192, 117, 54, 203, 29, 215, 103,
-			241, 48, 126, 58, 8, 249, 233, 172, 235, 211, 135, 183, 182, 174,
-			193, 156, 244, 28, 191, 198, 102, 248, 224, 215, 89, 171, 29, 196,
-			204, 135, 97, 13, 66, 234, 243, 35, 180, 151, 243, 188, 248, 184,
-			50, 122, 66, 246, 159, 182, 37, 39, 172, 87, 202, 91, 192, 28,
-			85, 238, 115, 238, 52, 48, 6, 55, 14, 59, 55, 233, 189, 118,
-			61, 245, 61, 169, 78, 29, 248, 202, 91, 140, 190, 107, 185, 107,
-			235, 155, 91, 146, 152, 120, 255, 255, 41, 107, 240, 8, 94, 197,
-			143, 243, 251, 255, 79, 91, 230, 93, 120, 203, 51, 46, 174, 251,
-			63, 109, 137, 87, 80, 198, 197, 117, 255, 167, 173, 252, 49, 114,
-			74, 148, 208, 108, 243, 183, 45, 115, 178, 112, 152, 27, 7, 177,
-			40, 213, 22, 162, 138, 105, 60, 219, 68, 146, 160, 67, 194, 157,
-			71, 200, 3, 2, 143, 110, 155, 191, 99, 153, 19, 133, 169, 52,
-			233, 120, 28, 65, 25, 150, 13, 3, 101, 240, 49, 136, 18, 212,
-			208, 130, 223, 177, 196, 51, 62, 227, 194, 90, 224, 119, 44, 124,
-			45, 10, 4, 129, 102, 103, 62, 99, 233, 191, 107, 221, 43, 70,
-			29, 4, 223, 103, 18, 6, 2, 193, 247, 25, 75, 68, 184, 28,
-			199, 134, 126, 198, 186, 107, 86, 130, 6, 128, 34, 30, 196, 56,
-			170, 246, 191, 107, 101, 78, 75, 112, 16, 192, 241, 83, 40, 163,
-			199, 161, 222, 207, 126, 15, 101, 244, 56, 106, 227, 159, 77, 154,
-			10, 218, 248, 103, 165, 140, 30, 199, 142, 127, 86, 202, 232, 113,
-			212, 198, 63, 43, 101, 244, 184, 101, 155, 127, 248, 34, 201, 232,
-			113, 144, 209, 127, 40, 101, 244, 56, 202, 232, 63, 148, 50, 122,
-			28, 101, 244, 31, 130, 140, 126, 139, 65, 116, 211, 182, 51, 159,
-			183, 6, 254, 198, 210, 242, 127, 175, 211, 37, 117, 170, 171, 238,
-			206, 65, 42, 56, 106, 135, 155, 144, 77, 221, 223, 40, 42, 137,
-			83, 70, 110, 227, 237, 180, 219, 204, 225, 46, 214, 178, 23, 34,
-			170, 26, 143, 239, 45, 61, 179, 149, 85, 218, 197, 139, 215, 68,
-			108, 58, 30, 134, 38, 29, 177, 56, 8, 60, 25, 237, 50, 18,
-			178, 13, 15, 124, 49, 156, 27, 52, 112, 37, 21, 154, 30, 253,
-			5, 163, 82, 143, 231, 114, 95, 19, 92, 191, 39, 152, 61, 47,
-			33, 30, 149, 231, 87, 24, 188, 125, 9, 218, 139, 23, 5, 138,
-			233, 25, 46, 46, 218, 97, 192, 223, 101, 232, 203, 182, 28, 180,
-			187, 91, 193, 244, 204, 140, 184, 187, 196, 200, 32, 56, 57, 174,
-			167, 195, 225, 169, 152, 121, 50, 224, 30, 15, 21, 101, 107, 182,
-			249, 121, 43, 123, 140, 252, 7, 157, 152, 166, 109, 12, 216, 153,
-			47, 90, 250, 159, 89, 70, 254, 223, 114, 75, 154, 180, 99, 127,
-			79, 124, 189, 228, 174, 16, 67, 40, 138, 112, 34, 106, 20, 121,
-			156, 215, 29, 17, 84, 128, 80, 135, 214, 131, 120, 86, 134, 216,
-			169, 75, 123, 110, 55, 218, 78, 226, 136, 136, 103, 17, 168, 219,
-			104, 164, 74, 167, 81, 250, 169, 32, 123, 116, 186, 206, 252, 32,
-			150, 145, 66, 248, 59, 53, 48, 84, 61, 60, 16, 181, 89, 45,
-			234, 183, 122, 156, 41, 17, 90, 46, 237, 148, 138, 175, 163, 175,
-			22, 15, 164, 163, 77, 204, 107, 138, 244, 213, 133, 170, 19, 150,
-			170, 206, 205, 66, 17, 27, 131, 73, 173, 84, 22, 250, 76, 170,
-			69, 132, 63, 172, 62, 45, 202, 204, 148, 32, 167, 152, 172, 54,
-			70, 160, 254, 162, 69, 248, 203, 94, 54, 143, 64, 253, 167, 150,
-			89, 64, 185, 100, 139, 152, 211, 127, 106, 137, 136, 208, 182, 136,
-			57, 253, 167, 214, 161, 137, 36, 65, 131, 132, 195, 119, 39, 9,
-			6, 36, 208, 19, 10, 167, 102, 155, 95, 178, 204, 147, 42, 3,
-			8, 179, 47, 165, 113, 106, 22, 36, 28, 26, 79, 18, 176, 136,
-			125, 79, 146, 96, 64, 194, 137, 2, 206, 101, 27, 90, 249, 231,
-			150, 206, 227, 162, 218, 216, 198, 63, 151, 18, 199, 198, 195, 181,
-			63, 183, 134, 39, 36, 168, 1, 120, 120, 82, 130, 6, 128, 199,
-			238, 226, 79, 68, 217, 208, 184, 47, 91, 250, 84, 254, 71, 181,
-			212, 75, 39, 207, 195, 77, 69, 24, 169, 189, 166, 19, 35, 23,
-			163, 113, 10, 106, 96, 193, 13, 6, 19, 62, 36, 176, 20, 240,
-			16, 165, 24, 40, 210, 137, 196, 213, 77, 114, 115, 83, 22, 78,
-			42, 226, 209, 40, 62, 121, 133, 209, 170, 120, 131, 74, 246, 5,
-			72, 245, 229, 164, 107, 64, 168, 47, 91, 194, 254, 195, 70, 50,
-			125, 217, 18, 158, 144, 54, 18, 233, 203, 214, 233, 123, 5, 145,
-			116, 219, 252, 138, 165, 207, 136, 143, 32, 150, 191, 146, 96, 210,
-			45, 0, 21, 38, 180, 23, 183, 236, 83, 18, 52, 0, 156, 154,
-			22, 152, 12, 219, 252, 170, 37, 162, 45, 219, 120, 185, 243, 213,
-			4, 147, 97, 1, 40, 44, 253, 108, 188, 220, 249, 170, 53, 126,
-			82, 130, 88, 246, 222, 41, 129, 201, 180, 205, 191, 182, 116, 249,
-			209, 228, 160, 196, 100, 90, 0, 170, 54, 153, 26, 128, 194, 193,
-			205, 198, 171, 145, 191, 78, 88, 192, 178, 205, 255, 102, 233, 178,
-			235, 150, 137, 160, 196, 100, 225, 87, 213, 38, 75, 3, 112, 92,
-			242, 139, 101, 0, 40, 226, 232, 218, 176, 64, 125, 205, 18, 161,
-			147, 109, 60, 206, 255, 90, 130, 41, 99, 1, 168, 218, 148, 209,
-			0, 180, 79, 72, 208, 0, 240, 212, 105, 242, 57, 141, 232, 230,
-			132, 157, 249, 150, 53, 240, 223, 51, 90, 254, 41, 90, 246, 107,
-			78, 59, 18, 241, 79, 111, 239, 201, 116, 30, 204, 43, 12, 90,
-			210, 4, 139, 208, 135, 92, 143, 245, 5, 44, 166, 123, 78, 42,
-			158, 75, 137, 44, 62, 249, 189, 140, 56, 219, 251, 128, 59, 54,
-			156, 75, 225, 9, 205, 54, 191, 101, 101, 239, 36, 111, 24, 39,
-			166, 57, 1, 51, 240, 67, 25, 253, 68, 254, 175, 115, 116, 137,
-			174, 6, 242, 110, 44, 137, 206, 235, 208, 182, 203, 184, 185, 66,
-			47, 70, 234, 244, 6, 100, 131, 190, 18, 126, 103, 23, 181, 3,
-			159, 219, 55, 58, 233, 235, 222, 36, 232, 173, 114, 175, 233, 125,
-			237, 157, 199, 121, 20, 175, 117, 36, 207, 135, 196, 1, 173, 172,
-			148, 241, 169, 168, 186, 120, 146, 137, 133, 81, 177, 207, 27, 48,
-			241, 15, 23, 129, 210, 220, 150, 235, 57, 33, 81, 239, 196, 139,
-			183, 190, 48, 224, 98, 145, 70, 78, 23, 54, 0, 220, 181, 139,
-			119, 65, 57, 36, 220, 210, 205, 12, 200, 42, 130, 89, 5, 129,
-			242, 46, 120, 134, 208, 85, 134, 30, 113, 65, 112, 131, 58, 49,
-			15, 209, 155, 152, 2, 39, 253, 70, 236, 207, 135, 234, 9, 225,
-			170, 240, 196, 19, 234, 15, 252, 247, 196, 19, 240, 209, 17, 31,
-			171, 53, 252, 83, 103, 148, 54, 40, 221, 105, 186, 4, 182, 75,
-			42, 216, 172, 10, 226, 67, 61, 49, 158, 220, 107, 35, 106, 59,
-			62, 165, 24, 176, 135, 246, 254, 75, 175, 50, 148, 190, 218, 41,
-			186, 51, 148, 190, 154, 158, 47, 210, 249, 34, 93, 44, 210, 121,
-			250, 26, 204, 7, 130, 117, 175, 25, 120, 251, 59, 86, 18, 5,
-			171, 125, 5, 139, 244, 60, 148, 133, 130, 158, 83, 101, 30, 157,
-			150, 189, 159, 225, 69, 106, 197, 250, 190, 34, 247, 201, 34, 252,
-			149, 57, 78, 38, 145, 159, 21, 27, 251, 242, 47, 200, 252, 60,
-			52, 105, 35, 8, 68, 230, 157, 98, 115, 95, 230, 115, 42, 51,
-			143, 234, 57, 189, 48, 35, 223, 152, 0, 50, 205, 210, 37, 69,
-			54, 97, 246, 161, 98, 136, 43, 75, 94, 97, 67, 20, 71, 204,
-			107, 136, 87, 240, 132, 137, 4, 134, 170, 163, 105, 166, 231, 47,
-			136, 137, 152, 182, 110, 60, 147, 242, 44, 147, 119, 212, 34, 196,
-			26, 122, 53, 4, 13, 105, 164, 27, 241, 112, 207, 148, 194, 38,
-			152, 91, 31, 49, 191, 230, 5, 194, 240, 67, 89, 232, 114, 119,
-			51, 174, 193, 148, 104, 47, 147, 163, 25, 99, 236, 134, 73, 80,
-			84, 52, 224, 173, 221, 160, 211, 237, 32, 138, 220, 170, 215, 77,
-			63, 101, 166, 172, 123, 18, 205, 39, 21, 168, 154, 171, 125, 24,
-			168, 148, 251, 67, 10, 43, 25, 69, 174, 189, 38, 108, 29, 145,
-			191, 144, 106, 234, 34, 168, 144, 104, 248, 5, 69, 69, 220, 241,
-			43, 43, 87, 12, 76, 228, 115, 106, 149, 96, 24, 174, 202, 182,
-			40, 38, 78, 246, 98, 202, 85, 15, 234, 146, 4, 229, 102, 172,
-			145, 180, 99, 229, 212, 73, 209, 47, 253, 182, 4, 62, 129, 214,
-			14, 113, 135, 10, 21, 243, 152, 204, 170, 251, 24, 66, 75, 4,
-			164, 167, 173, 32, 194, 67, 133, 160, 186, 235, 6, 157, 72, 18,
-			87, 62, 18, 200, 251, 86, 47, 8, 186, 58, 59, 142, 235, 171,
-			200, 182, 50, 24, 114, 58, 142, 111, 122, 24, 122, 95, 192, 136,
-			106, 65, 155, 21, 185, 165, 54, 218, 165, 168, 72, 193, 7, 244,
-			186, 151, 85, 167, 34, 62, 189, 165, 157, 23, 247, 180, 193, 240,
-			180, 130, 171, 220, 56, 18, 138, 171, 200, 203, 121, 133, 183, 72,
-			176, 75, 170, 63, 140, 21, 184, 133, 90, 15, 47, 36, 4, 148,
-			123, 32, 110, 143, 7, 88, 170, 108, 199, 245, 145, 141, 132, 214,
-			213, 79, 25, 238, 9, 28, 53, 157, 144, 111, 45, 250, 34, 77,
-			75, 187, 45, 30, 33, 23, 203, 96, 39, 31, 225, 230, 69, 220,
-			76, 202, 57, 168, 199, 233, 110, 70, 65, 75, 6, 16, 237, 203,
-			9, 152, 213, 198, 174, 197, 28, 245, 44, 12, 71, 1, 155, 38,
-			230, 215, 157, 3, 38, 17, 45, 224, 115, 195, 5, 177, 131, 69,
-			33, 137, 239, 138, 58, 92, 66, 65, 207, 82, 129, 195, 213, 204,
-			236, 121, 204, 36, 9, 201, 153, 112, 52, 32, 74, 22, 76, 151,
-			191, 197, 38, 67, 130, 8, 19, 114, 64, 42, 98, 217, 185, 33,
-			238, 40, 3, 63, 177, 31, 85, 47, 161, 208, 89, 186, 156, 68,
-			169, 226, 143, 237, 160, 211, 135, 208, 123, 83, 211, 72, 104, 168,
-			237, 48, 168, 58, 85, 110, 45, 83, 103, 145, 187, 227, 227, 57,
-			24, 134, 154, 198, 99, 66, 26, 227, 124, 150, 84, 146, 39, 7,
-			60, 118, 74, 236, 248, 245, 34, 40, 197, 232, 183, 192, 45, 162,
-			131, 70, 170, 150, 26, 183, 199, 161, 252, 45, 148, 90, 16, 214,
-			83, 113, 58, 209, 245, 68, 40, 199, 19, 168, 247, 127, 40, 163,
-			43, 48, 3, 160, 208, 251, 39, 80, 239, 255, 80, 230, 240, 93,
-			18, 52, 0, 60, 78, 241, 128, 101, 2, 118, 145, 31, 206, 232,
-			223, 202, 112, 243, 231, 9, 220, 8, 125, 56, 67, 108, 242, 230,
-			65, 146, 1, 24, 52, 156, 223, 200, 152, 197, 252, 223, 102, 210,
-			143, 14, 136, 176, 232, 78, 24, 75, 118, 189, 149, 142, 38, 125,
-			190, 197, 243, 67, 68, 245, 17, 35, 162, 167, 188, 42, 196, 217,
-			105, 234, 36, 84, 188, 6, 198, 133, 154, 8, 225, 134, 70, 122,
-			14, 151, 146, 160, 36, 114, 15, 235, 48, 8, 226, 3, 91, 32,
-			163, 236, 128, 68, 18, 246, 76, 113, 207, 251, 1, 52, 168, 213,
-			58, 248, 6, 125, 15, 211, 226, 41, 10, 84, 130, 75, 63, 46,
-			129, 231, 112, 9, 124, 0, 215, 75, 146, 72, 232, 139, 92, 16,
-			123, 172, 36, 125, 232, 97, 52, 167, 207, 205, 80, 58, 55, 135,
-			229, 164, 7, 101, 9, 251, 54, 253, 192, 140, 82, 28, 230, 230,
-			16, 165, 202, 0, 11, 240, 244, 76, 74, 179, 152, 155, 163, 11,
-			137, 217, 163, 156, 197, 7, 116, 180, 167, 114, 30, 73, 32, 77,
-			200, 243, 216, 74, 181, 0, 247, 83, 169, 167, 240, 131, 244, 252,
-			37, 130, 115, 166, 191, 14, 142, 114, 31, 242, 197, 94, 228, 7,
-			61, 47, 66, 165, 219, 231, 162, 64, 125, 224, 27, 36, 168, 127,
-			236, 67, 191, 112, 160, 214, 135, 121, 133, 27, 105, 34, 26, 184,
-			103, 62, 50, 71, 242, 8, 169, 90, 96, 131, 228, 209, 57, 113,
-			168, 81, 105, 128, 18, 43, 76, 104, 56, 43, 121, 78, 20, 75,
-			150, 220, 55, 248, 48, 242, 138, 53, 250, 86, 233, 94, 205, 46,
-			17, 245, 211, 42, 60, 164, 18, 241, 68, 78, 26, 174, 212, 73,
-			169, 133, 30, 54, 242, 34, 174, 229, 214, 2, 47, 240, 103, 132,
-			29, 255, 132, 56, 132, 248, 141, 140, 56, 48, 152, 16, 135, 16,
-			191, 145, 17, 207, 82, 77, 136, 67, 136, 223, 200, 76, 28, 73,
-			18, 12, 72, 200, 31, 75, 18, 178, 144, 112, 215, 89, 146, 35,
-			89, 145, 160, 67, 202, 221, 103, 200, 239, 234, 98, 202, 107, 182,
-			249, 91, 48, 229, 127, 93, 151, 62, 167, 77, 124, 131, 130, 111,
-			215, 227, 102, 200, 24, 127, 53, 160, 19, 42, 109, 235, 162, 8,
-			248, 237, 185, 62, 236, 14, 240, 119, 45, 240, 58, 45, 191, 72,
-			40, 44, 216, 240, 33, 81, 95, 139, 41, 139, 93, 39, 138, 58,
-			45, 86, 231, 139, 179, 19, 165, 16, 205, 20, 177, 40, 199, 163,
-			30, 184, 112, 66, 229, 54, 230, 250, 60, 66, 46, 95, 48, 56,
-			237, 209, 91, 75, 56, 130, 213, 186, 37, 154, 178, 137, 6, 156,
-			156, 255, 56, 74, 117, 13, 2, 40, 111, 178, 48, 152, 229, 135,
-			251, 160, 127, 40, 155, 245, 110, 208, 225, 139, 197, 158, 8, 100,
-			224, 212, 235, 132, 46, 160, 251, 25, 136, 47, 113, 27, 82, 119,
-			163, 182, 231, 116, 93, 249, 34, 104, 135, 187, 209, 74, 186, 107,
-			38, 18, 53, 25, 59, 205, 130, 132, 212, 216, 105, 72, 246, 212,
-			216, 105, 6, 36, 164, 198, 78, 203, 66, 66, 106, 236, 52, 24,
-			187, 223, 130, 177, 251, 192, 136, 24, 59, 221, 54, 255, 46, 99,
-			206, 228, 159, 29, 81, 161, 236, 55, 113, 223, 9, 203, 91, 197,
-			111, 4, 189, 7, 127, 42, 160, 109, 138, 99, 101, 224, 107, 7,
-			195, 123, 117, 97, 83, 218, 18, 26, 53, 106, 45, 110, 114, 5,
-			132, 75, 55, 190, 213, 201, 165, 106, 194, 245, 98, 239, 142, 5,
-			164, 217, 181, 124, 144, 69, 50, 124, 122, 129, 39, 132, 46, 97,
-			12, 93, 177, 16, 226, 81, 247, 254, 122, 3, 31, 237, 164, 89,
-			173, 131, 86, 167, 144, 45, 226, 161, 75, 169, 31, 112, 158, 34,
-			252, 16, 170, 175, 20, 95, 140, 85, 126, 126, 181, 23, 139, 243,
-			102, 124, 88, 85, 237, 7, 176, 82, 104, 144, 199, 28, 208, 42,
-			182, 235, 140, 183, 123, 91, 53, 8, 17, 220, 96, 172, 13, 75,
-			160, 179, 19, 58, 237, 38, 54, 91, 101, 64, 118, 227, 13, 32,
-			146, 88, 211, 213, 78, 140, 218, 83, 45, 240, 125, 238, 71, 16,
-			7, 51, 252, 164, 155, 251, 0, 200, 217, 84, 226, 11, 163, 194,
-			141, 206, 29, 242, 60, 182, 218, 229, 209, 151, 250, 59, 19, 36,
-			20, 75, 150, 84, 174, 48, 36, 123, 6, 229, 121, 190, 142, 94,
-			59, 205, 164, 136, 112, 172, 73, 71, 197, 185, 164, 62, 182, 156,
-			240, 6, 204, 19, 126, 14, 62, 55, 55, 195, 55, 87, 17, 190,
-			71, 202, 112, 23, 32, 212, 62, 174, 172, 74, 58, 20, 37, 13,
-			129, 31, 98, 17, 105, 11, 153, 198, 167, 78, 20, 179, 208, 141,
-			110, 36, 175, 28, 43, 116, 251, 37, 38, 110, 236, 48, 30, 43,
-			48, 70, 144, 56, 112, 242, 99, 130, 48, 138, 75, 132, 174, 177,
-			61, 164, 9, 114, 174, 240, 215, 77, 124, 125, 241, 193, 44, 254,
-			12, 139, 124, 52, 162, 103, 97, 193, 216, 48, 234, 52, 1, 215,
-			222, 101, 209, 253, 52, 227, 54, 130, 0, 119, 199, 183, 248, 92,
-			117, 194, 210, 1, 104, 171, 78, 200, 87, 191, 131, 214, 178, 170,
-			115, 147, 62, 72, 207, 93, 122, 94, 180, 55, 101, 173, 75, 190,
-			208, 207, 129, 18, 251, 242, 60, 15, 142, 150, 106, 249, 11, 97,
-			146, 57, 83, 15, 234, 118, 170, 30, 236, 229, 2, 174, 30, 8,
-			4, 43, 98, 98, 40, 62, 225, 111, 31, 133, 59, 169, 215, 97,
-			129, 225, 37, 19, 4, 33, 141, 67, 199, 69, 207, 17, 201, 34,
-			2, 21, 175, 149, 202, 242, 233, 7, 90, 67, 46, 138, 170, 158,
-			227, 223, 224, 76, 47, 103, 131, 240, 148, 229, 122, 32, 162, 129,
-			125, 69, 233, 133, 155, 151, 76, 45, 186, 88, 58, 112, 76, 120,
-			182, 7, 233, 125, 124, 84, 206, 208, 203, 105, 198, 86, 212, 66,
-			213, 237, 12, 15, 205, 143, 221, 166, 171, 162, 175, 146, 189, 35,
-			145, 69, 50, 185, 80, 54, 74, 244, 204, 220, 243, 98, 22, 123,
-			9, 122, 134, 238, 132, 24, 168, 82, 20, 232, 99, 44, 254, 145,
-			62, 72, 239, 87, 163, 34, 172, 20, 104, 189, 175, 251, 81, 106,
-			57, 210, 77, 92, 39, 146, 229, 72, 183, 32, 65, 220, 103, 96,
-			130, 6, 9, 135, 79, 37, 9, 6, 36, 76, 77, 227, 125, 6,
-			36, 24, 182, 249, 205, 140, 121, 70, 101, 48, 76, 76, 72, 112,
-			26, 22, 36, 164, 112, 26, 26, 36, 28, 62, 157, 36, 32, 142,
-			233, 25, 133, 211, 180, 205, 191, 207, 152, 139, 42, 131, 201, 19,
-			18, 156, 166, 5, 9, 41, 156, 166, 6, 9, 135, 103, 147, 4,
-			3, 18, 230, 23, 200, 95, 106, 68, 55, 15, 219, 153, 55, 14,
-			14, 188, 107, 80, 203, 255, 151, 255, 143, 189, 55, 15, 143, 35,
-			185, 238, 4, 43, 50, 163, 10, 133, 192, 29, 4, 64, 48, 121,
-			5, 139, 100, 3, 32, 129, 2, 8, 30, 205, 163, 47, 16, 0,
-			187, 209, 13, 130, 236, 2, 216, 221, 146, 220, 141, 78, 84, 37,
-			128, 20, 171, 42, 171, 43, 179, 0, 66, 189, 109, 75, 242, 72,
-			163, 195, 26, 235, 248, 36, 91, 30, 91, 30, 89, 182, 108, 141,
-			118, 44, 91, 94, 31, 210, 248, 147, 101, 91, 43, 91, 242, 72,
-			227, 107, 180, 94, 123, 45, 217, 227, 67, 182, 198, 163, 217, 111,
-			53, 182, 101, 173, 173, 221, 47, 94, 28, 25, 89, 40, 144, 84,
-			187, 173, 249, 188, 219, 255, 144, 120, 89, 153, 113, 188, 136, 120,
-			241, 226, 197, 123, 191, 135, 140, 76, 126, 66, 42, 150, 197, 97,
-			107, 195, 175, 177, 85, 47, 218, 242, 188, 106, 83, 60, 153, 56,
-			117, 71, 97, 179, 145, 90, 37, 138, 152, 142, 113, 231, 245, 254,
-			106, 162, 199, 132, 97, 80, 244, 93, 125, 243, 165, 243, 227, 232,
-			90, 136, 105, 245, 142, 175, 204, 85, 158, 7, 208, 48, 1, 141,
-			159, 79, 177, 216, 87, 88, 126, 148, 192, 7, 17, 6, 234, 126,
-			68, 241, 119, 183, 101, 247, 145, 91, 4, 227, 126, 126, 122, 123,
-			99, 155, 117, 220, 121, 53, 155, 174, 178, 105, 237, 244, 162, 182,
-			160, 80, 28, 248, 193, 18, 192, 85, 82, 239, 22, 236, 25, 77,
-			76, 0, 195, 156, 218, 83, 136, 178, 134, 40, 32, 169, 234, 122,
-			50, 97, 9, 156, 36, 251, 65, 89, 125, 99, 155, 165, 201, 12,
-			39, 59, 6, 20, 137, 56, 57, 200, 20, 105, 115, 242, 232, 49,
-			56, 149, 246, 243, 83, 233, 191, 108, 179, 190, 175, 77, 156, 74,
-			251, 225, 84, 250, 47, 219, 72, 63, 249, 94, 68, 50, 156, 230,
-			253, 122, 75, 27, 30, 115, 190, 203, 60, 148, 130, 167, 102, 114,
-			231, 107, 190, 92, 72, 228, 204, 142, 243, 141, 129, 151, 177, 176,
-			165, 195, 182, 172, 76, 69, 110, 179, 246, 148, 87, 54, 254, 188,
-			176, 34, 193, 172, 235, 151, 186, 249, 91, 218, 228, 68, 237, 151,
-			186, 249, 91, 218, 164, 126, 215, 47, 117, 243, 183, 180, 73, 253,
-			174, 95, 234, 230, 111, 105, 147, 250, 93, 191, 212, 205, 223, 210,
-			38, 245, 187, 126, 165, 155, 191, 165, 237, 224, 9, 114, 77, 246,
-			27, 81, 252, 182, 54, 124, 204, 121, 176, 185, 223, 48, 7, 0,
-			153, 91, 156, 77, 164, 138, 213, 186, 255, 70, 187, 185, 94, 250,
-			54, 179, 221, 92, 47, 125, 91, 155, 92, 96, 253, 82, 47, 125,
-			91, 91, 255, 225, 248, 129, 205, 31, 228, 142, 146, 45, 217, 40,
-			139, 226, 119, 180, 225, 131, 206, 122, 115, 163, 64, 147, 23, 187,
-			247, 90, 232, 193, 160, 172, 110, 71, 113, 226, 218, 228, 60, 147,
-			104, 228, 176, 44, 99, 47, 120, 227, 26, 81, 220, 198, 27, 141,
-			231, 226, 226, 29, 102, 227, 185, 20, 123, 135, 201, 116, 206, 176,
-			119, 180, 201, 76, 192, 253, 82, 138, 189, 163, 109, 255, 1, 242,
-			7, 106, 42, 217, 20, 191, 167, 13, 239, 119, 62, 139, 118, 204,
-			37, 233, 92, 118, 55, 141, 151, 145, 179, 183, 105, 60, 148, 34,
-			0, 122, 188, 170, 162, 141, 251, 78, 46, 23, 106, 110, 24, 25,
-			199, 208, 186, 87, 246, 54, 33, 155, 250, 118, 228, 177, 17, 153,
-			168, 79, 96, 74, 168, 35, 36, 172, 217, 251, 161, 200, 113, 161,
-			47, 141, 26, 28, 226, 50, 249, 61, 38, 135, 184, 76, 126, 143,
-			201, 33, 46, 147, 223, 211, 38, 51, 25, 247, 75, 153, 252, 158,
-			182, 125, 14, 249, 204, 81, 114, 88, 128, 207, 79, 184, 53, 127,
-			2, 22, 202, 138, 66, 12, 16, 19, 137, 18, 137, 78, 239, 214,
-			124, 71, 34, 213, 79, 40, 164, 250, 137, 216, 145, 66, 188, 125,
-			226, 231, 16, 233, 130, 147, 255, 101, 89, 10, 61, 68, 156, 43,
-			243, 115, 11, 179, 43, 151, 231, 30, 153, 126, 98, 254, 90, 97,
-			229, 198, 226, 210, 245, 185, 153, 249, 43, 243, 115, 179, 189, 41,
-			218, 73, 178, 42, 237, 122, 47, 226, 84, 97, 238, 241, 27, 243,
-			133, 185, 217, 94, 139, 246, 144, 142, 107, 55, 150, 175, 223, 88,
-			94, 185, 182, 184, 240, 138, 94, 155, 118, 19, 50, 191, 168, 105,
-			76, 187, 72, 251, 252, 213, 171, 55, 150, 167, 47, 47, 204, 245,
-			166, 41, 37, 221, 55, 22, 175, 21, 102, 231, 10, 115, 179, 43,
-			11, 243, 75, 203, 189, 25, 58, 64, 250, 22, 175, 45, 174, 204,
-			93, 189, 190, 252, 138, 149, 217, 185, 43, 211, 55, 22, 150, 123,
-			219, 160, 164, 217, 185, 197, 101, 222, 140, 66, 111, 246, 226, 179,
-			164, 59, 217, 125, 122, 48, 223, 140, 203, 15, 29, 147, 206, 27,
-			67, 63, 152, 101, 246, 72, 247, 212, 190, 124, 204, 159, 124, 162,
-			231, 133, 174, 53, 147, 188, 92, 35, 221, 197, 160, 98, 188, 126,
-			153, 38, 222, 7, 11, 201, 117, 244, 202, 105, 249, 198, 122, 80,
-			118, 171, 235, 249, 160, 190, 62, 177, 238, 85, 161, 17, 19, 226,
-			39, 183, 230, 135, 48, 96, 134, 143, 227, 37, 227, 239, 31, 177,
-			240, 195, 211, 215, 231, 31, 253, 207, 135, 73, 134, 226, 238, 212,
-			58, 34, 191, 136, 9, 234, 164, 118, 119, 138, 78, 253, 52, 102,
-			51, 65, 109, 187, 238, 175, 111, 68, 108, 106, 114, 234, 180, 244,
-			55, 100, 11, 11, 51, 132, 176, 5, 191, 232, 85, 33, 76, 178,
-			90, 146, 167, 190, 233, 26, 215, 50, 212, 47, 99, 236, 9, 129,
-			136, 195, 166, 242, 147, 108, 4, 12, 217, 242, 167, 220, 232, 37,
-			2, 167, 105, 149, 150, 210, 200, 210, 8, 151, 38, 69, 175, 6,
-			203, 76, 0, 93, 186, 213, 162, 23, 99, 111, 202, 50, 242, 4,
-			176, 54, 33, 85, 244, 42, 108, 145, 252, 12, 91, 83, 49, 219,
-			234, 53, 230, 70, 68, 88, 213, 54, 162, 168, 118, 113, 98, 98,
-			107, 107, 43, 239, 66, 67, 129, 101, 101, 241, 90, 56, 177, 48,
-			63, 51, 183, 184, 52, 55, 62, 149, 159, 36, 132, 221, 168, 66,
-			80, 171, 14, 120, 93, 221, 86, 217, 19, 185, 246, 91, 118, 183,
-			192, 34, 185, 94, 151, 144, 95, 126, 85, 97, 198, 140, 177, 48,
-			88, 139, 182, 224, 80, 84, 242, 185, 10, 185, 218, 136, 18, 92,
-			82, 13, 147, 217, 215, 213, 11, 1, 132, 166, 230, 166, 151, 216,
-			252, 82, 142, 93, 158, 94, 154, 95, 26, 35, 236, 201, 249, 229,
-			71, 174, 221, 88, 102, 79, 78, 23, 10, 211, 139, 203, 243, 115,
-			75, 236, 90, 129, 205, 92, 91, 156, 157, 231, 107, 97, 137, 93,
-			187, 194, 166, 23, 95, 193, 30, 155, 95, 156, 29, 83, 1, 190,
-			222, 45, 126, 174, 15, 193, 129, 17, 188, 249, 74, 6, 242, 171,
-			170, 94, 251, 147, 171, 36, 15, 26, 23, 109, 61, 216, 244, 234,
-			112, 212, 2, 196, 142, 80, 230, 175, 172, 150, 8, 3, 236, 26,
-			105, 151, 222, 209, 163, 60, 33, 89, 130, 44, 106, 247, 166, 246,
-			144, 118, 98, 217, 41, 106, 211, 212, 9, 254, 48, 75, 237, 254,
-			212, 83, 252, 97, 182, 67, 252, 41, 30, 14, 164, 114, 240, 144,
-			136, 63, 197, 195, 193, 212, 105, 120, 40, 255, 20, 15, 247, 166,
-			134, 225, 33, 18, 127, 138, 135, 67, 242, 243, 99, 234, 79, 212,
-			70, 177, 147, 26, 65, 228, 247, 109, 98, 181, 165, 168, 61, 108,
-			93, 116, 62, 111, 179, 105, 105, 152, 55, 172, 127, 186, 223, 10,
-			219, 66, 226, 159, 140, 168, 49, 31, 83, 153, 149, 185, 174, 54,
-			6, 25, 121, 70, 193, 21, 75, 173, 243, 4, 80, 104, 242, 40,
-			217, 100, 154, 100, 175, 26, 49, 86, 127, 82, 126, 140, 178, 251,
-			153, 18, 101, 79, 195, 17, 99, 41, 114, 35, 153, 223, 229, 110,
-			62, 54, 36, 159, 248, 190, 89, 28, 205, 54, 164, 37, 38, 138,
-			202, 188, 64, 177, 28, 238, 80, 106, 44, 62, 91, 23, 186, 236,
-			87, 188, 48, 114, 43, 53, 62, 219, 252, 186, 183, 18, 249, 162,
-			175, 119, 85, 186, 209, 230, 49, 105, 243, 190, 67, 115, 148, 244,
-			126, 250, 18, 33, 132, 216, 109, 41, 139, 218, 78, 219, 81, 241,
-			55, 230, 3, 45, 159, 103, 168, 61, 220, 33, 159, 35, 106, 15,
-			31, 155, 18, 127, 219, 212, 30, 62, 123, 129, 124, 197, 34, 86,
-			58, 69, 241, 100, 106, 29, 57, 127, 104, 65, 196, 126, 181, 228,
-			23, 1, 154, 76, 138, 14, 19, 11, 199, 149, 121, 233, 197, 44,
-			25, 89, 75, 94, 13, 184, 145, 178, 35, 75, 44, 33, 41, 46,
-			64, 97, 127, 174, 225, 133, 145, 136, 11, 22, 101, 184, 161, 154,
-			82, 128, 79, 34, 15, 103, 46, 215, 35, 106, 141, 104, 84, 193,
-			26, 156, 56, 161, 110, 215, 78, 156, 48, 129, 185, 117, 179, 212,
-			28, 44, 6, 101, 38, 115, 88, 202, 59, 244, 75, 252, 16, 44,
-			124, 43, 133, 163, 93, 152, 252, 146, 31, 98, 184, 196, 148, 112,
-			26, 27, 193, 22, 155, 190, 62, 15, 142, 29, 124, 190, 110, 184,
-			213, 82, 89, 171, 145, 10, 153, 16, 28, 211, 151, 117, 144, 213,
-			137, 19, 21, 119, 251, 196, 9, 86, 247, 138, 158, 191, 233, 1,
-			82, 107, 34, 49, 112, 124, 43, 69, 136, 157, 230, 131, 48, 153,
-			166, 228, 65, 130, 211, 92, 91, 183, 167, 172, 35, 206, 20, 155,
-			9, 170, 155, 92, 35, 18, 70, 5, 233, 213, 12, 220, 133, 8,
-			62, 35, 174, 24, 54, 6, 112, 90, 77, 167, 65, 119, 182, 167,
-			172, 3, 138, 178, 168, 61, 117, 152, 145, 247, 35, 40, 29, 81,
-			251, 94, 171, 199, 121, 23, 146, 16, 74, 210, 110, 171, 88, 161,
-			76, 254, 110, 168, 207, 206, 121, 194, 158, 4, 168, 13, 0, 230,
-			16, 184, 23, 173, 216, 235, 214, 61, 22, 91, 172, 35, 5, 52,
-			176, 170, 229, 167, 132, 149, 97, 94, 165, 182, 225, 134, 190, 192,
-			28, 136, 115, 101, 234, 246, 35, 104, 163, 166, 44, 106, 223, 219,
-			213, 77, 62, 41, 218, 111, 81, 251, 62, 171, 199, 249, 40, 63,
-			159, 238, 104, 178, 154, 92, 26, 253, 66, 76, 91, 207, 0, 115,
-			17, 239, 158, 56, 81, 105, 132, 124, 238, 172, 122, 49, 172, 181,
-			27, 38, 238, 232, 228, 236, 28, 19, 54, 240, 53, 215, 47, 67,
-			122, 222, 128, 149, 2, 22, 202, 28, 88, 242, 162, 184, 202, 188,
-			122, 157, 11, 198, 134, 76, 20, 251, 236, 252, 226, 19, 211, 11,
-			243, 179, 43, 211, 133, 135, 111, 92, 157, 91, 92, 126, 118, 84,
-			119, 143, 15, 193, 125, 186, 123, 208, 161, 174, 110, 242, 15, 162,
-			123, 54, 181, 47, 91, 212, 249, 111, 45, 187, 103, 8, 219, 59,
-			246, 208, 196, 235, 134, 165, 22, 214, 2, 190, 137, 143, 73, 224,
-			159, 98, 185, 161, 34, 28, 136, 121, 157, 44, 123, 157, 200, 48,
-			40, 52, 19, 153, 31, 93, 112, 78, 93, 219, 250, 145, 216, 245,
-			224, 41, 172, 71, 145, 219, 47, 230, 137, 43, 64, 61, 67, 64,
-			202, 50, 64, 11, 135, 67, 153, 126, 171, 232, 197, 188, 177, 17,
-			239, 127, 151, 162, 44, 106, 95, 238, 237, 35, 239, 19, 188, 193,
-			212, 126, 216, 234, 115, 222, 222, 146, 55, 32, 31, 94, 36, 107,
-			148, 20, 146, 87, 223, 164, 233, 158, 92, 127, 197, 87, 155, 105,
-			41, 213, 86, 82, 209, 92, 140, 120, 3, 59, 21, 101, 81, 251,
-			225, 158, 94, 242, 1, 209, 248, 52, 181, 23, 172, 94, 231, 221,
-			173, 27, 95, 169, 52, 34, 174, 54, 221, 177, 237, 106, 69, 121,
-			188, 175, 69, 47, 57, 102, 0, 231, 225, 65, 214, 79, 2, 126,
-			222, 252, 20, 27, 219, 73, 36, 254, 147, 16, 151, 37, 97, 44,
-			132, 251, 8, 221, 131, 52, 226, 173, 236, 80, 148, 69, 237, 133,
-			238, 30, 242, 46, 11, 122, 144, 161, 118, 193, 26, 112, 222, 96,
-			233, 30, 72, 225, 62, 162, 44, 230, 163, 6, 142, 107, 149, 53,
-			170, 128, 23, 227, 149, 88, 217, 7, 139, 243, 110, 61, 83, 49,
-			129, 188, 141, 42, 11, 180, 97, 157, 208, 152, 78, 188, 24, 208,
-			46, 220, 234, 54, 115, 235, 171, 126, 84, 119, 235, 219, 76, 192,
-			210, 140, 177, 186, 155, 52, 116, 75, 180, 26, 145, 7, 168, 174,
-			15, 247, 92, 240, 235, 244, 113, 108, 90, 195, 49, 149, 101, 22,
-			10, 94, 203, 112, 40, 191, 6, 213, 185, 110, 114, 47, 148, 227,
-			36, 121, 148, 65, 156, 43, 189, 138, 178, 168, 93, 216, 211, 79,
-			94, 39, 56, 214, 70, 237, 167, 172, 33, 231, 107, 40, 201, 49,
-			3, 115, 67, 56, 201, 135, 50, 171, 165, 184, 169, 80, 146, 94,
-			194, 226, 173, 41, 184, 219, 93, 56, 40, 145, 129, 160, 143, 178,
-			95, 242, 64, 14, 165, 169, 108, 48, 241, 52, 225, 2, 205, 168,
-			78, 188, 16, 27, 92, 133, 215, 190, 56, 122, 67, 161, 38, 118,
-			152, 187, 5, 82, 126, 13, 156, 153, 201, 142, 82, 68, 58, 109,
-			97, 118, 16, 28, 105, 67, 156, 7, 123, 20, 101, 81, 251, 169,
-			193, 189, 228, 235, 24, 248, 147, 165, 246, 154, 213, 231, 124, 5,
-			55, 243, 199, 75, 74, 36, 105, 142, 25, 113, 181, 185, 80, 158,
-			208, 164, 169, 144, 48, 67, 49, 82, 175, 143, 106, 175, 55, 125,
-			175, 35, 203, 1, 149, 51, 10, 88, 163, 234, 63, 215, 128, 72,
-			53, 97, 120, 144, 25, 238, 212, 123, 121, 184, 162, 153, 158, 191,
-			62, 174, 142, 88, 17, 64, 28, 203, 189, 173, 9, 48, 88, 0,
-			188, 169, 219, 58, 194, 158, 229, 181, 60, 171, 16, 223, 147, 45,
-			208, 16, 94, 90, 233, 48, 210, 33, 36, 203, 74, 218, 52, 85,
-			102, 4, 81, 76, 236, 198, 100, 192, 8, 169, 100, 7, 218, 152,
-			82, 143, 81, 231, 227, 15, 33, 87, 181, 240, 244, 138, 237, 164,
-			73, 45, 159, 72, 204, 66, 35, 98, 80, 201, 25, 95, 38, 117,
-			89, 245, 74, 82, 18, 138, 171, 46, 72, 138, 37, 4, 144, 138,
-			205, 170, 2, 44, 131, 31, 107, 19, 34, 7, 82, 85, 164, 207,
-			22, 16, 94, 252, 179, 27, 181, 146, 249, 153, 168, 65, 109, 231,
-			163, 121, 54, 175, 210, 25, 5, 107, 242, 165, 113, 125, 58, 49,
-			142, 235, 18, 33, 244, 217, 216, 30, 241, 172, 89, 144, 158, 150,
-			252, 140, 180, 166, 69, 117, 214, 162, 246, 90, 79, 239, 106, 70,
-			192, 140, 146, 223, 122, 134, 156, 94, 15, 242, 197, 141, 122, 80,
-			241, 27, 21, 113, 8, 110, 20, 253, 9, 183, 234, 150, 183, 67,
-			95, 102, 66, 159, 216, 60, 53, 81, 12, 42, 149, 160, 42, 237,
-			59, 189, 252, 165, 188, 122, 41, 191, 121, 202, 185, 147, 73, 72,
-			191, 160, 205, 64, 145, 58, 52, 136, 23, 114, 91, 164, 157, 159,
-			35, 10, 92, 102, 211, 115, 36, 235, 185, 245, 178, 239, 133, 209,
-			16, 98, 104, 164, 99, 202, 217, 97, 89, 209, 167, 142, 130, 126,
-			151, 78, 145, 12, 88, 218, 162, 33, 235, 142, 95, 201, 55, 115,
-			231, 72, 231, 178, 23, 70, 5, 216, 185, 231, 75, 116, 144, 100,
-			132, 201, 20, 106, 110, 47, 72, 138, 118, 19, 203, 47, 65, 185,
-			237, 5, 203, 47, 229, 182, 72, 219, 19, 110, 157, 175, 22, 122,
-			134, 216, 37, 111, 109, 8, 49, 123, 164, 99, 42, 151, 111, 230,
-			78, 94, 190, 151, 159, 245, 214, 0, 243, 188, 192, 95, 119, 206,
-			145, 172, 122, 64, 123, 137, 125, 211, 219, 150, 53, 242, 63, 105,
-			63, 73, 131, 172, 145, 53, 10, 226, 162, 117, 30, 229, 206, 16,
-			178, 4, 103, 202, 235, 174, 95, 191, 219, 47, 115, 11, 164, 255,
-			114, 99, 125, 185, 238, 22, 111, 250, 213, 245, 25, 229, 242, 182,
-			107, 119, 15, 144, 118, 237, 23, 39, 75, 138, 31, 228, 206, 147,
-			238, 235, 92, 215, 89, 173, 248, 81, 161, 81, 253, 22, 216, 86,
-			35, 93, 211, 250, 2, 228, 114, 99, 253, 110, 63, 164, 140, 180,
-			151, 253, 234, 205, 149, 200, 187, 21, 13, 217, 252, 241, 101, 251,
-			143, 166, 237, 66, 150, 63, 93, 246, 110, 69, 116, 128, 216, 141,
-			122, 121, 8, 199, 191, 113, 58, 119, 129, 180, 207, 148, 27, 97,
-			228, 213, 231, 75, 188, 91, 110, 121, 61, 168, 251, 209, 134, 170,
-			48, 126, 176, 163, 177, 215, 72, 187, 176, 244, 23, 188, 53, 122,
-			158, 180, 173, 251, 145, 95, 246, 66, 57, 39, 15, 236, 28, 233,
-			135, 197, 11, 5, 111, 237, 145, 84, 65, 189, 126, 57, 171, 186,
-			152, 91, 32, 36, 126, 133, 82, 130, 55, 2, 57, 197, 219, 11,
-			240, 55, 29, 34, 109, 181, 122, 240, 106, 175, 168, 184, 174, 72,
-			62, 210, 117, 111, 77, 116, 189, 192, 255, 60, 241, 3, 136, 116,
-			92, 110, 248, 229, 210, 82, 228, 70, 141, 144, 30, 32, 67, 151,
-			111, 204, 47, 204, 174, 44, 45, 79, 47, 223, 88, 106, 178, 154,
-			14, 145, 254, 196, 175, 75, 55, 102, 102, 230, 150, 150, 122, 209,
-			142, 95, 174, 76, 207, 47, 220, 40, 204, 245, 90, 244, 16, 113,
-			18, 191, 204, 47, 94, 41, 76, 235, 223, 109, 186, 143, 12, 36,
-			126, 159, 153, 94, 156, 153, 91, 152, 155, 237, 197, 39, 190, 23,
-			145, 190, 185, 91, 129, 202, 43, 82, 0, 228, 47, 154, 35, 135,
-			230, 158, 186, 182, 56, 87, 152, 94, 158, 191, 182, 184, 82, 152,
-			155, 94, 186, 182, 216, 212, 208, 65, 66, 175, 205, 204, 220, 40,
-			44, 173, 92, 91, 92, 185, 58, 61, 191, 184, 48, 191, 56, 215,
-			139, 232, 94, 178, 39, 126, 126, 109, 249, 145, 185, 194, 202, 204,
-			194, 82, 175, 69, 123, 73, 231, 226, 181, 229, 149, 153, 194, 252,
-			242, 252, 204, 244, 66, 175, 77, 247, 144, 158, 27, 139, 115, 79,
-			93, 159, 155, 89, 158, 155, 93, 185, 62, 189, 180, 212, 139, 79,
-			60, 77, 122, 150, 96, 198, 70, 94, 233, 138, 95, 142, 188, 58,
-			101, 228, 192, 210, 141, 203, 87, 231, 151, 249, 107, 87, 230, 23,
-			150, 231, 154, 109, 205, 148, 116, 95, 91, 92, 120, 197, 138, 126,
-			173, 23, 209, 126, 210, 11, 207, 110, 44, 198, 79, 173, 19, 175,
-			33, 189, 230, 154, 184, 26, 148, 60, 222, 221, 235, 133, 57, 241,
-			214, 74, 225, 198, 226, 202, 213, 107, 179, 115, 77, 53, 116, 144,
-			182, 217, 194, 43, 248, 175, 194, 152, 125, 229, 198, 194, 2, 80,
-			22, 237, 35, 93, 143, 223, 152, 159, 121, 108, 69, 189, 96, 243,
-			186, 23, 231, 158, 92, 185, 62, 189, 60, 243, 200, 210, 28, 20,
-			218, 139, 79, 252, 8, 34, 212, 172, 92, 78, 136, 99, 132, 37,
-			171, 111, 57, 49, 118, 52, 210, 156, 32, 115, 179, 208, 229, 195,
-			100, 127, 203, 119, 248, 84, 0, 187, 251, 17, 114, 176, 229, 11,
-			122, 70, 216, 151, 79, 191, 242, 212, 221, 237, 54, 151, 212, 147,
-			218, 234, 163, 63, 246, 56, 105, 163, 233, 238, 212, 187, 44, 68,
-			62, 21, 91, 164, 63, 214, 100, 145, 158, 2, 101, 96, 225, 198,
-			204, 60, 155, 6, 244, 82, 176, 196, 253, 179, 51, 76, 191, 108,
-			153, 254, 54, 90, 166, 247, 197, 150, 233, 81, 248, 19, 81, 123,
-			79, 106, 84, 219, 163, 31, 81, 70, 106, 254, 231, 49, 98, 225,
-			20, 197, 67, 169, 67, 200, 25, 98, 211, 172, 14, 182, 56, 62,
-			142, 74, 163, 8, 133, 149, 11, 167, 16, 181, 135, 178, 125, 228,
-			62, 130, 33, 254, 222, 118, 172, 81, 103, 66, 160, 157, 151, 75,
-			112, 126, 213, 6, 83, 51, 133, 128, 212, 61, 121, 185, 66, 127,
-			227, 95, 103, 248, 231, 251, 21, 133, 168, 237, 28, 56, 166, 40,
-			155, 218, 206, 176, 200, 54, 10, 246, 174, 131, 214, 176, 115, 31,
-			155, 151, 229, 9, 4, 102, 221, 56, 121, 136, 21, 121, 207, 125,
-			17, 98, 89, 46, 197, 231, 72, 101, 87, 227, 69, 101, 120, 89,
-			170, 82, 196, 75, 62, 144, 83, 148, 77, 237, 131, 199, 239, 33,
-			35, 4, 124, 44, 142, 164, 198, 144, 115, 64, 94, 145, 10, 144,
-			92, 151, 113, 181, 74, 90, 66, 36, 75, 120, 25, 71, 178, 253,
-			228, 73, 130, 1, 60, 219, 62, 102, 245, 59, 143, 138, 112, 164,
-			248, 229, 80, 101, 145, 38, 108, 38, 134, 191, 141, 84, 182, 108,
-			1, 212, 169, 65, 212, 115, 226, 163, 210, 106, 78, 54, 28, 89,
-			169, 52, 47, 57, 171, 40, 68, 237, 99, 237, 61, 138, 178, 169,
-			125, 140, 238, 33, 223, 111, 65, 27, 16, 181, 79, 90, 189, 206,
-			91, 44, 54, 63, 171, 103, 153, 209, 22, 157, 40, 166, 101, 243,
-			248, 129, 41, 241, 139, 95, 101, 66, 129, 156, 189, 60, 38, 227,
-			197, 32, 83, 154, 31, 94, 36, 44, 231, 87, 55, 149, 11, 255,
-			196, 243, 243, 139, 79, 92, 155, 17, 187, 223, 252, 236, 11, 19,
-			188, 152, 112, 226, 249, 27, 133, 133, 149, 185, 165, 153, 233, 235,
-			115, 179, 43, 203, 115, 75, 203, 240, 155, 44, 125, 226, 121, 46,
-			88, 23, 224, 89, 142, 176, 39, 193, 249, 41, 81, 204, 24, 107,
-			241, 61, 156, 64, 244, 151, 48, 244, 210, 54, 43, 189, 239, 137,
-			217, 108, 205, 68, 148, 230, 172, 81, 76, 228, 35, 119, 178, 189,
-			67, 81, 54, 181, 79, 118, 247, 144, 255, 128, 136, 133, 45, 138,
-			167, 82, 231, 144, 243, 75, 136, 73, 5, 55, 233, 69, 186, 229,
-			110, 139, 196, 145, 194, 87, 79, 206, 139, 162, 27, 234, 179, 27,
-			120, 83, 232, 167, 58, 207, 195, 45, 175, 216, 144, 249, 56, 226,
-			67, 219, 150, 187, 45, 241, 110, 85, 172, 80, 80, 37, 198, 239,
-			215, 150, 198, 216, 195, 215, 111, 40, 191, 207, 248, 7, 137, 23,
-			160, 35, 202, 131, 58, 111, 18, 92, 81, 172, 149, 221, 117, 181,
-			106, 249, 140, 152, 202, 246, 144, 183, 35, 130, 177, 197, 231, 232,
-			89, 235, 144, 243, 122, 17, 248, 108, 70, 6, 40, 220, 109, 161,
-			210, 11, 159, 204, 155, 222, 246, 184, 152, 152, 53, 215, 175, 39,
-			216, 0, 160, 180, 110, 197, 139, 188, 186, 196, 16, 88, 5, 243,
-			122, 176, 21, 207, 175, 45, 55, 228, 109, 146, 167, 75, 217, 19,
-			121, 205, 36, 199, 197, 2, 81, 112, 214, 26, 80, 20, 162, 246,
-			217, 193, 125, 138, 178, 169, 125, 246, 192, 65, 66, 8, 56, 58,
-			157, 79, 61, 136, 160, 83, 92, 162, 157, 207, 82, 242, 24, 193,
-			216, 230, 125, 186, 100, 245, 57, 15, 176, 130, 183, 238, 221, 186,
-			200, 158, 121, 149, 59, 254, 154, 167, 249, 63, 147, 227, 23, 86,
-			158, 62, 49, 50, 209, 244, 96, 244, 196, 49, 194, 174, 186, 183,
-			164, 115, 193, 69, 118, 238, 140, 108, 142, 13, 107, 237, 146, 156,
-			38, 54, 52, 231, 82, 123, 167, 162, 108, 106, 95, 234, 233, 37,
-			135, 161, 90, 68, 237, 7, 172, 61, 14, 77, 148, 52, 117, 246,
-			156, 46, 138, 207, 184, 7, 116, 81, 124, 198, 61, 208, 222, 173,
-			40, 155, 218, 15, 244, 81, 178, 64, 192, 235, 235, 114, 234, 26,
-			114, 30, 106, 146, 55, 171, 141, 117, 22, 201, 131, 77, 28, 204,
-			35, 140, 42, 137, 223, 212, 250, 5, 222, 96, 68, 237, 203, 217,
-			3, 2, 58, 23, 115, 230, 92, 177, 250, 157, 247, 139, 1, 111,
-			241, 89, 171, 160, 34, 63, 140, 167, 47, 4, 238, 196, 174, 29,
-			68, 225, 85, 220, 149, 128, 51, 100, 155, 184, 96, 200, 85, 130,
-			106, 80, 119, 253, 114, 142, 79, 216, 220, 106, 99, 221, 133, 8,
-			122, 37, 236, 48, 12, 192, 21, 201, 53, 12, 3, 112, 69, 10,
-			59, 12, 3, 112, 133, 238, 33, 175, 183, 161, 111, 136, 218, 139,
-			214, 94, 231, 191, 91, 59, 251, 22, 179, 235, 37, 237, 222, 188,
-			188, 61, 107, 193, 70, 72, 92, 41, 250, 22, 231, 87, 87, 192,
-			30, 162, 41, 110, 40, 108, 75, 194, 44, 7, 22, 163, 208, 243,
-			152, 31, 137, 156, 16, 44, 55, 95, 93, 171, 187, 15, 240, 99,
-			251, 3, 87, 202, 238, 77, 191, 234, 133, 97, 78, 216, 177, 204,
-			178, 161, 1, 36, 110, 129, 60, 73, 201, 117, 150, 83, 154, 104,
-			110, 84, 25, 189, 101, 86, 0, 97, 74, 86, 17, 8, 210, 79,
-			93, 95, 238, 168, 173, 65, 150, 54, 28, 74, 181, 83, 234, 172,
-			144, 12, 209, 95, 111, 104, 23, 118, 49, 36, 124, 146, 47, 234,
-			225, 226, 147, 124, 177, 157, 42, 202, 166, 246, 226, 192, 32, 121,
-			156, 128, 39, 99, 33, 245, 52, 114, 230, 154, 38, 121, 77, 105,
-			247, 66, 82, 184, 229, 48, 96, 55, 171, 193, 86, 85, 92, 60,
-			228, 102, 30, 103, 133, 70, 85, 204, 150, 153, 39, 224, 239, 81,
-			57, 211, 211, 136, 218, 133, 236, 32, 121, 47, 159, 233, 105, 62,
-			211, 159, 176, 250, 157, 183, 138, 153, 46, 71, 69, 228, 97, 112,
-			165, 37, 17, 114, 105, 7, 69, 174, 158, 137, 158, 26, 117, 223,
-			229, 238, 204, 53, 251, 241, 226, 102, 14, 68, 54, 48, 104, 38,
-			168, 240, 34, 158, 16, 176, 212, 117, 194, 70, 196, 227, 39, 148,
-			140, 75, 195, 156, 126, 66, 50, 41, 13, 115, 250, 9, 57, 167,
-			211, 48, 167, 159, 160, 123, 200, 167, 68, 47, 16, 181, 191, 195,
-			234, 117, 126, 14, 37, 248, 212, 170, 181, 243, 205, 143, 227, 137,
-			40, 27, 144, 216, 178, 21, 90, 142, 234, 10, 132, 251, 228, 158,
-			231, 175, 174, 92, 47, 92, 123, 116, 110, 102, 249, 133, 9, 65,
-			206, 60, 1, 91, 178, 202, 84, 194, 248, 78, 191, 86, 119, 39,
-			206, 95, 56, 127, 254, 252, 169, 11, 103, 206, 157, 62, 127, 246,
-			204, 248, 169, 241, 181, 11, 103, 238, 61, 61, 181, 230, 77, 77,
-			78, 158, 61, 183, 86, 58, 149, 211, 29, 230, 179, 226, 59, 116,
-			135, 249, 172, 248, 14, 185, 217, 166, 97, 86, 124, 71, 119, 15,
-			185, 64, 32, 107, 216, 179, 169, 16, 57, 227, 45, 68, 159, 146,
-			115, 227, 173, 229, 92, 6, 81, 251, 217, 236, 0, 89, 39, 24,
-			103, 248, 224, 23, 173, 126, 231, 149, 108, 73, 51, 97, 183, 133,
-			26, 232, 53, 28, 251, 109, 11, 221, 157, 220, 81, 60, 101, 96,
-			40, 139, 178, 103, 25, 24, 202, 162, 28, 202, 12, 12, 101, 145,
-			238, 33, 31, 70, 208, 38, 68, 237, 13, 171, 215, 249, 33, 62,
-			148, 183, 105, 80, 108, 8, 245, 155, 70, 156, 55, 145, 180, 88,
-			253, 97, 113, 195, 171, 192, 116, 124, 94, 46, 214, 23, 38, 158,
-			175, 54, 42, 94, 221, 47, 174, 248, 165, 23, 198, 68, 190, 105,
-			221, 250, 230, 143, 140, 87, 117, 191, 248, 136, 109, 232, 126, 241,
-			17, 219, 144, 35, 150, 129, 17, 219, 232, 238, 33, 33, 116, 203,
-			162, 184, 108, 85, 38, 29, 143, 77, 179, 141, 70, 197, 173, 142,
-			215, 61, 183, 4, 71, 51, 176, 204, 43, 173, 51, 193, 224, 56,
-			194, 8, 36, 32, 103, 67, 184, 17, 212, 163, 178, 95, 189, 169,
-			229, 86, 157, 127, 82, 12, 42, 19, 167, 166, 78, 159, 57, 123,
-			238, 222, 220, 168, 110, 158, 149, 166, 118, 89, 55, 143, 179, 182,
-			44, 197, 76, 198, 178, 108, 106, 151, 7, 6, 21, 149, 165, 118,
-			5, 79, 144, 30, 146, 5, 234, 7, 179, 41, 106, 87, 210, 121,
-			82, 131, 214, 219, 20, 63, 103, 213, 39, 157, 85, 153, 253, 16,
-			192, 148, 74, 208, 160, 27, 133, 5, 53, 229, 231, 160, 65, 252,
-			208, 26, 94, 156, 152, 88, 109, 172, 135, 201, 243, 125, 109, 66,
-			145, 19, 126, 24, 54, 188, 112, 162, 228, 69, 174, 95, 126, 208,
-			47, 221, 47, 26, 31, 207, 24, 59, 77, 237, 231, 116, 211, 185,
-			234, 242, 156, 212, 40, 50, 150, 109, 83, 251, 185, 158, 94, 69,
-			101, 169, 93, 215, 77, 183, 69, 211, 235, 233, 60, 249, 119, 54,
-			177, 112, 27, 77, 191, 144, 122, 35, 66, 206, 15, 219, 76, 27,
-			254, 76, 157, 44, 246, 193, 84, 203, 168, 40, 94, 203, 199, 70,
-			132, 235, 114, 171, 240, 67, 194, 2, 153, 151, 202, 85, 246, 250,
-			196, 206, 160, 83, 40, 198, 1, 42, 16, 53, 115, 43, 74, 36,
-			132, 129, 139, 8, 117, 77, 35, 34, 79, 245, 253, 187, 121, 95,
-			35, 31, 202, 22, 65, 138, 43, 121, 121, 88, 21, 121, 38, 20,
-			179, 213, 85, 144, 95, 203, 151, 188, 205, 137, 83, 83, 83, 163,
-			188, 133, 69, 9, 14, 6, 86, 11, 79, 194, 126, 8, 100, 122,
-			184, 81, 219, 244, 75, 13, 183, 12, 14, 0, 97, 235, 22, 136,
-			89, 22, 5, 113, 122, 47, 113, 241, 162, 205, 164, 208, 141, 81,
-			233, 5, 175, 182, 206, 146, 23, 250, 117, 152, 220, 42, 171, 175,
-			106, 9, 224, 127, 145, 157, 21, 73, 249, 212, 134, 168, 253, 66,
-			182, 143, 172, 16, 140, 219, 172, 20, 197, 175, 69, 214, 94, 231,
-			113, 54, 173, 171, 147, 34, 161, 5, 123, 224, 92, 161, 223, 19,
-			215, 132, 134, 138, 98, 188, 41, 61, 208, 219, 192, 3, 251, 181,
-			200, 202, 42, 18, 113, 178, 157, 42, 210, 230, 228, 192, 32, 249,
-			223, 16, 52, 7, 81, 252, 6, 100, 245, 58, 191, 110, 202, 38,
-			89, 164, 121, 245, 100, 130, 128, 37, 120, 37, 239, 23, 171, 37,
-			175, 94, 222, 134, 32, 79, 227, 43, 31, 66, 253, 43, 65, 24,
-			177, 83, 231, 132, 31, 177, 116, 91, 72, 230, 34, 19, 209, 46,
-			124, 155, 223, 240, 110, 185, 37, 175, 232, 87, 220, 50, 81, 158,
-			101, 193, 26, 107, 128, 37, 225, 244, 20, 43, 7, 91, 94, 29,
-			14, 83, 198, 155, 172, 184, 225, 214, 221, 98, 20, 131, 46, 182,
-			129, 67, 247, 27, 98, 54, 32, 232, 104, 123, 135, 34, 109, 78,
-			118, 247, 144, 255, 104, 131, 139, 84, 230, 237, 40, 245, 195, 8,
-			57, 159, 180, 153, 97, 111, 86, 67, 106, 120, 60, 0, 192, 154,
-			0, 129, 130, 144, 121, 165, 32, 110, 122, 245, 18, 228, 135, 118,
-			67, 141, 132, 166, 238, 121, 229, 177, 79, 41, 30, 124, 214, 21,
-			245, 117, 111, 226, 107, 184, 20, 214, 193, 137, 98, 158, 202, 144,
-			32, 34, 98, 194, 215, 234, 174, 246, 96, 9, 234, 188, 232, 162,
-			87, 46, 187, 113, 76, 119, 221, 43, 141, 142, 169, 12, 32, 58,
-			183, 111, 163, 42, 174, 115, 97, 210, 52, 183, 23, 84, 45, 129,
-			255, 34, 109, 108, 80, 5, 196, 104, 241, 238, 198, 142, 81, 211,
-			229, 178, 210, 225, 107, 117, 79, 100, 170, 131, 211, 104, 194, 70,
-			46, 213, 91, 9, 235, 206, 149, 125, 112, 26, 247, 139, 194, 1,
-			167, 236, 223, 244, 68, 238, 172, 100, 86, 109, 184, 19, 157, 144,
-			9, 38, 61, 216, 154, 164, 141, 75, 7, 205, 74, 3, 218, 174,
-			18, 226, 156, 8, 7, 1, 124, 191, 183, 163, 116, 47, 153, 146,
-			142, 88, 248, 157, 200, 58, 236, 28, 99, 211, 114, 0, 149, 147,
-			139, 194, 200, 240, 33, 254, 54, 106, 200, 249, 35, 124, 175, 248,
-			71, 142, 34, 45, 78, 30, 60, 68, 142, 73, 231, 43, 252, 110,
-			100, 237, 119, 6, 229, 129, 131, 151, 25, 54, 138, 69, 72, 244,
-			164, 203, 64, 226, 181, 65, 69, 90, 156, 220, 231, 144, 35, 210,
-			1, 10, 127, 63, 47, 99, 143, 81, 6, 231, 187, 81, 0, 175,
-			231, 251, 227, 2, 196, 39, 251, 28, 242, 144, 116, 49, 194, 255,
-			26, 129, 131, 89, 92, 0, 87, 240, 27, 213, 8, 188, 53, 212,
-			132, 17, 57, 74, 57, 87, 229, 204, 209, 229, 219, 8, 138, 56,
-			160, 72, 139, 147, 135, 25, 25, 150, 110, 58, 248, 125, 200, 58,
-			224, 236, 51, 202, 231, 243, 69, 76, 58, 163, 153, 24, 193, 155,
-			123, 21, 105, 113, 210, 217, 79, 126, 152, 47, 46, 68, 51, 63,
-			142, 82, 159, 66, 200, 121, 187, 205, 118, 220, 151, 176, 162, 91,
-			19, 185, 170, 92, 153, 59, 133, 109, 109, 108, 43, 91, 138, 154,
-			233, 91, 110, 72, 152, 39, 191, 245, 74, 121, 93, 14, 100, 23,
-			22, 105, 214, 60, 243, 237, 216, 225, 176, 90, 98, 37, 31, 46,
-			228, 101, 154, 253, 53, 145, 1, 139, 239, 106, 110, 49, 2, 64,
-			55, 190, 11, 136, 64, 86, 87, 131, 190, 202, 254, 70, 1, 20,
-			203, 184, 26, 85, 247, 248, 142, 169, 126, 159, 89, 96, 171, 30,
-			16, 94, 24, 41, 116, 157, 38, 213, 156, 176, 203, 16, 89, 29,
-			84, 245, 156, 149, 142, 3, 9, 37, 34, 86, 33, 64, 187, 22,
-			255, 158, 156, 168, 184, 126, 245, 226, 122, 48, 17, 214, 139, 19,
-			45, 239, 21, 148, 129, 48, 190, 197, 230, 109, 89, 145, 150, 73,
-			120, 248, 224, 115, 247, 239, 224, 249, 61, 209, 118, 205, 187, 191,
-			40, 99, 167, 210, 124, 150, 254, 56, 74, 15, 146, 11, 4, 167,
-			33, 243, 223, 135, 144, 117, 220, 57, 201, 166, 147, 131, 112, 167,
-			53, 35, 114, 1, 126, 8, 89, 76, 145, 22, 39, 143, 30, 35,
-			63, 101, 65, 201, 136, 226, 159, 66, 214, 62, 231, 253, 22, 91,
-			18, 48, 91, 134, 92, 210, 71, 108, 192, 224, 13, 86, 37, 244,
-			111, 192, 185, 202, 25, 1, 209, 154, 171, 117, 183, 90, 220, 32,
-			18, 61, 9, 80, 115, 194, 8, 14, 7, 190, 129, 8, 212, 168,
-			134, 234, 22, 76, 250, 72, 133, 202, 19, 99, 52, 79, 216, 8,
-			215, 163, 33, 195, 37, 92, 105, 4, 107, 16, 231, 25, 169, 179,
-			186, 176, 46, 135, 2, 171, 101, 141, 31, 190, 203, 219, 194, 236,
-			174, 212, 5, 63, 84, 105, 209, 133, 196, 229, 147, 96, 103, 71,
-			242, 163, 132, 77, 203, 100, 129, 224, 163, 178, 163, 167, 224, 123,
-			41, 231, 203, 196, 204, 227, 124, 202, 132, 210, 241, 77, 114, 16,
-			9, 150, 245, 43, 210, 226, 228, 222, 33, 242, 87, 130, 161, 22,
-			197, 191, 128, 44, 199, 249, 195, 111, 129, 161, 102, 165, 188, 198,
-			145, 112, 20, 20, 117, 144, 177, 99, 132, 53, 170, 34, 152, 167,
-			196, 102, 22, 70, 194, 209, 60, 27, 81, 26, 158, 204, 167, 231,
-			111, 106, 37, 5, 52, 94, 185, 10, 228, 102, 78, 24, 32, 96,
-			169, 241, 106, 104, 245, 9, 140, 6, 245, 138, 208, 50, 227, 240,
-			86, 9, 40, 5, 42, 229, 90, 208, 0, 219, 12, 97, 107, 110,
-			81, 64, 178, 241, 189, 66, 152, 18, 33, 147, 31, 160, 66, 55,
-			32, 241, 212, 204, 66, 200, 54, 195, 150, 191, 145, 230, 9, 227,
-			189, 36, 131, 193, 231, 239, 47, 32, 107, 64, 145, 192, 253, 161,
-			125, 194, 225, 19, 113, 105, 252, 9, 100, 237, 113, 190, 138, 180,
-			217, 95, 52, 10, 122, 95, 247, 97, 206, 196, 160, 23, 97, 196,
-			194, 198, 170, 97, 173, 153, 89, 24, 101, 53, 23, 114, 192, 105,
-			197, 65, 5, 73, 169, 176, 96, 128, 205, 41, 185, 145, 43, 112,
-			81, 132, 125, 171, 92, 86, 81, 245, 177, 66, 203, 252, 53, 162,
-			43, 10, 229, 173, 8, 40, 175, 94, 21, 32, 182, 133, 102, 95,
-			113, 75, 94, 220, 182, 17, 215, 208, 228, 133, 251, 164, 43, 175,
-			168, 56, 187, 234, 62, 192, 230, 149, 85, 227, 253, 8, 128, 74,
-			37, 59, 248, 86, 242, 9, 100, 117, 43, 210, 226, 100, 31, 37,
-			127, 36, 184, 131, 41, 254, 85, 100, 13, 58, 191, 133, 154, 47,
-			69, 132, 10, 82, 53, 199, 132, 179, 33, 207, 70, 98, 4, 11,
-			72, 68, 15, 168, 82, 90, 148, 193, 193, 5, 80, 171, 26, 81,
-			80, 113, 213, 138, 84, 88, 186, 188, 209, 77, 37, 114, 221, 179,
-			33, 46, 20, 35, 51, 49, 175, 105, 249, 138, 2, 121, 95, 176,
-			196, 143, 163, 242, 252, 170, 172, 106, 21, 183, 218, 48, 42, 225,
-			211, 195, 139, 25, 192, 55, 193, 95, 69, 86, 159, 34, 45, 78,
-			246, 15, 16, 143, 88, 105, 139, 102, 126, 29, 165, 126, 11, 33,
-			231, 73, 214, 116, 67, 207, 214, 224, 191, 48, 161, 150, 133, 18,
-			110, 27, 118, 67, 173, 197, 109, 179, 13, 183, 100, 74, 55, 162,
-			196, 155, 148, 228, 124, 134, 254, 58, 74, 247, 131, 36, 183, 184,
-			36, 255, 44, 178, 142, 58, 39, 117, 102, 108, 145, 85, 71, 223,
-			160, 185, 229, 114, 178, 90, 217, 25, 176, 190, 243, 111, 15, 41,
-			210, 226, 228, 145, 28, 185, 6, 5, 35, 138, 63, 135, 172, 1,
-			103, 90, 224, 41, 168, 11, 190, 100, 15, 96, 224, 74, 65, 117,
-			88, 110, 24, 45, 164, 178, 174, 14, 137, 18, 123, 21, 105, 113,
-			114, 79, 63, 153, 135, 234, 44, 138, 127, 147, 31, 153, 46, 221,
-			177, 186, 59, 86, 196, 155, 254, 155, 200, 162, 138, 132, 162, 7,
-			6, 201, 91, 45, 98, 165, 109, 154, 249, 2, 74, 253, 25, 66,
-			206, 223, 35, 214, 236, 233, 160, 238, 54, 164, 79, 102, 133, 63,
-			218, 105, 183, 204, 199, 166, 67, 18, 143, 161, 180, 194, 129, 120,
-			130, 15, 13, 237, 150, 185, 92, 49, 249, 39, 80, 14, 138, 155,
-			224, 172, 182, 234, 175, 63, 215, 240, 234, 219, 92, 59, 112, 163,
-			200, 171, 212, 98, 205, 32, 225, 122, 209, 164, 21, 240, 245, 252,
-			5, 148, 30, 32, 23, 9, 78, 219, 124, 46, 253, 30, 215, 10,
-			198, 216, 116, 147, 165, 246, 78, 106, 1, 220, 157, 240, 143, 153,
-			34, 45, 78, 30, 61, 70, 38, 161, 100, 68, 241, 31, 32, 171,
-			219, 201, 177, 66, 163, 170, 167, 100, 8, 123, 71, 73, 4, 70,
-			136, 218, 116, 121, 72, 124, 210, 174, 72, 139, 147, 157, 93, 228,
-			44, 148, 103, 81, 252, 69, 100, 245, 56, 195, 77, 229, 113, 77,
-			80, 96, 180, 139, 124, 187, 77, 133, 242, 118, 124, 17, 89, 154,
-			132, 98, 186, 186, 201, 41, 40, 212, 166, 248, 143, 145, 213, 239,
-			28, 133, 66, 33, 122, 252, 78, 173, 228, 12, 252, 99, 100, 245,
-			40, 210, 226, 36, 221, 67, 230, 160, 64, 76, 241, 159, 242, 57,
-			125, 47, 47, 48, 52, 75, 12, 170, 172, 230, 70, 197, 141, 208,
-			139, 88, 163, 86, 14, 220, 210, 109, 42, 225, 66, 231, 79, 213,
-			124, 182, 65, 232, 252, 41, 159, 207, 63, 202, 53, 111, 76, 51,
-			95, 69, 169, 111, 32, 228, 188, 219, 102, 59, 157, 103, 212, 105,
-			95, 186, 134, 138, 65, 107, 53, 167, 239, 124, 252, 107, 233, 24,
-			179, 227, 24, 72, 212, 57, 240, 219, 113, 12, 252, 167, 213, 188,
-			139, 155, 19, 42, 15, 197, 68, 189, 81, 157, 8, 163, 160, 238,
-			174, 75, 55, 204, 75, 229, 251, 167, 206, 63, 248, 220, 253, 124,
-			217, 31, 159, 154, 156, 121, 226, 248, 212, 164, 224, 238, 241, 169,
-			201, 178, 91, 93, 191, 168, 194, 209, 249, 66, 227, 67, 248, 85,
-			148, 222, 11, 103, 85, 204, 23, 218, 255, 137, 172, 225, 111, 225,
-			172, 10, 119, 99, 252, 163, 156, 34, 45, 78, 30, 191, 135, 228,
-			160, 68, 68, 241, 215, 248, 210, 237, 23, 134, 78, 62, 127, 155,
-			78, 170, 112, 93, 195, 95, 98, 138, 180, 56, 121, 244, 24, 57,
-			12, 37, 88, 20, 255, 13, 178, 114, 78, 159, 46, 33, 113, 78,
-			197, 176, 120, 254, 6, 9, 72, 110, 78, 194, 7, 236, 8, 57,
-			14, 159, 219, 20, 255, 29, 178, 142, 57, 123, 245, 231, 45, 78,
-			145, 24, 22, 204, 223, 33, 235, 176, 34, 45, 78, 230, 142, 146,
-			203, 196, 194, 89, 154, 249, 7, 148, 122, 163, 133, 156, 51, 172,
-			96, 222, 193, 107, 183, 106, 137, 161, 168, 192, 134, 171, 81, 61,
-			40, 199, 247, 4, 29, 196, 198, 89, 68, 241, 63, 160, 108, 31,
-			249, 207, 136, 96, 156, 205, 166, 104, 230, 245, 150, 245, 6, 203,
-			86, 74, 73, 203, 143, 193, 108, 147, 151, 200, 65, 210, 223, 81,
-			104, 195, 181, 90, 0, 41, 238, 85, 32, 64, 37, 16, 32, 70,
-			243, 107, 210, 189, 91, 20, 16, 130, 149, 82, 95, 178, 233, 207,
-			252, 170, 140, 29, 147, 250, 95, 53, 168, 142, 171, 242, 215, 253,
-			104, 140, 47, 245, 77, 225, 187, 53, 38, 221, 226, 153, 156, 102,
-			132, 173, 54, 138, 55, 189, 40, 28, 29, 19, 122, 129, 180, 48,
-			65, 90, 121, 48, 166, 9, 43, 88, 54, 11, 57, 253, 172, 108,
-			55, 153, 224, 93, 230, 147, 235, 95, 88, 120, 191, 115, 132, 79,
-			46, 80, 141, 1, 247, 66, 214, 90, 247, 106, 65, 232, 71, 65,
-			93, 234, 188, 252, 131, 12, 124, 209, 173, 72, 196, 201, 158, 65,
-			69, 218, 156, 220, 231, 144, 251, 136, 133, 219, 105, 230, 205, 86,
-			234, 93, 22, 114, 242, 201, 33, 138, 43, 114, 91, 87, 197, 7,
-			167, 29, 81, 252, 102, 43, 75, 201, 12, 193, 184, 157, 55, 244,
-			173, 150, 69, 157, 179, 48, 48, 234, 171, 141, 32, 212, 55, 164,
-			122, 77, 10, 214, 168, 197, 29, 84, 20, 188, 125, 59, 88, 66,
-			223, 106, 73, 19, 96, 59, 52, 254, 173, 86, 123, 151, 34, 109,
-			78, 246, 246, 113, 129, 140, 219, 249, 12, 254, 87, 150, 53, 224,
-			220, 43, 50, 97, 72, 149, 79, 250, 203, 223, 174, 1, 92, 72,
-			196, 117, 162, 52, 148, 163, 234, 68, 80, 108, 123, 175, 34, 109,
-			78, 238, 233, 39, 223, 141, 160, 82, 139, 226, 119, 90, 86, 159,
-			211, 80, 119, 135, 117, 111, 77, 149, 95, 247, 214, 194, 137, 13,
-			207, 45, 133, 32, 142, 114, 38, 56, 184, 66, 176, 242, 133, 246,
-			188, 230, 69, 197, 13, 152, 165, 139, 50, 21, 177, 228, 57, 23,
-			189, 99, 224, 215, 103, 22, 39, 126, 204, 169, 38, 91, 105, 104,
-			133, 106, 50, 231, 196, 59, 173, 246, 78, 69, 218, 156, 52, 124,
-			234, 191, 56, 66, 206, 220, 165, 79, 189, 12, 82, 216, 205, 169,
-			62, 247, 147, 136, 180, 9, 247, 227, 144, 94, 33, 221, 146, 203,
-			43, 162, 103, 210, 7, 249, 240, 174, 62, 200, 130, 101, 133, 174,
-			117, 147, 164, 15, 145, 14, 161, 244, 149, 253, 48, 10, 135, 44,
-			112, 89, 63, 212, 162, 16, 175, 94, 247, 163, 25, 120, 181, 96,
-			126, 66, 247, 145, 172, 31, 174, 148, 252, 122, 180, 13, 190, 200,
-			217, 66, 155, 31, 206, 114, 50, 247, 61, 136, 116, 37, 106, 255,
-			199, 122, 56, 211, 195, 164, 67, 116, 119, 101, 195, 13, 55, 132,
-			107, 119, 129, 136, 71, 143, 184, 225, 6, 117, 72, 22, 150, 138,
-			31, 84, 135, 210, 12, 141, 216, 5, 77, 231, 54, 73, 167, 217,
-			141, 59, 53, 38, 157, 108, 204, 32, 201, 136, 110, 67, 43, 237,
-			130, 164, 160, 70, 169, 128, 64, 75, 121, 141, 146, 126, 20, 103,
-			113, 111, 58, 247, 3, 136, 144, 25, 205, 177, 150, 213, 222, 109,
-			225, 233, 184, 112, 58, 75, 72, 176, 85, 245, 234, 43, 55, 253,
-			106, 9, 88, 209, 61, 117, 124, 231, 200, 197, 53, 95, 227, 111,
-			63, 230, 87, 75, 133, 246, 64, 253, 121, 162, 64, 246, 180, 120,
-			131, 50, 114, 96, 230, 145, 233, 197, 135, 231, 22, 230, 151, 150,
-			87, 174, 61, 185, 184, 195, 17, 186, 157, 164, 31, 185, 113, 117,
-			122, 177, 23, 209, 110, 66, 166, 111, 44, 95, 187, 10, 238, 103,
-			189, 214, 139, 115, 236, 253, 119, 57, 129, 52, 241, 157, 183, 245,
-			235, 61, 253, 178, 95, 239, 203, 126, 189, 223, 178, 95, 175, 240,
-			224, 165, 177, 7, 47, 255, 243, 188, 240, 224, 29, 72, 229, 145,
-			51, 166, 162, 194, 21, 38, 145, 145, 133, 65, 187, 194, 8, 203,
-			181, 225, 213, 59, 144, 237, 33, 63, 100, 41, 183, 222, 67, 214,
-			81, 231, 123, 165, 75, 149, 27, 66, 222, 183, 80, 122, 8, 10,
-			248, 120, 25, 131, 6, 89, 214, 21, 28, 226, 85, 183, 90, 114,
-			197, 198, 206, 53, 161, 170, 87, 244, 194, 208, 173, 111, 143, 177,
-			45, 79, 26, 180, 220, 82, 73, 233, 65, 192, 39, 83, 243, 81,
-			101, 130, 129, 208, 175, 18, 169, 35, 141, 201, 108, 108, 46, 11,
-			170, 94, 176, 38, 0, 35, 36, 102, 36, 220, 211, 203, 199, 66,
-			101, 19, 217, 23, 18, 130, 154, 37, 55, 23, 157, 58, 129, 45,
-			105, 45, 171, 224, 109, 250, 208, 191, 112, 179, 186, 82, 87, 132,
-			192, 5, 102, 140, 229, 243, 121, 194, 94, 48, 253, 150, 15, 89,
-			61, 134, 223, 242, 161, 222, 67, 134, 223, 242, 161, 35, 57, 242,
-			53, 164, 28, 151, 143, 91, 35, 206, 159, 9, 37, 51, 222, 105,
-			70, 194, 81, 133, 214, 14, 214, 80, 25, 43, 8, 153, 248, 163,
-			22, 60, 151, 172, 33, 73, 126, 131, 131, 130, 88, 204, 188, 95,
-			207, 53, 188, 134, 199, 162, 250, 246, 171, 131, 85, 144, 36, 211,
-			234, 254, 117, 178, 169, 234, 230, 144, 126, 153, 210, 75, 56, 19,
-			241, 245, 229, 214, 133, 193, 81, 134, 226, 105, 179, 139, 130, 158,
-			228, 69, 66, 202, 12, 47, 98, 106, 195, 140, 93, 172, 49, 239,
-			181, 233, 112, 125, 188, 99, 175, 225, 112, 125, 124, 232, 168, 225,
-			112, 125, 252, 158, 97, 242, 107, 98, 222, 89, 212, 30, 183, 250,
-			157, 143, 89, 236, 201, 216, 242, 85, 87, 169, 249, 170, 219, 218,
-			162, 15, 182, 75, 149, 51, 90, 176, 102, 76, 93, 212, 131, 161,
-			166, 164, 82, 128, 53, 3, 179, 27, 60, 117, 101, 108, 164, 87,
-			45, 110, 11, 239, 176, 218, 122, 221, 229, 90, 180, 68, 47, 229,
-			75, 4, 2, 231, 133, 59, 8, 196, 44, 131, 201, 177, 30, 148,
-			203, 112, 47, 94, 244, 107, 96, 5, 100, 51, 27, 94, 189, 190,
-			61, 94, 243, 133, 79, 143, 107, 240, 27, 244, 200, 160, 166, 47,
-			145, 193, 25, 152, 143, 98, 144, 48, 144, 135, 62, 132, 89, 19,
-			86, 113, 111, 170, 107, 38, 181, 32, 128, 189, 177, 135, 6, 87,
-			160, 225, 24, 161, 16, 82, 203, 219, 70, 198, 51, 206, 87, 43,
-			205, 57, 153, 81, 20, 162, 246, 120, 155, 154, 175, 150, 77, 237,
-			113, 186, 135, 188, 221, 18, 94, 238, 231, 82, 87, 145, 243, 221,
-			86, 211, 146, 9, 19, 18, 68, 105, 29, 170, 19, 106, 197, 202,
-			137, 7, 151, 141, 202, 11, 156, 176, 58, 103, 147, 184, 159, 25,
-			83, 209, 191, 74, 229, 31, 214, 31, 149, 131, 245, 60, 187, 202,
-			249, 28, 26, 24, 22, 99, 226, 92, 167, 95, 35, 240, 30, 33,
-			108, 62, 18, 119, 213, 201, 134, 113, 125, 99, 66, 42, 53, 19,
-			117, 15, 224, 104, 87, 125, 9, 127, 163, 227, 144, 85, 81, 222,
-			45, 174, 228, 49, 191, 58, 38, 157, 0, 33, 74, 61, 118, 104,
-			81, 222, 252, 231, 178, 3, 228, 25, 229, 205, 127, 193, 162, 206,
-			227, 70, 52, 110, 236, 153, 245, 173, 30, 77, 76, 249, 104, 56,
-			245, 95, 72, 56, 245, 95, 104, 239, 50, 156, 250, 47, 244, 246,
-			129, 127, 51, 18, 120, 19, 3, 224, 223, 172, 120, 41, 157, 70,
-			170, 154, 19, 173, 143, 39, 45, 170, 69, 105, 94, 154, 233, 6,
-			127, 95, 123, 175, 225, 6, 127, 223, 30, 0, 30, 228, 245, 90,
-			212, 158, 182, 250, 156, 215, 161, 255, 33, 199, 148, 22, 109, 231,
-			115, 123, 90, 183, 157, 243, 101, 90, 122, 82, 33, 152, 219, 211,
-			61, 189, 144, 224, 16, 113, 98, 214, 218, 231, 92, 86, 45, 95,
-			122, 100, 122, 252, 20, 184, 250, 158, 153, 188, 179, 51, 73, 139,
-			170, 237, 52, 47, 81, 85, 109, 35, 106, 207, 182, 247, 43, 138,
-			215, 182, 119, 136, 252, 162, 8, 193, 192, 212, 94, 176, 6, 156,
-			159, 180, 216, 172, 72, 1, 193, 92, 22, 5, 145, 91, 150, 152,
-			4, 18, 57, 217, 23, 70, 63, 225, 107, 178, 150, 39, 108, 90,
-			174, 181, 77, 111, 12, 252, 253, 162, 160, 42, 111, 55, 252, 106,
-			177, 238, 185, 176, 19, 250, 213, 200, 91, 87, 30, 93, 117, 79,
-			128, 157, 66, 22, 36, 25, 150, 32, 244, 54, 33, 70, 36, 144,
-			190, 145, 211, 148, 173, 7, 81, 160, 130, 135, 139, 65, 101, 98,
-			221, 143, 198, 5, 46, 184, 87, 39, 76, 28, 43, 88, 173, 220,
-			88, 247, 171, 121, 118, 77, 216, 51, 130, 114, 67, 166, 105, 48,
-			60, 90, 220, 144, 109, 121, 229, 50, 215, 204, 32, 79, 42, 81,
-			118, 63, 153, 157, 68, 57, 27, 194, 203, 32, 228, 194, 8, 12,
-			228, 176, 247, 187, 178, 207, 59, 25, 141, 1, 104, 163, 77, 81,
-			136, 218, 11, 89, 53, 63, 177, 77, 237, 133, 61, 253, 132, 137,
-			40, 141, 235, 169, 85, 228, 244, 3, 44, 169, 104, 182, 60, 81,
-			24, 145, 15, 215, 179, 253, 228, 17, 21, 248, 80, 176, 168, 115,
-			73, 189, 204, 23, 141, 152, 128, 201, 133, 51, 206, 85, 0, 111,
-			171, 165, 125, 65, 6, 37, 164, 121, 81, 89, 35, 96, 161, 32,
-			23, 174, 8, 88, 40, 244, 246, 145, 223, 65, 188, 1, 237, 212,
-			126, 202, 234, 114, 62, 141, 216, 172, 0, 87, 112, 217, 134, 31,
-			70, 65, 29, 238, 221, 42, 126, 24, 185, 55, 117, 250, 5, 128,
-			234, 172, 122, 158, 200, 133, 181, 234, 17, 198, 165, 89, 125, 27,
-			244, 117, 55, 130, 219, 82, 173, 175, 199, 71, 29, 13, 169, 208,
-			168, 70, 126, 153, 115, 180, 94, 220, 224, 39, 140, 51, 121, 54,
-			50, 159, 159, 203, 107, 157, 75, 92, 28, 150, 188, 208, 171, 251,
-			110, 217, 127, 141, 130, 158, 46, 123, 235, 110, 113, 155, 48, 227,
-			249, 206, 226, 225, 202, 175, 131, 115, 178, 61, 69, 237, 167, 58,
-			196, 178, 179, 218, 121, 247, 13, 202, 18, 212, 105, 96, 57, 162,
-			246, 171, 172, 1, 231, 30, 61, 62, 66, 88, 239, 102, 73, 17,
-			28, 228, 242, 233, 85, 154, 187, 92, 62, 189, 74, 202, 39, 11,
-			228, 211, 171, 246, 244, 147, 81, 40, 222, 162, 246, 51, 214, 30,
-			231, 128, 108, 173, 132, 183, 151, 165, 131, 19, 166, 46, 148, 11,
-			142, 103, 228, 164, 130, 219, 32, 251, 153, 108, 183, 162, 108, 106,
-			63, 211, 71, 201, 8, 20, 106, 83, 219, 181, 6, 156, 253, 236,
-			58, 159, 76, 160, 228, 36, 203, 213, 101, 114, 137, 224, 234, 50,
-			185, 68, 112, 179, 170, 161, 92, 34, 184, 123, 250, 73, 78, 196,
-			173, 120, 169, 231, 144, 51, 200, 166, 217, 186, 96, 68, 172, 33,
-			200, 169, 202, 191, 246, 178, 20, 166, 42, 196, 179, 172, 191, 20,
-			83, 85, 4, 179, 172, 39, 130, 89, 214, 229, 84, 21, 193, 44,
-			235, 189, 125, 192, 76, 8, 102, 121, 245, 93, 49, 83, 132, 181,
-			188, 90, 118, 92, 132, 181, 188, 58, 107, 134, 181, 188, 90, 50,
-			211, 230, 35, 84, 185, 27, 102, 218, 48, 64, 21, 93, 38, 111,
-			77, 69, 50, 211, 134, 49, 169, 236, 233, 135, 73, 101, 115, 162,
-			102, 29, 119, 238, 1, 1, 120, 211, 7, 108, 81, 6, 102, 0,
-			237, 67, 105, 114, 87, 20, 97, 103, 248, 87, 3, 138, 66, 212,
-			174, 13, 50, 69, 241, 18, 143, 30, 35, 243, 2, 52, 45, 74,
-			125, 39, 114, 238, 103, 77, 182, 132, 166, 107, 64, 93, 159, 187,
-			219, 144, 2, 38, 88, 148, 30, 130, 139, 36, 56, 86, 109, 90,
-			71, 157, 99, 77, 103, 1, 89, 144, 84, 5, 33, 236, 33, 129,
-			2, 182, 105, 29, 50, 80, 192, 54, 143, 228, 164, 103, 27, 162,
-			246, 182, 213, 233, 28, 111, 46, 206, 15, 161, 68, 233, 205, 7,
-			206, 217, 9, 84, 174, 109, 193, 98, 137, 202, 181, 77, 58, 72,
-			73, 129, 114, 189, 96, 245, 57, 79, 222, 182, 60, 121, 225, 30,
-			84, 243, 108, 4, 142, 31, 134, 62, 28, 212, 73, 124, 35, 207,
-			26, 181, 186, 183, 169, 34, 45, 242, 163, 38, 112, 214, 11, 26,
-			180, 3, 42, 53, 12, 140, 191, 219, 65, 46, 221, 165, 129, 81,
-			186, 38, 173, 8, 207, 177, 93, 237, 140, 51, 164, 235, 138, 120,
-			83, 6, 233, 79, 145, 129, 90, 221, 175, 184, 245, 237, 21, 192,
-			182, 90, 145, 194, 77, 154, 176, 246, 200, 31, 231, 248, 111, 87,
-			197, 79, 47, 206, 6, 244, 90, 34, 108, 64, 185, 151, 99, 187,
-			95, 182, 1, 125, 59, 108, 64, 83, 202, 6, 148, 67, 206, 61,
-			108, 126, 71, 234, 209, 38, 231, 202, 38, 235, 207, 0, 249, 49,
-			172, 172, 63, 71, 172, 163, 206, 247, 97, 1, 220, 12, 248, 111,
-			74, 191, 128, 35, 85, 163, 28, 249, 21, 113, 236, 4, 215, 164,
-			82, 236, 214, 36, 93, 38, 101, 200, 135, 0, 68, 34, 26, 94,
-			41, 218, 81, 30, 216, 16, 98, 216, 36, 101, 103, 224, 103, 253,
-			48, 114, 139, 55, 33, 76, 198, 11, 185, 98, 92, 213, 177, 177,
-			91, 202, 53, 202, 64, 76, 18, 90, 140, 91, 101, 115, 48, 189,
-			193, 187, 135, 79, 218, 71, 221, 77, 209, 229, 60, 97, 243, 66,
-			209, 6, 165, 95, 162, 158, 25, 204, 96, 37, 161, 158, 25, 41,
-			13, 107, 94, 81, 130, 199, 75, 97, 3, 240, 114, 219, 132, 249,
-			149, 138, 87, 242, 5, 11, 214, 92, 174, 213, 43, 71, 201, 24,
-			220, 186, 184, 17, 132, 94, 21, 80, 49, 227, 196, 63, 218, 128,
-			66, 90, 21, 175, 226, 185, 52, 178, 24, 0, 52, 213, 162, 109,
-			153, 59, 72, 1, 55, 74, 243, 141, 114, 42, 87, 46, 251, 134,
-			31, 44, 81, 249, 244, 117, 52, 178, 255, 26, 237, 186, 167, 88,
-			86, 17, 121, 242, 152, 247, 92, 67, 120, 167, 5, 117, 22, 86,
-			92, 144, 229, 16, 56, 127, 106, 114, 234, 140, 70, 0, 39, 236,
-			198, 242, 149, 241, 243, 113, 244, 126, 154, 207, 147, 172, 97, 5,
-			59, 210, 110, 90, 193, 142, 28, 201, 105, 177, 254, 229, 215, 91,
-			228, 236, 221, 130, 49, 137, 222, 236, 122, 115, 116, 71, 180, 37,
-			231, 197, 192, 62, 57, 47, 234, 94, 203, 249, 199, 108, 86, 185,
-			183, 90, 164, 91, 198, 240, 20, 4, 40, 151, 121, 99, 131, 146,
-			55, 54, 139, 164, 211, 112, 240, 85, 151, 91, 39, 91, 92, 145,
-			36, 74, 204, 199, 240, 79, 133, 142, 72, 255, 29, 58, 255, 10,
-			17, 18, 255, 70, 15, 147, 14, 9, 12, 182, 18, 185, 235, 178,
-			114, 34, 31, 45, 187, 235, 116, 47, 105, 131, 250, 53, 132, 80,
-			134, 147, 243, 37, 122, 133, 116, 39, 123, 7, 119, 59, 45, 47,
-			239, 18, 27, 113, 161, 107, 205, 36, 115, 95, 177, 73, 143, 110,
-			187, 192, 185, 164, 55, 201, 160, 156, 14, 94, 105, 37, 209, 125,
-			1, 71, 117, 246, 54, 221, 23, 69, 40, 218, 43, 25, 140, 232,
-			47, 238, 124, 24, 210, 2, 161, 113, 228, 205, 138, 52, 20, 74,
-			172, 173, 163, 187, 86, 228, 87, 215, 229, 78, 92, 232, 43, 54,
-			63, 114, 222, 109, 145, 61, 45, 90, 112, 103, 118, 63, 67, 178,
-			106, 33, 200, 161, 190, 252, 162, 250, 170, 158, 9, 104, 46, 93,
-			166, 243, 2, 233, 52, 127, 161, 23, 9, 145, 191, 241, 17, 22,
-			215, 175, 251, 119, 173, 113, 190, 84, 104, 47, 106, 216, 169, 83,
-			196, 94, 109, 172, 75, 78, 181, 24, 246, 4, 36, 86, 129, 191,
-			155, 251, 52, 34, 125, 59, 24, 72, 199, 9, 213, 177, 69, 161,
-			30, 1, 4, 87, 133, 125, 241, 47, 234, 245, 7, 73, 87, 189,
-			81, 246, 194, 166, 177, 186, 29, 46, 90, 39, 124, 160, 10, 152,
-			38, 221, 34, 158, 89, 151, 96, 223, 177, 132, 46, 241, 133, 44,
-			34, 55, 76, 250, 30, 246, 162, 166, 85, 76, 9, 230, 71, 53,
-			117, 41, 202, 255, 206, 253, 123, 76, 218, 228, 107, 173, 126, 231,
-			51, 98, 195, 13, 87, 228, 30, 7, 93, 201, 22, 200, 134, 27,
-			74, 192, 109, 218, 79, 210, 145, 31, 149, 61, 121, 131, 44, 8,
-			250, 16, 105, 171, 120, 81, 221, 47, 134, 67, 4, 166, 201, 61,
-			187, 14, 90, 254, 170, 120, 81, 76, 5, 245, 25, 189, 74, 142,
-			114, 53, 108, 211, 45, 123, 213, 104, 69, 45, 101, 149, 199, 197,
-			15, 170, 43, 156, 103, 67, 109, 80, 43, 139, 95, 149, 139, 121,
-			58, 126, 177, 208, 40, 123, 78, 142, 100, 102, 130, 70, 53, 10,
-			185, 44, 171, 6, 21, 191, 234, 150, 161, 163, 118, 65, 145, 206,
-			103, 16, 233, 230, 28, 229, 91, 162, 124, 249, 2, 105, 11, 170,
-			222, 74, 201, 221, 150, 163, 200, 118, 239, 135, 248, 164, 144, 9,
-			170, 222, 172, 187, 77, 239, 39, 237, 144, 160, 15, 62, 182, 239,
-			242, 227, 44, 124, 34, 63, 15, 189, 77, 175, 10, 159, 227, 187,
-			253, 28, 62, 153, 117, 183, 157, 18, 233, 52, 249, 218, 2, 178,
-			238, 1, 19, 178, 174, 99, 106, 100, 247, 194, 147, 60, 73, 194,
-			226, 29, 122, 216, 139, 10, 94, 44, 97, 174, 215, 131, 117, 174,
-			134, 222, 110, 206, 125, 22, 145, 254, 86, 223, 180, 156, 128, 99,
-			132, 214, 228, 239, 43, 53, 175, 190, 82, 241, 203, 114, 30, 166,
-			11, 189, 234, 151, 235, 94, 253, 42, 127, 78, 239, 37, 184, 236,
-			134, 194, 175, 224, 46, 197, 35, 124, 192, 63, 172, 122, 183, 162,
-			161, 204, 183, 240, 33, 255, 32, 247, 22, 139, 28, 120, 188, 225,
-			213, 183, 229, 11, 75, 141, 74, 197, 173, 251, 94, 120, 231, 189,
-			243, 120, 188, 69, 9, 143, 107, 185, 133, 169, 29, 72, 226, 165,
-			237, 35, 89, 48, 206, 174, 172, 110, 203, 69, 214, 6, 244, 229,
-			109, 94, 182, 90, 102, 152, 217, 252, 23, 181, 124, 46, 18, 194,
-			245, 143, 21, 128, 54, 146, 236, 104, 33, 56, 53, 252, 99, 161,
-			61, 210, 72, 144, 231, 9, 222, 244, 189, 45, 224, 69, 247, 212,
-			177, 93, 121, 33, 186, 186, 253, 132, 239, 109, 21, 224, 139, 92,
-			149, 28, 220, 133, 23, 114, 231, 188, 74, 212, 110, 180, 18, 170,
-			31, 229, 166, 185, 251, 236, 150, 245, 20, 122, 139, 77, 197, 230,
-			222, 99, 107, 85, 69, 190, 244, 143, 218, 49, 180, 44, 179, 76,
-			89, 38, 247, 145, 93, 213, 135, 157, 251, 8, 125, 56, 30, 151,
-			54, 232, 220, 248, 157, 58, 215, 90, 10, 58, 11, 164, 67, 252,
-			240, 4, 95, 115, 49, 204, 164, 144, 92, 130, 160, 195, 164, 167,
-			228, 250, 229, 237, 149, 213, 186, 231, 222, 44, 5, 91, 85, 216,
-			155, 237, 66, 55, 60, 190, 172, 158, 58, 27, 119, 20, 10, 151,
-			147, 66, 97, 236, 46, 155, 13, 173, 51, 5, 195, 43, 201, 126,
-			115, 34, 72, 161, 172, 215, 196, 32, 201, 136, 20, 222, 10, 127,
-			82, 80, 244, 40, 233, 18, 61, 79, 46, 136, 78, 241, 80, 172,
-			135, 92, 41, 185, 224, 226, 178, 229, 28, 155, 37, 89, 117, 46,
-			146, 83, 171, 133, 108, 155, 245, 195, 200, 175, 22, 163, 100, 33,
-			5, 253, 101, 238, 119, 218, 200, 96, 235, 151, 76, 157, 19, 37,
-			116, 206, 211, 164, 77, 98, 20, 73, 254, 237, 219, 21, 151, 180,
-			160, 222, 228, 187, 61, 100, 217, 135, 61, 141, 47, 194, 187, 217,
-			237, 245, 23, 252, 25, 125, 37, 233, 210, 238, 209, 43, 245, 70,
-			85, 238, 23, 45, 212, 208, 214, 61, 202, 155, 158, 216, 133, 206,
-			154, 65, 209, 19, 164, 207, 15, 87, 192, 237, 119, 69, 133, 229,
-			128, 60, 201, 22, 122, 252, 16, 34, 147, 103, 228, 99, 250, 20,
-			233, 52, 194, 97, 194, 161, 12, 112, 255, 204, 93, 55, 195, 12,
-			11, 76, 148, 68, 31, 34, 157, 162, 9, 194, 205, 24, 182, 253,
-			238, 169, 131, 59, 75, 54, 66, 165, 11, 29, 171, 6, 78, 231,
-			25, 50, 232, 87, 215, 193, 187, 100, 37, 190, 21, 230, 99, 152,
-			133, 49, 236, 87, 191, 206, 235, 31, 231, 75, 116, 134, 28, 242,
-			195, 149, 86, 31, 66, 122, 77, 175, 52, 212, 14, 172, 216, 239,
-			135, 243, 59, 190, 191, 44, 94, 161, 15, 36, 253, 255, 132, 66,
-			212, 2, 200, 52, 182, 235, 38, 189, 255, 250, 73, 26, 34, 104,
-			135, 58, 96, 215, 19, 132, 243, 40, 233, 48, 248, 69, 47, 145,
-			140, 60, 231, 32, 224, 77, 139, 45, 108, 71, 212, 101, 65, 126,
-			226, 252, 57, 34, 157, 230, 28, 160, 143, 146, 222, 196, 140, 138,
-			101, 105, 11, 49, 157, 68, 165, 45, 116, 215, 146, 40, 181, 253,
-			36, 13, 166, 100, 37, 85, 129, 160, 231, 8, 174, 4, 37, 49,
-			217, 187, 91, 1, 248, 54, 71, 187, 20, 224, 125, 122, 31, 201,
-			200, 57, 128, 119, 219, 158, 118, 198, 21, 20, 228, 55, 185, 25,
-			50, 106, 202, 142, 56, 102, 151, 31, 77, 228, 210, 188, 147, 148,
-			202, 189, 14, 145, 19, 119, 83, 138, 148, 71, 75, 164, 11, 196,
-			133, 92, 240, 74, 40, 229, 119, 149, 173, 45, 203, 43, 192, 57,
-			91, 21, 158, 251, 107, 68, 14, 220, 238, 245, 151, 88, 72, 61,
-			68, 14, 168, 197, 175, 116, 113, 126, 40, 80, 21, 75, 191, 73,
-			71, 189, 163, 100, 114, 220, 52, 58, 71, 122, 185, 162, 181, 98,
-			44, 107, 41, 166, 110, 39, 232, 122, 248, 55, 198, 188, 205, 189,
-			21, 17, 199, 228, 253, 35, 112, 103, 185, 253, 146, 41, 91, 148,
-			224, 146, 187, 29, 202, 14, 193, 223, 187, 107, 89, 185, 39, 146,
-			219, 156, 110, 140, 28, 249, 123, 101, 97, 98, 192, 119, 87, 42,
-			229, 119, 179, 238, 182, 168, 49, 247, 145, 248, 28, 26, 255, 70,
-			31, 141, 219, 33, 74, 156, 188, 139, 18, 119, 57, 94, 65, 63,
-			35, 165, 233, 192, 223, 206, 197, 59, 170, 7, 9, 152, 235, 180,
-			177, 225, 159, 184, 70, 232, 78, 173, 144, 30, 35, 108, 102, 225,
-			198, 210, 242, 92, 97, 101, 233, 198, 213, 171, 211, 133, 87, 172,
-			60, 49, 63, 247, 228, 78, 47, 215, 203, 211, 75, 243, 51, 189,
-			136, 102, 9, 190, 114, 99, 97, 161, 215, 154, 250, 161, 12, 201,
-			202, 18, 67, 122, 61, 62, 164, 238, 174, 38, 202, 57, 224, 28,
-			185, 163, 69, 34, 151, 162, 15, 19, 251, 97, 47, 162, 45, 198,
-			100, 199, 185, 217, 105, 177, 64, 228, 27, 185, 20, 109, 144, 189,
-			187, 28, 129, 104, 139, 225, 185, 253, 105, 201, 105, 113, 74, 110,
-			245, 122, 46, 69, 95, 67, 6, 90, 106, 218, 180, 133, 88, 185,
-			221, 241, 196, 153, 184, 235, 247, 53, 239, 182, 72, 127, 43, 5,
-			140, 182, 80, 114, 111, 163, 4, 58, 119, 104, 105, 179, 94, 151,
-			75, 209, 31, 64, 114, 189, 181, 150, 184, 244, 210, 237, 75, 188,
-			173, 180, 119, 238, 123, 113, 31, 235, 198, 85, 72, 39, 188, 47,
-			151, 30, 109, 161, 59, 239, 46, 184, 156, 59, 240, 174, 73, 178,
-			228, 82, 47, 238, 238, 239, 171, 191, 139, 4, 178, 243, 59, 210,
-			47, 223, 254, 189, 124, 251, 247, 237, 184, 253, 147, 112, 207, 253,
-			49, 220, 243, 64, 234, 126, 248, 211, 162, 246, 96, 234, 1, 248,
-			211, 166, 246, 222, 212, 28, 121, 115, 23, 177, 50, 41, 154, 190,
-			55, 245, 46, 132, 156, 255, 187, 147, 93, 87, 57, 103, 84, 238,
-			14, 243, 90, 201, 0, 193, 20, 232, 66, 114, 82, 0, 12, 4,
-			32, 169, 136, 137, 17, 132, 30, 97, 250, 250, 134, 16, 54, 29,
-			163, 73, 133, 204, 101, 235, 245, 160, 33, 252, 96, 13, 104, 17,
-			145, 255, 4, 38, 88, 165, 18, 84, 99, 15, 60, 64, 218, 104,
-			118, 224, 77, 0, 114, 242, 249, 185, 22, 212, 43, 49, 198, 153,
-			17, 69, 26, 151, 47, 80, 4, 54, 96, 214, 232, 106, 160, 32,
-			225, 79, 19, 232, 119, 37, 10, 77, 158, 45, 55, 87, 3, 174,
-			167, 171, 50, 208, 80, 195, 151, 73, 168, 97, 225, 104, 209, 8,
-			189, 250, 120, 37, 40, 249, 107, 62, 204, 99, 85, 166, 97, 73,
-			101, 245, 70, 217, 99, 35, 194, 65, 82, 103, 202, 14, 201, 14,
-			94, 172, 54, 214, 33, 93, 138, 88, 184, 2, 161, 212, 184, 221,
-			11, 85, 56, 40, 4, 171, 213, 188, 186, 72, 56, 36, 96, 143,
-			37, 72, 215, 174, 213, 75, 127, 113, 64, 239, 84, 48, 47, 18,
-			141, 65, 222, 43, 250, 85, 86, 1, 111, 107, 57, 148, 98, 208,
-			61, 183, 184, 161, 249, 108, 102, 236, 174, 192, 149, 168, 57, 50,
-			144, 39, 210, 124, 125, 67, 192, 60, 40, 7, 218, 49, 229, 11,
-			40, 17, 190, 192, 22, 27, 67, 164, 134, 23, 9, 27, 223, 137,
-			86, 215, 202, 251, 73, 32, 52, 174, 53, 138, 27, 161, 239, 230,
-			242, 234, 187, 216, 134, 104, 32, 173, 53, 33, 168, 37, 0, 212,
-			216, 52, 160, 149, 73, 57, 195, 133, 218, 200, 116, 173, 238, 151,
-			65, 70, 139, 72, 203, 24, 246, 76, 96, 106, 12, 243, 30, 243,
-			54, 141, 111, 158, 30, 134, 21, 175, 124, 203, 79, 75, 57, 201,
-			139, 225, 47, 141, 131, 159, 110, 43, 84, 183, 49, 54, 44, 166,
-			220, 174, 101, 196, 23, 186, 76, 97, 36, 181, 46, 136, 15, 209,
-			48, 220, 110, 140, 111, 78, 13, 107, 9, 36, 158, 8, 52, 130,
-			248, 67, 94, 90, 140, 226, 38, 186, 91, 142, 54, 0, 29, 68,
-			197, 54, 151, 183, 85, 99, 188, 210, 88, 92, 150, 1, 73, 167,
-			218, 234, 135, 108, 195, 47, 149, 188, 42, 47, 136, 87, 44, 193,
-			241, 192, 45, 20, 184, 254, 60, 223, 49, 87, 52, 76, 37, 20,
-			52, 241, 60, 255, 111, 197, 47, 189, 192, 220, 242, 150, 187, 109,
-			160, 194, 133, 192, 57, 3, 140, 206, 88, 104, 194, 241, 122, 211,
-			19, 179, 121, 197, 47, 229, 71, 249, 219, 79, 122, 2, 151, 175,
-			26, 176, 245, 134, 91, 119, 171, 145, 199, 219, 10, 126, 15, 32,
-			137, 53, 14, 86, 176, 102, 116, 1, 226, 211, 101, 32, 173, 242,
-			86, 96, 224, 90, 32, 177, 68, 32, 133, 162, 251, 92, 195, 147,
-			128, 116, 224, 73, 43, 251, 7, 211, 109, 186, 26, 23, 55, 174,
-			218, 185, 19, 68, 47, 6, 193, 52, 223, 22, 225, 7, 50, 251,
-			30, 31, 18, 57, 6, 35, 46, 76, 71, 99, 10, 50, 119, 23,
-			143, 101, 209, 172, 177, 216, 241, 0, 120, 23, 183, 222, 173, 170,
-			14, 8, 204, 215, 68, 243, 153, 0, 123, 223, 240, 228, 212, 25,
-			54, 103, 212, 46, 104, 128, 144, 73, 76, 142, 88, 12, 171, 12,
-			181, 138, 128, 140, 2, 151, 50, 243, 179, 26, 127, 145, 147, 2,
-			16, 68, 122, 66, 39, 145, 11, 9, 177, 51, 41, 68, 237, 123,
-			179, 189, 228, 255, 106, 35, 56, 3, 30, 40, 143, 225, 251, 156,
-			63, 109, 107, 78, 79, 46, 191, 210, 184, 69, 85, 0, 194, 171,
-			168, 96, 13, 181, 88, 70, 192, 81, 85, 112, 188, 112, 125, 70,
-			39, 104, 51, 138, 0, 176, 3, 16, 105, 134, 224, 146, 145, 70,
-			132, 21, 5, 144, 135, 130, 61, 50, 70, 52, 22, 2, 99, 26,
-			11, 217, 43, 197, 171, 180, 89, 220, 74, 225, 169, 12, 91, 58,
-			184, 56, 129, 163, 44, 243, 159, 185, 108, 53, 224, 27, 127, 137,
-			133, 145, 91, 246, 170, 160, 102, 40, 252, 67, 222, 215, 138, 95,
-			109, 68, 94, 158, 141, 20, 20, 34, 163, 234, 13, 81, 178, 91,
-			227, 142, 180, 174, 136, 183, 229, 174, 154, 45, 165, 101, 184, 163,
-			118, 22, 52, 162, 241, 96, 109, 156, 31, 100, 129, 203, 211, 77,
-			83, 117, 76, 233, 5, 34, 241, 159, 40, 83, 37, 162, 4, 109,
-			20, 122, 71, 20, 123, 86, 27, 17, 232, 171, 46, 4, 125, 137,
-			117, 234, 149, 216, 226, 181, 101, 145, 58, 173, 188, 45, 60, 228,
-			133, 123, 180, 216, 236, 162, 128, 48, 183, 92, 14, 182, 88, 221,
-			83, 82, 72, 206, 190, 53, 87, 76, 216, 100, 253, 170, 55, 81,
-			80, 246, 184, 112, 0, 224, 208, 13, 127, 157, 43, 10, 143, 95,
-			95, 138, 3, 242, 33, 8, 106, 59, 104, 212, 161, 165, 176, 218,
-			164, 158, 202, 87, 90, 80, 93, 231, 82, 184, 20, 18, 25, 184,
-			207, 27, 190, 229, 86, 69, 214, 70, 183, 92, 142, 23, 140, 225,
-			33, 4, 233, 25, 89, 163, 86, 114, 35, 17, 28, 196, 123, 62,
-			58, 70, 88, 173, 236, 65, 52, 16, 223, 72, 139, 81, 19, 210,
-			54, 88, 239, 194, 188, 144, 108, 34, 59, 157, 4, 32, 85, 129,
-			43, 144, 31, 82, 36, 115, 91, 43, 187, 0, 107, 30, 122, 101,
-			62, 232, 138, 91, 69, 15, 48, 165, 84, 58, 184, 70, 61, 177,
-			44, 64, 41, 171, 113, 153, 8, 40, 49, 144, 33, 23, 48, 243,
-			212, 96, 149, 61, 183, 164, 243, 250, 195, 12, 142, 99, 128, 72,
-			147, 22, 183, 186, 221, 212, 254, 56, 155, 92, 204, 19, 208, 21,
-			139, 110, 185, 216, 16, 176, 149, 161, 196, 246, 205, 8, 7, 162,
-			199, 178, 61, 138, 178, 168, 253, 152, 8, 170, 203, 8, 119, 162,
-			199, 70, 207, 147, 247, 98, 16, 15, 136, 218, 79, 225, 89, 231,
-			173, 152, 21, 248, 88, 48, 127, 135, 167, 91, 188, 73, 196, 114,
-			134, 93, 215, 220, 6, 101, 167, 21, 183, 85, 228, 151, 91, 2,
-			88, 20, 87, 231, 149, 132, 193, 13, 97, 222, 69, 146, 125, 99,
-			42, 214, 161, 105, 170, 21, 21, 8, 144, 252, 102, 21, 220, 216,
-			2, 118, 217, 95, 135, 131, 38, 176, 216, 13, 9, 0, 91, 141,
-			197, 17, 96, 97, 52, 30, 212, 34, 191, 226, 3, 140, 238, 154,
-			80, 193, 248, 192, 248, 18, 72, 51, 134, 237, 146, 203, 46, 88,
-			83, 10, 139, 210, 86, 78, 157, 30, 147, 219, 5, 180, 82, 12,
-			43, 76, 88, 246, 157, 167, 216, 195, 151, 249, 161, 67, 204, 83,
-			192, 134, 230, 173, 47, 187, 117, 62, 176, 9, 85, 107, 76, 197,
-			240, 212, 221, 106, 88, 22, 89, 42, 3, 208, 157, 67, 80, 245,
-			39, 243, 103, 217, 141, 37, 86, 20, 91, 53, 47, 83, 212, 228,
-			70, 172, 214, 88, 45, 251, 225, 6, 72, 58, 191, 8, 77, 187,
-			177, 116, 236, 236, 196, 242, 101, 6, 199, 227, 215, 72, 124, 46,
-			197, 13, 25, 61, 39, 194, 250, 20, 46, 63, 159, 156, 114, 63,
-			131, 83, 234, 182, 208, 139, 119, 195, 124, 57, 125, 74, 207, 34,
-			196, 231, 70, 182, 67, 81, 22, 181, 159, 234, 60, 168, 40, 155,
-			218, 79, 141, 60, 68, 254, 22, 193, 44, 178, 40, 126, 6, 175,
-			140, 58, 127, 129, 228, 52, 82, 66, 95, 221, 117, 179, 186, 55,
-			110, 232, 90, 241, 156, 146, 162, 53, 207, 10, 230, 11, 68, 162,
-			81, 234, 117, 158, 216, 109, 96, 171, 81, 58, 185, 47, 50, 72,
-			67, 98, 72, 185, 94, 249, 64, 240, 5, 69, 118, 85, 220, 185,
-			58, 213, 188, 29, 37, 116, 64, 121, 140, 52, 126, 106, 1, 214,
-			159, 73, 201, 200, 135, 195, 138, 178, 168, 253, 12, 123, 64, 81,
-			54, 181, 87, 250, 142, 147, 175, 100, 129, 71, 54, 197, 91, 248,
-			214, 148, 243, 7, 89, 246, 184, 156, 137, 226, 54, 121, 187, 213,
-			162, 11, 106, 137, 211, 31, 184, 29, 122, 209, 206, 3, 159, 10,
-			29, 18, 217, 126, 165, 124, 49, 155, 13, 17, 70, 38, 52, 91,
-			28, 186, 148, 52, 45, 55, 103, 230, 20, 46, 111, 68, 198, 69,
-			53, 237, 145, 218, 187, 84, 36, 15, 138, 15, 175, 37, 175, 238,
-			111, 170, 64, 24, 153, 74, 185, 44, 96, 84, 85, 155, 181, 220,
-			132, 93, 135, 23, 181, 201, 165, 225, 186, 107, 70, 69, 194, 126,
-			18, 70, 90, 206, 169, 122, 249, 124, 15, 131, 10, 32, 192, 172,
-			42, 142, 24, 71, 52, 1, 234, 39, 32, 68, 229, 204, 106, 138,
-			90, 149, 189, 21, 186, 30, 164, 207, 204, 37, 125, 238, 46, 134,
-			225, 70, 78, 58, 198, 174, 249, 92, 192, 148, 203, 77, 112, 210,
-			33, 219, 218, 80, 113, 180, 98, 166, 233, 3, 174, 200, 79, 206,
-			114, 80, 136, 56, 216, 251, 205, 135, 226, 49, 229, 171, 187, 5,
-			184, 139, 171, 190, 204, 58, 45, 59, 171, 120, 199, 183, 247, 112,
-			35, 30, 107, 49, 213, 205, 179, 99, 172, 9, 55, 66, 111, 173,
-			81, 230, 205, 170, 18, 147, 163, 213, 245, 216, 112, 82, 171, 7,
-			171, 101, 175, 34, 178, 203, 11, 33, 189, 225, 215, 184, 60, 116,
-			53, 194, 55, 96, 103, 189, 52, 18, 158, 152, 50, 232, 159, 153,
-			132, 23, 154, 218, 169, 201, 157, 114, 254, 94, 86, 226, 218, 122,
-			176, 38, 166, 154, 58, 27, 126, 171, 162, 159, 196, 218, 104, 44,
-			255, 239, 32, 254, 201, 93, 200, 127, 33, 122, 108, 68, 237, 173,
-			172, 18, 214, 182, 69, 237, 173, 67, 23, 21, 101, 83, 251, 86,
-			95, 158, 188, 211, 2, 177, 132, 105, 250, 187, 240, 107, 209, 41,
-			231, 245, 150, 150, 75, 114, 181, 64, 47, 227, 217, 87, 253, 255,
-			129, 42, 32, 88, 132, 17, 181, 191, 43, 123, 64, 81, 22, 181,
-			191, 235, 224, 121, 210, 37, 40, 0, 126, 239, 27, 39, 63, 35,
-			248, 151, 166, 153, 55, 33, 252, 102, 244, 160, 243, 35, 49, 3,
-			5, 164, 162, 52, 183, 43, 206, 105, 187, 26, 76, 10, 176, 58,
-			213, 189, 162, 232, 211, 134, 91, 98, 110, 149, 36, 128, 57, 235,
-			94, 49, 168, 67, 26, 126, 137, 207, 27, 109, 120, 149, 188, 0,
-			208, 50, 253, 12, 152, 225, 83, 79, 100, 233, 0, 9, 107, 164,
-			238, 215, 71, 174, 186, 103, 4, 200, 131, 190, 32, 41, 22, 6,
-			229, 77, 29, 229, 170, 21, 246, 24, 93, 11, 12, 115, 97, 228,
-			86, 75, 110, 189, 4, 89, 160, 79, 157, 158, 98, 11, 144, 130,
-			92, 88, 58, 133, 102, 33, 229, 43, 216, 54, 165, 7, 152, 138,
-			152, 20, 232, 78, 25, 72, 171, 142, 223, 132, 178, 71, 21, 105,
-			113, 242, 216, 85, 69, 218, 20, 191, 25, 245, 93, 34, 255, 32,
-			88, 156, 161, 153, 119, 32, 252, 78, 52, 233, 252, 149, 193, 98,
-			216, 36, 224, 130, 65, 166, 64, 174, 251, 197, 208, 212, 145, 141,
-			45, 135, 133, 110, 228, 135, 219, 188, 123, 114, 254, 214, 125, 190,
-			65, 186, 73, 155, 168, 128, 217, 131, 128, 58, 189, 211, 2, 126,
-			173, 140, 106, 8, 164, 244, 45, 9, 99, 169, 92, 248, 97, 36,
-			133, 194, 255, 231, 86, 131, 24, 142, 12, 162, 248, 29, 40, 219,
-			175, 72, 139, 147, 3, 19, 138, 180, 41, 126, 39, 234, 27, 35,
-			157, 16, 49, 147, 121, 55, 74, 253, 207, 8, 1, 22, 89, 10,
-			96, 219, 179, 131, 228, 63, 1, 128, 135, 157, 162, 153, 247, 34,
-			252, 99, 40, 237, 124, 10, 177, 216, 173, 58, 134, 46, 223, 169,
-			7, 185, 73, 147, 66, 216, 88, 91, 243, 139, 190, 7, 135, 68,
-			18, 31, 134, 34, 17, 190, 45, 67, 58, 164, 238, 162, 242, 33,
-			200, 19, 159, 119, 171, 230, 130, 65, 32, 216, 244, 234, 144, 239,
-			176, 217, 32, 46, 23, 108, 162, 25, 242, 227, 184, 200, 248, 116,
-			43, 112, 191, 82, 54, 239, 230, 123, 81, 231, 32, 249, 89, 68,
-			50, 156, 182, 82, 20, 255, 16, 202, 30, 118, 126, 20, 177, 107,
-			194, 94, 20, 185, 235, 176, 8, 0, 153, 68, 90, 222, 138, 16,
-			29, 50, 38, 89, 174, 85, 44, 95, 98, 6, 215, 149, 39, 186,
-			186, 80, 128, 227, 163, 27, 134, 126, 40, 115, 112, 149, 197, 254,
-			174, 94, 148, 22, 111, 217, 212, 48, 79, 216, 172, 58, 124, 198,
-			200, 121, 50, 199, 123, 158, 93, 147, 233, 199, 243, 132, 244, 144,
-			54, 209, 236, 52, 180, 187, 59, 126, 128, 248, 131, 30, 39, 126,
-			96, 243, 7, 7, 15, 145, 77, 217, 83, 68, 241, 251, 81, 118,
-			191, 179, 22, 91, 151, 234, 166, 42, 1, 214, 55, 141, 177, 161,
-			81, 129, 103, 47, 143, 74, 230, 43, 227, 179, 82, 215, 116, 50,
-			249, 8, 242, 142, 64, 143, 170, 126, 245, 213, 238, 197, 137, 9,
-			163, 169, 40, 13, 21, 199, 77, 69, 208, 146, 158, 193, 248, 129,
-			205, 31, 236, 115, 200, 69, 217, 84, 139, 226, 31, 69, 217, 139,
-			206, 9, 49, 85, 18, 10, 89, 178, 201, 254, 26, 87, 236, 70,
-			141, 234, 172, 12, 124, 124, 79, 252, 0, 241, 7, 195, 103, 227,
-			7, 54, 127, 112, 254, 2, 192, 37, 192, 12, 248, 113, 132, 247,
-			58, 211, 59, 77, 239, 92, 112, 52, 37, 189, 144, 87, 6, 70,
-			188, 147, 146, 96, 106, 146, 193, 216, 252, 56, 194, 154, 4, 172,
-			249, 14, 170, 72, 155, 147, 3, 131, 100, 67, 34, 229, 224, 15,
-			35, 60, 234, 188, 114, 103, 226, 204, 248, 46, 42, 111, 64, 218,
-			76, 78, 238, 188, 13, 90, 141, 47, 160, 196, 172, 12, 170, 198,
-			177, 64, 86, 140, 48, 84, 213, 169, 200, 12, 39, 187, 246, 42,
-			18, 26, 50, 116, 76, 145, 54, 39, 135, 71, 64, 84, 32, 154,
-			249, 73, 148, 250, 188, 20, 21, 252, 205, 159, 68, 217, 189, 228,
-			65, 130, 49, 226, 146, 226, 167, 16, 254, 21, 148, 150, 233, 80,
-			13, 91, 165, 155, 76, 190, 25, 170, 171, 19, 104, 163, 108, 23,
-			130, 53, 249, 83, 168, 243, 48, 121, 132, 100, 56, 201, 75, 252,
-			40, 202, 254, 123, 212, 238, 156, 99, 211, 85, 51, 193, 141, 222,
-			26, 239, 80, 48, 31, 105, 40, 9, 81, 252, 81, 212, 123, 128,
-			108, 147, 172, 120, 192, 71, 251, 103, 81, 231, 57, 103, 93, 230,
-			112, 225, 43, 204, 52, 243, 38, 55, 225, 56, 211, 152, 107, 102,
-			174, 201, 193, 193, 52, 151, 180, 21, 207, 207, 10, 51, 119, 24,
-			72, 83, 23, 155, 159, 205, 19, 210, 71, 218, 85, 213, 25, 168,
-			251, 30, 243, 17, 226, 143, 134, 79, 153, 143, 108, 254, 232, 204,
-			89, 242, 139, 72, 55, 26, 81, 252, 113, 212, 121, 218, 249, 80,
-			156, 200, 80, 95, 163, 149, 226, 107, 108, 217, 146, 49, 38, 86,
-			69, 124, 226, 224, 39, 63, 194, 106, 65, 173, 81, 214, 72, 225,
-			250, 120, 148, 184, 205, 219, 237, 20, 62, 166, 181, 21, 125, 125,
-			103, 156, 83, 227, 59, 199, 64, 220, 226, 37, 250, 205, 103, 218,
-			199, 81, 231, 9, 243, 17, 244, 232, 100, 222, 124, 100, 243, 71,
-			167, 166, 200, 27, 144, 152, 7, 124, 168, 62, 193, 69, 115, 227,
-			206, 146, 89, 39, 218, 149, 115, 190, 133, 28, 38, 230, 235, 74,
-			38, 123, 187, 75, 100, 53, 137, 96, 57, 127, 66, 201, 47, 36,
-			7, 237, 19, 74, 212, 34, 57, 100, 159, 224, 162, 118, 70, 182,
-			28, 81, 252, 203, 40, 123, 210, 57, 109, 174, 136, 112, 135, 16,
-			73, 76, 92, 94, 189, 57, 121, 97, 189, 254, 50, 202, 246, 198,
-			15, 50, 252, 65, 223, 193, 248, 1, 212, 115, 232, 158, 248, 129,
-			205, 31, 140, 158, 32, 111, 180, 36, 140, 15, 254, 12, 178, 47,
-			57, 127, 139, 146, 13, 105, 190, 0, 16, 199, 213, 60, 104, 152,
-			188, 57, 161, 20, 32, 240, 27, 96, 225, 55, 127, 176, 35, 113,
-			98, 108, 132, 144, 113, 101, 66, 175, 85, 195, 160, 18, 94, 1,
-			114, 15, 32, 193, 184, 161, 249, 221, 24, 11, 3, 49, 66, 254,
-			120, 180, 193, 90, 68, 116, 25, 151, 46, 161, 54, 206, 142, 71,
-			27, 196, 84, 79, 154, 27, 35, 68, 140, 149, 194, 192, 136, 14,
-			69, 102, 56, 217, 121, 88, 145, 136, 147, 236, 156, 34, 109, 78,
-			94, 184, 72, 222, 133, 36, 2, 17, 254, 28, 178, 199, 156, 127,
-			33, 152, 40, 237, 238, 34, 119, 74, 43, 115, 212, 152, 186, 48,
-			172, 238, 114, 237, 65, 116, 4, 107, 232, 213, 55, 253, 162, 244,
-			83, 209, 115, 151, 239, 181, 37, 111, 181, 177, 190, 14, 254, 18,
-			141, 122, 45, 8, 61, 51, 49, 3, 111, 84, 6, 90, 213, 175,
-			72, 104, 228, 192, 176, 34, 109, 78, 158, 56, 73, 254, 15, 145,
-			211, 55, 243, 59, 40, 245, 37, 132, 156, 207, 237, 236, 66, 235,
-			118, 39, 111, 79, 160, 185, 205, 134, 110, 178, 155, 175, 132, 232,
-			128, 43, 181, 119, 115, 210, 240, 146, 119, 132, 185, 140, 137, 97,
-			151, 246, 161, 157, 214, 43, 185, 211, 202, 155, 55, 25, 253, 28,
-			138, 84, 99, 29, 2, 233, 6, 255, 14, 202, 238, 35, 23, 37,
-			212, 13, 254, 79, 8, 31, 113, 198, 204, 158, 238, 58, 86, 18,
-			233, 24, 216, 6, 224, 54, 252, 227, 118, 69, 34, 78, 146, 3,
-			138, 180, 57, 121, 152, 145, 95, 65, 18, 225, 5, 255, 62, 194,
-			147, 206, 207, 160, 230, 170, 118, 53, 101, 138, 234, 226, 188, 128,
-			27, 30, 91, 170, 185, 213, 170, 87, 39, 10, 52, 42, 78, 35,
-			46, 119, 34, 56, 180, 192, 118, 34, 156, 44, 138, 50, 21, 68,
-			181, 24, 212, 107, 129, 200, 7, 164, 244, 80, 197, 68, 93, 87,
-			115, 152, 116, 236, 168, 32, 250, 196, 167, 209, 239, 35, 124, 80,
-			145, 208, 167, 67, 39, 21, 105, 115, 50, 63, 65, 222, 130, 36,
-			232, 12, 254, 34, 194, 167, 156, 255, 169, 185, 195, 173, 47, 221,
-			118, 118, 86, 119, 142, 48, 51, 201, 68, 211, 165, 32, 152, 72,
-			165, 193, 183, 121, 214, 233, 150, 115, 53, 239, 139, 113, 203, 5,
-			148, 253, 161, 49, 69, 218, 156, 156, 152, 4, 245, 197, 166, 153,
-			63, 70, 169, 63, 151, 234, 139, 192, 168, 207, 238, 35, 255, 22,
-			73, 192, 25, 252, 103, 8, 247, 59, 255, 26, 233, 164, 136, 187,
-			37, 238, 19, 166, 235, 168, 238, 123, 10, 98, 175, 226, 70, 23,
-			181, 58, 60, 17, 39, 164, 84, 179, 119, 226, 121, 21, 43, 164,
-			231, 220, 11, 241, 51, 72, 65, 201, 102, 189, 208, 95, 175, 202,
-			177, 10, 224, 60, 3, 187, 86, 194, 204, 223, 165, 32, 109, 120,
-			99, 53, 137, 56, 217, 209, 163, 72, 155, 147, 116, 15, 116, 26,
-			211, 204, 95, 160, 212, 7, 44, 209, 105, 140, 40, 254, 11, 148,
-			237, 33, 183, 100, 94, 100, 252, 95, 120, 159, 95, 125, 199, 46,
-			191, 100, 221, 132, 54, 66, 130, 99, 94, 181, 38, 17, 39, 101,
-			15, 32, 197, 49, 254, 47, 188, 7, 211, 50, 197, 49, 254, 42,
-			194, 251, 156, 211, 77, 184, 136, 190, 64, 65, 47, 242, 179, 165,
-			50, 7, 36, 13, 55, 186, 62, 126, 248, 248, 42, 194, 89, 69,
-			66, 145, 237, 253, 138, 180, 57, 185, 119, 136, 252, 27, 145, 47,
-			26, 32, 224, 241, 128, 243, 14, 116, 135, 244, 158, 49, 119, 192,
-			196, 147, 212, 172, 118, 92, 87, 40, 19, 180, 17, 232, 202, 238,
-			103, 81, 189, 225, 73, 92, 184, 228, 247, 124, 249, 38, 93, 87,
-			66, 221, 31, 43, 13, 77, 212, 36, 96, 216, 119, 244, 42, 210,
-			230, 228, 158, 126, 210, 205, 187, 195, 21, 233, 191, 70, 248, 235,
-			40, 45, 126, 6, 117, 248, 175, 81, 39, 37, 115, 36, 195, 73,
-			62, 15, 254, 22, 101, 29, 9, 57, 46, 28, 37, 52, 88, 66,
-			84, 247, 139, 108, 36, 108, 84, 42, 234, 32, 14, 134, 85, 169,
-			234, 169, 35, 23, 150, 26, 210, 223, 162, 108, 87, 252, 0, 241,
-			7, 221, 3, 241, 3, 155, 63, 24, 218, 39, 27, 134, 104, 230,
-			27, 8, 191, 206, 82, 13, 227, 195, 242, 13, 212, 233, 144, 51,
-			208, 48, 80, 92, 254, 158, 159, 85, 5, 102, 144, 180, 181, 139,
-			246, 37, 140, 57, 37, 119, 91, 183, 67, 236, 235, 127, 175, 52,
-			53, 120, 128, 248, 3, 121, 210, 132, 7, 54, 127, 176, 207, 33,
-			151, 100, 69, 136, 226, 111, 162, 236, 65, 231, 228, 29, 42, 18,
-			174, 90, 210, 114, 164, 74, 227, 210, 243, 155, 102, 125, 8, 138,
-			235, 25, 138, 31, 216, 252, 193, 254, 3, 228, 172, 172, 207, 162,
-			248, 181, 86, 246, 160, 68, 47, 218, 189, 190, 45, 207, 187, 105,
-			212, 196, 165, 221, 107, 45, 163, 38, 222, 240, 215, 90, 70, 77,
-			124, 232, 95, 107, 237, 63, 64, 254, 46, 11, 83, 217, 166, 248,
-			251, 44, 60, 238, 124, 37, 27, 143, 109, 104, 90, 223, 110, 115,
-			128, 16, 22, 154, 138, 91, 99, 55, 189, 109, 37, 187, 231, 103,
-			73, 243, 220, 16, 62, 105, 98, 157, 20, 203, 225, 184, 48, 189,
-			141, 235, 240, 156, 220, 168, 186, 15, 19, 153, 22, 249, 222, 171,
-			171, 175, 123, 134, 101, 76, 158, 65, 192, 1, 238, 118, 5, 66,
-			222, 230, 229, 132, 229, 175, 36, 67, 189, 88, 201, 219, 244, 202,
-			1, 220, 0, 196, 177, 77, 194, 3, 66, 218, 4, 93, 184, 218,
-			7, 104, 82, 112, 23, 138, 83, 126, 140, 204, 60, 62, 10, 89,
-			26, 84, 214, 169, 120, 23, 231, 7, 89, 95, 121, 36, 42, 254,
-			204, 221, 18, 25, 211, 120, 41, 102, 109, 46, 56, 51, 239, 192,
-			140, 130, 110, 169, 216, 149, 113, 181, 132, 198, 227, 248, 150, 86,
-			29, 211, 135, 42, 229, 182, 169, 141, 212, 6, 130, 174, 225, 212,
-			34, 129, 243, 140, 110, 141, 67, 12, 25, 184, 73, 25, 159, 196,
-			181, 202, 156, 114, 50, 87, 32, 115, 139, 145, 200, 245, 4, 159,
-			37, 74, 26, 213, 140, 105, 202, 174, 168, 179, 111, 25, 96, 56,
-			202, 54, 167, 27, 164, 50, 107, 242, 9, 30, 4, 144, 96, 14,
-			110, 77, 34, 191, 54, 30, 172, 141, 71, 117, 79, 129, 240, 250,
-			161, 98, 0, 152, 65, 171, 227, 175, 241, 234, 193, 24, 243, 35,
-			94, 140, 184, 103, 118, 147, 149, 200, 142, 185, 225, 14, 86, 180,
-			224, 3, 100, 190, 98, 34, 169, 76, 115, 106, 176, 82, 192, 194,
-			96, 204, 68, 230, 89, 245, 152, 200, 247, 18, 212, 5, 242, 46,
-			111, 144, 42, 74, 140, 169, 142, 230, 212, 227, 39, 192, 46, 155,
-			13, 211, 113, 194, 181, 228, 60, 106, 178, 57, 195, 49, 157, 25,
-			7, 70, 243, 227, 248, 182, 192, 72, 242, 197, 155, 11, 215, 19,
-			174, 145, 204, 166, 88, 119, 195, 141, 49, 240, 172, 91, 13, 234,
-			145, 242, 244, 5, 191, 196, 85, 185, 216, 124, 233, 31, 164, 174,
-			133, 205, 154, 68, 58, 77, 153, 85, 131, 49, 225, 250, 182, 234,
-			53, 101, 44, 227, 69, 78, 64, 85, 19, 80, 141, 222, 163, 236,
-			12, 200, 158, 195, 138, 68, 156, 100, 195, 138, 4, 201, 116, 98,
-			140, 188, 211, 6, 65, 133, 41, 254, 55, 22, 62, 237, 124, 183,
-			157, 48, 246, 237, 240, 223, 141, 17, 24, 154, 174, 206, 243, 236,
-			186, 222, 62, 5, 7, 255, 169, 54, 97, 233, 35, 37, 77, 180,
-			166, 147, 234, 142, 214, 74, 156, 207, 29, 133, 0, 228, 43, 97,
-			59, 163, 112, 192, 53, 244, 97, 47, 50, 117, 204, 216, 143, 16,
-			36, 244, 252, 44, 171, 184, 81, 113, 67, 250, 4, 16, 195, 242,
-			148, 39, 236, 134, 84, 247, 215, 220, 162, 95, 246, 35, 55, 242,
-			68, 206, 54, 225, 122, 85, 245, 182, 68, 73, 218, 81, 206, 221,
-			201, 34, 61, 130, 56, 13, 131, 162, 73, 196, 201, 142, 188, 34,
-			109, 78, 158, 154, 34, 103, 9, 127, 51, 243, 65, 43, 245, 111,
-			45, 228, 12, 183, 212, 100, 133, 103, 119, 66, 151, 229, 10, 105,
-			26, 81, 252, 65, 43, 123, 15, 121, 1, 18, 255, 167, 40, 254,
-			144, 133, 251, 157, 64, 200, 64, 67, 15, 173, 27, 225, 73, 166,
-			139, 138, 84, 89, 239, 90, 35, 175, 183, 8, 115, 146, 253, 77,
-			131, 2, 243, 33, 213, 223, 180, 200, 233, 105, 73, 173, 52, 13,
-			74, 195, 135, 44, 186, 135, 252, 24, 130, 172, 253, 153, 143, 88,
-			169, 95, 182, 144, 243, 30, 196, 90, 5, 79, 197, 119, 36, 242,
-			140, 211, 202, 171, 198, 37, 242, 124, 12, 18, 64, 182, 114, 184,
-			105, 197, 11, 247, 15, 237, 19, 0, 199, 58, 98, 156, 222, 77,
-			183, 76, 253, 88, 50, 56, 131, 40, 254, 136, 149, 61, 64, 158,
-			131, 204, 239, 41, 138, 63, 202, 25, 92, 252, 22, 25, 252, 162,
-			153, 154, 1, 166, 126, 84, 49, 53, 3, 76, 253, 168, 98, 106,
-			6, 152, 250, 81, 206, 212, 95, 69, 208, 64, 68, 241, 207, 91,
-			248, 136, 243, 191, 32, 118, 189, 9, 116, 67, 105, 32, 186, 113,
-			234, 136, 34, 29, 151, 146, 156, 173, 55, 170, 99, 224, 147, 20,
-			54, 234, 234, 104, 28, 52, 66, 183, 90, 138, 54, 66, 54, 194,
-			245, 3, 128, 248, 24, 5, 231, 119, 113, 231, 6, 66, 89, 104,
-			97, 128, 103, 17, 18, 225, 216, 49, 201, 70, 38, 143, 51, 149,
-			156, 122, 84, 248, 25, 76, 78, 178, 145, 83, 147, 230, 115, 221,
-			101, 126, 218, 248, 121, 75, 26, 16, 50, 160, 14, 254, 188, 37,
-			13, 8, 25, 80, 6, 127, 222, 58, 204, 224, 116, 147, 225, 170,
-			224, 199, 45, 124, 88, 26, 9, 215, 3, 183, 156, 56, 242, 171,
-			210, 75, 59, 251, 167, 235, 227, 106, 225, 199, 45, 60, 160, 72,
-			196, 201, 65, 71, 145, 54, 39, 15, 30, 34, 95, 69, 42, 251,
-			255, 39, 121, 133, 95, 66, 59, 106, 220, 149, 149, 121, 145, 58,
-			22, 246, 30, 210, 114, 100, 180, 37, 48, 63, 42, 206, 250, 242,
-			26, 78, 155, 251, 132, 161, 143, 220, 177, 87, 194, 204, 190, 233,
-			250, 101, 216, 153, 119, 248, 219, 135, 99, 100, 87, 11, 149, 31,
-			178, 70, 85, 40, 98, 37, 205, 29, 190, 15, 125, 50, 230, 14,
-			223, 135, 62, 25, 115, 199, 6, 118, 28, 60, 196, 79, 203, 233,
-			20, 205, 252, 170, 149, 250, 188, 56, 45, 67, 42, 237, 95, 181,
-			210, 123, 201, 146, 74, 165, 253, 105, 11, 143, 58, 115, 192, 182,
-			146, 204, 43, 57, 193, 26, 213, 208, 83, 249, 37, 133, 154, 59,
-			125, 125, 94, 108, 150, 234, 37, 185, 83, 65, 244, 41, 3, 188,
-			90, 51, 215, 246, 167, 45, 124, 204, 200, 181, 253, 105, 107, 120,
-			132, 31, 208, 69, 174, 237, 207, 90, 184, 219, 121, 181, 206, 91,
-			9, 183, 63, 242, 210, 180, 201, 73, 65, 186, 166, 141, 177, 185,
-			167, 102, 22, 110, 204, 206, 47, 62, 76, 24, 0, 115, 48, 141,
-			215, 17, 54, 29, 235, 135, 67, 117, 246, 144, 250, 120, 34, 127,
-			247, 103, 229, 20, 150, 249, 187, 63, 107, 117, 118, 65, 74, 68,
-			184, 152, 251, 156, 133, 187, 156, 49, 221, 46, 111, 211, 171, 111,
-			71, 27, 2, 158, 187, 85, 179, 18, 137, 189, 63, 103, 137, 163,
-			184, 76, 236, 253, 57, 11, 208, 147, 45, 220, 70, 51, 191, 105,
-			165, 126, 216, 22, 214, 138, 54, 68, 241, 111, 90, 217, 99, 228,
-			8, 228, 235, 79, 81, 252, 219, 22, 222, 43, 147, 134, 155, 23,
-			116, 58, 243, 61, 151, 53, 191, 173, 100, 77, 27, 48, 247, 183,
-			45, 121, 229, 214, 6, 178, 230, 183, 173, 129, 65, 242, 254, 118,
-			40, 144, 159, 10, 45, 124, 200, 121, 103, 59, 155, 174, 10, 247,
-			136, 115, 147, 44, 140, 182, 249, 198, 45, 92, 34, 12, 159, 226,
-			132, 15, 159, 236, 163, 148, 128, 9, 27, 40, 204, 74, 233, 227,
-			235, 153, 78, 14, 252, 16, 36, 162, 191, 65, 156, 11, 255, 138,
-			16, 54, 96, 229, 22, 247, 42, 213, 136, 53, 245, 222, 211, 35,
-			187, 185, 125, 158, 155, 20, 254, 231, 113, 98, 66, 56, 75, 185,
-			161, 55, 30, 122, 85, 1, 162, 46, 98, 156, 86, 249, 15, 66,
-			172, 241, 133, 234, 185, 245, 226, 134, 84, 111, 212, 88, 5, 229,
-			70, 165, 42, 182, 157, 21, 191, 36, 60, 41, 155, 64, 251, 68,
-			186, 13, 184, 216, 133, 168, 35, 3, 59, 50, 199, 235, 121, 69,
-			208, 0, 215, 69, 126, 58, 152, 94, 156, 29, 99, 215, 10, 192,
-			139, 197, 107, 203, 108, 4, 28, 83, 116, 179, 70, 89, 57, 88,
-			7, 252, 111, 126, 78, 115, 69, 190, 99, 87, 32, 168, 131, 162,
-			0, 161, 114, 92, 187, 102, 195, 227, 195, 0, 3, 153, 208, 250,
-			22, 175, 45, 231, 217, 85, 5, 84, 25, 119, 79, 166, 90, 140,
-			189, 101, 228, 9, 96, 122, 113, 86, 39, 139, 40, 229, 153, 232,
-			201, 50, 120, 235, 241, 111, 227, 194, 47, 18, 182, 225, 149, 203,
-			1, 219, 10, 234, 229, 18, 228, 236, 215, 143, 120, 33, 226, 49,
-			17, 121, 27, 148, 203, 213, 69, 194, 92, 222, 217, 85, 254, 63,
-			127, 107, 241, 218, 242, 200, 42, 231, 209, 120, 113, 212, 96, 140,
-			156, 82, 0, 169, 194, 103, 71, 93, 179, 29, 250, 60, 124, 255,
-			240, 24, 27, 62, 114, 255, 176, 136, 101, 186, 56, 204, 70, 54,
-			220, 112, 52, 102, 145, 114, 236, 132, 224, 217, 13, 56, 160, 248,
-			37, 189, 127, 171, 215, 52, 172, 165, 203, 66, 240, 64, 81, 153,
-			111, 231, 242, 235, 23, 137, 26, 225, 139, 145, 87, 246, 248, 220,
-			220, 38, 108, 188, 201, 151, 114, 217, 175, 120, 65, 35, 34, 172,
-			53, 142, 201, 253, 57, 128, 59, 25, 63, 127, 126, 106, 234, 194,
-			185, 211, 103, 39, 39, 79, 159, 63, 127, 238, 222, 243, 103, 79,
-			157, 206, 17, 194, 150, 116, 150, 63, 61, 169, 2, 57, 231, 88,
-			80, 133, 147, 189, 108, 3, 255, 51, 89, 53, 127, 82, 247, 220,
-			114, 133, 255, 209, 186, 118, 254, 203, 14, 35, 163, 249, 80, 188,
-			34, 143, 133, 144, 89, 76, 87, 41, 240, 69, 128, 116, 215, 67,
-			153, 218, 83, 88, 6, 192, 181, 219, 15, 217, 58, 87, 149, 203,
-			219, 172, 238, 149, 26, 69, 126, 50, 22, 193, 53, 27, 110, 149,
-			93, 152, 60, 62, 202, 252, 53, 62, 236, 144, 16, 57, 42, 110,
-			120, 161, 178, 214, 144, 157, 141, 18, 130, 64, 183, 74, 250, 234,
-			242, 249, 6, 233, 213, 155, 175, 196, 18, 62, 174, 90, 160, 113,
-			77, 226, 239, 99, 129, 134, 64, 100, 117, 236, 83, 164, 205, 201,
-			3, 7, 201, 7, 51, 32, 208, 44, 138, 191, 215, 198, 67, 206,
-			247, 101, 216, 52, 92, 36, 184, 227, 122, 218, 51, 129, 181, 189,
-			166, 54, 15, 192, 59, 45, 197, 247, 1, 112, 251, 201, 86, 183,
-			149, 169, 70, 109, 93, 97, 80, 23, 113, 138, 240, 178, 31, 50,
-			55, 44, 138, 180, 169, 151, 96, 100, 97, 219, 223, 6, 108, 112,
-			153, 77, 21, 222, 36, 242, 232, 35, 123, 93, 171, 121, 213, 18,
-			115, 89, 14, 94, 204, 9, 175, 160, 91, 194, 192, 84, 226, 199,
-			240, 237, 106, 228, 222, 50, 147, 43, 128, 76, 173, 186, 155, 254,
-			58, 23, 163, 178, 209, 156, 165, 210, 30, 37, 35, 139, 34, 200,
-			41, 83, 188, 25, 249, 197, 155, 113, 240, 44, 27, 121, 246, 217,
-			209, 184, 24, 47, 44, 186, 53, 89, 136, 204, 201, 42, 83, 52,
-			131, 13, 0, 198, 146, 61, 251, 42, 119, 252, 53, 211, 227, 175,
-			92, 121, 90, 254, 49, 57, 126, 97, 229, 233, 103, 21, 63, 224,
-			120, 204, 153, 225, 138, 148, 237, 114, 110, 3, 142, 110, 221, 51,
-			146, 91, 198, 214, 44, 200, 34, 4, 242, 159, 200, 14, 192, 22,
-			144, 8, 232, 13, 160, 76, 126, 28, 7, 115, 23, 228, 118, 151,
-			102, 170, 216, 44, 21, 51, 23, 206, 141, 23, 9, 203, 169, 253,
-			250, 217, 221, 173, 100, 207, 230, 133, 204, 7, 142, 115, 225, 113,
-			87, 117, 9, 19, 157, 48, 93, 197, 176, 191, 47, 174, 214, 49,
-			173, 86, 60, 171, 138, 74, 52, 74, 114, 67, 164, 118, 247, 34,
-			215, 47, 135, 99, 44, 244, 60, 227, 160, 56, 37, 34, 221, 248,
-			148, 130, 93, 19, 230, 201, 152, 216, 160, 226, 173, 240, 212, 209,
-			138, 91, 27, 151, 147, 4, 242, 106, 137, 137, 3, 150, 70, 183,
-			22, 115, 95, 172, 27, 43, 13, 11, 69, 147, 136, 147, 29, 123,
-			20, 105, 115, 114, 112, 47, 249, 27, 4, 171, 202, 166, 248, 221,
-			54, 102, 206, 151, 91, 220, 12, 141, 112, 1, 109, 90, 67, 67,
-			17, 178, 47, 148, 162, 150, 154, 144, 15, 120, 206, 183, 57, 77,
-			201, 130, 38, 158, 151, 16, 101, 226, 122, 72, 197, 216, 107, 133,
-			194, 116, 253, 223, 13, 55, 36, 134, 135, 134, 187, 100, 224, 48,
-			145, 126, 122, 155, 110, 217, 47, 41, 27, 110, 236, 249, 18, 115,
-			201, 198, 208, 241, 78, 69, 166, 57, 217, 165, 184, 196, 213, 232,
-			119, 219, 253, 251, 21, 9, 92, 58, 116, 152, 252, 134, 96, 26,
-			166, 248, 7, 108, 124, 208, 249, 69, 153, 244, 222, 175, 200, 51,
-			149, 184, 66, 144, 222, 84, 1, 91, 247, 162, 93, 121, 36, 50,
-			47, 39, 228, 19, 104, 213, 137, 64, 134, 132, 75, 55, 95, 130,
-			137, 24, 173, 184, 90, 21, 2, 39, 252, 43, 224, 109, 248, 180,
-			238, 69, 210, 255, 191, 230, 213, 253, 0, 82, 41, 92, 152, 84,
-			38, 126, 209, 57, 156, 129, 222, 116, 41, 18, 113, 178, 123, 72,
-			145, 54, 39, 247, 31, 32, 223, 9, 61, 79, 83, 252, 62, 62,
-			93, 106, 208, 241, 178, 183, 233, 193, 241, 74, 112, 63, 78, 202,
-			212, 124, 195, 29, 247, 92, 217, 29, 55, 220, 77, 47, 207, 199,
-			189, 133, 157, 104, 39, 6, 142, 110, 108, 58, 3, 13, 24, 84,
-			36, 226, 228, 94, 53, 78, 105, 155, 147, 135, 14, 131, 186, 157,
-			165, 153, 247, 219, 169, 31, 149, 234, 118, 22, 81, 252, 126, 59,
-			123, 28, 50, 46, 65, 194, 220, 15, 216, 120, 202, 185, 63, 233,
-			51, 2, 225, 156, 137, 51, 131, 14, 126, 104, 25, 55, 34, 211,
-			229, 98, 40, 173, 211, 200, 173, 251, 1, 187, 107, 191, 145, 91,
-			247, 3, 246, 129, 113, 35, 183, 238, 7, 236, 201, 83, 208, 198,
-			118, 154, 249, 160, 157, 250, 140, 108, 99, 59, 162, 248, 131, 118,
-			118, 16, 146, 250, 66, 174, 220, 159, 176, 113, 222, 57, 98, 182,
-			81, 7, 223, 26, 22, 86, 157, 23, 55, 3, 95, 28, 50, 242,
-			226, 254, 132, 125, 120, 212, 200, 139, 251, 19, 246, 216, 56, 184,
-			180, 65, 94, 220, 15, 219, 120, 192, 57, 197, 150, 253, 168, 44,
-			239, 27, 131, 170, 55, 94, 246, 171, 42, 41, 126, 205, 140, 128,
-			105, 174, 141, 239, 222, 31, 86, 114, 70, 100, 196, 253, 176, 221,
-			97, 102, 196, 253, 176, 189, 167, 159, 84, 84, 66, 220, 143, 216,
-			248, 164, 179, 114, 55, 190, 93, 242, 62, 29, 150, 131, 130, 151,
-			151, 209, 227, 34, 230, 161, 57, 88, 189, 233, 6, 177, 29, 108,
-			6, 31, 177, 113, 206, 72, 125, 251, 17, 251, 232, 61, 70, 234,
-			219, 143, 216, 163, 39, 224, 162, 174, 221, 78, 209, 204, 79, 219,
-			248, 147, 182, 184, 168, 107, 135, 27, 196, 159, 182, 59, 247, 146,
-			15, 218, 36, 195, 105, 62, 18, 31, 179, 179, 67, 206, 187, 109,
-			37, 36, 133, 171, 94, 226, 50, 81, 195, 39, 192, 196, 145, 238,
-			164, 188, 167, 49, 212, 66, 100, 126, 44, 133, 147, 62, 186, 24,
-			151, 78, 240, 140, 196, 7, 45, 21, 36, 101, 4, 254, 52, 31,
-			214, 18, 87, 217, 45, 12, 195, 119, 168, 60, 174, 138, 236, 12,
-			200, 218, 37, 98, 128, 107, 0, 112, 165, 82, 14, 3, 237, 220,
-			239, 18, 179, 207, 49, 206, 197, 150, 187, 61, 166, 12, 86, 33,
-			115, 35, 13, 223, 194, 15, 49, 6, 147, 136, 46, 86, 25, 232,
-			33, 218, 64, 199, 28, 236, 236, 153, 188, 65, 108, 151, 119, 180,
-			31, 179, 229, 29, 109, 187, 188, 163, 253, 152, 221, 189, 39, 126,
-			96, 243, 7, 131, 123, 201, 155, 45, 57, 182, 136, 226, 95, 178,
-			179, 39, 157, 175, 163, 157, 247, 195, 201, 33, 141, 29, 181, 199,
-			196, 25, 78, 232, 17, 83, 103, 198, 55, 130, 70, 93, 74, 216,
-			144, 40, 102, 9, 47, 246, 146, 33, 166, 33, 97, 158, 8, 106,
-			246, 192, 209, 184, 26, 232, 163, 162, 80, 43, 225, 170, 42, 210,
-			137, 24, 189, 106, 84, 223, 110, 53, 55, 244, 158, 144, 172, 60,
-			153, 188, 160, 86, 247, 138, 94, 73, 141, 101, 220, 140, 225, 80,
-			198, 35, 74, 167, 115, 205, 28, 132, 129, 23, 189, 241, 131, 52,
-			127, 208, 55, 24, 63, 0, 110, 237, 189, 39, 126, 96, 243, 7,
-			163, 39, 200, 127, 23, 137, 175, 109, 138, 63, 109, 227, 81, 149,
-			20, 51, 190, 145, 77, 178, 50, 20, 202, 241, 77, 79, 195, 14,
-			113, 69, 70, 118, 52, 222, 174, 95, 212, 101, 236, 183, 93, 153,
-			104, 7, 35, 220, 167, 109, 124, 64, 145, 136, 147, 7, 143, 41,
-			18, 152, 34, 221, 140, 9, 205, 252, 134, 157, 250, 223, 165, 196,
-			39, 136, 226, 223, 176, 179, 71, 201, 247, 113, 246, 17, 46, 104,
-			62, 111, 227, 65, 231, 77, 119, 225, 167, 99, 250, 163, 190, 196,
-			14, 59, 19, 77, 91, 28, 129, 149, 245, 121, 37, 236, 9, 172,
-			171, 207, 219, 29, 125, 138, 180, 57, 217, 63, 64, 222, 100, 67,
-			55, 16, 197, 191, 199, 245, 163, 191, 179, 98, 183, 254, 221, 59,
-			36, 25, 27, 251, 161, 199, 81, 50, 18, 181, 99, 213, 99, 37,
-			63, 172, 149, 221, 109, 64, 100, 250, 86, 213, 75, 145, 176, 54,
-			208, 19, 194, 52, 232, 194, 253, 31, 8, 199, 42, 127, 69, 155,
-			166, 248, 75, 53, 175, 190, 22, 212, 43, 158, 176, 149, 36, 60,
-			67, 132, 105, 165, 236, 175, 251, 50, 110, 223, 136, 152, 200, 179,
-			107, 177, 160, 216, 18, 241, 30, 91, 62, 63, 170, 71, 1, 171,
-			248, 235, 252, 196, 42, 132, 98, 20, 48, 55, 182, 205, 201, 67,
-			114, 177, 236, 54, 66, 13, 134, 227, 87, 87, 68, 179, 71, 158,
-			21, 255, 143, 251, 165, 103, 71, 115, 68, 94, 244, 233, 159, 197,
-			254, 189, 214, 168, 22, 101, 60, 176, 24, 25, 190, 130, 127, 47,
-			30, 55, 4, 35, 211, 49, 164, 72, 155, 147, 251, 15, 192, 204,
-			236, 160, 153, 63, 176, 83, 95, 146, 51, 179, 3, 81, 252, 7,
-			118, 246, 24, 121, 21, 193, 184, 131, 79, 204, 47, 218, 248, 148,
-			115, 149, 73, 200, 250, 219, 108, 64, 11, 126, 197, 151, 146, 29,
-			134, 55, 128, 219, 30, 112, 100, 154, 154, 156, 156, 212, 166, 37,
-			217, 200, 14, 208, 159, 190, 168, 244, 167, 14, 80, 99, 190, 104,
-			119, 29, 85, 36, 226, 228, 177, 49, 69, 218, 156, 156, 152, 36,
-			239, 71, 196, 194, 157, 52, 243, 39, 118, 234, 87, 48, 114, 222,
-			137, 88, 107, 64, 92, 3, 122, 134, 185, 173, 92, 15, 140, 248,
-			55, 177, 184, 139, 110, 153, 40, 60, 10, 174, 172, 11, 117, 164,
-			166, 96, 180, 194, 178, 191, 190, 1, 161, 78, 65, 5, 240, 194,
-			120, 95, 235, 110, 53, 92, 19, 5, 27, 253, 227, 156, 236, 68,
-			20, 255, 137, 157, 61, 68, 126, 142, 175, 241, 78, 174, 110, 124,
-			217, 198, 95, 177, 211, 206, 143, 33, 86, 80, 109, 211, 193, 198,
-			110, 213, 12, 166, 203, 139, 220, 56, 113, 16, 158, 240, 15, 128,
-			123, 135, 198, 42, 56, 22, 6, 107, 196, 136, 21, 129, 89, 227,
-			178, 153, 133, 81, 152, 17, 171, 97, 80, 214, 225, 207, 171, 101,
-			211, 189, 203, 184, 229, 150, 119, 113, 36, 17, 118, 34, 173, 76,
-			114, 148, 58, 65, 45, 250, 50, 87, 139, 46, 147, 12, 39, 249,
-			156, 248, 75, 59, 123, 206, 153, 146, 158, 52, 197, 13, 191, 234,
-			197, 190, 100, 50, 146, 69, 85, 103, 118, 74, 108, 57, 157, 50,
-			92, 224, 47, 237, 236, 137, 248, 1, 226, 15, 78, 158, 138, 31,
-			216, 252, 193, 153, 179, 228, 97, 224, 30, 162, 153, 255, 106, 227,
-			55, 227, 180, 115, 111, 43, 230, 25, 103, 253, 122, 163, 42, 217,
-			1, 214, 244, 153, 39, 88, 161, 81, 29, 213, 221, 225, 75, 225,
-			191, 218, 157, 67, 228, 11, 54, 244, 7, 252, 177, 190, 97, 103,
-			239, 119, 254, 87, 91, 6, 15, 197, 217, 96, 147, 165, 74, 163,
-			154, 244, 34, 23, 43, 57, 190, 210, 204, 19, 51, 65, 82, 28,
-			226, 4, 193, 180, 185, 153, 199, 79, 158, 202, 77, 240, 255, 166,
-			114, 92, 13, 242, 42, 53, 200, 34, 44, 178, 204, 113, 89, 117,
-			173, 42, 235, 171, 172, 202, 250, 174, 78, 191, 66, 76, 79, 136,
-			142, 211, 110, 163, 177, 233, 144, 205, 207, 154, 152, 28, 62, 31,
-			74, 62, 124, 65, 35, 84, 41, 163, 71, 5, 24, 11, 220, 100,
-			236, 82, 132, 208, 181, 249, 27, 128, 233, 5, 254, 67, 201, 142,
-			207, 207, 154, 193, 72, 96, 157, 79, 220, 229, 106, 104, 60, 62,
-			207, 146, 159, 130, 50, 15, 157, 16, 229, 38, 192, 186, 165, 136,
-			110, 14, 29, 216, 114, 85, 152, 96, 185, 44, 61, 65, 194, 88,
-			209, 76, 86, 48, 70, 116, 58, 84, 184, 178, 210, 115, 76, 120,
-			204, 125, 195, 206, 14, 199, 15, 16, 127, 48, 114, 62, 126, 96,
-			243, 7, 151, 238, 35, 95, 180, 228, 92, 64, 20, 191, 30, 103,
-			247, 57, 159, 19, 105, 210, 19, 233, 7, 155, 166, 152, 102, 72,
-			194, 11, 56, 241, 197, 204, 2, 31, 98, 33, 103, 248, 4, 152,
-			224, 195, 47, 252, 121, 202, 126, 241, 38, 223, 96, 70, 188, 77,
-			15, 210, 90, 193, 101, 2, 184, 27, 39, 217, 23, 109, 196, 217,
-			171, 102, 22, 66, 81, 159, 116, 20, 13, 139, 65, 77, 45, 108,
-			157, 95, 10, 54, 165, 98, 80, 1, 151, 22, 175, 194, 207, 231,
-			110, 169, 4, 119, 203, 62, 47, 222, 223, 132, 164, 160, 110, 141,
-			11, 54, 183, 12, 33, 52, 171, 34, 78, 99, 76, 228, 26, 21,
-			187, 162, 200, 75, 157, 139, 61, 189, 114, 108, 4, 204, 159, 250,
-			1, 209, 62, 254, 110, 17, 180, 246, 112, 20, 54, 204, 92, 35,
-			244, 234, 185, 56, 67, 150, 49, 38, 124, 95, 122, 61, 150, 190,
-			126, 157, 210, 171, 240, 245, 184, 167, 63, 126, 96, 243, 7, 123,
-			135, 200, 147, 114, 72, 44, 138, 223, 128, 179, 167, 157, 135, 133,
-			184, 9, 226, 219, 129, 4, 171, 228, 85, 206, 108, 225, 21, 43,
-			133, 27, 139, 99, 236, 202, 141, 133, 5, 241, 215, 227, 55, 230,
-			103, 30, 91, 145, 63, 24, 109, 225, 135, 197, 55, 224, 236, 104,
-			252, 0, 241, 7, 39, 242, 241, 3, 155, 63, 56, 53, 165, 219,
-			98, 83, 252, 38, 156, 189, 87, 182, 37, 118, 74, 218, 173, 53,
-			97, 163, 88, 244, 188, 18, 231, 172, 10, 221, 173, 179, 162, 91,
-			45, 122, 101, 145, 170, 77, 213, 196, 53, 201, 55, 225, 236, 201,
-			248, 1, 226, 15, 198, 166, 226, 7, 80, 247, 217, 115, 144, 214,
-			179, 147, 11, 173, 239, 193, 120, 175, 179, 191, 101, 22, 235, 40,
-			14, 16, 233, 4, 29, 238, 123, 48, 214, 36, 226, 164, 188, 63,
-			236, 132, 53, 240, 61, 120, 96, 144, 108, 65, 185, 136, 226, 183,
-			99, 60, 234, 248, 108, 54, 105, 10, 8, 170, 6, 244, 165, 76,
-			73, 92, 111, 84, 171, 250, 156, 225, 133, 209, 24, 97, 114, 31,
-			210, 111, 174, 54, 138, 55, 189, 136, 11, 33, 191, 92, 146, 23,
-			137, 210, 203, 45, 108, 248, 145, 167, 91, 137, 50, 80, 243, 1,
-			69, 66, 67, 164, 46, 221, 9, 179, 226, 237, 120, 120, 132, 124,
-			30, 54, 83, 62, 41, 222, 131, 241, 41, 231, 151, 16, 211, 120,
-			215, 241, 134, 175, 115, 173, 71, 70, 232, 121, 107, 195, 152, 190,
-			234, 104, 233, 203, 6, 199, 61, 121, 69, 165, 69, 151, 56, 82,
-			65, 112, 186, 112, 41, 220, 41, 28, 68, 197, 240, 158, 146, 19,
-			144, 73, 19, 184, 96, 252, 170, 123, 207, 231, 226, 123, 176, 244,
-			248, 239, 132, 153, 248, 30, 44, 61, 254, 59, 97, 30, 190, 7,
-			79, 76, 146, 101, 232, 188, 77, 241, 123, 49, 62, 234, 92, 97,
-			179, 226, 192, 194, 23, 246, 206, 70, 180, 176, 180, 132, 58, 74,
-			194, 20, 232, 162, 18, 62, 5, 223, 139, 113, 175, 34, 17, 39,
-			251, 14, 41, 18, 42, 61, 146, 35, 63, 40, 6, 0, 83, 252,
-			62, 140, 15, 59, 111, 67, 166, 255, 186, 236, 32, 72, 56, 233,
-			17, 42, 194, 230, 154, 216, 35, 150, 133, 240, 52, 148, 27, 64,
-			252, 37, 23, 253, 187, 73, 123, 83, 208, 25, 167, 4, 127, 189,
-			26, 196, 81, 170, 157, 224, 227, 245, 62, 44, 61, 227, 59, 193,
-			182, 249, 62, 220, 238, 40, 210, 230, 228, 193, 67, 100, 14, 186,
-			146, 166, 248, 253, 24, 159, 112, 238, 21, 185, 17, 77, 68, 3,
-			87, 198, 227, 73, 149, 54, 225, 156, 185, 233, 213, 75, 126, 49,
-			94, 102, 105, 12, 229, 116, 42, 50, 195, 201, 174, 33, 69, 34,
-			78, 238, 59, 174, 72, 155, 147, 35, 163, 228, 143, 44, 104, 67,
-			134, 226, 159, 192, 120, 202, 249, 45, 171, 133, 100, 17, 172, 49,
-			181, 15, 112, 209, 107, 82, 63, 216, 76, 211, 61, 147, 60, 87,
-			248, 85, 229, 151, 18, 143, 61, 172, 82, 113, 146, 48, 70, 76,
-			201, 37, 46, 164, 54, 92, 56, 253, 8, 151, 88, 165, 51, 143,
-			138, 74, 20, 112, 170, 10, 194, 145, 21, 65, 73, 242, 222, 55,
-			174, 148, 36, 57, 42, 170, 150, 235, 64, 73, 71, 80, 81, 66,
-			190, 101, 1, 236, 11, 204, 128, 216, 147, 183, 121, 139, 21, 31,
-			199, 17, 115, 176, 218, 131, 93, 174, 86, 245, 240, 100, 4, 139,
-			153, 34, 17, 39, 143, 140, 43, 210, 230, 228, 228, 41, 242, 113,
-			49, 30, 109, 20, 255, 52, 198, 247, 56, 31, 22, 227, 97, 232,
-			75, 137, 116, 248, 201, 33, 72, 68, 247, 232, 224, 32, 169, 165,
-			7, 181, 113, 97, 54, 55, 202, 130, 33, 53, 63, 26, 3, 72,
-			227, 248, 141, 156, 200, 237, 45, 48, 110, 197, 200, 239, 116, 188,
-			229, 223, 199, 8, 168, 50, 116, 84, 185, 16, 198, 47, 18, 22,
-			110, 135, 145, 87, 81, 215, 117, 42, 2, 126, 172, 201, 81, 87,
-			180, 37, 52, 218, 25, 238, 96, 182, 148, 100, 66, 184, 203, 129,
-			215, 156, 110, 75, 3, 243, 52, 137, 56, 217, 113, 68, 145, 54,
-			39, 143, 29, 39, 127, 37, 56, 157, 165, 248, 227, 24, 143, 57,
-			127, 104, 177, 121, 105, 7, 106, 161, 165, 202, 132, 33, 162, 119,
-			126, 18, 216, 228, 193, 152, 211, 194, 221, 183, 222, 240, 32, 74,
-			184, 92, 102, 35, 213, 160, 58, 30, 222, 244, 107, 53, 175, 52,
-			218, 74, 105, 109, 42, 139, 176, 17, 5, 130, 188, 179, 21, 163,
-			205, 14, 202, 38, 146, 148, 145, 103, 33, 6, 219, 91, 115, 139,
-			17, 56, 164, 40, 4, 34, 48, 140, 93, 98, 197, 13, 175, 120,
-			51, 177, 48, 136, 240, 40, 240, 148, 40, 55, 49, 152, 253, 176,
-			53, 79, 32, 237, 187, 226, 204, 150, 27, 18, 86, 241, 1, 109,
-			73, 48, 42, 121, 242, 18, 220, 207, 166, 129, 221, 74, 48, 102,
-			17, 39, 219, 135, 21, 105, 115, 242, 196, 73, 242, 106, 24, 154,
-			118, 138, 63, 129, 241, 69, 231, 59, 100, 56, 185, 144, 195, 96,
-			131, 109, 14, 66, 0, 231, 251, 72, 52, 209, 208, 140, 111, 40,
-			248, 162, 68, 28, 1, 120, 244, 128, 27, 105, 188, 60, 219, 49,
-			84, 166, 164, 103, 123, 134, 147, 93, 35, 138, 68, 156, 28, 61,
-			163, 72, 155, 147, 247, 94, 16, 103, 67, 139, 80, 252, 203, 24,
-			15, 56, 23, 154, 130, 12, 90, 120, 152, 43, 140, 229, 208, 51,
-			15, 250, 170, 17, 36, 13, 37, 181, 43, 18, 113, 146, 244, 40,
-			210, 230, 36, 237, 7, 171, 73, 23, 205, 124, 10, 167, 126, 29,
-			11, 171, 73, 23, 162, 248, 83, 56, 123, 138, 252, 44, 223, 29,
-			187, 184, 118, 246, 107, 24, 15, 58, 31, 188, 11, 123, 158, 17,
-			178, 144, 140, 132, 248, 39, 176, 239, 121, 45, 113, 248, 101, 255,
-			187, 64, 83, 252, 53, 181, 114, 187, 64, 83, 252, 53, 44, 173,
-			125, 93, 160, 41, 254, 26, 238, 31, 128, 254, 119, 211, 204, 103,
-			113, 234, 63, 202, 254, 119, 35, 138, 63, 139, 179, 83, 228, 119,
-			120, 255, 187, 193, 158, 137, 241, 125, 206, 167, 16, 155, 214, 62,
-			26, 119, 11, 70, 100, 48, 68, 41, 17, 49, 154, 118, 158, 93,
-			171, 11, 47, 168, 213, 109, 97, 93, 42, 106, 173, 215, 180, 151,
-			140, 152, 230, 167, 228, 66, 3, 27, 187, 56, 169, 148, 99, 147,
-			149, 27, 131, 80, 36, 91, 42, 185, 211, 13, 230, 170, 207, 171,
-			41, 218, 13, 199, 203, 207, 227, 174, 81, 69, 34, 78, 158, 184,
-			87, 145, 54, 39, 47, 94, 34, 191, 135, 136, 133, 123, 104, 230,
-			119, 113, 234, 79, 49, 114, 62, 131, 216, 237, 114, 34, 36, 141,
-			86, 9, 125, 35, 9, 83, 174, 248, 21, 178, 85, 207, 171, 26,
-			60, 203, 27, 8, 237, 201, 239, 181, 249, 40, 244, 4, 236, 87,
-			83, 5, 226, 50, 185, 202, 103, 18, 159, 96, 241, 245, 92, 28,
-			172, 179, 22, 55, 65, 90, 185, 122, 16, 197, 191, 139, 179, 199,
-			200, 101, 130, 113, 15, 31, 248, 47, 240, 99, 201, 25, 54, 189,
-			59, 254, 4, 212, 10, 221, 49, 29, 196, 37, 159, 123, 96, 22,
-			126, 65, 205, 194, 30, 96, 236, 23, 212, 121, 165, 7, 24, 251,
-			5, 117, 94, 233, 129, 64, 229, 255, 1, 231, 149, 30, 17, 93,
-			172, 206, 43, 61, 34, 186, 88, 157, 87, 122, 68, 116, 49, 63,
-			175, 124, 63, 130, 102, 90, 20, 127, 9, 227, 147, 206, 155, 81,
-			147, 140, 210, 106, 242, 200, 206, 136, 158, 209, 102, 99, 43, 105,
-			181, 92, 154, 198, 95, 3, 235, 38, 183, 53, 185, 220, 204, 248,
-			59, 209, 82, 174, 254, 126, 73, 201, 188, 30, 56, 121, 124, 9,
-			147, 123, 20, 105, 115, 114, 244, 4, 121, 5, 116, 195, 166, 248,
-			79, 48, 62, 237, 60, 214, 234, 68, 164, 204, 250, 173, 23, 158,
-			188, 36, 212, 253, 213, 233, 177, 101, 77, 252, 248, 241, 39, 234,
-			4, 212, 3, 199, 143, 63, 193, 135, 242, 138, 132, 154, 79, 77,
-			129, 236, 233, 165, 153, 47, 227, 212, 255, 35, 101, 79, 47, 162,
-			248, 203, 56, 155, 3, 135, 218, 94, 176, 78, 226, 219, 57, 212,
-			246, 194, 4, 251, 75, 53, 193, 122, 133, 233, 81, 77, 176, 94,
-			97, 120, 228, 19, 236, 175, 45, 40, 16, 81, 252, 53, 140, 15,
-			57, 127, 110, 189, 36, 14, 181, 187, 57, 209, 170, 219, 171, 22,
-			55, 240, 183, 75, 65, 147, 79, 130, 103, 194, 213, 213, 102, 108,
-			236, 21, 0, 82, 90, 141, 128, 131, 83, 254, 159, 129, 147, 96,
-			47, 88, 138, 190, 22, 15, 18, 130, 97, 144, 78, 130, 189, 176,
-			190, 190, 134, 15, 28, 36, 159, 65, 48, 72, 22, 197, 95, 199,
-			120, 143, 243, 241, 230, 245, 165, 64, 18, 21, 68, 155, 216, 84,
-			27, 245, 106, 158, 177, 171, 238, 45, 191, 210, 168, 72, 231, 24,
-			87, 196, 168, 41, 63, 25, 176, 175, 171, 175, 252, 144, 221, 244,
-			106, 209, 206, 136, 125, 102, 112, 82, 248, 28, 135, 218, 224, 32,
-			0, 20, 195, 162, 11, 145, 45, 101, 191, 234, 185, 117, 5, 123,
-			27, 237, 104, 164, 238, 56, 95, 144, 95, 87, 11, 178, 23, 22,
-			228, 215, 49, 233, 86, 164, 205, 201, 62, 42, 252, 184, 122, 57,
-			249, 77, 252, 82, 248, 113, 137, 174, 254, 51, 241, 227, 234, 5,
-			63, 174, 111, 170, 205, 184, 23, 252, 184, 190, 137, 165, 31, 87,
-			47, 72, 143, 111, 98, 233, 199, 213, 11, 210, 227, 155, 88, 250,
-			7, 245, 209, 204, 235, 210, 169, 55, 166, 133, 244, 232, 67, 20,
-			191, 46, 157, 61, 74, 126, 129, 243, 179, 143, 139, 143, 55, 164,
-			241, 136, 66, 35, 50, 225, 252, 20, 228, 94, 158, 73, 11, 172,
-			10, 29, 212, 166, 239, 166, 105, 199, 53, 242, 4, 96, 91, 100,
-			162, 248, 8, 107, 67, 53, 2, 239, 40, 85, 148, 137, 190, 22,
-			10, 215, 203, 146, 203, 15, 209, 224, 40, 48, 198, 248, 20, 242,
-			61, 17, 28, 46, 20, 25, 201, 145, 62, 80, 79, 222, 144, 150,
-			28, 233, 3, 245, 228, 13, 233, 174, 67, 138, 68, 156, 60, 124,
-			84, 145, 54, 39, 239, 25, 38, 115, 196, 194, 148, 102, 222, 156,
-			78, 189, 35, 141, 204, 187, 149, 48, 142, 102, 150, 8, 120, 106,
-			166, 136, 248, 58, 181, 97, 138, 40, 117, 206, 74, 138, 40, 126,
-			115, 58, 187, 143, 124, 23, 193, 152, 130, 133, 50, 141, 143, 58,
-			207, 177, 105, 225, 174, 200, 15, 206, 49, 59, 228, 216, 130, 70,
-			44, 205, 43, 134, 15, 134, 27, 105, 231, 11, 185, 157, 65, 61,
-			183, 241, 30, 144, 175, 3, 44, 21, 239, 35, 5, 14, 124, 79,
-			90, 250, 140, 81, 97, 247, 76, 239, 61, 164, 72, 155, 147, 71,
-			114, 164, 12, 173, 69, 20, 191, 61, 141, 251, 157, 103, 132, 247,
-			174, 107, 44, 234, 208, 75, 4, 118, 131, 188, 142, 51, 63, 176,
-			27, 203, 51, 226, 125, 191, 202, 230, 151, 174, 177, 243, 231, 38,
-			79, 73, 185, 43, 47, 115, 167, 38, 167, 166, 198, 79, 157, 26,
-			159, 186, 160, 154, 198, 133, 219, 219, 211, 88, 147, 80, 187, 12,
-			31, 163, 194, 216, 153, 166, 123, 86, 51, 144, 134, 234, 52, 249,
-			145, 33, 114, 230, 238, 242, 85, 169, 245, 41, 210, 1, 210, 222,
-			230, 181, 151, 59, 77, 246, 45, 248, 97, 36, 183, 68, 153, 177,
-			238, 78, 57, 27, 159, 36, 78, 171, 143, 100, 162, 190, 11, 205,
-			153, 245, 90, 164, 249, 77, 124, 26, 167, 0, 252, 2, 34, 93,
-			137, 159, 90, 102, 177, 222, 79, 218, 181, 180, 145, 57, 247, 178,
-			226, 193, 124, 137, 230, 201, 30, 240, 11, 89, 81, 23, 144, 43,
-			240, 189, 200, 245, 220, 7, 63, 21, 228, 47, 139, 188, 48, 70,
-			58, 140, 45, 22, 178, 40, 182, 23, 204, 71, 244, 32, 33, 126,
-			184, 34, 93, 197, 101, 182, 214, 118, 63, 156, 21, 15, 232, 81,
-			210, 197, 87, 230, 74, 173, 238, 243, 237, 110, 27, 50, 61, 167,
-			11, 157, 252, 225, 117, 249, 108, 234, 22, 105, 147, 108, 162, 21,
-			210, 205, 153, 119, 37, 168, 203, 158, 210, 147, 59, 249, 179, 235,
-			152, 56, 45, 50, 161, 237, 62, 22, 47, 54, 181, 217, 199, 246,
-			144, 12, 197, 221, 169, 197, 151, 19, 155, 189, 156, 216, 236, 219,
-			145, 216, 236, 50, 228, 42, 195, 3, 169, 125, 200, 57, 23, 103,
-			42, 219, 9, 188, 170, 125, 119, 170, 59, 176, 146, 84, 146, 153,
-			129, 108, 15, 241, 84, 142, 153, 33, 252, 10, 231, 41, 150, 92,
-			112, 76, 216, 157, 140, 162, 92, 51, 64, 89, 31, 15, 18, 49,
-			222, 34, 100, 192, 116, 218, 79, 164, 181, 24, 202, 14, 26, 105,
-			45, 134, 246, 78, 26, 105, 45, 134, 46, 221, 32, 15, 1, 10,
-			45, 62, 144, 58, 134, 224, 48, 172, 146, 122, 68, 129, 176, 135,
-			236, 214, 152, 248, 48, 76, 4, 114, 173, 125, 32, 123, 4, 236,
-			113, 208, 189, 195, 120, 208, 121, 90, 29, 190, 248, 9, 171, 21,
-			206, 55, 196, 68, 202, 8, 56, 126, 42, 145, 71, 9, 29, 13,
-			121, 27, 253, 78, 244, 81, 224, 124, 218, 135, 177, 166, 16, 181,
-			15, 119, 244, 41, 202, 166, 246, 225, 254, 1, 242, 52, 192, 103,
-			226, 145, 212, 56, 114, 30, 7, 158, 135, 9, 45, 48, 142, 119,
-			141, 15, 251, 223, 2, 179, 137, 192, 227, 180, 71, 178, 57, 114,
-			65, 66, 254, 217, 39, 240, 176, 132, 63, 107, 89, 75, 180, 243,
-			204, 215, 169, 80, 242, 236, 19, 184, 83, 81, 25, 106, 159, 232,
-			114, 20, 133, 168, 125, 98, 127, 78, 81, 54, 181, 79, 28, 191,
-			135, 204, 3, 186, 28, 158, 76, 45, 34, 231, 126, 174, 195, 8,
-			5, 3, 196, 143, 57, 84, 227, 122, 221, 52, 165, 229, 137, 34,
-			46, 91, 74, 178, 39, 22, 162, 246, 100, 118, 128, 60, 47, 145,
-			220, 236, 51, 184, 223, 169, 222, 201, 59, 238, 197, 42, 227, 154,
-			147, 83, 34, 214, 132, 31, 230, 36, 47, 0, 10, 206, 62, 131,
-			53, 133, 168, 125, 166, 163, 71, 81, 54, 181, 207, 208, 61, 124,
-			65, 1, 2, 147, 125, 30, 239, 115, 158, 50, 46, 153, 77, 67,
-			142, 209, 70, 145, 66, 1, 78, 187, 110, 20, 121, 245, 234, 69,
-			246, 204, 171, 220, 241, 215, 60, 61, 194, 255, 157, 28, 191, 48,
-			254, 244, 243, 147, 99, 231, 78, 189, 32, 201, 167, 71, 31, 60,
-			166, 27, 132, 210, 188, 30, 77, 241, 90, 59, 250, 21, 101, 83,
-			251, 60, 184, 3, 8, 148, 54, 251, 62, 124, 212, 121, 84, 65,
-			88, 177, 214, 16, 86, 178, 97, 112, 9, 79, 88, 238, 70, 232,
-			213, 33, 48, 232, 138, 184, 132, 215, 57, 174, 115, 186, 9, 86,
-			154, 151, 172, 41, 68, 237, 251, 58, 14, 41, 202, 166, 246, 125,
-			71, 114, 164, 78, 4, 12, 149, 253, 16, 222, 239, 120, 59, 155,
-			208, 226, 232, 174, 26, 178, 200, 7, 177, 92, 222, 150, 222, 42,
-			110, 173, 230, 185, 117, 185, 250, 55, 188, 114, 13, 0, 58, 106,
-			140, 31, 17, 19, 156, 149, 45, 176, 211, 188, 82, 77, 33, 106,
-			63, 212, 49, 168, 40, 222, 160, 125, 14, 200, 8, 203, 194, 212,
-			158, 197, 67, 206, 211, 137, 91, 89, 57, 117, 99, 207, 164, 112,
-			35, 216, 170, 74, 184, 20, 87, 96, 75, 146, 196, 105, 176, 44,
-			19, 249, 1, 122, 186, 134, 217, 103, 53, 119, 29, 204, 229, 162,
-			102, 156, 230, 149, 101, 21, 133, 168, 61, 219, 190, 71, 81, 54,
-			181, 103, 7, 247, 146, 159, 181, 136, 64, 246, 178, 31, 195, 7,
-			156, 31, 183, 216, 146, 161, 38, 37, 178, 135, 137, 88, 188, 213,
-			109, 41, 196, 76, 149, 91, 158, 132, 226, 246, 202, 147, 128, 185,
-			36, 35, 35, 89, 7, 68, 132, 41, 253, 76, 7, 173, 251, 85,
-			79, 24, 44, 70, 100, 41, 163, 252, 29, 72, 124, 18, 10, 36,
-			25, 56, 98, 153, 177, 98, 209, 206, 74, 8, 11, 189, 98, 80,
-			45, 181, 174, 76, 98, 236, 4, 12, 128, 145, 32, 95, 98, 236,
-			167, 169, 147, 216, 129, 204, 147, 200, 54, 210, 74, 154, 40, 69,
-			115, 56, 13, 124, 107, 87, 20, 162, 246, 99, 100, 175, 162, 108,
-			106, 63, 230, 236, 215, 135, 132, 255, 176, 151, 156, 187, 203, 67,
-			66, 173, 238, 149, 252, 162, 27, 121, 187, 29, 19, 156, 211, 119,
-			89, 146, 204, 58, 9, 116, 238, 167, 16, 233, 149, 118, 236, 235,
-			170, 6, 122, 154, 100, 188, 231, 26, 110, 57, 148, 41, 240, 119,
-			79, 152, 254, 72, 170, 32, 95, 165, 247, 146, 172, 242, 179, 187,
-			99, 158, 245, 71, 82, 5, 253, 50, 61, 66, 58, 54, 220, 112,
-			99, 69, 86, 9, 167, 128, 71, 82, 5, 194, 31, 206, 193, 179,
-			203, 29, 164, 93, 247, 63, 247, 135, 22, 233, 7, 243, 187, 184,
-			204, 143, 155, 189, 159, 180, 135, 141, 213, 21, 8, 220, 149, 135,
-			145, 108, 216, 88, 45, 112, 154, 94, 35, 125, 42, 22, 87, 23,
-			37, 219, 217, 34, 23, 127, 51, 75, 10, 189, 155, 205, 76, 90,
-			32, 189, 250, 114, 77, 101, 88, 23, 185, 253, 91, 228, 227, 94,
-			82, 111, 138, 184, 251, 66, 79, 152, 124, 64, 175, 146, 254, 164,
-			159, 221, 10, 248, 255, 203, 140, 241, 251, 119, 150, 184, 236, 87,
-			188, 2, 127, 165, 64, 245, 135, 250, 25, 189, 72, 246, 73, 155,
-			209, 202, 170, 31, 10, 125, 98, 69, 94, 171, 201, 227, 209, 94,
-			249, 194, 101, 245, 187, 196, 201, 125, 113, 167, 145, 111, 244, 137,
-			211, 200, 244, 203, 167, 145, 151, 79, 35, 47, 241, 105, 68, 102,
-			84, 166, 169, 251, 229, 193, 164, 63, 62, 152, 240, 63, 207, 8,
-			189, 125, 111, 42, 135, 156, 17, 211, 26, 22, 59, 217, 51, 117,
-			87, 55, 254, 0, 91, 13, 130, 178, 161, 171, 239, 205, 14, 145,
-			78, 174, 171, 103, 83, 20, 15, 89, 71, 108, 161, 49, 103, 197,
-			137, 161, 15, 66, 240, 64, 143, 119, 240, 94, 103, 138, 77, 235,
-			187, 55, 133, 111, 0, 114, 75, 26, 188, 98, 36, 51, 48, 188,
-			151, 183, 99, 229, 60, 195, 75, 232, 48, 148, 115, 167, 147, 26,
-			202, 185, 51, 48, 72, 30, 149, 8, 252, 246, 65, 188, 15, 244,
-			87, 89, 213, 112, 200, 110, 122, 219, 227, 194, 214, 86, 115, 253,
-			122, 40, 42, 87, 153, 127, 33, 229, 179, 118, 128, 11, 170, 158,
-			174, 21, 101, 120, 97, 170, 86, 174, 165, 29, 236, 236, 87, 148,
-			77, 237, 131, 123, 135, 200, 3, 80, 171, 69, 109, 134, 247, 59,
-			167, 18, 181, 114, 17, 44, 170, 50, 58, 41, 154, 209, 220, 63,
-			174, 46, 48, 125, 248, 224, 157, 96, 82, 221, 17, 217, 204, 216,
-			62, 135, 156, 23, 135, 143, 227, 169, 105, 228, 140, 237, 50, 80,
-			134, 100, 111, 26, 44, 222, 250, 227, 217, 3, 228, 143, 144, 58,
-			88, 156, 180, 246, 58, 191, 141, 154, 226, 86, 180, 138, 95, 12,
-			106, 224, 47, 239, 150, 1, 191, 86, 152, 246, 35, 35, 245, 203,
-			90, 61, 168, 52, 249, 41, 137, 183, 185, 128, 80, 233, 11, 115,
-			247, 201, 2, 31, 184, 152, 99, 181, 186, 183, 230, 223, 226, 50,
-			235, 201, 13, 175, 26, 71, 166, 140, 197, 185, 204, 224, 54, 73,
-			213, 160, 76, 197, 107, 65, 163, 170, 173, 201, 26, 51, 77, 34,
-			76, 52, 219, 145, 227, 243, 79, 154, 119, 49, 107, 156, 120, 78,
-			182, 83, 227, 196, 115, 114, 96, 144, 44, 72, 72, 112, 59, 111,
-			141, 58, 15, 54, 177, 194, 72, 115, 182, 211, 75, 65, 156, 109,
-			53, 47, 224, 70, 171, 83, 97, 121, 219, 121, 75, 213, 196, 217,
-			158, 223, 115, 76, 81, 54, 181, 243, 195, 35, 228, 191, 137, 65,
-			176, 168, 125, 214, 58, 239, 252, 177, 57, 8, 90, 139, 133, 58,
-			197, 80, 134, 108, 221, 83, 151, 157, 171, 219, 172, 24, 148, 164,
-			24, 222, 233, 161, 162, 115, 122, 62, 12, 110, 246, 234, 233, 168,
-			161, 10, 203, 157, 203, 176, 207, 203, 116, 39, 58, 124, 72, 249,
-			209, 133, 230, 24, 185, 229, 114, 220, 30, 183, 110, 88, 238, 71,
-			234, 222, 186, 91, 47, 149, 5, 152, 23, 228, 135, 147, 109, 18,
-			94, 125, 163, 154, 55, 86, 134, 247, 88, 157, 59, 57, 227, 207,
-			30, 61, 173, 40, 155, 218, 103, 207, 221, 75, 190, 32, 120, 99,
-			83, 251, 146, 117, 220, 249, 117, 147, 55, 75, 18, 165, 194, 85,
-			49, 232, 107, 49, 6, 117, 152, 156, 165, 137, 137, 36, 166, 170,
-			128, 150, 143, 83, 23, 149, 133, 212, 116, 21, 118, 142, 198, 155,
-			92, 94, 94, 96, 35, 242, 82, 108, 84, 76, 48, 190, 55, 38,
-			38, 153, 152, 194, 129, 232, 231, 152, 252, 1, 120, 20, 159, 206,
-			205, 54, 104, 22, 216, 25, 222, 177, 14, 69, 33, 106, 95, 234,
-			100, 138, 226, 157, 62, 122, 140, 252, 178, 96, 1, 166, 246, 67,
-			214, 113, 231, 103, 90, 78, 143, 248, 90, 43, 106, 206, 52, 2,
-			247, 14, 160, 38, 104, 237, 196, 0, 242, 111, 241, 43, 12, 39,
-			172, 65, 217, 15, 127, 77, 199, 24, 72, 72, 197, 166, 15, 234,
-			70, 86, 0, 239, 150, 156, 79, 230, 105, 69, 246, 136, 31, 152,
-			30, 178, 50, 138, 226, 199, 184, 54, 213, 91, 126, 96, 122, 232,
-			232, 49, 173, 206, 127, 149, 220, 181, 205, 95, 217, 140, 118, 179,
-			249, 191, 146, 180, 41, 211, 115, 43, 243, 250, 17, 210, 41, 163,
-			236, 132, 233, 220, 146, 38, 113, 241, 12, 140, 230, 67, 164, 77,
-			86, 34, 13, 235, 138, 124, 113, 10, 222, 91, 218, 133, 130, 119,
-			228, 101, 5, 239, 101, 5, 239, 219, 97, 110, 38, 66, 171, 235,
-			231, 51, 78, 233, 106, 253, 217, 30, 225, 204, 14, 10, 217, 62,
-			220, 239, 252, 171, 219, 120, 235, 41, 56, 127, 97, 156, 48, 32,
-			125, 192, 119, 172, 88, 228, 221, 54, 222, 187, 131, 145, 85, 216,
-			232, 202, 97, 176, 107, 254, 219, 169, 169, 132, 41, 118, 95, 194,
-			20, 187, 79, 154, 235, 132, 182, 183, 143, 238, 33, 31, 70, 177,
-			186, 119, 192, 249, 33, 209, 17, 185, 130, 245, 29, 171, 106, 113,
-			210, 45, 69, 186, 174, 64, 76, 132, 43, 182, 145, 38, 75, 187,
-			216, 7, 35, 237, 184, 33, 48, 5, 4, 124, 132, 10, 91, 50,
-			10, 28, 14, 217, 66, 163, 232, 235, 242, 231, 103, 181, 90, 34,
-			51, 82, 72, 157, 49, 205, 91, 75, 76, 125, 178, 99, 175, 169,
-			79, 58, 251, 201, 189, 177, 62, 185, 87, 102, 204, 82, 197, 250,
-			208, 17, 46, 108, 84, 204, 172, 146, 66, 185, 219, 42, 146, 212,
-			84, 36, 7, 6, 181, 192, 125, 219, 43, 201, 217, 111, 77, 224,
-			238, 122, 203, 234, 188, 40, 201, 157, 27, 32, 123, 140, 171, 61,
-			117, 3, 152, 187, 74, 250, 147, 143, 229, 189, 235, 89, 146, 85,
-			13, 145, 23, 175, 45, 140, 36, 242, 171, 130, 126, 53, 55, 78,
-			246, 62, 236, 169, 210, 102, 96, 159, 87, 247, 191, 45, 118, 135,
-			220, 119, 199, 87, 180, 226, 229, 150, 123, 200, 21, 210, 189, 218,
-			88, 95, 169, 184, 85, 119, 29, 210, 3, 194, 173, 104, 203, 171,
-			224, 203, 141, 245, 171, 250, 181, 66, 215, 170, 73, 62, 138, 179,
-			86, 175, 253, 40, 206, 218, 189, 248, 81, 156, 197, 189, 233, 71,
-			113, 54, 221, 155, 201, 189, 3, 145, 174, 196, 135, 116, 154, 100,
-			107, 65, 217, 47, 250, 158, 234, 253, 241, 59, 212, 117, 157, 191,
-			190, 93, 208, 159, 209, 251, 73, 182, 18, 84, 131, 186, 235, 151,
-			165, 245, 166, 133, 181, 229, 170, 124, 67, 51, 82, 125, 146, 251,
-			27, 76, 246, 180, 168, 128, 118, 19, 203, 47, 73, 238, 88, 126,
-			137, 14, 146, 140, 72, 184, 56, 148, 97, 246, 72, 123, 65, 82,
-			187, 221, 92, 91, 187, 221, 92, 63, 200, 199, 91, 222, 57, 11,
-			227, 208, 209, 150, 61, 254, 127, 217, 251, 19, 232, 56, 178, 243,
-			62, 20, 239, 91, 85, 221, 104, 92, 112, 1, 138, 123, 1, 36,
-			47, 155, 27, 64, 54, 26, 32, 184, 204, 12, 201, 225, 76, 19,
-			0, 201, 214, 128, 0, 166, 1, 112, 102, 60, 26, 1, 133, 238,
-			2, 80, 195, 238, 170, 158, 170, 106, 146, 24, 105, 36, 121, 145,
-			45, 219, 138, 237, 191, 29, 43, 222, 228, 69, 86, 100, 201, 177,
-			242, 207, 73, 148, 23, 39, 78, 226, 68, 246, 211, 179, 245, 20,
-			217, 150, 159, 188, 40, 182, 143, 44, 203, 246, 115, 172, 232, 28,
-			89, 114, 228, 229, 61, 189, 119, 238, 119, 151, 186, 213, 221, 0,
-			65, 74, 154, 35, 191, 112, 206, 25, 162, 239, 173, 187, 124, 119,
-			255, 238, 119, 191, 239, 247, 217, 158, 251, 170, 19, 8, 25, 107,
-			89, 102, 50, 175, 197, 15, 245, 6, 244, 88, 135, 183, 229, 14,
-			13, 42, 180, 188, 218, 155, 115, 184, 199, 185, 215, 168, 217, 30,
-			108, 200, 32, 2, 234, 25, 59, 179, 181, 178, 38, 227, 140, 101,
-			181, 20, 235, 83, 8, 103, 184, 14, 64, 226, 189, 31, 181, 188,
-			247, 207, 227, 221, 118, 37, 114, 239, 48, 3, 143, 104, 45, 112,
-			194, 53, 191, 86, 221, 100, 0, 33, 231, 188, 72, 88, 222, 21,
-			103, 151, 145, 230, 243, 120, 111, 213, 233, 88, 174, 190, 213, 114,
-			247, 168, 5, 200, 104, 235, 89, 220, 163, 180, 153, 50, 91, 220,
-			253, 241, 226, 90, 84, 175, 241, 230, 245, 240, 184, 27, 81, 189,
-			102, 30, 198, 61, 54, 19, 178, 65, 10, 54, 31, 48, 139, 162,
-			9, 114, 207, 227, 157, 45, 179, 82, 101, 208, 80, 130, 65, 51,
-			143, 227, 29, 130, 187, 99, 55, 78, 94, 224, 118, 30, 59, 11,
-			145, 108, 201, 229, 126, 28, 225, 157, 45, 237, 50, 7, 112, 151,
-			239, 57, 139, 85, 123, 29, 138, 214, 111, 164, 202, 25, 223, 115,
-			38, 236, 245, 119, 34, 100, 18, 220, 13, 238, 35, 224, 187, 6,
-			223, 81, 57, 11, 81, 113, 138, 208, 185, 227, 120, 144, 66, 135,
-			20, 90, 57, 11, 81, 44, 197, 85, 140, 179, 139, 188, 138, 171,
-			219, 48, 94, 148, 5, 66, 72, 102, 62, 181, 136, 251, 218, 166,
-			183, 153, 195, 135, 174, 46, 92, 47, 78, 151, 190, 101, 178, 188,
-			56, 91, 46, 205, 148, 75, 243, 47, 44, 46, 76, 207, 205, 78,
-			142, 151, 174, 149, 38, 39, 122, 83, 102, 6, 107, 179, 163, 189,
-			8, 254, 158, 233, 213, 224, 239, 88, 175, 14, 127, 207, 246, 26,
-			240, 247, 92, 111, 122, 236, 63, 34, 156, 21, 219, 172, 249, 2,
-			238, 190, 238, 136, 61, 111, 168, 125, 252, 55, 216, 68, 173, 141,
-			181, 95, 88, 186, 92, 202, 124, 14, 27, 116, 71, 55, 59, 236,
-			88, 29, 14, 0, 235, 196, 253, 146, 125, 109, 202, 31, 239, 154,
-			194, 93, 102, 122, 71, 234, 175, 209, 35, 118, 252, 17, 59, 254,
-			122, 176, 227, 92, 8, 187, 59, 117, 5, 207, 51, 69, 144, 125,
-			169, 163, 200, 186, 17, 43, 130, 48, 71, 206, 97, 103, 230, 58,
-			225, 46, 2, 64, 171, 54, 82, 13, 217, 151, 237, 197, 175, 9,
-			213, 144, 126, 109, 220, 106, 144, 235, 78, 20, 182, 88, 188, 37,
-			223, 237, 25, 196, 103, 139, 18, 1, 41, 207, 142, 147, 170, 19,
-			186, 171, 142, 199, 93, 0, 212, 27, 66, 153, 119, 35, 30, 30,
-			28, 2, 196, 42, 35, 253, 153, 157, 138, 202, 72, 127, 239, 73,
-			69, 101, 164, 127, 236, 41, 252, 47, 17, 80, 138, 76, 61, 167,
-			21, 173, 247, 33, 174, 81, 161, 82, 18, 146, 59, 110, 40, 160,
-			103, 0, 204, 35, 100, 206, 207, 41, 121, 98, 149, 68, 247, 161,
-			106, 76, 200, 41, 105, 38, 176, 45, 19, 182, 154, 5, 114, 141,
-			251, 216, 14, 28, 27, 124, 138, 112, 129, 25, 131, 32, 230, 243,
-			169, 182, 78, 86, 106, 246, 234, 170, 96, 229, 51, 140, 121, 207,
-			101, 100, 72, 51, 245, 28, 123, 61, 207, 48, 86, 62, 55, 244,
-			36, 174, 192, 29, 76, 63, 153, 58, 100, 61, 175, 40, 196, 248,
-			12, 205, 4, 72, 6, 19, 237, 200, 39, 43, 78, 196, 221, 210,
-			10, 219, 33, 57, 242, 177, 96, 10, 111, 160, 19, 4, 151, 187,
-			147, 217, 126, 252, 70, 38, 33, 62, 157, 42, 32, 107, 22, 106,
-			228, 120, 139, 92, 212, 45, 44, 65, 218, 234, 136, 103, 151, 156,
-			97, 184, 99, 77, 180, 217, 167, 179, 3, 248, 113, 33, 68, 30,
-			214, 8, 247, 182, 212, 86, 104, 231, 137, 26, 43, 167, 12, 107,
-			88, 81, 78, 25, 238, 49, 21, 81, 237, 240, 174, 126, 69, 84,
-			59, 124, 232, 48, 92, 104, 65, 57, 229, 28, 82, 180, 75, 14,
-			115, 181, 141, 148, 169, 159, 213, 76, 174, 182, 209, 233, 22, 155,
-			156, 241, 91, 55, 52, 131, 108, 9, 61, 146, 179, 90, 86, 209,
-			35, 57, 219, 189, 93, 209, 35, 57, 219, 219, 7, 132, 234, 166,
-			113, 33, 245, 52, 35, 84, 71, 166, 126, 33, 187, 7, 239, 161,
-			191, 187, 77, 253, 49, 109, 111, 174, 155, 76, 56, 128, 155, 143,
-			113, 15, 54, 12, 189, 59, 101, 234, 143, 245, 48, 93, 29, 189,
-			155, 150, 171, 132, 52, 22, 98, 9, 233, 167, 29, 59, 249, 39,
-			148, 12, 105, 44, 196, 18, 210, 64, 159, 201, 63, 209, 206, 82,
-			66, 252, 27, 75, 168, 155, 250, 99, 96, 226, 70, 63, 81, 98,
-			149, 144, 198, 66, 223, 33, 124, 235, 233, 151, 53, 211, 186, 75,
-			202, 155, 201, 11, 18, 61, 189, 181, 222, 125, 0, 225, 0, 120,
-			205, 211, 47, 243, 49, 0, 167, 121, 250, 101, 62, 6, 224, 51,
-			79, 191, 220, 219, 7, 206, 35, 117, 218, 232, 167, 180, 163, 214,
-			48, 25, 111, 223, 238, 56, 164, 71, 5, 32, 249, 226, 75, 156,
-			172, 5, 101, 104, 230, 157, 34, 68, 139, 234, 61, 36, 66, 186,
-			169, 63, 117, 36, 135, 207, 128, 99, 62, 99, 60, 245, 6, 100,
-			29, 39, 115, 78, 68, 143, 65, 122, 116, 50, 36, 67, 142, 247,
-			151, 40, 28, 51, 239, 125, 250, 120, 118, 15, 115, 195, 104, 208,
-			94, 189, 166, 229, 173, 215, 216, 155, 3, 123, 112, 16, 23, 54,
-			33, 124, 241, 189, 40, 240, 1, 165, 132, 12, 6, 206, 240, 144,
-			223, 112, 60, 56, 114, 43, 53, 63, 4, 255, 58, 244, 140, 226,
-			215, 30, 247, 85, 105, 126, 180, 220, 92, 85, 143, 43, 81, 78,
-			187, 192, 3, 90, 102, 192, 178, 188, 166, 201, 80, 198, 212, 175,
-			113, 157, 32, 112, 238, 167, 95, 59, 124, 82, 132, 116, 83, 191,
-			118, 234, 52, 244, 52, 172, 197, 146, 118, 216, 26, 38, 130, 75,
-			31, 86, 204, 203, 86, 41, 115, 3, 91, 107, 114, 102, 240, 146,
-			104, 79, 151, 180, 62, 17, 162, 69, 153, 150, 8, 233, 166, 94,
-			58, 120, 8, 255, 173, 14, 190, 105, 210, 183, 82, 31, 69, 200,
-			250, 75, 157, 20, 91, 186, 150, 245, 216, 122, 167, 13, 146, 92,
-			237, 144, 146, 246, 173, 232, 141, 187, 107, 142, 199, 60, 47, 249,
-			119, 89, 143, 193, 70, 40, 102, 8, 83, 98, 162, 61, 238, 84,
-			243, 178, 143, 67, 102, 186, 94, 37, 119, 156, 128, 153, 161, 219,
-			33, 243, 47, 85, 96, 74, 48, 156, 160, 53, 59, 36, 54, 28,
-			90, 195, 252, 32, 195, 49, 118, 9, 23, 75, 45, 148, 184, 183,
-			1, 143, 52, 252, 48, 34, 149, 102, 24, 249, 117, 226, 122, 204,
-			49, 23, 243, 14, 227, 113, 36, 128, 85, 224, 65, 19, 167, 185,
-			125, 199, 119, 171, 161, 232, 101, 137, 157, 3, 77, 73, 0, 21,
-			73, 51, 173, 229, 117, 76, 108, 233, 195, 77, 100, 144, 61, 3,
-			60, 8, 92, 236, 28, 81, 177, 154, 95, 245, 143, 196, 32, 74,
-			11, 27, 130, 157, 218, 194, 227, 145, 130, 112, 42, 187, 140, 155,
-			172, 139, 122, 49, 211, 16, 170, 58, 195, 162, 118, 161, 74, 152,
-			70, 166, 126, 43, 219, 143, 255, 155, 206, 253, 11, 233, 203, 90,
-			175, 245, 7, 122, 71, 155, 75, 209, 228, 142, 51, 132, 246, 222,
-			172, 104, 39, 104, 90, 69, 118, 228, 172, 52, 107, 108, 48, 236,
-			168, 165, 111, 163, 192, 174, 220, 22, 235, 16, 122, 148, 225, 83,
-			49, 188, 99, 76, 226, 11, 48, 157, 79, 85, 246, 122, 205, 251,
-			140, 79, 129, 65, 58, 184, 224, 164, 204, 165, 252, 60, 207, 74,
-			212, 203, 51, 142, 51, 3, 222, 212, 56, 101, 66, 216, 105, 45,
-			159, 140, 5, 99, 18, 117, 64, 191, 225, 53, 193, 36, 118, 104,
-			37, 49, 172, 11, 211, 114, 83, 200, 132, 6, 43, 80, 203, 144,
-			147, 89, 214, 215, 192, 43, 137, 207, 92, 67, 210, 198, 82, 90,
-			110, 129, 97, 18, 67, 236, 238, 172, 226, 56, 150, 80, 113, 36,
-			131, 115, 12, 5, 190, 154, 240, 31, 69, 153, 71, 225, 22, 162,
-			109, 115, 103, 62, 186, 196, 169, 82, 154, 8, 11, 67, 108, 123,
-			0, 103, 78, 250, 50, 223, 238, 193, 151, 147, 190, 220, 221, 35,
-			66, 186, 169, 47, 239, 216, 137, 63, 139, 96, 90, 32, 83, 95,
-			213, 14, 90, 255, 7, 138, 145, 172, 194, 100, 15, 229, 201, 221,
-			53, 95, 200, 178, 129, 41, 2, 84, 3, 134, 64, 192, 185, 31,
-			55, 12, 155, 78, 72, 207, 166, 138, 19, 120, 210, 62, 43, 46,
-			132, 47, 112, 55, 114, 234, 210, 200, 148, 242, 62, 241, 11, 171,
-			237, 145, 167, 121, 35, 43, 126, 157, 65, 82, 97, 129, 73, 5,
-			222, 255, 165, 227, 66, 14, 159, 53, 216, 8, 156, 21, 39, 176,
-			151, 107, 235, 196, 102, 32, 60, 0, 107, 39, 174, 97, 5, 209,
-			29, 200, 160, 141, 148, 161, 180, 169, 175, 114, 45, 229, 52, 236,
-			157, 171, 230, 126, 17, 210, 77, 125, 181, 127, 0, 255, 103, 13,
-			58, 71, 51, 117, 95, 59, 98, 253, 43, 141, 20, 41, 165, 65,
-			212, 25, 2, 89, 206, 11, 38, 158, 81, 231, 136, 92, 98, 97,
-			94, 120, 16, 130, 101, 205, 84, 59, 197, 164, 231, 155, 153, 235,
-			177, 213, 82, 241, 235, 116, 245, 133, 5, 197, 126, 40, 116, 188,
-			8, 102, 233, 74, 96, 175, 210, 175, 12, 117, 18, 16, 118, 229,
-			71, 112, 111, 215, 160, 253, 228, 144, 42, 248, 10, 145, 15, 158,
-			54, 89, 105, 214, 106, 36, 140, 252, 6, 83, 215, 12, 24, 192,
-			16, 112, 173, 21, 187, 225, 70, 118, 141, 212, 156, 136, 217, 108,
-			39, 145, 250, 115, 234, 91, 254, 96, 200, 0, 46, 216, 236, 87,
-			44, 138, 93, 47, 70, 225, 201, 201, 190, 215, 210, 180, 15, 197,
-			84, 164, 211, 205, 239, 30, 16, 33, 221, 212, 253, 195, 4, 127,
-			44, 13, 189, 173, 155, 198, 183, 34, 237, 136, 245, 75, 105, 46,
-			193, 231, 154, 153, 49, 175, 196, 123, 215, 13, 69, 247, 114, 205,
-			80, 246, 10, 207, 28, 108, 116, 200, 185, 241, 30, 75, 47, 251,
-			85, 119, 101, 133, 78, 122, 14, 229, 13, 88, 142, 138, 154, 166,
-			44, 148, 15, 104, 216, 182, 205, 195, 102, 61, 24, 58, 14, 225,
-			32, 156, 225, 210, 144, 204, 218, 130, 154, 34, 79, 208, 154, 184,
-			87, 116, 32, 150, 82, 197, 68, 32, 11, 13, 176, 25, 163, 13,
-			16, 233, 114, 36, 188, 235, 70, 224, 81, 36, 174, 221, 13, 137,
-			227, 217, 203, 53, 167, 58, 148, 199, 27, 246, 129, 124, 95, 129,
-			82, 97, 143, 97, 254, 31, 18, 173, 230, 186, 38, 124, 101, 179,
-			53, 222, 126, 220, 65, 39, 36, 79, 58, 74, 9, 175, 93, 104,
-			189, 74, 42, 6, 125, 118, 108, 241, 108, 162, 152, 161, 214, 55,
-			31, 238, 20, 129, 49, 68, 146, 253, 205, 139, 38, 48, 169, 94,
-			139, 210, 46, 219, 150, 238, 56, 65, 12, 43, 138, 85, 175, 57,
-			74, 113, 130, 154, 217, 81, 62, 20, 113, 174, 217, 192, 29, 30,
-			205, 147, 217, 51, 34, 112, 38, 143, 201, 236, 152, 8, 141, 229,
-			137, 19, 85, 10, 67, 28, 251, 78, 150, 73, 143, 59, 166, 209,
-			81, 245, 239, 122, 210, 157, 158, 158, 129, 201, 188, 75, 4, 17,
-			13, 238, 30, 16, 65, 152, 234, 135, 9, 254, 21, 3, 102, 190,
-			97, 26, 223, 143, 180, 67, 214, 135, 13, 149, 161, 21, 186, 205,
-			220, 191, 39, 109, 170, 96, 191, 148, 163, 41, 30, 223, 97, 206,
-			204, 136, 67, 155, 148, 0, 25, 139, 31, 115, 124, 210, 134, 27,
-			56, 62, 204, 115, 15, 137, 234, 60, 89, 113, 1, 126, 110, 147,
-			114, 60, 226, 220, 227, 58, 224, 48, 15, 101, 97, 45, 83, 14,
-			139, 57, 39, 92, 138, 240, 210, 216, 94, 40, 214, 19, 7, 181,
-			81, 26, 39, 5, 236, 180, 159, 41, 19, 224, 123, 228, 84, 113,
-			250, 133, 83, 152, 247, 14, 227, 39, 185, 238, 246, 176, 178, 50,
-			107, 235, 162, 56, 149, 103, 104, 43, 16, 179, 18, 167, 166, 78,
-			41, 110, 192, 72, 81, 233, 94, 175, 163, 31, 77, 222, 67, 210,
-			149, 100, 212, 2, 193, 29, 18, 123, 5, 12, 237, 153, 162, 7,
-			237, 32, 201, 72, 198, 80, 198, 54, 244, 17, 176, 187, 181, 192,
-			177, 171, 235, 12, 166, 129, 245, 123, 172, 37, 230, 99, 198, 174,
-			146, 106, 19, 132, 138, 124, 71, 96, 195, 45, 167, 156, 193, 102,
-			145, 12, 102, 104, 144, 195, 178, 164, 65, 198, 241, 253, 200, 60,
-			32, 130, 58, 13, 14, 28, 196, 55, 233, 4, 212, 83, 102, 230,
-			7, 145, 246, 79, 145, 174, 218, 176, 72, 239, 249, 29, 39, 157,
-			221, 105, 202, 177, 210, 1, 12, 246, 7, 17, 238, 197, 159, 70,
-			56, 67, 195, 90, 202, 52, 254, 9, 50, 14, 88, 191, 142, 238,
-			99, 36, 130, 201, 53, 122, 78, 9, 193, 72, 172, 24, 36, 86,
-			3, 221, 143, 46, 98, 121, 233, 229, 62, 26, 19, 194, 108, 17,
-			24, 1, 88, 50, 254, 239, 233, 145, 186, 237, 122, 23, 87, 253,
-			145, 48, 168, 140, 108, 46, 255, 6, 120, 19, 207, 174, 197, 49,
-			194, 134, 70, 76, 146, 85, 159, 161, 50, 166, 57, 174, 252, 63,
-			65, 134, 18, 129, 104, 68, 207, 238, 56, 66, 167, 17, 251, 246,
-			227, 15, 138, 254, 64, 166, 241, 19, 200, 24, 182, 126, 4, 41,
-			174, 50, 236, 72, 66, 134, 57, 109, 235, 173, 144, 152, 149, 149,
-			74, 51, 16, 128, 126, 49, 222, 135, 116, 78, 225, 68, 32, 207,
-			117, 238, 85, 28, 167, 42, 64, 104, 197, 212, 47, 96, 114, 115,
-			97, 110, 30, 164, 189, 235, 164, 243, 131, 86, 33, 110, 13, 202,
-			0, 173, 187, 226, 8, 32, 126, 247, 96, 28, 161, 211, 136, 211,
-			121, 252, 37, 141, 55, 79, 51, 141, 247, 33, 99, 196, 250, 44,
-			3, 70, 171, 187, 30, 64, 40, 240, 137, 181, 113, 107, 3, 202,
-			246, 121, 226, 116, 1, 243, 47, 59, 209, 106, 165, 217, 173, 173,
-			118, 67, 178, 236, 212, 252, 187, 124, 209, 15, 171, 55, 133, 184,
-			93, 137, 18, 227, 246, 170, 86, 47, 14, 189, 117, 172, 122, 244,
-			38, 110, 131, 51, 163, 154, 127, 151, 46, 101, 80, 123, 235, 152,
-			57, 242, 41, 235, 115, 39, 113, 83, 14, 156, 134, 67, 119, 188,
-			154, 28, 67, 6, 209, 230, 85, 149, 46, 247, 86, 73, 181, 9,
-			98, 90, 207, 119, 67, 105, 155, 86, 181, 35, 155, 171, 43, 192,
-			195, 2, 195, 187, 115, 238, 57, 149, 38, 248, 96, 240, 61, 64,
-			103, 113, 188, 106, 168, 12, 148, 150, 129, 94, 143, 7, 138, 206,
-			178, 247, 161, 221, 167, 226, 8, 157, 70, 12, 23, 240, 187, 25,
-			243, 159, 54, 141, 15, 210, 115, 231, 187, 16, 153, 188, 215, 176,
-			61, 155, 129, 95, 56, 247, 162, 118, 166, 171, 157, 165, 173, 230,
-			185, 133, 144, 207, 97, 103, 232, 141, 157, 65, 3, 173, 216, 21,
-			135, 201, 8, 132, 248, 89, 72, 114, 67, 230, 242, 72, 49, 225,
-			44, 144, 114, 204, 176, 179, 221, 41, 157, 1, 194, 182, 139, 32,
-			162, 193, 29, 98, 239, 74, 235, 52, 56, 112, 16, 135, 176, 119,
-			33, 51, 243, 33, 164, 253, 175, 72, 183, 42, 100, 220, 167, 140,
-			112, 20, 227, 193, 139, 189, 57, 73, 26, 56, 159, 130, 103, 229,
-			88, 201, 5, 154, 9, 76, 219, 106, 211, 5, 134, 57, 246, 166,
-			14, 232, 133, 247, 136, 27, 201, 29, 142, 174, 129, 15, 33, 188,
-			7, 127, 86, 135, 41, 15, 248, 208, 191, 136, 140, 1, 235, 183,
-			232, 5, 187, 230, 123, 171, 78, 192, 44, 191, 134, 55, 179, 252,
-			234, 192, 212, 98, 56, 16, 212, 94, 118, 61, 114, 99, 254, 230,
-			84, 59, 95, 62, 223, 194, 151, 183, 56, 215, 238, 200, 167, 99,
-			50, 200, 113, 48, 135, 18, 44, 187, 116, 111, 30, 173, 57, 204,
-			127, 87, 236, 168, 156, 123, 207, 6, 175, 132, 244, 84, 194, 49,
-			208, 248, 58, 247, 200, 33, 154, 236, 172, 172, 56, 140, 199, 179,
-			35, 177, 40, 4, 171, 11, 239, 5, 76, 121, 136, 177, 137, 66,
-			5, 219, 174, 81, 174, 76, 232, 100, 210, 150, 84, 252, 59, 78,
-			80, 0, 231, 138, 176, 87, 209, 117, 105, 123, 76, 134, 68, 150,
-			215, 233, 141, 105, 217, 89, 241, 65, 163, 214, 99, 14, 178, 10,
-			100, 142, 165, 144, 254, 80, 153, 43, 108, 249, 42, 23, 249, 194,
-			85, 153, 112, 15, 216, 12, 29, 121, 217, 141, 253, 243, 71, 246,
-			106, 120, 145, 52, 107, 121, 82, 115, 243, 196, 150, 43, 12, 212,
-			163, 233, 32, 43, 17, 136, 70, 244, 236, 139, 35, 116, 26, 97,
-			245, 227, 191, 211, 248, 188, 64, 166, 241, 171, 200, 232, 183, 254,
-			130, 94, 34, 91, 102, 192, 154, 127, 151, 216, 220, 62, 144, 111,
-			64, 171, 190, 184, 59, 7, 235, 28, 178, 146, 78, 61, 88, 95,
-			124, 178, 108, 50, 33, 46, 55, 107, 87, 48, 185, 92, 115, 175,
-			220, 114, 157, 187, 2, 95, 73, 112, 30, 151, 71, 106, 46, 255,
-			124, 217, 38, 107, 129, 179, 242, 100, 142, 191, 115, 174, 250, 145,
-			95, 136, 175, 223, 35, 85, 167, 238, 71, 206, 48, 189, 19, 13,
-			87, 94, 201, 93, 153, 128, 240, 229, 17, 251, 74, 172, 58, 11,
-			23, 166, 241, 103, 69, 169, 35, 205, 218, 149, 220, 55, 215, 120,
-			161, 52, 116, 190, 18, 1, 163, 209, 179, 55, 142, 208, 105, 196,
-			1, 11, 215, 153, 11, 220, 143, 161, 212, 111, 35, 4, 142, 136,
-			224, 78, 213, 172, 171, 88, 107, 180, 222, 171, 173, 183, 16, 6,
-			134, 83, 138, 218, 125, 117, 130, 189, 162, 7, 187, 122, 156, 171,
-			56, 91, 98, 72, 40, 224, 99, 247, 99, 40, 189, 23, 95, 22,
-			62, 118, 63, 142, 180, 227, 86, 129, 72, 195, 199, 166, 39, 117,
-			201, 47, 9, 143, 125, 242, 245, 155, 123, 221, 197, 177, 51, 221,
-			143, 35, 141, 224, 216, 153, 238, 199, 209, 209, 99, 248, 184, 112,
-			166, 251, 9, 164, 117, 91, 251, 200, 236, 104, 158, 220, 104, 185,
-			157, 37, 60, 223, 126, 2, 105, 6, 142, 61, 223, 126, 2, 117,
-			209, 203, 59, 247, 124, 251, 27, 72, 19, 110, 113, 105, 153, 191,
-			17, 167, 101, 95, 101, 90, 221, 52, 126, 51, 78, 75, 175, 63,
-			191, 25, 167, 213, 53, 26, 148, 105, 13, 211, 248, 173, 56, 45,
-			101, 84, 127, 43, 78, 107, 104, 52, 40, 211, 166, 77, 227, 147,
-			113, 90, 122, 48, 124, 50, 78, 155, 214, 104, 176, 43, 139, 67,
-			230, 164, 252, 119, 81, 234, 79, 17, 178, 28, 210, 162, 7, 195,
-			151, 225, 178, 19, 38, 180, 216, 229, 51, 23, 237, 98, 56, 188,
-			56, 119, 187, 220, 92, 5, 199, 61, 128, 81, 204, 172, 240, 91,
-			175, 168, 138, 203, 241, 223, 69, 217, 125, 120, 31, 13, 116, 155,
-			198, 239, 33, 109, 123, 14, 139, 151, 43, 144, 138, 24, 70, 166,
-			59, 69, 191, 244, 48, 16, 158, 76, 55, 29, 59, 53, 168, 241,
-			224, 117, 225, 183, 252, 15, 144, 38, 112, 43, 91, 43, 230, 251,
-			187, 104, 17, 101, 238, 38, 219, 212, 28, 183, 75, 111, 228, 127,
-			128, 180, 172, 8, 34, 26, 236, 238, 21, 65, 157, 6, 119, 237,
-			198, 127, 172, 9, 111, 228, 159, 67, 218, 128, 245, 73, 141, 75,
-			99, 28, 182, 15, 217, 82, 104, 199, 101, 88, 208, 83, 252, 192,
-			21, 189, 37, 253, 162, 9, 31, 239, 244, 190, 2, 98, 97, 230,
-			201, 16, 16, 102, 5, 161, 193, 114, 115, 149, 110, 58, 57, 240,
-			173, 187, 114, 143, 6, 171, 206, 157, 156, 80, 41, 21, 117, 39,
-			77, 43, 164, 249, 11, 45, 154, 67, 42, 41, 162, 181, 2, 38,
-			51, 2, 89, 158, 93, 71, 233, 94, 82, 115, 87, 152, 199, 71,
-			90, 158, 146, 58, 105, 207, 107, 131, 7, 207, 187, 118, 80, 37,
-			97, 205, 14, 215, 200, 96, 110, 36, 55, 212, 110, 243, 27, 215,
-			92, 16, 34, 95, 86, 180, 19, 10, 199, 154, 111, 226, 82, 222,
-			55, 14, 23, 64, 236, 123, 238, 181, 99, 75, 9, 95, 233, 159,
-			139, 7, 4, 65, 151, 119, 239, 19, 65, 157, 6, 173, 126, 252,
-			107, 26, 115, 15, 253, 121, 148, 250, 107, 132, 172, 127, 171, 145,
-			22, 229, 43, 217, 45, 10, 147, 31, 114, 13, 5, 197, 229, 174,
-			180, 195, 7, 175, 132, 234, 69, 88, 241, 26, 76, 119, 227, 80,
-			62, 52, 120, 82, 126, 228, 122, 85, 247, 142, 234, 29, 12, 171,
-			117, 137, 219, 185, 184, 114, 0, 190, 107, 193, 41, 208, 66, 164,
-			236, 72, 73, 15, 143, 8, 78, 4, 3, 179, 206, 156, 122, 85,
-			252, 250, 50, 64, 86, 115, 103, 102, 30, 153, 41, 15, 135, 78,
-			221, 246, 34, 183, 50, 84, 224, 83, 33, 65, 182, 40, 33, 142,
-			197, 4, 158, 142, 238, 174, 249, 76, 40, 214, 244, 120, 123, 40,
-			255, 197, 215, 104, 23, 50, 141, 207, 211, 53, 122, 74, 184, 214,
-			254, 2, 210, 14, 90, 3, 45, 221, 2, 190, 55, 61, 135, 35,
-			87, 49, 15, 130, 41, 3, 18, 171, 46, 183, 191, 128, 184, 83,
-			59, 230, 114, 251, 11, 168, 79, 248, 70, 164, 11, 234, 11, 168,
-			127, 0, 231, 133, 199, 237, 47, 34, 237, 176, 117, 168, 67, 61,
-			160, 202, 150, 168, 9, 25, 144, 92, 245, 133, 251, 197, 184, 38,
-			4, 133, 245, 89, 34, 168, 211, 224, 193, 67, 120, 88, 184, 194,
-			253, 50, 173, 233, 112, 135, 154, 64, 77, 46, 233, 225, 145, 238,
-			191, 95, 142, 171, 162, 91, 236, 151, 227, 170, 40, 221, 95, 142,
-			171, 162, 27, 251, 151, 209, 193, 67, 82, 31, 250, 123, 158, 196,
-			252, 109, 130, 105, 141, 45, 55, 87, 70, 0, 215, 109, 177, 110,
-			135, 183, 185, 234, 243, 78, 206, 89, 136, 20, 185, 35, 184, 251,
-			26, 77, 116, 211, 14, 111, 155, 187, 113, 186, 97, 71, 107, 76,
-			65, 183, 187, 204, 2, 87, 223, 129, 240, 174, 138, 95, 47, 180,
-			100, 189, 186, 67, 102, 156, 165, 81, 179, 232, 91, 198, 120, 146,
-			85, 191, 102, 123, 171, 112, 157, 151, 164, 68, 235, 13, 39, 28,
-			185, 237, 249, 119, 61, 70, 22, 165, 170, 177, 252, 55, 8, 189,
-			87, 211, 175, 207, 94, 253, 121, 237, 208, 117, 150, 123, 150, 103,
-			41, 60, 231, 212, 106, 207, 208, 12, 243, 52, 239, 27, 126, 230,
-			18, 238, 50, 211, 135, 82, 95, 70, 8, 127, 124, 27, 232, 189,
-			29, 74, 153, 99, 255, 121, 27, 129, 28, 21, 191, 70, 174, 54,
-			153, 4, 121, 152, 176, 178, 78, 134, 76, 71, 5, 238, 27, 236,
-			9, 138, 67, 125, 225, 132, 178, 220, 232, 227, 60, 3, 41, 121,
-			149, 2, 1, 143, 41, 240, 141, 222, 30, 67, 39, 184, 3, 219,
-			185, 144, 116, 200, 235, 72, 168, 50, 106, 13, 78, 196, 240, 50,
-			35, 98, 4, 99, 82, 118, 164, 170, 24, 24, 42, 121, 85, 56,
-			210, 0, 44, 14, 30, 139, 104, 204, 178, 235, 217, 204, 10, 175,
-			30, 230, 153, 76, 220, 15, 132, 29, 34, 38, 117, 191, 10, 23,
-			96, 90, 66, 30, 22, 40, 168, 119, 129, 133, 154, 132, 102, 148,
-			128, 134, 49, 51, 38, 223, 228, 184, 54, 80, 221, 137, 46, 114,
-			157, 186, 83, 45, 132, 1, 43, 199, 41, 2, 243, 60, 48, 251,
-			12, 156, 72, 220, 202, 24, 72, 101, 69, 244, 24, 166, 252, 143,
-			11, 183, 55, 202, 255, 8, 17, 145, 90, 35, 247, 138, 28, 147,
-			83, 117, 195, 74, 205, 118, 235, 176, 69, 119, 38, 194, 245, 212,
-			190, 16, 68, 52, 2, 191, 218, 172, 56, 49, 29, 56, 38, 228,
-			107, 162, 67, 130, 172, 86, 253, 74, 179, 46, 221, 19, 217, 94,
-			117, 132, 238, 56, 160, 146, 87, 183, 35, 39, 112, 237, 90, 24,
-			119, 117, 12, 44, 161, 82, 47, 27, 53, 205, 149, 249, 34, 69,
-			149, 71, 157, 91, 158, 31, 127, 131, 126, 7, 47, 64, 32, 217,
-			163, 69, 249, 65, 8, 202, 145, 138, 181, 138, 227, 85, 253, 32,
-			116, 232, 164, 104, 4, 62, 229, 254, 9, 235, 147, 40, 36, 148,
-			129, 23, 126, 163, 56, 86, 137, 208, 99, 148, 150, 172, 82, 153,
-			1, 184, 76, 80, 120, 140, 28, 79, 81, 18, 4, 81, 240, 141,
-			210, 28, 153, 155, 185, 54, 255, 92, 177, 60, 73, 74, 115, 100,
-			182, 60, 115, 171, 52, 49, 57, 65, 174, 190, 64, 230, 111, 76,
-			146, 241, 153, 217, 23, 202, 165, 235, 55, 230, 201, 141, 153, 169,
-			137, 201, 242, 28, 120, 153, 31, 159, 153, 158, 47, 151, 174, 46,
-			204, 207, 148, 231, 176, 212, 130, 164, 95, 138, 211, 47, 144, 201,
-			231, 103, 203, 147, 115, 160, 250, 88, 186, 57, 59, 85, 154, 156,
-			80, 20, 34, 243, 164, 52, 61, 62, 181, 48, 81, 154, 190, 158,
-			39, 87, 23, 230, 201, 244, 204, 60, 38, 83, 165, 155, 165, 249,
-			201, 9, 50, 63, 147, 135, 106, 219, 243, 145, 153, 107, 228, 230,
-			100, 121, 252, 70, 113, 122, 190, 120, 181, 52, 85, 154, 127, 1,
-			42, 188, 86, 154, 159, 166, 149, 93, 155, 41, 99, 82, 36, 179,
-			197, 242, 124, 105, 124, 97, 170, 88, 38, 179, 11, 229, 217, 153,
-			185, 73, 66, 91, 54, 81, 154, 27, 159, 42, 150, 110, 78, 78,
-			20, 72, 105, 154, 76, 207, 144, 201, 91, 147, 211, 243, 100, 238,
-			70, 113, 106, 42, 217, 80, 76, 102, 158, 155, 158, 44, 115, 197,
-			77, 217, 76, 114, 117, 146, 76, 149, 138, 87, 167, 38, 105, 85,
-			208, 206, 137, 82, 121, 114, 124, 158, 54, 40, 254, 53, 94, 154,
-			152, 156, 158, 47, 78, 229, 49, 1, 213, 230, 226, 84, 158, 76,
-			62, 63, 121, 115, 118, 170, 88, 126, 33, 207, 11, 157, 155, 124,
-			118, 97, 114, 122, 190, 84, 156, 34, 19, 197, 155, 197, 235, 147,
-			115, 100, 240, 126, 189, 50, 91, 158, 25, 95, 40, 79, 222, 164,
-			84, 207, 92, 35, 115, 11, 87, 231, 230, 75, 243, 11, 243, 147,
-			228, 250, 204, 204, 4, 116, 246, 220, 100, 249, 86, 105, 124, 114,
-			238, 18, 153, 154, 153, 131, 14, 91, 152, 155, 204, 99, 50, 81,
-			156, 47, 66, 213, 179, 229, 153, 107, 165, 249, 185, 75, 244, 247,
-			213, 133, 185, 18, 116, 92, 105, 122, 126, 178, 92, 94, 152, 157,
-			47, 205, 76, 15, 145, 27, 51, 207, 77, 222, 154, 44, 147, 241,
-			226, 194, 220, 228, 4, 244, 240, 204, 52, 109, 45, 157, 43, 147,
-			51, 229, 23, 104, 177, 180, 31, 96, 4, 242, 228, 185, 27, 147,
-			243, 55, 38, 203, 180, 83, 161, 183, 138, 180, 27, 230, 230, 203,
-			165, 241, 121, 53, 217, 76, 153, 204, 207, 148, 231, 177, 210, 78,
-			50, 61, 121, 125, 170, 116, 125, 114, 122, 124, 146, 126, 158, 161,
-			197, 60, 87, 154, 155, 28, 34, 197, 114, 105, 142, 38, 40, 65,
-			197, 228, 185, 226, 11, 100, 102, 1, 90, 77, 7, 106, 97, 110,
-			18, 179, 223, 202, 212, 205, 195, 120, 146, 210, 53, 82, 156, 184,
-			85, 162, 148, 243, 212, 179, 51, 115, 115, 37, 62, 93, 160, 219,
-			198, 111, 240, 62, 151, 250, 175, 36, 181, 159, 235, 191, 230, 82,
-			151, 64, 255, 245, 56, 251, 201, 34, 143, 166, 242, 16, 137, 216,
-			79, 22, 121, 44, 53, 2, 145, 252, 39, 139, 60, 158, 202, 65,
-			36, 102, 63, 89, 228, 137, 212, 17, 136, 60, 198, 126, 178, 200,
-			147, 169, 146, 80, 180, 165, 63, 89, 228, 96, 234, 48, 68, 30,
-			102, 63, 255, 101, 30, 52, 49, 51, 95, 68, 244, 232, 179, 126,
-			54, 79, 150, 228, 201, 187, 148, 4, 32, 231, 239, 85, 225, 122,
-			125, 217, 175, 185, 21, 142, 235, 10, 199, 120, 158, 97, 162, 50,
-			1, 133, 56, 8, 224, 203, 69, 146, 91, 41, 216, 185, 214, 152,
-			229, 66, 53, 135, 49, 185, 225, 4, 14, 89, 90, 105, 169, 136,
-			21, 12, 231, 88, 221, 33, 129, 239, 71, 2, 29, 54, 79, 150,
-			236, 37, 216, 119, 151, 150, 151, 132, 203, 121, 105, 254, 46, 17,
-			100, 185, 77, 252, 210, 202, 18, 123, 56, 90, 170, 46, 201, 98,
-			85, 139, 121, 44, 243, 64, 234, 194, 50, 248, 194, 135, 246, 19,
-			202, 65, 132, 92, 231, 147, 75, 41, 164, 137, 117, 108, 254, 206,
-			41, 80, 239, 75, 203, 14, 142, 237, 191, 151, 65, 103, 129, 166,
-			109, 72, 232, 235, 128, 159, 186, 252, 179, 199, 31, 205, 226, 36,
-			133, 22, 26, 64, 251, 143, 1, 4, 113, 253, 167, 55, 204, 205,
-			76, 19, 199, 171, 248, 240, 228, 4, 143, 210, 32, 20, 7, 13,
-			153, 163, 60, 247, 77, 200, 237, 122, 66, 95, 24, 0, 239, 185,
-			117, 182, 106, 250, 71, 143, 7, 46, 11, 182, 197, 125, 147, 177,
-			3, 177, 150, 172, 232, 39, 63, 0, 27, 246, 97, 217, 111, 97,
-			236, 18, 155, 95, 151, 138, 179, 37, 218, 91, 112, 113, 75, 226,
-			72, 240, 206, 178, 67, 172, 184, 222, 148, 216, 5, 225, 237, 66,
-			82, 204, 229, 174, 200, 47, 177, 149, 162, 115, 199, 245, 155, 33,
-			22, 169, 136, 155, 240, 203, 211, 129, 98, 59, 228, 231, 115, 40,
-			230, 229, 10, 121, 51, 215, 254, 39, 54, 185, 72, 198, 198, 68,
-			104, 57, 254, 64, 72, 149, 92, 36, 103, 226, 224, 61, 154, 82,
-			4, 95, 19, 63, 214, 105, 162, 179, 88, 141, 124, 245, 34, 121,
-			156, 191, 126, 10, 71, 48, 194, 74, 94, 244, 134, 60, 51, 249,
-			27, 34, 93, 59, 188, 111, 238, 229, 153, 170, 210, 171, 24, 84,
-			3, 220, 128, 107, 56, 137, 151, 72, 58, 237, 184, 200, 137, 219,
-			147, 179, 25, 206, 173, 237, 153, 168, 215, 167, 41, 156, 123, 17,
-			38, 126, 51, 106, 52, 163, 161, 139, 248, 225, 155, 254, 154, 104,
-			29, 6, 173, 105, 246, 218, 33, 150, 104, 200, 125, 63, 179, 251,
-			50, 183, 209, 224, 204, 34, 160, 203, 55, 252, 208, 85, 0, 223,
-			97, 3, 136, 193, 189, 217, 147, 179, 220, 111, 132, 250, 55, 47,
-			86, 120, 251, 102, 168, 136, 234, 26, 202, 179, 197, 27, 175, 41,
-			54, 5, 152, 118, 94, 173, 38, 58, 115, 208, 134, 71, 164, 68,
-			13, 43, 74, 2, 76, 214, 236, 42, 123, 139, 149, 243, 113, 40,
-			9, 136, 46, 118, 13, 152, 132, 210, 147, 139, 231, 84, 232, 236,
-			10, 220, 26, 179, 0, 89, 151, 250, 8, 177, 103, 159, 214, 137,
-			8, 94, 176, 133, 129, 172, 77, 202, 147, 115, 243, 29, 27, 165,
-			212, 39, 90, 85, 117, 3, 167, 18, 201, 90, 100, 209, 204, 155,
-			163, 219, 90, 44, 230, 188, 106, 162, 96, 177, 140, 194, 200, 177,
-			171, 106, 127, 1, 144, 117, 226, 218, 15, 228, 226, 24, 2, 131,
-			111, 101, 82, 7, 141, 150, 222, 169, 53, 124, 111, 98, 214, 26,
-			121, 204, 153, 220, 24, 185, 38, 193, 123, 22, 184, 242, 137, 36,
-			77, 144, 36, 85, 9, 42, 53, 6, 145, 46, 24, 104, 88, 225,
-			171, 12, 226, 129, 185, 149, 2, 22, 181, 82, 179, 249, 36, 224,
-			4, 23, 103, 75, 5, 66, 74, 30, 6, 185, 6, 165, 145, 117,
-			0, 123, 178, 144, 250, 52, 173, 205, 26, 17, 63, 66, 85, 185,
-			12, 147, 101, 103, 205, 190, 67, 89, 91, 186, 74, 139, 179, 165,
-			176, 227, 22, 203, 53, 120, 102, 26, 210, 179, 8, 93, 46, 202,
-			88, 186, 237, 219, 124, 168, 8, 116, 184, 207, 99, 54, 111, 125,
-			254, 222, 27, 217, 193, 170, 19, 169, 93, 79, 143, 163, 85, 159,
-			63, 24, 196, 42, 62, 76, 199, 148, 110, 188, 9, 210, 229, 46,
-			204, 238, 166, 81, 194, 23, 55, 92, 92, 196, 134, 220, 121, 63,
-			102, 207, 152, 53, 135, 187, 201, 97, 3, 26, 146, 166, 23, 249,
-			77, 0, 179, 100, 235, 87, 82, 231, 134, 164, 97, 135, 226, 100,
-			241, 177, 20, 87, 178, 183, 57, 174, 142, 196, 72, 200, 203, 179,
-			130, 61, 83, 133, 45, 228, 217, 181, 154, 60, 223, 217, 222, 121,
-			71, 61, 100, 224, 184, 16, 27, 72, 251, 174, 20, 55, 7, 196,
-			99, 237, 125, 159, 7, 45, 24, 94, 29, 157, 114, 224, 57, 206,
-			110, 52, 28, 175, 26, 187, 120, 139, 181, 94, 90, 106, 96, 125,
-			196, 198, 71, 182, 191, 16, 239, 27, 184, 35, 85, 48, 24, 98,
-			179, 84, 125, 112, 36, 118, 73, 178, 4, 187, 228, 82, 235, 54,
-			217, 114, 236, 182, 141, 88, 91, 73, 114, 132, 217, 12, 100, 61,
-			129, 59, 116, 5, 243, 123, 45, 250, 67, 158, 53, 117, 39, 88,
-			133, 226, 91, 123, 67, 161, 4, 111, 212, 23, 173, 111, 86, 76,
-			62, 172, 164, 228, 5, 116, 56, 148, 147, 103, 81, 242, 20, 238,
-			116, 8, 87, 46, 146, 23, 207, 188, 164, 28, 83, 94, 85, 25,
-			242, 7, 168, 103, 180, 99, 209, 99, 74, 209, 204, 23, 106, 91,
-			215, 186, 140, 183, 224, 236, 237, 139, 148, 191, 205, 229, 41, 155,
-			91, 201, 189, 36, 114, 69, 45, 12, 193, 242, 131, 145, 180, 89,
-			219, 243, 100, 44, 209, 124, 80, 249, 112, 98, 161, 3, 221, 118,
-			185, 132, 129, 248, 13, 182, 245, 208, 189, 225, 142, 19, 4, 110,
-			213, 145, 8, 99, 0, 254, 169, 238, 119, 56, 158, 196, 116, 39,
-			144, 12, 54, 44, 75, 152, 152, 30, 71, 234, 4, 171, 33, 202,
-			156, 240, 3, 243, 164, 80, 205, 110, 101, 86, 148, 158, 107, 134,
-			17, 243, 215, 232, 41, 167, 128, 87, 237, 192, 227, 240, 178, 226,
-			87, 124, 38, 44, 137, 39, 27, 185, 193, 244, 98, 221, 118, 122,
-			98, 118, 192, 87, 55, 171, 188, 236, 17, 91, 212, 130, 225, 120,
-			4, 195, 82, 190, 122, 226, 125, 151, 147, 165, 148, 166, 80, 157,
-			167, 60, 125, 213, 199, 42, 46, 6, 177, 121, 131, 194, 248, 209,
-			134, 241, 232, 114, 65, 39, 38, 80, 130, 227, 241, 197, 236, 101,
-			253, 213, 137, 207, 193, 29, 25, 29, 165, 200, 4, 167, 19, 251,
-			134, 82, 25, 29, 133, 207, 137, 249, 235, 208, 225, 29, 16, 86,
-			214, 156, 186, 77, 156, 59, 126, 173, 41, 246, 9, 151, 157, 225,
-			117, 199, 246, 248, 62, 39, 47, 64, 14, 169, 212, 92, 80, 202,
-			16, 92, 210, 109, 207, 191, 203, 244, 238, 237, 144, 41, 152, 194,
-			203, 48, 184, 194, 115, 193, 181, 36, 221, 90, 48, 239, 105, 102,
-			0, 24, 67, 150, 197, 179, 192, 13, 36, 118, 18, 243, 15, 42,
-			60, 60, 223, 181, 189, 72, 61, 162, 243, 170, 43, 10, 225, 141,
-			152, 210, 203, 143, 68, 112, 91, 225, 242, 87, 91, 187, 118, 215,
-			94, 23, 219, 232, 58, 78, 116, 94, 158, 203, 192, 248, 11, 133,
-			19, 4, 126, 64, 59, 216, 243, 193, 252, 179, 200, 221, 97, 37,
-			216, 55, 126, 162, 213, 132, 67, 190, 214, 41, 196, 213, 194, 147,
-			111, 146, 201, 51, 177, 197, 43, 136, 92, 111, 85, 135, 30, 75,
-			161, 84, 192, 137, 231, 195, 109, 215, 171, 2, 43, 214, 153, 213,
-			233, 176, 81, 37, 31, 222, 151, 29, 178, 230, 131, 150, 136, 114,
-			123, 3, 6, 231, 40, 25, 231, 175, 69, 156, 87, 161, 199, 199,
-			141, 249, 249, 89, 198, 98, 178, 11, 14, 132, 41, 13, 220, 103,
-			125, 235, 209, 194, 217, 26, 120, 207, 79, 76, 78, 185, 246, 249,
-			40, 207, 22, 231, 199, 111, 72, 238, 212, 95, 33, 179, 11, 243,
-			137, 197, 204, 222, 119, 214, 89, 141, 226, 185, 40, 196, 100, 144,
-			38, 4, 89, 174, 176, 160, 129, 155, 45, 220, 168, 154, 181, 26,
-			167, 40, 228, 247, 98, 184, 54, 79, 138, 107, 179, 191, 162, 114,
-			113, 108, 55, 163, 41, 242, 109, 107, 19, 110, 218, 204, 142, 201,
-			38, 161, 235, 173, 214, 28, 126, 58, 115, 191, 169, 236, 94, 3,
-			146, 240, 208, 105, 216, 18, 142, 206, 6, 37, 127, 187, 192, 42,
-			10, 153, 204, 214, 245, 24, 211, 77, 51, 241, 55, 49, 174, 160,
-			76, 89, 182, 17, 120, 83, 5, 93, 180, 225, 138, 93, 119, 106,
-			52, 19, 23, 185, 223, 97, 238, 138, 67, 62, 9, 65, 47, 151,
-			159, 175, 226, 109, 175, 69, 32, 29, 79, 33, 201, 39, 203, 123,
-			176, 248, 54, 27, 248, 96, 98, 47, 143, 30, 64, 200, 6, 85,
-			170, 39, 201, 153, 75, 34, 118, 118, 141, 222, 43, 27, 240, 239,
-			147, 100, 236, 82, 226, 214, 43, 202, 130, 172, 178, 32, 222, 71,
-			42, 160, 88, 162, 72, 254, 93, 120, 36, 87, 75, 133, 209, 96,
-			87, 89, 187, 149, 139, 89, 226, 20, 47, 193, 226, 174, 249, 62,
-			108, 179, 97, 179, 178, 38, 155, 70, 147, 74, 50, 132, 216, 9,
-			236, 163, 85, 98, 114, 173, 41, 160, 117, 185, 4, 13, 108, 70,
-			72, 237, 119, 49, 37, 164, 192, 138, 77, 11, 216, 217, 69, 237,
-			178, 98, 154, 184, 165, 222, 105, 187, 238, 228, 91, 171, 73, 94,
-			39, 232, 150, 57, 227, 57, 98, 122, 134, 45, 34, 169, 40, 112,
-			236, 72, 57, 133, 124, 154, 52, 36, 47, 211, 69, 96, 83, 194,
-			86, 225, 25, 152, 31, 210, 114, 25, 243, 219, 101, 235, 204, 104,
-			157, 12, 115, 48, 159, 110, 242, 144, 108, 9, 84, 2, 250, 64,
-			139, 236, 167, 194, 167, 240, 81, 228, 163, 123, 238, 82, 252, 101,
-			174, 185, 44, 74, 10, 155, 203, 139, 162, 142, 39, 201, 19, 151,
-			58, 136, 23, 230, 147, 123, 21, 51, 69, 218, 124, 68, 149, 65,
-			164, 37, 204, 4, 155, 39, 87, 136, 80, 114, 197, 167, 32, 111,
-			229, 122, 67, 188, 160, 12, 230, 226, 38, 231, 98, 189, 59, 59,
-			116, 134, 40, 125, 28, 250, 144, 11, 211, 184, 120, 131, 111, 159,
-			241, 136, 146, 91, 96, 212, 199, 206, 5, 222, 206, 22, 14, 205,
-			103, 143, 240, 244, 58, 196, 174, 207, 138, 75, 71, 85, 124, 1,
-			164, 169, 119, 16, 233, 83, 74, 72, 31, 193, 128, 112, 157, 191,
-			229, 115, 240, 193, 132, 160, 150, 206, 46, 1, 201, 232, 97, 178,
-			84, 154, 190, 85, 156, 42, 77, 44, 22, 203, 215, 23, 110, 78,
-			78, 207, 47, 197, 199, 29, 37, 9, 54, 41, 56, 109, 235, 118,
-			163, 161, 188, 175, 167, 224, 113, 58, 219, 7, 239, 222, 160, 214,
-			244, 37, 164, 13, 240, 119, 111, 85, 58, 202, 198, 66, 116, 205,
-			118, 134, 84, 102, 64, 114, 25, 76, 211, 32, 87, 98, 103, 74,
-			78, 95, 66, 230, 62, 17, 212, 105, 80, 1, 55, 255, 131, 151,
-			240, 216, 22, 49, 181, 130, 102, 205, 217, 24, 153, 235, 48, 127,
-			210, 182, 27, 46, 127, 205, 22, 172, 4, 203, 98, 221, 247, 205,
-			91, 22, 17, 63, 69, 11, 212, 77, 158, 224, 161, 192, 211, 127,
-			60, 139, 141, 114, 179, 230, 116, 132, 215, 58, 24, 195, 251, 0,
-			122, 207, 85, 253, 51, 69, 61, 198, 248, 25, 192, 93, 180, 213,
-			139, 46, 131, 43, 226, 159, 51, 52, 174, 84, 53, 243, 120, 39,
-			124, 141, 197, 50, 204, 235, 17, 77, 165, 149, 119, 208, 111, 19,
-			242, 147, 121, 1, 235, 203, 205, 85, 14, 234, 212, 1, 203, 166,
-			40, 205, 173, 174, 54, 87, 89, 17, 52, 131, 217, 143, 187, 221,
-			112, 145, 169, 119, 3, 248, 87, 182, 156, 117, 67, 208, 108, 119,
-			204, 19, 120, 167, 27, 50, 116, 48, 215, 91, 93, 164, 21, 236,
-			128, 36, 219, 221, 240, 38, 143, 189, 218, 92, 53, 31, 195, 251,
-			91, 210, 197, 110, 150, 118, 66, 134, 61, 137, 12, 18, 5, 40,
-			192, 67, 27, 101, 92, 164, 183, 228, 69, 198, 21, 0, 26, 250,
-			254, 94, 104, 155, 213, 170, 128, 0, 48, 232, 48, 142, 229, 163,
-			29, 107, 153, 178, 195, 136, 73, 125, 104, 74, 243, 42, 222, 193,
-			24, 189, 69, 174, 149, 187, 191, 107, 35, 128, 117, 238, 241, 174,
-			84, 45, 111, 103, 89, 120, 132, 249, 34, 222, 157, 196, 77, 91,
-			4, 131, 212, 253, 125, 80, 210, 177, 251, 96, 106, 205, 209, 180,
-			108, 176, 205, 229, 182, 15, 230, 211, 184, 167, 66, 79, 14, 222,
-			236, 236, 253, 154, 205, 74, 194, 44, 15, 52, 241, 152, 44, 129,
-			30, 104, 251, 187, 227, 201, 197, 83, 209, 195, 223, 124, 35, 182,
-			160, 143, 237, 102, 213, 141, 0, 200, 76, 237, 109, 115, 107, 213,
-			238, 163, 69, 20, 69, 9, 74, 55, 63, 189, 81, 233, 64, 210,
-			174, 152, 164, 78, 37, 0, 125, 37, 220, 219, 54, 7, 240, 214,
-			168, 218, 81, 75, 142, 249, 27, 177, 37, 17, 255, 219, 39, 214,
-			246, 45, 54, 85, 22, 209, 50, 163, 76, 108, 56, 145, 189, 186,
-			127, 27, 91, 250, 244, 247, 27, 140, 108, 79, 239, 182, 220, 111,
-			107, 216, 108, 31, 122, 115, 14, 111, 99, 170, 229, 124, 218, 48,
-			32, 188, 209, 173, 76, 155, 2, 3, 100, 131, 223, 229, 158, 70,
-			28, 176, 254, 28, 225, 30, 229, 35, 93, 217, 188, 146, 24, 142,
-			141, 69, 148, 170, 201, 101, 175, 181, 44, 251, 41, 188, 155, 13,
-			157, 98, 90, 225, 114, 231, 108, 155, 47, 64, 19, 198, 50, 134,
-			83, 163, 189, 51, 139, 247, 66, 105, 73, 187, 22, 90, 158, 113,
-			223, 242, 128, 14, 213, 64, 132, 126, 202, 13, 225, 29, 215, 157,
-			136, 238, 186, 2, 1, 113, 159, 186, 249, 178, 221, 141, 193, 32,
-			142, 224, 222, 41, 55, 132, 180, 210, 93, 94, 127, 210, 93, 30,
-			75, 46, 124, 230, 21, 113, 159, 146, 129, 67, 54, 230, 113, 26,
-			142, 39, 62, 80, 123, 219, 7, 10, 136, 97, 137, 114, 54, 238,
-			27, 135, 85, 166, 82, 184, 89, 165, 230, 8, 54, 104, 86, 14,
-			134, 183, 65, 241, 188, 89, 52, 97, 238, 135, 17, 238, 99, 19,
-			80, 173, 67, 20, 131, 182, 88, 140, 121, 9, 247, 240, 117, 64,
-			207, 73, 94, 125, 251, 120, 72, 174, 166, 140, 89, 114, 208, 18,
-			19, 179, 94, 143, 103, 125, 110, 2, 247, 78, 249, 254, 237, 102,
-			227, 106, 115, 85, 105, 57, 211, 47, 77, 180, 156, 69, 153, 187,
-			0, 116, 81, 139, 63, 104, 110, 53, 55, 132, 251, 148, 82, 248,
-			24, 236, 22, 99, 160, 49, 165, 52, 8, 140, 125, 151, 142, 211,
-			48, 86, 102, 17, 235, 215, 157, 200, 36, 29, 17, 223, 148, 94,
-			178, 54, 232, 151, 92, 202, 124, 150, 227, 187, 117, 112, 6, 210,
-			58, 137, 172, 14, 24, 142, 109, 243, 38, 151, 50, 175, 227, 12,
-			155, 11, 102, 135, 12, 109, 179, 100, 19, 218, 174, 227, 12, 27,
-			240, 78, 5, 181, 77, 133, 77, 10, 186, 133, 187, 101, 231, 118,
-			108, 105, 203, 248, 117, 108, 105, 235, 232, 60, 44, 134, 221, 135,
-			75, 12, 195, 238, 255, 121, 132, 97, 247, 8, 195, 238, 27, 230,
-			51, 100, 8, 126, 34, 83, 223, 149, 58, 5, 63, 53, 83, 223,
-			205, 99, 117, 83, 223, 35, 157, 138, 236, 139, 241, 238, 232, 207,
-			219, 12, 228, 174, 63, 117, 22, 89, 139, 29, 65, 238, 234, 182,
-			231, 54, 152, 95, 112, 216, 143, 218, 160, 123, 242, 49, 216, 180,
-			96, 192, 113, 108, 120, 12, 51, 138, 67, 148, 8, 236, 187, 254,
-			236, 118, 60, 47, 176, 239, 14, 105, 167, 173, 235, 164, 204, 81,
-			190, 66, 14, 146, 208, 226, 133, 175, 226, 131, 237, 30, 56, 167,
-			218, 26, 164, 221, 161, 76, 183, 2, 105, 119, 8, 239, 87, 32,
-			237, 14, 29, 61, 137, 255, 163, 132, 180, 59, 174, 93, 178, 254,
-			133, 128, 180, 99, 87, 52, 76, 230, 103, 38, 102, 46, 198, 23,
-			99, 210, 160, 28, 183, 52, 214, 168, 219, 183, 217, 82, 250, 166,
-			128, 183, 59, 158, 128, 183, 59, 222, 211, 175, 192, 219, 29, 63,
-			241, 24, 190, 5, 45, 213, 76, 125, 72, 59, 99, 149, 8, 219,
-			146, 67, 110, 123, 255, 144, 157, 125, 86, 82, 64, 187, 112, 40,
-			179, 77, 132, 104, 53, 219, 5, 192, 158, 166, 155, 250, 208, 208,
-			48, 46, 3, 5, 186, 169, 15, 107, 103, 172, 73, 254, 62, 253,
-			53, 12, 245, 57, 89, 59, 157, 242, 195, 178, 118, 58, 235, 135,
-			101, 237, 116, 226, 15, 15, 13, 227, 15, 178, 161, 54, 76, 125,
-			76, 123, 218, 250, 113, 68, 232, 222, 30, 146, 102, 131, 72, 44,
-			139, 118, 164, 14, 105, 66, 146, 151, 218, 165, 183, 61, 255, 110,
-			205, 169, 174, 58, 88, 110, 127, 138, 179, 69, 162, 2, 99, 184,
-			222, 67, 180, 234, 130, 108, 149, 129, 76, 125, 76, 98, 50, 26,
-			154, 169, 143, 245, 18, 17, 210, 77, 125, 236, 244, 101, 124, 1,
-			84, 229, 210, 23, 82, 63, 133, 144, 53, 68, 138, 201, 134, 36,
-			140, 255, 121, 147, 24, 38, 149, 192, 33, 188, 144, 221, 134, 127,
-			83, 130, 204, 95, 210, 76, 235, 35, 155, 128, 204, 119, 64, 148,
-			18, 131, 55, 158, 68, 156, 7, 124, 28, 246, 88, 226, 178, 245,
-			196, 173, 138, 93, 143, 0, 235, 64, 153, 21, 186, 2, 54, 135,
-			156, 131, 133, 56, 242, 102, 46, 60, 120, 24, 96, 250, 75, 28,
-			1, 134, 237, 7, 151, 56, 246, 28, 219, 1, 46, 245, 246, 225,
-			167, 57, 46, 189, 113, 69, 123, 106, 212, 82, 206, 95, 97, 177,
-			21, 99, 38, 200, 166, 16, 225, 253, 168, 5, 44, 254, 138, 172,
-			139, 46, 200, 43, 221, 189, 10, 88, 252, 149, 93, 210, 21, 81,
-			214, 212, 159, 50, 70, 240, 78, 156, 133, 208, 79, 100, 83, 166,
-			254, 84, 186, 128, 27, 28, 75, 222, 24, 215, 38, 70, 173, 101,
-			32, 101, 99, 188, 172, 141, 198, 34, 15, 150, 40, 103, 199, 152,
-			188, 30, 244, 97, 214, 156, 123, 118, 213, 169, 184, 117, 187, 70,
-			119, 148, 192, 174, 68, 204, 85, 125, 12, 66, 63, 46, 73, 167,
-			43, 121, 92, 146, 78, 151, 235, 184, 36, 93, 203, 154, 250, 132,
-			36, 93, 99, 164, 79, 164, 11, 248, 119, 53, 160, 93, 55, 141,
-			105, 109, 102, 216, 250, 53, 102, 185, 5, 125, 37, 239, 146, 121,
-			174, 119, 3, 47, 20, 160, 90, 34, 102, 102, 108, 44, 28, 175,
-			192, 66, 203, 99, 253, 201, 192, 177, 67, 223, 35, 83, 165, 103,
-			38, 73, 110, 206, 175, 59, 76, 40, 120, 145, 28, 207, 157, 164,
-			76, 82, 59, 132, 2, 151, 122, 184, 222, 42, 159, 71, 244, 212,
-			133, 127, 10, 171, 190, 208, 124, 11, 153, 200, 114, 242, 234, 244,
-			53, 178, 26, 216, 245, 186, 29, 119, 177, 60, 165, 197, 172, 230,
-			78, 26, 147, 237, 10, 47, 97, 226, 70, 28, 43, 73, 106, 97,
-			114, 21, 248, 185, 200, 246, 170, 118, 80, 37, 115, 207, 78, 9,
-			4, 146, 186, 125, 15, 48, 9, 132, 218, 69, 205, 241, 86, 153,
-			68, 243, 194, 249, 243, 103, 47, 116, 26, 34, 61, 109, 234, 211,
-			114, 136, 232, 118, 55, 221, 189, 95, 132, 116, 83, 159, 238, 31,
-			16, 161, 172, 169, 207, 24, 121, 62, 68, 58, 27, 162, 153, 244,
-			105, 238, 249, 202, 48, 141, 178, 54, 55, 108, 141, 74, 8, 184,
-			216, 146, 66, 29, 142, 150, 173, 80, 18, 98, 100, 76, 189, 172,
-			17, 17, 66, 166, 94, 62, 114, 76, 132, 116, 83, 47, 159, 28,
-			20, 161, 172, 169, 207, 73, 66, 12, 70, 200, 92, 250, 52, 254,
-			54, 182, 221, 164, 77, 253, 57, 109, 143, 213, 76, 56, 2, 165,
-			4, 197, 120, 8, 226, 41, 114, 121, 189, 149, 215, 0, 232, 21,
-			150, 79, 225, 50, 120, 206, 48, 18, 218, 34, 118, 84, 89, 115,
-			170, 196, 94, 165, 3, 29, 239, 205, 178, 53, 105, 32, 34, 35,
-			66, 200, 212, 159, 235, 18, 51, 63, 173, 155, 250, 115, 187, 118,
-			227, 31, 99, 228, 102, 76, 253, 69, 109, 192, 122, 23, 146, 244,
-			38, 241, 149, 120, 221, 76, 80, 70, 18, 56, 72, 9, 220, 65,
-			230, 99, 73, 158, 29, 74, 63, 211, 182, 39, 80, 93, 56, 186,
-			132, 19, 70, 246, 114, 205, 13, 105, 75, 238, 184, 118, 188, 13,
-			201, 102, 100, 210, 148, 56, 209, 140, 12, 50, 245, 23, 187, 132,
-			59, 178, 140, 110, 234, 47, 30, 232, 199, 54, 180, 162, 203, 212,
-			23, 181, 227, 214, 60, 153, 112, 34, 202, 111, 122, 160, 231, 213,
-			169, 61, 240, 172, 206, 36, 140, 114, 100, 100, 139, 248, 19, 38,
-			86, 32, 128, 36, 49, 93, 105, 90, 135, 32, 166, 11, 153, 250,
-			98, 215, 97, 17, 210, 77, 125, 49, 119, 12, 207, 0, 49, 89,
-			83, 95, 214, 158, 177, 174, 146, 25, 80, 9, 37, 51, 94, 109,
-			157, 169, 141, 69, 110, 157, 110, 178, 157, 229, 165, 228, 174, 29,
-			50, 205, 162, 24, 217, 135, 21, 159, 205, 208, 18, 251, 69, 8,
-			153, 250, 242, 192, 164, 8, 233, 166, 190, 124, 227, 13, 204, 223,
-			107, 74, 235, 54, 117, 87, 27, 181, 126, 78, 227, 190, 195, 90,
-			112, 118, 148, 189, 158, 214, 198, 100, 137, 204, 234, 132, 12, 178,
-			103, 136, 161, 2, 189, 185, 68, 110, 141, 4, 206, 112, 188, 213,
-			48, 163, 70, 198, 207, 73, 77, 131, 192, 169, 54, 43, 78, 85,
-			188, 190, 51, 173, 70, 54, 188, 178, 15, 133, 57, 82, 140, 99,
-			164, 192, 41, 148, 38, 72, 228, 212, 106, 161, 138, 207, 73, 175,
-			66, 160, 167, 134, 59, 228, 231, 182, 177, 124, 148, 249, 171, 48,
-			12, 51, 135, 90, 10, 137, 231, 200, 7, 119, 9, 183, 84, 175,
-			55, 65, 56, 201, 48, 132, 88, 187, 99, 244, 207, 148, 214, 157,
-			161, 253, 38, 246, 154, 110, 100, 234, 238, 193, 211, 34, 164, 155,
-			186, 91, 24, 193, 111, 130, 14, 198, 166, 81, 215, 188, 81, 107,
-			182, 21, 208, 19, 4, 129, 244, 32, 7, 185, 136, 4, 37, 115,
-			170, 28, 123, 36, 126, 195, 82, 85, 210, 163, 24, 75, 154, 213,
-			134, 51, 166, 94, 215, 196, 193, 132, 145, 169, 215, 247, 12, 137,
-			144, 110, 234, 245, 124, 65, 132, 178, 166, 238, 201, 67, 11, 179,
-			141, 200, 75, 23, 112, 1, 8, 237, 49, 141, 87, 180, 96, 212,
-			34, 241, 204, 147, 60, 156, 50, 246, 178, 226, 158, 140, 169, 191,
-			34, 39, 89, 15, 50, 245, 87, 6, 78, 138, 144, 110, 234, 175,
-			156, 18, 29, 210, 147, 53, 245, 64, 86, 220, 195, 42, 14, 210,
-			5, 252, 11, 108, 14, 110, 51, 141, 117, 237, 213, 81, 235, 61,
-			108, 18, 194, 235, 54, 71, 144, 229, 243, 45, 222, 176, 216, 141,
-			161, 162, 64, 22, 2, 76, 161, 124, 167, 230, 39, 22, 83, 74,
-			58, 201, 100, 78, 39, 201, 32, 0, 53, 210, 179, 15, 43, 8,
-			169, 28, 37, 85, 214, 210, 186, 189, 18, 55, 10, 157, 218, 202,
-			144, 168, 149, 21, 42, 228, 7, 180, 118, 1, 141, 148, 167, 172,
-			6, 232, 188, 186, 161, 192, 182, 5, 187, 180, 186, 203, 85, 7,
-			248, 57, 24, 8, 77, 67, 143, 233, 66, 54, 153, 86, 53, 189,
-			45, 74, 104, 223, 170, 83, 115, 152, 70, 17, 204, 189, 179, 163,
-			194, 88, 148, 245, 230, 182, 180, 169, 175, 203, 35, 112, 27, 50,
-			245, 245, 110, 49, 252, 219, 116, 83, 95, 223, 39, 14, 196, 109,
-			89, 83, 127, 85, 246, 251, 54, 214, 239, 175, 166, 11, 248, 187,
-			217, 86, 190, 221, 52, 222, 170, 189, 109, 212, 122, 51, 3, 64,
-			162, 59, 9, 12, 187, 237, 17, 41, 195, 231, 239, 132, 116, 2,
-			72, 133, 214, 98, 219, 119, 12, 167, 190, 183, 206, 83, 251, 252,
-			28, 179, 1, 231, 172, 227, 252, 102, 41, 101, 163, 182, 103, 76,
-			253, 173, 114, 50, 109, 71, 166, 254, 214, 129, 11, 34, 164, 155,
-			250, 91, 159, 184, 36, 66, 89, 83, 127, 155, 108, 212, 118, 214,
-			168, 183, 165, 11, 248, 123, 116, 104, 212, 14, 51, 243, 93, 72,
-			123, 39, 26, 181, 254, 86, 139, 219, 165, 204, 41, 113, 152, 182,
-			183, 242, 235, 219, 178, 111, 196, 76, 85, 119, 130, 111, 234, 169,
-			202, 94, 135, 119, 164, 77, 227, 187, 132, 117, 125, 74, 219, 129,
-			104, 176, 251, 168, 8, 234, 52, 120, 98, 80, 4, 179, 166, 241,
-			78, 100, 140, 224, 94, 24, 217, 29, 48, 178, 198, 59, 81, 186,
-			128, 207, 195, 200, 238, 52, 51, 223, 139, 180, 119, 161, 81, 235,
-			248, 6, 91, 84, 203, 97, 200, 202, 221, 153, 49, 141, 239, 69,
-			90, 191, 8, 34, 26, 28, 200, 139, 160, 78, 131, 35, 103, 68,
-			48, 107, 26, 239, 138, 137, 216, 201, 136, 120, 23, 37, 98, 31,
-			189, 41, 118, 155, 198, 247, 33, 109, 71, 14, 147, 178, 83, 247,
-			239, 72, 60, 137, 84, 119, 138, 126, 233, 97, 120, 73, 41, 192,
-			147, 80, 131, 26, 15, 254, 83, 182, 227, 245, 154, 153, 31, 70,
-			218, 187, 209, 168, 245, 143, 181, 45, 180, 133, 153, 89, 220, 181,
-			215, 25, 147, 10, 32, 65, 85, 110, 36, 197, 89, 59, 201, 251,
-			209, 219, 53, 8, 75, 242, 4, 204, 255, 5, 166, 16, 104, 88,
-			10, 109, 115, 159, 180, 60, 52, 99, 144, 219, 137, 247, 161, 2,
-			25, 188, 202, 12, 132, 2, 59, 140, 242, 140, 12, 193, 0, 181,
-			112, 106, 160, 82, 56, 61, 51, 207, 203, 198, 92, 243, 50, 73,
-			21, 160, 162, 202, 148, 92, 21, 141, 11, 95, 233, 98, 25, 194,
-			130, 247, 161, 83, 78, 14, 93, 111, 198, 52, 126, 56, 30, 186,
-			94, 68, 131, 3, 23, 68, 80, 167, 193, 39, 46, 137, 96, 214,
-			52, 222, 29, 15, 93, 47, 27, 186, 119, 211, 161, 251, 115, 182,
-			223, 245, 153, 198, 79, 32, 109, 151, 245, 123, 136, 175, 205, 53,
-			167, 114, 59, 108, 214, 5, 171, 210, 140, 226, 51, 22, 108, 191,
-			131, 36, 31, 202, 22, 149, 191, 194, 118, 1, 161, 95, 201, 152,
-			112, 110, 62, 145, 84, 17, 141, 17, 183, 192, 154, 23, 214, 90,
-			172, 141, 201, 80, 166, 64, 53, 111, 56, 242, 135, 33, 3, 171,
-			130, 227, 244, 52, 2, 191, 226, 56, 85, 80, 52, 191, 255, 125,
-			255, 252, 57, 217, 111, 125, 105, 104, 169, 88, 119, 125, 128, 22,
-			215, 189, 93, 4, 1, 43, 174, 215, 196, 55, 193, 239, 66, 230,
-			189, 40, 245, 31, 16, 178, 158, 34, 237, 111, 143, 194, 67, 46,
-			3, 95, 102, 200, 236, 42, 15, 195, 0, 46, 56, 15, 222, 195,
-			28, 45, 24, 239, 69, 89, 75, 122, 90, 48, 222, 135, 180, 65,
-			238, 16, 78, 150, 2, 42, 118, 27, 225, 92, 111, 23, 158, 22,
-			104, 86, 25, 4, 96, 181, 158, 189, 34, 8, 176, 106, 251, 142,
-			138, 32, 128, 170, 157, 56, 137, 175, 210, 90, 245, 148, 153, 249,
-			57, 164, 253, 123, 164, 115, 49, 134, 172, 215, 222, 0, 126, 61,
-			209, 12, 40, 18, 240, 19, 127, 14, 225, 61, 248, 10, 206, 208,
-			32, 109, 202, 7, 145, 113, 192, 42, 108, 128, 158, 184, 81, 115,
-			118, 226, 46, 150, 31, 144, 222, 12, 37, 2, 32, 213, 56, 68,
-			33, 226, 16, 133, 31, 68, 251, 246, 211, 233, 202, 234, 68, 166,
-			241, 255, 71, 198, 62, 235, 119, 80, 226, 114, 200, 132, 113, 45,
-			104, 184, 177, 112, 157, 51, 74, 11, 124, 239, 240, 61, 226, 220,
-			113, 130, 117, 21, 44, 50, 104, 122, 9, 227, 59, 50, 76, 230,
-			184, 78, 111, 208, 148, 40, 160, 73, 252, 67, 192, 10, 15, 92,
-			48, 139, 135, 29, 170, 238, 68, 5, 37, 231, 138, 93, 11, 91,
-			179, 38, 128, 63, 219, 51, 203, 166, 163, 52, 180, 52, 27, 71,
-			64, 211, 187, 205, 56, 66, 167, 17, 123, 246, 226, 175, 104, 188,
-			115, 52, 211, 248, 55, 200, 120, 204, 250, 51, 173, 133, 125, 81,
-			234, 135, 154, 236, 170, 19, 163, 25, 22, 161, 197, 112, 33, 168,
-			192, 150, 230, 174, 40, 61, 169, 164, 141, 229, 75, 110, 32, 74,
-			30, 164, 235, 21, 147, 87, 157, 192, 39, 157, 222, 203, 193, 86,
-			11, 68, 33, 173, 68, 120, 116, 8, 120, 201, 67, 49, 25, 11,
-			37, 134, 46, 6, 126, 139, 35, 31, 192, 252, 36, 152, 39, 3,
-			147, 177, 35, 114, 23, 144, 128, 99, 244, 237, 74, 123, 123, 88,
-			65, 161, 31, 8, 68, 71, 181, 3, 124, 128, 83, 168, 56, 128,
-			164, 184, 65, 206, 53, 255, 46, 247, 40, 32, 178, 66, 135, 170,
-			136, 246, 98, 40, 180, 12, 244, 252, 193, 56, 2, 209, 136, 67,
-			99, 113, 132, 78, 35, 206, 95, 192, 13, 62, 86, 186, 105, 252,
-			18, 50, 158, 176, 150, 182, 48, 84, 174, 247, 96, 36, 202, 57,
-			150, 32, 82, 207, 64, 149, 49, 145, 58, 162, 17, 135, 206, 197,
-			17, 64, 212, 99, 143, 227, 109, 224, 162, 37, 243, 203, 40, 245,
-			171, 8, 193, 46, 70, 27, 244, 203, 40, 187, 23, 47, 113, 47,
-			45, 153, 143, 32, 237, 87, 208, 176, 53, 219, 230, 168, 5, 206,
-			235, 45, 251, 101, 105, 19, 227, 194, 110, 3, 14, 90, 140, 143,
-			136, 13, 27, 60, 180, 24, 31, 17, 27, 54, 184, 104, 49, 62,
-			130, 122, 251, 68, 48, 107, 26, 191, 130, 140, 60, 28, 116, 52,
-			8, 7, 221, 175, 160, 244, 105, 104, 138, 110, 102, 62, 138, 82,
-			255, 59, 111, 10, 109, 246, 71, 81, 118, 63, 94, 228, 190, 80,
-			50, 191, 142, 180, 143, 161, 97, 107, 134, 97, 74, 129, 58, 2,
-			7, 70, 39, 254, 93, 143, 35, 149, 86, 40, 63, 91, 17, 250,
-			143, 226, 25, 104, 51, 79, 169, 64, 28, 184, 57, 49, 126, 93,
-			180, 4, 252, 156, 24, 191, 142, 186, 119, 138, 160, 78, 131, 230,
-			46, 17, 204, 154, 198, 199, 68, 75, 116, 209, 146, 143, 137, 150,
-			24, 102, 230, 191, 160, 212, 111, 242, 150, 24, 200, 52, 254, 11,
-			202, 30, 192, 22, 247, 63, 98, 252, 6, 210, 44, 107, 155, 148,
-			176, 10, 190, 19, 252, 129, 208, 143, 50, 152, 161, 193, 158, 157,
-			34, 8, 176, 105, 189, 123, 68, 80, 167, 193, 253, 7, 160, 202,
-			180, 153, 249, 36, 74, 253, 87, 94, 37, 3, 55, 203, 30, 192,
-			203, 220, 127, 67, 230, 83, 72, 251, 29, 52, 108, 149, 149, 206,
-			83, 205, 8, 132, 213, 100, 236, 60, 98, 217, 137, 47, 206, 247,
-			239, 63, 240, 27, 96, 124, 74, 244, 31, 56, 14, 48, 62, 37,
-			250, 15, 60, 7, 24, 159, 18, 253, 151, 134, 254, 251, 29, 209,
-			127, 105, 209, 127, 191, 67, 251, 239, 56, 119, 45, 144, 249, 125,
-			164, 125, 26, 13, 91, 123, 98, 65, 52, 229, 16, 129, 36, 89,
-			41, 202, 152, 198, 239, 35, 45, 35, 130, 136, 6, 187, 122, 68,
-			80, 167, 193, 29, 130, 6, 148, 53, 141, 79, 199, 149, 50, 185,
-			189, 241, 105, 49, 104, 25, 51, 243, 135, 40, 245, 121, 222, 131,
-			25, 100, 26, 127, 72, 123, 240, 135, 17, 71, 82, 203, 124, 22,
-			105, 127, 130, 134, 173, 239, 64, 9, 138, 24, 195, 36, 36, 195,
-			52, 246, 100, 72, 150, 232, 74, 91, 138, 141, 244, 132, 248, 153,
-			159, 190, 28, 2, 204, 247, 111, 171, 37, 60, 248, 250, 203, 192,
-			12, 249, 172, 232, 0, 134, 203, 246, 89, 209, 1, 12, 151, 237,
-			179, 162, 3, 50, 208, 235, 127, 34, 58, 32, 35, 122, 253, 79,
-			104, 7, 12, 11, 220, 182, 63, 67, 90, 158, 163, 63, 9, 112,
-			26, 97, 141, 163, 180, 149, 163, 140, 101, 32, 125, 191, 130, 65,
-			246, 103, 104, 224, 164, 130, 65, 246, 103, 232, 212, 105, 252, 191,
-			176, 30, 212, 76, 227, 47, 145, 102, 90, 63, 199, 250, 175, 210,
-			12, 96, 18, 58, 145, 189, 170, 238, 73, 28, 51, 220, 99, 31,
-			92, 5, 198, 6, 224, 111, 133, 29, 144, 0, 159, 223, 184, 160,
-			188, 180, 74, 148, 136, 219, 53, 191, 114, 155, 23, 100, 123, 164,
-			120, 117, 166, 60, 63, 57, 193, 85, 147, 99, 35, 33, 225, 114,
-			158, 183, 67, 75, 3, 225, 2, 105, 141, 118, 210, 95, 138, 45,
-			46, 3, 39, 198, 95, 210, 45, 110, 27, 3, 90, 251, 2, 74,
-			253, 45, 159, 66, 93, 128, 233, 149, 221, 143, 95, 230, 96, 97,
-			153, 47, 34, 237, 175, 208, 176, 245, 45, 66, 116, 198, 153, 212,
-			14, 0, 119, 170, 92, 86, 130, 121, 51, 133, 5, 76, 114, 210,
-			65, 44, 160, 222, 73, 108, 251, 92, 65, 69, 23, 251, 162, 160,
-			153, 161, 139, 125, 81, 44, 70, 134, 46, 246, 69, 177, 24, 187,
-			96, 90, 252, 149, 152, 22, 93, 98, 90, 252, 21, 157, 22, 255,
-			28, 113, 252, 177, 204, 87, 144, 246, 55, 104, 216, 122, 15, 34,
-			165, 234, 38, 116, 199, 126, 136, 90, 93, 166, 128, 132, 57, 1,
-			209, 207, 13, 32, 42, 107, 14, 200, 138, 73, 60, 219, 223, 236,
-			53, 235, 14, 243, 206, 250, 90, 158, 217, 12, 198, 0, 254, 45,
-			153, 148, 164, 5, 21, 242, 236, 43, 113, 243, 233, 196, 252, 10,
-			234, 238, 81, 32, 207, 190, 34, 86, 69, 23, 108, 11, 127, 19,
-			55, 159, 111, 11, 127, 35, 182, 133, 172, 153, 249, 123, 4, 90,
-			63, 116, 76, 179, 200, 52, 254, 158, 110, 11, 116, 76, 179, 116,
-			47, 255, 42, 210, 6, 172, 55, 198, 123, 57, 169, 248, 1, 179,
-			165, 175, 114, 25, 175, 98, 125, 197, 174, 158, 15, 179, 212, 179,
-			112, 54, 124, 85, 156, 13, 89, 24, 226, 175, 10, 245, 246, 44,
-			12, 241, 87, 133, 122, 123, 22, 134, 248, 171, 170, 122, 251, 239,
-			29, 199, 135, 90, 181, 202, 171, 194, 53, 212, 6, 72, 107, 23,
-			113, 118, 130, 39, 49, 247, 227, 174, 208, 169, 248, 94, 53, 100,
-			62, 90, 203, 34, 104, 238, 198, 105, 207, 246, 252, 16, 84, 227,
-			210, 101, 22, 184, 250, 237, 27, 64, 176, 109, 23, 37, 10, 4,
-			182, 51, 91, 68, 96, 19, 196, 62, 16, 0, 219, 167, 143, 226,
-			140, 105, 28, 74, 133, 143, 240, 215, 30, 225, 175, 61, 194, 95,
-			123, 132, 191, 246, 8, 127, 237, 17, 254, 218, 235, 137, 191, 38,
-			97, 209, 232, 79, 129, 191, 38, 53, 55, 233, 79, 129, 191, 38,
-			145, 218, 142, 75, 164, 182, 19, 169, 130, 64, 106, 163, 63, 5,
-			254, 154, 68, 106, 59, 41, 145, 218, 6, 99, 164, 54, 250, 243,
-			39, 123, 65, 169, 204, 88, 73, 133, 200, 250, 255, 245, 146, 34,
-			17, 167, 110, 11, 248, 26, 232, 181, 229, 153, 31, 197, 97, 174,
-			197, 18, 54, 108, 102, 104, 238, 214, 29, 213, 34, 149, 227, 227,
-			86, 252, 166, 199, 32, 219, 216, 249, 15, 27, 230, 74, 96, 87,
-			226, 99, 65, 124, 136, 8, 240, 2, 16, 196, 112, 219, 100, 70,
-			255, 5, 142, 120, 238, 122, 204, 8, 221, 97, 5, 50, 115, 243,
-			154, 227, 85, 237, 128, 57, 104, 244, 189, 138, 211, 136, 232, 54,
-			125, 219, 33, 185, 170, 189, 158, 3, 113, 63, 229, 127, 163, 181,
-			156, 40, 70, 241, 255, 41, 205, 49, 164, 119, 65, 216, 173, 193,
-			75, 23, 64, 16, 44, 59, 209, 93, 199, 241, 48, 137, 238, 170,
-			169, 133, 169, 60, 109, 160, 236, 42, 120, 63, 138, 132, 247, 58,
-			187, 74, 183, 114, 63, 32, 97, 115, 57, 2, 237, 158, 42, 102,
-			234, 4, 118, 92, 80, 129, 148, 129, 99, 96, 0, 98, 129, 127,
-			207, 165, 199, 65, 109, 157, 156, 30, 62, 51, 154, 31, 29, 29,
-			37, 235, 142, 29, 112, 180, 159, 73, 166, 25, 21, 98, 44, 126,
-			146, 51, 23, 201, 56, 147, 231, 199, 100, 48, 56, 108, 149, 92,
-			56, 247, 26, 161, 211, 172, 250, 112, 248, 22, 248, 33, 29, 183,
-			7, 220, 179, 145, 39, 73, 161, 80, 184, 212, 250, 205, 241, 170,
-			137, 47, 178, 34, 193, 97, 137, 175, 236, 179, 100, 18, 197, 176,
-			62, 73, 75, 144, 161, 97, 86, 151, 8, 95, 106, 201, 4, 19,
-			128, 103, 97, 191, 69, 6, 8, 137, 74, 220, 21, 50, 216, 86,
-			209, 101, 50, 74, 78, 156, 104, 45, 235, 10, 25, 29, 138, 141,
-			112, 219, 50, 157, 86, 77, 193, 91, 178, 14, 63, 73, 206, 140,
-			138, 255, 132, 81, 56, 113, 184, 244, 183, 157, 128, 43, 29, 9,
-			184, 188, 57, 1, 195, 155, 16, 112, 186, 19, 1, 202, 240, 143,
-			197, 195, 31, 143, 23, 140, 127, 28, 60, 29, 15, 216, 131, 207,
-			130, 13, 199, 122, 227, 57, 194, 62, 169, 67, 254, 100, 114, 200,
-			201, 233, 182, 78, 184, 20, 103, 18, 19, 64, 25, 116, 53, 67,
-			219, 44, 136, 243, 36, 251, 57, 49, 231, 212, 46, 142, 51, 116,
-			236, 221, 120, 120, 227, 132, 87, 212, 132, 27, 212, 113, 186, 115,
-			29, 29, 167, 144, 50, 130, 103, 55, 90, 192, 85, 59, 114, 232,
-			142, 90, 160, 255, 84, 157, 26, 92, 49, 200, 236, 122, 180, 198,
-			184, 41, 250, 95, 68, 59, 189, 61, 225, 96, 213, 94, 15, 159,
-			60, 155, 39, 117, 215, 107, 70, 78, 248, 228, 153, 209, 161, 228,
-			50, 35, 79, 202, 218, 6, 91, 62, 21, 174, 5, 126, 125, 94,
-			22, 21, 85, 135, 98, 208, 138, 155, 118, 163, 225, 122, 171, 49,
-			36, 1, 191, 233, 176, 139, 185, 164, 31, 236, 195, 219, 0, 43,
-			152, 125, 126, 96, 43, 122, 5, 30, 230, 96, 123, 121, 69, 162,
-			200, 19, 2, 214, 8, 231, 158, 195, 230, 202, 138, 123, 143, 228,
-			194, 28, 25, 228, 15, 15, 32, 65, 96, 93, 63, 196, 140, 39,
-			64, 242, 227, 84, 0, 15, 158, 63, 109, 114, 164, 254, 248, 136,
-			225, 119, 159, 248, 148, 9, 133, 138, 61, 144, 137, 229, 185, 100,
-			215, 68, 150, 22, 56, 200, 179, 242, 180, 130, 146, 70, 19, 101,
-			41, 216, 155, 162, 237, 110, 162, 163, 104, 87, 228, 206, 134, 57,
-			16, 67, 211, 209, 79, 28, 138, 103, 148, 194, 120, 89, 0, 79,
-			18, 147, 216, 169, 180, 130, 152, 93, 103, 104, 185, 180, 156, 150,
-			82, 49, 169, 187, 149, 32, 89, 238, 86, 139, 61, 19, 230, 64,
-			117, 92, 40, 143, 175, 100, 123, 241, 31, 72, 229, 241, 151, 181,
-			221, 214, 39, 16, 153, 99, 218, 238, 162, 82, 161, 221, 166, 176,
-			5, 5, 114, 147, 222, 180, 150, 29, 54, 183, 135, 207, 158, 57,
-			159, 63, 255, 216, 5, 122, 192, 209, 255, 65, 23, 227, 116, 75,
-			36, 67, 14, 8, 225, 145, 126, 218, 143, 156, 139, 180, 212, 208,
-			33, 203, 126, 211, 227, 168, 254, 242, 41, 155, 150, 122, 17, 147,
-			11, 163, 148, 136, 145, 186, 235, 145, 83, 52, 80, 119, 189, 145,
-			181, 128, 156, 34, 99, 231, 200, 90, 48, 82, 181, 215, 201, 41,
-			114, 246, 194, 249, 194, 216, 121, 80, 223, 24, 161, 135, 43, 57,
-			197, 86, 40, 59, 105, 85, 69, 242, 151, 181, 46, 69, 145, 252,
-			229, 236, 78, 69, 145, 252, 101, 115, 23, 254, 118, 157, 107, 146,
-			235, 129, 102, 90, 127, 173, 137, 142, 72, 48, 55, 54, 239, 151,
-			36, 119, 163, 48, 55, 106, 127, 225, 184, 195, 196, 106, 10, 185,
-			87, 46, 186, 96, 124, 207, 145, 165, 5, 9, 94, 75, 168, 250,
-			143, 98, 178, 196, 199, 65, 72, 147, 65, 120, 201, 33, 217, 238,
-			192, 21, 207, 115, 86, 109, 248, 189, 4, 4, 45, 9, 157, 32,
-			58, 209, 197, 54, 192, 52, 61, 149, 10, 65, 14, 23, 56, 224,
-			186, 209, 247, 134, 225, 101, 144, 189, 225, 139, 231, 195, 68, 105,
-			236, 106, 189, 28, 155, 76, 0, 110, 9, 229, 31, 9, 195, 97,
-			106, 165, 179, 117, 138, 60, 241, 196, 19, 121, 254, 63, 155, 30,
-			74, 132, 50, 53, 84, 101, 252, 64, 142, 23, 162, 99, 146, 221,
-			174, 40, 227, 7, 189, 125, 82, 180, 245, 221, 67, 248, 137, 45,
-			226, 33, 0, 240, 198, 29, 39, 168, 186, 149, 104, 67, 0, 135,
-			251, 200, 201, 238, 143, 206, 112, 110, 139, 212, 112, 104, 72, 14,
-			207, 240, 183, 26, 238, 153, 119, 194, 232, 22, 35, 207, 220, 135,
-			187, 128, 92, 105, 18, 157, 161, 193, 82, 213, 60, 130, 183, 113,
-			191, 93, 139, 107, 118, 184, 198, 76, 82, 203, 61, 60, 238, 134,
-			29, 174, 153, 71, 241, 118, 215, 187, 195, 81, 163, 36, 104, 67,
-			121, 91, 28, 89, 170, 154, 151, 112, 134, 233, 50, 131, 117, 243,
-			142, 177, 14, 214, 147, 10, 61, 115, 144, 180, 204, 179, 152, 69,
-			188, 3, 220, 131, 196, 38, 210, 233, 251, 154, 72, 111, 151, 57,
-			192, 218, 186, 132, 119, 49, 100, 199, 69, 251, 206, 234, 162, 232,
-			100, 64, 118, 232, 25, 59, 208, 86, 142, 88, 65, 229, 62, 150,
-			171, 120, 103, 85, 74, 39, 175, 224, 30, 38, 171, 171, 185, 97,
-			20, 238, 239, 2, 219, 231, 129, 14, 102, 172, 50, 81, 89, 205,
-			112, 234, 101, 220, 75, 155, 90, 6, 40, 61, 214, 82, 51, 135,
-			15, 205, 79, 206, 205, 47, 150, 39, 231, 22, 166, 230, 23, 231,
-			230, 139, 243, 11, 115, 139, 11, 211, 112, 55, 191, 86, 154, 156,
-			232, 77, 153, 89, 108, 204, 22, 231, 230, 122, 17, 253, 117, 173,
-			88, 154, 234, 213, 204, 110, 156, 30, 47, 23, 231, 110, 244, 234,
-			244, 39, 60, 50, 244, 26, 244, 251, 220, 51, 165, 217, 222, 244,
-			169, 239, 69, 184, 175, 173, 95, 205, 163, 248, 48, 212, 118, 107,
-			178, 60, 81, 26, 223, 160, 186, 29, 24, 47, 76, 79, 62, 63,
-			59, 57, 62, 63, 57, 209, 139, 205, 253, 120, 119, 28, 158, 122,
-			97, 145, 214, 48, 59, 57, 209, 187, 155, 214, 124, 109, 170, 248,
-			204, 11, 189, 135, 104, 166, 201, 231, 103, 166, 39, 203, 69, 154,
-			105, 208, 220, 134, 179, 178, 136, 177, 83, 115, 24, 207, 221, 118,
-			27, 101, 176, 205, 48, 251, 241, 62, 90, 198, 98, 121, 178, 56,
-			55, 51, 221, 82, 251, 32, 62, 86, 92, 152, 159, 185, 89, 156,
-			47, 141, 23, 167, 166, 94, 88, 156, 40, 205, 21, 175, 78, 77,
-			78, 44, 94, 155, 41, 47, 210, 234, 64, 42, 208, 139, 30, 206,
-			192, 246, 223, 29, 193, 25, 211, 216, 145, 122, 245, 145, 125, 237,
-			35, 251, 218, 111, 148, 125, 237, 96, 108, 95, 59, 20, 219, 215,
-			94, 225, 98, 148, 189, 177, 104, 134, 254, 252, 62, 4, 142, 221,
-			140, 129, 212, 37, 100, 125, 43, 34, 108, 169, 50, 46, 0, 188,
-			216, 49, 232, 77, 233, 193, 205, 38, 117, 23, 222, 18, 253, 21,
-			2, 91, 15, 251, 94, 93, 22, 91, 41, 43, 128, 33, 44, 195,
-			171, 16, 204, 234, 200, 7, 60, 196, 59, 14, 179, 66, 138, 133,
-			34, 181, 117, 38, 222, 16, 207, 9, 148, 109, 75, 83, 222, 101,
-			32, 189, 7, 191, 141, 59, 126, 211, 137, 118, 220, 10, 4, 109,
-			160, 178, 227, 71, 49, 34, 35, 3, 100, 20, 200, 190, 156, 59,
-			180, 43, 81, 211, 174, 169, 109, 8, 47, 49, 197, 193, 144, 113,
-			247, 73, 136, 76, 9, 242, 234, 133, 78, 164, 234, 63, 51, 223,
-			113, 58, 97, 230, 68, 204, 117, 156, 78, 142, 30, 195, 131, 220,
-			115, 156, 126, 84, 235, 177, 250, 153, 162, 40, 173, 140, 25, 179,
-			217, 2, 210, 87, 150, 130, 32, 105, 70, 132, 52, 83, 63, 218,
-			141, 241, 79, 35, 238, 59, 78, 31, 212, 122, 172, 31, 68, 29,
-			202, 89, 177, 185, 225, 195, 28, 51, 0, 9, 99, 169, 15, 60,
-			75, 240, 105, 65, 243, 112, 67, 221, 32, 128, 11, 10, 128, 92,
-			179, 81, 171, 133, 62, 229, 167, 66, 119, 185, 6, 90, 160, 60,
-			127, 91, 38, 152, 193, 124, 160, 87, 106, 246, 109, 71, 146, 79,
-			91, 58, 40, 201, 7, 130, 187, 49, 254, 16, 226, 238, 236, 244,
-			97, 109, 155, 245, 83, 157, 200, 175, 4, 54, 88, 5, 85, 155,
-			236, 146, 4, 94, 90, 217, 219, 0, 77, 236, 55, 163, 138, 95,
-			119, 4, 21, 130, 75, 186, 216, 177, 125, 117, 152, 76, 148, 175,
-			19, 239, 28, 12, 241, 90, 109, 177, 104, 215, 105, 200, 172, 54,
-			78, 182, 5, 44, 113, 25, 231, 149, 230, 150, 184, 184, 7, 175,
-			112, 111, 123, 250, 25, 109, 155, 245, 66, 135, 150, 192, 253, 222,
-			169, 178, 106, 232, 44, 180, 151, 125, 112, 143, 206, 85, 66, 87,
-			92, 207, 13, 215, 152, 70, 104, 145, 48, 92, 42, 194, 44, 2,
-			47, 2, 139, 236, 55, 99, 26, 12, 68, 43, 18, 52, 24, 154,
-			169, 159, 193, 61, 248, 103, 52, 238, 198, 79, 191, 168, 245, 88,
-			63, 164, 181, 80, 81, 117, 171, 208, 104, 238, 234, 182, 32, 47,
-			229, 76, 77, 112, 30, 192, 134, 121, 255, 138, 125, 57, 169, 45,
-			36, 203, 10, 243, 0, 252, 199, 214, 2, 141, 165, 155, 54, 123,
-			104, 202, 39, 26, 215, 8, 156, 186, 29, 53, 3, 135, 171, 155,
-			39, 42, 0, 71, 19, 113, 153, 52, 35, 45, 39, 188, 237, 54,
-			26, 48, 103, 135, 227, 22, 172, 217, 129, 71, 55, 201, 164, 63,
-			193, 24, 142, 57, 57, 43, 161, 189, 220, 87, 102, 162, 72, 222,
-			99, 105, 68, 251, 72, 204, 199, 180, 102, 234, 23, 187, 49, 126,
-			19, 6, 167, 135, 79, 165, 166, 145, 85, 110, 223, 204, 4, 91,
-			188, 229, 221, 236, 22, 99, 56, 89, 65, 124, 119, 162, 11, 249,
-			169, 244, 94, 92, 197, 70, 26, 209, 221, 233, 170, 118, 194, 122,
-			174, 165, 10, 118, 153, 160, 99, 181, 198, 80, 192, 221, 144, 91,
-			213, 9, 43, 0, 129, 107, 13, 27, 150, 112, 167, 200, 205, 179,
-			120, 33, 220, 174, 36, 13, 58, 181, 250, 85, 237, 136, 8, 105,
-			166, 126, 245, 216, 113, 60, 15, 20, 32, 83, 159, 208, 76, 235,
-			122, 220, 211, 130, 134, 53, 216, 41, 165, 115, 91, 6, 182, 10,
-			151, 171, 90, 77, 108, 138, 204, 83, 135, 231, 220, 107, 56, 21,
-			105, 65, 148, 6, 87, 160, 250, 132, 182, 77, 132, 52, 83, 159,
-			216, 217, 135, 223, 4, 53, 106, 166, 126, 77, 27, 176, 158, 253,
-			186, 212, 88, 91, 111, 25, 91, 4, 123, 205, 53, 109, 175, 8,
-			209, 234, 14, 244, 227, 10, 212, 173, 155, 122, 73, 219, 110, 221,
-			122, 128, 186, 1, 195, 215, 143, 214, 136, 168, 146, 61, 57, 75,
-			10, 176, 160, 76, 18, 64, 55, 136, 18, 95, 156, 8, 54, 136,
-			18, 222, 134, 175, 2, 1, 134, 169, 63, 163, 153, 214, 249, 206,
-			4, 208, 235, 39, 191, 119, 178, 143, 42, 57, 178, 124, 186, 248,
-			159, 145, 157, 75, 23, 255, 51, 59, 251, 112, 25, 202, 79, 155,
-			250, 77, 173, 215, 154, 252, 26, 58, 183, 109, 48, 233, 98, 185,
-			169, 201, 144, 102, 234, 55, 183, 239, 196, 255, 130, 158, 253, 154,
-			105, 204, 165, 222, 132, 172, 159, 69, 228, 166, 93, 89, 115, 61,
-			39, 118, 200, 204, 173, 153, 185, 67, 7, 222, 28, 177, 250, 233,
-			30, 17, 175, 243, 45, 172, 167, 152, 7, 255, 218, 184, 3, 58,
-			59, 230, 210, 59, 241, 71, 232, 217, 163, 209, 5, 248, 188, 118,
-			200, 250, 48, 34, 180, 2, 65, 115, 39, 38, 1, 150, 157, 250,
-			56, 228, 169, 199, 61, 87, 179, 84, 12, 123, 5, 32, 174, 231,
-			123, 195, 180, 165, 124, 155, 228, 96, 228, 108, 53, 59, 97, 129,
-			208, 198, 87, 108, 143, 157, 178, 146, 5, 89, 161, 249, 164, 42,
-			42, 123, 56, 23, 121, 48, 243, 126, 193, 199, 7, 116, 74, 245,
-			231, 181, 3, 34, 164, 153, 250, 243, 3, 7, 241, 247, 176, 6,
-			34, 83, 127, 73, 59, 109, 189, 70, 38, 220, 144, 14, 75, 85,
-			177, 61, 170, 209, 30, 242, 98, 159, 25, 128, 250, 10, 3, 5,
-			67, 35, 213, 149, 185, 113, 10, 141, 12, 49, 247, 90, 13, 94,
-			211, 11, 152, 44, 136, 118, 142, 211, 203, 140, 51, 51, 71, 198,
-			159, 229, 30, 140, 197, 134, 36, 9, 69, 64, 204, 9, 17, 210,
-			76, 253, 165, 161, 83, 24, 179, 167, 184, 37, 122, 175, 17, 98,
-			183, 165, 236, 46, 236, 9, 169, 91, 69, 219, 99, 217, 100, 161,
-			13, 38, 128, 119, 14, 173, 76, 140, 15, 199, 188, 102, 194, 23,
-			198, 155, 217, 97, 231, 205, 153, 93, 101, 11, 92, 130, 144, 0,
-			84, 168, 36, 0, 21, 42, 18, 41, 32, 165, 155, 122, 101, 215,
-			110, 124, 82, 136, 193, 86, 52, 203, 178, 96, 169, 173, 217, 225,
-			154, 32, 136, 203, 25, 18, 162, 154, 149, 4, 110, 194, 74, 247,
-			30, 69, 84, 179, 178, 159, 169, 161, 2, 35, 247, 178, 214, 111,
-			45, 64, 145, 165, 9, 217, 66, 233, 222, 36, 22, 79, 180, 28,
-			120, 98, 149, 47, 59, 53, 223, 91, 5, 93, 68, 122, 42, 96,
-			226, 122, 204, 236, 55, 1, 133, 240, 114, 2, 10, 225, 229, 238,
-			189, 10, 20, 194, 203, 7, 44, 110, 54, 170, 155, 122, 93, 59,
-			108, 29, 145, 150, 22, 205, 80, 237, 243, 248, 60, 20, 118, 249,
-			96, 175, 186, 75, 177, 224, 175, 239, 182, 20, 11, 254, 250, 193,
-			67, 248, 135, 16, 183, 209, 215, 95, 209, 70, 172, 119, 192, 149,
-			33, 224, 42, 227, 210, 107, 187, 112, 154, 30, 52, 61, 110, 53,
-			199, 183, 39, 182, 151, 0, 242, 115, 32, 61, 39, 241, 212, 152,
-			38, 31, 130, 247, 199, 182, 34, 151, 155, 110, 173, 186, 220, 172,
-			220, 118, 34, 246, 155, 12, 250, 194, 127, 237, 80, 194, 240, 63,
-			54, 123, 53, 192, 236, 245, 148, 98, 248, 255, 202, 112, 1, 191,
-			36, 12, 251, 155, 218, 89, 174, 51, 110, 223, 113, 2, 0, 123,
-			22, 76, 9, 175, 116, 182, 56, 55, 55, 57, 145, 184, 67, 196,
-			224, 176, 194, 127, 3, 236, 9, 173, 29, 153, 206, 208, 242, 45,
-			197, 102, 191, 217, 95, 80, 108, 246, 155, 103, 198, 240, 29, 97,
-			178, 191, 174, 157, 176, 92, 166, 48, 42, 229, 53, 131, 225, 144,
-			98, 95, 16, 193, 4, 200, 115, 108, 89, 142, 230, 14, 134, 7,
-			129, 195, 206, 154, 51, 163, 121, 238, 31, 60, 54, 139, 56, 51,
-			202, 101, 174, 220, 99, 11, 205, 17, 155, 227, 27, 180, 98, 25,
-			162, 100, 244, 236, 81, 140, 243, 215, 247, 30, 81, 140, 243, 215,
-			143, 29, 151, 178, 200, 95, 94, 122, 48, 89, 228, 154, 27, 70,
-			126, 176, 254, 240, 96, 178, 95, 179, 176, 242, 97, 160, 100, 173,
-			11, 91, 204, 36, 33, 54, 120, 190, 135, 151, 211, 230, 254, 16,
-			225, 125, 207, 54, 157, 96, 157, 238, 110, 55, 88, 183, 9, 220,
-			62, 5, 188, 86, 1, 238, 83, 193, 107, 133, 36, 85, 129, 239,
-			19, 226, 212, 18, 238, 150, 100, 114, 220, 200, 19, 155, 74, 66,
-			103, 69, 106, 86, 78, 156, 27, 112, 44, 237, 85, 103, 49, 116,
-			95, 101, 144, 145, 233, 114, 150, 70, 204, 185, 175, 58, 230, 65,
-			140, 225, 99, 228, 223, 118, 60, 144, 150, 118, 151, 33, 249, 60,
-			141, 200, 189, 134, 247, 183, 55, 143, 3, 10, 62, 129, 179, 130,
-			235, 229, 184, 142, 7, 55, 165, 176, 44, 147, 155, 39, 240, 78,
-			207, 185, 23, 45, 42, 85, 51, 121, 241, 118, 26, 61, 43, 171,
-			255, 44, 194, 3, 173, 245, 83, 206, 62, 252, 255, 82, 31, 127,
-			200, 192, 7, 55, 104, 36, 239, 233, 155, 56, 179, 26, 248, 205,
-			134, 232, 231, 243, 237, 84, 110, 90, 64, 225, 58, 205, 93, 230,
-			133, 108, 181, 247, 173, 127, 172, 227, 52, 228, 236, 32, 87, 71,
-			15, 42, 87, 223, 194, 251, 192, 16, 238, 141, 89, 252, 69, 80,
-			233, 129, 97, 73, 151, 119, 198, 241, 227, 52, 218, 188, 140, 45,
-			245, 62, 178, 200, 217, 90, 158, 137, 13, 192, 126, 53, 197, 28,
-			75, 192, 114, 31, 198, 61, 192, 80, 241, 228, 105, 72, 142, 33,
-			138, 37, 24, 194, 189, 130, 103, 151, 133, 102, 24, 37, 113, 60,
-			75, 122, 28, 239, 104, 33, 185, 11, 18, 110, 79, 18, 188, 193,
-			179, 66, 246, 193, 159, 21, 114, 255, 55, 194, 187, 97, 184, 249,
-			85, 247, 235, 179, 24, 250, 113, 119, 216, 92, 94, 12, 28, 187,
-			86, 231, 15, 51, 217, 176, 185, 92, 166, 97, 115, 6, 247, 137,
-			193, 139, 87, 12, 123, 18, 233, 128, 128, 201, 233, 146, 171, 165,
-			220, 123, 167, 37, 230, 107, 90, 47, 95, 65, 120, 79, 75, 251,
-			249, 58, 153, 193, 89, 94, 147, 88, 41, 103, 55, 88, 41, 173,
-			89, 5, 205, 37, 111, 197, 47, 203, 66, 182, 188, 82, 28, 220,
-			163, 20, 208, 54, 215, 81, 251, 92, 63, 139, 187, 120, 144, 163,
-			198, 30, 216, 176, 31, 203, 34, 101, 238, 223, 35, 220, 39, 23,
-			250, 86, 135, 125, 4, 247, 241, 97, 95, 12, 155, 203, 76, 241,
-			65, 157, 0, 59, 217, 4, 152, 19, 223, 54, 159, 9, 95, 203,
-			192, 61, 135, 77, 149, 122, 62, 104, 7, 112, 150, 211, 39, 252,
-			165, 119, 49, 138, 182, 220, 253, 99, 63, 165, 179, 71, 74, 190,
-			247, 153, 75, 56, 13, 21, 153, 67, 247, 223, 40, 5, 74, 235,
-			169, 173, 36, 149, 176, 180, 62, 198, 240, 21, 182, 89, 179, 176,
-			229, 253, 152, 213, 53, 242, 128, 251, 119, 46, 101, 46, 227, 237,
-			137, 137, 107, 118, 56, 169, 58, 109, 10, 214, 201, 45, 174, 128,
-			92, 202, 124, 129, 55, 10, 198, 167, 19, 76, 110, 219, 220, 179,
-			58, 32, 181, 183, 15, 241, 195, 130, 219, 190, 253, 25, 6, 110,
-			251, 157, 218, 163, 199, 183, 71, 143, 111, 175, 3, 184, 237, 224,
-			6, 224, 182, 244, 167, 97, 234, 123, 83, 79, 195, 207, 180, 169,
-			239, 75, 141, 243, 215, 185, 3, 241, 235, 28, 253, 121, 158, 65,
-			222, 14, 164, 70, 145, 53, 36, 32, 111, 85, 196, 219, 192, 177,
-			171, 92, 186, 14, 43, 221, 117, 84, 240, 218, 129, 236, 46, 252,
-			1, 196, 209, 107, 13, 162, 29, 33, 214, 143, 34, 5, 191, 86,
-			149, 1, 132, 28, 104, 129, 251, 27, 164, 95, 74, 19, 220, 133,
-			44, 131, 52, 224, 80, 147, 54, 243, 2, 109, 99, 30, 31, 128,
-			54, 176, 80, 2, 194, 164, 88, 97, 10, 205, 66, 30, 206, 118,
-			134, 24, 137, 80, 53, 170, 95, 115, 90, 68, 221, 28, 17, 151,
-			100, 122, 20, 68, 92, 178, 237, 168, 130, 136, 123, 196, 24, 0,
-			115, 75, 64, 196, 53, 78, 104, 39, 143, 91, 63, 135, 18, 160,
-			188, 97, 179, 94, 183, 131, 117, 249, 220, 241, 128, 45, 196, 178,
-			137, 157, 90, 24, 126, 29, 154, 136, 144, 169, 159, 200, 244, 42,
-			72, 184, 39, 250, 134, 21, 36, 220, 147, 198, 17, 252, 138, 64,
-			194, 61, 173, 77, 89, 85, 165, 125, 130, 157, 216, 234, 120, 73,
-			41, 2, 38, 129, 83, 241, 3, 69, 118, 209, 176, 195, 136, 60,
-			161, 66, 88, 113, 144, 220, 211, 153, 93, 10, 72, 238, 233, 221,
-			131, 10, 72, 238, 233, 179, 55, 240, 171, 2, 36, 119, 68, 27,
-			183, 234, 228, 154, 235, 85, 67, 65, 3, 151, 241, 196, 30, 172,
-			5, 38, 134, 228, 23, 54, 162, 18, 51, 97, 199, 150, 168, 164,
-			75, 108, 68, 118, 33, 93, 101, 35, 125, 57, 5, 76, 119, 100,
-			248, 41, 124, 153, 137, 37, 207, 166, 102, 144, 53, 10, 78, 152,
-			147, 238, 225, 192, 37, 150, 114, 74, 178, 195, 102, 137, 1, 190,
-			74, 65, 230, 217, 236, 97, 252, 111, 132, 254, 160, 241, 152, 246,
-			248, 176, 245, 1, 58, 223, 216, 86, 89, 104, 199, 98, 85, 5,
-			108, 242, 97, 129, 148, 10, 14, 83, 87, 179, 133, 0, 153, 189,
-			87, 11, 57, 24, 205, 194, 37, 37, 121, 226, 70, 0, 124, 23,
-			242, 52, 188, 47, 1, 1, 42, 176, 61, 166, 22, 87, 91, 231,
-			94, 208, 21, 137, 162, 112, 180, 196, 123, 53, 33, 18, 125, 44,
-			33, 18, 125, 44, 33, 18, 125, 76, 130, 167, 166, 178, 166, 254,
-			184, 4, 196, 100, 118, 178, 250, 227, 233, 211, 49, 4, 237, 37,
-			237, 242, 176, 53, 214, 210, 1, 98, 254, 69, 62, 121, 133, 118,
-			162, 218, 28, 208, 142, 75, 136, 82, 47, 37, 68, 169, 151, 18,
-			16, 180, 151, 18, 16, 180, 151, 37, 41, 28, 130, 246, 114, 250,
-			52, 94, 16, 16, 180, 79, 105, 79, 15, 3, 32, 183, 32, 165,
-			152, 20, 165, 74, 183, 128, 194, 163, 116, 51, 140, 164, 71, 60,
-			222, 81, 66, 120, 35, 133, 171, 25, 83, 127, 74, 59, 169, 8,
-			87, 159, 26, 60, 163, 8, 87, 159, 58, 119, 94, 193, 153, 125,
-			90, 210, 199, 113, 102, 159, 78, 159, 22, 136, 70, 186, 169, 95,
-			215, 246, 90, 191, 143, 18, 120, 170, 177, 130, 175, 227, 209, 37,
-			205, 183, 241, 168, 25, 120, 194, 192, 62, 233, 129, 17, 60, 78,
-			173, 56, 119, 133, 10, 114, 36, 33, 196, 152, 25, 121, 211, 147,
-			111, 28, 121, 98, 71, 12, 81, 228, 204, 232, 104, 188, 81, 180,
-			155, 125, 39, 40, 226, 62, 66, 67, 208, 41, 189, 36, 236, 68,
-			152, 113, 35, 141, 138, 177, 18, 124, 39, 168, 48, 14, 128, 198,
-			39, 112, 95, 175, 75, 69, 70, 186, 50, 175, 103, 251, 20, 169,
-			241, 245, 221, 123, 240, 103, 164, 212, 120, 90, 219, 111, 125, 18,
-			145, 34, 105, 0, 6, 41, 101, 185, 243, 128, 128, 34, 77, 246,
-			152, 76, 24, 252, 213, 147, 138, 93, 171, 21, 176, 60, 250, 160,
-			241, 10, 162, 7, 215, 181, 94, 14, 233, 210, 102, 216, 231, 96,
-			36, 0, 190, 250, 5, 16, 58, 101, 83, 236, 90, 141, 191, 193,
-			52, 236, 192, 174, 59, 145, 19, 40, 214, 139, 220, 130, 153, 222,
-			3, 160, 70, 120, 120, 198, 170, 1, 62, 141, 132, 125, 77, 49,
-			46, 117, 148, 38, 196, 34, 232, 4, 8, 174, 1, 32, 184, 187,
-			20, 17, 244, 244, 222, 125, 248, 73, 192, 118, 50, 202, 169, 55,
-			34, 235, 12, 108, 76, 45, 190, 247, 239, 191, 51, 209, 133, 83,
-			206, 18, 252, 46, 196, 177, 156, 244, 91, 218, 121, 235, 109, 9,
-			80, 131, 196, 225, 71, 7, 61, 113, 24, 138, 81, 5, 87, 145,
-			140, 231, 91, 74, 138, 101, 150, 200, 196, 228, 220, 120, 158, 44,
-			169, 215, 207, 37, 82, 156, 27, 207, 99, 178, 148, 80, 190, 132,
-			88, 222, 7, 128, 15, 165, 223, 210, 100, 40, 99, 234, 183, 122,
-			78, 138, 16, 50, 245, 91, 131, 163, 34, 164, 155, 250, 173, 179,
-			231, 64, 13, 198, 128, 167, 236, 23, 181, 131, 76, 13, 70, 224,
-			143, 37, 92, 248, 187, 109, 130, 111, 249, 176, 16, 175, 34, 50,
-			239, 131, 139, 81, 57, 164, 48, 76, 254, 10, 199, 238, 12, 29,
-			110, 254, 10, 79, 76, 226, 80, 0, 248, 93, 225, 24, 30, 68,
-			252, 28, 53, 95, 29, 94, 4, 219, 215, 139, 124, 120, 217, 35,
-			249, 139, 28, 227, 24, 193, 246, 245, 98, 255, 0, 46, 2, 102,
-			141, 177, 152, 122, 51, 178, 206, 111, 249, 220, 129, 59, 154, 58,
-			196, 180, 55, 22, 179, 199, 216, 225, 163, 209, 195, 167, 162, 85,
-			255, 193, 29, 62, 90, 226, 61, 78, 75, 188, 199, 105, 242, 61,
-			110, 155, 0, 207, 209, 171, 124, 71, 21, 216, 57, 122, 149, 31,
-			62, 128, 74, 177, 170, 173, 61, 252, 225, 163, 193, 232, 173, 74,
-			82, 232, 232, 173, 74, 82, 232, 232, 173, 74, 82, 232, 225, 179,
-			38, 73, 225, 135, 207, 26, 63, 124, 40, 87, 100, 220, 214, 106,
-			95, 231, 195, 71, 131, 195, 231, 54, 63, 124, 52, 56, 124, 110,
-			243, 195, 71, 131, 195, 231, 54, 63, 124, 52, 56, 124, 106, 146,
-			62, 126, 248, 212, 196, 225, 3, 137, 195, 255, 9, 14, 31, 13,
-			14, 159, 144, 31, 62, 26, 28, 62, 33, 63, 124, 52, 56, 124,
-			66, 113, 248, 104, 244, 240, 121, 245, 31, 192, 225, 131, 153, 218,
-			211, 131, 31, 62, 26, 28, 62, 175, 202, 249, 77, 15, 159, 87,
-			249, 225, 163, 193, 225, 243, 234, 222, 125, 120, 28, 96, 168, 210,
-			111, 77, 253, 44, 66, 214, 133, 173, 159, 62, 109, 251, 19, 237,
-			235, 183, 102, 143, 227, 237, 216, 48, 116, 61, 101, 166, 223, 166,
-			253, 40, 210, 161, 54, 93, 167, 203, 252, 109, 120, 39, 254, 81,
-			132, 51, 52, 72, 183, 175, 111, 71, 198, 25, 235, 157, 72, 60,
-			70, 39, 94, 120, 221, 144, 192, 123, 66, 219, 49, 165, 62, 24,
-			195, 209, 20, 95, 12, 198, 206, 145, 53, 191, 25, 132, 10, 142,
-			1, 223, 178, 165, 145, 31, 152, 220, 216, 141, 168, 25, 136, 108,
-			113, 69, 0, 54, 6, 164, 101, 128, 182, 131, 113, 4, 162, 17,
-			135, 242, 113, 132, 78, 35, 70, 70, 241, 41, 222, 26, 100, 26,
-			223, 137, 140, 129, 77, 85, 7, 68, 102, 148, 134, 196, 74, 4,
-			228, 238, 217, 23, 71, 232, 52, 194, 234, 199, 215, 120, 241, 154,
-			105, 124, 55, 50, 14, 91, 23, 24, 164, 153, 92, 181, 241, 27,
-			68, 203, 205, 86, 92, 180, 90, 90, 166, 165, 161, 160, 238, 56,
-			2, 209, 8, 108, 197, 17, 58, 141, 56, 120, 8, 207, 242, 170,
-			117, 192, 88, 29, 178, 158, 222, 176, 234, 88, 97, 107, 107, 68,
-			232, 105, 40, 50, 38, 66, 71, 52, 2, 31, 139, 35, 160, 210,
-			147, 131, 184, 200, 137, 48, 76, 227, 251, 145, 97, 89, 103, 90,
-			136, 136, 21, 87, 238, 91, 171, 145, 134, 50, 226, 90, 41, 199,
-			245, 253, 8, 239, 137, 35, 116, 26, 177, 255, 128, 236, 245, 180,
-			105, 252, 96, 167, 94, 143, 31, 105, 182, 86, 117, 154, 21, 20,
-			87, 157, 70, 52, 66, 233, 245, 180, 78, 35, 14, 30, 194, 19,
-			188, 106, 192, 82, 53, 14, 90, 231, 218, 170, 126, 144, 225, 206,
-			164, 161, 152, 184, 226, 12, 128, 178, 226, 253, 113, 4, 192, 178,
-			246, 15, 224, 41, 94, 113, 151, 105, 252, 8, 50, 206, 91, 151,
-			55, 84, 132, 104, 216, 97, 8, 75, 43, 169, 5, 209, 145, 128,
-			174, 12, 20, 55, 16, 71, 32, 26, 113, 112, 52, 142, 208, 105,
-			196, 217, 115, 12, 230, 90, 167, 219, 194, 123, 144, 54, 32, 96,
-			174, 59, 112, 174, 172, 146, 176, 176, 1, 251, 10, 95, 185, 74,
-			31, 103, 101, 113, 11, 47, 91, 181, 163, 77, 120, 217, 24, 29,
-			207, 0, 90, 100, 48, 67, 131, 61, 189, 10, 88, 222, 123, 80,
-			223, 62, 5, 44, 239, 61, 116, 201, 254, 12, 107, 7, 64, 171,
-			126, 179, 178, 174, 140, 100, 186, 15, 189, 55, 70, 255, 99, 104,
-			176, 221, 251, 69, 80, 167, 193, 254, 1, 124, 133, 129, 251, 189,
-			31, 165, 254, 55, 180, 177, 220, 4, 204, 225, 18, 162, 127, 113,
-			52, 8, 56, 192, 247, 163, 236, 0, 190, 193, 225, 0, 51, 31,
-			64, 218, 7, 209, 176, 245, 120, 39, 222, 85, 202, 128, 84, 254,
-			45, 22, 115, 49, 6, 142, 227, 1, 166, 77, 227, 3, 162, 13,
-			12, 45, 240, 3, 168, 187, 87, 65, 11, 252, 0, 218, 181, 91,
-			4, 179, 128, 234, 202, 80, 175, 12, 1, 250, 245, 65, 148, 62,
-			141, 199, 129, 46, 100, 102, 126, 1, 105, 31, 66, 195, 214, 217,
-			173, 176, 148, 157, 73, 162, 221, 250, 11, 49, 73, 180, 91, 127,
-			33, 38, 137, 118, 235, 47, 196, 36, 161, 172, 105, 124, 40, 38,
-			137, 3, 113, 125, 136, 146, 244, 95, 17, 208, 164, 153, 198, 135,
-			145, 182, 207, 250, 56, 34, 51, 13, 102, 215, 203, 72, 226, 189,
-			52, 28, 86, 124, 58, 223, 225, 65, 109, 83, 250, 164, 154, 49,
-			99, 67, 105, 114, 1, 126, 3, 207, 19, 151, 121, 137, 87, 46,
-			230, 40, 203, 179, 226, 222, 147, 204, 139, 194, 212, 129, 142, 114,
-			172, 48, 22, 11, 31, 155, 158, 60, 136, 129, 85, 1, 83, 105,
-			94, 11, 204, 122, 220, 138, 51, 103, 192, 121, 244, 225, 184, 175,
-			232, 170, 249, 48, 234, 54, 69, 80, 167, 193, 61, 12, 217, 211,
-			160, 161, 95, 68, 218, 144, 85, 86, 58, 162, 35, 117, 66, 254,
-			202, 128, 173, 195, 22, 230, 122, 19, 114, 244, 12, 84, 33, 234,
-			167, 231, 210, 47, 162, 93, 199, 68, 16, 8, 56, 57, 136, 255,
-			130, 141, 140, 97, 26, 191, 140, 180, 189, 214, 167, 55, 226, 169,
-			101, 231, 252, 3, 102, 170, 89, 211, 233, 225, 249, 203, 72, 235,
-			18, 65, 6, 185, 218, 39, 130, 58, 13, 238, 222, 131, 191, 196,
-			58, 38, 109, 26, 31, 69, 218, 126, 235, 115, 91, 102, 172, 91,
-			119, 142, 215, 141, 209, 110, 173, 248, 97, 228, 60, 172, 19, 210,
-			172, 213, 98, 42, 167, 1, 202, 181, 123, 151, 8, 234, 52, 184,
-			119, 31, 126, 138, 225, 150, 126, 12, 112, 75, 55, 22, 246, 108,
-			190, 157, 210, 194, 63, 134, 178, 7, 241, 83, 216, 48, 210, 122,
-			202, 204, 124, 28, 105, 159, 68, 186, 53, 66, 198, 21, 7, 81,
-			114, 33, 196, 208, 241, 28, 224, 5, 212, 106, 215, 4, 164, 40,
-			224, 103, 127, 156, 178, 66, 148, 157, 77, 51, 230, 252, 19, 91,
-			97, 103, 211, 28, 43, 251, 19, 130, 157, 77, 115, 110, 249, 19,
-			130, 157, 77, 115, 110, 249, 19, 244, 108, 28, 229, 197, 35, 211,
-			248, 45, 202, 83, 50, 239, 37, 10, 117, 27, 86, 130, 50, 144,
-			101, 32, 142, 128, 50, 14, 30, 139, 35, 116, 26, 113, 114, 16,
-			95, 230, 240, 175, 198, 167, 144, 118, 204, 162, 215, 112, 193, 69,
-			136, 229, 82, 32, 51, 177, 140, 75, 244, 145, 218, 33, 112, 244,
-			127, 74, 28, 253, 105, 56, 250, 63, 37, 0, 205, 5, 206, 235,
-			190, 195, 9, 156, 215, 220, 81, 112, 214, 7, 56, 174, 198, 167,
-			233, 209, 255, 99, 116, 250, 243, 153, 207, 61, 164, 48, 88, 29,
-			192, 157, 183, 67, 202, 146, 8, 205, 131, 165, 182, 233, 45, 79,
-			123, 182, 7, 196, 248, 251, 96, 40, 194, 144, 237, 242, 156, 131,
-			128, 103, 18, 207, 111, 93, 18, 0, 71, 20, 9, 135, 5, 254,
-			10, 22, 39, 119, 140, 37, 155, 6, 90, 179, 10, 150, 236, 167,
-			5, 11, 192, 176, 100, 63, 77, 89, 128, 75, 12, 42, 246, 143,
-			80, 234, 239, 17, 178, 134, 239, 203, 2, 192, 251, 188, 58, 97,
-			41, 223, 249, 71, 40, 123, 0, 79, 10, 96, 217, 207, 32, 237,
-			143, 209, 176, 117, 126, 203, 231, 63, 104, 167, 171, 39, 109, 6,
-			102, 222, 103, 98, 148, 82, 58, 44, 159, 17, 39, 45, 3, 130,
-			253, 140, 56, 105, 25, 16, 236, 31, 183, 1, 193, 254, 49, 61,
-			105, 231, 56, 16, 108, 230, 115, 72, 251, 83, 52, 108, 141, 43,
-			68, 205, 192, 113, 23, 91, 217, 169, 207, 88, 241, 3, 214, 134,
-			64, 170, 180, 131, 63, 23, 147, 72, 59, 248, 115, 168, 219, 82,
-			224, 98, 63, 71, 217, 126, 30, 204, 154, 198, 159, 198, 36, 114,
-			102, 224, 79, 41, 137, 191, 45, 241, 100, 63, 79, 153, 129, 95,
-			253, 102, 100, 6, 30, 142, 9, 96, 96, 179, 159, 79, 130, 205,
-			126, 94, 48, 1, 12, 108, 246, 243, 148, 9, 248, 11, 214, 5,
-			186, 105, 124, 105, 211, 83, 55, 126, 118, 252, 6, 157, 186, 178,
-			130, 111, 236, 169, 155, 129, 139, 242, 151, 196, 169, 155, 1, 118,
-			228, 75, 226, 212, 205, 0, 59, 242, 37, 122, 234, 254, 119, 214,
-			49, 134, 105, 252, 29, 61, 117, 255, 232, 1, 79, 93, 190, 88,
-			95, 231, 35, 151, 215, 250, 176, 231, 109, 6, 88, 146, 191, 139,
-			103, 13, 101, 73, 254, 78, 156, 183, 25, 96, 73, 254, 142, 158,
-			183, 151, 25, 68, 241, 87, 81, 234, 59, 53, 4, 167, 193, 125,
-			206, 219, 182, 189, 171, 11, 80, 102, 179, 22, 190, 196, 33, 141,
-			141, 183, 107, 218, 97, 216, 5, 19, 215, 211, 82, 85, 57, 88,
-			236, 90, 99, 205, 94, 118, 184, 73, 78, 140, 81, 108, 64, 110,
-			21, 178, 248, 237, 26, 199, 179, 101, 144, 197, 111, 215, 76, 75,
-			129, 44, 126, 187, 118, 240, 16, 59, 87, 186, 232, 186, 120, 135,
-			246, 15, 225, 92, 97, 96, 196, 239, 208, 18, 96, 196, 239, 208,
-			248, 185, 194, 192, 136, 223, 161, 245, 15, 72, 11, 130, 247, 247,
-			227, 139, 15, 162, 37, 95, 119, 34, 155, 94, 128, 55, 50, 33,
-			200, 253, 44, 194, 219, 232, 64, 222, 228, 9, 77, 19, 27, 158,
-			205, 21, 139, 187, 203, 240, 219, 188, 136, 179, 53, 254, 86, 193,
-			181, 36, 15, 117, 214, 207, 158, 226, 169, 202, 50, 189, 57, 142,
-			183, 47, 55, 87, 23, 43, 126, 189, 225, 123, 14, 215, 36, 238,
-			88, 192, 213, 230, 234, 184, 72, 85, 222, 182, 172, 132, 114, 115,
-			140, 72, 81, 60, 37, 50, 112, 26, 190, 32, 146, 254, 54, 251,
-			113, 247, 138, 91, 115, 22, 129, 122, 166, 158, 152, 165, 17, 211,
-			180, 5, 38, 54, 106, 174, 231, 112, 53, 102, 248, 157, 251, 103,
-			8, 111, 83, 235, 52, 167, 241, 118, 55, 12, 155, 206, 34, 192,
-			86, 59, 1, 87, 174, 238, 160, 185, 87, 162, 201, 230, 89, 42,
-			153, 255, 70, 170, 188, 205, 85, 62, 152, 69, 156, 21, 104, 214,
-			188, 219, 58, 232, 242, 221, 228, 41, 212, 98, 100, 182, 171, 89,
-			156, 97, 208, 217, 185, 139, 120, 79, 199, 90, 205, 35, 120, 155,
-			236, 92, 1, 7, 163, 151, 123, 100, 92, 169, 154, 27, 199, 125,
-			109, 213, 152, 251, 91, 212, 85, 99, 77, 213, 221, 56, 13, 91,
-			48, 239, 69, 22, 120, 56, 213, 193, 47, 236, 97, 176, 29, 183,
-			54, 213, 28, 60, 251, 72, 115, 240, 145, 230, 224, 3, 107, 14,
-			50, 189, 63, 51, 214, 251, 163, 63, 63, 138, 152, 234, 210, 129,
-			212, 49, 100, 253, 91, 68, 74, 30, 195, 57, 131, 27, 221, 50,
-			101, 218, 108, 110, 52, 137, 201, 56, 243, 13, 80, 91, 167, 3,
-			91, 227, 38, 167, 173, 166, 146, 98, 99, 44, 144, 9, 95, 204,
-			36, 44, 15, 222, 200, 39, 85, 55, 112, 42, 180, 144, 200, 103,
-			46, 127, 161, 221, 42, 122, 169, 115, 39, 70, 255, 194, 44, 87,
-			187, 131, 125, 184, 111, 50, 187, 204, 137, 171, 138, 18, 213, 129,
-			236, 110, 124, 66, 88, 131, 246, 107, 166, 117, 128, 129, 63, 4,
-			46, 229, 36, 56, 76, 7, 221, 243, 18, 42, 75, 253, 9, 149,
-			165, 254, 132, 91, 236, 254, 222, 62, 112, 155, 2, 86, 156, 135,
-			181, 1, 171, 76, 121, 19, 14, 200, 39, 112, 45, 184, 255, 107,
-			238, 213, 155, 89, 198, 213, 28, 94, 17, 17, 187, 123, 129, 238,
-			188, 2, 107, 64, 181, 24, 22, 138, 72, 25, 90, 195, 14, 69,
-			101, 233, 240, 206, 125, 138, 202, 210, 97, 171, 31, 175, 11, 235,
-			207, 163, 218, 17, 171, 198, 220, 80, 209, 141, 142, 240, 93, 152,
-			200, 157, 172, 205, 129, 187, 32, 88, 154, 247, 97, 114, 181, 185,
-			154, 192, 124, 149, 109, 226, 32, 216, 224, 10, 53, 105, 207, 167,
-			101, 104, 221, 59, 20, 189, 165, 163, 59, 7, 20, 189, 165, 163,
-			135, 9, 46, 48, 189, 147, 147, 169, 39, 144, 149, 35, 226, 28,
-			74, 232, 12, 196, 151, 115, 69, 209, 228, 100, 118, 55, 254, 180,
-			84, 52, 25, 214, 76, 235, 227, 136, 92, 119, 35, 183, 230, 132,
-			100, 161, 60, 37, 240, 209, 90, 28, 128, 219, 132, 118, 172, 244,
-			4, 0, 113, 74, 174, 139, 18, 58, 254, 242, 154, 31, 70, 87,
-			70, 228, 29, 36, 225, 85, 155, 228, 68, 50, 185, 107, 51, 251,
-			22, 6, 45, 6, 40, 243, 226, 203, 72, 24, 84, 114, 152, 193,
-			178, 1, 78, 134, 199, 251, 56, 87, 88, 117, 163, 28, 93, 221,
-			55, 102, 22, 166, 38, 58, 141, 51, 130, 41, 55, 44, 117, 59,
-			232, 148, 27, 230, 83, 142, 41, 170, 12, 247, 246, 225, 47, 74,
-			69, 149, 243, 218, 62, 235, 79, 16, 153, 86, 61, 207, 211, 201,
-			117, 119, 163, 89, 200, 93, 67, 172, 64, 251, 97, 31, 166, 221,
-			19, 186, 76, 235, 130, 169, 7, 192, 171, 40, 163, 120, 100, 36,
-			39, 225, 61, 46, 210, 160, 156, 65, 225, 72, 195, 94, 175, 195,
-			143, 138, 31, 56, 34, 180, 200, 185, 178, 69, 186, 208, 23, 155,
-			145, 91, 91, 108, 122, 148, 191, 11, 163, 66, 5, 186, 197, 190,
-			199, 221, 120, 95, 36, 231, 207, 140, 21, 248, 67, 179, 56, 88,
-			150, 237, 202, 237, 176, 102, 135, 107, 160, 227, 42, 175, 196, 170,
-			230, 203, 249, 132, 230, 203, 249, 110, 83, 209, 124, 57, 191, 103,
-			47, 200, 167, 0, 30, 226, 113, 173, 207, 26, 35, 51, 158, 51,
-			204, 156, 244, 81, 86, 69, 220, 217, 54, 233, 32, 94, 156, 150,
-			166, 37, 116, 137, 16, 50, 245, 199, 179, 219, 68, 72, 55, 245,
-			199, 119, 246, 98, 155, 41, 217, 60, 153, 154, 68, 214, 2, 41,
-			171, 144, 207, 241, 82, 115, 225, 172, 72, 44, 196, 2, 131, 102,
-			17, 9, 66, 12, 25, 152, 138, 11, 155, 182, 144, 60, 84, 148,
-			112, 158, 204, 238, 6, 63, 146, 26, 189, 158, 95, 209, 38, 216,
-			27, 183, 150, 165, 51, 228, 74, 118, 7, 30, 227, 250, 57, 250,
-			211, 70, 158, 187, 191, 20, 216, 246, 80, 243, 124, 235, 22, 16,
-			171, 198, 100, 104, 166, 3, 138, 106, 204, 211, 214, 73, 69, 53,
-			230, 233, 83, 167, 97, 219, 100, 254, 238, 141, 163, 124, 219, 20,
-			140, 85, 135, 18, 233, 94, 53, 110, 236, 81, 52, 92, 198, 247,
-			30, 82, 52, 92, 198, 143, 228, 240, 219, 64, 3, 192, 40, 165,
-			166, 144, 21, 38, 187, 195, 235, 72, 121, 30, 112, 94, 224, 85,
-			157, 128, 167, 10, 186, 224, 175, 10, 111, 37, 121, 28, 59, 48,
-			165, 55, 5, 177, 92, 161, 31, 69, 175, 199, 46, 33, 20, 237,
-			129, 82, 246, 32, 184, 15, 213, 105, 231, 61, 163, 29, 176, 6,
-			183, 208, 121, 164, 52, 193, 91, 11, 14, 173, 244, 103, 248, 68,
-			129, 39, 58, 253, 153, 236, 110, 17, 210, 77, 253, 153, 125, 251,
-			241, 75, 240, 158, 101, 204, 166, 110, 33, 235, 217, 214, 214, 202,
-			158, 76, 204, 145, 124, 162, 65, 177, 139, 139, 229, 230, 106, 152,
-			224, 29, 121, 91, 12, 100, 234, 179, 217, 3, 120, 136, 191, 118,
-			233, 101, 109, 143, 53, 144, 28, 42, 33, 228, 82, 14, 57, 120,
-			206, 210, 203, 124, 77, 193, 107, 150, 94, 230, 250, 72, 240, 152,
-			165, 151, 119, 237, 230, 79, 104, 200, 212, 23, 180, 93, 214, 165,
-			13, 198, 159, 11, 50, 200, 36, 61, 231, 114, 87, 107, 174, 119,
-			251, 74, 177, 82, 113, 194, 208, 93, 118, 107, 110, 180, 158, 147,
-			117, 210, 117, 188, 32, 235, 164, 51, 100, 161, 123, 135, 8, 233,
-			166, 190, 208, 103, 202, 139, 218, 239, 231, 241, 129, 86, 227, 106,
-			122, 66, 109, 224, 76, 229, 2, 214, 139, 222, 58, 216, 96, 173,
-			55, 156, 197, 102, 80, 19, 76, 57, 13, 47, 4, 181, 36, 83,
-			190, 77, 48, 229, 119, 58, 251, 81, 201, 22, 189, 117, 225, 66,
-			37, 191, 69, 23, 42, 182, 183, 222, 88, 222, 170, 235, 148, 191,
-			58, 133, 187, 204, 244, 161, 212, 123, 208, 35, 223, 41, 143, 124,
-			167, 60, 242, 157, 242, 200, 119, 202, 35, 223, 41, 143, 124, 167,
-			188, 158, 190, 83, 198, 197, 173, 255, 168, 52, 1, 60, 22, 187,
-			73, 57, 38, 221, 164, 28, 103, 38, 132, 89, 254, 83, 248, 78,
-			145, 110, 82, 78, 72, 55, 41, 39, 99, 55, 41, 244, 231, 255,
-			96, 110, 82, 210, 111, 161, 135, 156, 245, 127, 246, 146, 165, 162,
-			183, 190, 36, 56, 94, 192, 192, 178, 131, 101, 55, 10, 232, 38,
-			25, 194, 206, 228, 190, 202, 206, 1, 118, 0, 178, 179, 71, 10,
-			207, 237, 154, 15, 239, 95, 209, 26, 177, 49, 220, 253, 224, 160,
-			168, 58, 97, 37, 112, 151, 185, 111, 93, 64, 211, 23, 32, 218,
-			113, 153, 188, 12, 186, 57, 136, 19, 153, 212, 220, 101, 168, 154,
-			111, 134, 33, 9, 155, 141, 134, 31, 192, 99, 96, 195, 174, 220,
-			30, 105, 122, 244, 15, 41, 122, 235, 210, 131, 137, 199, 55, 224,
-			160, 14, 80, 221, 244, 166, 227, 70, 235, 100, 165, 233, 9, 12,
-			243, 128, 216, 85, 182, 97, 219, 53, 178, 234, 8, 237, 61, 97,
-			56, 201, 73, 163, 101, 82, 82, 11, 73, 239, 36, 179, 180, 62,
-			134, 16, 7, 85, 219, 178, 241, 174, 71, 198, 79, 159, 22, 174,
-			12, 174, 249, 62, 89, 241, 253, 132, 123, 9, 90, 164, 237, 173,
-			243, 16, 101, 149, 104, 105, 215, 2, 191, 62, 184, 226, 251, 67,
-			60, 190, 80, 40, 196, 222, 32, 104, 162, 5, 168, 105, 222, 31,
-			60, 65, 83, 41, 238, 26, 100, 202, 22, 23, 26, 155, 210, 248,
-			6, 251, 142, 189, 5, 34, 201, 147, 244, 87, 129, 230, 223, 148,
-			56, 55, 28, 188, 230, 251, 133, 74, 205, 14, 67, 149, 54, 86,
-			44, 77, 193, 136, 80, 82, 117, 246, 26, 177, 41, 209, 73, 55,
-			17, 172, 236, 107, 190, 63, 88, 40, 20, 134, 100, 111, 50, 146,
-			7, 135, 146, 221, 11, 212, 183, 19, 79, 63, 151, 24, 237, 19,
-			147, 115, 227, 229, 210, 236, 252, 76, 121, 232, 162, 32, 63, 238,
-			119, 37, 63, 43, 33, 166, 250, 220, 125, 168, 190, 238, 11, 169,
-			41, 165, 248, 226, 147, 228, 68, 99, 185, 112, 205, 247, 223, 92,
-			40, 20, 94, 195, 178, 162, 60, 113, 130, 128, 126, 6, 246, 180,
-			48, 237, 220, 85, 235, 116, 87, 224, 243, 145, 39, 137, 231, 214,
-			226, 254, 141, 27, 67, 68, 81, 113, 76, 75, 117, 175, 37, 139,
-			98, 53, 21, 22, 188, 186, 29, 132, 107, 118, 109, 222, 103, 35,
-			124, 255, 122, 48, 119, 158, 91, 185, 45, 87, 139, 228, 83, 150,
-			215, 73, 163, 117, 221, 178, 55, 205, 117, 137, 166, 11, 114, 197,
-			147, 176, 170, 24, 39, 107, 55, 220, 16, 184, 217, 149, 102, 173,
-			86, 128, 15, 148, 81, 57, 41, 100, 70, 176, 87, 128, 12, 137,
-			51, 86, 172, 155, 113, 188, 88, 57, 72, 38, 91, 244, 205, 90,
-			109, 157, 188, 210, 180, 107, 28, 51, 148, 230, 6, 166, 200, 94,
-			17, 230, 182, 224, 120, 250, 228, 200, 73, 201, 141, 137, 42, 192,
-			205, 102, 44, 86, 90, 241, 253, 194, 178, 29, 0, 113, 247, 70,
-			214, 11, 175, 230, 88, 107, 214, 225, 117, 140, 102, 194, 172, 232,
-			28, 253, 70, 175, 122, 224, 114, 130, 119, 17, 120, 159, 144, 96,
-			130, 82, 144, 102, 123, 124, 135, 101, 175, 193, 205, 208, 17, 79,
-			238, 171, 205, 154, 29, 224, 14, 89, 128, 97, 116, 226, 125, 50,
-			79, 156, 250, 178, 3, 174, 152, 248, 76, 227, 60, 188, 237, 97,
-			117, 95, 99, 175, 120, 75, 79, 83, 74, 151, 196, 203, 160, 170,
-			14, 36, 218, 93, 144, 98, 36, 62, 89, 105, 7, 51, 1, 182,
-			184, 112, 173, 184, 53, 135, 47, 90, 49, 187, 103, 157, 32, 244,
-			189, 120, 154, 112, 213, 7, 128, 22, 131, 231, 41, 162, 186, 143,
-			225, 95, 193, 241, 58, 255, 56, 166, 236, 2, 244, 63, 89, 82,
-			14, 72, 206, 93, 36, 185, 78, 243, 36, 73, 84, 129, 145, 145,
-			203, 203, 204, 64, 192, 180, 93, 167, 5, 92, 102, 213, 94, 137,
-			191, 82, 2, 90, 62, 198, 84, 48, 216, 180, 182, 238, 149, 222,
-			95, 238, 58, 181, 218, 48, 23, 112, 112, 220, 80, 155, 84, 154,
-			97, 228, 215, 217, 208, 183, 140, 94, 158, 29, 126, 45, 67, 42,
-			158, 249, 101, 53, 116, 204, 188, 85, 98, 179, 17, 195, 100, 9,
-			230, 134, 24, 178, 53, 31, 60, 15, 131, 151, 95, 89, 19, 72,
-			174, 248, 80, 11, 1, 48, 31, 105, 44, 156, 79, 136, 61, 10,
-			240, 245, 68, 83, 94, 220, 8, 251, 231, 165, 23, 95, 26, 186,
-			248, 176, 35, 145, 44, 74, 25, 12, 104, 9, 205, 127, 166, 48,
-			118, 102, 44, 204, 201, 190, 150, 146, 255, 183, 100, 123, 240, 239,
-			98, 97, 62, 253, 163, 72, 219, 107, 253, 26, 229, 166, 23, 202,
-			83, 35, 210, 57, 55, 204, 24, 232, 203, 38, 96, 68, 214, 214,
-			99, 89, 242, 102, 44, 5, 222, 136, 79, 225, 14, 133, 249, 172,
-			132, 123, 159, 208, 228, 17, 190, 250, 49, 96, 181, 230, 70, 114,
-			164, 178, 102, 131, 179, 179, 160, 16, 123, 192, 15, 157, 213, 58,
-			247, 217, 70, 171, 92, 40, 79, 157, 12, 73, 195, 142, 214, 226,
-			75, 36, 12, 57, 238, 184, 45, 169, 78, 232, 129, 242, 65, 155,
-			242, 45, 152, 44, 209, 34, 54, 236, 216, 165, 161, 66, 236, 195,
-			62, 6, 34, 101, 111, 118, 182, 231, 123, 110, 133, 174, 123, 96,
-			124, 6, 157, 194, 106, 33, 79, 155, 2, 147, 43, 87, 200, 209,
-			25, 236, 249, 17, 177, 1, 157, 192, 169, 14, 21, 152, 215, 163,
-			6, 248, 118, 129, 59, 173, 99, 215, 67, 210, 12, 155, 128, 27,
-			218, 8, 156, 138, 95, 111, 184, 53, 90, 3, 159, 99, 252, 182,
-			12, 90, 25, 235, 13, 39, 134, 26, 95, 199, 220, 22, 129, 184,
-			192, 155, 113, 161, 3, 76, 91, 223, 139, 156, 123, 208, 85, 148,
-			159, 32, 55, 252, 187, 206, 29, 39, 96, 187, 237, 66, 121, 42,
-			228, 19, 157, 239, 225, 88, 120, 54, 94, 90, 139, 162, 198, 82,
-			158, 253, 13, 151, 242, 224, 231, 197, 231, 95, 243, 48, 58, 21,
-			219, 35, 62, 87, 124, 170, 173, 131, 26, 125, 179, 65, 108, 190,
-			57, 131, 24, 37, 96, 36, 214, 237, 70, 40, 55, 60, 208, 100,
-			17, 43, 34, 126, 209, 0, 224, 119, 118, 111, 15, 233, 82, 56,
-			69, 55, 4, 89, 163, 234, 135, 91, 18, 5, 160, 182, 97, 216,
-			172, 131, 184, 230, 20, 41, 122, 228, 198, 252, 252, 44, 185, 62,
-			57, 79, 124, 79, 76, 13, 54, 39, 216, 185, 97, 183, 47, 193,
-			249, 245, 134, 243, 210, 139, 47, 209, 181, 193, 117, 132, 18, 98,
-			9, 59, 202, 243, 155, 56, 72, 37, 108, 143, 121, 235, 102, 245,
-			177, 183, 84, 41, 116, 97, 111, 115, 236, 22, 95, 129, 87, 228,
-			154, 239, 223, 110, 54, 164, 45, 1, 19, 183, 51, 210, 104, 133,
-			112, 236, 249, 129, 64, 161, 118, 234, 202, 176, 87, 217, 184, 219,
-			130, 24, 250, 251, 142, 239, 86, 41, 239, 64, 243, 178, 162, 97,
-			74, 6, 128, 112, 158, 23, 41, 105, 1, 118, 196, 69, 139, 9,
-			107, 209, 134, 144, 110, 209, 2, 124, 143, 35, 90, 194, 87, 152,
-			80, 5, 50, 184, 16, 2, 36, 70, 200, 108, 185, 228, 9, 206,
-			6, 205, 246, 236, 85, 32, 124, 57, 112, 108, 112, 186, 205, 75,
-			40, 12, 97, 44, 157, 42, 185, 161, 228, 246, 109, 32, 129, 79,
-			253, 74, 252, 22, 42, 5, 184, 124, 150, 250, 43, 43, 110, 197,
-			181, 107, 56, 230, 97, 2, 135, 110, 4, 78, 94, 120, 65, 228,
-			133, 72, 220, 223, 120, 58, 45, 59, 171, 174, 231, 185, 252, 230,
-			131, 73, 135, 221, 146, 46, 182, 57, 152, 72, 33, 151, 244, 128,
-			150, 89, 203, 36, 39, 131, 92, 88, 227, 212, 27, 209, 58, 159,
-			121, 67, 28, 206, 126, 217, 193, 28, 13, 156, 158, 246, 46, 221,
-			220, 99, 33, 146, 148, 185, 132, 78, 221, 246, 34, 183, 2, 47,
-			20, 219, 197, 91, 41, 221, 89, 179, 34, 136, 104, 176, 187, 79,
-			4, 117, 26, 220, 189, 7, 63, 39, 32, 28, 126, 2, 105, 166,
-			85, 146, 222, 139, 108, 58, 45, 221, 234, 102, 215, 63, 190, 151,
-			49, 169, 157, 2, 217, 206, 110, 79, 219, 5, 178, 3, 45, 185,
-			75, 4, 161, 162, 236, 118, 17, 212, 105, 80, 113, 105, 244, 185,
-			9, 124, 245, 129, 160, 50, 185, 225, 206, 114, 96, 123, 149, 53,
-			225, 82, 232, 97, 240, 68, 55, 22, 105, 127, 99, 160, 68, 115,
-			23, 112, 255, 117, 39, 82, 240, 228, 175, 66, 27, 4, 228, 218,
-			62, 85, 89, 137, 193, 168, 65, 68, 238, 223, 25, 220, 141, 142,
-			154, 171, 163, 110, 147, 162, 6, 163, 37, 213, 96, 20, 23, 75,
-			250, 166, 46, 150, 140, 118, 88, 185, 3, 56, 27, 56, 43, 236,
-			51, 195, 95, 235, 10, 156, 149, 86, 196, 185, 204, 86, 17, 231,
-			204, 97, 172, 7, 206, 10, 64, 26, 246, 140, 245, 183, 103, 152,
-			3, 110, 160, 236, 172, 148, 105, 58, 115, 28, 155, 43, 174, 199,
-			102, 228, 34, 63, 144, 67, 14, 114, 184, 187, 13, 228, 176, 232,
-			173, 151, 251, 100, 250, 57, 158, 92, 41, 196, 245, 86, 69, 41,
-			251, 187, 183, 80, 136, 235, 173, 242, 82, 204, 115, 24, 135, 116,
-			29, 134, 116, 221, 237, 223, 182, 73, 102, 37, 157, 121, 25, 227,
-			53, 63, 90, 100, 75, 104, 63, 134, 92, 29, 64, 77, 75, 94,
-			163, 25, 177, 119, 134, 114, 247, 154, 207, 127, 130, 191, 39, 159,
-			78, 94, 150, 189, 103, 43, 217, 49, 205, 193, 126, 231, 170, 184,
-			71, 249, 100, 238, 197, 25, 246, 246, 203, 85, 171, 120, 200, 124,
-			82, 193, 93, 213, 0, 229, 240, 72, 123, 29, 179, 224, 138, 205,
-			247, 218, 176, 87, 115, 239, 74, 227, 157, 45, 95, 205, 19, 120,
-			7, 157, 254, 110, 36, 62, 240, 42, 91, 98, 205, 2, 54, 214,
-			252, 102, 192, 181, 202, 54, 67, 255, 132, 116, 224, 241, 43, 92,
-			140, 173, 49, 97, 66, 103, 203, 219, 220, 112, 82, 198, 153, 79,
-			96, 35, 104, 122, 225, 126, 3, 218, 114, 252, 190, 109, 41, 148,
-			155, 94, 25, 178, 88, 255, 73, 199, 122, 185, 73, 233, 218, 37,
-			65, 56, 27, 118, 24, 114, 36, 78, 214, 136, 62, 241, 105, 214,
-			14, 67, 134, 198, 169, 166, 95, 177, 221, 26, 79, 175, 37, 211,
-			95, 3, 189, 53, 154, 126, 20, 239, 142, 65, 62, 3, 59, 92,
-			83, 208, 73, 245, 178, 41, 161, 62, 233, 167, 246, 28, 224, 151,
-			67, 129, 38, 85, 114, 20, 233, 39, 150, 99, 12, 239, 81, 208,
-			79, 149, 86, 164, 33, 203, 174, 248, 99, 220, 142, 100, 30, 165,
-			37, 153, 214, 60, 113, 91, 206, 225, 189, 42, 202, 170, 210, 154,
-			46, 200, 180, 91, 193, 90, 141, 219, 147, 204, 165, 182, 40, 219,
-			154, 75, 105, 211, 17, 188, 205, 13, 23, 171, 77, 198, 18, 57,
-			176, 136, 179, 229, 30, 55, 156, 16, 81, 99, 1, 222, 213, 182,
-			97, 58, 161, 249, 34, 214, 175, 59, 145, 57, 220, 62, 27, 54,
-			217, 151, 173, 141, 156, 197, 169, 105, 31, 22, 141, 240, 143, 46,
-			48, 52, 194, 15, 161, 71, 58, 133, 143, 116, 10, 95, 7, 52,
-			194, 163, 27, 160, 17, 178, 87, 131, 125, 177, 254, 33, 253, 249,
-			3, 58, 3, 30, 36, 169, 99, 200, 250, 118, 29, 244, 150, 2,
-			126, 211, 76, 64, 222, 49, 102, 140, 176, 137, 237, 48, 99, 19,
-			50, 215, 176, 61, 15, 94, 101, 139, 236, 9, 185, 238, 211, 83,
-			148, 191, 169, 58, 30, 29, 70, 122, 13, 101, 131, 23, 54, 24,
-			254, 27, 115, 80, 224, 168, 222, 122, 18, 149, 96, 34, 150, 15,
-			29, 113, 94, 7, 25, 148, 26, 241, 160, 239, 101, 135, 46, 120,
-			196, 8, 185, 217, 124, 19, 76, 215, 231, 158, 157, 2, 203, 24,
-			215, 9, 243, 152, 44, 59, 21, 91, 200, 19, 217, 91, 190, 244,
-			251, 59, 164, 24, 202, 196, 119, 9, 144, 64, 210, 33, 107, 81,
-			117, 172, 214, 93, 143, 46, 159, 0, 32, 118, 248, 171, 68, 51,
-			106, 210, 139, 146, 27, 37, 30, 93, 105, 238, 170, 27, 54, 106,
-			246, 58, 131, 169, 112, 54, 107, 98, 252, 200, 177, 80, 202, 19,
-			39, 170, 40, 152, 142, 36, 219, 143, 167, 4, 164, 99, 78, 59,
-			122, 192, 186, 162, 34, 58, 130, 24, 9, 8, 143, 187, 115, 227,
-			17, 115, 147, 176, 139, 185, 76, 183, 2, 187, 152, 195, 199, 21,
-			216, 197, 163, 198, 30, 124, 149, 105, 165, 158, 76, 141, 9, 228,
-			144, 13, 128, 141, 90, 246, 95, 186, 203, 182, 194, 234, 157, 204,
-			30, 197, 255, 218, 16, 114, 161, 81, 237, 204, 176, 245, 126, 35,
-			150, 126, 36, 188, 84, 36, 72, 103, 206, 102, 132, 182, 166, 208,
-			188, 243, 131, 186, 80, 177, 9, 71, 222, 60, 91, 158, 121, 195,
-			228, 248, 252, 107, 112, 115, 8, 71, 222, 188, 80, 158, 90, 156,
-			156, 27, 47, 206, 78, 78, 44, 130, 11, 202, 210, 196, 107, 35,
-			194, 66, 106, 228, 205, 183, 138, 229, 82, 113, 122, 126, 241, 70,
-			113, 238, 198, 107, 35, 129, 179, 18, 142, 188, 185, 60, 121, 141,
-			133, 49, 83, 88, 187, 136, 9, 47, 85, 88, 81, 109, 136, 187,
-			180, 97, 87, 147, 14, 116, 136, 210, 184, 149, 81, 158, 56, 97,
-			197, 110, 240, 75, 96, 172, 33, 162, 234, 189, 220, 94, 29, 241,
-			156, 104, 164, 25, 212, 70, 142, 206, 218, 209, 218, 36, 228, 40,
-			192, 46, 3, 62, 104, 68, 38, 219, 109, 20, 170, 206, 157, 145,
-			51, 99, 99, 5, 76, 212, 86, 138, 90, 85, 107, 200, 142, 109,
-			144, 107, 110, 240, 204, 5, 82, 243, 239, 58, 193, 112, 197, 14,
-			157, 97, 41, 51, 35, 107, 206, 61, 46, 103, 163, 171, 71, 116,
-			155, 168, 128, 137, 241, 34, 121, 52, 240, 62, 17, 247, 73, 209,
-			53, 210, 127, 208, 253, 107, 73, 104, 8, 143, 38, 52, 132, 71,
-			19, 26, 194, 163, 189, 125, 10, 168, 225, 153, 86, 80, 195, 51,
-			233, 211, 248, 77, 160, 16, 155, 62, 159, 122, 59, 66, 214, 179,
-			170, 22, 33, 147, 58, 52, 124, 87, 237, 3, 166, 212, 10, 192,
-			18, 149, 102, 205, 14, 200, 32, 159, 114, 121, 174, 192, 203, 123,
-			45, 79, 2, 103, 101, 72, 81, 160, 61, 159, 61, 128, 63, 104,
-			8, 5, 218, 43, 154, 105, 253, 248, 86, 231, 250, 204, 163, 9,
-			254, 63, 231, 4, 103, 250, 200, 87, 18, 250, 200, 87, 18, 250,
-			200, 87, 122, 251, 240, 25, 161, 142, 92, 212, 246, 88, 199, 218,
-			240, 224, 132, 70, 160, 224, 78, 115, 9, 117, 222, 98, 66, 157,
-			183, 200, 85, 15, 153, 58, 111, 113, 215, 110, 60, 40, 212, 121,
-			39, 180, 61, 220, 197, 101, 107, 115, 185, 21, 66, 172, 183, 59,
-			33, 203, 4, 199, 116, 178, 76, 77, 55, 245, 137, 93, 187, 177,
-			3, 101, 234, 166, 126, 67, 179, 172, 231, 201, 141, 118, 91, 243,
-			60, 177, 67, 217, 77, 204, 249, 162, 115, 207, 174, 58, 21, 183,
-			110, 215, 98, 97, 61, 157, 110, 172, 113, 79, 92, 168, 92, 120,
-			188, 90, 121, 226, 220, 5, 123, 249, 220, 232, 133, 199, 227, 70,
-			234, 105, 90, 143, 32, 136, 50, 66, 55, 184, 223, 38, 4, 12,
-			208, 141, 253, 7, 240, 179, 64, 16, 120, 117, 219, 107, 77, 36,
-			8, 226, 175, 19, 108, 72, 183, 78, 22, 175, 192, 0, 229, 84,
-			81, 57, 184, 124, 235, 238, 19, 33, 221, 212, 159, 217, 189, 7,
-			191, 2, 149, 167, 77, 125, 70, 59, 105, 85, 201, 132, 84, 163,
-			0, 55, 230, 130, 59, 188, 107, 67, 127, 7, 77, 38, 131, 140,
-			13, 6, 192, 172, 193, 142, 19, 50, 7, 73, 148, 199, 105, 186,
-			53, 202, 33, 50, 79, 234, 204, 33, 88, 211, 149, 72, 115, 8,
-			28, 20, 205, 112, 7, 69, 204, 63, 220, 76, 127, 78, 132, 116,
-			83, 159, 57, 126, 2, 231, 129, 184, 140, 169, 151, 181, 227, 214,
-			97, 24, 126, 62, 187, 99, 117, 67, 80, 51, 243, 107, 178, 220,
-			12, 36, 31, 16, 33, 100, 234, 229, 131, 68, 132, 116, 83, 47,
-			31, 61, 134, 127, 69, 135, 130, 187, 76, 253, 77, 218, 176, 245,
-			175, 117, 40, 89, 74, 110, 196, 211, 139, 228, 129, 252, 102, 212,
-			104, 70, 92, 18, 73, 175, 63, 220, 132, 133, 153, 166, 48, 89,
-			183, 48, 106, 100, 239, 39, 160, 156, 233, 217, 181, 54, 49, 102,
-			24, 249, 12, 8, 12, 75, 6, 18, 92, 194, 41, 14, 146, 24,
-			171, 230, 44, 55, 87, 73, 163, 25, 52, 252, 208, 97, 79, 208,
-			5, 242, 156, 195, 94, 183, 59, 136, 125, 98, 209, 121, 224, 220,
-			113, 236, 26, 128, 28, 71, 252, 65, 130, 61, 37, 222, 1, 89,
-			118, 205, 165, 45, 195, 194, 23, 235, 186, 210, 8, 23, 172, 121,
-			74, 160, 183, 64, 34, 223, 175, 197, 114, 117, 134, 242, 77, 27,
-			201, 96, 128, 108, 114, 215, 13, 28, 110, 185, 51, 8, 239, 31,
-			112, 161, 161, 109, 132, 55, 41, 96, 195, 25, 75, 237, 3, 160,
-			19, 36, 29, 74, 72, 234, 185, 210, 61, 127, 119, 151, 86, 161,
-			129, 211, 168, 217, 21, 14, 162, 196, 159, 44, 217, 122, 176, 35,
-			86, 45, 23, 250, 178, 49, 237, 202, 208, 81, 20, 107, 170, 11,
-			153, 250, 155, 246, 14, 138, 144, 110, 234, 111, 58, 157, 23, 227,
-			157, 53, 245, 151, 219, 198, 155, 246, 138, 120, 107, 123, 52, 222,
-			223, 252, 227, 157, 205, 208, 81, 20, 227, 157, 69, 166, 254, 178,
-			28, 239, 172, 110, 234, 47, 159, 206, 227, 143, 178, 241, 238, 54,
-			245, 59, 218, 9, 235, 23, 117, 240, 195, 202, 100, 162, 220, 122,
-			173, 21, 200, 236, 209, 144, 127, 19, 15, 121, 119, 134, 14, 164,
-			24, 242, 110, 100, 234, 119, 246, 138, 45, 189, 91, 55, 245, 59,
-			71, 143, 227, 99, 48, 226, 216, 212, 215, 181, 67, 214, 62, 134,
-			34, 227, 211, 21, 157, 24, 86, 150, 7, 131, 203, 59, 193, 200,
-			96, 100, 234, 235, 59, 4, 132, 46, 214, 77, 125, 189, 255, 32,
-			62, 14, 229, 245, 152, 250, 91, 180, 195, 214, 126, 230, 27, 207,
-			175, 85, 59, 23, 216, 147, 161, 233, 68, 129, 61, 200, 212, 223,
-			178, 227, 128, 8, 233, 166, 254, 150, 129, 67, 248, 31, 33, 176,
-			23, 202, 124, 7, 74, 125, 47, 66, 214, 219, 136, 34, 27, 79,
-			106, 205, 72, 111, 171, 28, 64, 246, 126, 55, 81, 55, 140, 175,
-			248, 224, 101, 54, 226, 141, 231, 19, 82, 232, 53, 1, 253, 146,
-			242, 30, 102, 91, 100, 124, 7, 202, 238, 226, 240, 182, 41, 211,
-			248, 78, 164, 237, 178, 198, 90, 48, 0, 59, 66, 255, 181, 116,
-			196, 118, 129, 180, 75, 139, 232, 18, 65, 192, 153, 204, 238, 16,
-			65, 64, 153, 236, 51, 177, 43, 208, 116, 191, 7, 105, 131, 214,
-			139, 132, 75, 194, 195, 60, 9, 185, 63, 107, 120, 225, 173, 187,
-			17, 105, 112, 97, 57, 25, 244, 107, 85, 230, 18, 52, 8, 163,
-			161, 60, 147, 72, 69, 107, 142, 39, 1, 133, 221, 186, 211, 146,
-			74, 210, 133, 12, 168, 75, 6, 51, 52, 216, 99, 137, 32, 80,
-			210, 127, 84, 4, 117, 26, 60, 113, 18, 207, 128, 153, 82, 230,
-			251, 80, 234, 67, 8, 89, 69, 210, 34, 184, 79, 120, 114, 77,
-			34, 6, 130, 187, 218, 150, 22, 240, 62, 215, 145, 105, 124, 31,
-			202, 238, 195, 231, 4, 252, 224, 15, 32, 173, 223, 58, 193, 167,
-			88, 178, 209, 66, 76, 23, 123, 119, 220, 46, 204, 142, 104, 182,
-			46, 5, 26, 240, 7, 80, 118, 175, 2, 13, 248, 3, 232, 128,
-			133, 111, 9, 100, 192, 31, 66, 218, 113, 235, 6, 3, 150, 115,
-			133, 166, 11, 195, 31, 17, 96, 193, 161, 120, 255, 175, 230, 73,
-			20, 52, 189, 138, 106, 250, 233, 57, 118, 0, 62, 62, 252, 102,
-			16, 195, 249, 101, 160, 224, 126, 5, 206, 239, 135, 208, 0, 81,
-			224, 252, 126, 8, 29, 61, 134, 159, 0, 34, 52, 211, 120, 55,
-			210, 14, 88, 167, 201, 115, 107, 142, 180, 38, 80, 106, 87, 48,
-			46, 65, 13, 35, 110, 172, 150, 134, 188, 25, 17, 68, 52, 216,
-			181, 91, 4, 117, 26, 220, 183, 31, 239, 224, 40, 176, 153, 31,
-			65, 218, 63, 67, 58, 251, 12, 72, 83, 63, 130, 240, 118, 137,
-			236, 153, 50, 141, 31, 67, 70, 206, 58, 67, 166, 219, 64, 46,
-			107, 235, 220, 103, 126, 43, 204, 100, 208, 244, 84, 184, 214, 52,
-			148, 209, 157, 128, 107, 253, 49, 132, 15, 38, 224, 90, 127, 12,
-			145, 35, 178, 86, 120, 138, 222, 184, 86, 230, 97, 127, 243, 90,
-			217, 235, 182, 82, 43, 123, 223, 86, 106, 101, 47, 220, 228, 8,
-			190, 26, 163, 184, 254, 36, 50, 142, 90, 99, 157, 107, 21, 142,
-			241, 55, 173, 150, 246, 254, 79, 182, 34, 184, 254, 36, 194, 135,
-			18, 8, 174, 63, 137, 142, 228, 100, 181, 186, 105, 252, 244, 38,
-			213, 10, 191, 238, 155, 86, 171, 167, 161, 144, 36, 102, 235, 79,
-			171, 213, 234, 80, 205, 145, 28, 30, 143, 49, 91, 223, 139, 140,
-			99, 214, 89, 165, 218, 4, 104, 236, 86, 198, 214, 0, 140, 202,
-			22, 212, 214, 247, 34, 124, 56, 129, 218, 250, 94, 148, 59, 42,
-			235, 77, 155, 198, 251, 54, 173, 119, 43, 163, 155, 102, 165, 36,
-			33, 91, 223, 167, 214, 155, 214, 105, 68, 238, 168, 10, 217, 250,
-			126, 100, 28, 183, 206, 109, 84, 239, 150, 198, 55, 147, 134, 98,
-			146, 144, 173, 239, 71, 152, 36, 32, 91, 223, 79, 87, 242, 68,
-			12, 217, 250, 129, 77, 43, 222, 210, 8, 119, 1, 146, 166, 82,
-			113, 23, 96, 105, 42, 21, 119, 1, 154, 230, 209, 99, 248, 2,
-			175, 56, 107, 26, 63, 143, 12, 203, 58, 161, 108, 34, 148, 183,
-			106, 122, 76, 180, 33, 95, 230, 90, 170, 202, 166, 33, 99, 54,
-			142, 64, 52, 162, 59, 134, 226, 205, 234, 52, 98, 255, 1, 48,
-			179, 213, 53, 192, 204, 212, 4, 50, 169, 110, 64, 80, 236, 71,
-			122, 134, 6, 123, 118, 136, 32, 224, 109, 238, 220, 37, 130, 144,
-			119, 239, 62, 169, 102, 242, 207, 63, 130, 30, 12, 108, 70, 162,
-			198, 221, 79, 191, 228, 235, 235, 110, 246, 220, 22, 51, 177, 123,
-			55, 167, 46, 247, 46, 132, 115, 18, 198, 136, 75, 221, 175, 217,
-			110, 173, 25, 56, 101, 59, 114, 132, 134, 201, 198, 40, 41, 83,
-			120, 123, 162, 213, 92, 7, 160, 3, 90, 140, 82, 67, 73, 218,
-			249, 151, 183, 69, 113, 116, 152, 123, 39, 194, 123, 58, 166, 83,
-			213, 80, 80, 66, 13, 229, 97, 92, 23, 182, 233, 174, 232, 109,
-			186, 43, 185, 255, 75, 195, 71, 55, 237, 25, 238, 48, 240, 141,
-			184, 27, 238, 18, 119, 236, 154, 112, 243, 120, 101, 19, 55, 116,
-			27, 151, 84, 40, 241, 98, 202, 113, 129, 230, 66, 231, 238, 29,
-			221, 180, 123, 149, 194, 197, 99, 83, 178, 159, 173, 159, 66, 56,
-			43, 170, 131, 103, 113, 254, 123, 209, 94, 101, 122, 65, 233, 114,
-			143, 136, 43, 174, 58, 230, 19, 160, 191, 18, 68, 204, 219, 234,
-			253, 245, 45, 186, 33, 53, 120, 90, 61, 143, 179, 142, 87, 101,
-			25, 245, 251, 102, 236, 114, 188, 42, 13, 229, 222, 221, 141, 15,
-			109, 222, 164, 215, 125, 74, 152, 14, 222, 33, 59, 42, 140, 236,
-			72, 40, 137, 116, 24, 239, 205, 73, 151, 67, 205, 252, 42, 110,
-			119, 213, 160, 249, 22, 108, 5, 77, 111, 145, 249, 132, 229, 44,
-			214, 34, 183, 58, 8, 247, 167, 161, 202, 167, 30, 184, 74, 206,
-			245, 114, 165, 239, 242, 190, 160, 233, 93, 163, 53, 36, 227, 67,
-			115, 21, 239, 12, 156, 138, 227, 73, 223, 210, 225, 254, 204, 67,
-			182, 178, 12, 229, 8, 157, 159, 29, 129, 26, 12, 173, 255, 142,
-			240, 246, 68, 63, 108, 101, 34, 62, 137, 251, 35, 63, 178, 107,
-			139, 180, 135, 164, 2, 136, 162, 128, 4, 174, 118, 33, 73, 185,
-			233, 77, 242, 4, 162, 78, 243, 49, 188, 63, 206, 158, 232, 224,
-			144, 131, 98, 237, 17, 121, 213, 206, 9, 205, 34, 62, 24, 103,
-			84, 84, 79, 100, 110, 230, 124, 212, 18, 185, 23, 100, 18, 217,
-			222, 95, 71, 120, 71, 178, 179, 191, 30, 142, 140, 207, 225, 189,
-			194, 191, 255, 98, 194, 163, 14, 215, 227, 219, 45, 190, 150, 228,
-			199, 82, 21, 116, 195, 164, 171, 120, 218, 116, 58, 192, 3, 237,
-			3, 60, 46, 19, 149, 213, 12, 214, 223, 32, 188, 61, 49, 186,
-			255, 96, 155, 98, 22, 240, 174, 53, 59, 84, 199, 148, 171, 127,
-			161, 193, 108, 185, 111, 205, 14, 227, 161, 44, 55, 189, 48, 247,
-			231, 26, 38, 173, 187, 249, 92, 100, 51, 85, 230, 251, 159, 151,
-			183, 59, 111, 232, 215, 238, 127, 100, 180, 86, 162, 174, 62, 113,
-			181, 109, 217, 230, 127, 9, 37, 212, 153, 164, 226, 220, 235, 190,
-			115, 158, 197, 93, 156, 239, 128, 142, 237, 88, 46, 83, 222, 12,
-			203, 34, 101, 238, 63, 32, 124, 100, 147, 78, 224, 231, 239, 92,
-			107, 135, 178, 51, 184, 131, 19, 220, 78, 197, 116, 62, 31, 205,
-			113, 156, 173, 4, 46, 24, 218, 243, 142, 216, 128, 161, 145, 5,
-			141, 243, 228, 101, 153, 49, 247, 223, 116, 198, 204, 180, 165, 49,
-			223, 132, 183, 173, 176, 253, 114, 145, 94, 153, 249, 82, 185, 180,
-			197, 42, 10, 202, 94, 43, 171, 237, 89, 137, 35, 205, 231, 49,
-			184, 15, 231, 165, 179, 6, 60, 177, 229, 210, 105, 206, 68, 217,
-			221, 43, 34, 202, 250, 78, 132, 119, 117, 168, 222, 60, 141, 251,
-			68, 139, 162, 181, 192, 9, 215, 252, 90, 149, 239, 223, 189, 252,
-			195, 188, 136, 55, 175, 226, 131, 21, 58, 118, 149, 102, 228, 222,
-			113, 22, 219, 51, 178, 109, 188, 95, 73, 116, 173, 165, 12, 235,
-			31, 33, 220, 215, 70, 169, 121, 16, 227, 186, 235, 45, 222, 117,
-			189, 170, 127, 151, 215, 223, 93, 119, 189, 231, 32, 194, 60, 137,
-			119, 178, 126, 105, 173, 106, 7, 68, 199, 20, 142, 226, 221, 113,
-			7, 42, 169, 233, 212, 70, 101, 83, 246, 135, 204, 145, 251, 54,
-			140, 7, 54, 155, 96, 175, 255, 154, 123, 169, 101, 146, 177, 133,
-			119, 241, 193, 214, 133, 58, 215, 146, 115, 236, 185, 196, 28, 75,
-			67, 225, 143, 63, 104, 225, 162, 31, 213, 41, 246, 189, 6, 238,
-			81, 106, 53, 247, 224, 140, 27, 46, 214, 29, 182, 149, 102, 203,
-			105, 55, 188, 233, 128, 74, 171, 178, 103, 67, 215, 194, 198, 205,
-			6, 212, 140, 191, 81, 10, 232, 206, 109, 94, 7, 80, 11, 57,
-			237, 58, 230, 102, 76, 128, 58, 61, 23, 218, 11, 122, 165, 157,
-			69, 98, 140, 224, 141, 135, 239, 220, 251, 48, 75, 255, 163, 237,
-			192, 181, 112, 182, 161, 170, 71, 167, 203, 50, 220, 225, 48, 190,
-			63, 203, 222, 114, 24, 127, 173, 199, 234, 73, 188, 179, 211, 145,
-			154, 46, 239, 104, 38, 206, 83, 186, 100, 37, 103, 21, 194, 60,
-			74, 151, 187, 5, 27, 21, 90, 63, 175, 227, 110, 57, 77, 54,
-			154, 11, 121, 108, 118, 96, 232, 216, 76, 232, 13, 90, 121, 185,
-			227, 120, 7, 171, 177, 133, 245, 219, 14, 177, 50, 153, 135, 217,
-			174, 16, 179, 222, 108, 144, 175, 63, 236, 36, 111, 101, 193, 183,
-			67, 241, 130, 241, 182, 254, 83, 59, 127, 184, 217, 24, 151, 241,
-			62, 199, 14, 106, 46, 157, 186, 15, 60, 216, 123, 68, 214, 217,
-			175, 231, 160, 143, 125, 191, 198, 16, 106, 229, 57, 250, 173, 8,
-			247, 194, 17, 174, 46, 232, 115, 15, 120, 61, 102, 202, 212, 231,
-			31, 234, 82, 157, 75, 153, 111, 198, 59, 132, 255, 49, 54, 50,
-			230, 216, 131, 51, 91, 214, 217, 7, 202, 243, 181, 121, 154, 255,
-			87, 239, 65, 76, 185, 251, 219, 140, 71, 174, 230, 31, 41, 119,
-			191, 30, 128, 177, 92, 227, 123, 119, 172, 241, 45, 60, 205, 107,
-			166, 190, 55, 117, 5, 223, 96, 10, 221, 7, 82, 167, 145, 117,
-			89, 160, 190, 135, 170, 43, 121, 62, 216, 225, 255, 203, 221, 213,
-			199, 216, 113, 93, 117, 223, 185, 119, 223, 190, 92, 191, 117, 236,
-			73, 108, 199, 227, 143, 189, 254, 88, 123, 159, 253, 246, 121, 119,
-			189, 206, 135, 147, 24, 28, 183, 105, 83, 37, 174, 211, 186, 16,
-			36, 4, 204, 190, 55, 251, 118, 200, 219, 153, 237, 204, 60, 111,
-			183, 10, 40, 77, 213, 144, 170, 170, 138, 42, 90, 64, 105, 35,
-			164, 6, 2, 138, 90, 10, 82, 65, 77, 136, 80, 170, 160, 72,
-			80, 164, 146, 170, 84, 128, 130, 2, 40, 80, 85, 170, 130, 212,
-			162, 16, 33, 33, 116, 206, 253, 156, 121, 111, 55, 235, 180, 132,
-			210, 228, 31, 223, 179, 111, 230, 158, 185, 247, 220, 243, 113, 239,
-			239, 158, 83, 61, 171, 47, 213, 253, 113, 128, 200, 251, 234, 55,
-			242, 79, 83, 141, 68, 62, 202, 142, 221, 26, 124, 148, 98, 104,
-			165, 239, 108, 43, 119, 67, 100, 10, 135, 236, 220, 27, 116, 223,
-			217, 82, 167, 243, 113, 210, 227, 34, 143, 123, 73, 216, 207, 69,
-			156, 96, 245, 30, 60, 101, 31, 113, 0, 11, 18, 141, 181, 224,
-			100, 110, 45, 46, 186, 81, 1, 67, 154, 32, 235, 120, 89, 24,
-			122, 143, 147, 94, 91, 220, 147, 20, 81, 210, 85, 39, 180, 152,
-			232, 114, 93, 100, 81, 39, 198, 235, 205, 41, 23, 49, 38, 215,
-			53, 39, 96, 176, 176, 186, 81, 39, 198, 201, 145, 165, 4, 226,
-			92, 188, 239, 242, 69, 115, 210, 91, 164, 38, 75, 141, 186, 199,
-			42, 84, 218, 93, 231, 29, 242, 112, 216, 150, 12, 144, 71, 238,
-			208, 119, 89, 155, 137, 20, 239, 48, 99, 237, 124, 46, 46, 58,
-			215, 101, 117, 183, 234, 34, 56, 46, 217, 117, 185, 92, 45, 104,
-			18, 45, 132, 134, 65, 206, 159, 153, 43, 225, 185, 143, 214, 111,
-			114, 240, 220, 71, 247, 221, 238, 224, 185, 143, 237, 90, 224, 95,
-			48, 101, 244, 155, 236, 228, 205, 193, 111, 144, 210, 244, 229, 150,
-			197, 13, 231, 14, 129, 239, 155, 13, 239, 166, 163, 219, 146, 165,
-			246, 23, 215, 197, 7, 238, 193, 81, 93, 78, 215, 20, 88, 77,
-			247, 93, 170, 153, 223, 172, 239, 225, 182, 102, 126, 115, 239, 45,
-			220, 214, 204, 63, 185, 107, 158, 115, 137, 79, 159, 217, 118, 150,
-			24, 156, 249, 76, 253, 36, 191, 87, 103, 30, 62, 205, 246, 6,
-			63, 53, 92, 52, 23, 56, 151, 247, 8, 202, 69, 174, 236, 21,
-			252, 126, 154, 62, 8, 186, 102, 181, 132, 62, 62, 205, 184, 131,
-			62, 62, 189, 221, 119, 208, 199, 167, 119, 239, 225, 127, 66, 116,
-			130, 226, 5, 118, 54, 248, 125, 50, 162, 198, 93, 185, 100, 148,
-			76, 219, 175, 207, 109, 150, 82, 117, 135, 162, 92, 138, 194, 60,
-			163, 46, 24, 216, 169, 137, 165, 32, 154, 236, 252, 26, 116, 170,
-			110, 148, 203, 78, 36, 100, 191, 208, 69, 228, 74, 203, 170, 88,
-			139, 59, 54, 165, 48, 97, 192, 120, 195, 201, 130, 188, 48, 113,
-			196, 201, 130, 188, 112, 116, 214, 201, 130, 188, 112, 102, 129, 207,
-			200, 4, 195, 183, 110, 123, 39, 9, 14, 15, 225, 69, 203, 215,
-			58, 28, 120, 244, 173, 245, 131, 252, 130, 70, 71, 159, 99, 123,
-			131, 5, 113, 65, 101, 130, 112, 115, 10, 187, 72, 12, 52, 75,
-			110, 245, 152, 18, 106, 246, 28, 227, 14, 106, 246, 220, 118, 223,
-			65, 205, 158, 219, 189, 135, 95, 213, 168, 217, 243, 236, 166, 32,
-			86, 176, 203, 85, 157, 144, 101, 75, 216, 75, 190, 17, 248, 242,
-			205, 177, 151, 48, 146, 231, 217, 118, 7, 122, 123, 190, 113, 131,
-			3, 189, 61, 191, 103, 47, 255, 6, 49, 216, 91, 118, 32, 248,
-			154, 20, 157, 82, 237, 34, 113, 161, 143, 16, 40, 8, 114, 112,
-			241, 32, 31, 230, 122, 137, 25, 101, 113, 57, 139, 150, 162, 204,
-			249, 137, 73, 89, 97, 94, 56, 29, 47, 217, 27, 243, 205, 150,
-			202, 205, 195, 75, 29, 162, 42, 46, 223, 73, 151, 178, 85, 33,
-			118, 163, 34, 140, 251, 114, 101, 131, 132, 74, 7, 179, 140, 17,
-			54, 211, 131, 24, 225, 237, 123, 93, 140, 112, 176, 31, 215, 177,
-			231, 179, 119, 109, 251, 32, 49, 73, 120, 223, 85, 63, 197, 223,
-			203, 25, 3, 59, 199, 222, 195, 62, 48, 22, 92, 16, 122, 251,
-			89, 1, 157, 20, 206, 60, 94, 137, 212, 92, 56, 200, 28, 164,
-			234, 157, 104, 142, 32, 43, 157, 51, 23, 107, 213, 190, 167, 113,
-			35, 255, 4, 225, 53, 104, 130, 20, 94, 170, 79, 6, 15, 89,
-			164, 25, 116, 178, 24, 97, 182, 71, 149, 171, 88, 132, 189, 232,
-			206, 57, 141, 2, 199, 21, 42, 99, 62, 110, 158, 104, 225, 111,
-			230, 13, 82, 92, 191, 40, 94, 89, 137, 186, 113, 88, 96, 21,
-			109, 76, 172, 128, 136, 16, 165, 13, 243, 84, 32, 90, 101, 7,
-			31, 151, 204, 140, 1, 55, 19, 182, 77, 124, 122, 105, 71, 96,
-			219, 212, 167, 151, 14, 30, 226, 119, 40, 230, 137, 79, 239, 175,
-			207, 5, 51, 35, 203, 236, 58, 92, 76, 99, 173, 200, 60, 190,
-			26, 53, 157, 222, 64, 54, 239, 175, 31, 182, 109, 120, 221, 145,
-			150, 109, 83, 159, 222, 127, 122, 150, 159, 83, 189, 121, 62, 189,
-			82, 63, 29, 156, 194, 222, 176, 58, 229, 200, 190, 162, 15, 141,
-			232, 203, 171, 193, 195, 182, 47, 96, 253, 202, 145, 147, 182, 77,
-			125, 122, 101, 166, 205, 127, 167, 174, 19, 44, 247, 217, 177, 224,
-			211, 117, 11, 164, 217, 124, 154, 245, 44, 59, 41, 242, 163, 16,
-			209, 203, 234, 239, 14, 130, 104, 126, 65, 172, 69, 209, 131, 221,
-			112, 93, 214, 20, 110, 169, 82, 154, 106, 57, 113, 252, 43, 124,
-			159, 41, 135, 46, 143, 213, 195, 66, 100, 152, 4, 118, 90, 102,
-			28, 107, 182, 109, 165, 165, 20, 109, 118, 148, 228, 97, 17, 113,
-			227, 22, 22, 235, 171, 202, 67, 201, 34, 4, 252, 160, 138, 144,
-			253, 72, 148, 95, 30, 69, 137, 116, 11, 84, 167, 96, 102, 223,
-			159, 90, 190, 101, 54, 40, 87, 254, 48, 103, 141, 169, 135, 156,
-			46, 153, 143, 129, 33, 224, 86, 202, 12, 0, 41, 82, 165, 162,
-			98, 48, 35, 221, 168, 34, 170, 206, 155, 120, 233, 85, 37, 121,
-			117, 197, 21, 239, 191, 65, 252, 109, 106, 201, 40, 207, 231, 172,
-			97, 59, 111, 41, 80, 91, 212, 21, 115, 48, 58, 103, 97, 110,
-			54, 98, 90, 247, 244, 128, 147, 32, 92, 194, 26, 101, 202, 157,
-			124, 37, 236, 247, 65, 183, 174, 70, 89, 156, 118, 65, 246, 48,
-			201, 82, 33, 30, 80, 182, 92, 213, 66, 205, 69, 244, 161, 16,
-			75, 27, 204, 47, 112, 219, 87, 104, 122, 139, 19, 241, 129, 43,
-			23, 157, 52, 39, 231, 16, 163, 137, 29, 67, 236, 96, 166, 126,
-			90, 254, 178, 217, 82, 25, 189, 20, 164, 20, 185, 133, 207, 229,
-			162, 131, 179, 134, 37, 145, 228, 10, 208, 143, 14, 86, 129, 247,
-			7, 164, 77, 144, 127, 54, 101, 153, 238, 206, 98, 96, 67, 191,
-			189, 93, 233, 253, 190, 52, 113, 255, 188, 81, 231, 182, 111, 94,
-			233, 188, 165, 122, 15, 213, 218, 76, 116, 143, 170, 192, 89, 38,
-			43, 36, 117, 165, 55, 252, 192, 137, 220, 252, 78, 119, 141, 150,
-			46, 86, 76, 41, 98, 88, 136, 91, 195, 21, 201, 141, 154, 130,
-			94, 26, 229, 28, 211, 200, 67, 119, 170, 19, 249, 59, 248, 170,
-			247, 130, 96, 172, 197, 16, 192, 218, 175, 187, 50, 136, 114, 228,
-			37, 173, 142, 131, 251, 106, 238, 126, 226, 144, 188, 219, 116, 234,
-			12, 84, 68, 195, 73, 174, 222, 159, 216, 237, 36, 87, 239, 239,
-			153, 116, 146, 171, 247, 143, 28, 229, 191, 170, 147, 171, 175, 178,
-			243, 193, 7, 109, 233, 87, 109, 248, 74, 33, 140, 185, 42, 164,
-			156, 40, 89, 1, 172, 228, 146, 133, 153, 51, 55, 26, 220, 156,
-			169, 112, 110, 93, 22, 88, 114, 158, 214, 137, 217, 25, 48, 208,
-			112, 82, 184, 175, 78, 156, 114, 82, 184, 175, 182, 110, 115, 82,
-			184, 175, 222, 113, 39, 143, 101, 17, 247, 193, 182, 127, 33, 36,
-			248, 121, 241, 126, 21, 53, 101, 81, 63, 186, 138, 142, 92, 106,
-			35, 162, 164, 39, 214, 20, 94, 167, 114, 177, 214, 248, 183, 220,
-			197, 255, 197, 9, 102, 241, 25, 44, 174, 196, 133, 147, 172, 125,
-			80, 63, 142, 78, 26, 38, 107, 95, 251, 161, 156, 52, 153, 184,
-			125, 141, 113, 39, 113, 251, 154, 114, 210, 100, 226, 246, 181, 221,
-			123, 248, 147, 186, 178, 50, 125, 136, 221, 20, 124, 150, 188, 109,
-			110, 154, 44, 2, 184, 154, 174, 14, 100, 1, 142, 120, 201, 105,
-			168, 172, 79, 182, 2, 86, 67, 163, 49, 233, 67, 202, 167, 67,
-			48, 38, 125, 72, 249, 116, 136, 197, 164, 15, 237, 217, 203, 47,
-			105, 40, 230, 195, 132, 29, 80, 5, 214, 203, 229, 40, 175, 173,
-			103, 139, 207, 124, 152, 48, 238, 224, 51, 31, 38, 219, 247, 58,
-			248, 204, 135, 73, 176, 223, 226, 51, 31, 33, 236, 115, 100, 204,
-			193, 103, 62, 66, 26, 251, 248, 103, 61, 11, 208, 124, 140, 212,
-			39, 131, 143, 121, 178, 34, 120, 111, 200, 154, 183, 197, 40, 183,
-			199, 122, 61, 92, 108, 221, 229, 41, 153, 144, 139, 89, 138, 59,
-			67, 8, 113, 119, 119, 166, 140, 5, 209, 88, 117, 149, 222, 106,
-			11, 27, 136, 182, 252, 27, 134, 250, 82, 78, 165, 7, 140, 102,
-			161, 226, 49, 72, 64, 155, 113, 11, 42, 168, 211, 199, 72, 125,
-			162, 132, 58, 125, 140, 236, 8, 74, 168, 211, 199, 200, 193, 67,
-			252, 25, 98, 97, 167, 159, 36, 245, 118, 240, 7, 164, 2, 233,
-			182, 85, 204, 101, 10, 192, 176, 43, 47, 153, 155, 114, 239, 217,
-			32, 1, 163, 127, 33, 41, 145, 36, 204, 14, 254, 33, 85, 114,
-			190, 22, 194, 10, 239, 137, 34, 204, 31, 108, 154, 151, 229, 149,
-			28, 119, 246, 21, 24, 88, 182, 4, 122, 99, 104, 46, 117, 33,
-			127, 21, 114, 86, 240, 174, 159, 116, 191, 151, 224, 215, 236, 104,
-			150, 240, 174, 159, 36, 173, 25, 254, 167, 158, 5, 188, 126, 134,
-			212, 79, 5, 191, 231, 189, 249, 247, 34, 84, 222, 112, 6, 98,
-			192, 29, 20, 227, 219, 58, 0, 170, 163, 114, 239, 182, 171, 202,
-			28, 217, 159, 113, 19, 169, 79, 163, 18, 81, 125, 98, 151, 238,
-			203, 240, 71, 205, 17, 189, 150, 80, 190, 159, 113, 7, 219, 195,
-			194, 253, 59, 142, 151, 80, 190, 159, 33, 205, 147, 252, 91, 196,
-			194, 124, 31, 39, 245, 217, 224, 133, 173, 10, 215, 200, 209, 221,
-			232, 163, 55, 29, 95, 249, 66, 62, 252, 145, 91, 28, 9, 190,
-			201, 80, 208, 49, 252, 174, 137, 18, 242, 248, 113, 178, 227, 84,
-			9, 121, 252, 56, 105, 159, 230, 223, 240, 52, 90, 244, 73, 194,
-			218, 193, 243, 158, 123, 209, 104, 49, 75, 31, 140, 18, 209, 77,
-			215, 18, 177, 184, 94, 94, 230, 109, 19, 57, 218, 162, 158, 169,
-			172, 228, 200, 213, 246, 85, 148, 116, 214, 91, 50, 124, 82, 110,
-			229, 70, 250, 78, 76, 131, 142, 188, 83, 204, 53, 117, 221, 81,
-			91, 26, 96, 41, 134, 24, 29, 95, 176, 186, 218, 143, 229, 142,
-			152, 153, 31, 140, 90, 192, 73, 24, 10, 90, 206, 113, 49, 99,
-			174, 101, 160, 83, 33, 223, 36, 93, 96, 93, 156, 20, 70, 119,
-			53, 202, 180, 17, 190, 120, 175, 222, 57, 150, 134, 79, 72, 5,
-			106, 186, 195, 52, 161, 131, 126, 17, 207, 92, 188, 87, 20, 217,
-			250, 47, 167, 139, 40, 8, 106, 50, 76, 133, 86, 137, 184, 125,
-			146, 176, 134, 131, 184, 125, 146, 76, 4, 14, 226, 246, 73, 178,
-			191, 233, 32, 110, 159, 4, 53, 112, 63, 90, 24, 226, 215, 158,
-			34, 236, 143, 9, 196, 231, 229, 227, 48, 39, 73, 120, 104, 174,
-			30, 232, 152, 103, 21, 226, 84, 116, 203, 205, 6, 180, 102, 135,
-			130, 230, 121, 138, 52, 2, 254, 135, 82, 246, 9, 24, 169, 167,
-			73, 253, 108, 240, 4, 81, 233, 138, 213, 41, 88, 41, 250, 212,
-			183, 54, 100, 64, 102, 98, 159, 17, 229, 183, 184, 52, 255, 234,
-			38, 11, 6, 106, 120, 221, 66, 221, 0, 210, 151, 196, 90, 38,
-			117, 38, 94, 244, 6, 255, 65, 38, 110, 84, 206, 18, 199, 149,
-			99, 67, 110, 35, 213, 4, 43, 74, 63, 77, 234, 135, 45, 129,
-			0, 225, 200, 172, 37, 80, 32, 156, 89, 192, 200, 26, 8, 196,
-			103, 95, 34, 245, 147, 193, 201, 145, 247, 174, 53, 150, 74, 88,
-			164, 149, 211, 29, 40, 239, 47, 145, 250, 14, 75, 192, 183, 93,
-			63, 101, 9, 20, 8, 211, 77, 126, 70, 117, 231, 249, 236, 143,
-			72, 189, 21, 28, 149, 55, 97, 204, 169, 224, 116, 222, 196, 209,
-			136, 186, 182, 62, 153, 121, 139, 199, 240, 169, 157, 150, 80, 3,
-			194, 174, 253, 150, 64, 128, 112, 224, 132, 37, 80, 32, 156, 60,
-			197, 255, 74, 174, 94, 230, 179, 103, 9, 187, 61, 248, 51, 79,
-			39, 180, 205, 183, 108, 49, 134, 52, 26, 119, 43, 125, 111, 105,
-			21, 235, 108, 208, 33, 72, 223, 82, 30, 21, 98, 86, 30, 194,
-			173, 196, 69, 121, 181, 205, 205, 182, 197, 221, 131, 12, 253, 232,
-			190, 253, 51, 170, 86, 195, 173, 190, 196, 195, 69, 30, 131, 11,
-			115, 86, 71, 153, 185, 8, 123, 120, 145, 47, 206, 43, 33, 151,
-			227, 139, 200, 13, 89, 16, 82, 220, 83, 224, 50, 95, 105, 102,
-			226, 89, 71, 67, 184, 217, 74, 155, 102, 225, 50, 57, 154, 122,
-			225, 178, 26, 52, 39, 244, 21, 33, 70, 160, 121, 224, 102, 221,
-			164, 208, 188, 237, 156, 114, 13, 61, 191, 246, 28, 97, 223, 52,
-			174, 33, 204, 220, 115, 224, 26, 170, 85, 135, 23, 212, 158, 255,
-			127, 180, 234, 48, 2, 4, 142, 15, 91, 2, 1, 130, 89, 117,
-			242, 78, 220, 243, 118, 213, 161, 184, 190, 240, 214, 86, 157, 135,
-			171, 238, 5, 187, 234, 228, 205, 182, 23, 236, 170, 147, 119, 219,
-			94, 176, 171, 206, 131, 85, 247, 226, 53, 174, 58, 15, 87, 221,
-			139, 118, 213, 121, 184, 234, 94, 180, 171, 206, 195, 207, 120, 209,
-			174, 58, 15, 87, 221, 139, 176, 234, 30, 165, 170, 103, 234, 179,
-			151, 72, 125, 42, 248, 15, 111, 228, 149, 48, 88, 112, 97, 213,
-			61, 120, 171, 46, 3, 31, 237, 132, 72, 151, 161, 165, 82, 183,
-			15, 187, 4, 182, 250, 157, 181, 23, 102, 83, 71, 134, 66, 40,
-			58, 48, 57, 107, 41, 47, 119, 157, 139, 233, 184, 29, 181, 225,
-			47, 168, 28, 154, 186, 120, 50, 110, 1, 154, 178, 238, 240, 156,
-			35, 134, 57, 198, 96, 54, 37, 203, 90, 170, 254, 10, 118, 18,
-			28, 75, 160, 56, 223, 160, 246, 7, 114, 89, 10, 92, 103, 201,
-			45, 15, 100, 62, 106, 32, 205, 204, 128, 191, 243, 18, 169, 55,
-			44, 129, 0, 97, 66, 88, 2, 78, 213, 209, 99, 252, 211, 99,
-			168, 49, 199, 124, 246, 207, 132, 157, 14, 62, 58, 166, 138, 129,
-			89, 109, 86, 178, 245, 213, 67, 211, 183, 236, 158, 152, 155, 195,
-			255, 87, 110, 137, 219, 43, 252, 252, 226, 189, 185, 8, 17, 49,
-			161, 0, 4, 131, 34, 213, 213, 81, 179, 200, 60, 216, 114, 50,
-			26, 67, 191, 121, 156, 244, 250, 120, 22, 27, 133, 246, 106, 24,
-			126, 190, 122, 65, 71, 12, 86, 179, 232, 42, 104, 214, 78, 148,
-			231, 58, 248, 198, 147, 105, 225, 148, 132, 194, 47, 152, 155, 117,
-			167, 255, 90, 13, 143, 115, 87, 245, 39, 192, 240, 140, 49, 20,
-			74, 109, 120, 198, 106, 208, 52, 30, 227, 24, 129, 230, 254, 147,
-			186, 73, 161, 57, 211, 230, 13, 44, 205, 87, 123, 149, 108, 251,
-			53, 143, 224, 245, 92, 48, 81, 175, 146, 250, 52, 191, 79, 149,
-			210, 99, 255, 70, 126, 20, 103, 182, 19, 186, 220, 30, 188, 207,
-			52, 9, 52, 183, 251, 186, 73, 161, 185, 123, 15, 94, 13, 198,
-			203, 217, 223, 37, 236, 140, 186, 26, 92, 218, 55, 211, 32, 46,
-			28, 69, 220, 71, 55, 93, 16, 134, 143, 53, 116, 179, 6, 205,
-			9, 161, 155, 248, 210, 195, 109, 221, 164, 208, 156, 155, 199, 3,
-			106, 70, 183, 249, 181, 239, 17, 246, 168, 55, 22, 220, 81, 46,
-			104, 89, 78, 88, 84, 148, 115, 80, 169, 68, 44, 206, 61, 103,
-			124, 57, 110, 237, 124, 143, 52, 38, 249, 221, 188, 6, 77, 24,
-			204, 215, 72, 125, 127, 112, 51, 126, 208, 181, 239, 220, 129, 62,
-			98, 106, 39, 228, 53, 109, 230, 152, 218, 9, 121, 141, 92, 191,
-			199, 18, 40, 16, 246, 5, 124, 77, 117, 77, 124, 246, 125, 82,
-			63, 16, 244, 254, 23, 247, 240, 74, 7, 173, 154, 19, 24, 255,
-			239, 147, 250, 245, 150, 128, 172, 236, 220, 107, 9, 20, 8, 193,
-			126, 254, 77, 162, 152, 245, 124, 246, 6, 169, 139, 159, 140, 67,
-			87, 253, 153, 96, 53, 222, 112, 39, 13, 230, 228, 13, 114, 253,
-			126, 75, 160, 64, 56, 52, 201, 95, 246, 212, 64, 96, 217, 254,
-			250, 129, 224, 235, 114, 59, 167, 34, 104, 21, 208, 144, 130, 138,
-			56, 59, 208, 218, 222, 44, 134, 185, 126, 56, 87, 78, 2, 146,
-			122, 113, 161, 111, 220, 155, 68, 98, 93, 243, 250, 38, 151, 103,
-			83, 73, 55, 148, 5, 114, 21, 120, 77, 91, 54, 245, 36, 12,
-			146, 182, 236, 113, 46, 226, 94, 146, 202, 162, 181, 58, 205, 134,
-			131, 111, 68, 227, 224, 0, 89, 150, 163, 4, 127, 98, 185, 215,
-			59, 252, 234, 188, 65, 25, 18, 85, 230, 206, 129, 101, 168, 163,
-			19, 243, 106, 147, 141, 131, 187, 137, 21, 69, 156, 255, 98, 55,
-			206, 100, 122, 122, 203, 153, 30, 112, 8, 171, 63, 230, 57, 178,
-			9, 150, 255, 99, 158, 35, 155, 20, 103, 32, 216, 143, 138, 114,
-			204, 175, 125, 220, 219, 246, 235, 74, 81, 130, 74, 253, 184, 87,
-			111, 242, 243, 156, 177, 49, 88, 219, 159, 240, 216, 29, 193, 44,
-			142, 184, 57, 97, 168, 108, 247, 15, 125, 167, 82, 22, 99, 222,
-			54, 134, 47, 104, 232, 102, 13, 154, 19, 77, 221, 36, 208, 60,
-			121, 139, 110, 82, 104, 158, 187, 157, 63, 70, 176, 115, 226, 179,
-			79, 121, 236, 68, 240, 97, 233, 196, 42, 48, 191, 193, 74, 233,
-			131, 136, 8, 145, 92, 145, 153, 136, 2, 228, 222, 73, 184, 25,
-			130, 129, 74, 150, 226, 222, 32, 179, 135, 39, 74, 253, 156, 200,
-			43, 201, 55, 245, 47, 67, 71, 231, 141, 225, 114, 255, 148, 199,
-			246, 233, 38, 114, 22, 28, 209, 77, 10, 205, 169, 227, 252, 49,
-			202, 33, 54, 170, 61, 238, 109, 251, 109, 74, 130, 55, 60, 113,
-			113, 99, 166, 43, 120, 36, 201, 174, 249, 72, 133, 9, 227, 14,
-			212, 9, 31, 112, 241, 78, 238, 129, 138, 184, 26, 135, 82, 80,
-			92, 160, 109, 187, 130, 9, 147, 169, 50, 45, 87, 48, 58, 232,
-			161, 233, 140, 166, 161, 180, 197, 106, 73, 150, 107, 255, 112, 123,
-			66, 54, 183, 32, 192, 5, 56, 97, 240, 70, 202, 99, 198, 135,
-			49, 155, 97, 191, 95, 1, 227, 113, 233, 220, 116, 162, 12, 87,
-			182, 113, 17, 13, 7, 88, 232, 32, 138, 68, 47, 69, 40, 236,
-			140, 243, 165, 51, 87, 231, 97, 22, 215, 34, 88, 59, 232, 102,
-			235, 15, 88, 140, 116, 73, 249, 149, 52, 139, 148, 186, 202, 85,
-			78, 142, 26, 241, 217, 227, 94, 253, 32, 202, 114, 13, 100, 249,
-			243, 158, 119, 66, 201, 114, 233, 4, 206, 188, 15, 220, 163, 213,
-			213, 254, 122, 91, 220, 167, 53, 132, 18, 130, 26, 10, 239, 231,
-			61, 111, 183, 110, 18, 104, 238, 57, 162, 155, 20, 154, 83, 199,
-			249, 167, 24, 244, 6, 70, 247, 105, 207, 251, 123, 143, 6, 255,
-			77, 55, 233, 16, 228, 1, 252, 172, 78, 154, 228, 113, 94, 72,
-			52, 129, 114, 31, 165, 218, 93, 142, 123, 203, 64, 67, 88, 162,
-			20, 115, 169, 236, 110, 59, 59, 117, 74, 255, 180, 9, 254, 33,
-			56, 38, 232, 126, 167, 25, 23, 33, 62, 232, 108, 223, 106, 159,
-			82, 59, 141, 50, 113, 173, 85, 140, 202, 78, 203, 176, 229, 68,
-			174, 227, 101, 173, 109, 13, 199, 82, 53, 231, 54, 81, 208, 144,
-			68, 88, 231, 29, 43, 191, 35, 82, 32, 196, 34, 43, 233, 146,
-			152, 198, 67, 227, 166, 152, 159, 117, 34, 32, 240, 92, 241, 9,
-			117, 22, 165, 121, 147, 202, 152, 59, 233, 98, 230, 102, 149, 214,
-			132, 161, 153, 155, 149, 49, 94, 83, 30, 182, 169, 88, 67, 115,
-			202, 13, 218, 49, 78, 80, 14, 211, 65, 14, 190, 128, 10, 170,
-			202, 28, 160, 230, 216, 48, 75, 141, 202, 181, 147, 24, 60, 229,
-			38, 130, 90, 17, 70, 45, 62, 232, 55, 61, 237, 241, 131, 252,
-			191, 24, 175, 65, 27, 4, 242, 25, 175, 118, 36, 248, 46, 171,
-			236, 180, 59, 65, 157, 229, 80, 150, 163, 145, 165, 53, 184, 88,
-			74, 7, 73, 87, 186, 83, 121, 63, 238, 202, 18, 34, 73, 55,
-			93, 67, 80, 101, 252, 225, 8, 6, 71, 173, 92, 131, 53, 25,
-			154, 114, 196, 61, 244, 226, 164, 2, 48, 189, 89, 6, 223, 189,
-			52, 237, 218, 88, 67, 86, 130, 174, 74, 131, 163, 65, 240, 16,
-			189, 204, 76, 174, 185, 225, 56, 87, 25, 50, 109, 65, 34, 198,
-			183, 20, 23, 242, 124, 176, 162, 189, 29, 244, 102, 212, 236, 112,
-			59, 61, 82, 240, 48, 252, 130, 73, 93, 137, 194, 36, 23, 115,
-			115, 250, 187, 173, 255, 0, 63, 198, 245, 132, 145, 83, 219, 152,
-			108, 245, 195, 60, 44, 226, 60, 94, 210, 176, 46, 125, 85, 75,
-			134, 215, 238, 135, 173, 68, 5, 55, 105, 150, 116, 77, 121, 251,
-			98, 49, 72, 180, 173, 49, 105, 154, 163, 171, 16, 131, 225, 84,
-			205, 149, 4, 92, 230, 145, 94, 140, 236, 196, 233, 165, 195, 245,
-			218, 49, 202, 84, 173, 60, 153, 172, 75, 178, 140, 9, 12, 85,
-			104, 101, 124, 59, 238, 116, 160, 49, 196, 139, 131, 98, 232, 59,
-			160, 231, 188, 19, 246, 141, 151, 80, 83, 222, 246, 51, 94, 109,
-			187, 37, 16, 32, 52, 14, 90, 2, 5, 130, 56, 204, 95, 27,
-			87, 2, 75, 124, 246, 119, 94, 173, 29, 252, 227, 120, 69, 96,
-			157, 123, 81, 35, 133, 215, 120, 82, 75, 92, 168, 248, 67, 171,
-			33, 93, 104, 10, 156, 159, 76, 133, 205, 122, 211, 30, 254, 110,
-			245, 5, 47, 103, 172, 106, 73, 95, 170, 200, 226, 94, 15, 183,
-			121, 92, 9, 62, 243, 230, 18, 236, 176, 108, 84, 35, 46, 47,
-			204, 4, 91, 164, 131, 206, 242, 40, 81, 229, 214, 177, 232, 70,
-			121, 220, 83, 240, 161, 78, 22, 33, 148, 67, 44, 129, 54, 196,
-			130, 94, 69, 90, 178, 214, 168, 149, 231, 102, 103, 167, 204, 238,
-			128, 84, 230, 114, 100, 76, 149, 38, 145, 23, 89, 10, 150, 88,
-			98, 194, 148, 81, 224, 230, 33, 9, 100, 111, 137, 126, 116, 53,
-			202, 194, 158, 94, 51, 230, 116, 35, 236, 227, 79, 176, 16, 119,
-			210, 137, 120, 117, 122, 204, 214, 130, 172, 90, 135, 123, 169, 106,
-			5, 40, 153, 111, 113, 23, 101, 111, 111, 57, 170, 42, 61, 221,
-			52, 202, 197, 165, 247, 94, 193, 140, 112, 42, 33, 220, 74, 132,
-			187, 64, 152, 54, 181, 40, 109, 5, 229, 157, 40, 1, 213, 43,
-			126, 246, 158, 123, 239, 53, 147, 85, 148, 124, 29, 101, 175, 108,
-			71, 96, 67, 210, 65, 6, 50, 209, 143, 114, 116, 121, 223, 45,
-			215, 196, 57, 113, 254, 110, 129, 255, 223, 33, 46, 187, 255, 155,
-			162, 147, 240, 223, 47, 148, 90, 247, 151, 38, 112, 104, 179, 170,
-			204, 33, 124, 216, 72, 46, 207, 57, 76, 156, 191, 44, 182, 192,
-			69, 149, 143, 17, 156, 76, 95, 106, 223, 213, 22, 239, 136, 179,
-			168, 163, 77, 176, 222, 166, 6, 17, 203, 76, 212, 19, 150, 92,
-			67, 110, 116, 110, 190, 190, 178, 18, 21, 153, 4, 254, 181, 197,
-			59, 109, 69, 239, 126, 180, 84, 192, 0, 202, 91, 65, 157, 116,
-			208, 239, 58, 101, 6, 197, 137, 126, 88, 68, 217, 137, 17, 198,
-			174, 221, 180, 74, 130, 140, 225, 138, 183, 74, 130, 160, 10, 104,
-			52, 45, 129, 2, 161, 53, 195, 239, 64, 31, 139, 248, 236, 101,
-			207, 59, 26, 200, 100, 138, 120, 145, 237, 26, 60, 44, 112, 179,
-			95, 246, 188, 27, 116, 19, 223, 118, 227, 33, 221, 164, 208, 60,
-			124, 132, 255, 235, 24, 122, 88, 196, 175, 189, 230, 121, 191, 69,
-			105, 240, 173, 177, 13, 187, 211, 254, 149, 235, 62, 85, 93, 37,
-			125, 145, 79, 230, 171, 214, 251, 34, 203, 97, 110, 76, 206, 70,
-			199, 67, 174, 6, 54, 215, 115, 176, 200, 158, 10, 75, 237, 14,
-			101, 219, 212, 137, 132, 46, 178, 72, 250, 8, 146, 45, 229, 110,
-			59, 91, 151, 210, 78, 134, 137, 187, 44, 212, 186, 28, 97, 136,
-			240, 45, 90, 177, 25, 52, 153, 178, 29, 160, 8, 251, 253, 17,
-			125, 100, 226, 22, 229, 179, 173, 165, 89, 177, 172, 52, 69, 73,
-			22, 114, 125, 65, 9, 122, 210, 26, 185, 234, 69, 180, 141, 66,
-			213, 183, 86, 22, 163, 98, 45, 82, 222, 146, 82, 100, 101, 127,
-			74, 222, 164, 234, 12, 114, 12, 113, 226, 100, 3, 95, 106, 180,
-			97, 197, 93, 111, 52, 226, 75, 97, 7, 215, 138, 53, 68, 213,
-			189, 4, 46, 119, 147, 113, 60, 101, 132, 163, 124, 135, 98, 57,
-			85, 22, 223, 14, 83, 148, 244, 195, 172, 39, 149, 154, 206, 178,
-			201, 245, 201, 0, 122, 16, 246, 180, 96, 200, 243, 45, 225, 115,
-			227, 149, 213, 12, 235, 255, 75, 241, 131, 79, 94, 141, 50, 44,
-			202, 151, 116, 16, 2, 169, 116, 63, 2, 110, 5, 196, 48, 87,
-			211, 254, 64, 158, 118, 89, 204, 140, 116, 28, 97, 25, 188, 230,
-			241, 253, 252, 35, 99, 104, 135, 241, 152, 250, 17, 202, 246, 5,
-			255, 46, 29, 199, 149, 56, 137, 87, 6, 43, 195, 195, 96, 38,
-			27, 44, 21, 130, 16, 180, 144, 86, 132, 4, 6, 250, 66, 14,
-			70, 36, 233, 134, 89, 183, 181, 161, 196, 40, 185, 230, 226, 212,
-			233, 25, 37, 62, 54, 16, 24, 114, 47, 97, 236, 193, 43, 65,
-			5, 174, 171, 55, 170, 107, 87, 35, 112, 37, 202, 85, 137, 62,
-			56, 8, 251, 232, 188, 167, 153, 232, 161, 93, 85, 69, 239, 236,
-			29, 254, 145, 28, 242, 209, 49, 137, 129, 98, 32, 43, 67, 214,
-			27, 123, 194, 152, 34, 46, 226, 60, 18, 97, 23, 24, 40, 180,
-			58, 25, 57, 131, 220, 30, 43, 154, 13, 98, 156, 75, 152, 73,
-			21, 11, 203, 9, 205, 91, 202, 209, 225, 162, 147, 202, 11, 58,
-			24, 36, 171, 189, 124, 171, 21, 54, 153, 189, 17, 210, 134, 155,
-			88, 120, 225, 113, 104, 96, 48, 78, 89, 91, 14, 139, 8, 253,
-			241, 234, 16, 97, 177, 71, 83, 196, 80, 110, 152, 192, 248, 232,
-			121, 154, 155, 157, 221, 204, 119, 146, 122, 31, 111, 195, 128, 12,
-			94, 103, 9, 4, 8, 252, 70, 75, 160, 64, 216, 123, 19, 127,
-			72, 73, 45, 241, 217, 163, 148, 29, 10, 250, 27, 8, 237, 104,
-			93, 168, 47, 116, 202, 104, 206, 88, 102, 171, 226, 219, 18, 22,
-			248, 102, 12, 131, 41, 123, 212, 101, 152, 32, 63, 124, 159, 37,
-			80, 32, 28, 56, 200, 95, 98, 138, 99, 207, 103, 191, 73, 217,
-			177, 224, 249, 242, 58, 115, 236, 140, 185, 110, 186, 1, 115, 45,
-			60, 242, 8, 193, 189, 91, 77, 51, 83, 204, 121, 88, 118, 149,
-			92, 174, 232, 210, 137, 92, 149, 244, 212, 170, 116, 182, 61, 43,
-			67, 222, 246, 108, 155, 67, 107, 78, 76, 207, 77, 53, 55, 117,
-			115, 239, 74, 139, 101, 81, 73, 108, 33, 46, 92, 122, 135, 116,
-			209, 70, 228, 177, 48, 221, 99, 228, 35, 171, 82, 160, 144, 75,
-			47, 111, 131, 208, 231, 82, 90, 168, 212, 163, 184, 122, 175, 70,
-			137, 61, 148, 146, 115, 58, 125, 22, 188, 94, 248, 167, 60, 125,
-			22, 107, 210, 29, 89, 116, 32, 116, 184, 8, 165, 15, 188, 162,
-			96, 156, 96, 253, 230, 207, 78, 185, 227, 13, 1, 132, 221, 200,
-			144, 160, 104, 212, 57, 105, 210, 137, 236, 218, 214, 165, 120, 226,
-			34, 23, 214, 86, 167, 131, 162, 147, 174, 200, 7, 241, 200, 241,
-			114, 152, 35, 92, 28, 211, 202, 138, 34, 91, 23, 119, 138, 179,
-			179, 83, 240, 167, 187, 195, 184, 95, 250, 83, 11, 243, 76, 2,
-			41, 143, 58, 41, 152, 103, 252, 57, 240, 55, 45, 63, 205, 125,
-			76, 38, 236, 197, 43, 143, 248, 27, 43, 135, 222, 24, 10, 149,
-			67, 32, 64, 216, 62, 105, 9, 20, 8, 71, 142, 242, 159, 225,
-			30, 27, 247, 107, 159, 163, 219, 30, 97, 36, 120, 183, 120, 255,
-			208, 62, 171, 59, 71, 91, 63, 199, 217, 206, 41, 27, 39, 62,
-			251, 28, 173, 31, 227, 119, 113, 198, 198, 193, 162, 60, 65, 223,
-			50, 246, 26, 236, 212, 56, 170, 132, 39, 40, 51, 77, 2, 77,
-			117, 10, 54, 142, 234, 224, 9, 186, 123, 15, 255, 93, 130, 61,
-			18, 159, 125, 129, 254, 56, 227, 175, 37, 219, 224, 146, 126, 129,
-			178, 237, 186, 137, 108, 55, 110, 208, 77, 10, 77, 5, 193, 30,
-			7, 125, 241, 20, 253, 145, 65, 176, 199, 81, 86, 158, 178, 35,
-			10, 99, 246, 20, 85, 16, 236, 113, 148, 147, 167, 104, 176, 159,
-			103, 216, 57, 245, 217, 211, 148, 29, 14, 186, 226, 158, 68, 150,
-			255, 133, 241, 204, 162, 190, 155, 241, 55, 180, 117, 127, 157, 61,
-			65, 92, 91, 220, 42, 45, 105, 163, 221, 16, 109, 45, 204, 75,
-			91, 42, 138, 5, 90, 195, 78, 175, 215, 77, 2, 205, 157, 7,
-			116, 19, 89, 154, 20, 8, 4, 26, 167, 219, 252, 218, 23, 41,
-			251, 54, 149, 64, 160, 113, 220, 16, 251, 34, 109, 236, 197, 211,
-			188, 113, 185, 31, 246, 101, 90, 191, 41, 232, 149, 144, 35, 163,
-			247, 77, 129, 33, 136, 58, 193, 122, 230, 81, 81, 218, 19, 178,
-			24, 160, 56, 55, 154, 10, 193, 154, 238, 55, 41, 219, 48, 174,
-			246, 66, 190, 76, 21, 86, 98, 92, 237, 133, 124, 153, 78, 220,
-			96, 9, 20, 8, 123, 246, 242, 84, 241, 74, 124, 246, 21, 90,
-			63, 17, 92, 144, 150, 33, 252, 80, 213, 152, 105, 37, 149, 46,
-			202, 186, 197, 184, 112, 146, 245, 97, 207, 107, 254, 128, 120, 7,
-			230, 140, 143, 157, 105, 83, 213, 164, 29, 30, 193, 126, 125, 133,
-			42, 252, 234, 184, 10, 197, 190, 66, 119, 28, 177, 4, 10, 132,
-			169, 227, 252, 47, 137, 98, 210, 243, 217, 179, 180, 126, 38, 248,
-			106, 21, 202, 251, 102, 251, 53, 142, 35, 37, 183, 103, 184, 186,
-			16, 63, 98, 143, 166, 180, 99, 165, 78, 206, 211, 65, 103, 57,
-			210, 19, 49, 98, 15, 197, 70, 66, 232, 243, 137, 124, 208, 89,
-			22, 57, 72, 127, 130, 245, 196, 149, 26, 159, 117, 190, 31, 214,
-			194, 179, 238, 247, 195, 20, 60, 75, 119, 180, 45, 129, 2, 97,
-			110, 158, 239, 148, 159, 15, 2, 247, 28, 173, 191, 68, 175, 211,
-			63, 65, 145, 123, 142, 238, 60, 200, 175, 240, 186, 36, 128, 208,
-			253, 57, 109, 76, 6, 119, 141, 204, 212, 93, 46, 216, 162, 221,
-			255, 202, 46, 181, 206, 218, 206, 119, 241, 235, 244, 91, 199, 240,
-			181, 187, 92, 18, 1, 146, 31, 184, 36, 10, 164, 131, 135, 248,
-			205, 134, 29, 226, 179, 175, 209, 198, 109, 170, 228, 206, 230, 80,
-			56, 183, 67, 208, 83, 95, 163, 141, 41, 151, 132, 239, 58, 190,
-			224, 146, 40, 144, 110, 185, 149, 159, 53, 29, 122, 62, 251, 11,
-			218, 152, 221, 26, 82, 204, 190, 201, 99, 248, 220, 141, 46, 169,
-			6, 164, 221, 147, 46, 137, 0, 73, 156, 114, 73, 20, 72, 237,
-			211, 252, 171, 158, 225, 129, 250, 236, 235, 180, 113, 124, 24, 223,
-			95, 1, 98, 142, 66, 251, 217, 209, 120, 59, 49, 100, 226, 178,
-			57, 120, 211, 199, 183, 224, 141, 56, 48, 26, 44, 96, 19, 47,
-			14, 208, 37, 155, 43, 163, 251, 7, 137, 209, 199, 90, 27, 44,
-			174, 171, 128, 164, 45, 46, 167, 121, 81, 121, 119, 39, 76, 100,
-			165, 117, 112, 141, 74, 243, 64, 199, 112, 236, 92, 65, 3, 45,
-			252, 117, 234, 31, 118, 73, 56, 194, 199, 166, 248, 95, 19, 51,
-			232, 204, 103, 127, 67, 27, 135, 131, 103, 164, 114, 192, 75, 150,
-			213, 234, 0, 91, 24, 244, 31, 151, 129, 96, 99, 248, 61, 238,
-			64, 48, 2, 36, 255, 128, 75, 162, 64, 154, 20, 252, 81, 173,
-			35, 169, 207, 254, 22, 116, 228, 85, 241, 62, 189, 217, 86, 13,
-			65, 44, 96, 205, 45, 188, 229, 32, 170, 230, 103, 229, 46, 55,
-			87, 250, 111, 37, 92, 23, 249, 106, 104, 55, 66, 230, 208, 27,
-			153, 159, 29, 242, 250, 173, 126, 163, 12, 25, 217, 105, 9, 53,
-			32, 236, 58, 100, 9, 4, 8, 147, 86, 225, 81, 228, 125, 110,
-			158, 175, 162, 3, 192, 124, 246, 15, 148, 29, 12, 22, 175, 197,
-			1, 48, 174, 245, 91, 49, 255, 172, 134, 93, 78, 232, 38, 129,
-			230, 142, 155, 116, 147, 66, 115, 255, 1, 101, 254, 137, 95, 123,
-			153, 178, 143, 48, 109, 254, 113, 119, 143, 54, 118, 243, 95, 193,
-			153, 192, 93, 141, 87, 192, 252, 175, 148, 205, 255, 136, 77, 189,
-			173, 25, 127, 248, 85, 55, 138, 86, 156, 112, 101, 99, 39, 64,
-			70, 180, 175, 88, 39, 64, 70, 180, 175, 88, 39, 64, 70, 180,
-			175, 128, 19, 240, 75, 138, 99, 226, 179, 87, 105, 125, 74, 85,
-			214, 168, 174, 160, 108, 144, 204, 200, 248, 199, 72, 146, 118, 6,
-			182, 104, 245, 101, 212, 250, 170, 181, 122, 50, 106, 125, 149, 238,
-			16, 150, 64, 129, 112, 244, 24, 255, 57, 197, 148, 231, 179, 239,
-			208, 250, 225, 224, 158, 145, 76, 85, 55, 21, 96, 9, 86, 152,
-			28, 222, 27, 210, 125, 129, 13, 254, 142, 203, 13, 140, 192, 119,
-			232, 142, 3, 150, 64, 129, 48, 41, 248, 119, 229, 250, 34, 8,
-			62, 163, 245, 255, 164, 215, 5, 223, 38, 155, 93, 220, 168, 152,
-			216, 77, 239, 111, 56, 33, 231, 208, 131, 136, 82, 82, 40, 92,
-			9, 71, 0, 87, 6, 129, 160, 208, 101, 249, 8, 137, 75, 207,
-			35, 52, 195, 129, 245, 215, 112, 15, 78, 134, 149, 22, 95, 129,
-			170, 80, 25, 126, 220, 226, 93, 13, 139, 206, 114, 30, 21, 118,
-			124, 36, 52, 142, 238, 60, 196, 239, 67, 45, 75, 20, 56, 14,
-			220, 139, 219, 55, 115, 47, 182, 230, 87, 16, 141, 145, 179, 90,
-			142, 104, 148, 156, 245, 43, 136, 198, 201, 129, 95, 241, 4, 49,
-			140, 16, 159, 253, 128, 54, 238, 10, 62, 33, 213, 189, 78, 244,
-			182, 129, 139, 81, 30, 84, 7, 76, 83, 184, 207, 142, 68, 224,
-			131, 147, 171, 161, 4, 229, 215, 156, 200, 203, 195, 95, 250, 50,
-			112, 96, 126, 96, 29, 24, 162, 28, 152, 31, 208, 227, 119, 186,
-			36, 10, 164, 159, 190, 160, 28, 24, 34, 29, 152, 215, 175, 209,
-			129, 33, 202, 129, 121, 221, 58, 48, 68, 57, 48, 175, 91, 7,
-			134, 40, 7, 230, 117, 235, 192, 16, 229, 192, 188, 14, 14, 204,
-			63, 41, 17, 199, 171, 176, 172, 126, 38, 248, 6, 41, 93, 53,
-			169, 154, 145, 107, 184, 113, 130, 91, 253, 120, 137, 62, 49, 233,
-			198, 10, 196, 155, 87, 146, 25, 254, 16, 87, 83, 54, 68, 5,
-			155, 243, 16, 103, 229, 131, 117, 122, 152, 25, 235, 68, 208, 58,
-			61, 204, 118, 77, 90, 2, 1, 130, 104, 91, 2, 14, 203, 220,
-			188, 174, 152, 240, 63, 1, 0, 0, 255, 255, 56, 86, 130, 229,
-			7, 87, 3, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 11, 116, 93, 87,
+			117, 47, 140, 107, 63, 206, 67, 75, 175, 163, 37, 63, 228, 227,
+			216, 94, 62, 142, 99, 201, 150, 142, 109, 57, 15, 108, 19, 184,
+			182, 172, 36, 10, 142, 228, 74, 50, 129, 240, 167, 202, 214, 57,
+			91, 210, 38, 71, 123, 31, 246, 222, 199, 178, 66, 129, 80, 104,
+			161, 180, 161, 208, 123, 121, 149, 64, 91, 70, 203, 163, 255, 203,
+			215, 175, 124, 165, 132, 87, 47, 183, 225, 134, 203, 163, 1, 10,
+			180, 92, 32, 192, 45, 36, 13, 207, 123, 83, 50, 46, 112, 129,
+			242, 141, 223, 92, 107, 237, 189, 143, 36, 231, 85, 242, 141, 142,
+			113, 155, 225, 12, 157, 185, 246, 122, 206, 53, 215, 92, 115, 206,
+			53, 215, 92, 236, 39, 140, 93, 187, 20, 84, 107, 203, 97, 176,
+			226, 181, 86, 170, 65, 184, 116, 184, 209, 170, 121, 135, 29, 223,
+			105, 172, 69, 94, 116, 184, 25, 6, 113, 112, 248, 194, 209, 195,
+			11, 173, 37, 199, 247, 110, 119, 195, 249, 216, 141, 98, 55, 172,
+			210, 23, 94, 66, 246, 170, 206, 94, 189, 112, 180, 82, 102, 131,
+			227, 161, 235, 196, 238, 172, 179, 210, 108, 184, 147, 81, 212, 114,
+			103, 220, 23, 182, 220, 40, 174, 92, 205, 118, 108, 242, 45, 106,
+			6, 126, 228, 242, 29, 172, 232, 33, 243, 188, 87, 31, 52, 132,
+			49, 100, 205, 20, 8, 158, 172, 143, 189, 204, 96, 125, 167, 117,
+			7, 230, 168, 125, 238, 179, 254, 13, 117, 241, 131, 213, 245, 253,
+			169, 110, 210, 32, 117, 166, 124, 232, 113, 229, 149, 157, 171, 116,
+			156, 62, 118, 203, 209, 199, 135, 170, 147, 58, 165, 185, 112, 227,
+			107, 139, 44, 207, 237, 222, 142, 189, 6, 251, 152, 205, 140, 110,
+			110, 245, 118, 240, 177, 15, 216, 98, 60, 104, 174, 133, 222, 210,
+			114, 44, 198, 142, 140, 29, 19, 115, 203, 174, 56, 123, 126, 124,
+			82, 156, 106, 197, 203, 65, 24, 85, 25, 19, 103, 189, 154, 235,
+			71, 110, 93, 180, 252, 186, 27, 138, 120, 217, 21, 167, 154, 78,
+			13, 57, 229, 151, 17, 241, 108, 55, 140, 188, 192, 23, 99, 213,
+			35, 98, 8, 25, 42, 234, 83, 101, 248, 36, 19, 107, 65, 75,
+			172, 56, 107, 194, 15, 98, 209, 138, 92, 17, 47, 123, 145, 88,
+			244, 26, 174, 112, 47, 214, 220, 102, 44, 60, 95, 212, 130, 149,
+			102, 195, 115, 252, 154, 43, 86, 189, 120, 89, 196, 105, 245, 85,
+			38, 158, 171, 106, 8, 22, 98, 199, 243, 133, 35, 106, 65, 115,
+			77, 4, 139, 217, 108, 194, 137, 25, 19, 244, 223, 114, 28, 55,
+			79, 28, 62, 188, 186, 186, 90, 117, 168, 167, 146, 160, 100, 190,
+			232, 240, 217, 201, 241, 137, 169, 217, 137, 209, 177, 234, 17, 198,
+			196, 121, 191, 225, 70, 145, 8, 221, 23, 182, 188, 208, 173, 139,
+			133, 53, 225, 52, 155, 13, 175, 230, 44, 52, 92, 209, 112, 86,
+			69, 16, 10, 103, 41, 116, 221, 186, 136, 3, 244, 117, 53, 244,
+			98, 207, 95, 26, 17, 81, 176, 24, 175, 58, 161, 203, 68, 221,
+			139, 226, 208, 91, 104, 197, 109, 104, 210, 61, 243, 162, 182, 12,
+			129, 47, 28, 95, 84, 78, 205, 138, 201, 217, 138, 56, 125, 106,
+			118, 114, 118, 132, 137, 155, 39, 231, 110, 152, 62, 63, 39, 110,
+			62, 53, 51, 115, 106, 106, 110, 114, 98, 86, 76, 207, 136, 241,
+			233, 169, 51, 147, 115, 147, 211, 83, 179, 98, 250, 58, 113, 106,
+			234, 185, 226, 89, 147, 83, 103, 70, 132, 235, 197, 203, 110, 40,
+			220, 139, 205, 16, 189, 15, 66, 225, 1, 129, 110, 189, 202, 196,
+			172, 235, 182, 53, 191, 24, 200, 89, 139, 154, 110, 205, 91, 244,
+			106, 162, 225, 248, 75, 45, 103, 201, 21, 75, 193, 5, 55, 244,
+			61, 127, 73, 52, 221, 112, 197, 139, 48, 137, 145, 112, 252, 58,
+			19, 13, 111, 197, 139, 157, 152, 18, 54, 140, 168, 202, 88, 145,
+			25, 38, 183, 74, 29, 59, 240, 171, 200, 45, 222, 113, 3, 235,
+			100, 102, 177, 75, 254, 156, 99, 102, 190, 131, 219, 91, 59, 6,
+			141, 242, 13, 226, 148, 136, 220, 240, 130, 87, 67, 191, 156, 88,
+			52, 195, 224, 130, 87, 119, 35, 225, 8, 44, 98, 180, 239, 249,
+			177, 27, 46, 58, 53, 217, 91, 90, 99, 183, 123, 110, 72, 233,
+			75, 33, 245, 163, 202, 24, 99, 86, 190, 195, 224, 214, 214, 226,
+			118, 118, 130, 217, 249, 14, 179, 131, 91, 219, 205, 231, 148, 71,
+			133, 92, 50, 168, 50, 162, 245, 46, 104, 205, 98, 44, 34, 116,
+			227, 86, 232, 71, 194, 139, 15, 68, 194, 171, 87, 25, 235, 102,
+			57, 148, 53, 184, 181, 61, 191, 93, 67, 38, 183, 182, 15, 30,
+			209, 144, 197, 173, 237, 39, 231, 216, 85, 204, 180, 59, 184, 181,
+			179, 99, 95, 249, 160, 80, 43, 22, 68, 76, 180, 80, 163, 54,
+			37, 110, 51, 141, 202, 142, 218, 232, 232, 206, 162, 96, 99, 204,
+			180, 13, 110, 239, 238, 216, 107, 148, 175, 16, 122, 37, 131, 122,
+			169, 60, 70, 239, 108, 86, 222, 48, 184, 181, 187, 184, 151, 29,
+			98, 182, 109, 96, 160, 194, 220, 90, 222, 77, 75, 212, 171, 107,
+			226, 167, 42, 220, 122, 82, 176, 155, 229, 144, 57, 135, 220, 5,
+			13, 25, 220, 18, 197, 146, 134, 44, 110, 137, 129, 45, 11, 121,
+			226, 19, 199, 216, 7, 134, 217, 158, 165, 32, 88, 106, 184, 146,
+			201, 46, 180, 22, 15, 199, 222, 138, 27, 197, 206, 74, 83, 113,
+			215, 62, 153, 161, 170, 51, 84, 78, 178, 206, 57, 157, 135, 15,
+			178, 66, 228, 214, 2, 191, 30, 105, 126, 169, 64, 190, 133, 229,
+			124, 199, 15, 162, 65, 83, 24, 67, 185, 25, 9, 156, 254, 13,
+			131, 13, 212, 130, 149, 234, 186, 74, 79, 247, 38, 85, 158, 67,
+			210, 57, 227, 150, 49, 149, 101, 41, 0, 201, 210, 42, 214, 185,
+			15, 199, 107, 77, 55, 58, 124, 155, 31, 172, 250, 105, 127, 155,
+			11, 63, 54, 140, 183, 155, 214, 245, 231, 78, 255, 169, 185, 251,
+			122, 89, 250, 156, 42, 82, 189, 217, 109, 52, 158, 133, 2, 115,
+			40, 123, 227, 251, 15, 72, 166, 248, 82, 131, 221, 151, 50, 197,
+			123, 214, 49, 197, 35, 163, 99, 71, 198, 174, 20, 167, 91, 139,
+			98, 206, 173, 45, 251, 65, 35, 88, 242, 220, 104, 68, 76, 250,
+			181, 127, 99, 143, 255, 198, 30, 31, 157, 61, 14, 38, 236, 113,
+			15, 177, 199, 61, 96, 143, 123, 84, 226, 64, 199, 164, 230, 153,
+			248, 41, 19, 183, 116, 140, 80, 162, 33, 127, 202, 196, 173, 29,
+			135, 41, 81, 253, 148, 137, 219, 58, 42, 148, 200, 228, 79, 153,
+			184, 189, 99, 47, 37, 94, 46, 127, 202, 196, 193, 142, 147, 148,
+			184, 95, 254, 252, 210, 46, 226, 106, 118, 220, 241, 82, 163, 252,
+			169, 93, 226, 148, 72, 86, 158, 8, 93, 160, 204, 245, 99, 176,
+			210, 102, 224, 249, 68, 127, 88, 96, 194, 243, 235, 110, 211, 245,
+			235, 174, 31, 131, 184, 28, 127, 77, 166, 223, 30, 248, 174, 8,
+			66, 209, 8, 106, 78, 131, 137, 154, 211, 112, 253, 186, 19, 142,
+			8, 215, 175, 5, 117, 183, 46, 28, 212, 85, 11, 90, 178, 156,
+			98, 14, 192, 163, 88, 12, 157, 154, 68, 98, 246, 67, 204, 4,
+			113, 10, 130, 69, 232, 70, 65, 163, 133, 92, 85, 98, 126, 178,
+			34, 15, 52, 217, 112, 98, 239, 130, 11, 186, 115, 124, 225, 54,
+			131, 218, 178, 112, 98, 113, 126, 110, 92, 172, 120, 117, 159, 196,
+			154, 192, 103, 226, 70, 199, 111, 57, 225, 154, 56, 58, 34, 142,
+			30, 191, 230, 200, 8, 141, 104, 217, 197, 62, 212, 112, 155, 177,
+			87, 19, 215, 135, 238, 82, 16, 122, 142, 159, 244, 94, 172, 46,
+			123, 181, 101, 225, 94, 140, 93, 116, 54, 94, 118, 217, 102, 185,
+			22, 156, 218, 109, 171, 78, 136, 28, 129, 88, 115, 157, 80, 4,
+			62, 230, 95, 156, 106, 52, 196, 138, 231, 183, 104, 79, 10, 93,
+			113, 245, 145, 100, 124, 141, 192, 95, 170, 138, 179, 174, 211, 76,
+			135, 28, 186, 162, 18, 173, 184, 78, 232, 214, 43, 34, 10, 228,
+			62, 233, 7, 162, 225, 58, 77, 166, 178, 137, 152, 214, 156, 23,
+			9, 223, 117, 129, 87, 108, 149, 216, 32, 195, 102, 232, 74, 98,
+			28, 17, 173, 72, 110, 40, 207, 27, 187, 114, 116, 57, 104, 133,
+			162, 225, 249, 174, 19, 50, 65, 181, 63, 127, 8, 139, 63, 58,
+			113, 248, 112, 221, 189, 224, 54, 130, 166, 27, 70, 154, 15, 215,
+			130, 21, 98, 164, 135, 41, 231, 48, 6, 1, 116, 135, 142, 191,
+			68, 107, 116, 49, 12, 86, 196, 145, 35, 71, 142, 142, 210, 191,
+			185, 35, 71, 78, 208, 191, 91, 48, 244, 227, 199, 143, 31, 31,
+			61, 58, 54, 122, 236, 232, 220, 216, 177, 19, 87, 29, 63, 113,
+			213, 241, 234, 113, 253, 223, 45, 85, 113, 122, 141, 97, 34, 227,
+			208, 171, 129, 57, 160, 8, 13, 145, 106, 31, 17, 171, 174, 112,
+			253, 168, 21, 42, 1, 97, 213, 21, 53, 96, 57, 240, 47, 184,
+			97, 140, 204, 146, 88, 130, 21, 241, 188, 153, 235, 198, 153, 56,
+			118, 236, 216, 241, 116, 44, 16, 244, 60, 55, 94, 164, 13, 34,
+			92, 172, 29, 14, 23, 107, 200, 81, 141, 47, 198, 195, 162, 142,
+			61, 26, 252, 199, 95, 34, 121, 118, 159, 152, 184, 72, 187, 117,
+			196, 152, 254, 41, 142, 158, 16, 227, 193, 74, 179, 21, 187, 153,
+			181, 64, 13, 158, 155, 158, 157, 124, 142, 184, 21, 152, 25, 26,
+			190, 181, 170, 152, 104, 154, 41, 217, 123, 78, 202, 47, 9, 92,
+			141, 220, 120, 94, 77, 240, 16, 82, 135, 166, 206, 159, 61, 59,
+			60, 188, 105, 62, 162, 247, 161, 35, 195, 39, 51, 125, 26, 123,
+			172, 62, 45, 185, 49, 106, 9, 22, 235, 206, 90, 166, 111, 81,
+			28, 182, 106, 49, 173, 205, 11, 78, 67, 196, 23, 84, 139, 109,
+			217, 175, 136, 47, 140, 8, 234, 208, 201, 39, 59, 164, 11, 213,
+			248, 2, 6, 248, 104, 35, 146, 153, 90, 145, 91, 19, 7, 197,
+			209, 35, 71, 218, 71, 120, 236, 146, 35, 188, 217, 243, 143, 141,
+			137, 91, 175, 119, 227, 217, 181, 40, 118, 87, 240, 249, 84, 116,
+			157, 215, 112, 231, 218, 39, 226, 186, 201, 179, 19, 115, 147, 55,
+			77, 136, 197, 88, 117, 227, 82, 101, 174, 88, 140, 117, 79, 207,
+			79, 78, 205, 93, 125, 165, 136, 189, 218, 109, 145, 184, 86, 12,
+			13, 13, 201, 148, 225, 197, 184, 90, 95, 189, 193, 91, 90, 62,
+			227, 196, 84, 106, 88, 60, 253, 233, 226, 216, 216, 176, 248, 53,
+			65, 223, 206, 6, 171, 250, 147, 198, 219, 225, 195, 226, 148, 184,
+			217, 243, 235, 193, 106, 68, 85, 98, 177, 28, 61, 114, 36, 195,
+			195, 162, 106, 146, 65, 114, 169, 163, 87, 111, 92, 70, 73, 109,
+			40, 126, 244, 234, 43, 175, 188, 242, 154, 99, 87, 31, 73, 217,
+			198, 130, 187, 24, 132, 174, 56, 239, 123, 23, 21, 175, 3, 51,
+			91, 95, 75, 245, 201, 77, 230, 144, 28, 191, 24, 26, 194, 8,
+			34, 113, 152, 38, 11, 255, 134, 197, 104, 182, 59, 143, 65, 193,
+			168, 231, 216, 88, 90, 207, 254, 76, 61, 68, 0, 195, 109, 4,
+			112, 229, 37, 9, 224, 70, 231, 130, 35, 110, 149, 147, 95, 173,
+			181, 194, 208, 245, 99, 100, 185, 201, 107, 52, 188, 40, 67, 0,
+			224, 166, 98, 133, 82, 197, 181, 226, 210, 5, 30, 133, 204, 197,
+			181, 105, 106, 213, 119, 87, 79, 183, 188, 70, 221, 13, 135, 134,
+			49, 176, 89, 133, 33, 213, 132, 68, 204, 176, 18, 165, 132, 16,
+			200, 51, 69, 180, 62, 228, 249, 49, 70, 174, 114, 202, 161, 171,
+			97, 3, 5, 195, 195, 213, 5, 212, 60, 212, 134, 130, 171, 30,
+			3, 5, 147, 126, 20, 59, 126, 92, 245, 131, 213, 204, 168, 85,
+			170, 240, 131, 85, 113, 173, 104, 203, 243, 168, 3, 77, 251, 253,
+			216, 35, 246, 131, 213, 234, 146, 27, 79, 128, 214, 100, 218, 208,
+			112, 102, 224, 237, 131, 87, 153, 1, 12, 109, 62, 208, 171, 47,
+			57, 80, 53, 91, 90, 202, 16, 231, 214, 226, 229, 192, 215, 67,
+			221, 116, 154, 134, 134, 215, 125, 172, 94, 239, 198, 227, 233, 172,
+			15, 13, 19, 167, 191, 113, 118, 122, 74, 220, 228, 52, 155, 158,
+			191, 196, 152, 152, 244, 101, 202, 98, 16, 174, 56, 241, 8, 137,
+			125, 105, 95, 160, 81, 96, 237, 182, 137, 45, 114, 227, 80, 18,
+			3, 163, 237, 231, 9, 237, 62, 178, 41, 72, 46, 14, 132, 22,
+			106, 147, 65, 230, 92, 33, 88, 84, 94, 4, 169, 225, 197, 163,
+			47, 90, 9, 252, 120, 249, 197, 163, 47, 170, 59, 107, 47, 158,
+			123, 17, 182, 238, 23, 159, 120, 209, 138, 231, 191, 248, 196, 139,
+			34, 183, 246, 226, 231, 85, 95, 4, 97, 9, 252, 246, 197, 207,
+			191, 165, 194, 196, 234, 178, 27, 186, 66, 150, 70, 69, 78, 99,
+			213, 89, 139, 180, 200, 11, 125, 132, 36, 129, 69, 200, 0, 117,
+			111, 201, 139, 35, 136, 52, 13, 87, 168, 150, 70, 4, 53, 53,
+			194, 132, 108, 108, 68, 80, 107, 35, 36, 151, 81, 147, 36, 149,
+			220, 238, 134, 193, 104, 211, 169, 3, 33, 216, 180, 87, 3, 93,
+			155, 235, 212, 150, 49, 46, 55, 145, 226, 32, 253, 41, 134, 50,
+			162, 228, 167, 154, 227, 139, 165, 64, 180, 154, 216, 196, 143, 235,
+			162, 67, 94, 213, 173, 170, 196, 163, 155, 203, 122, 195, 35, 140,
+			218, 15, 154, 128, 156, 134, 108, 169, 114, 75, 69, 68, 173, 197,
+			69, 239, 34, 164, 81, 175, 230, 64, 188, 194, 44, 130, 72, 72,
+			14, 29, 170, 156, 159, 27, 175, 12, 159, 108, 75, 101, 194, 75,
+			85, 152, 170, 56, 5, 201, 47, 14, 142, 73, 98, 136, 220, 208,
+			115, 26, 176, 232, 137, 104, 57, 104, 53, 234, 26, 149, 173, 200,
+			37, 89, 114, 200, 137, 146, 214, 96, 31, 98, 162, 114, 75, 101,
+			24, 19, 224, 139, 102, 232, 249, 82, 160, 217, 72, 74, 64, 164,
+			211, 214, 84, 211, 9, 163, 180, 153, 5, 151, 9, 146, 232, 32,
+			223, 212, 200, 18, 182, 16, 196, 203, 212, 38, 202, 6, 100, 226,
+			209, 99, 136, 54, 244, 3, 86, 164, 96, 113, 49, 114, 99, 18,
+			214, 174, 11, 160, 240, 208, 90, 27, 17, 149, 177, 35, 71, 175,
+			25, 61, 114, 116, 244, 232, 85, 115, 71, 142, 158, 56, 118, 228,
+			196, 209, 171, 170, 71, 142, 222, 82, 81, 212, 29, 9, 130, 147,
+			205, 165, 233, 68, 49, 19, 148, 147, 218, 15, 252, 84, 106, 190,
+			106, 68, 160, 182, 170, 90, 64, 206, 5, 103, 182, 22, 122, 205,
+			120, 4, 178, 110, 155, 160, 230, 8, 108, 142, 34, 88, 120, 129,
+			11, 1, 36, 80, 186, 172, 36, 118, 41, 153, 18, 249, 131, 91,
+			213, 157, 176, 206, 196, 243, 226, 96, 114, 118, 122, 150, 22, 217,
+			208, 240, 38, 226, 105, 117, 37, 184, 221, 107, 52, 28, 146, 237,
+			92, 127, 244, 252, 236, 225, 122, 80, 139, 14, 223, 236, 46, 28,
+			78, 187, 114, 120, 198, 93, 116, 67, 215, 175, 185, 135, 175, 111,
+			4, 11, 78, 99, 126, 154, 250, 16, 29, 70, 135, 14, 103, 26,
+			25, 102, 98, 197, 141, 151, 131, 122, 21, 220, 64, 114, 154, 17,
+			225, 36, 93, 18, 183, 66, 94, 4, 210, 171, 250, 199, 173, 122,
+			64, 24, 234, 130, 171, 71, 235, 214, 217, 166, 67, 100, 226, 121,
+			183, 70, 113, 184, 72, 69, 51, 35, 10, 106, 81, 181, 73, 237,
+			209, 88, 198, 14, 55, 188, 133, 208, 9, 215, 72, 232, 174, 46,
+			199, 43, 141, 125, 244, 75, 151, 29, 38, 75, 40, 75, 8, 89,
+			55, 2, 51, 158, 56, 176, 255, 185, 163, 251, 87, 70, 247, 215,
+			231, 246, 223, 112, 98, 255, 77, 39, 246, 207, 86, 247, 47, 222,
+			114, 160, 42, 206, 122, 183, 185, 171, 30, 140, 178, 30, 166, 240,
+			130, 147, 206, 82, 43, 114, 101, 109, 55, 6, 117, 135, 136, 245,
+			64, 36, 158, 119, 235, 228, 236, 180, 22, 105, 174, 163, 22, 104,
+			224, 74, 204, 122, 254, 16, 211, 246, 130, 23, 4, 117, 103, 20,
+			29, 171, 70, 65, 43, 172, 65, 26, 89, 114, 171, 190, 27, 31,
+			118, 154, 30, 205, 9, 134, 133, 92, 52, 162, 195, 178, 187, 135,
+			55, 86, 79, 67, 77, 219, 96, 98, 24, 120, 76, 140, 23, 178,
+			92, 236, 134, 162, 230, 52, 105, 125, 4, 139, 98, 201, 245, 93,
+			152, 1, 177, 210, 244, 42, 195, 106, 200, 162, 191, 202, 82, 195,
+			91, 92, 236, 103, 119, 25, 204, 182, 201, 68, 120, 209, 220, 82,
+			126, 141, 33, 102, 82, 221, 86, 211, 125, 176, 72, 228, 142, 14,
+			139, 200, 243, 107, 89, 249, 138, 109, 46, 96, 137, 155, 90, 81,
+			44, 22, 220, 71, 85, 136, 216, 102, 26, 209, 45, 194, 243, 107,
+			141, 86, 228, 93, 208, 166, 186, 14, 50, 213, 93, 84, 166, 58,
+			105, 146, 188, 88, 236, 211, 144, 197, 173, 139, 124, 128, 125, 75,
+			14, 196, 224, 214, 75, 76, 94, 254, 59, 67, 76, 5, 254, 168,
+			239, 46, 73, 237, 87, 115, 95, 26, 140, 163, 70, 6, 61, 120,
+			83, 190, 90, 21, 83, 170, 96, 162, 86, 94, 112, 26, 45, 55,
+			34, 106, 203, 84, 182, 130, 81, 70, 177, 215, 104, 136, 101, 231,
+			130, 43, 252, 108, 155, 84, 181, 42, 8, 154, 114, 98, 165, 223,
+			47, 6, 33, 212, 97, 109, 51, 88, 143, 44, 165, 42, 142, 168,
+			255, 217, 38, 8, 49, 114, 24, 166, 70, 136, 129, 65, 23, 123,
+			52, 100, 113, 235, 37, 165, 254, 196, 118, 249, 155, 119, 217, 76,
+			172, 55, 94, 214, 221, 136, 216, 83, 16, 94, 202, 122, 121, 19,
+			235, 135, 38, 113, 38, 201, 56, 235, 198, 252, 105, 204, 134, 209,
+			109, 208, 16, 214, 80, 215, 216, 229, 235, 173, 147, 213, 246, 18,
+			231, 208, 218, 12, 149, 168, 188, 41, 199, 6, 54, 249, 202, 57,
+			179, 125, 103, 197, 37, 163, 104, 231, 12, 253, 134, 173, 180, 233,
+			212, 110, 115, 150, 92, 178, 137, 118, 206, 104, 144, 239, 102, 76,
+			155, 87, 106, 107, 131, 150, 176, 134, 58, 103, 50, 41, 252, 16,
+			235, 111, 182, 22, 26, 94, 109, 62, 147, 141, 9, 107, 40, 55,
+			83, 146, 31, 206, 164, 153, 15, 176, 190, 85, 215, 185, 45, 155,
+			181, 139, 178, 246, 34, 57, 147, 113, 156, 117, 175, 184, 81, 228,
+			44, 185, 243, 16, 127, 6, 109, 26, 189, 216, 48, 250, 245, 35,
+			239, 82, 165, 96, 73, 229, 167, 88, 167, 235, 183, 86, 100, 13,
+			185, 75, 224, 111, 194, 111, 173, 172, 175, 165, 136, 98, 170, 138,
+			130, 58, 34, 24, 204, 83, 5, 7, 54, 84, 48, 43, 191, 175,
+			175, 67, 151, 227, 227, 172, 147, 172, 53, 48, 181, 14, 22, 168,
+			146, 253, 27, 42, 185, 206, 115, 27, 245, 245, 85, 164, 229, 248,
+			213, 172, 32, 37, 142, 104, 176, 40, 140, 161, 174, 177, 203, 54,
+			169, 162, 225, 78, 203, 60, 51, 58, 51, 159, 100, 37, 201, 21,
+			231, 33, 59, 206, 123, 254, 98, 48, 216, 73, 21, 236, 217, 80,
+			193, 44, 101, 28, 15, 234, 238, 164, 191, 24, 204, 244, 70, 109,
+			48, 223, 198, 242, 209, 154, 31, 59, 23, 7, 187, 137, 66, 20,
+			196, 199, 88, 193, 173, 123, 104, 119, 176, 87, 24, 67, 189, 99,
+			131, 27, 106, 158, 144, 223, 103, 116, 198, 202, 7, 242, 172, 239,
+			241, 144, 229, 73, 150, 91, 4, 102, 6, 205, 39, 130, 55, 89,
+			166, 29, 241, 249, 39, 137, 248, 83, 172, 203, 199, 129, 106, 93,
+			82, 145, 245, 56, 233, 144, 201, 66, 27, 201, 208, 126, 82, 100,
+			248, 28, 214, 151, 116, 105, 158, 108, 83, 138, 158, 15, 63, 86,
+			79, 170, 19, 186, 220, 12, 138, 205, 244, 38, 245, 16, 204, 207,
+			48, 22, 248, 110, 176, 56, 95, 119, 107, 141, 193, 226, 37, 176,
+			52, 141, 44, 235, 187, 215, 73, 5, 207, 184, 181, 6, 63, 158,
+			146, 103, 225, 18, 212, 117, 147, 92, 152, 27, 40, 244, 60, 235,
+			197, 230, 23, 94, 112, 235, 106, 100, 157, 212, 137, 234, 99, 142,
+			108, 70, 21, 163, 129, 204, 244, 232, 90, 8, 228, 251, 88, 146,
+			48, 15, 14, 71, 44, 169, 115, 166, 91, 39, 78, 57, 43, 110,
+			249, 118, 214, 219, 142, 30, 156, 12, 69, 177, 19, 198, 196, 28,
+			115, 51, 18, 224, 37, 102, 185, 126, 93, 157, 22, 225, 39, 255,
+			119, 233, 128, 45, 26, 240, 21, 27, 186, 219, 94, 243, 250, 113,
+			151, 175, 97, 61, 109, 3, 120, 188, 77, 87, 62, 109, 179, 173,
+			155, 214, 205, 159, 195, 182, 180, 252, 196, 240, 234, 214, 231, 101,
+			23, 7, 255, 177, 112, 9, 162, 59, 159, 205, 45, 107, 153, 25,
+			104, 171, 66, 38, 242, 231, 178, 46, 208, 135, 3, 81, 39, 240,
+			213, 106, 28, 123, 124, 67, 174, 158, 73, 75, 158, 182, 94, 101,
+			152, 51, 217, 186, 248, 53, 172, 184, 232, 58, 113, 43, 116, 163,
+			193, 49, 66, 229, 206, 13, 245, 94, 39, 51, 204, 186, 241, 76,
+			146, 153, 175, 176, 238, 11, 110, 136, 131, 99, 170, 154, 230, 161,
+			119, 236, 105, 143, 179, 83, 207, 206, 20, 157, 141, 157, 216, 61,
+			193, 206, 79, 61, 123, 98, 102, 242, 186, 201, 137, 51, 178, 155,
+			109, 213, 151, 255, 131, 193, 186, 50, 35, 1, 59, 244, 91, 43,
+			11, 110, 168, 230, 75, 65, 124, 39, 235, 92, 108, 53, 26, 146,
+			232, 48, 109, 157, 51, 69, 36, 128, 224, 176, 245, 42, 54, 130,
+			116, 250, 205, 203, 172, 168, 137, 114, 48, 39, 140, 161, 226, 76,
+			2, 203, 111, 77, 58, 74, 29, 204, 235, 111, 18, 190, 209, 46,
+			218, 165, 92, 229, 74, 214, 191, 97, 40, 188, 143, 117, 157, 153,
+			24, 63, 123, 106, 230, 20, 206, 160, 74, 29, 188, 151, 101, 70,
+			87, 50, 14, 118, 22, 31, 42, 148, 238, 184, 227, 142, 59, 204,
+			202, 127, 206, 179, 45, 155, 49, 193, 77, 249, 113, 58, 104, 171,
+			109, 208, 167, 88, 174, 225, 44, 184, 141, 65, 155, 38, 225, 208,
+			227, 98, 179, 213, 179, 40, 50, 35, 75, 242, 103, 40, 212, 0,
+			5, 189, 99, 7, 31, 95, 13, 224, 175, 10, 141, 59, 89, 39,
+			254, 74, 188, 231, 37, 222, 145, 64, 120, 47, 179, 34, 241, 189,
+			186, 155, 204, 137, 134, 193, 41, 234, 238, 162, 211, 106, 196, 243,
+			36, 56, 18, 7, 235, 156, 233, 86, 137, 207, 70, 26, 223, 195,
+			186, 136, 219, 205, 227, 176, 233, 34, 109, 161, 185, 25, 201, 57,
+			39, 145, 130, 105, 127, 65, 20, 248, 154, 215, 160, 134, 34, 18,
+			168, 249, 107, 82, 110, 33, 119, 239, 93, 155, 15, 111, 61, 147,
+			224, 7, 88, 31, 229, 56, 166, 150, 178, 211, 24, 236, 39, 50,
+			232, 149, 201, 211, 42, 181, 242, 151, 38, 179, 129, 12, 76, 253,
+			220, 115, 207, 77, 204, 159, 153, 62, 127, 250, 236, 68, 201, 192,
+			212, 83, 194, 117, 103, 167, 79, 205, 149, 204, 4, 38, 195, 106,
+			201, 74, 10, 156, 151, 9, 118, 54, 195, 177, 177, 82, 142, 151,
+			88, 55, 193, 215, 77, 62, 103, 226, 204, 213, 87, 150, 242, 237,
+			41, 199, 198, 74, 5, 222, 195, 58, 41, 229, 244, 244, 244, 217,
+			82, 49, 169, 115, 118, 110, 102, 114, 234, 250, 82, 103, 82, 231,
+			245, 51, 211, 231, 207, 149, 88, 82, 195, 77, 19, 179, 179, 167,
+			174, 159, 40, 117, 37, 57, 78, 63, 119, 110, 98, 182, 212, 221,
+			214, 173, 99, 99, 165, 158, 164, 137, 137, 169, 243, 55, 149, 122,
+			121, 63, 235, 33, 112, 86, 119, 162, 111, 93, 210, 213, 87, 150,
+			74, 105, 71, 100, 45, 253, 109, 9, 87, 95, 89, 226, 149, 113,
+			150, 35, 50, 228, 156, 245, 158, 61, 117, 122, 226, 236, 252, 244,
+			57, 44, 154, 83, 103, 75, 70, 154, 54, 51, 113, 110, 226, 212,
+			220, 196, 153, 146, 149, 77, 251, 149, 243, 147, 51, 19, 103, 74,
+			102, 165, 198, 182, 108, 182, 67, 110, 186, 132, 50, 180, 96, 94,
+			130, 22, 168, 174, 245, 180, 80, 249, 166, 201, 6, 54, 145, 18,
+			54, 109, 228, 153, 44, 39, 105, 89, 114, 234, 225, 13, 77, 160,
+			34, 162, 236, 117, 181, 205, 200, 114, 89, 121, 211, 186, 132, 188,
+			137, 42, 54, 16, 236, 243, 55, 236, 230, 82, 224, 185, 122, 211,
+			226, 235, 26, 167, 180, 39, 182, 171, 231, 54, 217, 213, 79, 178,
+			254, 13, 21, 61, 238, 221, 245, 229, 6, 27, 188, 20, 114, 30,
+			131, 37, 154, 109, 44, 241, 228, 122, 12, 238, 221, 20, 5, 212,
+			206, 134, 185, 126, 167, 193, 182, 109, 174, 87, 108, 218, 135, 103,
+			176, 188, 52, 30, 169, 249, 222, 40, 140, 220, 68, 159, 215, 213,
+			53, 163, 74, 101, 197, 55, 235, 18, 226, 155, 234, 205, 134, 158,
+			254, 150, 201, 182, 110, 90, 249, 166, 29, 221, 197, 152, 231, 55,
+			91, 177, 148, 133, 129, 176, 206, 153, 78, 74, 33, 230, 5, 46,
+			219, 138, 147, 239, 22, 125, 103, 50, 137, 50, 60, 45, 237, 168,
+			77, 29, 221, 125, 137, 145, 174, 239, 39, 63, 194, 74, 181, 134,
+			231, 250, 241, 124, 20, 135, 174, 179, 226, 249, 75, 114, 183, 61,
+			145, 91, 116, 26, 145, 59, 211, 39, 63, 207, 234, 175, 40, 65,
+			4, 20, 102, 74, 228, 219, 74, 200, 207, 73, 137, 202, 123, 58,
+			89, 87, 70, 11, 227, 123, 89, 247, 11, 156, 11, 206, 188, 214,
+			172, 13, 210, 172, 187, 144, 118, 78, 105, 215, 71, 216, 22, 128,
+			243, 65, 43, 118, 195, 249, 90, 195, 137, 34, 32, 138, 148, 188,
+			206, 25, 142, 111, 211, 248, 52, 174, 191, 240, 171, 216, 0, 82,
+			231, 87, 90, 141, 216, 107, 54, 220, 121, 232, 250, 209, 32, 203,
+			246, 172, 31, 57, 110, 82, 25, 208, 163, 136, 159, 97, 187, 144,
+			56, 175, 12, 86, 238, 188, 251, 194, 150, 211, 136, 230, 29, 191,
+			62, 191, 236, 68, 203, 131, 91, 80, 193, 105, 115, 208, 152, 217,
+			129, 140, 215, 171, 124, 19, 148, 237, 148, 95, 191, 193, 137, 150,
+			249, 9, 182, 13, 31, 129, 17, 207, 95, 154, 175, 45, 187, 181,
+			219, 230, 91, 241, 226, 211, 6, 119, 102, 219, 167, 30, 74, 227,
+			233, 56, 178, 156, 143, 23, 159, 198, 103, 89, 55, 230, 110, 197,
+			187, 221, 157, 95, 12, 66, 218, 67, 123, 199, 134, 31, 77, 143,
+			173, 78, 171, 2, 55, 5, 117, 247, 68, 110, 246, 220, 196, 196,
+			153, 153, 46, 93, 203, 117, 65, 200, 119, 49, 182, 20, 36, 8,
+			238, 34, 172, 117, 46, 5, 26, 189, 87, 177, 129, 90, 77, 142,
+			217, 171, 205, 43, 141, 60, 26, 44, 181, 33, 171, 86, 163, 193,
+			122, 53, 69, 227, 17, 63, 206, 182, 166, 200, 202, 22, 236, 207,
+			22, 28, 72, 240, 148, 41, 122, 21, 27, 104, 174, 109, 44, 200,
+			219, 90, 108, 174, 173, 47, 182, 159, 172, 44, 161, 75, 54, 244,
+			193, 237, 217, 220, 153, 15, 188, 202, 74, 181, 218, 188, 235, 195,
+			252, 56, 239, 132, 174, 239, 68, 131, 123, 40, 179, 29, 135, 45,
+			119, 166, 183, 86, 155, 160, 143, 167, 232, 27, 63, 200, 250, 131,
+			133, 23, 212, 36, 97, 205, 55, 67, 119, 209, 187, 56, 120, 57,
+			97, 169, 15, 31, 136, 172, 206, 81, 50, 31, 102, 165, 90, 180,
+			236, 132, 77, 226, 172, 81, 211, 169, 185, 131, 251, 101, 86, 153,
+			62, 165, 147, 65, 216, 209, 170, 183, 24, 235, 26, 15, 80, 182,
+			46, 74, 83, 181, 13, 177, 82, 115, 185, 217, 222, 240, 16, 101,
+			235, 109, 46, 55, 179, 237, 238, 99, 61, 205, 229, 108, 163, 195,
+			148, 173, 187, 185, 156, 105, 241, 74, 182, 13, 153, 86, 220, 216,
+			169, 59, 177, 147, 201, 61, 66, 185, 183, 52, 151, 155, 55, 169,
+			143, 109, 253, 12, 91, 11, 107, 9, 125, 140, 82, 222, 46, 164,
+			105, 10, 121, 210, 234, 199, 83, 166, 108, 85, 78, 176, 238, 44,
+			221, 243, 78, 38, 41, 191, 100, 64, 8, 26, 159, 62, 51, 49,
+			63, 59, 121, 203, 68, 201, 132, 24, 117, 118, 114, 110, 98, 126,
+			230, 252, 20, 124, 13, 74, 86, 70, 176, 191, 209, 46, 30, 44,
+			29, 186, 209, 46, 94, 81, 58, 64, 232, 217, 64, 148, 149, 71,
+			44, 214, 219, 174, 150, 243, 167, 179, 237, 218, 238, 134, 99, 248,
+			85, 47, 164, 197, 186, 226, 200, 141, 51, 33, 202, 45, 42, 215,
+			172, 27, 223, 236, 133, 202, 130, 206, 207, 178, 61, 126, 48, 175,
+			143, 44, 230, 83, 139, 231, 188, 83, 171, 185, 81, 20, 132, 131,
+			102, 182, 150, 203, 252, 96, 86, 101, 78, 119, 143, 83, 42, 235,
+			186, 53, 97, 93, 106, 77, 236, 100, 157, 43, 78, 115, 222, 245,
+			227, 112, 141, 100, 247, 226, 76, 113, 197, 105, 78, 0, 230, 207,
+			102, 87, 164, 89, 231, 27, 238, 146, 83, 91, 155, 135, 92, 62,
+			79, 54, 162, 249, 90, 224, 47, 54, 188, 90, 28, 13, 118, 37,
+			252, 175, 146, 150, 56, 75, 5, 110, 140, 2, 159, 84, 164, 113,
+			157, 187, 77, 107, 237, 254, 87, 65, 54, 237, 83, 111, 151, 114,
+			55, 218, 197, 92, 41, 127, 163, 93, 204, 151, 10, 55, 218, 197,
+			98, 169, 243, 70, 187, 216, 89, 98, 149, 183, 246, 176, 238, 172,
+			186, 193, 79, 177, 92, 141, 54, 92, 76, 113, 239, 216, 190, 71,
+			85, 78, 170, 227, 216, 137, 79, 228, 165, 108, 63, 35, 75, 66,
+			10, 194, 34, 115, 165, 44, 85, 156, 81, 16, 191, 158, 229, 95,
+			16, 33, 7, 169, 175, 189, 99, 151, 63, 122, 221, 55, 206, 82,
+			229, 157, 55, 206, 206, 79, 77, 207, 220, 116, 234, 236, 140, 42,
+			206, 119, 48, 187, 225, 220, 190, 214, 190, 103, 83, 18, 175, 178,
+			190, 22, 28, 178, 188, 69, 15, 115, 140, 92, 125, 217, 92, 189,
+			233, 215, 179, 200, 255, 56, 233, 106, 7, 179, 97, 148, 110, 223,
+			89, 41, 137, 15, 177, 238, 186, 187, 208, 90, 154, 15, 221, 186,
+			83, 139, 219, 247, 147, 46, 250, 52, 67, 95, 248, 179, 88, 39,
+			38, 206, 199, 240, 72, 117, 235, 29, 27, 125, 116, 20, 168, 41,
+			214, 133, 102, 210, 242, 252, 6, 86, 136, 157, 112, 201, 141, 163,
+			193, 1, 97, 13, 245, 142, 85, 31, 79, 85, 115, 84, 4, 120,
+			157, 209, 197, 249, 205, 172, 164, 76, 177, 243, 74, 205, 141, 6,
+			183, 16, 223, 26, 121, 244, 42, 149, 37, 247, 140, 44, 52, 211,
+			231, 182, 193, 237, 235, 98, 235, 19, 89, 23, 231, 89, 159, 250,
+			61, 31, 181, 154, 205, 32, 140, 7, 183, 9, 227, 177, 59, 164,
+			43, 147, 101, 102, 122, 23, 219, 224, 167, 110, 185, 149, 111, 97,
+			189, 237, 200, 200, 26, 194, 173, 199, 105, 8, 231, 91, 82, 69,
+			13, 91, 147, 212, 190, 202, 111, 48, 89, 111, 251, 192, 248, 245,
+			140, 171, 50, 243, 158, 31, 135, 65, 189, 85, 115, 229, 61, 160,
+			71, 107, 167, 95, 149, 153, 76, 138, 100, 43, 202, 172, 2, 243,
+			113, 86, 116, 38, 93, 31, 135, 217, 128, 174, 0, 149, 173, 58,
+			116, 99, 67, 153, 182, 120, 230, 211, 205, 242, 11, 63, 197, 52,
+			185, 204, 135, 238, 74, 112, 193, 173, 15, 218, 143, 209, 108, 175,
+			42, 48, 35, 243, 87, 14, 179, 28, 177, 31, 206, 152, 98, 64,
+			165, 14, 94, 100, 246, 248, 244, 204, 153, 146, 129, 253, 80, 166,
+			206, 159, 155, 156, 24, 159, 40, 153, 149, 171, 88, 94, 242, 20,
+			108, 157, 9, 87, 41, 117, 40, 80, 213, 97, 232, 175, 231, 111,
+			58, 61, 49, 83, 50, 43, 231, 89, 223, 186, 117, 200, 183, 178,
+			254, 153, 137, 185, 137, 41, 24, 7, 230, 207, 79, 61, 107, 106,
+			250, 230, 169, 82, 71, 123, 178, 222, 135, 13, 190, 133, 149, 210,
+			228, 217, 233, 243, 51, 212, 155, 223, 49, 89, 105, 253, 162, 228,
+			219, 217, 192, 220, 169, 153, 235, 39, 230, 230, 201, 50, 145, 86,
+			189, 133, 149, 178, 31, 224, 81, 88, 50, 248, 30, 182, 51, 155,
+			58, 241, 156, 185, 137, 169, 89, 180, 50, 115, 106, 234, 122, 8,
+			5, 235, 234, 211, 38, 22, 11, 93, 205, 126, 184, 110, 114, 226,
+			236, 153, 146, 189, 62, 121, 122, 106, 98, 250, 186, 82, 110, 125,
+			235, 100, 118, 201, 243, 50, 219, 182, 62, 117, 126, 98, 106, 110,
+			230, 185, 165, 194, 250, 134, 103, 39, 102, 158, 61, 57, 62, 81,
+			42, 242, 109, 140, 103, 63, 220, 52, 49, 119, 195, 244, 153, 82,
+			231, 102, 59, 22, 47, 13, 84, 254, 163, 193, 186, 179, 38, 144,
+			54, 166, 98, 252, 107, 219, 108, 43, 159, 51, 89, 87, 198, 22,
+			2, 83, 161, 211, 104, 4, 171, 243, 78, 195, 115, 34, 181, 31,
+			50, 74, 58, 133, 148, 199, 187, 255, 60, 126, 209, 37, 255, 164,
+			69, 151, 194, 191, 66, 209, 37, 87, 202, 87, 190, 100, 178, 210,
+			122, 235, 200, 58, 188, 25, 151, 194, 91, 118, 124, 230, 19, 25,
+			223, 250, 93, 221, 186, 228, 174, 190, 201, 102, 101, 255, 107, 222,
+			172, 178, 228, 250, 69, 131, 245, 42, 181, 83, 35, 54, 139, 177,
+			202, 19, 193, 88, 251, 140, 236, 189, 212, 140, 252, 127, 50, 174,
+			55, 91, 172, 167, 205, 246, 243, 120, 123, 247, 66, 214, 239, 213,
+			221, 149, 102, 16, 195, 243, 96, 190, 129, 11, 8, 132, 134, 222,
+			177, 195, 143, 110, 93, 170, 78, 166, 229, 206, 162, 216, 137, 129,
+			201, 51, 19, 55, 157, 155, 158, 155, 152, 26, 127, 174, 222, 36,
+			102, 74, 153, 234, 41, 91, 219, 18, 220, 247, 68, 16, 254, 148,
+			97, 178, 114, 142, 149, 214, 143, 6, 12, 125, 147, 241, 148, 58,
+			248, 0, 235, 155, 154, 158, 159, 157, 60, 51, 49, 63, 113, 221,
+			117, 19, 227, 115, 179, 242, 160, 33, 201, 61, 87, 50, 179, 115,
+			243, 251, 22, 27, 216, 164, 39, 252, 148, 50, 17, 74, 171, 229,
+			232, 227, 233, 125, 21, 218, 253, 57, 39, 140, 149, 69, 113, 152,
+			1, 189, 126, 12, 225, 63, 84, 7, 56, 22, 29, 224, 244, 165,
+			233, 196, 70, 248, 8, 227, 205, 32, 242, 224, 156, 4, 25, 74,
+			101, 198, 194, 181, 103, 74, 250, 203, 164, 31, 39, 185, 181, 91,
+			81, 38, 55, 212, 15, 107, 166, 164, 191, 36, 185, 247, 178, 238,
+			122, 208, 130, 85, 70, 214, 10, 150, 108, 204, 116, 201, 180, 36,
+			139, 50, 155, 165, 199, 76, 221, 51, 93, 50, 77, 102, 57, 192,
+			250, 156, 165, 165, 16, 149, 235, 138, 164, 33, 176, 55, 73, 166,
+			140, 229, 27, 89, 81, 227, 1, 39, 79, 192, 196, 124, 83, 90,
+			183, 77, 156, 60, 249, 250, 227, 94, 214, 237, 69, 243, 201, 153,
+			255, 160, 41, 204, 161, 226, 76, 151, 23, 37, 167, 162, 149, 247,
+			116, 49, 150, 18, 27, 127, 157, 193, 122, 229, 6, 35, 157, 210,
+			106, 90, 45, 220, 196, 82, 151, 148, 146, 50, 249, 57, 85, 224,
+			244, 51, 95, 101, 24, 111, 50, 236, 55, 25, 198, 219, 141, 30,
+			94, 156, 120, 206, 185, 179, 147, 227, 147, 115, 131, 15, 22, 8,
+			158, 188, 73, 193, 255, 88, 104, 255, 254, 80, 225, 189, 134, 85,
+			124, 168, 48, 211, 179, 152, 173, 143, 55, 178, 30, 20, 230, 165,
+			20, 201, 180, 55, 19, 202, 111, 226, 244, 48, 117, 36, 79, 29,
+			233, 226, 249, 241, 179, 211, 179, 19, 103, 168, 27, 157, 220, 158,
+			62, 55, 49, 53, 248, 143, 186, 201, 212, 217, 226, 77, 6, 219,
+			174, 79, 89, 213, 94, 75, 206, 169, 90, 186, 237, 29, 59, 250,
+			104, 141, 207, 168, 162, 132, 146, 9, 85, 240, 244, 232, 6, 148,
+			156, 154, 58, 163, 250, 210, 197, 243, 231, 78, 141, 63, 107, 226,
+			76, 218, 155, 173, 225, 102, 181, 240, 151, 176, 62, 88, 91, 65,
+			27, 94, 157, 132, 235, 65, 251, 82, 231, 165, 105, 143, 96, 126,
+			125, 118, 82, 66, 33, 69, 206, 78, 39, 183, 167, 166, 167, 38,
+			116, 55, 232, 0, 252, 185, 105, 55, 122, 91, 109, 69, 249, 75,
+			88, 73, 155, 135, 18, 148, 228, 46, 117, 228, 155, 118, 64, 25,
+			153, 18, 100, 92, 145, 233, 193, 22, 222, 119, 118, 98, 234, 250,
+			185, 27, 230, 207, 205, 76, 208, 201, 221, 224, 131, 186, 249, 190,
+			149, 246, 130, 252, 215, 13, 214, 37, 173, 55, 100, 112, 82, 70,
+			133, 43, 30, 109, 240, 36, 1, 81, 238, 211, 199, 169, 89, 75,
+			19, 196, 118, 206, 207, 78, 92, 127, 106, 252, 185, 243, 167, 39,
+			102, 231, 192, 201, 166, 103, 36, 141, 50, 158, 59, 117, 246, 236,
+			244, 205, 41, 34, 216, 11, 146, 106, 42, 255, 63, 214, 211, 70,
+			238, 16, 138, 73, 152, 198, 8, 102, 39, 166, 198, 179, 66, 124,
+			55, 75, 200, 187, 100, 240, 110, 150, 16, 127, 201, 4, 27, 85,
+			29, 72, 206, 18, 173, 202, 53, 172, 168, 201, 23, 162, 57, 73,
+			216, 235, 20, 131, 34, 35, 218, 45, 25, 80, 131, 36, 77, 151,
+			204, 202, 179, 217, 214, 77, 73, 143, 239, 99, 123, 244, 249, 229,
+			188, 236, 231, 196, 212, 248, 244, 153, 201, 169, 235, 51, 117, 50,
+			166, 104, 80, 246, 82, 211, 103, 201, 172, 76, 178, 222, 118, 2,
+			226, 59, 217, 246, 243, 115, 215, 61, 109, 254, 217, 167, 206, 78,
+			158, 57, 181, 78, 33, 98, 76, 81, 81, 201, 132, 102, 6, 234,
+			42, 89, 21, 187, 104, 148, 140, 202, 44, 235, 91, 71, 10, 252,
+			50, 54, 168, 52, 148, 205, 122, 53, 192, 214, 19, 135, 52, 130,
+			158, 153, 56, 59, 121, 211, 36, 14, 100, 205, 202, 13, 140, 165,
+			115, 140, 61, 11, 46, 237, 243, 215, 65, 209, 155, 203, 84, 213,
+			201, 228, 156, 150, 12, 232, 35, 27, 39, 190, 100, 30, 252, 109,
+			3, 91, 214, 111, 79, 149, 127, 221, 224, 187, 138, 15, 21, 120,
+			161, 218, 92, 168, 214, 154, 205, 114, 31, 126, 140, 55, 155, 138,
+			174, 34, 190, 167, 248, 237, 2, 47, 34, 21, 135, 0, 229, 18,
+			126, 193, 171, 57, 201, 176, 179, 248, 157, 2, 207, 35, 121, 41,
+			40, 247, 226, 239, 245, 65, 242, 113, 95, 241, 55, 167, 56, 67,
+			34, 45, 215, 163, 101, 142, 223, 116, 108, 118, 84, 103, 58, 152,
+			47, 254, 246, 84, 233, 181, 83, 7, 243, 197, 215, 78, 149, 94,
+			55, 117, 99, 190, 248, 143, 133, 210, 67, 133, 202, 255, 176, 24,
+			87, 153, 102, 221, 56, 177, 188, 60, 135, 21, 19, 83, 142, 244,
+			21, 125, 250, 163, 44, 11, 93, 44, 147, 164, 116, 110, 245, 101,
+			38, 169, 13, 122, 251, 138, 231, 123, 43, 173, 149, 121, 165, 142,
+			63, 182, 222, 174, 10, 40, 152, 170, 112, 46, 182, 85, 145, 123,
+			204, 42, 156, 139, 153, 42, 202, 63, 54, 216, 224, 165, 58, 251,
+			164, 76, 47, 83, 108, 11, 238, 81, 135, 94, 29, 7, 38, 243,
+			137, 64, 102, 63, 182, 64, 54, 144, 41, 168, 146, 35, 126, 26,
+			251, 230, 69, 183, 158, 214, 148, 123, 236, 154, 122, 168, 136, 174,
+			227, 70, 44, 19, 232, 64, 102, 201, 74, 165, 190, 202, 59, 77,
+			214, 219, 238, 156, 201, 207, 176, 34, 174, 53, 99, 32, 202, 51,
+			120, 232, 49, 252, 57, 171, 103, 85, 254, 153, 164, 100, 249, 62,
+			131, 21, 117, 50, 223, 198, 236, 166, 19, 47, 83, 117, 185, 211,
+			102, 201, 152, 33, 24, 233, 81, 211, 145, 78, 95, 42, 29, 48,
+			206, 139, 26, 174, 3, 126, 62, 95, 11, 86, 86, 112, 53, 91,
+			25, 127, 250, 84, 250, 184, 74, 134, 143, 112, 28, 58, 94, 163,
+			45, 175, 77, 121, 75, 250, 67, 146, 249, 4, 219, 161, 235, 173,
+			187, 49, 194, 164, 212, 211, 66, 112, 227, 236, 156, 217, 174, 50,
+			156, 81, 223, 117, 217, 202, 103, 77, 214, 175, 79, 46, 235, 9,
+			178, 110, 98, 204, 241, 253, 32, 206, 162, 107, 163, 176, 185, 161,
+			92, 245, 84, 82, 104, 38, 83, 65, 249, 187, 6, 99, 233, 167,
+			75, 226, 109, 15, 235, 82, 174, 183, 56, 161, 85, 6, 62, 38,
+			147, 174, 243, 26, 46, 108, 127, 11, 238, 146, 231, 43, 95, 42,
+			9, 104, 151, 4, 59, 113, 73, 224, 51, 172, 24, 185, 43, 142,
+			31, 123, 53, 34, 169, 222, 177, 171, 159, 80, 231, 171, 179, 170,
+			244, 76, 82, 79, 101, 136, 21, 117, 106, 194, 165, 59, 120, 129,
+			89, 179, 19, 115, 37, 3, 71, 78, 167, 206, 78, 158, 154, 45,
+			153, 7, 223, 105, 178, 130, 90, 59, 216, 176, 38, 100, 92, 131,
+			54, 30, 221, 171, 19, 37, 87, 45, 189, 162, 144, 77, 60, 55,
+			51, 61, 55, 61, 86, 122, 112, 99, 226, 177, 210, 63, 22, 120,
+			63, 235, 214, 137, 8, 245, 83, 122, 104, 125, 210, 149, 165, 111,
+			147, 109, 73, 39, 29, 157, 159, 3, 215, 158, 158, 58, 251, 220,
+			146, 145, 253, 48, 150, 249, 96, 242, 93, 108, 187, 254, 128, 43,
+			15, 215, 100, 62, 190, 245, 206, 252, 250, 207, 79, 203, 124, 126,
+			219, 198, 207, 199, 51, 159, 255, 224, 206, 60, 31, 96, 93, 250,
+			243, 77, 167, 158, 83, 250, 197, 47, 126, 241, 139, 194, 233, 151,
+			108, 30, 62, 164, 180, 206, 49, 34, 186, 193, 184, 101, 244, 49,
+			3, 136, 164, 103, 103, 109, 177, 67, 202, 235, 99, 135, 204, 184,
+			139, 13, 151, 174, 70, 220, 248, 218, 15, 89, 172, 192, 115, 219,
+			58, 94, 193, 12, 246, 31, 11, 20, 57, 100, 219, 191, 69, 14,
+			249, 183, 200, 33, 191, 140, 200, 33, 99, 95, 49, 85, 240, 173,
+			19, 226, 54, 215, 143, 3, 255, 223, 41, 26, 174, 5, 43, 98,
+			232, 89, 148, 36, 158, 237, 132, 117, 7, 87, 96, 79, 59, 32,
+			160, 192, 23, 65, 232, 45, 121, 190, 211, 16, 68, 251, 181, 160,
+			129, 136, 53, 139, 110, 24, 137, 186, 27, 121, 75, 62, 221, 91,
+			20, 179, 142, 255, 2, 103, 77, 92, 191, 236, 174, 56, 171, 184,
+			1, 123, 163, 187, 184, 40, 206, 184, 142, 47, 239, 125, 210, 149,
+			195, 72, 135, 126, 80, 90, 65, 230, 158, 21, 104, 75, 174, 152,
+			5, 137, 138, 186, 187, 232, 249, 196, 180, 112, 11, 171, 229, 215,
+			145, 87, 174, 73, 162, 196, 168, 202, 196, 41, 92, 20, 242, 234,
+			217, 100, 125, 149, 46, 14, 29, 63, 106, 128, 159, 138, 186, 23,
+			186, 181, 184, 177, 134, 43, 66, 142, 216, 228, 62, 13, 35, 2,
+			14, 90, 49, 69, 30, 161, 158, 10, 207, 151, 135, 230, 88, 35,
+			67, 110, 117, 169, 154, 228, 9, 229, 206, 37, 112, 221, 212, 91,
+			129, 233, 48, 26, 78, 226, 178, 12, 38, 113, 89, 202, 29, 103,
+			116, 8, 22, 252, 148, 137, 59, 211, 16, 44, 59, 147, 16, 44,
+			151, 117, 28, 213, 33, 88, 240, 83, 38, 238, 234, 184, 70, 7,
+			86, 193, 79, 153, 184, 59, 13, 193, 178, 59, 9, 193, 178, 39,
+			13, 0, 179, 39, 9, 0, 179, 175, 227, 50, 246, 82, 102, 22,
+			59, 233, 103, 57, 22, 235, 239, 46, 137, 21, 117, 125, 74, 251,
+			226, 200, 160, 31, 81, 19, 139, 102, 193, 173, 57, 88, 246, 97,
+			194, 154, 70, 23, 64, 14, 76, 56, 13, 196, 41, 137, 151, 87,
+			34, 81, 15, 252, 3, 177, 88, 13, 194, 219, 68, 189, 5, 11,
+			136, 88, 8, 130, 56, 138, 67, 121, 41, 186, 202, 216, 11, 100,
+			48, 152, 3, 29, 195, 70, 249, 87, 233, 150, 109, 83, 147, 16,
+			152, 135, 215, 160, 155, 120, 190, 144, 238, 97, 27, 230, 102, 214,
+			197, 221, 47, 31, 252, 67, 223, 131, 149, 19, 205, 36, 1, 8,
+			47, 150, 247, 94, 35, 21, 215, 10, 119, 220, 14, 20, 119, 176,
+			46, 125, 59, 111, 200, 28, 82, 247, 187, 58, 108, 64, 201, 213,
+			184, 60, 183, 134, 186, 118, 107, 200, 224, 214, 208, 158, 125, 26,
+			178, 184, 53, 116, 197, 1, 54, 202, 204, 92, 7, 183, 71, 58,
+			110, 50, 202, 123, 169, 243, 240, 1, 23, 145, 75, 161, 102, 40,
+			58, 148, 80, 130, 168, 106, 63, 135, 246, 71, 114, 221, 8, 32,
+			150, 163, 219, 129, 85, 115, 91, 121, 20, 151, 130, 27, 78, 205,
+			93, 14, 26, 96, 198, 192, 178, 227, 139, 150, 223, 86, 5, 8,
+			89, 135, 217, 66, 89, 131, 91, 85, 179, 95, 67, 38, 183, 170,
+			91, 182, 178, 55, 27, 84, 177, 193, 173, 49, 115, 123, 249, 183,
+			141, 117, 53, 235, 170, 212, 60, 214, 188, 197, 53, 224, 77, 105,
+			1, 98, 193, 93, 118, 46, 120, 65, 24, 137, 131, 50, 224, 195,
+			65, 92, 137, 148, 114, 43, 19, 171, 14, 22, 97, 24, 197, 34,
+			61, 156, 172, 10, 49, 135, 197, 137, 155, 235, 139, 139, 216, 163,
+			46, 184, 141, 53, 226, 132, 158, 79, 171, 211, 165, 235, 189, 149,
+			164, 223, 184, 84, 55, 102, 150, 52, 100, 114, 107, 108, 96, 27,
+			251, 117, 147, 250, 109, 114, 235, 105, 230, 246, 242, 35, 134, 144,
+			39, 27, 66, 94, 63, 18, 21, 213, 241, 168, 66, 13, 186, 17,
+			226, 231, 184, 163, 184, 199, 153, 96, 120, 68, 44, 180, 112, 53,
+			146, 46, 12, 174, 180, 106, 203, 162, 225, 221, 166, 184, 184, 231,
+			215, 226, 36, 99, 82, 69, 205, 1, 121, 46, 184, 73, 76, 53,
+			133, 18, 98, 46, 42, 55, 102, 50, 75, 81, 178, 21, 133, 147,
+			54, 14, 68, 171, 5, 199, 218, 141, 53, 121, 239, 122, 236, 176,
+			186, 126, 173, 149, 44, 44, 31, 150, 137, 218, 3, 10, 119, 98,
+			111, 193, 107, 120, 241, 90, 130, 32, 211, 0, 18, 52, 130, 8,
+			37, 3, 219, 64, 176, 185, 14, 211, 226, 214, 113, 115, 187, 250,
+			100, 25, 128, 116, 70, 203, 228, 214, 241, 129, 109, 236, 207, 36,
+			5, 216, 220, 122, 134, 185, 173, 252, 71, 134, 80, 114, 29, 174,
+			170, 59, 177, 188, 78, 185, 224, 186, 62, 34, 24, 185, 88, 177,
+			18, 29, 233, 62, 162, 110, 100, 226, 254, 187, 19, 46, 120, 113,
+			136, 232, 69, 180, 123, 168, 171, 227, 216, 241, 23, 192, 125, 233,
+			34, 31, 54, 0, 137, 148, 120, 217, 93, 19, 171, 184, 179, 169,
+			238, 176, 131, 77, 123, 43, 238, 104, 16, 214, 221, 80, 113, 15,
+			215, 137, 214, 24, 133, 20, 115, 66, 47, 162, 64, 11, 178, 255,
+			182, 129, 30, 247, 170, 209, 216, 38, 183, 158, 209, 191, 85, 13,
+			59, 199, 173, 103, 154, 219, 212, 167, 156, 1, 72, 103, 204, 153,
+			220, 122, 102, 255, 86, 246, 98, 202, 152, 231, 214, 184, 89, 46,
+			55, 197, 185, 141, 68, 143, 61, 34, 76, 98, 254, 233, 25, 204,
+			94, 78, 85, 100, 209, 54, 76, 38, 137, 35, 8, 129, 175, 53,
+			181, 221, 181, 226, 200, 171, 187, 32, 13, 84, 23, 37, 99, 200,
+			27, 104, 127, 171, 134, 76, 110, 141, 15, 238, 80, 99, 40, 112,
+			235, 140, 89, 86, 159, 10, 6, 32, 157, 177, 96, 114, 235, 76,
+			146, 177, 200, 173, 9, 179, 162, 62, 97, 27, 152, 48, 119, 104,
+			200, 228, 214, 196, 101, 123, 85, 198, 78, 110, 93, 151, 100, 236,
+			52, 0, 233, 140, 157, 38, 183, 174, 75, 50, 50, 110, 93, 159,
+			100, 100, 6, 32, 157, 145, 153, 220, 186, 254, 178, 189, 236, 110,
+			73, 53, 93, 220, 58, 107, 238, 44, 255, 169, 209, 134, 192, 117,
+			220, 162, 229, 47, 4, 144, 26, 235, 26, 179, 66, 157, 143, 105,
+			102, 160, 16, 24, 248, 141, 53, 38, 220, 11, 110, 152, 172, 177,
+			133, 53, 209, 108, 180, 150, 60, 77, 141, 53, 132, 206, 186, 216,
+			84, 23, 246, 125, 202, 171, 100, 54, 218, 104, 107, 203, 240, 8,
+			71, 124, 43, 166, 27, 17, 142, 240, 221, 85, 221, 116, 130, 250,
+			46, 3, 93, 239, 209, 144, 201, 173, 179, 165, 50, 59, 44, 195,
+			31, 158, 235, 8, 141, 242, 62, 113, 70, 9, 15, 50, 34, 24,
+			98, 21, 196, 122, 191, 160, 197, 173, 246, 8, 112, 168, 115, 197,
+			157, 236, 184, 142, 125, 248, 43, 230, 206, 202, 8, 229, 16, 190,
+			179, 226, 142, 180, 5, 255, 10, 131, 128, 216, 189, 212, 249, 68,
+			28, 186, 110, 18, 9, 209, 70, 217, 108, 92, 196, 95, 233, 234,
+			207, 196, 69, 252, 21, 190, 45, 19, 23, 241, 87, 118, 148, 217,
+			65, 106, 210, 224, 214, 140, 185, 187, 178, 75, 144, 80, 81, 89,
+			12, 130, 202, 8, 253, 169, 46, 56, 97, 101, 68, 184, 113, 77,
+			221, 88, 54, 76, 195, 70, 230, 4, 202, 113, 107, 38, 105, 3,
+			3, 153, 225, 59, 52, 100, 113, 107, 230, 178, 93, 236, 74, 106,
+			195, 228, 214, 121, 115, 111, 249, 128, 192, 17, 3, 221, 222, 86,
+			27, 38, 137, 43, 114, 170, 18, 177, 43, 105, 205, 180, 81, 44,
+			129, 114, 220, 58, 159, 180, 6, 190, 117, 158, 95, 166, 33, 139,
+			91, 231, 247, 8, 246, 43, 212, 154, 197, 173, 155, 205, 161, 242,
+			25, 65, 247, 104, 220, 72, 75, 249, 242, 238, 112, 218, 168, 234,
+			131, 175, 196, 59, 125, 83, 88, 52, 188, 40, 22, 206, 66, 144,
+			92, 213, 54, 76, 203, 70, 157, 9, 148, 227, 214, 205, 93, 58,
+			204, 36, 56, 227, 205, 253, 21, 13, 161, 245, 253, 7, 216, 237,
+			212, 21, 155, 91, 183, 152, 87, 148, 87, 214, 119, 5, 62, 95,
+			143, 175, 35, 85, 25, 238, 66, 42, 105, 163, 116, 120, 5, 217,
+			119, 197, 83, 177, 66, 137, 240, 171, 226, 76, 160, 117, 163, 164,
+			207, 54, 53, 158, 64, 57, 110, 221, 146, 244, 25, 252, 239, 150,
+			126, 161, 33, 139, 91, 183, 236, 219, 207, 174, 166, 62, 231, 184,
+			245, 124, 115, 164, 60, 76, 129, 221, 226, 160, 57, 74, 231, 151,
+			109, 187, 79, 86, 74, 78, 218, 203, 217, 40, 152, 64, 121, 110,
+			61, 191, 171, 172, 33, 131, 91, 207, 223, 121, 64, 67, 22, 183,
+			158, 127, 240, 16, 24, 134, 109, 152, 121, 110, 253, 170, 57, 170,
+			62, 229, 109, 64, 186, 146, 60, 190, 41, 185, 200, 32, 134, 247,
+			171, 123, 134, 52, 100, 113, 235, 87, 15, 141, 168, 74, 10, 220,
+			154, 55, 171, 234, 83, 193, 6, 164, 43, 41, 228, 185, 53, 223,
+			181, 87, 67, 6, 183, 230, 43, 195, 26, 178, 184, 53, 63, 50,
+			170, 42, 41, 114, 235, 214, 164, 146, 162, 13, 72, 87, 82, 204,
+			115, 235, 214, 174, 61, 26, 50, 184, 117, 171, 208, 149, 20, 45,
+			110, 221, 154, 84, 210, 201, 173, 5, 115, 159, 170, 164, 211, 6,
+			164, 43, 233, 204, 115, 107, 161, 75, 47, 67, 48, 209, 133, 237,
+			122, 112, 157, 22, 183, 22, 246, 86, 216, 35, 6, 213, 194, 184,
+			181, 108, 30, 46, 63, 104, 72, 38, 71, 103, 70, 90, 248, 140,
+			132, 190, 186, 213, 166, 21, 56, 11, 208, 24, 64, 93, 137, 134,
+			164, 216, 68, 45, 168, 103, 85, 224, 200, 89, 132, 216, 20, 146,
+			7, 148, 156, 72, 28, 107, 134, 174, 106, 70, 43, 22, 203, 78,
+			136, 43, 10, 34, 108, 249, 136, 112, 192, 196, 98, 203, 87, 209,
+			111, 188, 56, 209, 157, 83, 65, 62, 18, 163, 163, 148, 148, 237,
+			85, 26, 195, 15, 132, 74, 186, 153, 138, 120, 2, 243, 155, 136,
+			131, 160, 161, 182, 52, 140, 218, 198, 176, 19, 40, 207, 173, 229,
+			46, 205, 81, 176, 149, 44, 151, 15, 106, 200, 226, 214, 242, 104,
+			149, 253, 137, 68, 87, 23, 183, 124, 115, 119, 249, 141, 64, 151,
+			171, 229, 56, 213, 193, 44, 203, 149, 159, 91, 77, 181, 240, 50,
+			210, 71, 133, 178, 141, 129, 247, 209, 175, 99, 21, 169, 39, 102,
+			132, 65, 68, 133, 89, 20, 183, 170, 132, 91, 161, 147, 171, 40,
+			27, 136, 117, 228, 69, 82, 102, 78, 20, 153, 182, 146, 178, 215,
+			93, 54, 250, 153, 64, 57, 110, 249, 9, 71, 195, 158, 226, 243,
+			65, 13, 89, 220, 242, 119, 238, 98, 163, 52, 188, 110, 110, 189,
+			208, 20, 101, 236, 120, 109, 178, 226, 250, 225, 169, 194, 221, 54,
+			242, 39, 80, 158, 91, 47, 236, 226, 26, 50, 184, 245, 194, 1,
+			189, 46, 187, 45, 110, 189, 112, 215, 30, 54, 196, 76, 219, 228,
+			185, 86, 199, 235, 13, 163, 188, 179, 109, 239, 82, 170, 49, 5,
+			250, 81, 123, 22, 152, 111, 171, 184, 157, 8, 222, 196, 158, 117,
+			193, 220, 73, 21, 154, 164, 215, 92, 80, 77, 155, 20, 242, 227,
+			130, 26, 161, 73, 74, 196, 5, 181, 11, 153, 164, 215, 92, 216,
+			81, 86, 149, 24, 220, 186, 104, 30, 84, 149, 96, 155, 185, 152,
+			84, 98, 228, 185, 117, 81, 45, 61, 147, 182, 153, 139, 98, 191,
+			134, 16, 55, 100, 104, 88, 85, 98, 114, 107, 77, 173, 95, 147,
+			118, 143, 181, 164, 18, 112, 153, 181, 164, 18, 12, 96, 77, 173,
+			95, 211, 132, 160, 187, 166, 214, 47, 1, 47, 50, 15, 169, 79,
+			224, 251, 47, 74, 42, 177, 242, 220, 122, 145, 226, 105, 38, 241,
+			253, 23, 237, 188, 66, 67, 40, 55, 124, 80, 85, 98, 115, 235,
+			215, 20, 79, 51, 137, 17, 255, 90, 82, 137, 157, 231, 214, 175,
+			41, 158, 102, 66, 96, 176, 126, 77, 241, 52, 147, 24, 241, 175,
+			29, 26, 97, 61, 168, 196, 234, 224, 185, 151, 152, 47, 55, 44,
+			249, 209, 2, 250, 94, 194, 6, 217, 78, 150, 7, 4, 188, 191,
+			212, 222, 85, 233, 22, 147, 153, 200, 34, 189, 172, 32, 63, 218,
+			248, 218, 157, 194, 57, 110, 189, 180, 135, 167, 176, 193, 173, 151,
+			14, 12, 166, 176, 197, 173, 151, 238, 188, 140, 93, 166, 42, 55,
+			184, 125, 135, 97, 239, 172, 116, 139, 137, 139, 105, 237, 125, 42,
+			183, 97, 211, 231, 238, 52, 33, 135, 132, 30, 158, 38, 80, 5,
+			3, 219, 210, 4, 11, 9, 59, 202, 172, 87, 181, 96, 114, 251,
+			215, 13, 251, 112, 146, 193, 180, 41, 33, 173, 211, 204, 35, 161,
+			167, 146, 38, 24, 72, 216, 119, 48, 77, 176, 144, 48, 138, 25,
+			183, 109, 211, 204, 113, 251, 21, 134, 89, 101, 61, 64, 151, 153,
+			179, 9, 100, 26, 204, 3, 236, 218, 161, 65, 3, 96, 121, 88,
+			131, 22, 192, 145, 81, 85, 83, 158, 219, 191, 105, 152, 135, 213,
+			199, 188, 77, 160, 174, 41, 79, 95, 187, 246, 104, 208, 0, 40,
+			14, 106, 208, 2, 152, 244, 169, 192, 237, 87, 25, 230, 21, 234,
+			99, 193, 38, 80, 215, 84, 200, 3, 76, 250, 84, 48, 0, 150,
+			247, 106, 208, 2, 120, 249, 126, 246, 31, 193, 227, 64, 110, 249,
+			59, 13, 243, 181, 134, 85, 126, 139, 33, 102, 32, 170, 130, 5,
+			235, 123, 138, 34, 118, 150, 132, 188, 54, 24, 85, 197, 204, 38,
+			169, 137, 1, 53, 149, 143, 97, 184, 112, 27, 117, 50, 254, 37,
+			30, 29, 34, 68, 221, 137, 60, 18, 57, 43, 137, 125, 44, 83,
+			177, 202, 180, 226, 172, 49, 170, 20, 231, 87, 13, 167, 89, 85,
+			99, 179, 12, 131, 219, 119, 26, 108, 187, 162, 40, 132, 245, 182,
+			95, 99, 108, 160, 87, 57, 149, 36, 189, 218, 175, 73, 103, 159,
+			4, 88, 251, 53, 41, 69, 145, 12, 107, 191, 198, 24, 24, 76,
+			19, 44, 110, 191, 198, 72, 104, 214, 48, 13, 110, 255, 238, 165,
+			104, 150, 100, 87, 251, 119, 179, 45, 24, 57, 228, 207, 180, 128,
+			46, 255, 110, 74, 179, 36, 196, 218, 191, 107, 236, 40, 171, 185,
+			44, 114, 251, 117, 134, 57, 162, 230, 178, 104, 19, 168, 231, 178,
+			152, 7, 216, 53, 168, 65, 3, 224, 142, 3, 26, 180, 0, 30,
+			60, 196, 94, 78, 115, 105, 118, 114, 251, 63, 24, 230, 254, 114,
+			43, 69, 40, 205, 4, 137, 252, 73, 72, 185, 141, 19, 166, 231,
+			107, 179, 185, 129, 249, 113, 201, 187, 224, 250, 84, 9, 205, 182,
+			220, 114, 221, 148, 72, 2, 191, 230, 234, 41, 50, 59, 109, 234,
+			69, 2, 230, 0, 118, 245, 107, 208, 0, 200, 53, 153, 119, 90,
+			0, 43, 151, 179, 110, 6, 54, 149, 127, 163, 209, 241, 41, 195,
+			96, 93, 204, 178, 45, 131, 219, 111, 52, 138, 187, 216, 52, 179,
+			109, 11, 19, 253, 102, 195, 60, 81, 62, 69, 187, 149, 14, 4,
+			23, 7, 161, 171, 5, 23, 4, 168, 23, 245, 192, 141, 96, 17,
+			9, 221, 90, 176, 228, 123, 183, 187, 2, 97, 254, 170, 100, 122,
+			214, 194, 55, 154, 182, 136, 54, 222, 172, 251, 105, 153, 29, 121,
+			52, 208, 181, 91, 131, 6, 192, 61, 199, 52, 104, 1, 188, 250,
+			56, 235, 69, 103, 172, 14, 158, 127, 139, 97, 190, 215, 176, 228,
+			103, 48, 81, 251, 45, 6, 219, 202, 158, 197, 242, 0, 209, 219,
+			183, 26, 246, 238, 242, 73, 234, 109, 186, 8, 228, 162, 17, 117,
+			183, 214, 112, 96, 78, 128, 80, 164, 176, 190, 110, 165, 40, 26,
+			163, 202, 108, 170, 173, 59, 77, 200, 33, 65, 209, 24, 37, 24,
+			72, 24, 216, 145, 38, 88, 72, 184, 108, 23, 187, 93, 117, 200,
+			224, 246, 31, 25, 118, 165, 252, 130, 196, 198, 183, 54, 138, 27,
+			147, 112, 71, 171, 203, 169, 13, 22, 215, 245, 131, 168, 162, 138,
+			2, 97, 42, 127, 56, 66, 157, 105, 50, 81, 15, 96, 70, 67,
+			24, 47, 25, 152, 58, 214, 214, 67, 84, 151, 233, 63, 214, 200,
+			31, 101, 251, 143, 53, 242, 71, 70, 207, 64, 154, 64, 221, 219,
+			178, 43, 77, 176, 144, 32, 246, 178, 63, 50, 212, 0, 76, 110,
+			191, 195, 176, 119, 149, 95, 107, 108, 58, 2, 200, 17, 143, 62,
+			140, 243, 190, 180, 165, 233, 219, 119, 85, 20, 25, 17, 153, 80,
+			25, 148, 34, 7, 186, 236, 92, 200, 12, 149, 70, 10, 11, 130,
+			90, 20, 17, 35, 33, 14, 142, 88, 81, 102, 156, 216, 107, 222,
+			145, 29, 39, 246, 142, 119, 100, 199, 9, 102, 242, 14, 99, 203,
+			96, 154, 96, 33, 97, 231, 101, 236, 62, 61, 78, 139, 219, 255,
+			127, 195, 222, 83, 254, 136, 1, 177, 48, 14, 91, 238, 72, 91,
+			168, 70, 71, 10, 227, 138, 144, 188, 40, 93, 133, 155, 211, 209,
+			136, 236, 171, 227, 175, 173, 71, 73, 122, 158, 165, 42, 35, 187,
+			215, 162, 227, 53, 112, 132, 160, 76, 215, 88, 57, 104, 209, 139,
+			40, 98, 38, 130, 227, 39, 196, 187, 190, 62, 196, 161, 119, 97,
+			144, 168, 103, 144, 98, 217, 52, 160, 20, 41, 86, 14, 9, 61,
+			253, 105, 130, 129, 4, 94, 78, 19, 8, 7, 187, 118, 179, 87,
+			107, 164, 216, 220, 254, 51, 32, 229, 246, 71, 197, 73, 218, 31,
+			77, 172, 164, 93, 202, 0, 234, 218, 79, 173, 202, 196, 52, 142,
+			62, 16, 227, 239, 177, 75, 105, 149, 40, 51, 32, 91, 118, 38,
+			29, 144, 157, 67, 66, 102, 64, 48, 215, 252, 89, 118, 64, 182,
+			133, 132, 93, 187, 217, 229, 146, 91, 116, 114, 251, 207, 13, 187,
+			175, 178, 85, 105, 75, 64, 221, 188, 238, 32, 35, 89, 198, 178,
+			58, 58, 59, 144, 173, 167, 87, 87, 211, 217, 97, 172, 79, 48,
+			85, 194, 159, 131, 255, 91, 160, 174, 247, 27, 230, 117, 229, 183,
+			27, 164, 221, 3, 31, 164, 214, 183, 34, 55, 140, 78, 136, 51,
+			211, 98, 106, 122, 78, 156, 159, 157, 168, 138, 155, 93, 210, 79,
+			20, 205, 52, 195, 0, 119, 25, 177, 136, 131, 166, 235, 75, 13,
+			143, 66, 49, 166, 216, 201, 196, 194, 33, 138, 114, 47, 186, 181,
+			86, 250, 246, 8, 218, 169, 53, 92, 199, 111, 53, 147, 80, 203,
+			158, 14, 249, 168, 44, 144, 186, 67, 141, 181, 132, 11, 131, 55,
+			188, 63, 229, 194, 70, 30, 96, 215, 54, 13, 210, 136, 182, 87,
+			52, 104, 1, 220, 127, 133, 6, 139, 0, 15, 76, 72, 132, 153,
+			70, 177, 31, 240, 208, 25, 118, 45, 97, 195, 228, 246, 7, 13,
+			243, 242, 242, 97, 113, 202, 95, 211, 230, 238, 40, 153, 93, 207,
+			111, 63, 123, 84, 10, 80, 210, 51, 172, 230, 15, 166, 61, 131,
+			220, 248, 65, 163, 107, 171, 6, 13, 128, 219, 244, 118, 97, 90,
+			0, 247, 238, 99, 255, 14, 77, 219, 29, 60, 255, 17, 195, 252,
+			207, 134, 85, 62, 66, 220, 42, 27, 160, 7, 145, 50, 227, 77,
+			216, 148, 230, 250, 84, 33, 30, 63, 177, 63, 98, 20, 6, 217,
+			56, 198, 134, 3, 27, 110, 255, 39, 195, 222, 82, 62, 38, 13,
+			40, 217, 162, 137, 253, 135, 170, 200, 144, 49, 38, 44, 93, 140,
+			168, 196, 160, 90, 250, 210, 4, 19, 9, 112, 120, 80, 205, 24,
+			220, 254, 168, 97, 43, 142, 5, 88, 38, 40, 154, 67, 130, 137,
+			132, 126, 206, 94, 35, 105, 206, 226, 249, 123, 13, 243, 227, 198,
+			201, 242, 75, 30, 99, 168, 106, 128, 98, 110, 250, 204, 244, 9,
+			177, 216, 240, 154, 74, 211, 167, 147, 5, 240, 156, 76, 44, 32,
+			146, 41, 132, 211, 104, 8, 119, 165, 25, 175, 41, 233, 145, 17,
+			209, 174, 56, 225, 109, 114, 121, 166, 177, 130, 146, 105, 3, 191,
+			185, 55, 157, 54, 43, 15, 176, 235, 50, 13, 26, 0, 119, 13,
+			107, 208, 2, 56, 50, 170, 193, 34, 183, 63, 110, 228, 79, 104,
+			176, 0, 176, 176, 83, 209, 151, 5, 250, 250, 184, 177, 235, 56,
+			187, 5, 226, 73, 142, 219, 159, 52, 204, 29, 229, 155, 196, 56,
+			5, 100, 136, 136, 214, 37, 117, 137, 90, 43, 138, 131, 21, 197,
+			62, 72, 176, 90, 63, 91, 94, 148, 136, 88, 109, 194, 9, 132,
+			66, 11, 71, 114, 159, 52, 122, 164, 216, 71, 39, 110, 246, 39,
+			141, 158, 126, 13, 154, 248, 186, 117, 144, 29, 99, 208, 2, 243,
+			247, 25, 29, 127, 105, 26, 229, 253, 109, 10, 118, 202, 226, 201,
+			47, 65, 183, 197, 72, 182, 2, 135, 186, 207, 40, 94, 70, 226,
+			140, 13, 114, 253, 140, 97, 254, 15, 37, 206, 216, 68, 124, 159,
+			49, 10, 93, 108, 150, 229, 109, 121, 200, 104, 255, 45, 136, 111,
+			92, 28, 105, 219, 114, 176, 39, 186, 97, 72, 15, 93, 137, 105,
+			28, 145, 224, 243, 170, 235, 133, 242, 219, 178, 23, 197, 65, 232,
+			213, 156, 134, 8, 93, 39, 194, 193, 149, 100, 164, 182, 34, 198,
+			191, 213, 196, 104, 43, 98, 252, 91, 77, 140, 182, 36, 198, 207,
+			107, 98, 180, 21, 49, 126, 94, 19, 163, 173, 136, 241, 243, 70,
+			63, 103, 171, 170, 132, 201, 237, 191, 51, 236, 129, 242, 146, 152,
+			10, 98, 113, 139, 183, 116, 139, 179, 164, 227, 81, 87, 69, 18,
+			186, 83, 237, 120, 145, 136, 157, 219, 92, 113, 244, 136, 88, 88,
+			139, 221, 168, 42, 196, 249, 200, 21, 153, 184, 59, 194, 91, 100,
+			66, 251, 208, 103, 237, 58, 16, 37, 136, 139, 233, 158, 128, 247,
+			254, 93, 182, 107, 178, 43, 253, 60, 25, 140, 197, 237, 191, 55,
+			236, 45, 73, 6, 200, 183, 127, 159, 29, 190, 101, 34, 129, 15,
+			36, 131, 177, 185, 253, 229, 95, 218, 96, 142, 141, 61, 254, 193,
+			128, 60, 190, 156, 29, 140, 109, 34, 33, 51, 152, 28, 183, 191,
+			98, 216, 91, 147, 12, 57, 131, 18, 74, 105, 130, 137, 132, 129,
+			45, 73, 137, 60, 183, 191, 154, 45, 145, 55, 40, 33, 45, 145,
+			55, 145, 144, 41, 81, 224, 246, 253, 134, 205, 147, 18, 80, 100,
+			239, 55, 236, 158, 52, 193, 68, 66, 169, 63, 41, 81, 228, 246,
+			215, 178, 40, 46, 26, 148, 144, 162, 184, 104, 34, 129, 15, 176,
+			123, 77, 85, 164, 147, 219, 223, 2, 101, 191, 207, 20, 115, 206,
+			210, 104, 221, 165, 135, 105, 192, 93, 244, 69, 134, 42, 19, 215,
+			135, 65, 43, 13, 111, 158, 94, 201, 161, 125, 16, 170, 84, 106,
+			251, 115, 22, 17, 120, 119, 157, 107, 85, 85, 220, 16, 172, 226,
+			96, 104, 68, 122, 151, 28, 99, 228, 20, 227, 194, 86, 169, 60,
+			89, 212, 121, 147, 140, 23, 187, 224, 38, 113, 173, 73, 209, 33,
+			62, 185, 68, 157, 64, 160, 3, 29, 200, 152, 14, 52, 17, 212,
+			37, 86, 31, 149, 26, 231, 68, 201, 185, 187, 76, 169, 10, 196,
+			106, 214, 135, 168, 35, 155, 87, 199, 180, 79, 137, 140, 218, 81,
+			23, 23, 60, 135, 114, 222, 186, 222, 147, 254, 86, 189, 153, 102,
+			72, 166, 211, 32, 68, 166, 36, 211, 105, 34, 161, 127, 128, 141,
+			42, 76, 51, 110, 63, 96, 216, 219, 42, 187, 196, 89, 215, 95,
+			138, 151, 55, 199, 117, 82, 158, 25, 148, 63, 165, 15, 102, 34,
+			97, 96, 43, 219, 167, 42, 236, 226, 246, 67, 152, 186, 1, 49,
+			229, 174, 130, 197, 94, 72, 156, 189, 210, 106, 186, 12, 202, 149,
+			246, 171, 203, 68, 66, 127, 202, 100, 186, 185, 253, 237, 44, 97,
+			118, 27, 148, 144, 18, 77, 183, 137, 4, 158, 18, 102, 15, 183,
+			191, 147, 101, 75, 61, 6, 37, 164, 132, 217, 99, 34, 161, 148,
+			46, 151, 94, 110, 127, 215, 176, 183, 39, 25, 122, 13, 74, 232,
+			79, 19, 76, 36, 108, 217, 150, 148, 232, 227, 246, 247, 178, 37,
+			250, 12, 74, 72, 75, 244, 153, 72, 216, 178, 141, 29, 80, 37,
+			74, 220, 254, 190, 97, 111, 173, 108, 7, 19, 139, 218, 216, 133,
+			244, 83, 209, 37, 75, 6, 229, 76, 7, 88, 50, 145, 192, 183,
+			36, 85, 245, 115, 251, 7, 143, 171, 170, 126, 131, 114, 166, 85,
+			245, 155, 72, 32, 92, 217, 182, 109, 27, 60, 255, 176, 97, 254,
+			36, 217, 88, 192, 192, 31, 54, 10, 221, 236, 32, 181, 68, 230,
+			155, 31, 26, 246, 246, 114, 249, 146, 27, 139, 110, 76, 154, 106,
+			126, 152, 50, 4, 131, 132, 151, 31, 26, 91, 53, 210, 200, 84,
+			243, 72, 138, 52, 131, 132, 151, 71, 178, 37, 176, 95, 60, 130,
+			18, 159, 54, 84, 17, 147, 219, 63, 70, 7, 62, 36, 213, 74,
+			117, 112, 91, 23, 20, 235, 15, 93, 34, 99, 7, 93, 138, 197,
+			43, 113, 254, 198, 165, 141, 165, 165, 156, 36, 192, 14, 244, 42,
+			99, 242, 185, 60, 247, 34, 222, 250, 242, 224, 151, 213, 12, 131,
+			101, 111, 1, 4, 191, 217, 122, 188, 181, 253, 46, 83, 178, 198,
+			146, 101, 169, 29, 60, 150, 148, 62, 150, 120, 186, 36, 243, 97,
+			144, 84, 250, 227, 236, 128, 177, 11, 253, 24, 3, 134, 88, 97,
+			3, 221, 63, 53, 204, 157, 114, 54, 200, 2, 242, 83, 45, 42,
+			217, 100, 27, 251, 169, 182, 212, 216, 132, 238, 159, 226, 14, 130,
+			2, 45, 148, 85, 86, 43, 27, 13, 253, 204, 48, 47, 83, 31,
+			33, 197, 255, 44, 173, 9, 250, 253, 207, 140, 174, 146, 6, 41,
+			115, 255, 118, 13, 90, 0, 203, 59, 85, 77, 38, 183, 127, 158,
+			246, 9, 82, 247, 207, 211, 154, 32, 117, 255, 60, 173, 9, 205,
+			254, 220, 232, 215, 125, 130, 212, 253, 115, 88, 210, 40, 82, 185,
+			13, 240, 101, 166, 185, 163, 124, 167, 212, 157, 117, 148, 69, 76,
+			99, 228, 234, 227, 20, 188, 253, 164, 237, 95, 145, 27, 99, 46,
+			22, 197, 66, 64, 143, 194, 225, 25, 8, 191, 158, 150, 148, 242,
+			102, 90, 86, 139, 214, 136, 8, 31, 44, 138, 228, 106, 249, 136,
+			200, 94, 97, 135, 221, 51, 141, 34, 168, 36, 83, 27, 167, 189,
+			246, 203, 204, 100, 104, 144, 76, 95, 102, 118, 245, 105, 208, 0,
+			88, 218, 162, 65, 26, 203, 246, 65, 246, 126, 147, 134, 6, 19,
+			175, 105, 138, 242, 187, 76, 82, 237, 20, 75, 78, 204, 16, 212,
+			229, 72, 245, 210, 131, 78, 220, 110, 13, 193, 103, 57, 82, 253,
+			133, 65, 15, 9, 99, 21, 182, 220, 17, 7, 170, 7, 70, 96,
+			64, 243, 162, 245, 182, 149, 170, 72, 53, 230, 17, 49, 126, 232,
+			208, 40, 164, 31, 17, 213, 2, 56, 192, 49, 17, 182, 26, 74,
+			144, 208, 36, 186, 232, 249, 245, 164, 89, 245, 88, 133, 244, 186,
+			162, 214, 41, 226, 48, 125, 139, 82, 3, 24, 12, 12, 201, 168,
+			8, 233, 78, 8, 151, 122, 140, 201, 245, 211, 124, 240, 197, 194,
+			35, 37, 8, 203, 175, 94, 192, 64, 34, 92, 24, 240, 234, 153,
+			138, 168, 52, 156, 96, 29, 234, 250, 171, 82, 172, 67, 89, 127,
+			149, 153, 16, 57, 36, 157, 87, 153, 92, 83, 31, 20, 245, 87,
+			153, 187, 247, 176, 151, 16, 210, 115, 220, 254, 29, 211, 220, 83,
+			110, 38, 234, 180, 148, 224, 31, 29, 207, 98, 193, 133, 86, 172,
+			226, 118, 214, 129, 118, 160, 149, 222, 239, 10, 26, 25, 75, 141,
+			180, 129, 59, 190, 239, 134, 80, 102, 18, 170, 75, 250, 142, 195,
+			134, 223, 73, 251, 158, 163, 254, 36, 125, 135, 204, 245, 59, 38,
+			47, 107, 208, 2, 184, 107, 55, 123, 157, 164, 152, 60, 183, 127,
+			207, 52, 47, 47, 255, 186, 164, 24, 191, 181, 130, 160, 74, 154,
+			80, 146, 67, 223, 182, 147, 221, 216, 189, 24, 167, 47, 216, 41,
+			79, 0, 57, 50, 229, 185, 71, 117, 45, 4, 1, 52, 253, 104,
+			68, 84, 96, 140, 169, 128, 224, 43, 116, 97, 185, 162, 114, 168,
+			231, 194, 214, 181, 147, 168, 122, 104, 6, 95, 72, 109, 26, 194,
+			98, 116, 163, 154, 211, 148, 168, 129, 169, 106, 213, 89, 27, 214,
+			141, 173, 197, 27, 58, 60, 158, 228, 151, 221, 18, 164, 20, 147,
+			220, 43, 158, 113, 173, 56, 58, 246, 52, 90, 184, 42, 83, 130,
+			79, 28, 185, 252, 94, 138, 207, 124, 14, 96, 130, 79, 72, 164,
+			191, 103, 42, 211, 180, 77, 71, 46, 191, 103, 86, 246, 177, 87,
+			72, 230, 82, 224, 246, 27, 76, 115, 111, 249, 2, 86, 17, 177,
+			4, 152, 197, 35, 117, 52, 93, 119, 233, 100, 216, 17, 1, 2,
+			66, 232, 25, 86, 157, 6, 57, 0, 239, 7, 176, 157, 232, 24,
+			214, 120, 3, 49, 74, 252, 141, 18, 11, 154, 35, 86, 92, 136,
+			243, 168, 141, 44, 91, 84, 34, 25, 2, 206, 122, 222, 144, 14,
+			161, 144, 3, 152, 240, 71, 136, 200, 111, 48, 251, 53, 91, 46,
+			88, 0, 247, 8, 246, 191, 229, 16, 138, 220, 190, 11, 67, 248,
+			174, 33, 95, 110, 73, 41, 87, 247, 128, 172, 189, 234, 196, 89,
+			114, 77, 56, 209, 108, 240, 103, 173, 42, 78, 66, 118, 157, 80,
+			44, 59, 50, 171, 35, 42, 73, 108, 217, 138, 82, 126, 69, 144,
+			120, 117, 184, 141, 250, 136, 26, 19, 125, 58, 160, 206, 182, 225,
+			152, 140, 233, 147, 12, 164, 154, 229, 54, 180, 135, 214, 93, 242,
+			211, 132, 161, 121, 133, 208, 77, 200, 58, 16, 209, 114, 71, 255,
+			212, 131, 34, 196, 141, 60, 114, 195, 170, 57, 43, 110, 99, 220,
+			137, 180, 65, 197, 166, 131, 149, 187, 82, 196, 21, 115, 0, 147,
+			185, 135, 166, 112, 87, 202, 7, 138, 22, 192, 221, 66, 109, 81,
+			157, 220, 126, 155, 105, 94, 174, 62, 226, 128, 227, 109, 105, 77,
+			157, 121, 128, 93, 122, 119, 131, 32, 252, 54, 115, 80, 83, 17,
+			14, 56, 222, 6, 42, 250, 191, 59, 105, 10, 24, 183, 223, 103,
+			154, 251, 203, 127, 212, 153, 154, 50, 53, 51, 73, 222, 186, 169,
+			104, 179, 99, 165, 42, 110, 94, 118, 253, 244, 75, 66, 38, 58,
+			7, 80, 196, 68, 28, 58, 120, 44, 76, 11, 15, 2, 210, 116,
+			88, 111, 40, 147, 158, 44, 164, 78, 218, 101, 133, 235, 130, 245,
+			162, 245, 76, 95, 100, 1, 181, 217, 209, 251, 96, 228, 83, 78,
+			148, 8, 236, 194, 31, 30, 111, 21, 225, 213, 145, 70, 93, 247,
+			173, 166, 204, 32, 52, 195, 73, 87, 168, 102, 138, 177, 69, 66,
+			93, 90, 63, 72, 205, 83, 11, 130, 161, 3, 210, 83, 216, 1,
+			30, 42, 240, 181, 88, 118, 113, 1, 72, 102, 144, 94, 19, 27,
+			59, 71, 238, 233, 81, 208, 112, 213, 170, 97, 98, 200, 117, 106,
+			203, 186, 75, 201, 248, 228, 136, 16, 82, 138, 74, 160, 161, 164,
+			9, 217, 194, 112, 85, 204, 182, 167, 68, 76, 184, 23, 225, 180,
+			229, 249, 235, 92, 82, 200, 59, 74, 118, 169, 46, 61, 164, 116,
+			180, 74, 242, 248, 59, 117, 110, 114, 179, 202, 116, 151, 181, 43,
+			169, 84, 21, 97, 226, 170, 132, 174, 211, 80, 35, 141, 244, 83,
+			68, 122, 51, 164, 174, 71, 35, 27, 166, 76, 159, 116, 201, 23,
+			140, 169, 97, 125, 107, 74, 249, 28, 142, 48, 245, 36, 11, 158,
+			30, 209, 230, 229, 100, 147, 213, 202, 162, 244, 115, 37, 10, 74,
+			8, 40, 81, 88, 33, 17, 144, 78, 202, 218, 12, 238, 110, 218,
+			84, 221, 141, 113, 98, 16, 44, 226, 120, 0, 11, 151, 112, 69,
+			108, 97, 53, 12, 98, 55, 67, 204, 216, 38, 252, 32, 38, 127,
+			25, 47, 202, 200, 181, 139, 173, 6, 17, 71, 8, 159, 204, 209,
+			56, 244, 154, 205, 118, 87, 120, 233, 193, 71, 104, 169, 5, 126,
+			228, 69, 49, 185, 212, 173, 186, 140, 152, 240, 134, 49, 173, 159,
+			92, 225, 52, 162, 96, 68, 184, 56, 198, 132, 23, 210, 210, 178,
+			18, 117, 48, 119, 90, 230, 39, 122, 94, 143, 135, 57, 181, 54,
+			93, 114, 30, 133, 233, 90, 57, 216, 225, 241, 56, 125, 129, 64,
+			73, 37, 24, 51, 249, 99, 51, 17, 187, 141, 134, 240, 22, 133,
+			211, 206, 216, 229, 140, 74, 215, 120, 156, 30, 141, 224, 169, 82,
+			47, 150, 212, 172, 102, 66, 207, 39, 91, 63, 8, 16, 198, 57,
+			96, 99, 108, 29, 93, 71, 154, 10, 35, 173, 26, 44, 54, 156,
+			165, 145, 108, 247, 214, 132, 211, 192, 141, 142, 181, 100, 26, 89,
+			90, 9, 100, 43, 113, 107, 123, 80, 233, 91, 19, 198, 201, 108,
+			98, 89, 9, 152, 3, 152, 136, 173, 80, 211, 223, 103, 150, 52,
+			187, 99, 22, 192, 202, 229, 172, 194, 76, 59, 199, 243, 31, 48,
+			59, 62, 108, 26, 229, 45, 109, 166, 73, 61, 26, 88, 34, 33,
+			196, 124, 192, 44, 194, 229, 210, 182, 115, 80, 80, 62, 104, 42,
+			101, 32, 71, 10, 202, 7, 117, 211, 57, 82, 80, 62, 168, 121,
+			118, 142, 20, 148, 15, 154, 74, 65, 201, 209, 145, 231, 7, 77,
+			165, 160, 228, 160, 41, 124, 72, 243, 236, 28, 29, 211, 127, 40,
+			173, 9, 199, 12, 31, 210, 60, 59, 71, 10, 202, 135, 52, 207,
+			206, 145, 130, 242, 33, 240, 236, 3, 204, 180, 243, 60, 255, 159,
+			204, 142, 255, 102, 26, 229, 29, 217, 65, 248, 169, 216, 173, 70,
+			2, 241, 225, 63, 153, 69, 169, 214, 228, 49, 146, 143, 234, 145,
+			228, 105, 36, 31, 213, 237, 231, 105, 36, 31, 213, 35, 201, 211,
+			72, 62, 170, 71, 146, 167, 145, 124, 84, 143, 36, 143, 145, 252,
+			181, 169, 28, 80, 242, 52, 146, 191, 78, 107, 194, 72, 254, 218,
+			236, 218, 167, 65, 202, 124, 249, 176, 206, 108, 1, 84, 14, 40,
+			121, 40, 131, 31, 51, 77, 157, 23, 170, 214, 199, 210, 154, 160,
+			106, 125, 204, 84, 71, 47, 121, 82, 37, 63, 102, 110, 223, 173,
+			65, 11, 95, 247, 86, 216, 159, 65, 186, 204, 227, 4, 252, 211,
+			166, 249, 25, 211, 42, 191, 205, 220, 196, 109, 68, 75, 155, 180,
+			191, 103, 61, 71, 148, 217, 113, 51, 167, 17, 4, 198, 244, 214,
+			121, 136, 0, 201, 155, 186, 135, 172, 243, 14, 129, 185, 87, 189,
+			125, 171, 119, 211, 228, 206, 4, 137, 13, 235, 238, 66, 85, 117,
+			149, 178, 235, 196, 225, 241, 158, 96, 76, 59, 81, 242, 168, 146,
+			136, 112, 7, 67, 125, 34, 134, 229, 52, 155, 97, 208, 12, 61,
+			39, 150, 206, 142, 74, 84, 198, 26, 211, 238, 142, 158, 31, 31,
+			27, 195, 233, 248, 138, 227, 249, 106, 25, 229, 201, 69, 224, 211,
+			38, 219, 73, 126, 37, 121, 28, 209, 115, 251, 111, 204, 77, 61,
+			87, 242, 234, 204, 255, 111, 76, 117, 202, 152, 87, 103, 254, 127,
+			99, 170, 51, 255, 188, 58, 243, 255, 27, 83, 121, 174, 228, 213,
+			153, 255, 223, 152, 202, 115, 5, 9, 48, 242, 155, 246, 206, 75,
+			180, 0, 66, 186, 47, 219, 2, 180, 246, 251, 178, 45, 96, 89,
+			220, 103, 42, 207, 149, 188, 58, 149, 191, 15, 132, 249, 167, 144,
+			39, 243, 208, 183, 191, 96, 154, 71, 54, 247, 28, 186, 36, 9,
+			180, 127, 88, 239, 142, 194, 32, 208, 97, 218, 245, 247, 245, 228,
+			144, 61, 136, 188, 36, 105, 176, 12, 109, 72, 2, 134, 246, 253,
+			133, 148, 218, 161, 125, 127, 193, 84, 231, 66, 121, 210, 190, 191,
+			96, 238, 58, 164, 65, 26, 89, 245, 48, 107, 208, 56, 109, 110,
+			127, 9, 170, 212, 175, 166, 205, 165, 61, 188, 164, 115, 77, 232,
+			98, 60, 213, 205, 253, 103, 216, 165, 28, 104, 242, 56, 210, 177,
+			191, 148, 246, 20, 26, 235, 151, 82, 94, 1, 141, 245, 75, 90,
+			75, 201, 195, 105, 198, 254, 18, 120, 213, 81, 102, 218, 5, 158,
+			255, 170, 217, 241, 223, 205, 245, 23, 5, 8, 147, 90, 133, 86,
+			172, 75, 113, 45, 104, 12, 95, 53, 139, 130, 56, 68, 1, 68,
+			121, 191, 230, 90, 5, 226, 90, 247, 235, 158, 20, 136, 107, 221,
+			175, 123, 82, 32, 174, 117, 191, 230, 90, 5, 226, 90, 247, 107,
+			174, 85, 0, 251, 248, 154, 169, 12, 68, 5, 226, 90, 95, 75,
+			107, 2, 169, 125, 77, 171, 45, 5, 226, 90, 95, 51, 149, 129,
+			168, 64, 252, 247, 107, 166, 50, 16, 21, 192, 181, 190, 97, 154,
+			67, 234, 35, 184, 214, 55, 210, 154, 192, 181, 190, 97, 118, 233,
+			30, 163, 217, 111, 152, 151, 237, 83, 205, 130, 66, 191, 97, 94,
+			113, 128, 93, 206, 76, 187, 200, 243, 223, 50, 59, 190, 109, 26,
+			229, 109, 109, 216, 81, 97, 134, 21, 66, 160, 9, 124, 203, 44,
+			194, 147, 210, 182, 139, 64, 200, 3, 26, 33, 69, 66, 200, 3,
+			186, 241, 34, 33, 228, 1, 141, 144, 34, 33, 228, 1, 141, 144,
+			34, 33, 228, 1, 141, 144, 34, 122, 246, 160, 169, 252, 188, 138,
+			132, 144, 7, 211, 154, 192, 198, 31, 52, 187, 132, 6, 41, 243,
+			222, 3, 58, 179, 5, 240, 224, 33, 85, 19, 44, 216, 166, 242,
+			254, 43, 194, 35, 213, 126, 40, 173, 9, 8, 121, 200, 84, 222,
+			127, 69, 98, 227, 15, 153, 202, 251, 175, 72, 8, 121, 200, 188,
+			124, 63, 59, 194, 76, 187, 147, 231, 191, 103, 118, 252, 111, 211,
+			40, 87, 218, 16, 178, 66, 209, 201, 244, 107, 120, 89, 228, 64,
+			185, 249, 158, 89, 220, 69, 29, 233, 4, 114, 190, 175, 145, 211,
+			73, 200, 249, 190, 238, 72, 39, 33, 231, 251, 26, 57, 157, 132,
+			156, 239, 107, 228, 116, 18, 114, 190, 15, 228, 188, 17, 172, 164,
+			19, 221, 124, 24, 170, 233, 171, 12, 49, 233, 211, 53, 73, 191,
+			174, 111, 76, 38, 190, 61, 81, 114, 191, 201, 9, 221, 205, 205,
+			41, 171, 206, 154, 112, 34, 38, 54, 125, 133, 37, 177, 176, 200,
+			203, 94, 36, 117, 133, 238, 34, 100, 214, 32, 35, 159, 169, 77,
+			93, 118, 20, 19, 245, 112, 58, 42, 80, 238, 195, 233, 168, 64,
+			185, 15, 155, 252, 50, 13, 90, 0, 247, 8, 133, 31, 147, 219,
+			255, 100, 154, 21, 133, 31, 76, 212, 63, 165, 53, 193, 57, 232,
+			159, 210, 154, 128, 129, 127, 66, 52, 0, 5, 90, 0, 197, 94,
+			85, 147, 197, 237, 71, 76, 115, 191, 250, 8, 94, 246, 72, 90,
+			19, 120, 217, 35, 166, 114, 18, 236, 36, 94, 246, 136, 185, 67,
+			104, 144, 202, 238, 187, 156, 77, 16, 162, 109, 110, 255, 200, 52,
+			175, 41, 95, 35, 38, 117, 216, 177, 8, 146, 170, 212, 222, 132,
+			124, 66, 0, 150, 78, 25, 148, 95, 167, 43, 220, 68, 186, 77,
+			48, 169, 31, 165, 93, 0, 147, 250, 145, 150, 10, 59, 137, 73,
+			253, 200, 44, 233, 46, 128, 73, 253, 200, 220, 119, 185, 6, 139,
+			0, 247, 95, 173, 193, 2, 192, 43, 174, 82, 29, 204, 113, 251,
+			39, 155, 117, 16, 148, 232, 134, 27, 59, 168, 210, 215, 119, 48,
+			103, 83, 61, 9, 72, 213, 38, 29, 132, 216, 249, 147, 180, 131,
+			57, 11, 96, 210, 193, 92, 17, 96, 210, 193, 92, 1, 224, 21,
+			87, 177, 63, 235, 101, 166, 205, 120, 254, 46, 171, 227, 77, 182,
+			49, 54, 37, 174, 253, 151, 255, 199, 132, 10, 210, 199, 198, 62,
+			219, 35, 38, 160, 188, 42, 91, 98, 246, 106, 12, 185, 46, 210,
+			230, 65, 158, 105, 74, 167, 138, 42, 194, 137, 101, 100, 145, 236,
+			202, 96, 226, 5, 160, 236, 52, 18, 72, 148, 217, 156, 164, 198,
+			130, 187, 27, 32, 250, 5, 55, 81, 93, 235, 34, 106, 120, 75,
+			203, 56, 169, 168, 123, 139, 244, 26, 108, 140, 203, 111, 65, 72,
+			237, 42, 83, 150, 88, 246, 160, 227, 67, 91, 147, 117, 64, 54,
+			90, 113, 124, 175, 217, 194, 53, 244, 40, 181, 21, 233, 9, 129,
+			116, 166, 61, 36, 80, 209, 230, 30, 18, 78, 180, 193, 67, 194,
+			21, 7, 21, 106, 18, 234, 35, 213, 17, 235, 63, 147, 87, 239,
+			184, 107, 176, 85, 185, 218, 138, 32, 239, 76, 235, 231, 78, 73,
+			207, 106, 215, 210, 72, 72, 128, 119, 130, 155, 154, 55, 181, 176,
+			177, 2, 155, 173, 31, 197, 174, 83, 135, 220, 167, 188, 76, 227,
+			101, 119, 5, 25, 18, 157, 206, 111, 235, 100, 106, 190, 174, 57,
+			13, 156, 159, 110, 22, 0, 81, 41, 148, 25, 27, 6, 205, 103,
+			194, 190, 212, 161, 67, 45, 12, 34, 188, 72, 221, 216, 4, 5,
+			112, 221, 34, 147, 120, 38, 98, 5, 106, 139, 3, 209, 12, 228,
+			44, 160, 95, 25, 215, 45, 76, 63, 76, 232, 174, 160, 231, 211,
+			201, 133, 79, 51, 198, 17, 160, 74, 82, 71, 51, 240, 252, 152,
+			26, 165, 57, 140, 210, 123, 182, 140, 144, 169, 206, 187, 163, 128,
+			242, 100, 106, 7, 51, 38, 180, 99, 170, 147, 120, 125, 137, 91,
+			2, 209, 74, 251, 108, 99, 206, 22, 92, 81, 91, 14, 34, 88,
+			183, 64, 79, 56, 97, 139, 78, 48, 113, 144, 180, 124, 157, 81,
+			246, 108, 21, 135, 226, 218, 235, 24, 66, 85, 34, 203, 192, 192,
+			177, 212, 112, 117, 96, 12, 20, 18, 184, 160, 44, 68, 16, 46,
+			57, 190, 119, 59, 217, 176, 71, 96, 121, 64, 55, 220, 139, 77,
+			55, 244, 232, 216, 189, 161, 59, 51, 2, 13, 68, 225, 80, 119,
+			249, 42, 60, 55, 143, 90, 226, 229, 144, 172, 5, 199, 241, 159,
+			182, 228, 171, 99, 7, 68, 15, 137, 3, 225, 250, 81, 43, 84,
+			11, 1, 73, 74, 23, 87, 138, 55, 42, 145, 51, 75, 85, 147,
+			153, 35, 97, 93, 170, 7, 213, 71, 25, 245, 130, 186, 96, 24,
+			45, 43, 244, 211, 240, 41, 169, 6, 124, 172, 37, 140, 16, 45,
+			193, 14, 45, 47, 28, 198, 164, 141, 196, 30, 36, 83, 119, 116,
+			5, 38, 26, 125, 100, 57, 186, 68, 47, 60, 143, 38, 51, 56,
+			26, 186, 75, 94, 20, 135, 107, 153, 72, 25, 168, 13, 55, 67,
+			165, 188, 43, 220, 245, 179, 90, 21, 179, 112, 97, 32, 219, 240,
+			5, 175, 238, 138, 181, 160, 21, 2, 192, 171, 209, 180, 89, 203,
+			120, 18, 168, 71, 62, 37, 237, 93, 112, 71, 199, 213, 213, 217,
+			97, 26, 74, 91, 145, 85, 119, 33, 242, 98, 87, 12, 193, 106,
+			114, 193, 241, 26, 112, 66, 24, 86, 119, 191, 66, 23, 214, 222,
+			0, 149, 249, 42, 220, 9, 14, 86, 137, 31, 5, 171, 104, 155,
+			156, 16, 113, 234, 20, 168, 72, 46, 238, 74, 85, 156, 143, 90,
+			100, 177, 193, 119, 34, 31, 42, 28, 248, 46, 42, 90, 63, 164,
+			42, 221, 100, 171, 57, 218, 211, 209, 221, 48, 79, 68, 117, 36,
+			189, 195, 32, 147, 86, 128, 218, 212, 244, 194, 88, 222, 138, 245,
+			131, 230, 138, 93, 68, 173, 133, 81, 181, 124, 171, 73, 12, 148,
+			113, 185, 34, 244, 242, 142, 16, 119, 0, 196, 187, 136, 218, 144,
+			161, 30, 212, 34, 69, 180, 244, 38, 57, 22, 135, 16, 27, 222,
+			247, 142, 116, 28, 159, 90, 176, 114, 88, 51, 223, 209, 5, 25,
+			187, 68, 62, 245, 77, 169, 251, 212, 32, 80, 201, 164, 50, 249,
+			199, 173, 16, 204, 22, 247, 251, 104, 43, 144, 252, 35, 28, 17,
+			142, 88, 117, 23, 180, 236, 151, 16, 34, 204, 75, 173, 166, 162,
+			12, 167, 21, 7, 43, 78, 12, 223, 46, 28, 144, 71, 176, 9,
+			43, 68, 41, 100, 68, 74, 104, 132, 137, 232, 46, 171, 56, 32,
+			47, 33, 50, 72, 141, 111, 183, 204, 125, 229, 7, 12, 248, 22,
+			75, 115, 34, 66, 210, 9, 245, 250, 9, 184, 85, 232, 10, 122,
+			145, 197, 141, 50, 251, 147, 178, 255, 123, 145, 182, 10, 170, 142,
+			49, 25, 31, 2, 39, 109, 167, 215, 180, 63, 225, 72, 230, 150,
+			158, 174, 24, 75, 55, 194, 145, 226, 66, 43, 181, 19, 4, 139,
+			49, 56, 156, 231, 103, 84, 251, 196, 116, 214, 86, 62, 177, 179,
+			249, 112, 152, 1, 97, 209, 17, 170, 36, 139, 36, 36, 130, 214,
+			252, 149, 196, 42, 5, 16, 70, 210, 241, 219, 45, 37, 48, 49,
+			146, 142, 223, 110, 41, 233, 143, 145, 116, 252, 118, 139, 239, 214,
+			160, 5, 28, 237, 173, 176, 135, 97, 109, 97, 144, 13, 223, 109,
+			153, 135, 202, 255, 96, 138, 241, 0, 33, 242, 27, 27, 143, 30,
+			87, 17, 145, 196, 13, 233, 205, 114, 137, 188, 44, 234, 84, 164,
+			157, 234, 186, 107, 139, 184, 191, 78, 89, 179, 49, 15, 244, 86,
+			191, 174, 192, 1, 204, 69, 156, 202, 212, 67, 195, 234, 37, 120,
+			18, 184, 87, 93, 84, 128, 243, 243, 181, 204, 245, 222, 100, 141,
+			108, 240, 187, 205, 84, 92, 165, 27, 144, 155, 60, 22, 165, 204,
+			56, 224, 4, 250, 28, 216, 81, 206, 175, 50, 124, 141, 166, 17,
+			125, 50, 196, 178, 253, 77, 232, 86, 62, 113, 43, 60, 159, 34,
+			30, 160, 180, 218, 53, 52, 202, 232, 81, 43, 137, 8, 37, 247,
+			51, 82, 208, 222, 157, 206, 23, 228, 254, 119, 167, 243, 101, 208,
+			140, 240, 43, 52, 104, 1, 28, 62, 200, 222, 97, 209, 124, 193,
+			47, 219, 50, 79, 150, 223, 104, 97, 100, 174, 159, 29, 65, 66,
+			238, 36, 63, 169, 41, 10, 148, 247, 189, 158, 49, 112, 13, 183,
+			233, 208, 207, 42, 48, 131, 59, 85, 13, 87, 133, 159, 168, 45,
+			103, 80, 172, 56, 203, 136, 50, 40, 131, 177, 234, 133, 187, 41,
+			202, 153, 50, 166, 139, 185, 229, 22, 121, 12, 64, 158, 210, 135,
+			241, 141, 134, 56, 232, 7, 241, 193, 205, 209, 166, 241, 69, 152,
+			146, 167, 237, 117, 108, 65, 155, 189, 12, 86, 21, 169, 211, 219,
+			134, 178, 114, 176, 218, 217, 141, 101, 8, 53, 14, 218, 200, 239,
+			177, 232, 46, 75, 118, 236, 113, 209, 157, 186, 210, 42, 231, 13,
+			42, 217, 159, 167, 147, 12, 149, 236, 207, 45, 165, 36, 48, 210,
+			157, 255, 220, 42, 237, 211, 160, 133, 175, 87, 12, 233, 175, 69,
+			128, 195, 39, 52, 88, 0, 120, 240, 56, 27, 38, 10, 176, 184,
+			253, 23, 150, 57, 81, 222, 169, 78, 185, 36, 99, 196, 153, 13,
+			56, 200, 178, 244, 200, 146, 37, 161, 205, 253, 69, 218, 9, 220,
+			143, 248, 139, 180, 19, 208, 230, 254, 194, 42, 141, 106, 144, 42,
+			62, 50, 166, 193, 34, 192, 99, 103, 200, 5, 138, 153, 22, 14,
+			46, 255, 194, 186, 114, 156, 125, 70, 18, 34, 204, 206, 150, 249,
+			140, 242, 71, 45, 68, 209, 193, 138, 27, 75, 227, 71, 37, 199,
+			5, 116, 238, 209, 162, 80, 16, 129, 140, 122, 22, 7, 226, 252,
+			220, 117, 163, 79, 19, 244, 114, 26, 182, 51, 80, 30, 200, 118,
+			132, 101, 197, 19, 200, 71, 171, 100, 13, 167, 128, 105, 24, 163,
+			183, 40, 60, 95, 6, 172, 146, 85, 224, 66, 179, 20, 31, 245,
+			50, 149, 110, 135, 144, 211, 228, 190, 33, 231, 221, 129, 110, 71,
+			77, 65, 22, 131, 44, 41, 61, 197, 225, 197, 142, 136, 54, 238,
+			69, 135, 226, 91, 173, 66, 229, 184, 205, 243, 235, 235, 14, 55,
+			193, 18, 19, 84, 51, 41, 18, 66, 108, 15, 228, 73, 93, 171,
+			89, 87, 162, 113, 164, 54, 87, 167, 86, 11, 66, 120, 180, 145,
+			39, 175, 114, 205, 84, 97, 19, 244, 113, 73, 211, 13, 225, 109,
+			169, 138, 17, 54, 34, 108, 226, 201, 30, 175, 167, 54, 227, 232,
+			133, 72, 31, 228, 13, 65, 7, 227, 126, 160, 194, 10, 157, 80,
+			54, 228, 140, 177, 83, 99, 92, 29, 87, 202, 166, 113, 73, 62,
+			88, 148, 248, 103, 186, 73, 77, 29, 182, 156, 209, 4, 196, 65,
+			66, 74, 44, 208, 187, 63, 106, 149, 52, 91, 130, 222, 253, 81,
+			107, 248, 144, 6, 139, 0, 71, 174, 213, 96, 1, 224, 232, 211,
+			217, 89, 144, 10, 156, 192, 239, 177, 204, 255, 106, 89, 229, 167,
+			139, 36, 74, 99, 194, 97, 213, 97, 221, 102, 97, 180, 180, 42,
+			24, 121, 183, 39, 43, 139, 92, 200, 239, 177, 10, 3, 236, 12,
+			8, 211, 38, 115, 247, 199, 44, 187, 183, 114, 101, 82, 121, 26,
+			191, 132, 56, 33, 42, 84, 58, 219, 8, 68, 15, 207, 105, 104,
+			241, 93, 26, 173, 153, 242, 25, 255, 152, 101, 119, 166, 9, 56,
+			211, 176, 186, 123, 216, 245, 170, 29, 56, 248, 91, 54, 47, 231,
+			101, 136, 145, 202, 97, 184, 67, 138, 52, 250, 224, 116, 19, 36,
+			145, 250, 223, 170, 153, 149, 59, 153, 118, 79, 103, 202, 217, 252,
+			94, 75, 185, 27, 51, 229, 108, 126, 175, 85, 234, 79, 154, 50,
+			185, 253, 113, 203, 222, 90, 185, 38, 59, 164, 58, 52, 20, 208,
+			134, 10, 32, 124, 22, 66, 173, 163, 252, 161, 26, 0, 84, 224,
+			131, 76, 83, 96, 52, 31, 183, 236, 82, 154, 64, 85, 15, 108,
+			33, 235, 15, 3, 91, 250, 132, 101, 158, 84, 243, 6, 203, 198,
+			39, 82, 18, 200, 229, 1, 170, 179, 44, 70, 87, 144, 63, 97,
+			13, 94, 174, 65, 11, 95, 15, 104, 166, 5, 203, 198, 39, 82,
+			166, 5, 203, 198, 39, 172, 131, 199, 217, 63, 152, 212, 80, 158,
+			219, 159, 181, 204, 74, 249, 243, 102, 42, 154, 93, 159, 202, 79,
+			82, 48, 139, 226, 176, 85, 139, 159, 152, 96, 54, 185, 40, 130,
+			21, 47, 142, 213, 46, 216, 86, 169, 218, 165, 235, 110, 232, 93,
+			200, 50, 9, 169, 19, 122, 254, 18, 9, 190, 163, 208, 252, 197,
+			130, 19, 185, 89, 161, 71, 87, 34, 207, 100, 69, 211, 137, 151,
+			71, 96, 44, 82, 90, 9, 130, 14, 10, 49, 154, 245, 40, 201,
+			150, 162, 235, 41, 68, 4, 106, 115, 80, 221, 199, 58, 84, 213,
+			208, 233, 206, 230, 181, 172, 239, 75, 91, 97, 29, 237, 34, 217,
+			136, 146, 21, 12, 39, 164, 207, 166, 211, 7, 39, 164, 207, 166,
+			130, 5, 78, 17, 63, 107, 41, 131, 34, 35, 39, 164, 207, 90,
+			123, 246, 178, 47, 216, 52, 65, 5, 110, 127, 21, 130, 197, 127,
+			177, 197, 172, 244, 24, 167, 89, 240, 106, 122, 207, 143, 218, 77,
+			58, 184, 120, 226, 212, 150, 147, 192, 138, 207, 20, 162, 162, 158,
+			116, 172, 36, 69, 24, 140, 70, 216, 150, 210, 203, 80, 16, 240,
+			253, 53, 240, 237, 216, 171, 181, 26, 78, 40, 102, 206, 141, 139,
+			104, 45, 138, 161, 87, 97, 42, 214, 168, 80, 218, 18, 197, 218,
+			113, 153, 32, 209, 183, 93, 180, 137, 54, 116, 67, 12, 105, 252,
+			56, 117, 233, 5, 235, 52, 116, 84, 165, 97, 56, 220, 175, 31,
+			212, 170, 171, 236, 52, 164, 125, 129, 249, 3, 235, 234, 179, 110,
+			41, 19, 198, 73, 31, 41, 58, 97, 99, 77, 251, 133, 71, 40,
+			178, 222, 125, 151, 109, 210, 24, 6, 230, 7, 171, 16, 68, 32,
+			49, 194, 87, 35, 227, 243, 239, 249, 98, 209, 185, 0, 27, 197,
+			162, 90, 233, 170, 227, 76, 218, 0, 150, 218, 120, 65, 22, 163,
+			82, 223, 189, 52, 74, 67, 138, 87, 55, 194, 20, 75, 74, 188,
+			229, 2, 185, 155, 192, 15, 179, 81, 151, 213, 202, 45, 88, 42,
+			251, 240, 161, 217, 72, 6, 242, 66, 1, 203, 238, 75, 114, 191,
+			119, 87, 244, 126, 159, 144, 36, 156, 202, 190, 154, 146, 36, 156,
+			202, 190, 154, 110, 42, 116, 68, 148, 138, 65, 112, 42, 251, 106,
+			42, 6, 21, 138, 0, 19, 142, 82, 32, 10, 61, 120, 92, 113,
+			174, 34, 183, 239, 183, 76, 189, 227, 192, 7, 235, 254, 180, 29,
+			248, 96, 221, 159, 182, 131, 147, 151, 251, 173, 210, 126, 13, 226,
+			60, 201, 26, 58, 168, 65, 170, 234, 208, 211, 53, 136, 219, 33,
+			214, 200, 73, 213, 78, 39, 183, 191, 150, 114, 72, 120, 104, 125,
+			45, 109, 167, 19, 167, 77, 105, 59, 56, 196, 248, 90, 58, 30,
+			120, 104, 125, 45, 29, 79, 103, 17, 96, 50, 158, 206, 2, 192,
+			131, 199, 217, 33, 102, 217, 172, 147, 219, 95, 183, 204, 222, 202,
+			238, 228, 226, 230, 102, 239, 85, 34, 38, 135, 109, 51, 220, 223,
+			252, 186, 213, 213, 35, 107, 234, 236, 48, 218, 65, 83, 129, 164,
+			34, 51, 110, 127, 195, 162, 248, 102, 182, 205, 88, 7, 160, 174,
+			189, 236, 135, 82, 97, 102, 220, 126, 208, 50, 199, 202, 223, 50,
+			196, 164, 18, 114, 192, 161, 50, 68, 249, 76, 38, 206, 16, 45,
+			96, 227, 33, 135, 59, 87, 196, 244, 114, 23, 84, 227, 24, 18,
+			140, 114, 237, 194, 62, 238, 174, 120, 177, 72, 31, 72, 203, 26,
+			138, 25, 109, 195, 144, 218, 215, 72, 72, 205, 74, 205, 100, 69,
+			243, 226, 132, 97, 235, 237, 27, 46, 49, 75, 126, 16, 186, 245,
+			147, 58, 59, 202, 51, 92, 20, 143, 180, 231, 52, 121, 54, 162,
+			31, 201, 174, 78, 45, 233, 33, 160, 41, 21, 238, 84, 33, 30,
+			110, 39, 15, 166, 115, 200, 114, 0, 147, 57, 132, 77, 225, 65,
+			171, 84, 214, 32, 14, 200, 172, 93, 123, 52, 88, 4, 40, 142,
+			106, 176, 0, 112, 239, 17, 246, 82, 66, 103, 23, 183, 191, 99,
+			153, 215, 148, 95, 40, 38, 72, 49, 139, 180, 199, 18, 86, 180,
+			124, 171, 54, 209, 151, 37, 75, 87, 42, 214, 186, 8, 85, 82,
+			178, 87, 98, 38, 147, 118, 161, 56, 72, 214, 127, 61, 213, 78,
+			131, 16, 110, 211, 201, 208, 186, 108, 234, 65, 2, 230, 0, 38,
+			67, 235, 194, 109, 18, 75, 29, 77, 48, 132, 236, 177, 191, 99,
+			237, 211, 171, 162, 171, 136, 175, 87, 92, 173, 191, 22, 0, 30,
+			184, 138, 253, 158, 36, 149, 110, 110, 255, 192, 50, 135, 203, 47,
+			207, 216, 86, 2, 109, 126, 19, 53, 165, 125, 201, 215, 112, 21,
+			19, 129, 120, 30, 234, 56, 136, 224, 251, 141, 70, 182, 8, 219,
+			108, 64, 235, 246, 123, 29, 35, 128, 28, 170, 52, 223, 74, 134,
+			219, 109, 83, 167, 18, 48, 7, 48, 217, 240, 186, 113, 133, 196,
+			226, 122, 53, 118, 91, 0, 175, 24, 98, 147, 52, 158, 30, 110,
+			63, 108, 153, 67, 229, 147, 50, 198, 27, 30, 210, 37, 116, 110,
+			232, 211, 73, 221, 108, 164, 61, 194, 213, 38, 159, 116, 163, 7,
+			7, 121, 105, 55, 122, 114, 0, 147, 110, 224, 14, 207, 195, 22,
+			175, 104, 16, 7, 121, 214, 254, 3, 210, 153, 154, 153, 189, 220,
+			254, 145, 37, 157, 169, 83, 19, 147, 152, 197, 59, 194, 26, 63,
+			216, 231, 104, 105, 208, 53, 188, 141, 198, 39, 200, 128, 227, 218,
+			45, 149, 60, 77, 66, 23, 226, 17, 72, 255, 64, 245, 128, 180,
+			34, 33, 38, 97, 24, 213, 112, 42, 161, 140, 189, 202, 169, 37,
+			208, 147, 166, 189, 204, 163, 195, 209, 218, 202, 66, 208, 136, 152,
+			214, 112, 149, 175, 104, 70, 9, 162, 101, 167, 69, 161, 145, 76,
+			112, 203, 228, 232, 64, 121, 250, 202, 179, 14, 246, 104, 205, 36,
+			173, 104, 4, 246, 226, 4, 48, 197, 103, 47, 78, 0, 83, 124,
+			226, 134, 211, 143, 82, 131, 86, 47, 78, 0, 173, 189, 251, 200,
+			177, 158, 153, 125, 220, 254, 41, 166, 181, 153, 82, 105, 115, 185,
+			249, 120, 169, 19, 89, 55, 16, 0, 219, 132, 42, 213, 171, 11,
+			192, 2, 221, 34, 78, 104, 161, 15, 55, 95, 210, 190, 247, 225,
+			230, 75, 218, 119, 220, 181, 250, 105, 74, 11, 125, 184, 249, 2,
+			90, 248, 43, 185, 198, 74, 220, 190, 195, 54, 247, 151, 255, 204,
+			32, 213, 34, 131, 111, 116, 80, 186, 94, 38, 214, 57, 186, 141,
+			0, 198, 178, 105, 167, 147, 46, 178, 180, 143, 27, 102, 49, 249,
+			212, 46, 188, 146, 232, 169, 121, 49, 157, 10, 80, 104, 214, 186,
+			27, 187, 8, 176, 166, 213, 211, 164, 19, 201, 224, 75, 8, 179,
+			100, 39, 131, 47, 33, 200, 146, 157, 12, 30, 183, 195, 238, 176,
+			149, 127, 9, 51, 75, 8, 176, 100, 87, 46, 103, 159, 148, 131,
+			239, 231, 246, 111, 216, 102, 181, 252, 161, 127, 193, 224, 245, 227,
+			216, 9, 22, 152, 70, 195, 19, 192, 66, 34, 107, 183, 33, 66,
+			5, 26, 0, 139, 120, 108, 68, 244, 219, 52, 152, 4, 204, 1,
+			76, 16, 129, 187, 109, 191, 97, 115, 45, 23, 244, 91, 0, 15,
+			141, 178, 191, 150, 136, 224, 220, 126, 181, 109, 94, 94, 254, 127,
+			30, 11, 17, 122, 190, 224, 27, 213, 90, 88, 251, 23, 16, 129,
+			114, 241, 124, 82, 100, 64, 77, 171, 50, 9, 2, 184, 77, 131,
+			72, 192, 28, 192, 4, 1, 220, 0, 152, 44, 97, 110, 1, 220,
+			187, 143, 162, 43, 48, 115, 128, 219, 119, 218, 79, 54, 186, 2,
+			51, 7, 108, 42, 159, 128, 121, 128, 42, 186, 2, 51, 7, 16,
+			230, 201, 86, 209, 21, 152, 57, 96, 1, 220, 187, 143, 253, 26,
+			53, 189, 133, 219, 175, 179, 205, 19, 101, 255, 73, 133, 2, 74,
+			195, 208, 215, 131, 90, 43, 185, 130, 155, 108, 249, 21, 117, 36,
+			83, 73, 206, 100, 178, 113, 131, 152, 185, 5, 17, 154, 210, 158,
+			111, 65, 132, 38, 91, 197, 13, 98, 230, 22, 68, 104, 178, 85,
+			220, 32, 102, 110, 65, 132, 38, 251, 234, 227, 236, 149, 6, 196,
+			188, 28, 183, 95, 111, 155, 59, 202, 183, 255, 139, 99, 6, 60,
+			249, 81, 144, 108, 138, 0, 3, 175, 183, 85, 128, 1, 70, 1,
+			6, 94, 111, 171, 0, 3, 44, 135, 139, 149, 175, 183, 183, 14,
+			146, 67, 20, 132, 222, 55, 218, 102, 175, 18, 106, 17, 149, 201,
+			78, 164, 88, 99, 29, 104, 42, 16, 225, 156, 186, 120, 254, 247,
+			237, 142, 239, 216, 50, 156, 19, 196, 154, 223, 183, 139, 219, 216,
+			151, 114, 204, 182, 187, 96, 15, 122, 143, 109, 62, 163, 252, 137,
+			28, 246, 128, 196, 254, 168, 14, 239, 210, 123, 5, 71, 181, 33,
+			5, 185, 178, 183, 159, 23, 219, 174, 100, 105, 167, 242, 204, 134,
+			135, 140, 233, 177, 204, 104, 91, 164, 234, 52, 246, 205, 186, 218,
+			153, 170, 190, 42, 232, 56, 80, 93, 203, 78, 15, 116, 33, 123,
+			7, 97, 38, 130, 190, 140, 107, 112, 2, 71, 195, 7, 34, 209,
+			112, 163, 136, 193, 222, 231, 213, 224, 241, 48, 66, 22, 192, 69,
+			119, 213, 13, 147, 37, 34, 173, 145, 152, 74, 236, 242, 232, 21,
+			157, 92, 187, 245, 117, 175, 6, 36, 247, 5, 244, 146, 210, 246,
+			207, 182, 227, 114, 145, 100, 191, 46, 8, 196, 139, 96, 168, 16,
+			138, 132, 244, 151, 245, 15, 228, 139, 107, 9, 219, 39, 101, 222,
+			12, 141, 93, 137, 253, 107, 197, 185, 72, 95, 94, 220, 238, 83,
+			155, 118, 75, 89, 48, 201, 57, 0, 135, 61, 186, 123, 100, 136,
+			141, 78, 102, 16, 26, 41, 79, 71, 202, 154, 157, 42, 38, 78,
+			181, 123, 16, 4, 139, 56, 195, 13, 69, 26, 128, 9, 199, 141,
+			158, 191, 68, 166, 7, 213, 114, 116, 82, 6, 19, 38, 49, 70,
+			123, 112, 44, 40, 127, 91, 28, 115, 35, 28, 19, 41, 41, 235,
+			239, 58, 64, 171, 16, 167, 213, 145, 94, 162, 142, 232, 227, 74,
+			201, 79, 105, 121, 136, 120, 149, 206, 188, 227, 208, 171, 41, 191,
+			20, 101, 25, 113, 17, 124, 180, 166, 204, 10, 201, 6, 212, 118,
+			39, 74, 114, 132, 46, 58, 233, 123, 143, 102, 16, 93, 116, 210,
+			247, 30, 91, 137, 247, 93, 100, 193, 124, 143, 93, 58, 160, 65,
+			11, 235, 224, 224, 33, 13, 22, 1, 42, 19, 109, 151, 217, 81,
+			0, 56, 250, 116, 246, 93, 108, 58, 93, 176, 19, 190, 215, 54,
+			175, 43, 127, 213, 16, 103, 188, 40, 85, 94, 212, 158, 146, 185,
+			174, 23, 197, 142, 95, 119, 194, 186, 168, 164, 190, 30, 67, 195,
+			21, 225, 212, 16, 232, 7, 126, 31, 82, 190, 170, 57, 62, 66,
+			153, 203, 23, 122, 245, 93, 80, 154, 202, 164, 38, 237, 151, 162,
+			175, 170, 129, 116, 93, 92, 127, 32, 106, 185, 205, 77, 163, 6,
+			43, 25, 76, 173, 91, 215, 137, 60, 55, 60, 73, 161, 174, 201,
+			104, 161, 22, 147, 115, 33, 240, 52, 185, 168, 51, 164, 76, 39,
+			43, 138, 209, 118, 209, 9, 220, 123, 83, 60, 226, 4, 238, 189,
+			41, 30, 193, 121, 222, 107, 151, 14, 107, 208, 2, 56, 118, 76,
+			131, 69, 128, 87, 78, 104, 176, 0, 240, 170, 51, 236, 7, 18,
+			143, 38, 183, 239, 182, 205, 163, 229, 175, 167, 26, 181, 34, 149,
+			167, 78, 169, 206, 172, 161, 39, 168, 73, 43, 69, 154, 61, 110,
+			77, 90, 53, 163, 149, 233, 46, 242, 17, 189, 59, 69, 37, 12,
+			202, 119, 167, 168, 4, 89, 221, 109, 43, 101, 186, 139, 124, 68,
+			239, 182, 119, 237, 214, 96, 17, 95, 247, 28, 209, 96, 1, 160,
+			56, 44, 153, 122, 39, 183, 63, 104, 155, 3, 180, 55, 116, 193,
+			224, 241, 65, 187, 171, 91, 230, 36, 131, 71, 22, 52, 21, 40,
+			243, 210, 199, 94, 213, 133, 78, 99, 29, 104, 42, 80, 230, 37,
+			168, 159, 171, 143, 166, 209, 14, 234, 175, 127, 152, 71, 102, 244,
+			254, 115, 182, 185, 187, 252, 186, 188, 184, 57, 115, 35, 73, 225,
+			68, 173, 252, 118, 239, 133, 84, 36, 91, 113, 154, 112, 156, 9,
+			215, 36, 43, 82, 243, 6, 171, 102, 83, 249, 89, 37, 215, 179,
+			146, 20, 201, 134, 5, 242, 60, 253, 89, 238, 218, 28, 133, 149,
+			163, 231, 79, 241, 243, 25, 72, 159, 167, 140, 226, 90, 113, 244,
+			36, 75, 133, 150, 122, 246, 74, 89, 35, 8, 110, 139, 40, 20,
+			139, 174, 78, 117, 248, 38, 167, 169, 158, 73, 140, 195, 53, 205,
+			225, 179, 92, 222, 105, 206, 203, 46, 183, 241, 245, 52, 135, 211,
+			16, 170, 91, 226, 54, 119, 77, 117, 98, 67, 150, 164, 195, 202,
+			91, 252, 90, 49, 166, 178, 189, 88, 254, 73, 152, 106, 123, 135,
+			214, 141, 142, 137, 73, 125, 178, 162, 92, 15, 225, 235, 85, 91,
+			14, 130, 72, 50, 210, 140, 133, 68, 206, 139, 238, 254, 181, 232,
+			188, 30, 53, 185, 104, 144, 48, 143, 88, 249, 50, 98, 13, 230,
+			198, 107, 91, 134, 137, 57, 57, 14, 4, 226, 252, 211, 183, 219,
+			220, 53, 76, 177, 190, 106, 162, 162, 51, 167, 111, 188, 136, 83,
+			231, 38, 201, 146, 67, 119, 59, 188, 117, 189, 149, 103, 210, 218,
+			201, 136, 158, 125, 129, 119, 131, 167, 162, 27, 166, 151, 185, 214,
+			239, 49, 41, 117, 76, 77, 207, 77, 156, 208, 161, 211, 149, 205,
+			85, 225, 121, 195, 227, 62, 226, 148, 116, 185, 208, 98, 15, 209,
+			25, 238, 125, 57, 23, 153, 86, 203, 201, 186, 162, 43, 80, 60,
+			84, 59, 195, 121, 43, 109, 214, 221, 133, 181, 140, 114, 164, 55,
+			38, 229, 189, 151, 110, 80, 56, 112, 254, 92, 202, 13, 112, 224,
+			252, 185, 148, 27, 224, 192, 249, 115, 118, 105, 135, 6, 105, 61,
+			93, 182, 139, 93, 174, 214, 251, 231, 109, 179, 167, 178, 157, 206,
+			252, 113, 156, 53, 159, 28, 213, 45, 52, 232, 213, 0, 44, 88,
+			139, 219, 159, 79, 87, 190, 101, 180, 131, 166, 2, 247, 168, 26,
+			191, 128, 26, 57, 213, 232, 59, 126, 48, 239, 68, 243, 168, 89,
+			87, 102, 115, 251, 11, 105, 105, 219, 104, 7, 77, 5, 222, 143,
+			3, 239, 46, 48, 186, 111, 216, 230, 185, 242, 125, 150, 178, 238,
+			17, 66, 228, 163, 244, 98, 217, 241, 235, 120, 194, 79, 4, 139,
+			242, 2, 116, 26, 29, 53, 217, 3, 35, 189, 205, 33, 226, 71,
+			88, 115, 200, 180, 0, 114, 194, 225, 116, 83, 61, 57, 69, 22,
+			154, 236, 81, 21, 234, 73, 194, 222, 165, 47, 131, 96, 190, 213,
+			217, 46, 102, 76, 209, 34, 54, 68, 29, 188, 131, 34, 80, 69,
+			226, 214, 228, 222, 244, 173, 144, 103, 2, 236, 211, 65, 11, 238,
+			152, 126, 61, 115, 146, 173, 143, 240, 181, 195, 13, 200, 109, 253,
+			115, 21, 137, 30, 72, 55, 105, 99, 60, 149, 77, 207, 159, 172,
+			184, 14, 57, 42, 58, 75, 184, 70, 31, 139, 133, 48, 184, 205,
+			245, 165, 75, 104, 36, 234, 45, 151, 105, 203, 153, 82, 94, 61,
+			63, 237, 37, 24, 225, 165, 49, 166, 142, 234, 19, 241, 64, 161,
+			59, 41, 189, 234, 194, 12, 77, 138, 177, 10, 15, 79, 209, 221,
+			234, 193, 170, 47, 253, 205, 113, 97, 18, 94, 231, 36, 41, 46,
+			59, 117, 17, 123, 43, 164, 14, 72, 209, 66, 43, 95, 93, 116,
+			55, 230, 27, 41, 233, 194, 237, 252, 27, 41, 233, 130, 54, 190,
+			97, 151, 174, 214, 160, 5, 240, 248, 73, 13, 22, 1, 62, 125,
+			154, 124, 37, 0, 210, 231, 107, 167, 72, 161, 237, 194, 158, 248,
+			205, 39, 173, 208, 118, 81, 96, 232, 111, 166, 61, 195, 169, 236,
+			55, 181, 66, 219, 69, 167, 178, 223, 212, 10, 109, 23, 197, 106,
+			248, 166, 189, 119, 31, 197, 182, 237, 194, 149, 141, 7, 237, 95,
+			94, 108, 219, 46, 58, 99, 124, 48, 237, 12, 98, 75, 63, 168,
+			117, 212, 46, 138, 45, 253, 160, 214, 81, 187, 232, 140, 241, 65,
+			232, 168, 8, 107, 215, 149, 227, 246, 183, 237, 167, 38, 172, 93,
+			23, 180, 206, 111, 107, 173, 179, 139, 180, 206, 111, 107, 173, 179,
+			139, 180, 206, 111, 67, 235, 132, 242, 216, 205, 243, 223, 179, 59,
+			94, 151, 151, 202, 35, 140, 196, 223, 179, 139, 91, 216, 239, 97,
+			149, 119, 67, 121, 252, 161, 109, 86, 203, 47, 183, 104, 65, 213,
+			104, 179, 78, 88, 172, 62, 160, 198, 124, 141, 31, 58, 180, 222,
+			209, 74, 105, 153, 78, 234, 230, 206, 46, 17, 243, 66, 249, 150,
+			44, 59, 190, 240, 50, 94, 127, 171, 96, 193, 85, 145, 104, 224,
+			154, 38, 244, 149, 90, 48, 130, 70, 176, 170, 25, 137, 238, 151,
+			138, 37, 148, 236, 56, 234, 189, 38, 245, 36, 12, 118, 0, 92,
+			132, 127, 30, 13, 230, 218, 241, 233, 153, 51, 207, 167, 213, 175,
+			18, 102, 231, 102, 38, 167, 174, 127, 190, 24, 202, 4, 210, 24,
+			198, 201, 95, 219, 229, 110, 197, 136, 80, 17, 74, 137, 10, 197,
+			196, 168, 104, 234, 77, 131, 123, 186, 250, 237, 36, 49, 58, 42,
+			162, 32, 12, 215, 70, 196, 170, 123, 0, 230, 107, 8, 63, 129,
+			240, 112, 41, 177, 238, 50, 165, 230, 162, 182, 72, 121, 191, 183,
+			160, 199, 234, 83, 213, 189, 146, 200, 186, 73, 237, 249, 161, 166,
+			185, 110, 138, 167, 252, 67, 91, 93, 241, 234, 38, 181, 231, 135,
+			182, 138, 220, 211, 77, 215, 127, 126, 104, 239, 40, 107, 176, 136,
+			9, 221, 57, 170, 193, 2, 192, 203, 70, 40, 138, 84, 55, 60,
+			83, 30, 177, 205, 151, 229, 100, 20, 169, 110, 27, 117, 61, 98,
+			23, 186, 217, 30, 150, 183, 187, 225, 4, 194, 237, 255, 101, 219,
+			125, 229, 190, 196, 142, 184, 66, 111, 83, 144, 63, 69, 183, 114,
+			27, 249, 95, 182, 157, 73, 48, 145, 208, 211, 203, 222, 96, 169,
+			58, 12, 110, 255, 179, 109, 247, 148, 127, 195, 202, 110, 183, 109,
+			179, 161, 188, 214, 37, 59, 86, 46, 75, 27, 177, 79, 196, 179,
+			214, 116, 153, 70, 126, 149, 188, 198, 51, 183, 231, 113, 155, 212,
+			199, 137, 16, 212, 7, 87, 144, 41, 84, 49, 112, 168, 189, 88,
+			247, 228, 179, 231, 136, 241, 32, 172, 39, 114, 128, 186, 144, 69,
+			62, 82, 85, 65, 110, 242, 82, 59, 128, 48, 164, 210, 117, 104,
+			26, 116, 53, 164, 176, 25, 8, 140, 16, 224, 52, 110, 49, 116,
+			150, 18, 65, 135, 250, 132, 76, 184, 24, 143, 35, 159, 0, 91,
+			82, 12, 55, 72, 229, 241, 36, 95, 188, 132, 21, 82, 95, 164,
+			160, 221, 206, 97, 212, 41, 210, 96, 218, 190, 74, 213, 177, 225,
+			57, 4, 37, 46, 143, 142, 178, 238, 195, 72, 87, 167, 162, 116,
+			155, 4, 23, 24, 240, 204, 158, 231, 172, 139, 64, 35, 29, 151,
+			51, 83, 7, 205, 224, 159, 109, 187, 152, 38, 152, 72, 232, 234,
+			38, 54, 142, 185, 52, 185, 125, 71, 78, 5, 112, 235, 86, 222,
+			52, 119, 228, 148, 55, 77, 183, 242, 166, 185, 35, 55, 176, 133,
+			125, 94, 114, 15, 131, 219, 191, 149, 51, 119, 150, 63, 102, 41,
+			126, 75, 209, 41, 212, 140, 43, 71, 39, 53, 0, 50, 246, 36,
+			243, 219, 12, 17, 176, 206, 187, 144, 108, 248, 228, 254, 143, 140,
+			12, 215, 199, 96, 207, 73, 76, 63, 27, 88, 138, 159, 120, 190,
+			85, 197, 140, 163, 244, 18, 199, 79, 4, 73, 88, 74, 212, 3,
+			158, 74, 198, 93, 74, 130, 81, 165, 126, 159, 174, 148, 173, 71,
+			178, 23, 142, 157, 48, 116, 214, 192, 95, 84, 108, 72, 242, 199,
+			117, 180, 147, 107, 99, 125, 180, 189, 133, 70, 176, 80, 77, 163,
+			150, 33, 144, 82, 99, 45, 241, 19, 128, 28, 73, 190, 109, 94,
+			156, 113, 100, 35, 77, 85, 42, 238, 202, 231, 65, 134, 244, 72,
+			98, 194, 109, 224, 117, 105, 180, 51, 225, 249, 44, 19, 236, 76,
+			61, 179, 38, 110, 213, 3, 151, 202, 209, 198, 232, 130, 235, 3,
+			159, 193, 45, 57, 12, 16, 118, 100, 217, 77, 100, 11, 181, 229,
+			117, 147, 181, 224, 183, 114, 9, 251, 129, 181, 224, 183, 114, 74,
+			50, 232, 166, 11, 149, 191, 149, 43, 105, 246, 131, 27, 166, 191,
+			149, 219, 81, 102, 191, 15, 99, 100, 55, 8, 228, 13, 57, 243,
+			88, 249, 183, 115, 68, 17, 47, 136, 178, 27, 138, 54, 165, 43,
+			91, 11, 156, 32, 103, 201, 88, 33, 167, 38, 57, 112, 80, 87,
+			136, 2, 21, 25, 71, 5, 57, 201, 114, 19, 160, 5, 86, 121,
+			248, 65, 73, 172, 99, 94, 175, 190, 82, 44, 32, 26, 133, 31,
+			187, 75, 161, 211, 160, 73, 167, 215, 182, 169, 254, 136, 137, 33,
+			207, 143, 175, 190, 114, 68, 180, 212, 223, 72, 253, 165, 76, 248,
+			16, 169, 95, 195, 85, 33, 78, 41, 46, 68, 235, 81, 13, 228,
+			198, 217, 121, 185, 125, 208, 49, 83, 66, 152, 82, 70, 204, 140,
+			71, 242, 16, 109, 26, 162, 233, 142, 68, 3, 87, 145, 2, 184,
+			84, 185, 53, 15, 28, 31, 100, 27, 203, 176, 116, 203, 240, 233,
+			245, 117, 96, 112, 201, 136, 146, 224, 65, 42, 42, 143, 230, 148,
+			139, 141, 64, 90, 39, 228, 61, 163, 180, 217, 42, 19, 179, 233,
+			203, 108, 55, 206, 206, 79, 157, 191, 233, 244, 196, 140, 86, 190,
+			245, 32, 200, 122, 215, 102, 238, 114, 235, 217, 206, 171, 43, 109,
+			44, 209, 165, 50, 223, 42, 242, 70, 66, 69, 199, 65, 155, 203,
+			80, 144, 222, 236, 245, 161, 174, 246, 31, 69, 71, 240, 54, 255,
+			89, 224, 172, 93, 77, 84, 142, 55, 228, 25, 166, 4, 240, 116,
+			134, 147, 208, 16, 129, 154, 236, 172, 143, 147, 242, 83, 165, 219,
+			22, 78, 157, 78, 101, 97, 211, 100, 20, 86, 176, 186, 226, 196,
+			203, 213, 73, 208, 65, 98, 78, 236, 38, 219, 205, 27, 82, 194,
+			134, 176, 248, 134, 156, 58, 164, 233, 38, 31, 229, 55, 228, 248,
+			160, 6, 17, 241, 41, 183, 243, 50, 13, 22, 1, 238, 26, 211,
+			32, 98, 88, 229, 118, 31, 85, 251, 170, 193, 243, 111, 204, 153,
+			127, 152, 236, 171, 88, 36, 111, 204, 21, 122, 40, 14, 100, 55,
+			189, 27, 103, 191, 57, 103, 243, 242, 118, 117, 196, 149, 34, 73,
+			93, 145, 149, 60, 89, 134, 102, 124, 115, 78, 249, 74, 118, 171,
+			208, 140, 111, 206, 149, 250, 217, 176, 170, 10, 119, 64, 80, 213,
+			14, 170, 42, 51, 53, 106, 223, 202, 84, 134, 110, 220, 149, 173,
+			12, 142, 151, 119, 101, 43, 51, 185, 253, 7, 155, 86, 150, 94,
+			60, 209, 101, 129, 159, 63, 200, 86, 134, 181, 254, 7, 168, 236,
+			205, 12, 72, 128, 185, 232, 238, 156, 121, 168, 252, 106, 166, 61,
+			234, 148, 135, 7, 68, 191, 133, 100, 231, 106, 56, 183, 123, 141,
+			181, 103, 10, 113, 214, 185, 125, 45, 209, 200, 180, 207, 135, 18,
+			121, 71, 129, 22, 253, 250, 137, 188, 45, 182, 130, 56, 105, 180,
+			98, 228, 42, 1, 18, 229, 117, 3, 85, 6, 36, 67, 47, 160,
+			145, 104, 41, 91, 35, 238, 142, 125, 223, 79, 243, 29, 144, 247,
+			50, 232, 208, 136, 184, 177, 114, 35, 86, 253, 3, 87, 213, 114,
+			66, 42, 65, 168, 13, 129, 97, 45, 41, 39, 179, 246, 90, 213,
+			91, 151, 181, 88, 94, 148, 74, 235, 163, 206, 170, 112, 128, 20,
+			185, 79, 26, 142, 245, 41, 133, 186, 58, 131, 225, 59, 116, 37,
+			20, 163, 109, 91, 35, 50, 34, 224, 98, 8, 25, 57, 208, 6,
+			160, 36, 68, 16, 137, 224, 76, 184, 206, 18, 34, 157, 133, 162,
+			1, 172, 106, 81, 162, 61, 122, 85, 114, 59, 51, 81, 48, 244,
+			85, 202, 228, 130, 1, 75, 54, 173, 236, 137, 41, 217, 147, 162,
+			214, 210, 18, 222, 183, 76, 207, 49, 18, 195, 189, 67, 175, 68,
+			67, 86, 247, 220, 11, 74, 48, 99, 106, 123, 107, 235, 79, 91,
+			4, 51, 140, 123, 53, 8, 213, 233, 81, 102, 105, 47, 4, 193,
+			109, 183, 185, 46, 34, 23, 201, 232, 19, 203, 152, 139, 120, 173,
+			169, 140, 140, 234, 189, 178, 54, 223, 99, 111, 3, 3, 209, 55,
+			4, 132, 67, 62, 227, 109, 47, 12, 34, 204, 254, 162, 58, 81,
+			199, 201, 75, 202, 6, 49, 203, 39, 33, 79, 53, 244, 213, 8,
+			184, 213, 43, 13, 54, 116, 87, 244, 117, 10, 220, 68, 132, 232,
+			216, 10, 33, 127, 172, 192, 219, 80, 196, 203, 240, 116, 31, 197,
+			243, 109, 201, 243, 107, 44, 211, 152, 58, 120, 150, 253, 201, 60,
+			170, 40, 59, 124, 18, 84, 25, 197, 170, 217, 164, 49, 212, 70,
+			204, 24, 99, 151, 118, 146, 228, 54, 157, 108, 144, 200, 185, 214,
+			10, 161, 127, 53, 160, 124, 44, 211, 89, 126, 224, 143, 182, 87,
+			8, 162, 247, 124, 101, 157, 80, 161, 154, 132, 171, 95, 226, 81,
+			231, 25, 237, 17, 96, 104, 242, 52, 129, 43, 73, 13, 196, 40,
+			109, 123, 68, 115, 210, 43, 84, 61, 29, 2, 242, 116, 107, 183,
+			49, 225, 53, 26, 163, 88, 41, 116, 193, 52, 116, 213, 113, 89,
+			16, 138, 21, 47, 82, 148, 169, 194, 195, 234, 37, 62, 238, 52,
+			200, 160, 52, 25, 77, 202, 53, 236, 221, 238, 214, 135, 134, 153,
+			8, 54, 91, 234, 164, 56, 202, 23, 54, 148, 65, 177, 125, 49,
+			226, 176, 112, 125, 83, 41, 59, 185, 206, 241, 32, 145, 182, 5,
+			216, 151, 53, 134, 110, 4, 166, 236, 101, 100, 117, 199, 107, 64,
+			73, 27, 82, 15, 217, 99, 57, 51, 209, 242, 21, 167, 65, 47,
+			117, 171, 234, 153, 220, 26, 242, 65, 148, 213, 161, 51, 187, 41,
+			96, 233, 221, 233, 206, 3, 59, 225, 221, 169, 72, 5, 171, 222,
+			221, 57, 21, 176, 180, 155, 66, 166, 220, 157, 219, 174, 55, 34,
+			11, 167, 6, 185, 29, 7, 53, 136, 83, 131, 92, 121, 152, 158,
+			246, 181, 187, 177, 167, 125, 36, 103, 94, 83, 254, 67, 67, 180,
+			124, 61, 19, 243, 52, 113, 106, 5, 136, 90, 16, 226, 65, 115,
+			31, 65, 236, 104, 126, 34, 141, 84, 168, 85, 42, 14, 128, 186,
+			79, 173, 157, 93, 219, 236, 95, 210, 91, 157, 234, 36, 97, 168,
+			13, 113, 25, 9, 149, 228, 249, 160, 77, 241, 97, 153, 112, 245,
+			114, 0, 48, 60, 125, 36, 197, 5, 12, 79, 31, 73, 113, 1,
+			195, 211, 71, 114, 42, 10, 86, 55, 5, 101, 249, 72, 174, 114,
+			185, 6, 139, 248, 170, 194, 9, 116, 211, 189, 139, 143, 228, 174,
+			184, 138, 61, 36, 113, 145, 227, 246, 61, 57, 243, 104, 249, 191,
+			101, 221, 59, 193, 105, 158, 178, 163, 40, 125, 8, 24, 61, 185,
+			131, 40, 21, 173, 245, 113, 185, 116, 18, 233, 106, 172, 193, 70,
+			118, 79, 138, 68, 196, 51, 189, 39, 69, 34, 108, 100, 247, 228,
+			212, 49, 84, 55, 217, 200, 238, 201, 169, 99, 168, 110, 51, 87,
+			196, 87, 117, 12, 213, 77, 55, 23, 238, 201, 137, 195, 236, 153,
+			132, 195, 60, 183, 239, 205, 153, 35, 229, 163, 79, 252, 89, 83,
+			217, 120, 30, 207, 70, 164, 93, 131, 87, 254, 189, 105, 215, 96,
+			49, 187, 55, 165, 117, 88, 204, 238, 205, 109, 223, 161, 193, 34,
+			192, 242, 33, 13, 22, 0, 238, 60, 40, 67, 155, 116, 155, 5,
+			110, 127, 50, 103, 94, 41, 67, 155, 72, 43, 64, 186, 27, 201,
+			153, 150, 251, 155, 178, 1, 232, 141, 61, 68, 255, 41, 8, 138,
+			20, 29, 228, 206, 84, 119, 23, 90, 75, 218, 75, 1, 167, 222,
+			56, 18, 79, 68, 139, 117, 47, 122, 70, 176, 205, 209, 225, 73,
+			45, 116, 235, 80, 23, 157, 70, 58, 29, 112, 251, 254, 100, 58,
+			102, 184, 125, 127, 50, 29, 51, 220, 190, 63, 153, 43, 105, 81,
+			18, 110, 223, 159, 204, 237, 217, 171, 193, 34, 192, 202, 49, 13,
+			210, 32, 247, 141, 177, 255, 66, 99, 182, 77, 158, 255, 76, 206,
+			252, 124, 206, 42, 255, 133, 161, 226, 165, 66, 12, 152, 153, 152,
+			155, 152, 66, 128, 185, 249, 217, 233, 243, 51, 227, 19, 35, 217,
+			179, 17, 77, 132, 234, 162, 72, 106, 93, 95, 240, 124, 39, 92,
+			171, 74, 22, 127, 2, 58, 11, 236, 247, 142, 223, 130, 89, 123,
+			236, 200, 216, 177, 145, 196, 138, 150, 132, 152, 196, 63, 210, 112,
+			151, 66, 48, 16, 232, 84, 201, 214, 186, 230, 198, 202, 41, 1,
+			178, 58, 237, 107, 98, 104, 225, 240, 216, 213, 87, 94, 117, 252,
+			216, 149, 79, 59, 158, 112, 64, 10, 115, 253, 153, 92, 97, 155,
+			178, 53, 224, 85, 74, 251, 179, 57, 187, 172, 164, 73, 147, 4,
+			223, 207, 166, 198, 7, 147, 238, 35, 125, 54, 55, 184, 35, 41,
+			129, 211, 148, 108, 9, 72, 183, 159, 203, 150, 128, 116, 251, 185,
+			108, 9, 147, 219, 127, 155, 179, 119, 36, 37, 208, 137, 191, 205,
+			169, 103, 13, 40, 129, 114, 108, 135, 161, 20, 180, 95, 228, 246,
+			23, 115, 166, 230, 180, 240, 177, 255, 98, 58, 169, 120, 64, 238,
+			139, 185, 46, 189, 168, 224, 99, 255, 197, 220, 78, 205, 153, 112,
+			93, 240, 139, 185, 3, 195, 236, 61, 112, 115, 237, 198, 11, 108,
+			95, 201, 153, 15, 230, 172, 242, 93, 166, 146, 242, 50, 118, 43,
+			229, 17, 10, 25, 65, 7, 23, 88, 79, 200, 176, 237, 64, 56,
+			38, 19, 162, 162, 90, 236, 97, 78, 198, 223, 130, 110, 3, 73,
+			209, 178, 229, 107, 127, 182, 44, 249, 42, 59, 27, 100, 199, 198,
+			90, 122, 150, 225, 107, 251, 42, 56, 160, 227, 167, 215, 64, 168,
+			51, 107, 213, 39, 70, 30, 236, 81, 233, 227, 241, 145, 7, 182,
+			192, 175, 228, 10, 219, 213, 212, 209, 35, 117, 95, 205, 217, 151,
+			169, 153, 178, 136, 60, 190, 154, 83, 17, 233, 187, 241, 142, 28,
+			30, 185, 200, 149, 119, 38, 37, 112, 231, 33, 157, 108, 139, 12,
+			21, 247, 167, 147, 109, 145, 185, 235, 126, 236, 171, 186, 4, 158,
+			176, 200, 217, 151, 39, 25, 64, 30, 95, 203, 217, 123, 210, 4,
+			202, 81, 217, 151, 148, 176, 184, 253, 245, 108, 175, 208, 237, 175,
+			103, 123, 133, 151, 71, 190, 158, 237, 21, 78, 89, 82, 162, 181,
+			104, 127, 251, 70, 74, 180, 22, 158, 117, 181, 191, 145, 18, 173,
+			133, 211, 147, 255, 158, 45, 145, 51, 40, 33, 45, 145, 51, 145,
+			144, 41, 145, 231, 246, 63, 100, 71, 14, 30, 251, 15, 217, 145,
+			227, 65, 144, 127, 200, 142, 188, 192, 237, 111, 102, 7, 10, 14,
+			245, 205, 108, 163, 5, 19, 9, 187, 118, 39, 37, 138, 220, 254,
+			86, 118, 228, 32, 255, 111, 101, 71, 142, 7, 65, 190, 149, 29,
+			121, 39, 183, 31, 200, 217, 59, 147, 12, 184, 44, 242, 64, 206,
+			222, 150, 38, 224, 25, 138, 156, 138, 234, 213, 141, 39, 23, 31,
+			202, 153, 195, 106, 61, 225, 226, 201, 67, 233, 226, 67, 104, 224,
+			135, 114, 42, 56, 89, 55, 189, 125, 248, 80, 78, 5, 39, 235,
+			166, 139, 39, 15, 229, 174, 24, 146, 218, 56, 34, 42, 126, 39,
+			103, 126, 95, 107, 227, 20, 48, 240, 59, 57, 166, 134, 143, 248,
+			126, 220, 254, 110, 206, 222, 43, 123, 2, 216, 166, 132, 238, 52,
+			33, 143, 132, 30, 133, 65, 21, 34, 240, 187, 185, 173, 106, 252,
+			42, 68, 224, 119, 115, 123, 4, 171, 168, 58, 113, 174, 146, 179,
+			119, 87, 184, 152, 115, 47, 198, 164, 26, 233, 72, 223, 73, 33,
+			195, 166, 76, 105, 59, 176, 173, 125, 47, 167, 158, 181, 235, 86,
+			129, 2, 191, 151, 219, 162, 230, 82, 5, 10, 252, 94, 238, 178,
+			93, 10, 73, 140, 219, 63, 200, 153, 122, 235, 198, 205, 142, 31,
+			164, 72, 98, 121, 128, 42, 96, 89, 55, 221, 236, 248, 65, 174,
+			172, 217, 25, 195, 125, 128, 220, 232, 97, 58, 164, 235, 54, 187,
+			184, 253, 112, 238, 201, 30, 210, 117, 211, 205, 139, 135, 211, 166,
+			187, 242, 0, 213, 33, 93, 183, 9, 23, 197, 135, 115, 234, 144,
+			174, 155, 110, 94, 60, 156, 219, 187, 143, 157, 65, 211, 120, 40,
+			245, 145, 156, 249, 155, 121, 171, 124, 165, 152, 220, 244, 129, 108,
+			205, 101, 86, 61, 191, 30, 172, 130, 51, 57, 186, 139, 186, 7,
+			22, 80, 245, 8, 102, 245, 11, 120, 129, 162, 27, 15, 142, 114,
+			251, 199, 57, 123, 164, 124, 143, 209, 246, 14, 179, 98, 172, 94,
+			148, 152, 67, 87, 157, 68, 23, 215, 241, 81, 132, 231, 67, 241,
+			246, 117, 169, 8, 42, 117, 216, 240, 180, 109, 57, 86, 17, 150,
+			71, 218, 140, 55, 217, 75, 215, 19, 103, 38, 105, 63, 62, 59,
+			113, 253, 169, 241, 231, 106, 65, 144, 169, 56, 25, 96, 139, 25,
+			119, 133, 54, 195, 131, 126, 161, 6, 170, 110, 136, 8, 1, 164,
+			204, 74, 10, 144, 47, 175, 254, 56, 37, 26, 131, 206, 131, 126,
+			156, 18, 167, 180, 25, 253, 56, 183, 245, 64, 154, 96, 33, 225,
+			224, 33, 122, 255, 8, 57, 12, 110, 255, 12, 152, 89, 90, 135,
+			152, 12, 78, 22, 220, 90, 128, 183, 255, 83, 81, 89, 162, 228,
+			124, 148, 88, 3, 100, 152, 101, 96, 130, 37, 117, 96, 135, 137,
+			67, 111, 9, 214, 135, 85, 39, 244, 179, 22, 40, 245, 168, 235,
+			207, 178, 157, 71, 120, 190, 159, 101, 59, 143, 105, 252, 89, 182,
+			243, 176, 40, 255, 44, 119, 240, 16, 187, 160, 58, 143, 83, 137,
+			188, 61, 82, 94, 164, 206, 39, 66, 49, 228, 28, 217, 160, 12,
+			182, 239, 45, 182, 15, 72, 133, 40, 73, 122, 157, 142, 27, 55,
+			70, 64, 2, 234, 245, 179, 246, 17, 167, 125, 135, 106, 117, 71,
+			62, 211, 119, 176, 230, 59, 242, 201, 106, 149, 38, 177, 59, 242,
+			91, 210, 190, 195, 6, 118, 71, 254, 224, 33, 246, 87, 154, 38,
+			225, 235, 158, 183, 135, 203, 239, 49, 46, 141, 122, 178, 11, 8,
+			68, 7, 119, 195, 71, 161, 72, 57, 18, 182, 142, 20, 27, 78,
+			20, 111, 164, 71, 45, 1, 94, 154, 248, 216, 227, 163, 62, 104,
+			174, 191, 145, 69, 130, 149, 71, 66, 102, 2, 177, 7, 254, 70,
+			126, 171, 218, 70, 145, 64, 99, 62, 48, 164, 88, 86, 55, 183,
+			95, 153, 87, 111, 53, 119, 211, 21, 166, 87, 230, 19, 190, 209,
+			157, 7, 152, 176, 44, 156, 78, 191, 50, 175, 94, 125, 238, 166,
+			43, 76, 175, 204, 143, 84, 233, 112, 191, 219, 236, 225, 246, 171,
+			243, 191, 188, 195, 253, 110, 186, 200, 244, 234, 180, 51, 61, 121,
+			128, 234, 112, 191, 155, 46, 50, 189, 58, 175, 14, 247, 187, 205,
+			30, 120, 237, 231, 213, 225, 126, 119, 142, 219, 119, 230, 159, 154,
+			195, 253, 110, 28, 238, 223, 153, 87, 135, 251, 221, 116, 184, 127,
+			103, 94, 29, 238, 119, 211, 225, 254, 157, 249, 173, 131, 236, 50,
+			244, 163, 147, 219, 191, 155, 55, 123, 42, 125, 201, 61, 202, 23,
+			64, 180, 84, 53, 193, 143, 240, 119, 243, 202, 197, 167, 155, 252,
+			8, 179, 160, 169, 192, 227, 170, 166, 215, 230, 205, 222, 202, 72,
+			18, 170, 42, 198, 41, 4, 132, 74, 250, 59, 31, 44, 32, 60,
+			124, 236, 206, 215, 131, 121, 63, 136, 231, 91, 81, 210, 140, 193,
+			237, 215, 230, 149, 239, 122, 119, 167, 177, 14, 52, 21, 8, 111,
+			132, 30, 158, 255, 247, 249, 142, 187, 148, 55, 2, 80, 252, 239,
+			243, 197, 45, 180, 39, 245, 128, 129, 191, 62, 111, 238, 123, 114,
+			123, 82, 15, 113, 202, 215, 235, 233, 236, 33, 62, 249, 250, 188,
+			218, 147, 122, 136, 75, 190, 62, 175, 246, 164, 30, 226, 145, 175,
+			207, 239, 173, 16, 109, 245, 96, 37, 191, 233, 151, 72, 91, 61,
+			196, 249, 222, 148, 118, 6, 124, 239, 77, 154, 182, 122, 72, 56,
+			125, 147, 166, 173, 30, 226, 121, 111, 210, 180, 133, 251, 116, 111,
+			121, 138, 104, 171, 7, 180, 245, 22, 77, 91, 61, 68, 91, 111,
+			209, 180, 213, 67, 180, 245, 150, 188, 114, 28, 233, 229, 249, 183,
+			229, 59, 62, 172, 166, 10, 215, 208, 222, 150, 47, 14, 176, 91,
+			153, 109, 247, 98, 170, 222, 158, 55, 69, 121, 38, 121, 213, 86,
+			117, 73, 95, 137, 86, 87, 204, 130, 85, 248, 37, 194, 148, 155,
+			58, 137, 208, 129, 44, 92, 197, 146, 27, 134, 176, 166, 178, 68,
+			92, 66, 95, 122, 105, 54, 223, 174, 17, 216, 75, 206, 223, 111,
+			207, 43, 157, 186, 151, 102, 243, 237, 249, 210, 78, 13, 34, 204,
+			83, 126, 247, 30, 246, 0, 180, 230, 94, 76, 231, 187, 242, 230,
+			209, 242, 223, 165, 150, 32, 21, 241, 248, 41, 50, 4, 161, 56,
+			90, 120, 170, 237, 64, 250, 165, 100, 57, 108, 16, 217, 187, 82,
+			28, 65, 156, 124, 87, 138, 35, 16, 217, 187, 242, 202, 12, 212,
+			75, 68, 246, 174, 188, 50, 3, 245, 154, 70, 17, 95, 149, 25,
+			168, 151, 28, 187, 223, 149, 23, 135, 201, 151, 176, 183, 147, 219,
+			127, 154, 127, 20, 95, 194, 94, 112, 150, 63, 213, 172, 164, 151,
+			56, 75, 22, 52, 21, 248, 95, 161, 13, 247, 226, 164, 232, 189,
+			121, 115, 186, 252, 1, 243, 255, 12, 95, 66, 233, 196, 55, 67,
+			28, 153, 230, 86, 143, 53, 61, 42, 109, 119, 219, 203, 120, 237,
+			177, 71, 113, 219, 235, 165, 51, 204, 247, 166, 51, 14, 145, 228,
+			189, 233, 140, 131, 238, 223, 155, 87, 110, 123, 189, 228, 127, 254,
+			222, 252, 241, 19, 26, 132, 43, 127, 254, 228, 20, 233, 65, 189,
+			242, 209, 251, 247, 230, 159, 126, 19, 113, 223, 94, 100, 126, 223,
+			147, 230, 190, 189, 100, 227, 126, 95, 218, 51, 200, 9, 239, 211,
+			220, 183, 151, 66, 233, 190, 79, 115, 223, 94, 146, 17, 222, 167,
+			185, 111, 47, 6, 117, 247, 47, 145, 251, 246, 146, 119, 227, 221,
+			105, 103, 236, 60, 26, 80, 220, 183, 151, 148, 240, 187, 53, 247,
+			237, 37, 35, 243, 221, 154, 251, 226, 246, 237, 135, 158, 34, 238,
+			219, 11, 238, 251, 33, 205, 125, 123, 137, 251, 126, 72, 115, 223,
+			94, 226, 190, 31, 210, 220, 183, 143, 231, 255, 42, 223, 241, 69,
+			197, 125, 251, 12, 110, 255, 85, 190, 56, 200, 126, 4, 254, 214,
+			7, 246, 123, 15, 248, 219, 67, 235, 248, 27, 177, 208, 167, 158,
+			203, 73, 86, 253, 84, 95, 190, 72, 91, 210, 28, 175, 143, 118,
+			133, 123, 244, 196, 246, 209, 174, 112, 143, 166, 255, 62, 218, 21,
+			238, 209, 28, 175, 143, 246, 248, 123, 52, 199, 235, 51, 59, 96,
+			248, 214, 28, 175, 143, 174, 4, 221, 3, 142, 7, 242, 239, 195,
+			210, 185, 247, 73, 147, 127, 31, 237, 247, 247, 166, 29, 195, 126,
+			127, 175, 38, 255, 62, 218, 239, 239, 213, 228, 223, 71, 172, 248,
+			94, 144, 255, 255, 37, 167, 211, 228, 246, 167, 242, 230, 177, 242,
+			31, 172, 55, 108, 235, 51, 55, 57, 17, 42, 92, 235, 134, 249,
+			222, 212, 220, 205, 54, 181, 119, 63, 81, 115, 55, 219, 196, 222,
+			221, 71, 92, 232, 83, 233, 96, 193, 133, 62, 149, 206, 2, 80,
+			249, 169, 188, 178, 119, 247, 17, 23, 250, 84, 126, 143, 208, 96,
+			17, 95, 247, 142, 233, 175, 5, 128, 149, 163, 236, 6, 194, 132,
+			197, 237, 251, 242, 230, 201, 242, 137, 39, 110, 25, 104, 147, 31,
+			250, 136, 31, 221, 151, 246, 17, 252, 232, 190, 124, 215, 21, 26,
+			52, 0, 30, 184, 90, 131, 212, 238, 241, 19, 36, 13, 246, 97,
+			120, 159, 251, 37, 242, 163, 62, 226, 71, 159, 75, 59, 3, 126,
+			244, 57, 205, 143, 250, 136, 31, 125, 78, 243, 163, 62, 226, 71,
+			159, 211, 252, 8, 55, 234, 191, 240, 20, 241, 163, 62, 240, 163,
+			47, 104, 126, 212, 71, 252, 232, 11, 154, 31, 245, 17, 63, 250,
+			130, 230, 71, 37, 158, 255, 251, 124, 199, 247, 20, 63, 194, 221,
+			246, 191, 207, 23, 183, 209, 214, 81, 2, 59, 250, 114, 254, 201,
+			26, 147, 74, 180, 168, 191, 172, 177, 83, 34, 193, 253, 203, 122,
+			237, 148, 104, 81, 127, 89, 175, 157, 18, 45, 234, 47, 231, 247,
+			238, 99, 255, 205, 162, 182, 97, 86, 204, 155, 99, 217, 27, 104,
+			105, 212, 198, 167, 144, 15, 170, 70, 158, 106, 38, 168, 154, 137,
+			170, 108, 236, 33, 67, 25, 230, 101, 76, 126, 29, 28, 86, 28,
+			77, 130, 43, 31, 27, 211, 241, 252, 211, 199, 95, 229, 17, 222,
+			129, 72, 36, 135, 120, 51, 231, 198, 113, 17, 105, 49, 116, 86,
+			92, 220, 203, 169, 10, 68, 194, 118, 154, 65, 35, 88, 130, 186,
+			140, 82, 203, 129, 19, 214, 149, 3, 101, 148, 121, 24, 59, 16,
+			65, 43, 140, 220, 198, 5, 55, 82, 55, 139, 132, 88, 117, 101,
+			84, 41, 29, 233, 175, 165, 76, 70, 238, 10, 25, 53, 180, 0,
+			182, 10, 187, 89, 205, 83, 174, 27, 218, 105, 250, 156, 114, 34,
+			65, 69, 167, 201, 101, 85, 179, 153, 18, 241, 212, 7, 82, 186,
+			128, 120, 251, 128, 102, 51, 37, 226, 169, 15, 104, 102, 95, 34,
+			158, 250, 64, 94, 69, 174, 41, 145, 120, 251, 64, 94, 69, 174,
+			41, 145, 120, 251, 64, 126, 239, 17, 90, 224, 37, 8, 164, 15,
+			253, 18, 23, 120, 137, 56, 226, 67, 105, 87, 225, 91, 246, 144,
+			94, 224, 37, 50, 20, 61, 164, 23, 120, 137, 56, 226, 67, 122,
+			129, 151, 114, 220, 254, 238, 83, 180, 192, 75, 88, 224, 223, 213,
+			11, 188, 68, 11, 252, 187, 122, 129, 151, 104, 129, 127, 87, 47,
+			240, 126, 158, 255, 65, 190, 227, 53, 5, 185, 192, 17, 179, 225,
+			7, 249, 226, 86, 246, 119, 88, 101, 253, 88, 225, 63, 193, 42,
+			251, 106, 186, 202, 148, 127, 204, 83, 186, 200, 100, 27, 79, 245,
+			26, 83, 30, 50, 255, 231, 45, 177, 126, 98, 189, 63, 209, 116,
+			219, 79, 242, 212, 79, 244, 18, 235, 39, 214, 251, 19, 189, 196,
+			250, 137, 245, 254, 68, 47, 177, 126, 146, 167, 126, 162, 151, 88,
+			63, 201, 83, 63, 193, 18, 251, 159, 16, 106, 250, 113, 217, 224,
+			159, 243, 230, 175, 23, 172, 246, 203, 193, 202, 169, 170, 238, 142,
+			202, 96, 158, 163, 56, 157, 20, 67, 120, 234, 20, 174, 78, 158,
+			47, 110, 152, 155, 59, 7, 243, 94, 3, 14, 36, 195, 114, 242,
+			235, 184, 125, 21, 187, 62, 166, 21, 143, 223, 185, 30, 252, 174,
+			158, 41, 243, 34, 126, 96, 29, 56, 79, 29, 194, 156, 196, 58,
+			173, 28, 230, 174, 159, 152, 3, 113, 44, 16, 234, 225, 84, 197,
+			244, 180, 75, 251, 232, 185, 243, 153, 239, 105, 115, 58, 83, 246,
+			234, 64, 246, 4, 224, 220, 244, 236, 156, 70, 38, 93, 167, 248,
+			231, 188, 58, 197, 236, 199, 109, 9, 110, 255, 34, 175, 78, 205,
+			250, 213, 237, 137, 95, 228, 213, 169, 25, 37, 152, 72, 40, 239,
+			100, 151, 171, 18, 136, 25, 83, 176, 7, 43, 91, 104, 40, 208,
+			47, 211, 190, 176, 164, 24, 88, 223, 29, 5, 245, 112, 122, 191,
+			114, 229, 191, 163, 176, 109, 59, 187, 70, 213, 99, 114, 251, 101,
+			5, 123, 160, 114, 32, 139, 58, 48, 236, 244, 233, 11, 68, 228,
+			83, 167, 178, 81, 90, 53, 88, 213, 203, 10, 118, 111, 154, 64,
+			85, 245, 227, 9, 118, 48, 2, 131, 231, 95, 94, 48, 95, 81,
+			184, 66, 205, 58, 120, 244, 203, 11, 9, 1, 65, 238, 125, 121,
+			65, 29, 212, 245, 19, 143, 126, 121, 225, 178, 81, 13, 90, 248,
+			170, 98, 238, 246, 19, 143, 126, 69, 33, 191, 95, 131, 5, 110,
+			191, 162, 80, 184, 156, 132, 138, 126, 240, 232, 87, 22, 158, 172,
+			80, 209, 79, 28, 249, 149, 105, 199, 192, 145, 95, 89, 80, 66,
+			69, 63, 113, 228, 87, 22, 148, 80, 209, 79, 28, 249, 149, 5,
+			117, 141, 172, 31, 208, 171, 11, 191, 188, 237, 161, 159, 132, 209,
+			87, 167, 157, 129, 48, 250, 234, 130, 218, 30, 250, 73, 57, 126,
+			117, 65, 109, 15, 253, 164, 28, 191, 186, 160, 182, 135, 126, 88,
+			154, 11, 79, 205, 246, 208, 143, 237, 225, 206, 130, 218, 30, 250,
+			105, 123, 184, 179, 160, 182, 135, 126, 218, 30, 238, 44, 108, 29,
+			100, 191, 109, 33, 254, 49, 207, 191, 161, 208, 241, 167, 5, 163,
+			252, 51, 83, 156, 210, 149, 166, 151, 51, 192, 201, 28, 213, 147,
+			244, 166, 74, 152, 58, 3, 36, 88, 82, 174, 102, 112, 49, 163,
+			224, 194, 174, 19, 130, 117, 38, 163, 32, 219, 140, 126, 190, 81,
+			199, 195, 76, 162, 67, 156, 56, 113, 78, 86, 172, 162, 140, 171,
+			7, 151, 112, 245, 251, 92, 16, 52, 244, 99, 70, 145, 98, 171,
+			164, 225, 208, 213, 76, 116, 48, 205, 171, 34, 179, 69, 213, 182,
+			120, 145, 235, 186, 224, 249, 162, 190, 161, 132, 124, 142, 65, 132,
+			46, 130, 254, 75, 55, 206, 180, 218, 19, 39, 84, 21, 67, 195,
+			146, 83, 53, 195, 160, 222, 170, 109, 204, 54, 30, 52, 215, 230,
+			130, 161, 225, 97, 117, 20, 8, 247, 91, 185, 46, 207, 103, 95,
+			59, 81, 193, 103, 152, 34, 247, 21, 245, 18, 0, 135, 179, 122,
+			161, 184, 147, 125, 10, 6, 54, 142, 35, 239, 187, 10, 230, 31,
+			20, 172, 242, 7, 225, 110, 162, 130, 25, 41, 94, 213, 246, 124,
+			138, 26, 98, 85, 200, 23, 114, 84, 160, 232, 100, 22, 35, 138,
+			151, 190, 4, 22, 74, 183, 77, 28, 81, 15, 226, 81, 29, 65,
+			189, 174, 195, 87, 120, 209, 124, 66, 104, 216, 97, 201, 226, 235,
+			45, 46, 102, 74, 103, 171, 244, 89, 74, 151, 98, 168, 238, 250,
+			65, 162, 203, 202, 55, 200, 33, 100, 182, 209, 0, 244, 236, 104,
+			253, 37, 239, 225, 42, 19, 19, 213, 165, 234, 200, 139, 196, 243,
+			42, 139, 65, 80, 25, 145, 151, 106, 158, 63, 34, 158, 87, 89,
+			112, 194, 234, 130, 115, 123, 101, 132, 58, 67, 73, 43, 153, 44,
+			226, 197, 153, 30, 49, 129, 226, 213, 33, 85, 102, 184, 138, 156,
+			106, 177, 114, 242, 23, 184, 171, 192, 56, 249, 11, 112, 233, 47,
+			240, 214, 130, 93, 33, 150, 200, 149, 191, 192, 91, 11, 234, 80,
+			140, 171, 39, 5, 223, 90, 80, 39, 131, 92, 249, 11, 188, 181,
+			176, 101, 87, 154, 96, 33, 65, 236, 77, 234, 52, 184, 253, 182,
+			130, 189, 47, 201, 0, 62, 250, 182, 108, 157, 144, 118, 223, 134,
+			197, 152, 36, 80, 17, 190, 59, 77, 176, 144, 176, 87, 198, 186,
+			228, 232, 229, 31, 22, 76, 249, 236, 21, 167, 141, 253, 15, 53,
+			199, 225, 164, 83, 253, 97, 161, 107, 64, 131, 6, 50, 111, 25,
+			212, 153, 45, 128, 59, 47, 99, 119, 97, 235, 230, 96, 142, 127,
+			92, 48, 15, 148, 239, 52, 50, 175, 88, 63, 10, 53, 141, 96,
+			166, 16, 77, 156, 168, 24, 7, 80, 148, 53, 198, 189, 104, 239,
+			118, 28, 90, 122, 250, 5, 170, 58, 24, 167, 131, 107, 210, 68,
+			120, 202, 205, 182, 42, 38, 84, 76, 30, 188, 7, 34, 91, 138,
+			18, 195, 198, 130, 11, 183, 55, 61, 63, 164, 22, 252, 113, 58,
+			52, 32, 234, 143, 11, 234, 30, 7, 167, 45, 231, 143, 11, 42,
+			230, 28, 39, 36, 253, 113, 97, 255, 21, 10, 73, 38, 183, 255,
+			164, 160, 188, 76, 56, 237, 17, 127, 146, 214, 4, 59, 198, 159,
+			164, 53, 1, 13, 127, 82, 224, 151, 107, 208, 2, 168, 206, 53,
+			57, 246, 136, 119, 20, 204, 33, 245, 17, 12, 254, 29, 105, 77,
+			240, 240, 125, 71, 65, 221, 217, 228, 196, 224, 223, 81, 232, 223,
+			167, 65, 42, 123, 197, 1, 85, 147, 205, 237, 119, 22, 212, 99,
+			162, 156, 76, 5, 239, 76, 107, 130, 127, 236, 59, 211, 62, 193,
+			84, 240, 206, 130, 10, 37, 198, 201, 84, 240, 206, 148, 4, 96,
+			255, 47, 168, 103, 210, 56, 93, 164, 126, 87, 90, 19, 156, 68,
+			223, 149, 246, 41, 135, 211, 129, 66, 191, 166, 23, 56, 137, 190,
+			171, 32, 246, 170, 154, 242, 220, 126, 119, 65, 189, 140, 199, 233,
+			22, 244, 187, 211, 154, 224, 211, 249, 238, 180, 79, 240, 55, 122,
+			119, 129, 239, 213, 160, 5, 240, 242, 253, 236, 43, 216, 54, 6,
+			120, 254, 125, 133, 142, 191, 44, 26, 229, 159, 154, 210, 44, 62,
+			129, 35, 12, 117, 111, 83, 44, 201, 139, 21, 98, 8, 50, 152,
+			20, 115, 60, 167, 209, 208, 49, 161, 116, 92, 138, 104, 24, 138,
+			173, 60, 179, 134, 137, 93, 68, 181, 160, 169, 222, 234, 82, 81,
+			116, 148, 7, 157, 155, 121, 247, 75, 201, 12, 116, 139, 72, 93,
+			37, 166, 205, 19, 59, 199, 50, 222, 74, 143, 151, 229, 93, 64,
+			186, 153, 171, 110, 117, 68, 203, 240, 60, 1, 159, 67, 176, 228,
+			3, 23, 146, 199, 149, 86, 156, 139, 20, 177, 157, 60, 241, 71,
+			241, 196, 14, 57, 55, 215, 29, 25, 8, 139, 100, 117, 4, 152,
+			185, 224, 138, 86, 4, 89, 114, 77, 222, 18, 166, 51, 46, 184,
+			86, 215, 92, 223, 9, 189, 64, 94, 45, 147, 87, 127, 117, 15,
+			177, 7, 80, 8, 166, 186, 171, 78, 240, 129, 143, 68, 154, 149,
+			242, 60, 5, 93, 192, 209, 137, 23, 141, 136, 229, 160, 233, 46,
+			182, 128, 169, 84, 246, 167, 213, 183, 140, 245, 149, 137, 68, 68,
+			34, 9, 27, 155, 254, 229, 60, 177, 118, 157, 18, 195, 228, 54,
+			132, 232, 114, 239, 43, 20, 193, 41, 109, 123, 0, 18, 255, 95,
+			22, 204, 15, 21, 164, 227, 213, 0, 201, 195, 127, 89, 40, 108,
+			33, 166, 55, 0, 113, 151, 219, 239, 47, 40, 191, 179, 1, 37,
+			15, 191, 191, 160, 252, 206, 6, 148, 60, 252, 254, 130, 242, 59,
+			67, 2, 44, 245, 5, 187, 63, 201, 128, 213, 125, 183, 102, 147,
+			3, 74, 242, 189, 187, 208, 87, 74, 74, 152, 220, 254, 64, 182,
+			4, 86, 241, 7, 178, 37, 32, 88, 126, 32, 91, 2, 207, 29,
+			23, 236, 193, 36, 3, 86, 235, 7, 181, 116, 77, 9, 8, 140,
+			3, 233, 154, 134, 105, 118, 240, 252, 135, 11, 230, 199, 11, 182,
+			28, 38, 177, 218, 15, 235, 213, 49, 64, 172, 246, 195, 5, 245,
+			136, 224, 0, 177, 218, 15, 23, 118, 28, 208, 160, 197, 237, 15,
+			23, 14, 30, 98, 125, 242, 107, 17, 149, 141, 124, 188, 96, 201,
+			1, 152, 29, 197, 126, 110, 127, 164, 160, 188, 211, 6, 204, 142,
+			226, 64, 7, 183, 255, 42, 193, 26, 18, 240, 62, 114, 193, 222,
+			205, 74, 170, 196, 54, 158, 255, 207, 5, 251, 158, 66, 46, 201,
+			178, 5, 143, 4, 20, 236, 137, 52, 193, 224, 246, 127, 105, 75,
+			48, 185, 125, 111, 193, 30, 87, 83, 103, 240, 252, 39, 10, 230,
+			223, 36, 83, 7, 60, 127, 162, 80, 232, 83, 72, 34, 231, 170,
+			79, 166, 51, 37, 157, 142, 62, 89, 80, 142, 140, 3, 234, 162,
+			218, 39, 11, 202, 45, 17, 9, 176, 2, 23, 212, 125, 177, 1,
+			117, 27, 237, 83, 5, 117, 255, 120, 64, 221, 70, 251, 84, 65,
+			221, 63, 70, 130, 201, 237, 79, 23, 236, 190, 36, 3, 166, 238,
+			211, 5, 117, 217, 124, 64, 93, 57, 251, 116, 161, 167, 87, 77,
+			132, 193, 243, 247, 21, 204, 47, 37, 19, 129, 141, 225, 190, 116,
+			34, 160, 139, 220, 167, 247, 188, 1, 218, 24, 238, 195, 158, 172,
+			64, 152, 124, 193, 240, 228, 68, 24, 69, 84, 86, 249, 82, 50,
+			17, 6, 38, 226, 51, 233, 68, 24, 52, 17, 159, 5, 222, 147,
+			4, 131, 219, 159, 75, 39, 194, 40, 110, 227, 249, 207, 23, 236,
+			47, 38, 19, 97, 208, 68, 252, 29, 208, 156, 36, 192, 114, 90,
+			176, 79, 41, 188, 155, 60, 255, 229, 130, 249, 181, 4, 239, 24,
+			242, 151, 11, 133, 203, 20, 78, 200, 79, 250, 43, 5, 123, 72,
+			161, 192, 36, 188, 127, 69, 11, 14, 3, 202, 79, 250, 43, 133,
+			43, 14, 36, 37, 224, 41, 155, 98, 81, 250, 73, 127, 53, 197,
+			162, 244, 147, 254, 170, 196, 34, 22, 0, 185, 185, 222, 159, 46,
+			25, 233, 39, 125, 127, 186, 100, 76, 149, 131, 150, 12, 22, 128,
+			201, 243, 95, 47, 152, 223, 78, 240, 142, 109, 244, 235, 41, 222,
+			161, 106, 125, 189, 160, 158, 96, 29, 160, 218, 190, 94, 216, 251,
+			52, 13, 194, 137, 182, 112, 242, 233, 10, 239, 102, 17, 149, 93,
+			251, 237, 4, 239, 38, 240, 254, 141, 20, 239, 38, 225, 253, 191,
+			167, 11, 192, 164, 5, 240, 15, 41, 222, 77, 224, 253, 91, 5,
+			251, 193, 4, 239, 38, 225, 253, 31, 83, 122, 55, 105, 1, 60,
+			148, 210, 187, 197, 243, 223, 45, 152, 255, 51, 193, 59, 214, 252,
+			119, 11, 133, 173, 10, 39, 228, 128, 252, 189, 130, 45, 20, 10,
+			164, 3, 242, 247, 10, 202, 159, 117, 64, 57, 32, 127, 175, 176,
+			123, 79, 82, 2, 207, 178, 166, 120, 151, 14, 200, 223, 79, 241,
+			46, 29, 144, 191, 159, 226, 157, 28, 144, 127, 144, 174, 16, 139,
+			48, 245, 131, 116, 133, 88, 132, 247, 31, 20, 212, 177, 250, 128,
+			13, 33, 227, 127, 160, 9, 85, 129, 221, 1, 184, 167, 87, 231,
+			39, 134, 219, 158, 96, 170, 4, 26, 181, 105, 241, 252, 195, 5,
+			243, 167, 201, 196, 161, 194, 135, 211, 137, 131, 90, 250, 112, 65,
+			121, 99, 13, 144, 212, 242, 112, 65, 121, 99, 13, 144, 90, 250,
+			112, 97, 100, 84, 77, 156, 85, 68, 101, 213, 159, 38, 19, 103,
+			97, 226, 254, 41, 157, 56, 139, 38, 238, 135, 233, 196, 89, 52,
+			113, 143, 164, 19, 103, 97, 226, 254, 87, 193, 254, 113, 50, 113,
+			22, 77, 220, 79, 176, 62, 146, 4, 131, 219, 255, 59, 157, 56,
+			155, 231, 127, 94, 48, 95, 86, 212, 19, 7, 129, 232, 231, 5,
+			117, 177, 96, 192, 182, 49, 113, 255, 156, 116, 2, 48, 140, 50,
+			5, 101, 132, 161, 12, 8, 123, 80, 216, 35, 146, 18, 6, 183,
+			127, 81, 176, 7, 89, 31, 251, 127, 217, 123, 23, 240, 184, 174,
+			235, 60, 20, 231, 129, 225, 204, 38, 94, 60, 4, 64, 242, 136,
+			143, 205, 17, 41, 0, 20, 48, 32, 193, 135, 36, 82, 178, 13,
+			2, 144, 4, 27, 124, 8, 36, 229, 87, 44, 232, 96, 230, 0,
+			56, 226, 96, 14, 52, 103, 134, 20, 236, 171, 196, 81, 226, 92,
+			63, 210, 196, 81, 234, 60, 235, 184, 114, 18, 167, 170, 219, 60,
+			220, 166, 105, 210, 124, 78, 236, 164, 137, 227, 212, 110, 94, 246,
+			205, 181, 19, 167, 169, 19, 187, 81, 26, 223, 230, 186, 113, 26,
+			223, 52, 189, 223, 191, 246, 218, 251, 156, 25, 12, 72, 218, 117,
+			210, 47, 247, 250, 211, 247, 137, 216, 123, 246, 217, 143, 181, 215,
+			94, 123, 237, 245, 84, 95, 96, 227, 254, 71, 74, 255, 93, 218,
+			184, 255, 161, 232, 63, 224, 238, 98, 91, 222, 156, 119, 61, 211,
+			0, 93, 188, 57, 111, 118, 18, 158, 5, 168, 24, 216, 197, 7,
+			198, 245, 114, 207, 229, 237, 119, 228, 53, 220, 193, 227, 61, 151,
+			55, 112, 135, 58, 232, 185, 60, 187, 22, 236, 38, 117, 208, 115,
+			249, 187, 238, 213, 69, 7, 191, 150, 38, 25, 238, 110, 30, 157,
+			29, 127, 71, 94, 195, 221, 5, 220, 191, 37, 111, 224, 238, 18,
+			220, 191, 53, 111, 224, 238, 18, 220, 223, 146, 55, 112, 119, 1,
+			247, 255, 61, 239, 190, 45, 175, 225, 238, 18, 220, 223, 158, 119,
+			23, 24, 204, 221, 94, 238, 31, 228, 237, 239, 50, 96, 238, 134,
+			104, 32, 191, 99, 23, 131, 160, 27, 96, 254, 142, 188, 129, 106,
+			55, 129, 249, 59, 242, 44, 218, 162, 6, 54, 90, 176, 65, 56,
+			42, 96, 124, 150, 103, 193, 18, 149, 85, 69, 33, 173, 128, 61,
+			90, 190, 167, 215, 124, 1, 195, 177, 188, 57, 114, 221, 116, 62,
+			222, 153, 103, 19, 114, 124, 1, 48, 191, 51, 191, 207, 103, 48,
+			119, 123, 185, 231, 243, 246, 187, 13, 152, 193, 0, 63, 159, 130,
+			185, 59, 135, 34, 139, 128, 118, 83, 36, 161, 231, 243, 195, 135,
+			117, 209, 193, 175, 71, 142, 50, 152, 187, 243, 232, 236, 158, 119,
+			27, 48, 119, 3, 204, 223, 157, 130, 185, 155, 192, 252, 15, 91,
+			42, 96, 98, 150, 55, 23, 68, 119, 126, 55, 194, 33, 164, 112,
+			239, 6, 220, 191, 47, 239, 254, 128, 129, 123, 55, 193, 253, 7,
+			243, 238, 165, 180, 2, 175, 207, 188, 123, 94, 49, 88, 5, 207,
+			125, 79, 222, 238, 39, 174, 124, 55, 140, 110, 222, 147, 223, 217,
+			167, 102, 76, 70, 55, 217, 162, 109, 138, 142, 187, 187, 219, 203,
+			189, 144, 183, 63, 144, 119, 213, 167, 144, 244, 188, 144, 239, 229,
+			163, 77, 146, 158, 23, 242, 44, 233, 217, 77, 146, 158, 23, 242,
+			67, 48, 59, 167, 95, 29, 124, 236, 127, 32, 239, 136, 93, 152,
+			85, 119, 23, 232, 97, 238, 189, 121, 247, 125, 249, 238, 180, 202,
+			242, 114, 63, 150, 119, 223, 175, 23, 131, 42, 219, 115, 255, 25,
+			22, 99, 218, 56, 94, 238, 159, 231, 221, 159, 202, 119, 139, 187,
+			177, 28, 100, 228, 202, 219, 126, 113, 152, 188, 203, 140, 184, 188,
+			17, 38, 13, 120, 251, 242, 92, 145, 168, 43, 157, 43, 142, 227,
+			191, 76, 231, 138, 195, 248, 47, 49, 215, 179, 220, 225, 79, 231,
+			237, 221, 197, 9, 146, 219, 234, 156, 142, 171, 81, 99, 173, 185,
+			92, 42, 199, 235, 147, 203, 205, 21, 146, 254, 76, 154, 164, 157,
+			97, 162, 199, 177, 61, 247, 167, 243, 189, 30, 143, 99, 91, 173,
+			69, 253, 235, 63, 179, 133, 237, 14, 122, 185, 159, 207, 119, 125,
+			50, 111, 249, 239, 134, 244, 139, 133, 81, 21, 35, 126, 76, 21,
+			11, 25, 233, 112, 194, 129, 102, 66, 74, 67, 197, 188, 190, 73,
+			189, 109, 210, 220, 42, 191, 209, 212, 7, 153, 220, 181, 152, 209,
+			134, 117, 157, 17, 157, 40, 239, 154, 182, 96, 30, 73, 8, 183,
+			32, 253, 144, 32, 233, 87, 83, 39, 200, 205, 150, 51, 166, 3,
+			74, 144, 101, 76, 190, 17, 224, 4, 47, 13, 153, 132, 65, 189,
+			188, 150, 106, 95, 202, 213, 56, 9, 225, 146, 28, 52, 202, 148,
+			195, 130, 101, 172, 227, 28, 54, 87, 73, 181, 116, 102, 135, 186,
+			10, 15, 11, 180, 197, 101, 251, 243, 249, 188, 47, 126, 18, 226,
+			169, 65, 136, 167, 62, 148, 183, 255, 93, 222, 241, 223, 3, 232,
+			33, 254, 32, 45, 18, 15, 150, 77, 78, 52, 204, 125, 235, 200,
+			169, 205, 90, 244, 52, 76, 27, 20, 236, 12, 64, 225, 244, 221,
+			72, 132, 169, 32, 95, 37, 114, 215, 230, 239, 211, 148, 184, 202,
+			150, 33, 172, 176, 80, 150, 48, 46, 144, 171, 209, 141, 208, 12,
+			166, 50, 196, 164, 189, 7, 141, 91, 174, 91, 198, 117, 149, 196,
+			38, 104, 64, 180, 199, 15, 175, 168, 97, 188, 102, 121, 91, 244,
+			179, 148, 157, 176, 56, 10, 47, 156, 185, 41, 28, 174, 12, 146,
+			178, 214, 146, 161, 67, 0, 145, 71, 96, 25, 199, 32, 201, 160,
+			62, 148, 23, 69, 34, 62, 131, 16, 32, 121, 238, 135, 53, 173,
+			25, 100, 25, 212, 135, 243, 204, 163, 13, 178, 207, 202, 135, 243,
+			108, 152, 61, 200, 50, 168, 15, 231, 217, 103, 101, 144, 125, 86,
+			62, 156, 63, 36, 197, 69, 238, 19, 111, 130, 188, 123, 194, 127,
+			153, 142, 246, 148, 100, 241, 84, 189, 167, 25, 219, 216, 58, 188,
+			130, 88, 6, 108, 190, 158, 78, 89, 15, 0, 254, 251, 223, 102,
+			231, 4, 14, 252, 223, 230, 123, 247, 164, 21, 52, 228, 222, 241,
+			180, 194, 65, 197, 228, 113, 113, 153, 231, 100, 123, 238, 71, 242,
+			238, 189, 254, 43, 110, 61, 167, 145, 198, 29, 207, 10, 220, 233,
+			71, 178, 179, 2, 127, 250, 145, 236, 172, 112, 242, 63, 146, 223,
+			123, 79, 90, 225, 160, 98, 236, 152, 222, 140, 130, 231, 126, 20,
+			247, 61, 111, 6, 232, 240, 71, 243, 204, 102, 81, 217, 106, 175,
+			176, 185, 66, 127, 65, 13, 6, 118, 153, 6, 214, 150, 10, 155,
+			43, 120, 72, 225, 185, 191, 158, 119, 247, 234, 33, 145, 201, 230,
+			215, 49, 103, 80, 174, 65, 96, 195, 199, 242, 182, 210, 188, 13,
+			210, 3, 244, 99, 250, 158, 27, 164, 7, 232, 199, 242, 59, 239,
+			214, 69, 11, 141, 143, 140, 235, 162, 131, 226, 228, 113, 241, 28,
+			100, 125, 131, 88, 251, 111, 230, 237, 17, 191, 65, 210, 15, 4,
+			186, 95, 111, 174, 107, 59, 155, 176, 162, 1, 42, 71, 41, 142,
+			25, 66, 5, 140, 105, 179, 33, 4, 112, 8, 40, 176, 128, 10,
+			20, 135, 152, 22, 38, 62, 145, 62, 30, 170, 153, 118, 119, 33,
+			9, 183, 57, 188, 122, 198, 64, 156, 223, 76, 23, 0, 180, 249,
+			205, 252, 78, 79, 23, 105, 138, 187, 139, 186, 8, 243, 152, 252,
+			209, 123, 196, 183, 169, 5, 216, 158, 251, 9, 44, 224, 25, 181,
+			0, 72, 105, 154, 235, 109, 68, 229, 206, 38, 111, 230, 46, 50,
+			190, 46, 109, 115, 175, 135, 213, 136, 220, 51, 182, 44, 2, 120,
+			246, 137, 116, 17, 192, 178, 79, 164, 139, 0, 156, 63, 145, 46,
+			2, 226, 195, 79, 96, 17, 159, 178, 132, 237, 14, 121, 185, 223,
+			207, 119, 125, 166, 96, 249, 79, 201, 185, 90, 57, 216, 72, 56,
+			7, 124, 212, 209, 8, 202, 196, 248, 226, 0, 116, 16, 142, 43,
+			179, 87, 142, 55, 36, 228, 195, 81, 53, 76, 213, 13, 164, 1,
+			166, 189, 50, 183, 76, 73, 76, 61, 249, 181, 204, 186, 159, 206,
+			134, 228, 121, 152, 184, 186, 11, 134, 224, 135, 153, 207, 15, 139,
+			231, 118, 9, 215, 29, 2, 234, 190, 173, 96, 31, 246, 191, 56,
+			32, 167, 229, 66, 172, 163, 8, 104, 249, 112, 34, 3, 185, 17,
+			133, 42, 54, 71, 107, 143, 50, 200, 106, 3, 16, 231, 2, 151,
+			21, 69, 55, 72, 54, 98, 164, 239, 162, 240, 72, 153, 188, 96,
+			105, 226, 127, 77, 146, 179, 0, 133, 113, 0, 226, 192, 80, 92,
+			23, 149, 194, 191, 153, 64, 168, 38, 27, 177, 156, 159, 157, 75,
+			198, 245, 200, 149, 240, 153, 176, 158, 140, 183, 101, 8, 96, 88,
+			198, 248, 5, 23, 115, 18, 173, 71, 213, 160, 142, 248, 26, 49,
+			57, 135, 203, 135, 211, 164, 211, 227, 50, 9, 54, 33, 164, 35,
+			44, 66, 100, 240, 106, 152, 6, 41, 222, 54, 244, 124, 80, 53,
+			185, 60, 227, 216, 68, 28, 126, 86, 200, 133, 144, 162, 228, 199,
+			241, 117, 228, 157, 127, 10, 119, 76, 106, 199, 151, 174, 251, 172,
+			184, 77, 87, 79, 112, 248, 226, 39, 158, 48, 255, 60, 129, 127,
+			158, 192, 135, 1, 255, 184, 92, 166, 127, 42, 161, 148, 43, 82,
+			174, 174, 69, 2, 22, 67, 38, 225, 190, 201, 47, 40, 171, 188,
+			159, 42, 146, 115, 178, 17, 212, 164, 164, 92, 130, 220, 145, 254,
+			47, 171, 138, 145, 242, 245, 193, 120, 52, 38, 229, 235, 229, 169,
+			113, 121, 124, 92, 78, 141, 203, 227, 242, 13, 104, 70, 39, 250,
+			230, 90, 92, 221, 186, 176, 18, 127, 184, 220, 246, 225, 184, 60,
+			133, 111, 241, 97, 53, 88, 14, 171, 114, 84, 175, 30, 218, 35,
+			41, 95, 95, 30, 175, 108, 249, 228, 180, 254, 132, 84, 23, 163,
+			10, 76, 220, 62, 28, 95, 217, 210, 254, 132, 110, 15, 49, 177,
+			28, 93, 137, 99, 110, 188, 58, 190, 182, 165, 241, 73, 211, 152,
+			44, 60, 228, 232, 137, 49, 29, 101, 5, 96, 154, 144, 211, 6,
+			108, 28, 109, 112, 5, 164, 39, 206, 134, 70, 230, 128, 57, 141,
+			36, 172, 174, 200, 209, 168, 20, 150, 116, 8, 104, 74, 215, 43,
+			179, 72, 79, 232, 170, 243, 250, 71, 141, 49, 131, 250, 236, 16,
+			7, 250, 7, 198, 43, 229, 76, 57, 20, 30, 241, 161, 88, 70,
+			53, 94, 229, 80, 59, 97, 141, 120, 64, 14, 162, 200, 113, 240,
+			232, 80, 176, 154, 175, 36, 91, 145, 28, 86, 196, 20, 75, 79,
+			232, 140, 8, 20, 38, 175, 124, 93, 142, 110, 196, 73, 18, 45,
+			87, 55, 53, 103, 6, 124, 49, 161, 108, 82, 245, 96, 186, 197,
+			201, 24, 203, 197, 165, 113, 34, 87, 1, 159, 12, 184, 110, 66,
+			50, 175, 240, 171, 30, 174, 176, 245, 12, 142, 65, 49, 229, 153,
+			139, 6, 138, 232, 40, 13, 233, 6, 235, 88, 132, 35, 5, 180,
+			74, 216, 134, 11, 122, 46, 186, 251, 36, 181, 149, 208, 78, 42,
+			52, 150, 6, 232, 26, 116, 215, 72, 102, 191, 22, 166, 208, 201,
+			192, 143, 211, 223, 27, 98, 147, 108, 64, 93, 64, 102, 185, 65,
+			185, 30, 39, 73, 186, 124, 164, 175, 210, 47, 3, 185, 30, 39,
+			100, 244, 19, 47, 223, 136, 226, 102, 162, 129, 139, 13, 76, 215,
+			86, 41, 50, 92, 41, 10, 177, 201, 238, 175, 185, 95, 221, 115,
+			251, 54, 112, 230, 53, 94, 13, 105, 81, 198, 89, 7, 178, 194,
+			183, 7, 96, 222, 105, 213, 173, 168, 58, 146, 168, 227, 173, 131,
+			26, 169, 232, 219, 129, 76, 154, 203, 140, 85, 81, 131, 210, 21,
+			135, 181, 6, 183, 85, 184, 162, 102, 196, 232, 146, 89, 79, 24,
+			22, 129, 139, 65, 198, 132, 161, 5, 128, 218, 80, 64, 241, 232,
+			232, 101, 57, 92, 141, 106, 53, 246, 28, 233, 4, 25, 168, 80,
+			144, 191, 49, 0, 207, 190, 188, 169, 158, 9, 6, 49, 248, 136,
+			160, 39, 124, 76, 208, 36, 68, 120, 37, 136, 169, 206, 91, 31,
+			116, 90, 113, 118, 153, 73, 188, 174, 147, 168, 183, 181, 68, 207,
+			198, 250, 97, 61, 12, 106, 28, 177, 180, 161, 186, 128, 101, 65,
+			88, 171, 4, 29, 14, 145, 44, 174, 214, 227, 230, 70, 145, 205,
+			60, 18, 244, 180, 28, 35, 44, 168, 162, 80, 184, 109, 2, 198,
+			229, 236, 201, 204, 192, 43, 155, 150, 220, 76, 140, 58, 74, 47,
+			76, 128, 142, 15, 140, 233, 86, 117, 202, 105, 118, 163, 58, 153,
+			93, 196, 181, 52, 88, 26, 30, 2, 213, 96, 131, 0, 53, 147,
+			38, 208, 108, 212, 217, 23, 198, 40, 135, 211, 65, 181, 26, 119,
+			163, 30, 47, 7, 32, 0, 89, 61, 23, 66, 91, 73, 54, 227,
+			147, 13, 58, 207, 26, 74, 218, 188, 70, 5, 10, 71, 26, 142,
+			113, 196, 164, 64, 176, 173, 52, 126, 70, 58, 74, 25, 186, 98,
+			116, 182, 140, 103, 47, 18, 82, 167, 86, 66, 43, 205, 140, 239,
+			248, 16, 49, 204, 111, 43, 48, 171, 54, 68, 12, 243, 219, 10,
+			172, 40, 24, 34, 81, 214, 219, 10, 131, 251, 117, 17, 33, 46,
+			11, 36, 47, 116, 221, 33, 188, 101, 223, 94, 176, 127, 191, 160,
+			36, 99, 67, 244, 82, 123, 123, 65, 120, 226, 237, 59, 68, 14,
+			191, 131, 195, 121, 111, 193, 29, 247, 255, 42, 39, 231, 83, 182,
+			70, 193, 9, 116, 90, 163, 235, 118, 60, 154, 182, 112, 98, 183,
+			117, 97, 214, 136, 107, 99, 46, 19, 187, 84, 225, 17, 187, 75,
+			169, 203, 37, 174, 167, 68, 141, 179, 203, 82, 214, 109, 176, 69,
+			141, 53, 98, 18, 213, 155, 183, 30, 199, 141, 142, 51, 96, 132,
+			32, 138, 196, 121, 138, 249, 141, 204, 100, 89, 155, 236, 148, 68,
+			43, 214, 18, 163, 143, 81, 232, 238, 167, 203, 252, 36, 93, 230,
+			247, 141, 203, 19, 242, 13, 34, 37, 209, 212, 0, 12, 80, 137,
+			249, 158, 37, 108, 231, 232, 201, 49, 41, 39, 39, 233, 59, 157,
+			86, 161, 68, 139, 27, 189, 111, 140, 203, 212, 0, 93, 154, 6,
+			184, 129, 71, 211, 159, 169, 193, 9, 142, 4, 23, 37, 230, 24,
+			119, 88, 105, 203, 224, 42, 189, 80, 22, 146, 167, 104, 150, 230,
+			6, 110, 7, 83, 203, 199, 15, 201, 83, 8, 26, 87, 219, 210,
+			76, 205, 127, 107, 231, 83, 173, 157, 147, 61, 119, 251, 8, 58,
+			23, 196, 20, 119, 221, 169, 17, 45, 127, 107, 247, 39, 58, 178,
+			125, 212, 150, 115, 75, 164, 180, 129, 12, 194, 20, 118, 64, 54,
+			146, 180, 16, 12, 118, 176, 48, 14, 121, 136, 145, 176, 34, 111,
+			134, 218, 219, 88, 164, 62, 232, 140, 147, 91, 54, 31, 59, 111,
+			80, 163, 237, 154, 110, 101, 237, 82, 90, 63, 154, 70, 44, 210,
+			52, 94, 232, 83, 163, 184, 58, 238, 64, 197, 147, 213, 150, 242,
+			235, 81, 57, 174, 198, 181, 49, 126, 252, 15, 177, 152, 228, 189,
+			5, 126, 252, 15, 177, 169, 206, 123, 11, 189, 94, 90, 1, 79,
+			210, 194, 238, 61, 105, 5, 124, 73, 11, 254, 93, 105, 69, 30,
+			21, 251, 239, 21, 3, 34, 207, 21, 54, 106, 14, 28, 19, 191,
+			101, 243, 153, 183, 60, 247, 69, 156, 249, 15, 217, 58, 17, 5,
+			54, 69, 39, 154, 106, 172, 193, 198, 149, 162, 255, 55, 235, 134,
+			221, 58, 43, 147, 6, 200, 65, 53, 130, 51, 191, 250, 187, 28,
+			87, 155, 235, 181, 113, 33, 195, 90, 133, 126, 72, 249, 215, 113,
+			117, 203, 32, 109, 54, 28, 253, 155, 235, 97, 69, 221, 226, 65,
+			146, 233, 104, 12, 233, 155, 42, 220, 15, 60, 17, 201, 144, 38,
+			168, 155, 232, 204, 202, 145, 81, 223, 24, 10, 246, 16, 248, 233,
+			120, 203, 229, 205, 146, 204, 134, 0, 140, 106, 161, 194, 63, 213,
+			37, 163, 24, 241, 138, 242, 141, 97, 61, 158, 80, 214, 183, 19,
+			19, 153, 8, 141, 155, 113, 83, 221, 22, 55, 57, 187, 81, 80,
+			169, 8, 24, 81, 199, 138, 215, 96, 209, 64, 37, 74, 54, 170,
+			193, 38, 95, 67, 1, 216, 83, 29, 176, 122, 136, 197, 73, 47,
+			102, 247, 14, 150, 62, 47, 102, 247, 14, 82, 148, 23, 179, 123,
+			7, 107, 159, 23, 179, 123, 7, 19, 211, 23, 179, 123, 103, 33,
+			80, 205, 139, 133, 3, 199, 196, 143, 246, 240, 222, 217, 158, 251,
+			169, 130, 59, 230, 255, 195, 30, 32, 55, 225, 234, 21, 122, 120,
+			226, 126, 131, 27, 82, 230, 185, 34, 3, 99, 125, 158, 197, 216,
+			113, 54, 88, 2, 80, 136, 35, 47, 199, 235, 204, 82, 19, 177,
+			140, 82, 27, 237, 160, 150, 141, 73, 145, 197, 122, 126, 188, 211,
+			7, 0, 21, 162, 135, 4, 141, 70, 80, 94, 83, 246, 31, 109,
+			237, 113, 27, 76, 35, 77, 117, 196, 55, 33, 176, 165, 195, 184,
+			8, 197, 29, 215, 146, 176, 220, 132, 67, 23, 53, 75, 198, 137,
+			249, 145, 181, 88, 225, 20, 158, 172, 215, 195, 90, 219, 87, 234,
+			54, 54, 237, 149, 237, 61, 130, 5, 130, 100, 5, 73, 138, 68,
+			60, 40, 38, 84, 13, 3, 8, 54, 151, 42, 161, 154, 247, 146,
+			153, 16, 225, 3, 194, 122, 130, 41, 12, 86, 235, 193, 198, 26,
+			77, 219, 52, 32, 116, 83, 19, 16, 26, 88, 163, 176, 169, 193,
+			75, 167, 28, 215, 106, 42, 106, 102, 35, 30, 3, 176, 37, 71,
+			188, 212, 167, 169, 164, 236, 25, 77, 223, 227, 66, 166, 86, 139,
+			16, 176, 34, 227, 101, 251, 98, 226, 20, 98, 186, 31, 205, 49,
+			104, 218, 204, 81, 35, 177, 182, 75, 181, 170, 49, 69, 165, 166,
+			28, 70, 54, 155, 42, 239, 156, 249, 145, 162, 139, 212, 181, 181,
+			232, 228, 36, 91, 48, 129, 22, 111, 108, 192, 114, 174, 169, 249,
+			62, 226, 60, 245, 119, 201, 184, 134, 33, 216, 241, 6, 103, 254,
+			36, 164, 169, 201, 32, 105, 132, 245, 40, 185, 46, 204, 10, 76,
+			119, 91, 41, 38, 128, 75, 167, 13, 139, 102, 14, 149, 99, 217,
+			224, 220, 215, 147, 6, 2, 193, 133, 55, 241, 179, 58, 206, 156,
+			169, 192, 112, 77, 113, 179, 177, 209, 68, 44, 26, 88, 231, 129,
+			53, 77, 206, 138, 150, 139, 133, 18, 198, 25, 113, 2, 221, 189,
+			51, 188, 252, 44, 226, 194, 214, 82, 200, 109, 127, 134, 21, 102,
+			135, 110, 151, 131, 186, 186, 253, 58, 221, 101, 203, 193, 27, 229,
+			67, 242, 228, 185, 91, 118, 251, 70, 61, 234, 116, 141, 25, 116,
+			64, 98, 75, 155, 91, 244, 177, 110, 102, 126, 187, 158, 116, 75,
+			51, 209, 74, 220, 132, 152, 112, 157, 128, 115, 234, 156, 224, 14,
+			102, 249, 96, 24, 60, 1, 237, 45, 199, 245, 85, 157, 6, 155,
+			31, 84, 26, 9, 224, 8, 83, 15, 34, 138, 141, 170, 81, 132,
+			187, 82, 243, 147, 250, 123, 195, 235, 52, 136, 105, 195, 134, 46,
+			87, 131, 218, 117, 154, 107, 162, 79, 3, 7, 164, 7, 186, 112,
+			55, 120, 88, 148, 110, 63, 189, 244, 104, 201, 169, 82, 199, 61,
+			81, 205, 30, 146, 167, 213, 174, 28, 147, 231, 179, 136, 109, 160,
+			133, 31, 229, 49, 192, 140, 151, 45, 23, 120, 173, 26, 189, 19,
+			110, 162, 145, 156, 153, 141, 146, 60, 54, 121, 203, 158, 249, 49,
+			33, 143, 201, 213, 58, 84, 7, 250, 3, 51, 81, 133, 175, 234,
+			71, 249, 144, 60, 99, 118, 133, 221, 136, 164, 38, 91, 186, 107,
+			29, 203, 104, 136, 245, 8, 159, 202, 94, 71, 48, 23, 253, 84,
+			129, 173, 126, 135, 88, 143, 240, 169, 194, 224, 145, 180, 194, 65,
+			197, 8, 2, 147, 169, 171, 198, 241, 220, 79, 23, 220, 99, 166,
+			1, 12, 48, 62, 157, 237, 19, 134, 163, 159, 206, 246, 9, 35,
+			140, 79, 23, 6, 143, 166, 21, 212, 199, 232, 152, 233, 211, 245,
+			220, 223, 43, 184, 83, 166, 129, 171, 42, 210, 62, 97, 66, 250,
+			123, 217, 62, 97, 96, 240, 123, 133, 193, 137, 180, 194, 65, 197,
+			241, 19, 200, 234, 103, 187, 195, 94, 238, 179, 133, 174, 111, 21,
+			22, 28, 119, 20, 87, 186, 204, 124, 97, 61, 172, 210, 221, 147,
+			172, 69, 27, 114, 57, 108, 220, 12, 195, 90, 91, 244, 100, 226,
+			21, 240, 246, 111, 147, 82, 11, 206, 245, 62, 45, 31, 209, 237,
+			205, 253, 202, 204, 52, 8, 105, 144, 36, 113, 57, 74, 125, 157,
+			225, 37, 128, 104, 71, 233, 40, 66, 139, 133, 209, 95, 234, 211,
+			194, 82, 37, 197, 97, 134, 181, 50, 102, 28, 103, 130, 142, 240,
+			71, 25, 9, 178, 214, 86, 14, 91, 158, 251, 217, 66, 126, 159,
+			120, 70, 184, 238, 48, 158, 111, 159, 43, 216, 71, 253, 167, 228,
+			116, 77, 78, 27, 175, 52, 125, 5, 37, 234, 197, 79, 162, 0,
+			176, 164, 20, 123, 106, 11, 16, 192, 207, 212, 244, 157, 34, 180,
+			56, 132, 27, 225, 176, 100, 230, 193, 207, 210, 97, 122, 150, 126,
+			78, 63, 75, 135, 233, 89, 250, 185, 2, 219, 43, 12, 211, 179,
+			244, 115, 133, 97, 169, 139, 72, 54, 84, 184, 251, 8, 61, 75,
+			135, 241, 44, 253, 124, 193, 254, 22, 161, 158, 165, 195, 244, 44,
+			253, 124, 65, 12, 138, 239, 180, 68, 206, 29, 86, 207, 210, 151,
+			192, 162, 126, 83, 246, 85, 138, 235, 172, 237, 230, 107, 215, 46,
+			100, 103, 154, 209, 107, 10, 236, 150, 210, 78, 96, 213, 70, 86,
+			20, 180, 115, 79, 37, 45, 228, 47, 225, 145, 193, 7, 106, 152,
+			121, 243, 151, 52, 162, 14, 51, 111, 254, 146, 230, 239, 134, 153,
+			55, 127, 73, 243, 119, 195, 204, 155, 191, 164, 249, 187, 97, 230,
+			205, 95, 210, 252, 221, 176, 230, 205, 95, 2, 127, 119, 137, 215,
+			13, 187, 172, 130, 123, 196, 127, 121, 251, 186, 129, 63, 201, 102,
+			210, 8, 215, 213, 219, 164, 17, 223, 98, 253, 153, 121, 131, 47,
+			253, 179, 236, 188, 193, 151, 254, 153, 62, 96, 195, 204, 151, 254,
+			89, 97, 240, 80, 90, 225, 160, 162, 120, 55, 69, 100, 67, 133,
+			237, 185, 127, 94, 112, 15, 248, 171, 237, 147, 34, 78, 30, 8,
+			18, 175, 172, 64, 144, 134, 220, 97, 200, 60, 164, 119, 167, 21,
+			207, 240, 180, 10, 224, 107, 161, 212, 69, 188, 130, 140, 177, 189,
+			82, 245, 103, 38, 15, 42, 246, 231, 217, 201, 131, 138, 253, 121,
+			22, 232, 160, 98, 127, 94, 216, 189, 55, 173, 128, 225, 87, 225,
+			174, 253, 226, 211, 26, 149, 28, 207, 253, 82, 193, 189, 203, 255,
+			168, 213, 62, 125, 173, 215, 190, 147, 201, 115, 156, 248, 219, 78,
+			158, 132, 231, 120, 212, 112, 175, 169, 87, 0, 232, 194, 70, 144,
+			52, 50, 207, 80, 56, 61, 222, 192, 171, 131, 98, 91, 143, 38,
+			49, 135, 154, 65, 234, 22, 253, 132, 164, 120, 113, 15, 209, 59,
+			105, 66, 241, 75, 250, 201, 56, 204, 52, 249, 75, 89, 8, 129,
+			38, 127, 41, 11, 33, 208, 228, 47, 21, 118, 15, 167, 21, 4,
+			144, 125, 190, 120, 74, 1, 8, 150, 208, 95, 46, 184, 223, 44,
+			186, 253, 215, 200, 197, 244, 241, 208, 113, 125, 35, 137, 142, 141,
+			26, 215, 110, 117, 30, 69, 203, 73, 52, 243, 37, 163, 191, 47,
+			23, 10, 187, 196, 195, 234, 8, 192, 140, 218, 115, 255, 186, 144,
+			235, 247, 79, 1, 116, 38, 194, 156, 30, 133, 227, 120, 168, 18,
+			56, 142, 26, 68, 145, 245, 104, 25, 122, 205, 146, 16, 187, 68,
+			65, 247, 131, 224, 124, 133, 92, 75, 149, 141, 170, 222, 62, 241,
+			160, 25, 13, 6, 118, 133, 92, 159, 127, 76, 94, 205, 206, 30,
+			44, 7, 141, 150, 62, 91, 215, 155, 13, 80, 240, 150, 49, 112,
+			86, 254, 166, 144, 43, 100, 171, 96, 161, 87, 232, 233, 21, 247,
+			155, 49, 96, 113, 39, 114, 3, 254, 61, 32, 202, 156, 237, 40,
+			110, 133, 86, 98, 92, 178, 90, 250, 7, 58, 191, 89, 228, 118,
+			102, 171, 168, 183, 62, 101, 71, 137, 253, 131, 57, 158, 96, 155,
+			218, 97, 190, 66, 159, 19, 25, 20, 32, 11, 61, 209, 59, 148,
+			86, 192, 70, 79, 12, 31, 76, 43, 28, 84, 28, 46, 138, 95,
+			187, 91, 28, 66, 14, 146, 106, 56, 25, 108, 68, 147, 68, 43,
+			151, 116, 220, 31, 181, 131, 158, 80, 13, 74, 193, 70, 228, 75,
+			110, 172, 237, 147, 38, 83, 143, 51, 213, 250, 216, 191, 178, 68,
+			47, 9, 127, 206, 115, 47, 222, 65, 225, 63, 60, 63, 183, 48,
+			187, 116, 126, 238, 209, 233, 199, 231, 47, 45, 46, 93, 187, 120,
+			229, 242, 220, 204, 252, 195, 243, 115, 179, 3, 93, 94, 143, 200,
+			95, 186, 140, 104, 148, 211, 11, 3, 22, 74, 139, 115, 143, 93,
+			155, 95, 156, 155, 29, 176, 189, 126, 177, 243, 210, 181, 171, 151,
+			175, 93, 93, 186, 116, 113, 225, 181, 3, 142, 215, 39, 196, 252,
+			69, 83, 118, 189, 94, 81, 152, 191, 112, 225, 218, 213, 233, 243,
+			11, 115, 3, 221, 158, 39, 250, 174, 93, 188, 180, 56, 59, 183,
+			56, 55, 187, 180, 48, 127, 229, 234, 64, 206, 27, 18, 187, 46,
+			94, 186, 184, 52, 119, 225, 242, 213, 215, 46, 205, 206, 61, 60,
+			125, 109, 225, 234, 192, 14, 234, 105, 118, 238, 226, 85, 76, 99,
+			113, 32, 127, 246, 73, 209, 215, 186, 124, 239, 64, 137, 87, 174,
+			87, 91, 162, 133, 177, 151, 219, 222, 127, 148, 151, 206, 104, 223,
+			212, 62, 221, 42, 216, 136, 74, 45, 43, 95, 236, 93, 201, 22,
+			207, 111, 136, 190, 114, 188, 158, 105, 126, 222, 107, 105, 79, 146,
+			180, 203, 214, 235, 166, 185, 197, 106, 12, 143, 146, 82, 92, 95,
+			157, 92, 13, 107, 52, 137, 73, 245, 83, 176, 17, 37, 180, 97,
+			25, 63, 244, 115, 153, 191, 223, 107, 187, 143, 76, 95, 158, 127,
+			229, 127, 60, 132, 48, 171, 125, 93, 171, 150, 248, 121, 87, 88,
+			61, 158, 211, 215, 229, 77, 253, 148, 43, 225, 208, 87, 143, 86,
+			215, 26, 20, 41, 153, 125, 194, 229, 194, 194, 140, 16, 114, 33,
+			42, 135, 181, 36, 172, 168, 56, 85, 132, 182, 211, 27, 96, 52,
+			245, 47, 227, 242, 113, 149, 4, 78, 78, 149, 142, 171, 204, 116,
+			69, 254, 169, 56, 118, 78, 32, 167, 55, 105, 118, 90, 211, 167,
+			67, 237, 207, 105, 9, 162, 26, 39, 64, 135, 207, 180, 54, 245,
+			50, 221, 151, 4, 229, 96, 71, 15, 241, 50, 116, 111, 36, 198,
+			216, 208, 73, 42, 116, 51, 25, 52, 136, 33, 150, 100, 72, 119,
+			118, 114, 242, 230, 205, 155, 165, 128, 38, 74, 32, 171, 170, 102,
+			201, 228, 194, 252, 204, 220, 197, 43, 115, 19, 83, 165, 227, 66,
+			200, 107, 53, 202, 48, 98, 82, 58, 44, 115, 90, 151, 50, 232,
+			137, 172, 6, 55, 65, 4, 130, 213, 58, 167, 130, 133, 171, 152,
+			202, 206, 53, 46, 147, 120, 165, 113, 147, 222, 197, 149, 8, 79,
+			217, 229, 102, 163, 5, 74, 122, 98, 81, 210, 210, 0, 234, 160,
+			154, 44, 78, 95, 145, 243, 87, 138, 242, 252, 244, 149, 249, 43,
+			227, 66, 190, 122, 254, 234, 163, 151, 174, 93, 149, 175, 158, 94,
+			92, 156, 190, 120, 117, 126, 238, 138, 188, 180, 40, 103, 46, 93,
+			84, 145, 89, 175, 200, 75, 15, 203, 233, 139, 175, 149, 175, 154,
+			191, 56, 59, 46, 149, 7, 57, 178, 117, 213, 49, 251, 184, 206,
+			30, 215, 176, 97, 209, 249, 8, 245, 240, 218, 32, 206, 56, 27,
+			155, 124, 185, 171, 208, 135, 208, 107, 123, 3, 162, 200, 196, 152,
+			233, 9, 73, 89, 194, 88, 55, 177, 101, 69, 37, 33, 242, 194,
+			178, 61, 103, 160, 107, 183, 40, 8, 219, 233, 242, 28, 175, 235,
+			24, 42, 243, 158, 51, 216, 245, 26, 84, 230, 119, 170, 63, 85,
+			229, 80, 87, 145, 42, 133, 250, 83, 85, 14, 119, 157, 164, 74,
+			254, 83, 85, 238, 233, 26, 161, 74, 75, 253, 169, 42, 247, 242,
+			231, 71, 244, 159, 8, 137, 225, 119, 141, 90, 226, 83, 142, 176,
+			119, 116, 121, 206, 136, 125, 214, 255, 184, 35, 167, 89, 57, 147,
+			17, 0, 155, 117, 235, 100, 62, 28, 197, 108, 84, 239, 249, 56,
+			196, 25, 27, 77, 92, 97, 72, 65, 18, 54, 202, 165, 49, 97,
+			226, 172, 45, 55, 87, 180, 188, 60, 217, 42, 77, 104, 147, 78,
+			203, 215, 143, 166, 199, 185, 212, 74, 63, 198, 228, 67, 82, 147,
+			178, 55, 208, 43, 243, 10, 46, 20, 136, 63, 27, 119, 244, 113,
+			134, 242, 169, 239, 219, 201, 209, 108, 147, 133, 113, 141, 70, 21,
+			29, 162, 141, 188, 93, 175, 243, 23, 111, 221, 233, 213, 104, 61,
+			76, 26, 193, 250, 6, 176, 45, 170, 135, 75, 20, 221, 237, 78,
+			123, 207, 204, 121, 156, 213, 30, 183, 153, 142, 166, 222, 111, 56,
+			39, 132, 16, 206, 142, 46, 219, 115, 252, 29, 119, 171, 191, 93,
+			108, 52, 215, 231, 60, 103, 100, 39, 215, 91, 158, 51, 114, 100,
+			74, 253, 237, 120, 206, 200, 233, 7, 196, 75, 182, 176, 97, 56,
+			124, 188, 107, 213, 242, 127, 223, 198, 213, 27, 169, 240, 84, 105,
+			178, 47, 61, 48, 202, 218, 186, 146, 166, 4, 179, 136, 22, 237,
+			16, 164, 123, 153, 167, 131, 33, 23, 17, 50, 247, 61, 221, 68,
+			110, 161, 113, 25, 215, 185, 15, 228, 38, 80, 40, 133, 7, 158,
+			126, 159, 7, 224, 86, 54, 154, 13, 152, 91, 208, 83, 228, 216,
+			49, 173, 97, 61, 118, 76, 167, 219, 109, 153, 150, 198, 193, 114,
+			172, 83, 34, 38, 108, 71, 113, 14, 58, 70, 224, 43, 172, 73,
+			225, 145, 156, 180, 46, 8, 239, 88, 80, 76, 206, 31, 180, 22,
+			223, 68, 194, 107, 50, 238, 193, 121, 87, 222, 120, 250, 37, 193,
+			34, 66, 200, 205, 207, 170, 71, 18, 156, 14, 229, 177, 99, 235,
+			193, 230, 177, 99, 178, 30, 150, 67, 8, 95, 17, 100, 144, 188,
+			105, 147, 45, 154, 73, 33, 156, 238, 46, 203, 115, 142, 119, 123,
+			226, 229, 194, 237, 6, 23, 231, 76, 217, 135, 253, 41, 57, 19,
+			215, 110, 128, 105, 36, 25, 6, 219, 157, 145, 145, 46, 198, 72,
+			178, 89, 56, 72, 97, 72, 193, 95, 186, 209, 129, 229, 57, 83,
+			246, 126, 93, 178, 61, 103, 234, 144, 20, 47, 88, 212, 187, 229,
+			57, 247, 217, 253, 254, 119, 91, 156, 51, 142, 69, 247, 26, 20,
+			90, 235, 19, 232, 136, 7, 65, 181, 36, 228, 171, 41, 183, 16,
+			244, 232, 28, 153, 172, 19, 120, 33, 121, 210, 223, 176, 90, 144,
+			173, 18, 152, 142, 112, 30, 45, 200, 100, 215, 130, 4, 28, 232,
+			10, 110, 140, 122, 188, 81, 199, 203, 223, 204, 223, 162, 57, 154,
+			146, 237, 57, 247, 245, 246, 137, 95, 84, 243, 183, 61, 231, 65,
+			187, 223, 255, 0, 68, 20, 91, 166, 172, 145, 75, 163, 9, 163,
+			173, 54, 32, 77, 45, 165, 142, 29, 91, 111, 38, 141, 99, 199,
+			240, 154, 208, 50, 92, 40, 153, 179, 122, 90, 198, 206, 113, 165,
+			6, 209, 9, 129, 26, 177, 172, 196, 50, 137, 149, 40, 150, 141,
+			5, 106, 50, 172, 215, 65, 24, 155, 137, 202, 3, 246, 228, 252,
+			197, 199, 167, 23, 230, 103, 151, 166, 23, 31, 185, 118, 97, 238,
+			226, 213, 39, 199, 204, 242, 108, 11, 75, 48, 37, 44, 168, 183,
+			79, 252, 119, 181, 60, 199, 115, 206, 219, 158, 255, 127, 117, 92,
+			94, 134, 216, 222, 118, 133, 25, 225, 52, 112, 78, 169, 254, 147,
+			144, 243, 71, 42, 145, 47, 7, 147, 97, 89, 55, 154, 5, 122,
+			213, 198, 130, 129, 12, 192, 71, 117, 228, 171, 176, 46, 21, 228,
+			180, 234, 30, 94, 167, 181, 138, 80, 181, 64, 199, 99, 20, 50,
+			231, 102, 10, 19, 210, 24, 112, 130, 37, 134, 44, 13, 55, 130,
+			9, 134, 9, 68, 54, 41, 108, 28, 11, 235, 239, 213, 37, 219,
+			115, 206, 15, 236, 18, 63, 164, 96, 227, 122, 206, 35, 246, 46,
+			255, 157, 29, 97, 19, 213, 190, 122, 208, 104, 42, 196, 230, 15,
+			89, 227, 66, 147, 132, 71, 167, 241, 202, 10, 203, 141, 160, 92,
+			77, 215, 181, 48, 193, 30, 158, 188, 107, 123, 206, 35, 253, 3,
+			226, 135, 213, 228, 187, 61, 103, 193, 30, 240, 223, 213, 121, 242,
+			235, 120, 34, 209, 51, 236, 54, 115, 215, 39, 10, 70, 40, 96,
+			249, 90, 246, 12, 178, 85, 210, 211, 192, 19, 24, 46, 0, 16,
+			100, 164, 162, 50, 14, 20, 168, 200, 37, 173, 180, 30, 146, 74,
+			202, 172, 160, 219, 194, 44, 119, 234, 146, 237, 57, 11, 125, 253,
+			226, 187, 109, 90, 65, 206, 115, 22, 237, 33, 255, 45, 182, 89,
+			1, 19, 247, 81, 173, 52, 25, 227, 73, 66, 158, 87, 147, 205,
+			154, 182, 155, 175, 70, 73, 227, 22, 43, 211, 113, 219, 48, 71,
+			222, 152, 236, 19, 143, 35, 154, 64, 37, 133, 39, 63, 86, 92,
+			219, 148, 65, 125, 57, 106, 80, 94, 118, 101, 77, 63, 46, 235,
+			153, 156, 176, 248, 156, 170, 233, 67, 40, 24, 205, 123, 26, 132,
+			95, 111, 127, 73, 78, 155, 252, 115, 96, 96, 244, 40, 35, 9,
+			127, 141, 41, 197, 245, 44, 244, 18, 222, 39, 134, 81, 206, 2,
+			84, 6, 116, 201, 246, 156, 197, 221, 131, 226, 155, 21, 196, 118,
+			120, 206, 107, 236, 189, 254, 23, 211, 61, 231, 53, 27, 39, 1,
+			21, 77, 36, 225, 60, 200, 74, 89, 165, 41, 61, 231, 1, 93,
+			161, 145, 147, 112, 59, 8, 114, 34, 52, 90, 35, 175, 139, 101,
+			50, 212, 27, 247, 146, 65, 147, 113, 209, 50, 156, 106, 144, 202,
+			220, 249, 45, 45, 175, 234, 78, 141, 33, 244, 114, 40, 3, 112,
+			237, 216, 15, 68, 125, 16, 91, 122, 65, 58, 223, 103, 54, 194,
+			114, 122, 38, 118, 88, 128, 193, 110, 93, 178, 61, 231, 53, 195,
+			123, 196, 127, 115, 9, 62, 121, 207, 89, 177, 119, 249, 47, 185,
+			237, 240, 49, 39, 85, 77, 155, 37, 114, 163, 129, 102, 39, 117,
+			212, 48, 150, 22, 11, 205, 122, 129, 49, 210, 205, 199, 140, 229,
+			35, 95, 185, 166, 31, 50, 136, 104, 196, 8, 105, 241, 116, 147,
+			162, 137, 41, 217, 19, 105, 249, 210, 131, 83, 34, 45, 221, 244,
+			252, 229, 9, 253, 196, 106, 128, 19, 208, 25, 162, 89, 28, 165,
+			51, 166, 101, 146, 90, 83, 47, 79, 98, 148, 39, 121, 29, 113,
+			235, 12, 76, 206, 66, 195, 116, 112, 103, 26, 202, 105, 95, 173,
+			98, 109, 18, 169, 152, 110, 82, 83, 54, 64, 140, 65, 163, 250,
+			206, 200, 155, 234, 122, 14, 43, 153, 15, 227, 149, 70, 88, 99,
+			171, 124, 35, 42, 111, 229, 242, 5, 39, 105, 53, 90, 97, 115,
+			35, 130, 129, 130, 35, 200, 250, 114, 88, 97, 74, 168, 180, 157,
+			184, 220, 103, 20, 1, 210, 241, 179, 0, 5, 33, 77, 152, 112,
+			206, 206, 219, 172, 97, 99, 116, 206, 66, 124, 118, 109, 163, 146,
+			253, 44, 98, 193, 142, 186, 206, 199, 74, 114, 94, 197, 225, 18,
+			88, 134, 106, 52, 97, 94, 39, 153, 231, 58, 231, 98, 126, 50,
+			149, 71, 60, 153, 237, 200, 160, 101, 222, 2, 234, 105, 82, 157,
+			183, 61, 103, 165, 127, 96, 57, 71, 146, 129, 147, 226, 183, 159,
+			16, 39, 87, 227, 82, 121, 173, 30, 175, 71, 205, 117, 245, 8,
+			110, 150, 163, 201, 160, 22, 84, 55, 147, 40, 81, 94, 101, 147,
+			55, 78, 76, 66, 97, 4, 17, 55, 202, 222, 64, 181, 89, 142,
+			74, 186, 81, 233, 198, 9, 255, 118, 34, 33, 211, 64, 63, 26,
+			38, 27, 250, 209, 160, 26, 20, 111, 138, 2, 222, 17, 139, 160,
+			217, 222, 25, 145, 87, 233, 73, 146, 198, 94, 75, 90, 163, 59,
+			167, 252, 210, 182, 175, 142, 69, 211, 214, 155, 18, 57, 18, 182,
+			54, 246, 218, 183, 253, 138, 91, 22, 207, 136, 158, 171, 97, 210,
+			88, 164, 155, 123, 190, 226, 13, 139, 156, 146, 154, 211, 200, 133,
+			69, 46, 121, 125, 194, 142, 42, 212, 111, 97, 209, 142, 42, 197,
+			155, 98, 199, 227, 65, 61, 10, 106, 13, 239, 148, 112, 42, 225,
+			202, 94, 75, 58, 163, 59, 167, 138, 165, 118, 232, 148, 184, 93,
+			105, 54, 92, 153, 171, 53, 234, 155, 139, 104, 238, 159, 17, 121,
+			93, 225, 13, 8, 231, 122, 184, 201, 35, 226, 79, 111, 80, 116,
+			19, 173, 225, 17, 85, 225, 172, 125, 191, 85, 60, 37, 196, 21,
+			122, 83, 94, 14, 162, 250, 157, 126, 89, 92, 16, 131, 231, 155,
+			171, 87, 235, 65, 249, 122, 84, 91, 157, 209, 102, 143, 219, 46,
+			119, 191, 40, 24, 219, 72, 238, 41, 173, 40, 222, 47, 250, 46,
+			131, 215, 89, 94, 143, 26, 139, 205, 218, 87, 0, 182, 186, 232,
+			157, 54, 58, 176, 243, 205, 213, 59, 253, 208, 59, 44, 10, 213,
+			168, 118, 125, 9, 210, 237, 189, 14, 170, 207, 187, 255, 113, 218,
+			114, 22, 243, 168, 70, 86, 34, 111, 88, 56, 205, 122, 117, 175,
+			155, 249, 17, 21, 197, 7, 68, 97, 166, 218, 132, 246, 117, 190,
+			226, 237, 23, 133, 160, 186, 26, 215, 163, 198, 154, 30, 50, 173,
+			216, 50, 221, 75, 162, 160, 212, 61, 139, 225, 138, 119, 191, 216,
+			177, 26, 53, 160, 86, 97, 172, 220, 191, 117, 175, 31, 81, 13,
+			22, 195, 149, 71, 187, 22, 117, 243, 243, 121, 189, 200, 226, 130,
+			16, 105, 19, 207, 19, 238, 90, 204, 72, 94, 88, 164, 191, 189,
+			189, 98, 199, 70, 61, 134, 106, 128, 231, 161, 139, 216, 235, 122,
+			184, 162, 22, 191, 232, 212, 195, 149, 99, 63, 96, 137, 157, 231,
+			225, 255, 9, 65, 65, 51, 241, 246, 139, 189, 231, 175, 205, 47,
+			204, 46, 93, 185, 58, 125, 245, 218, 149, 54, 185, 233, 94, 49,
+			216, 242, 235, 149, 107, 51, 51, 115, 87, 174, 12, 88, 91, 126,
+			121, 120, 122, 126, 225, 218, 226, 220, 128, 13, 89, 108, 203, 47,
+			243, 23, 31, 94, 156, 54, 191, 59, 222, 62, 49, 212, 242, 251,
+			204, 244, 197, 153, 185, 133, 185, 217, 1, 247, 216, 119, 90, 98,
+			215, 220, 51, 49, 233, 53, 163, 184, 182, 24, 6, 73, 92, 243,
+			138, 226, 224, 220, 107, 46, 93, 156, 91, 156, 134, 212, 106, 105,
+			113, 110, 250, 202, 165, 139, 109, 19, 29, 22, 222, 165, 153, 153,
+			107, 139, 87, 150, 46, 93, 92, 186, 48, 61, 127, 113, 97, 254,
+			226, 220, 128, 229, 237, 17, 187, 211, 250, 75, 87, 31, 157, 91,
+			92, 154, 89, 184, 50, 96, 123, 3, 162, 231, 226, 165, 171, 75,
+			51, 139, 243, 87, 231, 103, 166, 23, 6, 28, 111, 183, 232, 191,
+			118, 113, 238, 53, 151, 231, 102, 174, 206, 205, 46, 93, 158, 190,
+			114, 101, 192, 61, 246, 6, 209, 127, 133, 112, 182, 17, 86, 30,
+			142, 170, 141, 176, 238, 73, 177, 255, 202, 181, 243, 23, 230, 175,
+			162, 217, 195, 243, 11, 87, 231, 218, 165, 205, 158, 232, 131, 236,
+			99, 201, 52, 27, 176, 188, 65, 49, 64, 117, 215, 46, 166, 181,
+			246, 177, 55, 138, 129, 236, 169, 184, 16, 87, 66, 44, 247, 242,
+			226, 156, 106, 181, 180, 120, 237, 226, 210, 133, 75, 179, 115, 109,
+			35, 236, 20, 59, 102, 23, 95, 139, 95, 149, 56, 251, 225, 107,
+			11, 11, 84, 178, 189, 93, 162, 247, 177, 107, 243, 51, 175, 90,
+			210, 13, 28, 140, 125, 113, 238, 213, 75, 151, 167, 175, 206, 60,
+			122, 101, 238, 42, 53, 116, 143, 189, 215, 18, 94, 118, 112, 70,
+			136, 35, 66, 182, 14, 207, 219, 212, 58, 129, 45, 147, 228, 86,
+			132, 32, 115, 179, 115, 179, 3, 150, 119, 72, 220, 213, 177, 13,
+			80, 129, 36, 239, 135, 197, 129, 142, 13, 12, 70, 56, 231, 79,
+			190, 238, 196, 157, 221, 55, 231, 116, 205, 198, 242, 43, 127, 244,
+			49, 177, 195, 235, 238, 235, 250, 110, 219, 18, 191, 156, 202, 164,
+			127, 182, 77, 38, 61, 69, 156, 220, 194, 181, 153, 121, 57, 77,
+			9, 155, 73, 22, 247, 247, 78, 52, 253, 117, 217, 244, 223, 161,
+			108, 122, 95, 42, 155, 30, 163, 63, 45, 207, 217, 221, 53, 198,
+			34, 229, 161, 174, 71, 181, 152, 26, 127, 30, 17, 54, 34, 146,
+			236, 237, 58, 104, 249, 123, 229, 180, 172, 131, 85, 161, 125, 212,
+			60, 5, 48, 78, 8, 199, 133, 156, 107, 111, 126, 151, 120, 80,
+			184, 46, 201, 185, 124, 123, 204, 159, 36, 252, 140, 171, 21, 112,
+			150, 134, 251, 1, 183, 203, 79, 109, 45, 50, 163, 126, 21, 7,
+			135, 175, 115, 248, 252, 46, 93, 178, 60, 199, 223, 127, 68, 151,
+			28, 207, 241, 71, 70, 197, 60, 141, 99, 121, 206, 1, 123, 196,
+			127, 80, 206, 115, 127, 196, 34, 154, 145, 18, 150, 81, 82, 126,
+			107, 248, 105, 195, 240, 164, 90, 73, 95, 146, 90, 178, 134, 174,
+			114, 232, 75, 15, 10, 57, 213, 129, 253, 69, 93, 114, 60, 231,
+			192, 209, 123, 196, 168, 32, 67, 155, 195, 93, 227, 150, 191, 159,
+			245, 228, 42, 47, 120, 32, 193, 130, 113, 178, 105, 6, 9, 250,
+			56, 156, 31, 20, 175, 22, 46, 130, 41, 121, 206, 17, 123, 208,
+			127, 37, 129, 36, 211, 56, 145, 234, 150, 44, 9, 57, 147, 102,
+			252, 6, 80, 104, 45, 55, 130, 106, 164, 115, 224, 70, 137, 44,
+			170, 17, 42, 203, 69, 158, 184, 133, 0, 191, 206, 17, 59, 175,
+			75, 150, 231, 28, 41, 244, 235, 146, 227, 57, 71, 188, 221, 226,
+			251, 224, 180, 143, 248, 77, 206, 189, 246, 128, 255, 118, 91, 206,
+			207, 26, 44, 203, 204, 69, 111, 71, 231, 233, 225, 201, 212, 242,
+			75, 84, 147, 138, 133, 156, 61, 63, 206, 94, 131, 176, 29, 145,
+			81, 114, 86, 200, 98, 84, 187, 193, 86, 34, 201, 228, 155, 230,
+			47, 62, 126, 105, 70, 221, 126, 243, 179, 207, 78, 162, 155, 100,
+			242, 77, 215, 22, 23, 150, 230, 174, 204, 76, 95, 158, 155, 93,
+			186, 58, 119, 229, 42, 253, 198, 189, 79, 190, 9, 132, 117, 129,
+			234, 138, 16, 83, 194, 2, 174, 165, 155, 113, 217, 225, 123, 122,
+			131, 152, 47, 201, 6, 146, 165, 179, 236, 131, 33, 178, 211, 54,
+			64, 180, 186, 61, 231, 94, 3, 68, 236, 220, 189, 133, 157, 186,
+			228, 120, 206, 189, 125, 253, 226, 223, 193, 146, 202, 246, 220, 169,
+			174, 51, 150, 255, 11, 150, 100, 22, 183, 213, 148, 248, 38, 168,
+			222, 138, 172, 55, 149, 27, 19, 227, 69, 57, 160, 51, 72, 123,
+			79, 38, 53, 166, 86, 123, 227, 135, 207, 192, 154, 55, 164, 119,
+			113, 106, 225, 4, 15, 172, 113, 153, 145, 186, 203, 184, 38, 50,
+			207, 186, 75, 87, 198, 229, 35, 151, 175, 105, 227, 223, 244, 7,
+			142, 102, 193, 79, 51, 136, 60, 48, 37, 28, 12, 185, 82, 13,
+			86, 245, 169, 5, 70, 76, 229, 251, 197, 59, 225, 119, 141, 200,
+			84, 206, 105, 251, 160, 255, 156, 138, 17, 153, 241, 15, 97, 202,
+			125, 131, 153, 122, 101, 152, 123, 61, 220, 156, 32, 216, 202, 141,
+			32, 170, 183, 128, 65, 144, 105, 225, 122, 8, 155, 104, 182, 68,
+			0, 52, 32, 112, 55, 248, 5, 191, 152, 122, 83, 191, 47, 121,
+			37, 172, 104, 226, 125, 177, 137, 20, 156, 182, 135, 116, 201, 242,
+			156, 211, 195, 251, 116, 201, 241, 156, 211, 251, 15, 8, 33, 40,
+			104, 226, 253, 93, 47, 183, 232, 220, 65, 208, 120, 127, 222, 19,
+			175, 18, 46, 130, 58, 121, 206, 57, 123, 151, 255, 50, 185, 24,
+			174, 134, 207, 156, 149, 79, 188, 62, 152, 120, 227, 27, 240, 191,
+			227, 19, 15, 44, 189, 225, 216, 232, 100, 91, 197, 216, 177, 35,
+			66, 94, 8, 158, 97, 11, 147, 179, 242, 204, 41, 158, 142, 67,
+			103, 237, 28, 163, 9, 5, 144, 114, 206, 21, 122, 116, 201, 241,
+			156, 115, 253, 3, 226, 16, 13, 107, 121, 206, 203, 236, 221, 190,
+			215, 210, 211, 212, 233, 51, 166, 43, 96, 220, 203, 76, 87, 192,
+			184, 151, 21, 250, 116, 201, 241, 156, 151, 237, 242, 196, 130, 32,
+			187, 133, 243, 93, 151, 44, 255, 21, 109, 244, 6, 153, 63, 26,
+			252, 180, 73, 93, 186, 128, 62, 109, 191, 233, 243, 75, 176, 129,
+			28, 243, 124, 126, 191, 74, 53, 239, 2, 56, 15, 219, 131, 254,
+			11, 106, 195, 59, 124, 214, 38, 46, 213, 129, 15, 13, 250, 146,
+			251, 150, 145, 71, 32, 94, 198, 166, 108, 220, 41, 129, 203, 208,
+			54, 165, 98, 40, 174, 199, 181, 184, 30, 68, 213, 34, 16, 182,
+			184, 220, 92, 13, 106, 209, 27, 195, 186, 38, 118, 46, 109, 192,
+			195, 12, 53, 10, 4, 229, 60, 204, 196, 206, 37, 124, 120, 216,
+			219, 45, 158, 115, 104, 109, 150, 231, 92, 180, 247, 248, 255, 213,
+			222, 186, 182, 20, 92, 95, 211, 229, 205, 179, 254, 172, 3, 24,
+			161, 48, 225, 181, 177, 140, 137, 93, 63, 211, 169, 4, 84, 33,
+			88, 48, 71, 50, 163, 36, 132, 20, 158, 19, 187, 20, 231, 107,
+			43, 245, 224, 101, 120, 184, 191, 236, 225, 106, 112, 29, 38, 192,
+			73, 81, 73, 178, 178, 125, 211, 90, 69, 58, 3, 126, 73, 241,
+			57, 43, 106, 78, 180, 56, 166, 197, 222, 97, 13, 166, 91, 21,
+			37, 76, 214, 110, 40, 236, 172, 192, 44, 72, 42, 105, 227, 222,
+			70, 18, 102, 59, 153, 103, 133, 41, 229, 74, 180, 202, 170, 86,
+			179, 93, 64, 242, 139, 102, 187, 128, 228, 23, 11, 158, 46, 57,
+			158, 115, 113, 104, 88, 60, 38, 200, 156, 117, 177, 235, 13, 150,
+			63, 215, 134, 228, 27, 154, 187, 7, 245, 146, 163, 65, 53, 137,
+			57, 12, 4, 169, 30, 138, 51, 143, 201, 197, 102, 77, 97, 203,
+			204, 227, 244, 247, 24, 99, 58, 228, 221, 139, 249, 97, 241, 253,
+			192, 244, 110, 96, 250, 227, 246, 160, 255, 14, 133, 233, 188, 43,
+			196, 29, 128, 14, 233, 200, 59, 27, 245, 184, 28, 38, 9, 175,
+			52, 51, 246, 29, 222, 206, 224, 236, 39, 202, 55, 138, 68, 178,
+			9, 64, 48, 99, 143, 26, 96, 184, 1, 198, 186, 144, 163, 170,
+			250, 113, 86, 140, 32, 172, 86, 55, 230, 150, 215, 37, 203, 115,
+			30, 103, 156, 238, 38, 156, 126, 220, 219, 45, 126, 89, 173, 194,
+			242, 156, 111, 176, 7, 252, 127, 165, 141, 244, 26, 134, 169, 110,
+			159, 237, 124, 123, 117, 138, 136, 60, 129, 150, 43, 91, 7, 22,
+			215, 75, 33, 159, 175, 226, 155, 208, 116, 233, 242, 226, 165, 87,
+			206, 205, 92, 125, 118, 82, 21, 103, 30, 167, 43, 89, 97, 37,
+			53, 139, 128, 152, 147, 247, 63, 112, 255, 253, 247, 159, 120, 224,
+			212, 153, 147, 247, 159, 62, 53, 113, 98, 98, 229, 129, 83, 247,
+			157, 156, 90, 9, 167, 142, 31, 63, 125, 102, 165, 114, 66, 31,
+			226, 110, 194, 138, 111, 48, 11, 6, 86, 124, 3, 95, 182, 221,
+			132, 21, 223, 208, 215, 47, 30, 16, 100, 161, 245, 100, 87, 98,
+			249, 19, 109, 88, 129, 179, 172, 233, 220, 68, 103, 58, 7, 217,
+			253, 147, 249, 33, 177, 42, 92, 55, 135, 205, 47, 219, 131, 254,
+			235, 228, 21, 3, 132, 237, 14, 42, 131, 115, 185, 185, 154, 26,
+			239, 43, 187, 18, 113, 91, 242, 148, 163, 173, 44, 243, 202, 114,
+			68, 158, 202, 188, 149, 57, 218, 202, 178, 183, 91, 188, 31, 91,
+			153, 195, 205, 187, 102, 15, 248, 239, 198, 86, 222, 98, 66, 169,
+			40, 52, 106, 219, 113, 76, 81, 116, 56, 253, 73, 121, 45, 92,
+			199, 30, 202, 55, 241, 97, 125, 118, 242, 77, 181, 230, 122, 88,
+			143, 202, 75, 81, 229, 89, 226, 39, 132, 52, 179, 111, 255, 40,
+			211, 212, 172, 11, 59, 182, 102, 214, 133, 29, 91, 227, 29, 203,
+			209, 142, 173, 245, 245, 139, 132, 150, 101, 123, 110, 213, 94, 63,
+			238, 135, 114, 90, 174, 53, 215, 131, 218, 68, 61, 12, 42, 208,
+			171, 40, 115, 16, 205, 117, 182, 0, 56, 117, 51, 35, 10, 8,
+			48, 80, 204, 94, 72, 185, 12, 221, 170, 227, 19, 196, 255, 58,
+			49, 117, 242, 212, 233, 51, 247, 21, 245, 9, 202, 217, 118, 183,
+			231, 84, 205, 244, 0, 218, 42, 147, 153, 28, 2, 255, 56, 213,
+			161, 97, 93, 202, 123, 206, 186, 139, 192, 123, 121, 42, 253, 163,
+			124, 151, 231, 172, 119, 151, 196, 6, 205, 222, 241, 220, 167, 237,
+			250, 113, 127, 57, 141, 180, 133, 164, 59, 152, 208, 181, 197, 5,
+			141, 242, 115, 96, 88, 138, 58, 50, 217, 114, 115, 53, 105, 125,
+			223, 111, 76, 234, 226, 100, 148, 36, 205, 48, 153, 172, 132, 141,
+			32, 170, 190, 60, 170, 60, 164, 38, 159, 98, 140, 211, 237, 57,
+			79, 155, 169, 131, 117, 121, 154, 57, 138, 156, 237, 56, 158, 243,
+			116, 255, 128, 46, 229, 61, 167, 110, 166, 238, 168, 169, 215, 187,
+			75, 226, 159, 35, 40, 243, 14, 175, 251, 217, 174, 111, 179, 44,
+			255, 61, 142, 52, 130, 63, 89, 239, 100, 168, 170, 143, 17, 98,
+			255, 64, 67, 152, 10, 17, 46, 243, 85, 129, 116, 208, 177, 18,
+			25, 209, 5, 216, 96, 51, 254, 244, 102, 80, 150, 85, 101, 118,
+			59, 225, 155, 172, 6, 57, 165, 81, 128, 68, 90, 21, 161, 21,
+			53, 202, 255, 152, 177, 87, 235, 43, 90, 194, 218, 243, 140, 132,
+			28, 229, 219, 38, 193, 227, 21, 179, 53, 97, 224, 88, 25, 20,
+			109, 148, 42, 225, 141, 201, 19, 83, 83, 99, 152, 97, 153, 243,
+			40, 64, 167, 87, 11, 57, 248, 75, 80, 38, 90, 14, 173, 228,
+			141, 168, 210, 12, 170, 96, 74, 27, 73, 231, 25, 40, 44, 131,
+			5, 0, 152, 214, 117, 114, 145, 68, 43, 35, 38, 197, 189, 92,
+			25, 163, 231, 133, 210, 96, 64, 161, 3, 179, 170, 186, 78, 183,
+			188, 30, 92, 15, 205, 76, 16, 5, 61, 20, 91, 7, 98, 250,
+			4, 221, 217, 179, 249, 93, 98, 73, 184, 238, 14, 24, 247, 190,
+			217, 178, 247, 248, 143, 201, 105, 51, 28, 147, 132, 14, 224, 193,
+			165, 158, 78, 139, 238, 176, 44, 139, 146, 105, 201, 110, 8, 59,
+			64, 151, 220, 55, 91, 118, 94, 23, 97, 64, 107, 21, 60, 93,
+			68, 142, 107, 107, 104, 88, 252, 31, 32, 77, 59, 108, 132, 150,
+			180, 236, 1, 255, 35, 89, 218, 196, 93, 50, 14, 225, 46, 51,
+			10, 69, 205, 21, 153, 73, 129, 116, 134, 74, 10, 81, 221, 140,
+			106, 171, 153, 96, 241, 117, 244, 23, 52, 228, 122, 156, 52, 228,
+			137, 51, 202, 152, 156, 13, 23, 146, 20, 95, 57, 198, 27, 246,
+			54, 144, 107, 225, 51, 1, 242, 76, 173, 195, 98, 154, 109, 203,
+			226, 21, 217, 220, 0, 212, 79, 78, 165, 25, 68, 179, 45, 97,
+			58, 84, 15, 202, 141, 52, 53, 206, 14, 92, 60, 238, 91, 82,
+			48, 192, 78, 249, 45, 86, 97, 167, 46, 34, 203, 181, 213, 215,
+			47, 254, 189, 67, 70, 82, 185, 119, 90, 93, 239, 177, 44, 255,
+			23, 29, 153, 145, 55, 235, 45, 205, 216, 60, 80, 52, 66, 96,
+			56, 7, 78, 208, 12, 226, 141, 176, 94, 137, 202, 13, 138, 210,
+			164, 147, 70, 104, 77, 111, 91, 200, 191, 74, 216, 8, 203, 70,
+			225, 219, 242, 53, 142, 82, 205, 120, 168, 42, 60, 101, 191, 48,
+			152, 175, 72, 186, 125, 141, 13, 11, 92, 199, 96, 48, 90, 173,
+			178, 177, 95, 153, 188, 42, 43, 99, 202, 4, 226, 230, 90, 200,
+			154, 116, 236, 143, 82, 232, 18, 210, 180, 207, 151, 88, 173, 108,
+			76, 64, 53, 4, 112, 143, 150, 155, 154, 70, 77, 87, 171, 154,
+			135, 223, 168, 135, 43, 209, 51, 218, 207, 167, 69, 70, 206, 236,
+			45, 71, 97, 55, 209, 17, 163, 50, 214, 80, 161, 176, 73, 213,
+			77, 236, 167, 137, 114, 78, 98, 69, 210, 138, 78, 114, 104, 117,
+			216, 70, 177, 96, 61, 73, 77, 208, 89, 128, 182, 45, 133, 56,
+			163, 124, 130, 96, 137, 229, 190, 211, 234, 30, 16, 83, 108, 138,
+			229, 62, 111, 217, 135, 252, 35, 114, 90, 173, 72, 106, 51, 23,
+			29, 41, 5, 241, 93, 104, 203, 213, 49, 194, 55, 22, 125, 228,
+			235, 162, 141, 226, 129, 131, 226, 8, 155, 95, 185, 239, 178, 236,
+			187, 252, 97, 126, 112, 160, 207, 164, 89, 46, 135, 97, 37, 172,
+			152, 62, 128, 116, 239, 178, 236, 97, 93, 180, 81, 220, 231, 139,
+			195, 108, 2, 229, 126, 31, 250, 216, 157, 233, 3, 112, 207, 116,
+			128, 113, 190, 47, 237, 64, 125, 178, 207, 23, 175, 96, 35, 35,
+			247, 7, 45, 50, 49, 75, 59, 0, 131, 223, 68, 16, 143, 176,
+			98, 16, 70, 69, 72, 131, 213, 19, 99, 142, 233, 31, 174, 16,
+			63, 104, 217, 251, 117, 17, 9, 164, 173, 67, 82, 140, 176, 161,
+			142, 251, 67, 150, 189, 223, 223, 151, 153, 32, 240, 69, 33, 93,
+			102, 154, 16, 148, 253, 144, 101, 239, 209, 69, 27, 69, 255, 46,
+			241, 30, 28, 46, 196, 25, 181, 186, 126, 217, 178, 252, 119, 58,
+			114, 139, 190, 68, 150, 131, 13, 108, 56, 206, 126, 93, 213, 220,
+			92, 219, 212, 178, 20, 141, 233, 55, 145, 156, 55, 228, 111, 97,
+			213, 160, 251, 9, 43, 38, 123, 128, 89, 29, 157, 65, 99, 114,
+			88, 171, 200, 74, 84, 193, 94, 35, 53, 239, 13, 4, 19, 169,
+			3, 157, 112, 171, 5, 229, 6, 229, 190, 192, 45, 144, 128, 242,
+			226, 164, 1, 237, 211, 147, 14, 215, 169, 32, 170, 74, 40, 210,
+			235, 33, 110, 76, 253, 251, 204, 130, 92, 14, 169, 16, 38, 44,
+			204, 105, 123, 196, 224, 220, 156, 15, 112, 234, 227, 154, 185, 213,
+			216, 116, 160, 133, 137, 72, 89, 8, 156, 111, 254, 255, 189, 147,
+			235, 65, 84, 59, 187, 26, 79, 38, 245, 242, 100, 71, 189, 130,
+			22, 16, 166, 122, 108, 64, 109, 137, 37, 147, 84, 249, 242, 167,
+			31, 218, 2, 243, 123, 16, 245, 226, 161, 50, 59, 208, 117, 3,
+			75, 127, 204, 234, 30, 22, 15, 8, 183, 27, 194, 75, 247, 69,
+			203, 62, 234, 223, 43, 167, 91, 55, 225, 118, 103, 198, 162, 51,
+			243, 162, 101, 75, 93, 132, 175, 190, 117, 247, 17, 138, 35, 218,
+			13, 145, 164, 251, 147, 150, 189, 207, 127, 193, 150, 87, 84, 176,
+			181, 12, 93, 50, 79, 236, 155, 184, 11, 226, 101, 88, 19, 177,
+			60, 94, 2, 16, 112, 131, 149, 203, 245, 160, 134, 168, 113, 42,
+			134, 22, 197, 78, 74, 96, 151, 202, 184, 201, 113, 161, 154, 181,
+			68, 107, 193, 216, 168, 52, 209, 182, 24, 176, 60, 29, 5, 31,
+			29, 220, 136, 163, 10, 240, 24, 219, 14, 103, 95, 78, 227, 173,
+			165, 213, 9, 57, 214, 66, 128, 118, 61, 170, 110, 130, 110, 9,
+			195, 46, 68, 73, 131, 89, 88, 69, 113, 129, 4, 91, 23, 2,
+			19, 234, 233, 52, 203, 116, 135, 149, 70, 181, 20, 95, 38, 103,
+			30, 195, 187, 55, 97, 211, 55, 134, 32, 182, 230, 39, 45, 123,
+			80, 23, 109, 20, 247, 236, 21, 255, 89, 1, 212, 246, 220, 127,
+			109, 217, 190, 255, 251, 95, 1, 64, 179, 131, 98, 196, 209, 100,
+			12, 143, 4, 69, 99, 199, 133, 108, 214, 148, 71, 87, 69, 206,
+			44, 140, 38, 99, 37, 57, 170, 57, 188, 164, 185, 186, 26, 38,
+			20, 133, 128, 47, 67, 226, 120, 249, 20, 240, 101, 46, 176, 99,
+			233, 126, 53, 13, 251, 4, 90, 31, 213, 193, 84, 6, 73, 198,
+			199, 153, 195, 138, 193, 43, 191, 182, 18, 55, 73, 54, 35, 228,
+			74, 80, 86, 129, 249, 112, 87, 40, 81, 34, 164, 200, 244, 5,
+			164, 3, 113, 77, 206, 44, 36, 242, 70, 210, 241, 55, 209, 142,
+			48, 225, 215, 100, 51, 128, 191, 255, 218, 178, 135, 116, 145, 160,
+			191, 119, 159, 50, 249, 180, 64, 141, 63, 104, 217, 187, 253, 47,
+			88, 70, 236, 175, 36, 93, 180, 122, 184, 76, 32, 168, 152, 137,
+			124, 146, 52, 16, 133, 42, 35, 173, 153, 89, 24, 147, 27, 65,
+			130, 160, 12, 134, 113, 208, 158, 114, 218, 55, 156, 130, 39, 85,
+			130, 70, 0, 168, 42, 184, 151, 227, 106, 85, 135, 86, 72, 25,
+			90, 25, 173, 8, 51, 80, 194, 90, 17, 98, 94, 195, 26, 37,
+			66, 36, 153, 143, 92, 15, 42, 97, 58, 183, 209, 32, 195, 201,
+			211, 235, 140, 24, 45, 96, 7, 192, 85, 143, 96, 198, 199, 241,
+			158, 101, 210, 140, 26, 97, 50, 102, 160, 131, 171, 228, 131, 150,
+			221, 71, 87, 137, 5, 155, 79, 247, 131, 214, 46, 79, 252, 7,
+			5, 29, 215, 115, 127, 201, 178, 135, 253, 223, 76, 161, 195, 160,
+			39, 114, 29, 212, 178, 123, 2, 48, 148, 228, 104, 26, 198, 36,
+			105, 150, 215, 112, 157, 233, 43, 0, 220, 14, 30, 46, 0, 109,
+			208, 108, 196, 136, 177, 169, 78, 164, 78, 59, 134, 73, 183, 245,
+			8, 222, 179, 169, 156, 93, 0, 25, 206, 117, 22, 37, 89, 201,
+			87, 35, 102, 125, 193, 21, 60, 71, 227, 122, 86, 14, 38, 215,
+			131, 90, 51, 51, 8, 208, 35, 76, 1, 128, 75, 240, 151, 44,
+			123, 151, 46, 218, 40, 14, 14, 137, 80, 192, 2, 51, 247, 17,
+			171, 235, 55, 45, 203, 127, 181, 108, 211, 208, 195, 15, 8, 140,
+			107, 11, 91, 150, 112, 82, 196, 184, 150, 229, 226, 16, 205, 174,
+			146, 165, 110, 66, 147, 55, 166, 228, 192, 208, 143, 88, 221, 131,
+			68, 201, 33, 226, 119, 63, 106, 217, 119, 251, 247, 234, 96, 188,
+			156, 99, 217, 104, 208, 96, 9, 222, 50, 44, 47, 198, 38, 74,
+			254, 81, 203, 62, 168, 139, 8, 111, 107, 169, 132, 239, 42, 144,
+			251, 199, 44, 123, 200, 159, 86, 65, 53, 180, 130, 175, 117, 5,
+			244, 88, 169, 196, 8, 1, 70, 247, 110, 7, 170, 108, 134, 3,
+			157, 251, 152, 101, 15, 232, 162, 141, 226, 238, 65, 104, 254, 84,
+			152, 248, 223, 192, 147, 233, 220, 109, 135, 187, 237, 64, 152, 250,
+			111, 88, 182, 167, 139, 54, 138, 67, 195, 226, 29, 240, 149, 112,
+			188, 220, 39, 173, 174, 63, 182, 44, 255, 175, 45, 217, 110, 233,
+			160, 117, 27, 236, 51, 176, 142, 224, 105, 91, 229, 150, 165, 84,
+			116, 40, 210, 61, 100, 41, 28, 26, 168, 15, 51, 220, 45, 188,
+			182, 254, 86, 152, 131, 242, 13, 50, 87, 91, 142, 86, 159, 110,
+			134, 245, 77, 112, 7, 65, 163, 1, 51, 79, 195, 25, 180, 152,
+			94, 180, 113, 5, 56, 207, 159, 180, 186, 135, 196, 89, 225, 118,
+			67, 181, 226, 254, 46, 184, 130, 113, 57, 221, 186, 226, 219, 178,
+			5, 164, 59, 193, 199, 82, 23, 109, 207, 253, 93, 176, 5, 199,
+			169, 103, 68, 72, 176, 236, 62, 191, 8, 145, 174, 65, 201, 132,
+			158, 137, 149, 152, 24, 14, 53, 154, 233, 15, 216, 242, 105, 203,
+			46, 232, 34, 82, 168, 88, 61, 189, 226, 52, 245, 103, 123, 238,
+			103, 44, 187, 223, 31, 105, 235, 175, 61, 69, 85, 91, 167, 192,
+			140, 207, 88, 182, 41, 82, 55, 189, 125, 226, 4, 117, 234, 120,
+			238, 31, 90, 246, 160, 127, 55, 117, 154, 196, 235, 225, 109, 103,
+			9, 0, 254, 161, 101, 247, 235, 162, 141, 162, 183, 91, 204, 81,
+			135, 174, 231, 254, 145, 101, 239, 241, 239, 67, 135, 28, 148, 0,
+			8, 13, 162, 2, 127, 246, 242, 26, 220, 111, 155, 27, 213, 56,
+			168, 220, 98, 16, 16, 157, 63, 210, 248, 236, 16, 209, 249, 35,
+			188, 238, 127, 4, 156, 183, 235, 229, 190, 96, 117, 125, 217, 178,
+			252, 119, 57, 45, 248, 204, 175, 219, 168, 197, 233, 91, 109, 90,
+			39, 156, 190, 253, 243, 175, 163, 97, 204, 150, 103, 160, 208, 239,
+			192, 191, 139, 103, 224, 223, 46, 231, 93, 190, 49, 169, 211, 31,
+			76, 214, 155, 181, 73, 100, 23, 133, 213, 46, 157, 172, 115, 213,
+			135, 166, 238, 127, 249, 211, 15, 225, 216, 31, 157, 58, 62, 243,
+			248, 209, 169, 227, 10, 186, 71, 167, 142, 195, 247, 240, 172, 142,
+			73, 0, 246, 27, 91, 248, 5, 171, 123, 15, 189, 85, 93, 28,
+			180, 255, 98, 217, 35, 95, 193, 91, 149, 116, 99, 238, 127, 177,
+			84, 230, 184, 110, 149, 34, 229, 191, 88, 71, 239, 17, 69, 234,
+			209, 242, 220, 47, 226, 232, 14, 210, 205, 11, 26, 212, 254, 82,
+			85, 73, 83, 190, 168, 143, 168, 74, 153, 242, 69, 28, 209, 67,
+			212, 131, 237, 185, 95, 178, 236, 162, 191, 203, 244, 208, 242, 78,
+			85, 25, 84, 190, 100, 217, 7, 116, 145, 62, 144, 135, 197, 81,
+			250, 28, 121, 135, 45, 251, 136, 191, 199, 124, 222, 225, 21, 233,
+			82, 194, 154, 191, 178, 236, 67, 186, 104, 227, 179, 226, 221, 226,
+			188, 176, 221, 188, 151, 251, 239, 86, 215, 183, 217, 150, 127, 42,
+			235, 145, 31, 164, 134, 213, 28, 73, 83, 135, 156, 174, 53, 234,
+			113, 149, 229, 234, 28, 40, 36, 143, 92, 51, 86, 126, 151, 248,
+			143, 16, 125, 229, 243, 93, 94, 238, 57, 219, 126, 139, 237, 104,
+			166, 164, 227, 199, 36, 182, 41, 113, 248, 40, 182, 119, 4, 151,
+			148, 134, 88, 215, 174, 0, 235, 49, 248, 163, 146, 156, 95, 97,
+			3, 111, 53, 122, 34, 107, 108, 158, 180, 28, 138, 204, 103, 81,
+			141, 189, 199, 152, 255, 131, 17, 190, 238, 127, 21, 154, 192, 164,
+			185, 124, 67, 217, 110, 141, 179, 97, 188, 100, 52, 131, 68, 191,
+			124, 61, 108, 36, 99, 120, 180, 132, 155, 90, 194, 20, 144, 29,
+			55, 120, 107, 218, 87, 55, 159, 7, 102, 60, 103, 231, 251, 196,
+			36, 150, 12, 228, 250, 86, 219, 189, 203, 63, 12, 228, 162, 183,
+			20, 192, 166, 71, 173, 135, 27, 113, 18, 53, 226, 58, 243, 188,
+			248, 32, 71, 95, 244, 233, 162, 133, 98, 255, 176, 46, 58, 40,
+			238, 243, 197, 131, 194, 118, 11, 94, 238, 109, 54, 172, 216, 252,
+			82, 235, 22, 165, 3, 5, 157, 135, 66, 20, 151, 2, 162, 118,
+			218, 121, 79, 204, 8, 215, 45, 96, 162, 239, 176, 109, 207, 63,
+			77, 40, 163, 191, 130, 253, 168, 214, 144, 154, 51, 169, 64, 163,
+			15, 119, 188, 174, 51, 129, 22, 72, 18, 250, 14, 155, 69, 128,
+			5, 58, 37, 239, 176, 11, 189, 186, 232, 96, 140, 129, 93, 32,
+			200, 110, 1, 24, 252, 29, 182, 61, 228, 223, 39, 175, 102, 88,
+			62, 182, 152, 191, 213, 4, 64, 36, 210, 49, 33, 118, 252, 142,
+			116, 76, 156, 171, 239, 176, 11, 3, 186, 232, 160, 184, 123, 80,
+			124, 11, 80, 176, 0, 206, 230, 121, 219, 222, 229, 55, 181, 238,
+			176, 30, 174, 232, 254, 235, 225, 74, 50, 185, 22, 6, 149, 132,
+			200, 81, 49, 27, 34, 158, 181, 201, 32, 208, 56, 74, 43, 97,
+			3, 129, 172, 16, 16, 12, 97, 165, 215, 244, 187, 135, 36, 112,
+			227, 192, 224, 150, 238, 212, 134, 20, 245, 148, 17, 66, 228, 249,
+			116, 202, 128, 196, 243, 118, 161, 71, 23, 145, 88, 199, 206, 88,
+			213, 127, 102, 84, 156, 186, 51, 43, 71, 166, 184, 201, 118, 102,
+			245, 197, 31, 183, 196, 14, 101, 126, 156, 120, 15, 139, 62, 134,
+			50, 5, 157, 139, 180, 101, 252, 161, 109, 109, 144, 21, 200, 22,
+			123, 249, 51, 85, 244, 94, 33, 118, 42, 238, 18, 14, 64, 201,
+			94, 155, 140, 214, 15, 118, 232, 36, 172, 215, 163, 198, 12, 53,
+			93, 204, 126, 226, 237, 19, 249, 40, 89, 170, 68, 245, 198, 38,
+			217, 34, 231, 23, 119, 68, 201, 44, 138, 197, 111, 183, 68, 111,
+			203, 232, 255, 179, 22, 206, 222, 33, 177, 83, 45, 119, 105, 45,
+			72, 214, 148, 109, 247, 162, 80, 85, 143, 6, 201, 154, 231, 139,
+			60, 29, 149, 40, 174, 237, 237, 150, 214, 168, 179, 104, 202, 197,
+			27, 162, 39, 187, 140, 219, 77, 166, 187, 117, 50, 195, 34, 167,
+			150, 77, 118, 216, 206, 34, 151, 104, 68, 102, 64, 104, 253, 206,
+			162, 41, 191, 210, 205, 187, 3, 221, 197, 31, 176, 132, 152, 49,
+			16, 235, 56, 236, 157, 118, 222, 157, 118, 238, 205, 10, 17, 223,
+			172, 133, 245, 165, 235, 81, 173, 66, 160, 232, 155, 58, 186, 117,
+			231, 210, 145, 47, 161, 245, 171, 162, 90, 101, 177, 16, 235, 63,
+			143, 45, 138, 221, 29, 90, 192, 16, 122, 230, 209, 233, 139, 143,
+			204, 33, 8, 198, 210, 165, 87, 95, 220, 98, 8, 93, 16, 221,
+			143, 94, 187, 48, 125, 113, 192, 66, 40, 140, 233, 107, 87, 47,
+			93, 32, 43, 182, 1, 251, 171, 51, 236, 253, 231, 69, 21, 107,
+			226, 27, 111, 105, 215, 123, 242, 235, 118, 189, 95, 183, 235, 253,
+			138, 237, 122, 149, 5, 175, 151, 90, 240, 226, 207, 251, 149, 5,
+			239, 80, 87, 201, 242, 199, 181, 95, 56, 191, 87, 13, 111, 195,
+			129, 155, 72, 234, 130, 7, 71, 88, 201, 88, 245, 14, 229, 251,
+			197, 187, 109, 109, 214, 123, 208, 190, 219, 255, 78, 54, 169, 130,
+			32, 136, 185, 17, 60, 17, 96, 69, 196, 252, 82, 34, 203, 107,
+			97, 249, 186, 142, 137, 121, 33, 168, 85, 2, 117, 177, 131, 19,
+			170, 133, 80, 204, 6, 245, 205, 113, 121, 51, 100, 129, 86, 80,
+			169, 104, 62, 136, 224, 148, 229, 124, 116, 159, 96, 98, 36, 124,
+			226, 20, 143, 132, 75, 140, 83, 212, 215, 194, 120, 133, 4, 207,
+			58, 112, 40, 233, 233, 185, 154, 56, 46, 206, 193, 209, 66, 168,
+			101, 235, 229, 98, 18, 104, 200, 43, 134, 203, 90, 12, 111, 68,
+			216, 12, 153, 220, 168, 45, 213, 117, 65, 5, 135, 150, 82, 150,
+			74, 37, 33, 159, 21, 108, 38, 12, 99, 197, 131, 118, 127, 198,
+			110, 249, 224, 192, 65, 93, 114, 60, 231, 224, 225, 162, 248, 162,
+			165, 13, 151, 143, 218, 163, 254, 31, 43, 38, 51, 189, 105, 32,
+			121, 85, 215, 57, 137, 188, 181, 183, 96, 115, 131, 153, 142, 118,
+			152, 51, 104, 68, 43, 188, 231, 74, 171, 37, 62, 204, 88, 215,
+			211, 205, 176, 25, 202, 70, 125, 243, 169, 120, 153, 40, 201, 180,
+			214, 191, 30, 111, 27, 186, 221, 169, 159, 19, 109, 41, 99, 162,
+			58, 130, 215, 214, 149, 192, 145, 157, 241, 140, 216, 69, 199, 31,
+			69, 151, 120, 200, 225, 161, 170, 47, 204, 146, 134, 143, 229, 122,
+			206, 81, 219, 148, 114, 158, 115, 116, 231, 30, 93, 2, 68, 246,
+			222, 173, 75, 142, 231, 28, 189, 103, 68, 252, 170, 194, 59, 219,
+			115, 38, 236, 65, 255, 103, 109, 249, 234, 84, 242, 69, 9, 146,
+			1, 163, 218, 38, 175, 34, 81, 178, 75, 22, 167, 50, 104, 198,
+			181, 162, 158, 4, 53, 21, 228, 220, 185, 17, 178, 225, 140, 9,
+			101, 17, 100, 97, 26, 176, 119, 36, 2, 39, 211, 145, 104, 110,
+			172, 214, 3, 112, 209, 28, 194, 150, 115, 219, 41, 30, 157, 189,
+			150, 73, 228, 88, 135, 220, 21, 150, 176, 229, 104, 131, 164, 128,
+			114, 102, 45, 172, 215, 55, 39, 54, 34, 101, 139, 23, 100, 224,
+			141, 103, 104, 35, 222, 208, 212, 147, 118, 150, 118, 49, 110, 17,
+			144, 39, 17, 57, 90, 67, 126, 125, 93, 171, 153, 120, 105, 146,
+			248, 145, 212, 66, 3, 12, 52, 61, 35, 116, 152, 92, 40, 41,
+			212, 114, 25, 174, 48, 148, 153, 176, 115, 186, 100, 121, 206, 196,
+			14, 141, 175, 48, 148, 153, 240, 118, 139, 119, 34, 57, 158, 229,
+			185, 103, 186, 46, 88, 254, 183, 216, 109, 71, 70, 163, 6, 219,
+			200, 49, 215, 161, 23, 161, 79, 44, 35, 30, 105, 167, 181, 21,
+			184, 128, 247, 128, 214, 207, 140, 107, 255, 95, 205, 242, 143, 152,
+			143, 170, 241, 106, 73, 94, 136, 235, 6, 13, 73, 136, 59, 78,
+			173, 87, 76, 51, 65, 237, 132, 144, 243, 13, 165, 171, 110, 157,
+			24, 248, 141, 73, 102, 106, 38, 235, 33, 136, 211, 250, 114, 84,
+			227, 64, 48, 218, 19, 89, 119, 21, 62, 3, 38, 79, 70, 181,
+			113, 54, 2, 68, 108, 133, 140, 65, 139, 182, 230, 63, 147, 31,
+			18, 79, 104, 107, 254, 7, 108, 207, 127, 44, 227, 143, 219, 216,
+			108, 135, 194, 157, 62, 77, 178, 244, 145, 109, 206, 97, 72, 246,
+			0, 155, 5, 145, 14, 205, 121, 160, 208, 171, 75, 142, 231, 60,
+			48, 176, 139, 236, 155, 161, 64, 115, 30, 180, 135, 200, 190, 89,
+			195, 146, 109, 106, 106, 100, 83, 179, 253, 243, 164, 195, 176, 176,
+			243, 122, 208, 12, 139, 37, 63, 88, 24, 208, 37, 199, 115, 30,
+			220, 77, 209, 39, 1, 1, 219, 115, 166, 237, 93, 254, 55, 91,
+			255, 75, 158, 41, 29, 230, 14, 220, 158, 54, 115, 7, 92, 166,
+			217, 146, 202, 194, 131, 197, 153, 238, 31, 128, 168, 218, 133, 86,
+			198, 153, 181, 247, 249, 231, 245, 204, 175, 60, 58, 61, 113, 130,
+			44, 153, 79, 29, 191, 189, 49, 73, 135, 161, 97, 196, 53, 107,
+			134, 134, 17, 215, 108, 97, 80, 151, 48, 218, 158, 189, 226, 231,
+			65, 202, 44, 219, 69, 20, 134, 33, 255, 199, 17, 105, 1, 254,
+			8, 120, 12, 55, 226, 70, 80, 229, 168, 4, 28, 62, 155, 162,
+			173, 106, 207, 236, 149, 18, 194, 130, 211, 238, 70, 55, 194, 113,
+			178, 36, 110, 196, 53, 214, 110, 68, 53, 232, 55, 160, 34, 66,
+			148, 229, 112, 85, 91, 116, 33, 97, 198, 250, 58, 231, 194, 98,
+			183, 4, 197, 183, 161, 169, 182, 65, 94, 222, 228, 91, 20, 67,
+			173, 66, 2, 197, 146, 51, 88, 218, 173, 70, 141, 9, 21, 28,
+			30, 1, 197, 213, 179, 66, 110, 84, 155, 171, 81, 173, 36, 47,
+			17, 33, 214, 185, 40, 91, 45, 90, 2, 36, 218, 175, 86, 17,
+			13, 76, 86, 99, 24, 0, 5, 73, 54, 71, 141, 54, 54, 132,
+			28, 5, 231, 44, 137, 18, 8, 97, 171, 155, 116, 247, 7, 188,
+			230, 173, 128, 118, 41, 212, 198, 14, 93, 66, 72, 139, 188, 198,
+			79, 215, 241, 156, 133, 221, 131, 66, 10, 18, 121, 94, 238, 90,
+			182, 252, 65, 57, 109, 166, 205, 47, 10, 62, 206, 192, 143, 203,
+			249, 65, 241, 168, 118, 124, 88, 180, 61, 255, 156, 110, 140, 67,
+			131, 67, 212, 126, 112, 38, 192, 2, 132, 55, 59, 29, 98, 237,
+			148, 208, 141, 174, 242, 186, 4, 35, 100, 62, 184, 54, 241, 0,
+			139, 3, 187, 196, 111, 91, 152, 64, 1, 209, 19, 122, 253, 95,
+			177, 228, 108, 147, 46, 173, 64, 174, 69, 16, 230, 96, 95, 229,
+			122, 148, 52, 130, 235, 33, 43, 160, 233, 41, 65, 50, 163, 68,
+			11, 141, 64, 205, 234, 136, 145, 187, 190, 17, 52, 72, 91, 106,
+			248, 245, 244, 169, 99, 130, 42, 52, 107, 141, 168, 10, 136, 214,
+			203, 107, 136, 102, 119, 170, 36, 71, 231, 75, 115, 37, 195, 115,
+			41, 197, 97, 37, 68, 240, 249, 160, 26, 189, 81, 199, 31, 175,
+			134, 171, 65, 121, 83, 200, 76, 253, 214, 238, 73, 229, 183, 19,
+			144, 44, 116, 121, 206, 107, 118, 170, 99, 103, 23, 186, 172, 150,
+			146, 173, 74, 39, 209, 16, 240, 127, 189, 61, 228, 223, 163, 65,
+			206, 196, 122, 59, 73, 10, 117, 1, 3, 46, 231, 245, 6, 186,
+			160, 79, 175, 103, 250, 100, 19, 207, 240, 250, 221, 131, 98, 140,
+			186, 183, 61, 231, 9, 123, 183, 191, 159, 103, 203, 57, 14, 184,
+			119, 50, 194, 52, 157, 130, 112, 60, 97, 239, 208, 37, 203, 115,
+			158, 200, 247, 233, 146, 227, 57, 79, 236, 242, 196, 40, 117, 234,
+			120, 78, 96, 15, 249, 119, 201, 203, 64, 38, 138, 212, 210, 218,
+			175, 233, 19, 20, 33, 48, 125, 130, 34, 4, 140, 168, 54, 81,
+			132, 96, 247, 160, 40, 42, 191, 149, 176, 235, 105, 203, 31, 150,
+			211, 114, 85, 1, 34, 229, 16, 24, 85, 241, 117, 152, 247, 8,
+			85, 161, 116, 113, 86, 191, 22, 168, 170, 156, 89, 86, 25, 152,
+			164, 144, 113, 86, 25, 85, 149, 51, 203, 234, 192, 46, 2, 38,
+			210, 2, 59, 79, 221, 17, 48, 29, 218, 161, 167, 120, 225, 148,
+			48, 221, 121, 138, 129, 233, 208, 14, 61, 197, 192, 68, 98, 97,
+			103, 253, 78, 128, 233, 144, 121, 239, 186, 233, 19, 179, 89, 103,
+			96, 58, 68, 217, 215, 119, 15, 18, 82, 57, 40, 108, 216, 71,
+			253, 123, 232, 90, 134, 196, 0, 87, 50, 137, 1, 244, 221, 220,
+			2, 93, 213, 189, 147, 195, 87, 67, 220, 33, 160, 189, 49, 44,
+			117, 9, 61, 222, 125, 68, 204, 147, 69, 160, 219, 232, 250, 70,
+			203, 127, 72, 182, 201, 18, 218, 212, 128, 102, 188, 96, 187, 45,
+			237, 6, 176, 27, 221, 123, 73, 145, 68, 207, 170, 27, 246, 221,
+			254, 145, 182, 183, 0, 79, 156, 89, 65, 114, 123, 48, 65, 46,
+			208, 193, 13, 251, 160, 46, 217, 158, 115, 227, 112, 145, 45, 219,
+			44, 207, 217, 180, 123, 252, 163, 237, 221, 193, 32, 247, 38, 155,
+			107, 6, 202, 56, 219, 244, 135, 141, 218, 180, 119, 100, 226, 114,
+			109, 138, 157, 162, 194, 54, 105, 206, 179, 246, 46, 255, 213, 183,
+			236, 143, 21, 238, 113, 173, 36, 71, 233, 249, 145, 225, 135, 97,
+			39, 101, 52, 242, 178, 185, 81, 15, 111, 104, 79, 139, 210, 152,
+			158, 1, 182, 245, 89, 19, 182, 131, 6, 205, 8, 24, 127, 103,
+			167, 56, 119, 135, 2, 70, 54, 77, 90, 194, 165, 184, 125, 248,
+			142, 226, 140, 232, 125, 88, 181, 100, 39, 253, 41, 49, 180, 81,
+			143, 214, 131, 250, 230, 18, 69, 183, 90, 98, 226, 198, 34, 172,
+			221, 252, 227, 28, 126, 187, 160, 126, 250, 234, 100, 64, 111, 22,
+			74, 6, 84, 252, 186, 111, 247, 215, 125, 187, 191, 198, 190, 221,
+			29, 101, 64, 83, 90, 6, 84, 180, 252, 123, 228, 124, 38, 95,
+			170, 74, 64, 219, 102, 92, 217, 38, 253, 25, 18, 63, 234, 106,
+			233, 207, 97, 251, 110, 255, 123, 93, 194, 76, 58, 35, 134, 191,
+			32, 75, 11, 36, 40, 92, 87, 207, 78, 50, 77, 170, 164, 102,
+			77, 108, 50, 201, 46, 31, 58, 185, 182, 14, 176, 212, 216, 210,
+			31, 201, 16, 210, 192, 73, 90, 206, 16, 212, 54, 33, 230, 41,
+			95, 39, 247, 54, 216, 112, 32, 130, 165, 246, 141, 189, 169, 77,
+			163, 50, 49, 147, 20, 23, 19, 212, 228, 28, 133, 46, 32, 235,
+			30, 40, 157, 94, 25, 220, 80, 75, 46, 9, 57, 175, 24, 109,
+			98, 250, 57, 238, 89, 6, 24, 178, 162, 216, 51, 147, 217, 81,
+			25, 16, 113, 122, 120, 69, 66, 40, 93, 236, 166, 64, 176, 183,
+			176, 130, 48, 47, 224, 100, 131, 70, 80, 53, 134, 146, 28, 5,
+			138, 98, 180, 197, 73, 88, 27, 111, 13, 163, 109, 18, 184, 136,
+			78, 221, 107, 127, 46, 19, 91, 140, 66, 52, 109, 52, 54, 57,
+			129, 148, 14, 221, 200, 226, 27, 109, 84, 174, 77, 246, 51, 118,
+			176, 144, 86, 80, 180, 37, 227, 141, 28, 189, 209, 152, 238, 105,
+			144, 233, 244, 230, 225, 211, 112, 154, 104, 196, 152, 106, 178, 30,
+			16, 45, 39, 199, 249, 19, 199, 167, 78, 153, 48, 240, 66, 94,
+			187, 250, 240, 196, 253, 124, 157, 82, 200, 127, 231, 48, 179, 21,
+			100, 50, 237, 28, 46, 100, 165, 96, 135, 15, 23, 13, 89, 255,
+			252, 115, 182, 56, 125, 135, 116, 157, 87, 147, 220, 54, 32, 211,
+			118, 241, 150, 252, 175, 38, 240, 147, 255, 85, 233, 181, 252, 255,
+			153, 203, 170, 248, 14, 91, 244, 177, 15, 207, 162, 10, 255, 151,
+			213, 216, 88, 173, 26, 155, 139, 162, 39, 99, 224, 171, 149, 91,
+			247, 118, 80, 145, 180, 244, 88, 74, 3, 64, 45, 238, 108, 152,
+			191, 19, 255, 59, 44, 33, 210, 223, 188, 67, 98, 39, 135, 6,
+			91, 106, 4, 171, 60, 184, 224, 170, 171, 193, 170, 183, 71, 236,
+			192, 231, 75, 38, 132, 80, 14, 197, 249, 10, 148, 119, 173, 171,
+			219, 235, 108, 167, 188, 107, 185, 136, 23, 123, 249, 51, 117, 47,
+			23, 95, 114, 68, 191, 129, 6, 60, 145, 147, 208, 187, 46, 134,
+			25, 29, 194, 202, 82, 203, 242, 85, 64, 170, 211, 183, 88, 190,
+			234, 66, 151, 195, 74, 6, 16, 131, 229, 173, 149, 137, 183, 40,
+			60, 174, 71, 26, 44, 22, 20, 114, 180, 173, 187, 183, 29, 40,
+			170, 173, 242, 77, 188, 184, 171, 220, 94, 229, 191, 203, 22, 187,
+			59, 204, 224, 246, 224, 126, 66, 228, 185, 55, 189, 213, 231, 191,
+			170, 181, 234, 58, 21, 156, 203, 244, 233, 63, 43, 122, 178, 191,
+			120, 103, 133, 224, 223, 176, 195, 152, 207, 206, 169, 187, 182, 29,
+			113, 190, 178, 88, 224, 230, 243, 21, 239, 132, 112, 150, 155, 171,
+			123, 237, 237, 182, 189, 37, 40, 214, 34, 218, 22, 127, 197, 18,
+			187, 102, 218, 161, 229, 77, 8, 207, 248, 22, 37, 102, 7, 48,
+			153, 238, 197, 93, 233, 47, 186, 249, 203, 69, 111, 189, 89, 13,
+			211, 150, 183, 143, 140, 214, 67, 31, 232, 14, 166, 17, 131, 190,
+			182, 18, 165, 187, 237, 220, 182, 135, 94, 245, 5, 119, 81, 28,
+			17, 187, 30, 9, 27, 173, 103, 14, 74, 81, 60, 213, 120, 91,
+			233, 239, 226, 191, 113, 197, 14, 110, 214, 233, 119, 96, 196, 90,
+			144, 44, 241, 29, 71, 192, 204, 47, 138, 181, 32, 225, 144, 219,
+			136, 125, 214, 136, 26, 213, 144, 53, 200, 170, 224, 189, 66, 236,
+			88, 15, 27, 245, 168, 156, 236, 21, 116, 36, 238, 217, 118, 211,
+			74, 23, 84, 67, 133, 10, 250, 51, 239, 130, 184, 27, 108, 216,
+			141, 160, 26, 214, 26, 75, 250, 40, 235, 100, 62, 81, 92, 91,
+			2, 204, 246, 238, 160, 81, 101, 218, 148, 15, 179, 222, 92, 28,
+			128, 102, 53, 244, 139, 34, 55, 19, 55, 107, 141, 4, 180, 172,
+			22, 175, 35, 255, 5, 45, 212, 89, 212, 69, 255, 215, 44, 209,
+			7, 136, 226, 74, 228, 198, 15, 136, 29, 113, 45, 92, 170, 4,
+			155, 188, 139, 114, 251, 117, 168, 79, 22, 115, 113, 45, 156, 13,
+			54, 189, 135, 68, 129, 178, 52, 210, 199, 206, 29, 126, 156, 167,
+			79, 248, 243, 36, 188, 17, 214, 232, 115, 247, 78, 63, 167, 79,
+			102, 131, 77, 191, 34, 122, 178, 112, 237, 16, 180, 238, 101, 217,
+			160, 117, 59, 167, 70, 183, 239, 188, 21, 38, 28, 222, 142, 3,
+			227, 29, 124, 36, 108, 44, 134, 124, 232, 16, 33, 175, 30, 175,
+			130, 13, 189, 21, 206, 125, 212, 18, 131, 157, 190, 233, 136, 128,
+			227, 194, 219, 224, 223, 151, 54, 194, 250, 210, 122, 84, 101, 60,
+			236, 94, 28, 208, 191, 92, 14, 235, 23, 80, 239, 221, 39, 220,
+			106, 144, 40, 187, 130, 59, 36, 143, 244, 1, 62, 172, 33, 204,
+			93, 238, 43, 248, 16, 31, 20, 223, 110, 139, 253, 143, 193, 154,
+			149, 27, 92, 105, 174, 175, 7, 245, 40, 76, 110, 127, 119, 30,
+			77, 175, 40, 101, 113, 205, 87, 152, 190, 129, 56, 94, 218, 62,
+			145, 39, 225, 236, 210, 242, 38, 31, 178, 29, 84, 62, 191, 233,
+			237, 77, 143, 153, 43, 157, 209, 66, 122, 124, 206, 10, 129, 112,
+			43, 75, 20, 218, 104, 111, 247, 118, 132, 211, 4, 128, 92, 44,
+			52, 76, 44, 200, 251, 133, 11, 49, 35, 193, 162, 111, 234, 200,
+			182, 176, 80, 75, 221, 124, 60, 10, 111, 46, 210, 23, 197, 154,
+			56, 176, 13, 44, 248, 230, 188, 32, 244, 109, 180, 148, 232, 31,
+			57, 138, 163, 188, 221, 56, 139, 3, 229, 182, 110, 139, 223, 227,
+			136, 190, 214, 70, 255, 83, 55, 134, 161, 101, 28, 199, 81, 209,
+			50, 190, 71, 156, 59, 191, 71, 188, 71, 210, 125, 217, 65, 139,
+			155, 184, 221, 226, 58, 83, 65, 127, 65, 236, 84, 63, 60, 14,
+			27, 215, 52, 208, 36, 48, 201, 225, 147, 232, 141, 136, 254, 74,
+			16, 85, 55, 151, 150, 235, 97, 112, 189, 18, 223, 196, 149, 227,
+			140, 58, 139, 125, 84, 125, 94, 215, 250, 107, 183, 37, 10, 231,
+			91, 137, 194, 248, 29, 78, 155, 102, 151, 37, 12, 175, 19, 119,
+			101, 17, 129, 137, 178, 57, 19, 195, 34, 167, 18, 185, 243, 216,
+			92, 242, 238, 22, 189, 10, 110, 173, 7, 162, 71, 85, 170, 243,
+			80, 172, 136, 253, 157, 251, 102, 28, 155, 21, 121, 62, 64, 26,
+			181, 58, 208, 182, 89, 120, 76, 213, 202, 141, 214, 78, 22, 205,
+			151, 197, 223, 222, 33, 134, 59, 55, 202, 242, 156, 86, 11, 207,
+			121, 82, 236, 224, 24, 69, 12, 191, 125, 219, 70, 38, 93, 212,
+			45, 189, 105, 209, 183, 129, 12, 93, 120, 208, 81, 30, 135, 59,
+			185, 237, 205, 23, 56, 192, 222, 235, 68, 175, 49, 143, 94, 170,
+			55, 107, 100, 168, 212, 145, 13, 237, 188, 162, 82, 214, 18, 123,
+			177, 199, 244, 181, 216, 172, 121, 199, 196, 174, 40, 89, 34, 111,
+			182, 37, 237, 150, 67, 244, 36, 191, 216, 31, 37, 231, 81, 63,
+			195, 213, 222, 107, 68, 79, 198, 29, 38, 217, 155, 35, 220, 63,
+			117, 199, 211, 200, 186, 5, 182, 244, 228, 189, 66, 244, 168, 41,
+			192, 202, 162, 137, 83, 5, 210, 116, 96, 107, 207, 25, 87, 233,
+			197, 157, 203, 105, 193, 59, 37, 134, 35, 40, 233, 27, 97, 101,
+			41, 213, 10, 99, 15, 243, 180, 135, 131, 250, 215, 121, 243, 227,
+			124, 197, 155, 17, 7, 163, 100, 169, 211, 135, 148, 99, 53, 172,
+			236, 45, 16, 40, 238, 138, 146, 249, 45, 223, 159, 87, 77, 188,
+			151, 181, 218, 255, 41, 134, 168, 67, 32, 211, 84, 174, 219, 106,
+			253, 55, 40, 186, 203, 184, 223, 247, 238, 164, 91, 79, 21, 252,
+			87, 138, 157, 25, 120, 121, 231, 68, 142, 223, 57, 192, 201, 190,
+			78, 87, 88, 22, 188, 212, 116, 145, 63, 241, 63, 103, 137, 158,
+			44, 14, 120, 175, 20, 3, 45, 24, 149, 210, 210, 14, 100, 58,
+			251, 229, 124, 101, 177, 47, 139, 63, 138, 170, 146, 40, 153, 15,
+			179, 42, 120, 103, 132, 11, 191, 19, 66, 246, 190, 169, 226, 173,
+			123, 133, 183, 203, 34, 181, 247, 30, 20, 57, 198, 1, 119, 187,
+			235, 41, 251, 37, 163, 2, 127, 83, 156, 17, 99, 89, 218, 161,
+			33, 162, 158, 97, 124, 52, 111, 71, 165, 138, 223, 108, 137, 99,
+			119, 210, 11, 211, 163, 43, 162, 151, 200, 5, 31, 120, 77, 148,
+			74, 91, 39, 126, 171, 254, 22, 123, 26, 105, 33, 41, 254, 133,
+			37, 246, 223, 170, 249, 215, 152, 72, 189, 66, 236, 215, 135, 95,
+			243, 226, 120, 20, 232, 129, 217, 110, 210, 215, 109, 248, 64, 39,
+			233, 212, 188, 57, 49, 0, 70, 203, 124, 132, 103, 141, 123, 219,
+			103, 77, 127, 53, 72, 26, 186, 23, 60, 108, 222, 97, 9, 63,
+			11, 251, 71, 73, 103, 185, 249, 53, 99, 182, 60, 225, 86, 130,
+			205, 132, 23, 68, 127, 111, 207, 101, 21, 31, 23, 119, 117, 156,
+			12, 239, 252, 125, 220, 153, 218, 240, 237, 153, 74, 254, 110, 54,
+			216, 92, 164, 15, 138, 63, 145, 190, 67, 211, 223, 188, 87, 166,
+			243, 80, 61, 30, 191, 131, 30, 59, 51, 22, 96, 181, 17, 22,
+			157, 129, 64, 127, 251, 103, 111, 203, 30, 24, 254, 3, 159, 117,
+			103, 46, 252, 99, 151, 132, 215, 202, 25, 128, 43, 244, 142, 8,
+			57, 179, 112, 237, 10, 34, 252, 94, 185, 118, 225, 194, 244, 226,
+			107, 151, 30, 159, 159, 123, 245, 86, 43, 87, 72, 190, 103, 6,
+			44, 47, 47, 220, 135, 175, 45, 44, 12, 216, 83, 239, 206, 137,
+			60, 247, 152, 120, 151, 211, 71, 106, 7, 250, 195, 63, 49, 14,
+			248, 135, 111, 209, 66, 109, 76, 177, 203, 123, 68, 56, 143, 132,
+			13, 175, 195, 158, 108, 121, 55, 251, 251, 182, 237, 176, 216, 229,
+			53, 197, 158, 109, 158, 64, 94, 135, 237, 185, 245, 107, 201, 239,
+			240, 74, 238, 212, 188, 216, 229, 189, 81, 12, 117, 228, 180, 189,
+			14, 100, 165, 99, 67, 61, 228, 228, 29, 183, 55, 176, 187, 41,
+			6, 179, 77, 244, 97, 247, 38, 110, 221, 149, 110, 167, 71, 46,
+			221, 105, 115, 51, 240, 15, 88, 124, 222, 58, 146, 188, 196, 59,
+			119, 235, 30, 59, 127, 165, 167, 243, 224, 87, 247, 177, 153, 220,
+			186, 232, 161, 246, 124, 244, 188, 241, 91, 247, 199, 205, 244, 232,
+			19, 119, 216, 90, 15, 247, 213, 233, 254, 190, 240, 59, 150, 138,
+			236, 252, 93, 221, 95, 215, 254, 125, 93, 251, 247, 119, 161, 253,
+			227, 112, 207, 131, 105, 184, 231, 161, 174, 135, 232, 79, 219, 115,
+			134, 187, 94, 70, 127, 58, 200, 43, 56, 39, 222, 214, 43, 236,
+			92, 151, 215, 125, 95, 215, 119, 91, 150, 255, 255, 244, 200, 203,
+			58, 235, 140, 206, 222, 145, 85, 43, 101, 130, 96, 66, 255, 85,
+			209, 72, 129, 21, 170, 72, 42, 74, 179, 20, 39, 8, 27, 192,
+			87, 9, 20, 79, 211, 166, 15, 4, 131, 146, 171, 245, 184, 169,
+			236, 96, 181, 214, 13, 84, 74, 97, 31, 66, 135, 67, 27, 147,
+			90, 224, 225, 1, 85, 110, 55, 224, 205, 206, 133, 34, 143, 67,
+			199, 153, 198, 56, 203, 120, 145, 166, 253, 147, 170, 47, 89, 35,
+			172, 49, 195, 80, 71, 144, 123, 145, 130, 142, 219, 114, 20, 154,
+			146, 188, 218, 62, 12, 153, 158, 46, 115, 18, 30, 30, 12, 65,
+			65, 86, 34, 99, 104, 129, 120, 158, 19, 235, 113, 37, 90, 137,
+			40, 216, 130, 238, 51, 35, 73, 149, 144, 164, 202, 81, 101, 248,
+			173, 235, 195, 68, 108, 129, 197, 114, 115, 117, 172, 164, 244, 149,
+			81, 194, 17, 74, 51, 218, 189, 68, 187, 131, 34, 30, 100, 188,
+			17, 194, 58, 216, 132, 61, 94, 222, 204, 54, 221, 58, 60, 219,
+			139, 199, 53, 181, 67, 25, 136, 106, 189, 98, 132, 40, 30, 181,
+			77, 179, 149, 106, 211, 67, 196, 2, 230, 170, 150, 180, 237, 104,
+			43, 90, 118, 6, 61, 207, 101, 155, 175, 5, 192, 113, 99, 64,
+			59, 174, 109, 1, 113, 14, 128, 15, 144, 197, 166, 193, 109, 17,
+			86, 122, 98, 107, 180, 186, 78, 214, 79, 42, 66, 227, 74, 179,
+			188, 150, 68, 65, 177, 164, 191, 99, 98, 142, 238, 211, 72, 107,
+			109, 17, 212, 90, 2, 168, 201, 105, 138, 86, 198, 116, 6, 68,
+			109, 116, 122, 163, 30, 85, 97, 67, 55, 53, 54, 222, 26, 246,
+			140, 28, 154, 229, 8, 86, 140, 57, 77, 220, 56, 57, 66, 246,
+			140, 172, 68, 144, 39, 153, 78, 162, 27, 52, 154, 64, 171, 142,
+			81, 221, 198, 229, 136, 66, 185, 109, 251, 208, 251, 136, 190, 56,
+			70, 82, 231, 142, 176, 69, 35, 192, 175, 100, 226, 198, 212, 136,
+			161, 64, 170, 6, 70, 231, 149, 204, 12, 208, 155, 249, 182, 132,
+			210, 232, 116, 21, 1, 116, 86, 215, 164, 246, 109, 70, 216, 83,
+			53, 153, 176, 50, 158, 246, 149, 126, 103, 230, 26, 37, 114, 45,
+			170, 84, 194, 26, 58, 194, 192, 28, 28, 143, 204, 66, 9, 234,
+			111, 194, 141, 185, 196, 47, 132, 103, 39, 169, 163, 201, 55, 225,
+			31, 68, 170, 148, 65, 21, 1, 176, 51, 81, 12, 209, 81, 102,
+			123, 178, 7, 13, 213, 42, 207, 36, 127, 142, 240, 46, 82, 190,
+			58, 132, 225, 60, 2, 174, 200, 213, 102, 80, 15, 106, 141, 16,
+			241, 199, 200, 238, 1, 159, 164, 113, 176, 226, 149, 204, 18, 176,
+			51, 9, 59, 210, 106, 107, 5, 137, 51, 208, 224, 88, 34, 72,
+			217, 183, 17, 60, 221, 12, 57, 32, 29, 89, 210, 242, 250, 8,
+			221, 166, 107, 105, 119, 19, 122, 158, 122, 222, 105, 56, 60, 182,
+			136, 136, 146, 150, 214, 202, 253, 128, 243, 239, 97, 75, 120, 15,
+			16, 141, 37, 104, 200, 12, 10, 202, 96, 27, 139, 101, 53, 173,
+			113, 109, 69, 1, 63, 99, 153, 157, 125, 80, 211, 11, 160, 55,
+			68, 235, 244, 37, 81, 87, 192, 71, 161, 206, 72, 58, 187, 241,
+			150, 13, 72, 23, 162, 146, 38, 242, 142, 165, 97, 149, 209, 23,
+			59, 100, 44, 130, 202, 204, 207, 106, 4, 70, 199, 28, 16, 132,
+			45, 161, 51, 29, 43, 11, 148, 28, 172, 7, 238, 203, 15, 136,
+			255, 123, 135, 112, 115, 100, 40, 247, 42, 247, 65, 255, 143, 118,
+			112, 64, 216, 149, 168, 245, 43, 19, 183, 168, 134, 20, 113, 228,
+			168, 210, 114, 167, 32, 118, 145, 14, 79, 185, 120, 121, 134, 3,
+			24, 182, 116, 145, 96, 122, 68, 210, 50, 132, 139, 61, 141, 132,
+			68, 100, 39, 236, 11, 135, 61, 202, 236, 168, 129, 79, 66, 68,
+			140, 98, 33, 135, 38, 181, 164, 216, 66, 110, 57, 37, 161, 22,
+			108, 25, 231, 98, 253, 173, 106, 9, 178, 143, 148, 110, 203, 136,
+			130, 4, 199, 142, 6, 180, 113, 136, 86, 99, 226, 31, 198, 181,
+			80, 174, 71, 181, 102, 35, 44, 201, 209, 69, 90, 80, 58, 173,
+			68, 104, 218, 109, 226, 142, 116, 30, 8, 84, 226, 142, 166, 205,
+			212, 50, 217, 50, 58, 60, 189, 38, 226, 149, 9, 60, 100, 9,
+			202, 211, 109, 168, 58, 174, 249, 2, 149, 250, 79, 245, 169, 83,
+			81, 194, 108, 92, 173, 78, 104, 240, 32, 192, 5, 248, 85, 208,
+			84, 24, 173, 224, 156, 134, 21, 121, 241, 210, 85, 216, 182, 212,
+			97, 101, 20, 243, 69, 104, 46, 187, 70, 44, 16, 221, 35, 190,
+			41, 235, 161, 166, 66, 140, 125, 43, 1, 0, 210, 62, 190, 94,
+			77, 35, 174, 134, 32, 14, 2, 125, 175, 69, 171, 96, 20, 30,
+			187, 124, 37, 117, 200, 47, 193, 2, 103, 51, 110, 214, 105, 166,
+			116, 218, 152, 79, 5, 1, 136, 107, 171, 224, 17, 42, 137, 96,
+			199, 125, 76, 252, 38, 146, 3, 128, 95, 66, 252, 18, 141, 39,
+			89, 11, 33, 74, 208, 40, 155, 72, 143, 70, 212, 159, 86, 62,
+			54, 46, 228, 70, 53, 196, 0, 112, 64, 9, 202, 13, 126, 6,
+			240, 35, 130, 108, 46, 235, 73, 73, 81, 54, 218, 91, 29, 128,
+			84, 59, 174, 80, 134, 72, 122, 213, 80, 176, 127, 252, 148, 132,
+			8, 8, 101, 160, 5, 211, 75, 12, 201, 25, 203, 154, 245, 150,
+			99, 1, 98, 36, 55, 64, 19, 41, 74, 12, 50, 227, 17, 178,
+			154, 205, 170, 134, 129, 206, 134, 199, 24, 156, 250, 0, 241, 141,
+			207, 24, 68, 100, 177, 117, 254, 105, 62, 185, 20, 38, 196, 43,
+			150, 131, 106, 185, 169, 194, 86, 38, 28, 219, 23, 103, 222, 242,
+			156, 87, 229, 251, 117, 201, 246, 156, 87, 41, 167, 186, 156, 114,
+			170, 123, 213, 216, 253, 226, 251, 93, 34, 15, 150, 231, 188, 198,
+			157, 245, 223, 225, 202, 69, 236, 133, 140, 182, 88, 186, 165, 151,
+			4, 143, 141, 117, 95, 54, 208, 6, 43, 219, 17, 218, 218, 243,
+			43, 168, 32, 22, 153, 12, 76, 102, 73, 218, 92, 160, 15, 34,
+			107, 41, 240, 141, 107, 95, 135, 54, 84, 99, 218, 65, 198, 113,
+			213, 42, 162, 57, 193, 140, 45, 150, 231, 163, 85, 122, 182, 18,
+			136, 17, 211, 16, 129, 173, 198, 83, 15, 176, 164, 49, 17, 111,
+			52, 162, 245, 8, 7, 56, 90, 81, 44, 24, 54, 38, 226, 64,
+			154, 105, 216, 46, 62, 118, 200, 225, 168, 24, 22, 205, 173, 156,
+			56, 57, 206, 215, 5, 205, 18, 95, 111, 42, 132, 149, 223, 120,
+			66, 62, 114, 94, 110, 132, 136, 27, 138, 36, 192, 48, 214, 5,
+			152, 170, 65, 29, 27, 219, 194, 106, 141, 107, 31, 158, 122, 80,
+			75, 16, 16, 14, 43, 7, 227, 139, 96, 174, 241, 138, 60, 94,
+			58, 45, 175, 93, 145, 101, 196, 226, 21, 212, 167, 26, 41, 104,
+			200, 141, 230, 114, 53, 74, 214, 136, 210, 69, 101, 0, 113, 244,
+			218, 149, 35, 167, 39, 175, 158, 151, 244, 224, 127, 35, 199, 231,
+			210, 208, 96, 239, 57, 200, 224, 19, 19, 151, 31, 52, 155, 239,
+			51, 48, 133, 213, 77, 197, 215, 111, 23, 243, 229, 36, 155, 144,
+			3, 53, 128, 27, 249, 157, 186, 4, 79, 132, 158, 3, 186, 228,
+			120, 206, 107, 70, 95, 33, 254, 210, 34, 44, 178, 61, 247, 9,
+			119, 105, 204, 255, 79, 22, 163, 145, 38, 250, 90, 215, 45, 235,
+			225, 4, 163, 143, 113, 156, 33, 198, 131, 73, 43, 66, 93, 100,
+			26, 8, 142, 70, 105, 206, 121, 203, 109, 67, 87, 13, 31, 22,
+			236, 57, 34, 79, 130, 186, 173, 240, 121, 197, 70, 224, 64, 153,
+			183, 64, 167, 155, 100, 203, 117, 212, 194, 3, 242, 51, 50, 243,
+			83, 11, 237, 55, 48, 130, 5, 246, 19, 249, 67, 186, 4, 15,
+			10, 249, 50, 93, 114, 60, 103, 105, 215, 81, 241, 82, 158, 96,
+			228, 120, 238, 77, 247, 153, 41, 255, 211, 121, 249, 24, 99, 162,
+			210, 38, 111, 118, 58, 116, 241, 134, 89, 175, 49, 59, 12, 27,
+			91, 31, 124, 218, 117, 72, 229, 251, 101, 250, 146, 157, 54, 69,
+			52, 17, 250, 245, 28, 86, 50, 174, 75, 173, 162, 101, 157, 23,
+			83, 123, 80, 17, 49, 44, 9, 246, 139, 106, 187, 35, 141, 117,
+			41, 153, 163, 242, 201, 5, 65, 170, 132, 245, 232, 134, 118, 132,
+			33, 190, 93, 245, 158, 94, 144, 137, 161, 155, 116, 235, 96, 234,
+			55, 224, 28, 186, 106, 50, 237, 163, 59, 186, 79, 146, 134, 161,
+			115, 122, 92, 224, 59, 130, 60, 225, 200, 47, 39, 97, 163, 141,
+			181, 231, 160, 126, 53, 25, 164, 152, 213, 230, 181, 202, 171, 85,
+			188, 158, 0, 68, 139, 173, 54, 119, 103, 147, 100, 173, 200, 217,
+			36, 86, 224, 15, 129, 187, 40, 94, 201, 66, 54, 145, 55, 201,
+			153, 22, 100, 5, 251, 33, 244, 107, 77, 226, 154, 196, 50, 138,
+			212, 9, 48, 115, 45, 140, 218, 31, 197, 227, 218, 86, 247, 38,
+			197, 93, 92, 142, 56, 239, 52, 47, 86, 195, 14, 215, 123, 98,
+			48, 61, 97, 84, 207, 190, 29, 83, 78, 184, 153, 132, 43, 205,
+			42, 166, 85, 19, 89, 136, 214, 86, 83, 193, 201, 70, 61, 94,
+			174, 134, 235, 9, 61, 211, 213, 149, 184, 22, 109, 224, 1, 22,
+			152, 8, 223, 88, 77, 242, 181, 161, 240, 34, 75, 131, 254, 158,
+			81, 120, 197, 169, 157, 56, 190, 149, 206, 223, 39, 161, 236, 0,
+			184, 8, 213, 244, 219, 240, 43, 37, 253, 34, 229, 70, 83, 250,
+			127, 27, 242, 47, 238, 128, 254, 43, 210, 3, 135, 156, 155, 121,
+			77, 172, 29, 219, 115, 110, 30, 60, 171, 75, 142, 231, 60, 179,
+			171, 36, 158, 183, 137, 44, 185, 94, 247, 55, 185, 111, 182, 78,
+			248, 207, 217, 134, 46, 241, 105, 33, 152, 165, 216, 87, 251, 255,
+			1, 43, 160, 64, 132, 140, 63, 223, 148, 223, 175, 75, 182, 231,
+			124, 211, 129, 251, 69, 175, 42, 81, 224, 247, 93, 19, 226, 95,
+			40, 248, 117, 123, 185, 183, 90, 238, 219, 172, 151, 251, 239, 77,
+			1, 136, 83, 36, 89, 13, 105, 32, 199, 48, 99, 164, 32, 169,
+			83, 61, 196, 189, 95, 85, 1, 41, 131, 90, 26, 155, 25, 43,
+			134, 51, 106, 29, 46, 244, 58, 62, 111, 99, 45, 92, 47, 201,
+			75, 160, 184, 153, 118, 228, 244, 170, 119, 73, 112, 239, 224, 113,
+			178, 201, 251, 245, 216, 168, 79, 29, 228, 113, 206, 103, 184, 36,
+			147, 184, 122, 195, 120, 185, 26, 134, 61, 141, 174, 181, 12, 135,
+			135, 164, 1, 255, 210, 122, 133, 242, 64, 159, 56, 57, 37, 23,
+			34, 224, 178, 146, 116, 42, 206, 130, 233, 43, 40, 156, 182, 0,
+			211, 30, 147, 42, 186, 83, 174, 11, 193, 172, 221, 183, 90, 249,
+			187, 117, 209, 246, 220, 183, 90, 71, 46, 232, 162, 227, 185, 111,
+			179, 118, 157, 19, 255, 93, 129, 56, 231, 229, 190, 203, 114, 159,
+			183, 142, 251, 255, 57, 3, 98, 186, 36, 72, 29, 193, 73, 144,
+			161, 34, 204, 242, 200, 153, 43, 71, 38, 65, 35, 74, 54, 177,
+			60, 198, 223, 122, 132, 11, 50, 104, 149, 137, 210, 54, 41, 71,
+			61, 115, 211, 82, 48, 97, 246, 106, 136, 153, 250, 86, 130, 205,
+			204, 193, 79, 26, 76, 20, 254, 63, 119, 26, 212, 118, 228, 44,
+			207, 253, 46, 43, 63, 200, 187, 147, 179, 81, 28, 154, 212, 69,
+			196, 181, 178, 118, 141, 139, 30, 242, 152, 201, 189, 203, 234, 250,
+			103, 150, 69, 129, 226, 16, 40, 236, 93, 86, 126, 88, 124, 194,
+			130, 51, 140, 211, 229, 229, 190, 223, 114, 127, 212, 234, 246, 127,
+			217, 146, 169, 89, 117, 26, 186, 124, 43, 31, 20, 180, 138, 20,
+			146, 230, 202, 74, 84, 134, 196, 133, 160, 164, 177, 58, 106, 224,
+			34, 228, 44, 233, 137, 230, 93, 56, 20, 135, 126, 241, 133, 207,
+			108, 4, 36, 16, 136, 111, 64, 16, 31, 173, 111, 137, 104, 193,
+			7, 182, 101, 26, 252, 113, 218, 101, 250, 186, 37, 100, 198, 178,
+			44, 207, 253, 126, 171, 103, 88, 252, 180, 37, 114, 40, 219, 93,
+			158, 251, 110, 43, 127, 200, 255, 17, 75, 94, 82, 242, 162, 70,
+			176, 74, 135, 160, 26, 165, 146, 55, 208, 49, 120, 132, 42, 144,
+			27, 22, 43, 226, 152, 193, 117, 86, 155, 149, 180, 66, 129, 158,
+			143, 65, 2, 89, 175, 74, 49, 134, 119, 31, 61, 162, 85, 67,
+			150, 120, 243, 84, 33, 146, 154, 213, 143, 207, 52, 114, 30, 103,
+			121, 47, 201, 75, 156, 128, 188, 36, 68, 191, 216, 161, 166, 221,
+			77, 243, 238, 75, 43, 44, 84, 244, 251, 105, 133, 131, 138, 3,
+			7, 197, 13, 94, 169, 229, 185, 47, 88, 249, 187, 252, 149, 84,
+			186, 84, 207, 178, 18, 114, 52, 200, 196, 216, 48, 81, 129, 103,
+			207, 143, 49, 240, 181, 240, 89, 179, 107, 38, 157, 124, 131, 242,
+			142, 208, 138, 106, 81, 237, 169, 224, 236, 228, 100, 102, 170, 8,
+			15, 247, 66, 118, 170, 8, 16, 247, 130, 213, 63, 156, 86, 56,
+			168, 216, 231, 139, 179, 60, 85, 219, 115, 127, 196, 202, 159, 245,
+			143, 201, 171, 25, 126, 145, 229, 192, 45, 83, 142, 86, 224, 153,
+			52, 150, 25, 206, 206, 209, 199, 247, 164, 21, 22, 42, 70, 78,
+			167, 21, 14, 42, 238, 127, 128, 194, 37, 64, 234, 230, 254, 152,
+			229, 238, 241, 167, 183, 138, 222, 65, 161, 152, 47, 88, 11, 91,
+			84, 6, 70, 236, 152, 82, 48, 141, 100, 180, 55, 63, 102, 185,
+			166, 72, 177, 230, 119, 122, 186, 232, 160, 56, 52, 44, 214, 56,
+			82, 142, 251, 126, 203, 29, 243, 95, 215, 30, 35, 58, 171, 139,
+			42, 101, 66, 218, 28, 63, 222, 218, 74, 97, 189, 57, 96, 10,
+			43, 227, 154, 57, 7, 102, 90, 150, 75, 67, 245, 232, 98, 14,
+			197, 222, 61, 186, 104, 161, 184, 247, 136, 46, 58, 40, 142, 140,
+			18, 169, 176, 188, 220, 143, 91, 93, 31, 103, 82, 129, 237, 251,
+			113, 43, 191, 71, 188, 28, 17, 31, 64, 41, 126, 210, 114, 63,
+			108, 117, 115, 58, 84, 158, 7, 100, 149, 45, 20, 1, 215, 60,
+			171, 78, 104, 142, 60, 47, 139, 206, 228, 79, 90, 61, 135, 196,
+			163, 34, 135, 254, 208, 227, 7, 172, 252, 191, 177, 10, 254, 25,
+			184, 191, 101, 18, 220, 112, 223, 183, 237, 24, 59, 77, 61, 89,
+			158, 251, 1, 107, 96, 191, 216, 20, 121, 213, 53, 118, 251, 167,
+			173, 158, 51, 254, 42, 231, 112, 137, 112, 228, 163, 45, 7, 130,
+			71, 210, 158, 105, 25, 45, 8, 134, 43, 66, 146, 149, 20, 91,
+			101, 197, 243, 179, 248, 137, 52, 102, 1, 189, 92, 229, 252, 108,
+			73, 136, 93, 162, 160, 135, 206, 209, 216, 247, 100, 171, 44, 84,
+			141, 156, 200, 86, 57, 168, 58, 117, 90, 252, 188, 101, 38, 109,
+			121, 238, 207, 89, 61, 39, 253, 23, 211, 68, 134, 250, 153, 172,
+			195, 209, 102, 102, 50, 14, 222, 41, 168, 109, 166, 47, 14, 188,
+			252, 132, 220, 136, 55, 32, 127, 98, 78, 148, 27, 155, 196, 161,
+			105, 18, 148, 78, 175, 240, 113, 195, 173, 232, 113, 147, 204, 59,
+			149, 191, 98, 158, 153, 50, 119, 101, 86, 100, 229, 104, 250, 199,
+			178, 85, 180, 162, 123, 75, 217, 42, 7, 85, 39, 166, 196, 91,
+			64, 154, 45, 69, 154, 63, 8, 210, 220, 188, 61, 101, 214, 124,
+			155, 198, 249, 14, 116, 152, 51, 7, 17, 33, 55, 52, 57, 220,
+			158, 34, 107, 36, 162, 227, 252, 65, 77, 191, 44, 222, 180, 15,
+			106, 82, 107, 49, 169, 253, 160, 117, 224, 160, 152, 225, 153, 91,
+			158, 251, 33, 43, 127, 175, 127, 50, 123, 34, 146, 45, 68, 164,
+			5, 113, 49, 124, 22, 121, 233, 188, 126, 200, 202, 15, 164, 21,
+			57, 84, 236, 58, 144, 86, 208, 56, 7, 239, 73, 43, 28, 84,
+			140, 29, 19, 223, 102, 115, 24, 31, 247, 215, 44, 231, 156, 255,
+			151, 86, 235, 68, 218, 21, 0, 192, 17, 140, 45, 103, 212, 116,
+			18, 38, 32, 244, 27, 136, 224, 150, 15, 210, 189, 111, 131, 188,
+			224, 107, 67, 177, 40, 44, 178, 53, 173, 40, 57, 44, 177, 154,
+			154, 89, 226, 239, 160, 51, 83, 59, 20, 77, 52, 214, 180, 34,
+			51, 235, 209, 149, 201, 101, 201, 18, 51, 213, 86, 100, 217, 147,
+			246, 201, 40, 18, 99, 119, 185, 4, 136, 157, 186, 152, 67, 177,
+			231, 144, 46, 90, 40, 202, 51, 186, 232, 160, 248, 192, 89, 241,
+			221, 22, 71, 32, 114, 63, 102, 57, 227, 254, 183, 42, 32, 178,
+			220, 157, 30, 92, 29, 197, 81, 227, 90, 97, 88, 219, 70, 237,
+			65, 57, 48, 9, 51, 145, 159, 34, 42, 179, 157, 138, 158, 52,
+			221, 181, 149, 112, 185, 185, 186, 10, 158, 115, 163, 89, 223, 136,
+			147, 48, 155, 152, 1, 177, 139, 114, 52, 171, 65, 93, 164, 73,
+			14, 141, 232, 162, 131, 226, 177, 123, 197, 239, 169, 156, 190, 185,
+			223, 182, 186, 254, 192, 178, 252, 143, 109, 93, 66, 231, 121, 183,
+			204, 87, 77, 183, 93, 208, 45, 182, 179, 149, 80, 82, 156, 128,
+			185, 247, 44, 210, 160, 231, 45, 110, 46, 227, 130, 83, 161, 145,
+			124, 104, 171, 244, 138, 111, 90, 214, 188, 45, 135, 6, 143, 56,
+			138, 46, 110, 208, 223, 182, 242, 251, 16, 180, 29, 1, 106, 60,
+			247, 19, 150, 123, 216, 31, 207, 174, 116, 219, 189, 226, 72, 199,
+			4, 54, 155, 78, 250, 39, 44, 183, 160, 139, 22, 250, 18, 251,
+			117, 209, 65, 241, 144, 20, 31, 182, 56, 194, 139, 251, 41, 203,
+			61, 238, 255, 139, 22, 160, 102, 222, 225, 91, 136, 40, 137, 126,
+			42, 41, 89, 198, 186, 175, 108, 4, 181, 26, 194, 15, 193, 64,
+			35, 202, 166, 17, 231, 155, 8, 6, 170, 244, 181, 84, 70, 22,
+			101, 78, 5, 81, 43, 199, 245, 141, 152, 204, 114, 25, 237, 133,
+			1, 162, 25, 171, 101, 143, 76, 98, 47, 142, 162, 141, 16, 51,
+			57, 90, 196, 1, 93, 164, 53, 29, 188, 87, 23, 29, 20, 75,
+			147, 226, 237, 22, 7, 157, 113, 63, 99, 185, 39, 252, 255, 173,
+			125, 193, 29, 209, 188, 195, 98, 205, 226, 132, 54, 226, 232, 240,
+			25, 194, 102, 25, 189, 97, 59, 214, 153, 153, 131, 205, 251, 76,
+			58, 115, 96, 193, 103, 172, 131, 227, 186, 232, 160, 56, 121, 156,
+			216, 23, 199, 203, 253, 161, 213, 245, 57, 102, 95, 16, 114, 251,
+			15, 129, 47, 255, 212, 226, 128, 51, 238, 31, 91, 238, 160, 255,
+			131, 22, 139, 79, 183, 79, 220, 7, 104, 214, 97, 223, 26, 234,
+			16, 123, 235, 65, 227, 172, 94, 70, 50, 153, 38, 164, 228, 15,
+			146, 201, 55, 241, 95, 75, 6, 231, 158, 77, 235, 40, 5, 165,
+			156, 13, 147, 104, 181, 198, 123, 21, 211, 123, 6, 3, 181, 138,
+			249, 123, 117, 72, 27, 76, 214, 20, 45, 207, 253, 99, 107, 103,
+			191, 46, 58, 40, 122, 187, 105, 209, 174, 151, 251, 79, 86, 215,
+			15, 219, 106, 209, 8, 184, 254, 159, 172, 124, 191, 120, 134, 114,
+			7, 119, 121, 238, 159, 98, 205, 79, 221, 118, 201, 95, 179, 101,
+			210, 28, 93, 58, 98, 127, 170, 87, 160, 162, 184, 255, 169, 94,
+			1, 165, 56, 118, 255, 212, 242, 118, 139, 105, 78, 113, 236, 126,
+			193, 114, 247, 249, 39, 219, 226, 34, 130, 205, 66, 20, 66, 188,
+			45, 181, 56, 128, 41, 63, 143, 105, 198, 195, 227, 227, 11, 150,
+			155, 215, 69, 10, 60, 95, 24, 212, 69, 7, 197, 61, 123, 197,
+			63, 6, 46, 184, 136, 77, 253, 69, 203, 29, 242, 191, 203, 186,
+			77, 122, 79, 51, 140, 18, 241, 180, 114, 86, 91, 212, 21, 90,
+			4, 157, 113, 116, 149, 15, 201, 70, 189, 25, 114, 92, 184, 214,
+			239, 113, 216, 91, 77, 87, 52, 218, 187, 136, 21, 228, 126, 49,
+			133, 31, 64, 244, 69, 107, 231, 128, 46, 58, 40, 238, 30, 20,
+			125, 88, 14, 24, 233, 191, 176, 220, 255, 102, 117, 171, 159, 137,
+			211, 254, 11, 171, 199, 19, 115, 34, 135, 34, 136, 229, 95, 90,
+			121, 159, 67, 142, 43, 67, 9, 222, 127, 37, 164, 145, 163, 208,
+			123, 232, 135, 56, 132, 235, 76, 219, 18, 253, 228, 114, 153, 67,
+			250, 75, 43, 223, 155, 86, 88, 168, 232, 27, 74, 43, 28, 84,
+			236, 221, 199, 19, 179, 188, 220, 151, 45, 247, 155, 109, 61, 49,
+			60, 41, 190, 108, 245, 248, 226, 20, 77, 140, 24, 151, 191, 198,
+			91, 85, 197, 12, 98, 89, 187, 154, 95, 139, 48, 167, 18, 108,
+			154, 121, 168, 123, 253, 175, 53, 167, 70, 21, 22, 42, 248, 165,
+			73, 21, 14, 42, 246, 249, 226, 28, 15, 100, 121, 238, 223, 88,
+			249, 3, 254, 189, 183, 25, 136, 220, 102, 181, 228, 72, 247, 6,
+			198, 246, 111, 178, 227, 97, 29, 127, 99, 245, 239, 77, 43, 28,
+			84, 220, 181, 95, 156, 230, 241, 108, 207, 125, 179, 157, 63, 224,
+			31, 189, 205, 120, 55, 195, 240, 122, 102, 36, 80, 187, 55, 219,
+			153, 145, 176, 241, 111, 182, 51, 35, 217, 144, 117, 218, 119, 237,
+			23, 127, 149, 7, 136, 81, 252, 94, 219, 157, 240, 95, 202, 167,
+			123, 155, 100, 165, 111, 183, 120, 64, 224, 134, 130, 96, 105, 67,
+			94, 15, 55, 53, 237, 158, 159, 21, 237, 184, 65, 154, 238, 34,
+			69, 90, 154, 40, 87, 147, 9, 224, 70, 88, 153, 48, 238, 57,
+			69, 54, 71, 1, 44, 161, 63, 2, 59, 99, 134, 175, 135, 25,
+			201, 24, 191, 65, 200, 0, 238, 86, 29, 82, 222, 230, 171, 45,
+			146, 191, 10, 187, 122, 201, 74, 120, 35, 172, 194, 80, 34, 19,
+			196, 137, 173, 32, 89, 38, 24, 52, 36, 4, 224, 13, 112, 183,
+			232, 200, 244, 43, 71, 103, 30, 27, 163, 44, 13, 58, 235, 84,
+			122, 139, 227, 33, 75, 196, 37, 74, 82, 248, 204, 61, 67, 1,
+			91, 18, 244, 146, 29, 45, 32, 99, 102, 126, 76, 113, 72, 40,
+			88, 28, 98, 89, 218, 119, 101, 66, 31, 161, 9, 45, 25, 14,
+			43, 157, 22, 166, 155, 201, 184, 214, 38, 164, 206, 68, 208, 213,
+			87, 103, 154, 109, 33, 179, 172, 9, 242, 33, 35, 3, 226, 204,
+			39, 233, 168, 156, 83, 142, 115, 5, 202, 160, 220, 104, 82, 154,
+			24, 250, 172, 165, 167, 49, 3, 152, 182, 236, 138, 38, 251, 86,
+			38, 24, 142, 150, 205, 153, 9, 233, 204, 154, 64, 240, 56, 166,
+			4, 115, 164, 52, 111, 68, 27, 48, 169, 105, 212, 67, 29, 132,
+			23, 194, 79, 37, 211, 197, 95, 113, 109, 226, 141, 97, 61, 30,
+			151, 8, 98, 42, 89, 207, 28, 180, 14, 194, 11, 11, 146, 45,
+			160, 232, 0, 7, 9, 182, 85, 146, 124, 117, 75, 106, 176, 74,
+			44, 147, 216, 104, 251, 88, 184, 163, 210, 125, 194, 214, 10, 145,
+			119, 17, 180, 89, 119, 165, 246, 84, 239, 81, 186, 127, 42, 216,
+			101, 186, 139, 89, 206, 120, 43, 30, 181, 240, 54, 252, 76, 151,
+			250, 231, 164, 245, 99, 126, 127, 215, 179, 153, 77, 105, 186, 164,
+			158, 8, 50, 201, 108, 202, 245, 32, 89, 27, 199, 140, 130, 101,
+			4, 156, 102, 75, 95, 188, 177, 128, 152, 116, 216, 34, 182, 15,
+			210, 106, 225, 236, 72, 228, 255, 199, 34, 89, 244, 66, 166, 111,
+			203, 97, 91, 198, 50, 172, 125, 146, 134, 154, 164, 97, 204, 29,
+			229, 228, 136, 246, 28, 210, 69, 11, 69, 57, 162, 139, 68, 153,
+			142, 141, 139, 231, 29, 34, 84, 174, 231, 254, 99, 219, 61, 233,
+			127, 139, 211, 34, 236, 107, 231, 157, 51, 17, 24, 128, 233, 45,
+			212, 234, 178, 185, 62, 177, 22, 241, 183, 118, 9, 179, 141, 20,
+			139, 104, 215, 194, 237, 237, 200, 116, 156, 207, 45, 157, 140, 131,
+			43, 22, 114, 139, 47, 6, 140, 7, 147, 201, 71, 194, 70, 150,
+			199, 76, 237, 8, 137, 66, 207, 207, 202, 117, 196, 18, 100, 155,
+			128, 84, 166, 14, 57, 147, 188, 198, 236, 254, 74, 80, 142, 170,
+			48, 168, 15, 85, 206, 54, 180, 14, 100, 45, 188, 169, 122, 50,
+			134, 114, 193, 86, 16, 153, 29, 116, 187, 105, 83, 76, 209, 66,
+			113, 103, 73, 23, 29, 20, 79, 76, 137, 211, 194, 118, 187, 189,
+			220, 251, 236, 174, 127, 106, 91, 254, 72, 71, 78, 150, 102, 222,
+			202, 203, 130, 33, 133, 86, 233, 125, 118, 254, 30, 241, 44, 37,
+			254, 71, 2, 78, 219, 29, 244, 99, 194, 129, 44, 31, 90, 207,
+			184, 39, 101, 77, 84, 152, 101, 189, 99, 142, 188, 222, 193, 205,
+			137, 215, 219, 77, 92, 233, 139, 122, 189, 221, 196, 190, 188, 104,
+			51, 87, 218, 77, 204, 203, 139, 182, 183, 91, 252, 40, 94, 211,
+			57, 47, 247, 19, 118, 215, 135, 108, 203, 255, 30, 88, 208, 108,
+			237, 53, 213, 145, 240, 27, 167, 147, 85, 77, 32, 248, 125, 76,
+			20, 128, 103, 57, 210, 118, 226, 155, 73, 139, 77, 0, 246, 47,
+			17, 250, 221, 149, 192, 50, 192, 176, 219, 137, 169, 102, 0, 67,
+			19, 244, 19, 118, 126, 191, 120, 154, 50, 191, 119, 121, 238, 7,
+			0, 224, 242, 87, 8, 224, 175, 26, 168, 57, 2, 234, 7, 52,
+			80, 115, 4, 212, 15, 104, 160, 230, 8, 168, 31, 0, 80, 127,
+			9, 172, 119, 14, 252, 204, 207, 216, 238, 97, 255, 95, 34, 13,
+			93, 107, 208, 13, 25, 181, 129, 146, 103, 206, 236, 67, 27, 100,
+			235, 205, 218, 56, 180, 156, 65, 66, 183, 34, 145, 220, 184, 153,
+			4, 181, 74, 99, 45, 145, 163, 224, 15, 40, 196, 199, 88, 9,
+			150, 6, 74, 231, 70, 68, 89, 113, 125, 20, 207, 2, 174, 9,
+			48, 236, 56, 46, 71, 143, 31, 149, 58, 57, 245, 24, 206, 38,
+			9, 224, 71, 79, 28, 207, 214, 155, 37, 227, 181, 241, 51, 54,
+			11, 16, 114, 36, 159, 251, 25, 155, 5, 8, 57, 98, 6, 127,
+			198, 62, 36, 233, 117, 147, 195, 99, 227, 231, 108, 247, 16, 11,
+			9, 87, 227, 192, 88, 174, 16, 199, 169, 123, 175, 108, 93, 159,
+			25, 15, 108, 225, 207, 217, 238, 144, 46, 66, 170, 106, 15, 251,
+			186, 8, 137, 170, 125, 224, 160, 248, 130, 165, 179, 255, 255, 34,
+			6, 252, 3, 107, 203, 136, 219, 130, 178, 164, 82, 199, 210, 221,
+			35, 58, 238, 140, 145, 4, 150, 198, 212, 91, 159, 213, 112, 70,
+			220, 167, 4, 125, 226, 182, 171, 66, 43, 25, 220, 8, 162, 42,
+			221, 204, 6, 181, 13, 102, 143, 139, 109, 37, 84, 80, 131, 215,
+			20, 35, 166, 197, 57, 57, 186, 135, 126, 49, 133, 14, 30, 254,
+			191, 152, 66, 199, 33, 112, 28, 128, 173, 37, 98, 159, 230, 126,
+			201, 238, 250, 184, 122, 45, 35, 124, 169, 251, 75, 118, 247, 30,
+			113, 69, 167, 210, 254, 21, 219, 29, 243, 231, 8, 108, 21, 206,
+			43, 57, 137, 164, 139, 33, 51, 239, 108, 123, 5, 75, 82, 186,
+			44, 117, 35, 190, 169, 206, 79, 95, 153, 159, 145, 136, 96, 194,
+			153, 191, 148, 16, 249, 87, 108, 247, 136, 46, 218, 40, 142, 140,
+			226, 129, 142, 8, 165, 158, 251, 81, 219, 237, 243, 159, 50, 121,
+			43, 73, 251, 195, 74, 211, 214, 183, 174, 54, 77, 27, 151, 115,
+			175, 153, 89, 184, 54, 59, 127, 241, 17, 33, 41, 48, 135, 52,
+			241, 58, 210, 51, 163, 190, 25, 73, 244, 219, 131, 249, 113, 51,
+			47, 224, 236, 71, 25, 133, 57, 127, 247, 71, 237, 158, 94, 72,
+			215, 40, 86, 170, 251, 49, 219, 237, 245, 199, 205, 188, 96, 135,
+			188, 9, 201, 245, 234, 54, 211, 50, 61, 99, 81, 31, 179, 213,
+			83, 28, 69, 27, 69, 138, 165, 108, 187, 59, 188, 220, 111, 216,
+			93, 239, 113, 8, 254, 238, 14, 203, 115, 127, 195, 206, 31, 65,
+			210, 112, 164, 243, 247, 220, 223, 178, 221, 61, 254, 238, 45, 10,
+			58, 222, 234, 29, 68, 107, 126, 75, 211, 154, 29, 4, 220, 223,
+			178, 89, 229, 182, 131, 104, 205, 111, 217, 67, 195, 226, 133, 2,
+			117, 136, 87, 161, 237, 30, 244, 159, 47, 64, 191, 68, 230, 17,
+			103, 142, 203, 164, 177, 137, 139, 91, 153, 68, 100, 108, 138, 91,
+			108, 248, 120, 141, 76, 1, 91, 100, 160, 132, 149, 108, 227, 171,
+			223, 72, 100, 4, 135, 71, 144, 242, 254, 6, 148, 216, 190, 2,
+			34, 140, 212, 44, 238, 245, 122, 18, 43, 186, 221, 27, 70, 183,
+			51, 251, 60, 115, 156, 158, 85, 153, 196, 132, 144, 180, 195, 2,
+			100, 34, 9, 107, 73, 132, 108, 197, 212, 64, 46, 227, 7, 106,
+			3, 18, 154, 132, 65, 29, 41, 179, 48, 37, 179, 87, 113, 181,
+			185, 142, 84, 226, 136, 31, 16, 193, 174, 165, 34, 100, 171, 137,
+			29, 167, 219, 32, 197, 46, 174, 156, 162, 49, 106, 12, 43, 8,
+			182, 76, 46, 147, 101, 36, 179, 77, 66, 57, 13, 143, 193, 75,
+			139, 232, 135, 44, 217, 71, 49, 45, 105, 166, 53, 134, 172, 6,
+			120, 3, 73, 188, 211, 16, 251, 28, 126, 2, 42, 130, 58, 49,
+			10, 228, 42, 135, 108, 192, 114, 100, 98, 4, 147, 110, 229, 250,
+			46, 94, 186, 90, 146, 23, 116, 160, 202, 116, 121, 244, 156, 20,
+			25, 107, 25, 126, 1, 76, 95, 156, 53, 201, 34, 42, 37, 169,
+			86, 114, 149, 172, 245, 130, 122, 150, 165, 60, 43, 228, 90, 88,
+			173, 198, 242, 102, 92, 135, 47, 76, 80, 171, 152, 42, 116, 162,
+			170, 133, 202, 219, 192, 220, 35, 220, 181, 2, 120, 78, 46, 227,
+			95, 180, 186, 120, 233, 234, 232, 50, 96, 52, 81, 30, 203, 0,
+			134, 81, 10, 54, 62, 17, 176, 163, 110, 192, 78, 107, 30, 121,
+			104, 100, 92, 142, 28, 126, 104, 132, 160, 54, 114, 118, 68, 142,
+			174, 5, 201, 88, 10, 34, 109, 216, 73, 206, 179, 107, 104, 4,
+			43, 32, 125, 162, 117, 51, 19, 214, 50, 144, 9, 89, 160, 48,
+			101, 194, 162, 207, 10, 189, 195, 103, 27, 33, 44, 181, 26, 245,
+			77, 33, 39, 90, 55, 250, 44, 162, 34, 196, 205, 134, 144, 157,
+			194, 145, 68, 149, 135, 138, 20, 238, 100, 226, 254, 251, 167, 166,
+			30, 56, 115, 242, 244, 241, 227, 39, 239, 191, 255, 204, 125, 247,
+			159, 62, 113, 178, 40, 132, 188, 98, 178, 252, 233, 213, 209, 25,
+			2, 206, 201, 184, 70, 47, 123, 158, 3, 254, 108, 29, 26, 53,
+			245, 48, 168, 174, 227, 143, 206, 163, 227, 151, 45, 66, 198, 108,
+			165, 106, 194, 207, 66, 202, 44, 102, 134, 84, 241, 69, 168, 24,
+			172, 38, 156, 218, 83, 73, 6, 96, 116, 6, 60, 91, 5, 171,
+			92, 133, 209, 120, 165, 89, 198, 203, 120, 29, 59, 77, 209, 64,
+			31, 56, 126, 116, 12, 26, 213, 240, 25, 162, 151, 224, 192, 195,
+			68, 75, 107, 196, 214, 73, 209, 54, 166, 179, 98, 91, 93, 224,
+			27, 165, 87, 111, 87, 137, 49, 118, 40, 27, 87, 67, 208, 192,
+			73, 252, 117, 74, 208, 64, 149, 255, 218, 222, 185, 79, 23, 33,
+			198, 178, 247, 31, 16, 239, 203, 17, 65, 179, 61, 247, 59, 29,
+			119, 175, 255, 189, 57, 57, 13, 182, 100, 61, 152, 48, 104, 47,
+			33, 153, 0, 178, 240, 229, 1, 175, 150, 186, 246, 178, 213, 218,
+			79, 185, 188, 169, 69, 53, 250, 234, 74, 226, 58, 153, 172, 40,
+			109, 29, 68, 174, 73, 89, 165, 77, 61, 135, 211, 165, 174, 125,
+			60, 38, 117, 181, 234, 86, 240, 211, 135, 87, 189, 1, 87, 47,
+			25, 200, 34, 53, 44, 42, 171, 160, 103, 232, 16, 202, 10, 158,
+			225, 155, 181, 70, 240, 76, 54, 185, 2, 209, 212, 90, 112, 3,
+			38, 177, 161, 158, 52, 64, 202, 242, 40, 246, 44, 2, 232, 96,
+			87, 216, 136, 202, 215, 83, 231, 89, 57, 250, 228, 147, 99, 105,
+			55, 97, 82, 14, 54, 184, 19, 98, 122, 89, 30, 196, 137, 101,
+			233, 53, 37, 159, 124, 125, 48, 241, 198, 233, 137, 215, 45, 189,
+			129, 255, 56, 62, 241, 192, 210, 27, 158, 212, 240, 192, 147, 146,
+			128, 65, 92, 137, 193, 109, 32, 15, 246, 148, 201, 121, 88, 201,
+			72, 179, 80, 143, 51, 22, 149, 5, 47, 160, 36, 218, 236, 151,
+			0, 65, 60, 205, 151, 55, 149, 156, 153, 114, 187, 179, 152, 202,
+			200, 45, 50, 192, 165, 119, 227, 89, 33, 139, 250, 190, 126, 114,
+			123, 41, 217, 147, 37, 58, 249, 244, 53, 124, 67, 175, 222, 209,
+			88, 74, 68, 167, 68, 87, 124, 58, 147, 175, 118, 212, 113, 125,
+			253, 149, 158, 212, 93, 181, 76, 138, 161, 65, 103, 172, 18, 54,
+			130, 168, 154, 140, 203, 36, 12, 51, 74, 143, 41, 194, 35, 66,
+			41, 96, 161, 194, 19, 218, 122, 145, 182, 58, 115, 226, 238, 245,
+			96, 99, 130, 145, 4, 31, 48, 226, 224, 19, 224, 139, 129, 190,
+			58, 55, 144, 158, 127, 167, 99, 78, 21, 56, 146, 239, 116, 118,
+			238, 214, 69, 7, 197, 225, 61, 226, 75, 224, 151, 119, 64, 132,
+			250, 46, 199, 149, 254, 231, 59, 104, 134, 32, 21, 100, 2, 204,
+			75, 5, 190, 105, 75, 251, 142, 156, 80, 20, 38, 183, 126, 77,
+			113, 71, 147, 111, 226, 16, 101, 164, 55, 49, 62, 246, 122, 152,
+			22, 211, 255, 45, 114, 4, 86, 175, 166, 225, 161, 227, 186, 134,
+			176, 96, 59, 189, 27, 65, 53, 170, 240, 6, 101, 44, 95, 82,
+			40, 57, 46, 45, 188, 71, 23, 187, 81, 236, 213, 80, 2, 27,
+			253, 46, 103, 240, 46, 93, 36, 40, 29, 60, 36, 126, 93, 1,
+			205, 245, 220, 31, 112, 220, 3, 254, 207, 115, 210, 251, 104, 157,
+			223, 84, 74, 133, 192, 214, 84, 177, 92, 13, 27, 219, 194, 8,
+			73, 240, 207, 182, 210, 39, 226, 170, 91, 28, 25, 90, 76, 186,
+			113, 243, 176, 46, 146, 136, 114, 102, 88, 237, 2, 167, 236, 43,
+			168, 53, 125, 90, 15, 27, 108, 255, 143, 52, 255, 113, 5, 36,
+			242, 129, 227, 90, 196, 175, 22, 231, 230, 104, 53, 189, 186, 104,
+			161, 216, 183, 87, 23, 29, 20, 239, 218, 47, 190, 145, 86, 222,
+			237, 185, 63, 4, 116, 217, 160, 133, 87, 33, 141, 70, 159, 10,
+			250, 105, 82, 166, 118, 13, 119, 186, 114, 45, 119, 132, 60, 175,
+			132, 125, 23, 219, 238, 111, 38, 6, 142, 153, 108, 119, 142, 38,
+			48, 172, 139, 22, 138, 123, 244, 62, 117, 59, 40, 30, 60, 68,
+			236, 118, 222, 203, 189, 224, 116, 253, 8, 179, 219, 72, 18, 252,
+			130, 147, 63, 74, 25, 151, 242, 96, 183, 127, 216, 113, 167, 252,
+			135, 90, 109, 70, 64, 130, 91, 223, 12, 198, 113, 132, 239, 176,
+			140, 33, 16, 79, 42, 79, 150, 23, 63, 172, 113, 73, 229, 214,
+			253, 97, 167, 247, 174, 76, 110, 221, 31, 118, 246, 79, 232, 162,
+			131, 226, 241, 19, 52, 199, 130, 151, 123, 159, 211, 245, 107, 60,
+			199, 2, 228, 69, 78, 126, 152, 146, 250, 22, 48, 199, 127, 226,
+			184, 37, 255, 112, 118, 142, 198, 249, 54, 35, 97, 213, 9, 95,
+			49, 240, 63, 113, 220, 131, 186, 104, 161, 120, 104, 76, 23, 29,
+			20, 199, 39, 196, 203, 117, 94, 220, 247, 59, 238, 144, 127, 66,
+			94, 69, 128, 70, 96, 15, 37, 225, 155, 168, 70, 53, 157, 20,
+			127, 35, 235, 1, 211, 62, 26, 110, 239, 247, 107, 58, 163, 50,
+			226, 190, 223, 97, 45, 93, 129, 110, 239, 247, 59, 187, 7, 197,
+			186, 78, 136, 251, 19, 142, 123, 175, 191, 116, 39, 182, 93, 172,
+			79, 167, 227, 160, 195, 203, 179, 247, 56, 14, 131, 121, 33, 27,
+			102, 132, 191, 211, 56, 93, 32, 153, 193, 79, 56, 110, 81, 23,
+			45, 20, 239, 190, 71, 23, 29, 20, 199, 142, 145, 162, 174, 224,
+			116, 121, 185, 159, 114, 220, 95, 116, 148, 162, 174, 64, 26, 196,
+			159, 114, 122, 246, 136, 247, 57, 34, 231, 22, 160, 217, 243, 220,
+			159, 117, 242, 123, 145, 110, 157, 137, 164, 50, 213, 107, 81, 38,
+			242, 36, 24, 113, 216, 156, 20, 86, 108, 122, 118, 236, 104, 175,
+			63, 102, 226, 100, 158, 46, 41, 253, 83, 76, 173, 72, 31, 90,
+			218, 73, 42, 227, 248, 163, 145, 176, 141, 12, 243, 176, 91, 5,
+			195, 183, 25, 60, 29, 74, 108, 117, 200, 106, 31, 132, 9, 28,
+			56, 0, 104, 25, 200, 26, 80, 27, 247, 7, 34, 187, 230, 52,
+			206, 197, 205, 96, 115, 92, 11, 172, 224, 84, 108, 194, 183, 52,
+			226, 236, 7, 137, 74, 79, 136, 110, 181, 128, 158, 188, 13, 140,
+			207, 193, 214, 149, 177, 6, 177, 192, 58, 218, 159, 117, 88, 71,
+			91, 96, 29, 237, 207, 58, 125, 187, 211, 10, 7, 21, 195, 123,
+			196, 219, 108, 222, 91, 203, 115, 127, 193, 201, 223, 235, 255, 55,
+			182, 112, 217, 126, 75, 83, 67, 237, 113, 245, 134, 83, 124, 196,
+			212, 169, 137, 53, 248, 20, 43, 10, 155, 8, 13, 44, 101, 197,
+			94, 201, 220, 14, 148, 48, 79, 57, 53, 35, 133, 64, 61, 174,
+			197, 230, 169, 8, 30, 64, 72, 82, 85, 153, 60, 2, 50, 172,
+			53, 234, 70, 55, 217, 178, 101, 250, 78, 104, 29, 188, 53, 121,
+			193, 6, 172, 7, 42, 122, 47, 211, 105, 140, 36, 236, 143, 200,
+			70, 231, 6, 56, 48, 198, 251, 5, 135, 141, 241, 10, 108, 213,
+			252, 11, 206, 174, 225, 180, 130, 160, 181, 231, 158, 180, 194, 65,
+			197, 216, 49, 241, 95, 113, 51, 22, 192, 78, 252, 138, 227, 142,
+			233, 164, 152, 252, 112, 141, 87, 218, 64, 153, 224, 136, 135, 164,
+			121, 101, 80, 131, 145, 225, 133, 166, 215, 245, 87, 165, 140, 253,
+			59, 103, 38, 10, 36, 132, 251, 21, 199, 221, 175, 139, 22, 138,
+			7, 142, 232, 34, 1, 133, 205, 140, 133, 151, 251, 117, 167, 235,
+			255, 100, 138, 47, 44, 207, 253, 117, 39, 127, 183, 248, 94, 128,
+			79, 128, 208, 124, 220, 113, 135, 253, 183, 222, 129, 157, 142, 57,
+			156, 119, 172, 30, 184, 99, 75, 22, 157, 210, 64, 175, 80, 144,
+			236, 233, 227, 154, 216, 11, 146, 61, 125, 220, 217, 185, 75, 23,
+			29, 204, 123, 112, 72, 188, 21, 234, 46, 1, 150, 243, 119, 193,
+			31, 253, 149, 157, 154, 245, 111, 191, 32, 6, 108, 106, 135, 110,
+			86, 166, 163, 118, 44, 135, 8, 9, 181, 81, 13, 54, 195, 202,
+			173, 151, 216, 145, 189, 20, 208, 105, 213, 98, 30, 40, 105, 17,
+			232, 146, 254, 143, 136, 99, 77, 214, 98, 190, 228, 113, 102, 34,
+			242, 126, 131, 122, 7, 52, 49, 96, 183, 75, 51, 53, 80, 169,
+			176, 26, 173, 70, 236, 183, 159, 241, 152, 40, 201, 75, 41, 161,
+			184, 9, 27, 131, 77, 121, 51, 74, 214, 232, 209, 183, 30, 173,
+			226, 197, 170, 136, 98, 35, 150, 65, 42, 155, 227, 71, 114, 185,
+			138, 188, 43, 58, 24, 78, 84, 91, 82, 211, 30, 125, 82, 253,
+			59, 17, 85, 158, 28, 43, 10, 86, 244, 153, 159, 177, 168, 64,
+			174, 52, 107, 101, 246, 7, 86, 59, 131, 19, 252, 187, 233, 190,
+			225, 146, 254, 93, 103, 231, 94, 93, 116, 80, 188, 11, 142, 101,
+			182, 187, 211, 203, 125, 218, 233, 250, 3, 198, 204, 157, 150, 231,
+			126, 218, 201, 31, 17, 175, 23, 174, 187, 19, 136, 249, 25, 199,
+			61, 225, 95, 144, 28, 178, 126, 187, 187, 161, 36, 228, 2, 98,
+			93, 133, 38, 184, 0, 9, 117, 217, 144, 105, 10, 138, 5, 45,
+			90, 226, 73, 238, 36, 254, 233, 51, 154, 127, 218, 73, 252, 211,
+			103, 156, 222, 187, 117, 209, 194, 216, 71, 198, 117, 17, 86, 111,
+			206, 228, 113, 241, 2, 20, 85, 61, 94, 238, 179, 78, 215, 135,
+			93, 203, 127, 222, 146, 157, 3, 226, 102, 66, 207, 200, 32, 163,
+			180, 54, 11, 200, 248, 191, 41, 210, 83, 14, 170, 66, 199, 163,
+			192, 131, 70, 177, 35, 44, 211, 72, 100, 82, 133, 144, 10, 174,
+			78, 241, 58, 40, 15, 116, 141, 228, 82, 185, 162, 180, 225, 153,
+			245, 65, 208, 219, 99, 121, 238, 103, 157, 252, 65, 241, 175, 112,
+			198, 123, 192, 110, 124, 222, 113, 95, 114, 186, 253, 31, 133, 110,
+			141, 231, 102, 156, 141, 131, 90, 214, 153, 174, 36, 167, 91, 202,
+			108, 31, 0, 184, 38, 205, 101, 224, 188, 140, 87, 68, 106, 150,
+			160, 40, 100, 32, 103, 22, 198, 8, 35, 150, 225, 69, 167, 221,
+			159, 151, 171, 89, 243, 174, 140, 150, 155, 117, 113, 134, 220, 102,
+			141, 15, 120, 151, 122, 136, 45, 250, 60, 216, 162, 243, 34, 231,
+			246, 224, 46, 245, 220, 63, 113, 242, 103, 252, 41, 182, 164, 129,
+			210, 54, 76, 109, 201, 216, 147, 69, 15, 151, 93, 148, 186, 177,
+			123, 216, 93, 224, 79, 156, 252, 177, 180, 194, 66, 197, 189, 39,
+			210, 10, 7, 21, 167, 78, 139, 71, 8, 122, 150, 151, 251, 51,
+			199, 125, 155, 219, 237, 223, 215, 9, 120, 153, 183, 126, 189, 89,
+			227, 11, 131, 212, 143, 51, 143, 203, 197, 102, 77, 171, 177, 122,
+			28, 28, 133, 63, 115, 122, 246, 138, 79, 130, 203, 235, 129, 221,
+			148, 231, 126, 217, 201, 63, 228, 255, 91, 71, 206, 183, 101, 131,
+			109, 237, 149, 133, 106, 108, 69, 174, 78, 114, 170, 210, 44, 177,
+			55, 121, 106, 96, 193, 14, 24, 208, 202, 21, 103, 30, 187, 247,
+			68, 113, 18, 255, 76, 21, 193, 6, 33, 5, 16, 238, 38, 149,
+			101, 14, 34, 148, 75, 53, 30, 111, 125, 153, 199, 187, 48, 253,
+			90, 21, 20, 140, 124, 69, 181, 232, 48, 147, 150, 87, 206, 207,
+			102, 99, 114, 68, 136, 220, 0, 33, 97, 220, 76, 116, 202, 232,
+			49, 21, 140, 5, 204, 199, 118, 93, 40, 35, 9, 180, 160, 152,
+			94, 100, 63, 212, 186, 240, 249, 217, 172, 51, 18, 73, 231, 91,
+			116, 185, 122, 175, 73, 37, 214, 250, 41, 49, 243, 180, 8, 213,
+			111, 75, 176, 110, 38, 209, 6, 245, 216, 148, 26, 70, 178, 138,
+			19, 172, 86, 217, 18, 36, 49, 94, 164, 109, 91, 61, 206, 62,
+			138, 164, 36, 83, 41, 66, 21, 6, 41, 139, 185, 47, 59, 249,
+			145, 180, 2, 150, 120, 206, 232, 253, 105, 133, 131, 138, 115, 15,
+			138, 207, 216, 140, 11, 150, 231, 62, 231, 230, 247, 249, 31, 83,
+			105, 210, 91, 210, 15, 182, 46, 44, 5, 72, 139, 21, 112, 203,
+			23, 51, 11, 216, 98, 69, 103, 128, 0, 147, 216, 126, 74, 75,
+			93, 174, 70, 136, 110, 141, 144, 51, 48, 36, 71, 252, 54, 40,
+			19, 226, 149, 54, 68, 166, 39, 146, 201, 94, 53, 179, 0, 211,
+			68, 204, 12, 87, 64, 34, 147, 114, 188, 161, 15, 182, 201, 47,
+			5, 198, 58, 44, 199, 80, 78, 214, 100, 184, 30, 68, 85, 120,
+			120, 130, 94, 65, 208, 187, 81, 143, 110, 80, 82, 208, 96, 3,
+			132, 45, 168, 162, 31, 21, 251, 47, 172, 140, 203, 102, 173, 65,
+			239, 121, 204, 137, 124, 56, 138, 58, 249, 95, 92, 43, 202, 81,
+			108, 116, 90, 1, 155, 108, 101, 227, 31, 148, 137, 107, 135, 91,
+			84, 173, 34, 139, 8, 155, 87, 76, 51, 100, 101, 246, 4, 247,
+			210, 115, 46, 219, 250, 245, 176, 85, 225, 115, 110, 255, 96, 90,
+			225, 160, 98, 207, 94, 241, 106, 222, 18, 219, 115, 223, 226, 230,
+			79, 250, 143, 96, 221, 176, 19, 15, 59, 110, 8, 171, 114, 102,
+			23, 95, 187, 180, 120, 237, 226, 184, 124, 248, 218, 194, 130, 250,
+			235, 177, 107, 243, 51, 175, 90, 226, 31, 50, 115, 193, 99, 241,
+			45, 110, 126, 44, 173, 176, 80, 113, 172, 148, 86, 56, 168, 56,
+			49, 101, 230, 226, 120, 238, 91, 221, 252, 125, 60, 151, 212, 40,
+			105, 187, 217, 36, 205, 114, 57, 12, 43, 128, 44, 203, 36, 225,
+			156, 132, 248, 156, 213, 42, 88, 26, 51, 18, 56, 201, 183, 186,
+			249, 123, 211, 10, 120, 38, 187, 227, 83, 105, 5, 141, 125, 250,
+			12, 165, 245, 236, 1, 209, 250, 118, 215, 221, 227, 223, 213, 49,
+			139, 117, 35, 117, 16, 233, 33, 30, 238, 219, 93, 230, 5, 122,
+			232, 20, 124, 187, 203, 250, 195, 30, 58, 3, 223, 238, 14, 13,
+			139, 155, 212, 175, 229, 185, 239, 116, 221, 49, 63, 146, 179, 173,
+			162, 0, 56, 205, 48, 247, 84, 214, 41, 137, 235, 205, 26, 39,
+			36, 86, 7, 120, 92, 72, 190, 135, 76, 203, 229, 102, 249, 122,
+			216, 0, 17, 138, 170, 21, 86, 36, 178, 149, 91, 210, 140, 26,
+			218, 123, 181, 135, 172, 245, 223, 233, 50, 47, 221, 67, 120, 241,
+			78, 151, 121, 233, 30, 194, 138, 119, 186, 35, 163, 226, 227, 116,
+			153, 66, 174, 240, 61, 174, 123, 194, 255, 5, 75, 154, 120, 215,
+			233, 133, 175, 231, 148, 52, 152, 104, 108, 47, 24, 99, 210, 37,
+			58, 219, 178, 209, 115, 143, 85, 84, 134, 116, 209, 243, 9, 103,
+			163, 153, 52, 131, 106, 117, 115, 235, 238, 243, 192, 212, 78, 211,
+			9, 9, 1, 6, 65, 33, 243, 171, 89, 61, 112, 241, 123, 92,
+			182, 248, 239, 33, 76, 252, 30, 151, 45, 254, 123, 8, 15, 191,
+			199, 157, 60, 46, 174, 210, 226, 29, 207, 253, 126, 215, 189, 219,
+			127, 88, 206, 170, 7, 139, 140, 58, 160, 96, 39, 73, 75, 98,
+			188, 36, 178, 4, 93, 109, 0, 80, 240, 251, 93, 119, 64, 23,
+			225, 77, 236, 238, 58, 168, 139, 52, 232, 225, 162, 248, 71, 106,
+			3, 92, 207, 253, 33, 215, 61, 228, 255, 3, 43, 107, 191, 206,
+			11, 36, 10, 199, 22, 161, 96, 7, 219, 175, 103, 62, 22, 208,
+			133, 154, 11, 32, 253, 18, 164, 127, 59, 106, 159, 37, 116, 153,
+			87, 66, 180, 90, 139, 83, 47, 213, 30, 88, 110, 97, 122, 121,
+			93, 132, 184, 208, 45, 248, 186, 8, 113, 161, 123, 224, 160, 152,
+			163, 165, 192, 133, 215, 117, 143, 249, 247, 201, 171, 173, 252, 74,
+			162, 66, 216, 166, 44, 109, 139, 113, 230, 141, 176, 94, 137, 140,
+			154, 190, 199, 238, 118, 169, 159, 30, 93, 204, 161, 216, 187, 87,
+			23, 33, 135, 116, 247, 29, 213, 69, 120, 5, 187, 163, 99, 226,
+			63, 192, 153, 173, 7, 219, 255, 79, 92, 119, 202, 255, 77, 187,
+			3, 101, 81, 160, 201, 114, 31, 97, 101, 43, 251, 33, 103, 218,
+			244, 76, 252, 174, 136, 106, 218, 46, 37, 221, 123, 58, 165, 234,
+			37, 145, 217, 49, 77, 151, 160, 229, 93, 11, 232, 245, 3, 31,
+			244, 186, 241, 156, 28, 83, 131, 232, 192, 169, 218, 9, 135, 7,
+			162, 158, 88, 239, 155, 14, 218, 18, 75, 66, 15, 205, 231, 64,
+			83, 71, 98, 81, 16, 77, 135, 78, 161, 194, 0, 253, 85, 88,
+			105, 191, 98, 213, 199, 169, 199, 28, 157, 246, 120, 27, 213, 170,
+			217, 158, 156, 2, 177, 212, 69, 8, 73, 221, 195, 19, 186, 8,
+			33, 169, 123, 252, 132, 248, 57, 181, 31, 59, 60, 247, 167, 92,
+			247, 30, 255, 253, 106, 63, 50, 252, 82, 75, 58, 252, 214, 45,
+			104, 241, 238, 225, 215, 67, 148, 48, 151, 30, 111, 76, 40, 177,
+			121, 166, 47, 218, 210, 236, 71, 208, 1, 201, 98, 218, 162, 168,
+			114, 123, 67, 7, 175, 119, 126, 171, 225, 109, 144, 164, 194, 9,
+			227, 58, 202, 239, 237, 76, 67, 33, 147, 205, 164, 17, 174, 107,
+			117, 157, 246, 128, 31, 111, 51, 212, 85, 115, 73, 50, 243, 204,
+			198, 132, 84, 192, 102, 74, 166, 136, 187, 250, 91, 63, 235, 122,
+			236, 29, 221, 4, 60, 83, 132, 88, 213, 221, 121, 88, 23, 29,
+			20, 143, 28, 21, 255, 89, 65, 58, 239, 185, 63, 231, 186, 227,
+			254, 239, 219, 114, 158, 229, 64, 12, 140, 44, 164, 56, 97, 136,
+			90, 93, 148, 180, 156, 196, 151, 167, 144, 38, 78, 22, 102, 175,
+			160, 136, 120, 183, 143, 194, 172, 57, 185, 30, 109, 108, 132, 149,
+			177, 86, 176, 25, 182, 41, 219, 151, 144, 163, 252, 174, 237, 48,
+			139, 177, 118, 3, 101, 62, 22, 88, 186, 212, 33, 206, 91, 34,
+			35, 174, 4, 229, 6, 25, 164, 232, 8, 68, 36, 24, 59, 39,
+			203, 107, 97, 249, 122, 203, 193, 160, 19, 11, 45, 33, 147, 114,
+			214, 70, 104, 145, 127, 135, 217, 40, 212, 48, 144, 185, 137, 40,
+			27, 235, 17, 197, 175, 194, 137, 106, 137, 205, 98, 246, 38, 223,
+			77, 224, 214, 132, 17, 218, 145, 159, 115, 11, 35, 186, 232, 160,
+			120, 236, 94, 241, 20, 109, 77, 193, 115, 63, 232, 186, 103, 253,
+			111, 96, 119, 114, 197, 108, 97, 225, 91, 156, 16, 200, 248, 30,
+			128, 12, 43, 153, 155, 69, 94, 211, 225, 139, 90, 252, 8, 200,
+			162, 103, 163, 209, 204, 82, 236, 130, 75, 131, 105, 234, 89, 200,
+			161, 216, 59, 170, 139, 22, 138, 99, 167, 116, 209, 65, 241, 190,
+			7, 212, 219, 208, 22, 158, 251, 33, 215, 29, 242, 31, 104, 115,
+			50, 104, 217, 238, 204, 107, 95, 93, 132, 153, 135, 190, 158, 132,
+			232, 166, 158, 10, 186, 8, 23, 100, 87, 244, 235, 34, 28, 144,
+			93, 111, 144, 164, 38, 189, 94, 238, 151, 221, 174, 143, 184, 74,
+			106, 210, 107, 121, 238, 47, 187, 249, 19, 8, 189, 225, 186, 189,
+			224, 206, 126, 213, 117, 135, 253, 247, 221, 129, 60, 79, 111, 83,
+			88, 105, 193, 196, 191, 21, 249, 94, 216, 49, 14, 63, 111, 66,
+			47, 113, 138, 191, 170, 79, 110, 47, 113, 138, 191, 234, 178, 180,
+			175, 151, 56, 197, 95, 117, 7, 145, 108, 221, 118, 251, 188, 220,
+			71, 221, 174, 127, 207, 235, 239, 131, 97, 157, 155, 159, 18, 191,
+			141, 245, 247, 97, 253, 31, 119, 221, 7, 17, 95, 101, 218, 216,
+			104, 180, 174, 174, 85, 124, 196, 20, 149, 182, 39, 3, 16, 237,
+			86, 98, 236, 182, 146, 146, 188, 4, 145, 185, 194, 112, 146, 46,
+			149, 13, 215, 171, 63, 196, 225, 24, 205, 138, 159, 50, 63, 192,
+			250, 53, 170, 39, 13, 245, 82, 169, 166, 34, 171, 32, 13, 66,
+			209, 186, 15, 12, 157, 62, 18, 87, 125, 92, 163, 104, 31, 61,
+			47, 63, 238, 246, 142, 233, 34, 68, 163, 238, 177, 251, 116, 17,
+			162, 81, 247, 236, 57, 241, 187, 16, 87, 245, 123, 185, 223, 113,
+			187, 254, 200, 181, 252, 95, 179, 228, 173, 114, 34, 180, 10, 173,
+			178, 243, 104, 11, 83, 174, 225, 133, 40, 62, 97, 42, 42, 10,
+			43, 165, 76, 132, 246, 214, 239, 141, 248, 40, 9, 27, 36, 242,
+			105, 27, 128, 14, 50, 69, 138, 78, 128, 96, 169, 122, 78, 195,
+			94, 125, 195, 157, 179, 148, 171, 223, 242, 220, 223, 113, 243, 71,
+			196, 121, 225, 186, 253, 216, 248, 79, 226, 89, 114, 74, 78, 111,
+			31, 127, 130, 70, 165, 229, 100, 13, 196, 25, 206, 253, 132, 133,
+			159, 212, 88, 216, 79, 88, 248, 73, 253, 94, 233, 39, 192, 126,
+			82, 191, 87, 250, 193, 40, 127, 234, 127, 193, 123, 165, 159, 222,
+			43, 159, 210, 239, 149, 126, 122, 175, 124, 74, 191, 87, 250, 233,
+			189, 242, 41, 188, 87, 190, 15, 7, 162, 31, 239, 149, 63, 112,
+			221, 123, 253, 183, 89, 109, 52, 74, 99, 184, 28, 221, 234, 209,
+			51, 150, 66, 94, 59, 65, 235, 157, 213, 219, 127, 35, 108, 223,
+			127, 19, 88, 55, 187, 183, 70, 57, 149, 245, 191, 83, 51, 133,
+			217, 200, 31, 104, 154, 215, 79, 47, 143, 63, 112, 197, 61, 186,
+			232, 224, 215, 177, 99, 226, 181, 180, 12, 199, 115, 63, 235, 186,
+			39, 253, 87, 117, 122, 17, 197, 43, 91, 228, 190, 122, 90, 188,
+			39, 129, 72, 215, 203, 75, 51, 243, 192, 243, 227, 179, 250, 5,
+			212, 79, 239, 223, 207, 186, 7, 75, 186, 72, 35, 159, 152, 34,
+			218, 51, 224, 229, 62, 239, 118, 253, 15, 166, 61, 3, 144, 79,
+			186, 249, 34, 25, 212, 14, 0, 3, 255, 196, 189, 149, 65, 237,
+			0, 33, 216, 159, 104, 4, 27, 32, 4, 251, 19, 141, 96, 3,
+			132, 96, 127, 2, 4, 251, 11, 48, 40, 3, 0, 200, 23, 93,
+			247, 160, 255, 57, 251, 107, 98, 80, 171, 21, 174, 237, 70, 180,
+			90, 123, 213, 65, 3, 191, 77, 74, 25, 21, 81, 161, 53, 120,
+			38, 169, 174, 110, 164, 194, 94, 232, 49, 130, 148, 141, 160, 135,
+			83, 233, 239, 129, 145, 224, 128, 109, 117, 255, 191, 236, 253, 9,
+			112, 92, 201, 117, 38, 10, 87, 230, 189, 85, 44, 36, 184, 0,
+			73, 144, 0, 47, 64, 242, 178, 72, 54, 1, 18, 40, 130, 96,
+			179, 23, 146, 221, 108, 112, 71, 55, 55, 21, 200, 94, 44, 181,
+			129, 139, 170, 11, 160, 196, 66, 21, 84, 183, 138, 36, 186, 67,
+			99, 201, 178, 214, 145, 109, 89, 222, 102, 198, 219, 63, 242, 38,
+			255, 118, 79, 40, 60, 122, 51, 26, 79, 132, 61, 158, 241, 211,
+			120, 233, 39, 217, 210, 72, 30, 203, 126, 178, 150, 182, 29, 243,
+			172, 39, 135, 67, 158, 103, 91, 142, 208, 123, 113, 78, 158, 147,
+			247, 86, 161, 0, 162, 91, 178, 194, 122, 175, 21, 161, 38, 50,
+			43, 111, 230, 201, 147, 219, 201, 147, 231, 124, 7, 249, 110, 147,
+			56, 12, 100, 36, 216, 131, 235, 235, 107, 238, 208, 110, 245, 59,
+			176, 190, 122, 96, 125, 253, 157, 235, 110, 247, 126, 173, 125, 125,
+			49, 72, 34, 67, 180, 153, 67, 181, 89, 175, 230, 125, 255, 106,
+			112, 175, 188, 212, 92, 34, 227, 24, 84, 125, 86, 86, 216, 78,
+			6, 245, 235, 252, 85, 57, 242, 111, 135, 203, 141, 85, 56, 17,
+			121, 63, 97, 110, 9, 125, 97, 52, 93, 126, 8, 94, 241, 163,
+			98, 128, 158, 45, 96, 94, 17, 212, 25, 246, 182, 21, 224, 45,
+			97, 151, 211, 131, 11, 242, 239, 120, 65, 246, 224, 130, 252, 59,
+			87, 109, 229, 164, 3, 191, 246, 106, 99, 199, 213, 3, 154, 129,
+			111, 184, 223, 10, 59, 46, 211, 213, 239, 16, 59, 174, 30, 180,
+			227, 250, 6, 31, 198, 61, 104, 199, 245, 13, 151, 236, 184, 122,
+			112, 247, 248, 134, 75, 118, 92, 61, 168, 59, 251, 134, 75, 246,
+			65, 189, 58, 243, 246, 116, 234, 93, 105, 179, 123, 244, 10, 237,
+			190, 61, 157, 221, 175, 62, 6, 252, 236, 133, 237, 227, 157, 105,
+			119, 152, 209, 136, 108, 135, 24, 39, 166, 20, 172, 228, 125, 210,
+			192, 178, 235, 160, 85, 125, 183, 77, 59, 144, 200, 9, 248, 36,
+			158, 237, 22, 9, 5, 42, 193, 151, 126, 128, 188, 227, 170, 172,
+			1, 85, 185, 138, 118, 141, 97, 9, 144, 100, 67, 101, 236, 79,
+			71, 253, 48, 168, 87, 202, 176, 199, 32, 244, 31, 8, 50, 196,
+			145, 94, 20, 79, 222, 153, 38, 142, 244, 162, 120, 242, 206, 244,
+			150, 61, 156, 20, 208, 177, 189, 251, 57, 9, 154, 205, 244, 3,
+			135, 212, 5, 37, 93, 173, 51, 239, 77, 167, 126, 40, 45, 146,
+			111, 43, 145, 237, 59, 35, 224, 241, 76, 169, 85, 147, 7, 38,
+			48, 196, 136, 2, 90, 104, 247, 189, 233, 236, 46, 245, 61, 202,
+			117, 53, 112, 242, 253, 105, 119, 191, 247, 22, 127, 18, 221, 173,
+			241, 226, 28, 179, 131, 198, 22, 31, 132, 73, 189, 146, 48, 171,
+			9, 216, 16, 203, 30, 103, 216, 206, 58, 214, 3, 84, 28, 220,
+			5, 177, 143, 26, 57, 240, 254, 180, 187, 147, 147, 160, 247, 76,
+			247, 239, 225, 36, 232, 61, 211, 251, 114, 170, 130, 212, 130, 186,
+			49, 237, 246, 121, 223, 141, 77, 148, 130, 196, 162, 142, 226, 105,
+			0, 247, 63, 116, 122, 224, 43, 167, 31, 248, 183, 110, 158, 195,
+			49, 130, 1, 158, 154, 190, 238, 63, 242, 208, 248, 49, 218, 119,
+			233, 49, 23, 176, 93, 199, 142, 29, 27, 155, 120, 148, 73, 3,
+			53, 248, 7, 210, 116, 2, 105, 20, 30, 62, 144, 38, 247, 49,
+			141, 155, 219, 7, 210, 122, 251, 92, 6, 195, 80, 29, 87, 31,
+			26, 80, 15, 110, 44, 94, 21, 175, 207, 60, 102, 232, 158, 246,
+			181, 151, 59, 174, 118, 1, 84, 38, 29, 137, 20, 177, 238, 126,
+			49, 27, 159, 81, 94, 167, 143, 40, 80, 223, 163, 237, 145, 245,
+			58, 132, 249, 109, 249, 52, 14, 1, 248, 89, 161, 182, 180, 252,
+			212, 49, 138, 245, 160, 234, 178, 187, 13, 197, 220, 203, 154, 140,
+			169, 146, 206, 171, 237, 104, 248, 59, 195, 15, 144, 51, 240, 13,
+			197, 122, 238, 197, 159, 10, 244, 203, 181, 96, 41, 212, 190, 234,
+			78, 28, 177, 24, 236, 181, 171, 144, 204, 210, 187, 149, 42, 71,
+			51, 100, 42, 78, 209, 90, 187, 202, 209, 121, 147, 161, 247, 171,
+			45, 176, 50, 103, 150, 235, 101, 184, 99, 173, 96, 164, 231, 116,
+			97, 51, 100, 222, 160, 188, 137, 123, 106, 19, 177, 73, 47, 169,
+			173, 192, 188, 139, 181, 58, 245, 84, 31, 89, 205, 159, 53, 199,
+			196, 27, 221, 88, 225, 111, 46, 180, 217, 127, 216, 174, 50, 218,
+			221, 154, 186, 246, 122, 96, 179, 215, 3, 155, 125, 59, 2, 155,
+			157, 197, 88, 101, 238, 142, 212, 46, 225, 61, 196, 128, 118, 157,
+			128, 87, 105, 167, 0, 190, 182, 74, 94, 113, 144, 153, 29, 217,
+			109, 42, 228, 24, 51, 3, 238, 115, 222, 179, 126, 235, 130, 67,
+			13, 68, 124, 158, 225, 12, 73, 56, 40, 83, 169, 54, 180, 34,
+			227, 50, 144, 52, 218, 183, 176, 204, 208, 232, 64, 118, 39, 167,
+			164, 118, 6, 250, 199, 57, 229, 104, 103, 224, 212, 45, 245, 4,
+			162, 208, 186, 67, 169, 3, 2, 47, 195, 116, 240, 193, 21, 0,
+			168, 89, 147, 24, 18, 180, 76, 12, 29, 23, 90, 26, 202, 238,
+			67, 125, 28, 24, 94, 56, 123, 221, 157, 222, 243, 124, 249, 2,
+			213, 70, 178, 7, 140, 132, 81, 187, 75, 166, 34, 244, 128, 78,
+			87, 9, 106, 114, 93, 249, 206, 244, 209, 224, 124, 58, 123, 93,
+			155, 18, 218, 217, 219, 221, 203, 41, 71, 59, 123, 251, 118, 168,
+			231, 17, 62, 211, 29, 78, 141, 9, 239, 13, 200, 243, 228, 81,
+			28, 37, 252, 93, 227, 203, 254, 171, 96, 182, 50, 120, 156, 206,
+			112, 54, 167, 30, 69, 180, 186, 148, 118, 14, 187, 135, 8, 254,
+			172, 99, 43, 141, 213, 119, 190, 205, 140, 146, 231, 28, 118, 193,
+			33, 19, 83, 25, 237, 28, 222, 226, 113, 74, 104, 231, 240, 96,
+			142, 83, 142, 118, 14, 31, 124, 64, 77, 33, 186, 156, 59, 158,
+			186, 38, 188, 199, 64, 134, 193, 246, 204, 246, 147, 28, 170, 49,
+			187, 110, 90, 221, 118, 131, 70, 3, 246, 150, 18, 245, 68, 10,
+			237, 140, 103, 119, 168, 23, 9, 201, 205, 121, 208, 237, 243, 170,
+			107, 171, 7, 147, 230, 196, 175, 94, 24, 183, 156, 156, 152, 0,
+			89, 4, 195, 41, 17, 47, 16, 10, 206, 121, 208, 181, 41, 161,
+			157, 7, 187, 183, 113, 202, 209, 206, 131, 122, 59, 44, 40, 68,
+			96, 114, 30, 113, 119, 121, 207, 38, 30, 153, 147, 138, 156, 4,
+			141, 48, 126, 228, 201, 14, 214, 45, 245, 234, 73, 255, 187, 193,
+			229, 232, 249, 97, 248, 239, 248, 216, 163, 99, 207, 191, 56, 62,
+			250, 208, 177, 183, 82, 242, 249, 145, 51, 7, 44, 65, 34, 173,
+			157, 71, 44, 65, 66, 104, 231, 145, 238, 62, 34, 72, 56, 218,
+			121, 4, 205, 1, 128, 111, 82, 59, 167, 221, 253, 222, 147, 12,
+			97, 229, 119, 134, 176, 34, 194, 240, 17, 94, 249, 185, 91, 81,
+			88, 71, 39, 164, 139, 230, 17, 222, 198, 184, 206, 89, 18, 100,
+			26, 106, 182, 41, 161, 157, 211, 221, 123, 56, 229, 104, 231, 244,
+			190, 156, 170, 35, 79, 28, 237, 60, 225, 14, 122, 225, 106, 18,
+			18, 66, 68, 27, 135, 252, 107, 48, 136, 21, 192, 239, 192, 211,
+			45, 88, 94, 14, 131, 58, 173, 254, 197, 176, 178, 140, 0, 29,
+			203, 62, 92, 17, 147, 223, 49, 61, 78, 26, 26, 181, 41, 161,
+			157, 39, 186, 119, 114, 10, 8, 218, 229, 225, 30, 33, 165, 171,
+			157, 243, 238, 128, 247, 124, 203, 171, 44, 77, 221, 216, 50, 9,
+			66, 62, 84, 9, 46, 5, 132, 26, 191, 92, 37, 8, 81, 146,
+			241, 43, 20, 200, 15, 110, 242, 113, 120, 58, 127, 57, 88, 128,
+			11, 34, 181, 236, 166, 161, 177, 44, 167, 132, 118, 206, 119, 109,
+			231, 148, 163, 157, 243, 59, 251, 213, 255, 2, 74, 20, 184, 211,
+			58, 79, 185, 67, 222, 47, 74, 127, 58, 33, 38, 181, 68, 15,
+			195, 27, 14, 208, 100, 54, 49, 187, 188, 193, 15, 205, 220, 132,
+			98, 122, 233, 38, 144, 92, 146, 201, 96, 29, 32, 138, 249, 44,
+			159, 89, 167, 117, 240, 111, 192, 167, 254, 97, 170, 101, 4, 202,
+			128, 119, 57, 54, 192, 87, 172, 164, 175, 88, 99, 117, 35, 96,
+			244, 82, 172, 85, 75, 157, 27, 131, 7, 188, 18, 64, 209, 128,
+			45, 166, 137, 151, 72, 223, 151, 163, 56, 136, 29, 30, 48, 132,
+			108, 67, 90, 210, 150, 90, 44, 135, 211, 200, 183, 46, 78, 9,
+			237, 60, 165, 250, 57, 229, 104, 231, 41, 111, 208, 94, 18, 94,
+			238, 87, 15, 109, 240, 146, 176, 92, 15, 75, 229, 98, 208, 8,
+			215, 186, 38, 120, 199, 55, 88, 19, 69, 157, 196, 116, 238, 35,
+			66, 245, 144, 30, 251, 6, 183, 160, 143, 171, 76, 248, 150, 102,
+			80, 129, 203, 193, 250, 1, 211, 47, 167, 10, 84, 84, 63, 172,
+			178, 244, 222, 25, 221, 55, 206, 250, 229, 84, 193, 22, 214, 251,
+			84, 55, 120, 199, 206, 80, 147, 120, 11, 184, 156, 42, 40, 200,
+			188, 128, 121, 103, 187, 85, 151, 237, 127, 238, 127, 151, 170, 15,
+			213, 239, 230, 49, 63, 38, 123, 80, 117, 69, 205, 57, 184, 84,
+			84, 150, 232, 50, 146, 141, 154, 115, 5, 72, 235, 235, 170, 151,
+			20, 171, 51, 182, 42, 162, 51, 183, 38, 157, 182, 238, 66, 207,
+			157, 182, 28, 125, 69, 245, 216, 199, 53, 142, 176, 14, 180, 111,
+			157, 232, 16, 143, 123, 154, 75, 26, 191, 251, 194, 54, 251, 169,
+			201, 208, 87, 85, 159, 85, 205, 162, 157, 221, 12, 186, 33, 224,
+			93, 167, 123, 98, 112, 117, 141, 96, 66, 83, 128, 34, 5, 109,
+			63, 180, 121, 250, 164, 218, 69, 58, 163, 153, 185, 50, 60, 13,
+			64, 181, 244, 172, 70, 215, 163, 126, 42, 112, 150, 127, 39, 156,
+			220, 215, 118, 27, 249, 122, 175, 185, 141, 76, 190, 126, 27, 121,
+			253, 54, 242, 45, 190, 141, 80, 68, 101, 157, 122, 140, 46, 38,
+			125, 241, 197, 4, 254, 124, 208, 200, 237, 253, 169, 156, 240, 134,
+			147, 218, 176, 216, 200, 222, 167, 5, 237, 143, 61, 238, 207, 213,
+			106, 21, 18, 240, 64, 86, 239, 207, 14, 168, 205, 32, 171, 103,
+			83, 218, 29, 144, 251, 28, 220, 171, 83, 89, 248, 109, 32, 219,
+			171, 206, 176, 28, 239, 185, 253, 222, 132, 63, 201, 239, 51, 22,
+			223, 0, 247, 45, 82, 120, 197, 72, 102, 225, 189, 160, 216, 168,
+			240, 177, 128, 214, 211, 142, 231, 118, 115, 74, 104, 199, 219, 172,
+			57, 229, 104, 199, 219, 177, 83, 61, 73, 8, 252, 206, 110, 119,
+			151, 247, 88, 220, 212, 33, 80, 101, 175, 140, 25, 93, 219, 114,
+			80, 174, 71, 166, 113, 218, 66, 1, 80, 40, 34, 81, 26, 44,
+			67, 171, 20, 141, 6, 234, 202, 104, 103, 183, 109, 21, 164, 180,
+			221, 155, 251, 56, 229, 104, 103, 119, 255, 128, 122, 28, 91, 149,
+			218, 241, 221, 65, 239, 88, 75, 171, 176, 5, 155, 166, 18, 157,
+			52, 100, 180, 247, 15, 196, 5, 159, 196, 157, 20, 74, 161, 62,
+			137, 59, 41, 41, 29, 237, 248, 187, 60, 245, 136, 185, 124, 28,
+			76, 77, 10, 111, 116, 141, 129, 74, 236, 236, 109, 131, 5, 212,
+			31, 204, 14, 169, 47, 8, 190, 88, 28, 145, 253, 222, 167, 68,
+			155, 223, 10, 73, 218, 99, 104, 104, 11, 176, 62, 65, 101, 9,
+			206, 110, 163, 218, 135, 105, 202, 47, 4, 160, 221, 108, 179, 83,
+			50, 165, 225, 190, 192, 225, 11, 115, 167, 169, 194, 199, 79, 230,
+			192, 36, 110, 190, 124, 15, 30, 99, 158, 89, 12, 171, 60, 223,
+			195, 210, 40, 89, 177, 240, 67, 41, 183, 192, 170, 226, 121, 136,
+			39, 202, 119, 29, 139, 153, 70, 8, 19, 237, 122, 100, 98, 169,
+			64, 153, 255, 136, 204, 114, 74, 104, 231, 72, 151, 230, 148, 163,
+			157, 35, 59, 118, 170, 43, 4, 9, 238, 228, 229, 136, 119, 166,
+			141, 21, 49, 158, 75, 7, 43, 133, 183, 52, 147, 148, 162, 134,
+			148, 234, 22, 25, 237, 228, 37, 183, 4, 108, 207, 111, 63, 192,
+			41, 71, 59, 249, 67, 195, 234, 175, 204, 32, 72, 237, 156, 144,
+			143, 120, 95, 76, 14, 130, 149, 98, 195, 200, 90, 220, 69, 254,
+			66, 200, 143, 157, 115, 224, 141, 81, 162, 109, 120, 181, 133, 138,
+			141, 233, 121, 9, 205, 236, 57, 119, 36, 97, 164, 79, 39, 87,
+			66, 63, 79, 225, 78, 172, 251, 16, 189, 79, 37, 189, 135, 70,
+			33, 82, 105, 76, 79, 80, 143, 57, 238, 15, 215, 195, 133, 160,
+			94, 194, 125, 26, 222, 144, 98, 154, 140, 85, 31, 69, 199, 114,
+			5, 120, 171, 58, 39, 36, 223, 59, 129, 241, 39, 246, 31, 231,
+			148, 163, 157, 19, 15, 61, 172, 62, 107, 120, 227, 104, 231, 148,
+			60, 232, 253, 118, 146, 55, 211, 132, 82, 17, 176, 15, 250, 124,
+			12, 176, 29, 181, 206, 210, 150, 137, 100, 166, 170, 129, 150, 183,
+			153, 181, 138, 57, 46, 3, 122, 21, 162, 171, 108, 88, 242, 111,
+			222, 188, 226, 15, 211, 163, 216, 136, 17, 166, 225, 108, 108, 153,
+			100, 102, 10, 215, 140, 88, 50, 74, 63, 32, 143, 226, 219, 121,
+			146, 6, 203, 2, 39, 3, 29, 235, 230, 148, 208, 206, 169, 205,
+			62, 167, 160, 211, 251, 15, 168, 223, 52, 44, 112, 181, 243, 132,
+			60, 232, 253, 219, 36, 11, 236, 244, 136, 159, 181, 108, 119, 217,
+			240, 7, 186, 107, 196, 4, 43, 157, 36, 128, 252, 59, 252, 138,
+			47, 149, 184, 6, 169, 31, 101, 54, 194, 138, 24, 82, 177, 237,
+			3, 110, 10, 62, 12, 239, 209, 124, 74, 222, 86, 168, 71, 112,
+			97, 122, 66, 102, 56, 5, 215, 184, 77, 220, 91, 23, 174, 113,
+			251, 15, 88, 113, 254, 171, 106, 195, 58, 127, 218, 84, 214, 212,
+			249, 127, 151, 218, 68, 234, 144, 142, 234, 245, 125, 106, 51, 121,
+			217, 25, 213, 185, 68, 213, 121, 55, 229, 161, 210, 124, 64, 109,
+			162, 70, 72, 177, 206, 201, 215, 38, 224, 189, 175, 203, 8, 120,
+			251, 94, 87, 55, 191, 174, 110, 254, 118, 168, 155, 149, 145, 234,
+			250, 82, 251, 132, 149, 213, 250, 178, 219, 140, 49, 59, 10, 100,
+			187, 220, 62, 239, 7, 214, 177, 214, 163, 249, 78, 202, 137, 4,
+			164, 15, 108, 180, 65, 177, 8, 187, 125, 162, 220, 125, 148, 172,
+			248, 96, 142, 102, 212, 107, 1, 161, 77, 76, 208, 174, 97, 84,
+			177, 187, 172, 52, 4, 162, 228, 46, 82, 215, 97, 116, 22, 103,
+			151, 222, 174, 126, 89, 196, 226, 222, 144, 247, 83, 166, 35, 180,
+			130, 237, 27, 43, 83, 76, 39, 29, 145, 67, 166, 43, 176, 96,
+			64, 189, 3, 199, 72, 155, 166, 221, 156, 131, 13, 27, 240, 4,
+			221, 102, 9, 62, 130, 221, 150, 18, 21, 30, 138, 252, 43, 205,
+			98, 153, 147, 128, 92, 193, 98, 9, 69, 164, 32, 153, 49, 13,
+			242, 164, 77, 1, 237, 221, 253, 156, 2, 121, 210, 27, 84, 15,
+			199, 242, 100, 63, 69, 204, 226, 106, 203, 216, 17, 216, 108, 216,
+			103, 150, 119, 161, 220, 186, 130, 36, 11, 202, 40, 72, 238, 216,
+			105, 55, 220, 239, 255, 46, 117, 226, 213, 109, 184, 107, 190, 178,
+			122, 175, 105, 231, 206, 237, 80, 219, 19, 79, 123, 252, 2, 152,
+			187, 170, 250, 90, 179, 233, 221, 245, 132, 202, 50, 33, 244, 240,
+			218, 65, 73, 66, 95, 21, 108, 209, 220, 152, 234, 191, 20, 114,
+			109, 231, 240, 156, 167, 150, 58, 157, 14, 185, 119, 196, 79, 180,
+			166, 112, 199, 51, 228, 162, 218, 58, 215, 92, 152, 89, 10, 170,
+			193, 2, 6, 203, 196, 87, 209, 142, 79, 193, 103, 155, 11, 87,
+			109, 177, 194, 150, 185, 100, 242, 73, 55, 43, 123, 156, 39, 221,
+			172, 211, 227, 62, 233, 102, 221, 158, 244, 147, 110, 54, 221, 147,
+			201, 253, 144, 80, 91, 90, 62, 212, 147, 42, 187, 92, 171, 148,
+			139, 229, 144, 123, 127, 240, 62, 109, 221, 128, 226, 43, 5, 251,
+			153, 126, 76, 101, 151, 106, 213, 90, 61, 40, 87, 72, 123, 211,
+			65, 219, 114, 149, 74, 16, 15, 10, 246, 147, 220, 255, 229, 170,
+			237, 29, 26, 208, 91, 149, 44, 151, 136, 59, 178, 92, 210, 59,
+			85, 6, 189, 2, 163, 129, 140, 239, 12, 119, 21, 40, 181, 214,
+			203, 181, 92, 235, 229, 250, 140, 202, 218, 55, 103, 56, 133, 183,
+			78, 236, 239, 216, 227, 160, 90, 126, 33, 172, 179, 142, 181, 96,
+			63, 210, 23, 227, 135, 122, 23, 231, 203, 232, 134, 56, 150, 111,
+			123, 181, 215, 211, 170, 59, 188, 183, 92, 9, 170, 184, 33, 163,
+			10, 168, 123, 226, 216, 198, 234, 186, 16, 127, 88, 72, 214, 226,
+			125, 70, 168, 12, 217, 0, 180, 188, 247, 139, 182, 247, 254, 155,
+			170, 47, 40, 54, 202, 119, 176, 237, 25, 8, 93, 16, 45, 214,
+			42, 165, 117, 6, 16, 191, 188, 201, 5, 11, 219, 227, 207, 109,
+			166, 126, 86, 237, 44, 133, 29, 235, 117, 54, 90, 239, 142, 82,
+			216, 161, 102, 239, 13, 170, 59, 209, 103, 189, 79, 109, 166, 240,
+			199, 51, 139, 141, 165, 10, 77, 147, 110, 202, 187, 220, 88, 170,
+			232, 189, 170, 27, 42, 170, 85, 77, 9, 137, 37, 148, 201, 130,
+			2, 185, 103, 213, 182, 182, 89, 153, 20, 208, 68, 139, 128, 166,
+			15, 170, 173, 116, 14, 128, 174, 114, 190, 124, 143, 42, 220, 66,
+			185, 55, 48, 211, 44, 185, 220, 79, 8, 181, 173, 173, 95, 122,
+			72, 109, 170, 85, 195, 153, 82, 176, 130, 196, 58, 160, 166, 173,
+			85, 195, 243, 193, 202, 123, 132, 208, 190, 234, 130, 49, 48, 191,
+			75, 252, 93, 20, 178, 152, 21, 151, 136, 194, 59, 97, 21, 75,
+			56, 88, 66, 22, 178, 152, 101, 74, 156, 85, 42, 59, 67, 77,
+			156, 221, 172, 212, 140, 173, 16, 83, 246, 227, 195, 51, 170, 119,
+			213, 244, 214, 57, 181, 231, 236, 173, 75, 147, 215, 166, 190, 235,
+			66, 97, 230, 70, 97, 234, 122, 97, 234, 230, 115, 51, 183, 174,
+			77, 223, 184, 112, 110, 234, 226, 212, 133, 243, 61, 41, 157, 81,
+			242, 198, 120, 143, 192, 127, 143, 245, 72, 252, 119, 162, 199, 193,
+			127, 143, 247, 184, 248, 239, 131, 61, 233, 137, 95, 23, 42, 75,
+			28, 141, 244, 115, 170, 235, 82, 200, 123, 222, 200, 234, 241, 95,
+			99, 19, 245, 58, 108, 121, 45, 229, 114, 41, 253, 140, 114, 97,
+			71, 215, 29, 118, 172, 14, 7, 128, 247, 192, 253, 138, 125, 115,
+			198, 31, 239, 191, 162, 54, 233, 244, 214, 212, 255, 20, 175, 139,
+			227, 175, 139, 227, 223, 14, 113, 156, 148, 176, 125, 169, 199, 213,
+			77, 99, 8, 210, 159, 218, 47, 188, 203, 177, 33, 136, 9, 228,
+			28, 117, 22, 174, 35, 146, 194, 225, 186, 109, 64, 171, 214, 50,
+			13, 233, 207, 246, 0, 8, 63, 216, 101, 104, 103, 80, 158, 243,
+			150, 253, 75, 97, 35, 106, 45, 111, 149, 29, 228, 224, 87, 171,
+			175, 50, 86, 80, 16, 17, 28, 192, 209, 202, 11, 0, 249, 9,
+			192, 200, 224, 83, 73, 198, 188, 107, 201, 240, 199, 143, 181, 152,
+			140, 12, 102, 182, 37, 76, 70, 6, 123, 14, 113, 202, 209, 206,
+			224, 196, 25, 245, 171, 2, 41, 21, 218, 201, 201, 73, 239, 231,
+			4, 89, 84, 36, 41, 137, 252, 59, 229, 136, 161, 103, 128, 35,
+			224, 105, 207, 228, 241, 42, 105, 220, 135, 170, 9, 214, 83, 194,
+			71, 229, 40, 225, 171, 153, 247, 47, 82, 140, 109, 192, 214, 6,
+			198, 146, 194, 12, 84, 34, 138, 231, 83, 101, 5, 162, 156, 44,
+			44, 176, 40, 15, 52, 3, 209, 25, 155, 146, 218, 201, 153, 215,
+			115, 248, 205, 209, 78, 110, 228, 49, 85, 196, 59, 152, 115, 40,
+			181, 199, 123, 54, 97, 16, 83, 51, 104, 38, 72, 50, 186, 104,
+			131, 237, 77, 216, 160, 176, 180, 236, 59, 100, 71, 158, 199, 10,
+			124, 92, 59, 219, 4, 225, 229, 238, 80, 118, 80, 189, 201, 104,
+			136, 143, 164, 242, 194, 187, 129, 45, 154, 125, 146, 85, 221, 236,
+			9, 178, 170, 141, 120, 118, 217, 25, 166, 58, 182, 4, 234, 204,
+			35, 217, 33, 245, 8, 43, 145, 199, 164, 79, 209, 150, 86, 85,
+			218, 121, 162, 146, 2, 214, 133, 79, 109, 42, 163, 157, 49, 186,
+			166, 24, 85, 237, 216, 246, 65, 78, 57, 218, 25, 219, 179, 87,
+			41, 54, 78, 121, 80, 36, 172, 75, 246, 146, 217, 70, 74, 59,
+			199, 165, 38, 179, 141, 78, 183, 216, 214, 25, 191, 113, 71, 51,
+			252, 140, 200, 54, 118, 36, 199, 73, 167, 44, 145, 208, 227, 93,
+			91, 56, 229, 104, 231, 120, 79, 47, 18, 234, 104, 247, 161, 212,
+			19, 134, 80, 71, 104, 231, 161, 236, 14, 181, 3, 162, 213, 117,
+			105, 231, 97, 185, 51, 215, 229, 159, 15, 193, 85, 184, 4, 118,
+			196, 174, 235, 116, 165, 180, 243, 48, 130, 167, 167, 33, 33, 90,
+			82, 210, 164, 76, 65, 248, 105, 235, 54, 250, 73, 180, 166, 164,
+			73, 153, 130, 144, 232, 213, 244, 147, 20, 45, 41, 250, 205, 20,
+			116, 180, 243, 48, 186, 184, 193, 79, 142, 104, 73, 73, 147, 250,
+			62, 142, 173, 231, 156, 150, 218, 187, 235, 23, 214, 211, 23, 180,
+			112, 122, 99, 220, 125, 21, 202, 1, 140, 154, 231, 156, 166, 49,
+			192, 160, 121, 206, 105, 26, 3, 140, 153, 231, 156, 238, 233, 5,
+			120, 123, 215, 129, 78, 159, 145, 251, 189, 49, 255, 92, 146, 36,
+			63, 9, 233, 1, 238, 79, 128, 45, 203, 50, 187, 109, 5, 180,
+			248, 103, 36, 177, 22, 87, 251, 153, 158, 61, 156, 114, 180, 115,
+			102, 95, 78, 29, 83, 210, 117, 181, 123, 46, 245, 164, 240, 14,
+			250, 211, 97, 3, 16, 150, 33, 52, 72, 37, 72, 224, 253, 181,
+			84, 14, 19, 194, 21, 218, 57, 151, 221, 161, 222, 7, 92, 117,
+			97, 17, 93, 148, 163, 222, 91, 113, 234, 210, 131, 3, 95, 216,
+			88, 249, 82, 171, 54, 234, 53, 120, 64, 10, 65, 217, 62, 54,
+			82, 91, 14, 193, 173, 107, 212, 47, 86, 106, 17, 108, 95, 120,
+			70, 209, 181, 167, 252, 130, 117, 63, 154, 107, 46, 36, 143, 43,
+			174, 103, 181, 194, 3, 123, 230, 130, 69, 189, 115, 145, 150, 165,
+			139, 143, 110, 23, 201, 38, 8, 131, 251, 57, 23, 247, 30, 226,
+			148, 163, 157, 139, 135, 143, 32, 167, 93, 224, 244, 148, 220, 235,
+			141, 249, 44, 165, 199, 38, 98, 192, 223, 249, 114, 5, 182, 130,
+			182, 153, 65, 53, 1, 167, 167, 100, 47, 167, 160, 42, 237, 113,
+			202, 209, 206, 212, 238, 61, 234, 239, 29, 140, 77, 147, 126, 58,
+			245, 113, 33, 188, 191, 116, 252, 201, 54, 214, 26, 142, 173, 116,
+			218, 32, 253, 179, 29, 74, 194, 179, 18, 115, 227, 46, 104, 242,
+			193, 136, 101, 177, 118, 23, 170, 53, 90, 109, 59, 67, 140, 17,
+			19, 112, 28, 244, 64, 204, 227, 8, 18, 240, 209, 157, 176, 14,
+			142, 26, 37, 136, 128, 139, 241, 165, 242, 198, 8, 134, 8, 2,
+			127, 197, 0, 148, 80, 245, 49, 58, 200, 20, 153, 149, 53, 86,
+			88, 45, 117, 107, 10, 109, 104, 138, 65, 213, 95, 6, 111, 154,
+			98, 51, 106, 212, 150, 124, 64, 129, 172, 55, 241, 18, 20, 177,
+			103, 137, 9, 198, 220, 218, 71, 63, 184, 83, 43, 3, 96, 178,
+			225, 178, 197, 206, 193, 174, 88, 97, 7, 246, 68, 242, 156, 138,
+			252, 185, 21, 229, 7, 54, 134, 27, 127, 96, 57, 131, 10, 62,
+			188, 216, 133, 220, 112, 242, 123, 139, 179, 129, 136, 56, 245, 102,
+			37, 204, 175, 9, 118, 74, 177, 179, 91, 16, 78, 45, 203, 200,
+			101, 157, 219, 85, 6, 251, 169, 20, 142, 113, 235, 108, 74, 152,
+			22, 218, 121, 58, 59, 168, 254, 15, 135, 226, 11, 57, 115, 178,
+			199, 251, 19, 167, 163, 207, 37, 119, 185, 227, 12, 1, 238, 221,
+			224, 126, 194, 56, 195, 19, 85, 56, 223, 4, 148, 2, 227, 255,
+			208, 202, 219, 70, 61, 40, 222, 230, 117, 136, 28, 69, 26, 151,
+			12, 222, 177, 98, 62, 193, 122, 3, 27, 41, 243, 122, 77, 60,
+			163, 41, 48, 12, 131, 11, 194, 126, 84, 174, 22, 237, 167, 126,
+			242, 242, 172, 226, 143, 225, 177, 204, 63, 7, 66, 8, 140, 77,
+			226, 201, 152, 5, 147, 70, 7, 244, 27, 106, 9, 26, 90, 12,
+			161, 17, 126, 159, 97, 43, 183, 4, 153, 216, 97, 203, 36, 250,
+			210, 120, 214, 87, 96, 246, 193, 47, 240, 142, 5, 157, 5, 90,
+			158, 70, 199, 36, 131, 216, 221, 217, 196, 113, 162, 197, 196, 209,
+			31, 158, 198, 178, 126, 41, 97, 238, 138, 146, 218, 10, 135, 133,
+			88, 181, 185, 35, 57, 86, 11, 61, 117, 62, 202, 143, 152, 77,
+			8, 131, 57, 57, 115, 180, 221, 99, 44, 39, 103, 174, 171, 155,
+			83, 142, 118, 230, 182, 110, 83, 95, 130, 157, 52, 13, 187, 208,
+			130, 220, 237, 253, 55, 17, 35, 89, 89, 68, 17, 211, 79, 176,
+			23, 174, 49, 60, 61, 172, 255, 0, 193, 182, 202, 243, 20, 138,
+			20, 38, 68, 57, 138, 154, 97, 4, 39, 127, 49, 172, 87, 173,
+			127, 86, 92, 9, 45, 240, 114, 35, 92, 226, 21, 140, 178, 79,
+			252, 194, 26, 84, 253, 39, 168, 147, 197, 218, 146, 129, 164, 82,
+			140, 73, 149, 247, 39, 19, 129, 11, 9, 112, 11, 124, 97, 231,
+			195, 122, 48, 7, 120, 243, 6, 132, 7, 97, 237, 248, 26, 70,
+			187, 101, 90, 10, 23, 58, 105, 83, 105, 237, 44, 144, 149, 114,
+			26, 79, 169, 5, 61, 128, 167, 84, 26, 247, 206, 133, 193, 33,
+			245, 155, 96, 143, 152, 150, 82, 59, 53, 185, 207, 251, 183, 210,
+			159, 132, 183, 224, 122, 163, 51, 4, 178, 157, 23, 70, 61, 147,
+			156, 35, 118, 137, 69, 100, 118, 77, 143, 239, 198, 180, 211, 175,
+			181, 108, 102, 229, 170, 217, 74, 193, 92, 46, 172, 54, 24, 90,
+			16, 118, 43, 8, 32, 210, 192, 89, 58, 95, 15, 22, 96, 109,
+			2, 238, 10, 5, 45, 180, 63, 142, 130, 77, 225, 50, 240, 41,
+			244, 75, 24, 43, 196, 62, 120, 6, 254, 124, 179, 82, 241, 163,
+			70, 109, 25, 63, 4, 24, 224, 6, 88, 113, 85, 23, 0, 74,
+			120, 185, 12, 49, 251, 42, 33, 24, 229, 174, 66, 234, 207, 37,
+			223, 242, 1, 246, 29, 134, 220, 204, 254, 132, 71, 113, 185, 26,
+			163, 240, 176, 110, 61, 141, 54, 157, 53, 59, 21, 97, 186, 213,
+			186, 134, 56, 229, 104, 167, 182, 215, 87, 191, 155, 70, 110, 59,
+			218, 125, 187, 144, 251, 188, 255, 152, 38, 13, 62, 89, 102, 214,
+			230, 219, 184, 11, 224, 155, 134, 189, 100, 25, 10, 20, 79, 205,
+			159, 196, 240, 22, 29, 190, 92, 123, 143, 133, 203, 126, 169, 60,
+			63, 31, 214, 233, 141, 23, 247, 14, 254, 158, 176, 246, 199, 90,
+			6, 52, 90, 181, 205, 67, 69, 254, 48, 160, 114, 16, 8, 103,
+			52, 59, 98, 63, 109, 221, 25, 203, 246, 4, 173, 240, 189, 162,
+			3, 177, 64, 149, 81, 129, 220, 90, 70, 159, 49, 232, 0, 151,
+			203, 249, 209, 221, 50, 92, 124, 146, 173, 67, 152, 152, 42, 168,
+			128, 75, 35, 163, 106, 173, 106, 227, 247, 21, 172, 21, 247, 24,
+			180, 12, 33, 150, 210, 23, 100, 107, 66, 43, 219, 172, 241, 213,
+			199, 29, 50, 161, 245, 164, 3, 62, 140, 170, 22, 19, 91, 75,
+			197, 48, 248, 117, 195, 188, 55, 159, 113, 53, 35, 237, 111, 62,
+			20, 20, 193, 8, 68, 86, 252, 29, 229, 46, 24, 165, 117, 220,
+			57, 254, 186, 88, 171, 222, 9, 235, 212, 39, 52, 191, 77, 68,
+			205, 73, 84, 199, 212, 220, 24, 167, 161, 136, 191, 186, 81, 47,
+			143, 141, 143, 250, 55, 142, 113, 226, 216, 168, 242, 111, 76, 112,
+			106, 98, 212, 15, 27, 197, 252, 8, 97, 223, 217, 58, 193, 138,
+			7, 205, 27, 252, 82, 237, 46, 35, 171, 166, 17, 243, 247, 237,
+			66, 110, 231, 36, 248, 143, 138, 190, 33, 78, 226, 84, 223, 235,
+			171, 255, 226, 226, 204, 119, 181, 251, 131, 66, 238, 241, 62, 234,
+			38, 5, 90, 154, 76, 116, 142, 98, 87, 89, 252, 74, 28, 77,
+			241, 248, 142, 145, 48, 67, 59, 46, 44, 9, 63, 176, 199, 28,
+			77, 218, 136, 28, 51, 219, 129, 178, 71, 41, 66, 98, 114, 158,
+			204, 131, 25, 252, 186, 245, 128, 209, 25, 217, 128, 195, 232, 199,
+			149, 17, 73, 92, 149, 226, 57, 199, 33, 69, 168, 54, 252, 153,
+			171, 100, 80, 27, 74, 195, 206, 106, 21, 236, 48, 92, 32, 4,
+			212, 170, 254, 225, 201, 107, 207, 29, 86, 196, 29, 92, 103, 108,
+			187, 109, 69, 32, 35, 53, 149, 231, 87, 201, 12, 171, 42, 4,
+			48, 32, 255, 240, 228, 149, 43, 135, 153, 221, 64, 225, 100, 252,
+			65, 173, 186, 154, 87, 177, 35, 174, 13, 37, 153, 48, 1, 39,
+			84, 235, 96, 30, 17, 56, 140, 161, 7, 44, 118, 43, 72, 198,
+			80, 198, 1, 12, 155, 17, 119, 43, 240, 188, 3, 146, 94, 88,
+			37, 190, 199, 86, 98, 53, 101, 196, 85, 191, 212, 68, 165, 34,
+			237, 8, 102, 184, 237, 148, 131, 107, 213, 15, 10, 105, 147, 25,
+			72, 118, 247, 114, 18, 2, 125, 8, 189, 139, 147, 16, 232, 67,
+			12, 237, 86, 87, 97, 2, 2, 234, 236, 7, 133, 252, 121, 225,
+			36, 125, 88, 248, 221, 186, 243, 164, 11, 58, 77, 57, 83, 187,
+			147, 18, 218, 253, 160, 80, 61, 234, 115, 66, 101, 32, 13, 110,
+			190, 63, 14, 113, 204, 127, 71, 220, 199, 73, 68, 249, 23, 225,
+			156, 98, 197, 72, 108, 24, 196, 171, 1, 246, 163, 147, 202, 94,
+			122, 41, 70, 99, 139, 50, 155, 19, 71, 17, 150, 140, 254, 123,
+			228, 232, 82, 80, 174, 158, 92, 168, 29, 141, 234, 197, 163, 235,
+			235, 191, 17, 222, 164, 26, 84, 226, 28, 106, 157, 255, 205, 47,
+			212, 12, 66, 100, 154, 112, 229, 127, 92, 184, 137, 12, 8, 247,
+			42, 186, 251, 226, 12, 8, 248, 42, 250, 7, 212, 47, 49, 63,
+			132, 118, 127, 82, 184, 99, 222, 191, 16, 137, 80, 25, 1, 199,
+			242, 77, 156, 55, 196, 116, 192, 25, 78, 206, 202, 98, 177, 89,
+			103, 64, 191, 24, 239, 195, 6, 167, 0, 237, 37, 248, 162, 223,
+			3, 228, 180, 136, 144, 62, 120, 234, 231, 149, 127, 245, 214, 244,
+			77, 8, 127, 87, 89, 105, 89, 30, 241, 131, 22, 129, 78, 34,
+			169, 25, 164, 117, 123, 156, 129, 196, 247, 13, 199, 25, 14, 100,
+			28, 25, 85, 95, 147, 212, 61, 169, 221, 159, 19, 238, 81, 239,
+			75, 18, 187, 183, 84, 174, 34, 132, 2, 77, 172, 181, 123, 91,
+			7, 177, 175, 202, 167, 11, 250, 218, 197, 212, 65, 175, 19, 221,
+			110, 239, 117, 25, 64, 110, 192, 25, 175, 177, 152, 188, 15, 225,
+			77, 33, 238, 151, 127, 190, 99, 127, 19, 166, 126, 160, 78, 0,
+			159, 51, 80, 115, 7, 85, 0, 187, 168, 212, 238, 194, 82, 70,
+			179, 183, 142, 31, 55, 106, 32, 250, 220, 105, 185, 41, 215, 195,
+			229, 16, 118, 188, 138, 29, 67, 84, 16, 194, 118, 21, 179, 188,
+			186, 224, 151, 154, 104, 117, 81, 173, 149, 217, 160, 214, 128, 80,
+			146, 185, 2, 62, 44, 128, 252, 21, 249, 225, 189, 176, 8, 175,
+			3, 184, 103, 221, 13, 195, 219, 97, 21, 3, 251, 240, 48, 0,
+			60, 224, 207, 37, 7, 74, 10, 200, 232, 59, 28, 103, 56, 144,
+			49, 150, 87, 63, 102, 132, 127, 136, 249, 10, 231, 206, 187, 133,
+			127, 225, 222, 50, 60, 62, 130, 97, 102, 35, 188, 215, 88, 45,
+			116, 173, 22, 105, 75, 163, 228, 33, 84, 35, 216, 25, 184, 177,
+			3, 138, 89, 88, 159, 15, 224, 17, 6, 116, 4, 172, 126, 102,
+			77, 110, 132, 78, 102, 73, 23, 206, 188, 95, 136, 5, 118, 220,
+			63, 100, 58, 131, 132, 109, 161, 205, 42, 13, 193, 104, 197, 86,
+			222, 187, 32, 142, 203, 47, 193, 222, 21, 65, 39, 28, 161, 51,
+			47, 9, 249, 191, 10, 199, 43, 130, 210, 10, 66, 218, 248, 244,
+			106, 25, 239, 205, 173, 164, 141, 2, 199, 241, 89, 185, 92, 109,
+			233, 38, 10, 109, 11, 205, 50, 10, 204, 113, 52, 117, 80, 54,
+			151, 239, 249, 101, 134, 81, 72, 35, 62, 244, 75, 66, 237, 80,
+			95, 2, 124, 232, 180, 35, 96, 135, 251, 152, 112, 135, 188, 63,
+			128, 11, 54, 196, 243, 11, 235, 198, 243, 107, 108, 61, 207, 175,
+			14, 66, 173, 194, 3, 33, 201, 229, 114, 213, 191, 124, 243, 234,
+			149, 213, 114, 249, 205, 54, 185, 156, 237, 177, 121, 101, 116, 146,
+			211, 1, 187, 206, 224, 96, 142, 180, 136, 236, 0, 7, 12, 208,
+			242, 64, 214, 138, 17, 253, 108, 160, 114, 138, 158, 13, 192, 43,
+			120, 42, 169, 24, 104, 124, 133, 34, 114, 112, 151, 195, 249, 249,
+			16, 166, 123, 8, 209, 205, 105, 81, 176, 168, 139, 177, 56, 225,
+			205, 136, 65, 201, 217, 4, 59, 168, 148, 27, 177, 9, 42, 244,
+			164, 8, 209, 52, 242, 112, 141, 48, 123, 21, 172, 203, 160, 106,
+			116, 72, 224, 114, 117, 107, 202, 159, 11, 231, 97, 38, 213, 67,
+			120, 89, 66, 210, 166, 77, 9, 27, 15, 21, 164, 0, 21, 191,
+			202, 1, 116, 158, 9, 85, 198, 225, 1, 155, 81, 104, 47, 187,
+			198, 161, 11, 73, 13, 22, 162, 147, 126, 179, 50, 234, 87, 202,
+			163, 126, 96, 87, 24, 154, 71, 187, 31, 139, 119, 122, 84, 187,
+			187, 31, 19, 221, 253, 113, 134, 3, 211, 192, 27, 84, 95, 151,
+			52, 47, 0, 243, 77, 184, 131, 222, 255, 128, 75, 100, 219, 12,
+			0, 229, 89, 64, 254, 129, 180, 1, 45, 212, 248, 238, 92, 95,
+			33, 200, 202, 249, 242, 61, 24, 20, 197, 179, 116, 157, 9, 113,
+			186, 89, 121, 92, 249, 167, 43, 229, 199, 159, 46, 135, 119, 25,
+			95, 137, 37, 143, 211, 71, 43, 101, 250, 249, 116, 224, 47, 214,
+			195, 249, 199, 114, 244, 206, 185, 80, 107, 212, 242, 241, 245, 251,
+			104, 41, 92, 170, 53, 194, 49, 184, 19, 141, 21, 223, 146, 123,
+			252, 60, 166, 79, 31, 13, 30, 143, 77, 103, 225, 71, 255, 220,
+			27, 184, 214, 163, 205, 202, 227, 185, 127, 90, 227, 37, 210, 200,
+			252, 68, 6, 142, 70, 247, 206, 56, 195, 129, 140, 93, 158, 90,
+			50, 33, 112, 127, 87, 164, 62, 45, 4, 6, 34, 194, 59, 85,
+			115, 41, 137, 181, 6, 93, 63, 219, 126, 11, 49, 96, 56, 83,
+			141, 213, 177, 58, 161, 56, 168, 43, 202, 213, 196, 87, 147, 55,
+			166, 12, 40, 90, 26, 38, 207, 239, 138, 244, 78, 117, 154, 99,
+			236, 190, 44, 228, 65, 47, 239, 179, 213, 2, 128, 41, 178, 45,
+			249, 41, 191, 84, 107, 125, 253, 166, 168, 187, 137, 96, 186, 47,
+			11, 233, 39, 130, 233, 190, 44, 246, 31, 80, 7, 57, 152, 238,
+			39, 132, 236, 242, 250, 253, 27, 227, 163, 254, 229, 182, 219, 153,
+			173, 4, 118, 181, 79, 8, 233, 114, 82, 66, 114, 19, 92, 222,
+			41, 242, 237, 39, 133, 228, 176, 184, 80, 231, 39, 227, 178, 128,
+			173, 244, 201, 184, 172, 163, 221, 223, 143, 203, 2, 208, 206, 239,
+			199, 101, 29, 9, 73, 91, 214, 213, 238, 31, 196, 101, 225, 49,
+			238, 15, 226, 178, 224, 92, 253, 7, 113, 89, 8, 114, 27, 151,
+			133, 131, 225, 83, 113, 217, 180, 132, 228, 166, 172, 138, 76, 144,
+			242, 63, 20, 169, 63, 19, 194, 11, 173, 134, 157, 222, 71, 105,
+			25, 206, 145, 98, 176, 69, 197, 14, 179, 14, 88, 140, 10, 110,
+			146, 110, 231, 154, 11, 24, 184, 167, 81, 179, 94, 248, 137, 59,
+			37, 63, 254, 114, 200, 241, 63, 20, 217, 126, 213, 15, 137, 46,
+			237, 254, 119, 33, 183, 228, 20, 191, 92, 161, 86, 196, 117, 51,
+			93, 41, 248, 165, 219, 128, 240, 100, 224, 237, 170, 37, 41, 41,
+			9, 184, 149, 25, 152, 23, 127, 34, 36, 227, 86, 182, 55, 76,
+			251, 59, 247, 8, 222, 238, 47, 172, 50, 115, 164, 120, 227, 105,
+			172, 201, 160, 122, 154, 104, 228, 127, 34, 186, 122, 18, 209, 200,
+			255, 68, 108, 239, 83, 95, 148, 28, 141, 252, 21, 33, 135, 188,
+			79, 73, 210, 198, 128, 1, 144, 209, 246, 210, 86, 69, 58, 44,
+			228, 20, 29, 184, 204, 45, 86, 166, 132, 28, 227, 29, 238, 57,
+			168, 22, 134, 18, 132, 48, 203, 132, 214, 65, 65, 95, 172, 45,
+			229, 192, 4, 33, 55, 127, 15, 146, 165, 240, 78, 142, 77, 74,
+			185, 237, 86, 215, 10, 235, 254, 2, 85, 19, 164, 82, 66, 181,
+			150, 87, 254, 117, 70, 150, 55, 215, 81, 216, 75, 42, 229, 121,
+			19, 241, 17, 234, 75, 148, 110, 245, 231, 13, 20, 232, 86, 239,
+			6, 245, 146, 31, 85, 192, 31, 105, 56, 119, 52, 55, 210, 90,
+			166, 181, 229, 60, 171, 124, 13, 169, 33, 132, 0, 7, 197, 202,
+			44, 170, 126, 199, 199, 30, 125, 211, 88, 30, 61, 219, 31, 124,
+			235, 129, 89, 59, 32, 176, 61, 189, 18, 15, 8, 172, 190, 87,
+			68, 87, 63, 39, 29, 72, 122, 131, 234, 183, 165, 9, 15, 253,
+			21, 1, 198, 55, 222, 127, 144, 126, 155, 241, 149, 101, 75, 66,
+			200, 143, 200, 66, 33, 17, 114, 151, 175, 88, 56, 158, 177, 240,
+			90, 142, 146, 81, 131, 97, 55, 142, 236, 67, 67, 213, 234, 143,
+			202, 213, 82, 249, 78, 50, 58, 88, 66, 168, 142, 232, 50, 29,
+			95, 57, 192, 193, 5, 163, 85, 149, 231, 99, 221, 81, 162, 60,
+			200, 12, 17, 0, 40, 130, 4, 161, 80, 132, 40, 214, 150, 230,
+			202, 213, 56, 152, 89, 213, 191, 94, 24, 139, 194, 165, 160, 218,
+			40, 23, 71, 242, 52, 21, 108, 29, 208, 36, 215, 16, 215, 172,
+			224, 69, 41, 0, 133, 53, 192, 73, 192, 51, 30, 245, 7, 116,
+			99, 180, 70, 33, 180, 246, 87, 96, 141, 30, 230, 208, 218, 95,
+			21, 114, 183, 55, 212, 198, 22, 96, 31, 108, 222, 165, 128, 239,
+			181, 155, 224, 169, 207, 253, 42, 95, 177, 77, 200, 237, 175, 10,
+			10, 106, 103, 66, 110, 127, 85, 244, 114, 108, 68, 88, 80, 95,
+			21, 131, 67, 106, 148, 35, 110, 255, 181, 144, 123, 189, 61, 29,
+			218, 1, 250, 91, 91, 130, 96, 91, 127, 29, 183, 4, 51, 229,
+			175, 227, 150, 96, 166, 252, 181, 232, 245, 56, 233, 64, 114, 247,
+			30, 53, 198, 161, 112, 255, 6, 90, 218, 219, 161, 37, 52, 147,
+			107, 141, 240, 40, 93, 44, 111, 147, 105, 72, 218, 166, 128, 238,
+			191, 137, 155, 2, 36, 185, 191, 17, 187, 247, 88, 123, 232, 247,
+			159, 80, 244, 54, 97, 236, 149, 231, 154, 243, 71, 17, 215, 109,
+			102, 41, 136, 110, 147, 233, 243, 54, 146, 44, 184, 68, 110, 159,
+			234, 186, 8, 133, 174, 6, 209, 109, 221, 167, 210, 203, 65, 99,
+			209, 24, 232, 118, 21, 76, 226, 236, 59, 133, 218, 94, 172, 45,
+			229, 219, 62, 61, 187, 213, 126, 120, 3, 106, 187, 33, 190, 107,
+			130, 138, 44, 212, 42, 65, 117, 1, 53, 0, 92, 250, 104, 99,
+			101, 57, 140, 142, 222, 174, 214, 238, 86, 13, 89, 64, 213, 242,
+			220, 223, 9, 241, 33, 233, 92, 186, 113, 246, 255, 47, 247, 92,
+			50, 95, 223, 160, 79, 242, 207, 132, 149, 202, 83, 240, 193, 77,
+			248, 246, 201, 159, 61, 110, 236, 222, 94, 17, 66, 125, 34, 182,
+			123, 251, 47, 109, 118, 111, 227, 99, 19, 227, 19, 15, 250, 103,
+			155, 243, 254, 205, 176, 184, 104, 98, 183, 133, 17, 70, 137, 127,
+			221, 2, 238, 117, 11, 184, 245, 45, 224, 6, 172, 5, 220, 40,
+			90, 192, 9, 176, 128, 27, 165, 204, 237, 169, 163, 152, 73, 127,
+			178, 67, 114, 14, 51, 149, 249, 211, 100, 238, 72, 237, 195, 204,
+			3, 230, 79, 147, 185, 51, 117, 10, 51, 15, 154, 63, 77, 102,
+			127, 106, 138, 77, 237, 224, 79, 147, 57, 144, 218, 139, 153, 123,
+			205, 159, 191, 58, 138, 182, 88, 153, 47, 138, 212, 43, 66, 120,
+			63, 59, 234, 207, 218, 181, 55, 155, 20, 139, 3, 214, 88, 71,
+			43, 75, 115, 112, 135, 37, 100, 71, 92, 200, 112, 108, 218, 43,
+			202, 73, 154, 92, 248, 203, 73, 63, 55, 159, 15, 114, 237, 57,
+			115, 249, 18, 92, 35, 46, 135, 245, 208, 159, 157, 111, 107, 200,
+			84, 140, 176, 135, 16, 176, 176, 86, 107, 48, 62, 228, 168, 63,
+			27, 204, 2, 215, 253, 217, 185, 89, 69, 97, 143, 89, 161, 18,
+			99, 72, 146, 87, 236, 236, 252, 172, 81, 29, 207, 150, 102, 109,
+			181, 73, 159, 89, 101, 191, 193, 210, 249, 57, 56, 182, 125, 236,
+			191, 15, 123, 136, 49, 120, 96, 21, 41, 135, 2, 15, 8, 56,
+			57, 25, 108, 60, 33, 49, 129, 34, 220, 122, 128, 206, 65, 241,
+			5, 96, 220, 178, 5, 191, 173, 67, 228, 26, 139, 208, 31, 84,
+			73, 109, 30, 23, 201, 183, 209, 128, 246, 63, 240, 184, 30, 176,
+			5, 196, 147, 211, 215, 175, 249, 97, 181, 88, 195, 216, 142, 248,
+			44, 53, 23, 86, 106, 119, 241, 141, 124, 63, 125, 125, 21, 191,
+			46, 87, 217, 98, 16, 102, 44, 187, 24, 39, 157, 127, 64, 245,
+			75, 218, 160, 128, 37, 206, 114, 173, 10, 143, 5, 214, 78, 142,
+			249, 4, 145, 75, 162, 230, 220, 152, 229, 91, 68, 192, 183, 177,
+			192, 52, 121, 99, 10, 184, 133, 162, 91, 171, 39, 57, 49, 11,
+			176, 48, 89, 152, 177, 68, 0, 187, 243, 173, 23, 93, 210, 0,
+			194, 47, 92, 10, 116, 13, 229, 90, 51, 82, 92, 202, 47, 183,
+			68, 230, 232, 64, 49, 88, 159, 224, 45, 50, 226, 121, 57, 239,
+			191, 72, 187, 159, 31, 248, 39, 253, 137, 9, 78, 205, 197, 63,
+			248, 126, 201, 63, 233, 31, 139, 147, 247, 160, 36, 39, 223, 202,
+			127, 172, 64, 161, 227, 42, 153, 249, 194, 73, 255, 17, 122, 255,
+			224, 80, 16, 236, 39, 203, 220, 176, 187, 11, 189, 34, 192, 218,
+			33, 222, 220, 27, 93, 193, 25, 251, 130, 194, 211, 161, 92, 39,
+			27, 7, 126, 139, 136, 66, 123, 233, 36, 228, 26, 51, 195, 201,
+			223, 22, 248, 132, 199, 34, 234, 247, 148, 95, 107, 54, 150, 155,
+			141, 145, 147, 234, 181, 119, 157, 186, 245, 86, 120, 49, 177, 250,
+			78, 90, 75, 229, 136, 162, 191, 130, 242, 180, 196, 103, 20, 225,
+			223, 86, 224, 33, 127, 185, 22, 145, 229, 199, 188, 31, 40, 179,
+			1, 196, 240, 190, 230, 209, 201, 238, 55, 108, 0, 74, 213, 210,
+			142, 64, 184, 104, 201, 53, 132, 34, 165, 138, 211, 52, 5, 140,
+			141, 112, 165, 194, 204, 28, 6, 21, 91, 91, 11, 243, 137, 2,
+			160, 132, 3, 109, 112, 210, 229, 126, 164, 21, 18, 153, 119, 13,
+			156, 132, 54, 150, 67, 53, 4, 39, 191, 160, 94, 6, 189, 239,
+			50, 24, 255, 242, 139, 100, 28, 219, 163, 125, 34, 230, 253, 169,
+			56, 178, 87, 224, 23, 46, 76, 223, 236, 216, 169, 68, 123, 220,
+			171, 82, 185, 30, 22, 27, 182, 21, 59, 199, 1, 154, 188, 145,
+			12, 24, 102, 170, 85, 244, 176, 210, 82, 49, 47, 163, 168, 17,
+			6, 165, 36, 191, 194, 160, 184, 216, 42, 248, 227, 202, 182, 48,
+			225, 118, 43, 179, 86, 40, 80, 123, 167, 222, 208, 222, 100, 236,
+			181, 71, 149, 9, 250, 149, 192, 174, 128, 251, 210, 138, 125, 6,
+			166, 231, 103, 75, 26, 147, 68, 51, 29, 222, 59, 0, 225, 118,
+			197, 47, 213, 138, 77, 176, 133, 192, 77, 120, 193, 56, 121, 163,
+			224, 83, 110, 0, 50, 70, 177, 18, 208, 36, 32, 130, 65, 247,
+			226, 251, 83, 85, 5, 145, 39, 144, 70, 195, 0, 163, 180, 244,
+			107, 107, 116, 235, 40, 255, 17, 37, 205, 75, 32, 164, 208, 98,
+			112, 167, 12, 43, 180, 86, 247, 39, 111, 76, 69, 29, 183, 88,
+			122, 195, 191, 206, 60, 143, 112, 185, 36, 198, 178, 188, 122, 155,
+			143, 111, 186, 108, 70, 69, 243, 214, 70, 119, 15, 234, 11, 33,
+			5, 103, 68, 26, 241, 56, 90, 168, 145, 202, 48, 126, 228, 7,
+			27, 18, 179, 241, 182, 144, 110, 119, 225, 69, 138, 16, 159, 140,
+			198, 11, 41, 106, 47, 72, 220, 185, 121, 216, 129, 104, 224, 96,
+			201, 175, 132, 20, 40, 195, 12, 40, 200, 56, 141, 90, 19, 225,
+			236, 204, 250, 181, 212, 129, 118, 59, 136, 248, 100, 169, 41, 171,
+			130, 129, 238, 48, 173, 68, 194, 168, 61, 43, 140, 162, 58, 106,
+			35, 47, 168, 84, 236, 249, 14, 123, 12, 234, 138, 227, 67, 6,
+			200, 179, 27, 200, 234, 93, 41, 238, 14, 236, 173, 29, 142, 216,
+			81, 124, 7, 167, 230, 96, 202, 193, 64, 195, 178, 0, 197, 37,
+			27, 24, 36, 222, 189, 219, 90, 48, 60, 50, 227, 99, 251, 159,
+			143, 247, 13, 213, 145, 42, 60, 18, 121, 179, 44, 87, 215, 216,
+			37, 253, 89, 220, 37, 103, 219, 183, 201, 182, 99, 119, 213, 136,
+			173, 170, 201, 142, 176, 89, 251, 134, 19, 170, 3, 43, 76, 228,
+			91, 230, 135, 125, 247, 94, 10, 235, 11, 56, 150, 237, 220, 72,
+			80, 162, 214, 226, 69, 187, 214, 218, 104, 136, 18, 37, 169, 130,
+			14, 135, 114, 235, 89, 212, 122, 10, 119, 58, 132, 139, 39, 253,
+			55, 30, 123, 62, 113, 76, 85, 75, 137, 33, 127, 21, 237, 140,
+			119, 172, 122, 34, 81, 53, 114, 170, 188, 138, 181, 101, 35, 91,
+			144, 120, 251, 198, 220, 124, 126, 46, 55, 10, 98, 110, 49, 247,
+			60, 127, 213, 104, 19, 8, 230, 94, 29, 73, 235, 245, 125, 212,
+			79, 210, 56, 89, 197, 11, 14, 218, 244, 82, 192, 216, 96, 133,
+			29, 182, 253, 26, 190, 75, 224, 73, 9, 43, 170, 14, 121, 140,
+			49, 4, 0, 21, 45, 251, 157, 138, 39, 49, 236, 4, 196, 74,
+			90, 150, 184, 252, 170, 6, 42, 15, 170, 131, 211, 218, 30, 152,
+			135, 216, 56, 147, 230, 14, 213, 62, 154, 228, 92, 51, 106, 224,
+			170, 163, 25, 132, 147, 20, 154, 89, 45, 227, 80, 93, 84, 144,
+			125, 207, 19, 147, 205, 191, 12, 102, 115, 248, 102, 210, 78, 79,
+			44, 14, 212, 146, 155, 213, 168, 229, 72, 192, 173, 192, 92, 142,
+			26, 232, 90, 70, 171, 135, 11, 91, 178, 18, 181, 37, 168, 30,
+			5, 153, 190, 84, 83, 73, 207, 120, 63, 160, 99, 45, 178, 187,
+			32, 136, 26, 149, 218, 93, 187, 160, 91, 38, 80, 139, 196, 83,
+			227, 217, 107, 248, 213, 73, 206, 81, 29, 5, 157, 68, 149, 181,
+			249, 100, 9, 27, 29, 198, 110, 27, 35, 249, 164, 156, 99, 89,
+			11, 34, 151, 97, 64, 84, 92, 12, 151, 2, 63, 188, 83, 171,
+			52, 121, 159, 0, 21, 96, 176, 130, 15, 131, 180, 207, 81, 253,
+			64, 102, 177, 82, 6, 113, 205, 74, 73, 160, 144, 65, 206, 65,
+			227, 112, 116, 152, 183, 60, 232, 232, 124, 185, 82, 161, 173, 69,
+			17, 167, 209, 191, 209, 238, 61, 245, 48, 158, 5, 229, 58, 143,
+			16, 42, 8, 227, 24, 175, 119, 131, 106, 35, 121, 68, 143, 38,
+			193, 232, 57, 30, 41, 200, 28, 116, 36, 130, 63, 160, 161, 17,
+			229, 196, 187, 16, 15, 130, 46, 117, 170, 133, 121, 56, 57, 74,
+			205, 34, 233, 40, 195, 122, 29, 180, 6, 160, 155, 132, 55, 0,
+			127, 50, 34, 115, 221, 164, 248, 22, 1, 127, 66, 191, 194, 33,
+			185, 218, 167, 16, 25, 134, 242, 100, 136, 58, 156, 137, 60, 10,
+			204, 12, 94, 111, 165, 112, 25, 172, 0, 72, 116, 73, 202, 189,
+			183, 203, 213, 18, 138, 98, 157, 69, 157, 14, 27, 85, 235, 211,
+			219, 92, 232, 47, 194, 91, 67, 203, 237, 13, 186, 184, 127, 63,
+			60, 179, 163, 190, 152, 100, 21, 56, 62, 46, 223, 188, 121, 3,
+			101, 61, 186, 224, 96, 26, 104, 160, 168, 213, 237, 71, 11, 137,
+			53, 248, 2, 219, 50, 57, 237, 218, 167, 81, 190, 49, 121, 243,
+			220, 101, 43, 157, 214, 230, 253, 27, 183, 110, 182, 44, 102, 163,
+			225, 93, 49, 45, 178, 194, 24, 32, 157, 160, 32, 212, 102, 109,
+			232, 241, 102, 11, 196, 162, 41, 172, 161, 40, 162, 123, 49, 94,
+			155, 47, 240, 181, 185, 54, 159, 148, 226, 96, 85, 86, 177, 196,
+			104, 235, 66, 130, 23, 63, 248, 4, 54, 65, 84, 131, 148, 171,
+			11, 149, 144, 78, 103, 138, 193, 142, 59, 63, 72, 103, 0, 9,
+			186, 28, 88, 64, 42, 80, 191, 45, 45, 5, 121, 211, 80, 100,
+			224, 55, 202, 85, 19, 63, 2, 62, 34, 173, 56, 25, 54, 194,
+			253, 225, 40, 190, 170, 128, 140, 80, 31, 43, 6, 75, 97, 5,
+			62, 34, 39, 17, 48, 62, 129, 193, 160, 73, 24, 84, 227, 243,
+			149, 181, 251, 109, 207, 166, 241, 20, 178, 114, 178, 189, 7, 243,
+			111, 55, 234, 53, 84, 49, 218, 163, 231, 22, 216, 81, 160, 49,
+			197, 99, 254, 177, 83, 156, 123, 99, 17, 238, 149, 203, 248, 223,
+			199, 252, 137, 83, 42, 121, 18, 113, 93, 248, 169, 173, 136, 120,
+			68, 239, 70, 136, 105, 208, 82, 37, 253, 206, 49, 137, 147, 181,
+			226, 104, 152, 171, 108, 203, 104, 192, 200, 206, 18, 197, 179, 184,
+			25, 85, 106, 53, 220, 102, 163, 102, 113, 209, 118, 13, 182, 94,
+			75, 6, 171, 157, 160, 75, 249, 36, 49, 185, 246, 18, 216, 187,
+			92, 11, 13, 102, 70, 88, 251, 87, 158, 18, 86, 97, 101, 166,
+			5, 238, 236, 220, 186, 109, 24, 10, 183, 181, 11, 240, 13, 163,
+			237, 205, 180, 94, 39, 96, 203, 188, 94, 13, 121, 122, 70, 109,
+			42, 169, 70, 61, 12, 26, 188, 175, 195, 26, 129, 162, 145, 255,
+			102, 88, 4, 1, 16, 182, 128, 15, 65, 116, 72, 219, 101, 12,
+			19, 67, 173, 158, 25, 39, 85, 235, 0, 78, 163, 90, 229, 42,
+			165, 108, 79, 176, 17, 180, 8, 0, 247, 252, 90, 66, 100, 177,
+			163, 72, 163, 251, 160, 29, 93, 223, 159, 110, 206, 113, 77, 128,
+			160, 202, 109, 60, 230, 63, 122, 170, 77, 146, 121, 43, 237, 40,
+			137, 145, 54, 192, 58, 235, 143, 104, 98, 16, 161, 134, 235, 245,
+			245, 139, 39, 136, 72, 124, 21, 159, 130, 212, 203, 149, 229, 16,
+			22, 93, 24, 249, 195, 185, 184, 203, 185, 216, 242, 38, 136, 194,
+			17, 112, 150, 32, 240, 51, 82, 166, 145, 122, 131, 182, 207, 120,
+			68, 65, 231, 15, 71, 18, 174, 62, 234, 103, 155, 132, 134, 219,
+			231, 10, 222, 224, 204, 245, 153, 118, 78, 56, 57, 147, 234, 11,
+			120, 233, 32, 30, 177, 6, 147, 15, 11, 210, 62, 162, 11, 209,
+			10, 189, 230, 17, 252, 88, 139, 162, 22, 102, 23, 131, 178, 85,
+			149, 63, 59, 117, 237, 233, 201, 43, 83, 231, 103, 38, 11, 151,
+			110, 93, 189, 112, 237, 230, 108, 124, 220, 193, 161, 2, 95, 193,
+			124, 111, 86, 151, 130, 229, 229, 196, 11, 91, 74, 104, 247, 139,
+			34, 219, 139, 47, 95, 224, 84, 237, 126, 25, 94, 146, 247, 36,
+			141, 155, 19, 163, 201, 172, 129, 23, 166, 20, 190, 177, 125, 153,
+			159, 163, 140, 113, 229, 151, 217, 140, 213, 152, 86, 126, 89, 232,
+			126, 78, 66, 192, 47, 145, 128, 55, 126, 229, 109, 66, 77, 108,
+			12, 220, 224, 40, 88, 44, 175, 13, 206, 179, 151, 94, 181, 130,
+			229, 50, 61, 104, 177, 44, 97, 62, 241, 238, 251, 236, 229, 237,
+			109, 47, 97, 129, 247, 168, 192, 107, 193, 79, 246, 78, 109, 240,
+			35, 178, 250, 153, 169, 135, 65, 196, 31, 231, 126, 33, 171, 220,
+			66, 179, 18, 118, 132, 231, 217, 19, 195, 131, 0, 70, 71, 215,
+			89, 247, 75, 147, 194, 137, 65, 66, 118, 171, 77, 192, 51, 136,
+			175, 226, 36, 126, 207, 64, 230, 84, 73, 143, 169, 109, 240, 215,
+			76, 172, 214, 25, 112, 109, 49, 89, 216, 10, 63, 158, 183, 191,
+			233, 135, 149, 51, 215, 92, 32, 92, 152, 14, 112, 24, 147, 214,
+			99, 227, 108, 115, 129, 234, 128, 47, 244, 160, 234, 42, 71, 51,
+			104, 66, 25, 34, 128, 80, 182, 144, 45, 71, 104, 29, 27, 234,
+			7, 212, 182, 114, 100, 16, 134, 202, 213, 133, 25, 104, 97, 43,
+			22, 217, 82, 142, 174, 82, 238, 217, 230, 130, 126, 88, 13, 180,
+			149, 139, 67, 181, 108, 195, 15, 118, 180, 124, 96, 145, 68, 234,
+			106, 100, 173, 15, 103, 42, 65, 212, 152, 49, 114, 5, 34, 42,
+			15, 244, 96, 231, 188, 246, 71, 204, 188, 141, 70, 94, 216, 223,
+			177, 149, 43, 65, 212, 48, 122, 35, 40, 169, 207, 170, 173, 230,
+			182, 49, 67, 150, 125, 3, 155, 214, 2, 105, 166, 168, 89, 83,
+			165, 194, 22, 243, 9, 101, 232, 55, 169, 190, 86, 236, 165, 25,
+			116, 106, 27, 232, 197, 154, 14, 220, 7, 151, 103, 26, 202, 210,
+			120, 235, 22, 48, 38, 252, 69, 79, 170, 238, 98, 61, 180, 253,
+			206, 222, 175, 223, 84, 149, 50, 31, 97, 39, 15, 218, 42, 224,
+			48, 30, 232, 74, 204, 48, 42, 6, 18, 132, 126, 94, 121, 200,
+			230, 160, 89, 42, 55, 96, 211, 105, 97, 184, 222, 96, 195, 253,
+			80, 199, 36, 87, 145, 96, 245, 228, 90, 213, 35, 81, 219, 19,
+			68, 117, 170, 2, 41, 124, 82, 245, 172, 154, 8, 106, 131, 116,
+			109, 173, 180, 142, 252, 243, 202, 179, 216, 225, 171, 167, 215, 150,
+			141, 246, 214, 214, 209, 54, 177, 180, 114, 195, 70, 176, 48, 176,
+			25, 250, 85, 192, 191, 159, 116, 179, 221, 61, 155, 115, 159, 150,
+			74, 175, 158, 1, 122, 90, 109, 54, 102, 192, 52, 123, 224, 201,
+			190, 123, 98, 124, 35, 179, 39, 143, 46, 164, 43, 56, 95, 10,
+			221, 203, 113, 194, 251, 11, 161, 186, 19, 63, 194, 2, 167, 70,
+			98, 100, 39, 147, 49, 85, 106, 93, 253, 178, 109, 245, 95, 81,
+			125, 200, 36, 182, 175, 6, 43, 237, 50, 197, 121, 90, 151, 81,
+			5, 141, 163, 105, 63, 131, 31, 244, 13, 181, 19, 114, 103, 90,
+			77, 228, 161, 62, 247, 190, 245, 33, 29, 73, 91, 115, 248, 41,
+			119, 88, 109, 189, 20, 54, 96, 3, 38, 212, 30, 61, 144, 220,
+			135, 105, 151, 195, 156, 220, 184, 234, 1, 104, 15, 40, 204, 24,
+			63, 122, 168, 53, 244, 22, 149, 167, 188, 220, 164, 234, 77, 124,
+			65, 248, 111, 163, 42, 13, 27, 47, 195, 159, 237, 92, 61, 84,
+			80, 190, 96, 10, 229, 138, 170, 247, 28, 174, 181, 36, 141, 235,
+			182, 170, 199, 149, 11, 223, 18, 180, 214, 26, 245, 211, 55, 88,
+			50, 247, 99, 66, 245, 154, 73, 152, 108, 133, 235, 17, 27, 173,
+			71, 159, 82, 221, 180, 26, 64, 142, 24, 144, 107, 12, 138, 149,
+			145, 10, 202, 20, 7, 201, 203, 78, 125, 39, 158, 250, 185, 139,
+			170, 231, 74, 173, 118, 187, 185, 124, 182, 201, 64, 77, 192, 114,
+			99, 175, 214, 218, 121, 147, 167, 251, 16, 197, 45, 62, 45, 101,
+			65, 150, 75, 185, 17, 213, 155, 168, 135, 6, 162, 143, 7, 66,
+			34, 190, 27, 49, 252, 199, 132, 210, 55, 224, 233, 50, 188, 187,
+			113, 150, 119, 56, 105, 229, 58, 39, 173, 86, 46, 88, 252, 32,
+			40, 107, 186, 128, 127, 3, 124, 88, 132, 242, 252, 76, 84, 126,
+			193, 76, 232, 116, 65, 153, 172, 233, 242, 11, 97, 238, 71, 51,
+			106, 123, 11, 97, 212, 141, 17, 213, 195, 246, 196, 51, 17, 8,
+			186, 161, 89, 163, 78, 97, 27, 231, 79, 155, 108, 61, 164, 186,
+			26, 245, 102, 21, 28, 150, 74, 180, 84, 227, 12, 125, 37, 198,
+			153, 115, 112, 23, 153, 88, 61, 228, 29, 8, 32, 68, 181, 232,
+			66, 181, 81, 95, 137, 209, 230, 12, 128, 89, 163, 92, 45, 54,
+			102, 64, 48, 7, 240, 58, 32, 106, 11, 231, 130, 173, 124, 164,
+			231, 84, 47, 232, 54, 43, 193, 242, 50, 156, 222, 56, 6, 3,
+			105, 108, 254, 196, 198, 154, 191, 30, 127, 142, 3, 214, 147, 168,
+			15, 50, 34, 253, 38, 181, 141, 88, 203, 12, 65, 72, 191, 238,
+			137, 227, 27, 107, 193, 220, 179, 46, 154, 111, 11, 91, 163, 100,
+			50, 242, 78, 170, 205, 73, 14, 232, 30, 229, 220, 14, 87, 104,
+			155, 132, 63, 193, 158, 10, 149, 70, 200, 114, 167, 96, 18, 39,
+			229, 35, 194, 155, 84, 219, 218, 200, 215, 58, 177, 234, 186, 104,
+			93, 121, 42, 107, 41, 55, 117, 216, 180, 247, 13, 161, 182, 180,
+			16, 168, 251, 213, 38, 96, 120, 140, 194, 151, 129, 228, 84, 73,
+			31, 87, 155, 200, 55, 225, 190, 209, 57, 10, 92, 82, 95, 84,
+			91, 91, 5, 215, 1, 103, 45, 1, 145, 8, 40, 160, 124, 91,
+			216, 66, 159, 153, 36, 48, 1, 193, 206, 41, 116, 159, 73, 232,
+			73, 181, 181, 53, 230, 197, 64, 122, 141, 77, 35, 222, 201, 183,
+			180, 4, 187, 152, 248, 162, 163, 210, 102, 156, 39, 149, 115, 41,
+			108, 104, 127, 53, 101, 173, 123, 188, 183, 198, 94, 150, 75, 233,
+			55, 16, 196, 91, 135, 120, 32, 237, 123, 191, 183, 127, 221, 50,
+			12, 238, 166, 47, 169, 140, 217, 193, 117, 135, 15, 86, 237, 237,
+			235, 208, 118, 73, 101, 204, 38, 221, 169, 162, 85, 219, 247, 58,
+			21, 61, 173, 186, 236, 110, 216, 177, 167, 241, 86, 185, 118, 79,
+			227, 50, 182, 167, 207, 2, 96, 53, 46, 31, 125, 224, 62, 43,
+			203, 212, 123, 240, 62, 165, 184, 230, 215, 6, 144, 247, 165, 162,
+			49, 20, 252, 67, 249, 58, 64, 222, 235, 0, 121, 223, 98, 128,
+			60, 194, 194, 211, 169, 17, 176, 208, 3, 196, 169, 237, 169, 195,
+			248, 167, 4, 3, 64, 147, 235, 128, 217, 223, 99, 248, 167, 11,
+			198, 126, 23, 172, 93, 159, 197, 213, 27, 72, 93, 86, 183, 13,
+			152, 222, 80, 234, 52, 56, 186, 116, 2, 211, 91, 10, 170, 229,
+			229, 38, 32, 50, 33, 188, 64, 180, 10, 34, 104, 212, 90, 190,
+			89, 92, 5, 21, 59, 56, 227, 228, 34, 40, 20, 198, 216, 27,
+			202, 110, 81, 55, 25, 99, 111, 175, 60, 226, 93, 242, 11, 132,
+			38, 22, 17, 24, 67, 91, 180, 63, 112, 203, 168, 213, 49, 144,
+			198, 6, 161, 243, 246, 102, 186, 18, 208, 121, 123, 213, 64, 2,
+			58, 111, 239, 254, 67, 234, 215, 45, 116, 222, 3, 242, 148, 247,
+			17, 134, 206, 131, 182, 193, 133, 231, 230, 245, 243, 215, 79, 198,
+			15, 164, 254, 50, 220, 240, 173, 83, 200, 82, 112, 27, 70, 188,
+			28, 253, 147, 128, 209, 123, 160, 5, 70, 239, 129, 238, 193, 4,
+			140, 222, 3, 15, 60, 172, 158, 198, 158, 74, 237, 28, 150, 199,
+			188, 41, 223, 236, 251, 17, 249, 248, 191, 70, 102, 31, 183, 20,
+			0, 140, 199, 225, 204, 102, 78, 65, 51, 91, 24, 200, 15, 0,
+			179, 15, 143, 140, 169, 2, 82, 0, 177, 59, 228, 49, 239, 2,
+			89, 193, 124, 19, 67, 253, 160, 109, 29, 102, 127, 222, 182, 238,
+			72, 237, 228, 109, 235, 14, 52, 56, 50, 6, 142, 214, 208, 188,
+			11, 208, 115, 79, 120, 63, 33, 124, 56, 129, 34, 191, 185, 28,
+			99, 102, 172, 70, 4, 177, 174, 42, 163, 54, 30, 11, 60, 84,
+			86, 194, 210, 66, 168, 236, 78, 152, 8, 234, 24, 87, 6, 239,
+			142, 213, 215, 208, 171, 135, 108, 175, 0, 225, 236, 184, 197, 126,
+			116, 37, 0, 227, 249, 156, 2, 152, 188, 35, 167, 213, 103, 36,
+			50, 53, 13, 209, 48, 206, 122, 255, 85, 250, 116, 124, 1, 91,
+			75, 245, 96, 222, 186, 250, 217, 206, 193, 228, 5, 134, 143, 194,
+			147, 83, 8, 146, 32, 250, 33, 251, 229, 134, 242, 131, 5, 240,
+			181, 110, 144, 151, 96, 5, 160, 181, 8, 231, 0, 4, 56, 187,
+			156, 243, 254, 100, 28, 182, 145, 51, 41, 190, 127, 73, 65, 189,
+			132, 87, 101, 236, 142, 138, 139, 97, 241, 54, 60, 113, 153, 39,
+			221, 36, 135, 140, 22, 167, 4, 27, 41, 189, 110, 210, 107, 85,
+			131, 23, 8, 92, 40, 34, 219, 200, 168, 95, 70, 175, 186, 37,
+			168, 40, 188, 183, 28, 86, 35, 112, 44, 69, 63, 108, 52, 56,
+			130, 111, 104, 43, 165, 163, 197, 80, 201, 167, 114, 158, 212, 101,
+			81, 222, 126, 253, 134, 102, 88, 135, 103, 242, 120, 47, 182, 35,
+			0, 128, 89, 167, 50, 91, 56, 37, 181, 115, 106, 43, 143, 64,
+			26, 162, 140, 28, 121, 92, 61, 132, 38, 209, 233, 51, 169, 15,
+			11, 225, 141, 248, 147, 173, 83, 169, 5, 230, 129, 38, 21, 120,
+			51, 197, 136, 147, 103, 178, 155, 213, 239, 219, 112, 2, 231, 165,
+			246, 254, 243, 58, 225, 4, 214, 26, 205, 188, 242, 207, 181, 198,
+			22, 64, 36, 36, 248, 3, 89, 9, 101, 200, 127, 188, 92, 245,
+			65, 184, 137, 242, 151, 194, 6, 176, 107, 125, 112, 65, 248, 48,
+			58, 250, 34, 105, 121, 95, 75, 8, 130, 243, 132, 245, 131, 170,
+			122, 231, 60, 161, 12, 154, 61, 248, 124, 79, 175, 122, 130, 34,
+			16, 184, 151, 228, 229, 113, 47, 33, 12, 177, 111, 94, 140, 142,
+			97, 187, 226, 115, 156, 171, 182, 176, 0, 151, 108, 91, 128, 226,
+			116, 169, 171, 135, 83, 142, 118, 46, 109, 239, 227, 84, 86, 59,
+			151, 221, 163, 106, 155, 202, 98, 234, 39, 179, 41, 237, 92, 78,
+			231, 213, 50, 69, 13, 112, 175, 200, 171, 227, 222, 28, 146, 178,
+			54, 50, 218, 90, 99, 49, 170, 224, 145, 239, 248, 132, 121, 151,
+			133, 135, 118, 127, 49, 188, 23, 148, 194, 98, 121, 41, 168, 248,
+			197, 197, 160, 14, 128, 89, 117, 6, 12, 52, 225, 6, 174, 88,
+			210, 97, 47, 189, 98, 73, 135, 221, 243, 138, 37, 93, 102, 181,
+			115, 213, 146, 46, 13, 233, 87, 211, 121, 245, 135, 224, 163, 135,
+			94, 150, 207, 200, 103, 199, 188, 223, 54, 62, 122, 64, 142, 111,
+			85, 125, 163, 100, 95, 137, 47, 209, 192, 79, 234, 65, 148, 112,
+			11, 231, 206, 0, 99, 91, 141, 178, 14, 153, 219, 151, 127, 101,
+			234, 169, 11, 126, 110, 186, 182, 20, 154, 199, 159, 147, 254, 193,
+			220, 33, 88, 182, 171, 193, 50, 104, 177, 149, 171, 11, 52, 143,
+			192, 67, 2, 255, 147, 95, 168, 177, 133, 51, 46, 80, 229, 95,
+			56, 123, 237, 162, 191, 80, 15, 150, 150, 130, 152, 197, 86, 100,
+			226, 89, 77, 225, 56, 91, 251, 21, 157, 82, 180, 47, 36, 173,
+			237, 47, 225, 180, 244, 167, 27, 65, 181, 4, 142, 123, 211, 111,
+			184, 194, 88, 51, 75, 193, 61, 68, 159, 96, 243, 186, 74, 88,
+			93, 48, 47, 87, 15, 157, 56, 113, 252, 161, 78, 67, 4, 145,
+			84, 159, 177, 67, 4, 7, 206, 51, 93, 3, 156, 114, 180, 243,
+			204, 224, 16, 167, 178, 218, 121, 214, 29, 165, 33, 114, 204, 16,
+			61, 155, 62, 66, 49, 206, 92, 237, 190, 81, 190, 105, 204, 27,
+			183, 96, 127, 96, 27, 147, 156, 80, 132, 156, 104, 7, 2, 143,
+			30, 75, 136, 155, 209, 206, 27, 165, 79, 132, 192, 25, 241, 198,
+			125, 7, 56, 229, 104, 231, 141, 135, 134, 57, 149, 213, 206, 155,
+			44, 33, 174, 33, 228, 77, 233, 35, 234, 123, 205, 118, 147, 214,
+			206, 172, 220, 225, 53, 227, 104, 72, 68, 144, 245, 77, 176, 38,
+			39, 115, 43, 237, 210, 30, 188, 12, 222, 53, 223, 37, 228, 60,
+			250, 50, 106, 176, 85, 160, 57, 19, 236, 193, 194, 123, 191, 237,
+			13, 4, 42, 157, 165, 200, 70, 232, 189, 235, 204, 110, 226, 153,
+			15, 59, 236, 236, 246, 62, 245, 175, 12, 185, 25, 237, 148, 228,
+			144, 247, 126, 97, 233, 109, 33, 137, 223, 52, 205, 115, 6, 25,
+			40, 145, 215, 52, 16, 107, 225, 18, 65, 77, 221, 140, 236, 233,
+			29, 79, 120, 236, 123, 11, 126, 15, 225, 136, 128, 230, 124, 174,
+			82, 142, 22, 195, 146, 127, 167, 28, 196, 219, 144, 237, 70, 38,
+			13, 196, 113, 55, 50, 66, 59, 165, 77, 28, 120, 46, 227, 104,
+			167, 180, 107, 80, 5, 216, 139, 77, 218, 89, 148, 7, 189, 155,
+			254, 249, 176, 1, 7, 78, 21, 206, 133, 142, 253, 129, 99, 142,
+			222, 129, 236, 200, 216, 30, 145, 169, 138, 237, 133, 57, 83, 76,
+			131, 155, 210, 208, 6, 19, 179, 73, 104, 103, 113, 211, 94, 78,
+			57, 218, 89, 204, 29, 80, 215, 145, 152, 172, 118, 110, 203, 167,
+			188, 179, 254, 117, 52, 253, 247, 175, 87, 43, 43, 198, 60, 24,
+			52, 33, 254, 90, 175, 90, 254, 221, 32, 50, 182, 168, 241, 161,
+			109, 170, 207, 102, 160, 198, 65, 78, 9, 237, 220, 30, 186, 192,
+			41, 71, 59, 183, 47, 63, 105, 34, 251, 166, 100, 151, 118, 234,
+			114, 28, 34, 251, 66, 123, 171, 16, 149, 18, 123, 253, 221, 32,
+			22, 20, 208, 222, 101, 184, 140, 47, 224, 96, 148, 118, 11, 177,
+			183, 234, 225, 88, 188, 213, 192, 50, 102, 137, 218, 90, 148, 213,
+			195, 82, 179, 8, 91, 8, 76, 192, 48, 50, 214, 235, 102, 120,
+			45, 15, 233, 184, 165, 154, 200, 130, 141, 82, 254, 212, 121, 191,
+			17, 86, 42, 81, 2, 103, 9, 246, 35, 99, 143, 172, 58, 124,
+			15, 163, 90, 245, 75, 52, 202, 100, 253, 131, 195, 76, 160, 90,
+			145, 95, 165, 203, 109, 18, 88, 107, 105, 169, 9, 147, 45, 52,
+			104, 81, 166, 223, 49, 206, 107, 74, 118, 101, 128, 111, 188, 215,
+			116, 9, 237, 212, 119, 31, 225, 148, 163, 157, 122, 254, 168, 250,
+			110, 28, 92, 165, 221, 59, 242, 238, 184, 119, 163, 29, 186, 21,
+			22, 0, 92, 239, 167, 81, 97, 109, 225, 231, 194, 18, 161, 204,
+			196, 182, 10, 73, 215, 35, 198, 76, 177, 148, 168, 140, 118, 238,
+			72, 62, 152, 148, 208, 206, 157, 29, 35, 156, 114, 180, 115, 103,
+			52, 207, 169, 172, 118, 238, 218, 67, 75, 153, 141, 232, 110, 58,
+			175, 242, 72, 104, 183, 118, 95, 144, 47, 142, 123, 126, 60, 243,
+			172, 140, 152, 24, 123, 219, 112, 119, 70, 59, 47, 216, 73, 214,
+			45, 180, 243, 194, 208, 33, 154, 100, 221, 142, 118, 94, 56, 204,
+			12, 233, 206, 106, 231, 69, 219, 112, 183, 105, 248, 197, 116, 94,
+			253, 27, 51, 7, 55, 235, 204, 247, 10, 249, 14, 49, 238, 253,
+			148, 153, 134, 208, 65, 58, 27, 121, 198, 197, 91, 150, 65, 128,
+			40, 242, 222, 8, 6, 85, 75, 65, 185, 194, 136, 148, 62, 157,
+			89, 32, 82, 135, 254, 33, 243, 28, 112, 200, 31, 6, 113, 1,
+			42, 136, 84, 2, 13, 151, 16, 113, 237, 188, 110, 223, 96, 253,
+			114, 35, 10, 43, 243, 35, 220, 170, 169, 148, 213, 57, 115, 97,
+			12, 131, 53, 10, 194, 6, 122, 55, 148, 35, 198, 49, 46, 71,
+			70, 145, 64, 118, 253, 230, 36, 172, 179, 77, 57, 248, 187, 69,
+			112, 80, 130, 132, 7, 147, 216, 146, 229, 151, 0, 79, 27, 228,
+			24, 51, 251, 142, 143, 39, 29, 131, 83, 114, 115, 90, 187, 223,
+			203, 238, 233, 41, 185, 89, 64, 178, 171, 143, 147, 14, 36, 251,
+			7, 56, 153, 213, 238, 59, 132, 123, 84, 245, 32, 247, 55, 35,
+			247, 221, 119, 136, 116, 94, 253, 115, 179, 163, 111, 209, 153, 119,
+			11, 249, 30, 49, 238, 189, 136, 220, 199, 45, 5, 119, 30, 128,
+			85, 226, 119, 84, 50, 97, 129, 153, 192, 219, 141, 63, 185, 234,
+			119, 5, 125, 2, 27, 20, 76, 145, 59, 9, 222, 11, 2, 63,
+			234, 56, 209, 77, 189, 182, 111, 91, 50, 218, 125, 183, 144, 131,
+			156, 20, 144, 28, 122, 136, 147, 14, 36, 31, 61, 197, 201, 172,
+			118, 223, 19, 247, 109, 139, 233, 219, 123, 160, 111, 239, 115, 176,
+			111, 91, 117, 230, 3, 66, 254, 144, 24, 247, 254, 94, 198, 157,
+			75, 204, 47, 234, 75, 135, 174, 126, 107, 187, 247, 143, 49, 107,
+			147, 251, 194, 119, 192, 180, 221, 154, 214, 238, 7, 226, 105, 187,
+			85, 64, 178, 107, 63, 39, 29, 72, 62, 48, 204, 201, 172, 118,
+			127, 40, 30, 218, 173, 102, 104, 127, 8, 134, 246, 4, 142, 236,
+			54, 157, 249, 17, 33, 127, 84, 140, 123, 7, 215, 216, 176, 218,
+			142, 70, 83, 239, 182, 140, 118, 127, 36, 158, 95, 219, 4, 36,
+			135, 70, 57, 233, 64, 242, 232, 49, 78, 102, 181, 251, 163, 49,
+			17, 219, 12, 17, 63, 10, 68, 0, 142, 72, 170, 11, 208, 227,
+			228, 214, 28, 196, 163, 92, 170, 221, 161, 205, 209, 117, 83, 128,
+			35, 242, 227, 162, 123, 139, 169, 167, 43, 37, 90, 147, 146, 146,
+			63, 111, 246, 191, 30, 157, 249, 105, 33, 127, 70, 140, 123, 63,
+			34, 55, 208, 23, 80, 250, 5, 254, 221, 0, 14, 131, 160, 225,
+			35, 56, 84, 137, 92, 99, 73, 208, 179, 146, 32, 232, 112, 208,
+			147, 104, 212, 47, 231, 195, 188, 197, 146, 2, 227, 53, 250, 5,
+			198, 176, 237, 209, 82, 193, 38, 106, 31, 243, 243, 254, 240, 89,
+			136, 211, 90, 109, 212, 131, 168, 49, 106, 200, 96, 113, 168, 77,
+			110, 67, 67, 242, 107, 215, 111, 82, 221, 138, 236, 237, 91, 169,
+			2, 215, 242, 184, 36, 25, 32, 147, 94, 28, 22, 222, 136, 98,
+			73, 8, 118, 74, 59, 116, 61, 25, 237, 254, 116, 60, 116, 61,
+			2, 146, 118, 107, 232, 113, 32, 105, 183, 134, 158, 172, 118, 127,
+			38, 30, 186, 30, 51, 116, 63, 3, 67, 247, 23, 102, 219, 235,
+			213, 238, 207, 11, 185, 221, 251, 239, 130, 214, 38, 232, 71, 162,
+			230, 18, 11, 46, 77, 58, 16, 160, 7, 96, 152, 30, 214, 91,
+			81, 37, 205, 89, 80, 155, 55, 187, 0, 91, 213, 27, 145, 156,
+			156, 230, 90, 29, 3, 216, 236, 15, 92, 1, 252, 176, 138, 107,
+			45, 182, 193, 7, 199, 70, 216, 58, 253, 230, 242, 88, 163, 54,
+			134, 31, 152, 38, 72, 97, 179, 92, 175, 1, 130, 71, 185, 186,
+			161, 24, 3, 39, 30, 180, 124, 235, 77, 99, 79, 121, 221, 245,
+			10, 72, 118, 209, 100, 148, 189, 14, 36, 123, 180, 186, 138, 241,
+			54, 50, 191, 44, 16, 150, 254, 140, 191, 218, 80, 132, 35, 35,
+			163, 228, 2, 226, 90, 27, 40, 57, 28, 177, 172, 70, 68, 123,
+			67, 128, 195, 248, 101, 145, 245, 108, 132, 13, 247, 37, 33, 135,
+			41, 16, 160, 173, 5, 13, 171, 219, 170, 106, 193, 173, 196, 8,
+			27, 240, 169, 77, 102, 180, 251, 146, 232, 222, 201, 73, 196, 124,
+			235, 223, 207, 73, 7, 146, 15, 28, 82, 103, 161, 85, 128, 208,
+			252, 136, 129, 161, 155, 104, 109, 55, 232, 12, 170, 222, 218, 13,
+			172, 18, 113, 51, 63, 2, 168, 114, 143, 171, 12, 84, 9, 93,
+			249, 85, 128, 205, 52, 98, 122, 66, 43, 17, 95, 4, 58, 118,
+			103, 155, 218, 100, 190, 79, 99, 5, 137, 12, 1, 25, 4, 77,
+			41, 8, 154, 242, 87, 69, 255, 128, 250, 11, 65, 109, 10, 64,
+			48, 115, 251, 189, 207, 198, 87, 47, 24, 13, 163, 28, 109, 67,
+			65, 142, 223, 61, 72, 104, 186, 69, 123, 71, 173, 234, 135, 119,
+			32, 250, 117, 2, 36, 180, 222, 172, 182, 184, 92, 251, 99, 16,
+			255, 1, 102, 105, 163, 222, 180, 232, 175, 212, 66, 108, 56, 227,
+			23, 235, 101, 144, 245, 97, 47, 66, 204, 214, 124, 226, 203, 249,
+			160, 18, 181, 127, 90, 10, 215, 253, 216, 118, 29, 64, 85, 62,
+			38, 220, 108, 156, 129, 93, 239, 210, 113, 6, 162, 185, 237, 216,
+			169, 254, 86, 18, 115, 164, 118, 255, 147, 112, 31, 246, 254, 60,
+			113, 204, 219, 109, 148, 218, 199, 150, 130, 18, 1, 217, 130, 236,
+			77, 10, 84, 184, 30, 20, 141, 250, 149, 194, 85, 32, 39, 19,
+			101, 45, 59, 231, 203, 117, 174, 25, 60, 120, 2, 229, 191, 16,
+			214, 107, 126, 39, 227, 38, 244, 208, 69, 197, 72, 59, 17, 85,
+			24, 2, 170, 121, 36, 38, 227, 22, 58, 225, 83, 188, 106, 112,
+			160, 88, 52, 184, 119, 22, 186, 18, 247, 251, 187, 128, 191, 16,
+			196, 168, 235, 197, 213, 253, 49, 21, 69, 0, 208, 102, 164, 232,
+			68, 219, 75, 181, 164, 102, 121, 141, 47, 1, 255, 14, 47, 78,
+			22, 94, 24, 58, 200, 120, 153, 33, 163, 144, 10, 2, 183, 252,
+			79, 194, 221, 29, 103, 8, 200, 216, 51, 17, 103, 56, 144, 113,
+			226, 33, 181, 76, 99, 133, 208, 110, 238, 163, 222, 236, 6, 134,
+			170, 92, 125, 117, 36, 150, 194, 78, 68, 58, 25, 108, 50, 38,
+			18, 128, 207, 126, 75, 236, 121, 48, 206, 64, 162, 30, 126, 68,
+			109, 198, 208, 60, 153, 223, 22, 169, 255, 77, 8, 220, 197, 64,
+			41, 250, 219, 34, 187, 83, 205, 82, 116, 158, 204, 239, 9, 249,
+			178, 24, 243, 110, 172, 10, 208, 195, 234, 246, 141, 197, 227, 89,
+			165, 212, 197, 13, 12, 3, 243, 184, 191, 199, 27, 182, 196, 237,
+			237, 247, 120, 195, 150, 160, 176, 117, 127, 79, 244, 244, 114, 50,
+			11, 128, 114, 238, 40, 30, 116, 144, 196, 131, 238, 101, 145, 134,
+			219, 151, 116, 29, 157, 249, 164, 72, 253, 55, 234, 10, 116, 251,
+			147, 34, 59, 160, 102, 40, 6, 78, 230, 83, 66, 126, 90, 140,
+			121, 215, 177, 43, 198, 92, 141, 0, 241, 1, 204, 159, 94, 0,
+			138, 32, 207, 22, 217, 234, 157, 159, 229, 214, 238, 25, 245, 4,
+			195, 219, 0, 44, 92, 150, 147, 8, 11, 215, 181, 141, 147, 14,
+			36, 245, 118, 78, 102, 181, 251, 105, 238, 137, 195, 61, 249, 52,
+			247, 196, 213, 153, 207, 138, 212, 31, 81, 79, 0, 141, 238, 179,
+			34, 187, 75, 121, 24, 179, 5, 113, 219, 164, 231, 109, 182, 250,
+			86, 150, 59, 49, 14, 12, 252, 104, 147, 25, 40, 219, 189, 141,
+			147, 136, 241, 214, 179, 131, 147, 14, 36, 7, 118, 33, 243, 210,
+			58, 243, 199, 136, 177, 130, 77, 2, 168, 221, 31, 67, 147, 115,
+			20, 183, 35, 243, 121, 33, 255, 84, 140, 121, 133, 4, 243, 146,
+			206, 99, 236, 43, 31, 7, 13, 153, 11, 227, 107, 244, 253, 249,
+			151, 70, 254, 125, 158, 249, 135, 1, 35, 220, 207, 51, 255, 48,
+			98, 132, 251, 121, 230, 95, 26, 249, 247, 167, 204, 191, 52, 243,
+			239, 79, 129, 127, 7, 41, 164, 68, 230, 75, 66, 126, 89, 140,
+			121, 59, 98, 181, 52, 72, 136, 72, 146, 109, 84, 100, 180, 251,
+			37, 33, 51, 156, 20, 144, 220, 212, 205, 73, 7, 146, 91, 153,
+			6, 145, 5, 67, 127, 219, 168, 48, 131, 246, 101, 30, 180, 140,
+			206, 252, 57, 162, 163, 89, 20, 190, 63, 7, 14, 254, 168, 32,
+			4, 189, 204, 95, 10, 249, 21, 49, 230, 125, 159, 104, 161, 168,
+			137, 167, 21, 235, 137, 33, 247, 80, 228, 207, 130, 245, 229, 44,
+			93, 189, 202, 145, 85, 70, 211, 233, 107, 36, 181, 57, 240, 44,
+			74, 212, 240, 234, 215, 95, 6, 66, 5, 185, 127, 201, 12, 48,
+			120, 124, 127, 201, 12, 48, 120, 124, 127, 201, 12, 200, 32, 215,
+			191, 194, 12, 200, 48, 215, 191, 2, 12, 24, 99, 188, 190, 191,
+			18, 114, 148, 80, 191, 24, 63, 155, 125, 48, 19, 125, 37, 116,
+			185, 140, 118, 255, 138, 165, 92, 131, 61, 247, 87, 98, 232, 16,
+			39, 29, 72, 30, 62, 162, 254, 157, 225, 160, 4, 212, 47, 169,
+			189, 95, 52, 252, 43, 54, 235, 176, 130, 125, 48, 160, 76, 238,
+			73, 132, 21, 95, 53, 63, 148, 163, 216, 31, 23, 164, 85, 235,
+			253, 201, 65, 7, 214, 174, 104, 212, 250, 162, 243, 124, 158, 171,
+			212, 240, 89, 17, 42, 10, 170, 254, 228, 217, 235, 133, 155, 23,
+			206, 147, 67, 10, 23, 98, 228, 6, 219, 75, 3, 102, 70, 19,
+			59, 131, 103, 198, 223, 240, 22, 151, 33, 48, 179, 30, 8, 5,
+			130, 0, 123, 127, 43, 82, 223, 43, 133, 5, 137, 251, 91, 216,
+			193, 222, 76, 32, 113, 153, 175, 11, 249, 15, 98, 204, 251, 46,
+			86, 164, 145, 144, 218, 1, 216, 144, 59, 2, 90, 90, 11, 226,
+			110, 108, 73, 148, 159, 99, 0, 71, 131, 118, 104, 99, 26, 48,
+			76, 163, 65, 149, 251, 58, 47, 70, 131, 42, 247, 117, 94, 140,
+			6, 85, 238, 235, 188, 24, 55, 225, 180, 248, 7, 158, 22, 155,
+			120, 90, 252, 3, 76, 139, 127, 35, 8, 119, 46, 243, 54, 41,
+			223, 46, 199, 188, 159, 18, 254, 84, 105, 29, 186, 227, 248, 83,
+			237, 161, 114, 160, 39, 173, 161, 25, 200, 237, 13, 252, 128, 81,
+			1, 21, 207, 246, 23, 171, 205, 165, 208, 68, 229, 125, 235, 40,
+			200, 51, 42, 17, 184, 161, 237, 163, 68, 81, 219, 125, 144, 202,
+			222, 38, 109, 247, 97, 98, 190, 77, 118, 117, 115, 210, 129, 36,
+			173, 138, 77, 240, 182, 231, 190, 93, 218, 238, 211, 182, 240, 118,
+			73, 219, 66, 86, 103, 190, 79, 166, 222, 67, 99, 154, 21, 218,
+			253, 62, 153, 221, 133, 99, 154, 5, 217, 250, 221, 82, 14, 121,
+			111, 178, 139, 63, 242, 139, 181, 186, 65, 80, 41, 145, 198, 55,
+			225, 115, 27, 150, 98, 62, 188, 186, 165, 158, 197, 179, 225, 221,
+			146, 206, 134, 44, 14, 241, 187, 37, 57, 53, 101, 113, 136, 223,
+			45, 201, 169, 41, 139, 67, 252, 110, 233, 129, 202, 83, 186, 93,
+			58, 243, 62, 153, 250, 73, 234, 66, 151, 208, 238, 251, 100, 214,
+			83, 207, 43, 215, 237, 130, 105, 249, 253, 82, 254, 128, 28, 243,
+			174, 198, 15, 169, 68, 12, 80, 79, 15, 254, 137, 227, 129, 158,
+			102, 214, 237, 5, 81, 221, 133, 100, 126, 63, 15, 69, 23, 146,
+			249, 253, 146, 102, 98, 23, 146, 249, 253, 146, 102, 98, 23, 206,
+			196, 31, 224, 161, 232, 226, 153, 248, 3, 48, 20, 139, 72, 172,
+			208, 153, 15, 74, 249, 195, 114, 204, 123, 182, 195, 107, 37, 99,
+			168, 151, 195, 187, 6, 235, 9, 72, 142, 48, 40, 210, 168, 15,
+			32, 86, 168, 120, 104, 83, 32, 176, 126, 108, 30, 223, 184, 45,
+			221, 48, 133, 62, 24, 211, 13, 83, 232, 131, 178, 107, 128, 147,
+			14, 36, 7, 135, 56, 153, 213, 238, 15, 199, 116, 211, 20, 250,
+			97, 160, 251, 199, 96, 5, 117, 73, 80, 163, 72, 217, 235, 189,
+			219, 108, 126, 132, 53, 90, 155, 71, 109, 169, 79, 190, 143, 241,
+			171, 216, 122, 124, 31, 133, 160, 85, 205, 42, 222, 1, 230, 130,
+			226, 109, 243, 194, 81, 173, 221, 205, 251, 231, 141, 31, 59, 126,
+			127, 12, 170, 206, 183, 60, 94, 18, 204, 65, 228, 63, 108, 187,
+			9, 155, 219, 143, 75, 185, 137, 147, 2, 146, 217, 205, 156, 132,
+			152, 3, 114, 91, 143, 186, 139, 157, 112, 180, 251, 19, 82, 14,
+			120, 229, 150, 90, 227, 190, 116, 82, 233, 176, 47, 96, 228, 27,
+			27, 100, 144, 198, 90, 200, 28, 95, 131, 198, 99, 227, 227, 150,
+			74, 39, 141, 45, 51, 149, 32, 26, 254, 132, 204, 242, 172, 113,
+			144, 174, 157, 16, 208, 95, 186, 74, 103, 126, 90, 166, 254, 144,
+			230, 186, 2, 197, 139, 204, 14, 170, 95, 132, 97, 80, 176, 94,
+			63, 36, 229, 110, 239, 95, 180, 15, 67, 27, 251, 153, 229, 32,
+			219, 243, 64, 216, 55, 73, 128, 133, 5, 253, 162, 45, 94, 174,
+			250, 104, 26, 76, 56, 5, 65, 165, 18, 214, 81, 69, 18, 27,
+			136, 64, 87, 241, 60, 133, 134, 84, 34, 76, 24, 190, 220, 218,
+			38, 168, 199, 10, 151, 205, 135, 184, 199, 10, 151, 205, 135, 100,
+			118, 128, 147, 14, 252, 58, 56, 164, 190, 102, 186, 37, 180, 251,
+			97, 41, 119, 120, 175, 180, 94, 183, 193, 18, 6, 47, 82, 16,
+			177, 105, 57, 44, 249, 6, 241, 200, 130, 180, 215, 208, 74, 47,
+			209, 17, 184, 175, 41, 11, 19, 209, 10, 23, 0, 152, 183, 97,
+			189, 92, 3, 64, 128, 249, 24, 54, 149, 204, 247, 227, 58, 208,
+			240, 44, 240, 155, 213, 50, 154, 99, 153, 81, 183, 192, 88, 92,
+			138, 234, 54, 53, 82, 64, 63, 152, 157, 228, 234, 204, 112, 174,
+			137, 157, 148, 99, 40, 88, 22, 193, 10, 253, 176, 36, 209, 71,
+			161, 244, 241, 97, 185, 169, 135, 147, 14, 252, 186, 189, 79, 189,
+			100, 88, 36, 181, 251, 146, 148, 251, 188, 159, 54, 35, 79, 136,
+			8, 181, 121, 251, 248, 70, 78, 0, 241, 237, 153, 73, 229, 89,
+			61, 183, 146, 20, 45, 230, 86, 8, 195, 214, 159, 58, 159, 55,
+			243, 129, 107, 160, 215, 32, 14, 118, 70, 234, 57, 70, 51, 182,
+			21, 3, 196, 70, 173, 74, 177, 207, 200, 151, 214, 118, 14, 174,
+			170, 47, 73, 217, 199, 73, 80, 27, 201, 29, 67, 156, 4, 181,
+			145, 220, 235, 131, 53, 164, 171, 64, 4, 249, 136, 148, 131, 222,
+			197, 246, 189, 133, 220, 22, 208, 177, 58, 242, 131, 165, 90, 117,
+			161, 133, 132, 78, 125, 179, 36, 192, 162, 251, 72, 60, 5, 97,
+			209, 125, 68, 102, 119, 114, 18, 27, 221, 229, 225, 211, 161, 130,
+			0, 10, 31, 149, 242, 63, 74, 199, 187, 230, 79, 197, 113, 25,
+			8, 135, 126, 85, 140, 31, 195, 34, 110, 61, 170, 197, 215, 210,
+			40, 88, 10, 99, 243, 0, 38, 6, 99, 37, 124, 84, 170, 93,
+			234, 13, 42, 3, 73, 88, 201, 255, 94, 186, 125, 222, 164, 127,
+			51, 137, 29, 68, 18, 228, 171, 58, 94, 225, 94, 141, 85, 166,
+			177, 206, 68, 134, 128, 140, 238, 109, 113, 134, 3, 25, 122, 187,
+			58, 67, 84, 8, 237, 254, 154, 116, 251, 189, 163, 109, 156, 239,
+			196, 224, 185, 90, 99, 209, 94, 247, 184, 70, 152, 196, 191, 38,
+			221, 46, 219, 4, 116, 244, 215, 164, 210, 113, 134, 3, 25, 59,
+			118, 154, 96, 123, 10, 144, 118, 127, 83, 202, 99, 222, 167, 197,
+			234, 158, 71, 247, 97, 172, 229, 43, 64, 217, 24, 252, 172, 16,
+			23, 23, 33, 97, 80, 209, 251, 247, 35, 15, 207, 8, 243, 112,
+			209, 102, 108, 22, 251, 150, 78, 117, 216, 47, 65, 243, 100, 16,
+			251, 88, 113, 2, 225, 8, 225, 190, 137, 122, 154, 248, 33, 62,
+			49, 251, 93, 211, 73, 155, 204, 64, 178, 219, 227, 164, 128, 228,
+			224, 40, 39, 29, 72, 30, 29, 199, 199, 22, 229, 72, 157, 249,
+			45, 41, 63, 45, 29, 239, 160, 63, 217, 178, 175, 175, 55, 219,
+			29, 16, 235, 127, 75, 170, 126, 245, 32, 14, 173, 132, 9, 246,
+			113, 24, 218, 3, 107, 216, 116, 81, 63, 161, 1, 59, 158, 70,
+			31, 242, 241, 120, 14, 25, 141, 200, 199, 101, 55, 143, 167, 209,
+			137, 124, 92, 238, 216, 169, 246, 83, 67, 66, 187, 191, 35, 221,
+			1, 111, 59, 54, 148, 12, 147, 151, 168, 23, 46, 186, 191, 35,
+			221, 238, 56, 3, 63, 219, 188, 61, 206, 112, 32, 99, 103, 191,
+			58, 72, 245, 74, 237, 190, 44, 221, 131, 116, 129, 102, 30, 144,
+			39, 119, 92, 51, 236, 52, 47, 75, 183, 55, 206, 128, 40, 0,
+			82, 251, 113, 134, 3, 25, 251, 15, 168, 163, 84, 179, 163, 221,
+			79, 74, 119, 7, 93, 19, 241, 232, 227, 89, 134, 228, 155, 211,
+			45, 65, 61, 108, 37, 159, 76, 114, 5, 54, 147, 79, 202, 238,
+			158, 56, 3, 235, 220, 222, 103, 217, 239, 106, 247, 83, 210, 61,
+			70, 236, 183, 238, 51, 102, 18, 81, 99, 212, 167, 68, 67, 110,
+			6, 63, 219, 29, 103, 128, 26, 71, 238, 25, 141, 51, 64, 145,
+			35, 143, 142, 67, 192, 4, 87, 129, 248, 243, 89, 41, 199, 188,
+			60, 33, 83, 68, 171, 230, 239, 58, 147, 70, 166, 93, 252, 220,
+			38, 51, 144, 236, 230, 83, 26, 148, 48, 159, 149, 187, 134, 57,
+			233, 64, 242, 200, 168, 197, 25, 248, 163, 221, 106, 79, 187, 119,
+			127, 137, 163, 244, 174, 1, 122, 125, 82, 101, 207, 83, 17, 61,
+			160, 54, 69, 97, 177, 86, 45, 69, 228, 60, 199, 73, 112, 92,
+			170, 6, 213, 26, 184, 9, 138, 225, 116, 193, 36, 206, 190, 99,
+			13, 52, 236, 45, 92, 35, 131, 97, 31, 219, 32, 24, 54, 19,
+			251, 170, 176, 176, 255, 120, 80, 101, 180, 187, 53, 53, 247, 58,
+			20, 246, 235, 80, 216, 255, 232, 80, 216, 22, 140, 90, 167, 246,
+			82, 230, 246, 216, 147, 5, 254, 100, 40, 108, 11, 154, 221, 103,
+			65, 179, 119, 164, 242, 12, 154, 13, 127, 50, 20, 182, 5, 205,
+			222, 105, 65, 179, 251, 99, 208, 236, 126, 11, 154, 61, 16, 131,
+			102, 195, 159, 63, 213, 131, 118, 223, 238, 173, 212, 156, 240, 62,
+			208, 227, 79, 250, 188, 238, 90, 225, 169, 141, 75, 195, 40, 68,
+			139, 10, 75, 99, 100, 104, 26, 45, 131, 189, 250, 188, 217, 254,
+			18, 224, 64, 20, 172, 0, 47, 132, 240, 59, 237, 0, 40, 80,
+			207, 131, 69, 42, 242, 42, 249, 67, 195, 199, 221, 0, 147, 120,
+			17, 33, 252, 181, 60, 133, 159, 41, 87, 13, 30, 24, 220, 0,
+			8, 55, 166, 8, 209, 104, 74, 1, 224, 59, 150, 224, 113, 29,
+			214, 7, 24, 94, 220, 14, 253, 92, 41, 88, 201, 225, 27, 60,
+			40, 165, 26, 139, 57, 174, 38, 17, 140, 221, 186, 65, 218, 80,
+			207, 48, 240, 38, 100, 42, 162, 193, 205, 133, 141, 187, 97, 8,
+			33, 214, 238, 38, 75, 51, 106, 25, 48, 197, 178, 10, 72, 40,
+			55, 88, 186, 14, 74, 160, 24, 172, 213, 193, 200, 3, 52, 104,
+			200, 17, 148, 180, 131, 184, 162, 188, 95, 0, 179, 2, 194, 114,
+			174, 215, 238, 149, 151, 130, 70, 88, 89, 241, 143, 140, 29, 27,
+			31, 29, 31, 31, 247, 87, 194, 160, 78, 192, 171, 23, 140, 241,
+			50, 160, 34, 209, 159, 254, 177, 147, 254, 57, 35, 197, 199, 100,
+			96, 27, 45, 228, 226, 157, 102, 57, 10, 155, 37, 176, 83, 46,
+			193, 33, 129, 235, 222, 22, 48, 177, 114, 253, 199, 252, 124, 62,
+			127, 170, 253, 183, 176, 90, 106, 249, 197, 54, 196, 123, 44, 255,
+			106, 62, 228, 220, 60, 15, 235, 99, 126, 88, 45, 217, 212, 152,
+			105, 139, 211, 167, 218, 62, 194, 9, 64, 159, 152, 191, 249, 3,
+			76, 113, 35, 229, 121, 127, 120, 85, 67, 167, 253, 113, 255, 129,
+			7, 218, 235, 122, 220, 31, 31, 137, 241, 144, 86, 125, 116, 36,
+			137, 202, 213, 246, 233, 216, 99, 254, 177, 113, 254, 31, 21, 122,
+			171, 31, 210, 147, 236, 106, 2, 30, 239, 72, 192, 233, 245, 9,
+			24, 91, 135, 128, 35, 157, 8, 72, 12, 255, 68, 60, 252, 241,
+			120, 225, 248, 199, 201, 35, 241, 204, 120, 245, 179, 96, 205, 177,
+			94, 123, 142, 152, 15, 147, 67, 254, 88, 235, 144, 251, 71, 226,
+			110, 82, 22, 213, 23, 15, 58, 127, 66, 108, 136, 63, 88, 53,
+			11, 226, 111, 90, 249, 220, 50, 231, 146, 44, 142, 63, 56, 178,
+			254, 240, 198, 5, 31, 79, 22, 92, 163, 141, 35, 157, 219, 24,
+			187, 207, 8, 30, 95, 107, 1, 195, 235, 0, 236, 168, 121, 248,
+			79, 41, 172, 52, 2, 88, 196, 55, 86, 26, 139, 40, 34, 67,
+			61, 126, 3, 22, 230, 234, 130, 195, 160, 184, 123, 236, 248, 168,
+			191, 84, 174, 54, 27, 97, 244, 216, 177, 241, 145, 214, 101, 230,
+			63, 102, 91, 27, 110, 251, 41, 127, 177, 94, 91, 186, 105, 171,
+			106, 148, 70, 98, 252, 192, 171, 6, 23, 32, 70, 135, 3, 141,
+			196, 82, 64, 234, 22, 75, 63, 66, 117, 173, 194, 14, 52, 128,
+			119, 245, 128, 84, 64, 96, 41, 88, 85, 132, 123, 62, 154, 120,
+			230, 35, 76, 53, 132, 125, 36, 85, 76, 212, 156, 135, 128, 70,
+			185, 40, 231, 15, 147, 53, 0, 212, 69, 172, 31, 129, 189, 31,
+			77, 10, 151, 225, 65, 190, 20, 11, 196, 241, 101, 145, 138, 26,
+			199, 183, 196, 41, 19, 177, 68, 128, 100, 42, 123, 46, 5, 21,
+			254, 164, 13, 153, 255, 56, 231, 155, 154, 198, 91, 234, 34, 163,
+			125, 8, 131, 192, 125, 47, 183, 48, 10, 88, 145, 59, 30, 229,
+			160, 191, 32, 207, 197, 181, 193, 217, 113, 44, 81, 25, 57, 0,
+			32, 82, 100, 76, 98, 167, 218, 242, 60, 187, 142, 65, 189, 80,
+			79, 91, 173, 202, 95, 42, 23, 235, 173, 245, 110, 180, 218, 99,
+			17, 60, 220, 88, 247, 174, 91, 217, 30, 245, 39, 214, 189, 235,
+			57, 217, 231, 125, 66, 248, 211, 40, 20, 216, 54, 73, 202, 76,
+			74, 5, 121, 255, 42, 64, 244, 129, 101, 56, 76, 237, 177, 227,
+			199, 78, 140, 158, 120, 248, 33, 56, 223, 224, 255, 104, 214, 123,
+			164, 45, 211, 68, 118, 7, 135, 56, 3, 46, 125, 18, 106, 141,
+			224, 101, 178, 89, 165, 8, 75, 45, 250, 171, 147, 202, 127, 104,
+			28, 136, 56, 186, 84, 174, 250, 135, 33, 177, 84, 174, 30, 93,
+			172, 251, 135, 253, 137, 7, 253, 197, 250, 209, 82, 176, 226, 31,
+			246, 143, 63, 116, 34, 63, 113, 2, 20, 208, 209, 81, 56, 91,
+			253, 195, 102, 129, 154, 131, 150, 173, 178, 193, 213, 235, 57, 185,
+			137, 83, 66, 59, 207, 101, 183, 113, 202, 209, 206, 115, 122, 187,
+			122, 135, 67, 190, 94, 78, 32, 181, 247, 63, 37, 51, 162, 69,
+			182, 9, 136, 47, 173, 194, 77, 66, 182, 97, 169, 28, 248, 165,
+			98, 134, 241, 98, 138, 124, 148, 182, 209, 246, 183, 86, 13, 109,
+			109, 245, 22, 81, 139, 61, 60, 199, 149, 63, 75, 227, 192, 47,
+			188, 48, 5, 2, 2, 199, 190, 3, 81, 44, 252, 106, 184, 16,
+			224, 223, 179, 72, 16, 21, 52, 243, 156, 119, 1, 227, 81, 146,
+			104, 16, 195, 150, 215, 193, 208, 213, 175, 214, 170, 99, 104, 173,
+			131, 66, 144, 149, 150, 91, 106, 51, 192, 164, 115, 177, 91, 41,
+			170, 120, 64, 124, 132, 217, 5, 25, 109, 116, 182, 79, 145, 71,
+			31, 125, 116, 148, 254, 111, 166, 71, 34, 35, 49, 53, 120, 188,
+			68, 90, 59, 129, 29, 47, 112, 151, 11, 178, 236, 154, 7, 62,
+			195, 65, 79, 175, 189, 219, 190, 119, 68, 61, 186, 49, 248, 131,
+			163, 160, 50, 152, 185, 19, 214, 75, 229, 98, 99, 77, 36, 189,
+			251, 92, 148, 239, 15, 147, 247, 224, 6, 169, 33, 144, 126, 130,
+			186, 251, 123, 169, 186, 1, 244, 229, 105, 67, 222, 218, 56, 37,
+			251, 212, 102, 82, 218, 204, 44, 6, 209, 34, 94, 188, 187, 10,
+			221, 148, 119, 57, 136, 22, 245, 126, 181, 165, 92, 189, 67, 248,
+			189, 22, 255, 174, 176, 57, 206, 156, 42, 233, 83, 42, 99, 188,
+			141, 16, 115, 100, 235, 68, 7, 24, 139, 4, 61, 96, 234, 216,
+			140, 10, 244, 201, 183, 0, 153, 68, 79, 169, 237, 6, 99, 127,
+			38, 184, 179, 48, 195, 76, 30, 200, 16, 246, 74, 123, 61, 188,
+			130, 10, 189, 230, 171, 201, 59, 11, 156, 165, 31, 87, 221, 198,
+			178, 22, 222, 61, 162, 129, 77, 8, 95, 51, 180, 186, 63, 231,
+			108, 161, 66, 242, 131, 195, 111, 86, 61, 208, 213, 2, 42, 147,
+			76, 79, 117, 78, 237, 185, 121, 97, 250, 230, 76, 225, 194, 244,
+			173, 43, 55, 103, 166, 111, 78, 222, 188, 53, 61, 115, 235, 218,
+			244, 141, 11, 231, 166, 46, 78, 93, 56, 223, 147, 210, 89, 229,
+			222, 152, 156, 158, 238, 17, 240, 215, 197, 201, 169, 43, 61, 82,
+			119, 169, 244, 185, 194, 228, 244, 229, 30, 7, 254, 196, 135, 255,
+			30, 23, 126, 159, 126, 106, 234, 70, 79, 250, 240, 63, 23, 170,
+			119, 21, 95, 245, 126, 181, 23, 91, 123, 250, 66, 225, 252, 212,
+			185, 53, 154, 219, 170, 212, 173, 107, 23, 158, 189, 113, 225, 220,
+			205, 11, 231, 123, 148, 30, 80, 125, 113, 250, 202, 115, 51, 208,
+			194, 141, 11, 231, 123, 250, 160, 229, 139, 87, 38, 159, 122, 174,
+			103, 143, 222, 170, 212, 133, 103, 175, 95, 187, 80, 152, 132, 143,
+			134, 245, 102, 149, 181, 85, 76, 28, 158, 86, 106, 250, 118, 121,
+			153, 80, 104, 6, 85, 63, 212, 49, 83, 184, 48, 57, 125, 253,
+			90, 91, 235, 195, 234, 192, 228, 173, 155, 215, 175, 78, 222, 156,
+			58, 55, 121, 229, 202, 115, 51, 231, 167, 166, 39, 207, 94, 185,
+			112, 126, 230, 226, 245, 194, 12, 52, 55, 117, 237, 2, 48, 227,
+			181, 225, 145, 252, 218, 62, 163, 172, 121, 225, 117, 56, 146, 215,
+			225, 72, 254, 177, 224, 72, 134, 99, 56, 146, 145, 24, 142, 228,
+			113, 210, 162, 236, 140, 213, 53, 240, 231, 15, 8, 12, 178, 235,
+			14, 165, 78, 9, 239, 237, 2, 156, 117, 27, 77, 146, 2, 90,
+			148, 207, 164, 135, 8, 252, 165, 50, 218, 247, 212, 230, 125, 152,
+			239, 121, 163, 156, 46, 205, 241, 86, 106, 42, 48, 177, 110, 160,
+			183, 102, 86, 227, 3, 124, 173, 114, 39, 52, 126, 194, 177, 78,
+			4, 32, 14, 64, 187, 129, 102, 246, 240, 98, 161, 148, 147, 78,
+			9, 237, 12, 165, 119, 168, 239, 161, 32, 188, 142, 47, 15, 122,
+			117, 166, 237, 110, 96, 2, 14, 17, 31, 225, 157, 3, 164, 45,
+			242, 227, 107, 146, 112, 24, 20, 27, 224, 97, 152, 232, 67, 116,
+			202, 199, 86, 34, 56, 206, 109, 24, 1, 122, 33, 183, 225, 54,
+			170, 0, 134, 203, 142, 73, 155, 57, 142, 175, 227, 27, 135, 95,
+			72, 73, 237, 248, 251, 15, 168, 97, 138, 226, 235, 236, 151, 221,
+			222, 96, 252, 216, 96, 220, 205, 3, 14, 174, 98, 107, 129, 3,
+			126, 191, 113, 180, 133, 148, 212, 206, 254, 46, 165, 254, 127, 130,
+			226, 248, 58, 195, 178, 219, 251, 160, 232, 80, 15, 168, 229, 177,
+			147, 211, 205, 5, 0, 131, 136, 98, 165, 15, 200, 235, 60, 45,
+			160, 109, 2, 51, 169, 67, 0, 34, 10, 55, 132, 153, 232, 47,
+			176, 92, 139, 162, 242, 92, 37, 84, 241, 247, 171, 62, 194, 25,
+			76, 3, 61, 95, 9, 110, 147, 180, 2, 20, 10, 237, 12, 91,
+			242, 145, 224, 46, 133, 175, 193, 105, 112, 122, 119, 198, 228, 102,
+			239, 103, 58, 145, 95, 172, 7, 232, 183, 91, 106, 154, 59, 18,
+			70, 204, 7, 73, 195, 88, 248, 213, 154, 141, 34, 188, 87, 18,
+			21, 44, 37, 157, 236, 216, 191, 37, 156, 76, 32, 215, 225, 31,
+			48, 11, 64, 94, 107, 233, 49, 247, 235, 8, 126, 156, 236, 156,
+			237, 11, 44, 142, 49, 35, 121, 65, 74, 106, 103, 76, 117, 171,
+			121, 138, 124, 236, 28, 147, 155, 189, 231, 58, 244, 4, 175, 247,
+			97, 201, 48, 22, 102, 97, 48, 87, 171, 55, 98, 92, 141, 249,
+			114, 181, 28, 45, 26, 47, 141, 73, 132, 146, 71, 93, 36, 156,
+			58, 39, 81, 211, 88, 107, 198, 52, 128, 223, 248, 49, 75, 3,
+			96, 139, 28, 83, 221, 234, 95, 75, 10, 169, 236, 156, 148, 221,
+			222, 15, 203, 54, 42, 74, 229, 18, 78, 125, 195, 196, 48, 111,
+			239, 228, 198, 116, 31, 10, 91, 254, 242, 190, 220, 106, 193, 107,
+			235, 138, 70, 17, 130, 221, 172, 5, 200, 133, 77, 123, 174, 92,
+			13, 234, 43, 163, 45, 157, 91, 174, 135, 75, 1, 128, 226, 144,
+			11, 88, 75, 3, 248, 34, 26, 215, 9, 31, 66, 61, 209, 237,
+			242, 242, 50, 206, 217, 177, 184, 7, 139, 65, 189, 10, 247, 130,
+			214, 216, 206, 118, 21, 183, 205, 74, 228, 58, 197, 45, 111, 169,
+			146, 56, 6, 158, 234, 39, 237, 124, 76, 75, 237, 156, 236, 82,
+			234, 187, 21, 184, 176, 187, 103, 82, 215, 132, 87, 88, 189, 153,
+			177, 88, 188, 225, 221, 140, 176, 240, 76, 69, 180, 59, 193, 66,
+			62, 147, 222, 169, 74, 202, 77, 11, 216, 157, 206, 202, 7, 188,
+			103, 218, 154, 48, 151, 9, 24, 171, 69, 19, 143, 169, 28, 129,
+			122, 8, 107, 177, 6, 136, 224, 227, 100, 108, 89, 57, 180, 53,
+			61, 250, 82, 37, 4, 126, 0, 173, 8, 104, 102, 31, 167, 164,
+			118, 206, 30, 56, 8, 230, 10, 105, 136, 102, 143, 160, 37, 151,
+			98, 78, 51, 13, 176, 252, 170, 53, 63, 188, 87, 171, 82, 8,
+			10, 114, 82, 2, 219, 17, 182, 153, 129, 91, 105, 179, 26, 222,
+			91, 14, 139, 214, 199, 23, 106, 197, 106, 55, 115, 74, 106, 231,
+			252, 182, 94, 176, 78, 72, 11, 88, 252, 23, 229, 144, 247, 134,
+			111, 73, 139, 149, 149, 182, 177, 21, 184, 215, 92, 148, 59, 57,
+			5, 205, 237, 26, 84, 69, 108, 219, 209, 206, 148, 220, 226, 61,
+			253, 42, 218, 134, 31, 224, 149, 221, 231, 38, 49, 55, 166, 192,
+			218, 15, 89, 2, 96, 131, 152, 162, 197, 41, 112, 131, 152, 82,
+			155, 193, 169, 40, 45, 96, 131, 120, 74, 106, 239, 68, 103, 2,
+			224, 250, 73, 247, 78, 243, 99, 146, 28, 91, 63, 44, 254, 167,
+			44, 115, 97, 241, 63, 181, 173, 23, 224, 153, 210, 2, 214, 254,
+			85, 217, 227, 93, 248, 38, 152, 203, 29, 179, 237, 193, 98, 185,
+			42, 109, 74, 106, 231, 234, 150, 109, 234, 35, 112, 246, 75, 237,
+			78, 167, 190, 91, 120, 63, 43, 252, 171, 65, 113, 177, 92, 13,
+			199, 234, 97, 80, 66, 199, 87, 194, 27, 161, 208, 122, 212, 29,
+			94, 253, 176, 71, 216, 129, 219, 200, 122, 138, 101, 240, 111, 78,
+			58, 128, 217, 49, 157, 222, 166, 254, 51, 156, 61, 96, 88, 224,
+			60, 43, 247, 120, 31, 21, 62, 52, 64, 251, 109, 71, 33, 1,
+			151, 157, 213, 64, 128, 128, 149, 60, 238, 201, 214, 35, 214, 53,
+			217, 208, 36, 160, 60, 128, 158, 210, 54, 73, 97, 161, 204, 106,
+			14, 163, 188, 15, 157, 47, 130, 110, 176, 18, 37, 68, 144, 121,
+			80, 58, 88, 59, 28, 244, 20, 164, 29, 0, 93, 122, 151, 151,
+			43, 43, 52, 62, 18, 151, 247, 179, 114, 23, 167, 164, 118, 158,
+			29, 218, 173, 222, 103, 58, 40, 180, 243, 188, 60, 226, 189, 213,
+			63, 95, 142, 96, 88, 74, 9, 127, 96, 240, 5, 70, 115, 57,
+			48, 144, 133, 8, 196, 53, 30, 40, 32, 120, 217, 186, 16, 145,
+			195, 40, 100, 70, 10, 79, 246, 21, 236, 13, 236, 70, 183, 184,
+			159, 231, 224, 50, 19, 94, 159, 246, 207, 189, 129, 12, 61, 120,
+			67, 178, 132, 194, 222, 247, 188, 124, 128, 83, 82, 59, 207, 143,
+			28, 86, 202, 188, 196, 205, 166, 94, 16, 86, 237, 54, 155, 221,
+			174, 170, 172, 117, 43, 202, 29, 94, 224, 223, 90, 223, 232, 195,
+			108, 139, 20, 125, 8, 204, 27, 201, 172, 12, 142, 168, 142, 155,
+			179, 185, 202, 230, 73, 131, 96, 209, 9, 64, 15, 86, 180, 64,
+			49, 192, 218, 162, 197, 242, 1, 200, 163, 226, 246, 62, 117, 136,
+			213, 96, 243, 210, 243, 60, 92, 106, 160, 103, 96, 130, 72, 207,
+			96, 171, 4, 85, 205, 188, 173, 18, 152, 48, 223, 181, 131, 83,
+			142, 118, 230, 7, 140, 107, 8, 56, 163, 57, 111, 150, 131, 222,
+			45, 172, 114, 234, 188, 237, 161, 13, 52, 25, 171, 39, 218, 14,
+			60, 94, 229, 115, 97, 165, 86, 93, 64, 155, 83, 56, 21, 148,
+			133, 0, 179, 212, 192, 22, 241, 102, 75, 13, 244, 226, 205, 93,
+			140, 117, 2, 162, 216, 155, 119, 121, 4, 236, 224, 104, 103, 73,
+			238, 245, 246, 89, 239, 199, 166, 213, 117, 38, 219, 180, 53, 59,
+			25, 248, 96, 59, 167, 132, 118, 150, 250, 60, 78, 65, 101, 187,
+			247, 168, 31, 54, 186, 84, 87, 59, 111, 145, 71, 189, 119, 226,
+			149, 161, 78, 110, 92, 84, 55, 172, 176, 230, 220, 82, 185, 225,
+			215, 155, 85, 242, 100, 167, 237, 9, 251, 12, 71, 14, 232, 73,
+			56, 134, 45, 149, 86, 80, 124, 4, 68, 208, 104, 85, 149, 115,
+			205, 114, 165, 52, 215, 44, 222, 14, 27, 230, 111, 127, 24, 151,
+			212, 221, 114, 20, 142, 88, 250, 1, 154, 231, 45, 22, 152, 2,
+			118, 217, 183, 12, 29, 230, 148, 163, 157, 183, 140, 229, 209, 76,
+			27, 161, 119, 154, 242, 56, 249, 113, 5, 119, 194, 58, 220, 195,
+			88, 63, 195, 141, 130, 222, 227, 194, 249, 150, 59, 132, 53, 45,
+			100, 147, 75, 248, 81, 173, 98, 100, 58, 163, 157, 166, 100, 214,
+			193, 246, 219, 28, 100, 48, 14, 64, 213, 105, 30, 155, 80, 119,
+			24, 84, 103, 69, 62, 64, 54, 192, 177, 190, 102, 56, 26, 73,
+			248, 252, 65, 35, 32, 120, 150, 241, 1, 153, 226, 106, 193, 227,
+			3, 112, 18, 161, 216, 142, 141, 143, 162, 151, 190, 74, 184, 42,
+			30, 27, 39, 157, 43, 197, 206, 132, 216, 71, 150, 194, 140, 11,
+			13, 219, 84, 70, 59, 43, 221, 60, 165, 1, 62, 103, 101, 231,
+			190, 4, 124, 206, 202, 129, 131, 86, 23, 249, 59, 179, 175, 78,
+			23, 185, 88, 142, 26, 181, 250, 202, 154, 186, 200, 251, 70, 245,
+			248, 166, 149, 149, 175, 41, 166, 199, 67, 27, 252, 200, 154, 203,
+			211, 119, 175, 93, 79, 155, 251, 130, 80, 253, 0, 134, 183, 2,
+			23, 233, 203, 134, 109, 4, 148, 155, 12, 4, 34, 108, 32, 16,
+			217, 18, 8, 132, 85, 169, 73, 220, 113, 86, 168, 62, 169, 186,
+			44, 161, 4, 223, 252, 192, 186, 186, 208, 27, 92, 154, 42, 138,
+			63, 7, 184, 255, 229, 96, 161, 5, 165, 60, 11, 25, 211, 229,
+			23, 66, 189, 91, 41, 248, 123, 166, 81, 187, 29, 86, 81, 97,
+			218, 85, 232, 130, 156, 155, 144, 145, 123, 171, 26, 88, 221, 67,
+			130, 49, 127, 84, 101, 137, 31, 140, 140, 191, 123, 93, 18, 11,
+			182, 56, 132, 24, 169, 134, 247, 26, 51, 137, 166, 145, 11, 133,
+			45, 144, 125, 195, 54, 255, 103, 66, 13, 181, 183, 15, 194, 125,
+			244, 255, 50, 54, 191, 228, 170, 221, 107, 244, 147, 152, 125, 85,
+			101, 22, 234, 181, 230, 50, 179, 186, 3, 212, 250, 186, 21, 228,
+			47, 193, 215, 5, 170, 100, 163, 3, 224, 253, 136, 163, 210, 248,
+			101, 7, 237, 186, 120, 181, 218, 245, 13, 188, 18, 140, 168, 158,
+			88, 208, 159, 65, 187, 30, 194, 220, 223, 22, 231, 159, 131, 108,
+			125, 90, 121, 113, 86, 101, 101, 134, 132, 91, 250, 200, 204, 243,
+			129, 100, 9, 144, 55, 151, 249, 235, 189, 170, 27, 197, 42, 42,
+			158, 198, 226, 10, 179, 76, 129, 17, 213, 195, 146, 187, 173, 52,
+			131, 149, 110, 139, 243, 77, 209, 131, 106, 43, 183, 66, 5, 55,
+			97, 193, 45, 156, 107, 138, 173, 241, 184, 144, 125, 245, 143, 11,
+			185, 255, 91, 168, 62, 28, 110, 186, 240, 126, 171, 214, 195, 160,
+			234, 130, 40, 94, 104, 211, 74, 15, 52, 217, 168, 57, 87, 128,
+			180, 190, 174, 122, 121, 248, 226, 69, 147, 193, 69, 211, 1, 146,
+			156, 40, 179, 11, 166, 208, 115, 167, 45, 231, 155, 90, 49, 127,
+			43, 212, 142, 54, 14, 208, 74, 185, 174, 178, 212, 18, 175, 149,
+			14, 65, 3, 58, 126, 154, 167, 12, 176, 224, 47, 216, 74, 54,
+			188, 86, 66, 213, 157, 168, 96, 213, 155, 152, 88, 253, 38, 246,
+			90, 224, 253, 115, 191, 46, 84, 175, 93, 234, 27, 30, 248, 113,
+			213, 75, 3, 63, 3, 136, 143, 104, 0, 209, 50, 5, 182, 153,
+			41, 48, 205, 63, 174, 63, 23, 190, 153, 161, 123, 70, 233, 36,
+			253, 52, 108, 187, 84, 150, 8, 52, 195, 214, 85, 192, 152, 8,
+			83, 165, 13, 15, 192, 196, 207, 56, 170, 59, 177, 255, 233, 89,
+			149, 198, 134, 244, 200, 253, 55, 75, 226, 163, 119, 120, 35, 69,
+			45, 126, 126, 77, 41, 252, 21, 183, 90, 157, 223, 240, 158, 108,
+			218, 58, 186, 225, 242, 182, 193, 57, 181, 165, 101, 234, 234, 7,
+			238, 59, 183, 77, 91, 135, 238, 91, 206, 182, 241, 28, 117, 10,
+			88, 25, 233, 253, 235, 16, 105, 107, 63, 176, 126, 33, 174, 250,
+			181, 189, 194, 189, 237, 41, 19, 21, 224, 93, 175, 71, 5, 120,
+			61, 42, 192, 183, 35, 42, 192, 240, 253, 162, 2, 60, 129, 127,
+			166, 193, 218, 249, 28, 189, 211, 237, 138, 223, 233, 224, 207, 19,
+			28, 32, 96, 28, 48, 174, 41, 64, 64, 50, 62, 0, 40, 237,
+			72, 207, 142, 43, 189, 28, 146, 190, 58, 3, 26, 144, 161, 236,
+			118, 245, 97, 65, 88, 255, 174, 47, 247, 249, 222, 191, 20, 9,
+			180, 255, 164, 54, 32, 34, 24, 36, 138, 1, 15, 191, 76, 157,
+			135, 235, 46, 103, 209, 17, 0, 156, 193, 108, 69, 249, 117, 184,
+			188, 90, 115, 32, 229, 79, 22, 225, 89, 153, 223, 23, 88, 169,
+			210, 234, 95, 204, 144, 55, 139, 161, 109, 222, 130, 127, 3, 225,
+			126, 166, 155, 83, 240, 238, 182, 121, 63, 167, 28, 237, 236, 115,
+			135, 212, 191, 227, 248, 1, 238, 3, 242, 208, 65, 239, 23, 147,
+			157, 2, 44, 98, 0, 51, 94, 177, 15, 31, 175, 178, 135, 202,
+			118, 177, 83, 15, 163, 111, 65, 23, 65, 149, 244, 64, 166, 135,
+			83, 16, 55, 160, 119, 140, 83, 142, 118, 14, 185, 251, 212, 91,
+			56, 110, 192, 17, 121, 197, 43, 37, 250, 71, 173, 109, 120, 188,
+			172, 62, 1, 20, 222, 69, 112, 81, 43, 89, 199, 81, 240, 29,
+			123, 212, 162, 246, 81, 216, 0, 161, 157, 35, 153, 237, 68, 14,
+			82, 208, 55, 204, 41, 71, 59, 71, 142, 95, 86, 47, 112, 72,
+			129, 163, 242, 156, 183, 228, 95, 44, 131, 161, 27, 209, 64, 218,
+			30, 66, 153, 78, 32, 86, 89, 129, 97, 45, 42, 201, 163, 109,
+			67, 84, 194, 18, 59, 106, 89, 8, 171, 236, 104, 111, 142, 83,
+			142, 118, 142, 142, 157, 81, 167, 141, 130, 242, 120, 234, 186, 240,
+			198, 253, 73, 27, 133, 149, 162, 203, 34, 3, 103, 19, 167, 164,
+			57, 108, 102, 13, 56, 187, 85, 105, 30, 207, 238, 85, 255, 158,
+			45, 9, 221, 135, 229, 35, 99, 222, 135, 97, 190, 153, 173, 50,
+			191, 26, 55, 157, 182, 228, 164, 254, 8, 224, 56, 0, 141, 15,
+			12, 215, 2, 202, 164, 151, 107, 214, 136, 65, 79, 73, 103, 130,
+			104, 251, 0, 82, 27, 81, 25, 226, 37, 188, 89, 52, 234, 65,
+			213, 24, 200, 85, 86, 112, 155, 174, 38, 117, 139, 28, 252, 150,
+			184, 106, 213, 62, 160, 28, 125, 216, 234, 14, 97, 133, 61, 220,
+			162, 28, 125, 216, 2, 157, 167, 178, 218, 121, 196, 130, 87, 167,
+			208, 7, 223, 121, 36, 125, 36, 134, 139, 63, 37, 79, 143, 121,
+			19, 109, 12, 224, 249, 215, 168, 249, 111, 1, 38, 38, 187, 131,
+			70, 143, 150, 20, 80, 170, 158, 178, 164, 192, 74, 56, 101, 73,
+			1, 251, 183, 83, 150, 20, 240, 254, 56, 109, 73, 49, 112, 0,
+			206, 233, 244, 17, 117, 139, 225, 226, 207, 200, 39, 198, 188, 75,
+			9, 82, 38, 91, 22, 60, 79, 32, 171, 39, 199, 215, 57, 142,
+			82, 78, 140, 162, 37, 107, 233, 3, 213, 220, 25, 201, 184, 182,
+			160, 102, 61, 51, 124, 140, 83, 142, 118, 206, 60, 120, 130, 83,
+			89, 237, 60, 225, 142, 182, 98, 194, 63, 145, 62, 194, 120, 131,
+			128, 125, 47, 119, 122, 127, 36, 214, 112, 244, 15, 171, 176, 101,
+			209, 54, 14, 202, 58, 134, 191, 105, 141, 138, 15, 191, 248, 243,
+			225, 93, 182, 69, 110, 88, 128, 79, 152, 85, 243, 240, 152, 193,
+			175, 29, 163, 96, 187, 137, 120, 95, 199, 198, 199, 121, 91, 138,
+			44, 18, 17, 171, 4, 243, 170, 133, 162, 36, 88, 192, 248, 41,
+			118, 24, 9, 230, 106, 119, 66, 204, 178, 223, 23, 107, 97, 29,
+			193, 156, 1, 112, 0, 129, 5, 72, 71, 156, 134, 158, 178, 73,
+			35, 172, 204, 75, 217, 94, 78, 1, 23, 250, 118, 168, 47, 88,
+			253, 241, 53, 57, 224, 125, 74, 248, 147, 62, 136, 242, 62, 202,
+			225, 163, 176, 51, 133, 229, 59, 236, 224, 141, 218, 225, 59, 229,
+			90, 51, 242, 1, 248, 52, 175, 236, 209, 135, 157, 79, 224, 109,
+			145, 209, 245, 92, 4, 75, 27, 182, 224, 96, 1, 14, 102, 255,
+			25, 120, 89, 229, 176, 49, 32, 166, 192, 219, 39, 170, 142, 1,
+			182, 41, 88, 2, 120, 232, 4, 40, 14, 225, 139, 192, 245, 0,
+			91, 196, 39, 104, 66, 215, 180, 112, 4, 192, 254, 70, 226, 155,
+			197, 48, 209, 5, 203, 12, 55, 173, 157, 107, 118, 126, 131, 50,
+			250, 90, 23, 171, 214, 93, 71, 59, 215, 118, 246, 171, 199, 140,
+			251, 109, 33, 245, 38, 225, 29, 243, 39, 19, 19, 116, 195, 59,
+			19, 44, 156, 66, 214, 87, 239, 23, 132, 180, 232, 60, 45, 79,
+			120, 223, 211, 2, 57, 212, 114, 248, 193, 160, 39, 211, 118, 84,
+			225, 68, 32, 153, 111, 182, 85, 53, 51, 235, 159, 191, 48, 125,
+			110, 212, 159, 165, 153, 132, 234, 150, 89, 127, 114, 250, 220, 168,
+			242, 103, 227, 109, 103, 166, 92, 194, 92, 226, 1, 162, 55, 58,
+			79, 147, 150, 25, 193, 27, 157, 167, 187, 15, 113, 74, 104, 231,
+			233, 225, 113, 78, 57, 218, 121, 250, 248, 131, 104, 16, 227, 226,
+			163, 246, 27, 229, 110, 99, 16, 195, 232, 160, 150, 80, 48, 209,
+			41, 175, 82, 129, 179, 134, 62, 177, 138, 252, 155, 53, 127, 33,
+			108, 196, 67, 138, 51, 173, 54, 79, 56, 219, 17, 184, 9, 217,
+			199, 38, 62, 20, 16, 150, 130, 172, 103, 140, 178, 159, 98, 12,
+			37, 135, 23, 93, 205, 157, 55, 210, 240, 154, 231, 242, 55, 82,
+			60, 2, 116, 50, 119, 222, 56, 56, 164, 38, 17, 81, 206, 157,
+			73, 189, 40, 188, 19, 27, 62, 119, 240, 142, 150, 28, 98, 224,
+			198, 76, 246, 128, 57, 124, 224, 197, 211, 45, 202, 210, 119, 220,
+			225, 35, 91, 94, 230, 100, 203, 203, 156, 180, 47, 115, 148, 202,
+			106, 167, 68, 59, 42, 35, 219, 57, 37, 58, 124, 224, 73, 212,
+			93, 144, 139, 175, 253, 240, 145, 56, 122, 11, 150, 20, 24, 189,
+			5, 75, 10, 140, 222, 130, 37, 5, 14, 159, 69, 75, 10, 29,
+			62, 139, 116, 248, 72, 56, 124, 110, 203, 202, 183, 248, 240, 65,
+			55, 114, 231, 54, 29, 62, 18, 58, 236, 220, 166, 195, 71, 226,
+			225, 115, 155, 14, 31, 137, 135, 79, 197, 210, 71, 135, 79, 133,
+			15, 31, 44, 28, 253, 127, 224, 240, 145, 24, 32, 36, 162, 195,
+			7, 93, 226, 157, 136, 14, 31, 137, 130, 96, 196, 135, 143, 4,
+			27, 142, 23, 190, 3, 14, 31, 133, 167, 207, 107, 56, 124, 36,
+			30, 62, 47, 216, 249, 13, 135, 207, 11, 116, 248, 72, 124, 9,
+			125, 97, 103, 191, 58, 135, 96, 15, 233, 127, 150, 250, 89, 33,
+			188, 135, 54, 126, 250, 172, 218, 159, 128, 215, 255, 44, 123, 80,
+			109, 81, 174, 235, 56, 41, 157, 254, 30, 249, 47, 133, 131, 173,
+			57, 0, 160, 235, 124, 143, 218, 166, 254, 165, 80, 25, 248, 21,
+			182, 175, 119, 8, 247, 152, 247, 30, 193, 207, 210, 45, 111, 189,
+			229, 200, 199, 55, 133, 85, 199, 84, 242, 233, 24, 143, 166, 248,
+			98, 0, 206, 50, 181, 102, 157, 129, 108, 225, 102, 65, 91, 54,
+			63, 29, 224, 135, 197, 96, 185, 209, 172, 243, 103, 113, 67, 136,
+			102, 128, 164, 101, 144, 182, 221, 113, 134, 128, 12, 194, 59, 112,
+			8, 155, 247, 29, 226, 232, 184, 58, 76, 189, 17, 218, 125, 151,
+			112, 135, 214, 53, 34, 224, 143, 1, 157, 228, 93, 12, 253, 235,
+			16, 186, 237, 187, 68, 119, 127, 156, 225, 64, 134, 55, 168, 46,
+			82, 245, 82, 187, 239, 21, 238, 94, 239, 161, 54, 68, 148, 248,
+			29, 162, 101, 175, 177, 238, 103, 237, 61, 3, 100, 134, 247, 10,
+			2, 70, 193, 12, 1, 25, 202, 139, 51, 28, 200, 216, 189, 71,
+			221, 160, 166, 29, 237, 190, 95, 184, 35, 222, 19, 107, 54, 29,
+			155, 110, 109, 140, 8, 192, 173, 120, 127, 146, 8, 192, 173, 120,
+			191, 80, 7, 226, 12, 108, 244, 208, 176, 154, 36, 34, 92, 237,
+			254, 160, 112, 61, 239, 88, 27, 17, 177, 9, 203, 125, 91, 117,
+			211, 88, 71, 220, 42, 72, 92, 63, 40, 212, 142, 56, 195, 129,
+			140, 129, 93, 150, 235, 0, 86, 214, 137, 235, 241, 67, 205, 198,
+			154, 78, 155, 138, 226, 166, 193, 56, 241, 131, 73, 174, 167, 1,
+			249, 76, 236, 222, 163, 206, 83, 211, 25, 64, 153, 119, 119, 123,
+			15, 174, 106, 250, 213, 12, 119, 38, 141, 213, 196, 13, 103, 4,
+			100, 168, 129, 56, 195, 129, 140, 193, 33, 117, 133, 26, 222, 164,
+			221, 127, 33, 220, 19, 222, 233, 53, 77, 34, 224, 217, 8, 151,
+			86, 66, 164, 88, 139, 128, 77, 25, 172, 110, 40, 206, 16, 144,
+			177, 123, 60, 206, 112, 32, 227, 248, 131, 234, 189, 130, 16, 105,
+			221, 159, 18, 114, 200, 123, 113, 77, 201, 213, 140, 108, 148, 95,
+			67, 124, 197, 95, 201, 184, 143, 68, 89, 213, 38, 203, 130, 223,
+			233, 218, 178, 108, 140, 93, 235, 34, 45, 54, 153, 129, 100, 119,
+			15, 39, 5, 36, 123, 251, 57, 233, 64, 210, 27, 84, 255, 218,
+			244, 3, 160, 197, 196, 63, 85, 209, 213, 144, 12, 251, 208, 135,
+			24, 206, 210, 1, 217, 213, 253, 144, 32, 48, 62, 7, 247, 160,
+			15, 137, 193, 33, 245, 184, 146, 174, 171, 51, 191, 32, 82, 255,
+			85, 172, 173, 55, 1, 34, 102, 91, 84, 255, 124, 52, 48, 88,
+			239, 47, 136, 236, 144, 186, 76, 96, 189, 153, 15, 11, 249, 75,
+			98, 204, 123, 164, 77, 116, 107, 199, 73, 140, 229, 55, 26, 167,
+			136, 5, 56, 66, 235, 5, 184, 50, 238, 131, 193, 242, 253, 176,
+			232, 234, 73, 96, 249, 126, 88, 108, 239, 227, 100, 86, 187, 191,
+			196, 144, 156, 46, 201, 147, 238, 47, 1, 36, 231, 57, 164, 75,
+			232, 204, 175, 8, 249, 146, 24, 243, 142, 111, 68, 164, 236, 76,
+			18, 176, 245, 87, 98, 146, 128, 173, 191, 18, 147, 4, 108, 253,
+			149, 152, 36, 128, 201, 124, 41, 38, 201, 200, 149, 238, 75, 64,
+			210, 31, 195, 68, 114, 65, 178, 252, 168, 144, 253, 222, 203, 194,
+			191, 190, 12, 231, 93, 80, 49, 82, 46, 113, 105, 44, 42, 214,
+			0, 133, 14, 31, 212, 214, 165, 207, 26, 28, 55, 44, 190, 16,
+			7, 211, 132, 156, 220, 105, 170, 241, 241, 147, 57, 16, 69, 231,
+			203, 247, 172, 240, 146, 16, 234, 192, 188, 40, 190, 82, 196, 77,
+			212, 154, 85, 123, 16, 163, 168, 2, 123, 4, 183, 130, 179, 94,
+			37, 100, 62, 234, 62, 156, 71, 31, 141, 121, 5, 18, 253, 71,
+			69, 151, 230, 164, 3, 201, 29, 6, 119, 219, 5, 64, 182, 143,
+			9, 57, 226, 21, 18, 140, 232, 72, 29, 17, 69, 32, 67, 81,
+			155, 112, 189, 14, 57, 128, 18, 254, 49, 33, 185, 125, 56, 151,
+			62, 38, 182, 31, 224, 36, 18, 112, 104, 88, 253, 15, 51, 50,
+			174, 118, 127, 67, 200, 157, 222, 231, 214, 146, 169, 45, 115, 190,
+			131, 133, 106, 211, 117, 56, 60, 127, 67, 16, 106, 157, 139, 202,
+			138, 223, 16, 217, 94, 78, 58, 240, 107, 223, 14, 3, 156, 232,
+			194, 160, 126, 92, 200, 1, 239, 149, 13, 11, 214, 237, 59, 199,
+			183, 77, 208, 110, 111, 248, 181, 232, 121, 12, 19, 224, 144, 255,
+			120, 60, 149, 225, 136, 255, 184, 232, 218, 206, 73, 7, 146, 59,
+			251, 213, 25, 131, 42, 254, 187, 34, 245, 199, 98, 29, 101, 207,
+			250, 219, 41, 84, 254, 187, 34, 187, 91, 157, 1, 88, 111, 8,
+			112, 241, 178, 144, 159, 18, 142, 119, 212, 63, 151, 8, 230, 200,
+			243, 131, 194, 77, 38, 144, 94, 208, 192, 118, 145, 104, 79, 131,
+			112, 238, 190, 12, 162, 16, 136, 179, 105, 144, 111, 181, 251, 137,
+			141, 136, 179, 105, 138, 100, 241, 9, 22, 103, 211, 36, 45, 127,
+			130, 197, 217, 52, 73, 203, 159, 16, 222, 160, 26, 167, 234, 133,
+			118, 255, 0, 100, 74, 19, 105, 44, 65, 221, 154, 141, 136, 12,
+			126, 50, 20, 103, 96, 29, 187, 15, 196, 25, 14, 100, 28, 26,
+			70, 8, 178, 52, 244, 224, 51, 66, 30, 240, 224, 26, 206, 82,
+			4, 85, 27, 229, 253, 235, 177, 142, 139, 50, 91, 24, 130, 71,
+			255, 103, 248, 232, 79, 131, 198, 202, 253, 12, 135, 27, 49, 40,
+			236, 159, 17, 253, 123, 57, 233, 64, 50, 183, 31, 67, 27, 3,
+			4, 186, 118, 63, 7, 71, 255, 191, 130, 233, 79, 51, 191, 5,
+			189, 18, 60, 223, 193, 156, 121, 54, 182, 60, 152, 93, 53, 189,
+			237, 105, 111, 180, 186, 244, 62, 76, 200, 231, 181, 165, 114, 163,
+			1, 187, 129, 145, 32, 240, 153, 164, 90, 107, 95, 18, 173, 24,
+			129, 181, 121, 197, 39, 183, 237, 39, 156, 85, 159, 227, 73, 155,
+			70, 17, 224, 115, 44, 2, 164, 81, 4, 248, 28, 136, 0, 167,
+			16, 45, 48, 243, 121, 145, 250, 7, 33, 188, 177, 251, 138, 0,
+			32, 153, 181, 76, 88, 144, 59, 63, 15, 184, 239, 23, 24, 246,
+			253, 11, 66, 126, 81, 140, 121, 39, 54, 124, 254, 131, 164, 221,
+			114, 210, 102, 64, 145, 228, 126, 129, 169, 55, 48, 237, 95, 224,
+			147, 214, 192, 180, 127, 129, 79, 90, 192, 101, 215, 238, 23, 87,
+			193, 180, 127, 17, 78, 218, 105, 130, 105, 207, 188, 34, 228, 159,
+			137, 49, 239, 92, 130, 40, 196, 32, 109, 196, 254, 118, 164, 253,
+			178, 59, 144, 121, 192, 90, 189, 255, 82, 171, 192, 224, 87, 98,
+			18, 129, 193, 175, 136, 46, 143, 147, 14, 36, 119, 239, 225, 100,
+			86, 187, 127, 22, 147, 72, 194, 192, 159, 1, 137, 159, 182, 104,
+			239, 95, 1, 97, 224, 183, 254, 41, 10, 3, 175, 77, 8, 200,
+			64, 212, 93, 247, 43, 49, 143, 96, 253, 124, 133, 133, 0, 3,
+			5, 255, 21, 177, 99, 167, 57, 117, 51, 144, 252, 218, 186, 167,
+			46, 137, 106, 255, 120, 167, 174, 109, 224, 31, 247, 212, 205, 128,
+			42, 203, 253, 26, 159, 186, 25, 208, 101, 185, 95, 227, 83, 55,
+			131, 226, 200, 215, 68, 223, 14, 245, 127, 26, 198, 184, 0, 63,
+			47, 7, 188, 207, 191, 202, 83, 151, 22, 235, 183, 249, 200, 165,
+			86, 95, 235, 121, 155, 145, 110, 18, 140, 63, 131, 34, 201, 215,
+			249, 188, 205, 160, 72, 242, 117, 177, 179, 31, 223, 124, 55, 233,
+			204, 55, 68, 234, 93, 82, 120, 249, 251, 159, 183, 171, 246, 46,
+			8, 56, 240, 13, 136, 97, 117, 138, 2, 14, 184, 111, 147, 114,
+			175, 55, 150, 56, 88, 204, 140, 40, 37, 14, 150, 160, 178, 188,
+			24, 204, 133, 228, 156, 67, 68, 111, 194, 115, 229, 109, 12, 193,
+			106, 2, 10, 188, 141, 209, 230, 77, 64, 129, 183, 73, 237, 37,
+			2, 10, 188, 77, 238, 222, 99, 206, 21, 136, 23, 224, 190, 83,
+			126, 39, 156, 43, 155, 64, 175, 238, 190, 179, 53, 84, 192, 59,
+			25, 231, 221, 132, 10, 120, 167, 28, 28, 178, 190, 4, 191, 48,
+			168, 78, 190, 26, 123, 249, 165, 176, 17, 192, 219, 205, 90, 206,
+			4, 185, 159, 21, 106, 51, 12, 228, 85, 42, 168, 181, 114, 33,
+			120, 8, 225, 139, 224, 223, 250, 164, 202, 86, 232, 173, 130, 236,
+			36, 247, 172, 182, 55, 131, 90, 174, 80, 169, 130, 45, 175, 207,
+			169, 45, 16, 137, 23, 128, 18, 106, 213, 144, 172, 137, 59, 86,
+			112, 182, 185, 112, 142, 75, 21, 54, 207, 37, 82, 185, 105, 181,
+			57, 89, 61, 16, 89, 15, 151, 107, 76, 36, 252, 13, 230, 146,
+			243, 229, 74, 56, 3, 20, 147, 121, 98, 22, 50, 174, 65, 15,
+			180, 114, 43, 229, 106, 136, 141, 167, 11, 248, 119, 238, 151, 133,
+			218, 156, 108, 83, 95, 83, 91, 202, 81, 212, 12, 103, 0, 202,
+			239, 118, 88, 199, 234, 187, 39, 58, 88, 238, 77, 65, 177, 155,
+			166, 148, 253, 254, 114, 170, 176, 185, 156, 248, 65, 79, 170, 44,
+			199, 154, 32, 182, 117, 176, 229, 187, 90, 171, 254, 63, 236, 125,
+			13, 112, 93, 199, 117, 30, 239, 221, 11, 224, 113, 9, 66, 228,
+			229, 255, 5, 41, 94, 62, 240, 7, 32, 129, 135, 31, 146, 178,
+			68, 139, 140, 64, 82, 18, 105, 209, 18, 5, 146, 150, 59, 142,
+			77, 93, 60, 92, 0, 183, 122, 184, 247, 249, 221, 247, 8, 195,
+			150, 26, 71, 137, 85, 167, 138, 99, 37, 105, 157, 52, 86, 84,
+			79, 162, 218, 77, 60, 78, 211, 118, 220, 54, 86, 60, 141, 93,
+			39, 158, 182, 238, 76, 226, 76, 156, 73, 50, 78, 221, 100, 220,
+			100, 60, 227, 168, 157, 164, 117, 60, 205, 116, 58, 223, 217, 179,
+			123, 247, 61, 60, 128, 160, 172, 184, 113, 170, 145, 102, 136, 221,
+			119, 119, 207, 254, 156, 61, 123, 246, 236, 183, 231, 208, 23, 118,
+			53, 166, 216, 249, 146, 236, 85, 129, 45, 202, 103, 228, 174, 174,
+			84, 1, 115, 53, 131, 171, 29, 195, 136, 153, 45, 38, 239, 242,
+			92, 249, 130, 220, 190, 138, 12, 220, 183, 242, 206, 197, 195, 104,
+			160, 170, 59, 101, 15, 137, 96, 30, 69, 149, 120, 109, 208, 193,
+			63, 219, 165, 28, 120, 188, 109, 93, 228, 224, 201, 55, 144, 131,
+			111, 32, 7, 239, 24, 57, 168, 125, 172, 26, 220, 31, 254, 252,
+			13, 71, 65, 151, 246, 109, 58, 236, 4, 191, 226, 116, 115, 6,
+			207, 207, 39, 101, 120, 65, 133, 0, 194, 59, 253, 176, 90, 227,
+			199, 167, 157, 143, 38, 181, 96, 172, 132, 23, 51, 205, 73, 236,
+			17, 130, 24, 62, 156, 75, 224, 43, 1, 186, 111, 134, 235, 170,
+			108, 153, 84, 124, 235, 81, 46, 189, 213, 213, 30, 0, 16, 222,
+			32, 107, 102, 100, 124, 111, 15, 68, 11, 232, 156, 122, 161, 121,
+			241, 60, 223, 19, 225, 42, 104, 95, 105, 167, 60, 202, 24, 42,
+			49, 232, 250, 193, 62, 90, 44, 89, 35, 129, 38, 193, 14, 59,
+			32, 243, 248, 42, 139, 142, 153, 98, 144, 175, 178, 232, 140, 41,
+			6, 55, 107, 63, 89, 184, 53, 30, 220, 182, 157, 130, 154, 145,
+			91, 179, 131, 238, 254, 96, 38, 124, 194, 120, 230, 211, 30, 46,
+			232, 168, 137, 253, 45, 174, 44, 84, 248, 141, 92, 77, 69, 112,
+			171, 200, 80, 75, 247, 10, 36, 175, 246, 58, 160, 231, 217, 122,
+			121, 233, 244, 250, 226, 160, 59, 160, 83, 160, 119, 215, 30, 157,
+			18, 190, 56, 24, 12, 202, 21, 198, 36, 137, 33, 247, 80, 80,
+			163, 206, 145, 20, 13, 89, 10, 135, 70, 146, 217, 17, 84, 205,
+			98, 69, 131, 205, 67, 63, 137, 80, 156, 109, 206, 95, 77, 159,
+			56, 206, 40, 118, 132, 142, 151, 125, 184, 58, 30, 50, 141, 196,
+			160, 12, 221, 165, 195, 149, 227, 54, 120, 232, 96, 40, 43, 10,
+			119, 114, 108, 211, 125, 78, 80, 14, 245, 62, 164, 37, 1, 17,
+			40, 14, 231, 60, 123, 184, 36, 63, 86, 218, 41, 127, 223, 0,
+			77, 198, 92, 31, 214, 201, 135, 147, 102, 2, 87, 223, 55, 102,
+			174, 104, 79, 105, 214, 27, 95, 142, 169, 25, 215, 51, 19, 72,
+			128, 56, 202, 42, 117, 198, 196, 50, 189, 127, 49, 203, 155, 231,
+			198, 205, 25, 68, 218, 158, 12, 195, 178, 254, 204, 72, 109, 245,
+			198, 69, 57, 25, 171, 84, 179, 37, 243, 203, 120, 222, 168, 150,
+			165, 114, 208, 6, 46, 135, 185, 156, 198, 184, 92, 89, 72, 154,
+			101, 224, 130, 47, 61, 118, 227, 202, 197, 110, 243, 76, 161, 11,
+			196, 24, 179, 156, 2, 170, 140, 49, 203, 57, 56, 93, 138, 177,
+			109, 219, 229, 255, 48, 64, 149, 211, 238, 158, 224, 143, 157, 240,
+			81, 43, 26, 32, 166, 37, 92, 94, 139, 11, 57, 112, 19, 62,
+			162, 133, 163, 134, 39, 79, 112, 197, 58, 202, 240, 128, 38, 208,
+			128, 170, 197, 227, 227, 101, 227, 232, 227, 12, 146, 134, 131, 242,
+			241, 122, 180, 130, 168, 169, 249, 120, 53, 107, 196, 58, 117, 147,
+			181, 178, 155, 88, 232, 55, 91, 205, 164, 118, 179, 149, 194, 110,
+			144, 55, 43, 85, 26, 150, 232, 61, 161, 242, 132, 124, 38, 60,
+			61, 57, 85, 225, 139, 102, 189, 177, 32, 12, 78, 94, 131, 219,
+			22, 64, 70, 204, 145, 216, 70, 190, 156, 54, 163, 3, 182, 56,
+			189, 217, 215, 41, 225, 139, 211, 187, 118, 147, 125, 138, 28, 69,
+			220, 235, 110, 15, 166, 194, 199, 210, 120, 76, 133, 208, 133, 170,
+			162, 207, 108, 235, 12, 16, 87, 135, 119, 186, 247, 242, 157, 190,
+			242, 11, 113, 111, 169, 95, 167, 132, 47, 238, 189, 107, 155, 140,
+			20, 200, 230, 236, 166, 7, 157, 224, 70, 56, 99, 189, 239, 183,
+			150, 26, 70, 57, 109, 95, 136, 56, 18, 88, 31, 224, 81, 190,
+			129, 184, 52, 136, 69, 233, 115, 134, 81, 123, 32, 126, 182, 4,
+			84, 136, 231, 185, 184, 62, 56, 231, 94, 84, 119, 220, 110, 9,
+			28, 114, 174, 52, 32, 167, 24, 159, 35, 30, 240, 70, 57, 56,
+			181, 114, 223, 30, 146, 174, 19, 178, 178, 83, 144, 229, 142, 186,
+			48, 52, 137, 7, 188, 125, 58, 229, 248, 226, 129, 64, 163, 63,
+			192, 113, 15, 28, 63, 65, 98, 147, 198, 224, 130, 55, 196, 98,
+			83, 43, 86, 93, 106, 132, 172, 186, 224, 237, 210, 41, 20, 219,
+			125, 183, 78, 9, 95, 92, 56, 84, 150, 63, 64, 8, 0, 239,
+			242, 166, 43, 78, 144, 183, 15, 71, 218, 181, 229, 64, 249, 47,
+			197, 208, 254, 243, 240, 169, 52, 91, 78, 177, 224, 207, 235, 88,
+			98, 163, 178, 8, 47, 142, 147, 130, 94, 174, 52, 142, 122, 212,
+			249, 89, 90, 53, 91, 178, 208, 3, 151, 75, 7, 40, 222, 4,
+			174, 1, 197, 35, 238, 190, 96, 120, 3, 131, 135, 80, 49, 106,
+			252, 40, 220, 164, 120, 132, 25, 133, 174, 232, 196, 35, 52, 87,
+			124, 67, 39, 30, 217, 179, 87, 190, 147, 238, 179, 188, 171, 155,
+			222, 230, 4, 143, 119, 246, 214, 140, 100, 27, 143, 140, 182, 117,
+			72, 154, 30, 205, 182, 22, 242, 54, 221, 145, 251, 2, 160, 197,
+			213, 210, 62, 57, 66, 247, 4, 155, 124, 49, 227, 238, 10, 246,
+			183, 79, 21, 203, 54, 222, 123, 168, 141, 116, 157, 37, 102, 120,
+			77, 209, 109, 150, 152, 97, 60, 18, 93, 102, 137, 153, 29, 59,
+			249, 10, 13, 46, 77, 221, 29, 193, 155, 215, 152, 127, 54, 100,
+			132, 15, 98, 159, 43, 159, 175, 37, 233, 83, 231, 128, 82, 135,
+			239, 167, 164, 150, 52, 87, 202, 134, 38, 214, 241, 13, 67, 19,
+			235, 248, 198, 230, 1, 157, 18, 190, 184, 177, 221, 55, 7, 181,
+			223, 56, 42, 247, 117, 62, 179, 142, 210, 149, 181, 226, 42, 220,
+			35, 197, 116, 186, 66, 111, 176, 86, 234, 241, 205, 86, 163, 166,
+			149, 114, 56, 218, 189, 209, 168, 181, 43, 229, 253, 90, 41, 191,
+			213, 61, 164, 66, 105, 58, 93, 209, 209, 20, 70, 249, 199, 219,
+			69, 83, 136, 210, 149, 250, 236, 70, 163, 40, 252, 151, 195, 234,
+			73, 208, 143, 59, 111, 132, 81, 120, 35, 140, 194, 95, 123, 24,
+			133, 11, 133, 138, 175, 223, 251, 236, 40, 34, 38, 236, 48, 17,
+			19, 248, 189, 80, 137, 255, 212, 97, 20, 76, 196, 132, 93, 38,
+			98, 194, 238, 34, 98, 194, 110, 19, 49, 97, 79, 17, 49, 1,
+			127, 254, 213, 118, 58, 75, 244, 100, 155, 126, 220, 113, 130, 63,
+			219, 30, 62, 57, 157, 174, 60, 169, 247, 60, 116, 38, 140, 26,
+			179, 73, 179, 129, 39, 51, 57, 194, 123, 215, 146, 247, 146, 7,
+			176, 172, 153, 85, 179, 90, 56, 219, 154, 159, 167, 208, 94, 202,
+			124, 134, 120, 91, 11, 236, 198, 87, 146, 246, 71, 199, 136, 185,
+			56, 175, 54, 146, 89, 142, 251, 134, 245, 174, 217, 207, 170, 147,
+			235, 168, 72, 25, 234, 53, 25, 214, 146, 89, 34, 205, 6, 192,
+			60, 204, 91, 245, 58, 34, 89, 35, 14, 97, 84, 125, 106, 188,
+			149, 214, 17, 160, 111, 58, 93, 209, 54, 85, 182, 56, 195, 9,
+			180, 4, 21, 232, 58, 73, 115, 37, 156, 111, 165, 58, 86, 67,
+			35, 140, 230, 230, 18, 118, 144, 189, 16, 107, 252, 142, 126, 58,
+			197, 77, 67, 157, 104, 106, 197, 242, 115, 62, 121, 38, 188, 10,
+			122, 56, 201, 48, 233, 200, 116, 62, 73, 195, 11, 39, 78, 104,
+			175, 230, 15, 101, 89, 56, 159, 101, 218, 159, 60, 178, 168, 202,
+			40, 93, 225, 20, 132, 37, 106, 131, 171, 242, 225, 249, 44, 27,
+			225, 252, 74, 165, 82, 56, 134, 199, 71, 55, 136, 210, 245, 108,
+			248, 40, 190, 178, 60, 183, 155, 47, 109, 95, 236, 83, 183, 105,
+			227, 91, 162, 91, 209, 6, 26, 25, 158, 69, 115, 43, 160, 188,
+			110, 227, 146, 124, 248, 161, 44, 171, 84, 107, 81, 158, 219, 109,
+			83, 213, 162, 249, 106, 160, 172, 175, 184, 170, 103, 212, 63, 227,
+			227, 152, 145, 46, 245, 94, 139, 150, 98, 200, 226, 105, 69, 97,
+			33, 110, 114, 88, 195, 203, 105, 222, 132, 176, 27, 30, 185, 45,
+			193, 174, 133, 12, 125, 25, 106, 37, 58, 60, 121, 155, 81, 107,
+			119, 89, 175, 104, 61, 148, 101, 195, 149, 74, 133, 93, 207, 155,
+			49, 27, 30, 105, 159, 95, 26, 190, 213, 163, 135, 159, 47, 171,
+			174, 1, 126, 52, 115, 249, 234, 245, 199, 102, 70, 206, 232, 238,
+			20, 19, 111, 149, 87, 53, 88, 205, 62, 117, 155, 102, 63, 156,
+			113, 139, 137, 23, 207, 156, 13, 143, 214, 103, 43, 15, 101, 217,
+			251, 42, 149, 10, 143, 63, 142, 149, 163, 8, 209, 27, 158, 161,
+			225, 171, 207, 86, 30, 141, 151, 109, 162, 201, 60, 253, 124, 232,
+			108, 152, 38, 181, 98, 192, 139, 222, 132, 207, 200, 206, 156, 14,
+			114, 207, 180, 87, 165, 40, 85, 110, 164, 75, 81, 35, 95, 140,
+			106, 215, 51, 162, 247, 230, 219, 211, 145, 28, 173, 170, 250, 148,
+			89, 175, 230, 118, 96, 118, 37, 172, 119, 74, 14, 117, 175, 178,
+			98, 124, 123, 146, 109, 227, 24, 173, 235, 5, 82, 0, 162, 122,
+			146, 211, 57, 113, 190, 85, 171, 85, 232, 7, 168, 97, 199, 244,
+			185, 21, 57, 36, 201, 34, 134, 87, 41, 238, 146, 133, 184, 96,
+			151, 125, 36, 52, 80, 201, 74, 248, 238, 86, 84, 99, 15, 134,
+			40, 141, 250, 194, 104, 94, 63, 249, 171, 225, 101, 221, 177, 241,
+			99, 82, 223, 142, 105, 18, 20, 136, 183, 56, 218, 206, 103, 89,
+			101, 54, 106, 80, 227, 222, 51, 190, 82, 121, 111, 89, 97, 51,
+			86, 8, 62, 134, 66, 146, 52, 198, 176, 140, 223, 192, 22, 240,
+			209, 47, 195, 179, 103, 207, 158, 85, 195, 132, 116, 225, 96, 221,
+			28, 232, 163, 148, 229, 60, 137, 76, 152, 123, 244, 213, 223, 66,
+			171, 22, 53, 100, 151, 34, 248, 121, 46, 46, 164, 245, 104, 24,
+			47, 205, 198, 20, 27, 134, 185, 141, 131, 18, 192, 99, 170, 37,
+			93, 213, 109, 194, 147, 15, 160, 181, 79, 234, 27, 10, 27, 150,
+			160, 251, 94, 209, 44, 125, 134, 25, 22, 131, 12, 38, 46, 20,
+			191, 249, 164, 22, 243, 210, 213, 28, 126, 53, 110, 192, 181, 155,
+			97, 21, 126, 67, 72, 206, 142, 200, 76, 30, 218, 241, 44, 248,
+			215, 90, 84, 252, 56, 197, 63, 62, 195, 84, 77, 77, 101, 106,
+			114, 249, 76, 88, 238, 198, 43, 237, 141, 170, 168, 102, 148, 71,
+			77, 97, 106, 0, 78, 255, 229, 51, 225, 253, 138, 236, 185, 226,
+			215, 90, 180, 234, 199, 162, 21, 116, 15, 19, 175, 26, 94, 19,
+			142, 98, 57, 174, 213, 198, 248, 160, 197, 158, 12, 163, 176, 218,
+			202, 155, 217, 18, 207, 127, 251, 236, 225, 254, 38, 106, 118, 78,
+			169, 190, 110, 52, 100, 48, 103, 233, 2, 124, 188, 98, 198, 36,
+			222, 245, 212, 90, 102, 202, 22, 179, 26, 30, 113, 46, 198, 54,
+			37, 176, 175, 158, 106, 109, 136, 226, 153, 150, 170, 26, 51, 169,
+			202, 227, 151, 238, 202, 59, 214, 242, 67, 242, 206, 119, 188, 115,
+			228, 204, 107, 157, 137, 246, 170, 172, 201, 160, 158, 96, 38, 39,
+			43, 83, 147, 83, 121, 217, 140, 181, 177, 64, 102, 165, 45, 242,
+			87, 183, 176, 9, 210, 251, 16, 174, 148, 63, 181, 37, 156, 6,
+			95, 142, 155, 16, 254, 196, 78, 52, 150, 45, 242, 90, 87, 91,
+			41, 108, 90, 22, 47, 175, 82, 108, 228, 90, 218, 18, 110, 235,
+			147, 92, 115, 37, 217, 120, 120, 105, 224, 164, 92, 139, 35, 184,
+			17, 131, 205, 182, 60, 94, 14, 171, 139, 17, 2, 50, 196, 13,
+			148, 98, 25, 146, 199, 11, 75, 28, 68, 10, 228, 111, 204, 92,
+			57, 6, 31, 195, 205, 69, 101, 48, 50, 83, 46, 187, 138, 166,
+			212, 50, 78, 81, 203, 135, 35, 152, 158, 36, 174, 12, 155, 139,
+			107, 14, 236, 147, 35, 170, 5, 84, 188, 112, 141, 8, 94, 64,
+			204, 168, 44, 197, 53, 39, 4, 217, 146, 12, 135, 97, 90, 29,
+			13, 107, 113, 68, 204, 85, 174, 148, 193, 193, 56, 201, 68, 244,
+			16, 60, 158, 131, 163, 54, 132, 97, 169, 163, 115, 73, 53, 30,
+			13, 155, 113, 180, 132, 112, 254, 173, 8, 205, 173, 227, 193, 239,
+			82, 29, 199, 157, 36, 101, 30, 83, 46, 105, 97, 137, 166, 69,
+			145, 27, 175, 121, 43, 146, 49, 209, 120, 166, 132, 176, 255, 56,
+			61, 164, 236, 242, 54, 109, 2, 68, 147, 205, 147, 86, 19, 94,
+			202, 150, 227, 91, 48, 94, 128, 47, 111, 204, 92, 209, 113, 61,
+			89, 142, 75, 29, 255, 252, 73, 28, 239, 159, 28, 85, 255, 230,
+			79, 142, 66, 85, 1, 184, 134, 126, 5, 196, 16, 215, 212, 105,
+			152, 49, 0, 163, 6, 45, 185, 25, 182, 234, 176, 193, 147, 128,
+			198, 123, 77, 146, 251, 64, 13, 68, 245, 220, 8, 60, 186, 81,
+			215, 43, 162, 176, 172, 146, 43, 106, 245, 102, 33, 199, 82, 56,
+			30, 94, 158, 47, 40, 218, 209, 250, 77, 163, 144, 25, 229, 121,
+			107, 9, 6, 185, 240, 120, 56, 157, 134, 151, 174, 95, 191, 26,
+			62, 252, 224, 117, 184, 82, 96, 214, 80, 60, 161, 246, 142, 104,
+			245, 18, 188, 190, 82, 143, 223, 249, 142, 119, 98, 109, 48, 86,
+			33, 101, 223, 191, 28, 79, 133, 186, 94, 111, 100, 115, 173, 106,
+			76, 225, 102, 225, 37, 87, 209, 83, 7, 62, 110, 61, 92, 208,
+			161, 245, 184, 173, 207, 194, 42, 14, 144, 97, 45, 203, 158, 106,
+			213, 13, 166, 89, 153, 253, 84, 211, 64, 144, 182, 190, 172, 161,
+			253, 226, 198, 75, 214, 180, 3, 66, 66, 46, 44, 185, 49, 248,
+			251, 86, 150, 0, 211, 189, 130, 178, 170, 106, 98, 201, 70, 60,
+			79, 177, 61, 248, 75, 84, 16, 53, 217, 196, 209, 246, 106, 173,
+			174, 221, 135, 162, 130, 44, 101, 31, 123, 52, 33, 152, 157, 188,
+			18, 14, 223, 200, 201, 251, 0, 142, 116, 246, 46, 78, 223, 44,
+			69, 105, 180, 16, 163, 236, 108, 35, 142, 158, 2, 103, 115, 13,
+			149, 17, 41, 77, 152, 151, 36, 55, 103, 142, 8, 86, 22, 205,
+			250, 213, 226, 78, 198, 24, 146, 152, 75, 179, 249, 249, 164, 154,
+			68, 53, 22, 25, 208, 99, 26, 49, 4, 1, 34, 150, 16, 88,
+			79, 87, 98, 60, 145, 22, 236, 52, 27, 47, 36, 41, 249, 170,
+			192, 70, 44, 195, 46, 210, 178, 18, 78, 211, 241, 230, 173, 209,
+			10, 220, 153, 156, 212, 119, 251, 64, 157, 167, 89, 184, 156, 204,
+			197, 218, 253, 48, 73, 4, 197, 188, 146, 92, 103, 196, 75, 122,
+			243, 192, 177, 112, 14, 60, 89, 175, 69, 41, 141, 136, 249, 29,
+			11, 2, 43, 250, 26, 173, 143, 156, 193, 31, 4, 169, 233, 88,
+			73, 225, 48, 159, 171, 227, 165, 122, 115, 133, 217, 123, 132, 189,
+			120, 3, 144, 75, 173, 64, 79, 58, 168, 23, 7, 241, 60, 94,
+			138, 210, 102, 82, 205, 97, 105, 219, 170, 47, 134, 32, 190, 75,
+			58, 137, 88, 248, 206, 230, 237, 58, 137, 88, 248, 64, 201, 60,
+			161, 223, 171, 191, 224, 184, 126, 112, 217, 4, 109, 137, 112, 136,
+			76, 230, 214, 59, 233, 178, 192, 84, 120, 89, 110, 10, 15, 23,
+			35, 30, 212, 163, 158, 23, 52, 86, 71, 193, 19, 95, 112, 74,
+			91, 117, 82, 32, 105, 69, 114, 249, 250, 69, 121, 254, 142, 60,
+			4, 242, 43, 133, 217, 70, 148, 2, 211, 188, 22, 242, 225, 246,
+			110, 20, 215, 182, 223, 5, 7, 59, 127, 50, 239, 166, 190, 3,
+			15, 138, 229, 55, 201, 193, 135, 227, 166, 229, 70, 251, 60, 245,
+			129, 125, 252, 248, 123, 109, 100, 6, 59, 141, 162, 156, 242, 103,
+			60, 185, 125, 85, 177, 174, 72, 14, 235, 210, 223, 109, 191, 244,
+			183, 66, 203, 136, 117, 67, 203, 120, 171, 67, 203, 236, 147, 165,
+			70, 60, 175, 126, 86, 142, 194, 250, 26, 241, 124, 167, 135, 45,
+			29, 196, 101, 21, 4, 162, 211, 195, 150, 63, 38, 69, 35, 158,
+			39, 39, 110, 91, 166, 6, 87, 23, 184, 70, 247, 87, 51, 241,
+			252, 12, 190, 243, 47, 72, 127, 30, 119, 161, 48, 148, 220, 228,
+			109, 63, 103, 183, 110, 59, 43, 157, 178, 124, 58, 93, 153, 217,
+			110, 190, 191, 198, 159, 91, 149, 36, 233, 130, 174, 101, 239, 230,
+			13, 84, 146, 164, 11, 92, 139, 127, 74, 74, 184, 44, 70, 72,
+			236, 106, 190, 183, 127, 157, 194, 214, 119, 254, 253, 82, 46, 102,
+			205, 155, 202, 90, 180, 87, 134, 78, 119, 79, 142, 151, 211, 122,
+			171, 121, 158, 62, 154, 217, 188, 152, 241, 159, 20, 231, 38, 3,
+			247, 82, 114, 239, 150, 141, 20, 151, 213, 172, 54, 167, 202, 151,
+			231, 228, 22, 235, 39, 127, 183, 236, 85, 55, 93, 12, 36, 225,
+			148, 127, 214, 114, 54, 233, 82, 44, 157, 67, 171, 105, 92, 205,
+			114, 178, 28, 173, 114, 56, 89, 254, 209, 30, 121, 87, 199, 175,
+			254, 81, 57, 0, 15, 162, 73, 83, 255, 192, 36, 59, 114, 253,
+			138, 244, 22, 179, 86, 99, 175, 123, 91, 127, 135, 244, 29, 69,
+			58, 202, 111, 22, 111, 207, 8, 245, 83, 2, 56, 231, 65, 147,
+			231, 223, 39, 189, 70, 43, 205, 247, 122, 212, 151, 35, 183, 237,
+			75, 101, 166, 149, 206, 80, 145, 224, 215, 132, 20, 51, 173, 212,
+			175, 200, 29, 250, 149, 217, 77, 188, 245, 98, 223, 131, 170, 19,
+			219, 245, 79, 87, 163, 60, 87, 254, 7, 237, 239, 17, 164, 130,
+			191, 119, 219, 191, 127, 136, 80, 58, 248, 126, 66, 238, 52, 223,
+			83, 84, 8, 46, 32, 168, 128, 175, 127, 187, 128, 159, 86, 151,
+			160, 120, 4, 92, 194, 107, 47, 49, 141, 159, 84, 137, 41, 185,
+			171, 149, 118, 235, 69, 15, 245, 98, 71, 43, 93, 221, 143, 246,
+			50, 86, 79, 122, 59, 203, 20, 125, 57, 37, 119, 183, 210, 174,
+			189, 233, 163, 222, 236, 108, 165, 93, 250, 211, 94, 202, 238, 81,
+			169, 179, 148, 213, 167, 67, 178, 63, 201, 111, 206, 181, 148, 226,
+			21, 211, 34, 46, 205, 108, 73, 242, 139, 58, 107, 170, 33, 119,
+			172, 18, 152, 113, 238, 191, 67, 138, 135, 227, 166, 63, 182, 154,
+			27, 214, 17, 204, 65, 23, 84, 215, 170, 111, 95, 171, 239, 181,
+			63, 188, 71, 93, 180, 124, 202, 121, 3, 65, 245, 6, 130, 234,
+			117, 70, 80, 117, 243, 189, 54, 180, 134, 239, 53, 125, 67, 98,
+			208, 86, 248, 243, 5, 161, 220, 172, 133, 64, 91, 253, 144, 32,
+			148, 70, 131, 207, 179, 246, 171, 183, 80, 105, 99, 161, 90, 81,
+			177, 122, 157, 16, 94, 171, 71, 105, 26, 55, 224, 22, 172, 73,
+			109, 92, 202, 176, 233, 66, 239, 166, 200, 162, 80, 254, 113, 216,
+			37, 37, 154, 48, 230, 77, 142, 30, 26, 53, 99, 59, 74, 73,
+			27, 17, 201, 84, 0, 218, 79, 53, 141, 112, 216, 224, 127, 193,
+			203, 113, 148, 39, 176, 80, 168, 74, 49, 243, 57, 154, 124, 237,
+			241, 43, 244, 142, 34, 137, 243, 81, 68, 229, 172, 70, 218, 114,
+			9, 96, 136, 21, 238, 116, 196, 122, 22, 80, 156, 88, 232, 73,
+			29, 166, 172, 3, 216, 53, 135, 104, 149, 173, 60, 134, 67, 68,
+			28, 231, 81, 225, 124, 171, 217, 194, 113, 44, 49, 200, 36, 115,
+			104, 153, 75, 242, 122, 45, 90, 65, 131, 12, 212, 163, 123, 23,
+			139, 11, 157, 27, 151, 71, 195, 184, 89, 181, 60, 216, 133, 165,
+			65, 121, 69, 59, 176, 43, 187, 67, 251, 130, 115, 150, 43, 52,
+			148, 225, 134, 23, 195, 217, 133, 146, 25, 75, 227, 62, 12, 117,
+			151, 123, 55, 235, 148, 235, 139, 178, 60, 162, 83, 64, 75, 121,
+			187, 228, 121, 186, 55, 243, 142, 109, 154, 210, 126, 18, 86, 191,
+			129, 121, 114, 149, 136, 140, 243, 202, 195, 113, 83, 3, 202, 181,
+			245, 233, 88, 105, 72, 126, 218, 211, 214, 167, 9, 119, 114, 44,
+			248, 184, 87, 216, 88, 178, 249, 181, 6, 9, 99, 221, 52, 216,
+			52, 254, 14, 167, 117, 13, 40, 200, 199, 223, 119, 117, 230, 177,
+			183, 60, 120, 225, 250, 51, 116, 116, 200, 199, 223, 119, 99, 230,
+			202, 205, 7, 175, 93, 152, 190, 250, 224, 197, 155, 20, 122, 239,
+			242, 197, 103, 198, 185, 218, 124, 252, 125, 111, 155, 158, 185, 60,
+			253, 232, 245, 155, 151, 166, 175, 93, 122, 102, 188, 17, 207, 231,
+			227, 239, 155, 121, 240, 33, 149, 150, 10, 191, 116, 70, 134, 92,
+			171, 126, 51, 178, 166, 151, 153, 53, 135, 58, 236, 210, 14, 93,
+			27, 191, 169, 24, 13, 227, 188, 26, 213, 249, 20, 88, 0, 45,
+			236, 91, 254, 167, 22, 198, 211, 184, 57, 222, 106, 212, 198, 135,
+			174, 70, 205, 197, 7, 169, 68, 133, 60, 60, 82, 132, 43, 93,
+			40, 74, 234, 149, 185, 248, 214, 248, 228, 20, 160, 77, 118, 47,
+			53, 85, 221, 84, 251, 129, 91, 91, 31, 116, 227, 195, 225, 201,
+			123, 66, 24, 56, 26, 99, 213, 40, 143, 199, 140, 101, 46, 92,
+			140, 223, 195, 214, 60, 172, 30, 61, 108, 154, 128, 50, 22, 54,
+			205, 214, 192, 99, 194, 41, 51, 52, 38, 110, 202, 237, 169, 48,
+			108, 131, 142, 189, 98, 130, 97, 27, 116, 234, 21, 19, 109, 120,
+			200, 137, 109, 218, 229, 22, 92, 184, 77, 118, 186, 112, 155, 236,
+			57, 129, 48, 73, 158, 227, 247, 156, 222, 244, 126, 7, 80, 24,
+			11, 51, 165, 108, 27, 245, 44, 177, 199, 0, 252, 29, 193, 237,
+			70, 51, 169, 226, 206, 32, 28, 102, 150, 131, 1, 15, 112, 69,
+			30, 53, 188, 56, 153, 31, 97, 102, 7, 158, 228, 116, 105, 159,
+			252, 5, 79, 195, 5, 207, 185, 126, 240, 209, 141, 242, 250, 99,
+			111, 48, 248, 255, 159, 12, 174, 208, 151, 231, 152, 193, 21, 250,
+			242, 92, 27, 250, 242, 220, 182, 237, 114, 82, 131, 47, 167, 221,
+			93, 193, 225, 66, 91, 100, 239, 87, 26, 255, 164, 181, 83, 13,
+			122, 82, 224, 197, 105, 83, 57, 152, 116, 154, 129, 86, 202, 109,
+			215, 244, 142, 157, 8, 237, 7, 236, 161, 47, 46, 186, 187, 56,
+			180, 95, 103, 119, 25, 115, 93, 160, 20, 47, 154, 58, 209, 170,
+			139, 166, 78, 32, 110, 47, 238, 216, 41, 99, 170, 83, 248, 226,
+			146, 27, 4, 111, 15, 47, 89, 243, 194, 83, 50, 10, 99, 176,
+			30, 38, 140, 18, 198, 38, 154, 139, 171, 201, 82, 84, 43, 174,
+			4, 32, 79, 85, 231, 238, 187, 167, 122, 207, 189, 115, 213, 251,
+			78, 221, 19, 205, 158, 154, 184, 231, 222, 162, 147, 112, 133, 116,
+			201, 52, 8, 138, 208, 37, 142, 87, 227, 144, 2, 116, 105, 239,
+			62, 249, 56, 53, 136, 162, 89, 237, 14, 46, 182, 53, 136, 239,
+			64, 148, 204, 218, 120, 179, 152, 0, 92, 15, 61, 98, 136, 3,
+			17, 247, 200, 230, 237, 58, 37, 124, 241, 200, 206, 93, 240, 105,
+			234, 81, 168, 171, 199, 220, 99, 193, 92, 120, 209, 64, 70, 178,
+			212, 210, 14, 151, 35, 98, 175, 70, 75, 89, 58, 245, 184, 51,
+			136, 59, 42, 62, 84, 129, 97, 160, 227, 32, 52, 76, 220, 224,
+			8, 210, 248, 56, 204, 91, 137, 241, 171, 229, 80, 96, 150, 199,
+			56, 48, 139, 131, 88, 113, 226, 177, 193, 178, 78, 9, 95, 60,
+			118, 228, 168, 28, 165, 198, 245, 2, 188, 119, 36, 56, 24, 94,
+			47, 184, 59, 73, 245, 216, 224, 138, 161, 145, 213, 76, 189, 189,
+			244, 249, 126, 157, 130, 79, 190, 3, 161, 78, 9, 95, 204, 12,
+			29, 150, 255, 30, 193, 183, 29, 183, 207, 23, 239, 114, 199, 130,
+			79, 11, 170, 153, 141, 46, 49, 236, 144, 11, 80, 23, 141, 14,
+			148, 181, 154, 245, 86, 147, 77, 145, 176, 179, 50, 96, 95, 1,
+			241, 149, 177, 95, 63, 225, 34, 147, 56, 140, 231, 113, 3, 48,
+			151, 78, 59, 38, 252, 185, 145, 219, 35, 105, 20, 200, 36, 111,
+			15, 12, 163, 84, 181, 120, 182, 181, 16, 214, 91, 141, 122, 134,
+			107, 98, 40, 128, 149, 240, 9, 10, 133, 37, 195, 46, 102, 159,
+			194, 64, 223, 136, 111, 197, 81, 13, 179, 148, 52, 249, 218, 3,
+			115, 176, 24, 221, 66, 94, 181, 150, 160, 103, 82, 199, 160, 92,
+			177, 58, 145, 208, 219, 133, 203, 4, 145, 8, 155, 89, 86, 43,
+			172, 247, 80, 189, 149, 242, 12, 125, 117, 52, 140, 194, 229, 4,
+			254, 159, 208, 59, 120, 214, 142, 72, 182, 204, 37, 24, 32, 186,
+			249, 34, 53, 28, 108, 130, 14, 67, 51, 163, 198, 142, 180, 221,
+			7, 208, 237, 11, 187, 137, 41, 6, 16, 87, 166, 181, 168, 202,
+			46, 99, 248, 98, 20, 204, 71, 84, 136, 44, 91, 125, 213, 156,
+			246, 245, 98, 22, 245, 154, 234, 115, 124, 241, 174, 221, 195, 58,
+			37, 124, 241, 174, 19, 163, 122, 190, 75, 136, 214, 212, 57, 223,
+			24, 21, 158, 240, 55, 230, 251, 123, 96, 190, 75, 189, 152, 69,
+			61, 223, 37, 196, 220, 50, 243, 93, 66, 204, 173, 19, 163, 242,
+			55, 212, 124, 111, 246, 197, 45, 247, 104, 240, 111, 69, 120, 205,
+			216, 68, 249, 173, 142, 54, 31, 190, 49, 229, 223, 3, 83, 190,
+			185, 23, 19, 169, 167, 124, 179, 227, 139, 91, 187, 181, 72, 223,
+			44, 124, 113, 107, 232, 136, 60, 76, 51, 46, 17, 74, 235, 238,
+			96, 15, 45, 240, 197, 12, 206, 151, 218, 166, 85, 149, 145, 136,
+			177, 229, 106, 69, 70, 34, 198, 214, 128, 118, 24, 42, 133, 47,
+			86, 6, 15, 200, 35, 84, 223, 22, 95, 60, 237, 30, 12, 246,
+			82, 125, 48, 109, 119, 175, 112, 75, 47, 190, 211, 21, 110, 113,
+			124, 241, 244, 192, 62, 174, 112, 139, 240, 197, 211, 251, 239, 150,
+			31, 114, 232, 117, 68, 239, 15, 59, 155, 158, 119, 156, 224, 7,
+			66, 203, 54, 222, 142, 205, 97, 222, 52, 238, 50, 187, 41, 141,
+			246, 73, 52, 201, 139, 123, 73, 138, 174, 217, 228, 206, 51, 67,
+			106, 4, 21, 181, 223, 180, 28, 78, 76, 92, 199, 247, 126, 216,
+			41, 237, 96, 103, 158, 155, 224, 242, 206, 221, 17, 76, 117, 120,
+			60, 179, 93, 127, 153, 21, 211, 49, 16, 91, 181, 95, 81, 84,
+			161, 174, 224, 92, 104, 140, 222, 115, 78, 105, 64, 39, 201, 167,
+			222, 118, 95, 38, 218, 119, 232, 63, 112, 220, 225, 224, 29, 33,
+			91, 194, 17, 9, 151, 227, 248, 174, 224, 77, 0, 226, 214, 213,
+			217, 88, 30, 14, 103, 181, 57, 180, 131, 48, 62, 35, 116, 49,
+			75, 0, 139, 148, 239, 183, 149, 135, 141, 246, 175, 76, 187, 28,
+			143, 104, 153, 100, 47, 146, 91, 2, 157, 116, 144, 28, 28, 210,
+			73, 129, 228, 209, 99, 242, 49, 122, 148, 209, 251, 99, 206, 166,
+			79, 57, 78, 48, 29, 118, 24, 238, 11, 164, 71, 225, 118, 158,
+			127, 129, 127, 196, 206, 30, 240, 152, 195, 133, 192, 143, 57, 165,
+			61, 242, 20, 191, 178, 240, 94, 112, 220, 193, 224, 40, 179, 88,
+			123, 167, 181, 153, 142, 235, 229, 254, 208, 35, 139, 226, 170, 147,
+			94, 89, 120, 47, 56, 165, 221, 58, 73, 87, 157, 251, 2, 249,
+			54, 237, 7, 237, 39, 29, 247, 72, 112, 137, 72, 208, 48, 49,
+			116, 35, 201, 77, 139, 21, 194, 97, 174, 85, 5, 194, 161, 217,
+			104, 165, 85, 251, 161, 91, 26, 71, 13, 12, 43, 110, 65, 76,
+			35, 224, 16, 230, 39, 29, 119, 80, 39, 29, 36, 247, 135, 58,
+			41, 144, 28, 58, 44, 239, 163, 70, 184, 190, 247, 17, 199, 221,
+			23, 156, 8, 159, 88, 140, 201, 78, 105, 179, 58, 12, 96, 230,
+			6, 5, 150, 203, 52, 211, 47, 217, 5, 57, 167, 248, 136, 227,
+			246, 234, 164, 131, 170, 250, 118, 234, 164, 64, 114, 207, 94, 57,
+			192, 62, 47, 123, 127, 218, 113, 63, 233, 8, 245, 51, 249, 213,
+			249, 105, 71, 110, 53, 126, 12, 55, 249, 222, 207, 56, 94, 57,
+			152, 12, 31, 53, 12, 174, 77, 253, 64, 190, 80, 28, 39, 131,
+			153, 96, 102, 111, 180, 82, 246, 132, 35, 216, 221, 206, 207, 216,
+			62, 253, 64, 228, 103, 28, 105, 121, 171, 20, 200, 8, 15, 25,
+			170, 142, 239, 189, 184, 14, 85, 92, 117, 220, 142, 42, 174, 183,
+			95, 180, 169, 226, 130, 251, 69, 155, 42, 30, 245, 191, 232, 132,
+			135, 228, 121, 166, 234, 250, 222, 75, 142, 55, 20, 76, 117, 167,
+			170, 3, 130, 175, 75, 22, 163, 255, 146, 77, 22, 252, 244, 146,
+			35, 239, 46, 50, 4, 50, 14, 149, 13, 89, 225, 123, 31, 91,
+			135, 172, 142, 103, 189, 46, 89, 56, 222, 248, 152, 77, 22, 235,
+			230, 99, 54, 89, 56, 223, 248, 152, 115, 168, 44, 47, 48, 89,
+			15, 190, 244, 188, 195, 193, 73, 139, 108, 43, 189, 195, 185, 133,
+			79, 139, 159, 179, 233, 226, 117, 230, 207, 57, 242, 96, 145, 33,
+			144, 81, 30, 50, 116, 123, 124, 239, 229, 117, 233, 110, 100, 118,
+			225, 187, 234, 101, 155, 46, 28, 76, 189, 108, 211, 133, 131, 202,
+			151, 157, 242, 144, 237, 160, 242, 227, 142, 119, 36, 56, 181, 22,
+			221, 13, 205, 47, 28, 84, 126, 220, 38, 12, 71, 65, 31, 119,
+			100, 88, 100, 8, 100, 12, 29, 54, 132, 251, 224, 210, 111, 61,
+			194, 27, 154, 225, 62, 242, 27, 104, 17, 134, 151, 143, 127, 102,
+			19, 238, 19, 200, 24, 58, 44, 239, 97, 194, 37, 223, 251, 69,
+			248, 32, 61, 106, 9, 17, 232, 86, 45, 60, 40, 12, 163, 208,
+			220, 204, 117, 144, 42, 245, 80, 193, 82, 145, 225, 32, 99, 115,
+			225, 120, 180, 36, 144, 177, 23, 155, 54, 164, 21, 121, 8, 116,
+			181, 31, 70, 225, 81, 82, 203, 35, 209, 139, 228, 150, 1, 157,
+			36, 239, 130, 119, 237, 208, 73, 42, 187, 123, 143, 193, 153, 252,
+			210, 231, 157, 59, 115, 173, 193, 123, 252, 237, 1, 38, 175, 43,
+			72, 36, 56, 181, 193, 66, 234, 220, 205, 173, 43, 255, 168, 35,
+			203, 198, 105, 11, 91, 221, 113, 57, 219, 106, 196, 51, 136, 13,
+			103, 32, 38, 6, 30, 194, 207, 207, 56, 233, 95, 145, 91, 219,
+			122, 205, 24, 128, 46, 190, 49, 44, 10, 151, 205, 171, 230, 153,
+			254, 102, 145, 157, 151, 63, 232, 200, 93, 93, 191, 179, 97, 40,
+			78, 27, 12, 229, 181, 132, 106, 91, 133, 93, 17, 171, 176, 43,
+			229, 191, 114, 229, 208, 186, 35, 195, 225, 209, 190, 95, 110, 166,
+			179, 196, 173, 168, 166, 195, 218, 157, 91, 221, 130, 13, 212, 84,
+			185, 204, 213, 204, 20, 21, 250, 55, 186, 15, 239, 196, 106, 10,
+			221, 43, 215, 151, 77, 237, 227, 28, 252, 19, 71, 150, 52, 57,
+			12, 134, 38, 120, 51, 90, 136, 9, 108, 209, 51, 179, 69, 231,
+			77, 47, 196, 254, 125, 132, 95, 105, 52, 111, 66, 253, 216, 0,
+			222, 98, 51, 125, 77, 177, 37, 79, 203, 82, 156, 206, 169, 130,
+			226, 182, 5, 251, 226, 116, 14, 169, 242, 71, 54, 203, 187, 215,
+			239, 210, 119, 157, 37, 252, 88, 14, 152, 129, 194, 229, 152, 6,
+			137, 156, 187, 211, 217, 48, 83, 141, 131, 109, 62, 179, 85, 215,
+			74, 73, 255, 105, 25, 52, 90, 233, 77, 242, 204, 172, 3, 219,
+			222, 228, 247, 13, 249, 222, 30, 90, 95, 223, 119, 199, 36, 89,
+			235, 101, 104, 249, 204, 158, 70, 43, 125, 8, 20, 218, 243, 115,
+			127, 65, 222, 5, 119, 87, 169, 137, 169, 155, 239, 237, 125, 141,
+			189, 156, 161, 122, 152, 192, 204, 64, 195, 78, 230, 193, 55, 29,
+			185, 181, 109, 28, 54, 194, 136, 103, 229, 96, 51, 107, 70, 181,
+			155, 24, 33, 189, 101, 21, 45, 5, 103, 246, 204, 236, 165, 79,
+			102, 90, 233, 131, 252, 129, 166, 233, 191, 73, 238, 45, 138, 183,
+			13, 112, 206, 46, 128, 118, 233, 178, 246, 224, 228, 254, 180, 60,
+			80, 20, 108, 165, 171, 41, 123, 68, 57, 208, 165, 111, 164, 113,
+			7, 237, 224, 63, 56, 114, 160, 125, 176, 95, 143, 208, 173, 167,
+			228, 110, 29, 215, 252, 102, 17, 57, 194, 132, 17, 157, 217, 169,
+			127, 189, 108, 126, 188, 60, 71, 216, 48, 19, 34, 27, 93, 199,
+			4, 239, 95, 61, 193, 23, 204, 71, 51, 118, 129, 224, 47, 29,
+			185, 181, 109, 118, 191, 103, 187, 2, 176, 214, 98, 148, 219, 115,
+			202, 240, 47, 103, 184, 52, 179, 125, 49, 202, 139, 169, 156, 105,
+			165, 121, 249, 79, 92, 25, 118, 74, 243, 107, 205, 72, 1, 166,
+			111, 191, 95, 62, 213, 93, 160, 63, 116, 251, 45, 163, 147, 136,
+			189, 250, 244, 209, 182, 67, 204, 191, 226, 200, 29, 93, 190, 250,
+			238, 75, 206, 147, 178, 143, 245, 142, 189, 222, 90, 245, 42, 240,
+			102, 62, 163, 191, 44, 127, 214, 145, 135, 214, 25, 4, 222, 127,
+			175, 117, 14, 168, 218, 131, 187, 132, 252, 236, 86, 77, 247, 253,
+			209, 191, 32, 75, 213, 70, 210, 4, 160, 121, 175, 187, 150, 179,
+			47, 212, 103, 42, 186, 192, 159, 207, 152, 130, 229, 111, 8, 185,
+			171, 235, 55, 254, 187, 100, 63, 14, 21, 173, 70, 124, 19, 71,
+			102, 94, 42, 111, 222, 32, 137, 138, 37, 107, 117, 222, 204, 22,
+			174, 16, 2, 216, 127, 187, 164, 128, 201, 92, 187, 234, 192, 125,
+			27, 174, 29, 37, 219, 234, 222, 60, 175, 179, 130, 231, 28, 185,
+			163, 11, 121, 255, 132, 220, 174, 123, 212, 92, 108, 196, 249, 98,
+			86, 155, 99, 249, 189, 141, 127, 184, 174, 243, 253, 243, 242, 64,
+			21, 115, 87, 109, 33, 234, 218, 205, 213, 5, 93, 42, 56, 104,
+			125, 244, 80, 71, 29, 193, 135, 28, 185, 125, 85, 75, 17, 224,
+			118, 41, 73, 111, 46, 39, 233, 92, 182, 204, 244, 55, 47, 37,
+			233, 19, 148, 225, 31, 147, 119, 81, 87, 86, 145, 26, 160, 236,
+			162, 133, 19, 114, 103, 49, 128, 214, 215, 216, 35, 156, 25, 223,
+			140, 135, 41, 81, 126, 86, 202, 253, 235, 49, 216, 119, 127, 205,
+			189, 179, 131, 201, 212, 194, 59, 211, 157, 13, 214, 106, 182, 205,
+			107, 237, 60, 246, 68, 27, 143, 245, 16, 143, 221, 123, 167, 149,
+			235, 113, 180, 89, 236, 121, 79, 110, 177, 168, 250, 187, 100, 111,
+			146, 223, 92, 138, 85, 252, 228, 210, 76, 79, 146, 191, 53, 38,
+			72, 171, 37, 179, 105, 104, 73, 112, 43, 222, 241, 139, 223, 208,
+			2, 72, 110, 255, 97, 25, 218, 108, 215, 181, 180, 160, 210, 54,
+			123, 222, 88, 93, 209, 187, 87, 171, 72, 74, 17, 188, 244, 218,
+			7, 247, 54, 202, 210, 255, 90, 181, 225, 6, 178, 164, 237, 140,
+			204, 232, 38, 221, 101, 51, 118, 239, 116, 51, 254, 78, 183, 213,
+			99, 242, 174, 110, 91, 106, 207, 204, 64, 145, 77, 179, 114, 64,
+			74, 163, 89, 65, 187, 197, 248, 111, 214, 106, 84, 30, 252, 162,
+			144, 155, 13, 155, 172, 197, 11, 163, 210, 239, 162, 208, 185, 164,
+			208, 109, 235, 80, 116, 115, 255, 136, 28, 160, 250, 139, 47, 213,
+			172, 111, 165, 92, 243, 89, 42, 149, 84, 40, 84, 111, 53, 201,
+			15, 223, 233, 36, 235, 214, 87, 218, 181, 191, 153, 173, 84, 61,
+			167, 242, 224, 215, 86, 235, 135, 235, 205, 241, 140, 220, 19, 71,
+			141, 90, 2, 214, 189, 227, 201, 222, 165, 139, 94, 125, 61, 39,
+			125, 234, 195, 174, 236, 183, 198, 34, 247, 127, 208, 145, 219, 104,
+			11, 183, 23, 244, 169, 219, 235, 58, 214, 231, 172, 237, 4, 167,
+			239, 176, 148, 137, 154, 253, 62, 57, 160, 163, 45, 169, 153, 241,
+			167, 110, 95, 85, 167, 178, 21, 156, 188, 163, 50, 223, 89, 92,
+			237, 127, 245, 179, 142, 2, 119, 63, 235, 189, 17, 88, 251, 141,
+			192, 218, 175, 115, 96, 237, 174, 238, 49, 25, 241, 173, 177, 221,
+			78, 17, 87, 219, 133, 35, 156, 115, 242, 146, 2, 116, 239, 219,
+			116, 194, 9, 238, 7, 168, 15, 222, 168, 115, 227, 206, 161, 153,
+			233, 201, 206, 59, 239, 234, 237, 139, 79, 59, 148, 54, 28, 88,
+			254, 132, 208, 72, 228, 33, 239, 240, 189, 193, 15, 9, 58, 90,
+			233, 151, 225, 172, 110, 132, 13, 198, 33, 243, 128, 196, 115, 237,
+			117, 142, 242, 237, 124, 146, 46, 200, 48, 79, 22, 210, 168, 6,
+			155, 53, 197, 42, 193, 88, 37, 93, 46, 96, 193, 209, 216, 20,
+			112, 15, 57, 158, 53, 100, 56, 135, 16, 114, 75, 73, 74, 77,
+			167, 71, 212, 160, 14, 52, 93, 8, 35, 69, 58, 199, 55, 180,
+			88, 16, 179, 43, 136, 174, 154, 212, 209, 204, 12, 254, 40, 8,
+			92, 169, 111, 192, 176, 176, 128, 234, 162, 201, 1, 36, 135, 222,
+			171, 207, 92, 189, 96, 110, 122, 155, 153, 241, 200, 195, 175, 101,
+			67, 118, 50, 106, 213, 161, 46, 135, 181, 199, 1, 125, 229, 14,
+			167, 28, 237, 210, 44, 68, 176, 117, 142, 20, 46, 195, 11, 214,
+			163, 92, 77, 150, 159, 155, 211, 146, 133, 107, 143, 230, 162, 193,
+			63, 242, 14, 161, 97, 144, 83, 39, 39, 219, 240, 220, 67, 165,
+			189, 22, 158, 123, 104, 223, 155, 117, 74, 248, 226, 240, 246, 83,
+			242, 231, 77, 208, 240, 17, 239, 248, 61, 193, 63, 116, 218, 166,
+			47, 47, 154, 184, 230, 220, 85, 228, 109, 134, 119, 221, 209, 133,
+			159, 188, 148, 198, 228, 198, 101, 124, 155, 47, 102, 203, 12, 86,
+			211, 180, 77, 127, 128, 80, 28, 41, 237, 214, 41, 215, 23, 35,
+			123, 222, 164, 83, 194, 23, 199, 183, 79, 73, 169, 240, 233, 99,
+			155, 78, 59, 6, 103, 62, 86, 58, 14, 188, 60, 112, 191, 190,
+			24, 247, 246, 4, 223, 183, 10, 36, 73, 87, 247, 234, 29, 65,
+			91, 231, 244, 200, 207, 170, 183, 220, 144, 53, 8, 237, 85, 160,
+			143, 199, 61, 147, 66, 240, 237, 45, 190, 78, 33, 220, 246, 174,
+			221, 242, 95, 59, 218, 29, 235, 41, 239, 52, 194, 148, 174, 142,
+			232, 165, 73, 217, 78, 202, 245, 189, 205, 124, 198, 111, 40, 218,
+			29, 239, 155, 50, 252, 192, 160, 152, 154, 68, 49, 34, 195, 242,
+			13, 60, 65, 191, 91, 199, 16, 107, 200, 190, 9, 153, 101, 87,
+			25, 54, 151, 147, 170, 6, 126, 108, 114, 29, 207, 23, 167, 188,
+			126, 157, 234, 245, 197, 169, 173, 101, 157, 66, 167, 134, 38, 116,
+			74, 248, 226, 212, 201, 83, 114, 140, 240, 212, 222, 189, 155, 30,
+			116, 130, 67, 171, 240, 162, 250, 150, 222, 196, 54, 209, 240, 232,
+			123, 75, 7, 228, 180, 70, 71, 159, 241, 246, 4, 167, 194, 105,
+			246, 55, 193, 21, 144, 7, 85, 27, 137, 65, 219, 18, 1, 148,
+			217, 50, 195, 173, 86, 168, 217, 51, 60, 49, 10, 53, 123, 134,
+			39, 70, 249, 44, 61, 179, 107, 183, 188, 165, 81, 179, 231, 188,
+			189, 65, 194, 176, 203, 186, 118, 251, 178, 33, 236, 165, 92, 11,
+			124, 121, 123, 236, 37, 60, 82, 158, 243, 182, 232, 20, 124, 102,
+			246, 239, 208, 41, 224, 122, 119, 239, 145, 191, 233, 24, 236, 173,
+			183, 63, 248, 188, 98, 29, 61, 77, 56, 50, 86, 194, 105, 132,
+			211, 71, 208, 1, 248, 15, 200, 184, 29, 230, 121, 137, 25, 229,
+			240, 106, 35, 158, 143, 27, 214, 39, 198, 49, 134, 169, 112, 56,
+			153, 47, 222, 229, 3, 221, 65, 2, 92, 182, 17, 68, 197, 29,
+			143, 210, 21, 111, 117, 100, 206, 197, 205, 40, 169, 169, 149, 13,
+			14, 85, 26, 169, 233, 188, 219, 227, 139, 139, 102, 122, 48, 7,
+			23, 183, 236, 209, 41, 96, 132, 131, 65, 90, 199, 174, 239, 61,
+			188, 233, 221, 142, 113, 57, 250, 112, 233, 132, 124, 12, 192, 21,
+			177, 201, 247, 222, 226, 221, 232, 9, 166, 67, 109, 126, 102, 160,
+			19, 227, 204, 129, 176, 80, 64, 88, 150, 215, 88, 222, 16, 176,
+			161, 182, 68, 75, 10, 30, 199, 77, 114, 1, 82, 16, 111, 233,
+			223, 41, 159, 119, 100, 47, 234, 7, 23, 62, 90, 58, 24, 60,
+			93, 32, 205, 110, 69, 136, 14, 133, 33, 96, 76, 85, 37, 140,
+			22, 226, 179, 147, 26, 5, 78, 43, 84, 29, 42, 165, 41, 49,
+			74, 223, 76, 25, 164, 184, 174, 40, 89, 90, 138, 231, 224, 238,
+			24, 1, 235, 201, 125, 3, 121, 202, 96, 105, 152, 103, 33, 161,
+			85, 6, 100, 159, 106, 12, 194, 103, 151, 182, 22, 105, 4, 208,
+			30, 8, 138, 52, 66, 104, 31, 184, 91, 222, 207, 141, 119, 124,
+			241, 120, 105, 50, 24, 235, 26, 84, 212, 106, 197, 48, 69, 198,
+			203, 147, 91, 241, 136, 69, 13, 188, 249, 120, 233, 80, 145, 70,
+			117, 229, 209, 34, 45, 124, 241, 248, 248, 132, 60, 195, 212, 92,
+			95, 92, 47, 141, 7, 39, 136, 26, 96, 110, 221, 105, 197, 239,
+			233, 66, 11, 14, 154, 175, 91, 180, 48, 205, 215, 203, 199, 139,
+			180, 240, 197, 245, 177, 138, 252, 88, 73, 187, 147, 173, 121, 135,
+			131, 159, 40, 133, 215, 55, 54, 205, 122, 150, 45, 135, 224, 113,
+			84, 93, 44, 126, 183, 16, 68, 83, 167, 194, 229, 56, 126, 106,
+			46, 90, 81, 17, 84, 71, 57, 112, 32, 47, 39, 73, 191, 162,
+			127, 140, 21, 211, 193, 83, 225, 100, 8, 252, 173, 150, 80, 186,
+			50, 194, 254, 109, 48, 231, 25, 0, 69, 245, 56, 205, 163, 102,
+			44, 141, 90, 216, 92, 169, 179, 134, 210, 136, 9, 240, 67, 34,
+			66, 209, 81, 40, 191, 60, 142, 83, 165, 22, 48, 209, 188, 34,
+			195, 107, 89, 209, 110, 82, 217, 219, 248, 143, 188, 107, 153, 232,
+			175, 217, 188, 233, 12, 134, 64, 22, 92, 198, 227, 134, 201, 193,
+			131, 184, 21, 144, 91, 138, 230, 226, 14, 86, 181, 106, 146, 109,
+			85, 181, 241, 171, 205, 174, 244, 254, 13, 231, 111, 19, 57, 131,
+			53, 159, 211, 166, 217, 249, 40, 131, 218, 226, 185, 112, 18, 163,
+			115, 26, 218, 213, 90, 141, 214, 148, 222, 110, 185, 67, 70, 33,
+			120, 201, 64, 19, 243, 165, 168, 86, 131, 108, 173, 199, 141, 36,
+			155, 11, 227, 148, 188, 237, 68, 205, 240, 237, 236, 213, 134, 35,
+			63, 2, 219, 20, 85, 241, 142, 111, 234, 148, 44, 104, 69, 134,
+			90, 146, 134, 55, 174, 95, 176, 156, 169, 156, 33, 127, 84, 68,
+			24, 103, 7, 51, 245, 195, 234, 203, 145, 81, 246, 27, 198, 144,
+			82, 106, 237, 114, 82, 171, 193, 62, 133, 89, 131, 143, 30, 94,
+			1, 186, 104, 171, 142, 182, 191, 157, 70, 140, 127, 54, 65, 104,
+			30, 106, 36, 232, 180, 174, 189, 210, 65, 253, 173, 89, 106, 255,
+			188, 22, 241, 130, 182, 236, 32, 62, 202, 212, 35, 94, 155, 169,
+			166, 200, 225, 156, 26, 42, 254, 26, 157, 2, 100, 248, 246, 99,
+			185, 249, 78, 147, 166, 157, 46, 225, 70, 113, 102, 212, 12, 239,
+			141, 150, 84, 107, 120, 10, 22, 178, 56, 151, 228, 52, 27, 157,
+			101, 34, 234, 187, 145, 138, 12, 31, 3, 99, 44, 39, 56, 192,
+			22, 189, 187, 222, 138, 115, 116, 175, 153, 117, 142, 131, 93, 181,
+			180, 187, 184, 138, 223, 181, 32, 71, 112, 25, 81, 99, 133, 69,
+			185, 146, 174, 109, 213, 142, 159, 33, 228, 107, 187, 15, 234, 148,
+			240, 69, 173, 60, 36, 255, 158, 118, 37, 93, 247, 206, 5, 239,
+			46, 2, 93, 234, 141, 175, 237, 8, 163, 143, 248, 90, 137, 34,
+			207, 67, 215, 173, 207, 149, 35, 32, 51, 55, 140, 187, 161, 24,
+			172, 248, 107, 69, 133, 147, 177, 74, 115, 107, 160, 104, 213, 77,
+			187, 33, 130, 235, 91, 79, 232, 148, 227, 139, 250, 232, 125, 58,
+			37, 124, 81, 191, 255, 172, 76, 8, 27, 217, 211, 218, 244, 199,
+			142, 19, 124, 127, 120, 141, 79, 77, 240, 155, 115, 11, 237, 110,
+			102, 197, 137, 40, 93, 192, 3, 47, 12, 126, 24, 181, 247, 206,
+			232, 183, 210, 232, 231, 170, 217, 16, 139, 173, 217, 165, 68, 43,
+			105, 120, 57, 211, 42, 29, 37, 37, 77, 64, 16, 47, 127, 71,
+			74, 26, 33, 40, 197, 50, 107, 1, 4, 160, 20, 203, 172, 164,
+			17, 126, 82, 44, 239, 218, 45, 95, 214, 113, 100, 197, 211, 222,
+			222, 224, 35, 206, 119, 77, 77, 83, 33, 207, 234, 89, 189, 85,
+			83, 3, 50, 111, 37, 216, 183, 20, 79, 162, 233, 16, 38, 237,
+			105, 214, 233, 40, 146, 172, 120, 154, 117, 58, 10, 36, 43, 158,
+			222, 189, 71, 62, 170, 161, 152, 239, 71, 100, 193, 7, 86, 171,
+			116, 119, 72, 153, 17, 152, 61, 84, 161, 73, 58, 72, 110, 209,
+			81, 121, 93, 129, 100, 48, 88, 224, 51, 159, 117, 188, 23, 157,
+			30, 11, 159, 249, 172, 211, 191, 79, 126, 196, 101, 144, 215, 38,
+			223, 251, 160, 83, 58, 24, 124, 192, 165, 246, 69, 11, 171, 118,
+			243, 74, 216, 77, 237, 49, 63, 143, 202, 112, 227, 42, 79, 219,
+			22, 114, 161, 145, 145, 101, 136, 94, 177, 216, 150, 41, 93, 141,
+			6, 176, 107, 39, 90, 27, 48, 32, 22, 193, 174, 232, 168, 175,
+			14, 19, 42, 98, 91, 252, 158, 168, 202, 186, 145, 105, 39, 217,
+			202, 10, 181, 192, 2, 177, 1, 117, 250, 65, 248, 51, 50, 25,
+			14, 50, 6, 138, 16, 214, 136, 86, 245, 65, 132, 176, 254, 140,
+			142, 240, 78, 142, 152, 74, 149, 224, 23, 156, 14, 72, 183, 182,
+			92, 243, 78, 181, 24, 161, 79, 80, 79, 216, 178, 14, 0, 29,
+			54, 253, 233, 180, 45, 11, 195, 26, 225, 55, 22, 201, 249, 114,
+			212, 88, 34, 118, 143, 242, 167, 70, 76, 101, 121, 135, 39, 189,
+			162, 10, 58, 88, 142, 134, 164, 141, 209, 118, 169, 195, 150, 243,
+			145, 211, 234, 47, 240, 174, 31, 178, 251, 11, 188, 235, 135, 156,
+			129, 145, 34, 131, 28, 75, 141, 142, 201, 127, 163, 121, 199, 69,
+			40, 237, 210, 137, 224, 227, 238, 237, 251, 75, 80, 121, 211, 50,
+			176, 129, 180, 80, 140, 223, 213, 1, 96, 66, 173, 180, 59, 169,
+			162, 205, 52, 71, 197, 103, 82, 15, 27, 28, 22, 207, 25, 154,
+			116, 6, 183, 43, 35, 162, 35, 93, 168, 154, 161, 4, 202, 247,
+			31, 217, 131, 237, 82, 152, 242, 129, 163, 69, 6, 133, 41, 31,
+			57, 46, 127, 71, 51, 151, 240, 189, 143, 58, 165, 137, 224, 11,
+			27, 101, 174, 174, 163, 187, 86, 167, 215, 29, 95, 53, 18, 237,
+			243, 117, 39, 35, 33, 215, 25, 10, 32, 143, 63, 106, 15, 5,
+			144, 199, 31, 117, 6, 78, 20, 25, 212, 243, 202, 184, 252, 77,
+			87, 163, 69, 95, 118, 188, 74, 240, 57, 215, 126, 104, 52, 219,
+			64, 244, 183, 112, 14, 46, 67, 103, 87, 218, 151, 121, 197, 156,
+			28, 115, 163, 167, 218, 145, 210, 73, 162, 85, 87, 70, 213, 241,
+			137, 213, 202, 181, 228, 93, 56, 12, 25, 121, 54, 156, 28, 209,
+			81, 22, 149, 35, 67, 20, 155, 79, 112, 70, 199, 95, 100, 227,
+			86, 22, 49, 51, 63, 116, 106, 73, 210, 246, 182, 145, 70, 119,
+			70, 134, 99, 230, 89, 6, 41, 21, 170, 38, 28, 22, 50, 19,
+			138, 17, 124, 86, 143, 27, 122, 19, 190, 112, 69, 91, 142, 17,
+			58, 7, 182, 121, 58, 39, 24, 114, 56, 128, 44, 181, 106, 205,
+			100, 236, 194, 149, 176, 217, 88, 249, 187, 217, 44, 230, 60, 231,
+			201, 48, 241, 40, 21, 226, 246, 101, 199, 235, 215, 201, 94, 36,
+			183, 6, 58, 73, 208, 232, 193, 17, 157, 36, 96, 244, 232, 24,
+			61, 164, 21, 194, 241, 123, 63, 225, 120, 255, 210, 193, 249, 188,
+			253, 58, 204, 114, 136, 30, 233, 65, 48, 103, 30, 92, 129, 129,
+			121, 163, 194, 0, 173, 155, 35, 32, 121, 62, 225, 244, 7, 242,
+			159, 43, 222, 199, 35, 122, 239, 147, 78, 233, 116, 240, 146, 195,
+			142, 145, 249, 22, 140, 53, 215, 249, 182, 87, 27, 234, 64, 102,
+			206, 62, 93, 130, 13, 73, 59, 148, 191, 58, 168, 197, 121, 241,
+			2, 72, 63, 18, 27, 53, 14, 58, 81, 25, 153, 121, 112, 19,
+			96, 148, 37, 137, 225, 180, 142, 220, 134, 171, 29, 232, 162, 104,
+			241, 161, 34, 195, 241, 189, 79, 58, 229, 137, 34, 67, 32, 227,
+			228, 41, 58, 89, 163, 143, 142, 239, 253, 146, 83, 58, 30, 28,
+			239, 250, 238, 90, 99, 169, 194, 2, 105, 101, 145, 131, 240, 254,
+			37, 167, 52, 80, 100, 80, 109, 119, 29, 41, 50, 4, 50, 134,
+			71, 228, 73, 38, 231, 250, 222, 191, 112, 74, 163, 193, 16, 145,
+			43, 110, 5, 135, 243, 17, 26, 141, 120, 174, 136, 198, 100, 106,
+			113, 61, 42, 181, 173, 200, 232, 69, 198, 246, 193, 34, 195, 65,
+			198, 254, 99, 69, 134, 64, 198, 241, 19, 242, 63, 169, 213, 235,
+			249, 222, 43, 142, 247, 230, 224, 87, 93, 237, 54, 55, 223, 240,
+			142, 97, 139, 22, 146, 104, 210, 142, 107, 188, 161, 85, 204, 151,
+			195, 88, 83, 217, 252, 60, 220, 152, 78, 96, 25, 95, 193, 117,
+			76, 251, 106, 155, 156, 168, 132, 15, 181, 26, 164, 71, 215, 138,
+			159, 33, 9, 139, 214, 234, 71, 60, 184, 200, 72, 171, 113, 120,
+			90, 159, 50, 243, 48, 90, 160, 135, 124, 240, 44, 211, 118, 228,
+			178, 116, 17, 101, 144, 5, 11, 55, 48, 252, 18, 118, 184, 194,
+			54, 221, 38, 33, 108, 159, 168, 250, 161, 149, 160, 176, 46, 175,
+			20, 11, 215, 235, 197, 224, 110, 213, 79, 132, 96, 154, 125, 197,
+			217, 127, 143, 78, 10, 36, 239, 59, 195, 170, 161, 235, 247, 126,
+			214, 241, 126, 219, 168, 134, 216, 130, 62, 11, 213, 144, 87, 157,
+			139, 85, 247, 185, 239, 161, 85, 71, 39, 64, 239, 115, 197, 170,
+			163, 67, 160, 247, 185, 98, 213, 81, 72, 33, 239, 115, 197, 170,
+			35, 118, 253, 194, 107, 91, 117, 46, 173, 186, 47, 20, 171, 78,
+			189, 108, 251, 66, 177, 234, 92, 90, 117, 95, 40, 86, 157, 139,
+			195, 192, 23, 239, 112, 213, 185, 180, 234, 190, 88, 172, 58, 151,
+			86, 221, 23, 139, 85, 231, 82, 55, 190, 88, 172, 58, 151, 86,
+			221, 23, 157, 227, 39, 228, 115, 130, 41, 11, 223, 251, 178, 83,
+			58, 18, 252, 79, 183, 235, 147, 48, 44, 184, 168, 83, 61, 120,
+			173, 42, 131, 236, 174, 132, 40, 149, 97, 148, 157, 196, 175, 86,
+			9, 180, 64, 56, 99, 237, 23, 198, 168, 131, 233, 96, 214, 1,
+			231, 45, 103, 178, 157, 116, 30, 14, 39, 149, 184, 130, 95, 208,
+			204, 124, 68, 135, 138, 37, 19, 32, 91, 241, 240, 107, 27, 27,
+			230, 176, 83, 164, 134, 153, 81, 88, 253, 138, 125, 18, 138, 37,
+			114, 172, 62, 176, 125, 0, 197, 44, 95, 188, 237, 3, 153, 119,
+			27, 72, 51, 51, 208, 119, 190, 236, 148, 250, 139, 12, 7, 25,
+			91, 245, 59, 28, 151, 54, 216, 47, 59, 67, 135, 229, 79, 244,
+			96, 161, 66, 87, 252, 35, 199, 27, 15, 126, 168, 39, 188, 222,
+			33, 205, 152, 104, 94, 24, 27, 173, 227, 254, 107, 86, 79, 204,
+			203, 225, 255, 87, 106, 137, 77, 21, 61, 187, 112, 37, 15, 35,
+			66, 76, 48, 128, 160, 213, 204, 116, 44, 200, 70, 108, 10, 142,
+			22, 111, 182, 35, 168, 67, 240, 5, 86, 3, 215, 212, 227, 168,
+			120, 26, 70, 221, 231, 10, 170, 97, 11, 102, 185, 176, 222, 200,
+			170, 113, 158, 235, 195, 55, 221, 76, 135, 234, 70, 22, 171, 83,
+			245, 96, 114, 66, 155, 135, 242, 202, 157, 111, 60, 220, 255, 191,
+			37, 27, 79, 143, 71, 76, 169, 55, 158, 158, 94, 36, 141, 198,
+			136, 199, 116, 127, 228, 12, 30, 215, 73, 129, 228, 88, 69, 246,
+			83, 32, 178, 222, 175, 59, 155, 254, 190, 235, 208, 243, 92, 108,
+			81, 95, 119, 74, 195, 242, 173, 20, 227, 107, 147, 239, 253, 137,
+			243, 122, 220, 217, 110, 213, 193, 197, 80, 159, 73, 58, 168, 126,
+			139, 175, 147, 2, 201, 93, 187, 233, 105, 48, 194, 220, 121, 223,
+			112, 188, 147, 252, 52, 184, 205, 110, 166, 65, 92, 185, 9, 48,
+			111, 72, 224, 169, 243, 55, 244, 64, 120, 174, 211, 139, 228, 214,
+			80, 39, 169, 210, 67, 21, 157, 20, 72, 78, 78, 209, 5, 181,
+			7, 227, 204, 55, 29, 239, 57, 183, 39, 184, 191, 61, 124, 95,
+			27, 241, 168, 217, 238, 131, 138, 29, 177, 232, 54, 233, 150, 144,
+			105, 231, 155, 78, 255, 65, 249, 144, 236, 69, 18, 219, 247, 171,
+			78, 105, 48, 184, 135, 58, 116, 231, 150, 59, 200, 35, 143, 45,
+			33, 175, 234, 109, 206, 99, 75, 200, 171, 206, 93, 187, 139, 12,
+			129, 140, 125, 129, 92, 102, 210, 42, 110, 251, 254, 96, 225, 175,
+			209, 134, 215, 118, 209, 170, 91, 130, 241, 255, 115, 167, 116, 151,
+			105, 26, 182, 228, 63, 119, 182, 237, 41, 50, 40, 136, 124, 48,
+			40, 127, 219, 225, 198, 186, 190, 247, 109, 167, 20, 254, 237, 184,
+			116, 213, 221, 196, 174, 241, 109, 123, 210, 192, 223, 223, 118, 238,
+			26, 44, 50, 4, 50, 238, 62, 40, 191, 234, 242, 64, 80, 144,
+			242, 210, 254, 224, 75, 202, 156, 211, 193, 104, 29, 160, 33, 134,
+			138, 88, 22, 104, 189, 223, 192, 241, 61, 23, 206, 89, 73, 160,
+			172, 133, 164, 169, 95, 220, 179, 91, 33, 232, 244, 186, 250, 17,
+			9, 205, 98, 41, 74, 231, 34, 184, 97, 24, 213, 224, 53, 189,
+			179, 113, 73, 204, 138, 222, 217, 113, 237, 182, 144, 98, 83, 176,
+			220, 108, 20, 58, 149, 218, 177, 152, 135, 32, 67, 17, 184, 63,
+			74, 87, 172, 214, 107, 11, 63, 223, 55, 240, 70, 18, 214, 27,
+			73, 214, 208, 26, 2, 239, 173, 81, 211, 170, 218, 120, 227, 40,
+			156, 84, 162, 9, 73, 126, 115, 46, 105, 52, 87, 218, 91, 166,
+			7, 28, 15, 89, 63, 224, 90, 188, 9, 75, 199, 7, 92, 139,
+			55, 177, 243, 127, 192, 13, 6, 73, 80, 246, 248, 189, 63, 226,
+			110, 122, 129, 5, 37, 68, 234, 143, 184, 165, 17, 121, 78, 122,
+			94, 15, 214, 246, 243, 174, 119, 127, 48, 17, 94, 47, 76, 196,
+			29, 96, 157, 46, 253, 100, 97, 209, 131, 155, 19, 239, 121, 151,
+			197, 86, 15, 41, 206, 207, 187, 91, 71, 116, 210, 65, 245, 199,
+			223, 164, 147, 2, 201, 51, 111, 150, 31, 132, 133, 190, 7, 156,
+			244, 97, 215, 59, 22, 188, 151, 136, 235, 247, 19, 6, 43, 165,
+			47, 34, 98, 156, 31, 155, 177, 153, 136, 38, 248, 222, 114, 184,
+			25, 225, 100, 148, 206, 39, 11, 173, 134, 86, 60, 98, 45, 126,
+			142, 229, 29, 206, 55, 245, 151, 90, 7, 87, 45, 195, 114, 255,
+			176, 235, 237, 211, 73, 106, 89, 80, 214, 73, 129, 228, 145, 163,
+			242, 131, 66, 186, 94, 175, 223, 251, 81, 119, 211, 63, 22, 78,
+			240, 109, 55, 188, 176, 118, 163, 59, 240, 72, 170, 185, 166, 147,
+			140, 9, 147, 22, 212, 137, 10, 216, 120, 39, 251, 66, 37, 188,
+			149, 68, 232, 152, 12, 45, 187, 117, 94, 233, 192, 132, 41, 87,
+			153, 69, 171, 48, 58, 100, 64, 210, 30, 77, 35, 181, 23, 243,
+			146, 100, 31, 168, 74, 167, 205, 101, 113, 67, 54, 121, 42, 132,
+			10, 112, 204, 224, 141, 88, 99, 166, 194, 224, 99, 220, 69, 218,
+			60, 2, 255, 168, 164, 220, 84, 227, 6, 42, 43, 84, 68, 211,
+			2, 48, 54, 32, 143, 11, 25, 65, 97, 199, 172, 158, 142, 221,
+			154, 130, 20, 67, 176, 30, 86, 179, 117, 7, 102, 99, 29, 64,
+			123, 9, 151, 186, 74, 92, 229, 236, 147, 3, 79, 222, 63, 234,
+			150, 14, 16, 47, 247, 130, 151, 127, 214, 117, 143, 49, 47, 179,
+			138, 165, 64, 132, 102, 216, 161, 30, 213, 235, 112, 217, 243, 86,
+			45, 33, 152, 9, 122, 137, 121, 127, 214, 117, 119, 233, 164, 131,
+			250, 118, 151, 117, 82, 32, 121, 228, 168, 252, 48, 252, 49, 246,
+			98, 211, 253, 164, 235, 254, 190, 43, 130, 255, 35, 214, 33, 56,
+			23, 55, 227, 42, 252, 66, 102, 105, 158, 228, 77, 229, 24, 150,
+			213, 71, 44, 250, 185, 112, 49, 89, 88, 132, 74, 73, 176, 68,
+			140, 169, 22, 118, 247, 157, 62, 114, 66, 127, 58, 2, 117, 21,
+			138, 9, 38, 113, 30, 192, 197, 136, 10, 90, 230, 91, 166, 159,
+			107, 165, 17, 247, 244, 73, 92, 8, 70, 222, 167, 213, 177, 229,
+			88, 174, 207, 203, 90, 218, 154, 22, 43, 60, 39, 187, 228, 237,
+			202, 17, 133, 242, 14, 14, 0, 79, 160, 57, 176, 129, 100, 243,
+			225, 48, 93, 26, 143, 132, 83, 140, 58, 195, 25, 42, 196, 33,
+			35, 110, 20, 119, 81, 186, 109, 74, 24, 203, 162, 141, 195, 147,
+			19, 44, 53, 49, 52, 147, 19, 234, 140, 55, 66, 3, 165, 207,
+			26, 186, 165, 210, 160, 29, 147, 148, 248, 48, 107, 229, 208, 5,
+			248, 80, 213, 222, 2, 146, 28, 107, 122, 169, 97, 95, 59, 169,
+			54, 4, 172, 199, 168, 29, 204, 168, 217, 135, 244, 166, 79, 186,
+			242, 128, 252, 223, 158, 236, 69, 26, 12, 249, 25, 183, 183, 28,
+			124, 195, 235, 176, 180, 91, 135, 186, 162, 133, 100, 209, 164, 176,
+			51, 128, 42, 204, 103, 173, 148, 132, 89, 20, 230, 181, 132, 174,
+			28, 150, 233, 49, 19, 202, 231, 201, 123, 227, 112, 114, 66, 175,
+			92, 214, 34, 164, 25, 86, 211, 47, 194, 61, 44, 36, 169, 13,
+			163, 172, 200, 240, 30, 108, 44, 17, 28, 210, 205, 21, 103, 13,
+			10, 100, 179, 138, 27, 44, 9, 66, 56, 129, 246, 198, 228, 186,
+			53, 18, 205, 137, 26, 212, 104, 61, 193, 43, 102, 124, 17, 73,
+			37, 111, 45, 105, 109, 135, 180, 25, 158, 29, 89, 76, 143, 98,
+			60, 58, 126, 97, 82, 151, 98, 196, 75, 153, 156, 100, 82, 166,
+			50, 53, 151, 120, 169, 147, 208, 201, 169, 98, 182, 108, 254, 48,
+			7, 16, 57, 153, 231, 179, 190, 121, 196, 133, 106, 219, 59, 182,
+			20, 55, 37, 251, 184, 210, 234, 43, 47, 84, 170, 56, 108, 33,
+			114, 34, 237, 53, 198, 77, 115, 124, 11, 103, 48, 154, 170, 201,
+			54, 6, 143, 82, 192, 230, 103, 227, 98, 226, 244, 210, 145, 122,
+			237, 24, 97, 202, 43, 111, 52, 140, 76, 147, 17, 170, 66, 31,
+			173, 140, 110, 167, 45, 12, 32, 160, 49, 196, 179, 173, 230, 170,
+			126, 128, 114, 94, 141, 106, 70, 75, 232, 101, 109, 251, 51, 110,
+			239, 150, 34, 195, 65, 70, 255, 129, 34, 67, 32, 35, 60, 36,
+			95, 237, 99, 134, 117, 124, 239, 247, 220, 222, 74, 240, 135, 125,
+			29, 12, 107, 189, 139, 234, 202, 188, 70, 147, 154, 151, 33, 159,
+			63, 180, 24, 210, 225, 172, 160, 252, 52, 248, 216, 172, 141, 246,
+			248, 189, 144, 23, 220, 93, 125, 52, 69, 136, 60, 236, 50, 141,
+			100, 97, 33, 110, 116, 112, 240, 201, 219, 115, 176, 213, 100, 35,
+			26, 105, 121, 145, 171, 227, 102, 214, 170, 46, 118, 99, 85, 89,
+			40, 22, 115, 49, 80, 229, 28, 154, 169, 129, 179, 63, 188, 158,
+			225, 166, 174, 30, 193, 95, 87, 102, 55, 74, 73, 229, 201, 137,
+			137, 35, 90, 102, 179, 206, 162, 70, 134, 207, 217, 144, 147, 205,
+			6, 194, 190, 130, 239, 138, 77, 65, 154, 66, 32, 9, 52, 84,
+			13, 17, 183, 162, 5, 189, 102, 204, 237, 70, 84, 35, 172, 59,
+			142, 51, 120, 134, 33, 59, 167, 71, 247, 181, 130, 85, 65, 167,
+			119, 179, 2, 152, 231, 71, 57, 194, 89, 231, 243, 75, 224, 246,
+			111, 197, 225, 92, 22, 231, 225, 163, 143, 93, 15, 83, 126, 74,
+			49, 27, 3, 238, 15, 201, 240, 32, 182, 165, 102, 155, 41, 40,
+			175, 198, 41, 68, 111, 248, 196, 229, 43, 87, 204, 100, 53, 219,
+			116, 29, 222, 175, 10, 66, 216, 67, 178, 86, 3, 151, 32, 120,
+			212, 129, 170, 47, 169, 53, 113, 38, 60, 247, 80, 72, 255, 221,
+			31, 94, 181, 255, 227, 39, 35, 234, 255, 119, 181, 165, 30, 111,
+			155, 64, 158, 254, 181, 90, 136, 142, 117, 109, 229, 25, 171, 17,
+			231, 174, 134, 27, 104, 69, 103, 59, 186, 180, 100, 248, 209, 202,
+			249, 74, 120, 49, 105, 196, 85, 189, 5, 243, 226, 199, 92, 36,
+			13, 115, 234, 137, 172, 225, 194, 49, 66, 203, 220, 124, 101, 105,
+			9, 240, 110, 2, 254, 85, 194, 7, 19, 99, 112, 173, 197, 243,
+			77, 12, 160, 122, 21, 84, 133, 241, 66, 22, 192, 196, 240, 24,
+			128, 32, 141, 99, 93, 54, 59, 132, 221, 210, 34, 0, 150, 231,
+			223, 179, 133, 4, 142, 185, 191, 231, 246, 143, 20, 25, 2, 25,
+			163, 99, 242, 126, 233, 121, 189, 80, 217, 191, 234, 186, 67, 65,
+			133, 84, 118, 122, 200, 118, 7, 26, 22, 212, 236, 175, 186, 238,
+			14, 157, 164, 218, 118, 222, 173, 147, 2, 201, 67, 101, 249, 223,
+			96, 175, 236, 197, 141, 224, 171, 174, 251, 211, 66, 4, 191, 211,
+			179, 38, 57, 173, 95, 217, 234, 83, 167, 170, 164, 31, 242, 1,
+			206, 132, 128, 107, 44, 133, 163, 156, 143, 161, 241, 220, 90, 215,
+			67, 182, 4, 214, 7, 72, 178, 121, 233, 99, 105, 97, 161, 228,
+			163, 99, 17, 34, 140, 116, 4, 213, 44, 86, 183, 181, 100, 227,
+			61, 130, 2, 125, 89, 203, 98, 84, 174, 181, 17, 81, 45, 90,
+			176, 233, 51, 152, 222, 59, 32, 8, 107, 181, 46, 52, 26, 225,
+			155, 88, 103, 91, 206, 26, 136, 113, 150, 205, 119, 242, 66, 174,
+			31, 40, 129, 146, 150, 200, 157, 90, 68, 197, 8, 84, 253, 106,
+			101, 54, 110, 46, 3, 107, 10, 109, 137, 5, 89, 91, 181, 252,
+			146, 170, 218, 130, 83, 54, 224, 154, 215, 208, 165, 186, 111, 172,
+			100, 245, 166, 77, 124, 62, 170, 130, 127, 172, 141, 136, 123, 169,
+			9, 97, 165, 52, 244, 158, 171, 78, 56, 180, 168, 213, 243, 36,
+			181, 227, 23, 195, 20, 167, 181, 168, 177, 160, 132, 154, 246, 178,
+			41, 25, 175, 139, 113, 53, 102, 81, 123, 251, 214, 187, 55, 95,
+			76, 65, 158, 102, 48, 173, 52, 178, 91, 120, 80, 70, 236, 135,
+			46, 215, 227, 6, 78, 113, 144, 201, 164, 229, 42, 217, 143, 35,
+			81, 4, 143, 212, 225, 173, 172, 214, 90, 162, 11, 2, 115, 99,
+			192, 138, 35, 214, 220, 171, 174, 28, 148, 63, 216, 67, 251, 176,
+			3, 197, 241, 89, 225, 237, 11, 254, 187, 82, 28, 1, 198, 91,
+			106, 45, 173, 30, 6, 51, 217, 216, 169, 8, 132, 160, 153, 180,
+			131, 73, 48, 208, 211, 8, 158, 137, 37, 217, 152, 27, 93, 147,
+			99, 152, 175, 101, 120, 98, 124, 140, 217, 167, 56, 8, 172, 82,
+			47, 193, 230, 56, 173, 163, 122, 19, 35, 146, 159, 93, 21, 141,
+			53, 4, 88, 85, 137, 17, 85, 147, 148, 247, 172, 17, 46, 208,
+			190, 202, 81, 239, 138, 55, 252, 93, 91, 40, 187, 159, 73, 12,
+			20, 131, 154, 178, 106, 247, 38, 74, 116, 166, 72, 154, 9, 222,
+			31, 206, 161, 1, 77, 45, 78, 186, 206, 32, 15, 164, 125, 192,
+			85, 115, 137, 153, 228, 179, 176, 154, 208, 124, 148, 21, 29, 128,
+			120, 115, 58, 1, 225, 64, 164, 109, 249, 133, 84, 88, 103, 246,
+			186, 112, 27, 108, 64, 180, 55, 174, 30, 24, 58, 167, 44, 47,
+			70, 77, 40, 8, 171, 135, 40, 106, 88, 26, 36, 27, 76, 48,
+			62, 122, 158, 38, 39, 38, 214, 211, 157, 148, 220, 167, 215, 48,
+			222, 179, 130, 221, 188, 245, 50, 240, 224, 89, 33, 119, 22, 25,
+			2, 25, 123, 246, 202, 167, 153, 107, 225, 53, 84, 120, 119, 7,
+			181, 53, 152, 182, 187, 44, 212, 15, 58, 105, 154, 138, 157, 185,
+			16, 241, 149, 112, 106, 35, 13, 198, 86, 246, 156, 221, 96, 44,
+			171, 231, 132, 220, 87, 100, 192, 143, 169, 216, 127, 64, 126, 217,
+			227, 22, 187, 190, 247, 83, 194, 59, 28, 124, 174, 125, 157, 89,
+			251, 140, 121, 110, 186, 70, 227, 240, 246, 2, 109, 171, 55, 50,
+			68, 218, 231, 61, 190, 11, 239, 178, 248, 224, 243, 29, 226, 253,
+			83, 7, 140, 40, 157, 168, 76, 144, 36, 157, 172, 76, 84, 100,
+			56, 81, 153, 152, 12, 135, 39, 143, 140, 172, 215, 243, 240, 60,
+			160, 112, 29, 142, 45, 194, 233, 71, 47, 82, 11, 187, 249, 177,
+			48, 228, 233, 228, 3, 22, 231, 189, 144, 206, 137, 107, 29, 125,
+			30, 205, 154, 236, 122, 20, 7, 13, 156, 128, 138, 75, 41, 80,
+			89, 9, 135, 79, 67, 235, 197, 159, 234, 246, 57, 92, 86, 234,
+			200, 172, 5, 161, 163, 69, 168, 116, 224, 37, 134, 113, 2, 229,
+			59, 117, 250, 136, 61, 222, 201, 188, 52, 26, 172, 6, 69, 211,
+			145, 54, 75, 171, 113, 33, 130, 117, 40, 30, 248, 74, 47, 246,
+			234, 172, 213, 172, 102, 8, 59, 26, 81, 84, 138, 177, 16, 33,
+			202, 32, 140, 201, 173, 44, 160, 75, 225, 217, 240, 244, 196, 17,
+			220, 70, 2, 242, 217, 246, 211, 40, 249, 153, 68, 86, 30, 87,
+			51, 108, 207, 244, 57, 218, 55, 140, 6, 174, 140, 216, 197, 8,
+			83, 210, 164, 39, 143, 103, 195, 169, 211, 71, 10, 62, 132, 193,
+			252, 167, 132, 103, 101, 56, 200, 216, 114, 176, 200, 16, 200, 40,
+			15, 201, 183, 73, 215, 235, 243, 123, 95, 20, 155, 158, 245, 156,
+			224, 82, 88, 216, 241, 140, 248, 182, 230, 136, 5, 209, 6, 238,
+			113, 96, 27, 131, 87, 198, 23, 69, 233, 176, 60, 47, 61, 175,
+			15, 59, 202, 75, 226, 53, 99, 175, 177, 79, 245, 145, 72, 120,
+			73, 240, 45, 88, 31, 157, 39, 95, 18, 124, 11, 214, 71, 226,
+			224, 37, 177, 107, 183, 252, 167, 48, 238, 246, 65, 83, 252, 121,
+			241, 55, 25, 127, 173, 122, 1, 149, 244, 231, 133, 183, 69, 39,
+			169, 217, 253, 59, 116, 82, 32, 201, 16, 236, 62, 160, 46, 62,
+			33, 94, 55, 8, 118, 31, 185, 200, 253, 68, 49, 162, 24, 179,
+			79, 8, 134, 96, 247, 17, 246, 226, 19, 34, 24, 148, 13, 34,
+			14, 156, 151, 240, 14, 5, 115, 225, 101, 122, 26, 75, 198, 156,
+			176, 17, 43, 10, 218, 118, 80, 68, 23, 182, 108, 130, 164, 162,
+			203, 66, 104, 161, 113, 237, 71, 180, 229, 200, 86, 56, 77, 3,
+			113, 213, 240, 73, 225, 221, 165, 147, 48, 113, 137, 109, 251, 117,
+			146, 154, 116, 48, 36, 32, 80, 31, 44, 162, 159, 18, 222, 239,
+			10, 5, 4, 234, 35, 131, 216, 167, 68, 255, 30, 186, 205, 235,
+			131, 5, 194, 247, 126, 89, 148, 246, 6, 11, 109, 200, 17, 187,
+			141, 237, 13, 194, 169, 19, 167, 216, 60, 214, 49, 192, 148, 77,
+			168, 192, 0, 37, 185, 145, 84, 56, 233, 180, 245, 137, 247, 134,
+			62, 182, 133, 252, 178, 96, 172, 68, 31, 219, 66, 126, 89, 108,
+			221, 81, 100, 8, 100, 236, 222, 35, 51, 110, 171, 227, 123, 159,
+			22, 165, 99, 193, 52, 77, 245, 82, 244, 158, 206, 205, 76, 11,
+			169, 108, 150, 226, 254, 42, 91, 93, 186, 178, 90, 243, 154, 218,
+			31, 94, 36, 159, 241, 137, 53, 109, 28, 179, 218, 106, 35, 246,
+			175, 79, 11, 198, 175, 246, 241, 81, 236, 211, 98, 160, 92, 100,
+			8, 100, 28, 57, 42, 255, 163, 195, 141, 116, 125, 239, 21, 81,
+			58, 25, 252, 74, 39, 148, 215, 54, 8, 116, 179, 215, 88, 138,
+			148, 50, 207, 72, 126, 16, 223, 197, 70, 211, 102, 177, 226, 155,
+			115, 24, 79, 98, 61, 17, 172, 25, 22, 7, 95, 101, 145, 83,
+			39, 33, 210, 249, 194, 28, 198, 150, 28, 220, 159, 82, 212, 114,
+			22, 227, 19, 86, 255, 177, 22, 94, 177, 251, 143, 213, 240, 138,
+			24, 168, 20, 25, 192, 157, 137, 201, 41, 185, 77, 117, 31, 12,
+			247, 89, 81, 250, 178, 216, 172, 63, 33, 150, 251, 172, 216, 118,
+			64, 94, 151, 37, 245, 9, 152, 238, 223, 137, 254, 131, 193, 121,
+			62, 196, 180, 31, 84, 88, 242, 181, 111, 214, 122, 185, 106, 125,
+			87, 123, 109, 151, 219, 229, 102, 93, 107, 15, 85, 187, 221, 206,
+			114, 144, 229, 7, 118, 150, 64, 214, 129, 187, 229, 61, 166, 57,
+			142, 239, 125, 94, 244, 223, 23, 28, 222, 0, 20, 206, 38, 8,
+			57, 245, 121, 209, 127, 196, 206, 162, 186, 142, 158, 178, 179, 4,
+			178, 222, 116, 175, 60, 109, 8, 186, 190, 247, 235, 162, 127, 98,
+			99, 72, 177, 162, 38, 96, 197, 126, 93, 244, 239, 180, 179, 122,
+			145, 181, 235, 160, 157, 229, 32, 43, 60, 97, 103, 9, 100, 85,
+			198, 229, 175, 184, 166, 13, 194, 247, 190, 36, 250, 143, 174, 198,
+			247, 91, 188, 73, 108, 217, 5, 237, 87, 140, 198, 119, 19, 67,
+			22, 94, 53, 23, 111, 252, 41, 105, 35, 22, 140, 6, 182, 119,
+			242, 20, 130, 197, 50, 105, 213, 77, 22, 109, 35, 143, 181, 52,
+			152, 93, 225, 3, 73, 5, 254, 232, 155, 29, 117, 87, 163, 84,
+			197, 115, 135, 106, 212, 54, 15, 128, 121, 125, 169, 157, 209, 112,
+			221, 251, 37, 225, 31, 178, 179, 104, 132, 15, 31, 145, 255, 217,
+			49, 131, 238, 249, 222, 111, 137, 254, 67, 193, 103, 148, 112, 32,
+			39, 71, 214, 208, 23, 178, 96, 253, 65, 255, 155, 50, 16, 240,
+			240, 253, 91, 237, 3, 1, 208, 207, 111, 9, 127, 191, 157, 37,
+			144, 117, 48, 148, 207, 105, 25, 41, 124, 239, 43, 144, 145, 183,
+			194, 25, 109, 108, 107, 95, 237, 108, 88, 79, 210, 182, 118, 86,
+			108, 68, 213, 212, 4, 141, 20, 238, 56, 193, 82, 132, 148, 200,
+			235, 81, 97, 8, 153, 132, 248, 196, 133, 83, 71, 213, 150, 124,
+			3, 54, 254, 43, 130, 193, 151, 125, 124, 141, 255, 21, 177, 253,
+			238, 34, 195, 65, 198, 193, 66, 224, 225, 26, 255, 43, 16, 120,
+			117, 236, 175, 88, 145, 127, 32, 188, 3, 193, 236, 157, 40, 0,
+			70, 181, 126, 45, 219, 63, 112, 192, 127, 32, 188, 173, 58, 233,
+			32, 57, 176, 87, 39, 5, 146, 131, 251, 121, 251, 119, 252, 222,
+			175, 10, 239, 7, 61, 189, 253, 99, 255, 250, 170, 232, 223, 37,
+			159, 161, 153, 32, 171, 198, 215, 176, 253, 47, 181, 111, 255, 133,
+			242, 127, 135, 155, 63, 84, 132, 185, 56, 94, 138, 231, 54, 160,
+			4, 168, 19, 237, 215, 10, 37, 64, 157, 104, 191, 86, 40, 1,
+			228, 229, 193, 251, 154, 216, 189, 71, 62, 201, 45, 6, 168, 76,
+			148, 142, 4, 83, 93, 87, 80, 163, 149, 142, 209, 201, 160, 224,
+			36, 173, 12, 108, 112, 215, 87, 167, 214, 175, 23, 187, 158, 58,
+			181, 126, 93, 12, 132, 69, 134, 64, 198, 208, 97, 249, 119, 184,
+			81, 174, 239, 253, 169, 40, 29, 10, 46, 119, 109, 84, 167, 81,
+			1, 75, 176, 163, 145, 171, 52, 20, 67, 11, 123, 240, 159, 218,
+			173, 193, 30, 252, 167, 98, 96, 127, 145, 33, 144, 113, 48, 148,
+			223, 80, 235, 203, 193, 38, 252, 77, 81, 250, 75, 177, 57, 248,
+			93, 71, 163, 45, 187, 61, 220, 104, 95, 25, 235, 191, 223, 176,
+			142, 156, 171, 10, 98, 237, 105, 20, 46, 89, 90, 72, 149, 33,
+			32, 40, 72, 182, 95, 33, 73, 165, 121, 68, 102, 56, 40, 44,
+			92, 54, 111, 142, 149, 5, 190, 2, 11, 92, 111, 252, 121, 180,
+			20, 135, 245, 168, 89, 93, 204, 99, 141, 96, 163, 190, 2, 26,
+			39, 182, 221, 45, 223, 74, 82, 214, 97, 112, 28, 212, 139, 55,
+			175, 167, 94, 108, 76, 175, 112, 88, 175, 120, 181, 144, 114, 14,
+			235, 21, 175, 22, 122, 133, 195, 122, 197, 171, 226, 192, 221, 242,
+			37, 199, 52, 196, 241, 189, 191, 16, 253, 231, 131, 231, 149, 184,
+			215, 142, 222, 214, 80, 49, 218, 7, 213, 2, 211, 52, 237, 178,
+			93, 17, 248, 176, 147, 106, 40, 65, 123, 53, 199, 242, 246, 225,
+			111, 235, 25, 20, 152, 191, 40, 20, 24, 135, 21, 152, 191, 16,
+			71, 207, 218, 89, 2, 89, 15, 76, 179, 2, 227, 40, 5, 230,
+			91, 119, 168, 192, 56, 172, 192, 124, 171, 80, 96, 28, 86, 96,
+			190, 85, 40, 48, 14, 43, 48, 223, 42, 20, 24, 135, 21, 152,
+			111, 137, 202, 184, 252, 175, 204, 226, 200, 120, 191, 87, 58, 25,
+			252, 166, 211, 246, 212, 164, 189, 243, 119, 244, 226, 132, 76, 253,
+			81, 179, 216, 59, 149, 0, 95, 237, 204, 240, 59, 120, 154, 178,
+			38, 42, 216, 220, 135, 88, 43, 31, 187, 211, 251, 61, 179, 59,
+			57, 244, 118, 235, 253, 222, 246, 131, 69, 6, 222, 7, 123, 161,
+			222, 157, 40, 14, 162, 247, 126, 111, 114, 106, 182, 183, 222, 200,
+			154, 217, 201, 255, 59, 0, 121, 114, 173, 143, 179, 162, 3, 0,
+		},
 	)
 }
 
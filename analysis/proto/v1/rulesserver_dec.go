@@ -107,3 +107,20 @@ func (s *DecoratedRules) LookupBug(ctx context.Context, req *LookupBugRequest) (
 	}
 	return
 }
+
+func (s *DecoratedRules) Preview(ctx context.Context, req *PreviewRuleRequest) (rsp *PreviewRuleResponse, err error) {
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "Preview", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		rsp, err = s.Service.Preview(ctx, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "Preview", rsp, err)
+	}
+	return
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"go.chromium.org/luci/analysis/internal/bugs"
+	"go.chromium.org/luci/analysis/internal/bugs/github"
 	configpb "go.chromium.org/luci/analysis/proto/config"
 	pb "go.chromium.org/luci/analysis/proto/v1"
 )
@@ -51,6 +52,26 @@ func createAssociatedBugPB(b bugs.BugID, cfg *configpb.ProjectConfig) *pb.Associ
 	case bugs.BuganizerSystem:
 		linkText = fmt.Sprintf("b/%s", b.ID)
 		url = fmt.Sprintf("https://issuetracker.google.com/issues/%s", b.ID)
+	case bugs.GitHubSystem:
+		repository, number, err := b.GitHubRepositoryAndNumber()
+		if err != nil {
+			// Fallback to basic name and blank URL.
+			break
+		}
+		linkText = fmt.Sprintf("%s#%s", repository, number)
+		hostname := cfg.BugManagement.GetGithub().GetHostname()
+		if hostname == "" {
+			hostname = github.DefaultHostname
+		}
+		url = bugs.GitHubIssueURL(hostname, b.ID)
+	case bugs.JiraSystem:
+		linkText = b.ID
+		jiraCfg := cfg.BugManagement.GetJira()
+		if jiraCfg == nil {
+			// The Jira instance is not known.
+			break
+		}
+		url = bugs.JiraIssueURL(jiraCfg.Hostname, b.ID)
 	default:
 		// Fallback.
 	}
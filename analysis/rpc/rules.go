@@ -18,12 +18,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/grpc/appstatus"
@@ -34,6 +36,7 @@ import (
 	bugspb "go.chromium.org/luci/analysis/internal/bugs/proto"
 	"go.chromium.org/luci/analysis/internal/clustering"
 	"go.chromium.org/luci/analysis/internal/clustering/rules"
+	"go.chromium.org/luci/analysis/internal/clustering/rules/preview"
 	"go.chromium.org/luci/analysis/internal/config/compiledcfg"
 	"go.chromium.org/luci/analysis/internal/perms"
 	configpb "go.chromium.org/luci/analysis/proto/config"
@@ -42,13 +45,16 @@ import (
 
 // Rules implements pb.RulesServer.
 type rulesServer struct {
+	// previewSource provides the failures rules are previewed against.
+	previewSource preview.Source
 }
 
-// NewRulesSever returns a new pb.RulesServer.
-func NewRulesSever() pb.RulesServer {
+// NewRulesSever returns a new pb.RulesServer. Rules are previewed
+// against failures read from the given source.
+func NewRulesSever(previewSource preview.Source) pb.RulesServer {
 	return &pb.DecoratedRules{
 		Prelude:  checkAllowedPrelude,
-		Service:  &rulesServer{},
+		Service:  &rulesServer{previewSource: previewSource},
 		Postlude: gRPCifyAndLogPostlude,
 	}
 }
@@ -472,6 +478,80 @@ func (*rulesServer) LookupBug(ctx context.Context, req *pb.LookupBugRequest) (*p
 	}, nil
 }
 
+// Previews a draft rule against recent failures.
+func (s *rulesServer) Preview(ctx context.Context, req *pb.PreviewRuleRequest) (*pb.PreviewRuleResponse, error) {
+	project, err := parseProjectName(req.Parent)
+	if err != nil {
+		return nil, invalidArgumentError(errors.Annotate(err, "parent").Err())
+	}
+	if err := validatePreviewRuleRequest(req); err != nil {
+		return nil, invalidArgumentError(err)
+	}
+	if err := perms.VerifyProjectPermissions(ctx, project, perms.PermGetCluster, perms.PermExpensiveClusterQueries, perms.PermGetRule); err != nil {
+		return nil, err
+	}
+
+	days := int(req.Days)
+	if days == 0 {
+		days = defaultPreviewDays
+	}
+	sampleSize := int(req.SampleSize)
+	if sampleSize == 0 {
+		sampleSize = defaultPreviewSampleSize
+	}
+	opts := preview.Options{
+		Project:    project,
+		Rule:       req.RuleDefinition,
+		Since:      clock.Now(ctx).Add(-time.Duration(days) * 24 * time.Hour),
+		SampleSize: sampleSize,
+	}
+	opts.Realms, err = perms.QueryRealmsNonEmpty(ctx, project, nil, perms.ListTestResultsAndExonerations...)
+	if err != nil {
+		// If the user has permission in no realms, QueryRealmsNonEmpty
+		// will return an appstatus error PERMISSION_DENIED.
+		return nil, err
+	}
+
+	result, err := preview.Run(ctx, s.previewSource, opts)
+	if err != nil {
+		if preview.InvalidRuleTag.In(err) {
+			return nil, invalidArgumentError(errors.Annotate(err, "rule_definition").Err())
+		}
+		// This will result in an internal error being reported to the caller.
+		return nil, errors.Annotate(err, "preview rule").Err()
+	}
+
+	return preview.ToResponse(project, result), nil
+}
+
+const (
+	// defaultPreviewDays is the default number of days of failures
+	// to preview a rule against.
+	defaultPreviewDays = 1
+	// maxPreviewDays is the maximum number of days of failures
+	// to preview a rule against.
+	maxPreviewDays = 7
+	// defaultPreviewSampleSize is the default number of sample failures
+	// returned by a rule preview.
+	defaultPreviewSampleSize = 10
+	// maxPreviewSampleSize is the maximum number of sample failures
+	// returned by a rule preview.
+	maxPreviewSampleSize = 100
+)
+
+func validatePreviewRuleRequest(req *pb.PreviewRuleRequest) error {
+	if req.RuleDefinition == "" {
+		return errors.New("rule_definition: unspecified")
+	}
+	if req.Days < 0 || req.Days > maxPreviewDays {
+		return errors.Reason("days: must be between 0 and %v", maxPreviewDays).Err()
+	}
+	if req.SampleSize < 0 || req.SampleSize > maxPreviewSampleSize {
+		return errors.Reason("sample_size: must be between 0 and %v", maxPreviewSampleSize).Err()
+	}
+	return nil
+}
+
 func createRulePB(r *rules.Entry, cfg *configpb.ProjectConfig, mask ruleMask) *pb.Rule {
 	definition := ""
 	if mask.IncludeDefinition {
@@ -555,6 +635,31 @@ func validateBugAgainstConfig(cfg *compiledcfg.ProjectConfig, bug bugs.BugID) er
 		}
 	case bugs.BuganizerSystem:
 		// Buganizer bugs are permitted for all LUCI Analysis projects.
+	case bugs.GitHubSystem:
+		repository, _, err := bug.GitHubRepositoryAndNumber()
+		if err != nil {
+			return err
+		}
+		githubCfg := cfg.Config.BugManagement.GetGithub()
+		if githubCfg == nil {
+			return fmt.Errorf("github bug system not enabled for this LUCI project")
+		}
+		expectedRepository := fmt.Sprintf("%s/%s", githubCfg.Owner, githubCfg.Repository)
+		if !strings.EqualFold(repository, expectedRepository) {
+			return fmt.Errorf("bug not in expected github repository (%s)", expectedRepository)
+		}
+	case bugs.JiraSystem:
+		projectKey, err := bug.JiraProjectKey()
+		if err != nil {
+			return err
+		}
+		jiraCfg := cfg.Config.BugManagement.GetJira()
+		if jiraCfg == nil {
+			return fmt.Errorf("jira bug system not enabled for this LUCI project")
+		}
+		if projectKey != jiraCfg.ProjectKey {
+			return fmt.Errorf("bug not in expected jira project (%s)", jiraCfg.ProjectKey)
+		}
 	default:
 		return fmt.Errorf("unsupported bug system: %s", bug.System)
 	}
@@ -15,6 +15,7 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -24,7 +25,9 @@ import (
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/resultdb/rdbperms"
 	"go.chromium.org/luci/server/auth"
 	"go.chromium.org/luci/server/auth/authtest"
 	"go.chromium.org/luci/server/secrets"
@@ -35,7 +38,9 @@ import (
 	bugspb "go.chromium.org/luci/analysis/internal/bugs/proto"
 	"go.chromium.org/luci/analysis/internal/clustering"
 	"go.chromium.org/luci/analysis/internal/clustering/algorithms/testname"
+	cpb "go.chromium.org/luci/analysis/internal/clustering/proto"
 	"go.chromium.org/luci/analysis/internal/clustering/rules"
+	"go.chromium.org/luci/analysis/internal/clustering/rules/preview"
 	"go.chromium.org/luci/analysis/internal/config"
 	"go.chromium.org/luci/analysis/internal/perms"
 	"go.chromium.org/luci/analysis/internal/testutil"
@@ -62,7 +67,8 @@ func TestRules(t *testing.T) {
 		// Provides datastore implementation needed for project config.
 		ctx = memory.Use(ctx)
 
-		srv := NewRulesSever()
+		previewSource := &fakePreviewSource{}
+		srv := NewRulesSever(previewSource)
 
 		ruleManagedBuilder := rules.NewRule(0).
 			WithProject(testProject).
@@ -923,6 +929,114 @@ func TestRules(t *testing.T) {
 				})
 			})
 		})
+		Convey("Preview", func() {
+			now := time.Date(2040, time.January, 1, 0, 0, 0, 0, time.UTC)
+			ctx, _ := testclock.UseTime(ctx, now)
+
+			authState.IdentityPermissions = listTestResultsPermissions("testproject:realm1")
+			authState.IdentityPermissions = append(authState.IdentityPermissions, []authtest.RealmPermission{
+				{
+					Realm:      "testproject:@project",
+					Permission: perms.PermGetCluster,
+				},
+				{
+					Realm:      "testproject:@project",
+					Permission: perms.PermExpensiveClusterQueries,
+				},
+				{
+					Realm:      "testproject:@project",
+					Permission: perms.PermGetRule,
+				},
+			}...)
+
+			newFailure := func(realm, reason string) *cpb.Failure {
+				return &cpb.Failure{
+					PartitionTime: timestamppb.New(now.Add(-time.Hour)),
+					Realm:         realm,
+					TestId:        "ninja://test_a",
+					Variant:       &pb.Variant{Def: map[string]string{"k": "v"}},
+					FailureReason: &pb.FailureReason{PrimaryErrorMessage: reason},
+				}
+			}
+			previewSource.chunks = []*preview.Chunk{
+				{
+					Failures: []*cpb.Failure{
+						newFailure("testproject:realm1", "timeout"),
+						newFailure("testproject:realm1", "crash"),
+						// Caller does not have access to this realm.
+						newFailure("testproject:realm2", "timeout"),
+					},
+					Clusters: [][]clustering.ClusterID{
+						{{Algorithm: "rules-v2", ID: ruleManaged.RuleID}},
+						{},
+						{},
+					},
+				},
+			}
+			request := &pb.PreviewRuleRequest{
+				Parent:         "projects/testproject",
+				RuleDefinition: `reason LIKE "%timeout%"`,
+			}
+
+			Convey("No permission to get cluster", func() {
+				authState.IdentityPermissions = removePermission(authState.IdentityPermissions, perms.PermGetCluster)
+
+				response, err := srv.Preview(ctx, request)
+				So(err, ShouldBeRPCPermissionDenied, "caller does not have permission analysis.clusters.get")
+				So(response, ShouldBeNil)
+			})
+			Convey("No permission to perform expensive queries", func() {
+				authState.IdentityPermissions = removePermission(authState.IdentityPermissions, perms.PermExpensiveClusterQueries)
+
+				response, err := srv.Preview(ctx, request)
+				So(err, ShouldBeRPCPermissionDenied, "caller does not have permission analysis.clusters.expensiveQueries")
+				So(response, ShouldBeNil)
+			})
+			Convey("No permission to list test results", func() {
+				authState.IdentityPermissions = removePermission(authState.IdentityPermissions, rdbperms.PermListTestResults)
+
+				response, err := srv.Preview(ctx, request)
+				So(err, ShouldBeRPCPermissionDenied, "caller does not have permissions [resultdb.testResults.list resultdb.testExonerations.list] in any realm in project \"testproject\"")
+				So(response, ShouldBeNil)
+			})
+			Convey("Invalid rule", func() {
+				request.RuleDefinition = `reason LIKE`
+
+				response, err := srv.Preview(ctx, request)
+				So(err, ShouldBeRPCInvalidArgument, "rule_definition: parse rule")
+				So(response, ShouldBeNil)
+			})
+			Convey("Invalid days", func() {
+				request.Days = 8
+
+				response, err := srv.Preview(ctx, request)
+				So(err, ShouldBeRPCInvalidArgument, "days: must be between 0 and 7")
+				So(response, ShouldBeNil)
+			})
+			Convey("Valid", func() {
+				response, err := srv.Preview(ctx, request)
+				So(err, ShouldBeNil)
+				So(response.FailuresScanned, ShouldEqual, 2)
+				So(response.Truncated, ShouldBeFalse)
+				So(response.Metrics["failures"], ShouldEqual, 1)
+				So(response.DistinctTests, ShouldEqual, 1)
+				So(response.OverlappingRules, ShouldResembleProto, []*pb.PreviewRuleResponse_OverlappingRule{
+					{
+						Rule:     fmt.Sprintf("projects/testproject/rules/%s", ruleManaged.RuleID),
+						Failures: 1,
+					},
+				})
+				So(response.SampleFailures, ShouldResembleProto, []*pb.PreviewRuleResponse_SampleFailure{
+					{
+						TestId:        "ninja://test_a",
+						Variant:       &pb.Variant{Def: map[string]string{"k": "v"}},
+						FailureReason: &pb.FailureReason{PrimaryErrorMessage: "timeout"},
+						Realm:         "testproject:realm1",
+						PartitionTime: timestamppb.New(now.Add(-time.Hour)),
+					},
+				})
+			})
+		})
 	})
 	Convey("createRulePB", t, func() {
 		// The behaviour of createRulePB is assumed by many test cases.
@@ -1056,3 +1170,19 @@ func removeGroup(groups []string, group string) []string {
 	}
 	return result
 }
+
+// fakePreviewSource is a preview.Source which provides chunks
+// held in memory.
+type fakePreviewSource struct {
+	chunks []*preview.Chunk
+}
+
+// ReadChunks implements preview.Source.
+func (s *fakePreviewSource) ReadChunks(ctx context.Context, project string, since time.Time, f func(*preview.Chunk) error) error {
+	for _, c := range s.chunks {
+		if err := f(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
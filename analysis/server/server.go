@@ -35,9 +35,12 @@ import (
 	"go.chromium.org/luci/analysis/internal/analysis"
 	"go.chromium.org/luci/analysis/internal/bugs/buganizer"
 	bugscron "go.chromium.org/luci/analysis/internal/bugs/cron"
+	"go.chromium.org/luci/analysis/internal/bugs/github"
+	"go.chromium.org/luci/analysis/internal/bugs/jira"
 	cpbq "go.chromium.org/luci/analysis/internal/changepoints/bqexporter"
 	"go.chromium.org/luci/analysis/internal/clustering/reclustering/orchestrator"
 	"go.chromium.org/luci/analysis/internal/clustering/rules"
+	"go.chromium.org/luci/analysis/internal/clustering/rules/preview"
 	"go.chromium.org/luci/analysis/internal/config"
 	"go.chromium.org/luci/analysis/internal/failureattributes"
 	"go.chromium.org/luci/analysis/internal/metrics"
@@ -76,6 +79,8 @@ func Main(init func(srv *luciserver.Server) error) {
 		scopedauth.NewModuleFromFlags(),
 		tq.NewModuleFromFlags(),
 		buganizer.NewModuleFromFlags(),
+		github.NewModuleFromFlags(),
+		jira.NewModuleFromFlags(),
 	}
 	luciserver.Main(nil, modules, func(srv *luciserver.Server) error {
 		// Register pPRC servers.
@@ -94,7 +99,7 @@ func Main(init func(srv *luciserver.Server) error) {
 		analysispb.RegisterClustersServer(srv, rpc.NewClustersServer(ac))
 		analysispb.RegisterMetricsServer(srv, rpc.NewMetricsServer())
 		analysispb.RegisterProjectsServer(srv, rpc.NewProjectsServer())
-		analysispb.RegisterRulesServer(srv, rpc.NewRulesSever())
+		analysispb.RegisterRulesServer(srv, rpc.NewRulesSever(preview.NewStoredSource()))
 		analysispb.RegisterTestVariantsServer(srv, rpc.NewTestVariantsServer())
 		analysispb.RegisterTestHistoryServer(srv, rpc.NewTestHistoryServer())
 		analysispb.RegisterBuganizerTesterServer(srv, rpc.NewBuganizerTesterServer())
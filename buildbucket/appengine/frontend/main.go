@@ -47,6 +47,7 @@ import (
 
 	"go.chromium.org/luci/buildbucket/appengine/internal/buildcron"
 	"go.chromium.org/luci/buildbucket/appengine/internal/buildercron"
+	"go.chromium.org/luci/buildbucket/appengine/internal/buildstream"
 	"go.chromium.org/luci/buildbucket/appengine/internal/clients"
 	"go.chromium.org/luci/buildbucket/appengine/internal/config"
 	"go.chromium.org/luci/buildbucket/appengine/internal/metrics"
@@ -152,6 +153,14 @@ func main() {
 			p.HackFixFieldMasksForJSON = true
 		})
 
+		// Deliver build updates to WatchBuilds streams. It requires Redis to
+		// receive the updates from the processes which commit them.
+		if redisconn.GetPool(srv.Context) != nil {
+			hub := buildstream.NewHub()
+			srv.Context = buildstream.WithHub(srv.Context, hub)
+			srv.RunInBackground("bb.buildstream", hub.Run)
+		}
+
 		pb.RegisterBuildsServer(srv, rpc.NewBuilds())
 		pb.RegisterBuildersServer(srv, rpc.NewBuilders())
 
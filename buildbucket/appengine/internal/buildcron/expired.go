@@ -82,6 +82,10 @@ func expireBuilds(ctx context.Context, bs []*model.Build, mr parallel.MultiRunne
 				workC <- func() error {
 					return tasks.FinalizeResultDB(ctx, &taskdefs.FinalizeResultDBGo{BuildId: b.ID})
 				}
+				workC <- func() error { return tasks.MaybeRetryBuild(ctx, b) }
+				if b.HasDependents {
+					workC <- func() error { return tasks.TriggerDependents(ctx, b.ID) }
+				}
 			}
 			workC <- func() error { return datastore.Put(ctx, toUpdate) }
 			workC <- func() error {
@@ -0,0 +1,250 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package buildstream delivers build updates to WatchBuilds streams.
+//
+// Build updates are published to a Redis channel after they are committed (see
+// Publish). Each frontend process runs a single Hub which subscribes to this
+// channel and fans the updates out to the WatchBuilds streams served by the
+// process.
+//
+// Delivery is best effort: updates published while a Hub is reconnecting to
+// Redis are lost.
+package buildstream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/server/redisconn"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+)
+
+const (
+	// channel is the Redis channel builds are published to.
+	channel = "buildbucket.v2.builds"
+
+	// subscriptionBuffer is how many builds a slow subscriber may lag behind
+	// before it is dropped.
+	subscriptionBuffer = 1000
+
+	// pingInterval is how often the Hub pings Redis to detect dead
+	// connections. receiveTimeout must be larger.
+	pingInterval   = time.Minute
+	receiveTimeout = 2 * pingInterval
+
+	// maxRetryDelay limits the delay between attempts to resubscribe.
+	maxRetryDelay = 30 * time.Second
+)
+
+// Publish sends the build to all Hubs.
+//
+// The build is expected to be stripped of large fields, since it is sent as
+// a single Redis message. Returns redisconn.ErrNotConfigured if Redis isn't
+// configured.
+func Publish(ctx context.Context, b *pb.Build) error {
+	blob, err := proto.Marshal(b)
+	if err != nil {
+		return errors.Annotate(err, "failed to marshal build %d", b.Id).Err()
+	}
+
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Do("PUBLISH", channel, blob); err != nil {
+		return errors.Annotate(err, "failed to publish build %d", b.Id).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// Hub receives published builds and fans them out to its subscribers.
+type Hub struct {
+	m    sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+// NewHub returns a Hub without subscribers.
+//
+// It doesn't receive anything until Run is called.
+func NewHub() *Hub {
+	return &Hub{subs: map[*Subscription]struct{}{}}
+}
+
+// Subscription receives builds published after it was created.
+type Subscription struct {
+	hub *Hub
+	c   chan *pb.Build
+}
+
+// Subscribe creates a new subscription.
+//
+// It must be closed when no longer needed.
+func (h *Hub) Subscribe() *Subscription {
+	s := &Subscription{hub: h, c: make(chan *pb.Build, subscriptionBuffer)}
+	h.m.Lock()
+	h.subs[s] = struct{}{}
+	h.m.Unlock()
+	return s
+}
+
+// Subscribers returns the number of active subscriptions.
+func (h *Hub) Subscribers() int {
+	h.m.Lock()
+	defer h.m.Unlock()
+	return len(h.subs)
+}
+
+// C returns the channel with the published builds.
+//
+// The builds are shared by all subscribers and must not be modified.
+//
+// The channel is closed if the subscriber lagged too far behind and some
+// builds were dropped, or when the subscription is closed.
+func (s *Subscription) C() <-chan *pb.Build {
+	return s.c
+}
+
+// Close stops the subscription.
+func (s *Subscription) Close() {
+	s.hub.m.Lock()
+	defer s.hub.m.Unlock()
+	s.hub.removeLocked(s)
+}
+
+func (h *Hub) removeLocked(s *Subscription) {
+	if _, ok := h.subs[s]; ok {
+		delete(h.subs, s)
+		close(s.c)
+	}
+}
+
+// dispatch sends the build to all subscribers.
+func (h *Hub) dispatch(b *pb.Build) {
+	h.m.Lock()
+	defer h.m.Unlock()
+	for s := range h.subs {
+		select {
+		case s.c <- b:
+		default:
+			h.removeLocked(s)
+		}
+	}
+}
+
+// Run receives published builds until the context is done.
+//
+// Resubscribes to the Redis channel on errors.
+func (h *Hub) Run(ctx context.Context) {
+	delay := time.Second
+	for ctx.Err() == nil {
+		err := h.receive(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case errors.Is(err, redisconn.ErrNotConfigured):
+			logging.Warningf(ctx, "Redis is not configured, not receiving build updates")
+			return
+		case err != nil:
+			logging.Errorf(ctx, "Failed to receive build updates, retrying in %s: %s", delay, err)
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, maxRetryDelay)
+	}
+}
+
+// receive subscribes to the Redis channel and dispatches the received builds
+// until the context is done or the connection fails.
+func (h *Hub) receive(ctx context.Context) error {
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		return err
+	}
+	psc := redis.PubSubConn{Conn: conn}
+	defer psc.Close()
+
+	if err := psc.Subscribe(channel); err != nil {
+		return errors.Annotate(err, "failed to subscribe").Err()
+	}
+
+	// Redis connections allow one concurrent writer and one concurrent reader.
+	// This goroutine is the writer. It must exit before the connection is
+	// closed.
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	defer func() {
+		close(done)
+		<-stopped
+	}()
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(pingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				psc.Unsubscribe()
+				return
+			case <-ticker.C:
+				psc.Ping("")
+			}
+		}
+	}()
+
+	for {
+		switch v := psc.ReceiveWithTimeout(receiveTimeout).(type) {
+		case redis.Message:
+			b := &pb.Build{}
+			if err := proto.Unmarshal(v.Data, b); err != nil {
+				logging.Errorf(ctx, "Skipping malformed build update: %s", err)
+				continue
+			}
+			h.dispatch(b)
+		case redis.Subscription:
+			if v.Count == 0 {
+				return nil // unsubscribed, the context is done
+			}
+		case error:
+			return errors.Annotate(v, "failed to receive").Err()
+		}
+	}
+}
+
+var hubKey = "holds the *buildstream.Hub"
+
+// WithHub installs the Hub into the context.
+func WithHub(ctx context.Context, h *Hub) context.Context {
+	return context.WithValue(ctx, &hubKey, h)
+}
+
+// GetHub returns the Hub installed in the context or nil if there is none.
+func GetHub(ctx context.Context) *Hub {
+	h, _ := ctx.Value(&hubKey).(*Hub)
+	return h
+}
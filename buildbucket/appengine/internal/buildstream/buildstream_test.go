@@ -0,0 +1,120 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package buildstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/server/redisconn"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestBuildStream(t *testing.T) {
+	t.Parallel()
+
+	Convey("Hub", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		h := NewHub()
+
+		Convey("without Redis", func() {
+			So(Publish(ctx, &pb.Build{Id: 1}), ShouldEqual, redisconn.ErrNotConfigured)
+
+			done := make(chan struct{})
+			go func() {
+				h.Run(ctx)
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(10 * time.Second):
+				t.Fatal("Run didn't return")
+			}
+		})
+
+		Convey("with Redis", func() {
+			s, err := miniredis.Run()
+			So(err, ShouldBeNil)
+			defer s.Close()
+
+			ctx = redisconn.UsePool(ctx, &redis.Pool{
+				Dial: func() (redis.Conn, error) {
+					return redis.Dial("tcp", s.Addr())
+				},
+			})
+
+			done := make(chan struct{})
+			go func() {
+				h.Run(ctx)
+				close(done)
+			}()
+			for s.PubSubNumSub(channel)[channel] == 0 {
+				time.Sleep(time.Millisecond)
+			}
+
+			sub1 := h.Subscribe()
+			defer sub1.Close()
+			sub2 := h.Subscribe()
+			defer sub2.Close()
+
+			So(Publish(ctx, &pb.Build{Id: 1, Status: pb.Status_STARTED}), ShouldBeNil)
+			So(<-sub1.C(), ShouldResembleProto, &pb.Build{Id: 1, Status: pb.Status_STARTED})
+			So(<-sub2.C(), ShouldResembleProto, &pb.Build{Id: 1, Status: pb.Status_STARTED})
+
+			cancel()
+			<-done
+		})
+
+		Convey("drops slow subscribers", func() {
+			slow := h.Subscribe()
+			fast := h.Subscribe()
+			defer fast.Close()
+
+			for i := 0; i <= subscriptionBuffer; i++ {
+				h.dispatch(&pb.Build{Id: int64(i)})
+				<-fast.C()
+			}
+
+			n := 0
+			for range slow.C() {
+				n++
+			}
+			So(n, ShouldEqual, subscriptionBuffer)
+			So(h.Subscribers(), ShouldEqual, 1)
+
+			// Closing a dropped subscription is fine.
+			slow.Close()
+		})
+
+		Convey("Close", func() {
+			sub := h.Subscribe()
+			sub.Close()
+			_, ok := <-sub.C()
+			So(ok, ShouldBeFalse)
+			So(h.Subscribers(), ShouldEqual, 0)
+		})
+	})
+}
@@ -886,6 +886,32 @@ func validateBuilderCfg(ctx *validation.Context, b *pb.BuilderConfig, wellKnownE
 
 		ctx.Exit()
 	}
+
+	// retry_policy
+	if b.RetryPolicy != nil {
+		ctx.Enter("retry_policy")
+		validateRetryPolicy(ctx, b.RetryPolicy)
+		ctx.Exit()
+	}
+}
+
+func validateRetryPolicy(ctx *validation.Context, p *pb.BuilderConfig_RetryPolicy) {
+	for i, c := range p.Conditions {
+		ctx.Enter("conditions #%d", i)
+		if c.Status != pb.Status_FAILURE && c.Status != pb.Status_INFRA_FAILURE {
+			ctx.Errorf("status: must be FAILURE or INFRA_FAILURE")
+		}
+		ctx.Exit()
+	}
+	if p.MaxAttempts < 2 || p.MaxAttempts > 10 {
+		ctx.Errorf("max_attempts: must be in [2, 10] range; got %d", p.MaxAttempts)
+	}
+	switch {
+	case p.Backoff != nil && p.Backoff.AsDuration() < 0:
+		ctx.Errorf("backoff: must not be negative")
+	case p.MaxBackoff != nil && p.MaxBackoff.AsDuration() < p.Backoff.AsDuration():
+		ctx.Errorf("max_backoff: must not be less than backoff")
+	}
 }
 
 func validateBuilderRecipe(ctx *validation.Context, recipe *pb.BuilderConfig_Recipe) {
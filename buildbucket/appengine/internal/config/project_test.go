@@ -1079,6 +1079,47 @@ func TestValidateProject(t *testing.T) {
 			So(ve.Errors[1].Error(), ShouldContainSubstring, "(default) execution_timeout_secs 10800 + expiration_secs 172800 exceeds max build completion time 172800")
 			So(ve.Errors[2].Error(), ShouldContainSubstring, "execution_timeout_secs 172800 + expiration_secs 172800 exceeds max build completion time 172800")
 		})
+
+		Convey("retry_policy", func() {
+			content := `
+				builders {
+					name: "ok"
+					swarming_host: "example.com"
+					exe {
+						cipd_package: "infra/executable/foo"
+						cipd_version: "refs/heads/main"
+					}
+					retry_policy {
+						conditions { status: INFRA_FAILURE }
+						conditions { status: FAILURE timeout: YES }
+						max_attempts: 3
+						backoff { seconds: 60 }
+						keep_build_number: true
+					}
+				}
+				builders {
+					name: "bad"
+					swarming_host: "example.com"
+					exe {
+						cipd_package: "infra/executable/foo"
+						cipd_version: "refs/heads/main"
+					}
+					retry_policy {
+						conditions { status: CANCELED }
+						max_attempts: 11
+						backoff { seconds: 60 }
+						max_backoff { seconds: 30 }
+					}
+				}
+			`
+			validateProjectSwarming(vctx, toBBSwarmingCfg(content), wellKnownExperiments, "", nil)
+			ve, ok := vctx.Finalize().(*validation.Error)
+			So(ok, ShouldEqual, true)
+			So(len(ve.Errors), ShouldEqual, 3)
+			So(ve.Errors[0].Error(), ShouldContainSubstring, "(swarming / builders #1 - bad / retry_policy / conditions #0): status: must be FAILURE or INFRA_FAILURE")
+			So(ve.Errors[1].Error(), ShouldContainSubstring, "max_attempts: must be in [2, 10] range; got 11")
+			So(ve.Errors[2].Error(), ShouldContainSubstring, "max_backoff: must not be less than backoff")
+		})
 	})
 
 	Convey("validate dimensions", t, func() {
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -480,6 +481,55 @@ func (q *Query) filterEntries(ctx context.Context, entries []*model.TagIndexEntr
 	return preprocessed, nil
 }
 
+// Match reports whether the build satisfies the query.
+//
+// It is used to filter individual builds in memory, e.g. streamed build
+// updates. The build must have at least the fields of
+// model.Build.ToSimpleBuildProto populated. Doesn't check permissions.
+func (q *Query) Match(b *pb.Build) bool {
+	switch {
+	case q.Builder.GetProject() != "" && q.Builder.Project != b.Builder.GetProject():
+		return false
+	case q.Builder.GetBucket() != "" && q.Builder.Bucket != b.Builder.GetBucket():
+		return false
+	case q.Builder.GetBuilder() != "" && q.Builder.Builder != b.Builder.GetBuilder():
+		return false
+	case q.Status == pb.Status_ENDED_MASK && !protoutil.IsEnded(b.Status):
+		return false
+	case q.Status != pb.Status_STATUS_UNSPECIFIED && q.Status != pb.Status_ENDED_MASK && q.Status != b.Status:
+		return false
+	case q.CreatedBy != "" && string(q.CreatedBy) != b.CreatedBy:
+		return false
+	case q.DescendantOf != 0 && !slices.Contains(b.AncestorIds, q.DescendantOf):
+		return false
+	case q.ChildOf != 0 && (len(b.AncestorIds) == 0 || b.AncestorIds[len(b.AncestorIds)-1] != q.ChildOf):
+		return false
+	}
+
+	idLow, idHigh := q.idRange()
+	if (idLow != 0 && b.Id < idLow) || (idHigh != 0 && b.Id >= idHigh) {
+		return false
+	}
+
+	tags := protoutil.StringPairMap(b.Tags)
+	for k, vals := range q.Tags {
+		for _, v := range vals {
+			if !tags.Contains(k, v) {
+				return false
+			}
+		}
+	}
+
+	// Build protos list only enabled experiments.
+	enabled := stringset.NewFromSlice(b.GetInput().GetExperiments()...)
+	matches := true
+	q.ExperimentFilters.Iter(func(filter string) bool {
+		matches = (filter[0] == '+') == enabled.Has(filter[1:])
+		return matches
+	})
+	return matches
+}
+
 // idRange computes the id range from q.BuildIdLow/q.BuildIdHigh, q.StartTime/q.EndTime and q.StartCursor.
 // Returning 0 means no boundary.
 func (q *Query) idRange() (idLow, idHigh int64) {
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/auth/identity"
@@ -165,6 +166,84 @@ func TestNewSearchQuery(t *testing.T) {
 	})
 }
 
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	Convey("Match", t, func() {
+		build := &pb.Build{
+			Id: 100,
+			Builder: &pb.BuilderID{
+				Project: "project",
+				Bucket:  "bucket",
+				Builder: "builder",
+			},
+			Status:      pb.Status_SUCCESS,
+			CreatedBy:   "user:user@example.com",
+			Tags:        []*pb.StringPair{{Key: "buildset", Value: "commit/git/1234"}},
+			AncestorIds: []int64{1, 2},
+			Input:       &pb.Build_Input{Experiments: []string{"luci.buildbucket.exp"}},
+		}
+		match := func(pr *pb.BuildPredicate) bool {
+			return NewQuery(&pb.SearchBuildsRequest{Predicate: pr}).Match(build)
+		}
+
+		Convey("empty predicate", func() {
+			So(NewQuery(&pb.SearchBuildsRequest{}).Match(build), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{}), ShouldBeTrue)
+		})
+
+		Convey("builder", func() {
+			So(match(&pb.BuildPredicate{Builder: &pb.BuilderID{Project: "project"}}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{Builder: proto.Clone(build.Builder).(*pb.BuilderID)}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{Builder: &pb.BuilderID{Project: "project", Bucket: "other"}}), ShouldBeFalse)
+			So(match(&pb.BuildPredicate{Builder: &pb.BuilderID{Project: "project", Bucket: "bucket", Builder: "other"}}), ShouldBeFalse)
+		})
+
+		Convey("status", func() {
+			So(match(&pb.BuildPredicate{Status: pb.Status_SUCCESS}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{Status: pb.Status_ENDED_MASK}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{Status: pb.Status_FAILURE}), ShouldBeFalse)
+			build.Status = pb.Status_STARTED
+			So(match(&pb.BuildPredicate{Status: pb.Status_ENDED_MASK}), ShouldBeFalse)
+		})
+
+		Convey("created_by", func() {
+			So(match(&pb.BuildPredicate{CreatedBy: "user@example.com"}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{CreatedBy: "other@example.com"}), ShouldBeFalse)
+		})
+
+		Convey("tags and gerrit changes", func() {
+			So(match(&pb.BuildPredicate{Tags: []*pb.StringPair{{Key: "buildset", Value: "commit/git/1234"}}}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{Tags: []*pb.StringPair{{Key: "buildset", Value: "commit/git/5678"}}}), ShouldBeFalse)
+			So(match(&pb.BuildPredicate{GerritChanges: []*pb.GerritChange{{Host: "h", Change: 1, Patchset: 1}}}), ShouldBeFalse)
+		})
+
+		Convey("ancestors", func() {
+			So(match(&pb.BuildPredicate{DescendantOf: 1}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{DescendantOf: 3}), ShouldBeFalse)
+			So(match(&pb.BuildPredicate{ChildOf: 2}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{ChildOf: 1}), ShouldBeFalse)
+		})
+
+		Convey("build range", func() {
+			So(match(&pb.BuildPredicate{Build: &pb.BuildRange{StartBuildId: 100, EndBuildId: 99}}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{Build: &pb.BuildRange{StartBuildId: 99}}), ShouldBeFalse)
+			So(match(&pb.BuildPredicate{Build: &pb.BuildRange{EndBuildId: 102}}), ShouldBeFalse)
+		})
+
+		Convey("experiments", func() {
+			So(match(&pb.BuildPredicate{Experiments: []string{"+luci.buildbucket.exp"}}), ShouldBeTrue)
+			So(match(&pb.BuildPredicate{Experiments: []string{"-luci.buildbucket.exp"}}), ShouldBeFalse)
+			So(match(&pb.BuildPredicate{Canary: pb.Trinary_YES}), ShouldBeFalse)
+			So(match(&pb.BuildPredicate{Canary: pb.Trinary_NO}), ShouldBeTrue)
+
+			build.Input.Experiments = append(build.Input.Experiments, bb.ExperimentNonProduction)
+			So(match(&pb.BuildPredicate{}), ShouldBeFalse)
+			So(match(&pb.BuildPredicate{IncludeExperimental: true}), ShouldBeTrue)
+		})
+	})
+}
+
 func TestFixPageSize(t *testing.T) {
 	t.Parallel()
 
@@ -165,6 +165,23 @@ type Build struct {
 	// the direct chain of ancestors from root to this build.
 	AncestorIds []int64 `gae:"ancestor_ids"`
 
+	// IDs of the builds this build depends on.
+	// Computed from Proto.DependsOn in order to find the dependents of a build.
+	DependsOn []int64 `gae:"depends_on"`
+
+	// Set once all dependencies of the build have ended with their conditions
+	// met and its task creation is enqueued.
+	// Buildbucket uses this to create the task only once.
+	DependenciesMet bool `gae:"dependencies_met,noindex"`
+
+	// Set if other builds depend on this build, so they must be triggered when
+	// this build ends.
+	HasDependents bool `gae:"has_dependents,noindex"`
+
+	// RetryPolicy is the retry policy of the builder at the time the build
+	// was created. Used to retry the build automatically when it ends.
+	RetryPolicy *pb.BuilderConfig_RetryPolicy `gae:"retry_policy,legacy"`
+
 	// Id of the first StartBuildTask call Buildbucket receives for the build.
 	// Buildbucket uses this to deduplicate the other StartBuildTask calls.
 	StartBuildTaskRequestID string `gae:"start_task_request_id,noindex"`
@@ -341,6 +358,11 @@ func (b *Build) Save(withMeta bool) (datastore.PropertyMap, error) {
 		b.ParentID = b.Proto.AncestorIds[len(b.Proto.AncestorIds)-1]
 	}
 
+	b.DependsOn = nil
+	for _, dep := range b.Proto.DependsOn {
+		b.DependsOn = append(b.DependsOn, dep.BuildId)
+	}
+
 	p, err := datastore.GetPLS(b).Save(withMeta)
 	if err != nil {
 		return nil, err
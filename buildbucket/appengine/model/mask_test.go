@@ -433,6 +433,7 @@ func TestBuildMask(t *testing.T) {
 			"status_details",
 			"can_outlive_parent",
 			"ancestor_ids",
+			"depends_on",
 		}
 		So(BuildFieldsWithVisibility(pb.BuildFieldVisibility_BUILDS_LIST_PERMISSION), ShouldResemble, expectedFields)
 	})
@@ -455,6 +456,7 @@ func TestBuildMask(t *testing.T) {
 			"infra.resultdb",
 			"can_outlive_parent",
 			"ancestor_ids",
+			"depends_on",
 		}
 		So(BuildFieldsWithVisibility(pb.BuildFieldVisibility_BUILDS_GET_LIMITED_PERMISSION), ShouldResemble, expectedFields)
 	})
@@ -15,6 +15,8 @@
 package rpc
 
 import (
+	"context"
+
 	pb "go.chromium.org/luci/buildbucket/proto"
 )
 
@@ -27,9 +29,36 @@ var _ pb.BuildsServer = &Builds{}
 
 // NewBuilds returns a new pb.BuildsServer.
 func NewBuilds() pb.BuildsServer {
-	return &pb.DecoratedBuilds{
-		Prelude:  commonPrelude,
-		Service:  &Builds{},
-		Postlude: commonPostlude,
+	return &decoratedBuilds{
+		DecoratedBuilds: &pb.DecoratedBuilds{
+			Prelude:  commonPrelude,
+			Service:  &Builds{},
+			Postlude: commonPostlude,
+		},
+	}
+}
+
+// decoratedBuilds adds streaming methods, which svcdec doesn't support, to
+// pb.DecoratedBuilds.
+type decoratedBuilds struct {
+	*pb.DecoratedBuilds
+}
+
+// WatchBuilds calls Builds.WatchBuilds wrapped into the prelude and postlude.
+func (s *decoratedBuilds) WatchBuilds(req *pb.WatchBuildsRequest, stream pb.Builds_WatchBuildsServer) (err error) {
+	ctx := stream.Context()
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "WatchBuilds", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		err = s.Service.WatchBuilds(req, &watchBuildsStream{stream, ctx})
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "WatchBuilds", nil, err)
 	}
+	return
 }
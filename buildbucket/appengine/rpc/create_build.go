@@ -22,7 +22,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -43,7 +42,6 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	bb "go.chromium.org/luci/buildbucket"
 	"go.chromium.org/luci/buildbucket/appengine/internal/buildid"
 	"go.chromium.org/luci/buildbucket/appengine/internal/config"
 	"go.chromium.org/luci/buildbucket/appengine/internal/metrics"
@@ -52,7 +50,6 @@ import (
 	"go.chromium.org/luci/buildbucket/appengine/internal/search"
 	"go.chromium.org/luci/buildbucket/appengine/model"
 	"go.chromium.org/luci/buildbucket/appengine/tasks"
-	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
 	"go.chromium.org/luci/buildbucket/bbperms"
 	pb "go.chromium.org/luci/buildbucket/proto"
 	"go.chromium.org/luci/buildbucket/protoutil"
@@ -563,7 +560,8 @@ func (bc *buildCreator) createBuilds(ctx context.Context) ([]*model.Build, error
 		bc.blds[i].Proto.Infra.Logdog.Prefix = fmt.Sprintf("buildbucket/%s/%d", appID, bc.blds[i].Proto.Id)
 		protoutil.SetStatus(now, bc.blds[i].Proto, pb.Status_SCHEDULED)
 
-		if bc.blds[i].Proto.GetInfra().GetBuildbucket().GetBuildNumber() {
+		// Retries may keep the number of the original build.
+		if bc.blds[i].Proto.GetInfra().GetBuildbucket().GetBuildNumber() && bc.blds[i].Proto.Number == 0 {
 			idxMapNums = append(idxMapNums, bc.idxMapBldToReq[i])
 			nums = append(nums, bc.blds[i])
 		}
@@ -594,129 +592,138 @@ func (bc *buildCreator) createBuilds(ctx context.Context) ([]*model.Build, error
 	// This parallel work isn't combined with the above parallel work to ensure build entities and Swarming (or Backend)
 	// task creation tasks are only created if everything else has succeeded (since everything can't be done
 	// in one transaction).
-	_ = parallel.WorkPool(min(64, len(validBlds)), func(work chan<- func() error) {
-		for i, b := range validBlds {
-			i := i
-			b := b
-			origI := idxMapValidBlds[i]
-			if bc.merr[origI] != nil {
-				validBlds[i] = nil
-				continue
-			}
-
-			reqID := bc.reqIDs[origI]
-			work <- func() error {
-				bldr := b.Proto.Builder
-				bs := &model.BuildStatus{
-					Build:        datastore.KeyForObj(ctx, b),
-					Status:       pb.Status_SCHEDULED,
-					BuildAddress: fmt.Sprintf("%s/%s/%s/b%d", bldr.Project, bldr.Bucket, bldr.Builder, b.ID),
-				}
-				if b.Proto.Number > 0 {
-					bs.BuildAddress = fmt.Sprintf("%s/%s/%s/%d", bldr.Project, bldr.Bucket, bldr.Builder, b.Proto.Number)
+	//
+	// Builds depending on other builds of the same batch are stored after them,
+	// since a request may be deduplicated to an existing build, so the IDs of
+	// the dependencies are only known once they are stored.
+	levels, byReqID := dependencyLevels(validBlds, idxMapValidBlds, bc.reqIDs)
+	stored := make([]bool, len(validBlds))
+	for _, level := range levels {
+		_ = parallel.WorkPool(min(64, len(level)), func(work chan<- func() error) {
+			for _, i := range level {
+				i := i
+				b := validBlds[i]
+				origI := idxMapValidBlds[i]
+				if bc.merr[origI] != nil {
+					validBlds[i] = nil
+					continue
 				}
-				toPut := []any{
-					b,
-					bs,
-					&model.BuildInfra{
-						Build: datastore.KeyForObj(ctx, b),
-						Proto: b.Proto.Infra,
-					},
-					&model.BuildInputProperties{
-						Build: datastore.KeyForObj(ctx, b),
-						Proto: b.Proto.Input.Properties,
-					},
+				if err := resolveDependencies(b, validBlds, byReqID, stored); err != nil {
+					validBlds[i] = nil
+					bc.merr[origI] = err
+					continue
 				}
-				r := model.NewRequestID(ctx, b.ID, now, reqID)
-
-				// Write the entities and trigger a task queue task to create the Swarming task.
-				err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
-					// Deduplicate by request ID.
-					if reqID != "" {
-						switch err := datastore.Get(ctx, r); {
-						case err == datastore.ErrNoSuchEntity:
-							toPut = append(toPut, r)
-						case err != nil:
-							return errors.Annotate(err, "failed to deduplicate request ID: %d", b.ID).Err()
-						default:
-							b.ID = r.BuildID
-							if err := datastore.Get(ctx, b); err != nil {
-								return errors.Annotate(err, "failed to fetch deduplicated build: %d", b.ID).Err()
-							}
-							return nil
-						}
-					}
 
-					// Request was not a duplicate.
-					switch err := datastore.Get(ctx, &model.Build{ID: b.ID}); {
-					case err == nil:
-						return appstatus.Errorf(codes.AlreadyExists, "build already exists: %d", b.ID)
-					case err != datastore.ErrNoSuchEntity:
-						return errors.Annotate(err, "failed to fetch build: %d", b.ID).Err()
+				reqID := bc.reqIDs[origI]
+				work <- func() error {
+					bldr := b.Proto.Builder
+					bs := &model.BuildStatus{
+						Build:        datastore.KeyForObj(ctx, b),
+						Status:       pb.Status_SCHEDULED,
+						BuildAddress: fmt.Sprintf("%s/%s/%s/b%d", bldr.Project, bldr.Bucket, bldr.Builder, b.ID),
 					}
-
-					// Drop the infra, input.properties when storing into Build entity, as
-					// they are stored in separate datastore entities.
-					infra := b.Proto.Infra
-					inProp := b.Proto.Input.Properties
-					b.Proto.Infra = nil
-					b.Proto.Input.Properties = nil
-					defer func() {
-						b.Proto.Infra = infra
-						b.Proto.Input.Properties = inProp
-					}()
-					if err := datastore.Put(ctx, toPut...); err != nil {
-						return errors.Annotate(err, "failed to store build: %d", b.ID).Err()
+					if b.Proto.Number > 0 {
+						bs.BuildAddress = fmt.Sprintf("%s/%s/%s/%d", bldr.Project, bldr.Bucket, bldr.Builder, b.Proto.Number)
 					}
+					toPut := []any{
+						b,
+						bs,
+						&model.BuildInfra{
+							Build: datastore.KeyForObj(ctx, b),
+							Proto: b.Proto.Infra,
+						},
+						&model.BuildInputProperties{
+							Build: datastore.KeyForObj(ctx, b),
+							Proto: b.Proto.Input.Properties,
+						},
+					}
+					r := model.NewRequestID(ctx, b.ID, now, reqID)
+
+					// Write the entities and trigger a task queue task to create the Swarming task.
+					err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+						// Deduplicate by request ID.
+						if reqID != "" {
+							switch err := datastore.Get(ctx, r); {
+							case err == datastore.ErrNoSuchEntity:
+								toPut = append(toPut, r)
+							case err != nil:
+								return errors.Annotate(err, "failed to deduplicate request ID: %d", b.ID).Err()
+							default:
+								b.ID = r.BuildID
+								if err := datastore.Get(ctx, b); err != nil {
+									return errors.Annotate(err, "failed to fetch deduplicated build: %d", b.ID).Err()
+								}
+								return nil
+							}
+						}
 
-					// If a backend is set, create a backend task. Otherwise, create a swarming task.
-					switch {
-					case infra.GetBackend() != nil:
-						if err := tasks.CreateBackendBuildTask(ctx, &taskdefs.CreateBackendBuildTask{
-							BuildId:   b.ID,
-							RequestId: uuid.New().String(),
-						}); err != nil {
-							return errors.Annotate(err, "failed to enqueue CreateBackendTask").Err()
+						// Request was not a duplicate.
+						switch err := datastore.Get(ctx, &model.Build{ID: b.ID}); {
+						case err == nil:
+							return appstatus.Errorf(codes.AlreadyExists, "build already exists: %d", b.ID)
+						case err != datastore.ErrNoSuchEntity:
+							return errors.Annotate(err, "failed to fetch build: %d", b.ID).Err()
 						}
-					case infra.GetSwarming().GetHostname() == "":
-						logging.Debugf(ctx, "skipped creating swarming task for build %d", b.ID)
-						return nil
-					default:
-						if stringset.NewFromSlice(b.Proto.Input.Experiments...).Has(bb.ExperimentBackendGo) {
-							if err := tasks.CreateSwarmingBuildTask(ctx, &taskdefs.CreateSwarmingBuildTask{
-								BuildId: b.ID,
-							}); err != nil {
-								return errors.Annotate(err, "failed to enqueue CreateSwarmingBuildTask: %d", b.ID).Err()
+
+						// Drop the infra, input.properties when storing into Build entity, as
+						// they are stored in separate datastore entities.
+						infra := b.Proto.Infra
+						inProp := b.Proto.Input.Properties
+						b.Proto.Infra = nil
+						b.Proto.Input.Properties = nil
+						defer func() {
+							b.Proto.Infra = infra
+							b.Proto.Input.Properties = inProp
+						}()
+						if err := datastore.Put(ctx, toPut...); err != nil {
+							return errors.Annotate(err, "failed to store build: %d", b.ID).Err()
+						}
+
+						// Mark the dependencies, so that they trigger the build when they
+						// end. A build with dependencies gets its task once they end.
+						// Otherwise, create a backend task if a backend is set, or a
+						// swarming task.
+						if len(b.Proto.DependsOn) > 0 {
+							if err := markDependencies(ctx, b); err != nil {
+								return err
+							}
+							if err := tasks.CheckBuildDependencies(ctx, b.ID); err != nil {
+								return errors.Annotate(err, "failed to enqueue CheckBuildDependencies: %d", b.ID).Err()
 							}
 						} else {
-							if err := tasks.CreateSwarmingTask(ctx, &taskdefs.CreateSwarmingTask{
-								BuildId: b.ID,
-							}); err != nil {
-								return errors.Annotate(err, "failed to enqueue CreateSwarmingTask: %d", b.ID).Err()
+							switch ok, err := tasks.ScheduleBuildTask(ctx, b, infra); {
+							case err != nil:
+								return err
+							case !ok:
+								logging.Debugf(ctx, "skipped creating swarming task for build %d", b.ID)
+								return nil
 							}
 						}
-					}
 
-					if err := tasks.NotifyPubSub(ctx, b); err != nil {
-						// Don't fail the entire creation. Just log the error since the
-						// status notification for unspecified -> scheduled is a
-						// nice-to-have not a must-to-have.
-						logging.Warningf(ctx, "failed to enqueue the notification when Build(%d) is scheduled: %s", b.ID, err)
-					}
-					return nil
-				}, nil)
+						if err := tasks.NotifyPubSub(ctx, b); err != nil {
+							// Don't fail the entire creation. Just log the error since the
+							// status notification for unspecified -> scheduled is a
+							// nice-to-have not a must-to-have.
+							logging.Warningf(ctx, "failed to enqueue the notification when Build(%d) is scheduled: %s", b.ID, err)
+						}
+						return nil
+					}, nil)
 
-				// Record any error happened in the above transaction.
-				if err != nil {
-					validBlds[i] = nil
-					bc.merr[origI] = err
+					// Record any error happened in the above transaction.
+					if err != nil {
+						validBlds[i] = nil
+						bc.merr[origI] = err
+						return nil
+					}
+					metrics.BuildCreated(ctx, b)
 					return nil
 				}
-				metrics.BuildCreated(ctx, b)
-				return nil
 			}
+		})
+		for _, i := range level {
+			stored[i] = true
 		}
-	})
+	}
 
 	if bc.merr.First() == nil {
 		return validBlds, nil
@@ -732,6 +739,98 @@ func (bc *buildCreator) createBuilds(ctx context.Context) ([]*model.Build, error
 	return resBlds, bc.merr
 }
 
+// dependencyLevels groups the builds, so that builds only depend on builds of
+// the earlier groups. Also marks the builds other builds depend on.
+//
+// Returns the groups of indexes in blds, and a map of request IDs to indexes
+// in blds.
+func dependencyLevels(blds []*model.Build, idxMapBldToReq []int, reqIDs []string) ([][]int, map[string]int) {
+	byReqID := make(map[string]int, len(blds))
+	for i := range blds {
+		if reqID := reqIDs[idxMapBldToReq[i]]; reqID != "" {
+			byReqID[reqID] = i
+		}
+	}
+
+	levels := make([]int, len(blds))
+	for i := range levels {
+		levels[i] = -1
+	}
+	var level func(i int) int
+	level = func(i int) int {
+		if levels[i] >= 0 {
+			return levels[i]
+		}
+		// Cycles are rejected by validateDependencyGraph. Just don't recurse
+		// infinitely here, resolveDependencies fails such builds.
+		levels[i] = 0
+		l := 0
+		for _, dep := range blds[i].Proto.DependsOn {
+			if j, ok := byReqID[dep.RequestId]; ok && dep.RequestId != "" {
+				blds[j].HasDependents = true
+				l = max(l, level(j)+1)
+			}
+		}
+		levels[i] = l
+		return l
+	}
+
+	var groups [][]int
+	for i := range blds {
+		l := level(i)
+		for len(groups) <= l {
+			groups = append(groups, nil)
+		}
+		groups[l] = append(groups[l], i)
+	}
+	return groups, byReqID
+}
+
+// resolveDependencies replaces request IDs in the build's dependencies with
+// IDs of the stored builds.
+func resolveDependencies(b *model.Build, blds []*model.Build, byReqID map[string]int, stored []bool) error {
+	for _, dep := range b.Proto.DependsOn {
+		if dep.RequestId == "" {
+			continue
+		}
+		j, ok := byReqID[dep.RequestId]
+		if !ok || !stored[j] || blds[j] == nil {
+			return appstatus.Errorf(codes.FailedPrecondition, "dependency %q was not scheduled", dep.RequestId)
+		}
+		dep.BuildId = blds[j].ID
+		dep.RequestId = ""
+	}
+	return nil
+}
+
+// markDependencies sets HasDependents of the incomplete dependencies of the
+// build.
+//
+// Must be called in a transaction.
+func markDependencies(ctx context.Context, b *model.Build) error {
+	deps := make([]*model.Build, len(b.Proto.DependsOn))
+	for i, dep := range b.Proto.DependsOn {
+		deps[i] = &model.Build{ID: dep.BuildId}
+	}
+	if err := datastore.Get(ctx, deps); err != nil {
+		return errors.Annotate(err, "failed to fetch dependencies: %d", b.ID).Err()
+	}
+	var toPut []*model.Build
+	for _, dep := range deps {
+		if !dep.HasDependents && !protoutil.IsEnded(dep.Proto.Status) {
+			dep.HasDependents = true
+			toPut = append(toPut, dep)
+		}
+	}
+	if len(toPut) == 0 {
+		return nil
+	}
+	if err := datastore.Put(ctx, toPut); err != nil {
+		return errors.Annotate(err, "failed to mark dependencies: %d", b.ID).Err()
+	}
+	return nil
+}
+
 // getValidBlds returns a list of valid builds where its corresponding error is nil.
 // as well as an index map where idxMap[returnedIndex] == originalIndex.
 func getValidBlds(blds []*model.Build, origErrs errors.MultiError, idxMapBldToReq []int) ([]*model.Build, []int) {
@@ -0,0 +1,161 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	"go.chromium.org/luci/buildbucket/appengine/tasks"
+	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
+	pb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/buildbucket/protoutil"
+)
+
+func init() {
+	tasks.RetryBuildTasks.AttachHandler(func(ctx context.Context, payload proto.Message) error {
+		return handleRetryBuild(ctx, payload.(*taskdefs.RetryBuild).BuildId)
+	})
+}
+
+// retryFromBuild returns a build to retry the given ended build with.
+//
+// The retry runs with the same inputs and infra as the original build, apart
+// from the fields set once the build is created or its task is.
+func retryFromBuild(orig *model.Build, infra *pb.BuildInfra, props *model.BuildInputProperties) *model.Build {
+	b := &pb.Build{
+		Builder:           orig.Proto.Builder,
+		BuilderInfo:       orig.Proto.BuilderInfo,
+		Critical:          orig.Proto.Critical,
+		Exe:               orig.Proto.Exe,
+		Canary:            orig.Proto.Canary,
+		SchedulingTimeout: orig.Proto.SchedulingTimeout,
+		ExecutionTimeout:  orig.Proto.ExecutionTimeout,
+		GracePeriod:       orig.Proto.GracePeriod,
+		WaitForCapacity:   orig.Proto.WaitForCapacity,
+		CanOutliveParent:  orig.Proto.CanOutliveParent,
+		AncestorIds:       orig.Proto.AncestorIds,
+		Retriable:         orig.Proto.Retriable,
+		Input:             proto.Clone(orig.Proto.Input).(*pb.Build_Input),
+		Infra:             proto.Clone(infra).(*pb.BuildInfra),
+		Retry: &pb.Build_Retry{
+			RetryOf:         orig.ID,
+			OriginalBuildId: orig.ID,
+			Attempt:         2,
+		},
+	}
+	for _, t := range orig.Proto.Tags {
+		// The build address stays with the original build, even if the retry
+		// keeps its number.
+		if t.Key != "build_address" {
+			b.Tags = append(b.Tags, t)
+		}
+	}
+	if r := orig.Proto.Retry; r != nil {
+		b.Retry.OriginalBuildId = r.OriginalBuildId
+		b.Retry.Attempt = r.Attempt + 1
+	}
+	if b.Input == nil {
+		b.Input = &pb.Build_Input{}
+	}
+	b.Input.Properties = props.Proto
+
+	if b.Infra.Resultdb != nil {
+		b.Infra.Resultdb.Invocation = ""
+	}
+	if b.Infra.Swarming != nil {
+		b.Infra.Swarming.TaskId = ""
+		b.Infra.Swarming.BotDimensions = nil
+	}
+	if b.Infra.Backend != nil {
+		b.Infra.Backend.Task = &pb.Task{
+			Id: &pb.TaskID{Target: b.Infra.Backend.GetTask().GetId().GetTarget()},
+		}
+	}
+	if b.Infra.Buildbucket.GetAgent() != nil {
+		b.Infra.Buildbucket.Agent.Output = nil
+	}
+
+	bld := &model.Build{
+		Proto:          b,
+		IsLuci:         orig.IsLuci,
+		PubSubCallback: orig.PubSubCallback,
+		RetryPolicy:    orig.RetryPolicy,
+	}
+	setExperimentsFromProto(bld)
+	// Tags are stored in the outer struct (see model/build.go).
+	tags := protoutil.StringPairMap(b.Tags).Format()
+	tags = stringset.NewFromSlice(tags...).ToSlice() // Deduplicate tags.
+	sort.Strings(tags)
+	bld.Tags = tags
+
+	if orig.RetryPolicy.GetKeepBuildNumber() && orig.Proto.Number > 0 {
+		b.Number = orig.Proto.Number
+	}
+	return bld
+}
+
+// RetryBuild schedules a retry of the given ended build according to the retry
+// policy of its builder.
+//
+// Retries are deduplicated, so the same build is retried at most once.
+func RetryBuild(ctx context.Context, orig *model.Build) (*model.Build, error) {
+	infra := &model.BuildInfra{Build: datastore.KeyForObj(ctx, orig)}
+	props := &model.BuildInputProperties{Build: datastore.KeyForObj(ctx, orig)}
+	if err := model.GetIgnoreMissing(ctx, infra, props); err != nil {
+		return nil, errors.Annotate(err, "failed to fetch details of build %d", orig.ID).Err()
+	}
+
+	bc := &buildCreator{
+		blds:           []*model.Build{retryFromBuild(orig, infra.Proto, props)},
+		idxMapBldToReq: []int{0},
+		reqIDs:         []string{fmt.Sprintf("retry-of-%d", orig.ID)},
+		merr:           make(errors.MultiError, 1),
+	}
+	blds, err := bc.createBuilds(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to create the retry of build %d", orig.ID).Err()
+	}
+	return blds[0], nil
+}
+
+// handleRetryBuild schedules a retry of the given ended build.
+func handleRetryBuild(ctx context.Context, buildID int64) error {
+	bld := &model.Build{ID: buildID}
+	switch err := datastore.Get(ctx, bld); {
+	case err == datastore.ErrNoSuchEntity:
+		logging.Warningf(ctx, "build %d not found", buildID)
+		return nil
+	case err != nil:
+		return errors.Annotate(err, "failed to fetch build %d", buildID).Tag(transient.Tag).Err()
+	}
+
+	retry, err := RetryBuild(ctx, bld)
+	if err != nil {
+		return errors.Annotate(err, "failed to retry build %d", buildID).Tag(transient.Tag).Err()
+	}
+	logging.Infof(ctx, "build %d is attempt %d of build %d", retry.ID, retry.Proto.Retry.GetAttempt(), retry.Proto.Retry.GetOriginalBuildId())
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/filter/txndefer"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/tq"
+
+	"go.chromium.org/luci/buildbucket/appengine/internal/metrics"
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRetryBuild(t *testing.T) {
+	t.Parallel()
+
+	Convey("RetryBuild", t, func() {
+		ctx := txndefer.FilterRDS(memory.Use(context.Background()))
+		ctx = metrics.WithServiceInfo(ctx, "svc", "job", "ins")
+		ctx, _ = testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+		ctx, sch := tq.TestingContext(ctx, nil)
+		datastore.GetTestable(ctx).AutoIndex(true)
+		datastore.GetTestable(ctx).Consistent(true)
+
+		orig := &model.Build{
+			ID: 1,
+			Proto: &pb.Build{
+				Id: 1,
+				Builder: &pb.BuilderID{
+					Project: "project",
+					Bucket:  "bucket",
+					Builder: "builder",
+				},
+				Number: 5,
+				Status: pb.Status_INFRA_FAILURE,
+				Input: &pb.Build_Input{
+					Experiments: []string{"luci.buildbucket.exp"},
+				},
+				Tags: []*pb.StringPair{
+					{Key: "buildset", Value: "bs"},
+					{Key: "build_address", Value: "luci.project.bucket/builder/5"},
+				},
+			},
+			Tags:        []string{"build_address:luci.project.bucket/builder/5", "buildset:bs"},
+			IsLuci:      true,
+			RetryPolicy: &pb.BuilderConfig_RetryPolicy{MaxAttempts: 3},
+		}
+		props, err := structpb.NewStruct(map[string]any{"a": "b"})
+		So(err, ShouldBeNil)
+		So(datastore.Put(ctx, orig, &model.BuildInfra{
+			Build: datastore.KeyForObj(ctx, orig),
+			Proto: &pb.BuildInfra{
+				Buildbucket: &pb.BuildInfra_Buildbucket{
+					Hostname:    "bb.example.com",
+					BuildNumber: true,
+				},
+				Logdog: &pb.BuildInfra_LogDog{Hostname: "logs.example.com"},
+				Swarming: &pb.BuildInfra_Swarming{
+					Hostname: "swarming.example.com",
+					TaskId:   "task",
+				},
+			},
+		}, &model.BuildInputProperties{
+			Build: datastore.KeyForObj(ctx, orig),
+			Proto: props,
+		}), ShouldBeNil)
+
+		Convey("ok", func() {
+			bld, err := RetryBuild(ctx, orig)
+			So(err, ShouldBeNil)
+			So(bld.ID, ShouldNotEqual, orig.ID)
+			So(bld.Proto.Retry, ShouldResembleProto, &pb.Build_Retry{
+				RetryOf:         1,
+				OriginalBuildId: 1,
+				Attempt:         2,
+			})
+			So(bld.Proto.Status, ShouldEqual, pb.Status_SCHEDULED)
+			So(bld.Proto.Infra.Swarming.TaskId, ShouldBeEmpty)
+			So(bld.Proto.Input.Properties, ShouldResembleProto, props)
+			So(bld.RetryPolicy, ShouldResembleProto, orig.RetryPolicy)
+			var created []int64
+			for _, p := range sch.Tasks().Payloads() {
+				if t, ok := p.(*taskdefs.CreateSwarmingTask); ok {
+					created = append(created, t.BuildId)
+				}
+			}
+			So(created, ShouldResemble, []int64{bld.ID})
+
+			Convey("deduplicated", func() {
+				again, err := RetryBuild(ctx, orig)
+				So(err, ShouldBeNil)
+				So(again.ID, ShouldEqual, bld.ID)
+			})
+
+			Convey("retry of retry", func() {
+				second, err := RetryBuild(ctx, bld)
+				So(err, ShouldBeNil)
+				So(second.Proto.Retry, ShouldResembleProto, &pb.Build_Retry{
+					RetryOf:         bld.ID,
+					OriginalBuildId: 1,
+					Attempt:         3,
+				})
+			})
+		})
+
+		Convey("new build number", func() {
+			bld, err := RetryBuild(ctx, orig)
+			So(err, ShouldBeNil)
+			So(bld.Proto.Number, ShouldEqual, 1)
+			So(bld.Tags, ShouldContain, "build_address:luci.project.bucket/builder/1")
+		})
+
+		Convey("keep build number", func() {
+			orig.RetryPolicy.KeepBuildNumber = true
+			bld, err := RetryBuild(ctx, orig)
+			So(err, ShouldBeNil)
+			So(bld.Proto.Number, ShouldEqual, 5)
+			// The build address stays with the original build.
+			So(bld.Tags, ShouldResemble, []string{"buildset:bs"})
+		})
+
+		Convey("handleRetryBuild", func() {
+			So(handleRetryBuild(ctx, 1), ShouldBeNil)
+			var blds []*model.Build
+			So(datastore.GetAll(ctx, datastore.NewQuery(model.BuildKind), &blds), ShouldBeNil)
+			So(blds, ShouldHaveLength, 2)
+			So(blds[1].Proto.Retry.GetRetryOf(), ShouldEqual, 1)
+
+			Convey("missing build", func() {
+				So(handleRetryBuild(ctx, 2), ShouldBeNil)
+			})
+		})
+	})
+}
@@ -286,6 +286,95 @@ func validateParent(ctx context.Context) (*model.Build, error) {
 	return pBld, nil
 }
 
+// maxDependencies is the maximum number of dependencies of a build.
+const maxDependencies = 100
+
+// validateDependencies validates the dependencies of the given request.
+//
+// Dependencies on other requests are validated by validateDependencyGraph.
+func validateDependencies(req *pb.ScheduleBuildRequest) error {
+	if len(req.DependsOn) > maxDependencies {
+		return errors.Reason("at most %d dependencies are allowed", maxDependencies).Err()
+	}
+	for i, dep := range req.DependsOn {
+		switch {
+		case (dep.GetBuildId() == 0) == (dep.GetRequestId() == ""):
+			return errors.Reason("%d: exactly one of build_id or request_id is required", i).Err()
+		case dep.BuildId < 0:
+			return errors.Reason("%d: build_id must be positive", i).Err()
+		case dep.RequestId != "" && dep.RequestId == req.RequestId:
+			return errors.Reason("%d: a build cannot depend on itself", i).Err()
+		case pb.BuildDependency_Condition_name[int32(dep.Condition)] == "":
+			return errors.Reason("%d: unknown condition %d", i, dep.Condition).Err()
+		}
+	}
+	return nil
+}
+
+// validateDependencyGraph validates the dependencies between the requests
+// scheduled together, setting errors of the requests which depend on
+// unknown or failed requests or form a cycle.
+func validateDependencyGraph(reqs []*pb.ScheduleBuildRequest, merr errors.MultiError) {
+	byReqID := make(map[string][]int, len(reqs))
+	for i, req := range reqs {
+		if req.GetRequestId() != "" {
+			byReqID[req.RequestId] = append(byReqID[req.RequestId], i)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(reqs))
+	errCycle := errors.New("dependency cycle")
+
+	// visit validates the dependencies of reqs[i] and returns its error.
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visiting:
+			return errCycle
+		case visited:
+			return merr[i]
+		}
+		state[i] = visiting
+		defer func() { state[i] = visited }()
+
+		if merr[i] != nil {
+			return merr[i]
+		}
+		for _, dep := range reqs[i].GetDependsOn() {
+			if dep.GetRequestId() == "" {
+				continue
+			}
+			var err error
+			switch idx := byReqID[dep.RequestId]; len(idx) {
+			case 0:
+				err = errors.Reason("request %q is not in the batch", dep.RequestId).Err()
+			case 1:
+				switch depErr := visit(idx[0]); {
+				case depErr == errCycle:
+					err = errors.Reason("request %q is in a dependency cycle", dep.RequestId).Err()
+				case depErr != nil:
+					err = errors.Reason("request %q is invalid", dep.RequestId).Err()
+				}
+			default:
+				err = errors.Reason("request %q is ambiguous", dep.RequestId).Err()
+			}
+			if err != nil {
+				merr[i] = appstatus.BadRequest(errors.Annotate(err, "depends_on").Err())
+				return merr[i]
+			}
+		}
+		return nil
+	}
+	for i := range reqs {
+		visit(i)
+	}
+}
+
 // validateSchedule validates the given request.
 func validateSchedule(ctx context.Context, req *pb.ScheduleBuildRequest, wellKnownExperiments stringset.Set, parent *model.Build) error {
 	var err error
@@ -314,6 +403,8 @@ func validateSchedule(ctx context.Context, req *pb.ScheduleBuildRequest, wellKno
 		return errors.Reason("can_outlive_parent is specified without parent build token").Err()
 	case teeErr(validateTags(req.Tags, TagNew), &err) != nil:
 		return errors.Annotate(err, "tags").Err()
+	case teeErr(validateDependencies(req), &err) != nil:
+		return errors.Annotate(err, "depends_on").Err()
 	}
 
 	for expName := range req.Experiments {
@@ -1595,12 +1686,17 @@ func scheduleBuilds(ctx context.Context, globalCfg *pb.SettingsCfg, reqs ...*pb.
 			build = buildFromScheduleRequest(ctx, reqs[origI], ancestors, pRunID, cfg, globalCfg)
 		}
 
+		for _, dep := range validReq[i].DependsOn {
+			build.DependsOn = append(build.DependsOn, proto.Clone(dep).(*pb.BuildDependency))
+		}
+
 		blds[i] = &model.Build{
 			Proto: build,
 		}
 
 		setExperimentsFromProto(blds[i])
 		blds[i].IsLuci = cfg != nil || inDynamicBucket
+		blds[i].RetryPolicy = cfg.GetRetryPolicy()
 		blds[i].PubSubCallback.Topic = validReq[i].GetNotify().GetPubsubTopic()
 		blds[i].PubSubCallback.UserData = validReq[i].GetNotify().GetUserData()
 		// Tags are stored in the outer struct (see model/build.go).
@@ -1697,9 +1793,30 @@ func validateScheduleBuild(ctx context.Context, wellKnownExperiments stringset.S
 	if err = perm.HasInBucket(ctx, bbperms.BuildsAdd, req.Builder.Project, bkt); err != nil {
 		return nil, nil, err
 	}
+	if err = checkDependencyBuilds(ctx, req.DependsOn); err != nil {
+		return nil, nil, err
+	}
 	return req, m, nil
 }
 
+// checkDependencyBuilds checks that the existing builds the request depends on
+// exist and the caller can see them.
+func checkDependencyBuilds(ctx context.Context, deps []*pb.BuildDependency) error {
+	for _, dep := range deps {
+		if dep.BuildId == 0 {
+			continue
+		}
+		bld, err := common.GetBuild(ctx, dep.BuildId)
+		if err != nil {
+			return err
+		}
+		if err := perm.HasInBuilder(ctx, bbperms.BuildsGet, bld.Proto.Builder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ScheduleBuild handles a request to schedule a build. Implements pb.BuildsServer.
 func (*Builds) ScheduleBuild(ctx context.Context, req *pb.ScheduleBuildRequest) (*pb.Build, error) {
 	globalCfg, err := config.GetSettingsCfg(ctx)
@@ -1723,6 +1840,11 @@ func (*Builds) ScheduleBuild(ctx context.Context, req *pb.ScheduleBuildRequest)
 	if err != nil {
 		return nil, err
 	}
+	merr := make(errors.MultiError, 1)
+	validateDependencyGraph([]*pb.ScheduleBuildRequest{req}, merr)
+	if merr[0] != nil {
+		return nil, merr[0]
+	}
 
 	blds, err := scheduleBuilds(ctx, globalCfg, req)
 	if err != nil {
@@ -1776,7 +1898,9 @@ func (*Builds) scheduleBuilds(ctx context.Context, globalCfg *pb.SettingsCfg, re
 		})
 	}
 
-	// Validate requests.
+	// Validate requests. Invalid requests are replaced with nil, keep them to
+	// validate the dependencies on them.
+	origReqs := append([]*pb.ScheduleBuildRequest(nil), reqs...)
 	_ = parallel.WorkPool(min(64, len(reqs)), func(work chan<- func() error) {
 		for i, req := range reqs {
 			i := i
@@ -1788,6 +1912,8 @@ func (*Builds) scheduleBuilds(ctx context.Context, globalCfg *pb.SettingsCfg, re
 		}
 	})
 
+	validateDependencyGraph(origReqs, merr)
+
 	validReqs, idxMapValidReqs := getValidReqs(reqs, merr)
 	// Non-MultiError error should apply to every item and fail all requests.
 	blds, err := scheduleBuilds(ctx, globalCfg, validReqs...)
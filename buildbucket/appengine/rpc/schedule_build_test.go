@@ -1865,6 +1865,99 @@ func TestScheduleBuild(t *testing.T) {
 				nil,
 			})
 		})
+
+		Convey("dependencies", func() {
+			testutil.PutBuilder(ctx, "project", "bucket", "builder", "")
+			testutil.PutBucket(ctx, "project", "bucket", &pb.Bucket{Swarming: &pb.Swarming{}})
+			builder := &pb.BuilderID{
+				Project: "project",
+				Bucket:  "bucket",
+				Builder: "builder",
+			}
+			reqs := []*pb.ScheduleBuildRequest{
+				{
+					Builder:   builder,
+					RequestId: "a",
+				},
+				{
+					Builder:   builder,
+					RequestId: "b",
+					DependsOn: []*pb.BuildDependency{{RequestId: "a"}},
+				},
+				{
+					Builder: builder,
+					DependsOn: []*pb.BuildDependency{
+						{RequestId: "a", Condition: pb.BuildDependency_FAILURE},
+						{RequestId: "b", Condition: pb.BuildDependency_ALWAYS},
+					},
+				},
+			}
+			checks := func() int {
+				n := 0
+				for _, p := range sch.Tasks().Payloads() {
+					if _, ok := p.(*taskdefs.CheckBuildDependencies); ok {
+						n++
+					}
+				}
+				return n
+			}
+
+			Convey("ok", func() {
+				blds, err := scheduleBuilds(ctx, globalCfg, reqs...)
+				So(err, ShouldBeNil)
+				So(blds[0].Proto.DependsOn, ShouldBeEmpty)
+				So(blds[1].Proto.DependsOn, ShouldResembleProto, []*pb.BuildDependency{
+					{BuildId: blds[0].ID},
+				})
+				So(blds[2].Proto.DependsOn, ShouldResembleProto, []*pb.BuildDependency{
+					{BuildId: blds[0].ID, Condition: pb.BuildDependency_FAILURE},
+					{BuildId: blds[1].ID, Condition: pb.BuildDependency_ALWAYS},
+				})
+				So(checks(), ShouldEqual, 2)
+
+				stored := []*model.Build{{ID: blds[0].ID}, {ID: blds[1].ID}, {ID: blds[2].ID}}
+				So(datastore.Get(ctx, stored), ShouldBeNil)
+				So(stored[0].HasDependents, ShouldBeTrue)
+				So(stored[1].HasDependents, ShouldBeTrue)
+				So(stored[2].HasDependents, ShouldBeFalse)
+				So(stored[2].DependsOn, ShouldResemble, []int64{blds[0].ID, blds[1].ID})
+			})
+
+			Convey("deduplicated dependency", func() {
+				So(datastore.Put(ctx, &model.Build{
+					ID: 1,
+					Proto: &pb.Build{
+						Id:      1,
+						Builder: builder,
+						Status:  pb.Status_STARTED,
+					},
+				}, model.NewRequestID(ctx, 1, testclock.TestRecentTimeUTC, "a")), ShouldBeNil)
+
+				blds, err := scheduleBuilds(ctx, globalCfg, reqs[:2]...)
+				So(err, ShouldBeNil)
+				So(blds[0].ID, ShouldEqual, 1)
+				So(blds[1].Proto.DependsOn, ShouldResembleProto, []*pb.BuildDependency{
+					{BuildId: 1},
+				})
+
+				dep := &model.Build{ID: 1}
+				So(datastore.Get(ctx, dep), ShouldBeNil)
+				So(dep.HasDependents, ShouldBeTrue)
+			})
+
+			Convey("failed dependency", func() {
+				reqs[0].Builder = &pb.BuilderID{
+					Project: "project",
+					Bucket:  "bucket",
+					Builder: "unknown",
+				}
+				blds, err := scheduleBuilds(ctx, globalCfg, reqs...)
+				So(err, ShouldErrLike, "builder not found")
+				So(blds, ShouldResemble, []*model.Build{nil, nil, nil})
+				So(err.(errors.MultiError)[1], ShouldErrLike, `dependency "a" was not scheduled`)
+				So(checks(), ShouldEqual, 0)
+			})
+		})
 	})
 
 	Convey("scheduleRequestFromTemplate", t, func() {
@@ -7504,6 +7597,103 @@ func TestScheduleBuild(t *testing.T) {
 				So(validateSchedule(ctx, req, nil, nil), ShouldErrLike, "unknown experiment has reserved prefix")
 			})
 		})
+
+		Convey("depends_on", func() {
+			req := &pb.ScheduleBuildRequest{
+				TemplateBuildId: 1,
+				RequestId:       "b",
+			}
+
+			Convey("ok", func() {
+				req.DependsOn = []*pb.BuildDependency{
+					{BuildId: 1},
+					{RequestId: "a", Condition: pb.BuildDependency_ALWAYS},
+				}
+				So(validateSchedule(ctx, req, nil, nil), ShouldBeNil)
+			})
+
+			Convey("both", func() {
+				req.DependsOn = []*pb.BuildDependency{{BuildId: 1, RequestId: "a"}}
+				So(validateSchedule(ctx, req, nil, nil), ShouldErrLike, "depends_on: 0: exactly one of build_id or request_id is required")
+			})
+
+			Convey("neither", func() {
+				req.DependsOn = []*pb.BuildDependency{{Condition: pb.BuildDependency_FAILURE}}
+				So(validateSchedule(ctx, req, nil, nil), ShouldErrLike, "exactly one of build_id or request_id is required")
+			})
+
+			Convey("self", func() {
+				req.DependsOn = []*pb.BuildDependency{{RequestId: "b"}}
+				So(validateSchedule(ctx, req, nil, nil), ShouldErrLike, "cannot depend on itself")
+			})
+
+			Convey("condition", func() {
+				req.DependsOn = []*pb.BuildDependency{{BuildId: 1, Condition: 10}}
+				So(validateSchedule(ctx, req, nil, nil), ShouldErrLike, "unknown condition 10")
+			})
+
+			Convey("too many", func() {
+				for i := 0; i <= maxDependencies; i++ {
+					req.DependsOn = append(req.DependsOn, &pb.BuildDependency{BuildId: int64(i + 1)})
+				}
+				So(validateSchedule(ctx, req, nil, nil), ShouldErrLike, "at most 100 dependencies are allowed")
+			})
+		})
+	})
+
+	Convey("validateDependencyGraph", t, func() {
+		req := func(id string, deps ...string) *pb.ScheduleBuildRequest {
+			r := &pb.ScheduleBuildRequest{RequestId: id}
+			for _, d := range deps {
+				r.DependsOn = append(r.DependsOn, &pb.BuildDependency{RequestId: d})
+			}
+			return r
+		}
+
+		Convey("ok", func() {
+			reqs := []*pb.ScheduleBuildRequest{
+				req("a"),
+				req("b", "a"),
+				req("c", "a"),
+				req("d", "b", "c"),
+				{DependsOn: []*pb.BuildDependency{{BuildId: 1}}},
+			}
+			merr := make(errors.MultiError, len(reqs))
+			validateDependencyGraph(reqs, merr)
+			So(merr.First(), ShouldBeNil)
+		})
+
+		Convey("unknown", func() {
+			merr := make(errors.MultiError, 1)
+			validateDependencyGraph([]*pb.ScheduleBuildRequest{req("a", "b")}, merr)
+			So(merr[0], ShouldHaveAppStatus, codes.InvalidArgument, `depends_on: request "b" is not in the batch`)
+		})
+
+		Convey("ambiguous", func() {
+			reqs := []*pb.ScheduleBuildRequest{req("a"), req("a"), req("b", "a")}
+			merr := make(errors.MultiError, len(reqs))
+			validateDependencyGraph(reqs, merr)
+			So(merr[:2], ShouldResemble, errors.MultiError{nil, nil})
+			So(merr[2], ShouldErrLike, `request "a" is ambiguous`)
+		})
+
+		Convey("invalid", func() {
+			reqs := []*pb.ScheduleBuildRequest{req("a"), req("b", "a"), req("c", "b")}
+			merr := errors.MultiError{errors.New("bad"), nil, nil}
+			validateDependencyGraph(reqs, merr)
+			So(merr[1], ShouldErrLike, `request "a" is invalid`)
+			So(merr[2], ShouldErrLike, `request "b" is invalid`)
+		})
+
+		Convey("cycle", func() {
+			reqs := []*pb.ScheduleBuildRequest{req("a", "c"), req("b", "a"), req("c", "b"), req("d")}
+			merr := make(errors.MultiError, len(reqs))
+			validateDependencyGraph(reqs, merr)
+			So(merr[0], ShouldErrLike, `request "c" is invalid`)
+			So(merr[1], ShouldErrLike, `request "a" is in a dependency cycle`)
+			So(merr[2], ShouldErrLike, `request "b" is invalid`)
+			So(merr[3], ShouldBeNil)
+		})
 	})
 
 	Convey("setInfraAgent", t, func() {
@@ -0,0 +1,180 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/appstatus"
+	"go.chromium.org/luci/server/auth/realms"
+
+	"go.chromium.org/luci/buildbucket/appengine/internal/buildstream"
+	"go.chromium.org/luci/buildbucket/appengine/internal/perm"
+	"go.chromium.org/luci/buildbucket/appengine/internal/search"
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	pb "go.chromium.org/luci/buildbucket/proto"
+)
+
+// maxWatchedBuilds is the maximum number of build IDs in a WatchBuilds
+// request.
+const maxWatchedBuilds = 1000
+
+// validateWatch validates the given request.
+func validateWatch(req *pb.WatchBuildsRequest) error {
+	switch {
+	case req.Predicate == nil && len(req.BuildIds) == 0:
+		return errors.Reason("one of predicate or build_ids is required").Err()
+	case req.Predicate != nil && len(req.BuildIds) > 0:
+		return errors.Reason("predicate is mutually exclusive with build_ids").Err()
+	case len(req.BuildIds) > maxWatchedBuilds:
+		return errors.Reason("build_ids: at most %d builds can be watched", maxWatchedBuilds).Err()
+	}
+	for i, id := range req.BuildIds {
+		if id <= 0 {
+			return errors.Reason("build_ids[%d]: must be positive", i).Err()
+		}
+	}
+	if req.Predicate != nil {
+		if req.Predicate.OutputGitilesCommit != nil {
+			return errors.Reason("predicate: output_gitiles_commit is not supported").Err()
+		}
+		if err := validatePredicate(req.Predicate); err != nil {
+			return errors.Annotate(err, "predicate").Err()
+		}
+	}
+	return nil
+}
+
+// buildWatcher sends builds to a WatchBuilds stream.
+type buildWatcher struct {
+	stream          pb.Builds_WatchBuildsServer
+	mask            *model.BuildMask
+	bucketPermCache map[string]realms.Permission
+}
+
+// send loads the details of the build and sends it to the stream.
+//
+// Skips the build if the caller can't see it.
+func (w *buildWatcher) send(b *pb.Build) error {
+	ctx := w.stream.Context()
+	redact := func(b *pb.Build) error {
+		return perm.RedactBuild(ctx, w.bucketPermCache, b)
+	}
+
+	// Check the permission before loading the details, builds the caller can't
+	// see are most likely the majority.
+	err := redact(b)
+	if err == nil {
+		err = model.LoadBuildDetails(ctx, w.mask, redact, b)
+	}
+	if err != nil {
+		if st, ok := appstatus.Get(err); ok && (st.Code() == codes.NotFound || st.Code() == codes.PermissionDenied) {
+			return nil
+		}
+		return err
+	}
+	return w.stream.Send(&pb.WatchBuildsResponse{Build: b})
+}
+
+// WatchBuilds handles a request to stream build updates. Implements
+// pb.BuildsServer.
+func (*Builds) WatchBuilds(req *pb.WatchBuildsRequest, stream pb.Builds_WatchBuildsServer) error {
+	ctx := stream.Context()
+	if err := validateWatch(req); err != nil {
+		return appstatus.BadRequest(err)
+	}
+	mask, err := model.NewBuildMask("", nil, req.Mask)
+	if err != nil {
+		return appstatus.BadRequest(errors.Annotate(err, "invalid mask").Err())
+	}
+	hub := buildstream.GetHub(ctx)
+	if hub == nil {
+		return appstatus.Errorf(codes.Unimplemented, "watching builds is not available, use GetBuild or SearchBuilds instead")
+	}
+
+	w := &buildWatcher{
+		stream:          stream,
+		mask:            mask,
+		bucketPermCache: map[string]realms.Permission{},
+	}
+
+	// Subscribe before loading the current state of the builds to not miss
+	// updates committed in between.
+	sub := hub.Subscribe()
+	defer sub.Close()
+
+	// Let the client know the stream is established before the first build.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	var match func(*pb.Build) bool
+	if len(req.BuildIds) > 0 {
+		ids := make(map[int64]bool, len(req.BuildIds))
+		builds := make([]*model.Build, len(req.BuildIds))
+		for i, id := range req.BuildIds {
+			ids[id] = true
+			builds[i] = &model.Build{ID: id}
+		}
+		match = func(b *pb.Build) bool { return ids[b.Id] }
+
+		if err := model.GetIgnoreMissing(ctx, builds); err != nil {
+			return errors.Annotate(err, "error fetching builds").Err()
+		}
+		for _, b := range builds {
+			if b.Proto == nil {
+				continue // missing
+			}
+			if err := w.send(b.ToSimpleBuildProto(ctx)); err != nil {
+				return err
+			}
+		}
+	} else {
+		match = search.NewQuery(&pb.SearchBuildsRequest{Predicate: req.Predicate}).Match
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case b, ok := <-sub.C():
+			if !ok {
+				return appstatus.Errorf(codes.Unavailable, "the stream fell behind, reconnect to continue watching")
+			}
+			if !match(b) {
+				continue
+			}
+			if err := w.send(proto.Clone(b).(*pb.Build)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchBuildsStream overrides the context of a WatchBuilds stream.
+type watchBuildsStream struct {
+	pb.Builds_WatchBuildsServer
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream.
+func (s *watchBuildsStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,243 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/redisconn"
+
+	"go.chromium.org/luci/buildbucket/appengine/internal/buildstream"
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	"go.chromium.org/luci/buildbucket/appengine/rpc/testutil"
+	"go.chromium.org/luci/buildbucket/bbperms"
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// fakeWatchStream implements pb.Builds_WatchBuildsServer.
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	builds chan *pb.Build
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *fakeWatchStream) Send(rsp *pb.WatchBuildsResponse) error {
+	s.builds <- rsp.Build
+	return nil
+}
+
+func TestValidateWatch(t *testing.T) {
+	t.Parallel()
+
+	Convey("validateWatch", t, func() {
+		Convey("empty", func() {
+			So(validateWatch(&pb.WatchBuildsRequest{}), ShouldErrLike, "one of predicate or build_ids is required")
+		})
+
+		Convey("both", func() {
+			err := validateWatch(&pb.WatchBuildsRequest{
+				Predicate: &pb.BuildPredicate{},
+				BuildIds:  []int64{1},
+			})
+			So(err, ShouldErrLike, "mutually exclusive")
+		})
+
+		Convey("too many builds", func() {
+			err := validateWatch(&pb.WatchBuildsRequest{BuildIds: make([]int64, maxWatchedBuilds+1)})
+			So(err, ShouldErrLike, "at most 1000 builds")
+		})
+
+		Convey("bad build id", func() {
+			So(validateWatch(&pb.WatchBuildsRequest{BuildIds: []int64{1, 0}}), ShouldErrLike, "build_ids[1]: must be positive")
+		})
+
+		Convey("bad predicate", func() {
+			err := validateWatch(&pb.WatchBuildsRequest{
+				Predicate: &pb.BuildPredicate{DescendantOf: 1, ChildOf: 2},
+			})
+			So(err, ShouldErrLike, "predicate: descendant_of is mutually exclusive with child_of")
+		})
+
+		Convey("output_gitiles_commit", func() {
+			err := validateWatch(&pb.WatchBuildsRequest{
+				Predicate: &pb.BuildPredicate{OutputGitilesCommit: &pb.GitilesCommit{}},
+			})
+			So(err, ShouldErrLike, "output_gitiles_commit is not supported")
+		})
+
+		Convey("ok", func() {
+			So(validateWatch(&pb.WatchBuildsRequest{BuildIds: []int64{1}}), ShouldBeNil)
+			So(validateWatch(&pb.WatchBuildsRequest{Predicate: &pb.BuildPredicate{}}), ShouldBeNil)
+		})
+	})
+}
+
+func TestWatchBuilds(t *testing.T) {
+	t.Parallel()
+
+	const userID = identity.Identity("user:user@example.com")
+
+	Convey("WatchBuilds", t, func() {
+		srv := &Builds{}
+		ctx, cancel := context.WithCancel(memory.Use(context.Background()))
+		defer cancel()
+		datastore.GetTestable(ctx).AutoIndex(true)
+		datastore.GetTestable(ctx).Consistent(true)
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity: userID,
+			FakeDB: authtest.NewFakeDB(
+				authtest.MockPermission(userID, "project:bucket", bbperms.BuildsGet),
+			),
+		})
+		testutil.PutBucket(ctx, "project", "bucket", nil)
+		testutil.PutBucket(ctx, "project", "secret", nil)
+
+		build := func(id int64, bucket string, status pb.Status) *pb.Build {
+			return &pb.Build{
+				Id: id,
+				Builder: &pb.BuilderID{
+					Project: "project",
+					Bucket:  bucket,
+					Builder: "builder",
+				},
+				Status: status,
+			}
+		}
+
+		stream := &fakeWatchStream{ctx: ctx, builds: make(chan *pb.Build, 10)}
+		watch := func(req *pb.WatchBuildsRequest) <-chan error {
+			errC := make(chan error, 1)
+			go func() {
+				errC <- srv.WatchBuilds(req, stream)
+			}()
+			return errC
+		}
+
+		Convey("without hub", func() {
+			err := srv.WatchBuilds(&pb.WatchBuildsRequest{BuildIds: []int64{1}}, stream)
+			So(err, ShouldHaveAppStatus, codes.Unimplemented)
+		})
+
+		Convey("bad request", func() {
+			err := srv.WatchBuilds(&pb.WatchBuildsRequest{}, stream)
+			So(err, ShouldHaveAppStatus, codes.InvalidArgument)
+		})
+
+		Convey("with hub", func() {
+			s, err := miniredis.Run()
+			So(err, ShouldBeNil)
+			defer s.Close()
+			ctx = redisconn.UsePool(ctx, &redis.Pool{
+				Dial: func() (redis.Conn, error) {
+					return redis.Dial("tcp", s.Addr())
+				},
+			})
+
+			hub := buildstream.NewHub()
+			ctx = buildstream.WithHub(ctx, hub)
+			stream.ctx = ctx
+			go hub.Run(ctx)
+			for s.PubSubNumSub("buildbucket.v2.builds")["buildbucket.v2.builds"] == 0 {
+				time.Sleep(time.Millisecond)
+			}
+
+			publish := func(b *pb.Build) {
+				So(buildstream.Publish(ctx, b), ShouldBeNil)
+			}
+			receive := func() *pb.Build {
+				select {
+				case b := <-stream.builds:
+					return b
+				case <-time.After(10 * time.Second):
+					return nil
+				}
+			}
+			waitSubscribed := func() {
+				for hub.Subscribers() == 0 {
+					time.Sleep(time.Millisecond)
+				}
+			}
+
+			Convey("build_ids", func() {
+				So(datastore.Put(ctx, &model.Build{
+					ID:    1,
+					Proto: build(1, "bucket", pb.Status_SCHEDULED),
+				}), ShouldBeNil)
+
+				errC := watch(&pb.WatchBuildsRequest{BuildIds: []int64{1, 2}})
+
+				// The current state first.
+				b := receive()
+				So(b.Id, ShouldEqual, 1)
+				So(b.Status, ShouldEqual, pb.Status_SCHEDULED)
+
+				publish(build(3, "bucket", pb.Status_STARTED))
+				publish(build(2, "secret", pb.Status_STARTED))
+				publish(build(1, "bucket", pb.Status_STARTED))
+
+				b = receive()
+				So(b.Id, ShouldEqual, 1)
+				So(b.Status, ShouldEqual, pb.Status_STARTED)
+
+				cancel()
+				So(<-errC, ShouldBeNil)
+				So(stream.builds, ShouldBeEmpty)
+			})
+
+			Convey("predicate", func() {
+				errC := watch(&pb.WatchBuildsRequest{
+					Predicate: &pb.BuildPredicate{Status: pb.Status_ENDED_MASK},
+				})
+				waitSubscribed()
+
+				publish(build(1, "bucket", pb.Status_STARTED))
+				publish(build(2, "secret", pb.Status_SUCCESS))
+				publish(build(3, "bucket", pb.Status_FAILURE))
+
+				b := receive()
+				So(b.Id, ShouldEqual, 3)
+				So(b.Status, ShouldEqual, pb.Status_FAILURE)
+
+				cancel()
+				So(<-errC, ShouldBeNil)
+				So(stream.builds, ShouldBeEmpty)
+			})
+		})
+	})
+}
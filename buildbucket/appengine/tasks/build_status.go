@@ -53,6 +53,14 @@ func sendOnBuildCompletion(ctx context.Context, bld *model.Build) error {
 		tks <- func() error {
 			return errors.Annotate(FinalizeResultDB(ctx, &taskdefs.FinalizeResultDBGo{BuildId: bld.ID}), "failed to enqueue resultDB finalization task: %d", bld.ID).Err()
 		}
+		tks <- func() error {
+			return errors.Annotate(MaybeRetryBuild(ctx, bld), "failed to enqueue retry task: %d", bld.ID).Err()
+		}
+		if bld.HasDependents {
+			tks <- func() error {
+				return errors.Annotate(TriggerDependents(ctx, bld.ID), "failed to enqueue dependents trigger task: %d", bld.ID).Err()
+			}
+		}
 	})
 }
 
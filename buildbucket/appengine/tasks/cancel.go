@@ -201,6 +201,11 @@ func Cancel(ctx context.Context, bID int64) (*model.Build, error) {
 		if err := NotifyPubSub(ctx, bld); err != nil {
 			return errors.Annotate(err, "failed to enqueue pubsub notification task: %d", bld.ID).Err()
 		}
+		if bld.HasDependents {
+			if err := TriggerDependents(ctx, bld.ID); err != nil {
+				return errors.Annotate(err, "failed to enqueue dependents trigger task: %d", bld.ID).Err()
+			}
+		}
 
 		now := clock.Now(ctx).UTC()
 
@@ -0,0 +1,213 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/tq"
+
+	"go.chromium.org/luci/buildbucket"
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
+	pb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/buildbucket/protoutil"
+)
+
+// CheckBuildDependencies enqueues a task queue task to check the dependencies
+// of the given build.
+func CheckBuildDependencies(ctx context.Context, buildID int64) error {
+	if buildID <= 0 {
+		return errors.Reason("build_id is invalid").Err()
+	}
+	return tq.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("check-build-dependencies-%d", buildID),
+		Payload: &taskdefs.CheckBuildDependencies{BuildId: buildID},
+	})
+}
+
+// TriggerDependents enqueues a task queue task to check the dependencies of
+// the builds depending on the given ended build.
+func TriggerDependents(ctx context.Context, buildID int64) error {
+	if buildID <= 0 {
+		return errors.Reason("build_id is invalid").Err()
+	}
+	return tq.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("trigger-dependents-%d", buildID),
+		Payload: &taskdefs.TriggerDependents{BuildId: buildID},
+	})
+}
+
+// ScheduleBuildTask enqueues a task to create the backend or swarming task of
+// the given build.
+//
+// Returns false if the build has neither a backend nor swarming, so there is
+// no task to create.
+func ScheduleBuildTask(ctx context.Context, bld *model.Build, infra *pb.BuildInfra) (bool, error) {
+	switch {
+	case infra.GetBackend() != nil:
+		if err := CreateBackendBuildTask(ctx, &taskdefs.CreateBackendBuildTask{
+			BuildId:   bld.ID,
+			RequestId: uuid.New().String(),
+		}); err != nil {
+			return false, errors.Annotate(err, "failed to enqueue CreateBackendTask").Err()
+		}
+	case infra.GetSwarming().GetHostname() == "":
+		return false, nil
+	case stringset.NewFromSlice(bld.Proto.Input.GetExperiments()...).Has(buildbucket.ExperimentBackendGo):
+		if err := CreateSwarmingBuildTask(ctx, &taskdefs.CreateSwarmingBuildTask{
+			BuildId: bld.ID,
+		}); err != nil {
+			return false, errors.Annotate(err, "failed to enqueue CreateSwarmingBuildTask: %d", bld.ID).Err()
+		}
+	default:
+		if err := CreateSwarmingTask(ctx, &taskdefs.CreateSwarmingTask{
+			BuildId: bld.ID,
+		}); err != nil {
+			return false, errors.Annotate(err, "failed to enqueue CreateSwarmingTask: %d", bld.ID).Err()
+		}
+	}
+	return true, nil
+}
+
+// dependencyConditionMet returns true if the dependency status satisfies the
+// condition.
+func dependencyConditionMet(cond pb.BuildDependency_Condition, status pb.Status) bool {
+	switch cond {
+	case pb.BuildDependency_ALWAYS:
+		return protoutil.IsEnded(status)
+	case pb.BuildDependency_FAILURE:
+		return status == pb.Status_FAILURE || status == pb.Status_INFRA_FAILURE
+	default:
+		return status == pb.Status_SUCCESS
+	}
+}
+
+// checkDependencies checks the dependencies of the build.
+//
+// Returns a non-empty reason if a dependency has ended without meeting its
+// condition, and whether all dependencies have ended.
+func checkDependencies(ctx context.Context, bld *model.Build) (unmet string, ended bool, err error) {
+	deps := make([]*model.Build, len(bld.Proto.DependsOn))
+	for i, dep := range bld.Proto.DependsOn {
+		deps[i] = &model.Build{ID: dep.BuildId}
+	}
+	merr := make(errors.MultiError, len(deps))
+	if err := datastore.Get(ctx, deps); err != nil {
+		var ok bool
+		if merr, ok = err.(errors.MultiError); !ok {
+			return "", false, errors.Annotate(err, "failed to fetch dependencies of build %d", bld.ID).Tag(transient.Tag).Err()
+		}
+	}
+
+	ended = true
+	for i, dep := range bld.Proto.DependsOn {
+		switch err := merr[i]; {
+		case err == datastore.ErrNoSuchEntity:
+			return fmt.Sprintf("dependency %d not found", dep.BuildId), true, nil
+		case err != nil:
+			return "", false, errors.Annotate(err, "failed to fetch dependency %d of build %d", dep.BuildId, bld.ID).Tag(transient.Tag).Err()
+		case !protoutil.IsEnded(deps[i].Proto.Status):
+			ended = false
+		case !dependencyConditionMet(dep.Condition, deps[i].Proto.Status):
+			return fmt.Sprintf("dependency %d ended with %s", dep.BuildId, deps[i].Proto.Status), true, nil
+		}
+	}
+	return "", ended, nil
+}
+
+// HandleCheckBuildDependencies creates the task of the given build once all of
+// its dependencies have ended with their conditions met.
+//
+// Cancels the build as soon as a dependency ends without meeting its
+// condition. That in turn triggers the builds depending on it, so the
+// cancellation propagates through the dependency graph.
+func HandleCheckBuildDependencies(ctx context.Context, buildID int64) error {
+	bld := &model.Build{ID: buildID}
+	switch err := datastore.Get(ctx, bld); {
+	case err == datastore.ErrNoSuchEntity:
+		logging.Warningf(ctx, "build %d not found", buildID)
+		return nil
+	case err != nil:
+		return errors.Annotate(err, "failed to fetch build %d", buildID).Tag(transient.Tag).Err()
+	case bld.DependenciesMet || protoutil.IsEnded(bld.Proto.Status) || bld.Proto.CancelTime != nil:
+		return nil
+	}
+
+	switch unmet, ended, err := checkDependencies(ctx, bld); {
+	case err != nil:
+		return err
+	case unmet != "":
+		logging.Infof(ctx, "canceling build %d: %s", buildID, unmet)
+		_, err := StartCancel(ctx, buildID, fmt.Sprintf("canceled since %s", unmet))
+		return err
+	case !ended:
+		// Wait for TriggerDependents of the remaining dependencies.
+		return nil
+	}
+
+	err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+		infra := &model.BuildInfra{Build: datastore.KeyForObj(ctx, bld)}
+		if err := datastore.Get(ctx, bld, infra); err != nil {
+			return errors.Annotate(err, "failed to fetch build %d", buildID).Err()
+		}
+		if bld.DependenciesMet || protoutil.IsEnded(bld.Proto.Status) || bld.Proto.CancelTime != nil {
+			return nil
+		}
+		bld.DependenciesMet = true
+		if err := datastore.Put(ctx, bld); err != nil {
+			return errors.Annotate(err, "failed to store build %d", buildID).Err()
+		}
+		switch ok, err := ScheduleBuildTask(ctx, bld, infra.Proto); {
+		case err != nil:
+			return err
+		case !ok:
+			logging.Debugf(ctx, "skipped creating swarming task for build %d", buildID)
+		}
+		return nil
+	}, nil)
+	return errors.Annotate(err, "failed to schedule the task of build %d", buildID).Tag(transient.Tag).Err()
+}
+
+// HandleTriggerDependents enqueues CheckBuildDependencies tasks for the
+// incomplete builds depending on the given build.
+func HandleTriggerDependents(ctx context.Context, buildID int64) error {
+	var dependents []int64
+	q := datastore.NewQuery(model.BuildKind).Eq("depends_on", buildID)
+	err := datastore.Run(ctx, q, func(bld *model.Build) error {
+		if !protoutil.IsEnded(bld.Proto.Status) && !bld.DependenciesMet {
+			dependents = append(dependents, bld.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to fetch dependents of build %d", buildID).Tag(transient.Tag).Err()
+	}
+
+	for _, id := range dependents {
+		if err := CheckBuildDependencies(ctx, id); err != nil {
+			return errors.Annotate(err, "failed to enqueue CheckBuildDependencies for build %d", id).Tag(transient.Tag).Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/filter/txndefer"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/tq"
+
+	"go.chromium.org/luci/buildbucket/appengine/internal/metrics"
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestBuildDependencies(t *testing.T) {
+	t.Parallel()
+
+	Convey("BuildDependencies", t, func() {
+		ctx := txndefer.FilterRDS(memory.Use(context.Background()))
+		ctx = metrics.WithServiceInfo(ctx, "svc", "job", "ins")
+		datastore.GetTestable(ctx).AutoIndex(true)
+		datastore.GetTestable(ctx).Consistent(true)
+		ctx, sch := tq.TestingContext(ctx, nil)
+		ctx, _ = testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+
+		builder := &pb.BuilderID{
+			Project: "project",
+			Bucket:  "bucket",
+			Builder: "builder",
+		}
+		putBuild := func(id int64, status pb.Status, deps ...*pb.BuildDependency) {
+			bld := &model.Build{
+				ID: id,
+				Proto: &pb.Build{
+					Id:        id,
+					Builder:   builder,
+					Status:    status,
+					DependsOn: deps,
+				},
+			}
+			So(datastore.Put(ctx, bld, &model.BuildInfra{
+				Build: datastore.KeyForObj(ctx, bld),
+				Proto: &pb.BuildInfra{
+					Swarming: &pb.BuildInfra_Swarming{Hostname: "swarming.example.com"},
+				},
+			}), ShouldBeNil)
+		}
+		getBuild := func(id int64) *model.Build {
+			bld := &model.Build{ID: id}
+			So(datastore.Get(ctx, bld), ShouldBeNil)
+			return bld
+		}
+
+		Convey("HandleCheckBuildDependencies", func() {
+			Convey("not found", func() {
+				So(HandleCheckBuildDependencies(ctx, 3), ShouldBeNil)
+				So(sch.Tasks(), ShouldBeEmpty)
+			})
+
+			Convey("pending", func() {
+				putBuild(1, pb.Status_SUCCESS)
+				putBuild(2, pb.Status_STARTED)
+				putBuild(3, pb.Status_SCHEDULED, &pb.BuildDependency{BuildId: 1}, &pb.BuildDependency{BuildId: 2})
+				So(HandleCheckBuildDependencies(ctx, 3), ShouldBeNil)
+				So(sch.Tasks(), ShouldBeEmpty)
+				So(getBuild(3).DependenciesMet, ShouldBeFalse)
+			})
+
+			Convey("met", func() {
+				putBuild(1, pb.Status_SUCCESS)
+				putBuild(2, pb.Status_INFRA_FAILURE)
+				putBuild(3, pb.Status_SCHEDULED,
+					&pb.BuildDependency{BuildId: 1},
+					&pb.BuildDependency{BuildId: 2, Condition: pb.BuildDependency_FAILURE},
+				)
+				So(HandleCheckBuildDependencies(ctx, 3), ShouldBeNil)
+				So(sch.Tasks().Payloads(), ShouldResembleProto, []proto.Message{
+					&taskdefs.CreateSwarmingTask{BuildId: 3},
+				})
+				So(getBuild(3).DependenciesMet, ShouldBeTrue)
+
+				// The task is created only once.
+				So(HandleCheckBuildDependencies(ctx, 3), ShouldBeNil)
+				So(sch.Tasks(), ShouldHaveLength, 1)
+			})
+
+			Convey("always", func() {
+				putBuild(1, pb.Status_CANCELED)
+				putBuild(3, pb.Status_SCHEDULED, &pb.BuildDependency{BuildId: 1, Condition: pb.BuildDependency_ALWAYS})
+				So(HandleCheckBuildDependencies(ctx, 3), ShouldBeNil)
+				So(getBuild(3).DependenciesMet, ShouldBeTrue)
+			})
+
+			Convey("unmet", func() {
+				putBuild(1, pb.Status_FAILURE)
+				putBuild(2, pb.Status_STARTED)
+				putBuild(3, pb.Status_SCHEDULED, &pb.BuildDependency{BuildId: 1}, &pb.BuildDependency{BuildId: 2})
+				So(HandleCheckBuildDependencies(ctx, 3), ShouldBeNil)
+
+				bld := getBuild(3)
+				So(bld.DependenciesMet, ShouldBeFalse)
+				So(bld.Proto.CancelTime, ShouldNotBeNil)
+				So(bld.Proto.CancellationMarkdown, ShouldEqual, "canceled since dependency 1 ended with FAILURE")
+				So(sch.Tasks().Payloads(), ShouldResembleProto, []proto.Message{
+					&taskdefs.CancelBuildTask{BuildId: 3},
+				})
+			})
+
+			Convey("dependency not found", func() {
+				putBuild(3, pb.Status_SCHEDULED, &pb.BuildDependency{BuildId: 1})
+				So(HandleCheckBuildDependencies(ctx, 3), ShouldBeNil)
+				So(getBuild(3).Proto.CancellationMarkdown, ShouldEqual, "canceled since dependency 1 not found")
+			})
+
+			Convey("ended", func() {
+				putBuild(1, pb.Status_SUCCESS)
+				putBuild(3, pb.Status_CANCELED, &pb.BuildDependency{BuildId: 1})
+				So(HandleCheckBuildDependencies(ctx, 3), ShouldBeNil)
+				So(sch.Tasks(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("HandleTriggerDependents", func() {
+			putBuild(1, pb.Status_SUCCESS)
+			putBuild(2, pb.Status_SCHEDULED, &pb.BuildDependency{BuildId: 1})
+			putBuild(3, pb.Status_CANCELED, &pb.BuildDependency{BuildId: 1})
+			putBuild(4, pb.Status_SCHEDULED)
+
+			So(HandleTriggerDependents(ctx, 1), ShouldBeNil)
+			So(sch.Tasks().Payloads(), ShouldResembleProto, []proto.Message{
+				&taskdefs.CheckBuildDependencies{BuildId: 2},
+			})
+		})
+
+		Convey("Cancel triggers dependents", func() {
+			bld := &model.Build{
+				ID:            1,
+				Proto:         &pb.Build{Id: 1, Builder: builder, Status: pb.Status_STARTED},
+				HasDependents: true,
+			}
+			So(datastore.Put(ctx, bld), ShouldBeNil)
+			_, err := Cancel(ctx, 1)
+			So(err, ShouldBeNil)
+
+			var triggered []int64
+			for _, p := range sch.Tasks().Payloads() {
+				if t, ok := p.(*taskdefs.TriggerDependents); ok {
+					triggered = append(triggered, t.BuildId)
+				}
+			}
+			So(triggered, ShouldResemble, []int64{1})
+		})
+	})
+}
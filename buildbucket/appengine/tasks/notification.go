@@ -28,8 +28,10 @@ import (
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/redisconn"
 	"go.chromium.org/luci/server/tq"
 
+	"go.chromium.org/luci/buildbucket/appengine/internal/buildstream"
 	"go.chromium.org/luci/buildbucket/appengine/internal/clients"
 	"go.chromium.org/luci/buildbucket/appengine/internal/compression"
 	"go.chromium.org/luci/buildbucket/appengine/model"
@@ -208,9 +210,17 @@ func PublishBuildsV2Notification(ctx context.Context, buildID int64, topic *pb.B
 		return publishToExternalTopic(ctx, msg, generateBuildsV2Attributes(p), topic.Name, prj)
 	default:
 		//  publish to the internal `builds_v2` topic.
-		return tq.AddTask(ctx, &tq.Task{
+		if err := tq.AddTask(ctx, &tq.Task{
 			Payload: bldV2,
-		})
+		}); err != nil {
+			return err
+		}
+		// Also deliver the update to WatchBuilds streams. It is best effort,
+		// failing the task would publish duplicate Pub/Sub notifications.
+		if err := buildstream.Publish(ctx, p); err != nil && !errors.Is(err, redisconn.ErrNotConfigured) {
+			logging.Warningf(ctx, "Failed to publish build %d to WatchBuilds streams: %s", buildID, err)
+		}
+		return nil
 	}
 }
 
@@ -0,0 +1,103 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.chromium.org/luci/server/tq"
+
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
+	pb "go.chromium.org/luci/buildbucket/proto"
+)
+
+// defaultMaxRetryBackoff is the maximum delay before a retry if the retry
+// policy doesn't specify one.
+const defaultMaxRetryBackoff = 10 * time.Minute
+
+// RetryBuildTasks describes how to route tasks retrying ended builds.
+//
+// The handler is implemented in the rpc package, which creates builds.
+var RetryBuildTasks = tq.RegisterTaskClass(tq.TaskClass{
+	ID:        "retry-build",
+	Kind:      tq.Transactional,
+	Prototype: (*taskdefs.RetryBuild)(nil),
+	Queue:     "backend-go-default",
+})
+
+// attempt returns the number of the attempt the build is, starting with 1.
+func attempt(b *pb.Build) int32 {
+	if a := b.Retry.GetAttempt(); a > 0 {
+		return a
+	}
+	return 1
+}
+
+// retryConditionMatches returns true if the ended build matches the condition.
+func retryConditionMatches(c *pb.BuilderConfig_RetryPolicy_Condition, b *pb.Build) bool {
+	matches := func(t pb.Trinary, set bool) bool {
+		return t == pb.Trinary_UNSET || (t == pb.Trinary_YES) == set
+	}
+	return b.Status == c.Status &&
+		matches(c.ResourceExhaustion, b.StatusDetails.GetResourceExhaustion() != nil) &&
+		matches(c.Timeout, b.StatusDetails.GetTimeout() != nil)
+}
+
+// retryDelay returns how long to wait before retrying the ended build, or
+// false if the build shouldn't be retried.
+func retryDelay(bld *model.Build) (time.Duration, bool) {
+	p := bld.RetryPolicy
+	if p == nil || bld.Proto.Retriable == pb.Trinary_NO || attempt(bld.Proto) >= p.MaxAttempts {
+		return 0, false
+	}
+
+	matches := len(p.Conditions) == 0 && bld.Proto.Status == pb.Status_INFRA_FAILURE
+	for _, c := range p.Conditions {
+		if retryConditionMatches(c, bld.Proto) {
+			matches = true
+			break
+		}
+	}
+	if !matches {
+		return 0, false
+	}
+
+	maxBackoff := defaultMaxRetryBackoff
+	if p.MaxBackoff != nil {
+		maxBackoff = p.MaxBackoff.AsDuration()
+	}
+	delay := p.Backoff.AsDuration()
+	for i := int32(1); i < attempt(bld.Proto) && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff), true
+}
+
+// MaybeRetryBuild enqueues a task to retry the ended build, if the retry
+// policy of its builder says so.
+func MaybeRetryBuild(ctx context.Context, bld *model.Build) error {
+	delay, ok := retryDelay(bld)
+	if !ok {
+		return nil
+	}
+	return tq.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("retry-build-%d", bld.ID),
+		Payload: &taskdefs.RetryBuild{BuildId: bld.ID},
+		Delay:   delay,
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/filter/txndefer"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/tq"
+
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRetryBuild(t *testing.T) {
+	Convey("RetryBuild", t, func() {
+		policy := &pb.BuilderConfig_RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     durationpb.New(time.Minute),
+		}
+		bld := &model.Build{
+			ID: 1,
+			Proto: &pb.Build{
+				Id: 1,
+				Builder: &pb.BuilderID{
+					Project: "project",
+					Bucket:  "bucket",
+					Builder: "builder",
+				},
+				Status: pb.Status_INFRA_FAILURE,
+			},
+			RetryPolicy: policy,
+		}
+
+		Convey("retryDelay", func() {
+			Convey("no policy", func() {
+				bld.RetryPolicy = nil
+				_, ok := retryDelay(bld)
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey("default conditions", func() {
+				delay, ok := retryDelay(bld)
+				So(ok, ShouldBeTrue)
+				So(delay, ShouldEqual, time.Minute)
+
+				bld.Proto.Status = pb.Status_FAILURE
+				_, ok = retryDelay(bld)
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey("not retriable", func() {
+				bld.Proto.Retriable = pb.Trinary_NO
+				_, ok := retryDelay(bld)
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey("conditions", func() {
+				policy.Conditions = []*pb.BuilderConfig_RetryPolicy_Condition{
+					{Status: pb.Status_FAILURE, Timeout: pb.Trinary_YES},
+					{Status: pb.Status_INFRA_FAILURE, ResourceExhaustion: pb.Trinary_NO},
+				}
+
+				_, ok := retryDelay(bld)
+				So(ok, ShouldBeTrue)
+
+				bld.Proto.StatusDetails = &pb.StatusDetails{ResourceExhaustion: &pb.StatusDetails_ResourceExhaustion{}}
+				_, ok = retryDelay(bld)
+				So(ok, ShouldBeFalse)
+
+				bld.Proto.Status = pb.Status_FAILURE
+				bld.Proto.StatusDetails = &pb.StatusDetails{Timeout: &pb.StatusDetails_Timeout{}}
+				_, ok = retryDelay(bld)
+				So(ok, ShouldBeTrue)
+			})
+
+			Convey("backoff", func() {
+				bld.Proto.Retry = &pb.Build_Retry{RetryOf: 1, OriginalBuildId: 1, Attempt: 2}
+				delay, ok := retryDelay(bld)
+				So(ok, ShouldBeTrue)
+				So(delay, ShouldEqual, 2*time.Minute)
+
+				policy.MaxAttempts = 10
+				bld.Proto.Retry.Attempt = 6
+				delay, ok = retryDelay(bld)
+				So(ok, ShouldBeTrue)
+				So(delay, ShouldEqual, defaultMaxRetryBackoff)
+
+				policy.MaxBackoff = durationpb.New(3 * time.Minute)
+				delay, ok = retryDelay(bld)
+				So(ok, ShouldBeTrue)
+				So(delay, ShouldEqual, 3*time.Minute)
+			})
+
+			Convey("max attempts", func() {
+				bld.Proto.Retry = &pb.Build_Retry{RetryOf: 1, OriginalBuildId: 1, Attempt: 3}
+				_, ok := retryDelay(bld)
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("MaybeRetryBuild", func() {
+			ctx := txndefer.FilterRDS(memory.Use(context.Background()))
+			ctx, _ = testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+			ctx, sch := tq.TestingContext(ctx, nil)
+
+			maybeRetry := func() error {
+				return datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+					return MaybeRetryBuild(ctx, bld)
+				}, nil)
+			}
+
+			So(maybeRetry(), ShouldBeNil)
+			So(sch.Tasks().Payloads(), ShouldResembleProto, []proto.Message{
+				&taskdefs.RetryBuild{BuildId: 1},
+			})
+			So(sch.Tasks()[0].ETA.Sub(testclock.TestRecentTimeUTC), ShouldEqual, time.Minute)
+
+			bld.Proto.Status = pb.Status_SUCCESS
+			So(maybeRetry(), ShouldBeNil)
+			So(sch.Tasks(), ShouldHaveLength, 1)
+		})
+
+	})
+}
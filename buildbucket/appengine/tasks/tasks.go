@@ -318,6 +318,28 @@ func init() {
 			return CheckLiveness(ctx, t.BuildId, t.HeartbeatTimeout)
 		},
 	})
+
+	tq.RegisterTaskClass(tq.TaskClass{
+		ID:        "check-build-dependencies",
+		Kind:      tq.FollowsContext,
+		Prototype: (*taskdefs.CheckBuildDependencies)(nil),
+		Queue:     "backend-go-default",
+		Handler: func(ctx context.Context, payload proto.Message) error {
+			t := payload.(*taskdefs.CheckBuildDependencies)
+			return HandleCheckBuildDependencies(ctx, t.BuildId)
+		},
+	})
+
+	tq.RegisterTaskClass(tq.TaskClass{
+		ID:        "trigger-dependents",
+		Kind:      tq.Transactional,
+		Prototype: (*taskdefs.TriggerDependents)(nil),
+		Queue:     "backend-go-default",
+		Handler: func(ctx context.Context, payload proto.Message) error {
+			t := payload.(*taskdefs.TriggerDependents)
+			return HandleTriggerDependents(ctx, t.BuildId)
+		},
+	})
 }
 
 // CancelBackendTask enqueues a task queue task to cancel the given Backend
@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/maruel/subcommands"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	"go.chromium.org/luci/auth"
@@ -57,9 +59,15 @@ type baseCommandRun struct {
 	noColor            bool
 	scheduleBuildToken string
 
+	authenticator  *auth.Authenticator
+	userAgent      string
 	httpClient     *http.Client
 	buildsClient   pb.BuildsClient
 	buildersClient pb.BuildersClient
+
+	// A gRPC connection for streaming RPCs, see grpcConn.
+	grpcM          sync.Mutex
+	grpcClientConn *grpc.ClientConn
 }
 
 func (r *baseCommandRun) RegisterDefaultFlags(p Params) {
@@ -89,7 +97,8 @@ func (r *baseCommandRun) initClients(ctx context.Context, rFn retry.Factory) err
 	if err != nil {
 		return err
 	}
-	r.httpClient, err = auth.NewAuthenticator(ctx, auth.SilentLogin, authOpts).Client()
+	r.authenticator = auth.NewAuthenticator(ctx, auth.SilentLogin, authOpts)
+	r.httpClient, err = r.authenticator.Client()
 	switch {
 	case err == auth.ErrLoginRequired:
 		return errors.New("Login required: run `bb auth-login`")
@@ -128,7 +137,8 @@ func (r *baseCommandRun) initClients(ctx context.Context, rFn retry.Factory) err
 	if err != nil {
 		return err
 	}
-	rpcOpts.UserAgent = fmt.Sprintf("buildbucket CLI, instanceID=%q", info.InstanceID)
+	r.userAgent = fmt.Sprintf("buildbucket CLI, instanceID=%q", info.InstanceID)
+	rpcOpts.UserAgent = r.userAgent
 	r.buildsClient = pb.NewBuildsPRPCClient(&prpc.Client{
 		C:       r.httpClient,
 		Host:    r.host,
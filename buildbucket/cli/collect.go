@@ -39,6 +39,9 @@ func cmdCollect(p Params) *subcommands.Command {
 
 			Optionally writes build details into an output file as JSON array of
 			bulidbucket.v2.Build proto messages.
+
+			Builds are watched using a stream of their updates if the server
+			supports it. Otherwise, their status is polled every -interval.
 		`),
 		CommandRun: func() subcommands.CommandRun {
 			r := &collectRun{}
@@ -69,6 +72,33 @@ func (r *collectRun) checkBuildStatus(ctx context.Context, req *pb.GetBuildReque
 	return r.buildsClient.GetBuildStatus(ctx, getStatusReq)
 }
 
+// watchBuild waits for the build to end using WatchBuilds.
+//
+// Returns errNoWatch if the server doesn't support it.
+func (r *collectRun) watchBuild(ctx context.Context, arg string, mask *pb.BuildMask) (*pb.Build, error) {
+	id, err := r.retrieveBuildID(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	var ended *pb.Build
+	err = r.watchBuilds(ctx, &pb.WatchBuildsRequest{BuildIds: []int64{id}, Mask: mask}, func(b *pb.Build) bool {
+		if protoutil.IsEnded(b.Status) {
+			ended = b
+			return false
+		}
+		logging.Infof(ctx, "build %d is still %s", b.Id, b.Status)
+		return true
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case ended == nil:
+		return nil, ctx.Err()
+	}
+	return ended, nil
+}
+
 func (r *collectRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
 	ctx := cli.GetContext(a, r, env)
 	// bb collect should retry infinitely on transient or deadline_exceeded errors.
@@ -96,6 +126,9 @@ func (r *collectRun) Run(a subcommands.Application, args []string, env subcomman
 		inverval = 20 * time.Second
 	}
 
+	mask := buildMask(fields)
+	defer r.closeGRPCConn()
+
 	return r.PrintAndDone(ctx, args, unordered, func(ctx context.Context, arg string) (*pb.Build, error) {
 		req, err := protoutil.ParseGetBuildRequest(arg)
 		if err != nil {
@@ -103,6 +136,15 @@ func (r *collectRun) Run(a subcommands.Application, args []string, env subcomman
 		}
 		req.Fields = fields
 
+		switch build, err := r.watchBuild(ctx, arg, mask); {
+		case err == errNoWatch:
+			logging.Debugf(ctx, "Falling back to polling the status of %s", arg)
+		case err != nil:
+			return nil, err
+		default:
+			return build, nil
+		}
+
 		for {
 			build, err := r.checkBuildStatus(ctx, req)
 			if err != nil {
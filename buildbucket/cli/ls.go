@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -30,6 +31,8 @@ import (
 	bb "go.chromium.org/luci/buildbucket"
 	"go.chromium.org/luci/common/cli"
 	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/sync/parallel"
 	"go.chromium.org/luci/common/system/pager"
 
 	luciflag "go.chromium.org/luci/common/flag"
@@ -114,6 +117,14 @@ func cmdLS(p Params) *subcommands.Command {
 			r.Flags.BoolVar(&r.noPager, "nopage", false, doc(`
 				Disable paging.
 			`))
+			r.Flags.BoolVar(&r.watch, "watch", false, doc(`
+				After listing the builds, keep printing builds that match the
+				criteria as they change status, until interrupted.
+				Flag -n limits only the initial listing. Implies -nopage.
+
+				If the server can't stream build updates, polls the newest builds
+				every 30s instead.
+			`))
 			r.Flags.Var(luciflag.MessageSliceFlag(&r.predicates), "predicate", doc(`
 				BuildPredicate that all builds in the response should match.
 
@@ -133,6 +144,10 @@ func cmdLS(p Params) *subcommands.Command {
 	}
 }
 
+// lsPollInterval is how often `ls -watch` polls builds if the server can't
+// stream them.
+const lsPollInterval = 30 * time.Second
+
 // flagsMEWithPredicate defines a set of flags that are mutually exclusive with predicate flag
 var flagsMEWithPredicate = stringset.NewFromSlice("cl", "exp", "status", "t")
 
@@ -148,6 +163,7 @@ type lsRun struct {
 	includeExperimental bool
 	limit               int
 	noPager             bool
+	watch               bool
 }
 
 func (r *lsRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -169,36 +185,117 @@ func (r *lsRun) Run(a subcommands.Application, args []string, env subcommands.En
 	disableColors := r.noColor || shouldDisableColors()
 
 	listBuilds := func(ctx context.Context, out io.WriteCloser) int {
+		searchCtx, cancelSearch := context.WithCancel(ctx)
+		defer cancelSearch()
+
 		buildC := make(chan *pb.Build)
-		errC := make(chan error)
+		errC := make(chan error, 1)
 		go func() {
-			err := protoutil.Search(ctx, buildC, r.buildsClient, reqs...)
+			err := protoutil.Search(searchCtx, buildC, r.buildsClient, reqs...)
 			close(buildC)
 			errC <- err
 		}()
 
 		p := newPrinter(out, disableColors, time.Now)
 		count := 0
+		seen := map[int64]pb.Status{}
 		for b := range buildC {
 			r.printBuild(p, b, count == 0)
+			seen[b.Id] = b.Status
 			count++
 			if count == r.limit {
-				return 0
+				if !r.watch {
+					return 0
+				}
+				cancelSearch()
+				for range buildC {
+				}
 			}
 		}
 
 		if err := <-errC; err != nil && err != context.Canceled {
 			return r.done(ctx, err)
 		}
+
+		if r.watch {
+			if err := r.watchUpdates(ctx, p, reqs, seen, count == 0); err != nil {
+				return r.done(ctx, err)
+			}
+		}
 		return 0
 	}
 
-	if r.noPager {
+	if r.noPager || r.watch {
 		return listBuilds(ctx, os.Stdout)
 	}
 	return pager.Main(ctx, listBuilds)
 }
 
+// watchUpdates prints builds matching the requests as they change status,
+// until the context is done.
+//
+// `seen` maps IDs of already printed builds to their printed status.
+func (r *lsRun) watchUpdates(ctx context.Context, p *printer, reqs []*pb.SearchBuildsRequest, seen map[int64]pb.Status, first bool) error {
+	fields, err := r.FieldMask()
+	if err != nil {
+		return err
+	}
+	mask := buildMask(fields)
+	defer r.closeGRPCConn()
+
+	var m sync.Mutex
+	printUpdate := func(b *pb.Build) bool {
+		m.Lock()
+		defer m.Unlock()
+		if status, ok := seen[b.Id]; ok && status == b.Status {
+			return true
+		}
+		seen[b.Id] = b.Status
+		r.printBuild(p, b, first)
+		first = false
+		return true
+	}
+
+	return parallel.FanOutIn(func(work chan<- func() error) {
+		for _, req := range reqs {
+			req := req
+			work <- func() error {
+				err := r.watchBuilds(ctx, &pb.WatchBuildsRequest{Predicate: req.Predicate, Mask: mask}, printUpdate)
+				if err == errNoWatch {
+					logging.Warningf(ctx, "%s, polling the newest builds every %s instead", err, lsPollInterval)
+					return r.pollBuilds(ctx, req, printUpdate)
+				}
+				return err
+			}
+		}
+	})
+}
+
+// pollBuilds periodically searches for the newest builds matching the request
+// and calls cb for each of them, oldest first, until the context is done.
+func (r *lsRun) pollBuilds(ctx context.Context, req *pb.SearchBuildsRequest, cb func(*pb.Build) bool) error {
+	req = proto.Clone(req).(*pb.SearchBuildsRequest)
+	req.PageToken = ""
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(lsPollInterval):
+		}
+
+		rsp, err := r.buildsClient.SearchBuilds(ctx, req)
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil:
+			return err
+		}
+		for i := len(rsp.Builds) - 1; i >= 0; i-- {
+			cb(rsp.Builds[i])
+		}
+	}
+}
+
 // parseSearchRequests converts flags and arguments to search requests.
 func (r *lsRun) parseSearchRequests(ctx context.Context, args []string) ([]*pb.SearchBuildsRequest, error) {
 	predicates, err := r.parseBuildPredicates(ctx, args)
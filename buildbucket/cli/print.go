@@ -198,6 +198,15 @@ func (p *printer) Build(b *pb.Build) {
 		p.f("%s\n", b.CreatedBy)
 	}
 
+	if r := b.Retry; r != nil {
+		p.attr("Retry of")
+		p.f("http://ci.chromium.org/b/%d (attempt %d", r.RetryOf, r.Attempt)
+		if r.OriginalBuildId != r.RetryOf {
+			p.f(", original http://ci.chromium.org/b/%d", r.OriginalBuildId)
+		}
+		p.f(")\n")
+	}
+
 	// Commit, CLs and tags.
 	if c := b.Input.GetGitilesCommit(); c != nil {
 		p.attr("Commit")
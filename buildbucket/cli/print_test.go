@@ -220,6 +220,26 @@ func TestPrint(t *testing.T) {
 			So(buf.String(), ShouldEqual, expectedBuildPrinted)
 		})
 
+		Convey("Retry", func() {
+			build := &pb.Build{
+				Id:      8917899588926498064,
+				Status:  pb.Status_STARTED,
+				Builder: &pb.BuilderID{Project: "chromium", Bucket: "try", Builder: "linux-rel"},
+				Retry: &pb.Build_Retry{
+					RetryOf:         8917899588926498063,
+					OriginalBuildId: 8917899588926498062,
+					Attempt:         3,
+				},
+			}
+			expectedBuildPrinted := ansifyTemplate(`<white+b><white+u><yellow+h>http://ci.chromium.org/b/8917899588926498064<reset><white+b><yellow+h> STARTED   'chromium/try/linux-rel'<reset>
+<white+b>Retry of<reset>: http://ci.chromium.org/b/8917899588926498063 (attempt 3, original http://ci.chromium.org/b/8917899588926498062)
+`)
+			p.Build(build)
+
+			So(p.Err, ShouldBeNil)
+			So(buf.String(), ShouldEqual, expectedBuildPrinted)
+		})
+
 		Convey("Partial build", func() {
 			// Only Id, Status and Builder are available
 			build := &pb.Build{
@@ -44,6 +44,7 @@ var defaultFieldMask = &field_mask.FieldMask{
 		"input.gerrit_changes",
 		"input.gitiles_commit",
 		"number",
+		"retry",
 		"start_time",
 		"status",
 		"status_details",
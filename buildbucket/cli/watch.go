@@ -0,0 +1,161 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/lhttp"
+	"go.chromium.org/luci/common/logging"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+)
+
+// errNoWatch is returned by watchBuilds if the server doesn't support
+// WatchBuilds, in which case the caller should fall back to polling.
+var errNoWatch = errors.New("the server doesn't support watching builds")
+
+// maxWatchRetryDelay limits the delay between attempts to reconnect a broken
+// WatchBuilds stream.
+const maxWatchRetryDelay = time.Minute
+
+// buildMask converts a field mask returned by printRun.FieldMask to a build
+// mask.
+func buildMask(fields *field_mask.FieldMask) *pb.BuildMask {
+	if len(fields.GetPaths()) == 1 && fields.Paths[0] == "*" {
+		return &pb.BuildMask{AllFields: true}
+	}
+	return &pb.BuildMask{Fields: fields}
+}
+
+// grpcConn returns a gRPC connection to the Buildbucket host.
+//
+// The connection is dialed on first use. Must be called after initClients.
+func (r *baseCommandRun) grpcConn(ctx context.Context) (*grpc.ClientConn, error) {
+	r.grpcM.Lock()
+	defer r.grpcM.Unlock()
+	if r.grpcClientConn != nil {
+		return r.grpcClientConn, nil
+	}
+
+	target := r.host
+	if !strings.Contains(target, ":") {
+		target += ":443"
+	}
+	opts := []grpc.DialOption{grpc.WithUserAgent(r.userAgent)}
+	if lhttp.IsLocalHost(r.host) {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	} else {
+		creds, err := r.authenticator.PerRPCCredentials()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts,
+			grpc.WithTransportCredentials(credentials.NewTLS(nil)),
+			grpc.WithPerRPCCredentials(creds),
+		)
+	}
+
+	conn, err := grpc.DialContext(ctx, target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot dial %s: %w", target, err)
+	}
+	r.grpcClientConn = conn
+	return conn, nil
+}
+
+// closeGRPCConn closes the gRPC connection if it was dialed.
+func (r *baseCommandRun) closeGRPCConn() {
+	r.grpcM.Lock()
+	defer r.grpcM.Unlock()
+	if r.grpcClientConn != nil {
+		r.grpcClientConn.Close()
+		r.grpcClientConn = nil
+	}
+}
+
+// watchBuilds calls cb for each build streamed by WatchBuilds, until cb
+// returns false or the context is done.
+//
+// Reconnects if an established stream breaks. Returns errNoWatch if the stream
+// can't be established because the server doesn't support WatchBuilds or
+// doesn't serve gRPC at all.
+func (r *baseCommandRun) watchBuilds(ctx context.Context, req *pb.WatchBuildsRequest, cb func(*pb.Build) bool) error {
+	conn, err := r.grpcConn(ctx)
+	if err != nil {
+		return err
+	}
+	client := pb.NewBuildsClient(conn)
+
+	// watch runs a single stream and returns whether it was established.
+	watch := func() (established, stop bool, err error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := client.WatchBuilds(ctx, req)
+		if err != nil {
+			return false, false, err
+		}
+		// The server sends headers as soon as it starts watching.
+		if _, err := stream.Header(); err != nil {
+			return false, false, err
+		}
+		for {
+			rsp, err := stream.Recv()
+			if err != nil {
+				return true, false, err
+			}
+			if !cb(rsp.Build) {
+				return true, true, nil
+			}
+		}
+	}
+
+	delay := time.Second
+	for {
+		established, stop, err := watch()
+		switch code := status.Code(err); {
+		case stop || ctx.Err() != nil:
+			return nil
+		case code == codes.Unimplemented || (!established && code == codes.Unavailable):
+			logging.Debugf(ctx, "Cannot watch builds: %s", err)
+			return errNoWatch
+		case code != codes.Unavailable:
+			return err
+		}
+
+		if established {
+			delay = time.Second
+		}
+		logging.Warningf(ctx, "Build stream broke, reconnecting in %s: %s", delay, err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, maxWatchRetryDelay)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Binary localbackend is a Buildbucket TaskBackend which runs builds as local
+// processes or containers on the host it runs on.
+//
+// Register it in the Buildbucket service config as a backend in full mode with
+// the same target as passed via -target. See
+// go.chromium.org/luci/buildbucket/localbackend for details.
+package main
+
+import (
+	"context"
+	"flag"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server"
+
+	"go.chromium.org/luci/buildbucket/localbackend"
+	pb "go.chromium.org/luci/buildbucket/proto"
+)
+
+func main() {
+	opts := localbackend.Options{}
+	caller := ""
+	flag.StringVar(&opts.Target, "target", "", "The target of this backend in the Buildbucket config, e.g. local://worker-1.")
+	flag.StringVar(&opts.RootDir, "root-dir", "", "Directory for task directories, named caches, agents and logs.")
+	flag.IntVar(&opts.MaxConcurrency, "max-concurrency", 0, "How many tasks may run at the same time. Defaults to the number of CPUs.")
+	flag.StringVar(&opts.AgentPath, "agent", "", "If set, run this agent binary instead of the one requested by Buildbucket.")
+	flag.StringVar(&caller, "buildbucket-account", "cr-buildbucket@appspot.gserviceaccount.com", "The service account Buildbucket calls the backend as.")
+
+	server.Main(nil, nil, func(srv *server.Server) error {
+		opts.Caller = identity.Identity("user:" + caller)
+
+		notifier := localbackend.NewPubSubNotifier()
+		opts.Notifier = notifier
+		backend, err := localbackend.New(opts)
+		if err != nil {
+			notifier.Close()
+			return errors.Annotate(err, "bad flags").Err()
+		}
+		srv.RegisterCleanup(func(ctx context.Context) {
+			backend.Close(ctx)
+			notifier.Close()
+		})
+
+		pb.RegisterTaskBackendServer(srv, backend)
+		return nil
+	})
+}
@@ -0,0 +1,677 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localbackend implements a Buildbucket TaskBackend which runs builds
+// on the host it runs on.
+//
+// Each task runs the Buildbucket agent (bbagent) either as a subprocess of the
+// backend or in a Docker or Podman container, as chosen by the "mode" key of
+// the builder's backend config. Task status changes are published to the
+// UpdateBuildTask Pub/Sub topic from the RunTask request. Buildbucket also
+// polls them with FetchTasks, which covers lost messages.
+//
+// Tasks are kept in memory. A restarted backend forgets its tasks, and their
+// builds eventually time out.
+package localbackend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/auth"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/buildbucket/protoutil"
+)
+
+// taskRetention is how long ended tasks are remembered for FetchTasks.
+const taskRetention = 24 * time.Hour
+
+// Options configure a Backend.
+type Options struct {
+	// Target is the target of this backend in the Buildbucket service config,
+	// e.g. "local://worker-1". Required.
+	Target string
+
+	// RootDir is where the backend keeps task directories, named caches,
+	// downloaded agents and agent logs. Required.
+	RootDir string
+
+	// MaxConcurrency is how many tasks may run at the same time. The rest wait
+	// in a queue. Defaults to the number of CPUs.
+	MaxConcurrency int
+
+	// AgentPath, if set, is the agent to run instead of the one from
+	// RunTaskRequest.agent.
+	AgentPath string
+
+	// Caller is the only identity allowed to call the backend, i.e. the
+	// Buildbucket service account. Required.
+	Caller identity.Identity
+
+	// Notifier sends task updates to Buildbucket. Required.
+	Notifier Notifier
+}
+
+// Backend implements pb.TaskBackendServer.
+type Backend struct {
+	pb.UnimplementedTaskBackendServer
+
+	opts Options
+
+	// agentM serializes agent downloads.
+	agentM sync.Mutex
+
+	// wg tracks the running tasks.
+	wg sync.WaitGroup
+
+	m           sync.Mutex
+	tasks       map[string]*task // task ID => task
+	queue       []*task          // tasks waiting for a free slot
+	running     int
+	cachesInUse map[string]bool
+	closed      bool
+}
+
+// Ensure Backend implements pb.TaskBackendServer.
+var _ pb.TaskBackendServer = &Backend{}
+
+// task is a single run of the agent.
+type task struct {
+	id  string
+	req *pb.RunTaskRequest
+	cfg *backendConfig
+	// ctx outlives the RunTask call.
+	ctx context.Context
+
+	// The fields below are guarded by Backend.m.
+
+	// state is the task as reported to Buildbucket.
+	state *pb.Task
+	ended time.Time
+	// stop is closed to stop the task. stopStatus and stopSummary are the
+	// resulting status of the task.
+	stop        chan struct{}
+	stopStatus  pb.Status
+	stopSummary string
+}
+
+// update is a task update to send to Buildbucket.
+type update struct {
+	t   *task
+	msg *pb.BuildTaskUpdate
+}
+
+// New returns a Backend.
+//
+// It must be closed to stop the running tasks.
+func New(opts Options) (*Backend, error) {
+	switch {
+	case opts.Target == "":
+		return nil, errors.Reason("Target is required").Err()
+	case opts.RootDir == "":
+		return nil, errors.Reason("RootDir is required").Err()
+	case opts.Caller == "":
+		return nil, errors.Reason("Caller is required").Err()
+	case opts.Notifier == nil:
+		return nil, errors.Reason("Notifier is required").Err()
+	case opts.MaxConcurrency < 0:
+		return nil, errors.Reason("MaxConcurrency must be non-negative").Err()
+	}
+	if err := opts.Caller.Validate(); err != nil {
+		return nil, errors.Annotate(err, "bad Caller").Err()
+	}
+	if opts.MaxConcurrency == 0 {
+		opts.MaxConcurrency = runtime.NumCPU()
+	}
+	root, err := filepath.Abs(opts.RootDir)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad RootDir").Err()
+	}
+	opts.RootDir = root
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, errors.Annotate(err, "failed to create RootDir").Err()
+	}
+
+	return &Backend{
+		opts:        opts,
+		tasks:       map[string]*task{},
+		cachesInUse: map[string]bool{},
+	}, nil
+}
+
+// RunTask handles a request to create a task. Implements pb.TaskBackendServer.
+//
+// Requests with the same build ID and request ID return the same task.
+func (b *Backend) RunTask(ctx context.Context, req *pb.RunTaskRequest) (*pb.RunTaskResponse, error) {
+	if err := b.checkCaller(ctx); err != nil {
+		return nil, err
+	}
+	cfg, err := b.validateRunTask(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	id := taskID(req.BuildId, req.RequestId)
+	now := clock.Now(ctx)
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	if b.closed {
+		return nil, status.Errorf(codes.Unavailable, "the backend is shutting down")
+	}
+	b.pruneLocked(now)
+	if t := b.tasks[id]; t != nil {
+		logging.Infof(ctx, "Task %s was already created", id)
+		return &pb.RunTaskResponse{Task: proto.Clone(t.state).(*pb.Task)}, nil
+	}
+
+	t := &task{
+		id:  id,
+		req: req,
+		cfg: cfg,
+		ctx: context.WithoutCancel(ctx),
+		state: &pb.Task{
+			Id: &pb.TaskID{
+				Target: b.opts.Target,
+				Id:     id,
+			},
+			Status:   pb.Status_SCHEDULED,
+			UpdateId: now.UnixNano(),
+		},
+		stop: make(chan struct{}),
+	}
+	b.tasks[id] = t
+	b.queue = append(b.queue, t)
+	rsp := &pb.RunTaskResponse{Task: proto.Clone(t.state).(*pb.Task)}
+	b.scheduleLocked()
+	return rsp, nil
+}
+
+// FetchTasks returns the current state of the tasks. Implements
+// pb.TaskBackendServer.
+func (b *Backend) FetchTasks(ctx context.Context, req *pb.FetchTasksRequest) (*pb.FetchTasksResponse, error) {
+	if err := b.checkCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	rsp := &pb.FetchTasksResponse{Responses: make([]*pb.FetchTasksResponse_Response, len(req.TaskIds))}
+	for i, id := range req.TaskIds {
+		if t := b.findLocked(id); t != nil {
+			rsp.Responses[i] = &pb.FetchTasksResponse_Response{
+				Response: &pb.FetchTasksResponse_Response_Task{Task: proto.Clone(t.state).(*pb.Task)},
+			}
+		} else {
+			rsp.Responses[i] = &pb.FetchTasksResponse_Response{
+				Response: &pb.FetchTasksResponse_Response_Error{
+					Error: status.Newf(codes.NotFound, "task %q not found", id.GetId()).Proto(),
+				},
+			}
+		}
+	}
+	return rsp, nil
+}
+
+// CancelTasks cancels the tasks. Implements pb.TaskBackendServer.
+//
+// Queued tasks are canceled right away. Running tasks are asked to terminate
+// and are killed if they don't within the grace period, they are reported as
+// canceled once the agent exits.
+func (b *Backend) CancelTasks(ctx context.Context, req *pb.CancelTasksRequest) (*pb.CancelTasksResponse, error) {
+	if err := b.checkCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	var updates []update
+	rsp := &pb.CancelTasksResponse{}
+	b.m.Lock()
+	for _, id := range req.TaskIds {
+		t := b.findLocked(id)
+		if t == nil {
+			continue
+		}
+		if msg := b.stopLocked(t, pb.Status_CANCELED, "Canceled by Buildbucket."); msg != nil {
+			updates = append(updates, update{t, msg})
+		}
+		rsp.Tasks = append(rsp.Tasks, proto.Clone(t.state).(*pb.Task))
+	}
+	b.m.Unlock()
+
+	b.notify(updates...)
+	return rsp, nil
+}
+
+// ValidateConfigs validates backend configs. Implements pb.TaskBackendServer.
+func (b *Backend) ValidateConfigs(ctx context.Context, req *pb.ValidateConfigsRequest) (*pb.ValidateConfigsResponse, error) {
+	if err := b.checkCaller(ctx); err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ValidateConfigsResponse{}
+	for i, c := range req.Configs {
+		var err error
+		if c.Target != b.opts.Target {
+			err = errors.Reason("unknown target %q", c.Target).Err()
+		} else {
+			_, err = parseConfig(c.ConfigJson)
+		}
+		if err != nil {
+			rsp.ConfigErrors = append(rsp.ConfigErrors, &pb.ValidateConfigsResponse_ErrorDetail{
+				Index: int32(i),
+				Error: err.Error(),
+			})
+		}
+	}
+	return rsp, nil
+}
+
+// Close stops all tasks and waits for them to end.
+//
+// The tasks are reported as INFRA_FAILURE. Calls to RunTask fail after Close.
+func (b *Backend) Close(ctx context.Context) {
+	var updates []update
+	b.m.Lock()
+	b.closed = true
+	for _, t := range b.tasks {
+		if msg := b.stopLocked(t, pb.Status_INFRA_FAILURE, "The backend is shutting down."); msg != nil {
+			updates = append(updates, update{t, msg})
+		}
+	}
+	b.m.Unlock()
+
+	b.notify(updates...)
+	b.wg.Wait()
+}
+
+// checkCaller checks the caller is Buildbucket.
+func (b *Backend) checkCaller(ctx context.Context) error {
+	s := auth.GetState(ctx)
+	if s == nil {
+		return status.Errorf(codes.Internal, "the auth state is not properly configured")
+	}
+	if peer := s.PeerIdentity(); peer != b.opts.Caller {
+		return status.Errorf(codes.PermissionDenied, "the peer %q is not allowed to access this task backend", peer)
+	}
+	return nil
+}
+
+// validateRunTask validates the request and returns the parsed backend config.
+func (b *Backend) validateRunTask(req *pb.RunTaskRequest) (*backendConfig, error) {
+	if req.Target != b.opts.Target {
+		return nil, errors.Reason("target: expected %q, got %q", b.opts.Target, req.Target).Err()
+	}
+	if id, err := strconv.ParseInt(req.BuildId, 10, 64); err != nil || id <= 0 {
+		return nil, errors.Reason("build_id: bad build ID %q", req.BuildId).Err()
+	}
+	if req.RequestId == "" {
+		return nil, errors.Reason("request_id: required").Err()
+	}
+	cfg, err := parseConfig(req.BackendConfig)
+	if err != nil {
+		return nil, errors.Annotate(err, "backend_config").Err()
+	}
+	if b.opts.AgentPath == "" {
+		if platform := agentPlatform(cfg.mode); agentSource(req.Agent, platform) == nil {
+			return nil, errors.Reason("agent: no agent for %s", platform).Err()
+		}
+	}
+	return cfg, nil
+}
+
+// taskID returns the ID of the task created by a RunTask request.
+//
+// The ID is safe to use in file and container names.
+func taskID(buildID, requestID string) string {
+	h := sha256.Sum256([]byte(requestID))
+	return fmt.Sprintf("%s-%s", buildID, hex.EncodeToString(h[:4]))
+}
+
+// findLocked returns the task with the given ID or nil.
+func (b *Backend) findLocked(id *pb.TaskID) *task {
+	if id.GetTarget() != b.opts.Target {
+		return nil
+	}
+	return b.tasks[id.GetId()]
+}
+
+// pruneLocked forgets tasks which ended long ago.
+func (b *Backend) pruneLocked(now time.Time) {
+	for id, t := range b.tasks {
+		if !t.ended.IsZero() && now.Sub(t.ended) > taskRetention {
+			delete(b.tasks, id)
+		}
+	}
+}
+
+// scheduleLocked starts queued tasks while there are free slots.
+func (b *Backend) scheduleLocked() {
+	for b.running < b.opts.MaxConcurrency && len(b.queue) > 0 {
+		t := b.queue[0]
+		b.queue = b.queue[1:]
+		b.running++
+		b.wg.Add(1)
+		go b.run(t)
+	}
+}
+
+// setStatusLocked changes the status of the task and returns the update to
+// send to Buildbucket.
+func (b *Backend) setStatusLocked(t *task, st pb.Status, details *pb.StatusDetails, summary string) *pb.BuildTaskUpdate {
+	now := clock.Now(t.ctx)
+	t.state.Status = st
+	t.state.StatusDetails = details
+	t.state.SummaryMarkdown = summary
+	// Buildbucket ignores updates which don't increase the update ID.
+	t.state.UpdateId = max(now.UnixNano(), t.state.UpdateId+1)
+	if protoutil.IsEnded(st) {
+		t.ended = now
+	}
+	return &pb.BuildTaskUpdate{
+		BuildId: t.req.BuildId,
+		Task:    proto.Clone(t.state).(*pb.Task),
+	}
+}
+
+// stopLocked stops the task unless it has already ended or is stopping.
+//
+// Queued tasks end right away and the update to send is returned. Running
+// tasks end once the agent exits.
+func (b *Backend) stopLocked(t *task, st pb.Status, summary string) *pb.BuildTaskUpdate {
+	if protoutil.IsEnded(t.state.Status) || t.stopStatus != pb.Status_STATUS_UNSPECIFIED {
+		return nil
+	}
+	t.stopStatus = st
+	t.stopSummary = summary
+	close(t.stop)
+	if i := slices.Index(b.queue, t); i >= 0 {
+		b.queue = slices.Delete(b.queue, i, i+1)
+		return b.setStatusLocked(t, st, nil, summary)
+	}
+	return nil
+}
+
+// notify sends the updates to Buildbucket.
+//
+// Failures are only logged, Buildbucket fetches the tasks periodically anyway.
+func (b *Backend) notify(updates ...update) {
+	for _, u := range updates {
+		if u.t.req.PubsubTopic == "" {
+			continue
+		}
+		if err := b.opts.Notifier.Notify(u.t.ctx, u.t.req.PubsubTopic, u.msg); err != nil {
+			logging.Warningf(u.t.ctx, "Failed to send the update of task %s: %s", u.t.id, err)
+		}
+	}
+}
+
+// run runs the task and reports the result.
+func (b *Backend) run(t *task) {
+	defer b.wg.Done()
+	st, details, summary := b.execute(t)
+
+	b.m.Lock()
+	b.running--
+	msg := b.setStatusLocked(t, st, details, summary)
+	b.scheduleLocked()
+	b.m.Unlock()
+
+	b.notify(update{t, msg})
+}
+
+// execute runs the agent and returns the final status of the task.
+func (b *Backend) execute(t *task) (pb.Status, *pb.StatusDetails, string) {
+	ctx := t.ctx
+
+	if dl := t.req.StartDeadline; dl != nil && clock.Now(ctx).After(dl.AsTime()) {
+		return pb.Status_INFRA_FAILURE, &pb.StatusDetails{Timeout: &pb.StatusDetails_Timeout{}}, "The task expired while waiting for a free slot."
+	}
+
+	spec, cleanup, err := b.prepare(t)
+	defer cleanup()
+	if err != nil {
+		logging.Errorf(ctx, "Failed to prepare task %s: %s", t.id, err)
+		return pb.Status_INFRA_FAILURE, nil, fmt.Sprintf("Failed to prepare the task: %s", err)
+	}
+	cmd := spec.command()
+	if err := cmd.Start(); err != nil {
+		logging.Errorf(ctx, "Failed to start task %s: %s", t.id, err)
+		return pb.Status_INFRA_FAILURE, nil, fmt.Sprintf("Failed to start the agent: %s", err)
+	}
+	logging.Infof(ctx, "Started task %s for build %s", t.id, t.req.BuildId)
+
+	b.m.Lock()
+	msg := b.setStatusLocked(t, pb.Status_STARTED, nil, "")
+	stop := t.stop
+	b.m.Unlock()
+	b.notify(update{t, msg})
+
+	waitC := make(chan error, 1)
+	go func() { waitC <- cmd.Wait() }()
+
+	var timeout, kill <-chan clock.TimerResult
+	if d := t.req.ExecutionTimeout.AsDuration(); d > 0 {
+		timeout = clock.After(ctx, d)
+	}
+
+	// halt asks the agent to terminate and kills it after the grace period.
+	var haltStatus pb.Status
+	var haltDetails *pb.StatusDetails
+	var haltSummary string
+	halt := func(st pb.Status, details *pb.StatusDetails, summary string) {
+		if haltStatus != pb.Status_STATUS_UNSPECIFIED {
+			return
+		}
+		haltStatus, haltDetails, haltSummary = st, details, summary
+		if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+			spec.kill(ctx, cmd)
+			return
+		}
+		kill = clock.After(ctx, t.req.GracePeriod.AsDuration())
+	}
+
+	for {
+		select {
+		case err := <-waitC:
+			var exitErr *exec.ExitError
+			switch {
+			case haltStatus != pb.Status_STATUS_UNSPECIFIED:
+				return haltStatus, haltDetails, haltSummary
+			case err == nil:
+				return pb.Status_SUCCESS, nil, ""
+			case errors.As(err, &exitErr):
+				return pb.Status_FAILURE, nil, fmt.Sprintf("The agent exited with code %d.", exitErr.ExitCode())
+			default:
+				return pb.Status_INFRA_FAILURE, nil, fmt.Sprintf("Failed to wait for the agent: %s", err)
+			}
+
+		case <-stop:
+			stop = nil
+			b.m.Lock()
+			st, summary := t.stopStatus, t.stopSummary
+			b.m.Unlock()
+			halt(st, nil, summary)
+
+		case <-timeout:
+			timeout = nil
+			halt(pb.Status_INFRA_FAILURE, &pb.StatusDetails{Timeout: &pb.StatusDetails_Timeout{}}, "The task exceeded its execution timeout.")
+
+		case <-kill:
+			kill = nil
+			logging.Warningf(ctx, "Task %s didn't terminate within the grace period, killing it", t.id)
+			spec.kill(ctx, cmd)
+		}
+	}
+}
+
+// prepare sets up the task directory.
+//
+// Returns how to run the agent and a function which cleans up after it. The
+// cleanup function must be called even if prepare fails.
+func (b *Backend) prepare(t *task) (spec *launchSpec, cleanup func(), err error) {
+	ctx := t.ctx
+	var cleanups []func()
+	cleanup = func() {
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+	}
+
+	agent := b.opts.AgentPath
+	if agent == "" {
+		src := agentSource(t.req.Agent, agentPlatform(t.cfg.mode))
+		b.agentM.Lock()
+		agent, err = fetchAgent(ctx, filepath.Join(b.opts.RootDir, "agents"), src)
+		b.agentM.Unlock()
+		if err != nil {
+			return nil, cleanup, errors.Annotate(err, "failed to fetch the agent").Err()
+		}
+	}
+
+	workDir := filepath.Join(b.opts.RootDir, "tasks", t.id)
+	if err := os.MkdirAll(workDir, 0700); err != nil {
+		return nil, cleanup, errors.Annotate(err, "failed to create the task directory").Err()
+	}
+	cleanups = append(cleanups, func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			logging.Warningf(ctx, "Failed to remove %s: %s", workDir, err)
+		}
+	})
+
+	contextFile := filepath.Join(workDir, "bbagent_context.json")
+	if err := writeContextFile(contextFile, t.id, t.req.Secrets); err != nil {
+		return nil, cleanup, errors.Annotate(err, "failed to write the agent context").Err()
+	}
+
+	cachesDir := filepath.Join(b.opts.RootDir, "caches")
+	if base := cacheBase(t.req.AgentArgs); base != "" && len(t.req.Caches) > 0 {
+		if err := checkRelPath(base); err != nil {
+			return nil, cleanup, errors.Annotate(err, "bad cache base").Err()
+		}
+		release, err := b.mountCaches(filepath.Join(workDir, base), cachesDir, t.req.Caches)
+		cleanups = append(cleanups, release)
+		if err != nil {
+			return nil, cleanup, errors.Annotate(err, "failed to mount the named caches").Err()
+		}
+	}
+
+	logDir := filepath.Join(b.opts.RootDir, "logs")
+	if err := os.MkdirAll(logDir, 0700); err != nil {
+		return nil, cleanup, errors.Annotate(err, "failed to create the log directory").Err()
+	}
+	log, err := os.Create(filepath.Join(logDir, t.id+".log"))
+	if err != nil {
+		return nil, cleanup, errors.Annotate(err, "failed to create the log file").Err()
+	}
+	cleanups = append(cleanups, func() { log.Close() })
+
+	return &launchSpec{
+		cfg:     t.cfg,
+		name:    "localbackend-" + t.id,
+		agent:   agent,
+		args:    agentArgs(t.req.AgentArgs, contextFile),
+		workDir: workDir,
+		mounts:  []string{cachesDir},
+		log:     log,
+	}, cleanup, nil
+}
+
+// mountCaches symlinks the named caches into the cache base directory of a
+// task.
+//
+// A named cache is used by one task at a time. If it is in use, the task gets
+// an empty directory instead, which is discarded afterwards. Returns
+// a function which releases the caches, it must be called even on errors.
+func (b *Backend) mountCaches(base, cachesDir string, caches []*pb.CacheEntry) (release func(), err error) {
+	var acquired []string
+	release = func() {
+		b.m.Lock()
+		defer b.m.Unlock()
+		for _, name := range acquired {
+			delete(b.cachesInUse, name)
+		}
+	}
+
+	for _, c := range caches {
+		if c.Name == "" || filepath.Base(c.Name) != c.Name {
+			return release, errors.Reason("bad cache name %q", c.Name).Err()
+		}
+		if err := checkRelPath(c.Path); err != nil {
+			return release, errors.Annotate(err, "bad path of cache %q", c.Name).Err()
+		}
+		path := filepath.Join(base, c.Path)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			return release, err
+		}
+
+		b.m.Lock()
+		busy := b.cachesInUse[c.Name]
+		if !busy {
+			b.cachesInUse[c.Name] = true
+			acquired = append(acquired, c.Name)
+		}
+		b.m.Unlock()
+
+		if busy {
+			if err := os.Mkdir(path, 0700); err != nil {
+				return release, err
+			}
+			continue
+		}
+		dir := filepath.Join(cachesDir, c.Name)
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return release, err
+		}
+		if err := os.Symlink(dir, path); err != nil {
+			return release, err
+		}
+	}
+	return release, nil
+}
+
+// checkRelPath checks that the path is relative and stays within the directory
+// it is relative to.
+func checkRelPath(p string) error {
+	switch {
+	case p == "":
+		return errors.Reason("the path is empty").Err()
+	case filepath.IsAbs(p) || filepath.VolumeName(p) != "":
+		return errors.Reason("%q must be relative", p).Err()
+	case !filepath.IsLocal(p):
+		return errors.Reason("%q must not go outside of its parent directory", p).Err()
+	}
+	return nil
+}
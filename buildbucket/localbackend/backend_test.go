@@ -0,0 +1,327 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localbackend
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// TestMain runs the test binary as a fake agent if asked to.
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == "fake-agent" {
+		os.Exit(fakeAgent(os.Args[2:]))
+	}
+	os.Exit(m.Run())
+}
+
+// fakeAgent checks the context file and the named caches, then sleeps and
+// exits with the given code.
+func fakeAgent(args []string) int {
+	fs := flag.NewFlagSet("fake-agent", flag.ContinueOnError)
+	contextFile := fs.String("context-file", "", "")
+	fs.String("cache-base", "", "")
+	cache := fs.String("cache", "", "")
+	sleep := fs.Duration("sleep", 0, "")
+	ignoreTerm := fs.Bool("ignore-term", false, "")
+	exit := fs.Int("exit", 0, "")
+	if err := fs.Parse(args); err != nil {
+		return 100
+	}
+
+	blob, err := os.ReadFile(*contextFile)
+	if err != nil {
+		return 101
+	}
+	bbagentCtx := &pb.BuildbucketAgentContext{}
+	if err := protojson.Unmarshal(blob, bbagentCtx); err != nil || bbagentCtx.TaskId == "" || bbagentCtx.Secrets.GetStartBuildToken() != "token" {
+		return 102
+	}
+	if *cache != "" {
+		if err := os.WriteFile(filepath.Join(*cache, "file"), []byte(bbagentCtx.TaskId), 0600); err != nil {
+			return 103
+		}
+	}
+
+	if *ignoreTerm {
+		signal.Ignore(syscall.SIGTERM)
+	}
+	time.Sleep(*sleep)
+	return *exit
+}
+
+// fakeNotifier collects the updates.
+type fakeNotifier struct {
+	updates chan *pb.BuildTaskUpdate
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, topic string, update *pb.BuildTaskUpdate) error {
+	if topic != "projects/bb/topics/backend" {
+		panic("unexpected topic " + topic)
+	}
+	n.updates <- update
+	return nil
+}
+
+func TestBackend(t *testing.T) {
+	t.Parallel()
+
+	const caller = identity.Identity("user:bb@example.com")
+
+	Convey("Backend", t, func() {
+		ctx := auth.WithState(context.Background(), &authtest.FakeState{
+			Identity:             "project:project",
+			PeerIdentityOverride: caller,
+		})
+		notifier := &fakeNotifier{updates: make(chan *pb.BuildTaskUpdate, 100)}
+		root := t.TempDir()
+
+		b, err := New(Options{
+			Target:         "local://test",
+			RootDir:        root,
+			MaxConcurrency: 1,
+			AgentPath:      os.Args[0],
+			Caller:         caller,
+			Notifier:       notifier,
+		})
+		So(err, ShouldBeNil)
+		defer b.Close(ctx)
+
+		req := func(buildID string, agentArgs ...string) *pb.RunTaskRequest {
+			return &pb.RunTaskRequest{
+				Target:      "local://test",
+				BuildId:     buildID,
+				RequestId:   "request-" + buildID,
+				AgentArgs:   append([]string{"fake-agent", "-context-file", contextFilePlaceholder}, agentArgs...),
+				Secrets:     &pb.BuildSecrets{StartBuildToken: "token"},
+				GracePeriod: durationpb.New(time.Minute),
+				PubsubTopic: "projects/bb/topics/backend",
+			}
+		}
+		run := func(r *pb.RunTaskRequest) *pb.Task {
+			rsp, err := b.RunTask(ctx, r)
+			So(err, ShouldBeNil)
+			return rsp.Task
+		}
+		next := func() *pb.BuildTaskUpdate {
+			select {
+			case u := <-notifier.updates:
+				return u
+			case <-time.After(30 * time.Second):
+				return nil
+			}
+		}
+		fetch := func(id string) *pb.Task {
+			rsp, err := b.FetchTasks(ctx, &pb.FetchTasksRequest{
+				TaskIds: []*pb.TaskID{{Target: "local://test", Id: id}},
+			})
+			So(err, ShouldBeNil)
+			return rsp.Responses[0].GetTask()
+		}
+
+		Convey("New", func() {
+			_, err := New(Options{Target: "local://test"})
+			So(err, ShouldErrLike, "RootDir is required")
+		})
+
+		Convey("checks the caller", func() {
+			ctx = auth.WithState(ctx, &authtest.FakeState{Identity: "user:someone@example.com"})
+			_, err := b.RunTask(ctx, req("1"))
+			So(err, ShouldHaveRPCCode, codes.PermissionDenied)
+		})
+
+		Convey("validates the request", func() {
+			r := req("1")
+			r.Target = "local://other"
+			_, err := b.RunTask(ctx, r)
+			So(err, ShouldHaveRPCCode, codes.InvalidArgument, "target")
+
+			r = req("1")
+			r.BackendConfig, _ = structpb.NewStruct(map[string]any{"mode": "vm"})
+			_, err = b.RunTask(ctx, r)
+			So(err, ShouldHaveRPCCode, codes.InvalidArgument, "backend_config: mode")
+		})
+
+		Convey("success", func() {
+			task := run(req("1"))
+			So(task.Status, ShouldEqual, pb.Status_SCHEDULED)
+			So(task.Id.Target, ShouldEqual, "local://test")
+
+			u := next()
+			So(u.BuildId, ShouldEqual, "1")
+			So(u.Task.Id.Id, ShouldEqual, task.Id.Id)
+			So(u.Task.Status, ShouldEqual, pb.Status_STARTED)
+			So(u.Task.UpdateId, ShouldBeGreaterThan, task.UpdateId)
+
+			u = next()
+			So(u.Task.Status, ShouldEqual, pb.Status_SUCCESS)
+			So(fetch(task.Id.Id), ShouldResembleProto, u.Task)
+
+			// The task directory is removed.
+			_, err := os.Stat(filepath.Join(root, "tasks", task.Id.Id))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("failure", func() {
+			run(req("1", "-exit", "3"))
+			So(next().Task.Status, ShouldEqual, pb.Status_STARTED)
+			u := next()
+			So(u.Task.Status, ShouldEqual, pb.Status_FAILURE)
+			So(u.Task.SummaryMarkdown, ShouldContainSubstring, "exited with code 3")
+		})
+
+		Convey("dedups requests", func() {
+			task := run(req("1", "-sleep", "1h"))
+			So(run(req("1", "-sleep", "1h")), ShouldResembleProto, task)
+			So(next().Task.Status, ShouldEqual, pb.Status_STARTED)
+		})
+
+		Convey("max concurrency", func() {
+			first := run(req("1", "-sleep", "1h"))
+			So(next().Task.Status, ShouldEqual, pb.Status_STARTED)
+
+			second := run(req("2"))
+			So(fetch(second.Id.Id).Status, ShouldEqual, pb.Status_SCHEDULED)
+
+			_, err := b.CancelTasks(ctx, &pb.CancelTasksRequest{TaskIds: []*pb.TaskID{first.Id}})
+			So(err, ShouldBeNil)
+			u := next()
+			So(u.Task.Id.Id, ShouldEqual, first.Id.Id)
+			So(u.Task.Status, ShouldEqual, pb.Status_CANCELED)
+
+			// The second one starts once the first one ends.
+			u = next()
+			So(u.Task.Id.Id, ShouldEqual, second.Id.Id)
+			So(u.Task.Status, ShouldEqual, pb.Status_STARTED)
+			So(next().Task.Status, ShouldEqual, pb.Status_SUCCESS)
+		})
+
+		Convey("cancel a queued task", func() {
+			run(req("1", "-sleep", "1h"))
+			So(next().Task.Status, ShouldEqual, pb.Status_STARTED)
+			queued := run(req("2"))
+
+			rsp, err := b.CancelTasks(ctx, &pb.CancelTasksRequest{TaskIds: []*pb.TaskID{queued.Id}})
+			So(err, ShouldBeNil)
+			So(rsp.Tasks, ShouldHaveLength, 1)
+			So(rsp.Tasks[0].Status, ShouldEqual, pb.Status_CANCELED)
+			So(next().Task.Status, ShouldEqual, pb.Status_CANCELED)
+		})
+
+		Convey("kills after the grace period", func() {
+			r := req("1", "-sleep", "1h", "-ignore-term")
+			r.GracePeriod = durationpb.New(100 * time.Millisecond)
+			task := run(r)
+			So(next().Task.Status, ShouldEqual, pb.Status_STARTED)
+
+			b.CancelTasks(ctx, &pb.CancelTasksRequest{TaskIds: []*pb.TaskID{task.Id}})
+			So(next().Task.Status, ShouldEqual, pb.Status_CANCELED)
+		})
+
+		Convey("execution timeout", func() {
+			r := req("1", "-sleep", "1h")
+			r.ExecutionTimeout = durationpb.New(100 * time.Millisecond)
+			run(r)
+			So(next().Task.Status, ShouldEqual, pb.Status_STARTED)
+			u := next()
+			So(u.Task.Status, ShouldEqual, pb.Status_INFRA_FAILURE)
+			So(u.Task.StatusDetails.GetTimeout(), ShouldNotBeNil)
+		})
+
+		Convey("named caches", func() {
+			r := req("1", "-cache", "cache/builder")
+			r.AgentArgs = append(r.AgentArgs, "-cache-base", "cache")
+			r.Caches = []*pb.CacheEntry{{Name: "builder_cache", Path: "builder"}}
+			task := run(r)
+			So(next().Task.Status, ShouldEqual, pb.Status_STARTED)
+			So(next().Task.Status, ShouldEqual, pb.Status_SUCCESS)
+
+			// The cache survives the task.
+			blob, err := os.ReadFile(filepath.Join(root, "caches", "builder_cache", "file"))
+			So(err, ShouldBeNil)
+			So(string(blob), ShouldEqual, task.Id.Id)
+		})
+
+		Convey("named cache outside of the cache base", func() {
+			for i, path := range []string{"/abs", "../builder", "a/../../builder"} {
+				r := req(strconv.Itoa(i+1), "-cache", "cache/builder")
+				r.AgentArgs = append(r.AgentArgs, "-cache-base", "cache")
+				r.Caches = []*pb.CacheEntry{{Name: "builder_cache", Path: path}}
+				run(r)
+				u := next()
+				So(u.Task.Status, ShouldEqual, pb.Status_INFRA_FAILURE)
+				So(u.Task.SummaryMarkdown, ShouldContainSubstring, "bad path of cache")
+			}
+		})
+
+		Convey("fetch unknown task", func() {
+			rsp, err := b.FetchTasks(ctx, &pb.FetchTasksRequest{
+				TaskIds: []*pb.TaskID{{Target: "local://test", Id: "unknown"}},
+			})
+			So(err, ShouldBeNil)
+			So(rsp.Responses[0].GetError().GetCode(), ShouldEqual, int32(codes.NotFound))
+		})
+
+		Convey("Close", func() {
+			run(req("1", "-sleep", "1h"))
+			So(next().Task.Status, ShouldEqual, pb.Status_STARTED)
+			b.Close(ctx)
+			So(next().Task.Status, ShouldEqual, pb.Status_INFRA_FAILURE)
+
+			_, err := b.RunTask(ctx, req("2"))
+			So(err, ShouldHaveRPCCode, codes.Unavailable)
+		})
+
+		Convey("ValidateConfigs", func() {
+			good, _ := structpb.NewStruct(map[string]any{"mode": "docker", "image": "ubuntu"})
+			bad, _ := structpb.NewStruct(map[string]any{"mode": "docker"})
+			rsp, err := b.ValidateConfigs(ctx, &pb.ValidateConfigsRequest{
+				Configs: []*pb.ValidateConfigsRequest_ConfigContext{
+					{Target: "local://test", ConfigJson: good},
+					{Target: "local://test", ConfigJson: bad},
+					{Target: "local://other", ConfigJson: good},
+				},
+			})
+			So(err, ShouldBeNil)
+			So(rsp, ShouldResembleProto, &pb.ValidateConfigsResponse{
+				ConfigErrors: []*pb.ValidateConfigsResponse_ErrorDetail{
+					{Index: 1, Error: `image: required in "docker" mode`},
+					{Index: 2, Error: `unknown target "local://other"`},
+				},
+			})
+		})
+	})
+}
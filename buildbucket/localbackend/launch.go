@@ -0,0 +1,289 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localbackend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+)
+
+const (
+	// modeProcess runs the agent as a subprocess of the backend.
+	modeProcess = "process"
+	// modeDocker runs the agent in a Docker container.
+	modeDocker = "docker"
+	// modePodman runs the agent in a Podman container.
+	modePodman = "podman"
+
+	// contextFilePlaceholder is replaced with the path to the
+	// BuildbucketAgentContext file in the agent arguments.
+	contextFilePlaceholder = "${BUILDBUCKET_AGENT_CONTEXT_FILE}"
+)
+
+// backendConfig is a parsed RunTaskRequest.backend_config.
+type backendConfig struct {
+	// mode is how to run the agent, one of mode* constants.
+	mode string
+	// image is the container image to run the agent in.
+	image string
+}
+
+// isContainer is true if the agent runs in a container.
+func (c *backendConfig) isContainer() bool {
+	return c.mode == modeDocker || c.mode == modePodman
+}
+
+// parseConfig parses and validates a backend config.
+//
+// Recognized keys:
+//   - "mode": "process" (default), "docker" or "podman".
+//   - "image": the container image, required in the container modes.
+//   - "tags": set by Buildbucket, ignored.
+func parseConfig(cfg *structpb.Struct) (*backendConfig, error) {
+	c := &backendConfig{mode: modeProcess}
+
+	keys := make([]string, 0, len(cfg.GetFields()))
+	for k := range cfg.GetFields() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := cfg.Fields[k]
+		switch k {
+		case "mode", "image":
+			s, ok := v.GetKind().(*structpb.Value_StringValue)
+			if !ok {
+				return nil, errors.Reason("%q: must be a string", k).Err()
+			}
+			if k == "mode" {
+				c.mode = s.StringValue
+			} else {
+				c.image = s.StringValue
+			}
+		case "tags":
+		default:
+			return nil, errors.Reason("unknown key %q", k).Err()
+		}
+	}
+
+	switch {
+	case c.mode != modeProcess && !c.isContainer():
+		return nil, errors.Reason("mode: must be one of %q, %q or %q, got %q", modeProcess, modeDocker, modePodman, c.mode).Err()
+	case c.isContainer() && c.image == "":
+		return nil, errors.Reason("image: required in %q mode", c.mode).Err()
+	case !c.isContainer() && c.image != "":
+		return nil, errors.Reason("image: not supported in %q mode", c.mode).Err()
+	}
+	return c, nil
+}
+
+// agentPlatform returns the CIPD ${platform} of the agent for the given mode.
+func agentPlatform(mode string) string {
+	goos := runtime.GOOS
+	switch {
+	case mode == modeDocker || mode == modePodman:
+		goos = "linux"
+	case goos == "darwin":
+		goos = "mac"
+	}
+	arch := runtime.GOARCH
+	if arch == "386" {
+		arch = "i386"
+	}
+	return goos + "-" + arch
+}
+
+// agentSource finds the agent for the platform.
+//
+// Buildbucket keys the sources either by the platform or by the full CIPD
+// package name ending with the platform.
+func agentSource(agent *pb.RunTaskRequest_AgentExecutable, platform string) *pb.RunTaskRequest_AgentExecutable_AgentSource {
+	if src := agent.GetSource()[platform]; src != nil {
+		return src
+	}
+	for k, src := range agent.GetSource() {
+		if strings.HasSuffix(k, "/"+platform) {
+			return src
+		}
+	}
+	return nil
+}
+
+// fetchAgent downloads the agent into the directory, unless it is already
+// there, and returns its path.
+//
+// The agent is stored under its SHA256 hash, so the same agent is downloaded
+// only once.
+func fetchAgent(ctx context.Context, dir string, src *pb.RunTaskRequest_AgentExecutable_AgentSource) (string, error) {
+	hash := strings.ToLower(src.Sha256)
+	if len(hash) != sha256.Size*2 || strings.Trim(hash, "0123456789abcdef") != "" {
+		return "", errors.Reason("bad agent sha256 %q", src.Sha256).Err()
+	}
+	path := filepath.Join(dir, hash)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", errors.Annotate(err, "failed to create the agent directory").Err()
+	}
+	f, err := os.CreateTemp(dir, hash+".*.tmp")
+	if err != nil {
+		return "", errors.Annotate(err, "failed to create the agent file").Err()
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name()) // noop once renamed
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", src.Url, nil)
+	if err != nil {
+		return "", errors.Annotate(err, "bad agent URL").Err()
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errors.Annotate(err, "failed to download the agent").Tag(transient.Tag).Err()
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return "", errors.Reason("failed to download the agent: HTTP %d", rsp.StatusCode).Err()
+	}
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(rsp.Body, src.SizeBytes+1))
+	switch {
+	case err != nil:
+		return "", errors.Annotate(err, "failed to download the agent").Tag(transient.Tag).Err()
+	case size != src.SizeBytes:
+		return "", errors.Reason("agent size mismatch: expected %d bytes, got %d", src.SizeBytes, size).Err()
+	case hex.EncodeToString(h.Sum(nil)) != hash:
+		return "", errors.Reason("agent hash mismatch: expected %s, got %x", hash, h.Sum(nil)).Err()
+	}
+
+	if err := f.Chmod(0755); err != nil {
+		return "", errors.Annotate(err, "failed to make the agent executable").Err()
+	}
+	if err := f.Close(); err != nil {
+		return "", errors.Annotate(err, "failed to write the agent").Err()
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", errors.Annotate(err, "failed to write the agent").Err()
+	}
+	return path, nil
+}
+
+// writeContextFile writes the BuildbucketAgentContext for the agent.
+func writeContextFile(path, taskID string, secrets *pb.BuildSecrets) error {
+	blob, err := protojson.Marshal(&pb.BuildbucketAgentContext{
+		TaskId:  taskID,
+		Secrets: secrets,
+	})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, blob, 0600)
+}
+
+// cacheBase returns the value of -cache-base in the agent arguments.
+func cacheBase(args []string) string {
+	for i, a := range args {
+		switch {
+		case (a == "-cache-base" || a == "--cache-base") && i+1 < len(args):
+			return args[i+1]
+		case strings.HasPrefix(a, "-cache-base="):
+			return strings.TrimPrefix(a, "-cache-base=")
+		case strings.HasPrefix(a, "--cache-base="):
+			return strings.TrimPrefix(a, "--cache-base=")
+		}
+	}
+	return ""
+}
+
+// agentArgs returns the agent arguments with the placeholders substituted.
+func agentArgs(args []string, contextFile string) []string {
+	out := make([]string, len(args))
+	for i, a := range args {
+		out[i] = strings.ReplaceAll(a, contextFilePlaceholder, contextFile)
+	}
+	return out
+}
+
+// launchSpec describes how to run the agent.
+type launchSpec struct {
+	cfg   *backendConfig
+	name  string // the container name
+	agent string // the agent path
+	args  []string
+	// workDir is the working directory of the agent.
+	workDir string
+	// mounts are host directories mounted into the container at the same path.
+	mounts []string
+	// log receives the agent output.
+	log io.Writer
+}
+
+// command returns the command that runs the agent.
+func (s *launchSpec) command() *exec.Cmd {
+	var cmd *exec.Cmd
+	if !s.cfg.isContainer() {
+		cmd = exec.Command(s.agent, s.args...)
+		cmd.Dir = s.workDir
+	} else {
+		// --init forwards the termination signal, which the CLI proxies to the
+		// container, to the agent.
+		args := []string{
+			"run", "--rm", "--init",
+			"--name", s.name,
+			"--workdir", s.workDir,
+			"--volume", s.workDir + ":" + s.workDir,
+			"--volume", filepath.Dir(s.agent) + ":" + filepath.Dir(s.agent) + ":ro",
+		}
+		for _, m := range s.mounts {
+			args = append(args, "--volume", m+":"+m)
+		}
+		args = append(args, s.cfg.image, s.agent)
+		args = append(args, s.args...)
+		cmd = exec.Command(s.cfg.mode, args...)
+	}
+	cmd.Stdout = s.log
+	cmd.Stderr = s.log
+	return cmd
+}
+
+// kill forcibly stops the agent.
+func (s *launchSpec) kill(ctx context.Context, cmd *exec.Cmd) {
+	cmd.Process.Kill()
+	if s.cfg.isContainer() {
+		// Killing the CLI doesn't necessarily stop the container.
+		exec.CommandContext(ctx, s.cfg.mode, "kill", s.name).Run()
+	}
+}
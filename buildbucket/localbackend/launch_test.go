@@ -0,0 +1,156 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localbackend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestLaunch(t *testing.T) {
+	t.Parallel()
+
+	Convey("parseConfig", t, func() {
+		parse := func(m map[string]any) (*backendConfig, error) {
+			s, err := structpb.NewStruct(m)
+			So(err, ShouldBeNil)
+			return parseConfig(s)
+		}
+
+		Convey("default", func() {
+			cfg, err := parseConfig(nil)
+			So(err, ShouldBeNil)
+			So(cfg, ShouldResemble, &backendConfig{mode: modeProcess})
+		})
+
+		Convey("container", func() {
+			cfg, err := parse(map[string]any{"mode": "podman", "image": "ubuntu", "tags": []any{"a:b"}})
+			So(err, ShouldBeNil)
+			So(cfg, ShouldResemble, &backendConfig{mode: modePodman, image: "ubuntu"})
+		})
+
+		Convey("errors", func() {
+			_, err := parse(map[string]any{"mode": 1})
+			So(err, ShouldErrLike, `"mode": must be a string`)
+			_, err = parse(map[string]any{"priority": 1})
+			So(err, ShouldErrLike, `unknown key "priority"`)
+			_, err = parse(map[string]any{"mode": "docker"})
+			So(err, ShouldErrLike, `image: required in "docker" mode`)
+			_, err = parse(map[string]any{"image": "ubuntu"})
+			So(err, ShouldErrLike, `image: not supported in "process" mode`)
+		})
+	})
+
+	Convey("agentSource", t, func() {
+		src := &pb.RunTaskRequest_AgentExecutable_AgentSource{Url: "url"}
+		agent := &pb.RunTaskRequest_AgentExecutable{
+			Source: map[string]*pb.RunTaskRequest_AgentExecutable_AgentSource{
+				"infra/tools/luci/bbagent/linux-amd64": src,
+			},
+		}
+		So(agentSource(agent, "linux-amd64"), ShouldEqual, src)
+		So(agentSource(agent, "mac-amd64"), ShouldBeNil)
+		So(agentPlatform(modeDocker), ShouldStartWith, "linux-")
+	})
+
+	Convey("args", t, func() {
+		So(cacheBase([]string{"-build-id", "1", "-cache-base", "cache"}), ShouldEqual, "cache")
+		So(cacheBase([]string{"-cache-base=cache"}), ShouldEqual, "cache")
+		So(cacheBase([]string{"-build-id", "1"}), ShouldEqual, "")
+		So(agentArgs([]string{"-context-file", contextFilePlaceholder}, "/ctx.json"), ShouldResemble, []string{"-context-file", "/ctx.json"})
+	})
+
+	Convey("fetchAgent", t, func() {
+		ctx := context.Background()
+		content := []byte("#!/bin/sh\n")
+		hash := sha256.Sum256(content)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write(content)
+		}))
+		defer srv.Close()
+		dir := t.TempDir()
+
+		src := &pb.RunTaskRequest_AgentExecutable_AgentSource{
+			Sha256:    hex.EncodeToString(hash[:]),
+			SizeBytes: int64(len(content)),
+			Url:       srv.URL,
+		}
+
+		Convey("ok", func() {
+			path, err := fetchAgent(ctx, dir, src)
+			So(err, ShouldBeNil)
+			blob, err := os.ReadFile(path)
+			So(err, ShouldBeNil)
+			So(blob, ShouldResemble, content)
+
+			// Cached.
+			srv.Close()
+			cached, err := fetchAgent(ctx, dir, src)
+			So(err, ShouldBeNil)
+			So(cached, ShouldEqual, path)
+		})
+
+		Convey("size mismatch", func() {
+			src.SizeBytes = 1
+			_, err := fetchAgent(ctx, dir, src)
+			So(err, ShouldErrLike, "size mismatch")
+		})
+
+		Convey("hash mismatch", func() {
+			other := sha256.Sum256(nil)
+			src.Sha256 = hex.EncodeToString(other[:])
+			_, err := fetchAgent(ctx, dir, src)
+			So(err, ShouldErrLike, "hash mismatch")
+		})
+	})
+
+	Convey("command", t, func() {
+		spec := &launchSpec{
+			cfg:     &backendConfig{mode: modeDocker, image: "ubuntu"},
+			name:    "localbackend-1",
+			agent:   "/root/agents/abc",
+			args:    []string{"-build-id", "1"},
+			workDir: "/root/tasks/1",
+			mounts:  []string{"/root/caches"},
+		}
+		cmd := spec.command()
+		So(cmd.Args, ShouldResemble, []string{
+			"docker", "run", "--rm", "--init",
+			"--name", "localbackend-1",
+			"--workdir", "/root/tasks/1",
+			"--volume", "/root/tasks/1:/root/tasks/1",
+			"--volume", "/root/agents:/root/agents:ro",
+			"--volume", "/root/caches:/root/caches",
+			"ubuntu", "/root/agents/abc", "-build-id", "1",
+		})
+
+		spec.cfg = &backendConfig{mode: modeProcess}
+		cmd = spec.command()
+		So(cmd.Args, ShouldResemble, []string{"/root/agents/abc", "-build-id", "1"})
+		So(cmd.Dir, ShouldEqual, "/root/tasks/1")
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localbackend
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/server/auth"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+)
+
+// Notifier sends task updates to Buildbucket.
+type Notifier interface {
+	// Notify sends the update to the Pub/Sub topic from
+	// RunTaskRequest.pubsub_topic.
+	Notify(ctx context.Context, topic string, update *pb.BuildTaskUpdate) error
+}
+
+// PubSubNotifier publishes task updates to Cloud Pub/Sub, which is how
+// Buildbucket receives UpdateBuildTask messages.
+//
+// Publishes using the service's own account.
+type PubSubNotifier struct {
+	m       sync.Mutex
+	clients map[string]*pubsub.Client // cloud project => client
+	topics  map[string]*pubsub.Topic  // full topic name => topic
+}
+
+// NewPubSubNotifier returns a PubSubNotifier.
+//
+// It must be closed when no longer needed.
+func NewPubSubNotifier() *PubSubNotifier {
+	return &PubSubNotifier{
+		clients: map[string]*pubsub.Client{},
+		topics:  map[string]*pubsub.Topic{},
+	}
+}
+
+// Notify implements Notifier.
+func (n *PubSubNotifier) Notify(ctx context.Context, topic string, update *pb.BuildTaskUpdate) error {
+	t, err := n.topic(ctx, topic)
+	if err != nil {
+		return err
+	}
+	blob, err := proto.Marshal(update)
+	if err != nil {
+		return errors.Annotate(err, "failed to marshal the update").Err()
+	}
+	if _, err := t.Publish(ctx, &pubsub.Message{Data: blob}).Get(ctx); err != nil {
+		return errors.Annotate(err, "failed to publish to %s", topic).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// topic returns the topic with the given full name.
+func (n *PubSubNotifier) topic(ctx context.Context, name string) (*pubsub.Topic, error) {
+	n.m.Lock()
+	defer n.m.Unlock()
+
+	if t := n.topics[name]; t != nil {
+		return t, nil
+	}
+
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "projects" || parts[2] != "topics" {
+		return nil, errors.Reason("bad topic %q, expecting projects/<project>/topics/<topic>", name).Err()
+	}
+	project, id := parts[1], parts[3]
+
+	client := n.clients[project]
+	if client == nil {
+		creds, err := auth.GetPerRPCCredentials(ctx, auth.AsSelf, auth.WithScopes(auth.CloudOAuthScopes...))
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to get credentials").Err()
+		}
+		client, err = pubsub.NewClient(context.WithoutCancel(ctx), project,
+			option.WithGRPCDialOption(grpc.WithPerRPCCredentials(creds)),
+		)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to create Pub/Sub client for %s", project).Err()
+		}
+		n.clients[project] = client
+	}
+
+	t := client.Topic(id)
+	n.topics[name] = t
+	return t, nil
+}
+
+// Close flushes pending messages and closes the clients.
+func (n *PubSubNotifier) Close() {
+	n.m.Lock()
+	defer n.m.Unlock()
+	for _, t := range n.topics {
+		t.Stop()
+	}
+	for _, c := range n.clients {
+		c.Close()
+	}
+	n.topics = map[string]*pubsub.Topic{}
+	n.clients = map[string]*pubsub.Client{}
+}
@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockisBatchRequest_Request_Request is a mock of isBatchRequest_Request_Request interface.
@@ -304,6 +305,149 @@ func (mr *MockBuildsClientMockRecorder) UpdateBuild(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuild", reflect.TypeOf((*MockBuildsClient)(nil).UpdateBuild), varargs...)
 }
 
+// WatchBuilds mocks base method.
+func (m *MockBuildsClient) WatchBuilds(ctx context.Context, in *WatchBuildsRequest, opts ...grpc.CallOption) (Builds_WatchBuildsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchBuilds", varargs...)
+	ret0, _ := ret[0].(Builds_WatchBuildsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchBuilds indicates an expected call of WatchBuilds.
+func (mr *MockBuildsClientMockRecorder) WatchBuilds(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchBuilds", reflect.TypeOf((*MockBuildsClient)(nil).WatchBuilds), varargs...)
+}
+
+// MockBuilds_WatchBuildsClient is a mock of Builds_WatchBuildsClient interface.
+type MockBuilds_WatchBuildsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBuilds_WatchBuildsClientMockRecorder
+}
+
+// MockBuilds_WatchBuildsClientMockRecorder is the mock recorder for MockBuilds_WatchBuildsClient.
+type MockBuilds_WatchBuildsClientMockRecorder struct {
+	mock *MockBuilds_WatchBuildsClient
+}
+
+// NewMockBuilds_WatchBuildsClient creates a new mock instance.
+func NewMockBuilds_WatchBuildsClient(ctrl *gomock.Controller) *MockBuilds_WatchBuildsClient {
+	mock := &MockBuilds_WatchBuildsClient{ctrl: ctrl}
+	mock.recorder = &MockBuilds_WatchBuildsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBuilds_WatchBuildsClient) EXPECT() *MockBuilds_WatchBuildsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockBuilds_WatchBuildsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockBuilds_WatchBuildsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBuilds_WatchBuildsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockBuilds_WatchBuildsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBuilds_WatchBuildsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBuilds_WatchBuildsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockBuilds_WatchBuildsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockBuilds_WatchBuildsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBuilds_WatchBuildsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockBuilds_WatchBuildsClient) Recv() (*WatchBuildsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*WatchBuildsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockBuilds_WatchBuildsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBuilds_WatchBuildsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBuilds_WatchBuildsClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBuilds_WatchBuildsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBuilds_WatchBuildsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBuilds_WatchBuildsClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBuilds_WatchBuildsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBuilds_WatchBuildsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockBuilds_WatchBuildsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockBuilds_WatchBuildsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBuilds_WatchBuildsClient)(nil).Trailer))
+}
+
 // MockBuildsServer is a mock of BuildsServer interface.
 type MockBuildsServer struct {
 	ctrl     *gomock.Controller
@@ -476,3 +620,136 @@ func (mr *MockBuildsServerMockRecorder) UpdateBuild(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBuild", reflect.TypeOf((*MockBuildsServer)(nil).UpdateBuild), arg0, arg1)
 }
+
+// WatchBuilds mocks base method.
+func (m *MockBuildsServer) WatchBuilds(arg0 *WatchBuildsRequest, arg1 Builds_WatchBuildsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchBuilds", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchBuilds indicates an expected call of WatchBuilds.
+func (mr *MockBuildsServerMockRecorder) WatchBuilds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchBuilds", reflect.TypeOf((*MockBuildsServer)(nil).WatchBuilds), arg0, arg1)
+}
+
+// MockBuilds_WatchBuildsServer is a mock of Builds_WatchBuildsServer interface.
+type MockBuilds_WatchBuildsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBuilds_WatchBuildsServerMockRecorder
+}
+
+// MockBuilds_WatchBuildsServerMockRecorder is the mock recorder for MockBuilds_WatchBuildsServer.
+type MockBuilds_WatchBuildsServerMockRecorder struct {
+	mock *MockBuilds_WatchBuildsServer
+}
+
+// NewMockBuilds_WatchBuildsServer creates a new mock instance.
+func NewMockBuilds_WatchBuildsServer(ctrl *gomock.Controller) *MockBuilds_WatchBuildsServer {
+	mock := &MockBuilds_WatchBuildsServer{ctrl: ctrl}
+	mock.recorder = &MockBuilds_WatchBuildsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBuilds_WatchBuildsServer) EXPECT() *MockBuilds_WatchBuildsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBuilds_WatchBuildsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBuilds_WatchBuildsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBuilds_WatchBuildsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockBuilds_WatchBuildsServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBuilds_WatchBuildsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBuilds_WatchBuildsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockBuilds_WatchBuildsServer) Send(arg0 *WatchBuildsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBuilds_WatchBuildsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBuilds_WatchBuildsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBuilds_WatchBuildsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBuilds_WatchBuildsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBuilds_WatchBuildsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockBuilds_WatchBuildsServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBuilds_WatchBuildsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBuilds_WatchBuildsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockBuilds_WatchBuildsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBuilds_WatchBuildsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBuilds_WatchBuildsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBuilds_WatchBuildsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBuilds_WatchBuildsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBuilds_WatchBuildsServer)(nil).SetTrailer), arg0)
+}
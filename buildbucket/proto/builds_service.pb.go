@@ -514,7 +514,7 @@ func (x *UpdateBuildRequest) GetMask() *BuildMask {
 
 // A request message for ScheduleBuild RPC.
 //
-// Next ID: 24.
+// Next ID: 25.
 type ScheduleBuildRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -704,6 +704,13 @@ type ScheduleBuildRequest struct {
 	//     adjustments on service_account, dimensions and properties.
 	//   - inherit its parent build's agent input and agent source if it has a parent.
 	ShadowInput *ScheduleBuildRequest_ShadowInput `protobuf:"bytes,23,opt,name=shadow_input,json=shadowInput,proto3" json:"shadow_input,omitempty"`
+	// Builds the new build depends on. See Build.depends_on.
+	//
+	// Dependencies on other requests in the same Batch request must use
+	// BuildDependency.request_id; both requests must have request_id set and the
+	// dependency graph must not have cycles. At most 100 dependencies are
+	// allowed.
+	DependsOn []*BuildDependency `protobuf:"bytes,24,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
 }
 
 func (x *ScheduleBuildRequest) Reset() {
@@ -914,6 +921,13 @@ func (x *ScheduleBuildRequest) GetShadowInput() *ScheduleBuildRequest_ShadowInpu
 	return nil
 }
 
+func (x *ScheduleBuildRequest) GetDependsOn() []*BuildDependency {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
 // A request message for CancelBuild RPC.
 type CancelBuildRequest struct {
 	state         protoimpl.MessageState
@@ -1337,6 +1351,135 @@ func (x *GetBuildStatusRequest) GetBuildNumber() int32 {
 	return 0
 }
 
+// A request message for WatchBuilds RPC.
+type WatchBuildsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Streamed builds must satisfy this predicate.
+	//
+	// Same as SearchBuildsRequest.predicate, except output_gitiles_commit is
+	// not supported.
+	//
+	// Mutually exclusive with build_ids. One of them is required.
+	Predicate *BuildPredicate `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	// IDs of builds to watch.
+	//
+	// The current state of each of these builds is sent first, followed by
+	// their updates.
+	//
+	// Mutually exclusive with predicate. At most 1000 builds.
+	BuildIds []int64 `protobuf:"varint,2,rep,packed,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+	// What portion of the Build message to return.
+	//
+	// If not set, the default mask is used, see Build message comments for the
+	// list of fields returned by default.
+	Mask *BuildMask `protobuf:"bytes,3,opt,name=mask,proto3" json:"mask,omitempty"`
+}
+
+func (x *WatchBuildsRequest) Reset() {
+	*x = WatchBuildsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBuildsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBuildsRequest) ProtoMessage() {}
+
+func (x *WatchBuildsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBuildsRequest.ProtoReflect.Descriptor instead.
+func (*WatchBuildsRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchBuildsRequest) GetPredicate() *BuildPredicate {
+	if x != nil {
+		return x.Predicate
+	}
+	return nil
+}
+
+func (x *WatchBuildsRequest) GetBuildIds() []int64 {
+	if x != nil {
+		return x.BuildIds
+	}
+	return nil
+}
+
+func (x *WatchBuildsRequest) GetMask() *BuildMask {
+	if x != nil {
+		return x.Mask
+	}
+	return nil
+}
+
+// A response message for WatchBuilds RPC.
+type WatchBuildsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The updated build.
+	Build *Build `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
+}
+
+func (x *WatchBuildsResponse) Reset() {
+	*x = WatchBuildsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBuildsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBuildsResponse) ProtoMessage() {}
+
+func (x *WatchBuildsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBuildsResponse.ProtoReflect.Descriptor instead.
+func (*WatchBuildsResponse) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchBuildsResponse) GetBuild() *Build {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
 // Defines a subset of Build fields and properties to return.
 type BuildMask struct {
 	state         protoimpl.MessageState
@@ -1379,7 +1522,7 @@ type BuildMask struct {
 func (x *BuildMask) Reset() {
 	*x = BuildMask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[13]
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1392,7 +1535,7 @@ func (x *BuildMask) String() string {
 func (*BuildMask) ProtoMessage() {}
 
 func (x *BuildMask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[13]
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,7 +1548,7 @@ func (x *BuildMask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildMask.ProtoReflect.Descriptor instead.
 func (*BuildMask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZIP(), []int{13}
+	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *BuildMask) GetFields() *fieldmaskpb.FieldMask {
@@ -1531,7 +1674,7 @@ type BuildPredicate struct {
 func (x *BuildPredicate) Reset() {
 	*x = BuildPredicate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[14]
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1544,7 +1687,7 @@ func (x *BuildPredicate) String() string {
 func (*BuildPredicate) ProtoMessage() {}
 
 func (x *BuildPredicate) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[14]
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,7 +1700,7 @@ func (x *BuildPredicate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildPredicate.ProtoReflect.Descriptor instead.
 func (*BuildPredicate) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZIP(), []int{14}
+	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *BuildPredicate) GetBuilder() *BuilderID {
@@ -1666,7 +1809,7 @@ type BuildRange struct {
 func (x *BuildRange) Reset() {
 	*x = BuildRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[15]
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1679,7 +1822,7 @@ func (x *BuildRange) String() string {
 func (*BuildRange) ProtoMessage() {}
 
 func (x *BuildRange) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[15]
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,7 +1835,7 @@ func (x *BuildRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildRange.ProtoReflect.Descriptor instead.
 func (*BuildRange) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZIP(), []int{15}
+	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *BuildRange) GetStartBuildId() int64 {
@@ -1728,7 +1871,7 @@ type BatchRequest_Request struct {
 func (x *BatchRequest_Request) Reset() {
 	*x = BatchRequest_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[16]
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1741,7 +1884,7 @@ func (x *BatchRequest_Request) String() string {
 func (*BatchRequest_Request) ProtoMessage() {}
 
 func (x *BatchRequest_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[16]
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,7 +1996,7 @@ type BatchResponse_Response struct {
 func (x *BatchResponse_Response) Reset() {
 	*x = BatchResponse_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[17]
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1866,7 +2009,7 @@ func (x *BatchResponse_Response) String() string {
 func (*BatchResponse_Response) ProtoMessage() {}
 
 func (x *BatchResponse_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[17]
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2000,7 +2143,7 @@ type ScheduleBuildRequest_Swarming struct {
 func (x *ScheduleBuildRequest_Swarming) Reset() {
 	*x = ScheduleBuildRequest_Swarming{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[19]
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2013,7 +2156,7 @@ func (x *ScheduleBuildRequest_Swarming) String() string {
 func (*ScheduleBuildRequest_Swarming) ProtoMessage() {}
 
 func (x *ScheduleBuildRequest_Swarming) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[19]
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2046,7 +2189,7 @@ type ScheduleBuildRequest_ShadowInput struct {
 func (x *ScheduleBuildRequest_ShadowInput) Reset() {
 	*x = ScheduleBuildRequest_ShadowInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[20]
+		mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2059,7 +2202,7 @@ func (x *ScheduleBuildRequest_ShadowInput) String() string {
 func (*ScheduleBuildRequest_ShadowInput) ProtoMessage() {}
 
 func (x *ScheduleBuildRequest_ShadowInput) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[20]
+	mi := &file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2221,7 +2364,7 @@ var file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDesc = [
 	0x02, 0x18, 0x01, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x6d,
 	0x61, 0x73, 0x6b, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x75, 0x69, 0x6c,
 	0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0xbd, 0x0c, 0x0a, 0x14, 0x53,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0xfd, 0x0c, 0x0a, 0x14, 0x53,
 	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
@@ -2313,7 +2456,11 @@ var file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDesc = [
 	0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x63,
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0b,
-	0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x3e, 0x0a, 0x10, 0x45,
+	0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x45,
 	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
 	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
 	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
@@ -2382,131 +2529,151 @@ var file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDesc = [
 	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x07,
 	0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64,
 	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xea, 0x02, 0x0a, 0x09, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x32, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x10,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6d,
-	0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0f,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
-	0x43, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61,
-	0x73, 0x6b, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6d, 0x61, 0x73, 0x6b, 0x2e,
-	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x37,
-	0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x65,
-	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x90, 0x05, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69,
-	0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12,
-	0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x43, 0x0a, 0x0e, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x67,
-	0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x6d,
-	0x69, 0x74, 0x52, 0x13, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65,
-	0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x69, 0x72,
-	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72,
-	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65,
-	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
-	0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x4f, 0x66, 0x12,
-	0x19, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x66, 0x22, 0x54, 0x0a, 0x0a, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64,
-	0x32, 0xa4, 0x06, 0x0a, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1f, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22,
-	0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x73, 0x12, 0x23, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a,
-	0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x22, 0x2e,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x24, 0x2e, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x22, 0x2e, 0x62, 0x75, 0x69, 0x6c,
-	0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x1c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x75, 0x69, 0x6c, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x9e, 0x01, 0x0a, 0x12, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x04,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x42, 0x0a, 0x13, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x22,
+	0xea, 0x02, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x32, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x41, 0x0a, 0x10, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x14, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x52,
+	0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x90, 0x05, 0x0a,
+	0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x33, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x44, 0x52, 0x07, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x0e, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x67, 0x65, 0x72, 0x72,
+	0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x15, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x13, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x47,
+	0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x2f, 0x0a, 0x06,
+	0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61, 0x6e, 0x74, 0x5f, 0x6f, 0x66,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x61,
+	0x6e, 0x74, 0x4f, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x66,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x66, 0x22,
+	0x54, 0x0a, 0x0a, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x49, 0x64, 0x32, 0x80, 0x07, 0x0a, 0x06, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73,
+	0x12, 0x44, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1f, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
 	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a,
-	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x22, 0x2e,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x53, 0x79,
-	0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x26, 0x2e,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53,
-	0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12, 0x50,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x25, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
-	0x32, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00,
-	0x12, 0x55, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x21,
+	0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x12, 0x22, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x12, 0x24, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x32, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x22,
 	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e,
-	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x3b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x05, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x12, 0x22, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x12, 0x26, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x32, 0x2e, 0x53, 0x79, 0x6e, 0x74, 0x68, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x12, 0x21, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x32,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69,
+	0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x3b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2521,7 +2688,7 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescGZI
 	return file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_goTypes = []interface{}{
 	(*GetBuildRequest)(nil),                  // 0: buildbucket.v2.GetBuildRequest
 	(*SearchBuildsRequest)(nil),              // 1: buildbucket.v2.SearchBuildsRequest
@@ -2536,124 +2703,133 @@ var file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_goTypes = [
 	(*StartBuildRequest)(nil),                // 10: buildbucket.v2.StartBuildRequest
 	(*StartBuildResponse)(nil),               // 11: buildbucket.v2.StartBuildResponse
 	(*GetBuildStatusRequest)(nil),            // 12: buildbucket.v2.GetBuildStatusRequest
-	(*BuildMask)(nil),                        // 13: buildbucket.v2.BuildMask
-	(*BuildPredicate)(nil),                   // 14: buildbucket.v2.BuildPredicate
-	(*BuildRange)(nil),                       // 15: buildbucket.v2.BuildRange
-	(*BatchRequest_Request)(nil),             // 16: buildbucket.v2.BatchRequest.Request
-	(*BatchResponse_Response)(nil),           // 17: buildbucket.v2.BatchResponse.Response
-	nil,                                      // 18: buildbucket.v2.ScheduleBuildRequest.ExperimentsEntry
-	(*ScheduleBuildRequest_Swarming)(nil),    // 19: buildbucket.v2.ScheduleBuildRequest.Swarming
-	(*ScheduleBuildRequest_ShadowInput)(nil), // 20: buildbucket.v2.ScheduleBuildRequest.ShadowInput
-	nil,                                      // 21: buildbucket.v2.SynthesizeBuildRequest.ExperimentsEntry
-	(*BuilderID)(nil),                        // 22: buildbucket.v2.BuilderID
-	(*fieldmaskpb.FieldMask)(nil),            // 23: google.protobuf.FieldMask
-	(*Build)(nil),                            // 24: buildbucket.v2.Build
-	(Trinary)(0),                             // 25: buildbucket.v2.Trinary
-	(*structpb.Struct)(nil),                  // 26: google.protobuf.Struct
-	(*GitilesCommit)(nil),                    // 27: buildbucket.v2.GitilesCommit
-	(*GerritChange)(nil),                     // 28: buildbucket.v2.GerritChange
-	(*StringPair)(nil),                       // 29: buildbucket.v2.StringPair
-	(*RequestedDimension)(nil),               // 30: buildbucket.v2.RequestedDimension
-	(*NotificationConfig)(nil),               // 31: buildbucket.v2.NotificationConfig
-	(*Executable)(nil),                       // 32: buildbucket.v2.Executable
-	(*durationpb.Duration)(nil),              // 33: google.protobuf.Duration
-	(*structmask.StructMask)(nil),            // 34: structmask.StructMask
-	(Status)(0),                              // 35: buildbucket.v2.Status
-	(*TimeRange)(nil),                        // 36: buildbucket.v2.TimeRange
-	(*status.Status)(nil),                    // 37: google.rpc.Status
+	(*WatchBuildsRequest)(nil),               // 13: buildbucket.v2.WatchBuildsRequest
+	(*WatchBuildsResponse)(nil),              // 14: buildbucket.v2.WatchBuildsResponse
+	(*BuildMask)(nil),                        // 15: buildbucket.v2.BuildMask
+	(*BuildPredicate)(nil),                   // 16: buildbucket.v2.BuildPredicate
+	(*BuildRange)(nil),                       // 17: buildbucket.v2.BuildRange
+	(*BatchRequest_Request)(nil),             // 18: buildbucket.v2.BatchRequest.Request
+	(*BatchResponse_Response)(nil),           // 19: buildbucket.v2.BatchResponse.Response
+	nil,                                      // 20: buildbucket.v2.ScheduleBuildRequest.ExperimentsEntry
+	(*ScheduleBuildRequest_Swarming)(nil),    // 21: buildbucket.v2.ScheduleBuildRequest.Swarming
+	(*ScheduleBuildRequest_ShadowInput)(nil), // 22: buildbucket.v2.ScheduleBuildRequest.ShadowInput
+	nil,                                      // 23: buildbucket.v2.SynthesizeBuildRequest.ExperimentsEntry
+	(*BuilderID)(nil),                        // 24: buildbucket.v2.BuilderID
+	(*fieldmaskpb.FieldMask)(nil),            // 25: google.protobuf.FieldMask
+	(*Build)(nil),                            // 26: buildbucket.v2.Build
+	(Trinary)(0),                             // 27: buildbucket.v2.Trinary
+	(*structpb.Struct)(nil),                  // 28: google.protobuf.Struct
+	(*GitilesCommit)(nil),                    // 29: buildbucket.v2.GitilesCommit
+	(*GerritChange)(nil),                     // 30: buildbucket.v2.GerritChange
+	(*StringPair)(nil),                       // 31: buildbucket.v2.StringPair
+	(*RequestedDimension)(nil),               // 32: buildbucket.v2.RequestedDimension
+	(*NotificationConfig)(nil),               // 33: buildbucket.v2.NotificationConfig
+	(*Executable)(nil),                       // 34: buildbucket.v2.Executable
+	(*durationpb.Duration)(nil),              // 35: google.protobuf.Duration
+	(*BuildDependency)(nil),                  // 36: buildbucket.v2.BuildDependency
+	(*structmask.StructMask)(nil),            // 37: structmask.StructMask
+	(Status)(0),                              // 38: buildbucket.v2.Status
+	(*TimeRange)(nil),                        // 39: buildbucket.v2.TimeRange
+	(*status.Status)(nil),                    // 40: google.rpc.Status
 }
 var file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_depIdxs = []int32{
-	22, // 0: buildbucket.v2.GetBuildRequest.builder:type_name -> buildbucket.v2.BuilderID
-	23, // 1: buildbucket.v2.GetBuildRequest.fields:type_name -> google.protobuf.FieldMask
-	13, // 2: buildbucket.v2.GetBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
-	14, // 3: buildbucket.v2.SearchBuildsRequest.predicate:type_name -> buildbucket.v2.BuildPredicate
-	23, // 4: buildbucket.v2.SearchBuildsRequest.fields:type_name -> google.protobuf.FieldMask
-	13, // 5: buildbucket.v2.SearchBuildsRequest.mask:type_name -> buildbucket.v2.BuildMask
-	24, // 6: buildbucket.v2.SearchBuildsResponse.builds:type_name -> buildbucket.v2.Build
-	16, // 7: buildbucket.v2.BatchRequest.requests:type_name -> buildbucket.v2.BatchRequest.Request
-	17, // 8: buildbucket.v2.BatchResponse.responses:type_name -> buildbucket.v2.BatchResponse.Response
-	24, // 9: buildbucket.v2.UpdateBuildRequest.build:type_name -> buildbucket.v2.Build
-	23, // 10: buildbucket.v2.UpdateBuildRequest.update_mask:type_name -> google.protobuf.FieldMask
-	23, // 11: buildbucket.v2.UpdateBuildRequest.fields:type_name -> google.protobuf.FieldMask
-	13, // 12: buildbucket.v2.UpdateBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
-	22, // 13: buildbucket.v2.ScheduleBuildRequest.builder:type_name -> buildbucket.v2.BuilderID
-	25, // 14: buildbucket.v2.ScheduleBuildRequest.canary:type_name -> buildbucket.v2.Trinary
-	25, // 15: buildbucket.v2.ScheduleBuildRequest.experimental:type_name -> buildbucket.v2.Trinary
-	18, // 16: buildbucket.v2.ScheduleBuildRequest.experiments:type_name -> buildbucket.v2.ScheduleBuildRequest.ExperimentsEntry
-	26, // 17: buildbucket.v2.ScheduleBuildRequest.properties:type_name -> google.protobuf.Struct
-	27, // 18: buildbucket.v2.ScheduleBuildRequest.gitiles_commit:type_name -> buildbucket.v2.GitilesCommit
-	28, // 19: buildbucket.v2.ScheduleBuildRequest.gerrit_changes:type_name -> buildbucket.v2.GerritChange
-	29, // 20: buildbucket.v2.ScheduleBuildRequest.tags:type_name -> buildbucket.v2.StringPair
-	30, // 21: buildbucket.v2.ScheduleBuildRequest.dimensions:type_name -> buildbucket.v2.RequestedDimension
-	31, // 22: buildbucket.v2.ScheduleBuildRequest.notify:type_name -> buildbucket.v2.NotificationConfig
-	23, // 23: buildbucket.v2.ScheduleBuildRequest.fields:type_name -> google.protobuf.FieldMask
-	13, // 24: buildbucket.v2.ScheduleBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
-	25, // 25: buildbucket.v2.ScheduleBuildRequest.critical:type_name -> buildbucket.v2.Trinary
-	32, // 26: buildbucket.v2.ScheduleBuildRequest.exe:type_name -> buildbucket.v2.Executable
-	19, // 27: buildbucket.v2.ScheduleBuildRequest.swarming:type_name -> buildbucket.v2.ScheduleBuildRequest.Swarming
-	33, // 28: buildbucket.v2.ScheduleBuildRequest.scheduling_timeout:type_name -> google.protobuf.Duration
-	33, // 29: buildbucket.v2.ScheduleBuildRequest.execution_timeout:type_name -> google.protobuf.Duration
-	33, // 30: buildbucket.v2.ScheduleBuildRequest.grace_period:type_name -> google.protobuf.Duration
-	25, // 31: buildbucket.v2.ScheduleBuildRequest.can_outlive_parent:type_name -> buildbucket.v2.Trinary
-	25, // 32: buildbucket.v2.ScheduleBuildRequest.retriable:type_name -> buildbucket.v2.Trinary
-	20, // 33: buildbucket.v2.ScheduleBuildRequest.shadow_input:type_name -> buildbucket.v2.ScheduleBuildRequest.ShadowInput
-	23, // 34: buildbucket.v2.CancelBuildRequest.fields:type_name -> google.protobuf.FieldMask
-	13, // 35: buildbucket.v2.CancelBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
-	24, // 36: buildbucket.v2.CreateBuildRequest.build:type_name -> buildbucket.v2.Build
-	13, // 37: buildbucket.v2.CreateBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
-	22, // 38: buildbucket.v2.SynthesizeBuildRequest.builder:type_name -> buildbucket.v2.BuilderID
-	21, // 39: buildbucket.v2.SynthesizeBuildRequest.experiments:type_name -> buildbucket.v2.SynthesizeBuildRequest.ExperimentsEntry
-	24, // 40: buildbucket.v2.StartBuildResponse.build:type_name -> buildbucket.v2.Build
-	22, // 41: buildbucket.v2.GetBuildStatusRequest.builder:type_name -> buildbucket.v2.BuilderID
-	23, // 42: buildbucket.v2.BuildMask.fields:type_name -> google.protobuf.FieldMask
-	34, // 43: buildbucket.v2.BuildMask.input_properties:type_name -> structmask.StructMask
-	34, // 44: buildbucket.v2.BuildMask.output_properties:type_name -> structmask.StructMask
-	34, // 45: buildbucket.v2.BuildMask.requested_properties:type_name -> structmask.StructMask
-	35, // 46: buildbucket.v2.BuildMask.step_status:type_name -> buildbucket.v2.Status
-	22, // 47: buildbucket.v2.BuildPredicate.builder:type_name -> buildbucket.v2.BuilderID
-	35, // 48: buildbucket.v2.BuildPredicate.status:type_name -> buildbucket.v2.Status
-	28, // 49: buildbucket.v2.BuildPredicate.gerrit_changes:type_name -> buildbucket.v2.GerritChange
-	27, // 50: buildbucket.v2.BuildPredicate.output_gitiles_commit:type_name -> buildbucket.v2.GitilesCommit
-	29, // 51: buildbucket.v2.BuildPredicate.tags:type_name -> buildbucket.v2.StringPair
-	36, // 52: buildbucket.v2.BuildPredicate.create_time:type_name -> buildbucket.v2.TimeRange
-	15, // 53: buildbucket.v2.BuildPredicate.build:type_name -> buildbucket.v2.BuildRange
-	25, // 54: buildbucket.v2.BuildPredicate.canary:type_name -> buildbucket.v2.Trinary
-	0,  // 55: buildbucket.v2.BatchRequest.Request.get_build:type_name -> buildbucket.v2.GetBuildRequest
-	1,  // 56: buildbucket.v2.BatchRequest.Request.search_builds:type_name -> buildbucket.v2.SearchBuildsRequest
-	6,  // 57: buildbucket.v2.BatchRequest.Request.schedule_build:type_name -> buildbucket.v2.ScheduleBuildRequest
-	7,  // 58: buildbucket.v2.BatchRequest.Request.cancel_build:type_name -> buildbucket.v2.CancelBuildRequest
-	12, // 59: buildbucket.v2.BatchRequest.Request.get_build_status:type_name -> buildbucket.v2.GetBuildStatusRequest
-	24, // 60: buildbucket.v2.BatchResponse.Response.get_build:type_name -> buildbucket.v2.Build
-	2,  // 61: buildbucket.v2.BatchResponse.Response.search_builds:type_name -> buildbucket.v2.SearchBuildsResponse
-	24, // 62: buildbucket.v2.BatchResponse.Response.schedule_build:type_name -> buildbucket.v2.Build
-	24, // 63: buildbucket.v2.BatchResponse.Response.cancel_build:type_name -> buildbucket.v2.Build
-	24, // 64: buildbucket.v2.BatchResponse.Response.get_build_status:type_name -> buildbucket.v2.Build
-	37, // 65: buildbucket.v2.BatchResponse.Response.error:type_name -> google.rpc.Status
-	0,  // 66: buildbucket.v2.Builds.GetBuild:input_type -> buildbucket.v2.GetBuildRequest
-	1,  // 67: buildbucket.v2.Builds.SearchBuilds:input_type -> buildbucket.v2.SearchBuildsRequest
-	5,  // 68: buildbucket.v2.Builds.UpdateBuild:input_type -> buildbucket.v2.UpdateBuildRequest
-	6,  // 69: buildbucket.v2.Builds.ScheduleBuild:input_type -> buildbucket.v2.ScheduleBuildRequest
-	7,  // 70: buildbucket.v2.Builds.CancelBuild:input_type -> buildbucket.v2.CancelBuildRequest
-	3,  // 71: buildbucket.v2.Builds.Batch:input_type -> buildbucket.v2.BatchRequest
-	8,  // 72: buildbucket.v2.Builds.CreateBuild:input_type -> buildbucket.v2.CreateBuildRequest
-	9,  // 73: buildbucket.v2.Builds.SynthesizeBuild:input_type -> buildbucket.v2.SynthesizeBuildRequest
-	12, // 74: buildbucket.v2.Builds.GetBuildStatus:input_type -> buildbucket.v2.GetBuildStatusRequest
-	10, // 75: buildbucket.v2.Builds.StartBuild:input_type -> buildbucket.v2.StartBuildRequest
-	24, // 76: buildbucket.v2.Builds.GetBuild:output_type -> buildbucket.v2.Build
-	2,  // 77: buildbucket.v2.Builds.SearchBuilds:output_type -> buildbucket.v2.SearchBuildsResponse
-	24, // 78: buildbucket.v2.Builds.UpdateBuild:output_type -> buildbucket.v2.Build
-	24, // 79: buildbucket.v2.Builds.ScheduleBuild:output_type -> buildbucket.v2.Build
-	24, // 80: buildbucket.v2.Builds.CancelBuild:output_type -> buildbucket.v2.Build
-	4,  // 81: buildbucket.v2.Builds.Batch:output_type -> buildbucket.v2.BatchResponse
-	24, // 82: buildbucket.v2.Builds.CreateBuild:output_type -> buildbucket.v2.Build
-	24, // 83: buildbucket.v2.Builds.SynthesizeBuild:output_type -> buildbucket.v2.Build
-	24, // 84: buildbucket.v2.Builds.GetBuildStatus:output_type -> buildbucket.v2.Build
-	11, // 85: buildbucket.v2.Builds.StartBuild:output_type -> buildbucket.v2.StartBuildResponse
-	76, // [76:86] is the sub-list for method output_type
-	66, // [66:76] is the sub-list for method input_type
-	66, // [66:66] is the sub-list for extension type_name
-	66, // [66:66] is the sub-list for extension extendee
-	0,  // [0:66] is the sub-list for field type_name
+	24, // 0: buildbucket.v2.GetBuildRequest.builder:type_name -> buildbucket.v2.BuilderID
+	25, // 1: buildbucket.v2.GetBuildRequest.fields:type_name -> google.protobuf.FieldMask
+	15, // 2: buildbucket.v2.GetBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
+	16, // 3: buildbucket.v2.SearchBuildsRequest.predicate:type_name -> buildbucket.v2.BuildPredicate
+	25, // 4: buildbucket.v2.SearchBuildsRequest.fields:type_name -> google.protobuf.FieldMask
+	15, // 5: buildbucket.v2.SearchBuildsRequest.mask:type_name -> buildbucket.v2.BuildMask
+	26, // 6: buildbucket.v2.SearchBuildsResponse.builds:type_name -> buildbucket.v2.Build
+	18, // 7: buildbucket.v2.BatchRequest.requests:type_name -> buildbucket.v2.BatchRequest.Request
+	19, // 8: buildbucket.v2.BatchResponse.responses:type_name -> buildbucket.v2.BatchResponse.Response
+	26, // 9: buildbucket.v2.UpdateBuildRequest.build:type_name -> buildbucket.v2.Build
+	25, // 10: buildbucket.v2.UpdateBuildRequest.update_mask:type_name -> google.protobuf.FieldMask
+	25, // 11: buildbucket.v2.UpdateBuildRequest.fields:type_name -> google.protobuf.FieldMask
+	15, // 12: buildbucket.v2.UpdateBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
+	24, // 13: buildbucket.v2.ScheduleBuildRequest.builder:type_name -> buildbucket.v2.BuilderID
+	27, // 14: buildbucket.v2.ScheduleBuildRequest.canary:type_name -> buildbucket.v2.Trinary
+	27, // 15: buildbucket.v2.ScheduleBuildRequest.experimental:type_name -> buildbucket.v2.Trinary
+	20, // 16: buildbucket.v2.ScheduleBuildRequest.experiments:type_name -> buildbucket.v2.ScheduleBuildRequest.ExperimentsEntry
+	28, // 17: buildbucket.v2.ScheduleBuildRequest.properties:type_name -> google.protobuf.Struct
+	29, // 18: buildbucket.v2.ScheduleBuildRequest.gitiles_commit:type_name -> buildbucket.v2.GitilesCommit
+	30, // 19: buildbucket.v2.ScheduleBuildRequest.gerrit_changes:type_name -> buildbucket.v2.GerritChange
+	31, // 20: buildbucket.v2.ScheduleBuildRequest.tags:type_name -> buildbucket.v2.StringPair
+	32, // 21: buildbucket.v2.ScheduleBuildRequest.dimensions:type_name -> buildbucket.v2.RequestedDimension
+	33, // 22: buildbucket.v2.ScheduleBuildRequest.notify:type_name -> buildbucket.v2.NotificationConfig
+	25, // 23: buildbucket.v2.ScheduleBuildRequest.fields:type_name -> google.protobuf.FieldMask
+	15, // 24: buildbucket.v2.ScheduleBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
+	27, // 25: buildbucket.v2.ScheduleBuildRequest.critical:type_name -> buildbucket.v2.Trinary
+	34, // 26: buildbucket.v2.ScheduleBuildRequest.exe:type_name -> buildbucket.v2.Executable
+	21, // 27: buildbucket.v2.ScheduleBuildRequest.swarming:type_name -> buildbucket.v2.ScheduleBuildRequest.Swarming
+	35, // 28: buildbucket.v2.ScheduleBuildRequest.scheduling_timeout:type_name -> google.protobuf.Duration
+	35, // 29: buildbucket.v2.ScheduleBuildRequest.execution_timeout:type_name -> google.protobuf.Duration
+	35, // 30: buildbucket.v2.ScheduleBuildRequest.grace_period:type_name -> google.protobuf.Duration
+	27, // 31: buildbucket.v2.ScheduleBuildRequest.can_outlive_parent:type_name -> buildbucket.v2.Trinary
+	27, // 32: buildbucket.v2.ScheduleBuildRequest.retriable:type_name -> buildbucket.v2.Trinary
+	22, // 33: buildbucket.v2.ScheduleBuildRequest.shadow_input:type_name -> buildbucket.v2.ScheduleBuildRequest.ShadowInput
+	36, // 34: buildbucket.v2.ScheduleBuildRequest.depends_on:type_name -> buildbucket.v2.BuildDependency
+	25, // 35: buildbucket.v2.CancelBuildRequest.fields:type_name -> google.protobuf.FieldMask
+	15, // 36: buildbucket.v2.CancelBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
+	26, // 37: buildbucket.v2.CreateBuildRequest.build:type_name -> buildbucket.v2.Build
+	15, // 38: buildbucket.v2.CreateBuildRequest.mask:type_name -> buildbucket.v2.BuildMask
+	24, // 39: buildbucket.v2.SynthesizeBuildRequest.builder:type_name -> buildbucket.v2.BuilderID
+	23, // 40: buildbucket.v2.SynthesizeBuildRequest.experiments:type_name -> buildbucket.v2.SynthesizeBuildRequest.ExperimentsEntry
+	26, // 41: buildbucket.v2.StartBuildResponse.build:type_name -> buildbucket.v2.Build
+	24, // 42: buildbucket.v2.GetBuildStatusRequest.builder:type_name -> buildbucket.v2.BuilderID
+	16, // 43: buildbucket.v2.WatchBuildsRequest.predicate:type_name -> buildbucket.v2.BuildPredicate
+	15, // 44: buildbucket.v2.WatchBuildsRequest.mask:type_name -> buildbucket.v2.BuildMask
+	26, // 45: buildbucket.v2.WatchBuildsResponse.build:type_name -> buildbucket.v2.Build
+	25, // 46: buildbucket.v2.BuildMask.fields:type_name -> google.protobuf.FieldMask
+	37, // 47: buildbucket.v2.BuildMask.input_properties:type_name -> structmask.StructMask
+	37, // 48: buildbucket.v2.BuildMask.output_properties:type_name -> structmask.StructMask
+	37, // 49: buildbucket.v2.BuildMask.requested_properties:type_name -> structmask.StructMask
+	38, // 50: buildbucket.v2.BuildMask.step_status:type_name -> buildbucket.v2.Status
+	24, // 51: buildbucket.v2.BuildPredicate.builder:type_name -> buildbucket.v2.BuilderID
+	38, // 52: buildbucket.v2.BuildPredicate.status:type_name -> buildbucket.v2.Status
+	30, // 53: buildbucket.v2.BuildPredicate.gerrit_changes:type_name -> buildbucket.v2.GerritChange
+	29, // 54: buildbucket.v2.BuildPredicate.output_gitiles_commit:type_name -> buildbucket.v2.GitilesCommit
+	31, // 55: buildbucket.v2.BuildPredicate.tags:type_name -> buildbucket.v2.StringPair
+	39, // 56: buildbucket.v2.BuildPredicate.create_time:type_name -> buildbucket.v2.TimeRange
+	17, // 57: buildbucket.v2.BuildPredicate.build:type_name -> buildbucket.v2.BuildRange
+	27, // 58: buildbucket.v2.BuildPredicate.canary:type_name -> buildbucket.v2.Trinary
+	0,  // 59: buildbucket.v2.BatchRequest.Request.get_build:type_name -> buildbucket.v2.GetBuildRequest
+	1,  // 60: buildbucket.v2.BatchRequest.Request.search_builds:type_name -> buildbucket.v2.SearchBuildsRequest
+	6,  // 61: buildbucket.v2.BatchRequest.Request.schedule_build:type_name -> buildbucket.v2.ScheduleBuildRequest
+	7,  // 62: buildbucket.v2.BatchRequest.Request.cancel_build:type_name -> buildbucket.v2.CancelBuildRequest
+	12, // 63: buildbucket.v2.BatchRequest.Request.get_build_status:type_name -> buildbucket.v2.GetBuildStatusRequest
+	26, // 64: buildbucket.v2.BatchResponse.Response.get_build:type_name -> buildbucket.v2.Build
+	2,  // 65: buildbucket.v2.BatchResponse.Response.search_builds:type_name -> buildbucket.v2.SearchBuildsResponse
+	26, // 66: buildbucket.v2.BatchResponse.Response.schedule_build:type_name -> buildbucket.v2.Build
+	26, // 67: buildbucket.v2.BatchResponse.Response.cancel_build:type_name -> buildbucket.v2.Build
+	26, // 68: buildbucket.v2.BatchResponse.Response.get_build_status:type_name -> buildbucket.v2.Build
+	40, // 69: buildbucket.v2.BatchResponse.Response.error:type_name -> google.rpc.Status
+	0,  // 70: buildbucket.v2.Builds.GetBuild:input_type -> buildbucket.v2.GetBuildRequest
+	1,  // 71: buildbucket.v2.Builds.SearchBuilds:input_type -> buildbucket.v2.SearchBuildsRequest
+	5,  // 72: buildbucket.v2.Builds.UpdateBuild:input_type -> buildbucket.v2.UpdateBuildRequest
+	6,  // 73: buildbucket.v2.Builds.ScheduleBuild:input_type -> buildbucket.v2.ScheduleBuildRequest
+	7,  // 74: buildbucket.v2.Builds.CancelBuild:input_type -> buildbucket.v2.CancelBuildRequest
+	3,  // 75: buildbucket.v2.Builds.Batch:input_type -> buildbucket.v2.BatchRequest
+	8,  // 76: buildbucket.v2.Builds.CreateBuild:input_type -> buildbucket.v2.CreateBuildRequest
+	9,  // 77: buildbucket.v2.Builds.SynthesizeBuild:input_type -> buildbucket.v2.SynthesizeBuildRequest
+	12, // 78: buildbucket.v2.Builds.GetBuildStatus:input_type -> buildbucket.v2.GetBuildStatusRequest
+	10, // 79: buildbucket.v2.Builds.StartBuild:input_type -> buildbucket.v2.StartBuildRequest
+	13, // 80: buildbucket.v2.Builds.WatchBuilds:input_type -> buildbucket.v2.WatchBuildsRequest
+	26, // 81: buildbucket.v2.Builds.GetBuild:output_type -> buildbucket.v2.Build
+	2,  // 82: buildbucket.v2.Builds.SearchBuilds:output_type -> buildbucket.v2.SearchBuildsResponse
+	26, // 83: buildbucket.v2.Builds.UpdateBuild:output_type -> buildbucket.v2.Build
+	26, // 84: buildbucket.v2.Builds.ScheduleBuild:output_type -> buildbucket.v2.Build
+	26, // 85: buildbucket.v2.Builds.CancelBuild:output_type -> buildbucket.v2.Build
+	4,  // 86: buildbucket.v2.Builds.Batch:output_type -> buildbucket.v2.BatchResponse
+	26, // 87: buildbucket.v2.Builds.CreateBuild:output_type -> buildbucket.v2.Build
+	26, // 88: buildbucket.v2.Builds.SynthesizeBuild:output_type -> buildbucket.v2.Build
+	26, // 89: buildbucket.v2.Builds.GetBuildStatus:output_type -> buildbucket.v2.Build
+	11, // 90: buildbucket.v2.Builds.StartBuild:output_type -> buildbucket.v2.StartBuildResponse
+	14, // 91: buildbucket.v2.Builds.WatchBuilds:output_type -> buildbucket.v2.WatchBuildsResponse
+	81, // [81:92] is the sub-list for method output_type
+	70, // [70:81] is the sub-list for method input_type
+	70, // [70:70] is the sub-list for extension type_name
+	70, // [70:70] is the sub-list for extension extendee
+	0,  // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() }
@@ -2823,7 +2999,7 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildMask); i {
+			switch v := v.(*WatchBuildsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2835,7 +3011,7 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildPredicate); i {
+			switch v := v.(*WatchBuildsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2847,7 +3023,7 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildRange); i {
+			switch v := v.(*BuildMask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2859,7 +3035,7 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchRequest_Request); i {
+			switch v := v.(*BuildPredicate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2871,7 +3047,19 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchResponse_Response); i {
+			switch v := v.(*BuildRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequest_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2883,6 +3071,18 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ScheduleBuildRequest_Swarming); i {
 			case 0:
 				return &v.state
@@ -2894,7 +3094,7 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 				return nil
 			}
 		}
-		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ScheduleBuildRequest_ShadowInput); i {
 			case 0:
 				return &v.state
@@ -2907,14 +3107,14 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 			}
 		}
 	}
-	file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*BatchRequest_Request_GetBuild)(nil),
 		(*BatchRequest_Request_SearchBuilds)(nil),
 		(*BatchRequest_Request_ScheduleBuild)(nil),
 		(*BatchRequest_Request_CancelBuild)(nil),
 		(*BatchRequest_Request_GetBuildStatus)(nil),
 	}
-	file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*BatchResponse_Response_GetBuild)(nil),
 		(*BatchResponse_Response_SearchBuilds)(nil),
 		(*BatchResponse_Response_ScheduleBuild)(nil),
@@ -2928,7 +3128,7 @@ func file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_buildbucket_proto_builds_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3040,6 +3240,22 @@ type BuildsClient interface {
 	// StartBuild will associate a task with a build if the association is not done
 	// after RunTaskResponse is returned to buildbucket.
 	StartBuild(ctx context.Context, in *StartBuildRequest, opts ...grpc.CallOption) (*StartBuildResponse, error)
+	// Watches builds for state changes.
+	//
+	// Streams a build every time one of the watched builds changes its state,
+	// soon after the change is committed. The stream never ends on its own: the
+	// client must cancel it when it is no longer interested. If the server
+	// closes the stream, e.g. during a restart, the client should retry; updates
+	// committed in between are not replayed.
+	//
+	// Builds the caller doesn't have access to are silently skipped.
+	//
+	// The server sends response headers as soon as it starts watching, before
+	// the first build.
+	//
+	// Available only over gRPC, since pRPC doesn't support streaming. Clients
+	// should fall back to polling if the method is unimplemented.
+	WatchBuilds(ctx context.Context, in *WatchBuildsRequest, opts ...grpc.CallOption) (Builds_WatchBuildsClient, error)
 }
 type buildsPRPCClient struct {
 	client *prpc.Client
@@ -3139,6 +3355,10 @@ func (c *buildsPRPCClient) StartBuild(ctx context.Context, in *StartBuildRequest
 	return out, nil
 }
 
+func (c *buildsPRPCClient) WatchBuilds(ctx context.Context, in *WatchBuildsRequest, opts ...grpc.CallOption) (Builds_WatchBuildsClient, error) {
+	return nil, prpc.ErrNoStreamingSupport
+}
+
 type buildsClient struct {
 	cc grpc.ClientConnInterface
 }
@@ -3237,6 +3457,38 @@ func (c *buildsClient) StartBuild(ctx context.Context, in *StartBuildRequest, op
 	return out, nil
 }
 
+func (c *buildsClient) WatchBuilds(ctx context.Context, in *WatchBuildsRequest, opts ...grpc.CallOption) (Builds_WatchBuildsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Builds_serviceDesc.Streams[0], "/buildbucket.v2.Builds/WatchBuilds", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &buildsWatchBuildsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Builds_WatchBuildsClient interface {
+	Recv() (*WatchBuildsResponse, error)
+	grpc.ClientStream
+}
+
+type buildsWatchBuildsClient struct {
+	grpc.ClientStream
+}
+
+func (x *buildsWatchBuildsClient) Recv() (*WatchBuildsResponse, error) {
+	m := new(WatchBuildsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BuildsServer is the server API for Builds service.
 type BuildsServer interface {
 	// Gets a build.
@@ -3325,6 +3577,22 @@ type BuildsServer interface {
 	// StartBuild will associate a task with a build if the association is not done
 	// after RunTaskResponse is returned to buildbucket.
 	StartBuild(context.Context, *StartBuildRequest) (*StartBuildResponse, error)
+	// Watches builds for state changes.
+	//
+	// Streams a build every time one of the watched builds changes its state,
+	// soon after the change is committed. The stream never ends on its own: the
+	// client must cancel it when it is no longer interested. If the server
+	// closes the stream, e.g. during a restart, the client should retry; updates
+	// committed in between are not replayed.
+	//
+	// Builds the caller doesn't have access to are silently skipped.
+	//
+	// The server sends response headers as soon as it starts watching, before
+	// the first build.
+	//
+	// Available only over gRPC, since pRPC doesn't support streaming. Clients
+	// should fall back to polling if the method is unimplemented.
+	WatchBuilds(*WatchBuildsRequest, Builds_WatchBuildsServer) error
 }
 
 // UnimplementedBuildsServer can be embedded to have forward compatible implementations.
@@ -3361,6 +3629,9 @@ func (*UnimplementedBuildsServer) GetBuildStatus(context.Context, *GetBuildStatu
 func (*UnimplementedBuildsServer) StartBuild(context.Context, *StartBuildRequest) (*StartBuildResponse, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method StartBuild not implemented")
 }
+func (*UnimplementedBuildsServer) WatchBuilds(*WatchBuildsRequest, Builds_WatchBuildsServer) error {
+	return status1.Errorf(codes.Unimplemented, "method WatchBuilds not implemented")
+}
 
 func RegisterBuildsServer(s prpc.Registrar, srv BuildsServer) {
 	s.RegisterService(&_Builds_serviceDesc, srv)
@@ -3546,6 +3817,27 @@ func _Builds_StartBuild_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Builds_WatchBuilds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBuildsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BuildsServer).WatchBuilds(m, &buildsWatchBuildsServer{stream})
+}
+
+type Builds_WatchBuildsServer interface {
+	Send(*WatchBuildsResponse) error
+	grpc.ServerStream
+}
+
+type buildsWatchBuildsServer struct {
+	grpc.ServerStream
+}
+
+func (x *buildsWatchBuildsServer) Send(m *WatchBuildsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Builds_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "buildbucket.v2.Builds",
 	HandlerType: (*BuildsServer)(nil),
@@ -3591,6 +3883,12 @@ var _Builds_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Builds_StartBuild_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBuilds",
+			Handler:       _Builds_WatchBuilds_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "go.chromium.org/luci/buildbucket/proto/builds_service.proto",
 }
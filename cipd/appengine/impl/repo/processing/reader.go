@@ -19,6 +19,7 @@ import (
 	"math"
 
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/errors"
 )
@@ -42,6 +43,7 @@ func NewPackageReader(r io.ReaderAt, size int64) (*PackageReader, error) {
 		// in case of transient Google Storage errors.
 		return nil, err
 	}
+	zr.RegisterDecompressor(zstd.ZipMethodWinZip, zstd.ZipDecompressor())
 	return &PackageReader{zr}, nil
 }
 
@@ -25,6 +25,7 @@ import (
 
 	"github.com/klauspost/compress/flate"
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/errors"
@@ -54,9 +55,17 @@ type Options struct {
 	// InstallMode defines how to install the package: "copy" or "symlink".
 	InstallMode pkg.InstallMode
 
-	// CompressionLevel defines deflate compression level in range [0-9].
+	// CompressionLevel defines compression level in range [0-9].
+	//
+	// 0 disables compression. For zstd, levels are mapped to zstd encoder speeds:
+	// [1-2] fastest, [3-5] default, [6-8] better compression, 9 best compression.
 	CompressionLevel int
 
+	// Compression defines how to compress files: "deflate" or "zstd".
+	//
+	// By default it is "deflate".
+	Compression pkg.Compression
+
 	// HashAlgo specifies what hashing algorithm to use for computing instance ID.
 	//
 	// By default it is common.DefaultHashAlgo.
@@ -123,7 +132,8 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 	}
 
 	// Write the final zip file, calculate its hash to use for instance ID.
-	if err := zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.CompressionLevel); err != nil {
+	err = zipInputFiles(ctx, files, io.MultiWriter(opts.Output, hash), opts.Compression, opts.CompressionLevel)
+	if err != nil {
 		return common.Pin{}, err
 	}
 	return common.Pin{
@@ -137,8 +147,11 @@ func BuildInstance(ctx context.Context, opts Options) (common.Pin, error) {
 
 // zipInputFiles deterministically builds a zip archive out of input files and
 // writes it to the writer. Files are written in the order given.
-func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int) error {
-	logging.Infof(ctx, "About to zip %d files with compression level %d", len(files), level)
+func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, compression pkg.Compression, level int) error {
+	if compression == "" {
+		compression = pkg.CompressionDeflate
+	}
+	logging.Infof(ctx, "About to zip %d files with %s compression level %d", len(files), compression, level)
 
 	writer := zip.NewWriter(w)
 	defer writer.Close()
@@ -147,6 +160,17 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		return flate.NewWriter(out, level)
 	})
 
+	method := zip.Deflate
+	if compression == pkg.CompressionZstd {
+		// Use a single goroutine per file to make sure the output is
+		// deterministic.
+		method = zstd.ZipMethodWinZip
+		writer.RegisterCompressor(method, zstd.ZipCompressor(
+			zstd.WithEncoderLevel(zstdEncoderLevel(level)),
+			zstd.WithEncoderConcurrency(1),
+		))
+	}
+
 	// Reports zipping progress to the log each second.
 	lastReport := time.Time{}
 	progress := func(count int) {
@@ -169,10 +193,15 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 		// are zero valued. See also zip.FileInfoHeader() implementation.
 		fh := zip.FileHeader{
 			Name:   in.Name(),
-			Method: zip.Deflate,
+			Method: method,
 		}
-		if level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in) {
+		switch {
+		case level == 0 || in.Symlink() || isLikelyAlreadyCompressed(in):
 			fh.Method = zip.Store
+		case in.Name() == pkg.ManifestName:
+			// Always use deflate for the manifest, so that older clients can at least
+			// read it and discover the package is compressed with something else.
+			fh.Method = zip.Deflate
 		}
 
 		mode := os.FileMode(0400)
@@ -210,6 +239,21 @@ func zipInputFiles(ctx context.Context, files []fs.File, w io.Writer, level int)
 	return nil
 }
 
+// zstdEncoderLevel maps a compression level in range [1-9] to a zstd encoder
+// level.
+func zstdEncoderLevel(level int) zstd.EncoderLevel {
+	switch {
+	case level <= 2:
+		return zstd.SpeedFastest
+	case level <= 5:
+		return zstd.SpeedDefault
+	case level <= 8:
+		return zstd.SpeedBetterCompression
+	default:
+		return zstd.SpeedBestCompression
+	}
+}
+
 func zipRegularFile(dst io.Writer, f fs.File) error {
 	src, err := f.Open()
 	if err != nil {
@@ -350,7 +394,19 @@ func makeManifestFile(opts Options) (fs.File, error) {
 	if err := pkg.ValidateInstallMode(opts.InstallMode); err != nil {
 		return nil, err
 	}
+	if err := pkg.ValidateCompression(opts.Compression); err != nil {
+		return nil, err
+	}
+	// Deflate is the default, no need to mention it to keep the manifest (and
+	// thus instance IDs) of existing packages unchanged.
+	compression := opts.Compression
+	if compression == pkg.CompressionDeflate {
+		compression = ""
+	}
 	formatVer := pkg.ManifestFormatVersion
+	if compression == pkg.CompressionZstd {
+		formatVer = pkg.ManifestFormatVersionZstd
+	}
 	if opts.OverrideFormatVersion != "" {
 		formatVer = opts.OverrideFormatVersion
 	}
@@ -360,6 +416,7 @@ func makeManifestFile(opts Options) (fs.File, error) {
 		PackageName:   opts.PackageName,
 		VersionFile:   opts.VersionFile,
 		InstallMode:   opts.InstallMode,
+		Compression:   compression,
 	}, buf)
 	if err != nil {
 		return nil, err
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
@@ -265,6 +266,106 @@ func TestBuildInstance(t *testing.T) {
 		}
 	})
 
+	Convey("Building zstd package", t, func() {
+		makeOpts := func(out io.Writer, level int) Options {
+			return Options{
+				Input: []fs.File{
+					fs.NewTestFile("testing/qwerty", "12345", fs.TestFileOpts{}),
+					fs.NewTestFile("abc", "duh", fs.TestFileOpts{Executable: true}),
+					fs.NewTestFile("archive.zip", "already compressed", fs.TestFileOpts{}),
+					fs.NewTestSymlink("rel_symlink", "abc"),
+				},
+				Output:           out,
+				PackageName:      "testing",
+				Compression:      pkg.CompressionZstd,
+				CompressionLevel: level,
+			}
+		}
+
+		goodManifest := `{
+  "format_version": "1.2",
+  "package_name": "testing",
+  "compression": "zstd"
+}`
+
+		goodFiles := []zippedFile{
+			{
+				name: "testing/qwerty",
+				size: 5,
+				mode: 0400,
+				body: []byte("12345"),
+			},
+			{
+				name: "abc",
+				size: 3,
+				mode: 0500,
+				body: []byte("duh"),
+			},
+			{
+				name: "archive.zip",
+				size: 18,
+				mode: 0400,
+				body: []byte("already compressed"),
+			},
+			{
+				name: "rel_symlink",
+				size: 3,
+				mode: 0400 | os.ModeSymlink,
+				body: []byte("abc"),
+			},
+			{
+				name: pkg.ManifestName,
+				size: uint64(len(goodManifest)),
+				mode: 0400,
+				body: []byte(goodManifest),
+			},
+		}
+
+		for lvl := 0; lvl <= 9; lvl++ {
+			out := bytes.Buffer{}
+			pin, err := BuildInstance(ctx, makeOpts(&out, lvl))
+			So(err, ShouldBeNil)
+			So(readZip(out.Bytes()), ShouldResemble, goodFiles)
+
+			// Regular files are compressed with zstd, unless they are already
+			// compressed. The manifest is always readable by older clients.
+			methods := zipMethods(out.Bytes())
+			if lvl == 0 {
+				So(methods, ShouldResemble, []uint16{zip.Store, zip.Store, zip.Store, zip.Store, zip.Store})
+			} else {
+				So(methods, ShouldResemble, []uint16{zstd.ZipMethodWinZip, zstd.ZipMethodWinZip, zip.Store, zip.Store, zip.Deflate})
+			}
+
+			// The output is deterministic.
+			again := bytes.Buffer{}
+			pin2, err := BuildInstance(ctx, makeOpts(&again, lvl))
+			So(err, ShouldBeNil)
+			So(pin2, ShouldResemble, pin)
+			So(again.Bytes(), ShouldResemble, out.Bytes())
+		}
+	})
+
+	Convey("Explicit deflate compression doesn't change the instance ID", t, func() {
+		out := bytes.Buffer{}
+		pin, err := BuildInstance(ctx, Options{
+			Output:           &out,
+			PackageName:      "testing",
+			Compression:      pkg.CompressionDeflate,
+			CompressionLevel: 5,
+		})
+		So(err, ShouldBeNil)
+		So(pin.InstanceID, ShouldEqual, "PPM180-5i-V1q5554ewKGO4jq4cWB-cOwTuyhoCv3joC")
+	})
+
+	Convey("Bad compression fails", t, func() {
+		_, err := BuildInstance(ctx, Options{
+			Output:      &bytes.Buffer{},
+			PackageName: "testing",
+			Compression: "lzma",
+		})
+		So(err, ShouldNotBeNil)
+	})
+
 	Convey("Duplicate files fail", t, func() {
 		_, err := BuildInstance(ctx, Options{
 			Input: []fs.File{
@@ -332,6 +433,7 @@ func readZip(data []byte) []zippedFile {
 	if err != nil {
 		panic("Failed to open zip file")
 	}
+	z.RegisterDecompressor(zstd.ZipMethodWinZip, zstd.ZipDecompressor())
 	files := make([]zippedFile, len(z.File))
 	for i, zf := range z.File {
 		reader, err := zf.Open()
@@ -357,3 +459,16 @@ func readZip(data []byte) []zippedFile {
 	}
 	return files
 }
+
+// zipMethods returns compression methods of files in the zip.
+func zipMethods(data []byte) []uint16 {
+	z, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		panic("Failed to open zip file")
+	}
+	methods := make([]uint16, len(z.File))
+	for i, zf := range z.File {
+		methods[i] = zf.Method
+	}
+	return methods
+}
@@ -46,6 +46,9 @@ type PackageDef struct {
 	// InstallMode defines how to deploy the package file: "copy" or "symlink".
 	InstallMode pkg.InstallMode `yaml:"install_mode"`
 
+	// Compression defines how to compress package files: "deflate" or "zstd".
+	Compression pkg.Compression `yaml:"compression"`
+
 	// PreserveModTime instructs CIPD to preserve the mtime of the files.
 	PreserveModTime bool `yaml:"preserve_mtime"`
 
@@ -105,6 +108,9 @@ func LoadPackageDef(r io.Reader, vars map[string]string) (PackageDef, error) {
 	if err = pkg.ValidateInstallMode(out.InstallMode); err != nil {
 		return PackageDef{}, err
 	}
+	if err = pkg.ValidateCompression(out.Compression); err != nil {
+		return PackageDef{}, err
+	}
 
 	versionFile := ""
 	for i, chunk := range out.Data {
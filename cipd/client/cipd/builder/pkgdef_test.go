@@ -45,6 +45,7 @@ func TestLoadPackageDef(t *testing.T) {
 			"package": "package/${var1}",
 			"root": "../..",
 			"install_mode": "copy",
+			"compression": "zstd",
 			"data": [
 				{
 					"file": "some_file_${var1}"
@@ -76,6 +77,7 @@ func TestLoadPackageDef(t *testing.T) {
 			Package:     "package/value1",
 			Root:        "../..",
 			InstallMode: "copy",
+			Compression: "zstd",
 			Data: []PackageChunkDef{
 				{
 					File: "some_file_value1",
@@ -131,6 +133,12 @@ func TestLoadPackageDef(t *testing.T) {
 		So(err, ShouldNotBeNil)
 	})
 
+	Convey("LoadPackageDef bad compression", t, func() {
+		body := strings.NewReader(`{"package": "abc", "compression": "lzma"}`)
+		_, err := LoadPackageDef(body, nil)
+		So(err, ShouldNotBeNil)
+	})
+
 	Convey("LoadPackageDef bad package name", t, func() {
 		body := strings.NewReader(`{"package": "not a valid name"}`)
 		_, err := LoadPackageDef(body, nil)
@@ -122,6 +122,7 @@ const (
 	EnvParallelDownloads   = "CIPD_PARALLEL_DOWNLOADS"
 	EnvAdmissionPlugin     = "CIPD_ADMISSION_PLUGIN"
 	EnvCIPDServiceURL      = "CIPD_SERVICE_URL"
+	EnvDeltaDownloads      = "CIPD_DELTA_DOWNLOADS"
 )
 
 var (
@@ -387,6 +388,15 @@ type ClientOptions struct {
 	//  >1: will fetch multiple packages at once and unzip in parallel to that.
 	ParallelDownloads int
 
+	// DeltaDownloads, if true, enables delta downloads of package upgrades.
+	//
+	// When upgrading an already deployed package, the client will try to
+	// reconstruct the new instance file from unchanged files of the deployed
+	// instance, fetching only the parts of the new instance file that changed.
+	// The result is verified against the instance ID. If this fails for any
+	// reason, the client falls back to the full download.
+	DeltaDownloads bool
+
 	// UserAgent is put into User-Agent HTTP header with each request.
 	//
 	// Default is UserAgent const.
@@ -459,6 +469,15 @@ func (opts *ClientOptions) LoadFromEnv(ctx context.Context) error {
 			}
 		}
 	}
+	if !opts.DeltaDownloads {
+		if v := env.Get(EnvDeltaDownloads); v != "" {
+			val, err := strconv.ParseBool(v)
+			if err != nil {
+				return errors.Reason("bad %s %q: not a boolean", EnvDeltaDownloads, v).Tag(cipderr.BadArgument).Err()
+			}
+			opts.DeltaDownloads = val
+		}
+	}
 	if opts.UserAgent == "" {
 		if v := env.Get(EnvHTTPUserAgentPrefix); v != "" {
 			opts.UserAgent = fmt.Sprintf("%s/%s", v, UserAgent)
@@ -1846,6 +1865,19 @@ func (c *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pin, ou
 		return c.rpcErr(err, nil)
 	}
 
+	if c.DeltaDownloads {
+		if subdirs := deltaBases(ctx); len(subdirs) != 0 {
+			switch err := c.deltaFetchInstance(ctx, pin, objRef, resp.SignedUrl, subdirs, output); {
+			case err == nil:
+				return nil
+			case err == errDeltaNotWorthIt:
+				logging.Infof(ctx, "Delta download of %s is not worth it, doing the full download", pin)
+			default:
+				logging.Warningf(ctx, "Delta download of %s failed, doing the full download: %s", pin, err)
+			}
+		}
+	}
+
 	hash := common.MustNewHash(objRef.HashAlgo)
 	if err = c.storage.download(ctx, resp.SignedUrl, output, hash); err != nil {
 		return
@@ -1986,6 +2018,12 @@ func (c *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSlice
 			checkCtx, checkDone = ui.NewActivity(ctx, activities, "check")
 		}
 
+		// If upgrading a package, the already deployed version can be used as
+		// a base for a delta download.
+		if c.DeltaDownloads {
+			fetchCtx = withDeltaBases(fetchCtx, deltaBaseSubdirs(existing, a.pin, a.updates))
+		}
+
 		reqs[i] = &internal.InstanceRequest{
 			Context: fetchCtx,
 			Done:    fetchDone,
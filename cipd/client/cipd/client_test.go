@@ -1156,6 +1156,45 @@ func TestEnsurePackage(t *testing.T) {
 			So(storage.downloads(), ShouldEqual, 2)
 		})
 
+		Convey("EnsurePackages does delta downloads", func() {
+			client.DeltaDownloads = true
+
+			// A body that compresses poorly, so it is large in the zip too.
+			big := strings.Builder{}
+			for i := 0; i < 200000; i++ {
+				fmt.Fprintf(&big, "%x", src.Int63()&0xffff)
+			}
+
+			v1, pin1 := buildTestInstance("pkg/delta", map[string]string{
+				"big":   big.String(),
+				"small": "v1",
+			})
+			v2, pin2 := buildTestInstance("pkg/delta", map[string]string{
+				"big":   big.String(),
+				"small": "v2",
+			})
+			setupRemoteInstance(v1, pin1, repo, storage)
+			setupRemoteInstance(v2, pin2, repo, storage)
+
+			// Consume the expected call for the default instance and install v1.
+			_, err := ensurePackages(common.PinSliceBySubdir{"": {pin}, "sub": {pin1}})
+			So(err, ShouldBeNil)
+			So(storage.downloads(), ShouldEqual, 2)
+			So(storage.rangeDownloads(), ShouldEqual, 0)
+
+			_, err = ensurePackages(common.PinSliceBySubdir{"": {pin}, "sub": {pin2}})
+			So(err, ShouldBeNil)
+			So(storage.downloads(), ShouldEqual, 2) // no full download
+			So(storage.rangeDownloads(), ShouldBeGreaterThan, 0)
+
+			body, err := os.ReadFile(filepath.Join(client.Root, "sub", "small"))
+			So(err, ShouldBeNil)
+			So(string(body), ShouldEqual, "v2")
+			body, err = os.ReadFile(filepath.Join(client.Root, "sub", "big"))
+			So(err, ShouldBeNil)
+			So(string(body), ShouldEqual, big.String())
+		})
+
 		// TODO: Add more tests.
 	})
 }
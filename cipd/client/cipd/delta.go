@@ -0,0 +1,172 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cipd
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	api "go.chromium.org/luci/cipd/api/cipd/v1"
+	"go.chromium.org/luci/cipd/client/cipd/deployer"
+	"go.chromium.org/luci/cipd/client/cipd/internal/delta"
+	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/cipd/common/cipderr"
+)
+
+const (
+	// deltaTailSize is how many bytes to fetch from the end of the instance file
+	// initially when looking for the zip central directory.
+	deltaTailSize = 64 * 1024
+
+	// deltaMinReusedRatio is the minimal fraction of the instance file that
+	// should be reusable to bother with a delta download.
+	deltaMinReusedRatio = 0.1
+)
+
+// errDeltaNotWorthIt is returned by deltaFetchInstance if a delta download
+// wouldn't save much.
+var errDeltaNotWorthIt = errors.New("not enough data can be reused")
+
+var deltaBasesKey = "cipd.deltaBases"
+
+// withDeltaBases returns a context that carries a list of site root subdirs
+// that may have an older version of a fetched package deployed.
+//
+// They are used as a base for delta downloads.
+func withDeltaBases(ctx context.Context, subdirs []string) context.Context {
+	if len(subdirs) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, &deltaBasesKey, subdirs)
+}
+
+// deltaBases returns subdirs set via withDeltaBases.
+func deltaBases(ctx context.Context) []string {
+	subdirs, _ := ctx.Value(&deltaBasesKey).([]string)
+	return subdirs
+}
+
+// deltaBaseSubdirs returns subdirs where the given actions will replace some
+// other instance of the package.
+func deltaBaseSubdirs(existing common.PinSliceBySubdir, pin common.Pin, actions []pinAction) []string {
+	var subdirs []string
+	for _, a := range actions {
+		if a.action != ActionInstall {
+			continue
+		}
+		for _, have := range existing[a.subdir] {
+			if have.PackageName == pin.PackageName && have.InstanceID != pin.InstanceID {
+				subdirs = append(subdirs, a.subdir)
+				break
+			}
+		}
+	}
+	return subdirs
+}
+
+// deltaFetchInstance reconstructs the instance file from files of a deployed
+// base instance and ranges of the remote instance file.
+//
+// Verifies the hash of the result. Returns an error if the delta download is
+// not possible for whatever reason. The caller should fall back to the full
+// download in that case.
+func (c *clientImpl) deltaFetchInstance(ctx context.Context, pin common.Pin, objRef *api.ObjectRef, url string, subdirs []string, output io.WriteSeeker) error {
+	base := c.findDeltaBase(ctx, pin, subdirs)
+	if base == nil {
+		return errDeltaNotWorthIt
+	}
+	logging.Infof(ctx, "Using %s in %q as a base for the delta download", base.Pin.InstanceID, base.Subdir)
+
+	files := make(map[string]string, len(base.Manifest.Files))
+	for _, f := range base.Manifest.Files {
+		if f.Symlink != "" {
+			continue
+		}
+		if abs, err := c.deployer.FS().RootRelToAbs(base.FilePath(f.Name)); err == nil {
+			files[f.Name] = abs
+		}
+	}
+	baseFunc := func(name string) (string, bool) {
+		path, ok := files[name]
+		return path, ok
+	}
+
+	// Fetch the tail of the file with the central directory, growing it if it
+	// doesn't fit.
+	tail := bytes.Buffer{}
+	size, err := c.storage.downloadRange(ctx, url, -1, deltaTailSize, &tail)
+	if err != nil {
+		return err
+	}
+	var plan *delta.Plan
+	for attempt := 0; plan == nil; attempt++ {
+		var incomplete *delta.IncompleteDirectoryError
+		switch plan, err = delta.NewPlan(ctx, size, tail.Bytes(), baseFunc, delta.Options{}); {
+		case errors.As(err, &incomplete) && attempt == 0:
+			more := bytes.Buffer{}
+			start := size - int64(tail.Len())
+			if _, err := c.storage.downloadRange(ctx, url, incomplete.Offset, start-incomplete.Offset, &more); err != nil {
+				return err
+			}
+			more.Write(tail.Bytes())
+			tail = more
+		case err != nil:
+			return err
+		}
+	}
+
+	if float64(plan.Reused()) < deltaMinReusedRatio*float64(size) {
+		return errDeltaNotWorthIt
+	}
+	logging.Infof(ctx, "Reusing %d of %d bytes, fetching the rest in %d requests", plan.Reused(), size, plan.Fetches())
+
+	if _, err := output.Seek(0, io.SeekStart); err != nil {
+		return errors.Annotate(err, "seeking output instance file").Tag(cipderr.IO).Err()
+	}
+	hash := common.MustNewHash(objRef.HashAlgo)
+	fetch := func(ctx context.Context, offset, length int64, w io.Writer) error {
+		_, err := c.storage.downloadRange(ctx, url, offset, length, w)
+		return err
+	}
+	if err := plan.Execute(ctx, fetch, io.MultiWriter(output, hash)); err != nil {
+		return err
+	}
+
+	if digest := common.HexDigest(hash); objRef.HexDigest != digest {
+		return errors.Reason("reconstructed package hash mismatch: expecting %q, got %q", objRef.HexDigest, digest).Tag(cipderr.HashMismatch).Err()
+	}
+	return nil
+}
+
+// findDeltaBase returns a deployed instance of the package to use as a base
+// for a delta download or nil if there's none.
+func (c *clientImpl) findDeltaBase(ctx context.Context, pin common.Pin, subdirs []string) *deployer.DeployedPackage {
+	for _, subdir := range subdirs {
+		dp, err := c.deployer.CheckDeployed(ctx, subdir, pin.PackageName, deployer.NotParanoid, pkg.WithManifest)
+		if err != nil {
+			logging.Warningf(ctx, "Failed to check %q in %q: %s", pin.PackageName, subdir, err)
+			continue
+		}
+		if dp.Deployed && dp.Manifest != nil && dp.Pin.InstanceID != pin.InstanceID {
+			return dp
+		}
+	}
+	return nil
+}
@@ -109,6 +109,24 @@ type DeployedPackage struct {
 	instancePath string
 }
 
+// FilePath returns a root-relative native path to the given file of the
+// deployed package, or "" if the package is not deployed.
+//
+// The name is a slash-separated path of the file inside the package. The file
+// is not guaranteed to exist.
+func (p *DeployedPackage) FilePath(name string) string {
+	if !p.Deployed {
+		return ""
+	}
+	if p.ActualInstallMode == pkg.InstallModeCopy {
+		return filepath.Join(p.Subdir, filepath.FromSlash(name))
+	}
+	if p.instancePath == "" {
+		return ""
+	}
+	return filepath.Join(p.instancePath, filepath.FromSlash(name))
+}
+
 // RepairParams is passed to RepairDeployed.
 type RepairParams struct {
 	// Instance holds the original package data.
@@ -0,0 +1,470 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package delta implements reconstruction of a CIPD instance file from files
+// that are already present locally plus byte ranges fetched from the remote
+// instance file.
+//
+// The instance file is a zip archive. Its central directory (fetched from the
+// end of the remote file) lists all files along with their CRC32 checksums,
+// sizes and offsets. A file whose local copy has the same size and CRC32 can
+// be recompressed locally instead of being fetched. Everything else (local
+// headers, data descriptors, changed files, the central directory itself) is
+// fetched remotely.
+//
+// The reconstruction is a best effort: recompressing a file is not guaranteed
+// to reproduce the exact same bytes (e.g. if the package was built by
+// a different version of the compressor). Callers must verify the hash of the
+// reconstructed file and fall back to a full download on a mismatch.
+package delta
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/klauspost/compress/flate"
+	"github.com/klauspost/compress/zip"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+const (
+	// DefaultMinReuseSize is the default value of Options.MinReuseSize.
+	DefaultMinReuseSize = 256 * 1024
+
+	// fileHeaderLen is the length of the fixed part of a zip local file header.
+	fileHeaderLen = 30
+	// fileHeaderSignature is the signature of a zip local file header.
+	fileHeaderSignature = 0x04034b50
+)
+
+// defaultLevels is the order in which compression levels are tried when
+// recompressing a deflated file.
+//
+// Level 5 is the level used by `cipd pkg-build` by default. Note that the
+// compressor must be the same one the builder uses.
+var defaultLevels = []int{5, 1, 2, 3, 4, 6, 7, 8, 9}
+
+// FetchFunc fetches [offset, offset+length) range of the remote instance file
+// and writes it to w.
+type FetchFunc func(ctx context.Context, offset, length int64, w io.Writer) error
+
+// BaseFunc returns a path to a local copy of a file in the package, if any.
+//
+// It receives a slash-separated file name as it appears in the package.
+type BaseFunc func(name string) (path string, ok bool)
+
+// IncompleteDirectoryError is returned by NewPlan if the supplied tail of the
+// instance file doesn't contain the entire central directory.
+type IncompleteDirectoryError struct {
+	// Offset is the offset in the instance file where the tail should start.
+	Offset int64
+}
+
+// Error implements error interface.
+func (e *IncompleteDirectoryError) Error() string {
+	return fmt.Sprintf("the central directory starts at offset %d, before the supplied tail", e.Offset)
+}
+
+// Options are passed to NewPlan.
+type Options struct {
+	// MinReuseSize is the minimum compressed size of a file to consider reusing
+	// it. Smaller files are always fetched.
+	//
+	// Default is DefaultMinReuseSize.
+	MinReuseSize int64
+}
+
+// Plan describes how to reconstruct an instance file.
+type Plan struct {
+	size     int64
+	segments []segment
+	reused   int64
+}
+
+// segment is a continuous range of the instance file.
+type segment struct {
+	offset int64
+	length int64
+	local  *localEntry // nil if the segment should be fetched
+}
+
+// localEntry describes how to produce compressed data of a file locally.
+type localEntry struct {
+	name   string
+	path   string
+	method uint16
+	level  int
+	header int64 // expected length of the zip local file header
+	extra  int   // expected length of the extra field in the local header
+}
+
+// Size is the total size of the instance file.
+func (p *Plan) Size() int64 { return p.size }
+
+// Reused is the number of bytes of the instance file that will be produced
+// locally instead of being fetched.
+func (p *Plan) Reused() int64 { return p.reused }
+
+// Fetches is the number of remote fetches the plan will do.
+func (p *Plan) Fetches() int {
+	n := 0
+	for _, s := range p.segments {
+		if s.local == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// NewPlan examines the central directory of the instance file and local
+// copies of its files to figure out what can be reused.
+//
+// `tail` must contain the last bytes of the instance file of the given size.
+// If it doesn't contain the entire central directory, returns
+// *IncompleteDirectoryError with the offset to fetch the tail from.
+func NewPlan(ctx context.Context, size int64, tail []byte, base BaseFunc, opts Options) (*Plan, error) {
+	if opts.MinReuseSize <= 0 {
+		opts.MinReuseSize = DefaultMinReuseSize
+	}
+
+	ra := &tailReaderAt{data: tail, start: size - int64(len(tail)), low: size}
+	zr, err := zip.NewReader(ra, size)
+	if err != nil {
+		if ra.low < ra.start {
+			return nil, &IncompleteDirectoryError{Offset: ra.low}
+		}
+		return nil, errors.Annotate(err, "parsing the central directory").Err()
+	}
+
+	offsets := make(map[*zip.File]int64, len(zr.File))
+	files := make([]*zip.File, len(zr.File))
+	for i, f := range zr.File {
+		offsets[f] = ra.headerOffset(f)
+		files[i] = f
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return offsets[files[i]] < offsets[files[j]]
+	})
+
+	plan := &Plan{size: size}
+	levels := defaultLevels
+	cur := int64(0) // the offset up to which segments were added
+
+	for _, f := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if int64(f.CompressedSize64) < opts.MinReuseSize || f.Mode()&os.ModeSymlink != 0 {
+			continue
+		}
+		path, ok := base(f.Name)
+		if !ok {
+			continue
+		}
+
+		local := &localEntry{
+			name:   f.Name,
+			path:   path,
+			method: f.Method,
+			header: int64(fileHeaderLen + len(f.Name) + len(f.Extra)),
+			extra:  len(f.Extra),
+		}
+		switch ok, err := sameContent(path, f); {
+		case err != nil:
+			return nil, err
+		case !ok:
+			continue
+		}
+		switch f.Method {
+		case zip.Store:
+			if f.CompressedSize64 != f.UncompressedSize64 {
+				continue
+			}
+		case zip.Deflate:
+			if len(levels) == 0 {
+				continue // recompression never works, don't waste time
+			}
+			level, err := findLevel(path, int64(f.CompressedSize64), levels)
+			if err != nil {
+				return nil, err
+			}
+			if level == -1 {
+				// If the very first deflated file can't be reproduced, the package was
+				// likely built by an incompatible compressor. Give up on all deflated
+				// files.
+				if len(levels) > 1 {
+					levels = nil
+				}
+				continue
+			}
+			// All files in a package are compressed using the same level.
+			local.level = level
+			levels = []int{level}
+		default:
+			continue
+		}
+
+		dataOffset := offsets[f] + local.header
+		if offsets[f] < cur || dataOffset < cur || dataOffset+int64(f.CompressedSize64) > size {
+			return nil, errors.Reason("file %q is outside of the expected range", f.Name).Err()
+		}
+		plan.addRemote(cur, dataOffset)
+		plan.segments = append(plan.segments, segment{
+			offset: dataOffset,
+			length: int64(f.CompressedSize64),
+			local:  local,
+		})
+		plan.reused += int64(f.CompressedSize64)
+		cur = dataOffset + int64(f.CompressedSize64)
+	}
+	plan.addRemote(cur, size)
+
+	return plan, nil
+}
+
+// addRemote appends a segment to fetch [from, to) range.
+func (p *Plan) addRemote(from, to int64) {
+	if from < to {
+		p.segments = append(p.segments, segment{offset: from, length: to - from})
+	}
+}
+
+// Execute reconstructs the instance file, writing it to `out` sequentially.
+//
+// Uses `fetch` to fetch ranges that can't be produced locally.
+func (p *Plan) Execute(ctx context.Context, fetch FetchFunc, out io.Writer) error {
+	// Keep the trailing bytes of each fetched segment to verify the local file
+	// header that precedes data of a reused file.
+	tw := &trailingWriter{w: out}
+	for _, s := range p.segments {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if s.local == nil {
+			tw.reset(maxHeaderLen)
+			if err := fetch(ctx, s.offset, s.length, tw); err != nil {
+				return errors.Annotate(err, "fetching [%d, %d)", s.offset, s.offset+s.length).Err()
+			}
+			continue
+		}
+		if err := s.local.checkHeader(tw.trailing()); err != nil {
+			return err
+		}
+		if err := s.local.write(out, s.length); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maxHeaderLen is the maximum length of a zip local file header.
+const maxHeaderLen = fileHeaderLen + 0xffff + 0xffff
+
+// checkHeader verifies the local file header preceding the file data matches
+// what was expected based on the central directory.
+func (e *localEntry) checkHeader(buf []byte) error {
+	if int64(len(buf)) < e.header {
+		return errors.Reason("local header of %q is not available", e.name).Err()
+	}
+	hdr := buf[int64(len(buf))-e.header:]
+	switch {
+	case binary.LittleEndian.Uint32(hdr[0:4]) != fileHeaderSignature:
+		return errors.Reason("bad local header signature for %q", e.name).Err()
+	case binary.LittleEndian.Uint16(hdr[8:10]) != e.method:
+		return errors.Reason("unexpected compression method of %q", e.name).Err()
+	case int(binary.LittleEndian.Uint16(hdr[26:28])) != len(e.name):
+		return errors.Reason("unexpected name length in local header of %q", e.name).Err()
+	case int(binary.LittleEndian.Uint16(hdr[28:30])) != e.extra:
+		return errors.Reason("unexpected extra field length in local header of %q", e.name).Err()
+	case string(hdr[fileHeaderLen:fileHeaderLen+len(e.name)]) != e.name:
+		return errors.Reason("unexpected name in local header of %q", e.name).Err()
+	}
+	return nil
+}
+
+// write writes compressed data of the local file to `out`.
+func (e *localEntry) write(out io.Writer, expected int64) error {
+	f, err := os.Open(e.path)
+	if err != nil {
+		return errors.Annotate(err, "opening %q", e.path).Err()
+	}
+	defer f.Close()
+
+	cw := &countingWriter{w: out}
+	switch e.method {
+	case zip.Store:
+		_, err = io.Copy(cw, f)
+	case zip.Deflate:
+		err = compress(cw, f, e.level)
+	}
+	if err != nil {
+		return errors.Annotate(err, "writing %q", e.name).Err()
+	}
+	if cw.n != expected {
+		return errors.Reason("%q has changed: produced %d bytes instead of %d", e.name, cw.n, expected).Err()
+	}
+	return nil
+}
+
+// sameContent returns true if the local file has the same size and CRC32 as
+// the file in the zip archive.
+func sameContent(path string, f *zip.File) (bool, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errors.Annotate(err, "opening %q", path).Err()
+	}
+	defer fd.Close()
+
+	switch st, err := fd.Stat(); {
+	case err != nil:
+		return false, errors.Annotate(err, "checking %q", path).Err()
+	case !st.Mode().IsRegular() || uint64(st.Size()) != f.UncompressedSize64:
+		return false, nil
+	}
+
+	h := crc32.NewIEEE()
+	if _, err := io.Copy(h, fd); err != nil {
+		return false, errors.Annotate(err, "reading %q", path).Err()
+	}
+	return h.Sum32() == f.CRC32, nil
+}
+
+// findLevel returns the first compression level that produces the data of
+// exactly the expected size or -1 if there's no such level.
+func findLevel(path string, expected int64, levels []int) (int, error) {
+	for _, level := range levels {
+		f, err := os.Open(path)
+		if err != nil {
+			return 0, errors.Annotate(err, "opening %q", path).Err()
+		}
+		cw := &countingWriter{w: io.Discard}
+		err = compress(cw, f, level)
+		f.Close()
+		if err != nil {
+			return 0, errors.Annotate(err, "compressing %q", path).Err()
+		}
+		if cw.n == expected {
+			return level, nil
+		}
+	}
+	return -1, nil
+}
+
+// compress deflates `src` into `dst` the same way the zip writer does.
+func compress(dst io.Writer, src io.Reader, level int) error {
+	fw, err := flate.NewWriter(dst, level)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(fw, src); err != nil {
+		return err
+	}
+	return fw.Close()
+}
+
+// tailReaderAt is io.ReaderAt on top of the tail of a file.
+//
+// It remembers the lowest offset it was asked to read from.
+type tailReaderAt struct {
+	data  []byte
+	start int64
+	low   int64
+
+	probing bool  // if true, ReadAt just records the offset and fails
+	probed  int64 // the offset recorded when probing
+}
+
+// headerOffset returns the offset of the local file header of the file.
+//
+// zip.File doesn't expose it directly, but DataOffset starts by reading the
+// local header at this offset. Intercept this read.
+func (r *tailReaderAt) headerOffset(f *zip.File) int64 {
+	r.probing, r.probed = true, -1
+	defer func() { r.probing = false }()
+	f.DataOffset()
+	return r.probed
+}
+
+func (r *tailReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if r.probing {
+		r.probed = off
+		return 0, errors.New("probing")
+	}
+	if off < r.low {
+		r.low = off
+	}
+	if off < r.start {
+		return 0, errors.Reason("offset %d is not available", off).Err()
+	}
+	n := copy(p, r.data[off-r.start:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// countingWriter counts the number of bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// trailingWriter passes writes through and remembers the last written bytes.
+type trailingWriter struct {
+	w   io.Writer
+	max int
+	buf bytes.Buffer
+}
+
+func (t *trailingWriter) reset(max int) {
+	t.max = max
+	t.buf.Reset()
+}
+
+func (t *trailingWriter) Write(p []byte) (int, error) {
+	n, err := t.w.Write(p)
+	t.buf.Write(p[:n])
+	if extra := t.buf.Len() - t.max; extra > t.max {
+		// Compact lazily to avoid shifting the buffer on every write.
+		t.buf.Next(extra)
+		rest := append([]byte(nil), t.buf.Bytes()...)
+		t.buf.Reset()
+		t.buf.Write(rest)
+	}
+	return n, err
+}
+
+func (t *trailingWriter) trailing() []byte {
+	b := t.buf.Bytes()
+	if len(b) > t.max {
+		b = b[len(b)-t.max:]
+	}
+	return b
+}
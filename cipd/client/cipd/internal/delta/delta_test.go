@@ -0,0 +1,187 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/flate"
+	"github.com/klauspost/compress/zip"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type testFile struct {
+	name   string
+	body   []byte
+	method uint16
+}
+
+// buildZip builds a zip the same way `cipd pkg-build` does.
+func buildZip(files []testFile, level int) []byte {
+	buf := bytes.Buffer{}
+	w := zip.NewWriter(&buf)
+	w.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+		return flate.NewWriter(out, level)
+	})
+	for _, f := range files {
+		fh := zip.FileHeader{Name: f.name, Method: f.method}
+		fh.SetMode(0400)
+		dst, err := w.CreateHeader(&fh)
+		if err != nil {
+			panic(err)
+		}
+		if _, err := dst.Write(f.body); err != nil {
+			panic(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// randomBody generates a compressible pseudo-random body.
+func randomBody(seed int64, size int) []byte {
+	r := rand.New(rand.NewSource(seed))
+	words := []string{"alpha ", "beta ", "gamma ", "delta ", "epsilon "}
+	buf := bytes.Buffer{}
+	for buf.Len() < size {
+		buf.WriteString(words[r.Intn(len(words))])
+		fmt.Fprintf(&buf, "%d\n", r.Intn(1000))
+	}
+	return buf.Bytes()[:size]
+}
+
+func TestDelta(t *testing.T) {
+	t.Parallel()
+
+	Convey("With files", t, func() {
+		ctx := context.Background()
+		baseDir := t.TempDir()
+
+		newFiles := []testFile{
+			{"same/deflated", randomBody(1, 300000), zip.Deflate},
+			{"same/stored", randomBody(2, 300000), zip.Store},
+			{"small", []byte("small file"), zip.Deflate},
+			{"changed", randomBody(3, 300000), zip.Deflate},
+			{"new", randomBody(4, 300000), zip.Deflate},
+			{"same/deflated2", randomBody(5, 300000), zip.Deflate},
+		}
+
+		writeBase := func(name string, body []byte) {
+			path := filepath.Join(baseDir, filepath.FromSlash(name))
+			So(os.MkdirAll(filepath.Dir(path), 0700), ShouldBeNil)
+			So(os.WriteFile(path, body, 0600), ShouldBeNil)
+		}
+		writeBase("same/deflated", newFiles[0].body)
+		writeBase("same/stored", newFiles[1].body)
+		writeBase("small", newFiles[2].body)
+		writeBase("changed", randomBody(33, 300000))
+		writeBase("same/deflated2", newFiles[5].body)
+
+		base := func(name string) (string, bool) {
+			return filepath.Join(baseDir, filepath.FromSlash(name)), true
+		}
+
+		var fetched int64
+		fetcher := func(blob []byte) FetchFunc {
+			return func(ctx context.Context, offset, length int64, w io.Writer) error {
+				fetched += length
+				_, err := w.Write(blob[offset : offset+length])
+				return err
+			}
+		}
+
+		reconstruct := func(blob []byte, tailLen int) (*Plan, []byte, error) {
+			tail := blob[len(blob)-tailLen:]
+			plan, err := NewPlan(ctx, int64(len(blob)), tail, base, Options{MinReuseSize: 1024})
+			if err != nil {
+				return nil, nil, err
+			}
+			out := bytes.Buffer{}
+			if err := plan.Execute(ctx, fetcher(blob), &out); err != nil {
+				return plan, nil, err
+			}
+			return plan, out.Bytes(), nil
+		}
+
+		Convey("Reuses unchanged files", func() {
+			blob := buildZip(newFiles, 5)
+
+			plan, out, err := reconstruct(blob, 64*1024)
+			So(err, ShouldBeNil)
+			So(bytes.Equal(out, blob), ShouldBeTrue)
+
+			// Reused "same/*" files, fetched everything else.
+			So(plan.Reused(), ShouldBeGreaterThan, 300000)
+			So(plan.Reused()+fetched, ShouldEqual, len(blob))
+			So(plan.Fetches(), ShouldEqual, 4)
+		})
+
+		Convey("Detects the compression level", func() {
+			blob := buildZip(newFiles, 9)
+
+			plan, out, err := reconstruct(blob, 64*1024)
+			So(err, ShouldBeNil)
+			So(bytes.Equal(out, blob), ShouldBeTrue)
+			So(plan.Reused(), ShouldBeGreaterThan, 300000)
+		})
+
+		Convey("Asks for a larger tail", func() {
+			blob := buildZip(newFiles, 5)
+
+			_, _, err := reconstruct(blob, 100)
+			So(err, ShouldHaveSameTypeAs, &IncompleteDirectoryError{})
+			offset := err.(*IncompleteDirectoryError).Offset
+			So(offset, ShouldBeLessThan, len(blob)-100)
+
+			_, out, err := reconstruct(blob, len(blob)-int(offset))
+			So(err, ShouldBeNil)
+			So(bytes.Equal(out, blob), ShouldBeTrue)
+		})
+
+		Convey("Detects files modified after planning", func() {
+			blob := buildZip(newFiles, 5)
+
+			plan, err := NewPlan(ctx, int64(len(blob)), blob, base, Options{MinReuseSize: 1024})
+			So(err, ShouldBeNil)
+
+			writeBase("same/stored", []byte("modified"))
+
+			err = plan.Execute(ctx, fetcher(blob), io.Discard)
+			So(err, ShouldErrLike, `"same/stored" has changed`)
+		})
+
+		Convey("Handles no base", func() {
+			blob := buildZip(newFiles, 5)
+
+			plan, err := NewPlan(ctx, int64(len(blob)), blob, func(string) (string, bool) {
+				return "", false
+			}, Options{})
+			So(err, ShouldBeNil)
+			So(plan.Reused(), ShouldEqual, 0)
+			So(plan.Fetches(), ShouldEqual, 1)
+		})
+	})
+}
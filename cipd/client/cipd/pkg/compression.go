@@ -0,0 +1,66 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"go.chromium.org/luci/cipd/common/cipderr"
+	"go.chromium.org/luci/common/errors"
+)
+
+// Compression defines how files inside a package are compressed.
+//
+// Regardless of the compression, the package file is a zip archive. Files
+// inside it are compressed individually, so any of them can be extracted
+// without decompressing the rest.
+type Compression string
+
+const (
+	// CompressionDeflate is the default: files are compressed with deflate.
+	//
+	// Packages compressed this way can be installed by all CIPD clients.
+	CompressionDeflate Compression = "deflate"
+
+	// CompressionZstd is used when files are compressed with Zstandard.
+	//
+	// It is much faster to decompress than deflate, but such packages can be
+	// installed only by CIPD clients that understand zstd.
+	CompressionZstd Compression = "zstd"
+)
+
+// Set is called by 'flag' package when parsing command line options.
+func (c *Compression) Set(value string) error {
+	val := Compression(value)
+	if err := ValidateCompression(val); err != nil {
+		return err
+	}
+	*c = val
+	return nil
+}
+
+// String is needed to conform to flag.Value interface.
+func (c Compression) String() string {
+	return string(c)
+}
+
+// ValidateCompression returns non nil if compression is invalid.
+//
+// Valid values are: "" (the same as "deflate"), "deflate"
+// (aka CompressionDeflate), "zstd" (aka CompressionZstd).
+func ValidateCompression(c Compression) error {
+	if c == "" || c == CompressionDeflate || c == CompressionZstd {
+		return nil
+	}
+	return errors.Reason("invalid compression %q", c).Tag(cipderr.BadArgument).Err()
+}
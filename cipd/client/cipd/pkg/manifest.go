@@ -32,6 +32,13 @@ const (
 
 	// ManifestFormatVersion is a version to write to the manifest file.
 	ManifestFormatVersion = "1.1"
+
+	// ManifestFormatVersionZstd is a version to write to the manifest file of
+	// packages with files compressed with zstd.
+	//
+	// Clients that don't know this version refuse to install such packages
+	// instead of failing to decompress them.
+	ManifestFormatVersionZstd = "1.2"
 )
 
 // Manifest defines structure of manifest.json file.
@@ -40,6 +47,7 @@ type Manifest struct {
 	PackageName   string      `json:"package_name"`
 	VersionFile   string      `json:"version_file,omitempty"` // where to put JSON with info about deployed package
 	InstallMode   InstallMode `json:"install_mode,omitempty"` // how to install: "copy" or "symlink"
+	Compression   Compression `json:"compression,omitempty"`  // how files are compressed: "deflate" or "zstd"
 
 	// The following fields are present only in deployed manifests:
 
@@ -31,6 +31,7 @@ import (
 
 	"github.com/klauspost/compress/flate"
 	"github.com/klauspost/compress/zip"
+	"github.com/klauspost/compress/zstd"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
@@ -506,6 +507,7 @@ func (inst *packageInstance) open(opts OpenInstanceOpts) error {
 	if inst.zip, err = zip.NewReader(inst.data, inst.data.Size()); err != nil {
 		return errors.Annotate(err, "reading instance file zip header").Tag(cipderr.IO).Err()
 	}
+	inst.zip.RegisterDecompressor(zstd.ZipMethodWinZip, zstd.ZipDecompressor())
 	inst.files = make([]fs.File, len(inst.zip.File))
 	for i, zf := range inst.zip.File {
 		fiz := &fileInZip{z: zf}
@@ -521,6 +523,15 @@ func (inst *packageInstance) open(opts OpenInstanceOpts) error {
 		inst.files[i] = fiz
 	}
 
+	// Newer format versions may use features this client doesn't support (e.g.
+	// unknown compression methods). Refuse them upfront instead of failing
+	// midway through the extraction.
+	switch inst.manifest.FormatVersion {
+	case "", "1", pkg.ManifestFormatVersion, pkg.ManifestFormatVersionZstd:
+	default:
+		return errors.Reason("unsupported package format version %q, try updating the CIPD client", inst.manifest.FormatVersion).Tag(cipderr.BadArgument).Err()
+	}
+
 	// Version "1" (legacy format) used to set the writable mode bit (0200) in
 	// zipped files, and then ignored it when unpacking. Newer versions respect
 	// the writable mode bit. Strip it off for the version "1", to preserve
@@ -576,7 +587,10 @@ func (inst *packageInstance) Close(ctx context.Context, corrupt bool) error {
 func IsCorruptionError(err error) bool {
 	return errors.Any(err, func(err error) bool {
 		switch err {
-		case io.ErrUnexpectedEOF, zip.ErrFormat, zip.ErrChecksum, zip.ErrAlgorithm, ErrHashMismatch:
+		case io.ErrUnexpectedEOF, zip.ErrFormat, zip.ErrChecksum, ErrHashMismatch:
+			return true
+		case zstd.ErrMagicMismatch, zstd.ErrCRCMismatch, zstd.ErrReservedBlockType, zstd.ErrBlockTooSmall,
+			zstd.ErrUnexpectedBlockSize, zstd.ErrCompressedSizeTooBig, zstd.ErrFrameSizeMismatch:
 			return true
 		default:
 			_, flateCorrupt := err.(flate.CorruptInputError)
@@ -658,6 +672,9 @@ func (f *fileInZip) prefetch() error {
 	}
 	r, err := f.z.Open()
 	if err != nil {
+		if err == zip.ErrAlgorithm {
+			return errors.Reason("%q: unsupported compression method %d, try updating the CIPD client", f.z.Name, f.z.Method).Tag(cipderr.BadArgument).Err()
+		}
 		return errors.Annotate(err, "prefetching %q", f.z.Name).Tag(cipderr.IO).Err()
 	}
 	defer r.Close()
@@ -722,6 +739,9 @@ func (f *fileInZip) Open() (io.ReadCloser, error) {
 	}
 	r, err := f.z.Open()
 	if err != nil {
+		if err == zip.ErrAlgorithm {
+			return nil, errors.Reason("%q: unsupported compression method %d, try updating the CIPD client", f.Name(), f.z.Method).Tag(cipderr.BadArgument).Err()
+		}
 		return nil, errors.Annotate(err, "opening %q for extraction", f.Name()).Tag(cipderr.IO).Err()
 	}
 	return r, nil
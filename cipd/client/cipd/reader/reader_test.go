@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"os"
 	"runtime"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -31,9 +32,11 @@ import (
 	"go.chromium.org/luci/cipd/client/cipd/builder"
 	"go.chromium.org/luci/cipd/client/cipd/fs"
 	"go.chromium.org/luci/cipd/client/cipd/pkg"
+	"go.chromium.org/luci/cipd/common/cipderr"
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/cipd/common"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func stringCounts(values []string) map[string]int {
@@ -383,6 +386,66 @@ func TestPackageReading(t *testing.T) {
 			shouldBeSameJSONDict, goodManifest)
 	})
 
+	Convey("ExtractFiles works with zstd packages", t, func() {
+		big := strings.Repeat("zstd is fast to decompress\n", 10000)
+
+		out := bytes.Buffer{}
+		_, err := builder.BuildInstance(ctx, builder.Options{
+			Input: []fs.File{
+				fs.NewTestFile("testing/qwerty", "12345", fs.TestFileOpts{}),
+				fs.NewTestFile("big", big, fs.TestFileOpts{Executable: true}),
+				fs.NewTestSymlink("rel_symlink", "big"),
+			},
+			Output:           &out,
+			PackageName:      "testing",
+			Compression:      pkg.CompressionZstd,
+			CompressionLevel: 5,
+		})
+		So(err, ShouldBeNil)
+		So(out.Len(), ShouldBeLessThan, len(big)/10)
+
+		inst, err := OpenInstance(ctx, bytesFile(&out), OpenInstanceOpts{
+			VerificationMode: CalculateHash,
+			HashAlgo:         api.HashAlgo_SHA256,
+		})
+		So(err, ShouldBeNil)
+		defer inst.Close(ctx, false)
+
+		dest := &testDestination{}
+		_, err = ExtractFilesTxn(ctx, inst.Files(), dest, 16, pkg.WithManifest, "")
+		So(err, ShouldBeNil)
+
+		So(string(dest.fileByName("testing/qwerty").Bytes()), ShouldEqual, "12345")
+		So(string(dest.fileByName("big").Bytes()), ShouldEqual, big)
+		So(dest.fileByName("big").executable, ShouldBeTrue)
+		So(dest.fileByName("rel_symlink").symlinkTarget, ShouldEqual, "big")
+
+		manifest, err := pkg.ReadManifest(bytes.NewReader(dest.fileByName(".cipdpkg/manifest.json").Bytes()))
+		So(err, ShouldBeNil)
+		So(manifest.Compression, ShouldEqual, pkg.CompressionZstd)
+	})
+
+	Convey("OpenInstance refuses unknown format versions", t, func() {
+		out := bytes.Buffer{}
+		_, err := builder.BuildInstance(ctx, builder.Options{
+			Input: []fs.File{
+				fs.NewTestFile("testing/qwerty", "12345", fs.TestFileOpts{}),
+			},
+			Output:                &out,
+			PackageName:           "testing",
+			OverrideFormatVersion: "2",
+		})
+		So(err, ShouldBeNil)
+
+		_, err = OpenInstance(ctx, bytesFile(&out), OpenInstanceOpts{
+			VerificationMode: CalculateHash,
+			HashAlgo:         api.HashAlgo_SHA256,
+		})
+		So(err, ShouldErrLike, `unsupported package format version "2"`)
+		So(cipderr.ToCode(err), ShouldEqual, cipderr.BadArgument)
+		So(IsCorruptionError(err), ShouldBeFalse)
+	})
+
 	Convey("ExtractFiles handles v1 packages correctly", t, func() {
 		// ZipInfos in packages with format_version "1" always have the writable bit
 		// set, and always have 0 timestamp. During the extraction of such package,
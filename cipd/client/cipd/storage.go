@@ -21,6 +21,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/net/context/ctxhttp"
@@ -70,6 +72,7 @@ func isTemporaryHTTPError(statusCode int) bool {
 type storage interface {
 	upload(ctx context.Context, url string, data io.ReadSeeker) error
 	download(ctx context.Context, url string, output io.WriteSeeker, h hash.Hash) error
+	downloadRange(ctx context.Context, url string, offset, length int64, output io.Writer) (total int64, err error)
 }
 
 // storageImpl implements 'storage' via Google Storage signed URLs.
@@ -324,6 +327,122 @@ func (s *storageImpl) download(ctx context.Context, url string, output io.WriteS
 	return errors.Reason("failed to download after multiple attempts").Tag(cipderr.CAS).Err()
 }
 
+// downloadRange fetches [offset, offset+length) range of the file and writes
+// it to the output.
+//
+// If offset is negative, fetches the last `length` bytes of the file instead.
+// Returns the total size of the file, as reported by the server.
+//
+// Retries on transient errors, resuming from where it left off.
+func (s *storageImpl) downloadRange(ctx context.Context, url string, offset, length int64, output io.Writer) (total int64, err error) {
+	// reportTransientError logs the error and sleep few seconds.
+	reportTransientError := func(msg string, args ...any) {
+		if err := ctx.Err(); err != nil {
+			return
+		}
+		logging.Warningf(ctx, msg, args...)
+		clock.Sleep(ctx, 2*time.Second)
+	}
+
+	for attempt := 0; attempt < downloadMaxAttempts; attempt++ {
+		// Context canceled?
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if length == 0 {
+			return total, nil
+		}
+
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return 0, errors.Annotate(err, "initializing GET request").Tag(cipderr.CAS).Err()
+		}
+		req.Header.Set("User-Agent", s.userAgent)
+		if offset < 0 {
+			req.Header.Set("Range", fmt.Sprintf("bytes=-%d", length))
+		} else {
+			req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+		}
+		resp, err := ctxhttp.Do(ctx, s.client, req)
+		if err != nil {
+			if isTemporaryNetError(err) {
+				reportTransientError("Failed to connect: %s", err)
+				continue
+			}
+			return 0, errors.Annotate(err, "download failed").Tag(cipderr.CAS).Err()
+		}
+
+		// Transient error, retry.
+		if isTemporaryHTTPError(resp.StatusCode) {
+			resp.Body.Close()
+			reportTransientError("Transient HTTP error %d", resp.StatusCode)
+			continue
+		}
+
+		// Servers that don't support ranges reply with 200 and the entire file.
+		if resp.StatusCode != http.StatusPartialContent {
+			resp.Body.Close()
+			return 0, errors.Reason("storage server replied with HTTP code %d to a range request", resp.StatusCode).Tag(cipderr.CAS).Err()
+		}
+
+		start, end, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil {
+			resp.Body.Close()
+			return 0, errors.Annotate(err, "bad range response").Tag(cipderr.CAS).Err()
+		}
+		if offset >= 0 && start != offset {
+			resp.Body.Close()
+			return 0, errors.Reason("asked for offset %d, but got %d", offset, start).Tag(cipderr.CAS).Err()
+		}
+		total = size
+		offset, length = start, end-start+1
+
+		// Fetch the body, remembering how much was written to resume on errors.
+		written, err := io.Copy(output, resp.Body)
+		resp.Body.Close()
+		offset += written
+		length -= written
+		if err != nil {
+			reportTransientError("Transient error: %s", err)
+			continue
+		}
+		if length != 0 {
+			reportTransientError("Got truncated response, %d bytes missing", length)
+			continue
+		}
+
+		// Success.
+		return total, nil
+	}
+
+	return 0, errors.Reason("failed to download after multiple attempts").Tag(cipderr.CAS).Err()
+}
+
+// parseContentRange parses "bytes <start>-<end>/<size>" header value.
+func parseContentRange(val string) (start, end, size int64, err error) {
+	rng, ok := strings.CutPrefix(val, "bytes ")
+	if !ok {
+		return 0, 0, 0, errors.Reason("unexpected Content-Range %q", val).Err()
+	}
+	rng, sizeStr, ok := strings.Cut(rng, "/")
+	if !ok {
+		return 0, 0, 0, errors.Reason("unexpected Content-Range %q", val).Err()
+	}
+	startStr, endStr, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, 0, errors.Reason("unexpected Content-Range %q", val).Err()
+	}
+	if start, err = strconv.ParseInt(startStr, 10, 64); err == nil {
+		if end, err = strconv.ParseInt(endStr, 10, 64); err == nil {
+			size, err = strconv.ParseInt(sizeStr, 10, 64)
+		}
+	}
+	if err != nil || start > end || end >= size {
+		return 0, 0, 0, errors.Reason("unexpected Content-Range %q", val).Err()
+	}
+	return start, end, size, nil
+}
+
 // readerWithProgress is io.Reader that calls callback whenever something is
 // read from it.
 type readerWithProgress struct {
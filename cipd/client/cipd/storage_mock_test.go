@@ -32,6 +32,7 @@ type mockedStorage struct {
 	store         map[string]string // URL -> data
 	err           error
 	downloadCount int64
+	rangeCount    int64
 }
 
 func (s *mockedStorage) getStored(url string) string {
@@ -53,6 +54,10 @@ func (s *mockedStorage) downloads() int {
 	return int(atomic.LoadInt64(&s.downloadCount))
 }
 
+func (s *mockedStorage) rangeDownloads() int {
+	return int(atomic.LoadInt64(&s.rangeCount))
+}
+
 func (s *mockedStorage) returnErr(err error) {
 	s.err = err
 }
@@ -96,3 +101,29 @@ func (s *mockedStorage) download(ctx context.Context, url string, output io.Writ
 	_, err := io.MultiWriter(output, h).Write([]byte(body))
 	return err
 }
+
+func (s *mockedStorage) downloadRange(ctx context.Context, url string, offset, length int64, output io.Writer) (int64, error) {
+	atomic.AddInt64(&s.rangeCount, 1)
+
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	body := s.getStored(url)
+	if body == "" {
+		return 0, errors.Reason("mocked downloaded error").Err()
+	}
+
+	if offset < 0 {
+		offset = int64(len(body)) - length
+		if offset < 0 {
+			offset = 0
+		}
+	}
+	end := offset + length
+	if end > int64(len(body)) {
+		end = int64(len(body))
+	}
+	_, err := output.Write([]byte(body[offset:end]))
+	return int64(len(body)), err
+}
@@ -34,6 +34,7 @@ import (
 	"go.chromium.org/luci/common/logging/gologger"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 func TestUpload(t *testing.T) {
@@ -133,6 +134,74 @@ func TestDownload(t *testing.T) {
 	})
 }
 
+func TestDownloadRange(t *testing.T) {
+	ctx := makeTestContext()
+
+	Convey("Download range", t, func(c C) {
+		out := bytes.Buffer{}
+
+		Convey("Absolute range with resume", func(c C) {
+			storage := mockStorageImpl(c, []expectedHTTPCall{
+				// Simulate a transient error.
+				{
+					Path:    "/dwn",
+					Headers: http.Header{"Range": {"bytes=5-14"}},
+					Status:  500,
+					Reply:   "error",
+				},
+				// Simulate a truncated response.
+				{
+					Path:            "/dwn",
+					Headers:         http.Header{"Range": {"bytes=5-14"}},
+					Status:          206,
+					ResponseHeaders: http.Header{"Content-Range": {"bytes 5-14/100"}},
+					Reply:           "01234",
+				},
+				{
+					Path:            "/dwn",
+					Headers:         http.Header{"Range": {"bytes=10-14"}},
+					Status:          206,
+					ResponseHeaders: http.Header{"Content-Range": {"bytes 10-14/100"}},
+					Reply:           "56789",
+				},
+			})
+			total, err := storage.downloadRange(ctx, "http://localhost/dwn", 5, 10, &out)
+			So(err, ShouldBeNil)
+			So(total, ShouldEqual, 100)
+			So(out.String(), ShouldEqual, "0123456789")
+		})
+
+		Convey("Suffix range", func(c C) {
+			storage := mockStorageImpl(c, []expectedHTTPCall{
+				{
+					Path:            "/dwn",
+					Headers:         http.Header{"Range": {"bytes=-5"}},
+					Status:          206,
+					ResponseHeaders: http.Header{"Content-Range": {"bytes 95-99/100"}},
+					Reply:           "tail!",
+				},
+			})
+			total, err := storage.downloadRange(ctx, "http://localhost/dwn", -1, 5, &out)
+			So(err, ShouldBeNil)
+			So(total, ShouldEqual, 100)
+			So(out.String(), ShouldEqual, "tail!")
+		})
+
+		Convey("Ranges not supported", func(c C) {
+			storage := mockStorageImpl(c, []expectedHTTPCall{
+				{
+					Path:    "/dwn",
+					Headers: http.Header{"Range": {"bytes=-5"}},
+					Status:  200,
+					Reply:   "whole file",
+				},
+			})
+			_, err := storage.downloadRange(ctx, "http://localhost/dwn", -1, 5, &out)
+			So(err, ShouldErrLike, "HTTP code 200")
+		})
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func makeTestContext() context.Context {
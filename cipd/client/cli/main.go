@@ -502,10 +502,15 @@ type inputOptions struct {
 	preserveModTime  bool
 	preserveWritable bool
 
-	// Deflate compression level (if [1-9]) or 0 to disable compression.
+	// Compression level (if [1-9]) or 0 to disable compression.
 	//
 	// Default is 5.
 	compressionLevel int
+
+	// Compression algorithm: "deflate" or "zstd".
+	//
+	// Default is whatever is in the package definition file or "deflate".
+	compression pkg.Compression
 }
 
 func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
@@ -532,7 +537,10 @@ func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
 
 	// Options for the builder.
 	f.IntVar(&opts.compressionLevel, "compression-level", 5,
-		"Deflate compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+		"Compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+	f.Var(&opts.compression, "compression",
+		"How to compress files: \"deflate\" (default) or \"zstd\" (faster to install, requires newer clients). "+
+			"Overrides the value in -pkg-def.")
 }
 
 // prepareInput processes inputOptions by collecting all files to be added to
@@ -573,6 +581,7 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 			Input:            files,
 			PackageName:      packageName,
 			InstallMode:      opts.installMode,
+			Compression:      opts.compression,
 			CompressionLevel: opts.compressionLevel,
 		}, nil
 	}
@@ -613,11 +622,16 @@ func (opts *inputOptions) prepareInput() (builder.Options, error) {
 		if err != nil {
 			return empty, err
 		}
+		compression := pkgDef.Compression
+		if opts.compression != "" {
+			compression = opts.compression
+		}
 		return builder.Options{
 			Input:            files,
 			PackageName:      pkgDef.Package,
 			VersionFile:      pkgDef.VersionFile(),
 			InstallMode:      pkgDef.InstallMode,
+			Compression:      compression,
 			CompressionLevel: opts.compressionLevel,
 		}, nil
 	}
@@ -2984,8 +2998,35 @@ func inspectPin(ctx context.Context, pin common.Pin) {
 	fmt.Printf("Instance: %s\n", pin)
 }
 
+// inspectCompression prints how files in the instance are compressed, as
+// recorded in its manifest.
+func inspectCompression(inst pkg.Instance) {
+	for _, f := range inst.Files() {
+		if f.Name() != pkg.ManifestName {
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			fmt.Printf("Compression: unknown (%s)\n", err)
+			return
+		}
+		defer r.Close()
+		manifest, err := pkg.ReadManifest(r)
+		switch {
+		case err != nil:
+			fmt.Printf("Compression: unknown (%s)\n", err)
+		case manifest.Compression == "":
+			fmt.Printf("Compression: %s\n", pkg.CompressionDeflate)
+		default:
+			fmt.Printf("Compression: %s\n", manifest.Compression)
+		}
+		return
+	}
+}
+
 func inspectInstance(ctx context.Context, inst pkg.Instance, listFiles bool) {
 	inspectPin(ctx, inst.Pin())
+	inspectCompression(inst)
 	if listFiles {
 		fmt.Println("Package files:")
 		for _, f := range inst.Files() {
@@ -95,6 +95,7 @@ func getApplication() *cli.Application {
 			swarmingimpl.CmdCollect(af),
 			swarmingimpl.CmdReproduce(af),
 			swarmingimpl.CmdRequestShow(af),
+			swarmingimpl.CmdRunLocal(af),
 			swarmingimpl.CmdSpawnTasks(af),
 			swarmingimpl.CmdTasks(af),
 			swarmingimpl.CmdTrigger(af),
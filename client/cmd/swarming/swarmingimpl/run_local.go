@@ -0,0 +1,501 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !copybara
+// +build !copybara
+
+package swarmingimpl
+
+import (
+	"context"
+	"flag"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bazelbuild/remote-apis-sdks/go/pkg/digest"
+	repb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/maruel/subcommands"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/cipd/client/cipd/ensure"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/system/exec2"
+
+	"go.chromium.org/luci/client/cmd/swarming/swarmingimpl/base"
+	"go.chromium.org/luci/swarming/client/swarming"
+	swarmingv2 "go.chromium.org/luci/swarming/proto/api_v2"
+)
+
+// CmdRunLocal returns an object for the `run-local` subcommand.
+func CmdRunLocal(authFlags base.AuthFlags) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "run-local -S <server> <task ID>|-json-input <path>",
+		ShortDesc: "runs a task locally the same way a bot would",
+		LongDesc: `Runs a task request locally, emulating a Swarming bot.
+
+The task request is either fetched from the server or read from a JSON file
+in the format accepted by 'spawn-tasks'. CAS inputs, CIPD packages and named
+caches are installed into the work directory, the task environment is set up
+and the command is run with the execution and I/O timeouts of the task. Files
+produced in ${ISOLATED_OUTDIR} and the declared outputs are then stored in
+a local content addressed directory.`,
+		CommandRun: func() subcommands.CommandRun {
+			return base.NewCommandRun(authFlags, &runLocalImpl{
+				cipdDownloader: downloadCIPDPackages,
+			}, base.Features{
+				MinArgs: 0,
+				MaxArgs: 1,
+				UsesCAS: true,
+				OutputJSON: base.OutputJSON{
+					Enabled: true,
+				},
+			})
+		},
+	}
+}
+
+type runLocalImpl struct {
+	work      string
+	out       string
+	cacheRoot string
+	casDir    string
+	jsonInput string
+	index     int
+	slice     int
+	taskID    string
+	requests  []*swarmingv2.NewTaskRequest
+
+	// cipdDownloader is used in testing to insert a mock CIPD downloader.
+	cipdDownloader func(context.Context, string, map[string]ensure.PackageSlice) error
+}
+
+func (cmd *runLocalImpl) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&cmd.work, "work", "work", "Directory to map the task input files into and execute the task. Will be cleared!")
+	fs.StringVar(&cmd.out, "out", "out", "Directory that will hold the task results. Will be cleared!")
+	fs.StringVar(&cmd.cacheRoot, "named-caches", "named_caches", "Directory that holds named caches between runs.")
+	fs.StringVar(&cmd.casDir, "cas-dir", "cas", "Directory to store the task outputs in, keyed by their CAS digests.")
+	fs.StringVar(&cmd.jsonInput, "json-input", "", "Read the task request from this file in 'spawn-tasks' format instead of fetching it from the server.")
+	fs.IntVar(&cmd.index, "index", 0, "Index of the request to run in the -json-input file.")
+	fs.IntVar(&cmd.slice, "slice", -1, "Index of the task slice to run. Defaults to the last one.")
+}
+
+func (cmd *runLocalImpl) ParseInputs(args []string, env subcommands.Env) error {
+	switch {
+	case len(args) == 0 && cmd.jsonInput == "":
+		return errors.Reason("expecting either a task ID or -json-input").Err()
+	case len(args) == 1 && cmd.jsonInput != "":
+		return errors.Reason("a task ID and -json-input are mutually exclusive").Err()
+	case len(args) == 1:
+		cmd.taskID = args[0]
+	default:
+		f, err := os.Open(cmd.jsonInput)
+		if err != nil {
+			return errors.Annotate(err, "failed to open -json-input tasks file").Err()
+		}
+		defer func() { _ = f.Close() }()
+		if cmd.requests, err = processTasksStream(f, env); err != nil {
+			return err
+		}
+		if cmd.index < 0 || cmd.index >= len(cmd.requests) {
+			return errors.Reason("-index %d is out of range, the file has %d requests", cmd.index, len(cmd.requests)).Err()
+		}
+	}
+
+	for _, dir := range []*string{&cmd.work, &cmd.out, &cmd.cacheRoot, &cmd.casDir} {
+		abs, err := filepath.Abs(*dir)
+		if err != nil {
+			return errors.Annotate(err, "failed to get absolute representation of %q", *dir).Err()
+		}
+		*dir = abs
+	}
+	return nil
+}
+
+func (cmd *runLocalImpl) Execute(ctx context.Context, svc swarming.Client, extra base.Extra) (any, error) {
+	var slices []*swarmingv2.TaskSlice
+	if cmd.taskID != "" {
+		tr, err := svc.TaskRequest(ctx, cmd.taskID)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to get task request: %s", cmd.taskID).Err()
+		}
+		slices = tr.TaskSlices
+	} else {
+		req := cmd.requests[cmd.index]
+		slices = req.TaskSlices
+		if len(slices) == 0 && req.Properties != nil {
+			slices = []*swarmingv2.TaskSlice{{Properties: req.Properties}}
+		}
+	}
+	if len(slices) == 0 {
+		return nil, errors.Reason("the task request has no slices").Err()
+	}
+	idx := cmd.slice
+	if idx < 0 {
+		idx = len(slices) - 1
+	}
+	if idx >= len(slices) {
+		return nil, errors.Reason("-slice %d is out of range, the task has %d slices", idx, len(slices)).Err()
+	}
+	properties := slices[idx].Properties
+	if properties == nil || len(properties.Command) == 0 {
+		return nil, errors.Reason("task slice %d has no command", idx).Err()
+	}
+
+	// Reuse the `reproduce` logic to materialize inputs and build the command.
+	prep := &reproduceImpl{
+		work:           cmd.work,
+		out:            cmd.out,
+		cipdDownloader: cmd.cipdDownloader,
+	}
+	execCmd, err := prep.prepareTaskRequestEnvironment(ctx, properties, svc, extra.AuthFlags)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to create command from task request").Err()
+	}
+
+	uninstall, err := installNamedCaches(cmd.cacheRoot, cmd.work, properties.Caches)
+	if err != nil {
+		return nil, err
+	}
+	res, runErr := runWithTimeouts(ctx, execCmd, taskTimeouts{
+		execution: time.Duration(properties.ExecutionTimeoutSecs) * time.Second,
+		io:        time.Duration(properties.IoTimeoutSecs) * time.Second,
+		grace:     time.Duration(properties.GracePeriodSecs) * time.Second,
+	}, extra.Stdout, extra.Stderr)
+	if err := uninstall(); err != nil {
+		logging.Warningf(ctx, "Failed to uninstall named caches: %s", err)
+	}
+	if runErr != nil {
+		return nil, runErr
+	}
+
+	if err := collectOutputs(cmd.work, cmd.out, properties.Outputs); err != nil {
+		return nil, err
+	}
+	root, err := archiveToLocalCAS(cmd.out, cmd.casDir)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to store outputs").Err()
+	}
+	res.CasOutputRoot = &swarmingv2.CASReference{
+		CasInstance: cmd.casDir,
+		Digest: &swarmingv2.Digest{
+			Hash:      root.Hash,
+			SizeBytes: root.Size,
+		},
+	}
+	logging.Infof(ctx, "Task finished with state %s and exit code %d, outputs stored as %s", res.State, res.ExitCode, root)
+	return res, nil
+}
+
+// taskTimeouts are timeouts of a task slice. Zero execution and I/O timeouts
+// mean no timeout, zero grace period means defaultGracePeriod.
+type taskTimeouts struct {
+	execution time.Duration
+	io        time.Duration
+	grace     time.Duration
+}
+
+// defaultGracePeriod is the grace period used when the task doesn't specify
+// one. It matches the default of the Swarming server.
+const defaultGracePeriod = 30 * time.Second
+
+// timeoutPollInterval is how often runWithTimeouts checks the timeouts.
+var timeoutPollInterval = 100 * time.Millisecond
+
+// activityWriter is an io.Writer that remembers when it was last written to.
+type activityWriter struct {
+	w    io.Writer
+	m    *sync.Mutex
+	last *time.Time
+}
+
+func (a activityWriter) Write(p []byte) (int, error) {
+	a.m.Lock()
+	*a.last = time.Now()
+	a.m.Unlock()
+	return a.w.Write(p)
+}
+
+// runWithTimeouts runs the prepared command, enforcing the task timeouts the
+// way a bot does: once a timeout is hit the process receives SIGTERM (or
+// CTRL+BREAK on Windows) and is killed if it is still running after the grace
+// period.
+func runWithTimeouts(ctx context.Context, prepared *exec.Cmd, timeouts taskTimeouts, stdout, stderr io.Writer) (*swarmingv2.TaskResultResponse, error) {
+	c := exec2.CommandContext(ctx, prepared.Path, prepared.Args[1:]...)
+	c.Env = prepared.Env
+	c.Dir = prepared.Dir
+
+	var m sync.Mutex
+	lastOutput := time.Now()
+	c.Stdout = activityWriter{w: stdout, m: &m, last: &lastOutput}
+	c.Stderr = activityWriter{w: stderr, m: &m, last: &lastOutput}
+
+	started := time.Now()
+	if err := c.Start(); err != nil {
+		return nil, errors.Annotate(err, "failed to start command: %v", c.Args).Err()
+	}
+	done := make(chan error, 1)
+	go func() { done <- c.Wait() }()
+
+	ticker := time.NewTicker(timeoutPollInterval)
+	defer ticker.Stop()
+
+	grace := timeouts.grace
+	if grace <= 0 {
+		grace = defaultGracePeriod
+	}
+	// kill fires once the grace period after the termination signal is over.
+	var kill <-chan time.Time
+
+	res := &swarmingv2.TaskResultResponse{State: swarmingv2.TaskState_COMPLETED}
+	var waitErr error
+	timedOut := false
+loop:
+	for {
+		select {
+		case waitErr = <-done:
+			break loop
+		case <-kill:
+			logging.Warningf(ctx, "Grace period of %s exceeded, killing the process", grace)
+			if err := c.Kill(); err != nil {
+				logging.Warningf(ctx, "Failed to kill the process: %s", err)
+			}
+			kill = nil
+		case <-ticker.C:
+			if timedOut {
+				continue
+			}
+			m.Lock()
+			silence := time.Since(lastOutput)
+			m.Unlock()
+			switch {
+			case timeouts.execution > 0 && time.Since(started) > timeouts.execution:
+				logging.Warningf(ctx, "Execution timeout of %s exceeded", timeouts.execution)
+			case timeouts.io > 0 && silence > timeouts.io:
+				logging.Warningf(ctx, "I/O timeout of %s exceeded", timeouts.io)
+			default:
+				continue
+			}
+			timedOut = true
+			res.State = swarmingv2.TaskState_TIMED_OUT
+			if err := c.Terminate(); err != nil {
+				logging.Warningf(ctx, "Failed to terminate the process: %s", err)
+			}
+			killTimer := time.NewTimer(grace)
+			defer killTimer.Stop()
+			kill = killTimer.C
+		}
+	}
+	res.Duration = float32(time.Since(started).Seconds())
+
+	var exitErr *exec.ExitError
+	switch {
+	case waitErr == nil:
+	case errors.As(waitErr, &exitErr):
+		res.ExitCode = int64(exitErr.ExitCode())
+	default:
+		return nil, errors.Annotate(waitErr, "failed to complete command: %v", c.Args).Err()
+	}
+	res.Failure = res.ExitCode != 0 || timedOut
+	return res, nil
+}
+
+// installNamedCaches moves named caches from the cache root into the work
+// directory, creating missing ones.
+//
+// Returns a callback that moves them back.
+func installNamedCaches(cacheRoot, work string, caches []*swarmingv2.CacheEntry) (func() error, error) {
+	var installed []*swarmingv2.CacheEntry
+	uninstall := func() error {
+		var merr errors.MultiError
+		for _, c := range installed {
+			if err := os.Rename(filepath.Join(work, c.Path), filepath.Join(cacheRoot, c.Name)); err != nil {
+				merr = append(merr, errors.Annotate(err, "named cache %q", c.Name).Err())
+			}
+		}
+		return merr.AsError()
+	}
+	// Cache names and paths come from the task request. Don't let them point
+	// outside of the cache root and the work directory.
+	for _, c := range caches {
+		if err := checkRelPath(c.Name); err != nil || filepath.Base(c.Name) != c.Name {
+			return nil, errors.Reason("bad named cache name %q", c.Name).Err()
+		}
+		if err := checkRelPath(c.Path); err != nil {
+			return nil, errors.Annotate(err, "bad path of named cache %q", c.Name).Err()
+		}
+	}
+	for _, c := range caches {
+		src := filepath.Join(cacheRoot, c.Name)
+		dst := filepath.Join(work, c.Path)
+		err := os.MkdirAll(src, os.ModePerm)
+		if err == nil {
+			err = os.MkdirAll(filepath.Dir(dst), os.ModePerm)
+		}
+		if err == nil {
+			err = os.Rename(src, dst)
+		}
+		if err != nil {
+			_ = uninstall()
+			return nil, errors.Annotate(err, "failed to install named cache %q", c.Name).Err()
+		}
+		installed = append(installed, c)
+	}
+	return uninstall, nil
+}
+
+// checkRelPath checks that the path is relative and stays within the directory
+// it is relative to.
+func checkRelPath(p string) error {
+	switch {
+	case p == "":
+		return errors.Reason("the path is empty").Err()
+	case filepath.IsAbs(p) || filepath.VolumeName(p) != "":
+		return errors.Reason("%q must be relative", p).Err()
+	case !filepath.IsLocal(p):
+		return errors.Reason("%q must not go outside of its parent directory", p).Err()
+	}
+	return nil
+}
+
+// collectOutputs copies the declared task outputs from the work directory into
+// the output directory. Outputs can be files or directories, directories are
+// copied recursively. Missing outputs are skipped, as on a bot.
+func collectOutputs(work, out string, outputs []string) error {
+	for _, rel := range outputs {
+		src := filepath.Join(work, rel)
+		if _, err := os.Stat(src); os.IsNotExist(err) {
+			continue
+		}
+		err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+			relPath, err := filepath.Rel(work, path)
+			if err != nil {
+				return err
+			}
+			return copyFile(path, filepath.Join(out, relPath))
+		})
+		if err != nil {
+			return errors.Annotate(err, "failed to collect output %q", rel).Err()
+		}
+	}
+	return nil
+}
+
+// copyFile copies a file, creating the parent directory of the destination.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return err
+	}
+	o, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(o, in); err != nil {
+		o.Close()
+		return err
+	}
+	return o.Close()
+}
+
+// archiveToLocalCAS stores the directory tree rooted at `dir` in `casDir`
+// using the RBE-CAS Merkle tree layout: every file and every serialized
+// Directory message is stored as a file named after its SHA256 hash.
+//
+// Returns the digest of the root directory.
+func archiveToLocalCAS(dir, casDir string) (digest.Digest, error) {
+	if err := os.MkdirAll(casDir, os.ModePerm); err != nil {
+		return digest.Digest{}, errors.Annotate(err, "failed to create %s", casDir).Err()
+	}
+	return archiveDir(dir, casDir)
+}
+
+func archiveDir(dir, casDir string) (digest.Digest, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return digest.Digest{}, errors.Annotate(err, "failed to list %s", dir).Err()
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	d := &repb.Directory{}
+	for _, e := range entries {
+		path := filepath.Join(dir, e.Name())
+		switch {
+		case e.IsDir():
+			sub, err := archiveDir(path, casDir)
+			if err != nil {
+				return digest.Digest{}, err
+			}
+			d.Directories = append(d.Directories, &repb.DirectoryNode{Name: e.Name(), Digest: sub.ToProto()})
+		case e.Type()&os.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return digest.Digest{}, errors.Annotate(err, "failed to read symlink %s", path).Err()
+			}
+			d.Symlinks = append(d.Symlinks, &repb.SymlinkNode{Name: e.Name(), Target: target})
+		case e.Type().IsRegular():
+			blob, err := os.ReadFile(path)
+			if err != nil {
+				return digest.Digest{}, errors.Annotate(err, "failed to read %s", path).Err()
+			}
+			info, err := e.Info()
+			if err != nil {
+				return digest.Digest{}, errors.Annotate(err, "failed to stat %s", path).Err()
+			}
+			dg, err := storeBlob(casDir, blob)
+			if err != nil {
+				return digest.Digest{}, err
+			}
+			d.Files = append(d.Files, &repb.FileNode{
+				Name:         e.Name(),
+				Digest:       dg.ToProto(),
+				IsExecutable: info.Mode()&0111 != 0,
+			})
+		}
+	}
+
+	blob, err := proto.MarshalOptions{Deterministic: true}.Marshal(d)
+	if err != nil {
+		return digest.Digest{}, errors.Annotate(err, "failed to serialize directory %s", dir).Err()
+	}
+	return storeBlob(casDir, blob)
+}
+
+func storeBlob(casDir string, blob []byte) (digest.Digest, error) {
+	dg := digest.NewFromBlob(blob)
+	path := filepath.Join(casDir, dg.Hash)
+	if _, err := os.Stat(path); err == nil {
+		return dg, nil
+	}
+	if err := os.WriteFile(path, blob, 0666); err != nil {
+		return digest.Digest{}, errors.Annotate(err, "failed to store blob %s", dg).Err()
+	}
+	return dg, nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swarmingimpl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/bazelbuild/remote-apis-sdks/go/pkg/digest"
+	repb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	swarmingv2 "go.chromium.org/luci/swarming/proto/api_v2"
+)
+
+func TestRunLocalParse(t *testing.T) {
+	t.Parallel()
+
+	expectErr := func(argv []string, errLike string) {
+		_, _, code, _, stderr := SubcommandTest(
+			context.Background(),
+			CmdRunLocal,
+			append([]string{"-server", "example.com"}, argv...),
+			nil, nil,
+		)
+		So(code, ShouldEqual, 1)
+		So(stderr, ShouldContainSubstring, errLike)
+	}
+
+	Convey(`Requires task ID or JSON input.`, t, func() {
+		expectErr(nil, "expecting either a task ID or -json-input")
+	})
+
+	Convey(`Task ID and JSON input are exclusive.`, t, func() {
+		expectErr([]string{"-json-input", "tasks.json", "aaaa"}, "mutually exclusive")
+	})
+
+	Convey(`Accepts only one task ID.`, t, func() {
+		expectErr([]string{"aaaa", "bbbb"}, "expecting")
+	})
+
+	Convey(`Checks the request index.`, t, func() {
+		path := filepath.Join(t.TempDir(), "tasks.json")
+		So(os.WriteFile(path, []byte(`{"requests": [{"name": "task"}]}`), 0600), ShouldBeNil)
+		expectErr([]string{"-json-input", path, "-index", "1"}, "-index 1 is out of range")
+	})
+}
+
+func TestRunLocal(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+
+	Convey(`With a task`, t, func() {
+		tmp := t.TempDir()
+		input := filepath.Join(tmp, "tasks.json")
+		writeInput := func(req string) {
+			So(os.WriteFile(input, []byte(`{"requests": [`+req+`]}`), 0600), ShouldBeNil)
+		}
+		run := func() *swarmingv2.TaskResultResponse {
+			res, err, code, _, stderr := SubcommandTest(
+				context.Background(),
+				CmdRunLocal,
+				[]string{
+					"-server", "example.com",
+					"-json-input", input,
+					"-work", filepath.Join(tmp, "work"),
+					"-out", filepath.Join(tmp, "out"),
+					"-named-caches", filepath.Join(tmp, "caches"),
+					"-cas-dir", filepath.Join(tmp, "cas"),
+				},
+				nil, nil,
+			)
+			So(err, ShouldBeNil)
+			So(code, ShouldEqual, 0)
+			So(stderr, ShouldNotContainSubstring, "Error")
+			return res.(*swarmingv2.TaskResultResponse)
+		}
+
+		Convey(`Runs the last slice and archives outputs`, func() {
+			writeInput(`{
+				"task_slices": [
+					{"properties": {"command": ["false"]}},
+					{"properties": {
+						"command": ["sh", "-c", "echo $GREETING > ${ISOLATED_OUTDIR}/hello.txt && echo hi > cache/f && echo out > result.txt && mkdir -p logs/sub && echo log > logs/sub/log.txt"],
+						"env": [{"key": "GREETING", "value": "hello"}],
+						"caches": [{"name": "cache_name", "path": "cache"}],
+						"outputs": ["result.txt", "logs", "missing"],
+						"execution_timeout_secs": 60
+					}}
+				]
+			}`)
+			res := run()
+			So(res.State, ShouldEqual, swarmingv2.TaskState_COMPLETED)
+			So(res.ExitCode, ShouldEqual, 0)
+			So(res.Failure, ShouldBeFalse)
+
+			// The named cache was moved back to the cache root.
+			blob, err := os.ReadFile(filepath.Join(tmp, "caches", "cache_name", "f"))
+			So(err, ShouldBeNil)
+			So(string(blob), ShouldEqual, "hi\n")
+
+			// The output tree was stored in the local CAS directory.
+			root := &repb.Directory{}
+			blob, err = os.ReadFile(filepath.Join(tmp, "cas", res.CasOutputRoot.Digest.Hash))
+			So(err, ShouldBeNil)
+			So(proto.Unmarshal(blob, root), ShouldBeNil)
+			So(root.Files, ShouldHaveLength, 2)
+			So(root.Files[0].Name, ShouldEqual, "hello.txt")
+			So(root.Files[1].Name, ShouldEqual, "result.txt")
+			blob, err = os.ReadFile(filepath.Join(tmp, "cas", root.Files[0].Digest.Hash))
+			So(err, ShouldBeNil)
+			So(string(blob), ShouldEqual, "hello\n")
+			So(digest.NewFromBlob(blob).Hash, ShouldEqual, root.Files[0].Digest.Hash)
+
+			// Output directories are copied recursively.
+			So(root.Directories, ShouldHaveLength, 1)
+			So(root.Directories[0].Name, ShouldEqual, "logs")
+			blob, err = os.ReadFile(filepath.Join(tmp, "out", "logs", "sub", "log.txt"))
+			So(err, ShouldBeNil)
+			So(string(blob), ShouldEqual, "log\n")
+		})
+
+		Convey(`Reports the exit code`, func() {
+			writeInput(`{"properties": {"command": ["sh", "-c", "exit 3"]}}`)
+			res := run()
+			So(res.State, ShouldEqual, swarmingv2.TaskState_COMPLETED)
+			So(res.ExitCode, ShouldEqual, 3)
+			So(res.Failure, ShouldBeTrue)
+		})
+
+		Convey(`Enforces the I/O timeout`, func() {
+			writeInput(`{"properties": {
+				"command": ["sleep", "60"],
+				"io_timeout_secs": 1,
+				"grace_period_secs": 1
+			}}`)
+			res := run()
+			So(res.State, ShouldEqual, swarmingv2.TaskState_TIMED_OUT)
+			So(res.Failure, ShouldBeTrue)
+			So(res.Duration, ShouldBeLessThan, 30)
+		})
+
+		Convey(`Signals the process before killing it`, func() {
+			writeInput(`{"properties": {
+				"command": ["sh", "-c", "trap 'exit 7' TERM; while true; do sleep 0.1; done"],
+				"io_timeout_secs": 1
+			}}`)
+			res := run()
+			So(res.State, ShouldEqual, swarmingv2.TaskState_TIMED_OUT)
+			So(res.ExitCode, ShouldEqual, 7)
+			So(res.Duration, ShouldBeLessThan, 30)
+		})
+	})
+}
+
+func TestInstallNamedCaches(t *testing.T) {
+	t.Parallel()
+
+	Convey(`installNamedCaches`, t, func() {
+		tmp := t.TempDir()
+		cacheRoot := filepath.Join(tmp, "caches")
+		work := filepath.Join(tmp, "work")
+		install := func(name, path string) error {
+			uninstall, err := installNamedCaches(cacheRoot, work, []*swarmingv2.CacheEntry{
+				{Name: "good", Path: "cache/good"},
+				{Name: name, Path: path},
+			})
+			if err == nil {
+				So(uninstall(), ShouldBeNil)
+			}
+			return err
+		}
+
+		Convey(`Installs and uninstalls caches`, func() {
+			So(install("other", "other"), ShouldBeNil)
+			_, err := os.Stat(filepath.Join(cacheRoot, "good"))
+			So(err, ShouldBeNil)
+			_, err = os.Stat(filepath.Join(work, "cache", "good"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey(`Rejects bad names`, func() {
+			for _, name := range []string{"", "..", "a/b", "../a", "/abs"} {
+				So(install(name, "cache/bad"), ShouldErrLike, "bad named cache name")
+			}
+		})
+
+		Convey(`Rejects bad paths`, func() {
+			for _, path := range []string{"", "/abs", "..", "../cache", "a/../../cache"} {
+				So(install("bad", path), ShouldErrLike, "bad path of named cache")
+			}
+		})
+
+		Convey(`Doesn't install anything if some cache is bad`, func() {
+			So(install("bad", "../cache"), ShouldNotBeNil)
+			_, err := os.Stat(cacheRoot)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+	})
+}
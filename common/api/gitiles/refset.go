@@ -111,7 +111,7 @@ func (w RefSet) Has(ref string) bool {
 // requester.
 func (w RefSet) Resolve(ctx context.Context, client gitiles.GitilesClient, project string) (refTips map[string]string, missingRefs []string, err error) {
 	lock := sync.Mutex{} // for concurrent writes to the map
-	refTips = map[string]string{}
+	allTips := map[string]string{}
 	err = parallel.FanOutIn(func(work chan<- func() error) {
 		for prefix := range w.byPrefix {
 			prefix := prefix
@@ -123,9 +123,7 @@ func (w RefSet) Resolve(ctx context.Context, client gitiles.GitilesClient, proje
 				lock.Lock()
 				defer lock.Unlock()
 				for ref, tip := range resp.Revisions {
-					if w.Has(ref) {
-						refTips[ref] = tip
-					}
+					allTips[ref] = tip
 				}
 				return nil
 			}
@@ -134,6 +132,22 @@ func (w RefSet) Resolve(ctx context.Context, client gitiles.GitilesClient, proje
 	if err != nil {
 		return
 	}
+	refTips, missingRefs = w.Filter(allTips)
+	return
+}
+
+// Filter returns refs from the given map of all refs of a repository which are
+// in this set.
+//
+// Also returns a list of original refs, incl. regular expressions, which didn't
+// match any of the given refs.
+func (w RefSet) Filter(allTips map[string]string) (refTips map[string]string, missingRefs []string) {
+	refTips = map[string]string{}
+	for ref, tip := range allTips {
+		if w.Has(ref) {
+			refTips[ref] = tip
+		}
+	}
 	// Compute missingRefs as those for which no actual ref was found.
 	for _, ref := range w.literalRefs {
 		if _, ok := refTips[ref]; !ok {
@@ -49,6 +49,20 @@ func TestRefSet(t *testing.T) {
 			So(wr.Has("refs/branch-heads/1.12.123"), ShouldBeFalse)
 		})
 
+		Convey("filter ref tips", func() {
+			refTips, missing := wr.Filter(map[string]string{
+				"refs/heads/master":          "01234567",
+				"refs/heads/foobar":          "89abcdef",
+				"refs/branch-heads/1.9":      "cafedead",
+				"refs/branch-heads/1.11.123": "deadbeef",
+			})
+			So(refTips, ShouldResemble, map[string]string{
+				"refs/heads/master":     "01234567",
+				"refs/branch-heads/1.9": "cafedead",
+			})
+			So(missing, ShouldResemble, []string{`refs/missing/exact`, `regexp:refs/missing/many.+`})
+		})
+
 		Convey("resolve ref tips", func() {
 			ctx := context.Background()
 			ctl := gomock.NewController(t)
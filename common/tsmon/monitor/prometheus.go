@@ -0,0 +1,354 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/tsmon/distribution"
+	"go.chromium.org/luci/common/tsmon/types"
+)
+
+// OpenMetricsContentType is the content type of responses served by
+// PrometheusMonitor.
+const OpenMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// PrometheusMonitor is a pull-based Monitor.
+//
+// Instead of sending metrics somewhere, it remembers values of all cells it
+// was asked to send and serves them over HTTP in the OpenMetrics text format,
+// understood by Prometheus. Install it as an HTTP handler of the endpoint that
+// Prometheus scrapes (usually /metrics).
+//
+// The served values are as fresh as the last flush: configure the flush
+// interval to be no longer than the scrape interval.
+//
+// Metric names are derived from tsmon metric names by replacing all characters
+// not allowed by Prometheus with "_". Metric fields become labels. Targets are
+// not exported, since Prometheus attaches its own "job" and "instance" labels
+// to all scraped series. If cells of different targets have the same metric
+// name and field values, the one sent last wins.
+//
+// Cells that are not sent for a while (e.g. because the store forgot them)
+// are no longer served, see Expiry.
+type PrometheusMonitor struct {
+	// Expiry is how long to serve a cell after it was sent last time.
+	//
+	// Default is DefaultPrometheusExpiry.
+	Expiry time.Duration
+
+	m     sync.Mutex
+	cells map[string]promCell // cellKey(...) => the last sent cell
+}
+
+// DefaultPrometheusExpiry is the default value of PrometheusMonitor.Expiry.
+//
+// It is long enough to survive a few missed flushes with the default flush
+// interval of 1 min.
+const DefaultPrometheusExpiry = 5 * time.Minute
+
+// promCell is a cell remembered by PrometheusMonitor.
+type promCell struct {
+	cell types.Cell
+	sent time.Time // when the cell was sent last time
+}
+
+// NewPrometheusMonitor returns a new PrometheusMonitor.
+func NewPrometheusMonitor() *PrometheusMonitor {
+	return &PrometheusMonitor{cells: map[string]promCell{}}
+}
+
+// ChunkSize implements Monitor.
+func (m *PrometheusMonitor) ChunkSize() int {
+	return 0
+}
+
+// Send implements Monitor.
+//
+// It remembers the cells to serve them later.
+func (m *PrometheusMonitor) Send(ctx context.Context, cells []types.Cell) error {
+	now := clock.Now(ctx)
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, c := range cells {
+		if d, ok := c.Value.(*distribution.Distribution); ok {
+			// The store may keep updating the distribution in place.
+			c.Value = d.Clone()
+		}
+		m.cells[cellKey(c)] = promCell{cell: c, sent: now}
+	}
+	return nil
+}
+
+// Close implements Monitor.
+func (m *PrometheusMonitor) Close() error {
+	return nil
+}
+
+// ServeHTTP serves all remembered cells in the OpenMetrics text format.
+//
+// Expired cells are forgotten.
+func (m *PrometheusMonitor) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	expiry := m.Expiry
+	if expiry <= 0 {
+		expiry = DefaultPrometheusExpiry
+	}
+	cutoff := clock.Now(r.Context()).Add(-expiry)
+
+	m.m.Lock()
+	cells := make([]types.Cell, 0, len(m.cells))
+	for key, c := range m.cells {
+		if c.sent.Before(cutoff) {
+			delete(m.cells, key)
+		} else {
+			cells = append(cells, c.cell)
+		}
+	}
+	m.m.Unlock()
+
+	rw.Header().Set("Content-Type", OpenMetricsContentType)
+	w := bufio.NewWriter(rw)
+	writeOpenMetrics(w, cells)
+	w.Flush()
+}
+
+// cellKey identifies a time series within a PrometheusMonitor.
+func cellKey(c types.Cell) string {
+	var sb strings.Builder
+	sb.WriteString(c.Name)
+	for _, v := range c.FieldVals {
+		fmt.Fprintf(&sb, "\x00%v", v)
+	}
+	return sb.String()
+}
+
+// writeOpenMetrics writes cells in the OpenMetrics text format.
+//
+// Mutates order of `cells` as a side effect.
+func writeOpenMetrics(w io.Writer, cells []types.Cell) {
+	sort.Slice(cells, func(i, j int) bool {
+		if l, r := cells[i].Name, cells[j].Name; l != r {
+			return l < r
+		}
+		return cellKey(cells[i]) < cellKey(cells[j])
+	})
+
+	emitted := map[string]bool{} // sanitized names of emitted metric families
+	for idx := 0; idx < len(cells); {
+		// Collect all cells of the same metric.
+		start := idx
+		for idx < len(cells) && cells[idx].Name == cells[start].Name {
+			idx++
+		}
+		family := cells[start:idx]
+
+		// Metric names of different tsmon metrics may collide after the
+		// sanitization. OpenMetrics doesn't allow to interleave families, so
+		// skip all but the first one.
+		name := sanitizeName(family[0].Name)
+		if emitted[name] {
+			continue
+		}
+		emitted[name] = true
+		writeFamily(w, name, family)
+	}
+	io.WriteString(w, "# EOF\n")
+}
+
+// writeFamily writes a single metric family.
+func writeFamily(w io.Writer, name string, cells []types.Cell) {
+	info := cells[0].MetricInfo
+
+	var typ string
+	switch info.ValueType {
+	case types.CumulativeIntType, types.CumulativeFloatType:
+		typ = "counter"
+	case types.NonCumulativeIntType, types.NonCumulativeFloatType, types.BoolType:
+		typ = "gauge"
+	case types.CumulativeDistributionType:
+		typ = "histogram"
+	case types.NonCumulativeDistributionType:
+		typ = "gaugehistogram"
+	case types.StringType:
+		typ = "info"
+	default:
+		return
+	}
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, typ)
+	if info.Description != "" {
+		fmt.Fprintf(w, "# HELP %s %s\n", name, escapeHelp(info.Description))
+	}
+
+	for _, c := range cells {
+		labels := make([]string, 0, len(c.FieldVals)+1)
+		for i, v := range c.FieldVals {
+			if i >= len(c.Fields) {
+				break
+			}
+			labels = append(labels, label(c.Fields[i].Name, fmt.Sprintf("%v", v)))
+		}
+
+		switch info.ValueType {
+		case types.CumulativeIntType, types.CumulativeFloatType:
+			writeSample(w, name+"_total", labels, formatNumber(c.Value))
+			if !c.ResetTime.IsZero() {
+				writeSample(w, name+"_created", labels, formatUnixTime(c))
+			}
+		case types.NonCumulativeIntType, types.NonCumulativeFloatType:
+			writeSample(w, name, labels, formatNumber(c.Value))
+		case types.BoolType:
+			val := "0"
+			if c.Value.(bool) {
+				val = "1"
+			}
+			writeSample(w, name, labels, val)
+		case types.StringType:
+			writeSample(w, name+"_info", append(labels, label("value", c.Value.(string))), "1")
+		case types.CumulativeDistributionType:
+			d := c.Value.(*distribution.Distribution)
+			writeBuckets(w, name, labels, d)
+			writeSample(w, name+"_count", labels, strconv.FormatInt(d.Count(), 10))
+			writeSample(w, name+"_sum", labels, formatFloat(d.Sum()))
+			if !c.ResetTime.IsZero() {
+				writeSample(w, name+"_created", labels, formatUnixTime(c))
+			}
+		case types.NonCumulativeDistributionType:
+			d := c.Value.(*distribution.Distribution)
+			writeBuckets(w, name, labels, d)
+			writeSample(w, name+"_gcount", labels, strconv.FormatInt(d.Count(), 10))
+			writeSample(w, name+"_gsum", labels, formatFloat(d.Sum()))
+		}
+	}
+}
+
+// writeBuckets writes cumulative bucket counts of a distribution.
+//
+// The underflow bucket of the tsmon distribution becomes the first bucket of
+// the histogram and the overflow bucket becomes the "+Inf" bucket.
+//
+// tsmon buckets don't include their upper bound, but Prometheus "le" bounds
+// are inclusive. Samples are float64, so "< bound" is the same as "<= the
+// largest float64 below bound", which is what is used as "le".
+func writeBuckets(w io.Writer, name string, labels []string, d *distribution.Distribution) {
+	b := d.Bucketer()
+	buckets := d.Buckets()
+	var total int64
+	for i := 0; i < b.NumBuckets(); i++ {
+		if i < len(buckets) {
+			total += buckets[i]
+		}
+		bound := b.UpperBound(i)
+		if !math.IsInf(bound, 1) {
+			bound = math.Nextafter(bound, math.Inf(-1))
+		}
+		le := label("le", formatFloat(bound))
+		writeSample(w, name+"_bucket", append(labels[:len(labels):len(labels)], le), strconv.FormatInt(total, 10))
+	}
+}
+
+func writeSample(w io.Writer, name string, labels []string, value string) {
+	if len(labels) == 0 {
+		fmt.Fprintf(w, "%s %s\n", name, value)
+	} else {
+		fmt.Fprintf(w, "%s{%s} %s\n", name, strings.Join(labels, ","), value)
+	}
+}
+
+func label(name, value string) string {
+	return fmt.Sprintf("%s=\"%s\"", sanitizeName(name), escapeLabelValue(value))
+}
+
+func formatNumber(v any) string {
+	switch v := v.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return formatFloat(v)
+	default:
+		return "NaN"
+	}
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		// OpenMetrics recommends to always render floats with a decimal point.
+		s += ".0"
+	}
+	return s
+}
+
+func formatUnixTime(c types.Cell) string {
+	return formatFloat(float64(c.ResetTime.UnixNano()) / 1e9)
+}
+
+// sanitizeName converts a tsmon metric or field name to a valid OpenMetrics
+// metric or label name.
+//
+// Leading slashes are dropped and all invalid characters are replaced with
+// "_", e.g. "/chrome/infra/x-y" becomes "chrome_infra_x_y".
+func sanitizeName(name string) string {
+	name = strings.TrimLeft(name, "/")
+	var sb strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+			sb.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+	if sb.Len() == 0 {
+		return "_"
+	}
+	return sb.String()
+}
+
+var (
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeLabelValue(v string) string {
+	return labelValueEscaper.Replace(v)
+}
+
+func escapeHelp(v string) string {
+	return helpEscaper.Replace(v)
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/tsmon/distribution"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPrometheusMonitor(t *testing.T) {
+	t.Parallel()
+
+	Convey("PrometheusMonitor", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		reset := time.Unix(1700000000, 500000000)
+		m := NewPrometheusMonitor()
+
+		scrape := func() string {
+			rec := httptest.NewRecorder()
+			m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil).WithContext(ctx))
+			So(rec.Header().Get("Content-Type"), ShouldEqual, OpenMetricsContentType)
+			return rec.Body.String()
+		}
+		cell := func(name string, typ types.ValueType, fields []field.Field, vals []any, value any) types.Cell {
+			return types.Cell{
+				MetricInfo: types.MetricInfo{
+					Name:        name,
+					Description: "Description of " + name,
+					Fields:      fields,
+					ValueType:   typ,
+				},
+				CellData: types.CellData{
+					FieldVals: vals,
+					ResetTime: reset,
+					Value:     value,
+				},
+			}
+		}
+
+		Convey("Empty", func() {
+			So(scrape(), ShouldEqual, "# EOF\n")
+		})
+
+		Convey("Counters and gauges", func() {
+			fields := []field.Field{field.String("method"), field.Int("code")}
+			So(m.Send(ctx, []types.Cell{
+				cell("/chrome/infra/rpc/count", types.CumulativeIntType, fields, []any{"Get", int64(200)}, int64(5)),
+				cell("/chrome/infra/rpc/count", types.CumulativeIntType, fields, []any{"Get", int64(404)}, int64(1)),
+				cell("server/load", types.NonCumulativeFloatType, nil, nil, 0.5),
+				cell("server/up", types.BoolType, nil, nil, true),
+				cell("server/version", types.StringType, nil, nil, `v"1"`),
+			}), ShouldBeNil)
+
+			So(scrape(), ShouldEqual, strings.Join([]string{
+				"# TYPE chrome_infra_rpc_count counter",
+				"# HELP chrome_infra_rpc_count Description of /chrome/infra/rpc/count",
+				`chrome_infra_rpc_count_total{method="Get",code="200"} 5`,
+				`chrome_infra_rpc_count_created{method="Get",code="200"} 1.7000000005e+09`,
+				`chrome_infra_rpc_count_total{method="Get",code="404"} 1`,
+				`chrome_infra_rpc_count_created{method="Get",code="404"} 1.7000000005e+09`,
+				"# TYPE server_load gauge",
+				"# HELP server_load Description of server/load",
+				"server_load 0.5",
+				"# TYPE server_up gauge",
+				"# HELP server_up Description of server/up",
+				"server_up 1",
+				"# TYPE server_version info",
+				"# HELP server_version Description of server/version",
+				`server_version_info{value="v\"1\""} 1`,
+				"# EOF",
+				"",
+			}, "\n"))
+
+			Convey("The last sent value wins", func() {
+				So(m.Send(ctx, []types.Cell{
+					cell("server/load", types.NonCumulativeFloatType, nil, nil, 2.0),
+				}), ShouldBeNil)
+				So(scrape(), ShouldContainSubstring, "\nserver_load 2.0\n")
+			})
+
+			Convey("Cells that are not sent anymore expire", func() {
+				tc.Add(DefaultPrometheusExpiry - time.Second)
+				So(m.Send(ctx, []types.Cell{
+					cell("server/load", types.NonCumulativeFloatType, nil, nil, 2.0),
+				}), ShouldBeNil)
+				tc.Add(2 * time.Second)
+				So(scrape(), ShouldEqual, strings.Join([]string{
+					"# TYPE server_load gauge",
+					"# HELP server_load Description of server/load",
+					"server_load 2.0",
+					"# EOF",
+					"",
+				}, "\n"))
+			})
+		})
+
+		Convey("Distributions", func() {
+			d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+			d.Add(-1)
+			d.Add(5)
+			d.Add(10) // goes to [10, 20) bucket
+			d.Add(15)
+			d.Add(100)
+			So(m.Send(ctx, []types.Cell{
+				cell("latency", types.CumulativeDistributionType, nil, nil, d),
+				cell("queue", types.NonCumulativeDistributionType, nil, nil, distribution.New(distribution.FixedWidthBucketer(10, 1))),
+			}), ShouldBeNil)
+
+			// Doesn't see changes made after the flush.
+			d.Add(1)
+
+			So(scrape(), ShouldEqual, strings.Join([]string{
+				"# TYPE latency histogram",
+				"# HELP latency Description of latency",
+				`latency_bucket{le="-5e-324"} 1`,
+				`latency_bucket{le="9.999999999999998"} 2`,
+				`latency_bucket{le="19.999999999999996"} 4`,
+				`latency_bucket{le="+Inf"} 5`,
+				"latency_count 5",
+				"latency_sum 129.0",
+				"latency_created 1.7000000005e+09",
+				"# TYPE queue gaugehistogram",
+				"# HELP queue Description of queue",
+				`queue_bucket{le="-5e-324"} 0`,
+				`queue_bucket{le="9.999999999999998"} 0`,
+				`queue_bucket{le="+Inf"} 0`,
+				"queue_gcount 0",
+				"queue_gsum 0.0",
+				"# EOF",
+				"",
+			}, "\n"))
+		})
+
+		Convey("Names are sanitized", func() {
+			So(sanitizeName("/chrome/infra/a-b.c"), ShouldEqual, "chrome_infra_a_b_c")
+			So(sanitizeName("1st"), ShouldEqual, "_1st")
+			So(sanitizeName(""), ShouldEqual, "_")
+		})
+	})
+}
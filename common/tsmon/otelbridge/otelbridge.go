@@ -0,0 +1,354 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package otelbridge implements a tsmon monitor that exports metrics through
+// OpenTelemetry.
+//
+// The monitor is an OpenTelemetry SDK metric producer: attach it to a reader
+// (e.g. a periodic reader with an OTLP exporter) via sdkmetric.WithProducer to
+// export tsmon metrics alongside metrics recorded via OpenTelemetry API.
+//
+// Metrics are mapped to OpenTelemetry data as follows:
+//   - Cumulative int and float metrics become monotonic cumulative sums.
+//   - Non-cumulative int and float metrics become gauges.
+//   - Bool metrics become int gauges with values 0 or 1.
+//   - String metrics become int gauges with value 1 and the string in the
+//     "value" attribute.
+//   - Cumulative distribution metrics become cumulative histograms with
+//     explicit bucket boundaries matching their bucketers.
+//   - Non-cumulative distribution metrics become delta histograms holding the
+//     distribution as of the last flush, as is.
+//
+// Metric fields become attributes. Targets are ignored: OpenTelemetry
+// identifies the process via the resource of the reader's MeterProvider
+// instead.
+//
+// Values are exported as of the last flush. Cells that are not sent for a
+// while (e.g. because the store forgot them) are no longer exported, see
+// Expiry.
+package otelbridge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/tsmon/distribution"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/monitor"
+	"go.chromium.org/luci/common/tsmon/types"
+)
+
+// ScopeName is the name of the instrumentation scope of exported metrics.
+const ScopeName = "go.chromium.org/luci/common/tsmon"
+
+// DefaultExpiry is the default value of Monitor.Expiry.
+//
+// It is long enough to survive a few missed flushes with the default flush
+// interval of 1 min.
+const DefaultExpiry = 5 * time.Minute
+
+// Monitor exports tsmon metrics through OpenTelemetry.
+//
+// Use it as the tsmon monitor (or as a part of it) and as a producer of an
+// OpenTelemetry reader.
+type Monitor struct {
+	// Expiry is how long to export a cell after it was sent last time.
+	//
+	// Default is DefaultExpiry.
+	Expiry time.Duration
+
+	m       sync.Mutex
+	metrics map[string]*metricState // metric name => its cells
+}
+
+var _ monitor.Monitor = (*Monitor)(nil)
+var _ sdkmetric.Producer = (*Monitor)(nil)
+
+// metricState holds the last sent cells of a metric.
+type metricState struct {
+	info  types.MetricInfo
+	meta  types.MetricMetadata
+	cells map[string]*cellState // seriesKey(...) => the last sent cell
+}
+
+// cellState is a cell remembered by Monitor.
+type cellState struct {
+	attrs attribute.Set
+	reset time.Time // when the cumulative value was reset
+	value any       // the value, distributions are cloned
+	sent  time.Time // when the cell was sent last time
+}
+
+// NewMonitor returns a new Monitor.
+func NewMonitor() *Monitor {
+	return &Monitor{metrics: map[string]*metricState{}}
+}
+
+// ChunkSize implements monitor.Monitor.
+func (m *Monitor) ChunkSize() int {
+	return 0
+}
+
+// Send implements monitor.Monitor.
+//
+// Remembers the cells to export them on the next Produce call.
+func (m *Monitor) Send(ctx context.Context, cells []types.Cell) error {
+	now := clock.Now(ctx)
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, c := range cells {
+		ms := m.metrics[c.Name]
+		if ms == nil || ms.info.ValueType != c.ValueType {
+			ms = &metricState{
+				info:  c.MetricInfo,
+				meta:  c.MetricMetadata,
+				cells: map[string]*cellState{},
+			}
+			m.metrics[c.Name] = ms
+		}
+
+		value := c.Value
+		if d, ok := value.(*distribution.Distribution); ok {
+			value = d.Clone()
+		}
+		ms.cells[seriesKey(c.FieldVals)] = &cellState{
+			attrs: attributes(c.Fields, c.FieldVals),
+			reset: c.ResetTime,
+			value: value,
+			sent:  now,
+		}
+	}
+	return nil
+}
+
+// Close implements monitor.Monitor.
+func (m *Monitor) Close() error {
+	return nil
+}
+
+// Produce implements sdkmetric.Producer.
+//
+// Returns the last sent values of all metrics. Expired cells are forgotten.
+func (m *Monitor) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
+	expiry := m.Expiry
+	if expiry <= 0 {
+		expiry = DefaultExpiry
+	}
+	now := clock.Now(ctx)
+	cutoff := now.Add(-expiry)
+
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	names := make([]string, 0, len(m.metrics))
+	for name, ms := range m.metrics {
+		for key, c := range ms.cells {
+			if c.sent.Before(cutoff) {
+				delete(ms.cells, key)
+			}
+		}
+		if len(ms.cells) == 0 {
+			delete(m.metrics, name)
+		} else {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	sort.Strings(names)
+
+	sm := metricdata.ScopeMetrics{
+		Scope:   instrumentation.Scope{Name: ScopeName},
+		Metrics: make([]metricdata.Metrics, 0, len(names)),
+	}
+	for _, name := range names {
+		ms := m.metrics[name]
+		sm.Metrics = append(sm.Metrics, metricdata.Metrics{
+			Name:        strings.TrimLeft(name, "/"),
+			Description: ms.info.Description,
+			Unit:        string(ms.meta.Units),
+			Data:        ms.aggregation(now),
+		})
+	}
+	return []metricdata.ScopeMetrics{sm}, nil
+}
+
+// aggregation converts the cells of the metric into OpenTelemetry data.
+func (ms *metricState) aggregation(now time.Time) metricdata.Aggregation {
+	cells := make([]*cellState, 0, len(ms.cells))
+	for _, c := range ms.cells {
+		cells = append(cells, c)
+	}
+	// Make the output deterministic.
+	sort.Slice(cells, func(i, j int) bool {
+		return cells[i].attrs.Encoded(attribute.DefaultEncoder()) < cells[j].attrs.Encoded(attribute.DefaultEncoder())
+	})
+
+	switch ms.info.ValueType {
+	case types.CumulativeIntType:
+		sum := metricdata.Sum[int64]{Temporality: metricdata.CumulativeTemporality, IsMonotonic: true}
+		for _, c := range cells {
+			if v, ok := c.value.(int64); ok {
+				sum.DataPoints = append(sum.DataPoints, metricdata.DataPoint[int64]{
+					Attributes: c.attrs, StartTime: c.reset, Time: now, Value: v,
+				})
+			}
+		}
+		return sum
+
+	case types.CumulativeFloatType:
+		sum := metricdata.Sum[float64]{Temporality: metricdata.CumulativeTemporality, IsMonotonic: true}
+		for _, c := range cells {
+			if v, ok := c.value.(float64); ok {
+				sum.DataPoints = append(sum.DataPoints, metricdata.DataPoint[float64]{
+					Attributes: c.attrs, StartTime: c.reset, Time: now, Value: v,
+				})
+			}
+		}
+		return sum
+
+	case types.NonCumulativeIntType, types.BoolType, types.StringType:
+		var gauge metricdata.Gauge[int64]
+		for _, c := range cells {
+			attrs, v := c.attrs, int64(0)
+			switch val := c.value.(type) {
+			case int64:
+				v = val
+			case bool:
+				if val {
+					v = 1
+				}
+			case string:
+				// The string value is a part of the attributes.
+				attrs = attribute.NewSet(append(c.attrs.ToSlice(), attribute.String("value", val))...)
+				v = 1
+			default:
+				continue
+			}
+			gauge.DataPoints = append(gauge.DataPoints, metricdata.DataPoint[int64]{
+				Attributes: attrs, Time: now, Value: v,
+			})
+		}
+		return gauge
+
+	case types.NonCumulativeFloatType:
+		var gauge metricdata.Gauge[float64]
+		for _, c := range cells {
+			if v, ok := c.value.(float64); ok {
+				gauge.DataPoints = append(gauge.DataPoints, metricdata.DataPoint[float64]{
+					Attributes: c.attrs, Time: now, Value: v,
+				})
+			}
+		}
+		return gauge
+
+	case types.CumulativeDistributionType, types.NonCumulativeDistributionType:
+		hist := metricdata.Histogram[float64]{Temporality: metricdata.CumulativeTemporality}
+		if ms.info.ValueType == types.NonCumulativeDistributionType {
+			// The distribution is an absolute value, not an accumulation since
+			// the reset time. Report it as samples collected since the flush.
+			hist.Temporality = metricdata.DeltaTemporality
+		}
+		for _, c := range cells {
+			d, ok := c.value.(*distribution.Distribution)
+			if !ok {
+				continue
+			}
+			start := c.reset
+			if hist.Temporality == metricdata.DeltaTemporality || start.IsZero() {
+				start = c.sent
+			}
+			hist.DataPoints = append(hist.DataPoints, histogramPoint(d, c.attrs, start, now))
+		}
+		return hist
+
+	default:
+		panic(fmt.Sprintf("unsupported value type %s", ms.info.ValueType))
+	}
+}
+
+// histogramPoint converts a distribution into a histogram data point.
+func histogramPoint(d *distribution.Distribution, attrs attribute.Set, start, now time.Time) metricdata.HistogramDataPoint[float64] {
+	b := d.Bucketer()
+	counts := make([]uint64, b.NumBuckets())
+	for i, n := range d.Buckets() {
+		if i < len(counts) && n > 0 {
+			counts[i] = uint64(n)
+		}
+	}
+	return metricdata.HistogramDataPoint[float64]{
+		Attributes:   attrs,
+		StartTime:    start,
+		Time:         now,
+		Count:        uint64(d.Count()),
+		Bounds:       Boundaries(b),
+		BucketCounts: counts,
+		Sum:          d.Sum(),
+	}
+}
+
+// Boundaries returns explicit histogram bucket boundaries matching the
+// bucketer.
+//
+// A histogram with these boundaries has the same number of buckets as the
+// bucketer, including the underflow and the overflow buckets.
+func Boundaries(b *distribution.Bucketer) []float64 {
+	bounds := make([]float64, b.NumBuckets()-1)
+	for i := range bounds {
+		bounds[i] = b.UpperBound(i)
+	}
+	return bounds
+}
+
+// attributes converts metric fields into OpenTelemetry attributes.
+func attributes(fields []field.Field, vals []any) attribute.Set {
+	kvs := make([]attribute.KeyValue, 0, len(vals))
+	for i, v := range vals {
+		if i >= len(fields) {
+			break
+		}
+		key := attribute.Key(fields[i].Name)
+		switch v := v.(type) {
+		case string:
+			kvs = append(kvs, key.String(v))
+		case int64:
+			kvs = append(kvs, key.Int64(v))
+		case bool:
+			kvs = append(kvs, key.Bool(v))
+		default:
+			kvs = append(kvs, key.String(fmt.Sprintf("%v", v)))
+		}
+	}
+	return attribute.NewSet(kvs...)
+}
+
+func seriesKey(vals []any) string {
+	var sb strings.Builder
+	for _, v := range vals {
+		fmt.Fprintf(&sb, "%v\x00", v)
+	}
+	return sb.String()
+}
@@ -0,0 +1,227 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otelbridge
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/tsmon/distribution"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMonitor(t *testing.T) {
+	t.Parallel()
+
+	Convey("Monitor", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		mon := NewMonitor()
+		reader := sdkmetric.NewManualReader(sdkmetric.WithProducer(mon))
+		mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+		defer mp.Shutdown(ctx)
+
+		collect := func() map[string]metricdata.Aggregation {
+			var rm metricdata.ResourceMetrics
+			So(reader.Collect(ctx, &rm), ShouldBeNil)
+			out := map[string]metricdata.Aggregation{}
+			for _, sm := range rm.ScopeMetrics {
+				if sm.Scope.Name != ScopeName {
+					continue
+				}
+				for _, m := range sm.Metrics {
+					out[m.Name] = m.Data
+				}
+			}
+			return out
+		}
+		cell := func(name string, typ types.ValueType, fields []field.Field, vals []any, value any) types.Cell {
+			return types.Cell{
+				MetricInfo: types.MetricInfo{
+					Name:      name,
+					Fields:    fields,
+					ValueType: typ,
+				},
+				CellData: types.CellData{
+					FieldVals: vals,
+					ResetTime: testclock.TestRecentTimeUTC,
+					Value:     value,
+				},
+			}
+		}
+		fields := []field.Field{field.String("method"), field.Int("code")}
+		attrs := func(method string, code int64) attribute.Set {
+			return attribute.NewSet(attribute.String("method", method), attribute.Int64("code", code))
+		}
+
+		Convey("Counters and gauges", func() {
+			So(mon.Send(ctx, []types.Cell{
+				cell("/test/rpc/count", types.CumulativeIntType, fields, []any{"Get", int64(200)}, int64(5)),
+				cell("/test/load", types.NonCumulativeFloatType, nil, nil, 0.5),
+				cell("/test/up", types.BoolType, nil, nil, true),
+				cell("/test/version", types.StringType, nil, nil, "v1"),
+			}), ShouldBeNil)
+
+			data := collect()
+			count := data["test/rpc/count"].(metricdata.Sum[int64])
+			So(count.IsMonotonic, ShouldBeTrue)
+			So(count.Temporality, ShouldEqual, metricdata.CumulativeTemporality)
+			So(count.DataPoints, ShouldHaveLength, 1)
+			So(count.DataPoints[0].Value, ShouldEqual, 5)
+			So(count.DataPoints[0].Attributes, ShouldResemble, attrs("Get", 200))
+			So(count.DataPoints[0].StartTime, ShouldEqual, testclock.TestRecentTimeUTC)
+
+			load := data["test/load"].(metricdata.Gauge[float64])
+			So(load.DataPoints[0].Value, ShouldEqual, 0.5)
+
+			up := data["test/up"].(metricdata.Gauge[int64])
+			So(up.DataPoints[0].Value, ShouldEqual, 1)
+
+			version := data["test/version"].(metricdata.Gauge[int64])
+			So(version.DataPoints[0].Value, ShouldEqual, 1)
+			val, _ := version.DataPoints[0].Attributes.Value("value")
+			So(val.AsString(), ShouldEqual, "v1")
+
+			Convey("Reports the last sent value", func() {
+				So(mon.Send(ctx, []types.Cell{
+					cell("/test/rpc/count", types.CumulativeIntType, fields, []any{"Get", int64(200)}, int64(7)),
+				}), ShouldBeNil)
+				count := collect()["test/rpc/count"].(metricdata.Sum[int64])
+				So(count.DataPoints[0].Value, ShouldEqual, 7)
+			})
+		})
+
+		Convey("Cumulative distributions", func() {
+			d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+			d.Add(-1)
+			d.Add(5)
+			d.Add(15)
+			So(mon.Send(ctx, []types.Cell{
+				cell("/test/latency", types.CumulativeDistributionType, nil, nil, d),
+			}), ShouldBeNil)
+
+			hist := collect()["test/latency"].(metricdata.Histogram[float64])
+			So(hist.DataPoints, ShouldHaveLength, 1)
+			So(hist.DataPoints[0].Bounds, ShouldResemble, []float64{0, 10, 20})
+			So(hist.DataPoints[0].BucketCounts, ShouldResemble, []uint64{1, 1, 1, 0})
+			So(hist.Temporality, ShouldEqual, metricdata.CumulativeTemporality)
+			So(hist.DataPoints[0].Count, ShouldEqual, 3)
+			So(hist.DataPoints[0].Sum, ShouldEqual, 19)
+
+			Convey("Reports the last sent distribution", func() {
+				d.Add(100)
+				d.Add(1)
+				So(mon.Send(ctx, []types.Cell{
+					cell("/test/latency", types.CumulativeDistributionType, nil, nil, d),
+				}), ShouldBeNil)
+
+				hist := collect()["test/latency"].(metricdata.Histogram[float64])
+				So(hist.DataPoints[0].BucketCounts, ShouldResemble, []uint64{1, 2, 1, 1})
+				So(hist.DataPoints[0].Count, ShouldEqual, 5)
+				So(hist.DataPoints[0].Sum, ShouldEqual, 120)
+			})
+
+			Convey("Doesn't alias the sent distribution", func() {
+				d.Add(100)
+				hist := collect()["test/latency"].(metricdata.Histogram[float64])
+				So(hist.DataPoints[0].Count, ShouldEqual, 3)
+			})
+		})
+
+		Convey("Non-cumulative distributions", func() {
+			d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+			d.Add(5)
+			d.Add(6)
+			send := func() {
+				So(mon.Send(ctx, []types.Cell{
+					cell("/test/queue", types.NonCumulativeDistributionType, nil, nil, d),
+				}), ShouldBeNil)
+			}
+
+			send()
+			send() // the same value is reported as is, not accumulated
+			hist := collect()["test/queue"].(metricdata.Histogram[float64])
+			So(hist.Temporality, ShouldEqual, metricdata.DeltaTemporality)
+			So(hist.DataPoints[0].BucketCounts, ShouldResemble, []uint64{0, 2, 0, 0})
+			So(hist.DataPoints[0].Count, ShouldEqual, 2)
+
+			d = distribution.New(distribution.FixedWidthBucketer(10, 2))
+			d.Add(15)
+			send() // smaller than before, replaces the previous value
+			hist = collect()["test/queue"].(metricdata.Histogram[float64])
+			So(hist.DataPoints[0].BucketCounts, ShouldResemble, []uint64{0, 0, 1, 0})
+			So(hist.DataPoints[0].Count, ShouldEqual, 1)
+			So(hist.DataPoints[0].Sum, ShouldEqual, 15)
+		})
+
+		Convey("Expiry", func() {
+			mon.Expiry = time.Minute
+			So(mon.Send(ctx, []types.Cell{
+				cell("/test/old", types.NonCumulativeIntType, nil, nil, int64(1)),
+				cell("/test/new", types.NonCumulativeIntType, fields, []any{"Get", int64(200)}, int64(1)),
+			}), ShouldBeNil)
+
+			tc.Add(45 * time.Second)
+			So(mon.Send(ctx, []types.Cell{
+				cell("/test/new", types.NonCumulativeIntType, fields, []any{"Put", int64(200)}, int64(2)),
+			}), ShouldBeNil)
+			data := collect()
+			So(data, ShouldContainKey, "test/old")
+			So(data["test/new"].(metricdata.Gauge[int64]).DataPoints, ShouldHaveLength, 2)
+
+			tc.Add(30 * time.Second)
+			data = collect()
+			So(data, ShouldNotContainKey, "test/old")
+			points := data["test/new"].(metricdata.Gauge[int64]).DataPoints
+			So(points, ShouldHaveLength, 1)
+			So(points[0].Attributes, ShouldResemble, attrs("Put", 200))
+
+			tc.Add(time.Minute)
+			So(collect(), ShouldBeEmpty)
+		})
+
+		Convey("Sending while collecting", func() {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for i := 0; i < 100; i++ {
+					var rm metricdata.ResourceMetrics
+					_ = reader.Collect(ctx, &rm)
+				}
+			}()
+			for i := 0; i < 100; i++ {
+				So(mon.Send(ctx, []types.Cell{
+					cell(fmt.Sprintf("/test/gauge/%d", i), types.NonCumulativeIntType, nil, nil, int64(i)),
+				}), ShouldBeNil)
+			}
+			<-done
+			So(mon.Close(), ShouldBeNil)
+		})
+
+		Convey("Boundaries", func() {
+			So(Boundaries(distribution.FixedWidthBucketer(10, 2)), ShouldResemble, []float64{0, 10, 20})
+			So(Boundaries(distribution.GeometricBucketer(2, 3)), ShouldResemble, []float64{1, 2, 4, 8})
+		})
+	})
+}
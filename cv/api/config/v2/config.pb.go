@@ -194,7 +194,7 @@ func (x Verifiers_GerritCQAbility_CQAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Verifiers_GerritCQAbility_CQAction.Descriptor instead.
 func (Verifiers_GerritCQAbility_CQAction) EnumDescriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 0, 0}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 0, 0}
 }
 
 // This message describes a CQ configuration.
@@ -502,6 +502,10 @@ type SubmitOptions struct {
 	//
 	// Must be >0 to take effect. Requires max_burst to be set, too.
 	BurstDelay *durationpb.Duration `protobuf:"bytes,2,opt,name=burst_delay,json=burstDelay,proto3" json:"burst_delay,omitempty"`
+	// Optional. If set, Runs are verified in a merge queue before submission.
+	//
+	// See MergeQueue for details.
+	MergeQueue *MergeQueue `protobuf:"bytes,3,opt,name=merge_queue,json=mergeQueue,proto3" json:"merge_queue,omitempty"`
 }
 
 func (x *SubmitOptions) Reset() {
@@ -550,6 +554,76 @@ func (x *SubmitOptions) GetBurstDelay() *durationpb.Duration {
 	return nil
 }
 
+func (x *SubmitOptions) GetMergeQueue() *MergeQueue {
+	if x != nil {
+		return x.MergeQueue
+	}
+	return nil
+}
+
+// MergeQueue makes CV verify CLs against the other CLs that will be submitted
+// before them.
+//
+// Full Runs whose Tryjobs have passed join the merge queue instead of being
+// submitted right away. CV takes a batch of Runs from the front of the queue
+// and launches the Tryjobs of the last Run in the batch again, this time
+// testing CLs of the Run stacked on top of CLs of all Runs ahead of it. If
+// these Tryjobs pass, the whole batch is submitted in order without any other
+// submission in between. If they fail, CV bisects the batch to find the first
+// Run that breaks it, fails that Run and submits the Runs ahead of it.
+//
+// This prevents breakages caused by CLs that pass Tryjobs on their own but
+// don't work together.
+type MergeQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. Maximum number of Runs verified together.
+	//
+	// Must be in range [1, 32].
+	MaxBatchSize int32 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+}
+
+func (x *MergeQueue) Reset() {
+	*x = MergeQueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeQueue) ProtoMessage() {}
+
+func (x *MergeQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeQueue.ProtoReflect.Descriptor instead.
+func (*MergeQueue) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MergeQueue) GetMaxBatchSize() int32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
 // Mode defines a CQ Run mode and how it can be triggered.
 //
 // The mode ACL check will be same as dry run (i.e. use `dry_run_access_list`)
@@ -584,12 +658,23 @@ type Mode struct {
 	//
 	// Must be > 0.
 	TriggeringValue int32 `protobuf:"varint,4,opt,name=triggering_value,json=triggeringValue,proto3" json:"triggering_value,omitempty"`
+	// Optional. Names of the builders to trigger in the mode.
+	//
+	// If set, a run in this mode triggers exactly these builders, regardless
+	// of their `mode_allowlist`. The other builder options (e.g.
+	// `location_filters`, `experiment_percentage`, `owner_whitelist_group`)
+	// still apply. If not set, builders are picked based on their
+	// `mode_allowlist`.
+	//
+	// Each name must be a name of a builder in `verifiers.tryjob.builders`,
+	// which is not `includable_only`.
+	TryjobBuilders []string `protobuf:"bytes,5,rep,name=tryjob_builders,json=tryjobBuilders,proto3" json:"tryjob_builders,omitempty"`
 }
 
 func (x *Mode) Reset() {
 	*x = Mode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[3]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -602,7 +687,7 @@ func (x *Mode) String() string {
 func (*Mode) ProtoMessage() {}
 
 func (x *Mode) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[3]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +700,7 @@ func (x *Mode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mode.ProtoReflect.Descriptor instead.
 func (*Mode) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{3}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Mode) GetName() string {
@@ -646,6 +731,13 @@ func (x *Mode) GetTriggeringValue() int32 {
 	return 0
 }
 
+func (x *Mode) GetTryjobBuilders() []string {
+	if x != nil {
+		return x.TryjobBuilders
+	}
+	return nil
+}
+
 // CombineCLs defines how CQ works with >1 CL per attempt.
 //
 // Dependencies between CLs are either implicit via Git child->parent
@@ -711,7 +803,7 @@ type CombineCLs struct {
 func (x *CombineCLs) Reset() {
 	*x = CombineCLs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[4]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -724,7 +816,7 @@ func (x *CombineCLs) String() string {
 func (*CombineCLs) ProtoMessage() {}
 
 func (x *CombineCLs) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[4]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +829,7 @@ func (x *CombineCLs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CombineCLs.ProtoReflect.Descriptor instead.
 func (*CombineCLs) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{4}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CombineCLs) GetStabilizationDelay() *durationpb.Duration {
@@ -783,7 +875,7 @@ type Verifiers struct {
 func (x *Verifiers) Reset() {
 	*x = Verifiers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[5]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -796,7 +888,7 @@ func (x *Verifiers) String() string {
 func (*Verifiers) ProtoMessage() {}
 
 func (x *Verifiers) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,7 +901,7 @@ func (x *Verifiers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Verifiers.ProtoReflect.Descriptor instead.
 func (*Verifiers) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Verifiers) GetGerritCqAbility() *Verifiers_GerritCQAbility {
@@ -872,7 +964,7 @@ type UserLimit struct {
 func (x *UserLimit) Reset() {
 	*x = UserLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[6]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -885,7 +977,7 @@ func (x *UserLimit) String() string {
 func (*UserLimit) ProtoMessage() {}
 
 func (x *UserLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +990,7 @@ func (x *UserLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLimit.ProtoReflect.Descriptor instead.
 func (*UserLimit) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UserLimit) GetName() string {
@@ -947,7 +1039,7 @@ type ConfigGroup_Gerrit struct {
 func (x *ConfigGroup_Gerrit) Reset() {
 	*x = ConfigGroup_Gerrit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -960,7 +1052,7 @@ func (x *ConfigGroup_Gerrit) String() string {
 func (*ConfigGroup_Gerrit) ProtoMessage() {}
 
 func (x *ConfigGroup_Gerrit) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1102,7 @@ type ConfigGroup_PostAction struct {
 func (x *ConfigGroup_PostAction) Reset() {
 	*x = ConfigGroup_PostAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[8]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1023,7 +1115,7 @@ func (x *ConfigGroup_PostAction) String() string {
 func (*ConfigGroup_PostAction) ProtoMessage() {}
 
 func (x *ConfigGroup_PostAction) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,7 +1196,7 @@ type ConfigGroup_TryjobExperiment struct {
 func (x *ConfigGroup_TryjobExperiment) Reset() {
 	*x = ConfigGroup_TryjobExperiment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[9]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1117,7 +1209,7 @@ func (x *ConfigGroup_TryjobExperiment) String() string {
 func (*ConfigGroup_TryjobExperiment) ProtoMessage() {}
 
 func (x *ConfigGroup_TryjobExperiment) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,7 +1284,7 @@ type ConfigGroup_Gerrit_Project struct {
 func (x *ConfigGroup_Gerrit_Project) Reset() {
 	*x = ConfigGroup_Gerrit_Project{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[10]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1205,7 +1297,7 @@ func (x *ConfigGroup_Gerrit_Project) String() string {
 func (*ConfigGroup_Gerrit_Project) ProtoMessage() {}
 
 func (x *ConfigGroup_Gerrit_Project) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[10]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,7 +1355,7 @@ type ConfigGroup_PostAction_TriggeringCondition struct {
 func (x *ConfigGroup_PostAction_TriggeringCondition) Reset() {
 	*x = ConfigGroup_PostAction_TriggeringCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[11]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1276,7 +1368,7 @@ func (x *ConfigGroup_PostAction_TriggeringCondition) String() string {
 func (*ConfigGroup_PostAction_TriggeringCondition) ProtoMessage() {}
 
 func (x *ConfigGroup_PostAction_TriggeringCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[11]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,7 +1411,7 @@ type ConfigGroup_PostAction_VoteGerritLabels struct {
 func (x *ConfigGroup_PostAction_VoteGerritLabels) Reset() {
 	*x = ConfigGroup_PostAction_VoteGerritLabels{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[12]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1332,7 +1424,7 @@ func (x *ConfigGroup_PostAction_VoteGerritLabels) String() string {
 func (*ConfigGroup_PostAction_VoteGerritLabels) ProtoMessage() {}
 
 func (x *ConfigGroup_PostAction_VoteGerritLabels) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[12]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1369,7 +1461,7 @@ type ConfigGroup_PostAction_VoteGerritLabels_Vote struct {
 func (x *ConfigGroup_PostAction_VoteGerritLabels_Vote) Reset() {
 	*x = ConfigGroup_PostAction_VoteGerritLabels_Vote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[13]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1382,7 +1474,7 @@ func (x *ConfigGroup_PostAction_VoteGerritLabels_Vote) String() string {
 func (*ConfigGroup_PostAction_VoteGerritLabels_Vote) ProtoMessage() {}
 
 func (x *ConfigGroup_PostAction_VoteGerritLabels_Vote) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[13]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,7 +1521,7 @@ type ConfigGroup_TryjobExperiment_Condition struct {
 func (x *ConfigGroup_TryjobExperiment_Condition) Reset() {
 	*x = ConfigGroup_TryjobExperiment_Condition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[14]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1442,7 +1534,7 @@ func (x *ConfigGroup_TryjobExperiment_Condition) String() string {
 func (*ConfigGroup_TryjobExperiment_Condition) ProtoMessage() {}
 
 func (x *ConfigGroup_TryjobExperiment_Condition) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[14]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +1618,7 @@ type Verifiers_GerritCQAbility struct {
 func (x *Verifiers_GerritCQAbility) Reset() {
 	*x = Verifiers_GerritCQAbility{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[15]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1539,7 +1631,7 @@ func (x *Verifiers_GerritCQAbility) String() string {
 func (*Verifiers_GerritCQAbility) ProtoMessage() {}
 
 func (x *Verifiers_GerritCQAbility) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[15]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1552,7 +1644,7 @@ func (x *Verifiers_GerritCQAbility) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Verifiers_GerritCQAbility.ProtoReflect.Descriptor instead.
 func (*Verifiers_GerritCQAbility) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 0}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *Verifiers_GerritCQAbility) GetCommitterList() []string {
@@ -1609,7 +1701,7 @@ type Verifiers_TreeStatus struct {
 func (x *Verifiers_TreeStatus) Reset() {
 	*x = Verifiers_TreeStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[16]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1622,7 +1714,7 @@ func (x *Verifiers_TreeStatus) String() string {
 func (*Verifiers_TreeStatus) ProtoMessage() {}
 
 func (x *Verifiers_TreeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[16]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +1727,7 @@ func (x *Verifiers_TreeStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Verifiers_TreeStatus.ProtoReflect.Descriptor instead.
 func (*Verifiers_TreeStatus) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 1}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 1}
 }
 
 func (x *Verifiers_TreeStatus) GetUrl() string {
@@ -1668,7 +1760,7 @@ type Verifiers_Tryjob struct {
 func (x *Verifiers_Tryjob) Reset() {
 	*x = Verifiers_Tryjob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[17]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1681,7 +1773,7 @@ func (x *Verifiers_Tryjob) String() string {
 func (*Verifiers_Tryjob) ProtoMessage() {}
 
 func (x *Verifiers_Tryjob) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[17]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1694,7 +1786,7 @@ func (x *Verifiers_Tryjob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Verifiers_Tryjob.ProtoReflect.Descriptor instead.
 func (*Verifiers_Tryjob) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 2}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 2}
 }
 
 func (x *Verifiers_Tryjob) GetBuilders() []*Verifiers_Tryjob_Builder {
@@ -1732,7 +1824,7 @@ type Verifiers_CQLinter struct {
 func (x *Verifiers_CQLinter) Reset() {
 	*x = Verifiers_CQLinter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[18]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1745,7 +1837,7 @@ func (x *Verifiers_CQLinter) String() string {
 func (*Verifiers_CQLinter) ProtoMessage() {}
 
 func (x *Verifiers_CQLinter) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[18]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1758,7 +1850,7 @@ func (x *Verifiers_CQLinter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Verifiers_CQLinter.ProtoReflect.Descriptor instead.
 func (*Verifiers_CQLinter) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 3}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 3}
 }
 
 // Fake is for internal use in CQ.
@@ -1778,7 +1870,7 @@ type Verifiers_Fake struct {
 func (x *Verifiers_Fake) Reset() {
 	*x = Verifiers_Fake{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[19]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1791,7 +1883,7 @@ func (x *Verifiers_Fake) String() string {
 func (*Verifiers_Fake) ProtoMessage() {}
 
 func (x *Verifiers_Fake) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[19]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,7 +1896,7 @@ func (x *Verifiers_Fake) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Verifiers_Fake.ProtoReflect.Descriptor instead.
 func (*Verifiers_Fake) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 4}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 4}
 }
 
 func (x *Verifiers_Fake) GetName() string {
@@ -1917,7 +2009,7 @@ type Verifiers_Tryjob_Builder struct {
 func (x *Verifiers_Tryjob_Builder) Reset() {
 	*x = Verifiers_Tryjob_Builder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[20]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1930,7 +2022,7 @@ func (x *Verifiers_Tryjob_Builder) String() string {
 func (*Verifiers_Tryjob_Builder) ProtoMessage() {}
 
 func (x *Verifiers_Tryjob_Builder) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[20]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1943,7 +2035,7 @@ func (x *Verifiers_Tryjob_Builder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Verifiers_Tryjob_Builder.ProtoReflect.Descriptor instead.
 func (*Verifiers_Tryjob_Builder) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 2, 0}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 2, 0}
 }
 
 func (x *Verifiers_Tryjob_Builder) GetHost() string {
@@ -2060,7 +2152,7 @@ type Verifiers_Tryjob_EquivalentBuilder struct {
 func (x *Verifiers_Tryjob_EquivalentBuilder) Reset() {
 	*x = Verifiers_Tryjob_EquivalentBuilder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[21]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2073,7 +2165,7 @@ func (x *Verifiers_Tryjob_EquivalentBuilder) String() string {
 func (*Verifiers_Tryjob_EquivalentBuilder) ProtoMessage() {}
 
 func (x *Verifiers_Tryjob_EquivalentBuilder) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[21]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2086,7 +2178,7 @@ func (x *Verifiers_Tryjob_EquivalentBuilder) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Verifiers_Tryjob_EquivalentBuilder.ProtoReflect.Descriptor instead.
 func (*Verifiers_Tryjob_EquivalentBuilder) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 2, 1}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 2, 1}
 }
 
 func (x *Verifiers_Tryjob_EquivalentBuilder) GetName() string {
@@ -2125,7 +2217,7 @@ type Verifiers_Tryjob_IncludableBuilder struct {
 func (x *Verifiers_Tryjob_IncludableBuilder) Reset() {
 	*x = Verifiers_Tryjob_IncludableBuilder{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[22]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2138,7 +2230,7 @@ func (x *Verifiers_Tryjob_IncludableBuilder) String() string {
 func (*Verifiers_Tryjob_IncludableBuilder) ProtoMessage() {}
 
 func (x *Verifiers_Tryjob_IncludableBuilder) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[22]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2151,7 +2243,7 @@ func (x *Verifiers_Tryjob_IncludableBuilder) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Verifiers_Tryjob_IncludableBuilder.ProtoReflect.Descriptor instead.
 func (*Verifiers_Tryjob_IncludableBuilder) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 2, 2}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 2, 2}
 }
 
 func (x *Verifiers_Tryjob_IncludableBuilder) GetName() string {
@@ -2199,7 +2291,7 @@ type Verifiers_Tryjob_RetryConfig struct {
 func (x *Verifiers_Tryjob_RetryConfig) Reset() {
 	*x = Verifiers_Tryjob_RetryConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[23]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2212,7 +2304,7 @@ func (x *Verifiers_Tryjob_RetryConfig) String() string {
 func (*Verifiers_Tryjob_RetryConfig) ProtoMessage() {}
 
 func (x *Verifiers_Tryjob_RetryConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[23]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,7 +2317,7 @@ func (x *Verifiers_Tryjob_RetryConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Verifiers_Tryjob_RetryConfig.ProtoReflect.Descriptor instead.
 func (*Verifiers_Tryjob_RetryConfig) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 2, 3}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 2, 3}
 }
 
 func (x *Verifiers_Tryjob_RetryConfig) GetSingleQuota() int32 {
@@ -2345,7 +2437,7 @@ type Verifiers_Tryjob_Builder_LocationFilter struct {
 func (x *Verifiers_Tryjob_Builder_LocationFilter) Reset() {
 	*x = Verifiers_Tryjob_Builder_LocationFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[24]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2358,7 +2450,7 @@ func (x *Verifiers_Tryjob_Builder_LocationFilter) String() string {
 func (*Verifiers_Tryjob_Builder_LocationFilter) ProtoMessage() {}
 
 func (x *Verifiers_Tryjob_Builder_LocationFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[24]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2371,7 +2463,7 @@ func (x *Verifiers_Tryjob_Builder_LocationFilter) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use Verifiers_Tryjob_Builder_LocationFilter.ProtoReflect.Descriptor instead.
 func (*Verifiers_Tryjob_Builder_LocationFilter) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{5, 2, 0, 0}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 2, 0, 0}
 }
 
 func (x *Verifiers_Tryjob_Builder_LocationFilter) GetGerritHostRegexp() string {
@@ -2419,7 +2511,7 @@ type UserLimit_Limit struct {
 func (x *UserLimit_Limit) Reset() {
 	*x = UserLimit_Limit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[25]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2432,7 +2524,7 @@ func (x *UserLimit_Limit) String() string {
 func (*UserLimit_Limit) ProtoMessage() {}
 
 func (x *UserLimit_Limit) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[25]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,7 +2537,7 @@ func (x *UserLimit_Limit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLimit_Limit.ProtoReflect.Descriptor instead.
 func (*UserLimit_Limit) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 0}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (m *UserLimit_Limit) GetLimit() isUserLimit_Limit_Limit {
@@ -2499,7 +2591,7 @@ type UserLimit_Run struct {
 func (x *UserLimit_Run) Reset() {
 	*x = UserLimit_Run{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[26]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2512,7 +2604,7 @@ func (x *UserLimit_Run) String() string {
 func (*UserLimit_Run) ProtoMessage() {}
 
 func (x *UserLimit_Run) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[26]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,7 +2617,7 @@ func (x *UserLimit_Run) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLimit_Run.ProtoReflect.Descriptor instead.
 func (*UserLimit_Run) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 1}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *UserLimit_Run) GetMaxActive() *UserLimit_Limit {
@@ -2560,7 +2652,7 @@ type UserLimit_Tryjob struct {
 func (x *UserLimit_Tryjob) Reset() {
 	*x = UserLimit_Tryjob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[27]
+		mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2573,7 +2665,7 @@ func (x *UserLimit_Tryjob) String() string {
 func (*UserLimit_Tryjob) ProtoMessage() {}
 
 func (x *UserLimit_Tryjob) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[27]
+	mi := &file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2586,7 +2678,7 @@ func (x *UserLimit_Tryjob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserLimit_Tryjob.ProtoReflect.Descriptor instead.
 func (*UserLimit_Tryjob) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{6, 2}
+	return file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP(), []int{7, 2}
 }
 
 func (x *UserLimit_Tryjob) GetMaxActive() *UserLimit_Limit {
@@ -2730,217 +2822,227 @@ var file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
 	0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x13, 0x6f,
 	0x77, 0x6e, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
-	0x73, 0x74, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x68, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
-	0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61,
-	0x78, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f,
-	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x62, 0x75, 0x72, 0x73, 0x74, 0x44, 0x65, 0x6c,
-	0x61, 0x79, 0x22, 0x82, 0x02, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x53, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xfa, 0x42, 0x3c, 0x72, 0x3a,
-	0x32, 0x13, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x5f, 0x5d, 0x7b, 0x30,
-	0x2c, 0x33, 0x39, 0x7d, 0x24, 0x5a, 0x07, 0x44, 0x52, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x5a, 0x08,
-	0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x52, 0x55, 0x4e, 0x5a, 0x10, 0x4e, 0x45, 0x57, 0x5f, 0x50, 0x41,
-	0x54, 0x43, 0x48, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x2f, 0x0a, 0x0e, 0x63, 0x71, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x30,
-	0x01, 0x30, 0x02, 0x52, 0x0c, 0x63, 0x71, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x40, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xfa, 0x42, 0x12,
-	0x72, 0x10, 0x10, 0x01, 0x5a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2d, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x58, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x62, 0x69,
-	0x6e, 0x65, 0x43, 0x4c, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x73,
-	0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x61,
-	0x79, 0x22, 0xe2, 0x11, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12,
-	0x50, 0x0a, 0x11, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x63, 0x71, 0x5f, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x76, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
-	0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x51, 0x41, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x52, 0x0f, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x71, 0x41, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x12, 0x40, 0x0a, 0x0b, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x72, 0x65,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x74, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62,
-	0x52, 0x06, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x12, 0x39, 0x0a, 0x08, 0x63, 0x71, 0x6c, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x76, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
-	0x2e, 0x43, 0x51, 0x4c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x71, 0x6c, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x46, 0x61, 0x6b, 0x65, 0x52, 0x04, 0x66, 0x61,
-	0x6b, 0x65, 0x1a, 0xb4, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x51, 0x41,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a,
-	0x13, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x72, 0x79, 0x52,
-	0x75, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x1c,
-	0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x75, 0x6e,
-	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x18, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x52,
-	0x75, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x1b,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x74,
-	0x68, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x73, 0x12, 0x6a, 0x0a, 0x1a, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x66, 0x5f, 0x73, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
+	0x73, 0x74, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xa0, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x42, 0x75, 0x72, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x62, 0x75, 0x72, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x5f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x0a, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x0a, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0xb9, 0x02, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x53, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3f, 0xfa, 0x42, 0x3c, 0x72, 0x3a, 0x32, 0x13, 0x5e,
+	0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x5f, 0x5d, 0x7b, 0x30, 0x2c, 0x33, 0x39,
+	0x7d, 0x24, 0x5a, 0x07, 0x44, 0x52, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x5a, 0x08, 0x46, 0x55, 0x4c,
+	0x4c, 0x5f, 0x52, 0x55, 0x4e, 0x5a, 0x10, 0x4e, 0x45, 0x57, 0x5f, 0x50, 0x41, 0x54, 0x43, 0x48,
+	0x53, 0x45, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a,
+	0x0e, 0x63, 0x71, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x30, 0x01, 0x30, 0x02,
+	0x52, 0x0c, 0x63, 0x71, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40,
+	0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xfa, 0x42, 0x12, 0x72, 0x10, 0x10,
+	0x01, 0x5a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x32, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x20, 0x00, 0x52, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x5f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0c, 0xfa,
+	0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x74, 0x72, 0x79,
+	0x6a, 0x6f, 0x62, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x58, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x43, 0x4c, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x73, 0x74, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x12, 0x73, 0x74, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0xe2, 0x11, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x63, 0x71,
+	0x5f, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
 	0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
 	0x69, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x51, 0x41, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x51, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x17, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x66, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f,
-	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x72, 0x79, 0x52,
-	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x73, 0x22, 0x2e, 0x0a, 0x08, 0x43, 0x51, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a,
-	0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x02, 0x1a, 0x1e, 0x0a, 0x0a, 0x54, 0x72, 0x65,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0xe5, 0x0a, 0x0a, 0x06, 0x54, 0x72,
-	0x79, 0x6a, 0x6f, 0x62, 0x12, 0x3f, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x72, 0x79,
-	0x6a, 0x6f, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x08, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x76,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x73, 0x2e, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x47, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6c,
-	0x65, 0x5f, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x11, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74,
-	0x61, 0x6c, 0x65, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x1a, 0x80, 0x06, 0x0a, 0x07, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c,
-	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x61,
-	0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x10, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a,
-	0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x75, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x75,
-	0x73, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x61,
-	0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x0b, 0x63, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
-	0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x52, 0x0a,
-	0x0d, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x72, 0x79, 0x6a, 0x6f,
-	0x62, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x54,
-	0x6f, 0x12, 0x5d, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x76,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x73, 0x2e, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
-	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0x32, 0x0a, 0x15, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c,
-	0x69, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x13, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f,
-	0x64, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0xad, 0x01, 0x0a, 0x0e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c,
-	0x0a, 0x12, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65,
-	0x67, 0x65, 0x78, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x67, 0x65, 0x72, 0x72,
-	0x69, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x32, 0x0a, 0x15,
-	0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72,
-	0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x67, 0x65, 0x72,
-	0x72, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x52, 0x65, 0x67, 0x65, 0x78,
-	0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10,
-	0x04, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x4a, 0x04, 0x08, 0x0d, 0x10, 0x0e, 0x1a, 0x7b, 0x0a,
-	0x11, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
-	0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x57, 0x68, 0x69, 0x74,
-	0x65, 0x6c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x27, 0x0a, 0x11, 0x49, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x1a, 0xdb, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x71, 0x75,
-	0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x38, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x43, 0x51, 0x4c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x1a, 0x57, 0x0a,
-	0x04, 0x46, 0x61, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x22, 0xf1, 0x02, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6e,
-	0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
-	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x52, 0x75, 0x6e, 0x52,
-	0x03, 0x72, 0x75, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x54, 0x72, 0x79, 0x6a, 0x6f,
-	0x62, 0x52, 0x06, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x1a, 0x48, 0x0a, 0x05, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x09, 0x75, 0x6e,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
-	0x09, 0x75, 0x6e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x1a, 0x40, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x6d, 0x61,
-	0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x43, 0x0a, 0x06, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x12,
-	0x39, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
-	0x09, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2a, 0x5d, 0x0a, 0x0c, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f,
-	0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x45,
-	0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c,
-	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43,
-	0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x53,
-	0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x24, 0x0a, 0x06, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x07,
-	0x0a, 0x03, 0x59, 0x45, 0x53, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4e, 0x4f, 0x10, 0x02, 0x42,
-	0x6c, 0xa2, 0xfe, 0x23, 0x3b, 0x0a, 0x39, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3a,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x63, 0x66, 0x67,
-	0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
-	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x32, 0x3b, 0x63, 0x66, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x71, 0x41, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x76, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x2e, 0x54, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x74, 0x72, 0x65,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x74, 0x72, 0x79, 0x6a, 0x6f,
+	0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x72,
+	0x79, 0x6a, 0x6f, 0x62, 0x52, 0x06, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x12, 0x39, 0x0a, 0x08,
+	0x63, 0x71, 0x6c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x2e, 0x43, 0x51, 0x4c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63,
+	0x71, 0x6c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x04, 0x66, 0x61, 0x6b, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x46, 0x61, 0x6b, 0x65,
+	0x52, 0x04, 0x66, 0x61, 0x6b, 0x65, 0x1a, 0xb4, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x72, 0x72, 0x69,
+	0x74, 0x43, 0x51, 0x41, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x13, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x1c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74,
+	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x73, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x3c, 0x0a, 0x1b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x73, 0x12, 0x6a,
+	0x0a, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x66,
+	0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43,
+	0x51, 0x41, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x51, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x66, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x5f, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x64,
+	0x65, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x70, 0x73, 0x22, 0x2e, 0x0a,
+	0x08, 0x43, 0x51, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53,
+	0x45, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x02, 0x1a, 0x1e, 0x0a,
+	0x0a, 0x54, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0xe5, 0x0a,
+	0x0a, 0x06, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x12, 0x3f, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x76, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x2e, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52,
+	0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x0c, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x47, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x1a, 0x80,
+	0x06, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x44, 0x0a, 0x11, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x10, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x75,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x75, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63,
+	0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52,
+	0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x15,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x52, 0x0a, 0x0d, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x54,
+	0x72, 0x79, 0x6a, 0x6f, 0x62, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c,
+	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x12, 0x5d, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x77, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x13, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x65,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x1a,
+	0xad, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70,
+	0x12, 0x32, 0x0a, 0x15, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x67, 0x65, 0x78, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x67, 0x65, 0x78, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4a,
+	0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x4a, 0x04, 0x08, 0x0d, 0x10,
+	0x0e, 0x1a, 0x7b, 0x0a, 0x11, 0x45, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x27,
+	0x0a, 0x11, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0xdb, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x25, 0x0a,
+	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x65, 0x6e,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x0a, 0x0a, 0x08, 0x43, 0x51, 0x4c, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x1a, 0x57, 0x0a, 0x04, 0x46, 0x61, 0x6b, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x22, 0xf1, 0x02, 0x0a, 0x09, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x03,
+	0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x76, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e,
+	0x52, 0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x74, 0x72, 0x79, 0x6a,
+	0x6f, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x54,
+	0x72, 0x79, 0x6a, 0x6f, 0x62, 0x52, 0x06, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x1a, 0x48, 0x0a,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e,
+	0x0a, 0x09, 0x75, 0x6e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x75, 0x6e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x42, 0x07,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x40, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x39,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x43, 0x0a, 0x06, 0x54, 0x72, 0x79,
+	0x6a, 0x6f, 0x62, 0x12, 0x39, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x76, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2a, 0x5d,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x17,
+	0x0a, 0x13, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4d, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x24, 0x0a,
+	0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x53, 0x45, 0x54,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x59, 0x45, 0x53, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x4e,
+	0x4f, 0x10, 0x02, 0x42, 0x6c, 0xa2, 0xfe, 0x23, 0x3b, 0x0a, 0x39, 0x68, 0x74, 0x74, 0x70, 0x73,
+	0x3a, 0x2f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x61,
+	0x70, 0x70, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x3a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x2d, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x2e, 0x63, 0x66, 0x67, 0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
+	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x32, 0x3b, 0x63, 0x66, 0x67, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2956,7 +3058,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDescGZIP() []byt
 }
 
 var file_go_chromium_org_luci_cv_api_config_v2_config_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_go_chromium_org_luci_cv_api_config_v2_config_proto_goTypes = []interface{}{
 	(CommentLevel)(0),                                    // 0: cv.config.CommentLevel
 	(Toggle)(0),                                          // 1: cv.config.Toggle
@@ -2964,77 +3066,79 @@ var file_go_chromium_org_luci_cv_api_config_v2_config_proto_goTypes = []interfac
 	(*Config)(nil),                                       // 3: cv.config.Config
 	(*ConfigGroup)(nil),                                  // 4: cv.config.ConfigGroup
 	(*SubmitOptions)(nil),                                // 5: cv.config.SubmitOptions
-	(*Mode)(nil),                                         // 6: cv.config.Mode
-	(*CombineCLs)(nil),                                   // 7: cv.config.CombineCLs
-	(*Verifiers)(nil),                                    // 8: cv.config.Verifiers
-	(*UserLimit)(nil),                                    // 9: cv.config.UserLimit
-	(*ConfigGroup_Gerrit)(nil),                           // 10: cv.config.ConfigGroup.Gerrit
-	(*ConfigGroup_PostAction)(nil),                       // 11: cv.config.ConfigGroup.PostAction
-	(*ConfigGroup_TryjobExperiment)(nil),                 // 12: cv.config.ConfigGroup.TryjobExperiment
-	(*ConfigGroup_Gerrit_Project)(nil),                   // 13: cv.config.ConfigGroup.Gerrit.Project
-	(*ConfigGroup_PostAction_TriggeringCondition)(nil),   // 14: cv.config.ConfigGroup.PostAction.TriggeringCondition
-	(*ConfigGroup_PostAction_VoteGerritLabels)(nil),      // 15: cv.config.ConfigGroup.PostAction.VoteGerritLabels
-	(*ConfigGroup_PostAction_VoteGerritLabels_Vote)(nil), // 16: cv.config.ConfigGroup.PostAction.VoteGerritLabels.Vote
-	(*ConfigGroup_TryjobExperiment_Condition)(nil),       // 17: cv.config.ConfigGroup.TryjobExperiment.Condition
-	(*Verifiers_GerritCQAbility)(nil),                    // 18: cv.config.Verifiers.GerritCQAbility
-	(*Verifiers_TreeStatus)(nil),                         // 19: cv.config.Verifiers.TreeStatus
-	(*Verifiers_Tryjob)(nil),                             // 20: cv.config.Verifiers.Tryjob
-	(*Verifiers_CQLinter)(nil),                           // 21: cv.config.Verifiers.CQLinter
-	(*Verifiers_Fake)(nil),                               // 22: cv.config.Verifiers.Fake
-	(*Verifiers_Tryjob_Builder)(nil),                     // 23: cv.config.Verifiers.Tryjob.Builder
-	(*Verifiers_Tryjob_EquivalentBuilder)(nil),           // 24: cv.config.Verifiers.Tryjob.EquivalentBuilder
-	(*Verifiers_Tryjob_IncludableBuilder)(nil),           // 25: cv.config.Verifiers.Tryjob.IncludableBuilder
-	(*Verifiers_Tryjob_RetryConfig)(nil),                 // 26: cv.config.Verifiers.Tryjob.RetryConfig
-	(*Verifiers_Tryjob_Builder_LocationFilter)(nil),      // 27: cv.config.Verifiers.Tryjob.Builder.LocationFilter
-	(*UserLimit_Limit)(nil),                              // 28: cv.config.UserLimit.Limit
-	(*UserLimit_Run)(nil),                                // 29: cv.config.UserLimit.Run
-	(*UserLimit_Tryjob)(nil),                             // 30: cv.config.UserLimit.Tryjob
-	(*durationpb.Duration)(nil),                          // 31: google.protobuf.Duration
-	(v1.Run_Status)(0),                                   // 32: cv.v1.Run.Status
+	(*MergeQueue)(nil),                                   // 6: cv.config.MergeQueue
+	(*Mode)(nil),                                         // 7: cv.config.Mode
+	(*CombineCLs)(nil),                                   // 8: cv.config.CombineCLs
+	(*Verifiers)(nil),                                    // 9: cv.config.Verifiers
+	(*UserLimit)(nil),                                    // 10: cv.config.UserLimit
+	(*ConfigGroup_Gerrit)(nil),                           // 11: cv.config.ConfigGroup.Gerrit
+	(*ConfigGroup_PostAction)(nil),                       // 12: cv.config.ConfigGroup.PostAction
+	(*ConfigGroup_TryjobExperiment)(nil),                 // 13: cv.config.ConfigGroup.TryjobExperiment
+	(*ConfigGroup_Gerrit_Project)(nil),                   // 14: cv.config.ConfigGroup.Gerrit.Project
+	(*ConfigGroup_PostAction_TriggeringCondition)(nil),   // 15: cv.config.ConfigGroup.PostAction.TriggeringCondition
+	(*ConfigGroup_PostAction_VoteGerritLabels)(nil),      // 16: cv.config.ConfigGroup.PostAction.VoteGerritLabels
+	(*ConfigGroup_PostAction_VoteGerritLabels_Vote)(nil), // 17: cv.config.ConfigGroup.PostAction.VoteGerritLabels.Vote
+	(*ConfigGroup_TryjobExperiment_Condition)(nil),       // 18: cv.config.ConfigGroup.TryjobExperiment.Condition
+	(*Verifiers_GerritCQAbility)(nil),                    // 19: cv.config.Verifiers.GerritCQAbility
+	(*Verifiers_TreeStatus)(nil),                         // 20: cv.config.Verifiers.TreeStatus
+	(*Verifiers_Tryjob)(nil),                             // 21: cv.config.Verifiers.Tryjob
+	(*Verifiers_CQLinter)(nil),                           // 22: cv.config.Verifiers.CQLinter
+	(*Verifiers_Fake)(nil),                               // 23: cv.config.Verifiers.Fake
+	(*Verifiers_Tryjob_Builder)(nil),                     // 24: cv.config.Verifiers.Tryjob.Builder
+	(*Verifiers_Tryjob_EquivalentBuilder)(nil),           // 25: cv.config.Verifiers.Tryjob.EquivalentBuilder
+	(*Verifiers_Tryjob_IncludableBuilder)(nil),           // 26: cv.config.Verifiers.Tryjob.IncludableBuilder
+	(*Verifiers_Tryjob_RetryConfig)(nil),                 // 27: cv.config.Verifiers.Tryjob.RetryConfig
+	(*Verifiers_Tryjob_Builder_LocationFilter)(nil),      // 28: cv.config.Verifiers.Tryjob.Builder.LocationFilter
+	(*UserLimit_Limit)(nil),                              // 29: cv.config.UserLimit.Limit
+	(*UserLimit_Run)(nil),                                // 30: cv.config.UserLimit.Run
+	(*UserLimit_Tryjob)(nil),                             // 31: cv.config.UserLimit.Tryjob
+	(*durationpb.Duration)(nil),                          // 32: google.protobuf.Duration
+	(v1.Run_Status)(0),                                   // 33: cv.v1.Run.Status
 }
 var file_go_chromium_org_luci_cv_api_config_v2_config_proto_depIdxs = []int32{
 	5,  // 0: cv.config.Config.submit_options:type_name -> cv.config.SubmitOptions
 	4,  // 1: cv.config.Config.config_groups:type_name -> cv.config.ConfigGroup
 	1,  // 2: cv.config.Config.project_scoped_account:type_name -> cv.config.Toggle
-	10, // 3: cv.config.ConfigGroup.gerrit:type_name -> cv.config.ConfigGroup.Gerrit
-	7,  // 4: cv.config.ConfigGroup.combine_cls:type_name -> cv.config.CombineCLs
-	8,  // 5: cv.config.ConfigGroup.verifiers:type_name -> cv.config.Verifiers
+	11, // 3: cv.config.ConfigGroup.gerrit:type_name -> cv.config.ConfigGroup.Gerrit
+	8,  // 4: cv.config.ConfigGroup.combine_cls:type_name -> cv.config.CombineCLs
+	9,  // 5: cv.config.ConfigGroup.verifiers:type_name -> cv.config.Verifiers
 	1,  // 6: cv.config.ConfigGroup.fallback:type_name -> cv.config.Toggle
-	6,  // 7: cv.config.ConfigGroup.additional_modes:type_name -> cv.config.Mode
-	9,  // 8: cv.config.ConfigGroup.user_limits:type_name -> cv.config.UserLimit
-	9,  // 9: cv.config.ConfigGroup.user_limit_default:type_name -> cv.config.UserLimit
-	11, // 10: cv.config.ConfigGroup.post_actions:type_name -> cv.config.ConfigGroup.PostAction
-	12, // 11: cv.config.ConfigGroup.tryjob_experiments:type_name -> cv.config.ConfigGroup.TryjobExperiment
-	31, // 12: cv.config.SubmitOptions.burst_delay:type_name -> google.protobuf.Duration
-	31, // 13: cv.config.CombineCLs.stabilization_delay:type_name -> google.protobuf.Duration
-	18, // 14: cv.config.Verifiers.gerrit_cq_ability:type_name -> cv.config.Verifiers.GerritCQAbility
-	19, // 15: cv.config.Verifiers.tree_status:type_name -> cv.config.Verifiers.TreeStatus
-	20, // 16: cv.config.Verifiers.tryjob:type_name -> cv.config.Verifiers.Tryjob
-	21, // 17: cv.config.Verifiers.cqlinter:type_name -> cv.config.Verifiers.CQLinter
-	22, // 18: cv.config.Verifiers.fake:type_name -> cv.config.Verifiers.Fake
-	29, // 19: cv.config.UserLimit.run:type_name -> cv.config.UserLimit.Run
-	30, // 20: cv.config.UserLimit.tryjob:type_name -> cv.config.UserLimit.Tryjob
-	13, // 21: cv.config.ConfigGroup.Gerrit.projects:type_name -> cv.config.ConfigGroup.Gerrit.Project
-	14, // 22: cv.config.ConfigGroup.PostAction.conditions:type_name -> cv.config.ConfigGroup.PostAction.TriggeringCondition
-	15, // 23: cv.config.ConfigGroup.PostAction.vote_gerrit_labels:type_name -> cv.config.ConfigGroup.PostAction.VoteGerritLabels
-	17, // 24: cv.config.ConfigGroup.TryjobExperiment.condition:type_name -> cv.config.ConfigGroup.TryjobExperiment.Condition
-	32, // 25: cv.config.ConfigGroup.PostAction.TriggeringCondition.statuses:type_name -> cv.v1.Run.Status
-	16, // 26: cv.config.ConfigGroup.PostAction.VoteGerritLabels.votes:type_name -> cv.config.ConfigGroup.PostAction.VoteGerritLabels.Vote
-	2,  // 27: cv.config.Verifiers.GerritCQAbility.allow_owner_if_submittable:type_name -> cv.config.Verifiers.GerritCQAbility.CQAction
-	23, // 28: cv.config.Verifiers.Tryjob.builders:type_name -> cv.config.Verifiers.Tryjob.Builder
-	26, // 29: cv.config.Verifiers.Tryjob.retry_config:type_name -> cv.config.Verifiers.Tryjob.RetryConfig
-	1,  // 30: cv.config.Verifiers.Tryjob.cancel_stale_tryjobs:type_name -> cv.config.Toggle
-	0,  // 31: cv.config.Verifiers.Tryjob.Builder.result_visibility:type_name -> cv.config.CommentLevel
-	1,  // 32: cv.config.Verifiers.Tryjob.Builder.cancel_stale:type_name -> cv.config.Toggle
-	24, // 33: cv.config.Verifiers.Tryjob.Builder.equivalent_to:type_name -> cv.config.Verifiers.Tryjob.EquivalentBuilder
-	27, // 34: cv.config.Verifiers.Tryjob.Builder.location_filters:type_name -> cv.config.Verifiers.Tryjob.Builder.LocationFilter
-	28, // 35: cv.config.UserLimit.Run.max_active:type_name -> cv.config.UserLimit.Limit
-	28, // 36: cv.config.UserLimit.Tryjob.max_active:type_name -> cv.config.UserLimit.Limit
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	7,  // 7: cv.config.ConfigGroup.additional_modes:type_name -> cv.config.Mode
+	10, // 8: cv.config.ConfigGroup.user_limits:type_name -> cv.config.UserLimit
+	10, // 9: cv.config.ConfigGroup.user_limit_default:type_name -> cv.config.UserLimit
+	12, // 10: cv.config.ConfigGroup.post_actions:type_name -> cv.config.ConfigGroup.PostAction
+	13, // 11: cv.config.ConfigGroup.tryjob_experiments:type_name -> cv.config.ConfigGroup.TryjobExperiment
+	32, // 12: cv.config.SubmitOptions.burst_delay:type_name -> google.protobuf.Duration
+	6,  // 13: cv.config.SubmitOptions.merge_queue:type_name -> cv.config.MergeQueue
+	32, // 14: cv.config.CombineCLs.stabilization_delay:type_name -> google.protobuf.Duration
+	19, // 15: cv.config.Verifiers.gerrit_cq_ability:type_name -> cv.config.Verifiers.GerritCQAbility
+	20, // 16: cv.config.Verifiers.tree_status:type_name -> cv.config.Verifiers.TreeStatus
+	21, // 17: cv.config.Verifiers.tryjob:type_name -> cv.config.Verifiers.Tryjob
+	22, // 18: cv.config.Verifiers.cqlinter:type_name -> cv.config.Verifiers.CQLinter
+	23, // 19: cv.config.Verifiers.fake:type_name -> cv.config.Verifiers.Fake
+	30, // 20: cv.config.UserLimit.run:type_name -> cv.config.UserLimit.Run
+	31, // 21: cv.config.UserLimit.tryjob:type_name -> cv.config.UserLimit.Tryjob
+	14, // 22: cv.config.ConfigGroup.Gerrit.projects:type_name -> cv.config.ConfigGroup.Gerrit.Project
+	15, // 23: cv.config.ConfigGroup.PostAction.conditions:type_name -> cv.config.ConfigGroup.PostAction.TriggeringCondition
+	16, // 24: cv.config.ConfigGroup.PostAction.vote_gerrit_labels:type_name -> cv.config.ConfigGroup.PostAction.VoteGerritLabels
+	18, // 25: cv.config.ConfigGroup.TryjobExperiment.condition:type_name -> cv.config.ConfigGroup.TryjobExperiment.Condition
+	33, // 26: cv.config.ConfigGroup.PostAction.TriggeringCondition.statuses:type_name -> cv.v1.Run.Status
+	17, // 27: cv.config.ConfigGroup.PostAction.VoteGerritLabels.votes:type_name -> cv.config.ConfigGroup.PostAction.VoteGerritLabels.Vote
+	2,  // 28: cv.config.Verifiers.GerritCQAbility.allow_owner_if_submittable:type_name -> cv.config.Verifiers.GerritCQAbility.CQAction
+	24, // 29: cv.config.Verifiers.Tryjob.builders:type_name -> cv.config.Verifiers.Tryjob.Builder
+	27, // 30: cv.config.Verifiers.Tryjob.retry_config:type_name -> cv.config.Verifiers.Tryjob.RetryConfig
+	1,  // 31: cv.config.Verifiers.Tryjob.cancel_stale_tryjobs:type_name -> cv.config.Toggle
+	0,  // 32: cv.config.Verifiers.Tryjob.Builder.result_visibility:type_name -> cv.config.CommentLevel
+	1,  // 33: cv.config.Verifiers.Tryjob.Builder.cancel_stale:type_name -> cv.config.Toggle
+	25, // 34: cv.config.Verifiers.Tryjob.Builder.equivalent_to:type_name -> cv.config.Verifiers.Tryjob.EquivalentBuilder
+	28, // 35: cv.config.Verifiers.Tryjob.Builder.location_filters:type_name -> cv.config.Verifiers.Tryjob.Builder.LocationFilter
+	29, // 36: cv.config.UserLimit.Run.max_active:type_name -> cv.config.UserLimit.Limit
+	29, // 37: cv.config.UserLimit.Tryjob.max_active:type_name -> cv.config.UserLimit.Limit
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() }
@@ -3080,7 +3184,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Mode); i {
+			switch v := v.(*MergeQueue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3092,7 +3196,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombineCLs); i {
+			switch v := v.(*Mode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3104,7 +3208,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers); i {
+			switch v := v.(*CombineCLs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3116,7 +3220,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserLimit); i {
+			switch v := v.(*Verifiers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3128,7 +3232,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGroup_Gerrit); i {
+			switch v := v.(*UserLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3140,7 +3244,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGroup_PostAction); i {
+			switch v := v.(*ConfigGroup_Gerrit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3152,7 +3256,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGroup_TryjobExperiment); i {
+			switch v := v.(*ConfigGroup_PostAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3164,7 +3268,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGroup_Gerrit_Project); i {
+			switch v := v.(*ConfigGroup_TryjobExperiment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3176,7 +3280,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGroup_PostAction_TriggeringCondition); i {
+			switch v := v.(*ConfigGroup_Gerrit_Project); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3188,7 +3292,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGroup_PostAction_VoteGerritLabels); i {
+			switch v := v.(*ConfigGroup_PostAction_TriggeringCondition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3200,7 +3304,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGroup_PostAction_VoteGerritLabels_Vote); i {
+			switch v := v.(*ConfigGroup_PostAction_VoteGerritLabels); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3212,7 +3316,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigGroup_TryjobExperiment_Condition); i {
+			switch v := v.(*ConfigGroup_PostAction_VoteGerritLabels_Vote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3224,7 +3328,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_GerritCQAbility); i {
+			switch v := v.(*ConfigGroup_TryjobExperiment_Condition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3236,7 +3340,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_TreeStatus); i {
+			switch v := v.(*Verifiers_GerritCQAbility); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3248,7 +3352,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_Tryjob); i {
+			switch v := v.(*Verifiers_TreeStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3260,7 +3364,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_CQLinter); i {
+			switch v := v.(*Verifiers_Tryjob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3272,7 +3376,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_Fake); i {
+			switch v := v.(*Verifiers_CQLinter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3284,7 +3388,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_Tryjob_Builder); i {
+			switch v := v.(*Verifiers_Fake); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3296,7 +3400,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_Tryjob_EquivalentBuilder); i {
+			switch v := v.(*Verifiers_Tryjob_Builder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3308,7 +3412,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_Tryjob_IncludableBuilder); i {
+			switch v := v.(*Verifiers_Tryjob_EquivalentBuilder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3320,7 +3424,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_Tryjob_RetryConfig); i {
+			switch v := v.(*Verifiers_Tryjob_IncludableBuilder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3332,7 +3436,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Verifiers_Tryjob_Builder_LocationFilter); i {
+			switch v := v.(*Verifiers_Tryjob_RetryConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3344,7 +3448,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserLimit_Limit); i {
+			switch v := v.(*Verifiers_Tryjob_Builder_LocationFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3356,7 +3460,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserLimit_Run); i {
+			switch v := v.(*UserLimit_Limit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3368,6 +3472,18 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserLimit_Run); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserLimit_Tryjob); i {
 			case 0:
 				return &v.state
@@ -3380,10 +3496,10 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			}
 		}
 	}
-	file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*ConfigGroup_PostAction_VoteGerritLabels_)(nil),
 	}
-	file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_go_chromium_org_luci_cv_api_config_v2_config_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*UserLimit_Limit_Value)(nil),
 		(*UserLimit_Limit_Unlimited)(nil),
 	}
@@ -3393,7 +3509,7 @@ func file_go_chromium_org_luci_cv_api_config_v2_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_cv_api_config_v2_config_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   28,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
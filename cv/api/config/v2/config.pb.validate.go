@@ -641,6 +641,35 @@ func (m *SubmitOptions) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetMergeQueue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitOptionsValidationError{
+					field:  "MergeQueue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitOptionsValidationError{
+					field:  "MergeQueue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMergeQueue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitOptionsValidationError{
+				field:  "MergeQueue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return SubmitOptionsMultiError(errors)
 	}
@@ -719,6 +748,107 @@ var _ interface {
 	ErrorName() string
 } = SubmitOptionsValidationError{}
 
+// Validate checks the field values on MergeQueue with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MergeQueue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeQueue with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MergeQueueMultiError, or
+// nil if none found.
+func (m *MergeQueue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeQueue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxBatchSize
+
+	if len(errors) > 0 {
+		return MergeQueueMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeQueueMultiError is an error wrapping multiple validation errors
+// returned by MergeQueue.ValidateAll() if the designated constraints aren't met.
+type MergeQueueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeQueueMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeQueueMultiError) AllErrors() []error { return m }
+
+// MergeQueueValidationError is the validation error returned by
+// MergeQueue.Validate if the designated constraints aren't met.
+type MergeQueueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeQueueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeQueueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeQueueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeQueueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeQueueValidationError) ErrorName() string { return "MergeQueueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MergeQueueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeQueue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeQueueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeQueueValidationError{}
+
 // Validate checks the field values on Mode with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
 // encountered is returned, or nil if there are no violations.
@@ -806,6 +936,22 @@ func (m *Mode) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetTryjobBuilders() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := ModeValidationError{
+				field:  fmt.Sprintf("TryjobBuilders[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ModeMultiError(errors)
 	}
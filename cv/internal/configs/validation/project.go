@@ -43,6 +43,10 @@ const (
 	CQStatusHostInternal = "internal-cq-status.appspot.com"
 
 	dummyProjectSkipListenerValidation = "dummy-project-skip-listener-validation-deabeef-abc"
+
+	// maxMergeQueueBatchSize is the maximum number of Runs verified together in
+	// a merge queue.
+	maxMergeQueueBatchSize = 32
 )
 
 var limitNameRe = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z.\-@_+]{0,511}$`)
@@ -140,6 +144,13 @@ func (vd *projectConfigValidator) validateProjectConfig(cfg *cfgpb.Config) {
 		if d := cfg.SubmitOptions.BurstDelay; d != nil && d.AsDuration() < 0 {
 			vd.ctx.Errorf("burst_delay must be positive or 0")
 		}
+		if mq := cfg.SubmitOptions.MergeQueue; mq != nil {
+			vd.ctx.Enter("merge_queue")
+			if n := mq.GetMaxBatchSize(); n < 1 || n > maxMergeQueueBatchSize {
+				vd.ctx.Errorf("max_batch_size must be in range [1, %d]", maxMergeQueueBatchSize)
+			}
+			vd.ctx.Exit()
+		}
 		vd.ctx.Exit()
 	}
 	if len(cfg.ConfigGroups) == 0 {
@@ -221,6 +232,7 @@ func (vd *projectConfigValidator) validateConfigGroup(group *cfgpb.ConfigGroup,
 			vd.ctx.Errorf("%q is already in use", m.Name)
 		}
 		vd.ctx.Exit()
+		vd.validateModeTryjobBuilders(m, group.GetVerifiers().GetTryjob().GetBuilders())
 		vd.ctx.Exit()
 	}
 
@@ -309,6 +321,30 @@ func (vd *projectConfigValidator) validateConfigGroup(group *cfgpb.ConfigGroup,
 	vd.validateUserLimits(group.GetUserLimits(), group.GetUserLimitDefault())
 }
 
+// validateModeTryjobBuilders validates that the builders to trigger in
+// a mode are defined in the tryjob verifier.
+func (vd *projectConfigValidator) validateModeTryjobBuilders(m *cfgpb.Mode, builders []*cfgpb.Verifiers_Tryjob_Builder) {
+	defined := make(map[string]*cfgpb.Verifiers_Tryjob_Builder, len(builders))
+	for _, b := range builders {
+		defined[b.GetName()] = b
+	}
+	seen := stringset.New(len(m.GetTryjobBuilders()))
+	for i, name := range m.GetTryjobBuilders() {
+		vd.ctx.Enter("tryjob_builders #%d", i+1)
+		switch b, ok := defined[name]; {
+		case name == "":
+			// Already reported by ValidateAll.
+		case !seen.Add(name):
+			vd.ctx.Errorf("duplicate builder %q", name)
+		case !ok:
+			vd.ctx.Errorf("builder %q is not defined in verifiers.tryjob.builders", name)
+		case b.GetIncludableOnly():
+			vd.ctx.Errorf("builder %q is includable_only", name)
+		}
+		vd.ctx.Exit()
+	}
+}
+
 func (vd *projectConfigValidator) validateVoteGerritLabels(work *cfgpb.ConfigGroup_PostAction_VoteGerritLabels) {
 	// perform extra validations that are not checked by PGV.
 	labels := stringset.New(len(work.Votes))
@@ -224,6 +224,23 @@ func TestValidateProjectDetailed(t *testing.T) {
 				validateProjectConfig(vctx, &cfg)
 				So(vctx.Finalize(), ShouldNotBeNil)
 			})
+			Convey("merge_queue", func() {
+				cfg.SubmitOptions.MergeQueue = &cfgpb.MergeQueue{MaxBatchSize: 4}
+				Convey("OK", func() {
+					validateProjectConfig(vctx, &cfg)
+					So(vctx.Finalize(), ShouldBeNil)
+				})
+				Convey("Missing max_batch_size", func() {
+					cfg.SubmitOptions.MergeQueue.MaxBatchSize = 0
+					validateProjectConfig(vctx, &cfg)
+					So(vctx.Finalize(), ShouldErrLike, "max_batch_size must be in range [1, 32]")
+				})
+				Convey("Too large max_batch_size", func() {
+					cfg.SubmitOptions.MergeQueue.MaxBatchSize = 33
+					validateProjectConfig(vctx, &cfg)
+					So(vctx.Finalize(), ShouldErrLike, "max_batch_size must be in range [1, 32]")
+				})
+			})
 			Convey("config_groups", func() {
 				orig := cfg.ConfigGroups[0]
 				add := func(refRegexps ...string) {
@@ -384,6 +401,34 @@ func TestValidateProjectDetailed(t *testing.T) {
 					validateProjectConfig(vctx, &cfg)
 					So(vctx.Finalize(), ShouldErrLike, `"TEST_RUN" is already in use`)
 				})
+				Convey("tryjob_builders", func() {
+					Convey("OK", func() {
+						mode.TryjobBuilders = []string{"chromium/try/linux"}
+						validateProjectConfig(vctx, &cfg)
+						So(vctx.Finalize(), ShouldBeNil)
+					})
+					Convey("undefined", func() {
+						mode.TryjobBuilders = []string{"chromium/try/mac"}
+						validateProjectConfig(vctx, &cfg)
+						So(vctx.Finalize(), ShouldErrLike, `builder "chromium/try/mac" is not defined`)
+					})
+					Convey("duplicate", func() {
+						mode.TryjobBuilders = []string{"chromium/try/linux", "chromium/try/linux"}
+						validateProjectConfig(vctx, &cfg)
+						So(vctx.Finalize(), ShouldErrLike, `duplicate builder "chromium/try/linux"`)
+					})
+					Convey("includable_only", func() {
+						cfg.ConfigGroups[0].Verifiers.Tryjob.Builders[0].IncludableOnly = true
+						mode.TryjobBuilders = []string{"chromium/try/linux"}
+						validateProjectConfig(vctx, &cfg)
+						So(vctx.Finalize(), ShouldErrLike, `builder "chromium/try/linux" is includable_only`)
+					})
+					Convey("empty", func() {
+						mode.TryjobBuilders = []string{""}
+						validateProjectConfig(vctx, &cfg)
+						So(vctx.Finalize(), ShouldErrLike, "value length must be at least 1 runes")
+					})
+				})
 			})
 
 			Convey("post_actions", func() {
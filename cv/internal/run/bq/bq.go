@@ -59,8 +59,15 @@ func send(ctx context.Context, env *common.Env, client cvbq.Client, id common.Ru
 		panic(fmt.Errorf("the Run status must be final before sending to BQ"))
 	}
 
-	if r.Mode != run.DryRun && r.Mode != run.FullRun {
-		// only export dry run and full run to bq
+	exportLegacy := true
+	switch {
+	case r.Mode == run.DryRun || r.Mode == run.FullRun:
+	case !r.Mode.IsStandard():
+		// Runs in additional modes are exported only to CV's own table, since
+		// legacy CQ table consumers aren't aware of them.
+		exportLegacy = false
+	default:
+		// Other standard modes (e.g. NEW_PATCHSET_RUN) aren't exported at all.
 		return nil
 	}
 	// Load CLs and convert them to GerritChanges including submit status.
@@ -79,6 +86,9 @@ func send(ctx context.Context, env *common.Env, client cvbq.Client, id common.Ru
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
+		if !exportLegacy {
+			return
+		}
 		logging.Debugf(ctx, "CV exporting Run to CQ BQ table")
 		project := legacyProject
 		if env.IsGAEDev {
@@ -143,7 +153,7 @@ func makeAttempt(ctx context.Context, r *run.Run, cls []*run.RunCL) (*cvbqpb.Att
 	failedSet := common.MakeCLIDsSet(r.Submission.GetFailedCls()...)
 	a.GerritChanges = make([]*cvbqpb.GerritChange, len(cls))
 	for i, cl := range cls {
-		a.GerritChanges[i] = toGerritChange(cl, submittedSet, failedSet, r.Mode)
+		a.GerritChanges[i] = toGerritChange(cl, submittedSet, failedSet, r)
 	}
 	a.Status, a.Substatus = attemptStatus(ctx, r)
 	return a, nil
@@ -152,7 +162,7 @@ func makeAttempt(ctx context.Context, r *run.Run, cls []*run.RunCL) (*cvbqpb.Att
 // toGerritChange creates a GerritChange for the given RunCL.
 //
 // This includes the submit status of the CL.
-func toGerritChange(cl *run.RunCL, submitted, failed common.CLIDsSet, mode run.Mode) *cvbqpb.GerritChange {
+func toGerritChange(cl *run.RunCL, submitted, failed common.CLIDsSet, r *run.Run) *cvbqpb.GerritChange {
 	detail := cl.Detail
 	ci := detail.GetGerrit().GetInfo()
 	gc := &cvbqpb.GerritChange{
@@ -162,12 +172,21 @@ func toGerritChange(cl *run.RunCL, submitted, failed common.CLIDsSet, mode run.M
 		Patchset:                   int64(detail.Patchset),
 		EarliestEquivalentPatchset: int64(detail.MinEquivalentPatchset),
 		TriggerTime:                cl.Trigger.Time,
-		Mode:                       mode.BQAttemptMode(),
 		SubmitStatus:               cvbqpb.GerritChange_PENDING,
 		Owner:                      ci.GetOwner().GetEmail(),
 	}
+	switch {
+	case r.Mode.IsStandard():
+		gc.Mode = r.Mode.BQAttemptMode()
+	case run.ShouldSubmit(r):
+		gc.Mode = cvbqpb.Mode_FULL_RUN
+		gc.ModeName = string(r.Mode)
+	default:
+		gc.Mode = cvbqpb.Mode_DRY_RUN
+		gc.ModeName = string(r.Mode)
+	}
 
-	if mode == run.FullRun {
+	if run.ShouldSubmit(r) {
 		// Mark the CL submit status as success if it appears in the submitted CLs
 		// list, and failure if it does not.
 		switch _, submitted := submitted[cl.ID]; {
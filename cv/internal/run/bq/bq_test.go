@@ -419,6 +419,35 @@ func TestMakeAttempt(t *testing.T) {
 			So(a.Substatus, ShouldEqual, cvbqpb.AttemptSubstatus_MANUAL_CANCEL)
 		})
 
+		Convey("Additional mode", func() {
+			r.Mode = "QUICK_RUN"
+			r.ModeDefinition = &cfgpb.Mode{
+				Name:            "QUICK_RUN",
+				CqLabelValue:    1,
+				TriggeringLabel: "Quick-Run",
+				TriggeringValue: 1,
+			}
+			r.Submission = nil
+			a, err := makeAttempt(ctx, r, []*run.RunCL{cl})
+			So(err, ShouldBeNil)
+			So(a.GerritChanges[0].Mode, ShouldEqual, cvbqpb.Mode_DRY_RUN)
+			So(a.GerritChanges[0].ModeName, ShouldEqual, "QUICK_RUN")
+			So(a.GerritChanges[0].SubmitStatus, ShouldEqual, cvbqpb.GerritChange_PENDING)
+
+			Convey("that submits", func() {
+				r.ModeDefinition.CqLabelValue = 2
+				r.Submission = &run.Submission{
+					Cls:          []int64{int64(cl.ID)},
+					SubmittedCls: []int64{int64(cl.ID)},
+				}
+				a, err := makeAttempt(ctx, r, []*run.RunCL{cl})
+				So(err, ShouldBeNil)
+				So(a.GerritChanges[0].Mode, ShouldEqual, cvbqpb.Mode_FULL_RUN)
+				So(a.GerritChanges[0].ModeName, ShouldEqual, "QUICK_RUN")
+				So(a.GerritChanges[0].SubmitStatus, ShouldEqual, cvbqpb.GerritChange_SUCCESS)
+			})
+		})
+
 		Convey("HasCustomRequirement", func() {
 			r.Options = &run.Options{
 				IncludedTryjobs: []string{fmt.Sprintf("%s/try: cool-builder", lProject)},
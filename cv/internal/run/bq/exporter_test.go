@@ -27,6 +27,7 @@ import (
 	"go.chromium.org/luci/server/tq/tqtesting"
 
 	cvbqpb "go.chromium.org/luci/cv/api/bigquery/v1"
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/cvtesting"
@@ -136,6 +137,31 @@ func TestExportRunToBQ(t *testing.T) {
 				So(ct.BQFake.Rows(legacyProject, legacyDataset, legacyTable), ShouldHaveLength, 0)
 				So(ct.BQFake.Rows(legacyProjectDev, legacyDataset, legacyTable), ShouldHaveLength, 1)
 			})
+
+			Convey("only to CV table for additional modes", func() {
+				r.Mode = "QUICK_RUN"
+				r.ModeDefinition = &cfgpb.Mode{
+					Name:            "QUICK_RUN",
+					CqLabelValue:    1,
+					TriggeringLabel: "Quick-Run",
+					TriggeringValue: 1,
+				}
+				So(datastore.Put(ctx, &r), ShouldBeNil)
+				So(schedule(), ShouldBeNil)
+				ct.TQ.Run(ctx, tqtesting.StopAfterTask(exportRunToBQTaskClass))
+				rows := ct.BQFake.Rows("", CVDataset, CVTable)
+				So(rows, ShouldHaveLength, 1)
+				So(rows[0].(*cvbqpb.Attempt).GerritChanges[0].ModeName, ShouldEqual, "QUICK_RUN")
+				So(ct.BQFake.TotalSent(), ShouldEqual, 1)
+			})
+		})
+
+		Convey("NEW_PATCHSET_RUN is not exported", func() {
+			r.Mode = run.NewPatchsetRun
+			So(datastore.Put(ctx, &r), ShouldBeNil)
+			So(schedule(), ShouldBeNil)
+			ct.TQ.Run(ctx, tqtesting.StopAfterTask(exportRunToBQTaskClass))
+			So(ct.BQFake.TotalSent(), ShouldEqual, 0)
 		})
 	})
 }
@@ -39,6 +39,7 @@ import (
 	"go.chromium.org/luci/cv/internal/rpc/versioning"
 	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/impl/state"
+	"go.chromium.org/luci/cv/internal/run/impl/submit"
 )
 
 // endRun sets Run to the provided status and populates `EndTime`.
@@ -78,6 +79,13 @@ func (impl *Impl) endRun(ctx context.Context, rs *state.RunState, st run.Status,
 		func(ctx context.Context) error {
 			return impl.removeRunFromCLs(ctx, rs.ID, rs.CLs)
 		},
+		func(ctx context.Context) error {
+			if cg.SubmitOptions.GetMergeQueue() == nil || !run.ShouldSubmit(&rs.Run) {
+				return nil
+			}
+			// Let the Runs behind this Run in the merge queue make progress.
+			return submit.LeaveMergeQueue(ctx, impl.RM.NotifyReadyForSubmission, rs.ID)
+		},
 		func(ctx context.Context) error {
 			txndefer.Defer(ctx, func(postTransCtx context.Context) {
 				logging.Infof(postTransCtx, "finalized Run with status %s", st)
@@ -0,0 +1,186 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+
+	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/run/impl/state"
+	"go.chromium.org/luci/cv/internal/run/impl/submit"
+	"go.chromium.org/luci/cv/internal/run/speculation"
+	"go.chromium.org/luci/cv/internal/tryjob"
+)
+
+const (
+	mergeQueueLogLabel = "Merge Queue"
+
+	mergeQueueRejectedMsgPrefix = "This CL has failed the verification in the merge queue together with the CLs that are submitted before it. Reason:\n\n"
+)
+
+// onSpeculationEnded is called when all Tryjobs of a Run in a project with
+// the merge queue have ended.
+//
+// If the Run has just passed its own Tryjobs, it joins the merge queue.
+// Otherwise, the result of the verification on top of the Runs ahead of it is
+// reported to the merge queue.
+func (impl *Impl) onSpeculationEnded(ctx context.Context, rs *state.RunState, opts *cfgpb.SubmitOptions, passed bool) (*Result, error) {
+	spec, err := submit.LoadSpeculation(ctx, rs.ID)
+	switch {
+	case err != nil:
+		return nil, err
+	case spec.Status == speculation.Verifying:
+		return impl.reportSpeculation(ctx, rs, passed)
+	case !passed:
+		// The result of a stale verification. The Run will be notified once it's
+		// its turn to be verified again.
+		return &Result{State: rs}, nil
+	}
+
+	var innerErr error
+	err = datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+		innerErr = submit.JoinMergeQueue(ctx, impl.RM.NotifyReadyForSubmission, rs.ID, opts)
+		return innerErr
+	}, nil)
+	switch {
+	case innerErr != nil:
+		return nil, innerErr
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to run the transaction to update the merge queue").Tag(transient.Tag).Err()
+	}
+	if spec.Status == speculation.NotInMergeQueue {
+		rs.LogInfo(ctx, mergeQueueLogLabel, "Tryjobs have passed. Waiting in the merge queue.")
+	}
+	return &Result{State: rs}, nil
+}
+
+// reportSpeculation reports the result of the verification of the Run to the
+// merge queue.
+//
+// No-op if the Run isn't verified on top of the same Runs in the merge queue
+// anymore.
+func (impl *Impl) reportSpeculation(ctx context.Context, rs *state.RunState, passed bool) (*Result, error) {
+	current := rs.Tryjobs.GetRequirement().GetSpeculation()
+	var stale bool
+	var innerErr error
+	err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+		spec, err := submit.LoadSpeculation(ctx, rs.ID)
+		switch {
+		case err != nil:
+			innerErr = err
+		case spec.Status != speculation.Verifying || !speculation.Equal(spec.Requirement(), current):
+			stale = true
+		default:
+			innerErr = submit.ReportSpeculation(ctx, impl.RM.NotifyReadyForSubmission, rs.ID, passed)
+		}
+		return innerErr
+	}, nil)
+	switch {
+	case innerErr != nil:
+		return nil, innerErr
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to run the transaction to update the merge queue").Tag(transient.Tag).Err()
+	}
+
+	switch {
+	case stale:
+	case passed:
+		rs.LogInfof(ctx, mergeQueueLogLabel, "Passed the verification on top of %d Run(s) ahead in the merge queue.", len(current.GetBaseRuns()))
+	default:
+		rs.LogInfof(ctx, mergeQueueLogLabel, "Failed the verification on top of %d Run(s) ahead in the merge queue.", len(current.GetBaseRuns()))
+	}
+	return &Result{State: rs}, nil
+}
+
+// checkMergeQueue handles the ReadyForSubmission event for a Run that may be
+// waiting in the merge queue.
+//
+// Returns nil Result if the Run isn't in the merge queue and should proceed
+// to the submission.
+func (impl *Impl) checkMergeQueue(ctx context.Context, rs *state.RunState) (*Result, error) {
+	spec, err := submit.LoadSpeculation(ctx, rs.ID)
+	if err != nil {
+		return nil, err
+	}
+	switch spec.Status {
+	case speculation.NotInMergeQueue:
+		return nil, nil
+	case speculation.Queued:
+		return &Result{State: rs}, nil
+	case speculation.Rejected:
+		return impl.onRejectedByMergeQueue(ctx, rs)
+	case speculation.Verifying:
+	default:
+		panic(fmt.Errorf("unknown speculation status %d", spec.Status))
+	}
+
+	target := spec.Requirement()
+	switch {
+	case speculation.Equal(rs.Tryjobs.GetRequirement().GetSpeculation(), target) &&
+		!hasExecuteTryjobLongOp(rs) &&
+		rs.Tryjobs.GetState().GetStatus() == tryjob.ExecutionState_SUCCEEDED &&
+		speculation.Equal(rs.Tryjobs.GetState().GetRequirement().GetSpeculation(), target):
+		// The Tryjobs have already passed on top of these Runs, e.g. the Run is
+		// at the front of the merge queue and has passed its own Tryjobs.
+		return impl.reportSpeculation(ctx, rs.ShallowCopy(), true)
+	case speculation.Equal(rs.Tryjobs.GetRequirement().GetSpeculation(), target):
+		// Already verifying.
+		return &Result{State: rs}, nil
+	case hasExecuteTryjobLongOp(rs):
+		// Start the verification after the current tryjob executor finishes
+		// running.
+		return &Result{State: rs, PreserveEvents: true}, nil
+	}
+	rs = rs.ShallowCopy()
+	// rs.Tryjobs MUST be non-nil now because Tryjobs have passed.
+	rs.Tryjobs = proto.Clone(rs.Tryjobs).(*run.Tryjobs)
+	if rs.Tryjobs.Requirement == nil {
+		rs.Tryjobs.Requirement = &tryjob.Requirement{}
+	}
+	rs.Tryjobs.Requirement.Speculation = target
+	rs.Tryjobs.RequirementVersion += 1
+	rs.Tryjobs.RequirementComputedAt = timestamppb.New(clock.Now(ctx).UTC())
+	enqueueRequirementChangedTask(ctx, rs)
+	rs.LogInfof(ctx, mergeQueueLogLabel, "Verifying on top of %d Run(s) ahead in the merge queue.", len(target.GetBaseRuns()))
+	return &Result{State: rs}, nil
+}
+
+// onRejectedByMergeQueue fails the Run rejected by the merge queue.
+func (impl *Impl) onRejectedByMergeQueue(ctx context.Context, rs *state.RunState) (*Result, error) {
+	if isCurrentlyResettingTriggers(rs) {
+		return &Result{State: rs}, nil
+	}
+	cls, err := LoadCLs(ctx, rs.ID, rs.CLs)
+	if err != nil {
+		return nil, err
+	}
+	rs = rs.ShallowCopy()
+	msgTmpl := mergeQueueRejectedMsgPrefix + rs.Tryjobs.GetState().GetFailureReasonTmpl()
+	metas := makeTmplReviewInputMetas(ctx, rs, cls, msgTmpl, "Merge queue verification failed")
+	scheduleTriggersReset(ctx, rs, metas, run.Status_FAILED)
+	rs.LogInfo(ctx, mergeQueueLogLabel, "Rejected by the merge queue.")
+	return &Result{State: rs}, nil
+}
@@ -174,11 +174,12 @@ func (impl *Impl) Start(ctx context.Context, rs *state.RunState) (*Result, error
 	}
 
 	switch result, err := requirement.Compute(ctx, requirement.Input{
-		ConfigGroup: cg.Content,
-		RunOwner:    rs.Owner,
-		CLs:         runCLs,
-		RunOptions:  rs.Options,
-		RunMode:     rs.Mode,
+		ConfigGroup:       cg.Content,
+		RunOwner:          rs.Owner,
+		CLs:               runCLs,
+		RunOptions:        rs.Options,
+		RunMode:           rs.Mode,
+		RunModeDefinition: rs.ModeDefinition,
 	}); {
 	case err != nil:
 		return nil, err
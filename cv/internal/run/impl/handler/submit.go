@@ -71,7 +71,14 @@ func (impl *Impl) OnReadyForSubmission(ctx context.Context, rs *state.RunState)
 		logging.Debugf(ctx, "received ReadyForSubmission event when Run is submitting")
 		return &Result{State: rs}, nil
 	case status == run.Status_RUNNING:
-		// This may happen when this Run transitioned from RUNNING status to
+		// The Run may be waiting or being verified in the merge queue.
+		switch res, err := impl.checkMergeQueue(ctx, rs); {
+		case err != nil:
+			return nil, err
+		case res != nil:
+			return res, nil
+		}
+		// This may also happen when this Run transitioned from RUNNING status to
 		// WAITING_FOR_SUBMISSION, prepared for submission but failed to
 		// save the state transition. This Run is receiving this event because
 		// of the fail-safe task sent while acquiring the Submit Queue. CV should
@@ -29,7 +29,10 @@ import (
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+
 	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/eventpb"
 	"go.chromium.org/luci/cv/internal/run/impl/state"
@@ -118,7 +121,18 @@ func (impl *Impl) onCompletedExecuteTryjobs(ctx context.Context, rs *state.RunSt
 				rs.Tryjobs = proto.Clone(rs.Tryjobs).(*run.Tryjobs)
 			}
 			rs.Tryjobs.State = es // Copy the execution state to Run entity
+			var submitOpts *cfgpb.SubmitOptions
+			if run.ShouldSubmit(&rs.Run) {
+				cg, err := prjcfg.GetConfigGroup(ctx, rs.ID.LUCIProject(), rs.ConfigGroupID)
+				if err != nil {
+					return nil, err
+				}
+				submitOpts = cg.SubmitOptions
+			}
+			mergeQueue := submitOpts.GetMergeQueue()
 			switch executionStatus := es.GetStatus(); {
+			case executionStatus == tryjob.ExecutionState_SUCCEEDED && mergeQueue != nil:
+				return impl.onSpeculationEnded(ctx, rs, submitOpts, true)
 			case executionStatus == tryjob.ExecutionState_SUCCEEDED && run.ShouldSubmit(&rs.Run):
 				rs.Status = run.Status_WAITING_FOR_SUBMISSION
 				return impl.OnReadyForSubmission(ctx, rs)
@@ -127,6 +141,10 @@ func (impl *Impl) onCompletedExecuteTryjobs(ctx context.Context, rs *state.RunSt
 				if rs.Mode != run.NewPatchsetRun {
 					msgTmpl = "This CL has passed the run"
 				}
+			case executionStatus == tryjob.ExecutionState_FAILED && mergeQueue != nil && rs.Tryjobs.GetRequirement().GetSpeculation() != nil:
+				// The failure may be caused by the Runs ahead in the merge queue.
+				// The Run fails only once the merge queue rejects it.
+				return impl.onSpeculationEnded(ctx, rs, submitOpts, false)
 			case executionStatus == tryjob.ExecutionState_FAILED:
 				runStatus = run.Status_FAILED
 				msgTmpl = "This CL has failed the run. Reason:\n\n" + es.FailureReasonTmpl
@@ -148,43 +166,49 @@ func (impl *Impl) onCompletedExecuteTryjobs(ctx context.Context, rs *state.RunSt
 		if err != nil {
 			return nil, err
 		}
+		scheduleTriggersReset(ctx, rs, makeTmplReviewInputMetas(ctx, rs, cls, msgTmpl, attentionReason), runStatus)
+	}
+
+	return &Result{
+		State: rs,
+	}, nil
+}
 
-		metas := make(map[common.CLID]reviewInputMeta, len(rs.CLs))
-		for _, cl := range cls {
-			var meta reviewInputMeta
-			if msgTmpl != "" {
-				whoms := rs.Mode.GerritNotifyTargets()
-				var msg string
-				switch t, err := template.New("FailureReason").Parse(msgTmpl); {
-				case err != nil:
-					logging.Errorf(ctx, "CL %d: failed to parse FailureReason template %q: %s", cl.ID, msgTmpl, err)
+// makeTmplReviewInputMetas renders the message template for each CL.
+//
+// Returns metas without a message if msgTmpl is empty.
+func makeTmplReviewInputMetas(ctx context.Context, rs *state.RunState, cls []*run.RunCL, msgTmpl, attentionReason string) map[common.CLID]reviewInputMeta {
+	metas := make(map[common.CLID]reviewInputMeta, len(cls))
+	for _, cl := range cls {
+		var meta reviewInputMeta
+		if msgTmpl != "" {
+			whoms := rs.Mode.GerritNotifyTargets()
+			var msg string
+			switch t, err := template.New("FailureReason").Parse(msgTmpl); {
+			case err != nil:
+				logging.Errorf(ctx, "CL %d: failed to parse FailureReason template %q: %s", cl.ID, msgTmpl, err)
+				msg = "This CL has failed the run. LUCI CV is having trouble with generating the reason. Please visit the check result tab for the failed Tryjobs."
+			default:
+				msgBuf := bytes.Buffer{}
+				if err := t.Execute(&msgBuf, tmplInfo{cl: cl}); err != nil {
+					logging.Errorf(ctx, "CL %d: failed to execute FailureReason template: %q: %s", cl.ID, msgTmpl, err)
 					msg = "This CL has failed the run. LUCI CV is having trouble with generating the reason. Please visit the check result tab for the failed Tryjobs."
-				default:
-					msgBuf := bytes.Buffer{}
-					if err := t.Execute(&msgBuf, tmplInfo{cl: cl}); err != nil {
-						logging.Errorf(ctx, "CL %d: failed to execute FailureReason template: %q: %s", cl.ID, msgTmpl, err)
-						msg = "This CL has failed the run. LUCI CV is having trouble with generating the reason. Please visit the check result tab for the failed Tryjobs."
-						//return nil, errors.Annotate(err, "failed to execute FailureReason template").Err()
-					}
-					msg = msgBuf.String()
-				}
-				meta = reviewInputMeta{
-					message: msg,
-					notify:  whoms,
-				}
-				if attentionReason != "" {
-					meta.addToAttention = whoms
-					meta.reason = attentionReason
+					//return nil, errors.Annotate(err, "failed to execute FailureReason template").Err()
 				}
+				msg = msgBuf.String()
+			}
+			meta = reviewInputMeta{
+				message: msg,
+				notify:  whoms,
+			}
+			if attentionReason != "" {
+				meta.addToAttention = whoms
+				meta.reason = attentionReason
 			}
-			metas[cl.ID] = meta
 		}
-		scheduleTriggersReset(ctx, rs, metas, runStatus)
+		metas[cl.ID] = meta
 	}
-
-	return &Result{
-		State: rs,
-	}, nil
+	return metas
 }
 
 func hasExecuteTryjobLongOp(rs *state.RunState) bool {
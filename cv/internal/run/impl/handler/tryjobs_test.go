@@ -38,6 +38,7 @@ import (
 	"go.chromium.org/luci/cv/internal/run/eventpb"
 	"go.chromium.org/luci/cv/internal/run/impl/state"
 	"go.chromium.org/luci/cv/internal/run/impl/submit"
+	"go.chromium.org/luci/cv/internal/run/speculation"
 	"go.chromium.org/luci/cv/internal/tryjob"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -244,6 +245,86 @@ func TestOnCompletedExecuteTryjobs(t *testing.T) {
 					So(res.SideEffectFn, ShouldBeNil)
 					So(res.PreserveEvents, ShouldBeFalse)
 				})
+				Convey("Full Run with merge queue", func() {
+					prjcfgtest.Update(ctx, lProject, &cfgpb.Config{
+						SubmitOptions: &cfgpb.SubmitOptions{
+							MergeQueue: &cfgpb.MergeQueue{MaxBatchSize: 2},
+						},
+						ConfigGroups: []*cfgpb.ConfigGroup{{Name: "single"}},
+					})
+					rs.ConfigGroupID = prjcfgtest.MustExist(ctx, lProject).ConfigGroupIDs[0]
+					rs.Mode = run.FullRun
+					rs.Tryjobs = &run.Tryjobs{
+						Requirement:        &tryjob.Requirement{},
+						RequirementVersion: 1,
+					}
+					res, err := h.OnLongOpCompleted(ctx, rs, result)
+					So(err, ShouldBeNil)
+					So(res.State.Status, ShouldEqual, run.Status_RUNNING)
+					So(res.State.OngoingLongOps, ShouldBeNil)
+					spec, err := submit.LoadSpeculation(ctx, rs.ID)
+					So(err, ShouldBeNil)
+					So(spec.Status, ShouldEqual, speculation.Verifying)
+
+					Convey("passes right away at the front of the merge queue", func() {
+						res, err := h.OnReadyForSubmission(ctx, res.State)
+						So(err, ShouldBeNil)
+						So(res.State.Status, ShouldEqual, run.Status_RUNNING)
+						// The Tryjobs have already verified the Run on its own.
+						So(res.State.Tryjobs.GetRequirement().GetSpeculation(), ShouldBeNil)
+						So(res.State.Tryjobs.GetRequirementVersion(), ShouldEqual, 1)
+						So(res.State.OngoingLongOps, ShouldBeNil)
+						spec, err := submit.LoadSpeculation(ctx, rs.ID)
+						So(err, ShouldBeNil)
+						So(spec.Status, ShouldEqual, speculation.NotInMergeQueue)
+
+						ctx = context.WithValue(ctx, &fakeTaskIDKey, "task-foo")
+						res, err = h.OnReadyForSubmission(ctx, res.State)
+						So(err, ShouldBeNil)
+						So(res.State.Status, ShouldEqual, run.Status_SUBMITTING)
+					})
+
+					Convey("submits once the verification passes", func() {
+						rs.Tryjobs.Requirement.Speculation = &tryjob.Requirement_Speculation{}
+						res, err := h.OnLongOpCompleted(ctx, rs, result)
+						So(err, ShouldBeNil)
+						So(res.State.Status, ShouldEqual, run.Status_RUNNING)
+						spec, err := submit.LoadSpeculation(ctx, rs.ID)
+						So(err, ShouldBeNil)
+						So(spec.Status, ShouldEqual, speculation.NotInMergeQueue)
+
+						ctx = context.WithValue(ctx, &fakeTaskIDKey, "task-foo")
+						res, err = h.OnReadyForSubmission(ctx, res.State)
+						So(err, ShouldBeNil)
+						So(res.State.Status, ShouldEqual, run.Status_SUBMITTING)
+						So(submit.MustCurrentRun(ctx, lProject), ShouldEqual, rs.ID)
+					})
+
+					Convey("is rejected once the verification fails", func() {
+						So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+							return tryjob.SaveExecutionState(ctx, rs.ID, &tryjob.ExecutionState{
+								Status:            tryjob.ExecutionState_FAILED,
+								FailureReasonTmpl: "builder failed",
+							}, 1, nil)
+						}, nil), ShouldBeNil)
+						rs.Tryjobs.Requirement.Speculation = &tryjob.Requirement_Speculation{}
+						res, err := h.OnLongOpCompleted(ctx, rs, result)
+						So(err, ShouldBeNil)
+						So(res.State.Status, ShouldEqual, run.Status_RUNNING)
+						So(res.State.OngoingLongOps, ShouldBeNil)
+						spec, err := submit.LoadSpeculation(ctx, rs.ID)
+						So(err, ShouldBeNil)
+						So(spec.Status, ShouldEqual, speculation.Rejected)
+
+						res, err = h.OnReadyForSubmission(ctx, res.State)
+						So(err, ShouldBeNil)
+						So(res.State.OngoingLongOps.GetOps(), ShouldHaveLength, 1)
+						for _, op := range res.State.OngoingLongOps.GetOps() {
+							So(op.GetResetTriggers().GetRunStatusIfSucceeded(), ShouldEqual, run.Status_FAILED)
+							So(op.GetResetTriggers().GetRequests()[0].GetMessage(), ShouldEqual, mergeQueueRejectedMsgPrefix+"builder failed")
+						}
+					})
+				})
 				Convey("Dry Run", func() {
 					rs.Mode = run.DryRun
 					res, err := h.OnLongOpCompleted(ctx, rs, result)
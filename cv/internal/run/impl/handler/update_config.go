@@ -134,15 +134,22 @@ func (impl *Impl) UpdateConfig(ctx context.Context, rs *state.RunState, hash str
 			},
 		})
 
-		switch result, err := requirement.Compute(ctx, requirement.Input{
-			ConfigGroup: cgsMap[rs.ConfigGroupID.Name()].Content,
-			RunOwner:    rs.Owner,
-			CLs:         runCLs,
-			RunOptions:  rs.Options,
-			RunMode:     rs.Mode,
-		}); {
-		case err != nil:
+		result, err := requirement.Compute(ctx, requirement.Input{
+			ConfigGroup:       cgsMap[rs.ConfigGroupID.Name()].Content,
+			RunOwner:          rs.Owner,
+			CLs:               runCLs,
+			RunOptions:        rs.Options,
+			RunMode:           rs.Mode,
+			RunModeDefinition: rs.ModeDefinition,
+		})
+		if err != nil {
 			return nil, err
+		}
+		if result.OK() {
+			// Keep verifying the Run on top of the same Runs in the merge queue.
+			result.Requirement.Speculation = rs.Tryjobs.GetRequirement().GetSpeculation()
+		}
+		switch {
 		case !result.OK():
 			whoms := rs.Mode.GerritNotifyTargets()
 			meta := reviewInputMeta{
@@ -0,0 +1,253 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submit
+
+import (
+	"context"
+	"fmt"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/run/speculation"
+)
+
+// The merge queue verifies Runs that are ready for submission on top of each
+// other before they join the waitlist.
+//
+// Runs join the back of the merge queue. At any time, exactly one Run in the
+// merge queue, the probe, is verified with its CLs stacked on top of CLs of
+// all Runs ahead of it. Normally, the probe is the last Run of the batch at
+// the front of the queue, so the whole batch is verified at once. If the
+// probe passes, it and all Runs ahead of it join the waitlist together and are
+// submitted in order. If the probe fails, the failing prefix of the queue is
+// bisected until the first Run that breaks it is found. That Run is rejected
+// and the Runs ahead of it, having passed, are submitted.
+
+// LoadSpeculation returns the position of the provided Run in the merge queue.
+func LoadSpeculation(ctx context.Context, runID common.RunID) (speculation.Speculation, error) {
+	q := &queue{ID: runID.LUCIProject()}
+	switch err := datastore.Get(ctx, q); {
+	case err == datastore.ErrNoSuchEntity:
+		return speculation.Speculation{Status: speculation.NotInMergeQueue}, nil
+	case err != nil:
+		return speculation.Speculation{}, errors.Annotate(err, "failed to load SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	}
+	return q.speculation(runID), nil
+}
+
+func (q *queue) speculation(runID common.RunID) speculation.Speculation {
+	switch idx := q.MergeQueue.Index(runID); {
+	case q.Rejected.Index(runID) != -1:
+		return speculation.Speculation{Status: speculation.Rejected}
+	case idx == -1:
+		return speculation.Speculation{Status: speculation.NotInMergeQueue}
+	case q.Probe == runID:
+		return speculation.Speculation{
+			Status: speculation.Verifying,
+			Base:   append(common.RunIDs(nil), q.MergeQueue[:idx]...),
+		}
+	default:
+		return speculation.Speculation{Status: speculation.Queued}
+	}
+}
+
+// JoinMergeQueue adds the Run to the back of the merge queue.
+//
+// If the merge queue was empty, the Run becomes the probe and is notified
+// right away. Otherwise, it will be notified once it becomes the probe or gets
+// rejected.
+//
+// No-op if the Run is already in the merge queue, the waitlist or is being
+// submitted.
+//
+// MUST be called in a datastore transaction.
+func JoinMergeQueue(ctx context.Context, notifyFn NotifyFn, runID common.RunID, opts *cfgpb.SubmitOptions) error {
+	switch {
+	case datastore.CurrentTransaction(ctx) == nil:
+		panic("JoinMergeQueue must be called in a datastore transaction")
+	case opts.GetMergeQueue() == nil:
+		panic(fmt.Errorf("merge queue is not enabled for %q", runID.LUCIProject()))
+	}
+
+	q := &queue{ID: runID.LUCIProject()}
+	switch err := datastore.Get(ctx, q); {
+	case err == datastore.ErrNoSuchEntity:
+	case err != nil:
+		return errors.Annotate(err, "failed to load SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	}
+	q.Opts = opts
+	switch {
+	case q.Current == runID, q.Waitlist.Index(runID) != -1, q.MergeQueue.Index(runID) != -1, q.Rejected.Index(runID) != -1:
+		return nil
+	}
+	q.MergeQueue = append(q.MergeQueue, runID)
+	if q.Probe == "" {
+		if err := q.pickProbe(ctx, notifyFn); err != nil {
+			return err
+		}
+	}
+	return saveQueue(ctx, q)
+}
+
+// ReportSpeculation records the result of the verification of the probe.
+//
+// If the probe has passed, the probe and all Runs ahead of it are moved to
+// the waitlist. Otherwise, the merge queue is bisected. The next probe and
+// the rejected Run, if any, are notified.
+//
+// No-op if the provided Run is not the current probe, e.g. because the Runs
+// ahead of it have changed since the verification started.
+//
+// MUST be called in a datastore transaction.
+func ReportSpeculation(ctx context.Context, notifyFn NotifyFn, runID common.RunID, passed bool) error {
+	if datastore.CurrentTransaction(ctx) == nil {
+		panic("ReportSpeculation must be called in a datastore transaction")
+	}
+	q, err := loadQueue(ctx, runID.LUCIProject())
+	if err != nil {
+		return err
+	}
+	if q.Probe != runID {
+		logging.Warningf(ctx, "%q reported the speculation result, but it's not the current probe (%q)", runID, q.Probe)
+		return nil
+	}
+
+	idx := q.MergeQueue.Index(runID)
+	switch {
+	case passed:
+		wasIdle := q.Current == "" && len(q.Waitlist) == 0
+		q.Waitlist = append(q.Waitlist, q.MergeQueue[:idx+1]...)
+		q.MergeQueue = append(common.RunIDs(nil), q.MergeQueue[idx+1:]...)
+		if q.FailingPrefix > 0 {
+			q.FailingPrefix -= idx + 1
+		}
+		if wasIdle {
+			if err := notifyFn(ctx, q.Waitlist[0], q.nextSubmissionETA(clock.Now(ctx))); err != nil {
+				return err
+			}
+		}
+	default:
+		q.FailingPrefix = idx + 1
+	}
+	if err := q.pickProbe(ctx, notifyFn); err != nil {
+		return err
+	}
+	return saveQueue(ctx, q)
+}
+
+// LeaveMergeQueue removes the Run from the merge queue.
+//
+// If the Run was the probe or ahead of it, the verification restarts with a
+// new probe. No-op if the Run is not in the merge queue.
+//
+// MUST be called in a datastore transaction.
+func LeaveMergeQueue(ctx context.Context, notifyFn NotifyFn, runID common.RunID) error {
+	if datastore.CurrentTransaction(ctx) == nil {
+		panic("LeaveMergeQueue must be called in a datastore transaction")
+	}
+	q := &queue{ID: runID.LUCIProject()}
+	switch err := datastore.Get(ctx, q); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil
+	case err != nil:
+		return errors.Annotate(err, "failed to load SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	}
+	switch changed, err := q.leaveMergeQueue(ctx, notifyFn, runID); {
+	case err != nil:
+		return err
+	case changed:
+		return saveQueue(ctx, q)
+	default:
+		return nil
+	}
+}
+
+func (q *queue) leaveMergeQueue(ctx context.Context, notifyFn NotifyFn, runID common.RunID) (changed bool, err error) {
+	if idx := q.Rejected.Index(runID); idx != -1 {
+		q.Rejected = append(q.Rejected[:idx], q.Rejected[idx+1:]...)
+		return true, nil
+	}
+	idx := q.MergeQueue.Index(runID)
+	if idx == -1 {
+		return false, nil
+	}
+	probeIdx := q.MergeQueue.Index(q.Probe)
+	q.MergeQueue = append(q.MergeQueue[:idx], q.MergeQueue[idx+1:]...)
+	if idx < q.FailingPrefix {
+		// The Run may have been the one breaking the prefix.
+		q.FailingPrefix = 0
+	}
+	if probeIdx != -1 && idx > probeIdx {
+		// The ongoing verification isn't affected.
+		return true, nil
+	}
+	return true, q.pickProbe(ctx, notifyFn)
+}
+
+// pickProbe picks the next Run to verify and notifies it.
+//
+// If the first Run in the merge queue is known to fail on its own, rejects
+// it and notifies it first.
+func (q *queue) pickProbe(ctx context.Context, notifyFn NotifyFn) error {
+	now := clock.Now(ctx)
+	if q.FailingPrefix == 1 {
+		culprit := q.MergeQueue[0]
+		q.MergeQueue = append(common.RunIDs(nil), q.MergeQueue[1:]...)
+		q.Rejected = append(q.Rejected, culprit)
+		q.FailingPrefix = 0
+		if err := notifyFn(ctx, culprit, now); err != nil {
+			return err
+		}
+	}
+
+	switch n := len(q.MergeQueue); {
+	case n == 0:
+		q.Probe = ""
+		return nil
+	case q.FailingPrefix > 1:
+		// Bisect the failing prefix.
+		q.Probe = q.MergeQueue[q.FailingPrefix/2-1]
+	default:
+		batch := int(q.Opts.GetMergeQueue().GetMaxBatchSize())
+		if batch <= 0 || batch > n {
+			batch = n
+		}
+		q.Probe = q.MergeQueue[batch-1]
+	}
+	return notifyFn(ctx, q.Probe, now)
+}
+
+// flushMergeQueue moves all Runs waiting in the merge queue to the waitlist.
+//
+// Used when the merge queue gets disabled.
+func (q *queue) flushMergeQueue() {
+	q.Waitlist = append(q.Waitlist, q.MergeQueue...)
+	q.MergeQueue = nil
+	q.Probe = ""
+	q.FailingPrefix = 0
+}
+
+func saveQueue(ctx context.Context, q *queue) error {
+	if err := datastore.Put(ctx, q); err != nil {
+		return errors.Annotate(err, "failed to put SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	}
+	return nil
+}
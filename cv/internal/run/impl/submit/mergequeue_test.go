@@ -0,0 +1,243 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/cvtesting"
+	"go.chromium.org/luci/cv/internal/run/speculation"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMergeQueue(t *testing.T) {
+	t.Parallel()
+
+	Convey("MergeQueue", t, func() {
+		ct := cvtesting.Test{}
+		ctx, cancel := ct.SetUp(t)
+		defer cancel()
+
+		notifier := &fakeNotifier{}
+		const lProject = "lProject"
+		runs := make(common.RunIDs, 6)
+		for i := range runs {
+			runs[i] = common.MakeRunID(lProject, clock.Now(ctx), 1, []byte(fmt.Sprintf("run%d", i)))
+		}
+		opts := &cfgpb.SubmitOptions{
+			MergeQueue: &cfgpb.MergeQueue{MaxBatchSize: 4},
+		}
+
+		inTxn := func(cb func(ctx context.Context) error) {
+			var innerErr error
+			err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+				innerErr = cb(ctx)
+				return innerErr
+			}, nil)
+			So(innerErr, ShouldBeNil)
+			So(err, ShouldBeNil)
+		}
+		join := func(runIDs ...common.RunID) {
+			for _, runID := range runIDs {
+				inTxn(func(ctx context.Context) error {
+					return JoinMergeQueue(ctx, notifier.notify, runID, opts)
+				})
+			}
+		}
+		report := func(runID common.RunID, passed bool) {
+			inTxn(func(ctx context.Context) error {
+				return ReportSpeculation(ctx, notifier.notify, runID, passed)
+			})
+		}
+		mustLoadQueue := func() *queue {
+			q, err := loadQueue(ctx, lProject)
+			So(err, ShouldBeNil)
+			return q
+		}
+		mustLoadSpeculation := func(runID common.RunID) speculation.Speculation {
+			s, err := LoadSpeculation(ctx, runID)
+			So(err, ShouldBeNil)
+			return s
+		}
+
+		Convey("First Run is verified right away", func() {
+			join(runs[0])
+			So(mustLoadSpeculation(runs[0]), ShouldResemble, speculation.Speculation{
+				Status: speculation.Verifying,
+			})
+			So(notifier.notifyETAs(ctx, runs[0]), ShouldHaveLength, 1)
+
+			Convey("Others wait", func() {
+				join(runs[1])
+				So(mustLoadSpeculation(runs[1]), ShouldResemble, speculation.Speculation{Status: speculation.Queued})
+				So(notifier.notifyETAs(ctx, runs[1]), ShouldBeEmpty)
+			})
+
+			Convey("Leaving picks a probe if there's none", func() {
+				join(runs[1], runs[2])
+				q := mustLoadQueue()
+				q.Probe = ""
+				So(datastore.Put(ctx, q), ShouldBeNil)
+
+				inTxn(func(ctx context.Context) error {
+					return LeaveMergeQueue(ctx, notifier.notify, runs[2])
+				})
+				So(mustLoadQueue().Probe, ShouldEqual, runs[1])
+				So(notifier.notifyETAs(ctx, runs[1]), ShouldHaveLength, 1)
+			})
+
+			Convey("Joining twice is noop", func() {
+				join(runs[0])
+				So(mustLoadQueue().MergeQueue, ShouldResemble, common.RunIDs{runs[0]})
+			})
+
+			Convey("Passed Run joins the waitlist", func() {
+				report(runs[0], true)
+				q := mustLoadQueue()
+				So(q.MergeQueue, ShouldBeEmpty)
+				So(q.Probe, ShouldBeEmpty)
+				So(q.Waitlist, ShouldResemble, common.RunIDs{runs[0]})
+				So(mustLoadSpeculation(runs[0]), ShouldResemble, speculation.Speculation{Status: speculation.NotInMergeQueue})
+				So(notifier.notifyETAs(ctx, runs[0]), ShouldHaveLength, 2)
+
+				Convey("and can acquire the submit queue", func() {
+					var waitlisted bool
+					inTxn(func(ctx context.Context) (err error) {
+						waitlisted, err = TryAcquire(ctx, notifier.notify, runs[0], opts)
+						return
+					})
+					So(waitlisted, ShouldBeFalse)
+					So(mustLoadQueue().Current, ShouldEqual, runs[0])
+				})
+			})
+
+			Convey("Failed Run is rejected", func() {
+				report(runs[0], false)
+				q := mustLoadQueue()
+				So(q.MergeQueue, ShouldBeEmpty)
+				So(q.Rejected, ShouldResemble, common.RunIDs{runs[0]})
+				So(mustLoadSpeculation(runs[0]), ShouldResemble, speculation.Speculation{Status: speculation.Rejected})
+				So(notifier.notifyETAs(ctx, runs[0]), ShouldHaveLength, 2)
+
+				inTxn(func(ctx context.Context) error {
+					return LeaveMergeQueue(ctx, notifier.notify, runs[0])
+				})
+				So(mustLoadQueue().Rejected, ShouldBeEmpty)
+			})
+		})
+
+		Convey("Batch", func() {
+			// runs[0] is verified alone. Once it passes, the batch of the next 4
+			// Runs is verified.
+			join(runs...)
+			report(runs[0], true)
+			So(mustLoadQueue().Probe, ShouldEqual, runs[4])
+			So(mustLoadSpeculation(runs[4]), ShouldResemble, speculation.Speculation{
+				Status: speculation.Verifying,
+				Base:   common.RunIDs{runs[1], runs[2], runs[3]},
+			})
+
+			Convey("passes", func() {
+				report(runs[4], true)
+				q := mustLoadQueue()
+				So(q.Waitlist, ShouldResemble, runs[:5])
+				So(q.MergeQueue, ShouldResemble, common.RunIDs{runs[5]})
+				So(q.Probe, ShouldEqual, runs[5])
+			})
+
+			Convey("bisects to find the culprit", func() {
+				report(runs[4], false)
+				// Verifies runs[1..2].
+				So(mustLoadQueue().Probe, ShouldEqual, runs[2])
+				report(runs[2], true)
+				q := mustLoadQueue()
+				So(q.Waitlist, ShouldResemble, runs[:3])
+				// Verifies runs[3].
+				So(q.Probe, ShouldEqual, runs[3])
+				So(mustLoadSpeculation(runs[3]), ShouldResemble, speculation.Speculation{
+					Status: speculation.Verifying,
+				})
+				report(runs[3], false)
+				q = mustLoadQueue()
+				So(q.Rejected, ShouldResemble, common.RunIDs{runs[3]})
+				So(notifier.notifyETAs(ctx, runs[3]), ShouldHaveLength, 2)
+				// The rest of the queue is verified again without the culprit.
+				So(q.MergeQueue, ShouldResemble, common.RunIDs{runs[4], runs[5]})
+				So(q.Probe, ShouldEqual, runs[5])
+				So(q.FailingPrefix, ShouldEqual, 0)
+			})
+
+			Convey("rejects the Run after a passing prefix", func() {
+				report(runs[4], false)
+				report(runs[2], true)
+				report(runs[3], true)
+				q := mustLoadQueue()
+				So(q.Waitlist, ShouldResemble, runs[:4])
+				So(q.Rejected, ShouldResemble, common.RunIDs{runs[4]})
+				So(q.MergeQueue, ShouldResemble, common.RunIDs{runs[5]})
+				So(q.Probe, ShouldEqual, runs[5])
+			})
+
+			Convey("ignores stale reports", func() {
+				report(runs[2], false)
+				So(mustLoadQueue().Probe, ShouldEqual, runs[4])
+				So(mustLoadQueue().FailingPrefix, ShouldEqual, 0)
+			})
+
+			Convey("restarts if a Run ahead of the probe leaves", func() {
+				inTxn(func(ctx context.Context) error {
+					return Release(ctx, notifier.notify, runs[2])
+				})
+				q := mustLoadQueue()
+				So(q.MergeQueue, ShouldResemble, common.RunIDs{runs[1], runs[3], runs[4], runs[5]})
+				So(q.Probe, ShouldEqual, runs[5])
+				So(mustLoadSpeculation(runs[5]), ShouldResemble, speculation.Speculation{
+					Status: speculation.Verifying,
+					Base:   common.RunIDs{runs[1], runs[3], runs[4]},
+				})
+			})
+
+			Convey("continues if a Run behind the probe leaves", func() {
+				inTxn(func(ctx context.Context) error {
+					return LeaveMergeQueue(ctx, notifier.notify, runs[5])
+				})
+				q := mustLoadQueue()
+				So(q.MergeQueue, ShouldResemble, runs[1:5])
+				So(q.Probe, ShouldEqual, runs[4])
+				So(notifier.notifyETAs(ctx, runs[4]), ShouldHaveLength, 1)
+			})
+
+			Convey("flushes to the waitlist once disabled", func() {
+				inTxn(func(ctx context.Context) error {
+					_, err := TryAcquire(ctx, notifier.notify, runs[0], nil)
+					return err
+				})
+				q := mustLoadQueue()
+				So(q.Current, ShouldEqual, runs[0])
+				So(q.Waitlist, ShouldResemble, runs[1:])
+				So(q.MergeQueue, ShouldBeEmpty)
+				So(q.Probe, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -50,6 +50,25 @@ type queue struct {
 	//
 	// Sorted in ascending order.
 	History []time.Time `gae:",noindex"`
+
+	// MergeQueue contains Runs waiting to be verified together with the Runs
+	// ahead of them before joining the waitlist.
+	//
+	// Only used if `Opts.MergeQueue` is set. See mergequeue.go.
+	MergeQueue common.RunIDs `gae:",noindex"`
+	// Probe is the Run in the MergeQueue that is currently being verified on
+	// top of all Runs ahead of it.
+	//
+	// Empty if MergeQueue is empty.
+	Probe common.RunID `gae:",noindex"`
+	// FailingPrefix is the number of Runs at the front of the MergeQueue which
+	// are known to fail the verification together.
+	//
+	// 0 if no such failure is known.
+	FailingPrefix int `gae:",noindex"`
+	// Rejected contains Runs that have failed the verification in the merge
+	// queue and must not be submitted.
+	Rejected common.RunIDs `gae:",noindex"`
 }
 
 // nextSubmissionETA computes the eta of when next submission can happen based
@@ -135,6 +154,11 @@ func tryAcquire(ctx context.Context, notifyFn NotifyFn, runID common.RunID, opts
 		q.Opts = opts
 		shouldSave = true
 	}
+	if opts.GetMergeQueue() == nil && len(q.MergeQueue) > 0 {
+		// The merge queue has been disabled. Don't hold the Runs anymore.
+		q.flushMergeQueue()
+		shouldSave = true
+	}
 
 	switch waitlistIdx := q.Waitlist.Index(runID); {
 	case q.Current == runID:
@@ -233,6 +257,10 @@ func release(ctx context.Context, notifyFn NotifyFn, runID common.RunID, submitt
 				q.History = q.History[1:]
 			}
 		}
+	case q.MergeQueue.Index(runID) != -1 || q.Rejected.Index(runID) != -1:
+		if _, err := q.leaveMergeQueue(ctx, notifyFn, runID); err != nil {
+			return err
+		}
 	default:
 		if !submittedAt.IsZero() {
 			logging.Warningf(ctx, "%q has submitted at %s, but it's no longer current (%q)", runID, submittedAt, q.Current)
@@ -240,8 +268,8 @@ func release(ctx context.Context, notifyFn NotifyFn, runID common.RunID, submitt
 		return nil
 	}
 
-	if err := datastore.Put(ctx, q); err != nil {
-		return errors.Annotate(err, "failed to put SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	if err := saveQueue(ctx, q); err != nil {
+		return err
 	}
 
 	if q.Current == "" && len(q.Waitlist) > 0 {
@@ -23,9 +23,11 @@ import (
 
 // Mode dictates the behavior of this Run.
 //
-// The end goal is to have arbitrary user-defined Mode names.
-// For now, CQDaemon/LUCI CV operates with just 3 pre-defined modes,
-// whose values are fixed based on legacy CQ BQ export.
+// Besides the 3 pre-defined modes below, whose values are fixed based on
+// legacy CQ BQ export, projects may define additional modes in their config
+// (see cfgpb.Mode). Such modes behave like DryRun or FullRun depending on the
+// Commit-Queue vote value they are bound to, but may trigger their own set of
+// tryjobs and have their own post actions.
 type Mode string
 
 const (
@@ -0,0 +1,114 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package speculation describes verification of Runs in the merge queue on
+// top of the Runs ahead of them.
+//
+// It is shared by the merge queue, the Run handler and the Tryjob executor.
+package speculation
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/tryjob"
+)
+
+// Status is the status of a Run in the merge queue.
+type Status int
+
+const (
+	// NotInMergeQueue means that the Run is not in the merge queue.
+	//
+	// Either it has never joined the merge queue or it has passed the
+	// verification and joined the waitlist.
+	NotInMergeQueue Status = iota
+	// Queued means that the Run waits in the merge queue for its turn to be
+	// verified.
+	Queued
+	// Verifying means that the Run should be verified on top of the base Runs.
+	Verifying
+	// Rejected means that the Run has failed the verification and must not be
+	// submitted.
+	Rejected
+)
+
+// Speculation describes the position of a Run in the merge queue.
+type Speculation struct {
+	Status Status
+	// Base contains Runs ahead of this Run in the merge queue in the order of
+	// submission.
+	//
+	// Set only if Status is Verifying.
+	Base common.RunIDs
+}
+
+// Requirement returns the Tryjob requirement to verify the Run with.
+//
+// Returns nil if the Run doesn't have to be verified on top of other Runs.
+func (s Speculation) Requirement() *tryjob.Requirement_Speculation {
+	if s.Status != Verifying || len(s.Base) == 0 {
+		return nil
+	}
+	ret := &tryjob.Requirement_Speculation{}
+	for _, id := range s.Base {
+		ret.BaseRuns = append(ret.BaseRuns, string(id))
+	}
+	return ret
+}
+
+// Equal returns true if both requirements verify the Run on top of the same
+// Runs.
+//
+// A requirement without base Runs is the same as no requirement: in both
+// cases the Run is verified on its own.
+func Equal(a, b *tryjob.Requirement_Speculation) bool {
+	if len(a.GetBaseRuns()) == 0 && len(b.GetBaseRuns()) == 0 {
+		return true
+	}
+	return proto.Equal(a, b)
+}
+
+// LoadBaseCLs loads CLs of each base Run of the requirement.
+//
+// Returns CLs grouped by Runs, in the order of the base Runs.
+func LoadBaseCLs(ctx context.Context, req *tryjob.Requirement_Speculation) ([][]*run.RunCL, error) {
+	if len(req.GetBaseRuns()) == 0 {
+		return nil, nil
+	}
+	runIDs := common.MakeRunIDs(req.GetBaseRuns()...)
+	runs, errs := run.LoadRunsFromIDs(runIDs...).Do(ctx)
+	ret := make([][]*run.RunCL, len(runs))
+	for i, r := range runs {
+		switch err := errs[i]; {
+		case err == datastore.ErrNoSuchEntity:
+			return nil, errors.Reason("base Run %q doesn't exist", runIDs[i]).Err()
+		case err != nil:
+			return nil, errors.Annotate(err, "failed to load base Run %q", runIDs[i]).Tag(transient.Tag).Err()
+		}
+		cls, err := run.LoadRunCLs(ctx, r.ID, r.CLs)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = cls
+	}
+	return ret, nil
+}
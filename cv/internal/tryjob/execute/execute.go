@@ -31,6 +31,7 @@ import (
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/metrics"
 	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/run/speculation"
 	"go.chromium.org/luci/cv/internal/tryjob"
 	"go.chromium.org/luci/cv/internal/tryjob/requirement"
 )
@@ -84,6 +85,12 @@ func (e *Executor) Do(ctx context.Context, r *run.Run, payload *tryjob.ExecuteTr
 		return err
 	case execState == nil:
 		execState = initExecutionState()
+	case hasEnded(execState) && isNewSpeculation(execState, r, payload):
+		// The Tryjobs have ended but the Run is now verified again in the merge
+		// queue.
+		execState.Status = tryjob.ExecutionState_RUNNING
+		execState.FailureReasonTmpl = ""
+		execState.EndTime = nil
 	case execState.GetStatus() == tryjob.ExecutionState_SUCCEEDED:
 		fallthrough
 	case execState.GetStatus() == tryjob.ExecutionState_FAILED:
@@ -138,6 +145,23 @@ func (e *Executor) Do(ctx context.Context, r *run.Run, payload *tryjob.ExecuteTr
 	}
 }
 
+// hasEnded returns true if the execution has a terminal status.
+func hasEnded(execState *tryjob.ExecutionState) bool {
+	switch execState.GetStatus() {
+	case tryjob.ExecutionState_SUCCEEDED, tryjob.ExecutionState_FAILED:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNewSpeculation returns true if the Executor is asked to verify the Run in
+// the merge queue on top of a different set of Runs than before.
+func isNewSpeculation(execState *tryjob.ExecutionState, r *run.Run, payload *tryjob.ExecuteTryjobsPayload) bool {
+	return payload.GetRequirementChanged() &&
+		!speculation.Equal(execState.GetRequirement().GetSpeculation(), r.Tryjobs.GetRequirement().GetSpeculation())
+}
+
 func initExecutionState() *tryjob.ExecutionState {
 	return &tryjob.ExecutionState{
 		Status: tryjob.ExecutionState_RUNNING,
@@ -412,6 +436,12 @@ func handleRequirementChange(curReqmt, targetReqmt *tryjob.Requirement, execStat
 				panic(fmt.Errorf("impossible; definition shows up in diff but not in existing execution state. Definition: %s", existingDef))
 			}
 			execState.Executions = append(execState.Executions, exec)
+			if reqmtDiff.SpeculationChanged {
+				p.triggerNewAttempt = append(p.triggerNewAttempt, planItem{
+					definition: def,
+					execution:  exec,
+				})
+			}
 		case !reqmtDiff.UnchangedDefsReverse.Has(def):
 			panic(fmt.Errorf("impossible; definition is not showing up in diff result. Definition: %s", def))
 		default: // Nothing has changed for this definition.
@@ -421,6 +451,14 @@ func handleRequirementChange(curReqmt, targetReqmt *tryjob.Requirement, execStat
 				panic(fmt.Errorf("impossible; definition shows up in diff but not in existing execution state. Definition: %s", existingDef))
 			}
 			execState.Executions = append(execState.Executions, exec)
+			if reqmtDiff.SpeculationChanged {
+				// The existing Tryjobs haven't verified the Run on top of the new
+				// set of Runs in the merge queue.
+				p.triggerNewAttempt = append(p.triggerNewAttempt, planItem{
+					definition: def,
+					execution:  exec,
+				})
+			}
 		}
 	}
 	if len(targetReqmt.GetDefinitions()) == 0 {
@@ -449,7 +487,7 @@ func (e *Executor) executePlan(ctx context.Context, p *plan, r *run.Run, execSta
 			executions[i] = item.execution
 		}
 
-		tryjobs, err := e.startTryjobs(ctx, r, definitions, executions)
+		tryjobs, err := e.startTryjobs(ctx, r, execState.GetRequirement().GetSpeculation(), definitions, executions)
 		if err != nil {
 			return nil, err
 		}
@@ -471,6 +471,32 @@ func TestPrepExecutionPlan(t *testing.T) {
 				})
 			})
 
+			Convey("Speculation started", func() {
+				latestReqmt.Speculation = &tryjob.Requirement_Speculation{
+					BaseRuns: []string{"prj/1-aaa"},
+				}
+				execState := newExecStateBuilder().
+					appendDefinition(builderFooDef).
+					build()
+				execState, plan, err := executor.prepExecutionPlan(ctx, execState, r, nil, true)
+				So(err, ShouldBeNil)
+				So(execState.Requirement, ShouldResembleProto, latestReqmt)
+				So(plan.triggerNewAttempt, ShouldHaveLength, 1)
+				So(plan.triggerNewAttempt[0].definition, ShouldResembleProto, builderFooDef)
+				So(plan.triggerNewAttempt[0].execution, ShouldEqual, execState.Executions[0])
+			})
+
+			Convey("Speculation without base Runs", func() {
+				latestReqmt.Speculation = &tryjob.Requirement_Speculation{}
+				execState := newExecStateBuilder().
+					appendDefinition(builderFooDef).
+					build()
+				execState, plan, err := executor.prepExecutionPlan(ctx, execState, r, nil, true)
+				So(err, ShouldBeNil)
+				So(execState.Requirement, ShouldResembleProto, latestReqmt)
+				So(plan.isEmpty(), ShouldBeTrue)
+			})
+
 			Convey("Empty definitions", func() {
 				latestReqmt.Definitions = nil
 				execState := newExecStateBuilder().
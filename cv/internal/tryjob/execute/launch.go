@@ -65,6 +65,19 @@ func (w *worker) launchTryjobs(ctx context.Context, tryjobs []*tryjob.Tryjob) ([
 	if err != nil {
 		return nil, err
 	}
+	if len(w.baseRunCLs) > 0 {
+		// CLs of the Runs ahead in the merge queue are applied first, in the order
+		// the Runs will be submitted.
+		var baseCLsInOrder []*run.RunCL
+		for _, cls := range w.baseRunCLs {
+			ordered, err := submit.ComputeOrder(cls)
+			if err != nil {
+				return nil, err
+			}
+			baseCLsInOrder = append(baseCLsInOrder, ordered...)
+		}
+		clsInOrder = append(baseCLsInOrder, clsInOrder...)
+	}
 	launchFailures := make(map[*tryjob.Tryjob]error)
 	_ = retry.Retry(clock.Tag(ctx, common.LaunchRetryClockTag), retryFactory, func() error {
 		var hasFatal bool
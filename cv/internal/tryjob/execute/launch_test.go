@@ -275,6 +275,45 @@ func TestLaunch(t *testing.T) {
 				{Host: gHost, Project: gRepo, Change: w.cls[0].Detail.GetGerrit().GetInfo().GetNumber(), Patchset: gPatchset},
 			})
 		})
+		Convey("Launched speculative Tryjob has base CLs first", func() {
+			baseCL := &run.RunCL{
+				ID: clid + 2,
+				Detail: &changelist.Snapshot{
+					Patchset:              gPatchset,
+					MinEquivalentPatchset: gMinPatchset,
+					Kind: &changelist.Snapshot_Gerrit{
+						Gerrit: &changelist.Gerrit{
+							Host: gHost,
+							Info: &gerritpb.ChangeInfo{
+								Project: gRepo,
+								Number:  gChange + 2,
+								Owner: &gerritpb.AccountInfo{
+									Email: "owner@example.com",
+								},
+							},
+						},
+					},
+				},
+			}
+			w.baseRunCLs = [][]*run.RunCL{{baseCL}}
+			tj := w.makePendingTryjob(ctx, defFoo)
+			So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+				return tryjob.SaveTryjobs(ctx, []*tryjob.Tryjob{tj}, nil)
+			}, nil), ShouldBeNil)
+			tryjobs, err := w.launchTryjobs(ctx, []*tryjob.Tryjob{tj})
+			So(err, ShouldBeNil)
+			So(tryjobs, ShouldHaveLength, 1)
+			_, buildID, err := tryjobs[0].ExternalID.ParseBuildbucketID()
+			So(err, ShouldBeNil)
+			bbClient, err := ct.BuildbucketFake.NewClientFactory().MakeClient(ctx, bbHost, lProject)
+			So(err, ShouldBeNil)
+			build, err := bbClient.GetBuild(ctx, &bbpb.GetBuildRequest{Id: buildID})
+			So(err, ShouldBeNil)
+			So(build.Input.GetGerritChanges(), ShouldResembleProto, []*bbpb.GerritChange{
+				{Host: gHost, Project: gRepo, Change: gChange + 2, Patchset: gPatchset},
+				{Host: gHost, Project: gRepo, Change: gChange, Patchset: gPatchset},
+			})
+		})
 	})
 }
 
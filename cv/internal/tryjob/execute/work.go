@@ -27,25 +27,39 @@ import (
 
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/run/speculation"
 	"go.chromium.org/luci/cv/internal/tryjob"
 )
 
 // startTryjobs triggers Tryjobs for the given Definitions by either reusing
 // existing Tryjobs or launching new ones.
-func (e *Executor) startTryjobs(ctx context.Context, r *run.Run, definitions []*tryjob.Definition, executions []*tryjob.ExecutionState_Execution) ([]*tryjob.Tryjob, error) {
+//
+// If spec has base Runs, the Tryjobs verify CLs of the Run on top of CLs of
+// the base Runs and are never reused.
+func (e *Executor) startTryjobs(ctx context.Context, r *run.Run, spec *tryjob.Requirement_Speculation, definitions []*tryjob.Definition, executions []*tryjob.ExecutionState_Execution) ([]*tryjob.Tryjob, error) {
 	cls, err := run.LoadRunCLs(ctx, r.ID, r.CLs)
 	if err != nil {
 		return nil, err
 	}
+	baseRunCLs, err := speculation.LoadBaseCLs(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	var allCLs []*run.RunCL
+	for _, baseCLs := range baseRunCLs {
+		allCLs = append(allCLs, baseCLs...)
+	}
+	allCLs = append(allCLs, cls...)
 	w := &worker{
 		backend:          e.Backend,
 		rm:               e.RM,
 		run:              r,
 		cls:              cls,
+		baseRunCLs:       baseRunCLs,
 		knownTryjobIDs:   make(common.TryjobIDSet),
 		knownExternalIDs: make(stringset.Set),
-		reuseKey:         computeReuseKey(cls),
-		clPatchsets:      make(tryjob.CLPatchsets, len(cls)),
+		reuseKey:         computeReuseKey(allCLs),
+		clPatchsets:      make(tryjob.CLPatchsets, len(allCLs)),
 	}
 	for _, execution := range executions {
 		for _, attempt := range execution.GetAttempts() {
@@ -57,11 +71,13 @@ func (e *Executor) startTryjobs(ctx context.Context, r *run.Run, definitions []*
 			}
 		}
 	}
-	for i, cl := range cls {
+	for i, cl := range allCLs {
 		w.clPatchsets[i] = tryjob.MakeCLPatchset(cl.ID, cl.Detail.GetPatchset())
 	}
 	sort.Sort(w.clPatchsets)
-	w.findReuseFns = append(w.findReuseFns, w.findReuseInCV, w.findReuseInBackend)
+	if len(baseRunCLs) == 0 {
+		w.findReuseFns = append(w.findReuseFns, w.findReuseInCV, w.findReuseInBackend)
+	}
 
 	ret, err := w.start(ctx, definitions)
 	for _, le := range w.logEntries {
@@ -78,8 +94,13 @@ func (e *Executor) startTryjobs(ctx context.Context, r *run.Run, definitions []*
 // It does this by searching for Tryjobs that can be reused first, and then
 // launching new Tryjobs if nothing can be reused.
 type worker struct {
-	run              *run.Run
-	cls              []*run.RunCL
+	run *run.Run
+	cls []*run.RunCL
+	// baseRunCLs are CLs of each Run ahead of this Run in the merge queue in the
+	// order of submission.
+	//
+	// Tryjobs are launched with these CLs applied before `cls`.
+	baseRunCLs       [][]*run.RunCL
 	knownTryjobIDs   common.TryjobIDSet
 	knownExternalIDs stringset.Set
 
@@ -23,6 +23,7 @@ import (
 
 	"go.chromium.org/luci/common/data/cmpbin"
 
+	"go.chromium.org/luci/cv/internal/run/speculation"
 	"go.chromium.org/luci/cv/internal/tryjob"
 )
 
@@ -67,6 +68,9 @@ type DiffResult struct {
 
 	// RetryConfigChanged indicates the retry configuration has changed.
 	RetryConfigChanged bool
+	// SpeculationChanged indicates the Run is verified on top of a different
+	// set of Runs in the merge queue.
+	SpeculationChanged bool
 }
 
 // Diff computes the diff between two Tryjob Requirements.
@@ -117,6 +121,9 @@ func Diff(base, target *tryjob.Requirement) DiffResult {
 	if !proto.Equal(base.GetRetryConfig(), target.GetRetryConfig()) {
 		res.RetryConfigChanged = true
 	}
+	if !speculation.Equal(base.GetSpeculation(), target.GetSpeculation()) {
+		res.SpeculationChanged = true
+	}
 	return res
 }
 
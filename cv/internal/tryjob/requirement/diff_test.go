@@ -217,5 +217,43 @@ func TestDiff(t *testing.T) {
 				So(res.RetryConfigChanged, ShouldBeTrue)
 			})
 		})
+		Convey("Compare speculation", func() {
+			Convey("Both empty", func() {
+				res := Diff(&tryjob.Requirement{}, &tryjob.Requirement{})
+				So(res.SpeculationChanged, ShouldBeFalse)
+			})
+			Convey("Speculation with no base is the same as none", func() {
+				res := Diff(
+					&tryjob.Requirement{},
+					&tryjob.Requirement{
+						Speculation: &tryjob.Requirement_Speculation{},
+					})
+				So(res.SpeculationChanged, ShouldBeFalse)
+			})
+			Convey("Starts speculation", func() {
+				res := Diff(
+					&tryjob.Requirement{},
+					&tryjob.Requirement{
+						Speculation: &tryjob.Requirement_Speculation{
+							BaseRuns: []string{"prj/1-a"},
+						},
+					})
+				So(res.SpeculationChanged, ShouldBeTrue)
+			})
+			Convey("Base runs changed", func() {
+				res := Diff(
+					&tryjob.Requirement{
+						Speculation: &tryjob.Requirement_Speculation{
+							BaseRuns: []string{"prj/1-a", "prj/2-b"},
+						},
+					},
+					&tryjob.Requirement{
+						Speculation: &tryjob.Requirement_Speculation{
+							BaseRuns: []string{"prj/2-b"},
+						},
+					})
+				So(res.SpeculationChanged, ShouldBeTrue)
+			})
+		})
 	})
 }
@@ -39,6 +39,9 @@ type Input struct {
 	CLs         []*run.RunCL
 	RunOptions  *run.Options
 	RunMode     run.Mode
+	// RunModeDefinition is the definition of RunMode if it is not a standard
+	// mode.
+	RunModeDefinition *cfgpb.Mode
 }
 
 func (i Input) allCLOwnersSorted() []string {
@@ -327,7 +330,7 @@ func shouldInclude(ctx context.Context, in Input, dm *definitionMaker, isOptiona
 		return skipBuilder, nil, nil
 	}
 
-	if !isModeAllowed(in.RunMode, b.ModeAllowlist) {
+	if !isTriggeredInMode(in.RunMode, in.RunModeDefinition, b) {
 		return skipBuilder, nil, nil
 	}
 
@@ -531,6 +534,23 @@ func (dm *definitionMaker) make() *tryjob.Definition {
 	return definition
 }
 
+// isTriggeredInMode returns whether the builder should be triggered in the
+// given Run mode.
+//
+// If the mode defines its own set of builders, only those are triggered.
+// Otherwise, builder's mode allowlist is consulted.
+func isTriggeredInMode(mode run.Mode, modeDef *cfgpb.Mode, b *cfgpb.Verifiers_Tryjob_Builder) bool {
+	if builders := modeDef.GetTryjobBuilders(); len(builders) > 0 {
+		for _, name := range builders {
+			if name == b.GetName() {
+				return true
+			}
+		}
+		return false
+	}
+	return isModeAllowed(mode, b.GetModeAllowlist())
+}
+
 func isModeAllowed(mode run.Mode, allowedModes []string) bool {
 	if len(allowedModes) == 0 {
 		allowedModes = run.DefaultAllowedModes()
@@ -915,6 +915,47 @@ func TestCompute(t *testing.T) {
 			})
 		})
 
+		Convey("custom mode", func() {
+			in := makeInput(ctx, []*cfgpb.Verifiers_Tryjob_Builder{
+				builderConfigGenerator{
+					Name: "test-proj/test/builder1",
+				}.generate(),
+				builderConfigGenerator{
+					Name:  "test-proj/test/builder2",
+					Modes: []string{"QUICK_RUN"},
+				}.generate(),
+				builderConfigGenerator{
+					Name:  "test-proj/test/builder3",
+					Modes: []string{string(run.FullRun)},
+				}.generate(),
+			})
+			in.RunMode = "QUICK_RUN"
+			in.RunModeDefinition = &cfgpb.Mode{
+				Name:            "QUICK_RUN",
+				CqLabelValue:    1,
+				TriggeringLabel: "Quick-Run",
+				TriggeringValue: 1,
+			}
+			builderNames := func() []string {
+				res, err := Compute(ctx, *in)
+				So(err, ShouldBeNil)
+				So(res.ComputationFailure, ShouldBeNil)
+				var names []string
+				for _, def := range res.Requirement.GetDefinitions() {
+					names = append(names, def.GetBuildbucket().GetBuilder().GetBuilder())
+				}
+				return names
+			}
+
+			Convey("uses mode_allowlist by default", func() {
+				So(builderNames(), ShouldResemble, []string{"builder2"})
+			})
+			Convey("uses tryjob_builders if set", func() {
+				in.RunModeDefinition.TryjobBuilders = []string{"test-proj/test/builder1", "test-proj/test/builder3"}
+				So(builderNames(), ShouldResemble, []string{"builder1", "builder3"})
+			})
+		})
+
 		Convey("Experiments", func() {
 			in := makeInput(ctx, []*cfgpb.Verifiers_Tryjob_Builder{builderConfigGenerator{Name: "test-proj/test/builder1"}.generate()})
 			in.ConfigGroup.TryjobExperiments = []*cfgpb.ConfigGroup_TryjobExperiment{
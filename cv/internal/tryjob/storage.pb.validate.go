@@ -312,6 +312,35 @@ func (m *Requirement) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetSpeculation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequirementValidationError{
+					field:  "Speculation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequirementValidationError{
+					field:  "Speculation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpeculation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequirementValidationError{
+				field:  "Speculation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RequirementMultiError(errors)
 	}
@@ -1754,6 +1783,108 @@ var _ interface {
 	ErrorName() string
 } = Definition_BuildbucketValidationError{}
 
+// Validate checks the field values on Requirement_Speculation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Requirement_Speculation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Requirement_Speculation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Requirement_SpeculationMultiError, or nil if none found.
+func (m *Requirement_Speculation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Requirement_Speculation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return Requirement_SpeculationMultiError(errors)
+	}
+
+	return nil
+}
+
+// Requirement_SpeculationMultiError is an error wrapping multiple validation
+// errors returned by Requirement_Speculation.ValidateAll() if the designated
+// constraints aren't met.
+type Requirement_SpeculationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Requirement_SpeculationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Requirement_SpeculationMultiError) AllErrors() []error { return m }
+
+// Requirement_SpeculationValidationError is the validation error returned by
+// Requirement_Speculation.Validate if the designated constraints aren't met.
+type Requirement_SpeculationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Requirement_SpeculationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Requirement_SpeculationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Requirement_SpeculationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Requirement_SpeculationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Requirement_SpeculationValidationError) ErrorName() string {
+	return "Requirement_SpeculationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Requirement_SpeculationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequirement_Speculation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Requirement_SpeculationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Requirement_SpeculationValidationError{}
+
 // Validate checks the field values on Result_Buildbucket with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
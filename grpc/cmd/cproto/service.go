@@ -72,6 +72,11 @@ func getServices(file *ast.File) ([]*service, error) {
 					if name == "" {
 						break
 					}
+					// Skip client interfaces of streaming methods, e.g.
+					// "<Service>_<Method>Client".
+					if strings.Contains(name, "_") {
+						break
+					}
 
 					iface, ok := st.Type.(*ast.InterfaceType)
 					if !ok {
@@ -150,6 +150,11 @@ func New{{.Service}}PRPCClient(client *{{.PRPCSymbolPrefix}}Client) {{.Service}}
 }
 
 {{range .Methods}}
+{{if .Stream}}
+func (c *{{$.StructName}}) {{.Name}}({{.Params}}) ({{.Stream}}, error) {
+	return nil, {{$.PRPCSymbolPrefix}}ErrNoStreamingSupport
+}
+{{else}}
 func (c *{{$.StructName}}) {{.Name}}(ctx context.Context, in *{{.InputMessage}}, opts ...grpc.CallOption) (*{{.OutputMessage}}, error) {
 	out := new({{.OutputMessage}})
 	err := c.client.Call(ctx, "{{$.ProtoPkg}}.{{$.Service}}", "{{.Name}}", in, out, opts...)
@@ -159,6 +164,7 @@ func (c *{{$.StructName}}) {{.Name}}(ctx context.Context, in *{{.InputMessage}},
 	return out, nil
 }
 {{end}}
+{{end}}
 `))
 
 // generateClient generates pRPC implementation of a client interface.
@@ -171,6 +177,10 @@ func (t *transformer) generateClient(protoPackage, serviceName string, iface *as
 		Name          string
 		InputMessage  string
 		OutputMessage string
+
+		// Set only for streaming methods, which pRPC doesn't support.
+		Params string // the parameter list
+		Stream string // the stream client type
 	}
 	methods := make([]Method, 0, len(iface.Methods.List))
 
@@ -190,6 +200,31 @@ func (t *transformer) generateClient(protoPackage, serviceName string, iface *as
 			return nil, fmt.Errorf("unexpected embedded interface in %sClient", serviceName)
 		}
 
+		// Streaming methods return a stream client interface instead of
+		// a message. Client-streaming methods also don't take a message.
+		if _, ok := signature.Results.List[0].Type.(*ast.StarExpr); !ok {
+			var params []string
+			for _, p := range signature.Params.List {
+				typ, err := toGoCode(p.Type)
+				if err != nil {
+					return nil, err
+				}
+				for _, n := range p.Names {
+					params = append(params, n.Name+" "+typ)
+				}
+			}
+			stream, err := toGoCode(signature.Results.List[0].Type)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, Method{
+				Name:   m.Names[0].Name,
+				Params: strings.Join(params, ", "),
+				Stream: stream,
+			})
+			continue
+		}
+
 		inStructPtr := signature.Params.List[1].Type.(*ast.StarExpr)
 		inStruct, err := toGoCode(inStructPtr.X)
 		if err != nil {
@@ -0,0 +1,231 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	log "go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/logdog/common/storage"
+)
+
+// Cache is a storage.Cache implementation that stores cached items as files
+// in a local directory.
+//
+// Each item is stored in its own file, named after the hash of its key. The
+// file starts with an 8-byte big-endian expiration time (in Unix nanoseconds,
+// or 0 for no expiration), followed by the item's data. Writes go through a
+// temporary file and a rename, so readers never observe partial items.
+//
+// Expired items are removed when they are read and by Sweep, which also keeps
+// the total size of the cache under MaxSize. Call Sweep periodically (see
+// RunSweeper) to keep the cache from growing without bound.
+type Cache struct {
+	// Root is the directory where cached items are stored. It will be created if
+	// it doesn't exist.
+	Root string
+	// MaxSize is the maximum total size of cached items in bytes.
+	//
+	// If exceeded, Sweep removes the oldest items. If 0, the size is unbounded.
+	MaxSize int64
+}
+
+var _ storage.Cache = (*Cache)(nil)
+
+// Put implements storage.Cache.
+func (c *Cache) Put(ctx context.Context, key storage.CacheKey, val []byte, exp time.Duration) {
+	var expiry int64
+	if exp > 0 {
+		expiry = clock.Now(ctx).Add(exp).UnixNano()
+	}
+
+	buf := make([]byte, 8, 8+len(val))
+	binary.BigEndian.PutUint64(buf, uint64(expiry))
+	buf = append(buf, val...)
+
+	path := c.itemPath(key)
+	if err := writeFileAtomic(path, buf); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       path,
+		}.Warningf(ctx, "Failed to write cache item.")
+	}
+}
+
+// Get implements storage.Cache.
+func (c *Cache) Get(ctx context.Context, key storage.CacheKey) ([]byte, bool) {
+	path := c.itemPath(key)
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return nil, false
+	case err != nil:
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       path,
+		}.Warningf(ctx, "Failed to read cache item.")
+		return nil, false
+	case len(data) < 8:
+		return nil, false
+	}
+
+	if expiry := int64(binary.BigEndian.Uint64(data)); expiry > 0 && clock.Now(ctx).UnixNano() >= expiry {
+		// Best-effort cleanup of the expired item.
+		os.Remove(path)
+		return nil, false
+	}
+	return data[8:], true
+}
+
+// Sweep removes expired items and, if the cache is larger than MaxSize, the
+// least recently written items until it fits.
+func (c *Cache) Sweep(ctx context.Context) error {
+	type item struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var items []item
+	var total int64
+
+	now := clock.Now(ctx).UnixNano()
+	err := filepath.WalkDir(c.Root, func(path string, d fs.DirEntry, err error) error {
+		switch {
+		case os.IsNotExist(err):
+			return nil // the cache is empty or the item was removed concurrently
+		case err != nil:
+			return err
+		case d.IsDir() || strings.HasPrefix(d.Name(), ".tmp-"):
+			return nil
+		}
+
+		expiry, err := readExpiry(path)
+		switch {
+		case os.IsNotExist(err):
+			return nil
+		case err != nil:
+			return err
+		case expiry > 0 && now >= expiry:
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		}
+
+		info, err := d.Info()
+		switch {
+		case os.IsNotExist(err):
+			return nil
+		case err != nil:
+			return err
+		}
+		items = append(items, item{path, info.Size(), info.ModTime()})
+		total += info.Size()
+		return nil
+	})
+	if err != nil {
+		return errors.Annotate(err, "failed to sweep the cache in %q", c.Root).Err()
+	}
+
+	if c.MaxSize <= 0 || total <= c.MaxSize {
+		return nil
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].modTime.Before(items[j].modTime) })
+	for _, it := range items {
+		if total <= c.MaxSize {
+			break
+		}
+		if err := os.Remove(it.path); err != nil && !os.IsNotExist(err) {
+			return errors.Annotate(err, "failed to remove cache item").Err()
+		}
+		total -= it.size
+	}
+	return nil
+}
+
+// RunSweeper calls Sweep every `interval` until the context is canceled.
+func (c *Cache) RunSweeper(ctx context.Context, interval time.Duration) {
+	for {
+		if err := c.Sweep(ctx); err != nil {
+			log.WithError(err).Warningf(ctx, "Failed to sweep the local cache.")
+		}
+		if r := <-clock.After(ctx, interval); r.Err != nil {
+			return
+		}
+	}
+}
+
+func (c *Cache) itemPath(key storage.CacheKey) string {
+	h := storage.HashKey(key.Schema, key.Type, key.Key)
+	return filepath.Join(c.Root, h[:2], h)
+}
+
+// readExpiry reads the expiration time from the header of a cache item.
+//
+// Returns 0 for items without expiration and for malformed items.
+func readExpiry(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	var hdr [8]byte
+	if _, err := io.ReadFull(f, hdr[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(hdr[:])), nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it
+// into place.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
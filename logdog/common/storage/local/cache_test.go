@@ -0,0 +1,124 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/logdog/common/storage"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A local Cache instance`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		cache := &Cache{Root: t.TempDir()}
+
+		key := storage.CacheKey{Schema: "v1", Type: "test", Key: "foo"}
+
+		Convey(`Returns nothing for missing items.`, func() {
+			_, ok := cache.Get(c, key)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey(`Can put and get items.`, func() {
+			cache.Put(c, key, []byte("bar"), 0)
+
+			v, ok := cache.Get(c, key)
+			So(ok, ShouldBeTrue)
+			So(string(v), ShouldEqual, "bar")
+
+			Convey(`Items are scoped by the full key.`, func() {
+				_, ok := cache.Get(c, storage.CacheKey{Schema: "v2", Type: "test", Key: "foo"})
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey(`Items can be overwritten.`, func() {
+				cache.Put(c, key, []byte("baz"), 0)
+
+				v, ok := cache.Get(c, key)
+				So(ok, ShouldBeTrue)
+				So(string(v), ShouldEqual, "baz")
+			})
+
+			Convey(`Items without expiration persist.`, func() {
+				tc.Add(24 * time.Hour)
+				_, ok := cache.Get(c, key)
+				So(ok, ShouldBeTrue)
+			})
+		})
+
+		Convey(`Items expire.`, func() {
+			cache.Put(c, key, []byte("bar"), time.Minute)
+
+			tc.Add(59 * time.Second)
+			_, ok := cache.Get(c, key)
+			So(ok, ShouldBeTrue)
+
+			tc.Add(time.Second)
+			_, ok = cache.Get(c, key)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey(`Sweep removes expired items.`, func() {
+			other := storage.CacheKey{Schema: "v1", Type: "test", Key: "other"}
+			cache.Put(c, key, []byte("bar"), time.Minute)
+			cache.Put(c, other, []byte("baz"), 0)
+
+			tc.Add(time.Minute)
+			So(cache.Sweep(c), ShouldBeNil)
+			_, err := os.Stat(cache.itemPath(key))
+			So(os.IsNotExist(err), ShouldBeTrue)
+			_, ok := cache.Get(c, other)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey(`Sweep keeps the cache under MaxSize.`, func() {
+			keys := make([]storage.CacheKey, 3)
+			for i := range keys {
+				keys[i] = storage.CacheKey{Schema: "v1", Type: "test", Key: fmt.Sprintf("key-%d", i)}
+				cache.Put(c, keys[i], []byte("0123456789"), 0)
+				// Make the order of writes deterministic.
+				mtime := testclock.TestRecentTimeUTC.Add(time.Duration(i) * time.Second)
+				So(os.Chtimes(cache.itemPath(keys[i]), mtime, mtime), ShouldBeNil)
+			}
+
+			// Each item is 8 bytes of header and 10 bytes of data.
+			cache.MaxSize = 40
+			So(cache.Sweep(c), ShouldBeNil)
+
+			_, ok := cache.Get(c, keys[0])
+			So(ok, ShouldBeFalse)
+			for _, k := range keys[1:] {
+				_, ok := cache.Get(c, k)
+				So(ok, ShouldBeTrue)
+			}
+		})
+
+		Convey(`Sweep works with an empty cache.`, func() {
+			cache.Root = filepath.Join(cache.Root, "missing")
+			So(cache.Sweep(c), ShouldBeNil)
+		})
+	})
+}
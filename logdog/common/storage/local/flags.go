@@ -0,0 +1,103 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// Flags contains the local storage config.
+type Flags struct {
+	// Root is the directory where log streams are stored.
+	//
+	// If empty, local storage is disabled.
+	Root string
+	// MaxSegmentSize is the size at which segment files are rolled over.
+	MaxSegmentSize int64
+
+	// CacheDir is the directory where the storage cache is kept.
+	//
+	// If empty, the local cache is disabled.
+	CacheDir string
+	// CacheMaxSize is the maximum total size of the local cache in bytes.
+	CacheMaxSize int64
+}
+
+// Register registers flags in the flag set.
+func (f *Flags) Register(fs *flag.FlagSet) {
+	fs.StringVar(&f.Root, "local-storage-dir", f.Root,
+		"If set, store logs in this local directory instead of BigTable.")
+	fs.Int64Var(&f.MaxSegmentSize, "local-storage-max-segment-size", f.MaxSegmentSize,
+		"Size (in bytes) at which local storage segment files are rolled over.")
+	fs.StringVar(&f.CacheDir, "local-cache-dir", f.CacheDir,
+		"If set, cache intermediate storage data in this local directory.")
+	fs.Int64Var(&f.CacheMaxSize, "local-cache-max-size", f.CacheMaxSize,
+		"Maximum total size (in bytes) of the local cache, 0 for unbounded.")
+}
+
+// Enabled returns true if local storage was requested.
+func (f *Flags) Enabled() bool {
+	return f.Root != ""
+}
+
+// CacheEnabled returns true if the local cache was requested.
+func (f *Flags) CacheEnabled() bool {
+	return f.CacheDir != ""
+}
+
+// Validate returns an error if some parsed flags have invalid values.
+func (f *Flags) Validate() error {
+	if f.MaxSegmentSize < 0 {
+		return errors.New("-local-storage-max-segment-size must be non-negative")
+	}
+	if f.CacheMaxSize < 0 {
+		return errors.New("-local-cache-max-size must be non-negative")
+	}
+	return nil
+}
+
+// StorageFromFlags instantiates the *local.Storage given parsed flags.
+func StorageFromFlags(f *Flags) (*Storage, error) {
+	root, err := filepath.Abs(f.Root)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad -local-storage-dir").Err()
+	}
+	if err := os.MkdirAll(root, dirPerm); err != nil {
+		return nil, errors.Annotate(err, "failed to create %q", root).Err()
+	}
+	return &Storage{
+		Root:           root,
+		MaxSegmentSize: f.MaxSegmentSize,
+	}, nil
+}
+
+// CacheFromFlags instantiates the *local.Cache given parsed flags.
+func CacheFromFlags(f *Flags) (*Cache, error) {
+	root, err := filepath.Abs(f.CacheDir)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad -local-cache-dir").Err()
+	}
+	if err := os.MkdirAll(root, dirPerm); err != nil {
+		return nil, errors.Annotate(err, "failed to create %q", root).Err()
+	}
+	return &Cache{
+		Root:    root,
+		MaxSize: f.CacheMaxSize,
+	}, nil
+}
@@ -0,0 +1,518 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package local implements a durable, single-host storage.Storage backed by
+// the local filesystem.
+//
+// Each log stream is stored in its own directory underneath the storage root.
+// The directory name is derived from a hash of the stream's (Project, Path):
+//
+//	<Root>/<HASH[:2]>/<HASH>/
+//	    index
+//	    000000.seg
+//	    000001.seg
+//	    ...
+//
+// Log entry data is appended to segment files. Once a segment grows past
+// MaxSegmentSize a new one is started. The "index" file is an append-only
+// sequence of fixed-size records, each describing the location of a single
+// log entry:
+//
+//	[ 8 bytes ][  4 bytes  ][ 8 bytes ][ 4 bytes ]
+//	  Index      Segment      Offset     Size
+//
+// All integers are big-endian. Entry data is always written (and synced) to
+// its segment before its index record is appended, so an index record never
+// refers to data that isn't on disk. When a stream is loaded, trailing index
+// records that are truncated or refer past the end of their segment (e.g.,
+// after a crash mid-write) are discarded.
+package local
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+)
+
+const (
+	// DefaultMaxSegmentSize is the default maximum size of a single segment
+	// file.
+	DefaultMaxSegmentSize = 64 * 1024 * 1024
+
+	// indexFileName is the name of the per-stream index file.
+	indexFileName = "index"
+
+	// indexRecordSize is the size, in bytes, of a single index record.
+	indexRecordSize = 8 + 4 + 8 + 4
+
+	// dirPerm and filePerm are the permissions used when creating directories
+	// and files.
+	dirPerm  = 0700
+	filePerm = 0600
+)
+
+// Storage is a storage.Storage implementation that stores log entries in
+// append-only segment files on the local filesystem.
+//
+// A single Root must not be shared by multiple Storage instances (or
+// processes) at the same time.
+type Storage struct {
+	// Root is the directory where log streams are stored. It will be created if
+	// it doesn't exist.
+	Root string
+
+	// MaxSegmentSize, if > 0, is the size at which a stream's segment file is
+	// rolled over into a new one. If <= 0, DefaultMaxSegmentSize will be used.
+	MaxSegmentSize int64
+
+	// MaxGetCount, if not zero, is the maximum number of records to retrieve from
+	// a single Get request.
+	MaxGetCount int
+
+	mu      sync.Mutex
+	streams map[string]*stream
+	closed  bool
+}
+
+var _ storage.Storage = (*Storage)(nil)
+
+// location is the position of a single log entry within a stream's segments.
+type location struct {
+	segment uint32
+	offset  int64
+	size    uint32
+}
+
+// stream is the loaded state of a single log stream.
+type stream struct {
+	mu sync.Mutex
+
+	dir string
+
+	// indices is the sorted list of stored message indices.
+	indices []types.MessageIndex
+	// locs maps a message index to its location.
+	locs map[types.MessageIndex]location
+
+	// segment is the current (last) segment number and segmentSize is its size.
+	segment     uint32
+	segmentSize int64
+}
+
+// Close implements storage.Storage.
+func (s *Storage) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	s.streams = nil
+}
+
+// Put implements storage.Storage.
+func (s *Storage) Put(c context.Context, req storage.PutRequest) error {
+	st, err := s.getStream(req.Project, req.Path, true)
+	if err != nil {
+		return err
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	// Refuse the whole request if any of its entries already exist, so that a
+	// failed Put doesn't leave a partially-written range behind.
+	for i := range req.Values {
+		if _, ok := st.locs[req.Index+types.MessageIndex(i)]; ok {
+			return storage.ErrExists
+		}
+	}
+	return st.append(req.Index, req.Values, s.maxSegmentSize())
+}
+
+// Get implements storage.Storage.
+func (s *Storage) Get(c context.Context, req storage.GetRequest, cb storage.GetCallback) error {
+	st, err := s.getStream(req.Project, req.Path, false)
+	if err != nil {
+		return err
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	limit := len(st.indices)
+	if req.Limit > 0 && req.Limit < limit {
+		limit = req.Limit
+	}
+	if s.MaxGetCount > 0 && s.MaxGetCount < limit {
+		limit = s.MaxGetCount
+	}
+
+	start := sort.Search(len(st.indices), func(i int) bool { return st.indices[i] >= req.Index })
+	end := start + limit
+	if end > len(st.indices) {
+		end = len(st.indices)
+	}
+
+	var r segmentReader
+	defer r.close()
+	for _, idx := range st.indices[start:end] {
+		var data []byte
+		if !req.KeysOnly {
+			if data, err = r.read(st.dir, st.locs[idx]); err != nil {
+				return errors.Annotate(err, "failed to read entry %d", idx).Err()
+			}
+		}
+		if !cb(storage.MakeEntry(data, idx)) {
+			break
+		}
+	}
+	return nil
+}
+
+// Tail implements storage.Storage.
+func (s *Storage) Tail(c context.Context, project string, path types.StreamPath) (*storage.Entry, error) {
+	st, err := s.getStream(project, path, false)
+	if err != nil {
+		return nil, err
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if len(st.indices) == 0 {
+		return nil, storage.ErrDoesNotExist
+	}
+
+	idx := st.indices[len(st.indices)-1]
+	var r segmentReader
+	defer r.close()
+	data, err := r.read(st.dir, st.locs[idx])
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read entry %d", idx).Err()
+	}
+	return storage.MakeEntry(data, idx), nil
+}
+
+// Expunge implements storage.Storage.
+func (s *Storage) Expunge(c context.Context, req storage.ExpungeRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errors.New("storage is closed")
+	}
+
+	key := streamKey(req.Project, req.Path)
+	if st := s.streams[key]; st != nil {
+		// Wait for any in-flight operations on this stream to finish.
+		st.mu.Lock()
+		defer st.mu.Unlock()
+		delete(s.streams, key)
+	}
+
+	if err := os.RemoveAll(s.streamDir(key)); err != nil {
+		return errors.Annotate(err, "failed to remove stream directory").Err()
+	}
+	return nil
+}
+
+// Count returns the number of log records for the given stream.
+func (s *Storage) Count(project string, path types.StreamPath) int {
+	st, err := s.getStream(project, path, false)
+	if err != nil {
+		return 0
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return len(st.indices)
+}
+
+func (s *Storage) maxSegmentSize() int64 {
+	if s.MaxSegmentSize > 0 {
+		return s.MaxSegmentSize
+	}
+	return DefaultMaxSegmentSize
+}
+
+func (s *Storage) streamDir(key string) string {
+	return filepath.Join(s.Root, key[:2], key)
+}
+
+// getStream returns the loaded stream for the given project and path.
+//
+// If the stream doesn't exist on disk and create is false, it will return
+// storage.ErrDoesNotExist.
+func (s *Storage) getStream(project string, path types.StreamPath, create bool) (*stream, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, errors.New("storage is closed")
+	}
+
+	key := streamKey(project, path)
+	if st := s.streams[key]; st != nil {
+		return st, nil
+	}
+
+	dir := s.streamDir(key)
+	switch _, err := os.Stat(dir); {
+	case os.IsNotExist(err):
+		if !create {
+			return nil, storage.ErrDoesNotExist
+		}
+		if err := os.MkdirAll(dir, dirPerm); err != nil {
+			return nil, errors.Annotate(err, "failed to create stream directory").Err()
+		}
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to stat stream directory").Err()
+	}
+
+	st, err := loadStream(dir)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to load stream %q", dir).Err()
+	}
+
+	if s.streams == nil {
+		s.streams = make(map[string]*stream)
+	}
+	s.streams[key] = st
+	return st, nil
+}
+
+func streamKey(project string, path types.StreamPath) string {
+	return storage.HashKey(project, string(path))
+}
+
+func segmentPath(dir string, segment uint32) string {
+	return filepath.Join(dir, fmt.Sprintf("%06d.seg", segment))
+}
+
+// loadStream loads a stream's index from its directory.
+//
+// Any trailing index records that are incomplete or point outside of their
+// segment file are discarded, and the index file is truncated accordingly.
+func loadStream(dir string) (*stream, error) {
+	st := &stream{
+		dir:  dir,
+		locs: make(map[types.MessageIndex]location),
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, indexFileName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	segmentSizes := map[uint32]int64{}
+	segmentSize := func(seg uint32) (int64, error) {
+		if sz, ok := segmentSizes[seg]; ok {
+			return sz, nil
+		}
+		fi, err := os.Stat(segmentPath(dir, seg))
+		switch {
+		case os.IsNotExist(err):
+			segmentSizes[seg] = -1
+		case err != nil:
+			return 0, err
+		default:
+			segmentSizes[seg] = fi.Size()
+		}
+		return segmentSizes[seg], nil
+	}
+
+	valid := 0
+	for ; valid+indexRecordSize <= len(data); valid += indexRecordSize {
+		idx, loc := decodeIndexRecord(data[valid : valid+indexRecordSize])
+		sz, err := segmentSize(loc.segment)
+		if err != nil {
+			return nil, err
+		}
+		if loc.offset+int64(loc.size) > sz {
+			break
+		}
+		if _, ok := st.locs[idx]; !ok {
+			st.indices = append(st.indices, idx)
+		}
+		st.locs[idx] = loc
+		if loc.segment >= st.segment {
+			st.segment = loc.segment
+		}
+	}
+	if valid != len(data) {
+		if err := os.Truncate(filepath.Join(dir, indexFileName), int64(valid)); err != nil {
+			return nil, errors.Annotate(err, "failed to truncate index").Err()
+		}
+	}
+	sort.Slice(st.indices, func(i, j int) bool { return st.indices[i] < st.indices[j] })
+
+	// Data may have been written to the current segment past the last indexed
+	// entry. New data is always appended at the end of the file, so that's
+	// harmless.
+	if st.segmentSize, err = segmentSize(st.segment); err != nil {
+		return nil, err
+	}
+	if st.segmentSize < 0 {
+		st.segmentSize = 0
+	}
+	return st, nil
+}
+
+// append writes sequential values starting at index to the stream's segments
+// and then records them in the index.
+//
+// st.mu must be held by the caller.
+func (st *stream) append(index types.MessageIndex, values [][]byte, maxSegmentSize int64) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	var (
+		f       *os.File
+		records = make([]byte, 0, len(values)*indexRecordSize)
+		locs    = make([]location, len(values))
+	)
+	closeSegment := func() error {
+		if f == nil {
+			return nil
+		}
+		err := f.Sync()
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		f = nil
+		return err
+	}
+	defer closeSegment()
+
+	segment, segmentSize := st.segment, st.segmentSize
+	for i, v := range values {
+		if segmentSize > 0 && segmentSize+int64(len(v)) > maxSegmentSize {
+			if err := closeSegment(); err != nil {
+				return errors.Annotate(err, "failed to sync segment %d", segment).Err()
+			}
+			segment, segmentSize = segment+1, 0
+		}
+
+		if f == nil {
+			var err error
+			if f, err = os.OpenFile(segmentPath(st.dir, segment), os.O_CREATE|os.O_WRONLY, filePerm); err != nil {
+				return errors.Annotate(err, "failed to open segment %d", segment).Err()
+			}
+		}
+		if _, err := f.WriteAt(v, segmentSize); err != nil {
+			return errors.Annotate(err, "failed to write segment %d", segment).Err()
+		}
+
+		locs[i] = location{segment: segment, offset: segmentSize, size: uint32(len(v))}
+		records = appendIndexRecord(records, index+types.MessageIndex(i), locs[i])
+		segmentSize += int64(len(v))
+	}
+	if err := closeSegment(); err != nil {
+		return errors.Annotate(err, "failed to sync segment %d", segment).Err()
+	}
+
+	// The data is durable; record it in the index.
+	idxf, err := os.OpenFile(filepath.Join(st.dir, indexFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, filePerm)
+	if err != nil {
+		return errors.Annotate(err, "failed to open index").Err()
+	}
+	defer idxf.Close()
+	if _, err := idxf.Write(records); err != nil {
+		return errors.Annotate(err, "failed to write index").Err()
+	}
+	if err := idxf.Sync(); err != nil {
+		return errors.Annotate(err, "failed to sync index").Err()
+	}
+
+	st.segment, st.segmentSize = segment, segmentSize
+	for i, loc := range locs {
+		st.insert(index+types.MessageIndex(i), loc)
+	}
+	return nil
+}
+
+// insert adds a message index to the stream's in-memory index.
+//
+// st.mu must be held by the caller.
+func (st *stream) insert(idx types.MessageIndex, loc location) {
+	st.locs[idx] = loc
+
+	// The common case is appending to the end of the stream.
+	if n := len(st.indices); n == 0 || st.indices[n-1] < idx {
+		st.indices = append(st.indices, idx)
+		return
+	}
+	pos := sort.Search(len(st.indices), func(i int) bool { return st.indices[i] >= idx })
+	st.indices = append(st.indices, 0)
+	copy(st.indices[pos+1:], st.indices[pos:])
+	st.indices[pos] = idx
+}
+
+func appendIndexRecord(buf []byte, idx types.MessageIndex, loc location) []byte {
+	buf = binary.BigEndian.AppendUint64(buf, uint64(idx))
+	buf = binary.BigEndian.AppendUint32(buf, loc.segment)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(loc.offset))
+	return binary.BigEndian.AppendUint32(buf, loc.size)
+}
+
+func decodeIndexRecord(buf []byte) (types.MessageIndex, location) {
+	return types.MessageIndex(binary.BigEndian.Uint64(buf[0:8])), location{
+		segment: binary.BigEndian.Uint32(buf[8:12]),
+		offset:  int64(binary.BigEndian.Uint64(buf[12:20])),
+		size:    binary.BigEndian.Uint32(buf[20:24]),
+	}
+}
+
+// segmentReader reads entries from segment files, keeping the most recently
+// used segment open between reads.
+type segmentReader struct {
+	f       *os.File
+	segment uint32
+}
+
+func (r *segmentReader) read(dir string, loc location) ([]byte, error) {
+	if r.f == nil || r.segment != loc.segment {
+		r.close()
+		f, err := os.Open(segmentPath(dir, loc.segment))
+		if err != nil {
+			return nil, err
+		}
+		r.f, r.segment = f, loc.segment
+	}
+
+	data := make([]byte, loc.size)
+	if _, err := r.f.ReadAt(data, loc.offset); err != nil {
+		if err == io.EOF {
+			return nil, storage.ErrBadData
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *segmentReader) close() {
+	if r.f != nil {
+		r.f.Close()
+		r.f = nil
+	}
+}
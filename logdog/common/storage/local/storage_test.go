@@ -0,0 +1,256 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/logdog/common/storage"
+	"go.chromium.org/luci/logdog/common/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type rec struct {
+	index types.MessageIndex
+	data  string
+}
+
+func recData(idx types.MessageIndex) string {
+	return fmt.Sprintf("record #%d", idx)
+}
+
+func TestStorage(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A local Storage instance.`, t, func() {
+		c := context.Background()
+		root := t.TempDir()
+
+		st := &Storage{Root: root, MaxSegmentSize: 32}
+		defer func() { st.Close() }()
+
+		project := "test-project"
+		path := types.StreamPath("testing/+/foo/bar")
+
+		putRange := func(st *Storage, start types.MessageIndex, count int) error {
+			req := storage.PutRequest{
+				Project: project,
+				Path:    path,
+				Index:   start,
+			}
+			for i := 0; i < count; i++ {
+				req.Values = append(req.Values, []byte(recData(start+types.MessageIndex(i))))
+			}
+			return st.Put(c, req)
+		}
+
+		getAll := func(st *Storage, req storage.GetRequest) ([]rec, error) {
+			var recs []rec
+			err := st.Get(c, req, func(e *storage.Entry) bool {
+				idx, err := e.GetStreamIndex()
+				So(err, ShouldBeNil)
+				recs = append(recs, rec{idx, string(e.D)})
+				return true
+			})
+			return recs, err
+		}
+
+		expected := func(indices ...types.MessageIndex) []rec {
+			recs := make([]rec, len(indices))
+			for i, idx := range indices {
+				recs[i] = rec{idx, recData(idx)}
+			}
+			return recs
+		}
+
+		Convey(`Can Put() log stream records {0..5, 7, 8, 10}.`, func() {
+			So(putRange(st, 0, 6), ShouldBeNil)
+			So(putRange(st, 10, 1), ShouldBeNil)
+			So(putRange(st, 7, 2), ShouldBeNil)
+
+			all := expected(0, 1, 2, 3, 4, 5, 7, 8, 10)
+			req := storage.GetRequest{
+				Project: project,
+				Path:    path,
+			}
+
+			Convey(`Rolls over segments.`, func() {
+				segs, err := filepath.Glob(filepath.Join(st.streamDir(streamKey(project, path)), "*.seg"))
+				So(err, ShouldBeNil)
+				So(len(segs), ShouldBeGreaterThan, 1)
+			})
+
+			Convey(`Put() will return ErrExists when putting an existing entry.`, func() {
+				So(putRange(st, 4, 3), ShouldEqual, storage.ErrExists)
+
+				// Nothing from the failed request was written.
+				recs, err := getAll(st, req)
+				So(err, ShouldBeNil)
+				So(recs, ShouldResemble, all)
+			})
+
+			Convey(`Get() can retrieve all of the records correctly.`, func() {
+				recs, err := getAll(st, req)
+				So(err, ShouldBeNil)
+				So(recs, ShouldResemble, all)
+			})
+
+			Convey(`Get() starts at the requested index.`, func() {
+				req.Index = 6
+				recs, err := getAll(st, req)
+				So(err, ShouldBeNil)
+				So(recs, ShouldResemble, expected(7, 8, 10))
+			})
+
+			Convey(`Get() will adhere to GetRequest limit.`, func() {
+				req.Limit = 4
+				recs, err := getAll(st, req)
+				So(err, ShouldBeNil)
+				So(recs, ShouldResemble, all[:4])
+			})
+
+			Convey(`Get() will adhere to hard limit.`, func() {
+				st.MaxGetCount = 3
+				req.Limit = 4
+				recs, err := getAll(st, req)
+				So(err, ShouldBeNil)
+				So(recs, ShouldResemble, all[:3])
+			})
+
+			Convey(`Get() will omit data for KeysOnly requests.`, func() {
+				req.KeysOnly = true
+				var indices []types.MessageIndex
+				So(st.Get(c, req, func(e *storage.Entry) bool {
+					So(e.D, ShouldBeNil)
+					idx, err := e.GetStreamIndex()
+					So(err, ShouldBeNil)
+					indices = append(indices, idx)
+					return true
+				}), ShouldBeNil)
+				So(indices, ShouldResemble, []types.MessageIndex{0, 1, 2, 3, 4, 5, 7, 8, 10})
+			})
+
+			Convey(`Get() will stop iterating if callback returns false.`, func() {
+				count := 0
+				So(st.Get(c, req, func(*storage.Entry) bool {
+					count++
+					return false
+				}), ShouldBeNil)
+				So(count, ShouldEqual, 1)
+			})
+
+			Convey(`Get() will fail if the stream doesn't exist.`, func() {
+				req.Project = "project-does-not-exist"
+				_, err := getAll(st, req)
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+				req.Project = project
+				req.Path = "testing/+/does/not/exist"
+				_, err = getAll(st, req)
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+			})
+
+			Convey(`Tail() can retrieve the tail record, 10.`, func() {
+				e, err := st.Tail(c, project, path)
+				So(err, ShouldBeNil)
+				So(string(e.D), ShouldEqual, recData(10))
+				idx, err := e.GetStreamIndex()
+				So(err, ShouldBeNil)
+				So(idx, ShouldEqual, 10)
+			})
+
+			Convey(`Tail() will fail if the stream doesn't exist.`, func() {
+				_, err := st.Tail(c, project, "testing/+/does/not/exist")
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+			})
+
+			Convey(`Count() returns the number of records.`, func() {
+				So(st.Count(project, path), ShouldEqual, 9)
+			})
+
+			Convey(`Data survives reopening the storage.`, func() {
+				st.Close()
+				st = &Storage{Root: root, MaxSegmentSize: 32}
+
+				recs, err := getAll(st, req)
+				So(err, ShouldBeNil)
+				So(recs, ShouldResemble, all)
+
+				So(putRange(st, 11, 2), ShouldBeNil)
+				e, err := st.Tail(c, project, path)
+				So(err, ShouldBeNil)
+				So(string(e.D), ShouldEqual, recData(12))
+			})
+
+			Convey(`Recovers from a partially written index.`, func() {
+				st.Close()
+
+				indexPath := filepath.Join(st.streamDir(streamKey(project, path)), indexFileName)
+				f, err := os.OpenFile(indexPath, os.O_WRONLY|os.O_APPEND, 0)
+				So(err, ShouldBeNil)
+				// A complete record that points past the end of its segment, followed
+				// by a truncated one.
+				buf := appendIndexRecord(nil, 11, location{segment: 1000, offset: 0, size: 10})
+				buf = append(buf, 1, 2, 3)
+				_, err = f.Write(buf)
+				So(err, ShouldBeNil)
+				So(f.Close(), ShouldBeNil)
+
+				st = &Storage{Root: root, MaxSegmentSize: 32}
+				recs, err := getAll(st, req)
+				So(err, ShouldBeNil)
+				So(recs, ShouldResemble, all)
+
+				fi, err := os.Stat(indexPath)
+				So(err, ShouldBeNil)
+				So(fi.Size(), ShouldEqual, 9*indexRecordSize)
+			})
+
+			Convey(`Expunge() removes the stream.`, func() {
+				So(st.Expunge(c, storage.ExpungeRequest{Project: project, Path: path}), ShouldBeNil)
+
+				_, err := getAll(st, req)
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+				_, err = st.Tail(c, project, path)
+				So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+				// The stream can be written again.
+				So(putRange(st, 0, 1), ShouldBeNil)
+				recs, err := getAll(st, req)
+				So(err, ShouldBeNil)
+				So(recs, ShouldResemble, expected(0))
+			})
+
+			Convey(`Fails once closed.`, func() {
+				st.Close()
+				So(putRange(st, 20, 1), ShouldErrLike, "storage is closed")
+				_, err := getAll(st, req)
+				So(err, ShouldErrLike, "storage is closed")
+			})
+		})
+
+		Convey(`Tail() of an empty stream returns ErrDoesNotExist.`, func() {
+			So(st.Put(c, storage.PutRequest{Project: project, Path: path}), ShouldBeNil)
+			_, err := st.Tail(c, project, path)
+			So(err, ShouldEqual, storage.ErrDoesNotExist)
+		})
+	})
+}
@@ -17,6 +17,7 @@ package service
 import (
 	"context"
 	"flag"
+	"time"
 
 	"go.chromium.org/luci/server"
 	"go.chromium.org/luci/server/gaeemulation"
@@ -25,9 +26,13 @@ import (
 	logdog "go.chromium.org/luci/logdog/api/endpoints/coordinator/services/v1"
 	"go.chromium.org/luci/logdog/common/storage"
 	"go.chromium.org/luci/logdog/common/storage/bigtable"
+	"go.chromium.org/luci/logdog/common/storage/local"
 	"go.chromium.org/luci/logdog/server/config"
 )
 
+// cacheSweepInterval is how often the local cache is swept.
+const cacheSweepInterval = 10 * time.Minute
+
 // Implementations contains some preconfigured Logdog subsystem clients.
 type Implementations struct {
 	Storage     storage.Storage       // the intermediate storage
@@ -59,21 +64,50 @@ func Main(cfg MainCfg, init func(srv *server.Server, impl *Implementations) erro
 	}
 	storageFlags.Register(flag.CommandLine)
 
+	localFlags := local.Flags{}
+	localFlags.Register(flag.CommandLine)
+
 	server.Main(nil, modules, func(srv *server.Server) error {
 		if err := coordFlags.validate(); err != nil {
 			return err
 		}
-		if err := storageFlags.Validate(); err != nil {
+		if err := localFlags.Validate(); err != nil {
 			return err
 		}
+		if !localFlags.Enabled() {
+			if err := storageFlags.Validate(); err != nil {
+				return err
+			}
+		}
 
 		// Add an in-memory config caching to avoid hitting datastore all the time.
 		srv.Context = config.WithStore(srv.Context, &config.Store{})
 
 		// Initialize our Storage.
-		st, err := bigtable.StorageFromFlags(srv.Context, &storageFlags)
-		if err != nil {
-			return err
+		var st storage.Storage
+		if localFlags.Enabled() {
+			ls, err := local.StorageFromFlags(&localFlags)
+			if err != nil {
+				return err
+			}
+			st = ls
+		} else {
+			bt, err := bigtable.StorageFromFlags(srv.Context, &storageFlags)
+			if err != nil {
+				return err
+			}
+			// Put the local cache, if any, in front of BigTable.
+			if localFlags.CacheEnabled() {
+				cache, err := local.CacheFromFlags(&localFlags)
+				if err != nil {
+					return err
+				}
+				bt.Cache = cache
+				srv.RunInBackground("logdog.local-cache-sweeper", func(ctx context.Context) {
+					cache.RunSweeper(ctx, cacheSweepInterval)
+				})
+			}
+			st = bt
 		}
 		srv.RegisterCleanup(func(context.Context) { st.Close() })
 
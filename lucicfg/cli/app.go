@@ -31,9 +31,11 @@ import (
 
 	"go.chromium.org/luci/lucicfg"
 	"go.chromium.org/luci/lucicfg/cli/base"
+	"go.chromium.org/luci/lucicfg/cli/cmds/diff"
 	"go.chromium.org/luci/lucicfg/cli/cmds/fmt"
 	"go.chromium.org/luci/lucicfg/cli/cmds/generate"
 	"go.chromium.org/luci/lucicfg/cli/cmds/lint"
+	"go.chromium.org/luci/lucicfg/cli/cmds/test"
 	"go.chromium.org/luci/lucicfg/cli/cmds/validate"
 )
 
@@ -77,8 +79,10 @@ func GetApplication(params base.Parameters) *cli.Application {
 			subcommands.Section("Config generation\n"),
 			generate.Cmd(params),
 			validate.Cmd(params),
+			diff.Cmd(params),
 			fmt.Cmd(params),
 			lint.Cmd(params),
+			test.Cmd(params),
 
 			subcommands.Section("Authentication for LUCI Config\n"),
 			authcli.SubcommandInfo(params.AuthOptions, "auth-info", true),
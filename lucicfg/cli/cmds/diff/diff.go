@@ -0,0 +1,219 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff implements 'diff' subcommand.
+package diff
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	configpb "go.chromium.org/luci/config_service/proto"
+
+	"go.chromium.org/luci/lucicfg"
+	"go.chromium.org/luci/lucicfg/cli/base"
+)
+
+// Cmd is 'diff' subcommand.
+func Cmd(params base.Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "diff [options] SCRIPT",
+		ShortDesc: "shows a semantic diff of generated configs",
+		LongDesc: `Shows a semantic diff of generated configs.
+
+Interprets a high-level config and compares generated configs to their base
+version, which is either configs in the config directory at some git revision
+(HEAD by default, see -rev), or configs currently deployed to LUCI Config (if
+-deployed is given).
+
+Instead of a textual diff, prints what entities (builders, buckets, CQ groups,
+realms, etc) were added, removed or modified, and how exactly fields of modified
+entities have changed. Doesn't touch any files on disk.
+`,
+		CommandRun: func() subcommands.CommandRun {
+			dr := &diffRun{}
+			dr.Init(params)
+			dr.AddGeneratorFlags()
+			dr.Flags.StringVar(&dr.rev, "rev", "HEAD", "A git revision with the base version of configs.")
+			dr.Flags.BoolVar(&dr.deployed, "deployed", false, "Compare to configs currently deployed to LUCI Config instead of a git revision.")
+			return dr
+		},
+	}
+}
+
+type diffRun struct {
+	base.Subcommand
+
+	rev      string
+	deployed bool
+}
+
+type diffResult struct {
+	// Meta is the final meta parameters used by the generator.
+	Meta *lucicfg.Meta `json:"meta,omitempty"`
+	// Files is a list of changed config files.
+	Files []*lucicfg.FileDiff `json:"files,omitempty"`
+}
+
+func (dr *diffRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !dr.CheckArgs(args, 1, 1) {
+		return 1
+	}
+	ctx := cli.GetContext(a, dr, env)
+	return dr.Done(dr.run(ctx, args[0]))
+}
+
+func (dr *diffRun) run(ctx context.Context, inputFile string) (*diffResult, error) {
+	meta := dr.DefaultMeta()
+	state, err := base.GenerateConfigs(ctx, inputFile, &meta, &dr.Meta, dr.Vars)
+	if err != nil {
+		return nil, err
+	}
+	output := state.Output
+
+	var baseFiles map[string][]byte
+	if dr.deployed {
+		baseFiles, err = dr.fetchDeployed(ctx, output, meta.ConfigServiceHost)
+	} else {
+		if meta.ConfigDir == "-" {
+			return nil, base.NewCLIError("can't compare to a git revision when the config directory is '-', use -deployed instead")
+		}
+		baseFiles, err = readGitRevision(ctx, meta.ConfigDir, dr.rev)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Ignore files lucicfg doesn't manage.
+	tracked := lucicfg.TrackedSet(meta.TrackedFiles)
+	for f := range baseFiles {
+		if _, generated := output.Data[f]; !generated {
+			if yes, err := tracked(f); err != nil {
+				return nil, err
+			} else if !yes {
+				delete(baseFiles, f)
+			}
+		}
+	}
+
+	result := &diffResult{Meta: &meta}
+	if result.Files, err = output.Diff(baseFiles); err != nil {
+		return nil, err
+	}
+	if len(result.Files) == 0 {
+		fmt.Println("No semantic changes.")
+	}
+	for _, f := range result.Files {
+		fmt.Print(f.Format())
+	}
+	return result, nil
+}
+
+// fetchDeployed fetches deployed configs of all config sets in the output.
+//
+// Returns them as a map with paths relative to the output root.
+func (dr *diffRun) fetchDeployed(ctx context.Context, output lucicfg.Output, host string) (map[string][]byte, error) {
+	if host == "" {
+		return nil, base.MissingFlagError("-config-service-host")
+	}
+	conn, err := dr.MakeConfigServiceConn(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := configpb.NewConfigsClient(conn)
+
+	files := map[string][]byte{}
+	for name, root := range output.Roots {
+		logging.Infof(ctx, "Fetching deployed configs of %q from %s...", name, host)
+		cs, err := lucicfg.FetchConfigSet(ctx, client, name)
+		if err != nil {
+			return nil, err
+		}
+		for f, body := range cs.Data {
+			files[path.Join(root, f)] = body
+		}
+	}
+	return files, nil
+}
+
+// readGitRevision reads files in the directory as they are at the given git
+// revision.
+//
+// Returns them as a map with paths relative to the directory.
+func readGitRevision(ctx context.Context, dir, rev string) (map[string][]byte, error) {
+	// The config directory may not exist yet. Run git in its closest existing
+	// parent directory.
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	cwd, sub := dir, "."
+	for {
+		if _, err := os.Stat(cwd); err == nil {
+			break
+		}
+		parent := filepath.Dir(cwd)
+		if parent == cwd {
+			return nil, errors.Reason("no existing parent directory of %s", dir).Err()
+		}
+		sub = path.Join(filepath.Base(cwd), sub)
+		cwd = parent
+	}
+
+	git := func(args ...string) ([]byte, error) {
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, "git", append([]string{"-C", cwd}, args...)...)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return nil, errors.Annotate(err, "git %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String())).Err()
+		}
+		return stdout.Bytes(), nil
+	}
+
+	if _, err := git("rev-parse", "--verify", "--quiet", rev+"^{commit}"); err != nil {
+		return nil, errors.Reason("not a valid git revision %q", rev).Err()
+	}
+	listing, err := git("ls-tree", "-r", "-z", "--name-only", rev, "--", sub)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string][]byte{}
+	for _, name := range strings.Split(string(listing), "\x00") {
+		if name == "" {
+			continue
+		}
+		rel := name
+		if sub != "." {
+			rel = strings.TrimPrefix(name, sub+"/")
+		}
+		if files[rel], err = git("cat-file", "blob", fmt.Sprintf("%s:./%s", rev, name)); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package test implements 'test' subcommand.
+package test
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/starlark/interpreter"
+
+	"go.chromium.org/luci/lucicfg"
+	"go.chromium.org/luci/lucicfg/cli/base"
+)
+
+// testFileSuffix is a suffix of test scripts discovered by 'test' subcommand.
+const testFileSuffix = "_test.star"
+
+// Cmd is 'test' subcommand.
+func Cmd(params base.Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "test [options] [DIR]",
+		ShortDesc: "runs unit tests for Starlark configs",
+		LongDesc: `Runs unit tests for Starlark configs.
+
+Recursively discovers *_test.star scripts in the given directory (the current
+one by default) and runs all test_* functions defined in them. Each test
+function runs in a fresh state: the test script is executed from scratch, then
+the test function is called, and then configs are generated.
+
+The directory is used as the root of the main package, i.e. it is what '//'
+refers to in load(...) statements in test scripts.
+
+Test scripts can use 'assert' module with generic assertions and 'testing'
+module with helpers for examining generated configs.
+
+If -update-golden is given, testing.assert_golden(...) overwrites golden files
+with the generated configs instead of comparing them.
+`,
+		CommandRun: func() subcommands.CommandRun {
+			tr := &testRun{}
+			tr.Init(params)
+			tr.Flags.BoolVar(&tr.updateGolden, "update-golden", false, "Overwrite golden files with generated configs.")
+			return tr
+		},
+	}
+}
+
+type testRun struct {
+	base.Subcommand
+
+	updateGolden bool
+}
+
+type testResult struct {
+	// Tests is results of all executed tests.
+	Tests []*fileResult `json:"tests,omitempty"`
+}
+
+type fileResult struct {
+	File     string   `json:"file"`
+	Test     string   `json:"test"`
+	Passed   bool     `json:"passed"`
+	Failures []string `json:"failures,omitempty"`
+}
+
+func (tr *testRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !tr.CheckArgs(args, 0, 1) {
+		return 1
+	}
+	dir := "."
+	if len(args) == 1 {
+		dir = args[0]
+	}
+	ctx := cli.GetContext(a, tr, env)
+	return tr.Done(tr.run(ctx, dir))
+}
+
+func (tr *testRun) run(ctx context.Context, dir string) (*testResult, error) {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(d.Name(), testFileSuffix) {
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case len(files) == 0:
+		return nil, errors.Reason("no *%s files in %s", testFileSuffix, dir).Err()
+	}
+
+	res := &testResult{}
+	failed := 0
+	for _, file := range files {
+		results, err := lucicfg.RunTests(ctx, lucicfg.TestInputs{
+			Code:         interpreter.FileSystemLoader(root),
+			Path:         root,
+			Entry:        file,
+			UpdateGolden: tr.updateGolden,
+		})
+		if err != nil {
+			return res, err
+		}
+		for _, r := range results {
+			status := "PASS"
+			if !r.Passed() {
+				status = "FAIL"
+				failed++
+			}
+			fmt.Printf("%s %s:%s (%.2fs)\n", status, file, r.Name, r.Duration.Seconds())
+			for _, msg := range r.Logs {
+				fmt.Printf("    %s\n", msg)
+			}
+			for _, msg := range r.Failures {
+				fmt.Fprintf(os.Stderr, "%s\n", indent(msg, "    "))
+			}
+			res.Tests = append(res.Tests, &fileResult{
+				File:     file,
+				Test:     r.Name,
+				Passed:   r.Passed(),
+				Failures: r.Failures,
+			})
+		}
+	}
+
+	if failed != 0 {
+		return res, errors.Reason("%d of %d tests failed", failed, len(res.Tests)).Err()
+	}
+	return res, nil
+}
+
+// indent prefixes all lines in the text.
+func indent(text, prefix string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, l := range lines {
+		lines[i] = prefix + l
+	}
+	return strings.Join(lines, "\n")
+}
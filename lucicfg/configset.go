@@ -37,7 +37,9 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	legacy_config "go.chromium.org/luci/common/api/luci_config/config/v1"
 	"go.chromium.org/luci/common/clock"
@@ -421,6 +423,71 @@ func ReadConfigSet(dir, name string) (ConfigSet, error) {
 	}, nil
 }
 
+// FetchConfigSet fetches all files of the given config set as they are
+// currently deployed to LUCI Config.
+//
+// Returns an empty ConfigSet if LUCI Config doesn't know about it.
+func FetchConfigSet(ctx context.Context, client configpb.ConfigsClient, name string) (ConfigSet, error) {
+	cs, err := client.GetConfigSet(ctx, &configpb.GetConfigSetRequest{
+		ConfigSet: name,
+		Fields:    &fieldmaskpb.FieldMask{Paths: []string{"configs"}},
+	})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return ConfigSet{Name: name, Data: map[string][]byte{}}, nil
+	case err != nil:
+		return ConfigSet{}, errors.Annotate(err, "failed to fetch config set %q", name).Err()
+	}
+
+	var m sync.Mutex
+	configs := make(map[string][]byte, len(cs.Configs))
+	eg, ectx := errgroup.WithContext(ctx)
+	eg.SetLimit(8)
+	for _, cfg := range cs.Configs {
+		path := cfg.Path
+		eg.Go(func() error {
+			resp, err := client.GetConfig(ectx, &configpb.GetConfigRequest{
+				ConfigSet: name,
+				Path:      path,
+			})
+			if err != nil {
+				return errors.Annotate(err, "failed to fetch %q in %q", path, name).Err()
+			}
+			content := resp.GetRawContent()
+			if url := resp.GetSignedUrl(); url != "" {
+				if content, err = downloadSignedURL(ectx, url); err != nil {
+					return errors.Annotate(err, "failed to download %q in %q", path, name).Err()
+				}
+			}
+			m.Lock()
+			configs[path] = content
+			m.Unlock()
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return ConfigSet{}, err
+	}
+	return ConfigSet{Name: name, Data: configs}, nil
+}
+
+// downloadSignedURL fetches a large config file body from a signed URL.
+func downloadSignedURL(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Reason("HTTP %d", resp.StatusCode).Err()
+	}
+	return io.ReadAll(resp.Body)
+}
+
 // Files returns a sorted list of file names in the config set.
 func (cs ConfigSet) Files() []string {
 	f := make([]string, 0, len(cs.Data))
@@ -0,0 +1,501 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lucicfg
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.chromium.org/luci/common/proto/textpb"
+)
+
+// DiffKind is a kind of a change in a config file or an entity in it.
+type DiffKind string
+
+const (
+	DiffAdded    DiffKind = "added"
+	DiffRemoved  DiffKind = "removed"
+	DiffModified DiffKind = "modified"
+)
+
+// FileDiff is a semantic difference between two versions of a config file.
+type FileDiff struct {
+	Path     string        `json:"path"`               // relative to the output root
+	Kind     DiffKind      `json:"kind"`               // how the file changed
+	Entities []*EntityDiff `json:"entities,omitempty"` // changed entities, if known
+	Note     string        `json:"note,omitempty"`     // why entities are unknown
+}
+
+// EntityDiff is a difference in a single entity defined in a config file.
+//
+// Entities are things like builders, buckets, CQ groups or realms. Fields of
+// the config file that don't belong to any entity are represented by
+// an EntityDiff with empty Type and Name.
+type EntityDiff struct {
+	Kind   DiffKind     `json:"kind"`             // how the entity changed
+	Type   string       `json:"type,omitempty"`   // e.g. "builder"
+	Name   string       `json:"name,omitempty"`   // e.g. "ci/linux"
+	Fields []*FieldDiff `json:"fields,omitempty"` // changed fields if DiffModified
+}
+
+// FieldDiff is a change of a single field of an entity.
+//
+// For repeated fields, elements are compared as a set: each added or removed
+// element is a separate FieldDiff with only New or Old populated. If only the
+// order of elements changed, both Old and New have the entire list.
+type FieldDiff struct {
+	Path string `json:"path"`          // e.g. "swarming.dimensions"
+	Old  string `json:"old,omitempty"` // the old value or "" if unset
+	New  string `json:"new,omitempty"` // the new value or "" if unset
+}
+
+// Diff semantically compares this output to a base version of it.
+//
+// 'base' maps file paths relative to the output root to their bodies, e.g. it
+// can be configs committed to a repository or currently deployed configs.
+//
+// Text protos are compared per entity (see entitySpecs): added, removed and
+// modified builders, CQ groups, realms, etc. Fields of modified entities are
+// compared individually. Other files are just reported as changed.
+//
+// Files that are semantically equal are skipped. Returns diffs sorted by path.
+func (o Output) Diff(base map[string][]byte) ([]*FileDiff, error) {
+	paths := make([]string, 0, len(o.Data)+len(base))
+	for p := range o.Data {
+		paths = append(paths, p)
+	}
+	for p := range base {
+		if _, ok := o.Data[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	var out []*FileDiff
+	for _, p := range paths {
+		datum, generated := o.Data[p]
+		old, existed := base[p]
+
+		if !generated {
+			out = append(out, &FileDiff{Path: p, Kind: DiffRemoved})
+			continue
+		}
+
+		msg, _ := datum.(*MessageDatum)
+		if !existed {
+			diff := &FileDiff{Path: p, Kind: DiffAdded}
+			if msg != nil {
+				if err := msg.ensureConverted(); err != nil {
+					return nil, err
+				}
+				diff.Entities = diffEntities(nil, msg.pmsg)
+			}
+			out = append(out, diff)
+			continue
+		}
+
+		switch res, err := datum.Compare(old); {
+		case err != nil:
+			return nil, err
+		case res != Different:
+			continue
+		}
+
+		diff := &FileDiff{Path: p, Kind: DiffModified}
+		if msg != nil {
+			switch oldpb, err := msg.unmarshal(old); {
+			case err != nil:
+				diff.Note = fmt.Sprintf("the base version is not a valid %s: %s", msg.Message.MessageType().Descriptor().FullName(), err)
+			default:
+				diff.Entities = diffEntities(oldpb, msg.pmsg)
+			}
+		}
+		out = append(out, diff)
+	}
+	return out, nil
+}
+
+// Format returns a human-readable representation of the diff.
+func (d *FileDiff) Format() string {
+	buf := strings.Builder{}
+	fmt.Fprintf(&buf, "%s %s\n", diffSymbol(d.Kind), d.Path)
+	if d.Note != "" {
+		fmt.Fprintf(&buf, "    (%s)\n", d.Note)
+	}
+	for _, e := range d.Entities {
+		if e.Type == "" {
+			fmt.Fprintf(&buf, "    %s top-level fields\n", diffSymbol(e.Kind))
+		} else {
+			fmt.Fprintf(&buf, "    %s %s %s\n", diffSymbol(e.Kind), e.Type, e.Name)
+		}
+		for _, f := range e.Fields {
+			switch {
+			case f.Old != "" && f.New != "":
+				fmt.Fprintf(&buf, "        %s: %s -> %s\n", f.Path, f.Old, f.New)
+			case f.Old != "":
+				fmt.Fprintf(&buf, "        %s: - %s\n", f.Path, f.Old)
+			default:
+				fmt.Fprintf(&buf, "        %s: + %s\n", f.Path, f.New)
+			}
+		}
+	}
+	return buf.String()
+}
+
+func diffSymbol(k DiffKind) string {
+	switch k {
+	case DiffAdded:
+		return "+"
+	case DiffRemoved:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Entities.
+
+// entitySpec describes where to find entities of some type in a config file.
+type entitySpec struct {
+	typ  string   // e.g. "builder"
+	path []string // path to a repeated field with entities
+	key  string   // a field with the entity name, e.g. "name"
+}
+
+// entitySpecs describes entities in known LUCI configs, see also protos.go.
+//
+// If an entity is nested in another one (like builders in buckets), it should
+// be listed first, so it is removed from the parent entity before the parent is
+// compared. Names of nested entities include names of their parents.
+var entitySpecs = map[protoreflect.FullName][]entitySpec{
+	"buildbucket.BuildbucketCfg": {
+		{"builder", []string{"buckets", "swarming", "builders"}, "name"},
+		{"bucket", []string{"buckets"}, "name"},
+	},
+	"cv.config.Config": {
+		{"cq_group", []string{"config_groups"}, "name"},
+	},
+	"auth_service.RealmsCfg": {
+		{"realm", []string{"realms"}, "name"},
+		{"custom_role", []string{"custom_roles"}, "name"},
+	},
+	"scheduler.config.ProjectConfig": {
+		{"job", []string{"job"}, "id"},
+		{"trigger", []string{"trigger"}, "id"},
+	},
+	"luci.milo.projectconfig.Project": {
+		{"console", []string{"consoles"}, "id"},
+	},
+	"notify.ProjectConfig": {
+		{"notifier", []string{"notifiers"}, "name"},
+	},
+}
+
+// entity is a part of a config message.
+type entity struct {
+	order int    // index in the entitySpecs list, -1 for top-level fields
+	typ   string // e.g. "builder"
+	name  string // e.g. "ci/linux"
+	msg   protoreflect.Message
+}
+
+func (e *entity) id() string {
+	return e.typ + "\x00" + e.name
+}
+
+// splitEntities splits a config message into entities per entitySpecs.
+//
+// Fields that don't belong to any entity are returned as an entity with empty
+// type and name. Doesn't modify 'msg'.
+func splitEntities(msg proto.Message) []*entity {
+	rest := proto.Clone(msg).ProtoReflect()
+	var out []*entity
+	for i, spec := range entitySpecs[rest.Descriptor().FullName()] {
+		extractEntities(rest, spec.path, spec.key, nil, func(names []string, m protoreflect.Message) {
+			out = append(out, &entity{
+				order: i,
+				typ:   spec.typ,
+				name:  strings.Join(names, "/"),
+				msg:   m,
+			})
+		})
+	}
+	return append(out, &entity{order: -1, msg: rest})
+}
+
+// extractEntities visits and removes all messages in 'm' at the given path.
+//
+// Repeated fields along the path contribute names of elements to 'names'.
+func extractEntities(m protoreflect.Message, path []string, key string, names []string, visit func(names []string, m protoreflect.Message)) {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil || fd.Message() == nil || fd.IsMap() || !m.Has(fd) {
+		return
+	}
+
+	if !fd.IsList() {
+		if len(path) > 1 {
+			extractEntities(m.Mutable(fd).Message(), path[1:], key, names, visit)
+		}
+		return
+	}
+
+	list := m.Mutable(fd).List()
+	keyFD := fd.Message().Fields().ByName(protoreflect.Name(key))
+	for i := 0; i < list.Len(); i++ {
+		elem := list.Get(i).Message()
+		name := fmt.Sprintf("#%d", i)
+		if keyFD != nil && elem.Has(keyFD) {
+			name = elem.Get(keyFD).String()
+		}
+		elemNames := append(append([]string(nil), names...), name)
+		if len(path) == 1 {
+			visit(elemNames, elem)
+		} else {
+			extractEntities(elem, path[1:], key, elemNames, visit)
+		}
+	}
+	if len(path) == 1 {
+		m.Clear(fd)
+	}
+}
+
+// diffEntities compares entities in two versions of a config message.
+//
+// Either of them can be nil.
+func diffEntities(old, cur proto.Message) []*EntityDiff {
+	byID := map[string][2]*entity{}
+	var all []*entity
+	collect := func(msg proto.Message, idx int) {
+		if msg == nil {
+			return
+		}
+		for _, e := range splitEntities(msg) {
+			pair, seen := byID[e.id()]
+			if !seen {
+				all = append(all, e)
+			}
+			pair[idx] = e
+			byID[e.id()] = pair
+		}
+	}
+	collect(old, 0)
+	collect(cur, 1)
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].order != all[j].order {
+			return all[i].order < all[j].order
+		}
+		return all[i].name < all[j].name
+	})
+
+	var out []*EntityDiff
+	for _, e := range all {
+		pair := byID[e.id()]
+		diff := &EntityDiff{Type: e.typ, Name: e.name}
+		switch {
+		case pair[0] == nil:
+			diff.Kind = DiffAdded
+		case pair[1] == nil:
+			diff.Kind = DiffRemoved
+		default:
+			diff.Kind = DiffModified
+			diff.Fields = diffMessages("", pair[0].msg, pair[1].msg, nil)
+		}
+		switch {
+		case e.typ == "" && diff.Kind != DiffModified:
+			// Top-level fields of added or removed files are not interesting.
+		case diff.Kind == DiffModified && len(diff.Fields) == 0:
+			// The entity is semantically the same.
+		default:
+			out = append(out, diff)
+		}
+	}
+	return out
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Fields.
+
+// diffMessages appends differences in fields of two messages of the same type.
+func diffMessages(prefix string, x, y protoreflect.Message, out []*FieldDiff) []*FieldDiff {
+	fields := x.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !shouldVisit(fd) {
+			continue
+		}
+		hx, hy := x.Has(fd), y.Has(fd)
+		if !hx && !hy {
+			continue
+		}
+
+		path := string(fd.Name())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		switch {
+		case fd.IsList():
+			out = diffLists(path, fd, x.Get(fd).List(), y.Get(fd).List(), out)
+		case fd.IsMap():
+			out = diffMaps(path, fd, x.Get(fd).Map(), y.Get(fd).Map(), out)
+		case fd.Message() != nil && hx && hy:
+			out = diffMessages(path, x.Get(fd).Message(), y.Get(fd).Message(), out)
+		case !hx || !hy || !equalValue(fd, x.Get(fd), y.Get(fd)):
+			diff := &FieldDiff{Path: path}
+			if hx {
+				diff.Old = formatValue(fd, x.Get(fd))
+			}
+			if hy {
+				diff.New = formatValue(fd, y.Get(fd))
+			}
+			out = append(out, diff)
+		}
+	}
+	return out
+}
+
+// diffLists appends differences in two lists, comparing them as sets.
+func diffLists(path string, fd protoreflect.FieldDescriptor, x, y protoreflect.List, out []*FieldDiff) []*FieldDiff {
+	matched := make([]bool, y.Len())
+	reordered := false
+	var removed []*FieldDiff
+
+	for i := 0; i < x.Len(); i++ {
+		found := -1
+		for j := 0; j < y.Len(); j++ {
+			if !matched[j] && equalValue(fd, x.Get(i), y.Get(j)) {
+				found = j
+				break
+			}
+		}
+		if found == -1 {
+			removed = append(removed, &FieldDiff{Path: path, Old: formatValue(fd, x.Get(i))})
+		} else {
+			matched[found] = true
+			reordered = reordered || found != i
+		}
+	}
+
+	out = append(out, removed...)
+	added := false
+	for j := 0; j < y.Len(); j++ {
+		if !matched[j] {
+			out = append(out, &FieldDiff{Path: path, New: formatValue(fd, y.Get(j))})
+			added = true
+		}
+	}
+
+	if reordered && len(removed) == 0 && !added {
+		out = append(out, &FieldDiff{
+			Path: path,
+			Old:  formatList(fd, x),
+			New:  formatList(fd, y),
+		})
+	}
+	return out
+}
+
+// diffMaps appends differences in two maps, comparing values key by key.
+func diffMaps(path string, fd protoreflect.FieldDescriptor, x, y protoreflect.Map, out []*FieldDiff) []*FieldDiff {
+	keys := map[string]protoreflect.MapKey{}
+	collect := func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[formatValue(fd.MapKey(), k.Value())] = k
+		return true
+	}
+	x.Range(collect)
+	y.Range(collect)
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	vfd := fd.MapValue()
+	for _, k := range sorted {
+		key := keys[k]
+		elemPath := fmt.Sprintf("%s[%s]", path, k)
+		hx, hy := x.Has(key), y.Has(key)
+		switch {
+		case vfd.Message() != nil && hx && hy:
+			out = diffMessages(elemPath, x.Get(key).Message(), y.Get(key).Message(), out)
+		case !hx || !hy || !equalValue(vfd, x.Get(key), y.Get(key)):
+			diff := &FieldDiff{Path: elemPath}
+			if hx {
+				diff.Old = formatValue(vfd, x.Get(key))
+			}
+			if hy {
+				diff.New = formatValue(vfd, y.Get(key))
+			}
+			out = append(out, diff)
+		}
+	}
+	return out
+}
+
+// formatValue formats a single (non-list, non-map) value for a diff.
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		// Note: prototext output has randomized whitespace, normalize it.
+		msg := v.Message()
+		blob, err := prototext.MarshalOptions{AllowPartial: true}.Marshal(msg.Interface())
+		if err == nil {
+			blob, err = textpb.Format(blob, msg.Descriptor())
+		}
+		if err != nil {
+			return fmt.Sprintf("<%s>", err)
+		}
+		lines := strings.Split(strings.TrimSpace(string(blob)), "\n")
+		for i, l := range lines {
+			lines[i] = strings.TrimSpace(l)
+		}
+		return "{" + strings.Join(lines, " ") + "}"
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.StringKind:
+		return strconv.Quote(v.String())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%q", v.Bytes())
+	default:
+		return v.String()
+	}
+}
+
+// formatList formats a list of values for a diff.
+func formatList(fd protoreflect.FieldDescriptor, l protoreflect.List) string {
+	buf := bytes.Buffer{}
+	buf.WriteString("[")
+	for i := 0; i < l.Len(); i++ {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(formatValue(fd, l.Get(i)))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
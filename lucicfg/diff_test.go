@@ -0,0 +1,182 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lucicfg
+
+import (
+	"context"
+	"testing"
+
+	"go.starlark.net/starlark"
+
+	"go.chromium.org/luci/starlark/interpreter"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	generate := func(code string) Output {
+		state, err := Generate(ctx, Inputs{
+			Code: interpreter.MemoryLoader(map[string]string{
+				"main.star": `
+luci.project(
+    name = "proj",
+    buildbucket = "cr-buildbucket.appspot.com",
+    swarming = "chromium-swarm.appspot.com",
+)
+luci.bucket(name = "ci")
+` + code,
+			}),
+			Entry:              "main.star",
+			testThreadModifier: func(*starlark.Thread) {},
+		})
+		So(err, ShouldBeNil)
+		return state.Output
+	}
+
+	asBase := func(o Output) map[string][]byte {
+		base := map[string][]byte{}
+		for name, datum := range o.Data {
+			var err error
+			base[name], err = datum.Bytes()
+			So(err, ShouldBeNil)
+		}
+		return base
+	}
+
+	Convey("Works", t, func() {
+		base := asBase(generate(`
+luci.builder(
+    name = "linux",
+    bucket = "ci",
+    executable = luci.recipe(name = "recipe", cipd_package = "recipe/bundles/main"),
+    dimensions = {"os": "Linux", "cpu": "x86-64"},
+    execution_timeout = 3 * time.hour,
+)
+luci.builder(
+    name = "old",
+    bucket = "ci",
+    executable = "recipe",
+)
+`))
+		base["something.txt"] = []byte("removed")
+
+		diff, err := generate(`
+luci.builder(
+    name = "linux",
+    bucket = "ci",
+    executable = luci.recipe(name = "recipe", cipd_package = "recipe/bundles/main"),
+    dimensions = {"os": "Ubuntu", "cpu": "x86-64"},
+    execution_timeout = 4 * time.hour,
+)
+luci.builder(
+    name = "new",
+    bucket = "ci",
+    executable = "recipe",
+)
+`).Diff(base)
+		So(err, ShouldBeNil)
+
+		So(diff, ShouldHaveLength, 2)
+		So(diff[0], ShouldResemble, &FileDiff{
+			Path: "cr-buildbucket.cfg",
+			Kind: DiffModified,
+			Entities: []*EntityDiff{
+				{
+					Kind: DiffModified,
+					Type: "builder",
+					Name: "ci/linux",
+					Fields: []*FieldDiff{
+						{Path: "dimensions", Old: `"os:Linux"`},
+						{Path: "dimensions", New: `"os:Ubuntu"`},
+						{Path: "execution_timeout_secs", Old: "10800", New: "14400"},
+					},
+				},
+				{Kind: DiffAdded, Type: "builder", Name: "ci/new"},
+				{Kind: DiffRemoved, Type: "builder", Name: "ci/old"},
+			},
+		})
+		So(diff[1], ShouldResemble, &FileDiff{
+			Path: "something.txt",
+			Kind: DiffRemoved,
+		})
+
+		So(diff[0].Format(), ShouldEqual, `~ cr-buildbucket.cfg
+    ~ builder ci/linux
+        dimensions: - "os:Linux"
+        dimensions: + "os:Ubuntu"
+        execution_timeout_secs: 10800 -> 14400
+    + builder ci/new
+    - builder ci/old
+`)
+	})
+
+	Convey("No changes", t, func() {
+		out := generate("")
+		base := asBase(out)
+		diff, err := out.Diff(base)
+		So(err, ShouldBeNil)
+		So(diff, ShouldBeEmpty)
+	})
+
+	Convey("Removed list elements", t, func() {
+		base := asBase(generate(`luci.bucket(name = "try", acls = [
+    acl.entry(acl.BUILDBUCKET_READER, groups = ["a"]),
+    acl.entry(acl.BUILDBUCKET_READER, groups = ["b"]),
+])`))
+		diff, err := generate(`luci.bucket(name = "try", acls = [
+    acl.entry(acl.BUILDBUCKET_READER, groups = ["b"]),
+])`).Diff(base)
+		So(err, ShouldBeNil)
+		So(diff[0].Entities, ShouldResemble, []*EntityDiff{
+			{
+				Kind: DiffModified,
+				Type: "bucket",
+				Name: "try",
+				Fields: []*FieldDiff{
+					{Path: "acls", Old: `{group: "a"}`},
+				},
+			},
+		})
+	})
+
+	Convey("Unparsable base", t, func() {
+		out := generate("")
+		base := asBase(out)
+		base["project.cfg"] = []byte("not a text proto")
+		diff, err := out.Diff(base)
+		So(err, ShouldBeNil)
+		So(diff, ShouldHaveLength, 1)
+		So(diff[0].Kind, ShouldEqual, DiffModified)
+		So(diff[0].Note, ShouldContainSubstring, "the base version is not a valid config.ProjectCfg")
+	})
+
+	Convey("Added file lists entities", t, func() {
+		diff, err := generate(`luci.bucket(name = "try")`).Diff(nil)
+		So(err, ShouldBeNil)
+		for _, d := range diff {
+			if d.Path == "cr-buildbucket.cfg" {
+				So(d.Kind, ShouldEqual, DiffAdded)
+				So(d.Entities, ShouldResemble, []*EntityDiff{
+					{Kind: DiffAdded, Type: "bucket", Name: "ci"},
+					{Kind: DiffAdded, Type: "bucket", Name: "try"},
+				})
+			}
+		}
+	})
+}
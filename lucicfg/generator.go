@@ -51,6 +51,8 @@ type Inputs struct {
 	testThreadModifier          func(th *starlark.Thread)
 	testDisableFailureCollector bool
 	testVersion                 string
+	testFunc                    string // a test function being run, see RunTests
+	testUpdateGolden            bool   // see TestInputs.UpdateGolden
 }
 
 // Generate interprets the high-level config.
@@ -163,16 +165,10 @@ func Generate(ctx context.Context, in Inputs) (*State, error) {
 	}
 
 	// Executing the script (with all its dependencies) populated the graph.
-	// Finalize it. This checks there are no dangling edges, freezes the graph,
-	// and makes it queryable, so generator callbacks can traverse it.
-	if errs := state.graph.Finalize(); len(errs) != 0 {
-		return nil, state.err(errs...)
-	}
-
-	// The script registered a bunch of callbacks that take the graph and
-	// transform it into actual output config files. Run these callbacks now.
-	genCtx := newGenCtx()
-	if errs := state.generators.call(intr.Thread(ctx), genCtx); len(errs) != 0 {
+	// Finalize it and run generator callbacks that transform it into actual
+	// output config files. Tests may have done it already, see generate().
+	genCtx, errs := state.generate(intr.Thread(ctx))
+	if len(errs) != 0 {
 		return nil, state.err(errs...)
 	}
 	output, err := genCtx.assembleOutput(!in.testOmitHeader)
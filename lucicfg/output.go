@@ -154,12 +154,8 @@ func (m *MessageDatum) Compare(other []byte) (CompareResult, error) {
 	}
 
 	// Try to load `other` as a proto message of the same type.
-	otherpb := dynamicpb.NewMessage(m.Message.MessageType().Descriptor())
-	opts := prototext.UnmarshalOptions{
-		AllowPartial: true,
-		Resolver:     m.Message.MessageType().Loader().Types(), // used for google.protobuf.Any fields
-	}
-	if err := opts.Unmarshal(other, otherpb); err != nil {
+	otherpb, err := m.unmarshal(other)
+	if err != nil {
 		return Different, nil // e.g. the schema has changed or the file is totally bogus
 	}
 
@@ -170,6 +166,20 @@ func (m *MessageDatum) Compare(other []byte) (CompareResult, error) {
 	return Different, nil
 }
 
+// unmarshal deserializes `other` as a text proto message of the same type as
+// `m.Message`.
+func (m *MessageDatum) unmarshal(other []byte) (proto.Message, error) {
+	otherpb := dynamicpb.NewMessage(m.Message.MessageType().Descriptor())
+	opts := prototext.UnmarshalOptions{
+		AllowPartial: true,
+		Resolver:     m.Message.MessageType().Loader().Types(), // used for google.protobuf.Any fields
+	}
+	if err := opts.Unmarshal(other, otherpb); err != nil {
+		return nil, err
+	}
+	return otherpb, nil
+}
+
 // ConfigSets partitions this output into 0 or more config sets based on Roots.
 //
 // Returns an error if some output Datum can't be serialized.
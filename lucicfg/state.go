@@ -54,12 +54,15 @@ type State struct {
 	seenErrs    stringset.Set     // set of all string backtraces in 'errors', for deduping
 	failOnErrs  bool              // if true, 'emit_error' aborts the execution
 
-	generators generators     // callbacks that generate config files based on state
-	graph      graph.Graph    // the graph with config entities defined so far
-	templates  templateCache  // cached parsed text templates, see templates.go
-	interner   stringInterner // a general purpose string interner used by other guts
-	files      fileCache      // cache of files read from disk, see io.go
-	protos     protoCache     // cache of deserialized protos, see protos.go
+	generators generators        // callbacks that generate config files based on state
+	generated  bool              // true if generate() was called already
+	genCtx     *genCtx           // the result of generate(), if succeeded
+	genErrs    errors.MultiError // errors from generate()
+	graph      graph.Graph       // the graph with config entities defined so far
+	templates  templateCache     // cached parsed text templates, see templates.go
+	interner   stringInterner    // a general purpose string interner used by other guts
+	files      fileCache         // cache of files read from disk, see io.go
+	protos     protoCache        // cache of deserialized protos, see protos.go
 }
 
 // NewState initializes the state.
@@ -122,6 +125,28 @@ func (s *State) err(err ...error) error {
 	return s.errors
 }
 
+// generate finalizes the graph and calls all registered generator callbacks,
+// returning the context with the generated output.
+//
+// Finalizing checks there are no dangling edges, freezes the graph, and makes
+// it queryable, so generator callbacks can traverse it.
+//
+// It is called at the end of the execution, or earlier by tests that examine
+// the generated output. Only the first call actually does something, all
+// subsequent calls return its result.
+func (s *State) generate(th *starlark.Thread) (*genCtx, errors.MultiError) {
+	if !s.generated {
+		s.generated = true
+		if s.genErrs = s.graph.Finalize(); len(s.genErrs) == 0 {
+			ctx := newGenCtx()
+			if s.genErrs = s.generators.call(th, ctx); len(s.genErrs) == 0 {
+				s.genCtx = ctx
+			}
+		}
+	}
+	return s.genCtx, s.genErrs
+}
+
 var stateCtxKey = "lucicfg.State"
 
 // withState puts *State into the context, to be accessed by native functions.
@@ -0,0 +1,326 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lucicfg
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarktest"
+	"go.starlark.net/syntax"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/starlark/interpreter"
+)
+
+// TestFuncPrefix is a prefix of names of test functions in test scripts.
+const TestFuncPrefix = "test_"
+
+// TestInputs describe a test script to run via RunTests.
+type TestInputs struct {
+	Code  interpreter.Loader // a package with the user supplied code
+	Path  string             // absolute path to the main package
+	Entry string             // a name of the test script in this package
+
+	// UpdateGolden, if true, makes testing.assert_golden(...) overwrite golden
+	// files with the generated configs instead of comparing them.
+	UpdateGolden bool
+}
+
+// TestResult is an outcome of a single test function.
+type TestResult struct {
+	Name     string        // name of the test function, e.g. "test_something"
+	Failures []string      // failed assertions and errors, empty if passed
+	Logs     []string      // messages printed via print(...)
+	Duration time.Duration // how long the test took
+}
+
+// Passed is true if the test has no failures.
+func (r *TestResult) Passed() bool {
+	return len(r.Failures) == 0
+}
+
+// Error is part of starlarktest.Reporter interface.
+//
+// It is called by failed assertions.
+func (r *TestResult) Error(args ...any) {
+	r.Failures = append(r.Failures, fmt.Sprint(args...))
+}
+
+// RunTests runs all test functions defined in a test script.
+//
+// Test functions are top-level functions without arguments with names that
+// start with "test_". Each test function runs in a fresh generator state (and
+// thus with an empty graph): the test script is executed from scratch,
+// including its top-level statements, and then the test function is called.
+// Configs are generated right after it returns, or earlier if the test itself
+// asks for them via testing.* functions.
+//
+// Tests have access to 'assert' module with generic assertions (see
+// go.starlark.net/starlarktest) and 'testing' module with lucicfg-specific
+// helpers (see testing.star).
+//
+// Returns an error if the test script can't be loaded or has no test
+// functions. Failures of individual tests are reported in TestResult.
+func RunTests(ctx context.Context, in TestInputs) ([]*TestResult, error) {
+	names, err := findTestFuncs(in.Code, in.Entry)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, errors.Reason("%s: no test functions, their names should start with %q", in.Entry, TestFuncPrefix).Err()
+	}
+
+	assertMod, err := starlarktest.LoadAssertModule()
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to load the assert module").Err()
+	}
+
+	results := make([]*TestResult, len(names))
+	for i, name := range names {
+		res := &TestResult{Name: name}
+		started := clock.Now(ctx)
+		_, err := Generate(ctx, Inputs{
+			Code:  withTestCall(in.Code, in.Entry, name),
+			Path:  in.Path,
+			Entry: in.Entry,
+
+			testFunc:         name,
+			testUpdateGolden: in.UpdateGolden,
+			testPredeclared:  assertMod,
+			testThreadModifier: func(th *starlark.Thread) {
+				starlarktest.SetReporter(th, res)
+				th.Print = func(_ *starlark.Thread, msg string) { res.Logs = append(res.Logs, msg) }
+			},
+			// Tests use assert.fails(...) which doesn't play well with the failure
+			// collector. See the comment in starlark_test.go.
+			testDisableFailureCollector: true,
+		})
+		if err != nil {
+			// Note: can't use errors.WalkLeaves, since it unwraps backtraces.
+			merr, ok := err.(errors.MultiError)
+			if !ok {
+				merr = errors.MultiError{err}
+			}
+			for _, err := range merr {
+				if bt, ok := err.(BacktracableError); ok {
+					res.Failures = append(res.Failures, bt.Backtrace())
+				} else {
+					res.Failures = append(res.Failures, err.Error())
+				}
+			}
+		}
+		res.Duration = clock.Since(ctx, started)
+		results[i] = res
+	}
+	return results, nil
+}
+
+// findTestFuncs parses the test script and returns names of test functions
+// defined in it, in order of their definition.
+func findTestFuncs(code interpreter.Loader, entry string) ([]string, error) {
+	_, src, err := code(entry)
+	if err != nil {
+		return nil, err
+	}
+	f, err := syntax.Parse(entry, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, stmt := range f.Stmts {
+		if def, ok := stmt.(*syntax.DefStmt); ok && strings.HasPrefix(def.Name.Name, TestFuncPrefix) {
+			if len(def.Params) != 0 {
+				return nil, errors.Reason("%s: test function %s must not have arguments", entry, def.Name.Name).Err()
+			}
+			names = append(names, def.Name.Name)
+		}
+	}
+	return names, nil
+}
+
+// withTestCall returns a loader that appends a call of the given test function
+// to the test script.
+//
+// This way the test function runs as part of the script execution, and it can
+// declare new rules just like the top-level code can.
+func withTestCall(code interpreter.Loader, entry, name string) interpreter.Loader {
+	return func(path string) (starlark.StringDict, string, error) {
+		dict, src, err := code(path)
+		if err == nil && dict == nil && path == entry {
+			src = fmt.Sprintf("%s\n%s()\n", src, name)
+		}
+		return dict, src, err
+	}
+}
+
+// compareGolden compares generated configs to files in the golden directory,
+// returning a list of found differences.
+//
+// If the state was created by RunTests with UpdateGolden, updates the golden
+// directory instead and returns nothing.
+//
+// 'dir' is relative to the directory of the test script, or to the main package
+// root if it starts with "//". If 'configSet' is not empty, compares only files
+// that belong to this config set (relative to the config set root).
+func compareGolden(th *starlark.Thread, s *State, dir, configSet string) ([]string, error) {
+	genCtx, errs := s.generate(th)
+	if len(errs) != 0 {
+		return nil, errs
+	}
+	out, err := genCtx.assembleOutput(!s.Inputs.testOmitHeader)
+	if err != nil {
+		return nil, err
+	}
+	if configSet != "" {
+		if out, err = configSetOutput(out, configSet); err != nil {
+			return nil, err
+		}
+	}
+
+	base := path.Dir(s.Inputs.Entry)
+	if strings.HasPrefix(dir, "//") {
+		base, dir = "", dir[2:]
+	}
+	rel, err := cleanRelativePath(base, dir, false)
+	if err != nil {
+		return nil, err
+	}
+	abs := filepath.Join(s.Inputs.Path, filepath.FromSlash(rel))
+
+	// Files in the golden directory that are not generated anymore.
+	var stale []string
+	err = filepath.WalkDir(abs, func(p string, d fs.DirEntry, err error) error {
+		switch {
+		case os.IsNotExist(err) && p == abs:
+			return filepath.SkipDir // no golden files yet
+		case err != nil:
+			return err
+		case d.IsDir():
+			return nil
+		}
+		name, err := filepath.Rel(abs, p)
+		if err != nil {
+			return err
+		}
+		if name = filepath.ToSlash(name); out.Data[name] == nil {
+			stale = append(stale, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "reading golden files").Err()
+	}
+
+	if s.Inputs.testUpdateGolden {
+		for _, name := range stale {
+			if err := os.Remove(filepath.Join(abs, filepath.FromSlash(name))); err != nil {
+				return nil, err
+			}
+		}
+		_, _, err := out.Write(abs, true)
+		return nil, err
+	}
+
+	cmp, err := out.Compare(abs, true)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []string
+	for _, name := range out.Files() {
+		if cmp[name] == Different {
+			diffs = append(diffs, fmt.Sprintf("%s: differs from the generated file", path.Join(rel, name)))
+		}
+	}
+	for _, name := range stale {
+		diffs = append(diffs, fmt.Sprintf("%s: is not generated", path.Join(rel, name)))
+	}
+	return diffs, nil
+}
+
+// configSetOutput returns output files that belong to the given config set, with
+// paths relative to its root.
+func configSetOutput(out Output, configSet string) (Output, error) {
+	root, ok := out.Roots[configSet]
+	if !ok {
+		known := make([]string, 0, len(out.Roots))
+		for name := range out.Roots {
+			known = append(known, name)
+		}
+		sort.Strings(known)
+		return Output{}, errors.Reason("no config set %q, generated config sets are %q", configSet, known).Err()
+	}
+	prefix := ""
+	if root = path.Clean(root); root != "." {
+		prefix = root + "/"
+	}
+	data := map[string]Datum{}
+	for name, datum := range out.Data {
+		if name = path.Clean(name); strings.HasPrefix(name, prefix) {
+			data[name[len(prefix):]] = datum
+		}
+	}
+	return Output{
+		Data:  data,
+		Roots: map[string]string{configSet: "."},
+	}, nil
+}
+
+func init() {
+	// test_generate() generates configs and returns the output, for tests.
+	declNative("test_generate", func(call nativeCall) (starlark.Value, error) {
+		if err := call.unpack(0); err != nil {
+			return nil, err
+		}
+		if call.State.Inputs.testFunc == "" {
+			return nil, errors.New("can be used only in tests executed by 'lucicfg test'")
+		}
+		genCtx, errs := call.State.generate(call.Thread)
+		if len(errs) != 0 {
+			return nil, errs
+		}
+		return genCtx.output, nil
+	})
+
+	// test_golden(dir, config_set) compares generated configs to golden files,
+	// returning a list of differences.
+	declNative("test_golden", func(call nativeCall) (starlark.Value, error) {
+		var dir, configSet starlark.String
+		if err := call.unpack(1, &dir, &configSet); err != nil {
+			return nil, err
+		}
+		if call.State.Inputs.testFunc == "" {
+			return nil, errors.New("can be used only in tests executed by 'lucicfg test'")
+		}
+		diffs, err := compareGolden(call.Thread, call.State, dir.GoString(), configSet.GoString())
+		if err != nil {
+			return nil, err
+		}
+		out := make([]starlark.Value, len(diffs))
+		for i, d := range diffs {
+			out[i] = starlark.String(d)
+		}
+		return starlark.NewList(out), nil
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lucicfg
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/starlark/interpreter"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+const testConfigs = `
+luci.project(
+    name = "proj",
+    buildbucket = "cr-buildbucket.appspot.com",
+    swarming = "chromium-swarm.appspot.com",
+)
+luci.bucket(name = "try")
+luci.builder(
+    name = "linux",
+    bucket = "try",
+    executable = luci.recipe(name = "recipe", cipd_package = "recipe/bundles/main"),
+    dimensions = {"os": "Linux"},
+)
+`
+
+func TestRunTests(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	Convey("With temp dir", t, func() {
+		tmp := t.TempDir()
+
+		run := func(script string, update bool) ([]*TestResult, error) {
+			So(os.WriteFile(filepath.Join(tmp, "cfg_test.star"), []byte(testConfigs+script), 0600), ShouldBeNil)
+			return RunTests(ctx, TestInputs{
+				Code:         interpreter.FileSystemLoader(tmp),
+				Path:         tmp,
+				Entry:        "cfg_test.star",
+				UpdateGolden: update,
+			})
+		}
+
+		Convey("Passing and failing tests", func() {
+			res, err := run(`
+def helper():
+    pass
+
+def test_dimensions():
+    print("checking")
+    testing.assert_builder_dimension("try", "linux", "os:Linux")
+    assert.eq(testing.builder("try", "linux").name, "linux")
+
+def test_fails():
+    assert.eq(1, 2)
+    testing.assert_builder_dimension("try", "linux", "os:Mac")
+`, false)
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveLength, 2)
+
+			So(res[0].Name, ShouldEqual, "test_dimensions")
+			So(res[0].Passed(), ShouldBeTrue)
+			So(res[0].Logs, ShouldResemble, []string{"checking"})
+
+			So(res[1].Name, ShouldEqual, "test_fails")
+			So(res[1].Passed(), ShouldBeFalse)
+			So(res[1].Failures, ShouldHaveLength, 2)
+			So(res[1].Failures[0], ShouldContainSubstring, "1 != 2")
+			So(res[1].Failures[1], ShouldContainSubstring, `doesn't have dimension "os:Mac"`)
+		})
+
+		Convey("Each test has its own graph", func() {
+			res, err := run(`
+def test_one():
+    luci.bucket(name = "ci")
+
+def test_two():
+    luci.bucket(name = "ci")
+    assert.true(testing.node("bucket", "ci"))
+`, false)
+			So(err, ShouldBeNil)
+			So(res[0].Passed(), ShouldBeTrue)
+			So(res[1].Passed(), ShouldBeTrue)
+		})
+
+		Convey("Golden files", func() {
+			script := `
+def test_golden():
+    testing.assert_golden("golden")
+`
+			res, err := run(script, false)
+			So(err, ShouldBeNil)
+			So(res[0].Failures, ShouldHaveLength, 1)
+			So(res[0].Failures[0], ShouldContainSubstring, "golden/cr-buildbucket.cfg: differs from the generated file")
+
+			So(os.MkdirAll(filepath.Join(tmp, "golden"), 0700), ShouldBeNil)
+			So(os.WriteFile(filepath.Join(tmp, "golden", "stale.cfg"), nil, 0600), ShouldBeNil)
+
+			res, err = run(script, true)
+			So(err, ShouldBeNil)
+			So(res[0].Passed(), ShouldBeTrue)
+			_, err = os.Stat(filepath.Join(tmp, "golden", "stale.cfg"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			res, err = run(script, false)
+			So(err, ShouldBeNil)
+			So(res[0].Passed(), ShouldBeTrue)
+		})
+
+		Convey("No tests", func() {
+			_, err := run("", false)
+			So(err, ShouldErrLike, "no test functions")
+		})
+
+		Convey("Test with arguments", func() {
+			_, err := run("def test_args(x):\n    pass\n", false)
+			So(err, ShouldErrLike, "must not have arguments")
+		})
+	})
+}
@@ -49,6 +49,7 @@ import (
 	"go.chromium.org/luci/resultdb/pbutil"
 	pb "go.chromium.org/luci/resultdb/proto/v1"
 	"go.chromium.org/luci/resultdb/sink"
+	"go.chromium.org/luci/resultdb/sink/adapter"
 	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
 )
 
@@ -137,6 +138,20 @@ func cmdStream(p Params) *subcommands.Command {
 				Path to the file that contains test location tags in JSON format. See
 				https://source.chromium.org/chromium/infra/infra/+/master:go/src/go.chromium.org/luci/resultdb/sink/proto/v1/location_tag.proto.
 			`))
+			r.Flags.StringVar(&r.resultFormat, "result-format", "", text.Doc(`
+				Format of the test results written by the test command, if it doesn't
+				use the ResultSink protocol. One of "junit" (JUnit/xUnit XML),
+				"gotest" (go test -json), "gtest" (GoogleTest JSON) and "tap".
+				The results are converted and uploaded like results reported via
+				ResultSink, e.g. -test-id-prefix, -var and -location-tags-file apply
+				to them.
+			`))
+			r.Flags.StringVar(&r.resultFile, "result-file", "", text.Doc(`
+				Path to the file with test results in -result-format, converted after
+				the test command exits.
+				If empty, the results are read from the stdout of the test command
+				as it runs.
+			`))
 			r.Flags.BoolVar(&r.exonerateUnexpectedPass, "exonerate-unexpected-pass",
 				false, text.Doc(`
 				If true, any unexpected pass result will be exonerated.
@@ -271,6 +286,8 @@ type streamRun struct {
 	sourcesFile             string
 	sources                 sources
 	baselineID              string
+	resultFormat            string
+	resultFile              string
 	// TODO(ddoman): add flags
 	// - invocation-tag
 	// - log-file
@@ -306,6 +323,13 @@ func (r *streamRun) validate(ctx context.Context, args []string) (err error) {
 	if sourceSpecs > 1 {
 		return errors.Reason("cannot specify more than one of -inherit-sources, -sources and -sources-file at the same time").Err()
 	}
+	if r.resultFormat != "" {
+		if err := adapter.ValidateFormat(adapter.Format(r.resultFormat)); err != nil {
+			return errors.Annotate(err, "-result-format").Err()
+		}
+	} else if r.resultFile != "" {
+		return errors.Reason("-result-file requires -result-format").Err()
+	}
 	return nil
 }
 
@@ -470,6 +494,7 @@ func (r *streamRun) runTestCmd(ctx context.Context, args []string) error {
 			exported.Close()
 		}()
 		exported.SetInCmd(cmd)
+		finishConversion := r.convertTestResults(ctx, cmd)
 		logging.Infof(ctx, "rdb-stream: starting the test command - %q", cmd.Args)
 
 		cmdProcMu.Lock()
@@ -477,9 +502,9 @@ func (r *streamRun) runTestCmd(ctx context.Context, args []string) error {
 		cmdProcMu.Unlock()
 
 		if err != nil {
-			return errors.Annotate(err, "cmd.start").Err()
+			return finishConversion(errors.Annotate(err, "cmd.start").Err())
 		}
-		return cmd.Wait()
+		return finishConversion(cmd.Wait())
 	})
 }
 
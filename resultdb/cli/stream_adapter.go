@@ -0,0 +1,161 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/system/exitcode"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/lucictx"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	"go.chromium.org/luci/resultdb/sink"
+	"go.chromium.org/luci/resultdb/sink/adapter"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// maxReportBatchSize is the maximum number of test results sent to the
+// ResultSink server in one request.
+const maxReportBatchSize = 500
+
+// convertTestResults starts converting test results written by the test
+// command in r.resultFormat, if set, and reports them to the ResultSink server
+// exported in the context.
+//
+// If r.resultFile is empty, the results are read from the stdout of `cmd`, so
+// it must be called before the command starts. The stdout is still copied to
+// os.Stdout.
+//
+// Returns a function that must be called with the result of the test command.
+// It waits for the conversion to finish and returns the command's error, if
+// any, or the conversion error.
+func (r *streamRun) convertTestResults(ctx context.Context, cmd *exec.Cmd) (finish func(cmdErr error) error) {
+	if r.resultFormat == "" {
+		return func(cmdErr error) error { return cmdErr }
+	}
+
+	rep := newResultReporter(ctx, r.testIDPrefix, r.testTestLocationBase != "")
+	format := adapter.Format(r.resultFormat)
+
+	if r.resultFile != "" {
+		return func(cmdErr error) error {
+			if _, ok := exitcode.Get(cmdErr); !ok {
+				// The command didn't run, there are no results.
+				return cmdErr
+			}
+			err := r.convertResultFile(ctx, format, rep)
+			return conversionResult(ctx, cmdErr, err)
+		}
+	}
+
+	pr, pw := io.Pipe()
+	cmd.Stdout = io.MultiWriter(os.Stdout, pw)
+	done := make(chan error, 1)
+	go func() {
+		err := adapter.Convert(ctx, format, pr, rep.report)
+		// Keep consuming the output, so the test command doesn't get stuck.
+		io.Copy(io.Discard, pr)
+		done <- err
+	}()
+	return func(cmdErr error) error {
+		pw.Close()
+		err := errors.Annotate(<-done, "converting test results from stdout").Err()
+		return conversionResult(ctx, cmdErr, err)
+	}
+}
+
+func (r *streamRun) convertResultFile(ctx context.Context, format adapter.Format, rep *resultReporter) error {
+	f, err := os.Open(r.resultFile)
+	if err != nil {
+		return errors.Annotate(err, "opening the result file").Err()
+	}
+	defer f.Close()
+	return errors.Annotate(adapter.Convert(ctx, format, f, rep.report), "converting %s", r.resultFile).Err()
+}
+
+// conversionResult returns the error to report for a test command which
+// finished with `cmdErr` and whose results were converted with `err`.
+func conversionResult(ctx context.Context, cmdErr, err error) error {
+	switch {
+	case err == nil:
+		return cmdErr
+	case cmdErr != nil:
+		// Failed test commands often leave incomplete reports behind, keep the
+		// exit code of the command.
+		logging.Errorf(ctx, "rdb-stream: %s", err)
+		return cmdErr
+	default:
+		return err
+	}
+}
+
+// resultReporter sends converted test results to the ResultSink server.
+type resultReporter struct {
+	client            sinkpb.SinkClient
+	authToken         string
+	testIDPrefix      string
+	relativeLocations bool
+}
+
+func newResultReporter(ctx context.Context, testIDPrefix string, relativeLocations bool) *resultReporter {
+	rs := lucictx.GetResultSink(ctx)
+	return &resultReporter{
+		client: sinkpb.NewSinkPRPCClient(&prpc.Client{
+			Host:    rs.GetAddress(),
+			Options: &prpc.Options{Insecure: true},
+		}),
+		authToken:         rs.GetAuthToken(),
+		testIDPrefix:      testIDPrefix,
+		relativeLocations: relativeLocations,
+	}
+}
+
+// report implements adapter.ReportFunc.
+//
+// The ResultSink server rejects requests with any invalid test result, so
+// results that it would reject are dropped here.
+func (r *resultReporter) report(ctx context.Context, trs []*sinkpb.TestResult) error {
+	valid := make([]*sinkpb.TestResult, 0, len(trs))
+	for _, tr := range trs {
+		if err := pbutil.ValidateTestID(r.testIDPrefix + tr.TestId); err != nil {
+			logging.Warningf(ctx, "rdb-stream: dropping a test result with invalid test ID %q: %s", tr.TestId, err)
+			continue
+		}
+		// Relative locations need -test-location-base.
+		if fn := tr.TestMetadata.GetLocation().GetFileName(); fn != "" && !r.relativeLocations && !strings.HasPrefix(fn, "//") {
+			tr.TestMetadata.Location = nil
+		}
+		valid = append(valid, tr)
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, sink.AuthTokenKey, sink.AuthTokenPrefix+" "+r.authToken)
+	for len(valid) > 0 {
+		batch := valid[:min(len(valid), maxReportBatchSize)]
+		valid = valid[len(batch):]
+		if _, err := r.client.ReportTestResults(ctx, &sinkpb.ReportTestResultsRequest{TestResults: batch}); err != nil {
+			return errors.Annotate(err, "reporting test results").Err()
+		}
+	}
+	return nil
+}
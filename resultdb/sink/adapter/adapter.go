@@ -0,0 +1,223 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package adapter converts test reports in common formats to ResultSink
+// TestResults.
+//
+// Supported formats are JUnit/xUnit XML, `go test -json`, GoogleTest JSON
+// (--gtest_output=json) and TAP. The produced TestResults are meant to be sent
+// to a ResultSink server, which adds the test ID prefix, the base variant and
+// the location tags configured for it.
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// Format is a test report format.
+type Format string
+
+const (
+	// JUnit is JUnit/xUnit XML, as produced by most Java, Python and JavaScript
+	// test runners.
+	JUnit Format = "junit"
+	// GoTest is the output of `go test -json`.
+	GoTest Format = "gotest"
+	// GTest is GoogleTest JSON, as produced with --gtest_output=json.
+	GTest Format = "gtest"
+	// TAP is the Test Anything Protocol, versions 12 to 14.
+	TAP Format = "tap"
+)
+
+// Formats returns all supported formats.
+func Formats() []Format {
+	return []Format{JUnit, GoTest, GTest, TAP}
+}
+
+// ReportFunc is called with TestResults as they get converted.
+//
+// If it returns an error, the conversion stops with this error.
+type ReportFunc func(ctx context.Context, trs []*sinkpb.TestResult) error
+
+// Convert reads a test report in the given format from `r` and calls `report`
+// with the converted TestResults.
+//
+// Formats that are written incrementally (GoTest and TAP) are converted as the
+// data arrives, so Convert can consume the output of a running test process.
+// Other formats are reported once the whole report is read.
+func Convert(ctx context.Context, format Format, r io.Reader, report ReportFunc) error {
+	switch format {
+	case JUnit:
+		return convertJUnit(ctx, r, report)
+	case GoTest:
+		return convertGoTest(ctx, r, report)
+	case GTest:
+		return convertGTest(ctx, r, report)
+	case TAP:
+		return convertTAP(ctx, r, report)
+	default:
+		return errors.Reason("unknown test report format %q", format).Err()
+	}
+}
+
+// ValidateFormat returns an error if the format is not supported.
+func ValidateFormat(format Format) error {
+	for _, f := range Formats() {
+		if f == format {
+			return nil
+		}
+	}
+	return errors.Reason("unknown test report format %q, must be one of %q", format, Formats()).Err()
+}
+
+const (
+	// maxLenSummaryHTML and maxLenPrimaryErrorMessage are limits ResultDB
+	// imposes on TestResults.
+	maxLenSummaryHTML         = 4 * 1024
+	maxLenPrimaryErrorMessage = 1024
+)
+
+// testID builds a test ID from the given components, skipping empty ones.
+//
+// Non-printable characters are replaced with spaces, since they aren't allowed
+// in test IDs.
+func testID(parts ...string) string {
+	nonEmpty := parts[:0:0]
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	id := norm.NFC.String(strings.Join(nonEmpty, "."))
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsPrint(r) {
+			return ' '
+		}
+		return r
+	}, id)
+}
+
+// truncate truncates `s` to at most `n` bytes without breaking UTF-8
+// sequences.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	s = s[:n]
+	for len(s) > 0 && !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+// failureReason returns a FailureReason with the given message or nil if the
+// message is empty.
+func failureReason(msg string) *pb.FailureReason {
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return nil
+	}
+	return &pb.FailureReason{PrimaryErrorMessage: truncate(msg, maxLenPrimaryErrorMessage)}
+}
+
+// textArtifact returns a text artifact with the given contents or nil if the
+// contents are empty.
+func textArtifact(contents string) *sinkpb.Artifact {
+	if strings.TrimSpace(contents) == "" {
+		return nil
+	}
+	return &sinkpb.Artifact{
+		Body:        &sinkpb.Artifact_Contents{Contents: []byte(contents)},
+		ContentType: "text/plain",
+	}
+}
+
+// addArtifact adds the artifact to the TestResult if it is not nil.
+func addArtifact(tr *sinkpb.TestResult, id string, a *sinkpb.Artifact) {
+	if a == nil {
+		return
+	}
+	if tr.Artifacts == nil {
+		tr.Artifacts = map[string]*sinkpb.Artifact{}
+	}
+	tr.Artifacts[id] = a
+}
+
+// setSummary sets SummaryHtml of the TestResult to embed its artifacts.
+//
+// If `msg` is not empty, it is added before the artifacts.
+func setSummary(tr *sinkpb.TestResult, msg string) {
+	ids := make([]string, 0, len(tr.Artifacts))
+	for id := range tr.Artifacts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var arts strings.Builder
+	for _, id := range ids {
+		fmt.Fprintf(&arts, `<text-artifact artifact-id="%s">`, html.EscapeString(id))
+	}
+	if msg != "" {
+		maxLen := maxLenSummaryHTML - arts.Len() - len("<p></p>")
+		msg = "<p>" + html.EscapeString(truncate(strings.TrimSpace(msg), maxLen/6)) + "</p>"
+	}
+	tr.SummaryHtml = msg + arts.String()
+}
+
+// location returns a TestLocation for the given file name.
+//
+// Backslashes are converted to slashes. Absolute OS paths can't be mapped to
+// a repository and are ignored. Relative paths are returned as is, the
+// ResultSink server resolves them against its TestLocationBase.
+func location(fileName string, line int) *pb.TestLocation {
+	fileName = strings.ReplaceAll(strings.TrimSpace(fileName), "\\", "/")
+	switch {
+	case fileName == "":
+		return nil
+	case strings.HasPrefix(fileName, "//"):
+		fileName = "//" + path.Clean(fileName[2:])
+	case strings.HasPrefix(fileName, "/") || len(fileName) > 1 && fileName[1] == ':':
+		// Absolute Unix or Windows paths.
+		return nil
+	default:
+		fileName = path.Clean(fileName)
+	}
+	loc := &pb.TestLocation{FileName: fileName}
+	if line > 0 {
+		loc.Line = int32(line)
+	}
+	return loc
+}
+
+// setLocation sets the test location of the TestResult, if any.
+func setLocation(tr *sinkpb.TestResult, fileName string, line int) {
+	if loc := location(fileName, line); loc != nil {
+		tr.TestMetadata = &pb.TestMetadata{Name: tr.TestId, Location: loc}
+	}
+}
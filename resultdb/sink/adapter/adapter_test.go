@@ -0,0 +1,87 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// convert converts the report and returns the results of each report call.
+func convert(format Format, report string) ([][]*sinkpb.TestResult, error) {
+	var calls [][]*sinkpb.TestResult
+	err := Convert(context.Background(), format, strings.NewReader(report), func(ctx context.Context, trs []*sinkpb.TestResult) error {
+		calls = append(calls, trs)
+		return nil
+	})
+	return calls, err
+}
+
+// contents returns the contents of the artifact or "" if it's missing.
+func contents(tr *sinkpb.TestResult, id string) string {
+	return string(tr.Artifacts[id].GetContents())
+}
+
+func TestHelpers(t *testing.T) {
+	t.Parallel()
+
+	Convey("ValidateFormat", t, func() {
+		So(ValidateFormat(JUnit), ShouldBeNil)
+		So(ValidateFormat("xml"), ShouldErrLike, `unknown test report format "xml"`)
+		_, err := convert("xml", "")
+		So(err, ShouldErrLike, `unknown test report format "xml"`)
+	})
+
+	Convey("testID", t, func() {
+		So(testID("a", "", "b"), ShouldEqual, "a.b")
+		So(testID("new\nline\ttab"), ShouldEqual, "new line tab")
+		So(testID("e\u0301"), ShouldEqual, "\u00e9")
+	})
+
+	Convey("truncate", t, func() {
+		So(truncate("abc", 5), ShouldEqual, "abc")
+		So(truncate("abc", 2), ShouldEqual, "ab")
+		So(truncate("aé", 2), ShouldEqual, "a")
+	})
+
+	Convey("location", t, func() {
+		So(location("", 1), ShouldBeNil)
+		So(location("/abs/path.cc", 1), ShouldBeNil)
+		So(location(`C:\src\a.cc`, 1), ShouldBeNil)
+		So(location(`src\a.cc`, 3), ShouldResembleProto, &pb.TestLocation{FileName: "src/a.cc", Line: 3})
+		So(location("./a/../b.py", 0), ShouldResembleProto, &pb.TestLocation{FileName: "b.py"})
+		So(location("//a/./b.go", 0), ShouldResembleProto, &pb.TestLocation{FileName: "//a/b.go"})
+	})
+
+	Convey("setSummary", t, func() {
+		tr := &sinkpb.TestResult{}
+		addArtifact(tr, "stdout", textArtifact("out"))
+		addArtifact(tr, "failure", textArtifact("boom"))
+		addArtifact(tr, "empty", textArtifact(" \n"))
+		setSummary(tr, "a < b")
+		So(tr.SummaryHtml, ShouldEqual,
+			`<p>a &lt; b</p><text-artifact artifact-id="failure"><text-artifact artifact-id="stdout">`)
+
+		setSummary(tr, strings.Repeat("x", 10000))
+		So(len(tr.SummaryHtml), ShouldBeLessThanOrEqualTo, maxLenSummaryHTML)
+	})
+}
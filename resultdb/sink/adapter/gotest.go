@@ -0,0 +1,141 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// goTestEvent is a line of `go test -json` output.
+//
+// See `go doc test2json`.
+type goTestEvent struct {
+	Time    time.Time
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// goTestFailureRe matches lines written by t.Error and friends.
+var goTestFailureRe = regexp.MustCompile(`^\s+\S+\.go:\d+: `)
+
+// goTest is a test (or a package, if name is "") being run.
+type goTest struct {
+	pkg, name string
+	start     time.Time
+	output    strings.Builder
+	failure   string // the first failure message
+}
+
+func convertGoTest(ctx context.Context, r io.Reader, report ReportFunc) error {
+	running := map[[2]string]*goTest{}
+	failedTests := map[string]bool{} // packages with failed tests
+
+	get := func(e *goTestEvent) *goTest {
+		key := [2]string{e.Package, e.Test}
+		t := running[key]
+		if t == nil {
+			t = &goTest{pkg: e.Package, name: e.Test, start: e.Time}
+			running[key] = t
+		}
+		return t
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if !bytes.HasPrefix(line, []byte("{")) {
+			// `go test -json` may interleave non-JSON lines, e.g. build errors.
+			continue
+		}
+		e := &goTestEvent{}
+		if err := json.Unmarshal(line, e); err != nil {
+			continue
+		}
+
+		switch e.Action {
+		case "run":
+			get(e)
+		case "output":
+			t := get(e)
+			t.output.WriteString(e.Output)
+			if t.failure == "" && goTestFailureRe.MatchString(e.Output) {
+				t.failure = strings.TrimSpace(e.Output)
+			}
+		case "pass", "fail", "skip":
+			t := get(e)
+			delete(running, [2]string{e.Package, e.Test})
+			if e.Test != "" && e.Action == "fail" {
+				failedTests[e.Package] = true
+			}
+			// Report packages only if they failed without failing tests, e.g.
+			// because of a build error, a panic in init or TestMain, or a timeout.
+			if e.Test == "" && (e.Action != "fail" || failedTests[e.Package]) {
+				continue
+			}
+			if err := report(ctx, []*sinkpb.TestResult{t.result(e)}); err != nil {
+				return err
+			}
+		}
+	}
+	return errors.Annotate(scanner.Err(), "reading go test output").Err()
+}
+
+// result returns a TestResult of a finished test.
+func (t *goTest) result(e *goTestEvent) *sinkpb.TestResult {
+	tr := &sinkpb.TestResult{
+		TestId:   testID(t.pkg, t.name),
+		Expected: e.Action != "fail",
+		Duration: durationpb.New(time.Duration(e.Elapsed * float64(time.Second))),
+	}
+	switch e.Action {
+	case "pass":
+		tr.Status = pb.TestStatus_PASS
+	case "fail":
+		tr.Status = pb.TestStatus_FAIL
+		msg := t.failure
+		if msg == "" {
+			// E.g. a panic or a build error.
+			msg, _, _ = strings.Cut(strings.TrimSpace(t.output.String()), "\n")
+		}
+		tr.FailureReason = failureReason(msg)
+	case "skip":
+		tr.Status = pb.TestStatus_SKIP
+	}
+	if !t.start.IsZero() {
+		tr.StartTime = timestamppb.New(t.start)
+	}
+	addArtifact(tr, "output", textArtifact(t.output.String()))
+	setSummary(tr, "")
+	return tr
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestGoTest(t *testing.T) {
+	t.Parallel()
+
+	Convey("GoTest", t, func() {
+		Convey("Works", func() {
+			calls, err := convert(GoTest, `
+{"Time":"2024-01-02T03:04:05Z","Action":"start","Package":"example.com/a"}
+{"Time":"2024-01-02T03:04:05Z","Action":"run","Package":"example.com/a","Test":"TestPass"}
+{"Time":"2024-01-02T03:04:05Z","Action":"output","Package":"example.com/a","Test":"TestPass","Output":"=== RUN   TestPass\n"}
+{"Time":"2024-01-02T03:04:06Z","Action":"pass","Package":"example.com/a","Test":"TestPass","Elapsed":1.5}
+{"Time":"2024-01-02T03:04:06Z","Action":"run","Package":"example.com/a","Test":"TestFail/sub"}
+{"Time":"2024-01-02T03:04:06Z","Action":"output","Package":"example.com/a","Test":"TestFail/sub","Output":"    a_test.go:12: got 1, want 2\n"}
+{"Time":"2024-01-02T03:04:06Z","Action":"output","Package":"example.com/a","Test":"TestFail/sub","Output":"    a_test.go:13: got 3, want 4\n"}
+{"Time":"2024-01-02T03:04:06Z","Action":"fail","Package":"example.com/a","Test":"TestFail/sub","Elapsed":0}
+{"Time":"2024-01-02T03:04:06Z","Action":"skip","Package":"example.com/a","Test":"TestSkip","Elapsed":0}
+{"Time":"2024-01-02T03:04:06Z","Action":"fail","Package":"example.com/a","Elapsed":2}
+# example.com/b
+not json
+{"Time":"2024-01-02T03:04:06Z","Action":"output","Package":"example.com/b","Output":"b.go:3:1: syntax error\n"}
+{"Time":"2024-01-02T03:04:06Z","Action":"fail","Package":"example.com/b","Elapsed":0}
+{"Time":"2024-01-02T03:04:06Z","Action":"pass","Package":"example.com/c","Elapsed":0}
+`)
+			So(err, ShouldBeNil)
+			So(calls, ShouldHaveLength, 4)
+			for _, c := range calls {
+				So(c, ShouldHaveLength, 1)
+			}
+
+			start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+			So(calls[0][0], ShouldResembleProto, &sinkpb.TestResult{
+				TestId:      "example.com/a.TestPass",
+				Status:      pb.TestStatus_PASS,
+				Expected:    true,
+				StartTime:   timestamppb.New(start),
+				Duration:    durationpb.New(1500 * time.Millisecond),
+				SummaryHtml: `<text-artifact artifact-id="output">`,
+				Artifacts: map[string]*sinkpb.Artifact{
+					"output": {Body: &sinkpb.Artifact_Contents{Contents: []byte("=== RUN   TestPass\n")}, ContentType: "text/plain"},
+				},
+			})
+
+			fail := calls[1][0]
+			So(fail.TestId, ShouldEqual, "example.com/a.TestFail/sub")
+			So(fail.Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(fail.Expected, ShouldBeFalse)
+			So(fail.FailureReason.PrimaryErrorMessage, ShouldEqual, "a_test.go:12: got 1, want 2")
+
+			So(calls[2][0].TestId, ShouldEqual, "example.com/a.TestSkip")
+			So(calls[2][0].Status, ShouldEqual, pb.TestStatus_SKIP)
+			So(calls[2][0].Artifacts, ShouldBeEmpty)
+
+			// A package which failed without failing tests is reported.
+			build := calls[3][0]
+			So(build.TestId, ShouldEqual, "example.com/b")
+			So(build.Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(build.FailureReason.PrimaryErrorMessage, ShouldEqual, "b.go:3:1: syntax error")
+		})
+
+		Convey("Report errors stop the conversion", func() {
+			err := Convert(context.Background(), GoTest, strings.NewReader(`
+{"Action":"pass","Package":"a","Test":"T1"}
+{"Action":"pass","Package":"a","Test":"T2"}
+`), func(ctx context.Context, trs []*sinkpb.TestResult) error {
+				return io.ErrClosedPipe
+			})
+			So(err, ShouldEqual, io.ErrClosedPipe)
+		})
+	})
+}
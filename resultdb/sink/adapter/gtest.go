@@ -0,0 +1,133 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// gtestReport is the GoogleTest JSON report, see
+// https://google.github.io/googletest/advanced.html#generating-a-json-report
+type gtestReport struct {
+	TestSuites []struct {
+		Name      string      `json:"name"`
+		TestSuite []gtestCase `json:"testsuite"`
+	} `json:"testsuites"`
+}
+
+type gtestCase struct {
+	Name       string `json:"name"`
+	ClassName  string `json:"classname"`
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Status     string `json:"status"`
+	Result     string `json:"result"`
+	Timestamp  string `json:"timestamp"`
+	Time       string `json:"time"`
+	TypeParam  string `json:"type_param"`
+	ValueParam string `json:"value_param"`
+	Failures   []struct {
+		Failure string `json:"failure"`
+	} `json:"failures"`
+}
+
+// gtestLocationRe matches the "file:line" line GoogleTest puts before
+// failure messages.
+var gtestLocationRe = regexp.MustCompile(`^\S.*:\d+$`)
+
+func convertGTest(ctx context.Context, r io.Reader, report ReportFunc) error {
+	rep := &gtestReport{}
+	if err := json.NewDecoder(r).Decode(rep); err != nil {
+		return errors.Annotate(err, "parsing GoogleTest JSON").Err()
+	}
+
+	var trs []*sinkpb.TestResult
+	for _, s := range rep.TestSuites {
+		for i := range s.TestSuite {
+			trs = append(trs, gtestResult(s.Name, &s.TestSuite[i]))
+		}
+	}
+	if len(trs) == 0 {
+		return nil
+	}
+	return report(ctx, trs)
+}
+
+func gtestResult(suite string, c *gtestCase) *sinkpb.TestResult {
+	class := c.ClassName
+	if class == "" {
+		class = suite
+	}
+	tr := &sinkpb.TestResult{
+		TestId:   testID(class, c.Name),
+		Status:   pb.TestStatus_PASS,
+		Expected: true,
+	}
+
+	switch {
+	case len(c.Failures) > 0:
+		tr.Status = pb.TestStatus_FAIL
+		tr.Expected = false
+	case c.Status == "NOTRUN" || c.Result == "SKIPPED" || c.Result == "SUPPRESSED":
+		tr.Status = pb.TestStatus_SKIP
+	}
+
+	if d, err := time.ParseDuration(c.Time); err == nil && d >= 0 {
+		tr.Duration = durationpb.New(d)
+	}
+	if ts, err := time.Parse(time.RFC3339Nano, c.Timestamp); err == nil {
+		tr.StartTime = timestamppb.New(ts)
+	}
+
+	failures := make([]string, len(c.Failures))
+	for i, f := range c.Failures {
+		failures[i] = f.Failure
+	}
+	if len(failures) > 0 {
+		msg := strings.TrimSpace(failures[0])
+		if first, rest, ok := strings.Cut(msg, "\n"); ok && gtestLocationRe.MatchString(first) {
+			msg = rest
+		}
+		tr.FailureReason = failureReason(msg)
+	}
+	addArtifact(tr, "failure", textArtifact(strings.Join(failures, "\n\n")))
+	setSummary(tr, "")
+
+	for _, tag := range []*pb.StringPair{
+		{Key: "gtest_type_param", Value: c.TypeParam},
+		{Key: "gtest_value_param", Value: c.ValueParam},
+	} {
+		if tag.Value != "" && pbutil.ValidateStringPair(tag) == nil {
+			tr.Tags = append(tr.Tags, tag)
+		}
+	}
+
+	setLocation(tr, c.File, c.Line)
+	return tr
+}
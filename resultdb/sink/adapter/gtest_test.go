@@ -0,0 +1,112 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestGTest(t *testing.T) {
+	t.Parallel()
+
+	Convey("GTest", t, func() {
+		Convey("Works", func() {
+			calls, err := convert(GTest, `{
+  "tests": 3,
+  "testsuites": [
+    {
+      "name": "FooTest",
+      "testsuite": [
+        {
+          "name": "Passes",
+          "file": "base/foo_test.cc",
+          "line": 10,
+          "status": "RUN",
+          "result": "COMPLETED",
+          "timestamp": "2024-01-02T03:04:05.5Z",
+          "time": "0.012s",
+          "classname": "FooTest"
+        },
+        {
+          "name": "Fails/0",
+          "value_param": "42",
+          "file": "base/foo_test.cc",
+          "line": 20,
+          "status": "RUN",
+          "result": "COMPLETED",
+          "time": "0s",
+          "classname": "FooTest",
+          "failures": [
+            {"failure": "base/foo_test.cc:22\nExpected equality of these values:\n  1\n  2", "type": ""},
+            {"failure": "base/foo_test.cc:23\nFailed", "type": ""}
+          ]
+        },
+        {
+          "name": "DISABLED_Skipped",
+          "status": "NOTRUN",
+          "result": "SUPPRESSED",
+          "time": "0s",
+          "classname": "FooTest"
+        }
+      ]
+    }
+  ]
+}`)
+			So(err, ShouldBeNil)
+			So(calls, ShouldHaveLength, 1)
+			trs := calls[0]
+			So(trs, ShouldHaveLength, 3)
+
+			So(trs[0], ShouldResembleProto, &sinkpb.TestResult{
+				TestId:    "FooTest.Passes",
+				Status:    pb.TestStatus_PASS,
+				Expected:  true,
+				StartTime: timestamppb.New(time.Date(2024, 1, 2, 3, 4, 5, 5e8, time.UTC)),
+				Duration:  durationpb.New(12 * time.Millisecond),
+				TestMetadata: &pb.TestMetadata{
+					Name:     "FooTest.Passes",
+					Location: &pb.TestLocation{FileName: "base/foo_test.cc", Line: 10},
+				},
+			})
+
+			So(trs[1].TestId, ShouldEqual, "FooTest.Fails/0")
+			So(trs[1].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(trs[1].Expected, ShouldBeFalse)
+			So(trs[1].FailureReason.PrimaryErrorMessage, ShouldEqual, "Expected equality of these values:\n  1\n  2")
+			So(contents(trs[1], "failure"), ShouldContainSubstring, "base/foo_test.cc:23\nFailed")
+			So(trs[1].SummaryHtml, ShouldEqual, `<text-artifact artifact-id="failure">`)
+			So(trs[1].Tags, ShouldResembleProto, []*pb.StringPair{{Key: "gtest_value_param", Value: "42"}})
+
+			So(trs[2].Status, ShouldEqual, pb.TestStatus_SKIP)
+			So(trs[2].Expected, ShouldBeTrue)
+			So(trs[2].TestMetadata, ShouldBeNil)
+		})
+
+		Convey("Invalid", func() {
+			_, err := convert(GTest, `[`)
+			So(err, ShouldErrLike, "parsing GoogleTest JSON")
+		})
+	})
+}
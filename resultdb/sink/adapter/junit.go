@@ -0,0 +1,163 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// junitSuite is a <testsuite> element.
+//
+// There's no formal spec of the format; this covers the subset written by
+// the popular JUnit, pytest, Jest, Mocha and xUnit.net reporters.
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	File   string       `xml:"file,attr"`
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+// junitCase is a <testcase> element.
+type junitCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	File       string          `xml:"file,attr"`
+	Line       int             `xml:"line,attr"`
+	Failures   []junitFailure  `xml:"failure"`
+	Errors     []junitFailure  `xml:"error"`
+	Skipped    *junitFailure   `xml:"skipped"`
+	Properties []junitProperty `xml:"properties>property"`
+	SystemOut  string          `xml:"system-out"`
+	SystemErr  string          `xml:"system-err"`
+}
+
+// junitFailure is a <failure>, <error> or <skipped> element.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+func convertJUnit(ctx context.Context, r io.Reader, report ReportFunc) error {
+	// The root is either <testsuites> with <testsuite> children or
+	// a single <testsuite>. Both can be decoded as junitSuite.
+	root := junitSuite{}
+	dec := xml.NewDecoder(r)
+	dec.Strict = false
+	if err := dec.Decode(&root); err != nil {
+		return errors.Annotate(err, "parsing JUnit XML").Err()
+	}
+
+	var trs []*sinkpb.TestResult
+	var visit func(s *junitSuite, file string)
+	visit = func(s *junitSuite, file string) {
+		if s.File != "" {
+			file = s.File
+		}
+		for i := range s.Cases {
+			trs = append(trs, junitResult(s, &s.Cases[i], file))
+		}
+		for i := range s.Suites {
+			visit(&s.Suites[i], file)
+		}
+	}
+	visit(&root, "")
+
+	if len(trs) == 0 {
+		return nil
+	}
+	return report(ctx, trs)
+}
+
+func junitResult(s *junitSuite, c *junitCase, file string) *sinkpb.TestResult {
+	class := c.ClassName
+	if class == "" {
+		class = s.Name
+	}
+	tr := &sinkpb.TestResult{
+		TestId:   testID(class, c.Name),
+		Status:   pb.TestStatus_PASS,
+		Expected: true,
+	}
+
+	// Some reporters write times like "1,234.5".
+	if secs, err := strconv.ParseFloat(strings.ReplaceAll(c.Time, ",", ""), 64); err == nil && secs >= 0 {
+		tr.Duration = durationpb.New(time.Duration(secs * float64(time.Second)))
+	}
+
+	var msg string
+	var failures []string
+	for _, f := range append(c.Failures, c.Errors...) {
+		if msg == "" {
+			msg = f.Message
+			if msg == "" {
+				msg, _, _ = strings.Cut(strings.TrimSpace(f.Text), "\n")
+			}
+		}
+		failures = append(failures, strings.TrimSpace(strings.Join([]string{f.Type, f.Message, f.Text}, "\n")))
+	}
+	switch {
+	case len(c.Errors) > 0:
+		tr.Status = pb.TestStatus_CRASH
+		tr.Expected = false
+	case len(c.Failures) > 0:
+		tr.Status = pb.TestStatus_FAIL
+		tr.Expected = false
+	case c.Skipped != nil:
+		tr.Status = pb.TestStatus_SKIP
+		msg = c.Skipped.Message
+	}
+	if !tr.Expected {
+		tr.FailureReason = failureReason(msg)
+	}
+
+	addArtifact(tr, "failure", textArtifact(strings.Join(failures, "\n\n")))
+	addArtifact(tr, "stdout", textArtifact(c.SystemOut))
+	addArtifact(tr, "stderr", textArtifact(c.SystemErr))
+	setSummary(tr, msg)
+
+	for _, p := range c.Properties {
+		tag := &pb.StringPair{Key: p.Name, Value: p.Value}
+		if pbutil.ValidateStringPair(tag) == nil {
+			tr.Tags = append(tr.Tags, tag)
+		}
+	}
+
+	fileName := c.File
+	if fileName == "" {
+		fileName = file
+	}
+	setLocation(tr, fileName, c.Line)
+	return tr
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestJUnit(t *testing.T) {
+	t.Parallel()
+
+	Convey("JUnit", t, func() {
+		Convey("Works", func() {
+			calls, err := convert(JUnit, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="all">
+  <testsuite name="suite" file="tests/test_a.py" tests="4">
+    <testcase classname="tests.test_a.TestA" name="test_pass" time="1,234.5" line="10">
+      <properties>
+        <property name="owner" value="team-a"/>
+        <property name="Not A Tag" value="x"/>
+      </properties>
+      <system-out>hello</system-out>
+    </testcase>
+    <testcase classname="tests.test_a.TestA" name="test_fail" time="0.5">
+      <failure message="assert 1 == 2" type="AssertionError">Traceback
+  assert 1 == 2</failure>
+      <system-err>oops</system-err>
+    </testcase>
+    <testcase name="test_skip">
+      <skipped message="not on Linux"/>
+    </testcase>
+    <testsuite name="nested" file="//other/test_b.py">
+      <testcase name="test_error"><error>Segfault
+in test</error></testcase>
+    </testsuite>
+  </testsuite>
+</testsuites>`)
+			So(err, ShouldBeNil)
+			So(calls, ShouldHaveLength, 1)
+			trs := calls[0]
+			So(trs, ShouldHaveLength, 4)
+
+			So(trs[0], ShouldResembleProto, &sinkpb.TestResult{
+				TestId:      "tests.test_a.TestA.test_pass",
+				Status:      pb.TestStatus_PASS,
+				Expected:    true,
+				Duration:    durationpb.New(1234500 * time.Millisecond),
+				SummaryHtml: `<text-artifact artifact-id="stdout">`,
+				Artifacts: map[string]*sinkpb.Artifact{
+					"stdout": {Body: &sinkpb.Artifact_Contents{Contents: []byte("hello")}, ContentType: "text/plain"},
+				},
+				Tags: []*pb.StringPair{{Key: "owner", Value: "team-a"}},
+				TestMetadata: &pb.TestMetadata{
+					Name:     "tests.test_a.TestA.test_pass",
+					Location: &pb.TestLocation{FileName: "tests/test_a.py", Line: 10},
+				},
+			})
+
+			So(trs[1].TestId, ShouldEqual, "tests.test_a.TestA.test_fail")
+			So(trs[1].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(trs[1].Expected, ShouldBeFalse)
+			So(trs[1].FailureReason.PrimaryErrorMessage, ShouldEqual, "assert 1 == 2")
+			So(contents(trs[1], "failure"), ShouldEqual, "AssertionError\nassert 1 == 2\nTraceback\n  assert 1 == 2")
+			So(contents(trs[1], "stderr"), ShouldEqual, "oops")
+			So(trs[1].SummaryHtml, ShouldStartWith, "<p>assert 1 == 2</p>")
+
+			So(trs[2].TestId, ShouldEqual, "suite.test_skip")
+			So(trs[2].Status, ShouldEqual, pb.TestStatus_SKIP)
+			So(trs[2].Expected, ShouldBeTrue)
+			So(trs[2].FailureReason, ShouldBeNil)
+			So(trs[2].SummaryHtml, ShouldEqual, "<p>not on Linux</p>")
+
+			So(trs[3].TestId, ShouldEqual, "nested.test_error")
+			So(trs[3].Status, ShouldEqual, pb.TestStatus_CRASH)
+			So(trs[3].FailureReason.PrimaryErrorMessage, ShouldEqual, "Segfault")
+			So(trs[3].TestMetadata.Location.FileName, ShouldEqual, "//other/test_b.py")
+		})
+
+		Convey("Single suite root", func() {
+			calls, err := convert(JUnit, `<testsuite name="s"><testcase name="t"/></testsuite>`)
+			So(err, ShouldBeNil)
+			So(calls[0][0].TestId, ShouldEqual, "s.t")
+		})
+
+		Convey("Empty", func() {
+			calls, err := convert(JUnit, `<testsuites/>`)
+			So(err, ShouldBeNil)
+			So(calls, ShouldBeEmpty)
+		})
+
+		Convey("Invalid", func() {
+			_, err := convert(JUnit, `not xml`)
+			So(err, ShouldErrLike, "parsing JUnit XML")
+		})
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+// tapTestRe matches a TAP test line, e.g. "not ok 2 - description # TODO x".
+var tapTestRe = regexp.MustCompile(`^(not ok|ok)\b\s*(\d+)?\s*(?:-\s*)?(.*)$`)
+
+// tapDirectiveRe matches a SKIP or TODO directive at the end of a test line.
+var tapDirectiveRe = regexp.MustCompile(`(?i)\s*(?:^|[^\\])#\s*(skip|todo)\S*\s*(.*)$`)
+
+// tapMessageRe matches the message in a YAML diagnostic block.
+var tapMessageRe = regexp.MustCompile(`^\s*message:\s*(.*)$`)
+
+// tapTest is a test line with its diagnostics.
+type tapTest struct {
+	tr          *sinkpb.TestResult
+	message     string // failure message
+	diagnostics strings.Builder
+	inYAML      bool
+}
+
+func convertTAP(ctx context.Context, r io.Reader, report ReportFunc) error {
+	var cur *tapTest
+	count := 0
+	flush := func() error {
+		if cur == nil {
+			return nil
+		}
+		t := cur
+		cur = nil
+		if !t.tr.Expected && t.tr.Status != pb.TestStatus_PASS {
+			t.tr.FailureReason = failureReason(t.message)
+		}
+		addArtifact(t.tr, "diagnostics", textArtifact(t.diagnostics.String()))
+		setSummary(t.tr, t.message)
+		return report(ctx, []*sinkpb.TestResult{t.tr})
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// The YAML diagnostic block after a test line, indented by 2 spaces.
+		if cur != nil {
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case cur.inYAML:
+				if trimmed == "..." {
+					cur.inYAML = false
+					continue
+				}
+				cur.diagnostics.WriteString(line + "\n")
+				if m := tapMessageRe.FindStringSubmatch(line); m != nil && cur.message == "" {
+					cur.message = strings.Trim(m[1], `'"`)
+				}
+				continue
+			case trimmed == "---" && strings.HasPrefix(line, " "):
+				cur.inYAML = true
+				continue
+			case strings.HasPrefix(trimmed, "#"):
+				// Comments after a test line usually explain its failure.
+				cur.diagnostics.WriteString(strings.TrimSpace(strings.TrimPrefix(trimmed, "#")) + "\n")
+				continue
+			}
+		}
+
+		switch {
+		case strings.HasPrefix(line, "Bail out!"):
+			if err := flush(); err != nil {
+				return err
+			}
+			logging.Warningf(ctx, "TAP: %s", line)
+			return nil
+		case tapTestRe.MatchString(line):
+			if err := flush(); err != nil {
+				return err
+			}
+			count++
+			cur = tapParseTest(line, count)
+		default:
+			// The plan, version, pragmas, subtests and unrecognized lines.
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Annotate(err, "reading TAP").Err()
+	}
+	return flush()
+}
+
+// tapParseTest parses a test line.
+//
+// `count` is the number of tests so far, it is used for tests without a
+// description and number.
+func tapParseTest(line string, count int) *tapTest {
+	m := tapTestRe.FindStringSubmatch(line)
+	ok, num, desc := m[1] == "ok", m[2], m[3]
+	if num == "" {
+		num = strconv.Itoa(count)
+	}
+
+	var directive, reason string
+	if loc := tapDirectiveRe.FindStringSubmatchIndex(desc); loc != nil {
+		directive = strings.ToLower(desc[loc[2]:loc[3]])
+		reason = strings.TrimSpace(desc[loc[4]:loc[5]])
+		desc = desc[:loc[2]]
+		desc = strings.TrimRight(strings.TrimSpace(desc), "#")
+	}
+	desc = strings.TrimSpace(strings.ReplaceAll(desc, `\#`, "#"))
+
+	name := desc
+	if name == "" {
+		name = num
+	}
+	tr := &sinkpb.TestResult{TestId: testID(name)}
+	t := &tapTest{tr: tr}
+	switch {
+	case directive == "skip":
+		tr.Status = pb.TestStatus_SKIP
+		tr.Expected = true
+		t.message = reason
+	case directive == "todo":
+		// Failures of TODO tests are expected, passes are a surprise.
+		tr.Expected = !ok
+		tr.Status = pb.TestStatus_FAIL
+		if ok {
+			tr.Status = pb.TestStatus_PASS
+		}
+		t.message = reason
+	case ok:
+		tr.Status = pb.TestStatus_PASS
+		tr.Expected = true
+	default:
+		tr.Status = pb.TestStatus_FAIL
+	}
+	return t
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adapter
+
+import (
+	"testing"
+
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestTAP(t *testing.T) {
+	t.Parallel()
+
+	Convey("TAP", t, func() {
+		results := func(report string) []*sinkpb.TestResult {
+			calls, err := convert(TAP, report)
+			So(err, ShouldBeNil)
+			var trs []*sinkpb.TestResult
+			for _, c := range calls {
+				So(c, ShouldHaveLength, 1)
+				trs = append(trs, c...)
+			}
+			return trs
+		}
+
+		Convey("Works", func() {
+			trs := results(`TAP version 13
+1..7
+ok 1 - passes
+not ok 2 - fails
+  ---
+  message: 'expected 1 to equal 2'
+  severity: fail
+  ...
+ok 3 skipped # SKIP no network
+not ok 4 - not yet # TODO implement it
+ok 5 - has \# hash
+not ok 6
+# diagnostics for 6
+ok
+Bail out! Database is down
+ok 8 - never seen
+`)
+			So(trs, ShouldHaveLength, 7)
+
+			So(trs[0], ShouldResembleProto, &sinkpb.TestResult{
+				TestId:   "passes",
+				Status:   pb.TestStatus_PASS,
+				Expected: true,
+			})
+
+			So(trs[1].TestId, ShouldEqual, "fails")
+			So(trs[1].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(trs[1].Expected, ShouldBeFalse)
+			So(trs[1].FailureReason.PrimaryErrorMessage, ShouldEqual, "expected 1 to equal 2")
+			So(contents(trs[1], "diagnostics"), ShouldEqual, "  message: 'expected 1 to equal 2'\n  severity: fail\n")
+
+			So(trs[2].TestId, ShouldEqual, "skipped")
+			So(trs[2].Status, ShouldEqual, pb.TestStatus_SKIP)
+			So(trs[2].Expected, ShouldBeTrue)
+			So(trs[2].SummaryHtml, ShouldEqual, "<p>no network</p>")
+
+			So(trs[3].TestId, ShouldEqual, "not yet")
+			So(trs[3].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(trs[3].Expected, ShouldBeTrue)
+			So(trs[3].FailureReason, ShouldBeNil)
+
+			So(trs[4].TestId, ShouldEqual, "has # hash")
+
+			So(trs[5].TestId, ShouldEqual, "6")
+			So(trs[5].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(contents(trs[5], "diagnostics"), ShouldEqual, "diagnostics for 6\n")
+
+			// No description nor number.
+			So(trs[6].TestId, ShouldEqual, "7")
+		})
+
+		Convey("Passing TODO is unexpected", func() {
+			trs := results("ok 1 - done # todo\n")
+			So(trs[0].Status, ShouldEqual, pb.TestStatus_PASS)
+			So(trs[0].Expected, ShouldBeFalse)
+		})
+	})
+}
@@ -43,6 +43,8 @@ import (
 var (
 	// jobIDRe is used to validate job ID field.
 	jobIDRe = regexp.MustCompile(`^[0-9A-Za-z_\-\. \)\(]{1,100}$`)
+	// calendarIDRe is used to validate calendar ID field.
+	calendarIDRe = regexp.MustCompile(`^[0-9A-Za-z_\-\.]{1,100}$`)
 )
 
 const (
@@ -149,6 +151,24 @@ type Definition struct {
 	// TriggeredJobIDs is a list of jobIDs which this job triggers.
 	// It's set only for triggering jobs.
 	TriggeredJobIDs []string
+
+	// CompletionTriggers is a list of jobs triggered when an invocation of this
+	// job finishes, sorted by JobID.
+	//
+	// It is derived from 'triggered_by_completion' fields of the triggered jobs.
+	// It's set only for regular jobs.
+	CompletionTriggers []CompletionTrigger
+}
+
+// CompletionTrigger describes a job triggered when an invocation of another job
+// finishes.
+type CompletionTrigger struct {
+	// JobID is globally unique ID of the triggered job: "<ProjectID>/<JobName>".
+	JobID string
+
+	// Statuses is a sorted list of final statuses of the finished invocation
+	// that cause the trigger.
+	Statuses []task.Status
 }
 
 // New returns implementation of Catalog.
@@ -230,6 +250,32 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 	out := make([]Definition, 0, len(cfg.Job)+len(cfg.Trigger))
 	disabledCount := 0
 
+	// Calendars referenced by schedules. Jobs that refer to broken calendars
+	// will fail validation below.
+	calCtx := &validation.Context{Context: c}
+	calendars := validateCalendars(calCtx, cfg.Calendar)
+	if err := calCtx.Finalize(); err != nil {
+		logging.Errorf(c, "Invalid calendar definitions: %s", err)
+	}
+
+	// Jobs triggered by completion of other jobs. Configs that pass validation
+	// never have cycles, but be defensive and ignore all such triggers if there
+	// are any, to avoid running jobs in an infinite loop.
+	enabledJobs := make([]*messages.Job, 0, len(cfg.Job))
+	enabledJobIDs := stringset.New(len(cfg.Job))
+	for _, job := range cfg.Job {
+		if !job.Disabled && job.Id != "" {
+			enabledJobs = append(enabledJobs, job)
+			enabledJobIDs.Add(job.Id)
+		}
+	}
+	compCtx := &validation.Context{Context: c}
+	completionTriggers := invertCompletionTriggers(compCtx, projectID, enabledJobs, enabledJobIDs, false)
+	if err := compCtx.Finalize(); err != nil {
+		logging.Errorf(c, "Ignoring 'triggered_by_completion' fields: %s", err)
+		completionTriggers = nil
+	}
+
 	// Regular jobs, triggered jobs.
 	// TODO(tandrii): consider switching to validateProjectConfig because configs
 	// provided by luci-config are known to be valid and so there is little value
@@ -247,7 +293,7 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 		// persist in context but we want to find all valid jobs/trigger.
 		ctx := &validation.Context{Context: c}
 		realmID := validateRealm(ctx, projectID, job.Realm)
-		task := cat.validateJobProto(ctx, job, realmID)
+		task := cat.validateJobProto(ctx, job, realmID, calendars)
 		if err := ctx.Finalize(); err != nil {
 			logging.Errorf(c, "Invalid job definition %s: %s", id, err)
 			continue
@@ -257,7 +303,7 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 			logging.Errorf(c, "Failed to marshal the task: %s: %s", id, err)
 			continue
 		}
-		schedule := job.Schedule
+		schedule := expandSchedule(job.Schedule, calendars)
 		if schedule == "" {
 			schedule = defaultJobSchedule
 		}
@@ -266,14 +312,15 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 			flavor = JobFlavorPeriodic
 		}
 		out = append(out, Definition{
-			JobID:            fmt.Sprintf("%s/%s", projectID, job.Id),
-			RealmID:          realmID,
-			Flavor:           flavor,
-			Revision:         meta.Revision,
-			RevisionURL:      revisionURL,
-			Schedule:         schedule,
-			Task:             packed,
-			TriggeringPolicy: marshalTriggeringPolicy(job.TriggeringPolicy),
+			JobID:              fmt.Sprintf("%s/%s", projectID, job.Id),
+			RealmID:            realmID,
+			Flavor:             flavor,
+			Revision:           meta.Revision,
+			RevisionURL:        revisionURL,
+			Schedule:           schedule,
+			Task:               packed,
+			TriggeringPolicy:   marshalTriggeringPolicy(job.TriggeringPolicy),
+			CompletionTriggers: completionTriggers[job.Id],
 		})
 	}
 
@@ -290,7 +337,7 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 		}
 		ctx := &validation.Context{Context: c}
 		realmID := validateRealm(ctx, projectID, trigger.Realm)
-		task := cat.validateTriggerProto(ctx, trigger, realmID, allJobIDs, false)
+		task := cat.validateTriggerProto(ctx, trigger, realmID, calendars, allJobIDs, false)
 		if err := ctx.Finalize(); err != nil {
 			logging.Errorf(c, "Invalid trigger definition %s: %s", id, err)
 			continue
@@ -300,7 +347,7 @@ func (cat *catalog) GetProjectJobs(c context.Context, projectID string) ([]Defin
 			logging.Errorf(c, "Failed to marshal the task: %s: %s", id, err)
 			continue
 		}
-		schedule := trigger.Schedule
+		schedule := expandSchedule(trigger.Schedule, calendars)
 		if schedule == "" {
 			schedule = defaultTriggerSchedule
 		}
@@ -343,6 +390,8 @@ func (cat *catalog) validateProjectConfig(ctx *validation.Context, configSet, pa
 	}
 	projectID := strings.TrimPrefix(configSet, "projects/")
 
+	calendars := validateCalendars(ctx, cfg.Calendar)
+
 	knownIDs := stringset.New(len(cfg.Job) + len(cfg.Trigger))
 	// Jobs.
 	ctx.Enter("job")
@@ -356,11 +405,20 @@ func (cat *catalog) validateProjectConfig(ctx *validation.Context, configSet, pa
 			ctx.Errorf("duplicate id %q", job.Id)
 		}
 		realmID := validateRealm(ctx, projectID, job.Realm)
-		cat.validateJobProto(ctx, job, realmID)
+		cat.validateJobProto(ctx, job, realmID, calendars)
 		ctx.Exit()
 	}
 	ctx.Exit()
 
+	// Jobs triggered by completion of other jobs.
+	regularJobIDs := stringset.New(len(cfg.Job))
+	for _, job := range cfg.Job {
+		if job.Id != "" {
+			regularJobIDs.Add(job.Id)
+		}
+	}
+	invertCompletionTriggers(ctx, projectID, cfg.Job, regularJobIDs, true)
+
 	// Triggers.
 	ctx.Enter("trigger")
 	allJobIDs := getAllJobIDs(&cfg)
@@ -374,7 +432,7 @@ func (cat *catalog) validateProjectConfig(ctx *validation.Context, configSet, pa
 			ctx.Errorf("duplicate id %q", trigger.Id)
 		}
 		realmID := validateRealm(ctx, projectID, trigger.Realm)
-		cat.validateTriggerProto(ctx, trigger, realmID, allJobIDs, true)
+		cat.validateTriggerProto(ctx, trigger, realmID, calendars, allJobIDs, true)
 		ctx.Exit()
 	}
 	ctx.Exit()
@@ -384,14 +442,15 @@ func (cat *catalog) validateProjectConfig(ctx *validation.Context, configSet, pa
 
 // validateJobProto validates messages.Job protobuf message.
 //
+// Takes calendars the job's schedule may refer to, as returned by
+// validateCalendars.
+//
 // It also extracts a task definition from it (e.g. SwarmingTask proto).
 // Errors are returned via validation.Context.
-func (cat *catalog) validateJobProto(ctx *validation.Context, j *messages.Job, realmID string) proto.Message {
+func (cat *catalog) validateJobProto(ctx *validation.Context, j *messages.Job, realmID string, calendars map[string][]string) proto.Message {
 	validateJobID(ctx, j.Id)
 	if j.Schedule != "" {
-		if _, err := schedule.Parse(j.Schedule, 0); err != nil {
-			ctx.Errorf("%s is not valid value for 'schedule' field - %s", j.Schedule, err)
-		}
+		validateSchedule(ctx, j.Schedule, calendars)
 	}
 	cat.validateTriggeringPolicy(ctx, j.TriggeringPolicy)
 	return cat.validateTaskProto(ctx, j, realmID)
@@ -401,18 +460,19 @@ func (cat *catalog) validateJobProto(ctx *validation.Context, j *messages.Job, r
 //
 // It also extracts a task definition from it.
 //
+// Takes calendars the trigger's schedule may refer to, as returned by
+// validateCalendars.
+//
 // Takes a set of all defined job IDs, to verify the trigger triggers only
 // declared jobs. If failOnMissing is true, referencing an undefined job is
 // reported as a validation error. Otherwise it is logged as a warning, and the
 // reference to the undefined job is removed.
 //
 // Errors are returned via validation.Context.
-func (cat *catalog) validateTriggerProto(ctx *validation.Context, t *messages.Trigger, realmID string, jobIDs stringset.Set, failOnMissing bool) proto.Message {
+func (cat *catalog) validateTriggerProto(ctx *validation.Context, t *messages.Trigger, realmID string, calendars map[string][]string, jobIDs stringset.Set, failOnMissing bool) proto.Message {
 	validateJobID(ctx, t.Id)
 	if t.Schedule != "" {
-		if _, err := schedule.Parse(t.Schedule, 0); err != nil {
-			ctx.Errorf("%s is not valid value for 'schedule' field - %s", t.Schedule, err)
-		}
+		validateSchedule(ctx, t.Schedule, calendars)
 	}
 	filtered := make([]string, 0, len(t.Triggers))
 	for _, id := range t.Triggers {
@@ -431,6 +491,63 @@ func (cat *catalog) validateTriggerProto(ctx *validation.Context, t *messages.Tr
 	return cat.validateTaskProto(ctx, t, realmID)
 }
 
+// validateSchedule validates a value of 'schedule' field, resolving references
+// to calendars.
+//
+// Errors are returned via validation.Context.
+func validateSchedule(ctx *validation.Context, sched string, calendars map[string][]string) {
+	expanded, err := schedule.ExpandCalendars(sched, calendars)
+	if err == nil {
+		_, err = schedule.Parse(expanded, 0)
+	}
+	if err != nil {
+		ctx.Errorf("%s is not valid value for 'schedule' field - %s", sched, err)
+	}
+}
+
+// expandSchedule resolves references to calendars in an already validated
+// value of 'schedule' field.
+func expandSchedule(sched string, calendars map[string][]string) string {
+	expanded, err := schedule.ExpandCalendars(sched, calendars)
+	if err != nil {
+		panic(fmt.Sprintf("the schedule %q should have been validated already: %s", sched, err))
+	}
+	return expanded
+}
+
+// validateCalendars validates messages.Calendar protobuf messages.
+//
+// Returns a map from a calendar ID to its blackout windows, as accepted by
+// schedule.ExpandCalendars. Errors are returned via validation.Context.
+func validateCalendars(ctx *validation.Context, cals []*messages.Calendar) map[string][]string {
+	out := make(map[string][]string, len(cals))
+	ctx.Enter("calendar")
+	defer ctx.Exit()
+	for _, cal := range cals {
+		id := "(empty)"
+		if cal.Id != "" {
+			id = cal.Id
+		}
+		ctx.Enter(id)
+		switch _, dup := out[cal.Id]; {
+		case cal.Id == "":
+			ctx.Errorf("missing 'id' field'")
+		case !calendarIDRe.MatchString(cal.Id):
+			ctx.Errorf("%q is not valid value for 'id' field, must match %q regexp", cal.Id, calendarIDRe)
+		case dup:
+			ctx.Errorf("duplicate id %q", cal.Id)
+		}
+		for _, w := range cal.Blackout {
+			if err := schedule.ValidateBlackout(w); err != nil {
+				ctx.Errorf("%s is not valid value for 'blackout' field - %s", w, err)
+			}
+		}
+		out[cal.Id] = cal.Blackout
+		ctx.Exit()
+	}
+	return out
+}
+
 func validateJobID(ctx *validation.Context, id string) {
 	if id == "" {
 		ctx.Errorf("missing 'id' field'")
@@ -565,6 +682,148 @@ func getAllJobIDs(cfg *messages.ProjectConfig) stringset.Set {
 	return out
 }
 
+// invertCompletionTriggers validates 'triggered_by_completion' fields of the
+// given jobs and inverts them.
+//
+// Returns a map from a job name to a list of jobs triggered when an invocation
+// of this job finishes, sorted by JobID.
+//
+// Takes a set of names of jobs that can be referenced, i.e. regular jobs, not
+// triggers. If strict is true, referencing any other job or using an unknown
+// status is reported as a validation error. Otherwise it is logged as
+// a warning, and the bad entry is skipped. Cycles are always reported as
+// validation errors.
+//
+// Errors are returned via validation.Context.
+func invertCompletionTriggers(ctx *validation.Context, projectID string, jobs []*messages.Job, jobIDs stringset.Set, strict bool) map[string][]CompletionTrigger {
+	// Triggering job name => triggered job name => statuses.
+	edges := map[string]map[string]stringset.Set{}
+
+	ctx.Enter("job")
+	for _, job := range jobs {
+		if len(job.TriggeredByCompletion) == 0 {
+			continue
+		}
+		id := "(empty)"
+		if job.Id != "" {
+			id = job.Id
+		}
+		ctx.Enter(id)
+		report := func(format string, args ...any) {
+			if strict {
+				ctx.Errorf(format, args...)
+			} else {
+				logging.Warningf(ctx.Context, "Job %q: %s", id, fmt.Sprintf(format, args...))
+			}
+		}
+		for _, t := range job.TriggeredByCompletion {
+			if !jobIDs.Has(t.Job) {
+				report("referencing unknown job %q in 'triggered_by_completion' field", t.Job)
+				continue
+			}
+			statuses := t.Statuses
+			if len(statuses) == 0 {
+				statuses = []string{string(task.StatusSucceeded)}
+			}
+			valid := stringset.New(len(statuses))
+			for _, s := range statuses {
+				if task.Status(s).Final() {
+					valid.Add(s)
+				} else {
+					report("%q is not a final invocation status, expecting one of SUCCEEDED, FAILED, OVERRUN or ABORTED", s)
+				}
+			}
+			if valid.Len() == 0 {
+				continue
+			}
+			if edges[t.Job] == nil {
+				edges[t.Job] = map[string]stringset.Set{}
+			}
+			if existing := edges[t.Job][job.Id]; existing != nil {
+				existing.AddAll(valid.ToSlice())
+			} else {
+				edges[t.Job][job.Id] = valid
+			}
+		}
+		ctx.Exit()
+	}
+	ctx.Exit()
+
+	if cycle := findCompletionCycle(edges); cycle != nil {
+		ctx.Errorf("jobs triggered by completion form a cycle: %s", strings.Join(cycle, " -> "))
+	}
+
+	out := make(map[string][]CompletionTrigger, len(edges))
+	for _, from := range sortedKeys(edges) {
+		for _, to := range sortedKeys(edges[from]) {
+			names := edges[from][to].ToSortedSlice()
+			statuses := make([]task.Status, len(names))
+			for i, s := range names {
+				statuses[i] = task.Status(s)
+			}
+			out[from] = append(out[from], CompletionTrigger{
+				JobID:    projectID + "/" + to,
+				Statuses: statuses,
+			})
+		}
+	}
+	return out
+}
+
+// findCompletionCycle returns a cycle in a graph of jobs triggered by
+// completion, as a list of job names that starts and ends with the same job.
+//
+// Returns nil if there are no cycles.
+func findCompletionCycle(edges map[string]map[string]stringset.Set) []string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var path []string
+
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		switch state[id] {
+		case visited:
+			return nil
+		case visiting:
+			for i, p := range path {
+				if p == id {
+					return append(append([]string(nil), path[i:]...), id)
+				}
+			}
+		}
+		state[id] = visiting
+		path = append(path, id)
+		for _, next := range sortedKeys(edges[id]) {
+			if cycle := visit(next); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = visited
+		return nil
+	}
+
+	for _, id := range sortedKeys(edges) {
+		if cycle := visit(id); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// sortedKeys returns sorted keys of a map.
+func sortedKeys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
 // normalizeTriggeredJobIDs returns sorted list without duplicates.
 func normalizeTriggeredJobIDs(projectID string, t *messages.Trigger) []string {
 	set := stringset.New(len(t.Triggers))
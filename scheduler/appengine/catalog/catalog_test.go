@@ -78,7 +78,9 @@ func TestProtoValidation(t *testing.T) {
 
 		call := func(j *messages.Job) error {
 			valCtx := &validation.Context{Context: ctx}
-			c.validateJobProto(valCtx, j, "some-project:some-realm")
+			c.validateJobProto(valCtx, j, "some-project:some-realm", map[string][]string{
+				"holidays": {"2024-12-25"},
+			})
 			return valCtx.Finalize()
 		}
 
@@ -93,6 +95,16 @@ func TestProtoValidation(t *testing.T) {
 			Id:       "good",
 			Schedule: "blah",
 		}), ShouldErrLike, "not valid value for 'schedule' field")
+		So(call(&messages.Job{
+			Id:       "good",
+			Schedule: "0 3 * * * tz=America/Los_Angeles blackout=sat,@holidays",
+			Noop:     &messages.NoopTask{},
+		}), ShouldBeNil)
+		So(call(&messages.Job{
+			Id:       "good",
+			Schedule: "0 3 * * * blackout=@unknown",
+			Noop:     &messages.NoopTask{},
+		}), ShouldErrLike, `unknown calendar "unknown"`)
 		So(call(&messages.Job{
 			Id:       "good",
 			Schedule: "* * * * *",
@@ -244,7 +256,7 @@ func TestConfigReading(t *testing.T) {
 		})
 
 		Convey("GetProjectJobs works", func() {
-			const expectedRev = "06e505e46c49133cc928fbc244b27b232d7e8010"
+			const expectedRev = "78cd660086baf45b5657144def69ddbc718f8225"
 
 			defs, err := cat.GetProjectJobs(ctx, "project1")
 			So(err, ShouldBeNil)
@@ -257,6 +269,16 @@ func TestConfigReading(t *testing.T) {
 					Schedule:         "*/10 * * * * * *",
 					Task:             []uint8{10, 0},
 					TriggeringPolicy: []uint8{16, 4},
+					CompletionTriggers: []CompletionTrigger{
+						{
+							JobID:    "project1/noop-job-2",
+							Statuses: []task.Status{task.StatusFailed, task.StatusSucceeded},
+						},
+						{
+							JobID:    "project1/urlfetch-job-1",
+							Statuses: []task.Status{task.StatusSucceeded},
+						},
+					},
 				},
 				{
 					JobID:       "project1/noop-job-2",
@@ -393,6 +415,111 @@ func TestValidateConfig(t *testing.T) {
 			So(ctx.Finalize(), ShouldErrLike, `referencing unknown job "noop-job-2" in 'triggers' field`)
 		})
 
+		Convey("validates calendars", func() {
+			So(rules.ValidateConfig(ctx, "projects/good", "luci-scheduler.cfg", []byte(`
+				calendar {
+					id: "release-freeze"
+					blackout: "2024-12-20..2025-01-03"
+				}
+				calendar {
+					id: "release-freeze"
+				}
+				calendar {
+					id: "bad id"
+				}
+				calendar {
+					id: "bad-window"
+					blackout: "someday"
+				}
+				job {
+					id: "job"
+					schedule: "0 3 * * * tz=Europe/Berlin blackout=@release-freeze"
+					noop: { }
+				}
+			`)), ShouldBeNil)
+			err := ctx.Finalize().(*validation.Error).Errors
+			So(err, ShouldHaveLength, 3)
+			So(err, ShouldContainErr, `duplicate id "release-freeze"`)
+			So(err, ShouldContainErr, `"bad id" is not valid value for 'id' field`)
+			So(err, ShouldContainErr, `someday is not valid value for 'blackout' field`)
+		})
+
+		Convey("validates triggered_by_completion", func() {
+			So(rules.ValidateConfig(ctx, "projects/bad", "luci-scheduler.cfg", []byte(`
+				job {
+					id: "a"
+					triggered_by_completion {
+						job: "b"
+						statuses: "RUNNING"
+					}
+					triggered_by_completion {
+						job: "trigger"
+					}
+					noop: { }
+				}
+				job {
+					id: "b"
+					noop: { }
+				}
+				trigger {
+					id: "trigger"
+					noop: { }
+				}
+			`)), ShouldBeNil)
+			err := ctx.Finalize().(*validation.Error).Errors
+			So(err, ShouldHaveLength, 2)
+			So(err, ShouldContainErr, `"RUNNING" is not a final invocation status`)
+			So(err, ShouldContainErr, `referencing unknown job "trigger" in 'triggered_by_completion' field`)
+		})
+
+		Convey("rejects triggered_by_completion cycles", func() {
+			So(rules.ValidateConfig(ctx, "projects/bad", "luci-scheduler.cfg", []byte(`
+				job {
+					id: "a"
+					triggered_by_completion {
+						job: "c"
+						statuses: "FAILED"
+					}
+					noop: { }
+				}
+				job {
+					id: "b"
+					triggered_by_completion {
+						job: "a"
+					}
+					noop: { }
+				}
+				job {
+					id: "c"
+					triggered_by_completion {
+						job: "b"
+					}
+					noop: { }
+				}
+				job {
+					id: "d"
+					triggered_by_completion {
+						job: "a"
+					}
+					noop: { }
+				}
+			`)), ShouldBeNil)
+			So(ctx.Finalize(), ShouldErrLike, `jobs triggered by completion form a cycle: a -> b -> c -> a`)
+		})
+
+		Convey("rejects triggered_by_completion self-reference", func() {
+			So(rules.ValidateConfig(ctx, "projects/bad", "luci-scheduler.cfg", []byte(`
+				job {
+					id: "a"
+					triggered_by_completion {
+						job: "a"
+					}
+					noop: { }
+				}
+			`)), ShouldBeNil)
+			So(ctx.Finalize(), ShouldErrLike, `jobs triggered by completion form a cycle: a -> a`)
+		})
+
 		Convey("rejects duplicate ids", func() {
 			// job + job
 			So(rules.ValidateConfig(ctx, "projects/bad", "luci-scheduler.cfg", []byte(`
@@ -525,6 +652,15 @@ job {
 	id: "noop-job-2"
 	schedule: "*/10 * * * * * *"
 
+	triggered_by_completion {
+		job: "noop-job-1"
+		statuses: "SUCCEEDED"
+		statuses: "FAILED"
+	}
+	triggered_by_completion {
+		job: "trigger"  # not a regular job, skipped
+	}
+
 	noop: {}
 }
 
@@ -533,6 +669,10 @@ job {
 	schedule: "*/10 * * * * * *"
 	disabled: true
 
+	triggered_by_completion {
+		job: "noop-job-1"  # skipped, since noop-job-3 is disabled
+	}
+
 	noop: {}
 }
 
@@ -540,6 +680,10 @@ job {
 	id: "urlfetch-job-1"
 	schedule: "*/10 * * * * * *"
 
+	triggered_by_completion {
+		job: "noop-job-1"
+	}
+
 	url_fetch: {
 		url: "https://example.com"
 	}
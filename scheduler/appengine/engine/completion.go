@@ -0,0 +1,161 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/scheduler/api/scheduler/v1"
+	"go.chromium.org/luci/scheduler/appengine/catalog"
+	"go.chromium.org/luci/scheduler/appengine/internal"
+)
+
+// marshalCompletionTriggers serializes a list of jobs triggered by completion
+// of invocations into CompletionTriggerList.
+//
+// Panics on errors.
+func marshalCompletionTriggers(defs []catalog.CompletionTrigger) []byte {
+	if len(defs) == 0 {
+		return nil
+	}
+	list := &internal.CompletionTriggerList{
+		Triggers: make([]*internal.CompletionTrigger, len(defs)),
+	}
+	for i, d := range defs {
+		statuses := make([]string, len(d.Statuses))
+		for j, s := range d.Statuses {
+			statuses[j] = string(s)
+		}
+		list.Triggers[i] = &internal.CompletionTrigger{
+			JobId:    d.JobID,
+			Statuses: statuses,
+		}
+	}
+	blob, err := proto.Marshal(list)
+	if err != nil {
+		panic(err)
+	}
+	return blob
+}
+
+// unmarshalCompletionTriggers deserializes CompletionTriggerList.
+func unmarshalCompletionTriggers(blob []byte) ([]*internal.CompletionTrigger, error) {
+	if len(blob) == 0 {
+		return nil, nil
+	}
+	list := internal.CompletionTriggerList{}
+	if err := proto.Unmarshal(blob, &list); err != nil {
+		return nil, err
+	}
+	return list.Triggers, nil
+}
+
+// completionFanOut returns a task that emits a trigger into jobs that depend on
+// the completion of the given finished invocation.
+//
+// Returns nil if no job is interested in the invocation's final status.
+func completionFanOut(inv *Invocation) (*internal.FanOutTriggersTask, error) {
+	deps, err := inv.CompletionTriggers()
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal CompletionTriggersRaw").Err()
+	}
+	var jobIDs []string
+	for _, dep := range deps {
+		for _, s := range dep.Statuses {
+			if s == string(inv.Status) {
+				jobIDs = append(jobIDs, dep.JobId)
+				break
+			}
+		}
+	}
+	if len(jobIDs) == 0 {
+		return nil, nil
+	}
+	t, err := completionTrigger(inv)
+	if err != nil {
+		return nil, err
+	}
+	return &internal.FanOutTriggersTask{
+		JobIds:   jobIDs,
+		Triggers: []*internal.Trigger{t},
+	}, nil
+}
+
+// CompletionTriggerID is ID of the trigger emitted when the given invocation
+// finishes.
+//
+// If the trigger was emitted, it is in the invocation's OutgoingTriggers.
+func CompletionTriggerID(inv *Invocation) string {
+	return fmt.Sprintf("completion:%s:%d", inv.JobID, inv.ID)
+}
+
+// completionTrigger builds a trigger emitted when the given invocation
+// finishes.
+//
+// The trigger refers to the invocation and carries its properties and tags,
+// so that the triggered invocation gets them too. If the invocation was
+// triggered by a commit, the trigger refers to the same commit.
+func completionTrigger(inv *Invocation) (*internal.Trigger, error) {
+	t := &internal.Trigger{
+		Id:           CompletionTriggerID(inv),
+		JobId:        inv.JobID,
+		InvocationId: inv.ID,
+		Created:      timestamppb.New(inv.Finished),
+		Title:        fmt.Sprintf("%s #%d %s", inv.JobID, inv.ID, strings.ToLower(string(inv.Status))),
+		Url:          fmt.Sprintf("/jobs/%s/%d", inv.JobID, inv.ID),
+	}
+
+	var props *structpb.Struct
+	if len(inv.PropertiesRaw) != 0 {
+		props = &structpb.Struct{}
+		if err := proto.Unmarshal(inv.PropertiesRaw, props); err != nil {
+			return nil, errors.Annotate(err, "failed to unmarshal PropertiesRaw").Err()
+		}
+	}
+
+	incoming, err := inv.IncomingTriggers()
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to unmarshal IncomingTriggersRaw").Err()
+	}
+	if len(incoming) != 0 {
+		if g := incoming[len(incoming)-1].GetGitiles(); g != nil {
+			t.Payload = &internal.Trigger_Gitiles{
+				Gitiles: &scheduler.GitilesTrigger{
+					Repo:       g.Repo,
+					Ref:        g.Ref,
+					Revision:   g.Revision,
+					Properties: props,
+					Tags:       inv.Tags,
+				},
+			}
+			return t, nil
+		}
+	}
+
+	t.Payload = &internal.Trigger_Buildbucket{
+		Buildbucket: &scheduler.BuildbucketTrigger{
+			Properties: props,
+			Tags:       inv.Tags,
+		},
+	}
+	return t, nil
+}
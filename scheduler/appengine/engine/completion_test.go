@@ -0,0 +1,98 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	api "go.chromium.org/luci/scheduler/api/scheduler/v1"
+	"go.chromium.org/luci/scheduler/appengine/catalog"
+	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/task"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestCompletionFanOut(t *testing.T) {
+	t.Parallel()
+
+	Convey("With invocation", t, func() {
+		props, _ := structpb.NewStruct(map[string]any{"revision": "deadbeef"})
+		propsRaw, _ := proto.Marshal(props)
+
+		inv := &Invocation{
+			ID:       123,
+			JobID:    "project/job",
+			Finished: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Status:   task.StatusFailed,
+			CompletionTriggersRaw: marshalCompletionTriggers([]catalog.CompletionTrigger{
+				{JobID: "project/on-any", Statuses: []task.Status{task.StatusFailed, task.StatusSucceeded}},
+				{JobID: "project/on-success", Statuses: []task.Status{task.StatusSucceeded}},
+			}),
+			PropertiesRaw: propsRaw,
+			Tags:          []string{"a:b"},
+		}
+
+		Convey("Picks jobs by status", func() {
+			fanOut, err := completionFanOut(inv)
+			So(err, ShouldBeNil)
+			So(fanOut.JobIds, ShouldResemble, []string{"project/on-any"})
+			So(fanOut.Triggers, ShouldHaveLength, 1)
+			So(fanOut.Triggers[0].GetBuildbucket(), ShouldResembleProto, &api.BuildbucketTrigger{
+				Properties: props,
+				Tags:       []string{"a:b"},
+			})
+		})
+
+		Convey("No interested jobs", func() {
+			inv.Status = task.StatusAborted
+			fanOut, err := completionFanOut(inv)
+			So(err, ShouldBeNil)
+			So(fanOut, ShouldBeNil)
+		})
+
+		Convey("Propagates the commit", func() {
+			inv.IncomingTriggersRaw = marshalTriggersList([]*internal.Trigger{
+				{
+					Id: "old",
+					Payload: &internal.Trigger_Gitiles{
+						Gitiles: &api.GitilesTrigger{Repo: "https://r.googlesource.com/repo", Ref: "refs/heads/main", Revision: "0000"},
+					},
+				},
+				{
+					Id: "new",
+					Payload: &internal.Trigger_Gitiles{
+						Gitiles: &api.GitilesTrigger{Repo: "https://r.googlesource.com/repo", Ref: "refs/heads/main", Revision: "deadbeef"},
+					},
+				},
+			})
+			trigger, err := completionTrigger(inv)
+			So(err, ShouldBeNil)
+			So(trigger.Id, ShouldEqual, "completion:project/job:123")
+			So(trigger.GetGitiles(), ShouldResembleProto, &api.GitilesTrigger{
+				Repo:       "https://r.googlesource.com/repo",
+				Ref:        "refs/heads/main",
+				Revision:   "deadbeef",
+				Properties: props,
+				Tags:       []string{"a:b"},
+			})
+		})
+	})
+}
@@ -937,15 +937,16 @@ func (e *engineImpl) updateJob(c context.Context, def catalog.Definition) error
 				return fmt.Errorf("unexpected jobID format: %s", def.JobID)
 			}
 			*job = Job{
-				JobID:               def.JobID,
-				ProjectID:           chunks[0],
-				RealmID:             def.RealmID,
-				Flavor:              def.Flavor,
-				Enabled:             false, // to trigger 'if wasDisabled' below
-				Schedule:            def.Schedule,
-				Task:                def.Task,
-				TriggeringPolicyRaw: def.TriggeringPolicy,
-				TriggeredJobIDs:     def.TriggeredJobIDs,
+				JobID:                 def.JobID,
+				ProjectID:             chunks[0],
+				RealmID:               def.RealmID,
+				Flavor:                def.Flavor,
+				Enabled:               false, // to trigger 'if wasDisabled' below
+				Schedule:              def.Schedule,
+				Task:                  def.Task,
+				TriggeringPolicyRaw:   def.TriggeringPolicy,
+				TriggeredJobIDs:       def.TriggeredJobIDs,
+				CompletionTriggersRaw: marshalCompletionTriggers(def.CompletionTriggers),
 			}
 		}
 		wasDisabled := !job.Enabled
@@ -962,6 +963,7 @@ func (e *engineImpl) updateJob(c context.Context, def catalog.Definition) error
 		job.Task = def.Task
 		job.TriggeringPolicyRaw = def.TriggeringPolicy
 		job.TriggeredJobIDs = def.TriggeredJobIDs
+		job.CompletionTriggersRaw = marshalCompletionTriggers(def.CompletionTriggers)
 
 		// If job triggering policy has changed, schedule a triage to potentially
 		// act based on the new policy.
@@ -1104,14 +1106,15 @@ func (e *engineImpl) allocateInvocation(c context.Context, job *Job, req task.Re
 	var inv *Invocation
 	err := runIsolatedTxn(c, func(c context.Context) (err error) {
 		inv, err = e.initInvocation(c, &Invocation{
-			JobID:           job.JobID,
-			RealmID:         job.RealmID,
-			Started:         clock.Now(c).UTC(),
-			Revision:        job.Revision,
-			RevisionURL:     job.RevisionURL,
-			Task:            job.Task,
-			TriggeredJobIDs: job.TriggeredJobIDs,
-			Status:          task.StatusStarting,
+			JobID:                 job.JobID,
+			RealmID:               job.RealmID,
+			Started:               clock.Now(c).UTC(),
+			Revision:              job.Revision,
+			RevisionURL:           job.RevisionURL,
+			Task:                  job.Task,
+			TriggeredJobIDs:       job.TriggeredJobIDs,
+			CompletionTriggersRaw: job.CompletionTriggersRaw,
+			Status:                task.StatusStarting,
 		}, &req)
 		if err != nil {
 			return
@@ -1413,6 +1416,24 @@ func (e *engineImpl) invChanging(c context.Context, old, fresh *Invocation, time
 				Triggers: fanOutTriggersTask,
 			},
 		})
+
+		// Emit a trigger into jobs that depend on the completion of this
+		// invocation. They are usually different from TriggeredJobIDs, so use
+		// a separate task. Don't fail the transaction if the trigger can't be
+		// built: the invocation must finish regardless.
+		switch completion, err := completionFanOut(fresh); {
+		case err != nil:
+			logging.WithError(err).Errorf(c, "Failed to prepare the completion trigger")
+			fresh.debugLog(c, "Failed to prepare the completion trigger, dependent jobs are not triggered: %s", err)
+		case completion != nil:
+			err := mutateTriggersList(&fresh.OutgoingTriggersRaw, func(out *[]*internal.Trigger) {
+				*out = append(*out, completion.Triggers...)
+			})
+			if err != nil {
+				return err
+			}
+			tasks = append(tasks, &tq.Task{Payload: completion})
+		}
 	} else if fanOutTriggersTask != nil {
 		tasks = append(tasks, &tq.Task{Payload: fanOutTriggersTask})
 	}
@@ -1950,3 +1950,111 @@ func (e *expectedTasks) invocationTimer(invID, seq int64, title string, created,
 		},
 	})
 }
+
+func TestJobTriggeredByCompletion(t *testing.T) {
+	t.Parallel()
+
+	Convey("with fake env", t, func() {
+		c := newTestContext(epoch)
+		e, mgr := newTestEngine()
+
+		tq := tqtesting.GetTestable(c, e.cfg.Dispatcher)
+		tq.CreateQueues()
+
+		upstreamJob := "project/upstream-job"
+		downstreamJob := "project/downstream-job"
+
+		So(e.UpdateProjectJobs(c, "project", []catalog.Definition{
+			{
+				JobID:    upstreamJob,
+				RealmID:  "project:testing",
+				Revision: "rev1",
+				Schedule: "triggered",
+				Task:     noopTaskBytes(),
+				CompletionTriggers: []catalog.CompletionTrigger{
+					{JobID: downstreamJob, Statuses: []task.Status{task.StatusSucceeded}},
+				},
+			},
+			{
+				JobID:    downstreamJob,
+				RealmID:  "project:testing",
+				Revision: "rev1",
+				Schedule: "triggered",
+				Task:     noopTaskBytes(),
+			},
+		}), ShouldBeNil)
+
+		runUpstream := func(status task.Status) int64 {
+			var invID int64
+			forceInvocation(c, e, upstreamJob)
+			mgr.launchTask = func(ctx context.Context, ctl task.Controller) error {
+				invID = ctl.InvocationID()
+				ctl.State().Status = status
+				return nil
+			}
+			_, _, err := tq.RunSimulation(c, &tqtesting.SimulationParams{
+				ShouldStopBefore: func(t tqtesting.Task) bool {
+					_, ok := t.Payload.(*internal.TriageJobStateTask)
+					return ok
+				},
+			})
+			So(err, ShouldBeNil)
+			return invID
+		}
+
+		Convey("succeeded invocation triggers the dependent job", func() {
+			invID := runUpstream(task.StatusSucceeded)
+
+			expectedTrigger := &internal.Trigger{
+				Id:           fmt.Sprintf("completion:%s:%d", upstreamJob, invID),
+				JobId:        upstreamJob,
+				InvocationId: invID,
+				Created:      timestamppb.New(epoch.Add(1 * time.Second)),
+				Title:        fmt.Sprintf("%s #%d succeeded", upstreamJob, invID),
+				Url:          fmt.Sprintf("/jobs/%s/%d", upstreamJob, invID),
+				Payload: &internal.Trigger_Buildbucket{
+					Buildbucket: &api.BuildbucketTrigger{},
+				},
+			}
+
+			// The trigger is recorded as outgoing.
+			inv, err := e.getInvocation(c, upstreamJob, invID)
+			So(err, ShouldBeNil)
+			outgoing, err := inv.OutgoingTriggers()
+			So(err, ShouldBeNil)
+			So(outgoing, ShouldResembleProto, []*internal.Trigger{expectedTrigger})
+
+			// And it is pending in the dependent job.
+			dj, _ := e.getJob(c, downstreamJob)
+			triggers, err := e.ListTriggers(c, dj)
+			So(err, ShouldBeNil)
+			So(triggers, ShouldResembleProto, []*internal.Trigger{expectedTrigger})
+
+			// The dependent job consumes it.
+			var seen []*internal.Trigger
+			mgr.launchTask = func(ctx context.Context, ctl task.Controller) error {
+				seen = ctl.Request().IncomingTriggers
+				ctl.State().Status = task.StatusSucceeded
+				return nil
+			}
+			_, _, err = tq.RunSimulation(c, nil)
+			So(err, ShouldBeNil)
+			So(seen, ShouldResembleProto, []*internal.Trigger{expectedTrigger})
+		})
+
+		Convey("failed invocation doesn't trigger the dependent job", func() {
+			invID := runUpstream(task.StatusFailed)
+
+			inv, err := e.getInvocation(c, upstreamJob, invID)
+			So(err, ShouldBeNil)
+			outgoing, err := inv.OutgoingTriggers()
+			So(err, ShouldBeNil)
+			So(outgoing, ShouldHaveLength, 0)
+
+			dj, _ := e.getJob(c, downstreamJob)
+			triggers, err := e.ListTriggers(c, dj)
+			So(err, ShouldBeNil)
+			So(triggers, ShouldHaveLength, 0)
+		})
+	})
+}
@@ -218,6 +218,13 @@ type Invocation struct {
 	// The list is sorted and without duplicates.
 	TriggeredJobIDs []string `gae:",noindex"`
 
+	// CompletionTriggersRaw is a serialized list of jobs triggered when this
+	// invocation finishes.
+	//
+	// It is copied from the Job entity when the invocation is created. Use
+	// CompletionTriggers() function to grab it in deserialized form.
+	CompletionTriggersRaw []byte `gae:",noindex"`
+
 	// DebugLog is short free form text log with debug messages.
 	DebugLog string `gae:",noindex"`
 
@@ -260,6 +267,7 @@ func (e *Invocation) isEqual(other *Invocation) bool {
 		e.RevisionURL == other.RevisionURL &&
 		bytes.Equal(e.Task, other.Task) &&
 		equalSortedLists(e.TriggeredJobIDs, other.TriggeredJobIDs) &&
+		bytes.Equal(e.CompletionTriggersRaw, other.CompletionTriggersRaw) &&
 		e.DebugLog == other.DebugLog &&
 		e.RetryCount == other.RetryCount &&
 		e.Status == other.Status &&
@@ -363,6 +371,14 @@ func (e *Invocation) OutgoingTriggers() ([]*internal.Trigger, error) {
 	return unmarshalTriggersList(e.OutgoingTriggersRaw)
 }
 
+// CompletionTriggers is a list of jobs triggered when the invocation finishes,
+// along with the final statuses that trigger them.
+//
+// It is deserialized on the fly from CompletionTriggersRaw.
+func (e *Invocation) CompletionTriggers() ([]*internal.CompletionTrigger, error) {
+	return unmarshalCompletionTriggers(e.CompletionTriggersRaw)
+}
+
 // PendingTimers is a list of not-yet-consumed invocation timers.
 //
 // It is deserialized on the fly from PendingTimersRaw.
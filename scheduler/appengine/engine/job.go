@@ -106,6 +106,13 @@ type Job struct {
 	// The list is sorted and without duplicates.
 	TriggeredJobIDs []string `gae:",noindex"`
 
+	// CompletionTriggersRaw is a list of jobs triggered when an invocation of
+	// this job finishes.
+	//
+	// It is serialized internal.CompletionTriggerList proto, see db.proto. It is
+	// taken from the job definition stored in the catalog.
+	CompletionTriggersRaw []byte `gae:",noindex"`
+
 	// Cron holds the state of the cron state machine.
 	Cron cron.State `gae:",noindex"`
 
@@ -228,6 +235,7 @@ func (e *Job) IsEqual(other *Job) bool {
 		e.LastTriage.Equal(other.LastTriage) &&
 		bytes.Equal(e.Task, other.Task) &&
 		equalSortedLists(e.TriggeredJobIDs, other.TriggeredJobIDs) &&
+		bytes.Equal(e.CompletionTriggersRaw, other.CompletionTriggersRaw) &&
 		e.Cron.Equal(&other.Cron) &&
 		bytes.Equal(e.TriggeringPolicyRaw, other.TriggeringPolicyRaw) &&
 		equalInt64Lists(e.ActiveInvocations, other.ActiveInvocations) &&
@@ -243,7 +251,8 @@ func (e *Job) MatchesDefinition(def catalog.Definition) bool {
 		e.Schedule == def.Schedule &&
 		bytes.Equal(e.Task, def.Task) &&
 		bytes.Equal(e.TriggeringPolicyRaw, def.TriggeringPolicy) &&
-		equalSortedLists(e.TriggeredJobIDs, def.TriggeredJobIDs)
+		equalSortedLists(e.TriggeredJobIDs, def.TriggeredJobIDs) &&
+		bytes.Equal(e.CompletionTriggersRaw, marshalCompletionTriggers(def.CompletionTriggers))
 }
 
 // CronTickTime returns time when the cron job is expected to start again.
@@ -58,7 +58,9 @@ import (
 	"go.chromium.org/luci/scheduler/appengine/internal"
 	"go.chromium.org/luci/scheduler/appengine/task"
 	"go.chromium.org/luci/scheduler/appengine/task/buildbucket"
+	"go.chromium.org/luci/scheduler/appengine/task/git"
 	"go.chromium.org/luci/scheduler/appengine/task/gitiles"
+	"go.chromium.org/luci/scheduler/appengine/task/httppoller"
 	"go.chromium.org/luci/scheduler/appengine/task/noop"
 	"go.chromium.org/luci/scheduler/appengine/task/urlfetch"
 	"go.chromium.org/luci/scheduler/appengine/ui"
@@ -80,7 +82,9 @@ var (
 	// Known kinds of tasks.
 	managers = []task.Manager{
 		&buildbucket.TaskManager{},
+		&git.TaskManager{},
 		&gitiles.TaskManager{},
+		&httppoller.TaskManager{},
 		&noop.TaskManager{},
 		&urlfetch.TaskManager{},
 	}
@@ -162,6 +162,7 @@ type DebugManagerState struct {
 	Error         string         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	DebugLog      string         `protobuf:"bytes,2,opt,name=debug_log,json=debugLog,proto3" json:"debug_log,omitempty"`
 	GitilesPoller *pb.DebugState `protobuf:"bytes,3,opt,name=gitiles_poller,json=gitilesPoller,proto3" json:"gitiles_poller,omitempty"`
+	GitPoller     *pb.DebugState `protobuf:"bytes,4,opt,name=git_poller,json=gitPoller,proto3" json:"git_poller,omitempty"`
 }
 
 func (x *DebugManagerState) Reset() {
@@ -217,6 +218,13 @@ func (x *DebugManagerState) GetGitilesPoller() *pb.DebugState {
 	return nil
 }
 
+func (x *DebugManagerState) GetGitPoller() *pb.DebugState {
+	if x != nil {
+		return x.GitPoller
+	}
+	return nil
+}
+
 type DebugJobState_CronState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -370,7 +378,7 @@ var file_go_chromium_org_luci_scheduler_appengine_internal_admin_proto_rawDesc =
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x63,
 	0x6b, 0x57, 0x68, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x69,
 	0x63, 0x6b, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x8b, 0x01,
+	0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xc8, 0x01,
 	0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x53, 0x74,
 	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x62,
@@ -379,16 +387,20 @@ var file_go_chromium_org_luci_scheduler_appengine_internal_admin_proto_rawDesc =
 	0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x67, 0x69,
-	0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x32, 0x4d, 0x0a, 0x05, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67,
-	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x66, 0x1a, 0x1d, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x6f,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75,
-	0x63, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0a, 0x67,
+	0x69, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x67,
+	0x69, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x32, 0x4d, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x66, 0x1a, 0x1d, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4a,
+	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x6f, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -415,21 +427,22 @@ var file_go_chromium_org_luci_scheduler_appengine_internal_admin_proto_goTypes =
 	(*v1.JobRef)(nil),               // 7: scheduler.JobRef
 }
 var file_go_chromium_org_luci_scheduler_appengine_internal_admin_proto_depIdxs = []int32{
-	3, // 0: internal.admin.DebugJobState.last_triage:type_name -> google.protobuf.Timestamp
-	2, // 1: internal.admin.DebugJobState.cron_state:type_name -> internal.admin.DebugJobState.CronState
-	1, // 2: internal.admin.DebugJobState.manager_state:type_name -> internal.admin.DebugManagerState
-	4, // 3: internal.admin.DebugJobState.finished_invocations:type_name -> internal.db.FinishedInvocation
-	5, // 4: internal.admin.DebugJobState.pending_triggers_set:type_name -> internal.triggers.Trigger
-	6, // 5: internal.admin.DebugManagerState.gitiles_poller:type_name -> gitiles.messages.DebugState
-	3, // 6: internal.admin.DebugJobState.CronState.last_rewind:type_name -> google.protobuf.Timestamp
-	3, // 7: internal.admin.DebugJobState.CronState.last_tick_when:type_name -> google.protobuf.Timestamp
-	7, // 8: internal.admin.Admin.GetDebugJobState:input_type -> scheduler.JobRef
-	0, // 9: internal.admin.Admin.GetDebugJobState:output_type -> internal.admin.DebugJobState
-	9, // [9:10] is the sub-list for method output_type
-	8, // [8:9] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	3,  // 0: internal.admin.DebugJobState.last_triage:type_name -> google.protobuf.Timestamp
+	2,  // 1: internal.admin.DebugJobState.cron_state:type_name -> internal.admin.DebugJobState.CronState
+	1,  // 2: internal.admin.DebugJobState.manager_state:type_name -> internal.admin.DebugManagerState
+	4,  // 3: internal.admin.DebugJobState.finished_invocations:type_name -> internal.db.FinishedInvocation
+	5,  // 4: internal.admin.DebugJobState.pending_triggers_set:type_name -> internal.triggers.Trigger
+	6,  // 5: internal.admin.DebugManagerState.gitiles_poller:type_name -> gitiles.messages.DebugState
+	6,  // 6: internal.admin.DebugManagerState.git_poller:type_name -> gitiles.messages.DebugState
+	3,  // 7: internal.admin.DebugJobState.CronState.last_rewind:type_name -> google.protobuf.Timestamp
+	3,  // 8: internal.admin.DebugJobState.CronState.last_tick_when:type_name -> google.protobuf.Timestamp
+	7,  // 9: internal.admin.Admin.GetDebugJobState:input_type -> scheduler.JobRef
+	0,  // 10: internal.admin.Admin.GetDebugJobState:output_type -> internal.admin.DebugJobState
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_scheduler_appengine_internal_admin_proto_init() }
@@ -141,6 +141,112 @@ func (x *FinishedInvocationList) GetInvocations() []*FinishedInvocation {
 	return nil
 }
 
+// CompletionTrigger is a job triggered when an invocation finishes with one of
+// the given statuses.
+type CompletionTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId    string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // "<project>/<job>"
+	Statuses []string `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`        // sorted list of task.Status values
+}
+
+func (x *CompletionTrigger) Reset() {
+	*x = CompletionTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionTrigger) ProtoMessage() {}
+
+func (x *CompletionTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionTrigger.ProtoReflect.Descriptor instead.
+func (*CompletionTrigger) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompletionTrigger) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *CompletionTrigger) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// CompletionTriggerList is stored in Job and Invocation entities as
+// CompletionTriggersRaw.
+type CompletionTriggerList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Triggers []*CompletionTrigger `protobuf:"bytes,1,rep,name=triggers,proto3" json:"triggers,omitempty"`
+}
+
+func (x *CompletionTriggerList) Reset() {
+	*x = CompletionTriggerList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionTriggerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionTriggerList) ProtoMessage() {}
+
+func (x *CompletionTriggerList) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionTriggerList.ProtoReflect.Descriptor instead.
+func (*CompletionTriggerList) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompletionTriggerList) GetTriggers() []*CompletionTrigger {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
 var File_go_chromium_org_luci_scheduler_appengine_internal_db_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDesc = []byte{
@@ -163,11 +269,21 @@ var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDesc = []
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
 	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x64, 0x62, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73,
 	0x68, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69,
-	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x6f,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75,
-	0x63, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x46, 0x0a, 0x11, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12,
+	0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x08, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x6f, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -182,20 +298,23 @@ func file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDescGZIP
 	return file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_goTypes = []interface{}{
 	(*FinishedInvocation)(nil),     // 0: internal.db.FinishedInvocation
 	(*FinishedInvocationList)(nil), // 1: internal.db.FinishedInvocationList
-	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
+	(*CompletionTrigger)(nil),      // 2: internal.db.CompletionTrigger
+	(*CompletionTriggerList)(nil),  // 3: internal.db.CompletionTriggerList
+	(*timestamppb.Timestamp)(nil),  // 4: google.protobuf.Timestamp
 }
 var file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_depIdxs = []int32{
-	2, // 0: internal.db.FinishedInvocation.finished:type_name -> google.protobuf.Timestamp
+	4, // 0: internal.db.FinishedInvocation.finished:type_name -> google.protobuf.Timestamp
 	0, // 1: internal.db.FinishedInvocationList.invocations:type_name -> internal.db.FinishedInvocation
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 2: internal.db.CompletionTriggerList.triggers:type_name -> internal.db.CompletionTrigger
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_init() }
@@ -228,6 +347,30 @@ func file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_init() {
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionTriggerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -235,7 +378,7 @@ func file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_scheduler_appengine_internal_db_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -12,1162 +12,1146 @@ func init() {
 			"internal.admin.Admin",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 107, 112, 28, 73,
-			122, 32, 214, 85, 213, 221, 104, 36, 72, 16, 72, 128, 32, 216,
-			124, 76, 178, 57, 36, 0, 78, 179, 65, 128, 143, 25, 130, 51,
-			59, 139, 71, 147, 108, 14, 8, 96, 26, 192, 112, 103, 102, 119,
-			193, 234, 238, 108, 160, 6, 213, 85, 61, 85, 213, 0, 123, 102,
-			71, 171, 211, 121, 181, 178, 47, 226, 78, 58, 173, 214, 14, 201,
-			190, 117, 156, 116, 62, 203, 103, 199, 133, 246, 34, 164, 144, 172,
-			179, 188, 62, 157, 21, 33, 93, 196, 217, 167, 56, 71, 156, 127,
-			216, 63, 214, 246, 31, 95, 56, 206, 143, 176, 29, 97, 59, 236,
-			248, 190, 204, 172, 202, 6, 192, 215, 204, 158, 28, 113, 65, 196,
-			60, 250, 171, 202, 231, 151, 95, 125, 249, 189, 242, 75, 242, 39,
-			51, 228, 181, 109, 223, 223, 118, 249, 116, 59, 240, 35, 191, 214,
-			105, 78, 71, 78, 139, 135, 145, 221, 106, 151, 240, 17, 61, 33,
-			10, 148, 84, 129, 194, 29, 210, 191, 161, 202, 208, 113, 210, 23,
-			242, 186, 239, 53, 194, 113, 131, 25, 147, 86, 85, 129, 116, 148,
-			100, 60, 219, 243, 195, 113, 147, 25, 147, 153, 170, 0, 22, 190,
-			103, 144, 145, 186, 223, 42, 29, 104, 116, 97, 48, 110, 114, 13,
-			30, 173, 25, 31, 205, 202, 34, 219, 190, 107, 123, 219, 37, 63,
-			216, 214, 198, 216, 109, 243, 112, 122, 215, 243, 247, 189, 100, 188,
-			237, 218, 255, 105, 24, 127, 211, 180, 238, 173, 45, 252, 7, 230,
-			249, 123, 162, 246, 154, 172, 82, 122, 196, 93, 247, 61, 168, 176,
-			1, 117, 31, 252, 195, 105, 210, 71, 51, 231, 83, 127, 221, 48,
-			200, 63, 58, 70, 140, 99, 212, 58, 159, 162, 179, 63, 57, 198,
-			176, 70, 221, 119, 217, 66, 167, 217, 228, 65, 200, 174, 50, 209,
-			214, 68, 200, 26, 118, 100, 51, 199, 139, 120, 80, 223, 177, 189,
-			109, 206, 154, 126, 208, 178, 35, 194, 22, 253, 118, 55, 112, 182,
-			119, 34, 54, 123, 237, 218, 91, 178, 2, 171, 120, 245, 18, 99,
-			243, 174, 203, 240, 93, 200, 2, 30, 242, 96, 143, 55, 74, 132,
-			237, 68, 81, 59, 156, 155, 158, 110, 240, 61, 238, 250, 109, 30,
-			132, 10, 39, 117, 191, 37, 102, 90, 247, 221, 171, 53, 49, 136,
-			105, 66, 88, 149, 55, 156, 48, 10, 156, 90, 39, 114, 124, 143,
-			217, 94, 131, 117, 66, 206, 28, 143, 133, 126, 39, 168, 115, 124,
-			82, 115, 60, 59, 232, 226, 184, 194, 34, 219, 119, 162, 29, 230,
-			7, 248, 127, 191, 19, 17, 214, 242, 27, 78, 211, 169, 219, 208,
-			66, 145, 217, 1, 103, 109, 30, 180, 156, 40, 226, 13, 214, 14,
-			252, 61, 167, 193, 27, 44, 218, 177, 35, 22, 237, 192, 236, 92,
-			215, 223, 119, 188, 109, 6, 75, 234, 64, 165, 16, 42, 17, 214,
-			226, 209, 28, 33, 12, 254, 174, 28, 24, 88, 200, 252, 166, 26,
-			81, 221, 111, 112, 214, 234, 132, 17, 11, 120, 100, 59, 30, 182,
-			106, 215, 252, 61, 120, 37, 49, 70, 152, 231, 71, 78, 157, 23,
-			89, 180, 227, 132, 204, 117, 194, 8, 90, 208, 123, 244, 26, 7,
-			134, 211, 112, 194, 186, 107, 59, 45, 30, 148, 158, 54, 8, 199,
-			211, 113, 161, 6, 209, 14, 252, 70, 167, 206, 147, 113, 144, 100,
-			32, 95, 105, 28, 132, 201, 217, 53, 252, 122, 167, 197, 189, 200,
-			86, 139, 52, 237, 7, 204, 143, 118, 120, 192, 90, 118, 196, 3,
-			199, 118, 195, 4, 213, 184, 64, 209, 14, 39, 76, 31, 125, 60,
-			169, 21, 238, 96, 77, 104, 216, 179, 91, 28, 6, 164, 211, 150,
-			231, 39, 239, 16, 239, 78, 20, 194, 140, 60, 209, 148, 31, 132,
-			172, 101, 119, 89, 141, 3, 165, 52, 88, 228, 51, 238, 53, 252,
-			32, 228, 64, 20, 237, 192, 111, 249, 17, 103, 2, 39, 81, 200,
-			26, 60, 112, 246, 120, 131, 53, 3, 191, 69, 4, 22, 66, 191,
-			25, 237, 3, 153, 72, 10, 98, 97, 155, 215, 129, 130, 88, 59,
-			112, 128, 176, 2, 160, 29, 79, 80, 81, 24, 226, 216, 9, 219,
-			184, 95, 89, 103, 235, 171, 119, 55, 30, 205, 87, 203, 172, 178,
-			206, 214, 170, 171, 31, 84, 150, 202, 75, 108, 225, 67, 182, 113,
-			191, 204, 22, 87, 215, 62, 172, 86, 238, 221, 223, 96, 247, 87,
-			151, 151, 202, 213, 117, 54, 191, 178, 196, 22, 87, 87, 54, 170,
-			149, 133, 205, 141, 213, 234, 58, 97, 133, 249, 117, 86, 89, 47,
-			224, 155, 249, 149, 15, 89, 249, 27, 107, 213, 242, 250, 58, 91,
-			173, 178, 202, 195, 181, 229, 74, 121, 137, 61, 154, 175, 86, 231,
-			87, 54, 42, 229, 245, 34, 171, 172, 44, 46, 111, 46, 85, 86,
-			238, 21, 217, 194, 230, 6, 91, 89, 221, 32, 108, 185, 242, 176,
-			178, 81, 94, 98, 27, 171, 69, 236, 246, 112, 61, 182, 122, 151,
-			61, 44, 87, 23, 239, 207, 175, 108, 204, 47, 84, 150, 43, 27,
-			31, 98, 135, 119, 43, 27, 43, 208, 217, 221, 213, 42, 97, 243,
-			108, 109, 190, 186, 81, 89, 220, 92, 158, 175, 178, 181, 205, 234,
-			218, 234, 122, 153, 193, 204, 150, 42, 235, 139, 203, 243, 149, 135,
-			229, 165, 18, 171, 172, 176, 149, 85, 86, 254, 160, 188, 178, 193,
-			214, 239, 207, 47, 47, 247, 78, 148, 176, 213, 71, 43, 229, 42,
-			140, 94, 159, 38, 91, 40, 179, 229, 202, 252, 194, 114, 25, 186,
-			194, 121, 46, 85, 170, 229, 197, 13, 152, 80, 242, 107, 177, 178,
-			84, 94, 217, 152, 95, 46, 18, 182, 190, 86, 94, 172, 204, 47,
-			23, 89, 249, 27, 229, 135, 107, 203, 243, 213, 15, 139, 178, 209,
-			245, 242, 251, 155, 229, 149, 141, 202, 252, 50, 91, 154, 127, 56,
-			127, 175, 188, 206, 38, 159, 135, 149, 181, 234, 234, 226, 102, 181,
-			252, 16, 70, 189, 122, 151, 173, 111, 46, 172, 111, 84, 54, 54,
-			55, 202, 236, 222, 234, 234, 18, 34, 123, 189, 92, 253, 160, 178,
-			88, 94, 191, 195, 150, 87, 215, 17, 97, 155, 235, 229, 34, 97,
-			75, 243, 27, 243, 216, 245, 90, 117, 245, 110, 101, 99, 253, 14,
-			252, 94, 216, 92, 175, 32, 226, 42, 43, 27, 229, 106, 117, 115,
-			109, 163, 178, 186, 50, 197, 238, 175, 62, 42, 127, 80, 174, 178,
-			197, 249, 205, 245, 242, 18, 98, 120, 117, 5, 102, 11, 180, 82,
-			94, 173, 126, 8, 205, 2, 30, 112, 5, 138, 236, 209, 253, 242,
-			198, 253, 114, 21, 144, 138, 216, 154, 7, 52, 172, 111, 84, 43,
-			139, 27, 122, 177, 213, 42, 219, 88, 173, 110, 16, 109, 158, 108,
-			165, 124, 111, 185, 114, 175, 188, 178, 88, 134, 215, 171, 208, 204,
-			163, 202, 122, 121, 138, 205, 87, 43, 235, 80, 160, 130, 29, 179,
-			71, 243, 31, 178, 213, 77, 156, 53, 44, 212, 230, 122, 153, 136,
-			223, 26, 233, 22, 113, 61, 89, 229, 46, 155, 95, 250, 160, 2,
-			35, 151, 165, 215, 86, 215, 215, 43, 146, 92, 16, 109, 139, 247,
-			37, 206, 75, 132, 228, 136, 97, 82, 139, 165, 198, 225, 87, 142,
-			90, 133, 212, 29, 210, 79, 204, 220, 37, 241, 83, 60, 188, 152,
-			122, 13, 31, 190, 38, 126, 138, 135, 175, 167, 42, 248, 112, 64,
-			252, 20, 15, 47, 165, 138, 248, 208, 16, 63, 197, 195, 203, 169,
-			105, 124, 40, 127, 138, 135, 19, 169, 2, 62, 36, 226, 167, 120,
-			56, 153, 186, 128, 15, 95, 23, 63, 255, 155, 115, 196, 76, 167,
-			104, 246, 251, 6, 108, 125, 249, 127, 124, 142, 205, 179, 120, 235,
-			69, 6, 201, 67, 238, 69, 33, 179, 89, 219, 119, 188, 8, 217,
-			154, 211, 130, 109, 166, 193, 219, 220, 107, 112, 15, 217, 162, 237,
-			117, 197, 243, 207, 124, 15, 185, 137, 235, 215, 109, 151, 176, 186,
-			237, 114, 175, 97, 7, 69, 198, 61, 224, 254, 13, 102, 67, 91,
-			117, 191, 35, 234, 73, 233, 0, 121, 105, 51, 176, 235, 201, 142,
-			161, 94, 192, 134, 0, 162, 2, 194, 176, 99, 250, 174, 96, 138,
-			108, 99, 135, 203, 134, 28, 216, 74, 93, 59, 114, 246, 56, 48,
-			53, 219, 99, 188, 237, 215, 119, 152, 29, 177, 205, 141, 69, 214,
-			114, 26, 30, 114, 116, 223, 35, 236, 129, 237, 117, 96, 27, 152,
-			41, 178, 153, 219, 111, 94, 43, 42, 70, 221, 14, 124, 151, 183,
-			35, 167, 206, 238, 5, 124, 219, 15, 28, 219, 139, 71, 207, 246,
-			119, 156, 250, 14, 227, 79, 34, 14, 99, 66, 6, 125, 68, 169,
-			154, 93, 223, 221, 183, 3, 40, 225, 179, 46, 183, 3, 230, 123,
-			28, 24, 32, 108, 249, 45, 199, 235, 68, 28, 247, 75, 118, 235,
-			90, 60, 63, 215, 247, 182, 75, 108, 153, 219, 237, 100, 202, 1,
-			103, 133, 176, 197, 237, 128, 55, 10, 44, 244, 197, 6, 236, 249,
-			204, 229, 118, 155, 200, 98, 44, 178, 107, 46, 135, 153, 123, 156,
-			3, 94, 155, 126, 32, 68, 145, 54, 236, 173, 98, 67, 239, 132,
-			176, 43, 217, 236, 227, 217, 27, 87, 119, 252, 78, 192, 92, 199,
-			227, 118, 64, 24, 182, 254, 173, 201, 103, 11, 29, 176, 158, 211,
-			88, 114, 10, 185, 248, 14, 103, 1, 74, 57, 78, 136, 123, 2,
-			187, 118, 237, 218, 204, 85, 252, 103, 227, 218, 181, 57, 252, 231,
-			35, 152, 250, 237, 219, 183, 111, 95, 157, 153, 189, 122, 125, 102,
-			99, 246, 250, 220, 205, 219, 115, 55, 111, 151, 110, 171, 191, 143,
-			74, 108, 161, 75, 96, 33, 163, 192, 169, 71, 48, 192, 72, 78,
-			17, 91, 47, 178, 125, 206, 184, 23, 118, 2, 46, 158, 238, 115,
-			86, 7, 44, 251, 222, 30, 15, 34, 177, 190, 98, 83, 98, 31,
-			87, 239, 46, 18, 118, 253, 250, 245, 219, 201, 92, 246, 247, 247,
-			75, 14, 143, 154, 40, 33, 6, 205, 58, 252, 11, 37, 74, 209,
-			147, 104, 10, 36, 54, 206, 160, 103, 111, 59, 132, 73, 93, 100,
-			229, 39, 118, 171, 237, 242, 144, 16, 245, 147, 205, 204, 177, 69,
-			191, 213, 238, 68, 92, 251, 22, 176, 195, 181, 213, 245, 202, 55,
-			216, 99, 192, 204, 228, 212, 227, 146, 20, 121, 146, 66, 177, 240,
-			121, 71, 188, 73, 132, 231, 144, 71, 91, 114, 129, 39, 177, 250,
-			202, 230, 242, 242, 212, 212, 145, 229, 144, 222, 39, 175, 77, 221,
-			209, 198, 52, 251, 188, 49, 109, 243, 8, 90, 241, 155, 13, 187,
-			171, 141, 45, 140, 130, 78, 61, 194, 14, 246, 108, 151, 69, 123,
-			178, 199, 158, 226, 151, 163, 189, 34, 195, 1, 221, 249, 178, 83,
-			218, 43, 69, 123, 0, 61, 107, 70, 162, 80, 39, 228, 117, 118,
-			133, 205, 92, 187, 214, 59, 195, 235, 79, 157, 225, 35, 199, 187,
-			62, 203, 30, 223, 227, 209, 122, 55, 140, 120, 11, 94, 207, 135,
-			119, 29, 151, 111, 244, 46, 196, 221, 202, 114, 121, 163, 242, 176,
-			204, 154, 145, 28, 198, 211, 234, 92, 110, 70, 106, 164, 155, 149,
-			149, 141, 91, 55, 88, 228, 212, 119, 67, 246, 14, 155, 156, 156,
-			20, 79, 166, 154, 81, 169, 177, 127, 223, 217, 222, 89, 178, 35,
-			172, 53, 197, 222, 126, 155, 93, 159, 157, 98, 223, 97, 248, 110,
-			217, 223, 87, 175, 20, 222, 166, 167, 217, 60, 140, 183, 225, 239,
-			135, 216, 36, 124, 44, 51, 215, 174, 105, 60, 44, 44, 197, 5,
-			4, 151, 154, 185, 117, 248, 51, 138, 91, 131, 234, 51, 183, 110,
-			220, 184, 241, 230, 245, 91, 215, 18, 182, 81, 227, 77, 63, 224,
-			108, 211, 115, 158, 168, 86, 110, 191, 121, 237, 96, 43, 165, 47,
-			183, 152, 147, 98, 254, 108, 114, 82, 32, 101, 26, 23, 11, 254,
-			166, 216, 85, 125, 56, 207, 161, 96, 104, 7, 208, 165, 218, 185,
-			164, 181, 131, 4, 48, 213, 67, 0, 55, 158, 74, 0, 15, 236,
-			61, 155, 61, 22, 11, 89, 170, 119, 130, 128, 123, 17, 20, 121,
-			232, 184, 174, 19, 106, 4, 0, 220, 148, 181, 240, 41, 123, 135,
-			61, 189, 194, 51, 200, 156, 189, 147, 60, 45, 121, 124, 127, 161,
-			227, 184, 13, 30, 76, 78, 193, 196, 214, 37, 134, 100, 23, 2,
-			49, 83, 162, 45, 248, 131, 50, 43, 98, 238, 142, 23, 193, 204,
-			101, 73, 49, 117, 57, 109, 196, 192, 84, 169, 6, 45, 227, 88,
-			18, 28, 220, 124, 14, 14, 42, 94, 24, 217, 94, 84, 242, 252,
-			125, 109, 218, 242, 41, 243, 252, 125, 246, 14, 235, 41, 243, 204,
-			153, 38, 3, 127, 254, 148, 61, 127, 191, 180, 205, 163, 50, 16,
-			155, 120, 54, 57, 165, 205, 188, 119, 246, 178, 48, 0, 147, 79,
-			153, 233, 173, 167, 206, 84, 174, 151, 146, 51, 216, 90, 55, 218,
-			17, 138, 68, 15, 161, 233, 11, 53, 57, 117, 144, 10, 239, 241,
-			104, 49, 89, 247, 201, 41, 228, 245, 15, 214, 87, 87, 216, 67,
-			187, 221, 118, 188, 109, 66, 88, 197, 19, 79, 132, 214, 94, 68,
-			49, 64, 195, 83, 183, 141, 91, 93, 143, 224, 34, 182, 14, 41,
-			51, 16, 220, 128, 94, 106, 255, 17, 93, 129, 236, 98, 131, 216,
-			82, 20, 205, 136, 167, 208, 89, 225, 115, 144, 27, 190, 184, 250,
-			121, 203, 247, 162, 157, 47, 174, 126, 222, 176, 187, 95, 108, 124,
-			14, 155, 247, 23, 115, 159, 183, 28, 239, 139, 185, 207, 67, 94,
-			255, 226, 227, 210, 231, 32, 46, 193, 39, 251, 197, 183, 62, 42,
-			16, 182, 191, 195, 3, 206, 68, 109, 104, 200, 118, 247, 237, 110,
-			200, 248, 19, 144, 224, 64, 217, 19, 178, 64, 19, 164, 128, 134,
-			179, 237, 68, 33, 8, 53, 46, 103, 178, 167, 34, 195, 174, 138,
-			132, 137, 206, 138, 12, 123, 43, 226, 102, 139, 93, 162, 92, 242,
-			25, 15, 252, 171, 109, 187, 209, 16, 234, 99, 180, 239, 171, 214,
-			184, 93, 223, 17, 50, 153, 146, 227, 64, 254, 147, 44, 165, 40,
-			37, 40, 216, 200, 183, 125, 214, 105, 163, 152, 160, 170, 78, 58,
-			37, 94, 146, 15, 103, 142, 150, 246, 166, 138, 4, 251, 247, 219,
-			162, 101, 209, 83, 225, 163, 2, 11, 59, 205, 166, 243, 4, 228,
-			81, 167, 110, 131, 128, 5, 171, 8, 116, 128, 146, 232, 100, 97,
-			115, 99, 177, 48, 117, 167, 231, 41, 17, 2, 227, 167, 29, 39,
-			224, 141, 18, 155, 103, 104, 94, 185, 46, 136, 33, 68, 157, 220,
-			249, 140, 7, 44, 220, 241, 59, 110, 67, 161, 178, 19, 114, 148,
-			38, 39, 237, 48, 238, 173, 193, 106, 93, 2, 195, 152, 130, 5,
-			240, 64, 11, 246, 132, 72, 115, 152, 148, 0, 145, 118, 79, 87,
-			109, 59, 8, 147, 110, 106, 156, 48, 148, 233, 64, 194, 169, 215,
-			121, 59, 98, 53, 63, 218, 193, 62, 161, 174, 48, 26, 168, 57,
-			132, 135, 198, 1, 98, 175, 223, 108, 134, 60, 66, 113, 237, 174,
-			31, 48, 46, 190, 181, 34, 43, 204, 94, 155, 121, 19, 118, 135,
-			153, 155, 27, 215, 102, 230, 174, 95, 155, 155, 185, 89, 186, 54,
-			243, 81, 65, 82, 119, 200, 16, 142, 183, 151, 182, 29, 70, 132,
-			97, 73, 236, 223, 247, 18, 185, 249, 102, 145, 65, 107, 37, 249,
-			1, 217, 123, 246, 122, 61, 112, 218, 81, 17, 164, 221, 30, 81,
-			205, 102, 176, 61, 50, 191, 246, 9, 175, 71, 66, 202, 3, 209,
-			81, 16, 187, 160, 71, 36, 127, 96, 87, 13, 59, 104, 16, 246,
-			113, 228, 87, 214, 87, 215, 241, 35, 155, 156, 58, 66, 64, 45,
-			181, 252, 207, 28, 215, 181, 241, 235, 226, 222, 213, 205, 245, 233,
-			134, 95, 15, 167, 31, 241, 218, 116, 50, 148, 233, 42, 111, 242,
-			128, 123, 117, 62, 125, 207, 245, 107, 182, 187, 181, 138, 99, 8,
-			167, 97, 64, 211, 90, 39, 83, 104, 187, 218, 241, 27, 37, 152,
-			140, 224, 52, 69, 252, 206, 197, 144, 216, 99, 144, 24, 1, 233,
-			37, 245, 227, 177, 154, 16, 76, 181, 198, 213, 108, 121, 131, 28,
-			57, 69, 194, 62, 126, 28, 70, 65, 19, 171, 106, 51, 242, 235,
-			97, 169, 45, 56, 27, 204, 101, 118, 218, 117, 106, 129, 29, 116,
-			81, 236, 46, 237, 68, 45, 247, 34, 254, 82, 117, 167, 208, 230,
-			66, 98, 66, 86, 157, 132, 109, 94, 103, 19, 151, 62, 188, 122,
-			169, 117, 245, 82, 99, 227, 210, 253, 185, 75, 15, 231, 46, 173,
-			151, 46, 53, 63, 154, 40, 177, 101, 103, 151, 239, 59, 33, 71,
-			53, 7, 16, 148, 172, 82, 39, 228, 162, 181, 7, 126, 195, 70,
-			98, 157, 8, 217, 199, 143, 43, 235, 171, 74, 168, 185, 43, 152,
-			85, 67, 130, 147, 83, 143, 191, 53, 41, 44, 149, 146, 207, 125,
-			226, 55, 196, 74, 192, 143, 171, 168, 47, 216, 109, 7, 23, 68,
-			61, 21, 90, 132, 24, 235, 244, 225, 182, 113, 158, 170, 131, 75,
-			179, 75, 151, 102, 151, 8, 155, 2, 68, 250, 53, 180, 16, 218,
-			114, 158, 17, 15, 88, 221, 110, 227, 7, 226, 55, 217, 54, 247,
-			120, 96, 139, 79, 77, 125, 102, 161, 96, 203, 49, 254, 75, 132,
-			16, 50, 64, 172, 116, 202, 160, 233, 239, 27, 185, 97, 242, 239,
-			24, 36, 157, 78, 153, 41, 154, 254, 43, 134, 57, 154, 255, 171,
-			6, 171, 38, 26, 174, 162, 125, 191, 137, 36, 143, 56, 14, 29,
-			175, 174, 75, 89, 228, 104, 49, 139, 61, 236, 132, 17, 208, 194,
-			179, 212, 34, 114, 148, 94, 244, 17, 115, 188, 186, 219, 9, 157,
-			61, 80, 20, 143, 147, 12, 12, 47, 131, 227, 235, 83, 160, 1,
-			96, 238, 132, 2, 45, 0, 233, 8, 249, 239, 197, 100, 12, 154,
-			254, 21, 195, 164, 249, 255, 202, 96, 43, 190, 119, 213, 227, 219,
-			66, 15, 238, 209, 166, 109, 165, 53, 130, 34, 121, 180, 54, 189,
-			34, 43, 198, 10, 230, 158, 237, 118, 120, 40, 76, 146, 73, 99,
-			104, 56, 13, 35, 199, 117, 217, 142, 189, 199, 153, 167, 247, 137,
-			77, 203, 138, 68, 104, 111, 66, 65, 111, 250, 1, 40, 198, 202,
-			122, 112, 16, 97, 82, 105, 44, 202, 127, 201, 17, 72, 49, 50,
-			56, 79, 133, 20, 3, 167, 157, 59, 174, 64, 11, 192, 161, 225,
-			90, 86, 240, 87, 242, 255, 14, 147, 51, 7, 61, 25, 188, 213,
-			142, 186, 79, 243, 98, 244, 145, 76, 25, 222, 47, 124, 113, 180,
-			67, 130, 224, 91, 229, 140, 40, 189, 160, 51, 2, 187, 124, 41,
-			71, 196, 127, 50, 68, 178, 52, 125, 62, 53, 59, 244, 202, 15,
-			241, 202, 15, 241, 202, 15, 241, 202, 15, 241, 202, 15, 241, 202,
-			15, 241, 23, 232, 135, 40, 43, 151, 3, 252, 84, 126, 136, 216,
-			229, 240, 122, 236, 114, 184, 148, 122, 67, 185, 28, 224, 167, 242,
-			67, 196, 46, 135, 203, 177, 203, 97, 34, 113, 57, 192, 79, 229,
-			135, 136, 29, 30, 240, 243, 63, 54, 209, 15, 97, 205, 166, 134,
-			242, 127, 215, 100, 243, 66, 192, 115, 234, 12, 119, 80, 214, 226,
-			97, 104, 111, 75, 131, 112, 215, 239, 160, 0, 27, 240, 171, 176,
-			209, 128, 186, 180, 231, 59, 13, 214, 224, 77, 199, 67, 246, 215,
-			105, 187, 66, 45, 34, 189, 245, 145, 253, 118, 65, 5, 158, 95,
-			171, 132, 160, 249, 69, 221, 182, 83, 183, 93, 165, 41, 129, 98,
-			24, 249, 98, 255, 138, 64, 227, 7, 46, 6, 154, 34, 7, 125,
-			72, 114, 181, 128, 135, 109, 223, 131, 158, 65, 173, 67, 231, 7,
-			180, 23, 43, 18, 119, 209, 18, 15, 26, 68, 157, 171, 221, 8,
-			246, 87, 167, 206, 217, 93, 223, 103, 159, 43, 171, 73, 208, 174,
-			179, 5, 59, 152, 60, 32, 107, 148, 80, 212, 152, 130, 189, 169,
-			19, 120, 33, 123, 202, 123, 105, 120, 251, 2, 164, 92, 33, 228,
-			90, 179, 185, 227, 137, 4, 84, 32, 103, 15, 74, 64, 194, 24,
-			252, 52, 17, 232, 223, 50, 72, 118, 29, 75, 208, 59, 36, 219,
-			116, 184, 139, 81, 28, 214, 228, 192, 236, 197, 131, 242, 80, 73,
-			20, 44, 221, 197, 82, 101, 47, 10, 186, 85, 89, 37, 255, 62,
-			25, 208, 30, 211, 33, 98, 237, 242, 46, 134, 131, 244, 87, 225,
-			39, 45, 146, 12, 202, 136, 24, 10, 50, 48, 59, 118, 168, 241,
-			15, 224, 109, 85, 20, 154, 51, 223, 50, 10, 63, 54, 73, 6,
-			31, 210, 59, 132, 120, 29, 215, 221, 18, 13, 64, 163, 131, 179,
-			249, 67, 13, 172, 116, 92, 23, 203, 223, 79, 85, 251, 61, 5,
-			208, 139, 228, 152, 215, 105, 213, 120, 176, 149, 244, 111, 220, 79,
-			85, 7, 196, 211, 184, 144, 176, 242, 200, 66, 22, 12, 28, 10,
-			137, 167, 162, 208, 107, 132, 212, 124, 95, 13, 35, 205, 140, 201,
-			28, 116, 5, 207, 68, 129, 183, 177, 149, 78, 61, 146, 69, 50,
-			56, 213, 83, 79, 193, 163, 108, 190, 83, 143, 226, 89, 194, 70,
-			47, 235, 102, 177, 238, 225, 89, 46, 59, 97, 20, 207, 210, 85,
-			192, 66, 150, 164, 119, 29, 175, 81, 184, 67, 250, 227, 18, 180,
-			68, 178, 66, 46, 151, 43, 250, 52, 164, 203, 82, 87, 206, 144,
-			254, 24, 137, 116, 144, 144, 149, 205, 229, 229, 173, 15, 230, 151,
-			55, 203, 67, 169, 133, 239, 30, 45, 35, 15, 136, 201, 40, 33,
-			121, 250, 5, 133, 100, 49, 241, 151, 146, 146, 127, 253, 156, 144,
-			146, 191, 253, 42, 90, 231, 149, 148, 252, 74, 74, 126, 37, 37,
-			191, 146, 146, 95, 73, 201, 255, 191, 71, 235, 220, 77, 162, 117,
-			238, 62, 59, 90, 167, 152, 68, 235, 20, 95, 34, 90, 231, 239,
-			89, 40, 37, 167, 103, 83, 55, 141, 252, 191, 103, 177, 199, 98,
-			195, 125, 220, 27, 168, 35, 246, 211, 78, 192, 27, 98, 203, 195,
-			29, 189, 8, 140, 37, 116, 66, 52, 153, 250, 77, 38, 100, 53,
-			34, 253, 50, 45, 27, 157, 47, 141, 174, 103, 183, 64, 30, 118,
-			187, 40, 220, 42, 35, 32, 26, 199, 67, 191, 197, 25, 236, 228,
-			29, 144, 164, 139, 113, 231, 132, 181, 144, 1, 215, 56, 11, 59,
-			237, 182, 31, 40, 119, 4, 243, 132, 85, 48, 30, 156, 45, 44,
-			141, 61, 158, 9, 199, 35, 44, 68, 163, 61, 12, 44, 110, 158,
-			185, 206, 46, 103, 15, 214, 227, 233, 8, 151, 141, 108, 72, 122,
-			227, 60, 34, 77, 241, 194, 27, 212, 128, 93, 202, 69, 78, 42,
-			194, 73, 122, 250, 197, 253, 178, 193, 161, 175, 26, 114, 211, 109,
-			14, 220, 153, 196, 92, 92, 184, 102, 212, 28, 48, 158, 6, 158,
-			170, 33, 169, 16, 24, 244, 220, 28, 104, 26, 202, 198, 107, 225,
-			132, 162, 140, 28, 154, 46, 157, 15, 146, 219, 210, 0, 109, 221,
-			48, 89, 190, 200, 54, 61, 63, 104, 112, 88, 41, 88, 1, 191,
-			249, 244, 21, 32, 228, 152, 48, 255, 102, 161, 238, 168, 130, 12,
-			106, 221, 56, 121, 70, 65, 22, 181, 110, 156, 127, 141, 252, 49,
-			40, 83, 6, 77, 191, 147, 122, 104, 228, 127, 207, 100, 143, 81,
-			138, 59, 64, 37, 79, 233, 73, 115, 212, 213, 56, 19, 59, 24,
-			97, 32, 66, 23, 97, 69, 81, 78, 46, 198, 142, 80, 248, 5,
-			50, 47, 183, 209, 107, 18, 240, 122, 39, 8, 97, 205, 229, 162,
-			73, 210, 243, 3, 102, 147, 120, 83, 86, 52, 53, 47, 55, 47,
-			30, 196, 79, 209, 223, 250, 164, 205, 235, 145, 216, 240, 66, 30,
-			161, 191, 2, 151, 148, 135, 156, 176, 61, 59, 112, 108, 47, 130,
-			234, 181, 144, 123, 117, 174, 162, 207, 228, 11, 205, 17, 104, 123,
-			140, 7, 129, 31, 60, 119, 229, 36, 122, 212, 194, 225, 72, 228,
-			186, 25, 6, 181, 222, 201, 29, 39, 5, 146, 78, 27, 185, 20,
-			77, 191, 107, 46, 91, 249, 81, 108, 15, 132, 236, 120, 228, 114,
-			129, 140, 28, 44, 201, 187, 185, 99, 100, 2, 106, 192, 74, 207,
-			167, 207, 229, 243, 186, 163, 193, 70, 116, 246, 86, 195, 117, 157,
-			79, 31, 87, 144, 65, 173, 249, 193, 113, 5, 89, 212, 154, 63,
-			115, 150, 76, 97, 147, 6, 181, 22, 211, 103, 243, 103, 123, 155,
-			108, 248, 157, 154, 203, 15, 52, 106, 100, 160, 108, 12, 65, 205,
-			129, 83, 10, 178, 168, 181, 152, 63, 35, 27, 53, 169, 85, 62,
-			220, 168, 244, 118, 247, 54, 106, 102, 160, 108, 12, 25, 212, 42,
-			199, 141, 154, 22, 181, 202, 249, 51, 228, 10, 54, 106, 81, 235,
-			94, 122, 60, 127, 174, 183, 81, 73, 47, 7, 90, 181, 50, 80,
-			56, 167, 32, 131, 90, 247, 250, 71, 20, 4, 13, 141, 157, 34,
-			87, 177, 213, 52, 181, 42, 233, 179, 121, 118, 104, 168, 138, 233,
-			245, 54, 156, 206, 66, 249, 24, 50, 168, 85, 137, 135, 155, 182,
-			168, 85, 201, 159, 145, 13, 103, 168, 245, 94, 250, 220, 193, 134,
-			3, 222, 230, 232, 97, 149, 100, 18, 55, 156, 201, 66, 121, 181,
-			98, 25, 131, 90, 239, 197, 43, 150, 177, 168, 245, 222, 153, 179,
-			228, 183, 13, 98, 102, 82, 52, 189, 158, 250, 192, 200, 255, 219,
-			6, 123, 28, 235, 84, 72, 110, 54, 11, 29, 111, 219, 229, 145,
-			239, 49, 238, 117, 90, 232, 210, 242, 61, 160, 252, 152, 78, 133,
-			136, 24, 19, 140, 226, 75, 36, 38, 91, 180, 66, 116, 60, 41,
-			189, 61, 155, 210, 123, 187, 199, 98, 143, 161, 237, 199, 130, 220,
-			51, 64, 119, 235, 153, 65, 146, 39, 233, 12, 178, 169, 77, 115,
-			56, 127, 156, 173, 28, 160, 215, 140, 224, 60, 155, 230, 49, 5,
-			153, 212, 218, 60, 49, 68, 126, 193, 32, 102, 218, 164, 233, 143,
-			83, 223, 54, 242, 123, 236, 113, 172, 127, 202, 233, 238, 7, 118,
-			187, 205, 3, 102, 7, 126, 7, 221, 225, 49, 118, 113, 63, 210,
-			184, 195, 243, 190, 217, 222, 150, 177, 152, 29, 4, 118, 87, 126,
-			183, 64, 150, 31, 231, 134, 201, 28, 73, 167, 77, 152, 200, 183,
-			204, 179, 249, 171, 176, 178, 7, 186, 123, 14, 195, 53, 205, 84,
-			26, 42, 199, 80, 150, 90, 223, 26, 24, 82, 144, 65, 173, 111,
-			13, 159, 82, 144, 69, 173, 111, 229, 207, 196, 22, 152, 159, 92,
-			38, 95, 219, 246, 75, 245, 157, 192, 111, 57, 157, 22, 42, 192,
-			110, 167, 238, 76, 135, 245, 29, 222, 232, 184, 60, 152, 182, 219,
-			58, 180, 55, 51, 29, 5, 206, 246, 54, 168, 147, 194, 70, 211,
-			31, 191, 204, 63, 211, 152, 83, 248, 115, 147, 244, 109, 136, 186,
-			116, 144, 152, 78, 67, 218, 91, 76, 167, 65, 71, 73, 38, 114,
-			34, 87, 152, 59, 250, 171, 2, 160, 67, 196, 234, 4, 174, 176,
-			110, 84, 225, 39, 45, 146, 116, 61, 240, 189, 241, 73, 105, 149,
-			137, 251, 46, 45, 6, 190, 39, 91, 191, 159, 170, 98, 41, 58,
-			77, 50, 251, 188, 214, 113, 198, 167, 164, 101, 35, 41, 254, 136,
-			215, 54, 43, 73, 121, 81, 14, 154, 247, 124, 191, 61, 62, 123,
-			168, 249, 21, 223, 111, 107, 205, 67, 41, 122, 147, 244, 109, 59,
-			145, 227, 242, 112, 252, 58, 86, 56, 173, 85, 184, 39, 222, 36,
-			117, 84, 89, 58, 79, 6, 48, 216, 168, 214, 169, 239, 242, 104,
-			252, 6, 86, 61, 167, 85, 93, 72, 222, 38, 213, 245, 58, 11,
-			253, 164, 175, 109, 119, 93, 223, 110, 20, 174, 146, 1, 109, 234,
-			244, 60, 33, 202, 239, 236, 123, 242, 124, 147, 246, 164, 48, 72,
-			142, 233, 83, 47, 92, 32, 3, 218, 212, 40, 37, 105, 144, 199,
-			228, 202, 224, 239, 194, 143, 12, 50, 216, 59, 27, 40, 22, 240,
-			182, 175, 138, 193, 111, 88, 172, 128, 55, 229, 2, 194, 79, 154,
-			39, 185, 128, 239, 57, 160, 180, 201, 53, 140, 97, 250, 38, 33,
-			237, 192, 111, 243, 32, 114, 120, 136, 198, 169, 167, 91, 158, 170,
-			90, 81, 232, 58, 178, 183, 195, 241, 12, 179, 160, 107, 248, 93,
-			176, 9, 61, 140, 179, 3, 93, 24, 47, 223, 133, 153, 116, 177,
-			112, 231, 163, 219, 47, 249, 157, 220, 137, 129, 7, 127, 254, 26,
-			201, 210, 244, 96, 202, 51, 200, 31, 167, 209, 20, 52, 152, 162,
-			179, 191, 159, 238, 177, 234, 204, 220, 66, 110, 178, 188, 185, 88,
-			97, 243, 157, 104, 199, 15, 144, 195, 44, 59, 117, 238, 97, 112,
-			148, 215, 144, 138, 250, 124, 219, 174, 67, 73, 241, 166, 200, 62,
-			224, 1, 224, 148, 205, 150, 174, 177, 73, 40, 80, 144, 175, 10,
-			83, 119, 8, 154, 162, 65, 71, 247, 252, 8, 205, 57, 34, 250,
-			192, 113, 57, 227, 79, 48, 130, 199, 241, 88, 221, 111, 181, 93,
-			199, 6, 81, 37, 22, 53, 101, 27, 37, 194, 62, 148, 45, 196,
-			225, 14, 117, 191, 221, 149, 242, 142, 42, 198, 236, 72, 154, 107,
-			244, 224, 11, 27, 71, 42, 176, 37, 202, 133, 211, 203, 149, 197,
-			242, 202, 122, 249, 234, 108, 233, 26, 33, 108, 211, 115, 121, 152,
-			68, 58, 161, 80, 222, 70, 211, 56, 136, 10, 174, 189, 143, 130,
-			217, 118, 192, 133, 176, 229, 120, 104, 17, 64, 177, 78, 153, 14,
-			52, 211, 70, 15, 154, 212, 200, 156, 176, 167, 0, 26, 77, 98,
-			229, 127, 97, 126, 189, 178, 94, 36, 236, 81, 101, 227, 62, 104,
-			107, 186, 230, 142, 74, 239, 82, 5, 52, 76, 84, 75, 65, 171,
-			123, 175, 178, 178, 84, 148, 50, 167, 138, 92, 131, 33, 58, 128,
-			64, 180, 187, 173, 115, 222, 211, 189, 18, 210, 99, 195, 134, 146,
-			214, 217, 182, 191, 199, 3, 244, 6, 36, 214, 13, 52, 2, 129,
-			28, 218, 114, 196, 86, 18, 30, 158, 81, 172, 2, 14, 165, 168,
-			84, 194, 104, 106, 77, 41, 118, 242, 167, 149, 162, 214, 104, 234,
-			50, 249, 27, 134, 208, 199, 78, 131, 62, 246, 43, 6, 147, 95,
-			71, 44, 61, 75, 219, 92, 173, 203, 36, 95, 199, 120, 21, 59,
-			220, 13, 217, 100, 216, 169, 239, 128, 22, 35, 191, 126, 241, 120,
-			74, 248, 24, 2, 191, 179, 189, 67, 208, 169, 96, 123, 13, 212,
-			220, 58, 173, 158, 134, 96, 197, 122, 219, 209, 62, 82, 217, 150,
-			166, 122, 156, 206, 157, 32, 255, 181, 165, 116, 143, 215, 205, 161,
-			252, 63, 178, 216, 166, 231, 124, 10, 34, 119, 131, 123, 145, 211,
-			116, 132, 24, 142, 225, 69, 162, 15, 140, 240, 66, 197, 75, 217,
-			159, 26, 60, 118, 173, 224, 192, 118, 80, 0, 151, 246, 48, 104,
-			168, 213, 246, 35, 238, 213, 209, 108, 199, 236, 70, 3, 227, 159,
-			236, 184, 65, 86, 182, 235, 59, 236, 19, 191, 198, 118, 80, 127,
-			19, 214, 88, 207, 118, 153, 176, 148, 138, 15, 164, 178, 132, 58,
-			92, 192, 235, 40, 254, 200, 29, 145, 57, 17, 193, 103, 206, 30,
-			111, 148, 20, 170, 67, 161, 234, 97, 40, 138, 237, 6, 220, 110,
-			116, 89, 141, 115, 143, 133, 240, 31, 80, 249, 66, 199, 229, 94,
-			228, 118, 89, 184, 235, 180, 219, 88, 21, 164, 39, 217, 161, 19,
-			2, 125, 56, 126, 67, 138, 0, 117, 16, 77, 121, 3, 62, 0,
-			230, 187, 13, 28, 139, 92, 77, 156, 90, 228, 96, 177, 128, 3,
-			74, 138, 132, 69, 59, 176, 84, 200, 7, 100, 24, 31, 176, 130,
-			128, 187, 93, 248, 22, 96, 108, 128, 69, 21, 237, 18, 91, 14,
-			107, 93, 182, 191, 227, 243, 61, 160, 243, 150, 19, 133, 61, 104,
-			103, 139, 162, 67, 219, 235, 70, 59, 120, 128, 35, 98, 54, 234,
-			95, 33, 17, 17, 206, 54, 96, 3, 23, 6, 151, 48, 81, 14,
-			51, 176, 184, 57, 77, 57, 124, 189, 127, 64, 83, 14, 95, 31,
-			60, 65, 62, 146, 81, 67, 214, 132, 57, 146, 127, 200, 86, 101,
-			64, 37, 172, 113, 192, 154, 129, 195, 189, 134, 219, 21, 246, 74,
-			241, 117, 57, 161, 26, 153, 192, 117, 184, 227, 239, 135, 172, 211,
-			6, 126, 177, 174, 216, 48, 219, 172, 196, 163, 0, 173, 99, 34,
-			30, 5, 104, 29, 19, 253, 131, 10, 178, 168, 53, 49, 76, 201,
-			59, 56, 10, 147, 90, 87, 204, 225, 252, 181, 100, 20, 247, 55,
-			54, 214, 152, 235, 120, 187, 72, 110, 78, 216, 118, 237, 238, 83,
-			59, 2, 137, 253, 74, 220, 17, 204, 233, 74, 255, 49, 5, 89,
-			212, 186, 114, 98, 136, 252, 107, 24, 37, 5, 154, 91, 201, 188,
-			97, 229, 247, 216, 124, 61, 234, 216, 110, 60, 37, 216, 133, 75,
-			172, 2, 75, 0, 114, 52, 250, 16, 121, 200, 118, 252, 125, 125,
-			77, 216, 190, 227, 186, 114, 5, 235, 24, 79, 75, 240, 99, 220,
-			225, 201, 200, 138, 64, 115, 172, 238, 7, 194, 63, 216, 64, 222,
-			3, 59, 97, 172, 188, 163, 110, 88, 202, 157, 32, 3, 56, 123,
-			139, 90, 211, 233, 188, 28, 175, 149, 5, 232, 132, 130, 12, 106,
-			77, 15, 41, 45, 31, 244, 159, 233, 83, 167, 101, 181, 52, 181,
-			174, 165, 207, 202, 87, 160, 221, 92, 75, 15, 41, 200, 160, 214,
-			181, 225, 49, 5, 89, 212, 186, 118, 250, 140, 172, 150, 161, 214,
-			76, 220, 27, 232, 46, 51, 113, 111, 160, 187, 204, 196, 189, 129,
-			238, 50, 19, 247, 150, 165, 214, 108, 154, 201, 87, 89, 132, 168,
-			130, 12, 106, 205, 142, 168, 38, 179, 22, 181, 102, 207, 189, 38,
-			171, 245, 81, 235, 122, 122, 82, 190, 234, 203, 2, 164, 134, 213,
-			103, 80, 235, 250, 169, 130, 130, 44, 106, 93, 191, 52, 65, 126,
-			95, 218, 44, 222, 77, 205, 27, 249, 255, 208, 100, 154, 220, 21,
-			115, 32, 197, 45, 220, 46, 32, 63, 33, 9, 216, 187, 90, 109,
-			151, 183, 128, 95, 168, 207, 25, 216, 76, 152, 176, 48, 157, 23,
-			239, 112, 6, 34, 44, 11, 35, 96, 100, 45, 187, 190, 131, 107,
-			190, 191, 195, 61, 252, 36, 157, 136, 53, 120, 221, 105, 200, 96,
-			226, 88, 214, 32, 234, 27, 119, 237, 142, 87, 23, 59, 185, 227,
-			237, 249, 194, 35, 3, 125, 173, 248, 17, 159, 99, 200, 146, 99,
-			190, 85, 183, 189, 9, 97, 29, 147, 237, 52, 20, 135, 199, 3,
-			101, 200, 254, 146, 185, 204, 175, 85, 144, 67, 117, 9, 138, 5,
-			160, 46, 217, 129, 114, 79, 192, 134, 160, 188, 207, 161, 228, 61,
-			154, 161, 226, 221, 220, 8, 41, 41, 179, 195, 215, 205, 83, 133,
-			11, 172, 229, 123, 126, 228, 123, 146, 187, 57, 94, 61, 224, 54,
-			6, 222, 10, 75, 78, 108, 125, 200, 64, 133, 62, 205, 250, 240,
-			245, 28, 213, 172, 15, 95, 63, 57, 70, 254, 91, 169, 219, 221,
-			79, 85, 140, 252, 63, 49, 152, 46, 234, 234, 24, 142, 209, 8,
-			28, 37, 100, 117, 215, 169, 239, 178, 130, 146, 137, 89, 173, 19,
-			129, 186, 235, 120, 226, 75, 254, 217, 160, 140, 77, 70, 62, 145,
-			97, 0, 117, 223, 107, 118, 96, 187, 159, 18, 136, 124, 57, 60,
-			2, 23, 185, 159, 27, 37, 223, 34, 240, 253, 164, 87, 82, 107,
-			70, 254, 125, 166, 137, 241, 49, 53, 246, 144, 32, 144, 230, 182,
-			210, 222, 121, 24, 133, 7, 116, 120, 140, 31, 136, 149, 59, 209,
-			21, 124, 228, 43, 185, 17, 252, 98, 44, 88, 178, 85, 83, 224,
-			220, 194, 245, 88, 149, 156, 205, 194, 245, 88, 237, 63, 174, 32,
-			139, 90, 171, 67, 195, 228, 63, 135, 245, 72, 211, 244, 55, 82,
-			143, 141, 252, 239, 27, 172, 87, 141, 56, 64, 243, 194, 165, 39,
-			119, 203, 125, 59, 170, 239, 196, 130, 199, 1, 9, 131, 28, 150,
-			36, 96, 153, 214, 123, 22, 8, 54, 214, 67, 235, 45, 98, 35,
-			218, 126, 232, 68, 126, 208, 149, 95, 151, 112, 181, 162, 24, 183,
-			231, 216, 132, 149, 91, 78, 20, 239, 221, 64, 236, 2, 23, 192,
-			185, 190, 145, 27, 35, 69, 146, 78, 167, 1, 23, 31, 154, 180,
-			240, 26, 136, 8, 155, 213, 101, 17, 193, 10, 77, 203, 152, 82,
-			108, 179, 33, 136, 55, 141, 200, 250, 80, 34, 43, 141, 200, 250,
-			80, 34, 43, 141, 200, 250, 112, 104, 152, 44, 97, 187, 6, 181,
-			62, 50, 135, 11, 111, 98, 107, 205, 158, 198, 48, 64, 186, 137,
-			102, 78, 94, 218, 46, 177, 66, 192, 155, 225, 244, 14, 183, 27,
-			225, 116, 203, 14, 35, 30, 20, 84, 127, 176, 191, 125, 20, 247,
-			7, 31, 222, 71, 114, 219, 73, 227, 254, 246, 209, 137, 33, 220,
-			223, 210, 176, 191, 125, 108, 142, 21, 174, 97, 127, 66, 57, 99,
-			147, 235, 247, 231, 103, 160, 179, 29, 254, 100, 74, 28, 170, 21,
-			194, 149, 228, 76, 1, 111, 170, 142, 128, 103, 127, 28, 119, 132,
-			38, 141, 254, 97, 5, 89, 212, 250, 120, 244, 36, 249, 6, 118,
-			100, 81, 235, 219, 230, 100, 254, 61, 182, 22, 107, 90, 194, 225,
-			105, 111, 35, 37, 218, 13, 140, 189, 5, 137, 76, 25, 152, 240,
-			12, 129, 84, 94, 165, 219, 80, 112, 70, 191, 213, 114, 162, 146,
-			26, 3, 236, 75, 223, 54, 199, 21, 100, 80, 235, 219, 167, 47,
-			42, 8, 186, 189, 60, 129, 4, 156, 134, 125, 105, 203, 60, 35,
-			95, 165, 17, 82, 141, 164, 51, 212, 218, 26, 80, 67, 135, 181,
-			222, 162, 99, 10, 178, 168, 181, 117, 58, 79, 254, 178, 69, 160,
-			92, 122, 55, 229, 25, 249, 255, 221, 100, 135, 149, 206, 103, 145,
-			180, 140, 209, 97, 182, 156, 18, 72, 148, 142, 219, 16, 2, 191,
-			46, 63, 31, 73, 220, 27, 218, 78, 95, 247, 61, 208, 196, 66,
-			230, 120, 34, 62, 64, 226, 171, 109, 135, 161, 8, 240, 231, 204,
-			227, 251, 61, 13, 97, 95, 9, 51, 219, 135, 17, 225, 51, 81,
-			35, 25, 190, 212, 83, 120, 131, 133, 188, 109, 3, 230, 221, 110,
-			172, 241, 72, 230, 244, 137, 95, 195, 33, 55, 157, 237, 142, 228,
-			40, 147, 120, 184, 196, 247, 240, 165, 246, 21, 122, 232, 154, 158,
-			146, 31, 151, 198, 218, 100, 192, 18, 116, 209, 226, 17, 148, 21,
-			77, 232, 106, 136, 100, 168, 237, 78, 205, 117, 234, 80, 79, 41,
-			12, 32, 14, 236, 230, 242, 184, 172, 25, 248, 22, 93, 83, 236,
-			228, 25, 52, 127, 185, 146, 26, 50, 248, 169, 185, 146, 26, 50,
-			248, 169, 185, 146, 26, 50, 64, 172, 45, 73, 13, 25, 211, 72,
-			3, 68, 20, 148, 161, 86, 75, 82, 67, 6, 191, 159, 150, 164,
-			134, 12, 126, 63, 173, 211, 249, 216, 134, 246, 127, 223, 39, 239,
-			190, 172, 13, 45, 49, 246, 28, 50, 162, 61, 43, 38, 60, 255,
-			21, 141, 117, 133, 111, 146, 129, 7, 126, 45, 172, 10, 236, 211,
-			113, 210, 215, 14, 252, 79, 120, 61, 146, 166, 28, 5, 210, 49,
-			146, 173, 119, 130, 208, 15, 164, 65, 71, 66, 244, 12, 233, 111,
-			219, 219, 124, 43, 116, 62, 19, 97, 71, 153, 106, 14, 30, 172,
-			59, 159, 241, 194, 26, 233, 23, 173, 183, 221, 46, 45, 144, 52,
-			136, 55, 50, 150, 103, 80, 179, 111, 61, 240, 107, 85, 124, 71,
-			95, 35, 3, 30, 127, 18, 109, 245, 116, 69, 224, 209, 34, 62,
-			41, 116, 8, 173, 196, 210, 75, 60, 236, 43, 164, 239, 19, 191,
-			182, 21, 240, 166, 52, 235, 12, 31, 104, 157, 55, 171, 217, 79,
-			240, 255, 95, 110, 34, 46, 25, 234, 233, 22, 230, 243, 38, 25,
-			72, 4, 41, 53, 173, 147, 90, 199, 73, 141, 170, 94, 242, 249,
-			147, 252, 47, 13, 50, 162, 239, 57, 106, 154, 95, 35, 125, 53,
-			216, 6, 227, 128, 168, 215, 181, 222, 142, 168, 80, 90, 128, 210,
-			85, 85, 137, 158, 37, 253, 241, 193, 19, 236, 214, 170, 38, 15,
-			242, 223, 36, 25, 44, 79, 139, 164, 79, 82, 137, 196, 38, 213,
-			186, 145, 93, 84, 85, 17, 122, 73, 46, 171, 137, 35, 58, 2,
-			241, 248, 186, 112, 131, 100, 5, 252, 12, 26, 27, 34, 214, 39,
-			126, 77, 89, 12, 63, 241, 107, 133, 199, 228, 184, 134, 69, 222,
-			124, 169, 149, 190, 72, 142, 39, 104, 223, 114, 26, 114, 202, 199,
-			146, 135, 149, 70, 225, 151, 13, 98, 61, 240, 107, 47, 213, 112,
-			158, 228, 212, 59, 57, 216, 24, 166, 83, 36, 131, 66, 4, 146,
-			208, 192, 236, 72, 111, 43, 235, 240, 170, 42, 74, 0, 37, 182,
-			109, 16, 203, 68, 44, 94, 85, 66, 133, 9, 146, 83, 69, 129,
-			42, 59, 206, 22, 148, 239, 132, 18, 93, 185, 142, 179, 142, 112,
-			225, 111, 154, 132, 36, 232, 161, 239, 146, 65, 109, 190, 201, 76,
-			198, 143, 166, 73, 222, 172, 106, 248, 129, 121, 157, 35, 36, 140,
-			236, 32, 226, 141, 173, 40, 84, 4, 34, 159, 108, 32, 221, 54,
-			29, 207, 9, 119, 196, 123, 75, 216, 146, 213, 163, 141, 144, 94,
-			32, 199, 98, 83, 207, 86, 173, 139, 211, 234, 175, 14, 196, 207,
-			22, 186, 48, 103, 57, 153, 140, 248, 250, 4, 68, 71, 73, 166,
-			233, 120, 182, 139, 113, 131, 185, 170, 0, 232, 4, 57, 33, 54,
-			149, 173, 216, 110, 220, 135, 213, 6, 197, 227, 170, 178, 30, 159,
-			38, 185, 61, 135, 239, 111, 117, 2, 119, 60, 39, 136, 10, 224,
-			205, 192, 157, 253, 126, 154, 244, 199, 50, 47, 125, 147, 244, 221,
-			227, 17, 48, 37, 58, 214, 187, 52, 234, 163, 201, 143, 30, 122,
-			14, 95, 251, 50, 25, 188, 199, 35, 141, 9, 208, 115, 71, 162,
-			53, 110, 230, 204, 211, 94, 67, 107, 95, 39, 199, 123, 90, 163,
-			79, 93, 163, 252, 209, 28, 133, 222, 36, 185, 53, 32, 23, 160,
-			221, 195, 164, 154, 63, 28, 42, 137, 1, 184, 244, 22, 233, 175,
-			114, 16, 44, 94, 178, 222, 77, 146, 155, 175, 249, 65, 244, 146,
-			213, 22, 201, 9, 172, 246, 66, 51, 125, 90, 35, 119, 201, 49,
-			157, 185, 209, 243, 207, 230, 122, 79, 107, 231, 171, 153, 236, 127,
-			116, 155, 244, 209, 204, 96, 234, 207, 140, 87, 54, 251, 87, 54,
-			251, 191, 80, 155, 253, 37, 252, 105, 80, 235, 100, 234, 62, 89,
-			34, 102, 22, 173, 247, 182, 145, 127, 75, 83, 230, 249, 147, 182,
-			31, 242, 80, 19, 141, 213, 226, 38, 101, 100, 64, 191, 16, 153,
-			179, 194, 198, 62, 76, 126, 222, 36, 233, 44, 218, 216, 153, 57,
-			157, 255, 95, 12, 38, 25, 36, 107, 114, 20, 27, 152, 237, 186,
-			104, 152, 98, 161, 29, 57, 97, 179, 11, 147, 213, 24, 38, 42,
-			109, 192, 135, 107, 142, 235, 68, 93, 54, 191, 184, 44, 108, 88,
-			77, 189, 84, 73, 110, 242, 189, 26, 69, 173, 19, 169, 48, 38,
-			124, 217, 240, 121, 232, 77, 68, 140, 63, 113, 194, 168, 40, 14,
-			66, 36, 65, 56, 56, 42, 140, 169, 138, 58, 129, 199, 81, 123,
-			153, 71, 245, 194, 9, 197, 8, 92, 206, 28, 49, 235, 186, 237,
-			194, 148, 119, 236, 144, 21, 18, 118, 129, 246, 181, 109, 30, 21,
-			122, 227, 71, 143, 145, 76, 86, 216, 158, 89, 246, 184, 130, 76,
-			106, 177, 193, 211, 10, 178, 168, 197, 94, 191, 74, 126, 222, 64,
-			100, 25, 212, 186, 100, 46, 229, 67, 214, 187, 39, 196, 40, 211,
-			196, 61, 97, 8, 216, 118, 246, 184, 7, 67, 45, 178, 150, 143,
-			129, 193, 232, 40, 104, 58, 65, 136, 214, 246, 170, 248, 118, 94,
-			124, 176, 160, 130, 92, 202, 142, 42, 200, 164, 214, 165, 147, 19,
-			10, 178, 168, 117, 105, 118, 129, 112, 28, 43, 26, 172, 223, 202,
-			127, 163, 119, 172, 201, 234, 202, 120, 142, 3, 86, 193, 151, 30,
-			16, 154, 178, 179, 35, 10, 130, 94, 71, 47, 40, 200, 162, 214,
-			149, 226, 45, 242, 55, 4, 169, 89, 212, 186, 97, 190, 153, 255,
-			101, 147, 169, 45, 76, 216, 170, 219, 1, 223, 3, 172, 216, 157,
-			200, 7, 37, 182, 174, 235, 126, 136, 198, 79, 252, 26, 250, 38,
-			108, 79, 179, 135, 195, 219, 73, 52, 128, 128, 66, 217, 99, 172,
-			169, 174, 45, 78, 33, 197, 225, 225, 95, 219, 117, 253, 125, 204,
-			158, 224, 117, 89, 155, 123, 194, 213, 227, 7, 44, 232, 120, 158,
-			72, 145, 145, 44, 27, 250, 97, 176, 26, 127, 194, 235, 192, 117,
-			160, 231, 120, 192, 64, 169, 192, 142, 209, 217, 225, 52, 21, 21,
-			42, 127, 142, 16, 229, 158, 137, 72, 44, 114, 36, 42, 225, 91,
-			191, 145, 29, 84, 144, 73, 173, 27, 39, 78, 42, 8, 144, 199,
-			110, 146, 127, 67, 16, 98, 154, 90, 119, 204, 183, 242, 95, 176,
-			120, 87, 103, 1, 254, 10, 229, 16, 20, 202, 146, 247, 79, 25,
-			57, 108, 45, 47, 48, 106, 209, 250, 145, 195, 78, 27, 212, 186,
-			147, 61, 161, 32, 147, 90, 119, 134, 198, 20, 100, 81, 235, 206,
-			133, 91, 228, 167, 130, 2, 50, 212, 42, 155, 111, 230, 255, 169,
-			201, 148, 84, 129, 39, 21, 164, 83, 9, 13, 6, 190, 102, 72,
-			69, 121, 185, 200, 108, 40, 43, 178, 131, 201, 124, 43, 110, 188,
-			142, 204, 15, 200, 17, 235, 8, 19, 180, 189, 174, 178, 115, 20,
-			213, 81, 3, 7, 25, 73, 232, 180, 28, 215, 70, 99, 252, 1,
-			49, 69, 237, 171, 88, 252, 192, 59, 162, 118, 58, 253, 59, 103,
-			149, 37, 100, 132, 49, 7, 211, 178, 129, 245, 218, 220, 197, 52,
-			120, 131, 57, 33, 97, 54, 122, 118, 220, 46, 115, 237, 8, 120,
-			105, 226, 42, 85, 91, 78, 188, 126, 79, 89, 16, 108, 237, 200,
-			245, 200, 24, 212, 42, 199, 100, 148, 49, 169, 85, 142, 201, 40,
-			99, 81, 171, 204, 110, 146, 239, 137, 245, 200, 82, 107, 213, 44,
-			231, 255, 15, 227, 16, 30, 176, 249, 80, 231, 96, 7, 24, 69,
-			165, 41, 156, 162, 9, 198, 65, 50, 241, 48, 29, 73, 163, 120,
-			168, 61, 157, 250, 100, 117, 16, 110, 246, 109, 79, 164, 73, 131,
-			226, 192, 147, 212, 70, 187, 211, 233, 89, 207, 98, 34, 254, 40,
-			243, 153, 227, 133, 17, 183, 27, 4, 56, 132, 162, 166, 47, 137,
-			177, 172, 65, 173, 213, 172, 194, 81, 214, 164, 214, 234, 216, 69,
-			5, 89, 212, 90, 45, 45, 146, 159, 102, 16, 99, 125, 212, 122,
-			108, 222, 207, 255, 211, 76, 47, 195, 105, 119, 162, 144, 201, 116,
-			130, 45, 63, 224, 154, 43, 216, 139, 252, 152, 88, 149, 121, 237,
-			211, 14, 239, 112, 25, 253, 203, 137, 182, 49, 42, 71, 208, 6,
-			204, 20, 185, 162, 32, 147, 58, 124, 161, 161, 216, 142, 225, 19,
-			1, 229, 76, 50, 40, 64, 40, 74, 159, 241, 116, 81, 224, 107,
-			241, 96, 155, 19, 214, 234, 184, 145, 211, 118, 15, 14, 72, 217,
-			231, 100, 19, 128, 224, 154, 29, 10, 9, 75, 249, 19, 5, 131,
-			72, 236, 123, 130, 67, 192, 186, 71, 204, 229, 118, 24, 197, 173,
-			244, 108, 223, 234, 232, 161, 182, 19, 123, 190, 134, 117, 76, 47,
-			17, 219, 25, 101, 42, 35, 238, 69, 78, 192, 147, 181, 13, 213,
-			215, 82, 98, 171, 32, 174, 137, 188, 32, 218, 9, 71, 40, 130,
-			135, 12, 162, 192, 246, 66, 149, 196, 103, 14, 190, 123, 39, 98,
-			77, 12, 174, 110, 57, 141, 125, 187, 43, 247, 136, 90, 87, 202,
-			15, 130, 81, 72, 207, 12, 158, 146, 10, 68, 236, 237, 84, 145,
-			136, 192, 241, 24, 85, 128, 71, 116, 215, 11, 55, 125, 167, 38,
-			45, 186, 240, 193, 99, 73, 41, 155, 151, 164, 227, 46, 180, 155,
-			92, 56, 83, 162, 160, 75, 98, 44, 20, 49, 80, 219, 237, 170,
-			100, 59, 161, 157, 116, 194, 42, 75, 161, 22, 33, 16, 75, 240,
-			88, 166, 178, 20, 226, 14, 73, 88, 141, 235, 1, 13, 141, 18,
-			74, 170, 202, 214, 172, 78, 156, 54, 21, 241, 201, 232, 242, 103,
-			126, 13, 145, 114, 114, 29, 241, 61, 244, 25, 212, 122, 156, 165,
-			10, 50, 169, 245, 120, 228, 178, 130, 44, 106, 61, 158, 185, 75,
-			136, 8, 39, 169, 167, 28, 35, 14, 221, 168, 231, 70, 200, 61,
-			21, 185, 193, 205, 147, 249, 57, 32, 23, 216, 97, 18, 10, 247,
-			3, 86, 40, 20, 81, 184, 148, 210, 95, 56, 33, 232, 218, 70,
-			2, 136, 69, 188, 36, 76, 128, 247, 132, 9, 240, 254, 33, 45,
-			76, 128, 143, 140, 74, 55, 174, 65, 173, 102, 28, 108, 110, 100,
-			0, 210, 253, 250, 205, 254, 19, 154, 95, 191, 73, 71, 72, 89,
-			249, 245, 119, 204, 177, 252, 91, 44, 182, 2, 194, 74, 38, 251,
-			12, 140, 62, 246, 216, 202, 181, 135, 93, 100, 219, 243, 3, 109,
-			156, 176, 181, 237, 72, 175, 164, 16, 138, 118, 114, 195, 154, 127,
-			127, 103, 244, 36, 162, 204, 16, 94, 131, 216, 15, 186, 155, 27,
-			198, 241, 27, 194, 120, 29, 135, 81, 167, 1, 210, 3, 174, 221,
-			129, 65, 205, 229, 233, 158, 24, 209, 92, 158, 238, 216, 41, 217,
-			8, 154, 178, 79, 107, 33, 213, 45, 51, 167, 133, 84, 183, 250,
-			71, 181, 144, 234, 214, 169, 113, 28, 147, 73, 211, 237, 84, 199,
-			136, 125, 138, 109, 105, 80, 199, 96, 212, 79, 205, 147, 90, 60,
-			233, 167, 178, 57, 17, 79, 250, 105, 255, 144, 22, 79, 250, 169,
-			92, 10, 156, 125, 32, 151, 194, 196, 81, 4, 113, 53, 24, 69,
-			32, 151, 194, 196, 81, 4, 116, 4, 143, 26, 160, 236, 24, 153,
-			99, 249, 162, 182, 20, 13, 222, 180, 59, 174, 112, 81, 222, 188,
-			38, 3, 254, 29, 248, 50, 159, 56, 173, 78, 43, 142, 124, 5,
-			244, 71, 18, 253, 38, 14, 32, 146, 232, 55, 17, 253, 145, 68,
-			191, 69, 211, 251, 169, 207, 140, 216, 167, 185, 159, 27, 79, 124,
-			154, 79, 204, 203, 202, 111, 153, 6, 136, 40, 40, 75, 173, 39,
-			3, 39, 53, 15, 231, 147, 177, 11, 154, 135, 243, 201, 235, 151,
-			100, 35, 6, 181, 186, 18, 253, 22, 78, 188, 27, 59, 70, 97,
-			226, 93, 137, 126, 11, 39, 222, 61, 5, 125, 155, 233, 52, 205,
-			124, 39, 245, 43, 134, 17, 59, 23, 191, 147, 59, 67, 142, 147,
-			116, 58, 109, 165, 104, 230, 11, 243, 231, 13, 75, 248, 159, 44,
-			232, 250, 11, 114, 130, 28, 39, 89, 128, 96, 204, 63, 151, 30,
-			39, 131, 164, 79, 128, 89, 128, 7, 18, 216, 160, 214, 207, 29,
-			27, 73, 96, 139, 90, 63, 55, 118, 42, 174, 110, 80, 235, 187,
-			233, 115, 241, 107, 35, 13, 240, 177, 4, 206, 82, 235, 187, 199,
-			147, 234, 48, 131, 239, 142, 38, 221, 193, 28, 190, 123, 230, 44,
-			138, 164, 232, 8, 77, 127, 207, 48, 207, 229, 191, 195, 230, 99,
-			230, 6, 31, 11, 126, 219, 78, 148, 164, 14, 99, 13, 238, 58,
-			123, 34, 46, 204, 7, 30, 181, 26, 52, 132, 203, 201, 105, 181,
-			253, 0, 243, 1, 30, 136, 153, 2, 214, 0, 218, 32, 111, 48,
-			110, 7, 174, 131, 97, 217, 92, 28, 19, 18, 103, 82, 124, 183,
-			129, 81, 96, 199, 133, 43, 53, 141, 131, 137, 193, 44, 128, 3,
-			67, 10, 52, 0, 28, 30, 87, 160, 5, 224, 153, 179, 228, 255,
-			177, 164, 231, 53, 253, 203, 134, 57, 150, 255, 159, 44, 54, 239,
-			197, 89, 220, 180, 100, 126, 242, 212, 189, 157, 4, 27, 213, 3,
-			46, 157, 111, 78, 11, 4, 86, 140, 118, 122, 162, 85, 113, 60,
-			194, 90, 78, 61, 72, 242, 106, 206, 135, 161, 179, 237, 37, 81,
-			30, 114, 39, 170, 117, 21, 205, 151, 128, 119, 162, 244, 85, 20,
-			135, 74, 107, 194, 192, 3, 77, 189, 113, 117, 230, 38, 107, 57,
-			158, 210, 245, 245, 52, 132, 37, 180, 204, 0, 70, 113, 139, 210,
-			142, 149, 246, 184, 196, 213, 97, 158, 90, 87, 27, 166, 223, 20,
-			185, 225, 162, 29, 222, 21, 231, 111, 113, 102, 176, 225, 44, 116,
-			133, 30, 165, 54, 177, 186, 235, 112, 33, 187, 9, 222, 222, 149,
-			225, 87, 170, 45, 204, 141, 219, 178, 119, 121, 143, 31, 29, 52,
-			179, 36, 248, 46, 194, 253, 90, 127, 13, 219, 101, 207, 238, 93,
-			212, 100, 9, 34, 83, 41, 68, 65, 23, 8, 74, 68, 168, 193,
-			120, 248, 19, 187, 30, 201, 109, 85, 95, 165, 109, 30, 73, 63,
-			113, 16, 240, 122, 36, 228, 83, 49, 115, 114, 32, 128, 48, 140,
-			105, 199, 200, 224, 250, 247, 41, 16, 201, 33, 55, 172, 64, 11,
-			192, 209, 147, 100, 6, 93, 192, 217, 31, 24, 169, 127, 211, 48,
-			242, 23, 153, 176, 136, 202, 40, 55, 183, 155, 132, 42, 134, 177,
-			254, 58, 32, 28, 150, 233, 31, 24, 57, 224, 229, 194, 99, 153,
-			254, 53, 195, 60, 137, 141, 103, 48, 155, 214, 175, 25, 102, 78,
-			129, 6, 128, 253, 67, 10, 180, 0, 28, 25, 149, 85, 13, 154,
-			254, 161, 97, 14, 203, 151, 48, 234, 31, 38, 85, 13, 124, 219,
-			127, 76, 129, 22, 128, 39, 134, 72, 133, 152, 233, 44, 205, 254,
-			186, 145, 250, 119, 13, 35, 127, 135, 245, 88, 98, 143, 30, 124,
-			143, 144, 175, 105, 227, 48, 155, 172, 65, 211, 191, 110, 228, 78,
-			226, 144, 178, 48, 155, 223, 80, 179, 201, 226, 87, 247, 27, 106,
-			72, 89, 156, 205, 111, 168, 217, 100, 113, 54, 191, 1, 179, 249,
-			67, 3, 235, 26, 52, 253, 35, 195, 204, 231, 255, 35, 131, 245,
-			120, 111, 196, 97, 140, 142, 140, 255, 244, 34, 142, 124, 165, 133,
-			161, 133, 174, 219, 163, 186, 99, 32, 103, 162, 176, 148, 8, 187,
-			239, 239, 243, 61, 30, 32, 17, 33, 191, 144, 28, 72, 175, 213,
-			43, 114, 245, 246, 93, 235, 68, 32, 123, 185, 190, 183, 45, 147,
-			63, 55, 156, 38, 166, 235, 139, 148, 168, 46, 102, 3, 232, 255,
-			145, 34, 154, 44, 162, 255, 71, 128, 23, 9, 90, 0, 142, 159,
-			38, 183, 136, 153, 238, 163, 217, 223, 52, 82, 191, 109, 24, 249,
-			73, 134, 122, 57, 15, 235, 78, 141, 235, 50, 71, 34, 113, 107,
-			184, 238, 51, 104, 250, 55, 141, 220, 0, 226, 186, 15, 112, 253,
-			91, 10, 215, 125, 136, 235, 223, 82, 184, 238, 67, 92, 255, 150,
-			194, 117, 31, 226, 250, 183, 20, 229, 244, 1, 170, 255, 150, 97,
-			142, 201, 151, 48, 244, 191, 149, 84, 53, 240, 109, 255, 176, 2,
-			45, 0, 71, 79, 202, 170, 38, 77, 255, 237, 164, 87, 51, 139,
-			32, 81, 160, 1, 224, 128, 234, 213, 180, 0, 140, 123, 181, 104,
-			250, 223, 55, 76, 42, 95, 90, 25, 4, 179, 10, 52, 0, 236,
-			59, 174, 64, 44, 60, 52, 76, 126, 197, 32, 102, 58, 71, 179,
-			191, 99, 164, 254, 158, 97, 228, 127, 193, 96, 202, 69, 22, 159,
-			143, 140, 17, 135, 216, 20, 145, 60, 118, 216, 115, 20, 79, 101,
-			209, 158, 35, 140, 177, 194, 82, 101, 125, 126, 97, 185, 188, 84,
-			64, 104, 13, 79, 10, 139, 223, 213, 205, 149, 149, 202, 202, 61,
-			1, 172, 47, 222, 47, 47, 109, 198, 229, 30, 205, 87, 54, 240,
-			29, 46, 70, 206, 160, 233, 223, 49, 114, 67, 56, 183, 28, 44,
-			198, 143, 13, 243, 20, 142, 62, 135, 159, 241, 143, 21, 70, 115,
-			184, 24, 63, 54, 250, 169, 2, 45, 0, 79, 142, 145, 50, 49,
-			211, 253, 52, 251, 187, 70, 234, 207, 12, 35, 255, 38, 211, 181,
-			229, 120, 110, 201, 113, 4, 152, 205, 33, 181, 77, 210, 70, 191,
-			65, 211, 191, 107, 96, 24, 92, 58, 221, 15, 195, 249, 61, 195,
-			188, 136, 29, 246, 35, 109, 252, 158, 97, 158, 80, 160, 1, 224,
-			208, 121, 5, 90, 0, 94, 40, 144, 59, 88, 213, 160, 233, 63,
-			48, 204, 83, 249, 171, 66, 199, 220, 138, 66, 25, 187, 219, 187,
-			155, 245, 110, 102, 68, 182, 5, 164, 244, 7, 234, 43, 232, 71,
-			82, 250, 3, 24, 149, 4, 45, 0, 79, 142, 145, 15, 177, 39,
-			147, 166, 255, 208, 48, 199, 243, 239, 49, 205, 187, 248, 2, 157,
-			177, 117, 60, 104, 9, 92, 170, 41, 57, 58, 134, 253, 118, 120,
-			60, 14, 51, 131, 109, 171, 113, 192, 164, 254, 208, 200, 141, 40,
-			208, 2, 112, 236, 20, 249, 53, 3, 7, 98, 209, 244, 31, 1,
-			231, 249, 69, 131, 233, 110, 76, 100, 60, 158, 100, 133, 81, 151,
-			77, 22, 118, 29, 175, 49, 135, 71, 174, 48, 135, 170, 20, 69,
-			53, 213, 70, 12, 138, 232, 12, 115, 63, 145, 18, 196, 102, 11,
-			250, 68, 79, 164, 102, 156, 111, 199, 137, 66, 238, 54, 227, 73,
-			192, 23, 242, 71, 138, 138, 250, 241, 11, 249, 35, 163, 255, 164,
-			2, 113, 212, 227, 167, 241, 236, 102, 191, 153, 166, 233, 159, 24,
-			230, 104, 254, 52, 91, 22, 6, 37, 225, 95, 213, 249, 181, 168,
-			151, 206, 96, 73, 213, 42, 232, 35, 63, 49, 250, 21, 109, 128,
-			124, 252, 19, 131, 142, 144, 117, 108, 53, 67, 211, 255, 192, 48,
-			135, 243, 101, 16, 69, 0, 195, 50, 101, 133, 54, 63, 141, 62,
-			65, 170, 16, 235, 1, 98, 223, 190, 47, 227, 34, 101, 208, 90,
-			60, 130, 140, 104, 53, 171, 64, 3, 192, 190, 99, 10, 180, 0,
-			60, 49, 68, 218, 56, 130, 44, 77, 255, 9, 8, 149, 53, 118,
-			192, 33, 204, 218, 142, 23, 42, 13, 114, 26, 190, 9, 81, 128,
-			237, 73, 135, 154, 93, 175, 251, 65, 67, 114, 109, 177, 88, 48,
-			246, 67, 139, 163, 4, 28, 53, 188, 108, 6, 187, 84, 8, 130,
-			29, 238, 79, 140, 254, 113, 5, 90, 0, 158, 57, 75, 62, 198,
-			225, 245, 209, 244, 159, 130, 168, 248, 144, 41, 55, 180, 136, 146,
-			67, 125, 101, 167, 211, 66, 113, 197, 110, 160, 63, 172, 173, 84,
-			116, 181, 69, 29, 176, 86, 238, 217, 142, 11, 5, 227, 145, 244,
-			101, 176, 117, 53, 18, 224, 255, 127, 170, 24, 115, 63, 232, 225,
-			233, 63, 53, 70, 79, 198, 225, 71, 127, 62, 64, 230, 158, 235,
-			231, 108, 115, 216, 201, 248, 180, 50, 128, 76, 55, 106, 50, 242,
-			104, 64, 61, 42, 53, 106, 249, 231, 221, 172, 85, 248, 148, 208,
-			187, 242, 171, 213, 124, 188, 135, 34, 47, 140, 195, 145, 23, 244,
-			22, 201, 169, 15, 94, 38, 85, 58, 156, 45, 40, 78, 71, 92,
-			141, 203, 22, 62, 38, 99, 135, 187, 92, 118, 194, 136, 206, 31,
-			21, 145, 243, 90, 73, 155, 80, 233, 112, 205, 158, 216, 156, 133,
-			235, 31, 205, 188, 52, 238, 30, 252, 179, 156, 56, 206, 117, 241,
-			153, 174, 225, 183, 94, 185, 134, 95, 185, 134, 95, 210, 53, 60,
-			26, 187, 134, 239, 39, 174, 225, 251, 137, 107, 120, 138, 252, 72,
-			30, 231, 202, 167, 206, 25, 249, 191, 110, 176, 195, 4, 222, 155,
-			66, 65, 56, 30, 221, 110, 188, 217, 62, 69, 170, 87, 150, 204,
-			200, 15, 68, 230, 138, 182, 29, 40, 39, 44, 147, 59, 161, 131,
-			90, 247, 17, 93, 134, 85, 123, 95, 28, 87, 214, 206, 115, 229,
-			165, 53, 9, 205, 21, 103, 204, 188, 102, 216, 59, 19, 27, 204,
-			160, 224, 153, 220, 73, 205, 176, 119, 102, 252, 52, 185, 168, 12,
-			123, 103, 205, 169, 194, 152, 80, 77, 157, 8, 217, 182, 154, 70,
-			124, 144, 39, 11, 165, 206, 104, 6, 191, 179, 103, 95, 215, 12,
-			126, 103, 39, 38, 201, 135, 194, 254, 118, 33, 117, 209, 200, 63,
-			60, 98, 248, 192, 76, 180, 201, 59, 94, 50, 105, 220, 223, 194,
-			167, 76, 89, 59, 214, 112, 33, 119, 62, 49, 231, 21, 204, 146,
-			102, 206, 43, 244, 152, 243, 10, 3, 231, 52, 115, 94, 225, 252,
-			148, 102, 206, 43, 20, 175, 198, 92, 253, 55, 47, 147, 175, 191,
-			60, 87, 63, 112, 52, 123, 56, 102, 133, 234, 197, 115, 57, 252,
-			87, 142, 48, 253, 213, 244, 211, 79, 121, 159, 36, 217, 79, 252,
-			154, 10, 205, 235, 175, 102, 62, 241, 107, 149, 198, 225, 237, 195,
-			58, 98, 251, 184, 65, 250, 228, 150, 45, 79, 11, 63, 107, 247,
-			80, 69, 233, 235, 100, 16, 213, 254, 45, 199, 219, 194, 176, 72,
-			12, 40, 179, 170, 199, 240, 105, 197, 19, 17, 144, 241, 233, 243,
-			204, 17, 167, 207, 179, 201, 233, 243, 203, 228, 132, 12, 238, 222,
-			170, 117, 183, 58, 33, 15, 100, 244, 217, 113, 249, 120, 161, 187,
-			25, 242, 224, 213, 41, 245, 67, 167, 212, 203, 100, 64, 22, 194,
-			157, 251, 22, 201, 41, 178, 145, 219, 118, 190, 116, 136, 86, 227,
-			216, 211, 184, 236, 151, 219, 174, 255, 11, 121, 250, 218, 125, 230,
-			118, 253, 230, 171, 237, 250, 213, 118, 253, 146, 219, 117, 254, 185,
-			219, 181, 22, 201, 245, 47, 100, 98, 172, 66, 170, 105, 228, 127,
-			106, 61, 235, 32, 54, 58, 70, 129, 136, 165, 110, 40, 93, 153,
-			128, 187, 88, 127, 196, 19, 21, 181, 46, 121, 225, 99, 219, 104,
-			113, 151, 158, 234, 67, 206, 97, 101, 85, 199, 35, 40, 184, 210,
-			78, 228, 160, 69, 5, 15, 146, 232, 186, 19, 170, 84, 59, 54,
-			6, 51, 212, 121, 114, 224, 164, 215, 98, 145, 52, 166, 190, 230,
-			222, 156, 90, 7, 207, 184, 16, 182, 235, 249, 251, 232, 254, 237,
-			104, 7, 170, 101, 246, 37, 68, 75, 207, 116, 98, 103, 185, 114,
-			130, 58, 189, 135, 181, 15, 228, 99, 233, 53, 250, 162, 165, 62,
-			222, 237, 133, 195, 182, 97, 71, 54, 62, 18, 62, 86, 219, 13,
-			125, 118, 144, 19, 33, 53, 201, 136, 187, 3, 29, 200, 195, 159,
-			226, 64, 124, 221, 118, 221, 80, 19, 133, 10, 185, 19, 228, 247,
-			213, 189, 14, 214, 132, 57, 148, 255, 59, 70, 124, 180, 93, 93,
-			86, 248, 23, 120, 196, 253, 229, 206, 122, 235, 46, 217, 137, 30,
-			151, 236, 68, 207, 201, 237, 137, 193, 19, 228, 231, 148, 228, 86,
-			52, 71, 243, 159, 178, 202, 82, 44, 99, 10, 135, 142, 162, 242,
-			158, 35, 219, 232, 26, 6, 93, 248, 200, 111, 0, 79, 246, 241,
-			168, 247, 97, 49, 57, 127, 9, 159, 113, 224, 52, 26, 220, 235,
-			57, 221, 93, 236, 241, 2, 23, 123, 188, 192, 69, 58, 66, 126,
-			209, 80, 110, 224, 89, 51, 159, 239, 170, 161, 246, 168, 231, 207,
-			28, 242, 181, 195, 35, 38, 95, 126, 200, 102, 6, 6, 162, 251,
-			145, 103, 99, 177, 216, 180, 168, 53, 59, 126, 154, 252, 208, 82,
-			103, 178, 223, 49, 39, 243, 191, 100, 105, 183, 247, 40, 239, 77,
-			114, 24, 188, 199, 196, 161, 142, 235, 135, 98, 80, 79, 93, 107,
-			21, 77, 169, 29, 7, 139, 195, 67, 66, 191, 5, 19, 84, 103,
-			91, 49, 72, 165, 164, 252, 252, 138, 146, 138, 58, 243, 194, 32,
-			63, 232, 210, 137, 20, 147, 144, 38, 91, 18, 123, 172, 54, 100,
-			142, 59, 177, 243, 77, 244, 10, 107, 19, 130, 228, 129, 138, 247,
-			185, 189, 43, 60, 56, 50, 56, 48, 210, 29, 133, 120, 241, 18,
-			32, 183, 225, 4, 188, 46, 76, 233, 173, 182, 29, 224, 54, 38,
-			152, 231, 182, 19, 201, 115, 131, 242, 102, 200, 196, 162, 31, 31,
-			8, 117, 56, 176, 73, 193, 87, 226, 190, 132, 255, 82, 25, 181,
-			18, 207, 99, 192, 93, 71, 218, 109, 146, 227, 241, 239, 196, 170,
-			8, 176, 253, 119, 206, 94, 212, 142, 199, 191, 115, 121, 130, 252,
-			89, 70, 157, 143, 95, 54, 207, 228, 255, 126, 6, 195, 106, 88,
-			13, 143, 106, 235, 147, 218, 231, 218, 233, 85, 25, 82, 134, 126,
-			138, 150, 143, 39, 200, 21, 215, 152, 108, 38, 76, 149, 196, 231,
-			229, 226, 24, 207, 234, 218, 162, 216, 38, 142, 10, 251, 196, 211,
-			150, 44, 66, 1, 200, 245, 253, 246, 84, 49, 225, 17, 50, 229,
-			96, 216, 18, 193, 60, 135, 214, 197, 9, 117, 84, 68, 62, 82,
-			53, 186, 98, 25, 102, 188, 98, 126, 147, 28, 174, 133, 155, 113,
-			79, 200, 75, 178, 8, 19, 146, 90, 39, 244, 203, 114, 96, 91,
-			242, 252, 136, 104, 203, 137, 223, 86, 236, 82, 126, 17, 130, 62,
-			162, 101, 248, 60, 136, 136, 206, 113, 120, 3, 36, 9, 215, 169,
-			59, 145, 219, 213, 194, 142, 226, 124, 10, 225, 193, 47, 57, 249,
-			38, 224, 89, 226, 86, 60, 232, 208, 12, 89, 195, 135, 210, 221,
-			35, 78, 225, 255, 43, 68, 247, 233, 12, 80, 115, 159, 150, 223,
-			97, 57, 167, 231, 119, 88, 62, 157, 39, 191, 106, 168, 4, 15,
-			239, 155, 35, 249, 239, 25, 108, 243, 203, 164, 242, 16, 167, 241,
-			37, 47, 123, 193, 68, 37, 75, 50, 70, 13, 227, 185, 4, 161,
-			30, 200, 72, 146, 193, 81, 229, 180, 68, 19, 239, 199, 185, 64,
-			50, 22, 181, 222, 31, 166, 114, 95, 203, 82, 235, 3, 115, 56,
-			255, 233, 243, 115, 129, 252, 203, 25, 105, 54, 3, 3, 200, 105,
-			185, 45, 62, 136, 147, 137, 100, 45, 106, 125, 112, 98, 72, 201,
-			25, 125, 212, 250, 166, 121, 14, 228, 140, 187, 250, 71, 151, 108,
-			102, 7, 79, 214, 226, 46, 124, 32, 3, 66, 49, 62, 105, 76,
-			18, 127, 8, 110, 232, 152, 137, 101, 127, 199, 215, 130, 227, 244,
-			165, 131, 201, 227, 33, 156, 222, 79, 254, 192, 254, 126, 200, 1,
-			34, 211, 109, 100, 96, 236, 57, 45, 21, 199, 55, 251, 199, 181,
-			84, 28, 223, 60, 115, 150, 220, 87, 25, 83, 182, 76, 110, 229,
-			231, 142, 202, 152, 34, 200, 71, 220, 0, 29, 226, 199, 42, 175,
-			66, 56, 66, 182, 193, 172, 39, 91, 113, 214, 147, 28, 181, 30,
-			167, 149, 21, 41, 151, 5, 232, 180, 130, 12, 106, 61, 206, 159,
-			87, 144, 69, 173, 199, 23, 46, 202, 106, 253, 212, 178, 211, 151,
-			229, 171, 254, 44, 64, 202, 234, 213, 111, 80, 203, 62, 163, 114,
-			148, 244, 91, 212, 178, 47, 94, 146, 213, 8, 181, 106, 113, 111,
-			36, 11, 144, 234, 141, 24, 212, 170, 197, 189, 17, 139, 90, 181,
-			184, 183, 1, 106, 213, 211, 87, 228, 171, 129, 44, 64, 42, 227,
-			202, 128, 65, 173, 250, 57, 213, 228, 128, 69, 173, 250, 196, 148,
-			172, 118, 140, 90, 141, 244, 172, 124, 117, 44, 11, 144, 26, 214,
-			49, 131, 90, 141, 11, 69, 5, 89, 212, 106, 76, 207, 144, 121,
-			97, 61, 251, 36, 229, 26, 249, 155, 76, 211, 226, 97, 19, 216,
-			151, 183, 31, 163, 228, 12, 132, 31, 139, 209, 177, 253, 76, 179,
-			146, 125, 34, 51, 73, 160, 149, 108, 215, 100, 154, 149, 108, 183,
-			199, 74, 182, 59, 64, 53, 43, 217, 238, 200, 25, 205, 74, 182,
-			123, 254, 181, 216, 74, 246, 195, 97, 114, 239, 133, 13, 2, 160,
-			55, 76, 75, 243, 198, 116, 187, 54, 173, 46, 220, 144, 198, 178,
-			33, 249, 170, 164, 158, 23, 174, 147, 204, 226, 142, 227, 54, 240,
-			20, 35, 94, 225, 40, 109, 89, 18, 162, 148, 164, 195, 29, 123,
-			6, 173, 89, 199, 170, 248, 187, 240, 136, 228, 170, 188, 185, 222,
-			182, 235, 226, 196, 103, 192, 181, 122, 2, 162, 215, 73, 174, 14,
-			13, 7, 220, 147, 231, 104, 79, 149, 14, 246, 94, 194, 174, 171,
-			113, 193, 66, 153, 156, 168, 198, 153, 43, 196, 105, 209, 89, 146,
-			13, 161, 163, 196, 136, 114, 168, 21, 53, 150, 170, 44, 89, 248,
-			29, 131, 144, 37, 94, 235, 108, 139, 38, 110, 145, 12, 222, 112,
-			32, 91, 96, 135, 91, 72, 10, 67, 99, 85, 81, 156, 206, 145,
-			62, 41, 203, 201, 25, 60, 191, 166, 170, 144, 159, 38, 86, 149,
-			55, 85, 194, 64, 35, 73, 24, 56, 70, 178, 98, 171, 138, 207,
-			106, 35, 180, 48, 247, 209, 91, 95, 118, 149, 31, 252, 143, 199,
-			132, 245, 231, 234, 43, 235, 207, 43, 235, 207, 207, 212, 250, 115,
-			58, 182, 254, 172, 38, 214, 159, 85, 140, 245, 73, 209, 244, 201,
-			212, 184, 145, 191, 194, 240, 43, 198, 64, 5, 204, 211, 29, 70,
-			194, 177, 130, 209, 196, 81, 200, 128, 101, 176, 200, 105, 107, 198,
-			130, 147, 185, 227, 137, 223, 100, 44, 73, 163, 157, 1, 72, 215,
-			190, 199, 98, 157, 22, 216, 226, 24, 29, 73, 2, 162, 79, 153,
-			195, 154, 42, 124, 42, 22, 206, 128, 9, 159, 202, 29, 211, 84,
-			225, 83, 39, 134, 200, 71, 130, 195, 159, 73, 157, 55, 242, 43,
-			76, 113, 12, 17, 128, 21, 171, 38, 138, 15, 73, 3, 80, 184,
-			99, 7, 60, 81, 75, 2, 222, 20, 119, 68, 96, 205, 73, 193,
-			231, 166, 52, 214, 127, 38, 55, 148, 176, 254, 179, 166, 10, 71,
-			134, 89, 157, 141, 67, 149, 97, 86, 103, 229, 172, 4, 179, 63,
-			75, 71, 146, 8, 231, 115, 230, 121, 21, 197, 156, 6, 40, 78,
-			26, 157, 165, 214, 57, 153, 240, 86, 196, 59, 159, 27, 62, 173,
-			197, 59, 159, 59, 123, 142, 220, 23, 241, 206, 232, 3, 122, 155,
-			29, 96, 164, 98, 251, 10, 97, 33, 68, 232, 132, 235, 138, 4,
-			68, 40, 238, 54, 67, 161, 41, 129, 184, 172, 101, 96, 186, 144,
-			59, 149, 68, 75, 23, 204, 215, 84, 68, 180, 230, 242, 49, 165,
-			203, 103, 68, 139, 157, 46, 140, 230, 181, 216, 233, 194, 185, 243,
-			100, 69, 196, 39, 95, 78, 93, 53, 242, 11, 44, 97, 154, 250,
-			209, 71, 221, 35, 119, 143, 71, 88, 40, 142, 136, 2, 45, 79,
-			165, 138, 210, 242, 54, 93, 150, 113, 65, 150, 149, 162, 233, 9,
-			243, 138, 136, 38, 182, 48, 154, 120, 130, 28, 199, 112, 96, 75,
-			68, 19, 79, 166, 69, 184, 47, 130, 25, 128, 73, 2, 27, 212,
-			154, 28, 24, 76, 96, 139, 90, 147, 195, 52, 174, 110, 80, 107,
-			42, 61, 22, 191, 6, 130, 155, 210, 170, 195, 114, 76, 13, 12,
-			39, 176, 69, 173, 169, 209, 147, 152, 4, 12, 163, 175, 223, 48,
-			79, 23, 46, 8, 212, 235, 86, 185, 94, 163, 28, 209, 2, 180,
-			223, 232, 9, 208, 126, 67, 198, 199, 139, 0, 237, 55, 78, 140,
-			106, 1, 218, 111, 156, 26, 39, 55, 84, 128, 118, 209, 60, 83,
-			152, 136, 251, 137, 17, 91, 235, 50, 185, 91, 48, 117, 73, 203,
-			190, 106, 31, 200, 172, 24, 247, 6, 100, 86, 140, 123, 67, 171,
-			210, 137, 49, 45, 174, 187, 168, 229, 132, 249, 237, 115, 228, 157,
-			151, 119, 223, 217, 141, 150, 227, 73, 113, 100, 48, 246, 135, 224,
-			211, 231, 59, 238, 190, 106, 14, 154, 252, 87, 136, 34, 201, 127,
-			101, 95, 101, 254, 103, 37, 199, 21, 126, 33, 75, 142, 247, 124,
-			31, 116, 156, 244, 113, 15, 246, 52, 225, 139, 204, 85, 21, 168,
-			165, 228, 48, 245, 148, 28, 244, 14, 25, 0, 94, 189, 21, 5,
-			142, 189, 173, 114, 123, 60, 203, 225, 72, 160, 248, 6, 150, 166,
-			119, 9, 169, 7, 190, 183, 37, 242, 130, 8, 103, 229, 68, 169,
-			119, 57, 75, 61, 35, 68, 183, 160, 200, 21, 210, 95, 87, 63,
-			233, 93, 114, 188, 101, 123, 246, 54, 15, 100, 83, 253, 216, 212,
-			133, 35, 155, 122, 40, 74, 138, 70, 142, 181, 52, 136, 94, 37,
-			212, 174, 71, 206, 30, 223, 210, 163, 101, 50, 204, 154, 180, 170,
-			195, 226, 141, 158, 231, 162, 74, 70, 227, 192, 60, 189, 66, 246,
-			197, 194, 107, 70, 154, 135, 125, 229, 116, 150, 156, 84, 129, 8,
-			91, 113, 227, 33, 143, 198, 251, 112, 20, 35, 234, 165, 106, 111,
-			157, 71, 116, 153, 140, 202, 227, 115, 91, 138, 84, 176, 74, 238,
-			185, 254, 66, 42, 235, 169, 179, 4, 235, 60, 202, 255, 115, 131,
-			244, 199, 88, 126, 6, 69, 244, 230, 207, 54, 15, 230, 207, 142,
-			41, 35, 224, 251, 142, 215, 120, 81, 202, 168, 98, 105, 250, 117,
-			50, 40, 200, 202, 169, 239, 110, 237, 239, 112, 239, 5, 92, 217,
-			199, 144, 178, 156, 250, 238, 163, 29, 238, 209, 203, 228, 68, 210,
-			130, 231, 123, 117, 225, 179, 182, 170, 199, 85, 177, 21, 120, 88,
-			248, 43, 6, 25, 62, 68, 23, 116, 148, 100, 240, 196, 155, 148,
-			185, 5, 64, 207, 144, 254, 6, 20, 221, 114, 253, 109, 149, 223,
-			6, 31, 44, 251, 219, 116, 145, 12, 202, 79, 109, 171, 237, 187,
-			46, 15, 228, 148, 207, 62, 75, 220, 175, 30, 151, 47, 215, 176,
-			202, 236, 67, 146, 153, 7, 82, 165, 75, 100, 232, 224, 238, 117,
-			84, 122, 145, 115, 207, 252, 90, 190, 156, 23, 248, 175, 81, 161,
-			7, 220, 127, 21, 180, 245, 74, 15, 248, 153, 234, 1, 167, 94,
-			212, 11, 60, 150, 170, 224, 79, 147, 90, 167, 82, 75, 248, 211,
-			162, 214, 120, 234, 1, 254, 76, 83, 235, 116, 234, 125, 242, 190,
-			72, 248, 113, 46, 85, 48, 242, 101, 134, 159, 77, 156, 236, 3,
-			63, 6, 64, 163, 184, 180, 104, 126, 173, 194, 58, 161, 184, 110,
-			222, 19, 9, 103, 117, 179, 154, 191, 239, 197, 137, 60, 49, 251,
-			199, 185, 220, 113, 210, 81, 201, 63, 46, 152, 243, 249, 157, 195,
-			178, 164, 186, 208, 83, 28, 37, 69, 249, 23, 175, 26, 146, 126,
-			89, 121, 60, 24, 247, 35, 60, 112, 20, 242, 102, 199, 21, 238,
-			39, 228, 25, 219, 61, 199, 108, 157, 48, 140, 239, 169, 144, 25,
-			55, 46, 100, 85, 142, 128, 148, 73, 173, 11, 167, 84, 18, 11,
-			144, 215, 46, 204, 190, 75, 126, 32, 3, 220, 174, 164, 222, 49,
-			242, 223, 55, 88, 239, 240, 92, 223, 15, 185, 219, 101, 45, 145,
-			226, 76, 143, 78, 3, 149, 202, 14, 67, 191, 238, 96, 178, 72,
-			52, 1, 198, 55, 174, 136, 252, 160, 146, 42, 148, 111, 93, 157,
-			238, 76, 174, 236, 208, 14, 208, 174, 240, 39, 17, 139, 236, 237,
-			57, 54, 115, 77, 211, 206, 174, 200, 67, 47, 41, 16, 170, 223,
-			48, 103, 132, 80, 157, 66, 161, 250, 13, 50, 130, 82, 113, 74,
-			8, 213, 197, 244, 73, 148, 122, 83, 82, 168, 46, 166, 115, 9,
-			140, 62, 201, 161, 4, 6, 249, 113, 100, 52, 174, 110, 80, 235,
-			106, 250, 116, 252, 26, 132, 234, 171, 233, 254, 4, 134, 247, 100,
-			52, 129, 45, 106, 93, 61, 53, 30, 87, 55, 169, 85, 74, 151,
-			226, 215, 102, 22, 224, 115, 9, 108, 80, 171, 116, 126, 42, 129,
-			45, 106, 149, 138, 87, 227, 234, 152, 245, 121, 38, 126, 45, 242,
-			62, 39, 213, 49, 243, 243, 249, 98, 2, 67, 249, 233, 107, 113,
-			117, 204, 254, 124, 62, 126, 157, 206, 0, 156, 12, 30, 51, 64,
-			147, 100, 114, 152, 3, 250, 236, 185, 68, 233, 189, 110, 142, 104,
-			74, 239, 117, 51, 171, 41, 189, 215, 251, 6, 53, 165, 247, 250,
-			48, 77, 148, 222, 27, 38, 213, 148, 222, 27, 113, 53, 64, 214,
-			141, 190, 227, 154, 210, 123, 99, 104, 88, 86, 51, 169, 117, 211,
-			84, 22, 79, 64, 211, 205, 216, 129, 7, 77, 222, 60, 59, 161,
-			249, 96, 111, 94, 121, 35, 73, 139, 125, 43, 241, 244, 101, 1,
-			26, 208, 252, 126, 183, 142, 141, 105, 126, 191, 91, 167, 243, 73,
-			90, 236, 55, 77, 101, 32, 78, 103, 1, 26, 209, 220, 38, 111,
-			138, 4, 42, 210, 109, 242, 230, 235, 151, 146, 180, 216, 183, 77,
-			149, 168, 58, 147, 6, 72, 247, 93, 220, 30, 24, 210, 124, 23,
-			183, 135, 47, 106, 190, 139, 219, 50, 217, 37, 250, 46, 230, 204,
-			69, 229, 44, 72, 3, 20, 187, 21, 224, 221, 192, 148, 230, 86,
-			152, 187, 242, 53, 205, 173, 48, 55, 191, 144, 164, 204, 190, 99,
-			190, 161, 108, 241, 152, 140, 68, 183, 218, 223, 137, 71, 210, 103,
-			80, 235, 206, 240, 101, 205, 106, 127, 103, 234, 74, 98, 93, 127,
-			219, 84, 237, 231, 210, 0, 17, 205, 214, 254, 246, 192, 235, 154,
-			173, 253, 237, 75, 183, 53, 91, 251, 219, 111, 191, 67, 62, 16,
-			214, 138, 249, 212, 125, 35, 255, 128, 29, 18, 114, 148, 85, 90,
-			100, 201, 14, 37, 255, 146, 78, 7, 59, 220, 45, 201, 194, 37,
-			197, 250, 214, 37, 47, 83, 150, 138, 249, 220, 233, 196, 82, 177,
-			96, 142, 104, 150, 138, 133, 30, 75, 197, 66, 255, 160, 102, 169,
-			88, 144, 164, 40, 46, 191, 50, 227, 43, 172, 50, 0, 233, 103,
-			177, 23, 251, 169, 102, 155, 88, 60, 57, 70, 222, 83, 215, 91,
-			221, 51, 103, 102, 191, 134, 65, 58, 42, 170, 165, 13, 187, 142,
-			54, 106, 113, 161, 151, 112, 140, 137, 75, 224, 66, 193, 119, 99,
-			150, 44, 154, 134, 229, 190, 103, 170, 80, 83, 24, 210, 189, 243,
-			69, 5, 89, 212, 186, 55, 125, 45, 9, 53, 29, 120, 129, 252,
-			165, 135, 212, 55, 145, 205, 50, 54, 158, 199, 2, 155, 124, 94,
-			120, 155, 12, 107, 26, 128, 200, 116, 73, 47, 16, 20, 103, 183,
-			194, 186, 237, 121, 92, 101, 104, 68, 137, 122, 93, 60, 122, 144,
-			206, 25, 67, 230, 151, 19, 241, 254, 73, 255, 171, 184, 252, 87,
-			34, 222, 191, 92, 83, 111, 143, 136, 247, 143, 197, 141, 240, 233,
-			179, 169, 139, 70, 254, 63, 51, 217, 33, 130, 215, 163, 182, 218,
-			246, 182, 227, 9, 17, 43, 196, 212, 6, 194, 148, 167, 167, 19,
-			171, 174, 45, 98, 224, 128, 22, 143, 143, 247, 249, 58, 159, 9,
-			147, 88, 205, 14, 249, 173, 27, 34, 13, 137, 60, 132, 157, 28,
-			201, 14, 49, 27, 76, 192, 39, 48, 239, 138, 72, 246, 238, 68,
-			93, 130, 23, 60, 112, 76, 148, 50, 199, 246, 57, 200, 72, 157,
-			22, 103, 181, 192, 223, 229, 30, 147, 159, 171, 76, 194, 241, 137,
-			184, 213, 176, 225, 239, 123, 241, 249, 107, 224, 44, 245, 93, 149,
-			130, 58, 201, 160, 162, 68, 43, 237, 44, 186, 156, 241, 1, 193,
-			74, 8, 85, 34, 135, 187, 24, 41, 17, 7, 219, 225, 83, 17,
-			254, 89, 160, 22, 71, 191, 135, 242, 108, 238, 52, 153, 130, 223,
-			253, 212, 58, 103, 30, 47, 156, 139, 209, 88, 211, 172, 130, 178,
-			79, 34, 54, 150, 254, 20, 181, 206, 13, 72, 227, 118, 63, 10,
-			188, 9, 100, 10, 232, 123, 113, 20, 94, 193, 60, 157, 127, 34,
-			163, 190, 98, 219, 188, 228, 75, 122, 144, 204, 164, 176, 144, 0,
-			241, 41, 203, 197, 148, 66, 198, 167, 29, 30, 116, 69, 156, 136,
-			144, 155, 209, 114, 92, 89, 210, 179, 23, 216, 129, 12, 101, 240,
-			4, 75, 240, 189, 222, 187, 80, 10, 61, 103, 33, 10, 185, 81,
-			77, 188, 41, 156, 26, 143, 185, 244, 127, 55, 252, 2, 55, 181,
-			29, 54, 178, 57, 173, 228, 56, 192, 137, 196, 100, 130, 143, 159,
-			127, 220, 235, 239, 24, 36, 179, 1, 101, 15, 133, 242, 107, 225,
-			248, 207, 63, 204, 21, 135, 227, 23, 137, 197, 35, 251, 5, 172,
-			38, 80, 44, 9, 203, 79, 235, 97, 249, 227, 113, 84, 57, 154,
-			62, 142, 85, 227, 32, 243, 59, 164, 31, 7, 139, 33, 230, 37,
-			146, 21, 179, 140, 47, 147, 63, 48, 123, 236, 45, 168, 202, 82,
-			95, 110, 199, 249, 147, 65, 177, 227, 220, 121, 229, 92, 124, 181,
-			227, 252, 76, 119, 156, 241, 231, 26, 21, 254, 133, 84, 148, 207,
-			164, 110, 26, 249, 159, 26, 24, 12, 122, 84, 52, 185, 237, 117,
-			15, 28, 57, 197, 163, 84, 242, 176, 42, 94, 26, 181, 203, 27,
-			152, 144, 78, 92, 67, 139, 223, 131, 200, 135, 88, 247, 219, 242,
-			146, 219, 67, 193, 131, 192, 206, 253, 125, 233, 65, 113, 34, 188,
-			141, 106, 159, 179, 6, 30, 255, 117, 188, 186, 219, 105, 240, 158,
-			67, 183, 1, 199, 176, 179, 58, 103, 176, 75, 169, 212, 89, 182,
-			187, 111, 119, 195, 228, 20, 172, 126, 201, 131, 23, 129, 14, 238,
-			55, 15, 197, 83, 159, 201, 29, 39, 255, 169, 198, 201, 135, 242,
-			63, 126, 110, 60, 181, 204, 81, 18, 20, 49, 221, 228, 213, 248,
-			86, 137, 159, 85, 120, 181, 100, 42, 47, 31, 249, 43, 54, 1,
-			221, 177, 91, 232, 9, 171, 46, 12, 158, 32, 223, 78, 46, 196,
-			154, 204, 191, 127, 100, 220, 47, 174, 254, 129, 168, 223, 151, 13,
-			155, 206, 66, 7, 250, 89, 186, 137, 56, 128, 21, 47, 197, 186,
-			60, 65, 154, 74, 109, 46, 154, 175, 231, 63, 100, 27, 176, 189,
-			137, 252, 51, 106, 44, 10, 209, 12, 55, 78, 59, 146, 182, 151,
-			56, 100, 243, 57, 209, 112, 80, 53, 137, 138, 206, 162, 223, 78,
-			211, 200, 139, 103, 95, 211, 52, 242, 98, 225, 34, 249, 107, 134,
-			82, 201, 103, 204, 145, 252, 95, 122, 78, 104, 33, 142, 236, 43,
-			5, 22, 226, 0, 95, 32, 88, 207, 202, 192, 136, 114, 154, 89,
-			96, 38, 14, 43, 180, 96, 180, 195, 148, 44, 43, 179, 192, 13,
-			115, 52, 255, 46, 155, 15, 106, 78, 20, 216, 65, 55, 201, 62,
-			36, 247, 54, 188, 218, 131, 55, 216, 30, 15, 106, 118, 228, 180,
-			148, 232, 215, 19, 84, 154, 132, 99, 222, 232, 9, 199, 188, 145,
-			59, 161, 217, 21, 110, 208, 17, 242, 174, 208, 161, 111, 167, 238,
-			24, 249, 235, 44, 222, 52, 95, 62, 162, 235, 182, 158, 198, 108,
-			206, 60, 171, 69, 116, 205, 245, 68, 116, 205, 197, 254, 121, 160,
-			240, 185, 225, 83, 154, 234, 60, 167, 93, 72, 251, 63, 23, 191,
-			204, 105, 246, 232, 211, 67, 167, 217, 163, 79, 95, 224, 40, 227,
-			51, 229, 165, 175, 238, 212, 44, 220, 34, 39, 171, 220, 110, 172,
-			137, 116, 5, 139, 152, 169, 96, 195, 14, 119, 233, 57, 188, 155,
-			20, 30, 110, 197, 98, 85, 191, 124, 82, 105, 20, 150, 200, 232,
-			50, 222, 223, 149, 104, 7, 88, 45, 57, 64, 105, 232, 7, 40,
-			79, 146, 172, 227, 237, 37, 87, 30, 100, 28, 111, 175, 210, 40,
-			108, 146, 252, 193, 86, 66, 60, 240, 136, 109, 189, 73, 50, 120,
-			146, 70, 202, 71, 154, 91, 49, 250, 180, 116, 84, 239, 85, 81,
-			190, 240, 6, 161, 194, 211, 169, 172, 178, 207, 24, 90, 97, 130,
-			12, 190, 231, 212, 119, 69, 133, 103, 21, 252, 203, 6, 25, 75,
-			250, 83, 142, 192, 151, 159, 53, 189, 163, 157, 45, 20, 130, 230,
-			107, 61, 83, 187, 107, 123, 171, 157, 56, 131, 39, 78, 44, 174,
-			80, 224, 132, 30, 126, 79, 79, 137, 203, 34, 156, 134, 64, 86,
-			63, 222, 12, 81, 105, 132, 61, 231, 24, 205, 23, 63, 199, 88,
-			104, 144, 145, 178, 135, 201, 64, 123, 250, 121, 202, 68, 191, 108,
-			47, 1, 161, 42, 25, 184, 216, 212, 191, 4, 54, 19, 49, 218,
-			122, 17, 49, 186, 176, 45, 101, 240, 47, 209, 85, 17, 196, 253,
-			86, 236, 97, 124, 90, 79, 162, 80, 97, 137, 28, 195, 195, 180,
-			64, 92, 207, 232, 235, 28, 33, 154, 175, 52, 190, 4, 69, 250,
-			73, 191, 156, 212, 255, 15, 47, 138, 187, 1, 126, 248, 236, 187,
-			1, 94, 137, 253, 175, 196, 254, 159, 117, 2, 136, 251, 201, 137,
-			210, 7, 100, 87, 41, 0, 231, 140, 252, 22, 59, 114, 163, 209,
-			229, 90, 145, 101, 81, 228, 101, 244, 155, 34, 75, 160, 220, 114,
-			128, 48, 223, 7, 118, 52, 199, 10, 1, 183, 27, 87, 229, 243,
-			171, 34, 185, 78, 161, 71, 246, 214, 60, 53, 103, 205, 113, 77,
-			138, 61, 219, 35, 197, 158, 237, 31, 209, 164, 216, 179, 99, 167,
-			200, 63, 48, 132, 216, 113, 57, 53, 101, 228, 127, 215, 96, 71,
-			109, 50, 250, 128, 69, 182, 99, 149, 228, 123, 210, 15, 100, 14,
-			64, 155, 185, 50, 42, 113, 42, 62, 215, 68, 14, 166, 169, 22,
-			55, 106, 10, 38, 219, 96, 158, 239, 93, 237, 201, 24, 236, 118,
-			139, 120, 27, 65, 252, 121, 36, 82, 63, 124, 122, 187, 188, 171,
-			227, 68, 92, 166, 201, 195, 130, 38, 250, 92, 206, 157, 77, 68,
-			159, 137, 158, 136, 198, 137, 30, 63, 193, 68, 79, 68, 227, 132,
-			30, 209, 56, 25, 187, 23, 140, 12, 64, 125, 154, 159, 96, 50,
-			55, 168, 249, 9, 38, 135, 41, 249, 67, 121, 187, 229, 76, 234,
-			186, 145, 255, 187, 135, 241, 151, 108, 238, 58, 22, 119, 157, 250,
-			46, 243, 155, 77, 22, 130, 36, 107, 247, 166, 206, 179, 35, 6,
-			124, 240, 8, 140, 29, 192, 22, 234, 107, 77, 219, 11, 153, 223,
-			137, 68, 46, 233, 22, 104, 150, 71, 173, 161, 142, 57, 121, 23,
-			85, 65, 11, 156, 156, 201, 21, 146, 192, 201, 89, 243, 138, 22,
-			56, 57, 219, 19, 56, 57, 59, 240, 154, 22, 56, 57, 203, 46,
-			105, 129, 147, 179, 147, 83, 228, 79, 77, 17, 57, 249, 78, 234,
-			93, 35, 255, 247, 77, 118, 88, 42, 97, 174, 239, 239, 134, 241,
-			1, 58, 244, 15, 73, 62, 247, 137, 95, 19, 9, 220, 241, 22,
-			213, 134, 144, 123, 65, 251, 243, 153, 199, 159, 224, 23, 81, 126,
-			6, 245, 40, 253, 53, 218, 9, 252, 40, 114, 5, 170, 131, 142,
-			7, 220, 46, 240, 159, 56, 45, 188, 55, 15, 145, 11, 220, 128,
-			48, 145, 198, 12, 171, 197, 131, 58, 144, 30, 92, 220, 135, 248,
-			172, 140, 45, 138, 155, 72, 114, 60, 112, 54, 59, 196, 111, 68,
-			109, 93, 82, 217, 9, 229, 164, 156, 72, 168, 248, 83, 250, 234,
-			136, 248, 182, 120, 117, 240, 204, 162, 76, 219, 130, 177, 153, 95,
-			51, 227, 112, 202, 12, 64, 250, 109, 159, 95, 147, 100, 45, 66,
-			45, 191, 70, 71, 200, 95, 178, 196, 109, 159, 15, 82, 203, 70,
-			254, 127, 51, 89, 175, 224, 167, 76, 19, 138, 46, 15, 32, 244,
-			224, 145, 115, 49, 52, 24, 236, 35, 46, 181, 214, 131, 53, 36,
-			173, 30, 177, 238, 69, 224, 10, 226, 100, 145, 90, 33, 84, 210,
-			91, 188, 190, 99, 123, 78, 216, 98, 152, 86, 189, 197, 91, 117,
-			220, 122, 1, 239, 160, 40, 202, 27, 203, 139, 50, 200, 89, 30,
-			63, 212, 172, 19, 34, 5, 78, 79, 246, 241, 176, 196, 214, 125,
-			149, 149, 190, 231, 5, 206, 88, 13, 178, 23, 25, 170, 7, 60,
-			125, 222, 9, 60, 97, 68, 126, 250, 132, 216, 164, 100, 84, 106,
-			46, 207, 90, 70, 208, 249, 30, 228, 198, 228, 157, 151, 41, 106,
-			189, 39, 151, 81, 220, 67, 250, 94, 207, 61, 164, 239, 201, 101,
-			20, 247, 144, 190, 71, 71, 200, 95, 53, 196, 45, 151, 27, 169,
-			111, 24, 160, 79, 31, 45, 149, 31, 113, 97, 113, 79, 150, 0,
-			145, 178, 71, 210, 112, 248, 2, 12, 70, 155, 15, 138, 47, 28,
-			113, 88, 208, 46, 124, 220, 144, 73, 118, 240, 194, 199, 77, 57,
-			39, 204, 158, 106, 109, 202, 57, 137, 11, 31, 55, 229, 156, 196,
-			133, 143, 155, 146, 227, 226, 133, 143, 31, 72, 142, 43, 174, 120,
-			252, 64, 114, 92, 113, 197, 227, 7, 146, 227, 138, 43, 30, 63,
-			144, 14, 221, 140, 105, 82, 235, 145, 89, 144, 175, 204, 44, 64,
-			170, 111, 104, 242, 209, 201, 115, 10, 178, 168, 245, 136, 93, 32,
-			127, 108, 96, 186, 213, 180, 157, 106, 24, 249, 223, 51, 216, 97,
-			149, 66, 6, 215, 227, 37, 183, 64, 114, 201, 81, 243, 240, 168,
-			243, 192, 95, 158, 63, 31, 161, 102, 20, 9, 230, 147, 108, 243,
-			0, 153, 96, 101, 233, 0, 41, 9, 229, 65, 226, 61, 107, 80,
-			203, 150, 44, 33, 11, 120, 175, 201, 32, 252, 44, 50, 236, 154,
-			100, 216, 89, 92, 133, 154, 188, 49, 19, 147, 190, 90, 53, 122,
-			90, 65, 22, 181, 106, 50, 194, 35, 11, 40, 171, 155, 83, 242,
-			149, 145, 6, 72, 53, 98, 100, 169, 85, 31, 56, 163, 32, 40,
-			41, 179, 57, 97, 50, 85, 171, 62, 49, 73, 254, 7, 3, 147,
-			169, 166, 91, 169, 182, 145, 255, 103, 198, 81, 51, 100, 118, 163,
-			17, 202, 108, 123, 201, 121, 89, 95, 36, 34, 152, 8, 15, 243,
-			221, 144, 63, 151, 225, 179, 73, 180, 76, 30, 94, 202, 41, 113,
-			139, 109, 111, 105, 34, 47, 51, 197, 47, 4, 79, 211, 10, 3,
-			170, 58, 160, 136, 109, 217, 120, 197, 247, 193, 47, 185, 7, 253,
-			125, 6, 181, 90, 57, 113, 63, 122, 31, 160, 223, 147, 164, 215,
-			135, 8, 247, 36, 217, 99, 230, 87, 203, 147, 100, 143, 137, 95,
-			45, 79, 146, 125, 31, 32, 220, 151, 8, 239, 67, 132, 251, 18,
-			225, 125, 136, 112, 95, 34, 28, 115, 192, 90, 190, 68, 56, 166,
-			128, 181, 252, 137, 73, 242, 183, 69, 46, 214, 244, 147, 212, 119,
-			140, 252, 175, 27, 236, 176, 78, 41, 240, 173, 209, 109, 131, 187,
-			118, 183, 215, 143, 166, 108, 184, 207, 165, 227, 170, 116, 143, 162,
-			102, 18, 183, 24, 43, 149, 73, 67, 49, 206, 132, 230, 41, 49,
-			150, 51, 168, 245, 68, 18, 108, 14, 48, 214, 149, 24, 195, 252,
-			172, 113, 98, 118, 76, 207, 106, 117, 37, 198, 48, 59, 171, 213,
-			149, 24, 203, 1, 198, 62, 147, 140, 34, 135, 140, 226, 51, 201,
-			40, 114, 136, 163, 207, 36, 163, 200, 33, 142, 62, 147, 140, 34,
-			7, 140, 226, 115, 243, 162, 124, 101, 166, 1, 34, 10, 202, 82,
-			235, 243, 129, 83, 10, 50, 168, 245, 249, 248, 121, 5, 89, 212,
-			250, 252, 66, 129, 252, 130, 41, 50, 195, 126, 207, 72, 253, 235,
-			134, 145, 255, 95, 13, 125, 214, 241, 157, 253, 98, 67, 151, 8,
-			22, 199, 204, 237, 70, 67, 59, 211, 59, 223, 104, 72, 95, 128,
-			239, 69, 1, 70, 224, 202, 91, 172, 19, 2, 151, 10, 207, 65,
-			26, 247, 3, 113, 173, 197, 146, 118, 231, 68, 114, 218, 61, 89,
-			205, 137, 144, 176, 53, 25, 100, 45, 132, 140, 144, 71, 115, 232,
-			106, 144, 66, 7, 94, 159, 224, 201, 120, 68, 225, 10, 13, 29,
-			87, 8, 54, 225, 174, 211, 110, 11, 11, 245, 225, 53, 84, 105,
-			109, 191, 103, 96, 34, 127, 153, 214, 246, 23, 13, 115, 84, 37,
-			174, 205, 32, 152, 211, 210, 218, 254, 98, 146, 201, 52, 101, 1,
-			72, 71, 100, 85, 131, 166, 191, 111, 152, 35, 90, 158, 218, 239,
-			247, 230, 169, 253, 190, 145, 27, 212, 242, 212, 126, 223, 24, 86,
-			201, 116, 77, 154, 254, 37, 195, 60, 173, 146, 199, 102, 17, 28,
-			212, 82, 203, 254, 146, 113, 98, 84, 75, 45, 251, 75, 198, 169,
-			113, 242, 127, 193, 183, 66, 104, 246, 87, 141, 212, 15, 13, 35,
-			255, 207, 13, 166, 27, 41, 208, 163, 31, 223, 114, 212, 147, 69,
-			64, 48, 38, 113, 229, 191, 44, 241, 252, 143, 37, 49, 255, 99,
-			34, 84, 121, 141, 185, 16, 115, 197, 233, 255, 72, 93, 214, 44,
-			46, 151, 15, 99, 237, 21, 111, 34, 19, 118, 123, 208, 118, 163,
-			100, 96, 170, 29, 76, 48, 132, 124, 170, 29, 240, 61, 199, 239,
-			136, 225, 17, 22, 57, 245, 221, 158, 15, 16, 30, 199, 107, 71,
-			12, 154, 254, 85, 35, 39, 178, 63, 19, 88, 187, 31, 168, 181,
-			35, 184, 118, 63, 80, 107, 71, 112, 237, 126, 160, 214, 142, 224,
-			218, 253, 0, 214, 238, 45, 172, 138, 89, 208, 205, 83, 133, 43,
-			137, 66, 184, 235, 180, 153, 231, 51, 215, 247, 182, 229, 133, 246,
-			1, 15, 145, 191, 226, 160, 136, 108, 200, 16, 249, 212, 251, 20,
-			136, 45, 201, 124, 196, 4, 215, 249, 215, 140, 147, 99, 202, 230,
-			252, 255, 5, 0, 0, 255, 255, 218, 100, 191, 196, 83, 192, 0,
-			0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 105, 108, 36, 89,
+			122, 32, 150, 17, 47, 50, 153, 124, 172, 131, 124, 60, 138, 149,
+			85, 172, 126, 149, 213, 213, 36, 171, 147, 201, 163, 174, 41, 86,
+			31, 195, 186, 186, 88, 93, 93, 85, 77, 178, 186, 167, 171, 71,
+			98, 69, 102, 190, 36, 163, 25, 25, 145, 29, 17, 73, 86, 118,
+			79, 107, 36, 173, 123, 52, 246, 143, 181, 100, 205, 140, 13, 141,
+			189, 99, 172, 36, 107, 237, 181, 177, 208, 44, 160, 193, 200, 90,
+			104, 101, 201, 43, 67, 50, 124, 236, 98, 13, 219, 128, 225, 31,
+			90, 248, 143, 23, 198, 250, 128, 109, 192, 54, 96, 124, 223, 59,
+			34, 146, 100, 157, 221, 187, 6, 140, 42, 112, 166, 243, 139, 120,
+			231, 247, 190, 247, 189, 239, 122, 95, 208, 159, 79, 211, 87, 54,
+			194, 112, 195, 23, 179, 237, 40, 76, 194, 90, 167, 57, 155, 120,
+			45, 17, 39, 110, 171, 93, 197, 71, 236, 176, 44, 80, 213, 5,
+			202, 151, 105, 255, 154, 46, 195, 198, 105, 95, 44, 234, 97, 208,
+			136, 199, 45, 110, 77, 145, 21, 13, 178, 17, 154, 15, 220, 32,
+			140, 199, 109, 110, 77, 229, 87, 36, 112, 229, 75, 139, 14, 215,
+			195, 86, 117, 87, 163, 87, 14, 153, 38, 239, 193, 163, 123, 214,
+			131, 5, 85, 100, 35, 244, 221, 96, 163, 26, 70, 27, 153, 49,
+			118, 219, 34, 158, 221, 10, 194, 157, 32, 29, 111, 187, 246, 127,
+			90, 214, 223, 180, 201, 59, 247, 174, 252, 123, 246, 137, 119, 100,
+			237, 123, 170, 74, 245, 67, 225, 251, 239, 66, 133, 53, 168, 123,
+			235, 103, 147, 180, 192, 156, 67, 185, 239, 90, 244, 63, 115, 168,
+			117, 128, 145, 67, 57, 182, 240, 167, 14, 191, 26, 182, 187, 145,
+			183, 177, 153, 240, 133, 185, 133, 185, 153, 133, 185, 133, 115, 252,
+			74, 167, 201, 215, 68, 125, 51, 8, 253, 112, 195, 19, 113, 133,
+			47, 7, 245, 42, 165, 252, 182, 87, 23, 65, 44, 26, 188, 19,
+			52, 68, 196, 147, 77, 193, 151, 218, 110, 125, 83, 232, 55, 21,
+			254, 129, 136, 98, 47, 12, 248, 66, 117, 142, 79, 65, 129, 178,
+			122, 85, 158, 190, 76, 121, 55, 236, 240, 150, 219, 229, 65, 152,
+			240, 78, 44, 120, 178, 233, 197, 188, 233, 249, 130, 139, 71, 117,
+			209, 78, 184, 23, 240, 122, 216, 106, 251, 158, 27, 212, 5, 223,
+			241, 146, 77, 158, 164, 205, 87, 41, 255, 72, 181, 16, 214, 18,
+			215, 11, 184, 203, 235, 97, 187, 203, 195, 102, 182, 24, 119, 19,
+			74, 57, 254, 219, 76, 146, 246, 226, 236, 236, 206, 206, 78, 213,
+			197, 145, 34, 98, 125, 89, 46, 158, 189, 189, 124, 245, 250, 157,
+			213, 235, 51, 11, 213, 57, 74, 249, 253, 192, 23, 113, 204, 35,
+			241, 105, 199, 139, 68, 131, 215, 186, 220, 109, 183, 125, 175, 238,
+			214, 124, 193, 125, 119, 135, 135, 17, 119, 55, 34, 33, 26, 60,
+			9, 97, 172, 59, 145, 151, 120, 193, 70, 133, 199, 97, 51, 217,
+			113, 35, 65, 121, 195, 139, 147, 200, 171, 117, 146, 30, 52, 233,
+			145, 121, 113, 79, 129, 48, 224, 110, 192, 203, 75, 171, 124, 121,
+			181, 204, 175, 44, 173, 46, 175, 86, 40, 255, 112, 121, 237, 230,
+			221, 251, 107, 252, 195, 165, 149, 149, 165, 59, 107, 203, 215, 87,
+			249, 221, 21, 126, 245, 238, 157, 107, 203, 107, 203, 119, 239, 172,
+			242, 187, 55, 248, 210, 157, 143, 248, 187, 203, 119, 174, 85, 184,
+			240, 146, 77, 17, 113, 241, 168, 29, 193, 232, 195, 136, 123, 128,
+			64, 209, 168, 82, 190, 42, 68, 79, 247, 205, 80, 174, 90, 220,
+			22, 117, 175, 233, 213, 57, 208, 90, 199, 221, 16, 124, 35, 220,
+			22, 81, 224, 5, 27, 188, 45, 162, 150, 23, 195, 34, 198, 220,
+			13, 26, 148, 251, 94, 203, 75, 220, 4, 31, 236, 153, 81, 149,
+			210, 34, 181, 108, 70, 6, 115, 227, 240, 171, 200, 8, 203, 189,
+			66, 251, 169, 93, 124, 69, 254, 148, 15, 135, 115, 203, 248, 112,
+			64, 254, 148, 15, 71, 114, 21, 124, 104, 201, 159, 242, 225, 104,
+			110, 22, 31, 170, 159, 242, 225, 88, 174, 140, 15, 169, 252, 41,
+			31, 30, 201, 157, 196, 135, 175, 202, 159, 242, 225, 120, 238, 50,
+			62, 60, 45, 127, 254, 215, 19, 212, 118, 114, 204, 73, 114, 223,
+			181, 74, 127, 57, 193, 151, 184, 217, 121, 60, 18, 128, 50, 17,
+			36, 49, 119, 121, 59, 244, 2, 164, 63, 216, 96, 220, 11, 26,
+			162, 45, 130, 134, 8, 18, 32, 46, 55, 232, 202, 231, 159, 133,
+			129, 224, 97, 196, 253, 176, 238, 250, 148, 215, 93, 95, 4, 13,
+			55, 170, 112, 17, 212, 195, 134, 104, 112, 23, 218, 170, 135, 29,
+			89, 79, 49, 7, 192, 35, 111, 70, 110, 93, 34, 49, 251, 34,
+			161, 28, 57, 5, 194, 60, 18, 113, 232, 119, 160, 84, 149, 175,
+			109, 10, 213, 144, 7, 52, 233, 187, 137, 183, 45, 128, 238, 220,
+			128, 139, 118, 88, 223, 228, 110, 194, 239, 175, 93, 229, 45, 175,
+			17, 224, 14, 14, 3, 202, 111, 185, 65, 199, 141, 186, 124, 190,
+			194, 231, 47, 93, 156, 171, 224, 140, 54, 5, 111, 71, 161, 47,
+			218, 137, 87, 231, 239, 68, 98, 35, 140, 60, 55, 48, 163, 231,
+			59, 155, 94, 125, 147, 139, 71, 137, 128, 193, 38, 155, 130, 238,
+			87, 170, 230, 214, 183, 118, 220, 8, 74, 132, 188, 43, 220, 136,
+			135, 129, 0, 182, 176, 228, 251, 188, 229, 5, 157, 68, 196, 220,
+			141, 4, 191, 48, 103, 230, 231, 135, 193, 70, 149, 223, 22, 110,
+			59, 157, 114, 36, 120, 57, 110, 9, 55, 18, 141, 50, 143, 67,
+			158, 108, 186, 9, 15, 66, 238, 11, 183, 77, 85, 49, 158, 224,
+			158, 243, 98, 30, 8, 1, 120, 5, 202, 245, 130, 68, 68, 237,
+			72, 72, 98, 172, 240, 78, 12, 244, 234, 242, 143, 23, 206, 205,
+			108, 134, 157, 136, 251, 94, 32, 220, 136, 114, 108, 253, 23, 166,
+			96, 243, 199, 139, 179, 179, 13, 177, 45, 252, 176, 45, 162, 88,
+			243, 225, 122, 216, 66, 70, 58, 139, 37, 167, 97, 18, 128, 238,
+			200, 13, 54, 112, 143, 54, 163, 176, 197, 231, 230, 230, 230, 103,
+			240, 111, 109, 110, 110, 17, 255, 30, 192, 212, 47, 93, 186, 116,
+			105, 102, 126, 97, 230, 236, 252, 218, 194, 217, 197, 243, 151, 22,
+			207, 95, 170, 94, 210, 255, 30, 84, 249, 149, 46, 133, 133, 76,
+			34, 175, 14, 204, 1, 170, 224, 20, 177, 245, 10, 223, 17, 92,
+			4, 113, 39, 130, 157, 233, 38, 0, 214, 1, 203, 97, 176, 45,
+			162, 4, 10, 75, 98, 9, 91, 252, 227, 149, 27, 87, 41, 63,
+			123, 246, 236, 165, 116, 46, 192, 201, 60, 145, 52, 145, 143, 69,
+			205, 250, 108, 212, 172, 67, 137, 106, 242, 40, 153, 230, 13, 55,
+			17, 28, 248, 79, 176, 17, 195, 164, 78, 241, 235, 143, 220, 86,
+			219, 23, 49, 165, 250, 39, 159, 95, 228, 87, 195, 86, 187, 147,
+			136, 204, 94, 192, 14, 239, 221, 93, 93, 254, 22, 127, 8, 152,
+			153, 154, 126, 88, 85, 76, 52, 45, 100, 206, 158, 203, 242, 141,
+			129, 171, 177, 72, 214, 213, 2, 79, 193, 211, 169, 59, 247, 111,
+			223, 158, 158, 222, 183, 28, 210, 251, 212, 220, 244, 229, 204, 152,
+			22, 158, 54, 166, 13, 145, 64, 43, 97, 179, 225, 118, 51, 99,
+			139, 147, 168, 83, 79, 112, 111, 110, 187, 62, 79, 182, 85, 143,
+			61, 197, 95, 75, 182, 43, 28, 7, 116, 249, 69, 167, 180, 93,
+			77, 182, 97, 130, 79, 154, 145, 44, 212, 137, 69, 157, 159, 225,
+			243, 115, 115, 189, 51, 60, 251, 216, 25, 126, 232, 5, 103, 23,
+			248, 195, 119, 68, 178, 218, 141, 19, 209, 130, 215, 75, 241, 13,
+			207, 23, 107, 189, 11, 113, 99, 249, 246, 245, 181, 229, 247, 174,
+			243, 102, 162, 134, 241, 184, 58, 175, 53, 19, 61, 210, 251, 203,
+			119, 214, 46, 156, 227, 137, 87, 223, 138, 249, 155, 124, 106, 106,
+			74, 62, 153, 110, 38, 213, 198, 206, 77, 111, 99, 243, 154, 155,
+			96, 173, 105, 254, 198, 27, 252, 236, 194, 52, 255, 14, 199, 119,
+			183, 195, 29, 253, 74, 227, 109, 118, 150, 47, 241, 15, 189, 160,
+			17, 238, 196, 216, 36, 108, 150, 249, 185, 185, 12, 15, 139, 171,
+			166, 128, 228, 82, 243, 23, 246, 110, 35, 211, 26, 84, 159, 191,
+			112, 238, 220, 185, 139, 103, 47, 204, 165, 108, 163, 38, 154, 97,
+			36, 248, 253, 192, 123, 164, 120, 29, 48, 179, 221, 173, 84, 95,
+			108, 49, 167, 228, 252, 249, 212, 20, 204, 32, 230, 179, 184, 88,
+			240, 55, 205, 103, 178, 195, 121, 10, 5, 67, 59, 103, 23, 210,
+			118, 78, 103, 218, 65, 2, 152, 238, 33, 128, 115, 143, 37, 128,
+			91, 238, 182, 203, 31, 202, 197, 175, 214, 59, 81, 36, 130, 4,
+			138, 188, 231, 249, 190, 23, 103, 8, 0, 184, 41, 111, 225, 83,
+			254, 38, 127, 124, 133, 39, 144, 57, 127, 51, 125, 90, 13, 196,
+			206, 149, 142, 231, 55, 68, 52, 53, 13, 19, 91, 85, 24, 82,
+			93, 72, 196, 76, 43, 81, 138, 115, 14, 101, 238, 32, 173, 79,
+			121, 65, 2, 51, 87, 37, 229, 212, 213, 180, 1, 5, 211, 211,
+			213, 26, 180, 60, 213, 131, 130, 243, 79, 65, 193, 114, 16, 39,
+			110, 144, 84, 131, 112, 39, 51, 107, 245, 148, 7, 225, 14, 127,
+			147, 247, 148, 121, 226, 68, 211, 113, 63, 125, 198, 65, 184, 83,
+			221, 16, 201, 117, 160, 53, 249, 108, 106, 58, 51, 241, 222, 201,
+			171, 194, 0, 76, 237, 63, 209, 11, 143, 157, 168, 90, 45, 45,
+			101, 240, 123, 221, 100, 51, 12, 244, 84, 247, 93, 166, 169, 233,
+			93, 47, 171, 239, 136, 228, 106, 186, 234, 83, 211, 200, 233, 111,
+			173, 222, 189, 195, 223, 115, 219, 109, 47, 216, 160, 148, 47, 7,
+			242, 73, 51, 140, 90, 110, 82, 65, 177, 47, 29, 75, 210, 109,
+			227, 65, 215, 35, 182, 200, 131, 67, 73, 12, 20, 143, 159, 231,
+			58, 125, 100, 87, 32, 185, 184, 9, 247, 98, 236, 147, 170, 167,
+			208, 89, 249, 115, 144, 26, 190, 152, 249, 188, 21, 6, 201, 230,
+			23, 51, 159, 55, 220, 238, 23, 107, 159, 195, 209, 253, 197, 226,
+			231, 45, 47, 248, 98, 241, 243, 88, 212, 191, 248, 184, 250, 57,
+			8, 75, 192, 111, 191, 248, 133, 7, 101, 202, 119, 54, 69, 36,
+			184, 172, 13, 13, 185, 254, 142, 219, 141, 181, 200, 11, 130, 54,
+			74, 2, 77, 144, 1, 26, 222, 134, 151, 196, 32, 210, 248, 130,
+			171, 158, 42, 28, 187, 170, 80, 46, 59, 171, 112, 236, 173, 130,
+			114, 25, 118, 137, 82, 201, 103, 34, 10, 103, 218, 110, 3, 16,
+			2, 135, 246, 78, 168, 91, 19, 110, 125, 19, 230, 37, 140, 20,
+			7, 210, 159, 98, 40, 21, 37, 63, 213, 221, 128, 111, 132, 188,
+			211, 134, 67, 252, 146, 174, 58, 229, 85, 69, 85, 61, 156, 223,
+			95, 214, 155, 174, 80, 236, 63, 108, 3, 228, 250, 178, 167, 242,
+			131, 50, 143, 59, 205, 166, 247, 8, 164, 81, 175, 238, 130, 120,
+			5, 171, 8, 68, 130, 114, 232, 84, 249, 254, 218, 213, 242, 244,
+			229, 158, 167, 148, 123, 169, 10, 83, 229, 75, 32, 249, 37, 225,
+			89, 73, 12, 177, 136, 60, 215, 247, 62, 19, 17, 143, 55, 195,
+			142, 223, 208, 168, 4, 101, 236, 254, 218, 85, 62, 229, 198, 166,
+			55, 80, 128, 40, 47, 63, 40, 79, 195, 2, 4, 188, 29, 121,
+			129, 20, 104, 246, 146, 18, 32, 210, 237, 233, 170, 237, 70, 113,
+			218, 77, 77, 80, 142, 18, 29, 200, 55, 117, 84, 245, 106, 97,
+			178, 137, 242, 43, 212, 13, 81, 135, 209, 115, 136, 247, 140, 3,
+			212, 164, 176, 217, 140, 69, 130, 194, 218, 141, 16, 20, 30, 220,
+			107, 21, 94, 94, 152, 155, 191, 56, 51, 55, 63, 51, 127, 126,
+			109, 110, 126, 241, 236, 220, 226, 252, 249, 234, 220, 252, 131, 178,
+			18, 202, 99, 142, 176, 57, 92, 218, 110, 156, 80, 142, 37, 177,
+			255, 48, 72, 165, 230, 243, 21, 14, 173, 85, 213, 6, 114, 183,
+			221, 213, 122, 228, 181, 147, 10, 200, 186, 61, 130, 154, 203, 225,
+			112, 228, 97, 237, 19, 1, 2, 72, 168, 116, 89, 73, 236, 82,
+			50, 69, 242, 7, 110, 213, 112, 163, 6, 229, 31, 39, 225, 242,
+			234, 221, 85, 220, 100, 83, 211, 251, 136, 167, 213, 86, 248, 153,
+			231, 251, 46, 202, 118, 34, 152, 185, 191, 58, 219, 8, 235, 241,
+			236, 135, 162, 54, 155, 14, 101, 118, 69, 52, 69, 36, 130, 186,
+			152, 125, 199, 15, 107, 174, 191, 126, 23, 199, 16, 207, 194, 128,
+			102, 51, 157, 76, 83, 222, 18, 201, 102, 216, 168, 2, 55, 144,
+			156, 166, 194, 93, 51, 36, 254, 16, 228, 69, 64, 122, 85, 255,
+			120, 168, 39, 4, 83, 173, 9, 61, 91, 209, 160, 251, 78, 145,
+			242, 143, 31, 198, 73, 212, 196, 170, 153, 25, 133, 245, 184, 218,
+			198, 254, 112, 46, 11, 179, 190, 87, 139, 220, 168, 139, 66, 119,
+			117, 51, 105, 249, 167, 240, 151, 174, 59, 141, 170, 62, 53, 132,
+			172, 59, 1, 61, 149, 79, 158, 254, 104, 230, 116, 107, 230, 116,
+			99, 237, 244, 205, 197, 211, 239, 45, 158, 94, 173, 158, 110, 62,
+			152, 172, 242, 219, 222, 150, 216, 241, 192, 234, 224, 193, 18, 110,
+			187, 233, 42, 117, 98, 33, 91, 187, 21, 54, 92, 36, 214, 201,
+			152, 127, 252, 112, 121, 245, 174, 22, 105, 110, 96, 15, 56, 113,
+			37, 102, 253, 194, 20, 213, 246, 130, 79, 194, 134, 59, 3, 3,
+			171, 198, 97, 39, 170, 131, 52, 178, 33, 170, 129, 72, 102, 221,
+			182, 135, 107, 2, 211, 130, 82, 56, 163, 89, 57, 220, 217, 189,
+			205, 227, 84, 211, 62, 40, 159, 6, 60, 26, 227, 133, 172, 151,
+			136, 136, 215, 221, 54, 238, 143, 176, 201, 55, 68, 32, 34, 87,
+			238, 52, 189, 203, 96, 87, 102, 209, 95, 165, 240, 143, 56, 57,
+			139, 145, 164, 56, 68, 255, 77, 139, 58, 78, 206, 206, 49, 242,
+			200, 30, 41, 253, 117, 139, 175, 164, 186, 173, 166, 251, 176, 137,
+			228, 14, 3, 230, 177, 23, 212, 179, 242, 21, 221, 95, 192, 226,
+			239, 117, 226, 132, 215, 196, 19, 21, 34, 186, 159, 70, 244, 128,
+			123, 65, 221, 239, 196, 222, 54, 168, 136, 7, 104, 30, 70, 151,
+			135, 225, 245, 105, 200, 98, 228, 81, 241, 176, 134, 8, 35, 143,
+			216, 48, 253, 39, 114, 34, 22, 35, 191, 100, 179, 210, 127, 101,
+			241, 59, 97, 48, 19, 136, 13, 169, 253, 106, 238, 139, 147, 113,
+			213, 204, 64, 15, 222, 151, 175, 86, 249, 29, 85, 209, 168, 149,
+			219, 174, 223, 17, 49, 82, 91, 166, 177, 22, 204, 50, 78, 60,
+			223, 231, 155, 238, 182, 224, 65, 182, 79, 108, 90, 85, 4, 154,
+			114, 19, 165, 150, 55, 195, 8, 212, 97, 109, 51, 216, 141, 44,
+			165, 42, 86, 212, 255, 232, 62, 8, 177, 242, 48, 77, 141, 16,
+			11, 38, 93, 60, 168, 33, 194, 200, 47, 13, 14, 213, 10, 146,
+			169, 210, 95, 161, 244, 216, 110, 219, 165, 104, 181, 147, 238, 227,
+			236, 150, 125, 52, 127, 29, 222, 95, 249, 98, 127, 19, 36, 197,
+			183, 218, 252, 88, 125, 70, 243, 35, 118, 249, 92, 166, 199, 191,
+			95, 148, 166, 199, 242, 224, 75, 211, 227, 75, 211, 227, 63, 111,
+			211, 227, 117, 109, 101, 132, 159, 218, 244, 104, 172, 140, 195, 198,
+			202, 56, 146, 123, 93, 91, 25, 225, 167, 54, 61, 26, 43, 227,
+			168, 177, 50, 142, 165, 86, 198, 49, 99, 101, 60, 146, 90, 25,
+			225, 167, 54, 61, 26, 195, 39, 252, 252, 15, 109, 52, 61, 146,
+			114, 110, 176, 244, 119, 108, 190, 36, 25, 187, 87, 231, 184, 135,
+			120, 75, 196, 49, 88, 94, 145, 163, 128, 97, 28, 206, 173, 72,
+			204, 32, 113, 134, 220, 221, 14, 189, 6, 111, 136, 166, 135, 168,
+			105, 116, 144, 26, 240, 36, 238, 169, 143, 39, 67, 23, 36, 223,
+			165, 123, 203, 49, 8, 124, 73, 183, 237, 213, 93, 95, 11, 72,
+			32, 15, 38, 33, 210, 188, 151, 128, 125, 18, 80, 8, 132, 38,
+			64, 12, 82, 171, 18, 137, 184, 29, 2, 149, 192, 94, 7, 154,
+			118, 3, 190, 116, 111, 217, 200, 15, 32, 112, 121, 168, 121, 213,
+			197, 162, 162, 240, 88, 68, 219, 94, 93, 240, 27, 97, 200, 63,
+			215, 186, 82, 212, 174, 243, 43, 110, 52, 181, 139, 219, 84, 145,
+			217, 76, 243, 72, 36, 157, 40, 136, 249, 99, 222, 43, 109, 251,
+			139, 204, 233, 86, 46, 30, 52, 60, 240, 151, 143, 209, 227, 187,
+			121, 160, 180, 0, 61, 142, 9, 254, 27, 22, 45, 172, 98, 9,
+			118, 153, 22, 154, 158, 240, 209, 115, 67, 166, 6, 22, 78, 237,
+			230, 136, 85, 89, 176, 122, 3, 75, 93, 15, 146, 168, 187, 162,
+			170, 148, 222, 167, 3, 153, 199, 108, 144, 146, 45, 209, 69, 23,
+			80, 255, 10, 252, 100, 21, 154, 199, 35, 2, 221, 63, 3, 11,
+			99, 123, 26, 255, 0, 222, 174, 200, 66, 139, 246, 55, 172, 242,
+			79, 109, 154, 199, 135, 236, 50, 165, 65, 199, 247, 215, 241, 29,
+			54, 122, 104, 161, 180, 167, 129, 59, 29, 223, 199, 242, 55, 115,
+			43, 253, 129, 6, 216, 41, 122, 32, 232, 180, 106, 34, 82, 213,
+			161, 127, 235, 102, 110, 101, 64, 62, 53, 133, 128, 53, 4, 27,
+			170, 16, 129, 129, 67, 33, 249, 84, 22, 122, 133, 210, 90, 24,
+			234, 97, 56, 220, 154, 42, 66, 87, 240, 76, 22, 120, 131, 30,
+			144, 216, 86, 69, 242, 56, 213, 35, 123, 70, 42, 241, 168, 154,
+			239, 212, 19, 51, 75, 223, 139, 117, 221, 2, 214, 221, 59, 203,
+			219, 94, 156, 152, 89, 250, 26, 184, 82, 160, 206, 150, 23, 52,
+			192, 25, 103, 74, 176, 42, 45, 96, 99, 122, 69, 31, 135, 116,
+			85, 234, 204, 49, 218, 111, 144, 200, 14, 81, 10, 38, 193, 245,
+			15, 150, 110, 223, 191, 62, 152, 187, 242, 221, 253, 79, 201, 1,
+			57, 25, 125, 76, 206, 62, 227, 49, 41, 39, 254, 92, 231, 228,
+			143, 71, 229, 57, 121, 231, 165, 139, 238, 165, 139, 238, 255, 27,
+			23, 221, 13, 125, 120, 194, 207, 39, 186, 232, 42, 169, 139, 174,
+			242, 85, 93, 116, 127, 151, 224, 57, 233, 148, 115, 167, 173, 210,
+			191, 67, 248, 67, 185, 229, 30, 246, 250, 231, 228, 142, 234, 192,
+			162, 55, 220, 196, 149, 2, 121, 5, 20, 212, 216, 139, 65, 18,
+			130, 83, 75, 114, 107, 170, 12, 50, 45, 23, 173, 46, 141, 110,
+			224, 182, 224, 68, 244, 187, 104, 172, 208, 90, 0, 106, 197, 113,
+			216, 18, 6, 197, 113, 197, 116, 78, 121, 11, 69, 211, 154, 224,
+			113, 167, 221, 14, 35, 109, 135, 224, 129, 84, 11, 204, 224, 16,
+			237, 213, 94, 147, 132, 23, 80, 30, 163, 225, 0, 6, 102, 154,
+			231, 190, 183, 37, 248, 173, 85, 51, 29, 32, 54, 211, 144, 50,
+			195, 5, 84, 233, 224, 210, 12, 212, 16, 137, 235, 249, 49, 204,
+			14, 37, 133, 222, 126, 209, 110, 212, 16, 208, 87, 13, 69, 195,
+			13, 1, 164, 70, 211, 125, 137, 236, 73, 207, 193, 80, 151, 30,
+			146, 246, 124, 161, 201, 102, 87, 211, 80, 214, 172, 133, 23, 75,
+			11, 146, 26, 90, 246, 124, 62, 68, 47, 105, 229, 243, 85, 155,
+			151, 42, 252, 126, 16, 70, 13, 1, 43, 5, 43, 16, 54, 31,
+			191, 2, 70, 51, 44, 64, 221, 17, 13, 89, 140, 188, 58, 122,
+			76, 67, 132, 145, 87, 79, 188, 66, 255, 12, 196, 41, 139, 57,
+			51, 185, 55, 173, 210, 207, 108, 254, 16, 249, 248, 46, 42, 121,
+			76, 79, 138, 32, 148, 81, 67, 138, 173, 148, 195, 33, 10, 246,
+			16, 121, 82, 86, 140, 5, 20, 126, 193, 169, 39, 220, 0, 126,
+			70, 162, 222, 137, 64, 111, 211, 139, 166, 72, 15, 56, 13, 136,
+			168, 49, 186, 122, 53, 77, 161, 193, 173, 209, 169, 139, 200, 60,
+			133, 85, 22, 143, 218, 162, 14, 52, 148, 132, 60, 22, 9, 26,
+			42, 112, 73, 5, 88, 42, 182, 93, 112, 181, 38, 32, 190, 213,
+			98, 48, 237, 104, 167, 179, 122, 97, 108, 97, 64, 31, 92, 68,
+			81, 24, 61, 117, 229, 20, 122, 244, 194, 225, 72, 208, 108, 64,
+			28, 208, 52, 103, 138, 7, 105, 153, 58, 142, 85, 204, 49, 103,
+			214, 126, 131, 148, 70, 144, 222, 224, 152, 53, 35, 87, 154, 170,
+			85, 132, 149, 158, 45, 30, 160, 147, 80, 3, 86, 122, 222, 153,
+			40, 149, 178, 86, 6, 64, 163, 239, 247, 86, 179, 115, 5, 40,
+			121, 80, 67, 22, 35, 243, 135, 198, 53, 68, 24, 153, 63, 118,
+			156, 78, 99, 147, 22, 35, 103, 157, 227, 165, 227, 189, 77, 54,
+			194, 14, 240, 245, 222, 70, 65, 107, 62, 235, 24, 8, 106, 14,
+			28, 209, 16, 97, 228, 108, 233, 152, 106, 212, 102, 228, 252, 222,
+			70, 149, 153, 187, 183, 81, 59, 15, 101, 13, 100, 49, 114, 222,
+			52, 106, 19, 70, 206, 151, 142, 209, 51, 216, 40, 97, 228, 162,
+			51, 94, 154, 232, 109, 84, 209, 203, 174, 161, 146, 60, 20, 46,
+			106, 200, 98, 228, 98, 255, 176, 134, 160, 161, 177, 35, 116, 6,
+			91, 117, 24, 185, 228, 28, 47, 113, 190, 242, 24, 166, 215, 219,
+			176, 83, 128, 242, 6, 178, 24, 185, 100, 134, 235, 16, 70, 46,
+			149, 142, 169, 134, 243, 140, 92, 118, 38, 118, 55, 28, 137, 182,
+			64, 211, 170, 218, 69, 166, 225, 124, 1, 202, 235, 21, 203, 91,
+			140, 92, 54, 43, 150, 39, 140, 92, 62, 118, 156, 254, 45, 139,
+			218, 249, 28, 115, 174, 230, 110, 88, 165, 31, 91, 252, 161, 145,
+			170, 30, 2, 169, 187, 96, 93, 218, 240, 69, 18, 6, 92, 4,
+			157, 22, 26, 179, 194, 0, 88, 177, 161, 83, 32, 252, 12, 193,
+			104, 190, 68, 13, 217, 194, 230, 229, 157, 0, 88, 235, 211, 8,
+			189, 183, 119, 220, 15, 15, 161, 105, 112, 32, 81, 74, 242, 64,
+			187, 87, 243, 135, 104, 137, 58, 121, 52, 145, 93, 183, 135, 74,
+			7, 249, 157, 93, 228, 10, 239, 44, 70, 174, 219, 7, 52, 100,
+			51, 114, 253, 240, 32, 253, 85, 139, 218, 142, 205, 156, 119, 115,
+			119, 172, 210, 54, 127, 104, 4, 80, 53, 219, 157, 200, 109, 183,
+			69, 196, 221, 40, 236, 4, 141, 44, 114, 241, 56, 50, 155, 41,
+			126, 234, 76, 122, 91, 198, 153, 184, 81, 228, 118, 213, 182, 181,
+			45, 70, 222, 45, 14, 209, 69, 234, 56, 54, 76, 228, 61, 251,
+			120, 105, 134, 175, 236, 233, 238, 41, 252, 214, 182, 115, 14, 84,
+			54, 80, 129, 145, 247, 6, 6, 53, 100, 49, 242, 222, 208, 17,
+			13, 17, 70, 222, 43, 29, 51, 42, 216, 159, 188, 70, 223, 218,
+			8, 171, 245, 205, 40, 108, 121, 157, 22, 74, 192, 126, 167, 238,
+			205, 198, 245, 77, 209, 232, 248, 34, 2, 19, 105, 6, 218, 158,
+			159, 77, 34, 111, 99, 3, 162, 47, 176, 13, 214, 111, 94, 150,
+			158, 168, 205, 149, 255, 145, 77, 251, 214, 100, 93, 118, 136, 218,
+			94, 67, 41, 92, 182, 215, 128, 112, 187, 196, 75, 124, 169, 111,
+			245, 175, 72, 0, 244, 178, 78, 228, 143, 19, 169, 151, 117, 34,
+			159, 85, 168, 83, 143, 194, 96, 124, 74, 169, 101, 166, 239, 234,
+			213, 40, 12, 84, 235, 55, 115, 43, 88, 138, 205, 210, 252, 142,
+			168, 117, 188, 241, 105, 165, 218, 164, 197, 63, 20, 181, 251, 203,
+			105, 121, 89, 14, 154, 15, 194, 176, 61, 190, 176, 167, 249, 59,
+			97, 216, 206, 52, 15, 165, 216, 121, 218, 183, 225, 37, 158, 47,
+			226, 241, 179, 88, 225, 104, 166, 194, 59, 242, 77, 90, 71, 151,
+			101, 75, 116, 0, 157, 169, 181, 78, 125, 75, 36, 227, 231, 176,
+			234, 68, 166, 234, 149, 244, 109, 90, 61, 91, 231, 74, 63, 237,
+			107, 187, 93, 63, 116, 27, 229, 25, 58, 144, 153, 58, 59, 65,
+			169, 54, 56, 135, 129, 10, 106, 204, 60, 41, 31, 162, 7, 178,
+			83, 47, 159, 164, 3, 153, 169, 49, 70, 29, 16, 199, 212, 202,
+			224, 239, 242, 79, 44, 122, 168, 119, 54, 80, 44, 18, 237, 80,
+			23, 131, 223, 176, 88, 145, 104, 170, 5, 36, 145, 104, 178, 18,
+			45, 70, 98, 219, 3, 193, 86, 173, 161, 129, 217, 69, 74, 219,
+			17, 4, 241, 36, 158, 136, 199, 157, 39, 170, 158, 43, 153, 162,
+			208, 117, 226, 110, 196, 227, 121, 78, 160, 107, 248, 93, 118, 41,
+			219, 139, 179, 93, 93, 88, 207, 223, 133, 157, 118, 113, 229, 242,
+			131, 75, 207, 185, 79, 46, 27, 224, 214, 63, 122, 69, 234, 130,
+			129, 69, 255, 44, 213, 5, 127, 222, 171, 11, 206, 95, 64, 110,
+			114, 251, 254, 213, 101, 190, 212, 73, 54, 195, 40, 126, 169, 1,
+			190, 212, 0, 159, 172, 1, 50, 165, 131, 177, 220, 61, 173, 236,
+			169, 159, 36, 7, 218, 222, 107, 244, 111, 192, 113, 151, 99, 206,
+			209, 220, 121, 171, 244, 27, 22, 87, 187, 67, 123, 4, 69, 203,
+			75, 148, 46, 164, 248, 58, 140, 39, 113, 227, 173, 152, 79, 197,
+			29, 8, 75, 140, 185, 218, 253, 242, 241, 52, 76, 55, 217, 140,
+			194, 206, 198, 38, 69, 171, 34, 120, 124, 65, 113, 235, 180, 122,
+			26, 18, 141, 221, 237, 100, 54, 169, 106, 75, 29, 133, 112, 166,
+			31, 45, 30, 166, 255, 45, 73, 85, 143, 193, 210, 127, 74, 32,
+			100, 232, 211, 142, 224, 30, 68, 110, 122, 77, 79, 74, 225, 128,
+			77, 213, 7, 236, 144, 101, 136, 78, 0, 131, 40, 82, 72, 67,
+			24, 219, 42, 24, 157, 249, 38, 202, 223, 237, 40, 220, 246, 26,
+			216, 80, 171, 29, 38, 34, 168, 119, 241, 140, 118, 27, 13, 244,
+			123, 186, 166, 65, 126, 221, 173, 111, 242, 79, 194, 26, 223, 132,
+			40, 138, 64, 134, 41, 6, 174, 207, 107, 157, 102, 83, 68, 114,
+			131, 44, 95, 139, 97, 36, 145, 168, 163, 244, 163, 78, 68, 238,
+			37, 16, 43, 88, 23, 222, 54, 56, 235, 21, 170, 193, 68, 235,
+			38, 210, 21, 229, 250, 145, 112, 27, 93, 94, 19, 34, 224, 49,
+			252, 31, 104, 124, 177, 231, 139, 32, 241, 187, 60, 222, 242, 218,
+			109, 172, 186, 41, 168, 238, 208, 139, 193, 146, 238, 133, 13, 37,
+			2, 212, 125, 225, 6, 162, 1, 174, 2, 30, 250, 13, 28, 139,
+			90, 77, 156, 90, 226, 97, 177, 72, 0, 74, 42, 224, 227, 130,
+			165, 66, 143, 137, 114, 223, 3, 43, 136, 132, 223, 229, 32, 198,
+			109, 162, 251, 209, 120, 187, 20, 166, 112, 33, 119, 54, 67, 177,
+			13, 116, 222, 130, 208, 135, 44, 218, 249, 85, 217, 161, 27, 116,
+			147, 77, 160, 24, 55, 225, 46, 170, 95, 49, 149, 113, 77, 224,
+			241, 151, 11, 131, 75, 168, 100, 21, 233, 53, 124, 213, 46, 102,
+			188, 134, 175, 246, 15, 104, 8, 116, 195, 67, 135, 233, 3, 237,
+			52, 156, 180, 135, 75, 239, 241, 187, 42, 144, 2, 214, 56, 226,
+			205, 200, 19, 65, 195, 239, 242, 192, 109, 233, 221, 5, 54, 113,
+			69, 215, 136, 235, 120, 19, 34, 218, 58, 109, 224, 23, 171, 154,
+			13, 243, 251, 203, 102, 20, 160, 116, 76, 154, 81, 128, 2, 53,
+			217, 127, 72, 67, 132, 145, 201, 33, 70, 223, 196, 81, 216, 140,
+			156, 177, 135, 74, 115, 233, 40, 110, 174, 173, 221, 131, 216, 212,
+			45, 36, 55, 47, 110, 251, 110, 247, 177, 29, 129, 34, 114, 198,
+			116, 4, 115, 58, 211, 127, 64, 67, 132, 145, 51, 135, 7, 233,
+			191, 132, 78, 82, 80, 220, 170, 246, 57, 82, 218, 230, 75, 245,
+			164, 227, 250, 102, 74, 112, 10, 87, 249, 50, 44, 1, 136, 209,
+			232, 68, 16, 49, 223, 12, 119, 178, 107, 194, 119, 192, 225, 41,
+			87, 176, 142, 113, 52, 20, 214, 16, 74, 152, 145, 85, 128, 230,
+			120, 61, 140, 164, 131, 0, 104, 31, 138, 39, 161, 209, 221, 81,
+			53, 172, 22, 15, 211, 1, 24, 19, 168, 74, 179, 78, 73, 141,
+			151, 20, 0, 210, 46, 94, 2, 58, 228, 160, 86, 242, 9, 148,
+			60, 114, 84, 85, 115, 24, 153, 115, 142, 171, 87, 160, 220, 204,
+			57, 131, 26, 178, 24, 153, 27, 26, 211, 16, 97, 100, 238, 232,
+			49, 85, 45, 15, 234, 165, 238, 13, 84, 151, 121, 211, 27, 168,
+			46, 243, 166, 55, 80, 93, 230, 77, 111, 5, 70, 22, 28, 174,
+			94, 21, 16, 98, 26, 178, 24, 89, 24, 214, 77, 22, 8, 35,
+			11, 19, 175, 168, 106, 125, 160, 119, 78, 169, 87, 125, 5, 128,
+			244, 176, 250, 64, 11, 61, 82, 214, 16, 104, 161, 167, 39, 233,
+			207, 149, 201, 226, 237, 220, 146, 85, 250, 247, 109, 158, 145, 187,
+			12, 7, 210, 220, 194, 239, 2, 242, 83, 146, 128, 179, 11, 220,
+			55, 45, 224, 23, 122, 59, 3, 155, 137, 83, 22, 150, 229, 197,
+			16, 45, 30, 133, 1, 4, 119, 36, 130, 183, 220, 250, 166, 7,
+			129, 52, 16, 185, 131, 91, 210, 75, 120, 67, 212, 189, 134, 10,
+			34, 50, 178, 6, 213, 33, 58, 190, 219, 9, 234, 242, 36, 247,
+			130, 237, 176, 142, 135, 9, 244, 117, 39, 76, 196, 34, 71, 150,
+			172, 40, 7, 217, 199, 36, 238, 126, 221, 78, 67, 115, 120, 12,
+			35, 135, 9, 101, 230, 178, 116, 111, 25, 57, 84, 151, 162, 88,
+			0, 234, 146, 27, 233, 200, 116, 56, 16, 180, 251, 41, 86, 188,
+			71, 113, 121, 216, 102, 111, 23, 135, 105, 85, 91, 29, 190, 105,
+			31, 41, 159, 228, 173, 48, 8, 147, 48, 80, 220, 205, 11, 234,
+			145, 112, 65, 229, 84, 134, 28, 173, 202, 2, 227, 248, 166, 242,
+			174, 75, 227, 195, 55, 139, 44, 99, 124, 248, 230, 232, 24, 253,
+			239, 149, 110, 119, 51, 183, 108, 149, 254, 161, 197, 179, 162, 110,
+			22, 195, 6, 141, 192, 81, 98, 94, 247, 189, 250, 22, 47, 171,
+			130, 101, 94, 235, 36, 160, 237, 122, 129, 100, 25, 95, 15, 202,
+			248, 20, 196, 86, 72, 63, 96, 61, 12, 154, 29, 56, 238, 167,
+			37, 34, 159, 15, 143, 192, 69, 110, 22, 71, 232, 47, 80, 216,
+			63, 206, 157, 220, 61, 171, 244, 62, 207, 136, 241, 134, 26, 123,
+			72, 16, 72, 115, 67, 43, 239, 34, 78, 226, 93, 42, 60, 58,
+			16, 245, 252, 84, 87, 176, 201, 239, 20, 135, 113, 199, 16, 88,
+			178, 187, 182, 196, 57, 65, 70, 126, 87, 113, 54, 130, 235, 113,
+			183, 255, 160, 134, 8, 35, 119, 7, 135, 232, 63, 128, 245, 112,
+			152, 243, 173, 220, 67, 171, 244, 115, 75, 11, 18, 251, 172, 72,
+			173, 203, 101, 108, 142, 58, 45, 119, 220, 164, 190, 105, 4, 143,
+			93, 18, 6, 221, 43, 73, 0, 101, 175, 246, 44, 16, 28, 172,
+			123, 214, 27, 40, 20, 180, 149, 216, 75, 194, 168, 171, 118, 87,
+			125, 19, 66, 249, 209, 225, 189, 237, 185, 148, 95, 111, 121, 90,
+			137, 136, 97, 29, 20, 46, 28, 139, 145, 111, 21, 199, 104, 133,
+			58, 142, 3, 184, 248, 200, 102, 229, 87, 64, 68, 184, 191, 114,
+			27, 164, 1, 180, 195, 168, 219, 1, 178, 205, 134, 36, 94, 7,
+			145, 245, 145, 66, 150, 131, 200, 250, 72, 33, 203, 193, 83, 239,
+			163, 193, 33, 122, 13, 219, 181, 24, 121, 96, 15, 149, 47, 162,
+			225, 161, 217, 211, 24, 6, 70, 53, 209, 202, 41, 170, 27, 85,
+			94, 142, 68, 51, 158, 221, 20, 110, 35, 158, 109, 185, 113, 34,
+			162, 178, 238, 15, 206, 183, 7, 166, 63, 216, 120, 15, 212, 177,
+			227, 224, 249, 246, 224, 240, 32, 158, 111, 142, 109, 51, 242, 177,
+			61, 86, 158, 195, 254, 164, 178, 198, 167, 86, 111, 46, 205, 67,
+			103, 155, 226, 209, 180, 188, 74, 3, 194, 92, 168, 57, 83, 36,
+			154, 186, 35, 56, 223, 62, 54, 29, 1, 101, 126, 220, 63, 164,
+			33, 194, 200, 199, 35, 163, 244, 91, 216, 17, 97, 228, 23, 237,
+			169, 210, 187, 252, 158, 209, 180, 80, 64, 3, 245, 10, 218, 118,
+			27, 13, 232, 18, 36, 50, 109, 95, 10, 129, 229, 41, 229, 85,
+			168, 91, 20, 208, 127, 61, 108, 181, 188, 68, 29, 89, 14, 158,
+			75, 191, 104, 143, 107, 200, 98, 228, 23, 143, 158, 210, 16, 116,
+			251, 218, 36, 18, 176, 99, 59, 140, 172, 219, 199, 212, 43, 7,
+			33, 221, 136, 147, 103, 100, 125, 64, 15, 29, 214, 122, 157, 141,
+			105, 136, 48, 178, 126, 180, 68, 255, 26, 184, 54, 242, 204, 217,
+			202, 5, 86, 233, 127, 183, 179, 84, 248, 12, 36, 173, 156, 244,
+			220, 85, 83, 2, 137, 210, 131, 152, 77, 8, 141, 200, 202, 207,
+			251, 18, 247, 90, 230, 164, 175, 135, 1, 104, 98, 16, 43, 32,
+			227, 199, 20, 190, 218, 110, 140, 155, 26, 112, 20, 136, 157, 158,
+			134, 176, 175, 148, 153, 237, 128, 152, 132, 207, 100, 141, 116, 248,
+			74, 79, 17, 13, 30, 139, 182, 11, 152, 247, 187, 70, 227, 81,
+			204, 233, 147, 176, 134, 67, 110, 122, 27, 29, 197, 81, 166, 96,
+			143, 161, 29, 28, 100, 103, 213, 30, 8, 208, 24, 11, 58, 173,
+			54, 87, 134, 181, 169, 136, 5, 232, 162, 37, 18, 216, 106, 178,
+			9, 85, 213, 11, 54, 12, 67, 109, 119, 106, 190, 87, 135, 122,
+			211, 106, 47, 130, 56, 176, 85, 44, 225, 178, 230, 97, 47, 250,
+			182, 60, 201, 243, 104, 164, 246, 21, 53, 228, 113, 171, 249, 138,
+			26, 242, 184, 213, 124, 69, 13, 121, 32, 214, 150, 162, 134, 188,
+			109, 57, 0, 81, 13, 229, 25, 105, 41, 106, 200, 227, 254, 105,
+			41, 106, 200, 227, 254, 105, 29, 45, 25, 27, 218, 255, 125, 147,
+			190, 253, 188, 54, 52, 3, 236, 53, 162, 61, 41, 44, 172, 244,
+			21, 141, 117, 229, 111, 211, 129, 91, 97, 45, 94, 145, 164, 8,
+			247, 95, 219, 81, 8, 46, 32, 101, 202, 209, 32, 27, 163, 5,
+			112, 147, 132, 145, 50, 232, 40, 136, 29, 163, 253, 109, 119, 67,
+			172, 199, 222, 103, 2, 141, 58, 249, 149, 34, 60, 88, 245, 62,
+			19, 229, 123, 180, 95, 182, 222, 246, 187, 172, 76, 29, 16, 111,
+			148, 51, 255, 80, 198, 190, 117, 43, 172, 173, 224, 59, 246, 10,
+			29, 8, 196, 163, 100, 189, 167, 43, 10, 143, 174, 226, 147, 114,
+			135, 178, 101, 35, 189, 152, 97, 159, 161, 125, 159, 132, 181, 117,
+			48, 55, 193, 176, 7, 22, 134, 118, 181, 46, 154, 43, 133, 79,
+			240, 191, 47, 54, 17, 159, 14, 246, 116, 11, 243, 185, 72, 7,
+			82, 65, 74, 79, 107, 52, 211, 113, 90, 99, 37, 91, 242, 233,
+			147, 252, 47, 45, 58, 156, 61, 115, 244, 52, 223, 162, 125, 53,
+			56, 6, 133, 238, 237, 213, 76, 111, 251, 84, 168, 94, 129, 210,
+			43, 186, 18, 59, 78, 251, 77, 196, 41, 206, 158, 172, 164, 15,
+			74, 223, 166, 121, 44, 207, 42, 180, 79, 81, 137, 194, 38, 203,
+			116, 163, 186, 88, 209, 69, 216, 105, 181, 172, 54, 39, 251, 35,
+			30, 95, 151, 207, 209, 130, 132, 159, 64, 99, 131, 148, 124, 18,
+			214, 20, 66, 224, 103, 249, 33, 61, 152, 193, 162, 104, 62, 215,
+			74, 159, 162, 7, 83, 180, 175, 123, 13, 53, 229, 3, 233, 195,
+			229, 70, 249, 215, 45, 74, 110, 133, 181, 231, 106, 184, 68, 139,
+			250, 157, 26, 172, 129, 217, 52, 205, 163, 16, 129, 123, 97, 96,
+			97, 184, 183, 149, 85, 120, 181, 34, 75, 0, 37, 182, 93, 16,
+			203, 208, 220, 89, 92, 81, 80, 121, 146, 22, 117, 81, 160, 202,
+			142, 183, 14, 229, 59, 177, 66, 87, 177, 227, 65, 51, 157, 184,
+			252, 55, 109, 74, 83, 244, 176, 183, 233, 161, 204, 124, 211, 153,
+			140, 103, 198, 208, 131, 205, 149, 12, 126, 0, 97, 19, 148, 198,
+			137, 11, 174, 240, 245, 36, 214, 4, 162, 158, 172, 33, 221, 66,
+			180, 90, 188, 41, 223, 19, 124, 79, 245, 163, 181, 152, 157, 164,
+			7, 20, 85, 136, 198, 122, 173, 139, 211, 234, 95, 25, 48, 207,
+			174, 116, 97, 206, 106, 50, 121, 156, 140, 130, 192, 222, 223, 244,
+			2, 215, 199, 192, 161, 226, 138, 4, 216, 36, 61, 44, 15, 149,
+			117, 45, 138, 140, 247, 97, 181, 67, 242, 241, 138, 182, 30, 31,
+			165, 197, 109, 79, 236, 172, 131, 119, 160, 136, 37, 250, 0, 190,
+			31, 249, 11, 191, 230, 208, 126, 35, 243, 178, 139, 180, 239, 29,
+			145, 0, 83, 98, 89, 107, 126, 134, 7, 150, 70, 246, 60, 135,
+			221, 126, 155, 30, 122, 71, 36, 41, 250, 98, 54, 177, 47, 90,
+			77, 51, 199, 30, 247, 26, 90, 251, 38, 61, 216, 211, 26, 123,
+			236, 26, 149, 246, 231, 40, 236, 60, 45, 222, 3, 114, 1, 218,
+			221, 75, 170, 165, 189, 177, 82, 24, 161, 199, 46, 208, 254, 21,
+			17, 119, 90, 207, 91, 239, 60, 45, 46, 213, 194, 40, 121, 206,
+			106, 87, 233, 97, 172, 246, 76, 51, 125, 92, 35, 55, 232, 129,
+			44, 115, 99, 39, 50, 45, 100, 95, 104, 204, 63, 166, 157, 175,
+			102, 178, 255, 201, 37, 218, 199, 242, 135, 114, 127, 105, 189, 180,
+			217, 191, 180, 217, 255, 11, 181, 217, 159, 134, 167, 160, 120, 143,
+			230, 110, 210, 107, 212, 46, 160, 245, 222, 181, 74, 223, 200, 40,
+			243, 226, 81, 59, 4, 3, 75, 42, 26, 235, 197, 77, 203, 168,
+			136, 94, 41, 50, 23, 64, 18, 62, 90, 28, 162, 191, 108, 83,
+			167, 128, 142, 115, 110, 207, 150, 254, 23, 139, 43, 6, 201, 155,
+			2, 101, 13, 176, 227, 114, 56, 195, 121, 236, 38, 94, 220, 236,
+			194, 100, 51, 12, 19, 244, 21, 14, 124, 184, 230, 249, 94, 210,
+			229, 75, 87, 111, 35, 209, 47, 55, 179, 165, 170, 234, 144, 239,
+			213, 40, 106, 157, 68, 71, 49, 225, 203, 70, 40, 226, 96, 50,
+			225, 226, 145, 23, 39, 21, 25, 73, 157, 198, 224, 64, 115, 80,
+			95, 70, 25, 67, 24, 58, 229, 75, 48, 52, 120, 136, 35, 128,
+			112, 104, 57, 107, 48, 30, 137, 8, 109, 246, 101, 179, 139, 171,
+			48, 13, 184, 8, 90, 206, 172, 150, 84, 27, 1, 3, 22, 35,
+			188, 112, 80, 67, 54, 35, 252, 208, 81, 13, 17, 70, 248, 171,
+			51, 244, 151, 45, 68, 150, 197, 200, 105, 251, 90, 41, 230, 189,
+			103, 130, 65, 89, 122, 174, 170, 171, 44, 27, 222, 182, 8, 96,
+			168, 21, 222, 10, 227, 68, 59, 10, 154, 94, 20, 131, 222, 202,
+			1, 151, 94, 36, 158, 125, 176, 160, 130, 156, 46, 140, 104, 200,
+			102, 228, 244, 232, 164, 134, 8, 35, 167, 23, 174, 80, 129, 99,
+			69, 131, 245, 55, 74, 223, 234, 29, 107, 186, 186, 42, 156, 99,
+			151, 85, 240, 185, 7, 4, 218, 211, 153, 194, 176, 134, 160, 215,
+			145, 147, 26, 2, 195, 118, 229, 2, 253, 27, 146, 212, 8, 35,
+			231, 236, 139, 165, 95, 183, 185, 62, 194, 164, 173, 186, 29, 137,
+			109, 176, 60, 185, 157, 36, 108, 185, 144, 204, 66, 137, 14, 42,
+			252, 207, 5, 4, 2, 182, 222, 115, 131, 140, 61, 28, 222, 78,
+			161, 1, 100, 219, 115, 123, 141, 53, 43, 247, 174, 78, 3, 113,
+			200, 203, 63, 174, 239, 135, 59, 162, 81, 229, 75, 65, 151, 67,
+			250, 15, 116, 245, 132, 17, 143, 58, 1, 70, 225, 103, 151, 13,
+			204, 69, 178, 154, 120, 36, 234, 192, 150, 160, 103, 51, 96, 160,
+			84, 184, 108, 178, 137, 245, 154, 154, 10, 181, 63, 71, 138, 114,
+			79, 68, 36, 22, 217, 23, 149, 176, 215, 207, 21, 14, 105, 200,
+			102, 228, 220, 225, 81, 13, 1, 242, 248, 121, 250, 175, 72, 66,
+			116, 24, 185, 108, 127, 163, 244, 5, 55, 167, 58, 24, 9, 59,
+			45, 224, 4, 208, 126, 67, 163, 44, 125, 255, 152, 145, 195, 209,
+			242, 12, 163, 150, 173, 239, 59, 108, 176, 145, 92, 46, 28, 214,
+			144, 205, 200, 229, 193, 49, 13, 65, 108, 209, 201, 11, 244, 175,
+			36, 5, 228, 33, 16, 231, 98, 233, 31, 219, 92, 75, 21, 48,
+			108, 161, 156, 74, 48, 34, 136, 163, 83, 61, 55, 164, 89, 174,
+			194, 93, 40, 11, 248, 118, 245, 61, 107, 223, 172, 35, 15, 35,
+			186, 207, 58, 194, 4, 221, 160, 171, 237, 28, 112, 103, 217, 5,
+			150, 227, 197, 176, 90, 177, 215, 242, 124, 23, 141, 241, 187, 196,
+			20, 125, 174, 98, 241, 93, 239, 168, 62, 233, 178, 251, 156, 47,
+			95, 67, 70, 104, 56, 88, 38, 7, 72, 175, 205, 93, 78, 3,
+			92, 3, 49, 229, 46, 122, 118, 252, 46, 247, 33, 250, 207, 120,
+			14, 85, 70, 20, 133, 140, 39, 46, 8, 182, 182, 239, 122, 228,
+			33, 220, 201, 144, 81, 30, 195, 157, 52, 25, 129, 195, 228, 58,
+			63, 79, 191, 148, 235, 81, 0, 67, 238, 245, 210, 255, 97, 237,
+			193, 3, 54, 31, 103, 57, 88, 102, 42, 138, 205, 187, 65, 118,
+			122, 40, 153, 4, 120, 13, 185, 81, 217, 211, 94, 150, 250, 84,
+			117, 16, 110, 118, 32, 10, 18, 140, 126, 80, 156, 187, 233, 65,
+			187, 217, 233, 89, 207, 74, 42, 254, 104, 243, 153, 23, 196, 137,
+			112, 27, 20, 24, 173, 166, 166, 23, 196, 88, 1, 140, 214, 5,
+			141, 163, 130, 205, 200, 221, 177, 83, 26, 2, 19, 118, 245, 42,
+			253, 171, 60, 98, 172, 143, 145, 135, 246, 205, 210, 63, 206, 247,
+			50, 156, 118, 39, 137, 185, 74, 34, 212, 130, 28, 23, 106, 65,
+			129, 84, 146, 208, 16, 171, 122, 202, 63, 237, 8, 184, 175, 40,
+			79, 106, 154, 57, 24, 181, 35, 104, 13, 174, 177, 33, 87, 148,
+			100, 82, 135, 29, 26, 195, 50, 160, 153, 14, 149, 51, 197, 160,
+			60, 72, 197, 179, 150, 245, 253, 160, 23, 161, 37, 162, 13, 65,
+			121, 171, 227, 39, 94, 219, 223, 61, 32, 109, 159, 83, 77, 192,
+			5, 226, 154, 11, 108, 35, 12, 140, 63, 17, 222, 123, 113, 106,
+			223, 147, 28, 2, 214, 61, 129, 164, 62, 113, 98, 90, 233, 57,
+			190, 245, 221, 163, 204, 73, 28, 132, 25, 172, 115, 112, 127, 104,
+			68, 120, 42, 133, 1, 184, 245, 35, 115, 127, 9, 246, 168, 218,
+			45, 85, 126, 23, 196, 53, 121, 31, 56, 115, 197, 9, 138, 220,
+			185, 187, 6, 45, 185, 65, 172, 47, 239, 47, 194, 190, 247, 18,
+			222, 196, 216, 234, 150, 215, 216, 113, 187, 234, 140, 168, 117, 149,
+			252, 0, 76, 67, 123, 231, 80, 52, 2, 41, 10, 66, 111, 225,
+			190, 62, 198, 141, 27, 84, 1, 30, 209, 93, 47, 221, 244, 157,
+			154, 178, 232, 194, 134, 199, 146, 74, 54, 175, 42, 199, 93, 236,
+			54, 5, 44, 79, 36, 146, 168, 75, 13, 22, 42, 24, 167, 237,
+			119, 245, 37, 251, 216, 77, 59, 1, 175, 125, 38, 66, 192, 72,
+			240, 88, 6, 60, 250, 112, 66, 82, 240, 47, 101, 2, 26, 26,
+			85, 188, 135, 167, 106, 153, 43, 103, 77, 77, 124, 42, 184, 252,
+			137, 187, 65, 245, 191, 239, 126, 0, 183, 231, 195, 2, 211, 144,
+			205, 200, 195, 225, 215, 52, 68, 24, 121, 56, 127, 131, 82, 25,
+			78, 82, 207, 121, 150, 9, 221, 168, 23, 135, 233, 59, 58, 114,
+			67, 216, 163, 165, 69, 190, 220, 132, 93, 159, 161, 240, 48, 226,
+			229, 114, 5, 133, 75, 37, 253, 197, 147, 64, 68, 242, 228, 205,
+			136, 120, 42, 20, 32, 15, 45, 105, 191, 57, 200, 174, 162, 95,
+			187, 144, 193, 138, 43, 134, 71, 148, 27, 215, 98, 164, 105, 98,
+			205, 193, 239, 209, 52, 213, 64, 104, 106, 246, 107, 23, 50, 216,
+			109, 155, 108, 152, 94, 215, 126, 253, 77, 123, 172, 244, 13, 110,
+			172, 128, 176, 146, 233, 57, 3, 163, 55, 30, 91, 181, 246, 112,
+			138, 108, 4, 97, 148, 25, 39, 248, 63, 54, 205, 157, 95, 16,
+			138, 54, 139, 67, 26, 34, 140, 108, 142, 140, 34, 202, 44, 233,
+			53, 48, 126, 208, 173, 226, 16, 142, 223, 178, 115, 169, 185, 218,
+			194, 72, 78, 95, 89, 161, 45, 105, 202, 30, 56, 164, 33, 48,
+			101, 31, 214, 241, 198, 128, 4, 127, 236, 136, 106, 4, 12, 212,
+			246, 81, 245, 10, 144, 208, 82, 72, 144, 17, 213, 173, 254, 145,
+			76, 68, 117, 235, 200, 56, 142, 201, 102, 78, 59, 215, 177, 140,
+			79, 177, 173, 12, 234, 24, 140, 250, 169, 61, 170, 99, 70, 11,
+			140, 124, 170, 154, 179, 81, 106, 254, 84, 45, 133, 140, 39, 253,
+			84, 45, 133, 13, 109, 68, 106, 41, 108, 28, 69, 100, 170, 193,
+			40, 34, 181, 20, 54, 74, 172, 17, 27, 198, 155, 6, 40, 59,
+			38, 246, 88, 169, 146, 89, 138, 134, 104, 186, 29, 31, 228, 131,
+			144, 159, 159, 83, 241, 254, 94, 204, 91, 238, 35, 175, 213, 105,
+			41, 244, 219, 136, 254, 68, 161, 223, 198, 1, 36, 10, 253, 54,
+			162, 63, 81, 232, 39, 204, 217, 201, 125, 102, 25, 159, 230, 78,
+			113, 60, 245, 105, 62, 178, 95, 211, 62, 77, 7, 32, 170, 161,
+			2, 35, 143, 6, 70, 53, 4, 23, 220, 199, 78, 106, 8, 46,
+			184, 191, 122, 90, 53, 98, 49, 210, 85, 232, 39, 56, 241, 174,
+			154, 56, 65, 244, 119, 21, 250, 9, 78, 188, 123, 4, 250, 6,
+			191, 104, 254, 59, 185, 223, 176, 44, 227, 92, 252, 78, 241, 24,
+			61, 8, 126, 42, 146, 99, 249, 47, 236, 95, 182, 8, 214, 114,
+			8, 224, 252, 11, 122, 152, 30, 164, 5, 120, 9, 99, 254, 37,
+			103, 156, 30, 162, 125, 18, 44, 0, 60, 144, 194, 112, 243, 252,
+			192, 112, 10, 195, 221, 243, 177, 35, 166, 186, 197, 200, 119, 157,
+			9, 243, 26, 60, 31, 223, 117, 14, 164, 112, 129, 145, 239, 30,
+			76, 171, 195, 12, 190, 59, 146, 118, 7, 115, 248, 238, 177, 227,
+			40, 146, 162, 35, 212, 249, 210, 178, 39, 74, 223, 225, 75, 134,
+			185, 1, 163, 196, 189, 237, 37, 105, 202, 16, 222, 16, 190, 183,
+			141, 23, 73, 146, 16, 120, 212, 93, 184, 86, 2, 187, 206, 107,
+			193, 109, 22, 72, 3, 180, 43, 102, 10, 88, 3, 104, 131, 162,
+			193, 133, 27, 249, 16, 255, 5, 143, 192, 89, 230, 201, 43, 41,
+			161, 223, 16, 112, 121, 226, 32, 32, 10, 182, 15, 12, 198, 128,
+			5, 230, 124, 105, 13, 12, 106, 208, 2, 112, 104, 92, 131, 4,
+			192, 99, 199, 233, 255, 67, 148, 231, 213, 249, 117, 203, 30, 43,
+			253, 79, 132, 47, 5, 38, 123, 75, 154, 180, 65, 95, 187, 117,
+			99, 51, 79, 8, 85, 128, 98, 88, 8, 130, 159, 32, 218, 233,
+			81, 166, 10, 220, 28, 106, 121, 245, 72, 101, 51, 128, 27, 33,
+			113, 236, 109, 4, 105, 148, 135, 58, 137, 106, 93, 77, 243, 85,
+			224, 157, 168, 63, 86, 120, 75, 5, 101, 193, 241, 0, 77, 189,
+			62, 51, 127, 30, 114, 243, 105, 93, 95, 177, 43, 236, 16, 48,
+			122, 31, 205, 14, 120, 68, 65, 126, 4, 15, 198, 102, 70, 43,
+			57, 173, 190, 203, 83, 235, 102, 134, 25, 54, 185, 244, 189, 109,
+			138, 46, 148, 162, 114, 102, 160, 59, 93, 233, 2, 207, 14, 119,
+			244, 33, 86, 247, 61, 236, 49, 84, 188, 189, 171, 194, 175, 116,
+			91, 21, 190, 35, 120, 203, 221, 18, 61, 126, 116, 190, 114, 239,
+			106, 26, 124, 151, 224, 121, 157, 125, 13, 199, 101, 207, 233, 93,
+			49, 167, 40, 92, 127, 146, 119, 169, 147, 168, 11, 49, 101, 50,
+			66, 13, 198, 35, 30, 185, 245, 68, 30, 153, 233, 92, 146, 144,
+			111, 64, 84, 192, 166, 10, 116, 170, 39, 82, 62, 149, 51, 167,
+			26, 117, 26, 41, 134, 118, 172, 60, 174, 127, 159, 6, 145, 28,
+			138, 67, 26, 36, 0, 142, 140, 210, 121, 116, 1, 23, 126, 96,
+			229, 254, 117, 203, 42, 157, 2, 155, 199, 138, 104, 170, 40, 55,
+			191, 155, 134, 42, 198, 70, 127, 29, 144, 14, 75, 231, 7, 86,
+			17, 120, 185, 227, 228, 97, 211, 252, 208, 178, 71, 177, 241, 60,
+			68, 7, 56, 63, 180, 236, 162, 6, 45, 120, 219, 63, 168, 223,
+			18, 0, 135, 71, 84, 85, 139, 57, 63, 178, 236, 33, 85, 21,
+			70, 253, 163, 180, 170, 133, 111, 251, 15, 104, 144, 0, 120, 120,
+			144, 46, 83, 219, 41, 176, 194, 111, 89, 185, 127, 219, 178, 74,
+			151, 121, 42, 161, 63, 118, 240, 61, 66, 126, 70, 27, 135, 217,
+			20, 44, 230, 252, 150, 85, 28, 197, 217, 20, 96, 54, 63, 214,
+			179, 41, 0, 59, 114, 126, 172, 135, 84, 192, 217, 252, 88, 207,
+			166, 128, 187, 238, 199, 214, 240, 8, 253, 35, 96, 31, 5, 219,
+			98, 206, 79, 44, 187, 84, 250, 15, 172, 76, 127, 235, 30, 168,
+			75, 220, 85, 35, 3, 217, 85, 0, 153, 184, 45, 12, 45, 244,
+			253, 76, 89, 204, 161, 146, 85, 88, 170, 148, 223, 12, 119, 32,
+			220, 3, 137, 8, 249, 133, 226, 64, 217, 90, 189, 34, 87, 250,
+			6, 250, 174, 117, 18, 144, 189, 32, 144, 81, 165, 124, 108, 120,
+			16, 252, 9, 148, 175, 68, 117, 57, 27, 64, 255, 79, 52, 209,
+			20, 16, 253, 63, 1, 188, 40, 144, 0, 56, 126, 148, 94, 160,
+			182, 211, 199, 10, 191, 109, 229, 254, 93, 203, 42, 77, 1, 209,
+			224, 53, 63, 175, 38, 178, 50, 71, 42, 113, 103, 112, 221, 103,
+			49, 231, 183, 173, 226, 0, 226, 186, 15, 112, 253, 59, 26, 215,
+			125, 136, 235, 223, 209, 184, 238, 67, 92, 255, 142, 198, 117, 31,
+			226, 250, 119, 52, 229, 244, 1, 170, 127, 215, 178, 199, 212, 75,
+			24, 250, 239, 166, 85, 97, 232, 191, 107, 245, 15, 105, 144, 0,
+			56, 50, 170, 170, 218, 204, 249, 189, 180, 87, 187, 128, 32, 213,
+			160, 5, 160, 98, 179, 125, 112, 238, 58, 191, 151, 246, 74, 152,
+			243, 183, 44, 155, 169, 151, 36, 143, 96, 65, 131, 22, 128, 125,
+			7, 53, 136, 133, 7, 135, 232, 111, 64, 224, 80, 145, 21, 126,
+			223, 202, 253, 93, 203, 42, 253, 170, 197, 181, 139, 204, 92, 143,
+			52, 136, 131, 87, 42, 146, 199, 141, 123, 110, 226, 233, 220, 153,
+			139, 96, 229, 46, 95, 91, 94, 93, 186, 114, 251, 250, 181, 50,
+			66, 247, 150, 238, 175, 234, 223, 43, 247, 239, 220, 89, 190, 243,
+			142, 4, 86, 175, 222, 188, 126, 237, 190, 41, 247, 225, 210, 242,
+			26, 190, 195, 197, 40, 90, 204, 249, 125, 171, 56, 136, 139, 81,
+			132, 197, 248, 169, 101, 31, 193, 197, 40, 226, 54, 254, 169, 198,
+			104, 17, 23, 227, 167, 86, 63, 211, 32, 129, 194, 163, 99, 244,
+			58, 181, 157, 126, 86, 248, 3, 11, 156, 11, 165, 139, 153, 189,
+			152, 153, 91, 122, 29, 1, 24, 214, 30, 181, 77, 209, 70, 191,
+			197, 156, 63, 176, 138, 12, 105, 163, 31, 134, 243, 51, 203, 62,
+			133, 29, 246, 35, 109, 252, 204, 178, 15, 35, 109, 244, 227, 112,
+			126, 102, 13, 158, 208, 32, 129, 194, 39, 203, 244, 50, 86, 181,
+			152, 243, 135, 150, 125, 164, 52, 3, 152, 140, 146, 245, 36, 230,
+			222, 62, 167, 89, 239, 97, 70, 85, 91, 64, 74, 127, 168, 119,
+			65, 63, 238, 130, 63, 132, 81, 41, 144, 192, 219, 209, 49, 250,
+			17, 246, 100, 51, 231, 143, 44, 123, 188, 244, 46, 207, 120, 23,
+			159, 161, 51, 190, 42, 64, 243, 4, 46, 5, 55, 131, 3, 215,
+			135, 74, 73, 212, 17, 102, 28, 118, 30, 219, 214, 227, 0, 130,
+			255, 35, 171, 56, 172, 65, 2, 224, 216, 17, 250, 67, 224, 60,
+			253, 64, 166, 127, 12, 156, 231, 123, 150, 62, 33, 209, 141, 9,
+			173, 130, 133, 3, 89, 97, 210, 229, 83, 101, 184, 67, 185, 136,
+			87, 203, 202, 211, 169, 40, 154, 81, 109, 228, 160, 104, 134, 137,
+			240, 157, 84, 74, 144, 103, 61, 232, 19, 61, 145, 154, 218, 60,
+			207, 189, 36, 22, 126, 211, 76, 2, 118, 200, 31, 107, 42, 234,
+			199, 29, 242, 199, 86, 255, 168, 6, 113, 212, 227, 71, 241, 234,
+			102, 191, 237, 48, 231, 79, 44, 123, 164, 116, 148, 223, 150, 6,
+			37, 216, 7, 29, 101, 132, 150, 60, 68, 214, 115, 242, 88, 82,
+			183, 234, 88, 0, 246, 107, 218, 128, 48, 194, 63, 177, 216, 48,
+			93, 197, 86, 243, 204, 249, 83, 203, 30, 42, 93, 7, 81, 4,
+			48, 92, 81, 38, 180, 116, 126, 25, 250, 4, 169, 66, 174, 7,
+			136, 125, 59, 161, 138, 139, 84, 65, 107, 102, 4, 121, 217, 106,
+			65, 131, 22, 128, 125, 7, 52, 72, 0, 60, 60, 72, 219, 56,
+			130, 2, 115, 254, 28, 132, 202, 26, 223, 229, 16, 230, 109, 136,
+			123, 82, 26, 228, 44, 236, 9, 89, 128, 111, 171, 116, 65, 110,
+			189, 30, 70, 13, 197, 181, 165, 222, 0, 99, 223, 179, 56, 90,
+			192, 209, 195, 43, 228, 177, 75, 141, 32, 56, 225, 254, 220, 234,
+			31, 215, 32, 1, 240, 216, 113, 250, 49, 14, 175, 143, 57, 127,
+			1, 162, 226, 123, 92, 187, 161, 101, 148, 28, 234, 43, 155, 157,
+			22, 138, 43, 110, 3, 179, 253, 180, 181, 138, 174, 143, 168, 204,
+			64, 188, 38, 119, 183, 93, 207, 135, 130, 102, 36, 125, 121, 108,
+			93, 143, 4, 248, 255, 95, 104, 198, 220, 15, 122, 184, 243, 23,
+			214, 200, 168, 9, 63, 250, 239, 6, 233, 226, 83, 253, 156, 109,
+			1, 39, 153, 152, 213, 6, 144, 217, 70, 77, 69, 30, 13, 232,
+			71, 213, 70, 173, 244, 180, 116, 250, 229, 79, 41, 187, 161, 118,
+			109, 202, 182, 246, 70, 94, 88, 123, 35, 47, 216, 5, 90, 212,
+			27, 126, 220, 126, 76, 186, 16, 147, 134, 112, 197, 148, 45, 127,
+			76, 199, 246, 118, 121, 219, 139, 19, 184, 72, 183, 55, 34, 231,
+			149, 106, 102, 66, 213, 189, 53, 123, 98, 115, 202, 55, 232, 16,
+			100, 246, 244, 5, 128, 74, 24, 101, 163, 20, 66, 74, 244, 60,
+			250, 87, 242, 159, 132, 181, 229, 6, 92, 103, 147, 187, 75, 232,
+			171, 97, 6, 46, 175, 210, 209, 61, 237, 224, 24, 23, 105, 81,
+			177, 1, 61, 192, 19, 61, 3, 220, 83, 107, 197, 148, 191, 114,
+			246, 193, 252, 115, 47, 236, 173, 127, 112, 64, 222, 53, 91, 120,
+			162, 223, 250, 27, 47, 253, 214, 47, 253, 214, 207, 233, 183, 30,
+			49, 126, 235, 155, 169, 223, 250, 102, 234, 183, 158, 166, 63, 1,
+			169, 45, 199, 156, 82, 110, 194, 42, 253, 107, 22, 223, 187, 251,
+			210, 120, 115, 80, 143, 164, 87, 212, 239, 26, 73, 224, 49, 42,
+			135, 54, 179, 38, 96, 122, 3, 45, 187, 13, 102, 240, 176, 137,
+			146, 159, 58, 166, 61, 52, 9, 236, 211, 101, 188, 226, 238, 200,
+			187, 212, 42, 118, 20, 172, 41, 37, 101, 234, 66, 63, 248, 49,
+			91, 95, 16, 129, 48, 237, 99, 198, 154, 7, 5, 143, 21, 71,
+			51, 86, 199, 99, 227, 71, 233, 41, 109, 117, 60, 110, 79, 151,
+			199, 164, 222, 236, 37, 120, 166, 232, 105, 104, 227, 160, 85, 128,
+			82, 58, 243, 5, 216, 81, 142, 31, 127, 85, 67, 132, 145, 227,
+			147, 83, 244, 35, 105, 28, 60, 153, 59, 101, 149, 222, 219, 103,
+			248, 192, 69, 50, 147, 247, 130, 116, 210, 168, 166, 197, 143, 153,
+			178, 154, 44, 116, 122, 178, 120, 34, 181, 53, 150, 237, 170, 50,
+			5, 130, 173, 177, 220, 99, 107, 44, 15, 76, 104, 8, 82, 117,
+			157, 152, 214, 16, 97, 164, 92, 153, 161, 161, 52, 26, 78, 229,
+			94, 183, 74, 117, 190, 135, 117, 193, 48, 81, 231, 205, 72, 62,
+			136, 158, 94, 135, 145, 194, 146, 210, 192, 64, 180, 13, 155, 210,
+			104, 46, 207, 71, 205, 84, 213, 12, 0, 213, 83, 197, 163, 148,
+			107, 203, 228, 180, 61, 82, 30, 230, 229, 55, 148, 16, 240, 214,
+			236, 27, 159, 132, 181, 183, 84, 232, 187, 13, 82, 56, 153, 54,
+			118, 71, 88, 195, 105, 99, 119, 4, 115, 229, 52, 27, 166, 115,
+			218, 92, 121, 198, 126, 165, 124, 138, 199, 232, 114, 48, 113, 7,
+			16, 115, 93, 5, 101, 163, 19, 171, 155, 246, 186, 109, 48, 149,
+			157, 81, 40, 147, 22, 206, 51, 42, 72, 216, 6, 169, 151, 156,
+			97, 37, 13, 129, 11, 124, 226, 4, 221, 144, 198, 199, 185, 220,
+			130, 85, 250, 120, 47, 202, 246, 95, 93, 144, 163, 210, 213, 204,
+			46, 54, 221, 219, 68, 102, 177, 193, 178, 57, 87, 156, 72, 45,
+			155, 243, 246, 25, 101, 120, 132, 197, 158, 87, 35, 151, 150, 205,
+			249, 129, 227, 25, 203, 230, 252, 196, 105, 13, 193, 221, 170, 169,
+			105, 35, 95, 252, 246, 107, 244, 155, 207, 47, 95, 232, 67, 76,
+			73, 25, 67, 250, 69, 85, 191, 120, 170, 172, 241, 149, 99, 157,
+			127, 211, 121, 124, 190, 129, 244, 136, 87, 9, 7, 228, 17, 191,
+			71, 144, 33, 251, 8, 50, 231, 104, 159, 18, 30, 199, 157, 167,
+			202, 49, 186, 40, 123, 149, 30, 66, 3, 212, 186, 23, 172, 99,
+			128, 46, 134, 54, 146, 149, 3, 248, 116, 57, 144, 177, 184, 38,
+			15, 66, 126, 159, 60, 8, 133, 52, 15, 194, 107, 244, 176, 186,
+			102, 176, 94, 235, 174, 195, 45, 38, 21, 7, 121, 80, 61, 190,
+			210, 189, 31, 139, 232, 101, 190, 132, 61, 249, 18, 174, 211, 1,
+			85, 8, 246, 30, 72, 165, 187, 228, 179, 82, 42, 159, 25, 130,
+			250, 154, 100, 179, 255, 66, 229, 1, 240, 159, 40, 155, 93, 124,
+			41, 155, 189, 148, 205, 158, 83, 54, 43, 61, 85, 54, 203, 196,
+			20, 254, 51, 147, 161, 173, 105, 149, 254, 138, 60, 41, 37, 0,
+			186, 232, 129, 179, 43, 43, 133, 114, 170, 3, 238, 76, 188, 14,
+			222, 237, 169, 117, 233, 51, 39, 16, 0, 137, 238, 174, 138, 153,
+			216, 19, 166, 160, 253, 59, 120, 25, 10, 87, 218, 75, 60, 176,
+			251, 185, 120, 165, 41, 229, 196, 74, 185, 223, 132, 67, 209, 173,
+			215, 69, 122, 245, 169, 215, 118, 150, 54, 166, 186, 216, 149, 220,
+			45, 123, 73, 10, 134, 77, 57, 164, 70, 198, 64, 132, 78, 230,
+			106, 191, 74, 3, 134, 104, 233, 153, 142, 9, 219, 208, 238, 120,
+			101, 63, 82, 155, 62, 149, 120, 141, 88, 155, 113, 63, 160, 207,
+			200, 28, 254, 82, 10, 130, 235, 222, 248, 72, 122, 251, 93, 63,
+			14, 249, 110, 78, 132, 6, 5, 21, 251, 185, 171, 3, 117, 13,
+			89, 166, 102, 0, 7, 138, 22, 164, 224, 152, 47, 23, 15, 211,
+			159, 155, 228, 226, 147, 246, 96, 233, 111, 91, 38, 201, 130, 254,
+			88, 150, 118, 2, 252, 11, 72, 182, 240, 124, 89, 7, 180, 92,
+			157, 235, 185, 189, 159, 195, 219, 251, 217, 28, 2, 147, 135, 14,
+			211, 95, 210, 98, 122, 197, 30, 41, 125, 10, 225, 90, 90, 161,
+			144, 49, 97, 154, 202, 123, 146, 7, 96, 144, 2, 216, 23, 247,
+			221, 3, 48, 127, 48, 66, 246, 60, 172, 164, 55, 129, 195, 109,
+			17, 69, 94, 163, 33, 84, 68, 133, 138, 71, 168, 152, 145, 130,
+			136, 88, 81, 194, 168, 244, 163, 86, 216, 48, 253, 158, 165, 3,
+			18, 22, 236, 82, 169, 171, 135, 218, 35, 51, 63, 113, 200, 115,
+			123, 71, 76, 95, 124, 200, 224, 82, 95, 48, 58, 16, 96, 112,
+			193, 232, 64, 224, 82, 95, 24, 63, 74, 127, 68, 116, 118, 128,
+			55, 237, 169, 210, 247, 9, 55, 2, 143, 241, 35, 154, 1, 246,
+			26, 219, 116, 226, 8, 72, 154, 247, 164, 181, 214, 113, 189, 6,
+			140, 211, 64, 37, 240, 111, 210, 244, 150, 53, 134, 75, 85, 117,
+			196, 137, 166, 36, 29, 95, 4, 19, 150, 225, 166, 208, 165, 167,
+			190, 237, 96, 92, 167, 212, 248, 78, 215, 84, 178, 69, 121, 242,
+			77, 246, 10, 107, 147, 16, 135, 38, 131, 244, 118, 132, 187, 37,
+			125, 137, 42, 76, 85, 13, 17, 72, 213, 77, 240, 211, 31, 128,
+			220, 134, 7, 174, 71, 72, 215, 17, 182, 224, 142, 35, 28, 99,
+			146, 121, 110, 120, 137, 186, 193, 170, 190, 76, 150, 250, 150, 204,
+			213, 100, 79, 196, 211, 85, 197, 87, 76, 95, 210, 147, 174, 86,
+			46, 227, 3, 143, 132, 239, 41, 11, 162, 74, 198, 80, 128, 133,
+			209, 122, 39, 104, 5, 111, 30, 63, 165, 33, 194, 200, 155, 175,
+			77, 210, 191, 204, 235, 76, 13, 183, 237, 99, 165, 191, 151, 7,
+			252, 185, 188, 134, 73, 3, 178, 147, 218, 17, 153, 123, 212, 42,
+			184, 17, 61, 174, 173, 176, 37, 50, 159, 216, 155, 106, 166, 76,
+			149, 154, 155, 155, 38, 218, 24, 252, 191, 120, 76, 236, 23, 128,
+			12, 141, 184, 60, 193, 12, 157, 126, 24, 182, 167, 43, 41, 143,
+			80, 185, 47, 227, 150, 12, 43, 219, 179, 46, 94, 156, 69, 69,
+			18, 2, 113, 201, 160, 0, 142, 185, 215, 80, 185, 220, 83, 11,
+			6, 155, 78, 17, 22, 60, 93, 132, 73, 69, 173, 147, 169, 51,
+			66, 186, 206, 131, 48, 161, 153, 229, 68, 118, 96, 130, 27, 158,
+			133, 160, 247, 105, 25, 236, 6, 84, 198, 137, 129, 43, 65, 60,
+			2, 118, 234, 37, 126, 55, 19, 0, 103, 50, 123, 196, 187, 119,
+			114, 186, 39, 128, 216, 83, 7, 247, 110, 215, 122, 204, 27, 160,
+			103, 171, 186, 41, 226, 255, 127, 70, 247, 112, 135, 251, 182, 97,
+			93, 16, 82, 115, 187, 56, 166, 33, 194, 200, 237, 163, 37, 250,
+			155, 146, 219, 230, 25, 121, 223, 30, 46, 125, 105, 241, 251, 47,
+			146, 84, 230, 254, 114, 134, 151, 61, 219, 225, 197, 175, 169, 104,
+			73, 140, 44, 148, 132, 186, 43, 55, 78, 30, 71, 165, 79, 139,
+			188, 197, 200, 251, 38, 43, 13, 68, 240, 190, 63, 196, 212, 185,
+			86, 96, 228, 3, 123, 168, 244, 233, 211, 179, 210, 252, 243, 25,
+			105, 33, 15, 3, 208, 35, 133, 56, 218, 15, 84, 126, 1, 25,
+			57, 251, 193, 225, 65, 45, 103, 244, 49, 242, 109, 123, 2, 228,
+			140, 27, 217, 77, 167, 89, 202, 222, 59, 222, 32, 23, 238, 206,
+			197, 81, 49, 119, 222, 105, 234, 153, 131, 83, 18, 232, 51, 130,
+			185, 100, 194, 52, 179, 75, 7, 147, 199, 235, 96, 188, 185, 95,
+			239, 106, 71, 236, 113, 197, 169, 196, 47, 121, 24, 187, 158, 37,
+			68, 71, 126, 187, 127, 92, 67, 132, 145, 111, 31, 59, 78, 111,
+			234, 220, 61, 235, 182, 32, 165, 197, 253, 114, 247, 224, 193, 192,
+			229, 23, 72, 193, 65, 109, 178, 242, 103, 145, 174, 250, 196, 252,
+			59, 235, 38, 255, 78, 145, 145, 135, 142, 54, 25, 22, 11, 0,
+			29, 213, 16, 4, 107, 150, 78, 104, 8, 194, 51, 79, 158, 82,
+			213, 250, 25, 113, 157, 215, 84, 181, 254, 2, 64, 218, 196, 217,
+			111, 49, 226, 30, 211, 217, 114, 250, 9, 35, 238, 169, 211, 170,
+			26, 101, 164, 102, 122, 163, 5, 128, 116, 111, 212, 98, 164, 102,
+			122, 163, 132, 145, 154, 233, 109, 128, 145, 186, 115, 70, 245, 54,
+			80, 0, 72, 231, 254, 25, 128, 232, 208, 9, 221, 228, 0, 97,
+			164, 62, 57, 173, 170, 29, 96, 164, 225, 44, 168, 87, 7, 10,
+			0, 233, 97, 29, 176, 24, 105, 156, 172, 104, 136, 48, 210, 152,
+			157, 167, 75, 210, 84, 250, 73, 206, 183, 74, 231, 249, 46, 11,
+			218, 142, 250, 250, 38, 74, 206, 64, 248, 70, 140, 54, 246, 51,
+			37, 7, 131, 20, 246, 137, 202, 105, 130, 225, 151, 91, 54, 207,
+			132, 95, 110, 41, 43, 153, 12, 191, 220, 26, 48, 57, 102, 32,
+			106, 115, 248, 152, 134, 8, 35, 91, 39, 94, 49, 86, 178, 31,
+			13, 209, 119, 158, 217, 32, 0, 122, 195, 172, 50, 111, 204, 182,
+			107, 179, 74, 115, 208, 198, 178, 65, 245, 170, 170, 159, 151, 207,
+			210, 252, 213, 77, 207, 111, 224, 125, 90, 252, 136, 152, 178, 101,
+			41, 8, 82, 22, 198, 155, 238, 60, 58, 214, 14, 172, 224, 239,
+			242, 135, 180, 184, 34, 154, 171, 109, 183, 46, 160, 94, 59, 18,
+			153, 122, 18, 98, 103, 105, 177, 14, 13, 71, 34, 64, 159, 22,
+			24, 125, 118, 247, 94, 197, 174, 87, 76, 193, 242, 117, 122, 120,
+			69, 51, 236, 46, 216, 73, 5, 91, 160, 133, 24, 58, 74, 141,
+			40, 123, 90, 209, 99, 89, 81, 37, 203, 191, 111, 81, 122, 77,
+			212, 58, 27, 178, 137, 11, 52, 15, 138, 87, 160, 90, 224, 123,
+			91, 72, 11, 87, 225, 54, 179, 44, 206, 22, 105, 159, 146, 229,
+			198, 237, 103, 172, 169, 43, 148, 102, 41, 129, 139, 208, 42, 117,
+			165, 250, 254, 67, 164, 178, 6, 224, 81, 165, 236, 131, 10, 186,
+			178, 248, 224, 27, 47, 186, 202, 183, 254, 71, 229, 153, 155, 121,
+			105, 253, 121, 105, 253, 249, 90, 173, 63, 71, 141, 245, 231, 110,
+			106, 253, 185, 139, 81, 103, 57, 230, 140, 230, 198, 173, 210, 25,
+			142, 187, 24, 208, 0, 195, 240, 221, 24, 174, 127, 193, 221, 27,
+			136, 107, 79, 98, 14, 44, 131, 39, 94, 59, 99, 44, 24, 45,
+			30, 84, 172, 58, 199, 200, 152, 137, 177, 7, 237, 123, 204, 156,
+			138, 112, 94, 141, 25, 157, 22, 180, 239, 49, 54, 172, 170, 89,
+			140, 28, 177, 117, 52, 60, 120, 75, 142, 24, 225, 12, 152, 240,
+			145, 162, 22, 25, 64, 21, 62, 114, 120, 144, 62, 144, 28, 254,
+			88, 238, 132, 85, 186, 195, 53, 199, 128, 65, 103, 84, 19, 205,
+			135, 148, 1, 40, 222, 4, 217, 220, 168, 37, 145, 104, 98, 102,
+			64, 100, 50, 124, 74, 242, 57, 157, 54, 6, 122, 61, 86, 28,
+			76, 89, 255, 113, 53, 43, 11, 221, 70, 199, 213, 172, 36, 179,
+			63, 174, 102, 37, 153, 253, 113, 53, 43, 11, 52, 226, 9, 251,
+			132, 122, 5, 30, 161, 9, 115, 98, 128, 195, 111, 66, 165, 94,
+			150, 145, 247, 19, 67, 38, 42, 159, 48, 50, 113, 124, 130, 222,
+			148, 78, 52, 116, 248, 189, 193, 119, 49, 82, 233, 227, 132, 143,
+			241, 182, 101, 16, 143, 239, 243, 29, 80, 64, 81, 205, 107, 194,
+			181, 34, 149, 102, 74, 205, 8, 70, 115, 178, 120, 36, 141, 219,
+			47, 219, 175, 40, 23, 84, 214, 191, 103, 43, 255, 222, 176, 134,
+			192, 22, 52, 162, 157, 85, 48, 191, 242, 196, 9, 122, 71, 58,
+			171, 94, 203, 205, 88, 165, 43, 60, 101, 154, 217, 75, 184, 89,
+			247, 235, 59, 34, 193, 66, 38, 54, 15, 180, 60, 157, 180, 76,
+			13, 16, 180, 207, 215, 84, 132, 26, 33, 57, 230, 76, 218, 103,
+			100, 92, 59, 193, 184, 246, 73, 122, 16, 227, 218, 137, 140, 107,
+			159, 114, 100, 224, 57, 130, 121, 128, 105, 10, 91, 140, 76, 13,
+			28, 74, 97, 194, 200, 212, 16, 51, 213, 193, 213, 231, 140, 153,
+			215, 64, 112, 211, 153, 234, 176, 28, 211, 3, 67, 41, 76, 24,
+			153, 30, 25, 197, 116, 116, 152, 219, 236, 117, 251, 104, 249, 164,
+			68, 125, 214, 42, 215, 107, 148, 51, 46, 52, 135, 145, 215, 21,
+			118, 165, 67, 237, 117, 117, 83, 67, 58, 212, 94, 63, 172, 99,
+			254, 1, 187, 175, 31, 25, 167, 231, 244, 85, 129, 138, 125, 172,
+			60, 105, 250, 49, 136, 173, 117, 185, 58, 45, 184, 60, 13, 130,
+			112, 71, 183, 15, 100, 86, 49, 189, 1, 153, 85, 76, 111, 48,
+			175, 202, 225, 49, 13, 17, 70, 42, 153, 236, 68, 255, 214, 9,
+			250, 230, 115, 123, 42, 102, 221, 70, 203, 11, 148, 56, 114, 72,
+			63, 173, 226, 211, 167, 59, 238, 190, 106, 54, 164, 210, 87, 136,
+			103, 42, 125, 101, 95, 101, 233, 235, 146, 227, 202, 191, 90, 160,
+			7, 123, 246, 7, 228, 194, 17, 1, 168, 172, 210, 23, 89, 92,
+			209, 96, 38, 57, 140, 157, 77, 14, 195, 46, 211, 1, 223, 141,
+			147, 245, 36, 242, 220, 13, 49, 78, 158, 234, 112, 164, 190, 27,
+			131, 27, 204, 221, 16, 236, 6, 165, 224, 215, 195, 124, 50, 66,
+			57, 43, 39, 83, 247, 22, 46, 103, 181, 103, 132, 232, 22, 196,
+			95, 43, 253, 117, 253, 147, 221, 160, 7, 91, 110, 224, 110, 136,
+			72, 53, 213, 143, 238, 187, 147, 251, 54, 245, 158, 44, 137, 53,
+			87, 14, 168, 122, 8, 177, 25, 202, 224, 198, 222, 182, 88, 79,
+			77, 16, 50, 147, 55, 89, 25, 146, 111, 50, 65, 12, 108, 133,
+			142, 232, 136, 138, 158, 10, 133, 103, 11, 244, 26, 110, 238, 121,
+			22, 179, 5, 58, 170, 163, 78, 214, 77, 227, 177, 72, 198, 251,
+			112, 20, 195, 250, 165, 110, 111, 85, 36, 236, 54, 29, 81, 23,
+			57, 215, 213, 69, 206, 24, 171, 20, 159, 234, 47, 100, 170, 158,
+			130, 227, 85, 145, 148, 254, 169, 69, 251, 13, 150, 159, 64, 17,
+			189, 153, 220, 237, 221, 153, 220, 13, 101, 68, 98, 199, 11, 26,
+			207, 74, 25, 43, 88, 154, 125, 147, 30, 2, 104, 29, 62, 117,
+			190, 14, 38, 219, 103, 112, 101, 31, 128, 26, 107, 94, 125, 235,
+			195, 77, 17, 176, 215, 232, 225, 180, 133, 32, 12, 234, 210, 103,
+			77, 86, 14, 234, 98, 119, 224, 97, 249, 63, 177, 232, 208, 30,
+			186, 128, 252, 63, 248, 217, 19, 165, 142, 72, 0, 178, 31, 53,
+			160, 232, 186, 31, 110, 40, 193, 187, 136, 15, 110, 135, 27, 236,
+			42, 61, 164, 182, 218, 122, 59, 4, 91, 160, 154, 242, 241, 39,
+			137, 251, 43, 7, 213, 203, 123, 88, 5, 190, 205, 182, 225, 37,
+			186, 1, 231, 25, 26, 232, 223, 240, 18, 89, 121, 225, 61, 154,
+			95, 130, 45, 195, 174, 209, 193, 221, 71, 223, 126, 89, 114, 38,
+			246, 221, 31, 186, 198, 11, 134, 247, 49, 169, 68, 44, 191, 12,
+			239, 123, 25, 222, 247, 181, 134, 247, 29, 121, 86, 23, 242, 88,
+			110, 25, 127, 218, 140, 28, 201, 93, 195, 159, 4, 190, 2, 118,
+			11, 127, 58, 140, 28, 205, 189, 79, 223, 151, 121, 107, 38, 114,
+			101, 171, 116, 157, 227, 182, 49, 57, 107, 112, 51, 0, 158, 93,
+			224, 248, 104, 242, 235, 196, 192, 3, 101, 120, 255, 46, 155, 92,
+			184, 19, 152, 124, 180, 152, 196, 102, 162, 120, 144, 118, 116, 14,
+			155, 147, 246, 82, 105, 115, 175, 32, 170, 63, 76, 41, 111, 68,
+			163, 240, 12, 87, 12, 181, 83, 87, 221, 114, 199, 195, 12, 44,
+			132, 247, 99, 209, 236, 248, 210, 119, 133, 12, 103, 163, 231, 182,
+			184, 23, 199, 230, 115, 43, 42, 113, 204, 201, 130, 78, 117, 145,
+			179, 25, 57, 121, 68, 231, 98, 1, 97, 239, 228, 194, 219, 244,
+			7, 42, 20, 242, 76, 238, 77, 171, 244, 107, 22, 239, 29, 158,
+			31, 134, 49, 228, 95, 111, 161, 104, 31, 103, 227, 24, 193, 141,
+			234, 198, 113, 88, 7, 167, 183, 250, 56, 154, 249, 110, 16, 140,
+			193, 80, 133, 114, 1, 152, 75, 202, 42, 186, 46, 140, 244, 71,
+			198, 160, 240, 29, 241, 8, 220, 218, 27, 139, 124, 126, 78, 73,
+			228, 128, 195, 51, 234, 238, 86, 14, 36, 242, 215, 237, 121, 41,
+			145, 231, 80, 34, 127, 157, 14, 163, 72, 157, 3, 17, 155, 145,
+			138, 51, 138, 34, 115, 78, 73, 228, 21, 167, 152, 194, 32, 122,
+			246, 15, 166, 48, 8, 159, 195, 35, 166, 58, 124, 152, 202, 57,
+			106, 94, 131, 68, 62, 227, 244, 167, 48, 188, 167, 35, 41, 76,
+			24, 153, 57, 50, 110, 170, 219, 140, 84, 157, 170, 121, 109, 23,
+			0, 158, 72, 97, 72, 85, 126, 98, 58, 133, 9, 35, 213, 202,
+			140, 169, 14, 41, 201, 157, 121, 243, 26, 210, 196, 206, 102, 170,
+			3, 45, 207, 158, 168, 164, 48, 148, 159, 157, 51, 213, 49, 137,
+			249, 9, 243, 26, 156, 11, 115, 153, 193, 131, 123, 97, 142, 166,
+			147, 131, 239, 52, 205, 29, 159, 72, 53, 230, 179, 246, 112, 70,
+			99, 62, 107, 23, 52, 4, 201, 197, 251, 180, 93, 31, 8, 230,
+			236, 16, 75, 53, 230, 115, 42, 195, 178, 212, 152, 207, 153, 106,
+			32, 230, 159, 235, 59, 168, 33, 200, 11, 51, 56, 164, 170, 193,
+			151, 177, 108, 109, 46, 5, 52, 157, 55, 222, 63, 104, 242, 252,
+			241, 73, 213, 36, 184, 108, 207, 159, 121, 93, 85, 35, 140, 92,
+			72, 221, 132, 5, 128, 180, 27, 29, 144, 115, 225, 128, 118, 158,
+			16, 40, 121, 84, 135, 204, 58, 140, 92, 84, 87, 166, 115, 112,
+			173, 144, 92, 52, 51, 5, 164, 92, 28, 57, 153, 241, 185, 92,
+			84, 151, 164, 209, 229, 114, 73, 101, 105, 205, 217, 121, 248, 66,
+			150, 173, 13, 237, 224, 248, 184, 164, 244, 102, 233, 248, 184, 52,
+			164, 29, 150, 224, 248, 184, 164, 114, 182, 98, 230, 146, 69, 251,
+			170, 122, 85, 112, 24, 89, 52, 141, 64, 230, 247, 197, 129, 233,
+			76, 230, 247, 197, 51, 111, 105, 136, 48, 178, 184, 116, 69, 53,
+			210, 7, 89, 116, 94, 87, 175, 250, 48, 167, 142, 110, 164, 47,
+			207, 200, 101, 51, 18, 48, 249, 95, 30, 210, 83, 237, 35, 140,
+			92, 158, 62, 163, 26, 41, 50, 242, 134, 173, 219, 47, 58, 0,
+			233, 70, 192, 80, 255, 198, 128, 182, 122, 23, 45, 70, 222, 56,
+			125, 73, 67, 132, 145, 55, 222, 120, 147, 126, 32, 77, 29, 75,
+			185, 101, 171, 116, 139, 239, 145, 144, 180, 73, 27, 93, 52, 168,
+			126, 167, 31, 45, 4, 181, 163, 170, 10, 87, 53, 235, 91, 85,
+			188, 76, 155, 57, 150, 138, 71, 83, 51, 199, 21, 181, 64, 210,
+			204, 113, 165, 199, 204, 113, 69, 185, 152, 164, 153, 227, 138, 34,
+			69, 52, 115, 92, 181, 245, 71, 200, 128, 20, 175, 154, 106, 208,
+			254, 213, 126, 109, 24, 7, 227, 205, 213, 209, 49, 250, 174, 254,
+			72, 219, 59, 246, 252, 194, 91, 24, 225, 163, 4, 42, 200, 53,
+			209, 51, 106, 14, 87, 170, 148, 87, 77, 126, 202, 16, 88, 117,
+			173, 179, 97, 88, 178, 250, 84, 91, 1, 90, 211, 65, 201, 48,
+			164, 119, 78, 84, 212, 144, 128, 148, 223, 153, 157, 83, 163, 37,
+			140, 220, 180, 103, 212, 43, 216, 233, 55, 77, 53, 32, 229, 155,
+			39, 166, 52, 4, 37, 95, 175, 24, 253, 248, 183, 7, 232, 219,
+			207, 45, 134, 205, 202, 36, 178, 74, 209, 99, 131, 250, 121, 85,
+			61, 47, 191, 65, 135, 50, 90, 135, 76, 102, 11, 249, 50, 81,
+			100, 142, 235, 110, 16, 40, 77, 143, 172, 160, 126, 183, 42, 31,
+			221, 114, 138, 214, 160, 253, 98, 146, 225, 63, 236, 151, 146, 225,
+			169, 151, 146, 225, 75, 201, 240, 107, 149, 12, 143, 238, 47, 25,
+			254, 231, 240, 57, 140, 28, 115, 142, 131, 37, 243, 63, 178, 51,
+			17, 235, 138, 224, 179, 145, 98, 109, 119, 195, 11, 164, 100, 22,
+			99, 98, 15, 105, 62, 204, 212, 193, 20, 1, 16, 172, 144, 134,
+			153, 197, 34, 242, 100, 98, 41, 88, 19, 136, 117, 184, 112, 14,
+			13, 214, 177, 74, 65, 144, 38, 36, 136, 49, 23, 82, 36, 38,
+			99, 30, 64, 208, 32, 124, 234, 192, 131, 100, 130, 176, 67, 5,
+			166, 9, 90, 132, 204, 4, 110, 12, 249, 204, 120, 45, 10, 183,
+			68, 192, 213, 118, 85, 41, 104, 62, 145, 159, 244, 108, 132, 59,
+			129, 201, 62, 0, 12, 169, 190, 165, 19, 176, 235, 205, 150, 126,
+			201, 81, 83, 104, 221, 204, 120, 151, 60, 38, 101, 49, 28, 181,
+			26, 41, 36, 103, 138, 193, 186, 167, 125, 194, 224, 18, 246, 178,
+			31, 97, 61, 94, 60, 74, 167, 225, 119, 63, 24, 151, 15, 150,
+			39, 12, 26, 107, 25, 75, 164, 234, 19, 110, 2, 59, 78, 174,
+			63, 7, 166, 103, 101, 80, 239, 207, 89, 61, 144, 45, 161, 47,
+			77, 228, 95, 217, 62, 90, 122, 164, 34, 205, 140, 63, 64, 241,
+			165, 108, 96, 206, 148, 180, 202, 0, 241, 105, 107, 201, 180, 70,
+			198, 167, 29, 17, 117, 101, 108, 138, 20, 183, 209, 90, 189, 124,
+			45, 155, 187, 195, 141, 84, 248, 4, 96, 20, 98, 58, 130, 52,
+			134, 0, 142, 162, 178, 113, 8, 192, 136, 203, 69, 227, 99, 0,
+			139, 244, 145, 113, 195, 165, 255, 201, 16, 125, 235, 185, 89, 34,
+			222, 94, 52, 76, 250, 176, 126, 92, 149, 143, 159, 106, 199, 44,
+			255, 109, 139, 230, 193, 176, 182, 247, 250, 64, 230, 10, 128, 253,
+			236, 87, 0, 42, 148, 136, 196, 125, 6, 75, 13, 20, 75, 175,
+			2, 56, 217, 171, 0, 227, 38, 146, 29, 205, 45, 7, 86, 52,
+			8, 223, 82, 135, 62, 209, 33, 14, 223, 82, 135, 217, 152, 160,
+			246, 177, 140, 145, 10, 159, 99, 111, 209, 138, 42, 245, 98, 39,
+			206, 159, 31, 146, 39, 206, 229, 151, 14, 205, 151, 14, 205, 175,
+			213, 161, 57, 190, 255, 137, 147, 177, 69, 252, 51, 165, 95, 31,
+			131, 207, 218, 253, 149, 133, 1, 168, 251, 69, 176, 195, 87, 151,
+			51, 252, 76, 166, 137, 131, 236, 131, 177, 98, 167, 237, 112, 75,
+			192, 215, 146, 18, 25, 43, 132, 237, 168, 108, 160, 245, 176, 45,
+			15, 175, 189, 1, 139, 192, 206, 193, 48, 129, 43, 11, 201, 236,
+			226, 16, 78, 151, 6, 94, 126, 247, 130, 186, 223, 105, 136, 158,
+			43, 231, 145, 192, 244, 33, 117, 193, 55, 69, 36, 116, 226, 56,
+			215, 223, 113, 187, 113, 122, 7, 60, 251, 137, 147, 32, 1, 213,
+			61, 108, 238, 137, 225, 62, 86, 60, 72, 255, 126, 134, 147, 15,
+			150, 126, 250, 212, 24, 110, 112, 251, 194, 204, 42, 152, 108, 117,
+			70, 153, 145, 69, 227, 235, 10, 233, 86, 156, 246, 249, 163, 141,
+			229, 33, 144, 117, 38, 151, 123, 66, 185, 203, 135, 14, 211, 95,
+			212, 170, 241, 164, 61, 85, 122, 127, 223, 88, 99, 232, 126, 215,
+			181, 254, 231, 29, 9, 56, 213, 38, 141, 54, 12, 42, 206, 164,
+			9, 154, 5, 21, 103, 242, 181, 73, 218, 212, 218, 118, 197, 126,
+			181, 244, 17, 95, 131, 227, 45, 65, 204, 234, 177, 104, 68, 67,
+			166, 79, 111, 27, 114, 50, 244, 134, 137, 62, 37, 2, 15, 170,
+			154, 17, 129, 178, 91, 49, 35, 2, 4, 84, 142, 191, 162, 33,
+			176, 180, 148, 79, 209, 127, 213, 210, 154, 252, 188, 61, 92, 250,
+			149, 167, 132, 51, 122, 173, 175, 26, 204, 232, 181, 158, 45, 64,
+			144, 192, 183, 220, 204, 186, 130, 10, 54, 111, 66, 25, 193, 154,
+			48, 63, 196, 232, 109, 109, 77, 56, 103, 143, 148, 222, 230, 75,
+			81, 205, 75, 34, 55, 234, 166, 185, 183, 212, 217, 134, 31, 182,
+			17, 13, 200, 241, 80, 115, 19, 175, 165, 69, 191, 116, 127, 153,
+			126, 193, 74, 115, 206, 8, 21, 96, 142, 56, 87, 60, 156, 49,
+			71, 156, 99, 195, 244, 109, 169, 122, 95, 202, 93, 182, 74, 103,
+			185, 57, 52, 159, 63, 138, 236, 82, 54, 137, 223, 162, 125, 60,
+			147, 196, 79, 155, 37, 100, 20, 217, 162, 178, 40, 200, 192, 130,
+			69, 245, 57, 102, 169, 113, 47, 102, 62, 199, 252, 63, 87, 94,
+			36, 151, 67, 242, 233, 158, 92, 14, 201, 167, 165, 183, 94, 160,
+			161, 140, 188, 244, 213, 29, 169, 229, 11, 116, 116, 69, 184, 141,
+			123, 242, 158, 238, 85, 204, 211, 177, 230, 198, 91, 108, 2, 191,
+			204, 11, 15, 211, 36, 11, 253, 234, 201, 114, 163, 124, 141, 142,
+			220, 198, 175, 215, 165, 218, 1, 86, 123, 76, 94, 134, 81, 90,
+			240, 130, 109, 125, 151, 147, 172, 228, 189, 96, 123, 185, 81, 190,
+			79, 75, 187, 91, 137, 241, 146, 37, 182, 117, 145, 230, 193, 32,
+			161, 229, 163, 140, 43, 51, 249, 180, 186, 95, 239, 43, 178, 124,
+			249, 117, 202, 164, 119, 85, 27, 115, 159, 48, 180, 242, 36, 61,
+			244, 174, 87, 223, 146, 21, 158, 84, 240, 175, 89, 116, 44, 237,
+			79, 59, 31, 159, 80, 227, 49, 179, 102, 151, 51, 247, 25, 165,
+			160, 153, 241, 147, 38, 159, 86, 111, 184, 193, 221, 142, 142, 13,
+			143, 161, 131, 244, 82, 99, 89, 80, 182, 247, 61, 59, 34, 63,
+			149, 226, 53, 36, 178, 250, 241, 187, 40, 203, 141, 184, 231, 238,
+			164, 253, 236, 119, 39, 203, 13, 58, 124, 61, 192, 84, 184, 61,
+			253, 60, 102, 162, 47, 218, 75, 68, 153, 78, 133, 143, 91, 60,
+			126, 1, 108, 166, 98, 52, 121, 22, 49, 186, 188, 161, 100, 240,
+			23, 232, 170, 2, 226, 126, 203, 120, 53, 31, 215, 147, 44, 84,
+			190, 70, 15, 128, 15, 25, 220, 172, 79, 234, 107, 130, 210, 140,
+			127, 214, 124, 2, 72, 249, 102, 95, 76, 234, 255, 143, 79, 201,
+			47, 99, 252, 200, 122, 41, 246, 191, 20, 251, 191, 86, 177, 255,
+			105, 25, 70, 110, 106, 23, 228, 104, 238, 22, 221, 210, 10, 192,
+			132, 85, 90, 231, 251, 30, 52, 89, 185, 86, 230, 24, 5, 183,
+			95, 12, 139, 13, 119, 200, 116, 26, 41, 32, 204, 247, 129, 29,
+			45, 194, 39, 21, 221, 198, 140, 122, 62, 35, 83, 75, 149, 123,
+			100, 239, 140, 131, 231, 184, 74, 219, 155, 235, 9, 30, 204, 169,
+			224, 65, 227, 252, 129, 68, 31, 99, 71, 232, 159, 130, 198, 98,
+			65, 116, 221, 180, 85, 250, 3, 139, 239, 119, 200, 100, 7, 140,
+			121, 216, 76, 138, 251, 169, 48, 82, 25, 48, 93, 238, 171, 72,
+			200, 105, 115, 151, 42, 171, 106, 164, 34, 189, 144, 76, 182, 193,
+			131, 48, 152, 233, 201, 151, 13, 95, 244, 131, 111, 113, 152, 237,
+			145, 74, 253, 160, 221, 108, 137, 110, 22, 39, 242, 83, 178, 34,
+			214, 136, 0, 209, 231, 181, 226, 241, 84, 244, 153, 236, 137, 162,
+			156, 236, 137, 162, 156, 236, 137, 162, 156, 204, 70, 81, 78, 25,
+			175, 4, 184, 23, 166, 148, 212, 38, 227, 38, 167, 138, 218, 43,
+			97, 169, 40, 191, 63, 82, 223, 118, 157, 207, 157, 181, 74, 127,
+			199, 226, 143, 63, 220, 179, 88, 220, 130, 47, 187, 134, 205, 38,
+			143, 65, 146, 117, 123, 19, 71, 186, 144, 80, 174, 46, 246, 193,
+			216, 46, 108, 161, 190, 214, 116, 131, 152, 135, 29, 96, 49, 73,
+			200, 91, 160, 89, 238, 183, 134, 89, 204, 169, 47, 177, 105, 196,
+			193, 164, 231, 139, 229, 52, 88, 115, 65, 229, 231, 176, 33, 115,
+			45, 89, 80, 50, 163, 12, 214, 92, 24, 48, 129, 156, 112, 7,
+			147, 159, 214, 16, 220, 193, 156, 154, 166, 127, 1, 230, 87, 194,
+			156, 55, 115, 111, 91, 165, 191, 103, 243, 189, 82, 9, 247, 195,
+			112, 43, 54, 151, 246, 64, 88, 209, 124, 238, 19, 149, 101, 68,
+			126, 67, 24, 62, 144, 10, 165, 66, 222, 8, 57, 124, 171, 14,
+			230, 112, 253, 9, 212, 163, 245, 87, 184, 188, 147, 36, 190, 68,
+			117, 212, 9, 192, 200, 17, 133, 143, 188, 22, 126, 53, 18, 145,
+			11, 220, 128, 114, 153, 49, 16, 171, 153, 65, 169, 216, 40, 125,
+			3, 6, 77, 4, 92, 199, 95, 25, 115, 99, 239, 130, 33, 55,
+			81, 228, 184, 235, 62, 120, 140, 123, 68, 31, 93, 74, 217, 137,
+			213, 164, 188, 68, 170, 248, 211, 217, 213, 145, 49, 117, 134, 172,
+			65, 73, 121, 83, 229, 5, 194, 120, 208, 183, 20, 89, 203, 111,
+			221, 190, 165, 200, 90, 134, 119, 190, 165, 200, 90, 134, 119, 190,
+			197, 134, 233, 175, 192, 173, 122, 135, 57, 183, 114, 183, 173, 210,
+			255, 102, 243, 94, 193, 79, 155, 38, 52, 93, 238, 66, 232, 238,
+			107, 238, 114, 104, 48, 216, 15, 133, 210, 90, 119, 215, 80, 180,
+			186, 207, 186, 87, 128, 43, 64, 216, 116, 98, 86, 8, 149, 244,
+			150, 128, 156, 124, 94, 220, 130, 147, 51, 230, 45, 209, 170, 131,
+			29, 11, 176, 138, 33, 211, 234, 123, 253, 21, 21, 88, 173, 174,
+			60, 102, 172, 19, 50, 199, 82, 79, 238, 253, 184, 202, 87, 67,
+			253, 77, 134, 158, 23, 48, 108, 51, 200, 94, 100, 232, 30, 32,
+			165, 49, 26, 142, 209, 136, 252, 248, 9, 241, 41, 197, 168, 244,
+			92, 158, 180, 140, 160, 243, 221, 42, 142, 169, 47, 190, 230, 24,
+			121, 87, 45, 163, 131, 203, 248, 174, 90, 70, 249, 21, 222, 119,
+			213, 50, 58, 184, 140, 239, 178, 97, 250, 215, 129, 205, 228, 153,
+			179, 150, 251, 150, 5, 250, 244, 254, 82, 249, 174, 239, 188, 246,
+			42, 163, 38, 39, 148, 162, 225, 248, 25, 24, 76, 102, 62, 117,
+			147, 225, 199, 204, 41, 111, 49, 178, 166, 178, 56, 229, 129, 52,
+			239, 171, 57, 97, 238, 96, 114, 95, 205, 73, 126, 238, 244, 190,
+			154, 19, 102, 14, 38, 247, 21, 199, 133, 196, 193, 228, 3, 197,
+			113, 49, 111, 48, 249, 64, 113, 92, 76, 27, 76, 62, 80, 28,
+			87, 126, 224, 244, 3, 229, 7, 206, 131, 181, 227, 67, 187, 172,
+			94, 129, 73, 226, 67, 211, 55, 52, 249, 225, 232, 132, 106, 18,
+			28, 178, 31, 242, 147, 244, 207, 0, 131, 5, 230, 184, 185, 134,
+			85, 250, 153, 197, 247, 170, 20, 42, 160, 31, 152, 35, 146, 92,
+			122, 35, 191, 39, 208, 95, 51, 134, 23, 231, 207, 251, 168, 25,
+			21, 10, 78, 8, 224, 74, 32, 11, 240, 229, 107, 187, 72, 9,
+			11, 106, 188, 67, 16, 129, 171, 88, 66, 1, 240, 94, 83, 129,
+			255, 5, 100, 216, 53, 197, 176, 11, 184, 10, 53, 149, 10, 10,
+			83, 30, 147, 26, 59, 170, 33, 194, 72, 77, 5, 134, 64, 190,
+			99, 82, 183, 167, 213, 43, 8, 235, 174, 155, 70, 192, 2, 85,
+			31, 56, 166, 33, 40, 169, 210, 133, 97, 42, 97, 82, 159, 156,
+			162, 255, 131, 133, 169, 132, 157, 86, 174, 109, 149, 254, 27, 107,
+			191, 25, 114, 183, 209, 136, 85, 46, 45, 61, 33, 96, 57, 152,
+			154, 107, 50, 222, 253, 81, 18, 112, 179, 61, 149, 225, 243, 41,
+			180, 76, 238, 93, 202, 105, 228, 30, 187, 74, 83, 245, 41, 95,
+			88, 81, 144, 110, 149, 148, 162, 123, 148, 86, 78, 151, 67, 128,
+			241, 244, 147, 208, 15, 225, 23, 173, 226, 49, 196, 28, 100, 62,
+			38, 129, 34, 189, 62, 68, 120, 160, 200, 30, 243, 30, 147, 64,
+			145, 61, 166, 61, 38, 129, 34, 123, 200, 122, 76, 66, 133, 240,
+			62, 252, 202, 111, 168, 16, 222, 135, 153, 238, 67, 133, 112, 204,
+			128, 76, 66, 133, 112, 76, 128, 76, 194, 201, 41, 250, 123, 128,
+			240, 34, 115, 30, 229, 190, 99, 149, 126, 203, 226, 123, 117, 74,
+			137, 239, 12, 221, 54, 132, 239, 118, 123, 253, 104, 218, 134, 251,
+			84, 58, 94, 81, 238, 81, 47, 200, 182, 104, 148, 202, 180, 33,
+			131, 51, 175, 149, 193, 24, 196, 154, 60, 82, 4, 11, 233, 137,
+			73, 87, 97, 12, 179, 19, 147, 174, 194, 24, 38, 39, 38, 93,
+			133, 49, 204, 77, 76, 186, 10, 99, 69, 192, 216, 103, 138, 81,
+			20, 145, 81, 124, 166, 24, 69, 17, 113, 244, 153, 98, 20, 69,
+			196, 209, 103, 138, 81, 20, 129, 81, 124, 110, 159, 82, 175, 192,
+			172, 247, 185, 66, 116, 17, 217, 198, 231, 3, 71, 52, 100, 49,
+			242, 249, 248, 9, 13, 17, 70, 62, 63, 89, 166, 191, 10, 242,
+			76, 63, 43, 124, 105, 229, 254, 101, 203, 42, 253, 175, 202, 166,
+			15, 68, 38, 19, 185, 195, 181, 146, 134, 60, 208, 21, 130, 17,
+			25, 128, 254, 204, 61, 226, 165, 70, 67, 249, 2, 194, 32, 137,
+			48, 112, 87, 125, 195, 61, 37, 112, 165, 240, 236, 66, 62, 15,
+			35, 80, 43, 171, 252, 90, 106, 2, 111, 164, 55, 236, 211, 213,
+			156, 140, 41, 191, 167, 2, 187, 161, 43, 220, 65, 139, 28, 24,
+			142, 18, 58, 224, 216, 84, 23, 71, 224, 206, 62, 156, 164, 177,
+			231, 75, 193, 38, 222, 242, 218, 109, 209, 216, 127, 13, 117, 82,
+			231, 47, 173, 226, 80, 154, 212, 249, 123, 150, 61, 162, 210, 191,
+			66, 142, 233, 239, 165, 201, 97, 33, 169, 243, 247, 210, 60, 190,
+			144, 240, 251, 123, 22, 27, 198, 132, 223, 253, 182, 197, 156, 95,
+			179, 108, 157, 255, 216, 202, 35, 168, 179, 35, 67, 150, 230, 95,
+			131, 140, 244, 10, 36, 0, 14, 233, 84, 210, 54, 115, 190, 111,
+			217, 71, 213, 75, 72, 248, 253, 125, 203, 214, 101, 161, 225, 239,
+			91, 135, 245, 152, 32, 147, 242, 247, 173, 35, 227, 244, 255, 130,
+			189, 66, 89, 225, 55, 173, 220, 143, 44, 171, 244, 79, 45, 158,
+			53, 82, 0, 3, 215, 226, 89, 6, 175, 74, 28, 157, 132, 44,
+			33, 97, 96, 74, 60, 125, 179, 164, 230, 127, 96, 228, 250, 35,
+			254, 24, 64, 164, 50, 14, 168, 119, 20, 196, 82, 225, 70, 177,
+			209, 94, 241, 59, 124, 40, 33, 194, 162, 123, 73, 58, 48, 221,
+			14, 38, 53, 66, 62, 213, 134, 212, 195, 97, 71, 14, 15, 60,
+			29, 245, 173, 158, 13, 8, 143, 205, 218, 81, 139, 57, 191, 105,
+			161, 27, 219, 113, 40, 172, 221, 15, 244, 218, 81, 216, 129, 206,
+			15, 244, 218, 81, 216, 130, 206, 15, 244, 218, 81, 216, 131, 206,
+			15, 44, 54, 76, 191, 129, 85, 241, 27, 0, 246, 145, 242, 25,
+			35, 50, 2, 225, 64, 112, 3, 100, 165, 135, 160, 48, 48, 200,
+			136, 24, 249, 43, 14, 138, 170, 134, 96, 157, 127, 168, 215, 153,
+			194, 150, 117, 126, 168, 179, 113, 83, 216, 179, 206, 15, 173, 209,
+			177, 90, 161, 29, 133, 73, 120, 246, 255, 29, 0, 239, 56, 58,
+			20, 70, 183, 0, 0},
 	)
 }
 
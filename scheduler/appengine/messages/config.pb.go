@@ -82,7 +82,7 @@ func (x Acl_Role) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Acl_Role.Descriptor instead.
 func (Acl_Role) EnumDescriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{1, 0}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{2, 0}
 }
 
 type TriggeringPolicy_Kind int32
@@ -148,7 +148,7 @@ func (x TriggeringPolicy_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TriggeringPolicy_Kind.Descriptor instead.
 func (TriggeringPolicy_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{3, 0}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{4, 0}
 }
 
 // ProjectConfig defines a schema for a config file that describe jobs belonging
@@ -166,6 +166,8 @@ type ProjectConfig struct {
 	//
 	// Deprecated: Marked as deprecated in go.chromium.org/luci/scheduler/appengine/messages/config.proto.
 	AclSets []*AclSet `protobuf:"bytes,3,rep,name=acl_sets,json=aclSets,proto3" json:"acl_sets,omitempty"`
+	// Calendar is a set of named calendars that schedules can refer to.
+	Calendar []*Calendar `protobuf:"bytes,5,rep,name=calendar,proto3" json:"calendar,omitempty"`
 }
 
 func (x *ProjectConfig) Reset() {
@@ -222,6 +224,80 @@ func (x *ProjectConfig) GetAclSets() []*AclSet {
 	return nil
 }
 
+func (x *ProjectConfig) GetCalendar() []*Calendar {
+	if x != nil {
+		return x.Calendar
+	}
+	return nil
+}
+
+// Calendar is a named list of blackout windows (e.g. holidays or release
+// freezes) shared by schedules of multiple jobs.
+//
+// A schedule refers to a calendar via "blackout=@<calendar id>" modifier, see
+// Job.schedule.
+type Calendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is a name of the calendar (unique for the project).
+	//
+	// Must match '^[0-9A-Za-z_\-\.]{1,100}$'.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Blackout is a list of blackout windows, in a format supported by the
+	// "blackout=..." schedule modifier, e.g. "2024-12-25" or
+	// "2024-12-20..2025-01-03". They are evaluated in the timezone of the
+	// schedule that refers to the calendar.
+	Blackout []string `protobuf:"bytes,2,rep,name=blackout,proto3" json:"blackout,omitempty"`
+}
+
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Calendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calendar) ProtoMessage() {}
+
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Calendar) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Calendar) GetBlackout() []string {
+	if x != nil {
+		return x.Blackout
+	}
+	return nil
+}
+
 // Deprecated in favor of LUCI Realms. This proto is totally unused now, exists
 // only to not break older configs that still may have deprecated fields
 // populated.
@@ -239,7 +315,7 @@ type Acl struct {
 func (x *Acl) Reset() {
 	*x = Acl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -252,7 +328,7 @@ func (x *Acl) String() string {
 func (*Acl) ProtoMessage() {}
 
 func (x *Acl) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -265,7 +341,7 @@ func (x *Acl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Acl.ProtoReflect.Descriptor instead.
 func (*Acl) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{1}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{2}
 }
 
 // Deprecated: Marked as deprecated in go.chromium.org/luci/scheduler/appengine/messages/config.proto.
@@ -301,7 +377,7 @@ type AclSet struct {
 func (x *AclSet) Reset() {
 	*x = AclSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -314,7 +390,7 @@ func (x *AclSet) String() string {
 func (*AclSet) ProtoMessage() {}
 
 func (x *AclSet) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -327,7 +403,7 @@ func (x *AclSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AclSet.ProtoReflect.Descriptor instead.
 func (*AclSet) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{2}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{3}
 }
 
 // Deprecated: Marked as deprecated in go.chromium.org/luci/scheduler/appengine/messages/config.proto.
@@ -398,7 +474,7 @@ type TriggeringPolicy struct {
 func (x *TriggeringPolicy) Reset() {
 	*x = TriggeringPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -411,7 +487,7 @@ func (x *TriggeringPolicy) String() string {
 func (*TriggeringPolicy) ProtoMessage() {}
 
 func (x *TriggeringPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,7 +500,7 @@ func (x *TriggeringPolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggeringPolicy.ProtoReflect.Descriptor instead.
 func (*TriggeringPolicy) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{3}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TriggeringPolicy) GetKind() TriggeringPolicy_Kind {
@@ -507,6 +583,21 @@ type Job struct {
 	//     run in a loop without any pauses.
 	//   - "triggered" schedule indicates that job is only started via a trigger.
 	//
+	// Cron-like and "with ... interval" schedules can be followed by modifiers:
+	//   - "tz=<IANA timezone>": evaluates the schedule in the given timezone
+	//     instead of UTC, respecting DST transitions. For example,
+	//     "0 3 * * * tz=America/Los_Angeles" runs at 3 AM local time all year.
+	//   - "jitter=<duration>": delays each tick of a cron-like schedule by a
+	//     pseudorandom duration in [0, <duration>), e.g. "jitter=15m".
+	//   - "blackout=<window>,...": windows when invocations must not start,
+	//     in the timezone of the schedule. A window is a day ("2024-12-25"),
+	//     an inclusive range of days ("2024-12-20..2025-01-03"), a day of week
+	//     ("sat"), a daily time range ("22:00-06:00") or a reference to
+	//     a calendar defined in the project config ("@release-freeze").
+	//     Can be used multiple times.
+	//   - "blackout-mode=skip|defer": whether ticks that happen during
+	//     a blackout are skipped (default) or deferred until its end.
+	//
 	// Default is "triggered".
 	Schedule string `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	// Disabled is true to disable this job.
@@ -530,6 +621,16 @@ type Job struct {
 	// If not specified defaults to GREEDY_BATCHING with 1 max concurrent
 	// invocation. See comments in TriggeringPolicy for more details.
 	TriggeringPolicy *TriggeringPolicy `protobuf:"bytes,7,opt,name=triggering_policy,json=triggeringPolicy,proto3" json:"triggering_policy,omitempty"`
+	// TriggeredByCompletion defines jobs (in the same project) whose finished
+	// invocations trigger this job.
+	//
+	// When an invocation of such job finishes with one of the listed statuses,
+	// a trigger is emitted into this job. It refers to the finished invocation
+	// and carries its revision and properties, e.g. a job triggered by a Gitiles
+	// trigger passes the commit it was triggered for further down the chain.
+	//
+	// Chains of jobs triggered by completion must not have cycles.
+	TriggeredByCompletion []*CompletionTrigger `protobuf:"bytes,9,rep,name=triggered_by_completion,json=triggeredByCompletion,proto3" json:"triggered_by_completion,omitempty"`
 	// Noop is used for testing. It is "do nothing" task.
 	Noop *NoopTask `protobuf:"bytes,100,opt,name=noop,proto3" json:"noop,omitempty"`
 	// UrlFetch can be used to make a simple HTTP call.
@@ -541,7 +642,7 @@ type Job struct {
 func (x *Job) Reset() {
 	*x = Job{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +655,7 @@ func (x *Job) String() string {
 func (*Job) ProtoMessage() {}
 
 func (x *Job) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +668,7 @@ func (x *Job) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Job.ProtoReflect.Descriptor instead.
 func (*Job) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{4}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Job) GetId() string {
@@ -621,6 +722,13 @@ func (x *Job) GetTriggeringPolicy() *TriggeringPolicy {
 	return nil
 }
 
+func (x *Job) GetTriggeredByCompletion() []*CompletionTrigger {
+	if x != nil {
+		return x.TriggeredByCompletion
+	}
+	return nil
+}
+
 func (x *Job) GetNoop() *NoopTask {
 	if x != nil {
 		return x.Noop
@@ -642,6 +750,68 @@ func (x *Job) GetBuildbucket() *BuildbucketTask {
 	return nil
 }
 
+// CompletionTrigger specifies a job whose finished invocations trigger
+// a dependent job.
+type CompletionTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Job is an ID of a job (not a trigger) in the same project.
+	Job string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Statuses are final statuses of an invocation that cause the trigger.
+	//
+	// Any of "SUCCEEDED", "FAILED", "OVERRUN" and "ABORTED". Default is
+	// ["SUCCEEDED"].
+	Statuses []string `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses,omitempty"`
+}
+
+func (x *CompletionTrigger) Reset() {
+	*x = CompletionTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionTrigger) ProtoMessage() {}
+
+func (x *CompletionTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionTrigger.ProtoReflect.Descriptor instead.
+func (*CompletionTrigger) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CompletionTrigger) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *CompletionTrigger) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
 // Trigger specifies a job that triggers other jobs.
 //
 // It is a special kind of a job that periodically checks the state of the world
@@ -666,7 +836,8 @@ type Trigger struct {
 	Realm string `protobuf:"bytes,7,opt,name=realm,proto3" json:"realm,omitempty"`
 	// Schedule describes when to run this triggering job.
 	//
-	// See Job.schedule for more info. Default is "with 30s interval".
+	// See Job.schedule for more info, including supported modifiers. Default is
+	// "with 30s interval".
 	Schedule string `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	// Disabled is true to disable this job.
 	//
@@ -691,12 +862,18 @@ type Trigger struct {
 	Noop *NoopTask `protobuf:"bytes,100,opt,name=noop,proto3" json:"noop,omitempty"`
 	// Gitiles is used to trigger jobs for new commits on Gitiles.
 	Gitiles *GitilesTask `protobuf:"bytes,101,opt,name=gitiles,proto3" json:"gitiles,omitempty"`
+	// Git is used to trigger jobs for new commits in any Git repository served
+	// over the smart HTTP protocol, e.g. on GitHub or GitLab.
+	Git *GitTask `protobuf:"bytes,102,opt,name=git,proto3" json:"git,omitempty"`
+	// HttpPoller is used to trigger jobs when a value in a JSON document served
+	// over HTTP changes.
+	HttpPoller *HttpPollerTask `protobuf:"bytes,103,opt,name=http_poller,json=httpPoller,proto3" json:"http_poller,omitempty"`
 }
 
 func (x *Trigger) Reset() {
 	*x = Trigger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -709,7 +886,7 @@ func (x *Trigger) String() string {
 func (*Trigger) ProtoMessage() {}
 
 func (x *Trigger) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +899,7 @@ func (x *Trigger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{5}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Trigger) GetId() string {
@@ -797,6 +974,20 @@ func (x *Trigger) GetGitiles() *GitilesTask {
 	return nil
 }
 
+func (x *Trigger) GetGit() *GitTask {
+	if x != nil {
+		return x.Git
+	}
+	return nil
+}
+
+func (x *Trigger) GetHttpPoller() *HttpPollerTask {
+	if x != nil {
+		return x.HttpPoller
+	}
+	return nil
+}
+
 // NoopTask is used for testing. It is a "do nothing" task that can emit fake
 // triggers.
 type NoopTask struct {
@@ -811,7 +1002,7 @@ type NoopTask struct {
 func (x *NoopTask) Reset() {
 	*x = NoopTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -824,7 +1015,7 @@ func (x *NoopTask) String() string {
 func (*NoopTask) ProtoMessage() {}
 
 func (x *NoopTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,7 +1028,7 @@ func (x *NoopTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoopTask.ProtoReflect.Descriptor instead.
 func (*NoopTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{6}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *NoopTask) GetSleepMs() int64 {
@@ -947,7 +1138,7 @@ type GitilesTask struct {
 func (x *GitilesTask) Reset() {
 	*x = GitilesTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -960,7 +1151,7 @@ func (x *GitilesTask) String() string {
 func (*GitilesTask) ProtoMessage() {}
 
 func (x *GitilesTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1164,7 @@ func (x *GitilesTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitilesTask.ProtoReflect.Descriptor instead.
 func (*GitilesTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{7}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GitilesTask) GetRepo() string {
@@ -1004,6 +1195,146 @@ func (x *GitilesTask) GetPathRegexpsExclude() []string {
 	return nil
 }
 
+// GitTask specifies a Git repository to poll for changes via the Git smart HTTP
+// protocol.
+//
+// Unlike GitilesTask, it can watch repositories hosted anywhere (e.g. on GitHub
+// or GitLab), but it only sees tips of refs: when a ref changes, a single
+// trigger is emitted for its new tip, no matter how many commits were pushed.
+//
+// Emitted triggers carry "repository", "branch" and "revision" properties.
+type GitTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repo is the https:// URL of the repository, e.g.
+	// "https://github.com/owner/repo.git".
+	//
+	// The repository must be readable anonymously.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Refs is a list of Git references to track.
+	//
+	// Supports the same syntax as GitilesTask.refs. Each tracked ref, either
+	// fully qualified or regexp, must match at least 1 ref in the repository.
+	Refs []string `protobuf:"bytes,2,rep,name=refs,proto3" json:"refs,omitempty"`
+}
+
+func (x *GitTask) Reset() {
+	*x = GitTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitTask) ProtoMessage() {}
+
+func (x *GitTask) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitTask.ProtoReflect.Descriptor instead.
+func (*GitTask) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GitTask) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *GitTask) GetRefs() []string {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+// HttpPollerTask specifies an HTTP endpoint serving a JSON document to poll for
+// changes.
+//
+// A trigger is emitted whenever the value selected by 'json_path' changes. It
+// carries "http_poller" property with "url", "json_path" and "value" fields.
+type HttpPollerTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Url is the https:// URL to fetch with GET request, e.g.
+	// "https://api.github.com/repos/owner/repo/releases/latest".
+	//
+	// The endpoint must be accessible anonymously.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// JsonPath selects the watched value in the JSON document, e.g.
+	// "$.tag_name" or "$.items[0].version".
+	//
+	// Supports field names separated by dots and array indexes in square
+	// brackets. Default is "$", i.e. the entire document.
+	JsonPath string `protobuf:"bytes,2,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+}
+
+func (x *HttpPollerTask) Reset() {
+	*x = HttpPollerTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpPollerTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpPollerTask) ProtoMessage() {}
+
+func (x *HttpPollerTask) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpPollerTask.ProtoReflect.Descriptor instead.
+func (*HttpPollerTask) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HttpPollerTask) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpPollerTask) GetJsonPath() string {
+	if x != nil {
+		return x.JsonPath
+	}
+	return ""
+}
+
 // UrlFetchTask specifies parameters for simple HTTP call.
 type UrlFetchTask struct {
 	state         protoimpl.MessageState
@@ -1021,7 +1352,7 @@ type UrlFetchTask struct {
 func (x *UrlFetchTask) Reset() {
 	*x = UrlFetchTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1034,7 +1365,7 @@ func (x *UrlFetchTask) String() string {
 func (*UrlFetchTask) ProtoMessage() {}
 
 func (x *UrlFetchTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1378,7 @@ func (x *UrlFetchTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UrlFetchTask.ProtoReflect.Descriptor instead.
 func (*UrlFetchTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{8}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UrlFetchTask) GetMethod() string {
@@ -1102,7 +1433,7 @@ type BuildbucketTask struct {
 func (x *BuildbucketTask) Reset() {
 	*x = BuildbucketTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1115,7 +1446,7 @@ func (x *BuildbucketTask) String() string {
 func (*BuildbucketTask) ProtoMessage() {}
 
 func (x *BuildbucketTask) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,7 +1459,7 @@ func (x *BuildbucketTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildbucketTask.ProtoReflect.Descriptor instead.
 func (*BuildbucketTask) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{9}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BuildbucketTask) GetServer() string {
@@ -1178,12 +1509,14 @@ type TaskDefWrapper struct {
 	UrlFetch    *UrlFetchTask    `protobuf:"bytes,2,opt,name=url_fetch,json=urlFetch,proto3" json:"url_fetch,omitempty"`
 	Buildbucket *BuildbucketTask `protobuf:"bytes,4,opt,name=buildbucket,proto3" json:"buildbucket,omitempty"`
 	Gitiles     *GitilesTask     `protobuf:"bytes,5,opt,name=gitiles,proto3" json:"gitiles,omitempty"`
+	Git         *GitTask         `protobuf:"bytes,6,opt,name=git,proto3" json:"git,omitempty"`
+	HttpPoller  *HttpPollerTask  `protobuf:"bytes,7,opt,name=http_poller,json=httpPoller,proto3" json:"http_poller,omitempty"`
 }
 
 func (x *TaskDefWrapper) Reset() {
 	*x = TaskDefWrapper{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10]
+		mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1196,7 +1529,7 @@ func (x *TaskDefWrapper) String() string {
 func (*TaskDefWrapper) ProtoMessage() {}
 
 func (x *TaskDefWrapper) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10]
+	mi := &file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +1542,7 @@ func (x *TaskDefWrapper) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskDefWrapper.ProtoReflect.Descriptor instead.
 func (*TaskDefWrapper) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{10}
+	return file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TaskDefWrapper) GetNoop() *NoopTask {
@@ -1240,6 +1573,20 @@ func (x *TaskDefWrapper) GetGitiles() *GitilesTask {
 	return nil
 }
 
+func (x *TaskDefWrapper) GetGit() *GitTask {
+	if x != nil {
+		return x.Git
+	}
+	return nil
+}
+
+func (x *TaskDefWrapper) GetHttpPoller() *HttpPollerTask {
+	if x != nil {
+		return x.HttpPoller
+	}
+	return nil
+}
+
 var File_go_chromium_org_luci_scheduler_appengine_messages_config_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc = []byte{
@@ -1253,7 +1600,7 @@ var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43,
+	0x6f, 0x74, 0x6f, 0x22, 0xf6, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x27, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x33,
@@ -1263,9 +1610,16 @@ var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	0x67, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x61, 0x63, 0x6c, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18,
 	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
 	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x07, 0x61, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x04,
-	0x10, 0x05, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x03, 0x41, 0x63, 0x6c, 0x12, 0x32, 0x0a, 0x04,
+	0x02, 0x18, 0x01, 0x52, 0x07, 0x61, 0x63, 0x6c, 0x53, 0x65, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x08,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a, 0x08,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x22, 0x8a, 0x01, 0x0a, 0x03, 0x41, 0x63, 0x6c, 0x12, 0x32, 0x0a, 0x04,
 	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68,
 	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x63,
 	0x6c, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
@@ -1302,7 +1656,7 @@ var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	0x47, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4c, 0x4f, 0x47, 0x41, 0x52, 0x49, 0x54, 0x48, 0x4d,
 	0x49, 0x43, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x10, 0x0a,
 	0x0c, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x03, 0x22,
-	0xc0, 0x03, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x9d, 0x04, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d,
 	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1a, 0x0a,
 	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -1318,19 +1672,29 @@ var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
 	0x63, 0x79, 0x52, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x18, 0x64, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04,
-	0x6e, 0x6f, 0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x09, 0x75, 0x72, 0x6c, 0x5f, 0x66, 0x65, 0x74, 0x63,
-	0x68, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x72, 0x6c, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x75, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x12, 0x43, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x66,
-	0x10, 0x67, 0x22, 0x8c, 0x03, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0e,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x5b, 0x0a, 0x17, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x15, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x65, 0x64, 0x42, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6e, 0x6f, 0x6f,
+	0x70, 0x12, 0x3b, 0x0a, 0x09, 0x75, 0x72, 0x6c, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x75, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x43,
+	0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x67, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x66, 0x10, 0x67, 0x22,
+	0x41, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x22, 0xfc, 0x03, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0e,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
 	0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72,
 	0x65, 0x61, 0x6c, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
@@ -1355,7 +1719,14 @@ var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	0x6c, 0x65, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65,
 	0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74,
 	0x69, 0x6c, 0x65, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65,
-	0x73, 0x22, 0x4c, 0x0a, 0x08, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x0a,
+	0x73, 0x12, 0x2b, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x47, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x03, 0x67, 0x69, 0x74, 0x12, 0x41,
+	0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x67, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x22, 0x4c, 0x0a, 0x08, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x0a,
 	0x08, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
 	0x07, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67,
 	0x67, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
@@ -1368,46 +1739,60 @@ var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 	0x61, 0x74, 0x68, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61,
 	0x74, 0x68, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75,
 	0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x61, 0x74, 0x68, 0x52, 0x65,
-	0x67, 0x65, 0x78, 0x70, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x59, 0x0a, 0x0c,
-	0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0e, 0x54, 0x61,
-	0x73, 0x6b, 0x44, 0x65, 0x66, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04,
-	0x6e, 0x6f, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f,
-	0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x09,
-	0x75, 0x72, 0x6c, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x08, 0x75, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x43, 0x0a, 0x0b, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x67, 0x65, 0x78, 0x70, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x07,
+	0x47, 0x69, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x22,
+	0x3f, 0x0a, 0x0e, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x59, 0x0a, 0x0c, 0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x22, 0x8f, 0x01, 0x0a, 0x0f,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xf1, 0x02,
+	0x0a, 0x0e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x66, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x37,
-	0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x07,
-	0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x42, 0x74, 0xa2,
-	0xfe, 0x23, 0x3d, 0x0a, 0x3b, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3a, 0x6c, 0x75,
-	0x63, 0x69, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x66, 0x67,
-	0x5a, 0x31, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
-	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
-	0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x2e, 0x4e, 0x6f, 0x6f, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x6e, 0x6f, 0x6f, 0x70,
+	0x12, 0x3b, 0x0a, 0x09, 0x75, 0x72, 0x6c, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x08, 0x75, 0x72, 0x6c, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x43, 0x0a,
+	0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x03, 0x67,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x03, 0x67, 0x69, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70,
+	0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x0a, 0x68, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x03, 0x10,
+	0x04, 0x42, 0x74, 0xa2, 0xfe, 0x23, 0x3d, 0x0a, 0x3b, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x61, 0x70, 0x70,
+	0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x3a, 0x6c, 0x75, 0x63, 0x69, 0x2d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x2e, 0x63, 0x66, 0x67, 0x5a, 0x31, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
+	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1423,49 +1808,59 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc
 }
 
 var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_goTypes = []interface{}{
 	(Acl_Role)(0),               // 0: scheduler.config.Acl.Role
 	(TriggeringPolicy_Kind)(0),  // 1: scheduler.config.TriggeringPolicy.Kind
 	(*ProjectConfig)(nil),       // 2: scheduler.config.ProjectConfig
-	(*Acl)(nil),                 // 3: scheduler.config.Acl
-	(*AclSet)(nil),              // 4: scheduler.config.AclSet
-	(*TriggeringPolicy)(nil),    // 5: scheduler.config.TriggeringPolicy
-	(*Job)(nil),                 // 6: scheduler.config.Job
-	(*Trigger)(nil),             // 7: scheduler.config.Trigger
-	(*NoopTask)(nil),            // 8: scheduler.config.NoopTask
-	(*GitilesTask)(nil),         // 9: scheduler.config.GitilesTask
-	(*UrlFetchTask)(nil),        // 10: scheduler.config.UrlFetchTask
-	(*BuildbucketTask)(nil),     // 11: scheduler.config.BuildbucketTask
-	(*TaskDefWrapper)(nil),      // 12: scheduler.config.TaskDefWrapper
-	(*durationpb.Duration)(nil), // 13: google.protobuf.Duration
+	(*Calendar)(nil),            // 3: scheduler.config.Calendar
+	(*Acl)(nil),                 // 4: scheduler.config.Acl
+	(*AclSet)(nil),              // 5: scheduler.config.AclSet
+	(*TriggeringPolicy)(nil),    // 6: scheduler.config.TriggeringPolicy
+	(*Job)(nil),                 // 7: scheduler.config.Job
+	(*CompletionTrigger)(nil),   // 8: scheduler.config.CompletionTrigger
+	(*Trigger)(nil),             // 9: scheduler.config.Trigger
+	(*NoopTask)(nil),            // 10: scheduler.config.NoopTask
+	(*GitilesTask)(nil),         // 11: scheduler.config.GitilesTask
+	(*GitTask)(nil),             // 12: scheduler.config.GitTask
+	(*HttpPollerTask)(nil),      // 13: scheduler.config.HttpPollerTask
+	(*UrlFetchTask)(nil),        // 14: scheduler.config.UrlFetchTask
+	(*BuildbucketTask)(nil),     // 15: scheduler.config.BuildbucketTask
+	(*TaskDefWrapper)(nil),      // 16: scheduler.config.TaskDefWrapper
+	(*durationpb.Duration)(nil), // 17: google.protobuf.Duration
 }
 var file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_depIdxs = []int32{
-	6,  // 0: scheduler.config.ProjectConfig.job:type_name -> scheduler.config.Job
-	7,  // 1: scheduler.config.ProjectConfig.trigger:type_name -> scheduler.config.Trigger
-	4,  // 2: scheduler.config.ProjectConfig.acl_sets:type_name -> scheduler.config.AclSet
-	0,  // 3: scheduler.config.Acl.role:type_name -> scheduler.config.Acl.Role
-	3,  // 4: scheduler.config.AclSet.acls:type_name -> scheduler.config.Acl
-	1,  // 5: scheduler.config.TriggeringPolicy.kind:type_name -> scheduler.config.TriggeringPolicy.Kind
-	13, // 6: scheduler.config.TriggeringPolicy.pending_timeout:type_name -> google.protobuf.Duration
-	3,  // 7: scheduler.config.Job.acls:type_name -> scheduler.config.Acl
-	5,  // 8: scheduler.config.Job.triggering_policy:type_name -> scheduler.config.TriggeringPolicy
-	8,  // 9: scheduler.config.Job.noop:type_name -> scheduler.config.NoopTask
-	10, // 10: scheduler.config.Job.url_fetch:type_name -> scheduler.config.UrlFetchTask
-	11, // 11: scheduler.config.Job.buildbucket:type_name -> scheduler.config.BuildbucketTask
-	3,  // 12: scheduler.config.Trigger.acls:type_name -> scheduler.config.Acl
-	5,  // 13: scheduler.config.Trigger.triggering_policy:type_name -> scheduler.config.TriggeringPolicy
-	8,  // 14: scheduler.config.Trigger.noop:type_name -> scheduler.config.NoopTask
-	9,  // 15: scheduler.config.Trigger.gitiles:type_name -> scheduler.config.GitilesTask
-	8,  // 16: scheduler.config.TaskDefWrapper.noop:type_name -> scheduler.config.NoopTask
-	10, // 17: scheduler.config.TaskDefWrapper.url_fetch:type_name -> scheduler.config.UrlFetchTask
-	11, // 18: scheduler.config.TaskDefWrapper.buildbucket:type_name -> scheduler.config.BuildbucketTask
-	9,  // 19: scheduler.config.TaskDefWrapper.gitiles:type_name -> scheduler.config.GitilesTask
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	7,  // 0: scheduler.config.ProjectConfig.job:type_name -> scheduler.config.Job
+	9,  // 1: scheduler.config.ProjectConfig.trigger:type_name -> scheduler.config.Trigger
+	5,  // 2: scheduler.config.ProjectConfig.acl_sets:type_name -> scheduler.config.AclSet
+	3,  // 3: scheduler.config.ProjectConfig.calendar:type_name -> scheduler.config.Calendar
+	0,  // 4: scheduler.config.Acl.role:type_name -> scheduler.config.Acl.Role
+	4,  // 5: scheduler.config.AclSet.acls:type_name -> scheduler.config.Acl
+	1,  // 6: scheduler.config.TriggeringPolicy.kind:type_name -> scheduler.config.TriggeringPolicy.Kind
+	17, // 7: scheduler.config.TriggeringPolicy.pending_timeout:type_name -> google.protobuf.Duration
+	4,  // 8: scheduler.config.Job.acls:type_name -> scheduler.config.Acl
+	6,  // 9: scheduler.config.Job.triggering_policy:type_name -> scheduler.config.TriggeringPolicy
+	8,  // 10: scheduler.config.Job.triggered_by_completion:type_name -> scheduler.config.CompletionTrigger
+	10, // 11: scheduler.config.Job.noop:type_name -> scheduler.config.NoopTask
+	14, // 12: scheduler.config.Job.url_fetch:type_name -> scheduler.config.UrlFetchTask
+	15, // 13: scheduler.config.Job.buildbucket:type_name -> scheduler.config.BuildbucketTask
+	4,  // 14: scheduler.config.Trigger.acls:type_name -> scheduler.config.Acl
+	6,  // 15: scheduler.config.Trigger.triggering_policy:type_name -> scheduler.config.TriggeringPolicy
+	10, // 16: scheduler.config.Trigger.noop:type_name -> scheduler.config.NoopTask
+	11, // 17: scheduler.config.Trigger.gitiles:type_name -> scheduler.config.GitilesTask
+	12, // 18: scheduler.config.Trigger.git:type_name -> scheduler.config.GitTask
+	13, // 19: scheduler.config.Trigger.http_poller:type_name -> scheduler.config.HttpPollerTask
+	10, // 20: scheduler.config.TaskDefWrapper.noop:type_name -> scheduler.config.NoopTask
+	14, // 21: scheduler.config.TaskDefWrapper.url_fetch:type_name -> scheduler.config.UrlFetchTask
+	15, // 22: scheduler.config.TaskDefWrapper.buildbucket:type_name -> scheduler.config.BuildbucketTask
+	11, // 23: scheduler.config.TaskDefWrapper.gitiles:type_name -> scheduler.config.GitilesTask
+	12, // 24: scheduler.config.TaskDefWrapper.git:type_name -> scheduler.config.GitTask
+	13, // 25: scheduler.config.TaskDefWrapper.http_poller:type_name -> scheduler.config.HttpPollerTask
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init() }
@@ -1487,7 +1882,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Acl); i {
+			switch v := v.(*Calendar); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1499,7 +1894,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AclSet); i {
+			switch v := v.(*Acl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1511,7 +1906,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggeringPolicy); i {
+			switch v := v.(*AclSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1523,7 +1918,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Job); i {
+			switch v := v.(*TriggeringPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1535,7 +1930,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trigger); i {
+			switch v := v.(*Job); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1547,7 +1942,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NoopTask); i {
+			switch v := v.(*CompletionTrigger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1559,7 +1954,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitilesTask); i {
+			switch v := v.(*Trigger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1571,7 +1966,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UrlFetchTask); i {
+			switch v := v.(*NoopTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1583,7 +1978,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildbucketTask); i {
+			switch v := v.(*GitilesTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,6 +1990,54 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			}
 		}
 		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpPollerTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UrlFetchTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildbucketTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskDefWrapper); i {
 			case 0:
 				return &v.state
@@ -1613,7 +2056,7 @@ func file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_init()
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_scheduler_appengine_messages_config_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,50 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/scheduler/appengine/schedule"
+)
+
+// DescribeSchedule returns a human readable description of a schedule.
+//
+// It spells out the timezone, jitter and blackout windows of the schedule,
+// e.g. "0 3 * * * (America/Los_Angeles), jitter up to 10m0s, blackouts: sat,
+// sun (skipped)". If 'now' is in a blackout window, mentions when it ends.
+func DescribeSchedule(s *schedule.Schedule, now time.Time) string {
+	var sb strings.Builder
+	sb.WriteString(s.Base())
+	if loc := s.Location(); loc != time.UTC {
+		fmt.Fprintf(&sb, " (%s)", loc)
+	}
+	if jitter := s.Jitter(); jitter != 0 {
+		fmt.Fprintf(&sb, ", jitter up to %s", jitter)
+	}
+	if blackouts := s.Blackouts(); len(blackouts) != 0 {
+		mode := "skipped"
+		if s.DefersBlackouts() {
+			mode = "deferred"
+		}
+		fmt.Fprintf(&sb, ", blackouts: %s (%s)", strings.Join(blackouts, ", "), mode)
+		if end, ok := s.InBlackout(now); ok {
+			fmt.Fprintf(&sb, "; in blackout until %s", end.In(s.Location()).Format("2006-01-02 15:04 MST"))
+		}
+	}
+	return sb.String()
+}
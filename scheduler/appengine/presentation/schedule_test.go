@@ -0,0 +1,46 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presentation
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"go.chromium.org/luci/scheduler/appengine/schedule"
+)
+
+func TestDescribeSchedule(t *testing.T) {
+	t.Parallel()
+
+	Convey("works", t, func() {
+		now := time.Date(2024, time.December, 24, 12, 0, 0, 0, time.UTC)
+		describe := func(expr string) string {
+			s, err := schedule.Parse(expr, 0)
+			So(err, ShouldBeNil)
+			return DescribeSchedule(s, now)
+		}
+
+		So(describe("0 3 * * *"), ShouldEqual, "0 3 * * *")
+		So(describe("with 30s interval"), ShouldEqual, "with 30s interval")
+		So(describe("0 3 * * * tz=America/Los_Angeles jitter=10m"), ShouldEqual,
+			"0 3 * * * (America/Los_Angeles), jitter up to 10m0s")
+		So(describe("0 3 * * * blackout=sat,sun"), ShouldEqual,
+			"0 3 * * *, blackouts: sat, sun (skipped)")
+		So(describe("0 3 * * * tz=Europe/Berlin blackout=2024-12-24..2024-12-26 blackout-mode=defer"), ShouldEqual,
+			"0 3 * * * (Europe/Berlin), blackouts: 2024-12-24..2024-12-26 (deferred); in blackout until 2024-12-27 00:00 CET")
+	})
+}
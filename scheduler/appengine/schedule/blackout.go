@@ -0,0 +1,174 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// blackout is a recurring or one-off window of time when invocations must not
+// start.
+type blackout interface {
+	// end returns the end of the window if 't' is in the window.
+	//
+	// 't' is in the timezone of the schedule.
+	end(t time.Time) (end time.Time, ok bool)
+
+	// String returns the window as it appears in the schedule string.
+	String() string
+}
+
+// weekdays maps day of week names to time.Weekday.
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ValidateBlackout validates a single blackout window, e.g. "2024-12-25".
+//
+// References to calendars are not allowed.
+func ValidateBlackout(w string) error {
+	_, err := parseBlackout(w)
+	return err
+}
+
+// parseBlackout parses a single blackout window.
+func parseBlackout(w string) (blackout, error) {
+	switch {
+	case w == "":
+		return nil, errors.New("empty blackout window")
+	case strings.HasPrefix(w, "@"):
+		return nil, fmt.Errorf("unresolved calendar reference %q", w)
+	}
+	if wd, ok := weekdays[strings.ToLower(w)]; ok {
+		return weekdayBlackout(wd), nil
+	}
+	if strings.Contains(w, ":") {
+		from, to, ok := strings.Cut(w, "-")
+		if !ok {
+			return nil, fmt.Errorf("bad blackout window %q - expecting \"HH:MM-HH:MM\"", w)
+		}
+		start, err := parseTimeOfDay(from)
+		if err != nil {
+			return nil, fmt.Errorf("bad blackout window %q - %s", w, err)
+		}
+		end, err := parseTimeOfDay(to)
+		if err != nil {
+			return nil, fmt.Errorf("bad blackout window %q - %s", w, err)
+		}
+		if start == end {
+			return nil, fmt.Errorf("bad blackout window %q - it is empty", w)
+		}
+		return dailyBlackout{from: start, to: end}, nil
+	}
+	from, to, isRange := strings.Cut(w, "..")
+	if !isRange {
+		to = from
+	}
+	for _, d := range []string{from, to} {
+		if _, err := time.Parse("2006-01-02", d); err != nil {
+			return nil, fmt.Errorf("bad blackout window %q - expecting a date, a range of dates, a day of week or a time range", w)
+		}
+	}
+	if to < from {
+		return nil, fmt.Errorf("bad blackout window %q - the range ends before it starts", w)
+	}
+	return dateBlackout{from: from, to: to}, nil
+}
+
+// parseTimeOfDay parses "HH:MM" into minutes since midnight.
+func parseTimeOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("bad time of day %q", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// midnight returns the start of the day 'days' days after the day of 't'.
+func midnight(t time.Time, days int) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d+days, 0, 0, 0, 0, t.Location())
+}
+
+// dateBlackout is an inclusive range of dates.
+type dateBlackout struct {
+	from, to string // "YYYY-MM-DD", can be compared lexicographically
+}
+
+func (b dateBlackout) end(t time.Time) (time.Time, bool) {
+	day := t.Format("2006-01-02")
+	if day < b.from || day > b.to {
+		return time.Time{}, false
+	}
+	to, _ := time.ParseInLocation("2006-01-02", b.to, t.Location())
+	return midnight(to, 1), true
+}
+
+func (b dateBlackout) String() string {
+	if b.from == b.to {
+		return b.from
+	}
+	return b.from + ".." + b.to
+}
+
+// weekdayBlackout is a day of week.
+type weekdayBlackout time.Weekday
+
+func (b weekdayBlackout) end(t time.Time) (time.Time, bool) {
+	if t.Weekday() != time.Weekday(b) {
+		return time.Time{}, false
+	}
+	return midnight(t, 1), true
+}
+
+func (b weekdayBlackout) String() string {
+	return strings.ToLower(time.Weekday(b).String()[:3])
+}
+
+// dailyBlackout is a daily time range, possibly spanning midnight.
+type dailyBlackout struct {
+	from, to int // minutes since midnight, 'to' is exclusive
+}
+
+func (b dailyBlackout) endAt(t time.Time, days int) time.Time {
+	m := midnight(t, days)
+	return time.Date(m.Year(), m.Month(), m.Day(), b.to/60, b.to%60, 0, 0, t.Location())
+}
+
+func (b dailyBlackout) end(t time.Time) (time.Time, bool) {
+	now := t.Hour()*60 + t.Minute()
+	switch {
+	case b.from < b.to && now >= b.from && now < b.to:
+		return b.endAt(t, 0), true
+	case b.from > b.to && now >= b.from:
+		return b.endAt(t, 1), true
+	case b.from > b.to && now < b.to:
+		return b.endAt(t, 0), true
+	}
+	return time.Time{}, false
+}
+
+func (b dailyBlackout) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", b.from/60, b.from%60, b.to/60, b.to%60)
+}
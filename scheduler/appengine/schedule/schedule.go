@@ -43,8 +43,19 @@ type Schedule struct {
 	cronExpr  *cronexpr.Expression // set for absolute schedules
 	interval  time.Duration        // set for relative schedules
 	triggered bool                 // set for triggered schedule
+
+	base           string         // the schedule without modifiers
+	loc            *time.Location // set if "tz=..." modifier is used
+	jitter         time.Duration  // set if "jitter=..." modifier is used
+	blackouts      []blackout     // set if "blackout=..." modifiers are used
+	deferBlackouts bool           // set if "blackout-mode=defer" is used
 }
 
+// maxSkips is the maximum number of ticks skipped when looking for the next
+// tick outside of blackout windows, before giving up and returning
+// DistantFuture.
+const maxSkips = 10000
+
 // IsAbsolute is true for schedules that do not depend on a job state.
 //
 // Absolute schedules are basically static time tables specifying when to
@@ -69,7 +80,7 @@ func (s *Schedule) Next(now, prev time.Time) time.Time {
 
 	// For an absolute schedule just look at the time table.
 	if s.cronExpr != nil {
-		return s.cronExpr.Next(now)
+		return s.nextAbsolute(now)
 	}
 
 	// Using relative schedule and this is a first invocation ever? Randomize
@@ -80,13 +91,175 @@ func (s *Schedule) Next(now, prev time.Time) time.Time {
 		// Pass seed through math/rand to make small seeds (used by unit tests),
 		// less special.
 		rnd := rand.New(rand.NewSource(int64(s.randSeed))).Float64()
-		return now.Add(time.Duration(float64(s.interval) * rnd))
+		return s.afterBlackouts(now.Add(time.Duration(float64(s.interval) * rnd)))
 	}
 	next := prev.Add(s.interval)
 	if next.Sub(now) < 0 {
 		next = now
 	}
-	return next
+	return s.afterBlackouts(next)
+}
+
+// nextAbsolute returns the next tick of an absolute schedule after 'now',
+// taking into account the timezone, jitter and blackout windows.
+func (s *Schedule) nextAbsolute(now time.Time) time.Time {
+	if s.loc == nil && s.jitter == 0 && len(s.blackouts) == 0 {
+		return s.cronExpr.Next(now)
+	}
+	callerLoc := now.Location()
+	if s.loc != nil {
+		now = now.In(s.loc)
+	}
+	// A tick before 'now' may still happen after 'now' once the jitter is
+	// applied, so start looking for ticks a jitter window before 'now'.
+	tick := s.cronNext(now.Add(-s.jitter))
+	for i := 0; i < maxSkips; i++ {
+		if tick.IsZero() {
+			return tick
+		}
+		next := tick.Add(s.jitterFor(tick))
+		if !next.After(now) {
+			tick = s.cronNext(tick)
+			continue
+		}
+		end, ok := s.blackoutEnd(next)
+		if !ok {
+			return next.In(callerLoc)
+		}
+		if s.deferBlackouts {
+			return s.afterBlackouts(end).In(callerLoc)
+		}
+		// Skip all ticks that happen in the blackout window.
+		from := end.Add(-s.jitter - time.Nanosecond)
+		if from.Before(tick) {
+			from = tick
+		}
+		tick = s.cronNext(from)
+	}
+	return DistantFuture
+}
+
+// cronNext returns the first tick of the cron expression strictly after 't'.
+//
+// If the schedule has a timezone, the cron expression is evaluated on the
+// wall-clock time in this timezone, since cronexpr doesn't handle DST
+// transitions. A tick that falls into a spring-forward gap (e.g. 02:30 on the
+// day clocks jump from 02:00 to 03:00) is shifted forward by the size of the
+// gap (i.e. to 03:30). A tick that happens twice when clocks are turned back
+// is triggered only once.
+func (s *Schedule) cronNext(t time.Time) time.Time {
+	if s.loc == nil {
+		return s.cronExpr.Next(t)
+	}
+	t = t.In(s.loc)
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	for i := 0; i < maxSkips; i++ {
+		wall = s.cronExpr.Next(wall)
+		if wall.IsZero() {
+			return wall
+		}
+		next := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), s.loc)
+		if next.Hour() != wall.Hour() || next.Minute() != wall.Minute() {
+			// The wall-clock time doesn't exist. time.Date may interpret it using
+			// the zone offset either before or after the transition. The offset
+			// before the transition gives the later instant, i.e. the one shifted
+			// forward by the size of the gap.
+			_, off := next.Zone()
+			if shifted := wall.Add(-time.Duration(off) * time.Second); shifted.After(next) {
+				next = shifted
+			}
+			next = next.In(s.loc)
+		}
+		if next.After(t) {
+			return next
+		}
+	}
+	return DistantFuture
+}
+
+// jitterFor returns a pseudorandom delay to apply to the given tick.
+//
+// The delay depends only on the tick and the random seed of the schedule, so
+// it stays the same no matter how many times Next is called.
+func (s *Schedule) jitterFor(tick time.Time) time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	rnd := rand.New(rand.NewSource(int64(s.randSeed) ^ tick.Unix()))
+	return time.Duration(rnd.Int63n(int64(s.jitter)))
+}
+
+// afterBlackouts returns 't' if it is not in a blackout window, or the end of
+// the blackout window(s) it is in.
+func (s *Schedule) afterBlackouts(t time.Time) time.Time {
+	if len(s.blackouts) == 0 {
+		return t
+	}
+	callerLoc := t.Location()
+	for i := 0; i < maxSkips; i++ {
+		end, ok := s.blackoutEnd(t)
+		if !ok {
+			return t.In(callerLoc)
+		}
+		t = end
+	}
+	return DistantFuture
+}
+
+// blackoutEnd returns the end of the blackout window that contains 't', if
+// any.
+func (s *Schedule) blackoutEnd(t time.Time) (end time.Time, ok bool) {
+	t = t.In(s.Location())
+	for _, b := range s.blackouts {
+		if end, ok := b.end(t); ok {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// InBlackout returns true and the end of the blackout window if 'now' is in
+// one of the blackout windows of the schedule.
+func (s *Schedule) InBlackout(now time.Time) (end time.Time, ok bool) {
+	end, ok = s.blackoutEnd(now)
+	if ok {
+		end = s.afterBlackouts(end).In(now.Location())
+	}
+	return
+}
+
+// Base is the schedule without any modifiers, e.g. "0 3 * * *".
+func (s *Schedule) Base() string {
+	return s.base
+}
+
+// Location is the timezone the schedule is evaluated in. UTC by default.
+func (s *Schedule) Location() *time.Location {
+	if s.loc == nil {
+		return time.UTC
+	}
+	return s.loc
+}
+
+// Jitter is the maximum random delay added to ticks of an absolute schedule.
+func (s *Schedule) Jitter() time.Duration {
+	return s.jitter
+}
+
+// Blackouts are the blackout windows of the schedule, as they appear in the
+// schedule string.
+func (s *Schedule) Blackouts() []string {
+	out := make([]string, len(s.blackouts))
+	for i, b := range s.blackouts {
+		out[i] = b.String()
+	}
+	return out
+}
+
+// DefersBlackouts is true if ticks that happen in a blackout window are
+// deferred until the end of the window, rather than skipped.
+func (s *Schedule) DefersBlackouts() bool {
+	return s.deferBlackouts
 }
 
 // String serializes the schedule to a human readable string.
@@ -112,30 +285,173 @@ func (s *Schedule) String() string {
 //     in a loop without any pauses.
 //   - "triggered" schedule indicates that job is always started via a trigger.
 //     'Next' always returns DistantFuture constant.
+//
+// Cron-like and relative schedules can be followed by modifiers:
+//   - "tz=America/Los_Angeles": evaluates a cron-like expression (and
+//     blackout windows) in the given IANA timezone instead of UTC, so that
+//     e.g. "0 3 * * * tz=America/Los_Angeles" runs at 3 AM local time both in
+//     winter and in summer.
+//   - "jitter=10m": delays each tick of a cron-like schedule by a pseudorandom
+//     duration in [0, 10m). Should be smaller than the interval between ticks.
+//   - "blackout=<window>[,<window>...]": defines windows when invocations must
+//     not start, in the timezone of the schedule. Can be used multiple times.
+//     A window is a day ("2024-12-25"), an inclusive range of days
+//     ("2024-12-20..2025-01-03"), a day of week ("sat") or a daily time range
+//     that may span midnight ("22:00-06:00").
+//   - "blackout-mode=skip|defer": whether ticks that happen in a blackout
+//     window are skipped (default), or deferred to the end of the window.
+//     Ticks of relative schedules are always deferred.
+//
+// Blackout windows can also refer to calendars with "@<calendar id>", but
+// such references must be resolved with ExpandCalendars before parsing.
 func Parse(expr string, randSeed uint64) (sched *Schedule, err error) {
+	base, mods := splitModifiers(expr)
+	if base == "" {
+		return nil, errors.New("missing schedule before modifiers")
+	}
 	toParse := ""
-	switch expr {
+	switch base {
 	case "triggered":
+		if len(mods) != 0 {
+			return nil, errors.New("\"triggered\" schedule can't have modifiers")
+		}
 		return &Schedule{
-			asString:  "triggered",
+			asString:  expr,
 			randSeed:  randSeed,
 			triggered: true,
+			base:      base,
 		}, nil
 	case "continuously":
 		toParse = "with 0s interval"
 	default:
-		toParse = expr
+		toParse = base
 	}
 	if strings.HasPrefix(toParse, "with ") {
 		sched, err = parseWithSchedule(toParse, randSeed)
 	} else {
 		sched, err = parseCronSchedule(toParse, randSeed)
 	}
-	if sched != nil {
-		sched.asString = expr
-		sched.randSeed = randSeed
+	if err != nil {
+		return nil, err
+	}
+	if err := sched.parseModifiers(mods); err != nil {
+		return nil, err
+	}
+	sched.asString = expr
+	sched.randSeed = randSeed
+	sched.base = base
+	return sched, nil
+}
+
+// splitModifiers splits a schedule string into the schedule itself and
+// trailing "key=value" modifiers.
+func splitModifiers(expr string) (base string, mods []string) {
+	tokens := strings.Fields(expr)
+	i := len(tokens)
+	for i > 0 && strings.Contains(tokens[i-1], "=") {
+		i--
+	}
+	if i == len(tokens) {
+		return strings.TrimSpace(expr), nil
+	}
+	return strings.Join(tokens[:i], " "), tokens[i:]
+}
+
+// parseModifiers parses "key=value" modifiers and applies them to the
+// schedule.
+func (s *Schedule) parseModifiers(mods []string) error {
+	seen := make(map[string]bool, len(mods))
+	for _, mod := range mods {
+		key, val, _ := strings.Cut(mod, "=")
+		if seen[key] && key != "blackout" {
+			return fmt.Errorf("modifier %q is used more than once", key)
+		}
+		seen[key] = true
+		switch key {
+		case "tz":
+			loc, err := time.LoadLocation(val)
+			if err != nil {
+				return fmt.Errorf("bad timezone %q - %s", val, err)
+			}
+			s.loc = loc
+		case "jitter":
+			if s.cronExpr == nil {
+				return errors.New("jitter is supported only by cron-like schedules")
+			}
+			jitter, err := time.ParseDuration(val)
+			if err != nil {
+				return fmt.Errorf("bad jitter %q - %s", val, err)
+			}
+			if jitter <= 0 {
+				return fmt.Errorf("bad jitter %q - it must be positive", val)
+			}
+			s.jitter = jitter
+		case "blackout":
+			for _, w := range strings.Split(val, ",") {
+				b, err := parseBlackout(w)
+				if err != nil {
+					return err
+				}
+				s.blackouts = append(s.blackouts, b)
+			}
+		case "blackout-mode":
+			switch val {
+			case "skip":
+				s.deferBlackouts = false
+			case "defer":
+				s.deferBlackouts = true
+			default:
+				return fmt.Errorf("bad blackout mode %q - expecting \"skip\" or \"defer\"", val)
+			}
+		default:
+			return fmt.Errorf("unknown modifier %q", key)
+		}
+	}
+	return nil
+}
+
+// ExpandCalendars replaces "@<calendar id>" references in blackout windows
+// of the schedule with the windows of the corresponding calendars.
+//
+// Returns the schedule unchanged if it has no such references.
+func ExpandCalendars(expr string, calendars map[string][]string) (string, error) {
+	base, mods := splitModifiers(expr)
+	changed := false
+	for i, mod := range mods {
+		key, val, _ := strings.Cut(mod, "=")
+		if key != "blackout" || !strings.Contains(val, "@") {
+			continue
+		}
+		var windows []string
+		for _, w := range strings.Split(val, ",") {
+			if !strings.HasPrefix(w, "@") {
+				windows = append(windows, w)
+				continue
+			}
+			cal, ok := calendars[w[1:]]
+			if !ok {
+				return "", fmt.Errorf("unknown calendar %q", w[1:])
+			}
+			windows = append(windows, cal...)
+		}
+		if len(windows) == 0 {
+			// An empty calendar, drop the modifier.
+			mods[i] = ""
+		} else {
+			mods[i] = "blackout=" + strings.Join(windows, ",")
+		}
+		changed = true
+	}
+	if !changed {
+		return expr, nil
+	}
+	tokens := []string{base}
+	for _, mod := range mods {
+		if mod != "" {
+			tokens = append(tokens, mod)
+		}
 	}
-	return sched, err
+	return strings.Join(tokens, " "), nil
 }
 
 // parseWithSchedule parses "with <interval> interval" schedule string.
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
 )
 
 var (
@@ -145,3 +146,160 @@ func TestRelativeSchedule(t *testing.T) {
 		So(sched.Next(epoch.Add(31*time.Second), epoch.Add(15*time.Second)), ShouldResemble, epoch.Add(31*time.Second))
 	})
 }
+
+func TestModifiers(t *testing.T) {
+	t.Parallel()
+
+	Convey("Parsing", t, func() {
+		sched, err := Parse("0 3 * * * tz=America/Los_Angeles jitter=10m blackout=sat,sun blackout=2024-12-20..2025-01-03 blackout-mode=defer", 0)
+		So(err, ShouldBeNil)
+		So(sched.String(), ShouldEqual, "0 3 * * * tz=America/Los_Angeles jitter=10m blackout=sat,sun blackout=2024-12-20..2025-01-03 blackout-mode=defer")
+		So(sched.Base(), ShouldEqual, "0 3 * * *")
+		So(sched.Location().String(), ShouldEqual, "America/Los_Angeles")
+		So(sched.Jitter(), ShouldEqual, 10*time.Minute)
+		So(sched.Blackouts(), ShouldResemble, []string{"sat", "sun", "2024-12-20..2025-01-03"})
+		So(sched.DefersBlackouts(), ShouldBeTrue)
+
+		sched, err = Parse("with 10s interval blackout=22:00-06:00", 0)
+		So(err, ShouldBeNil)
+		So(sched.Location(), ShouldEqual, time.UTC)
+		So(sched.Blackouts(), ShouldResemble, []string{"22:00-06:00"})
+	})
+
+	Convey("Parsing errors", t, func() {
+		bad := map[string]string{
+			"triggered tz=UTC":                          "can't have modifiers",
+			"tz=UTC":                                    "missing schedule",
+			"0 3 * * * tz=Mars/Olympus_Mons":            "bad timezone",
+			"0 3 * * * color=blue":                      "unknown modifier",
+			"0 3 * * * tz=UTC tz=UTC":                   "used more than once",
+			"0 3 * * * jitter=-1m":                      "must be positive",
+			"with 10s interval jitter=1m":               "only by cron-like schedules",
+			"0 3 * * * blackout=":                       "empty blackout window",
+			"0 3 * * * blackout=@holidays":              "unresolved calendar reference",
+			"0 3 * * * blackout=2024-13-01":             "expecting a date",
+			"0 3 * * * blackout=2025-01-01..2024-01-01": "ends before it starts",
+			"0 3 * * * blackout=22:00-22:00":            "it is empty",
+			"0 3 * * * blackout=25:00-06:00":            "bad time of day",
+			"0 3 * * * blackout=sat blackout-mode=no":   "bad blackout mode",
+		}
+		for expr, msg := range bad {
+			sched, err := Parse(expr, 0)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, msg)
+			So(sched, ShouldBeNil)
+		}
+	})
+
+	Convey("Timezone is respected across DST transitions", t, func() {
+		la, err := time.LoadLocation("America/Los_Angeles")
+		So(err, ShouldBeNil)
+		now := parseTime("2024-03-08 12:00:00 +0000 UTC")
+		So(timeTable("0 3 * * * tz=America/Los_Angeles", now, 3), ShouldResemble, []time.Time{
+			time.Date(2024, 3, 9, 3, 0, 0, 0, la).UTC(),  // 11:00 UTC, PST
+			time.Date(2024, 3, 10, 3, 0, 0, 0, la).UTC(), // 10:00 UTC, PDT
+			time.Date(2024, 3, 11, 3, 0, 0, 0, la).UTC(),
+		})
+	})
+
+	Convey("Ticks in the spring-forward gap are shifted", t, func() {
+		la, err := time.LoadLocation("America/Los_Angeles")
+		So(err, ShouldBeNil)
+		// On 2024-03-10 clocks jump from 02:00 PST to 03:00 PDT.
+		now := parseTime("2024-03-09 12:00:00 +0000 UTC")
+		So(timeTable("30 2 * * * tz=America/Los_Angeles", now, 3), ShouldResemble, []time.Time{
+			time.Date(2024, 3, 10, 3, 30, 0, 0, la).UTC(), // 10:30 UTC, PDT
+			time.Date(2024, 3, 11, 2, 30, 0, 0, la).UTC(), // 09:30 UTC, PDT
+			time.Date(2024, 3, 12, 2, 30, 0, 0, la).UTC(),
+		})
+	})
+
+	Convey("Repeated ticks on fall-back run once", t, func() {
+		// On 2024-11-03 clocks go back from 02:00 PDT to 01:00 PST, so 01:30
+		// happens twice: at 08:30 UTC and at 09:30 UTC.
+		now := parseTime("2024-11-02 12:00:00 +0000 UTC")
+		So(timeTable("30 1 * * * tz=America/Los_Angeles", now, 3), ShouldResemble, []time.Time{
+			parseTime("2024-11-03 08:30:00 +0000 UTC"), // PDT
+			parseTime("2024-11-04 09:30:00 +0000 UTC"), // PST
+			parseTime("2024-11-05 09:30:00 +0000 UTC"),
+		})
+	})
+
+	Convey("Jitter is deterministic and bounded", t, func() {
+		sched, err := Parse("0 * * * * jitter=10m", 42)
+		So(err, ShouldBeNil)
+		next := sched.Next(epoch, time.Time{})
+		So(next, ShouldHappenOnOrAfter, epoch.Add(18*time.Minute))
+		So(next, ShouldHappenBefore, epoch.Add(28*time.Minute))
+		// Asking again before the jittered tick returns the same tick.
+		So(sched.Next(epoch.Add(18*time.Minute), time.Time{}), ShouldResemble, next)
+		// Asking after it returns the next hour's tick.
+		following := sched.Next(next, time.Time{})
+		So(following, ShouldHappenOnOrAfter, epoch.Add(78*time.Minute))
+		So(following, ShouldHappenBefore, epoch.Add(88*time.Minute))
+	})
+
+	Convey("Blackouts", t, func() {
+		// 2015-09-14 is a Monday.
+		Convey("Skipped", func() {
+			So(timeTable("0 7 * * * blackout=tue,2015-09-17", epoch, 2), ShouldResemble, []time.Time{
+				closestMidnight.Add(31 * time.Hour), // Wed
+				closestMidnight.Add(79 * time.Hour), // Fri
+			})
+		})
+		Convey("Deferred", func() {
+			So(timeTable("0 * * * * blackout=02:00-04:30 blackout-mode=defer", closestMidnight.Add(90*time.Minute), 3), ShouldResemble, []time.Time{
+				closestMidnight.Add(270 * time.Minute), // 04:30
+				closestMidnight.Add(5 * time.Hour),
+				closestMidnight.Add(6 * time.Hour),
+			})
+		})
+		Convey("Spanning midnight", func() {
+			So(timeTable("0 * * * * blackout=22:00-02:00", epoch, 2), ShouldResemble, []time.Time{
+				closestMidnight.Add(2 * time.Hour),
+				closestMidnight.Add(3 * time.Hour),
+			})
+		})
+		Convey("Relative schedules are deferred", func() {
+			sched, err := Parse("with 1h interval blackout=2015-09-15", 0)
+			So(err, ShouldBeNil)
+			prev := closestMidnight.Add(-30 * time.Minute)
+			So(sched.Next(prev, prev), ShouldResemble, closestMidnight.Add(24*time.Hour))
+		})
+		Convey("Always in blackout", func() {
+			sched, err := Parse("0 * * * * blackout=mon,tue,wed,thu,fri,sat,sun", 0)
+			So(err, ShouldBeNil)
+			So(sched.Next(epoch, time.Time{}), ShouldResemble, DistantFuture)
+		})
+		Convey("InBlackout", func() {
+			sched, err := Parse("0 * * * * blackout=2015-09-14 blackout=2015-09-15", 0)
+			So(err, ShouldBeNil)
+			end, ok := sched.InBlackout(epoch)
+			So(ok, ShouldBeTrue)
+			So(end, ShouldResemble, closestMidnight.Add(24*time.Hour))
+			_, ok = sched.InBlackout(end)
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("ExpandCalendars", t, func() {
+		calendars := map[string][]string{
+			"holidays": {"2024-12-25", "2025-01-01"},
+			"empty":    nil,
+		}
+		out, err := ExpandCalendars("0 3 * * * tz=UTC", calendars)
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, "0 3 * * * tz=UTC")
+
+		out, err = ExpandCalendars("0 3 * * *  blackout=sat,@holidays", calendars)
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, "0 3 * * * blackout=sat,2024-12-25,2025-01-01")
+
+		out, err = ExpandCalendars("0 3 * * * blackout=@empty blackout-mode=defer", calendars)
+		So(err, ShouldBeNil)
+		So(out, ShouldEqual, "0 3 * * * blackout-mode=defer")
+
+		_, err = ExpandCalendars("0 3 * * * blackout=@unknown", calendars)
+		So(err, ShouldErrLike, `unknown calendar "unknown"`)
+	})
+}
@@ -0,0 +1,285 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package git implements a task that polls a Git repository hosted anywhere
+// via the Git smart HTTP protocol and emits a trigger when a watched ref
+// changes.
+package git
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"google.golang.org/api/pubsub/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.chromium.org/luci/common/api/gitiles"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/config/validation"
+	"go.chromium.org/luci/gae/service/urlfetch"
+
+	api "go.chromium.org/luci/scheduler/api/scheduler/v1"
+	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/messages"
+	"go.chromium.org/luci/scheduler/appengine/task"
+	gitilestask "go.chromium.org/luci/scheduler/appengine/task/gitiles"
+	"go.chromium.org/luci/scheduler/appengine/task/gitiles/pb"
+)
+
+// fetchTimeout limits how long fetching refs is allowed to last.
+const fetchTimeout = time.Minute
+
+// defaultMaxTriggersPerInvocation limits number of triggers emitted per one
+// invocation.
+const defaultMaxTriggersPerInvocation = 100
+
+// TaskManager implements task.Manager interface for tasks defined with
+// GitTask proto message.
+type TaskManager struct {
+	maxTriggersPerInvocation int // Avoid choking on DS or runtime limits.
+}
+
+// Name is part of Manager interface.
+func (m TaskManager) Name() string {
+	return "git"
+}
+
+// ProtoMessageType is part of Manager interface.
+func (m TaskManager) ProtoMessageType() proto.Message {
+	return (*messages.GitTask)(nil)
+}
+
+// Traits is part of Manager interface.
+func (m TaskManager) Traits() task.Traits {
+	return task.Traits{
+		Multistage: false, // we don't use task.StatusRunning state
+	}
+}
+
+// ValidateProtoMessage is part of Manager interface.
+func (m TaskManager) ValidateProtoMessage(c *validation.Context, msg proto.Message, realmID string) {
+	cfg, ok := msg.(*messages.GitTask)
+	if !ok {
+		c.Errorf("wrong type %T, expecting *messages.GitTask", msg)
+		return
+	}
+
+	c.Enter("repo")
+	if cfg.Repo == "" {
+		c.Errorf("field 'repo' is required")
+	} else {
+		u, err := url.Parse(cfg.Repo)
+		switch {
+		case err != nil:
+			c.Errorf("invalid URL %q: %s", cfg.Repo, err)
+		case u.Scheme != "https" || u.Host == "":
+			c.Errorf("not an https:// URL: %q", cfg.Repo)
+		case u.RawQuery != "" || u.Fragment != "":
+			c.Errorf("must not have a query or a fragment: %q", cfg.Repo)
+		}
+	}
+	c.Exit()
+
+	c.Enter("refs")
+	if len(cfg.Refs) == 0 {
+		c.Errorf("at least one ref is required")
+	}
+	gitiles.ValidateRefSet(c, cfg.Refs)
+	c.Exit()
+}
+
+// LaunchTask is part of Manager interface.
+func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
+	cfg := ctl.Task().(*messages.GitTask)
+	ctl.DebugLog("Repo: %s, Refs: %s", cfg.Repo, cfg.Refs)
+
+	known, current, err := m.fetchRefsState(c, ctl, cfg)
+	if err != nil {
+		ctl.DebugLog("Error fetching state of the world: %s", err)
+		return err
+	}
+
+	watched := gitiles.NewRefSet(cfg.Refs)
+	changed := 0
+	for ref := range known {
+		switch {
+		case !watched.Has(ref):
+			ctl.DebugLog("Ref %s is no longer watched", ref)
+			delete(known, ref)
+			changed++
+		case current[ref] == "":
+			ctl.DebugLog("Ref %s deleted", ref)
+			delete(known, ref)
+			changed++
+		}
+	}
+
+	// Safeguard against too many changes such as the first run after config
+	// change to watch many more refs than before.
+	maxTriggersPerInvocation := m.maxTriggersPerInvocation
+	if maxTriggersPerInvocation <= 0 {
+		maxTriggersPerInvocation = defaultMaxTriggersPerInvocation
+	}
+	emitted := 0
+	for _, ref := range sortedRefs(current) {
+		tip := current[ref]
+		oldTip, existed := known[ref]
+		if oldTip == tip {
+			continue
+		}
+		if emitted == maxTriggersPerInvocation {
+			ctl.DebugLog("Emitted %d triggers, postponing the rest", emitted)
+			break
+		}
+		if existed {
+			ctl.DebugLog("Ref %s updated: %s => %s", ref, oldTip, tip)
+		} else {
+			ctl.DebugLog("Ref %s is new: %s", ref, tip)
+		}
+		ctl.EmitTrigger(c, makeTrigger(cfg.Repo, ref, tip))
+		known[ref] = tip
+		emitted++
+		changed++
+	}
+
+	if changed == 0 {
+		ctl.DebugLog("No changes detected")
+		ctl.State().Status = task.StatusSucceeded
+		return nil
+	}
+	// Force save to ensure triggers are actually emitted.
+	if err := ctl.Save(c); err != nil {
+		// At this point, triggers have not been sent, so bail now and don't save
+		// the refs' tips newest values.
+		return err
+	}
+	// Note: this reports luci/scheduler/task/gitiles/stored/* metrics for the
+	// job, since the state is stored the same way as by the Gitiles poller.
+	if err := gitilestask.SaveRefTips(c, ctl.JobID(), stateKey(cfg.Repo), known); err != nil {
+		return err
+	}
+	ctl.DebugLog("Saved %d known refs", len(known))
+	ctl.State().Status = task.StatusSucceeded
+	return nil
+}
+
+// AbortTask is part of Manager interface.
+func (m TaskManager) AbortTask(c context.Context, ctl task.Controller) error {
+	return nil
+}
+
+// ExamineNotification is part of Manager interface.
+func (m TaskManager) ExamineNotification(c context.Context, msg *pubsub.PubsubMessage) string {
+	return ""
+}
+
+// HandleNotification is part of Manager interface.
+func (m TaskManager) HandleNotification(c context.Context, ctl task.Controller, msg *pubsub.PubsubMessage) error {
+	return errors.New("not implemented")
+}
+
+// HandleTimer is part of Manager interface.
+func (m TaskManager) HandleTimer(c context.Context, ctl task.Controller, name string, payload []byte) error {
+	return errors.New("not implemented")
+}
+
+// GetDebugState is part of Manager interface.
+func (m TaskManager) GetDebugState(c context.Context, ctl task.ControllerReadOnly) (*internal.DebugManagerState, error) {
+	cfg := ctl.Task().(*messages.GitTask)
+	known, current, err := m.fetchRefsState(c, ctl, cfg)
+	if err != nil {
+		ctl.DebugLog("Error fetching state of the world: %s", err)
+		return nil, err
+	}
+
+	debugRefs := func(refs map[string]string) []*pb.DebugState_Ref {
+		out := make([]*pb.DebugState_Ref, 0, len(refs))
+		for _, ref := range sortedRefs(refs) {
+			out = append(out, &pb.DebugState_Ref{Ref: ref, Commit: refs[ref]})
+		}
+		return out
+	}
+
+	return &internal.DebugManagerState{
+		GitPoller: &pb.DebugState{
+			Known:   debugRefs(known),
+			Current: debugRefs(current),
+		},
+	}, nil
+}
+
+// fetchRefsState returns tips of refs seen by the previous invocation and the
+// current tips of watched refs in the repository.
+func (m TaskManager) fetchRefsState(c context.Context, ctl task.ControllerReadOnly, cfg *messages.GitTask) (known, current map[string]string, err error) {
+	if known, err = gitilestask.LoadRefTips(c, ctl.JobID(), stateKey(cfg.Repo)); err != nil {
+		return nil, nil, err
+	}
+
+	fc, cancel := clock.WithTimeout(c, fetchTimeout)
+	defer cancel()
+	all, err := fetchRefs(fc, &http.Client{Transport: urlfetch.Get(c)}, cfg.Repo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, missingRefs := gitiles.NewRefSet(cfg.Refs).Filter(all)
+	ctl.DebugLog("Fetched refs: %d from datastore, %d from the repository (%d watched)", len(known), len(all), len(current))
+	if len(missingRefs) > 0 {
+		sort.Strings(missingRefs)
+		ctl.DebugLog("The following configured refs didn't match a single actual ref: %s\n"+
+			"Hint: is %q readable anonymously?", missingRefs, cfg.Repo)
+		return nil, nil, errors.Reason("%d unresolved refs", len(missingRefs)).Err()
+	}
+	return known, current, nil
+}
+
+// makeTrigger returns a trigger emitted when the ref gets the given tip.
+func makeTrigger(repo, ref, tip string) *internal.Trigger {
+	props, err := structpb.NewStruct(map[string]any{
+		"repository": repo,
+		"branch":     ref,
+		"revision":   tip,
+	})
+	if err != nil {
+		panic(err) // impossible, all values are strings
+	}
+	return &internal.Trigger{
+		Id:    fmt.Sprintf("%s/+/%s@%s", repo, ref, tip),
+		Title: tip,
+		Payload: &internal.Trigger_Buildbucket{
+			Buildbucket: &api.BuildbucketTrigger{Properties: props},
+		},
+	}
+}
+
+// stateKey is a key used to store tips of refs seen in the repository.
+func stateKey(repo string) string {
+	return "git:" + repo
+}
+
+func sortedRefs(refs map[string]string) []string {
+	keys := make([]string, 0, len(refs))
+	for ref := range refs {
+		keys = append(keys, ref)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,335 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/config/validation"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/urlfetch"
+
+	"go.chromium.org/luci/scheduler/appengine/messages"
+	"go.chromium.org/luci/scheduler/appengine/task"
+	gitilestask "go.chromium.org/luci/scheduler/appengine/task/gitiles"
+	"go.chromium.org/luci/scheduler/appengine/task/utils/tasktest"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+var _ task.Manager = (*TaskManager)(nil)
+
+const (
+	sha1 = "1111111111111111111111111111111111111111"
+	sha2 = "2222222222222222222222222222222222222222"
+	sha3 = "3333333333333333333333333333333333333333"
+)
+
+// pktLine encodes a pkt-line.
+func pktLine(s string) string {
+	return fmt.Sprintf("%04x%s", len(s)+4, s)
+}
+
+// advertisement returns a smart HTTP ref advertisement of the given refs.
+func advertisement(refs map[string]string) string {
+	names := make([]string, 0, len(refs))
+	for ref := range refs {
+		names = append(names, ref)
+	}
+	sort.Strings(names)
+
+	sb := strings.Builder{}
+	sb.WriteString(pktLine("# service=git-upload-pack\n"))
+	sb.WriteString("0000")
+	if len(names) == 0 {
+		sb.WriteString(pktLine(strings.Repeat("0", 40) + " capabilities^{}\x00side-band-64k\n"))
+	}
+	for i, ref := range names {
+		line := refs[ref] + " " + ref
+		if i == 0 {
+			line += "\x00multi_ack side-band-64k"
+		}
+		sb.WriteString(pktLine(line + "\n"))
+	}
+	sb.WriteString("0000")
+	return sb.String()
+}
+
+func TestParseRefAdvertisement(t *testing.T) {
+	t.Parallel()
+
+	Convey("parseRefAdvertisement", t, func() {
+		parse := func(s string) (map[string]string, error) {
+			return parseRefAdvertisement(strings.NewReader(s))
+		}
+
+		Convey("Works", func() {
+			refs, err := parse(advertisement(map[string]string{
+				"HEAD":              sha1,
+				"refs/heads/main":   sha1,
+				"refs/heads/stable": sha2,
+			}))
+			So(err, ShouldBeNil)
+			So(refs, ShouldResemble, map[string]string{
+				"HEAD":              sha1,
+				"refs/heads/main":   sha1,
+				"refs/heads/stable": sha2,
+			})
+		})
+
+		Convey("Peels annotated tags", func() {
+			refs, err := parse(pktLine(sha1+" refs/heads/main\x00caps\n") +
+				pktLine(sha2+" refs/tags/v1\n") +
+				pktLine(sha3+" refs/tags/v1^{}\n") +
+				"0000")
+			So(err, ShouldBeNil)
+			So(refs, ShouldResemble, map[string]string{
+				"refs/heads/main": sha1,
+				"refs/tags/v1":    sha3,
+			})
+		})
+
+		Convey("Empty repository", func() {
+			refs, err := parse(advertisement(nil))
+			So(err, ShouldBeNil)
+			So(refs, ShouldBeEmpty)
+		})
+
+		Convey("Protocol version 1", func() {
+			refs, err := parse(pktLine("version 1\n") + pktLine(sha1+" refs/heads/main\x00caps\n") + "0000")
+			So(err, ShouldBeNil)
+			So(refs, ShouldResemble, map[string]string{"refs/heads/main": sha1})
+		})
+
+		Convey("Malformed line", func() {
+			_, err := parse(pktLine("not-a-sha refs/heads/main\n") + "0000")
+			So(err, ShouldErrLike, "malformed line")
+		})
+
+		Convey("Truncated", func() {
+			_, err := parse(pktLine(sha1 + " refs/heads/main\n"))
+			So(err, ShouldErrLike, "bad ref advertisement")
+		})
+	})
+}
+
+func TestLaunchTask(t *testing.T) {
+	t.Parallel()
+
+	Convey("LaunchTask", t, func() {
+		c := memory.Use(context.Background())
+		c = urlfetch.Set(c, http.DefaultTransport)
+
+		repoRefs := map[string]string{}
+		status := http.StatusOK
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/repo.git/info/refs" || r.URL.Query().Get("service") != "git-upload-pack" {
+				http.NotFound(w, r)
+				return
+			}
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+			w.Header().Set("Content-Type", advertisementContentType)
+			fmt.Fprint(w, advertisement(repoRefs))
+		}))
+		defer ts.Close()
+
+		cfg := &messages.GitTask{
+			Repo: ts.URL + "/repo.git",
+			Refs: []string{"refs/heads/main", `regexp:refs/branch-heads/\d+`},
+		}
+		jobID := "proj/git"
+		m := TaskManager{}
+
+		launch := func() (*tasktest.TestController, error) {
+			ctl := &tasktest.TestController{
+				TaskMessage:   cfg,
+				SaveCallback:  func() error { return nil },
+				OverrideJobID: jobID,
+			}
+			return ctl, m.LaunchTask(c, ctl)
+		}
+		triggered := func(ctl *tasktest.TestController) (out []string) {
+			for _, t := range ctl.Triggers {
+				props := t.GetBuildbucket().GetProperties().GetFields()
+				out = append(out, fmt.Sprintf("%s@%s",
+					props["branch"].GetStringValue(), props["revision"].GetStringValue()))
+			}
+			return
+		}
+		knownRefs := func() map[string]string {
+			refs, err := gitilestask.LoadRefTips(c, jobID, stateKey(cfg.Repo))
+			So(err, ShouldBeNil)
+			return refs
+		}
+
+		Convey("Emits triggers for new and changed refs", func() {
+			repoRefs["refs/heads/main"] = sha1
+			repoRefs["refs/heads/ignored"] = sha1
+			repoRefs["refs/branch-heads/1"] = sha2
+
+			ctl, err := launch()
+			So(err, ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+			So(triggered(ctl), ShouldResemble, []string{
+				"refs/branch-heads/1@" + sha2,
+				"refs/heads/main@" + sha1,
+			})
+			So(ctl.Triggers[1], ShouldResembleProto, makeTrigger(cfg.Repo, "refs/heads/main", sha1))
+			So(ctl.Triggers[1].Id, ShouldEqual, cfg.Repo+"/+/refs/heads/main@"+sha1)
+			So(knownRefs(), ShouldResemble, map[string]string{
+				"refs/heads/main":     sha1,
+				"refs/branch-heads/1": sha2,
+			})
+
+			Convey("No changes", func() {
+				ctl, err := launch()
+				So(err, ShouldBeNil)
+				So(ctl.Triggers, ShouldBeEmpty)
+			})
+
+			Convey("Ref updated and deleted", func() {
+				repoRefs["refs/heads/main"] = sha3
+				delete(repoRefs, "refs/branch-heads/1")
+				repoRefs["refs/branch-heads/2"] = sha2
+
+				ctl, err := launch()
+				So(err, ShouldBeNil)
+				So(triggered(ctl), ShouldResemble, []string{
+					"refs/branch-heads/2@" + sha2,
+					"refs/heads/main@" + sha3,
+				})
+				So(knownRefs(), ShouldResemble, map[string]string{
+					"refs/heads/main":     sha3,
+					"refs/branch-heads/2": sha2,
+				})
+			})
+
+			Convey("Failed save doesn't update state", func() {
+				repoRefs["refs/heads/main"] = sha3
+				ctl := &tasktest.TestController{
+					TaskMessage:   cfg,
+					SaveCallback:  func() error { return transient.Tag.Apply(fmt.Errorf("boom")) },
+					OverrideJobID: jobID,
+				}
+				So(m.LaunchTask(c, ctl), ShouldErrLike, "boom")
+				So(knownRefs()["refs/heads/main"], ShouldEqual, sha1)
+			})
+		})
+
+		Convey("Limits number of triggers", func() {
+			m.maxTriggersPerInvocation = 1
+			repoRefs["refs/heads/main"] = sha1
+			repoRefs["refs/branch-heads/1"] = sha2
+
+			ctl, err := launch()
+			So(err, ShouldBeNil)
+			So(triggered(ctl), ShouldResemble, []string{"refs/branch-heads/1@" + sha2})
+
+			ctl, err = launch()
+			So(err, ShouldBeNil)
+			So(triggered(ctl), ShouldResemble, []string{"refs/heads/main@" + sha1})
+		})
+
+		Convey("Missing refs", func() {
+			repoRefs["refs/heads/main"] = sha1
+			_, err := launch()
+			So(err, ShouldErrLike, "1 unresolved refs")
+		})
+
+		Convey("Transient HTTP error", func() {
+			status = http.StatusServiceUnavailable
+			_, err := launch()
+			So(err, ShouldErrLike, "HTTP 503")
+			So(transient.Tag.In(err), ShouldBeTrue)
+		})
+
+		Convey("Not a smart HTTP server", func() {
+			cfg.Repo = ts.URL + "/elsewhere"
+			_, err := launch()
+			So(err, ShouldErrLike, "HTTP 404")
+			So(transient.Tag.In(err), ShouldBeFalse)
+		})
+
+		Convey("GetDebugState", func() {
+			repoRefs["refs/heads/main"] = sha1
+			repoRefs["refs/branch-heads/1"] = sha2
+			ctl := &tasktest.TestController{TaskMessage: cfg, OverrideJobID: jobID}
+			state, err := m.GetDebugState(c, ctl)
+			So(err, ShouldBeNil)
+			So(state.GitPoller.Known, ShouldBeEmpty)
+			So(state.GitPoller.Current, ShouldHaveLength, 2)
+			So(state.GitPoller.Current[0].Ref, ShouldEqual, "refs/branch-heads/1")
+		})
+	})
+}
+
+func TestValidateProtoMessage(t *testing.T) {
+	t.Parallel()
+
+	Convey("ValidateProtoMessage", t, func() {
+		ctx := &validation.Context{Context: context.Background()}
+		m := TaskManager{}
+		validate := func(msg proto.Message) error {
+			m.ValidateProtoMessage(ctx, msg, "some-project:some-realm")
+			return ctx.Finalize()
+		}
+
+		Convey("Valid", func() {
+			So(validate(&messages.GitTask{
+				Repo: "https://github.com/owner/repo.git",
+				Refs: []string{"refs/heads/main", `regexp:refs/tags/v\d+`},
+			}), ShouldBeNil)
+		})
+
+		Convey("Wrong type", func() {
+			So(validate(&messages.GitilesTask{}), ShouldErrLike, "wrong type")
+		})
+
+		Convey("No repo", func() {
+			So(validate(&messages.GitTask{Refs: []string{"refs/heads/main"}}), ShouldErrLike, "field 'repo' is required")
+		})
+
+		Convey("Not https", func() {
+			So(validate(&messages.GitTask{
+				Repo: "git://github.com/owner/repo.git",
+				Refs: []string{"refs/heads/main"},
+			}), ShouldErrLike, "not an https:// URL")
+		})
+
+		Convey("No refs", func() {
+			So(validate(&messages.GitTask{Repo: "https://github.com/owner/repo.git"}), ShouldErrLike, "at least one ref is required")
+		})
+
+		Convey("Bad ref", func() {
+			So(validate(&messages.GitTask{
+				Repo: "https://github.com/owner/repo.git",
+				Refs: []string{"main"},
+			}), ShouldNotBeNil)
+		})
+	})
+}
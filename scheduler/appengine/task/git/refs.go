@@ -0,0 +1,149 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+)
+
+// advertisementContentType is Content-Type of a ref advertisement served by
+// a Git server implementing the smart HTTP protocol.
+const advertisementContentType = "application/x-git-upload-pack-advertisement"
+
+// fetchRefs fetches tips of all refs of a repository via the Git smart HTTP
+// protocol, i.e. like "git ls-remote" does.
+//
+// Returns a map from a ref name to the SHA1 of the object it points to, with
+// annotated tags peeled to the commit they tag.
+func fetchRefs(c context.Context, client *http.Client, repo string) (map[string]string, error) {
+	url := strings.TrimSuffix(repo, "/") + "/info/refs?service=git-upload-pack"
+	req, err := http.NewRequestWithContext(c, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Some Git hosts serve the smart protocol only to Git clients.
+	req.Header.Set("User-Agent", "git/2.0 (luci-scheduler)")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, transient.Tag.Apply(errors.Annotate(err, "failed to fetch refs").Err())
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests:
+		return nil, errors.Reason("failed to fetch refs: HTTP %d", resp.StatusCode).Tag(transient.Tag).Err()
+	case resp.StatusCode != http.StatusOK:
+		return nil, errors.Reason("failed to fetch refs: HTTP %d", resp.StatusCode).Err()
+	case resp.Header.Get("Content-Type") != advertisementContentType:
+		return nil, errors.Reason("%q doesn't support the Git smart HTTP protocol: unexpected Content-Type %q",
+			repo, resp.Header.Get("Content-Type")).Err()
+	}
+
+	refs, err := parseRefAdvertisement(resp.Body)
+	if err != nil {
+		return nil, transient.Tag.Apply(err)
+	}
+	return refs, nil
+}
+
+// parseRefAdvertisement parses refs advertised by a Git server in response to
+// "GET <repo>/info/refs?service=git-upload-pack" (protocol version 0 or 1).
+//
+// See https://git-scm.com/docs/http-protocol#_smart_clients.
+func parseRefAdvertisement(r io.Reader) (map[string]string, error) {
+	refs := map[string]string{}
+	header := true // true until the first ref line
+	for {
+		line, flush, err := readPktLine(r)
+		switch {
+		case err != nil:
+			return nil, errors.Annotate(err, "bad ref advertisement").Err()
+		case flush:
+			return refs, nil
+		case header && strings.HasPrefix(line, "# service="):
+			// Smart HTTP responses start with the service announcement, followed
+			// by a flush packet.
+			if _, flush, err := readPktLine(r); err != nil || !flush {
+				return nil, errors.Reason("bad ref advertisement: no flush after %q", line).Err()
+			}
+			continue
+		case header && line == "version 1":
+			continue
+		}
+		header = false
+
+		// The first ref is followed by a list of capabilities after NUL.
+		if i := strings.IndexByte(line, 0); i != -1 {
+			line = line[:i]
+		}
+		sha, ref, ok := strings.Cut(line, " ")
+		if !ok || !isObjectID(sha) || ref == "" {
+			return nil, errors.Reason("bad ref advertisement: malformed line %q", line).Err()
+		}
+		switch peeled, isPeeled := strings.CutSuffix(ref, "^{}"); {
+		case ref == "capabilities^{}":
+			// An empty repository, no refs.
+		case isPeeled:
+			// An annotated tag peeled to a commit. It follows the tag itself.
+			refs[peeled] = sha
+		default:
+			refs[ref] = sha
+		}
+	}
+}
+
+// readPktLine reads a single pkt-line.
+//
+// Returns the line without the trailing LF, or flush == true for a flush
+// packet.
+func readPktLine(r io.Reader) (line string, flush bool, err error) {
+	var hdr [4]byte
+	if _, err = io.ReadFull(r, hdr[:]); err != nil {
+		return "", false, err
+	}
+	n, err := strconv.ParseUint(string(hdr[:]), 16, 16)
+	switch {
+	case err != nil:
+		return "", false, fmt.Errorf("bad pkt-line length %q", hdr[:])
+	case n == 0:
+		return "", true, nil
+	case n < 4:
+		return "", false, fmt.Errorf("unexpected special packet %q", hdr[:])
+	}
+	buf := make([]byte, n-4)
+	if _, err = io.ReadFull(r, buf); err != nil {
+		return "", false, err
+	}
+	return strings.TrimSuffix(string(buf), "\n"), false, nil
+}
+
+// isObjectID returns true if s is a hex-encoded SHA1 or SHA256 object ID.
+func isObjectID(s string) bool {
+	if len(s) != 40 && len(s) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -72,30 +72,60 @@ func repositoryID(jobID, repo string) (string, error) {
 	return strings.Join([]string{jobID, host, proj}, "\x00 "), nil
 }
 
+// refTipsID is used as Repository ID for states stored via SaveRefTips.
+//
+// It never collides with IDs produced by repositoryID, since keys are prefixed
+// with a kind of the repository, e.g. "git:".
+func refTipsID(jobID, key string) string {
+	return strings.Join([]string{jobID, key}, "\x00 ")
+}
+
+// LoadRefTips loads tips of refs of some repository watched by a job, as
+// previously stored by SaveRefTips.
+//
+// The key identifies the repository within the job, e.g. "git:<url>". Returns
+// an empty map if nothing was stored yet.
+func LoadRefTips(c context.Context, jobID, key string) (map[string]string, error) {
+	return loadStateByID(c, refTipsID(jobID, key))
+}
+
+// SaveRefTips stores tips of refs of some repository watched by a job.
+//
+// It uses the same compact storage format as the Gitiles poller. See
+// LoadRefTips.
+//
+// Reports the same luci/scheduler/task/gitiles/stored/* metrics as the
+// Gitiles poller does. They are keyed by the job ID, so the job kind can be
+// told from it.
+func SaveRefTips(c context.Context, jobID, key string, refTips map[string]string) error {
+	return saveStateByID(c, jobID, refTipsID(jobID, key), refTips)
+}
+
 // loadStateEntry loads Repository instance from datastore.
-func loadStateEntry(c context.Context, jobID, repo string) (*Repository, error) {
-	id, err := repositoryID(jobID, repo)
-	if err != nil {
-		return nil, err
-	}
+func loadStateEntry(c context.Context, id string) (*Repository, error) {
 	entry := &Repository{ID: id}
-	if err := ds.Get(c, entry); err == ds.ErrNoSuchEntity {
+	err := ds.Get(c, entry)
+	if err == ds.ErrNoSuchEntity {
 		return nil, err
 	}
 	return entry, transient.Tag.Apply(err)
 }
 
-func saveStateEntry(c context.Context, jobID, repo string, compressedBytes []byte) error {
-	id, err := repositoryID(jobID, repo)
-	if err != nil {
-		return err
-	}
+func saveStateEntry(c context.Context, id string, compressedBytes []byte) error {
 	entry := Repository{ID: id, CompressedState: compressedBytes}
 	return transient.Tag.Apply(ds.Put(c, &entry))
 }
 
 func loadState(c context.Context, jobID, repo string) (map[string]string, error) {
-	switch stored, err := loadStateEntry(c, jobID, repo); {
+	id, err := repositoryID(jobID, repo)
+	if err != nil {
+		return nil, err
+	}
+	return loadStateByID(c, id)
+}
+
+func loadStateByID(c context.Context, id string) (map[string]string, error) {
+	switch stored, err := loadStateEntry(c, id); {
 	case err == ds.ErrNoSuchEntity:
 		return map[string]string{}, nil
 	case err != nil:
@@ -132,6 +162,14 @@ func loadState(c context.Context, jobID, repo string) (map[string]string, error)
 }
 
 func saveState(c context.Context, jobID, repo string, refTips map[string]string) error {
+	id, err := repositoryID(jobID, repo)
+	if err != nil {
+		return err
+	}
+	return saveStateByID(c, jobID, id, refTips)
+}
+
+func saveStateByID(c context.Context, jobID, id string, refTips map[string]string) error {
 	// There could be many refTips in repos, though most will share some prefix.
 	// So we trade CPU to save this efficiently.
 
@@ -177,7 +215,7 @@ func saveState(c context.Context, jobID, repo string, refTips map[string]string)
 
 	metricTaskGitilesStoredRefs.Set(c, int64(len(refTips)), jobID)
 	metricTaskGitilesStoredSize.Set(c, int64(compressed.Len()), jobID)
-	return saveStateEntry(c, jobID, repo, compressed.Bytes())
+	return saveStateEntry(c, id, compressed.Bytes())
 }
 
 type sortedSpaces []*pb.RefSpace
@@ -0,0 +1,334 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httppoller implements a task that polls an HTTP endpoint serving
+// a JSON document and emits a trigger when a value in it changes.
+package httppoller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+
+	"google.golang.org/api/pubsub/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/config/validation"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/urlfetch"
+
+	api "go.chromium.org/luci/scheduler/api/scheduler/v1"
+	"go.chromium.org/luci/scheduler/appengine/internal"
+	"go.chromium.org/luci/scheduler/appengine/messages"
+	"go.chromium.org/luci/scheduler/appengine/task"
+)
+
+// fetchTimeout limits how long fetching the document is allowed to last.
+const fetchTimeout = time.Minute
+
+// maxDocumentSize limits the size of the fetched document.
+const maxDocumentSize = 4 * 1024 * 1024
+
+// maxTitleLen limits the length of the trigger title derived from the value.
+const maxTitleLen = 100
+
+// State stores the last seen value of the polled document.
+type State struct {
+	_kind  string         `gae:"$kind,httppoller.State"`
+	_extra ds.PropertyMap `gae:"-,extra"`
+
+	// ID is uniquely derived from jobID, URL and JSON path, see stateID().
+	ID string `gae:"$id"`
+
+	// Value is the last seen value, serialized as JSON.
+	Value []byte `gae:",noindex"`
+}
+
+func stateID(jobID string, cfg *messages.HttpPollerTask) string {
+	return strings.Join([]string{jobID, cfg.Url, cfg.JsonPath}, "\x00 ")
+}
+
+// TaskManager implements task.Manager interface for tasks defined with
+// HttpPollerTask proto message.
+type TaskManager struct {
+}
+
+// Name is part of Manager interface.
+func (m TaskManager) Name() string {
+	return "http_poller"
+}
+
+// ProtoMessageType is part of Manager interface.
+func (m TaskManager) ProtoMessageType() proto.Message {
+	return (*messages.HttpPollerTask)(nil)
+}
+
+// Traits is part of Manager interface.
+func (m TaskManager) Traits() task.Traits {
+	return task.Traits{
+		Multistage: false, // we don't use task.StatusRunning state
+	}
+}
+
+// ValidateProtoMessage is part of Manager interface.
+func (m TaskManager) ValidateProtoMessage(c *validation.Context, msg proto.Message, realmID string) {
+	cfg, ok := msg.(*messages.HttpPollerTask)
+	if !ok {
+		c.Errorf("wrong type %T, expecting *messages.HttpPollerTask", msg)
+		return
+	}
+
+	c.Enter("url")
+	if cfg.Url == "" {
+		c.Errorf("field 'url' is required")
+	} else {
+		u, err := url.Parse(cfg.Url)
+		switch {
+		case err != nil:
+			c.Errorf("invalid URL %q: %s", cfg.Url, err)
+		case u.Scheme != "https" || u.Host == "":
+			c.Errorf("not an https:// URL: %q", cfg.Url)
+		}
+	}
+	c.Exit()
+
+	c.Enter("json_path")
+	if _, err := parseJSONPath(cfg.JsonPath); err != nil {
+		c.Errorf("bad JSON path %q: %s", cfg.JsonPath, err)
+	}
+	c.Exit()
+}
+
+// LaunchTask is part of Manager interface.
+func (m TaskManager) LaunchTask(c context.Context, ctl task.Controller) error {
+	cfg := ctl.Task().(*messages.HttpPollerTask)
+	ctl.DebugLog("URL: %s, JSON path: %s", cfg.Url, jsonPathOrDefault(cfg.JsonPath))
+
+	value, err := fetchValue(c, cfg)
+	if err != nil {
+		ctl.DebugLog("Error fetching the value: %s", err)
+		return err
+	}
+	// encoding/json sorts object keys, so the serialized value is canonical.
+	current, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	state := &State{ID: stateID(ctl.JobID(), cfg)}
+	switch err := ds.Get(c, state); {
+	case err == ds.ErrNoSuchEntity:
+		ctl.DebugLog("The value is seen for the first time: %s", current)
+	case err != nil:
+		return transient.Tag.Apply(err)
+	case bytes.Equal(state.Value, current):
+		ctl.DebugLog("No changes detected")
+		ctl.State().Status = task.StatusSucceeded
+		return nil
+	default:
+		ctl.DebugLog("The value changed: %s => %s", state.Value, current)
+	}
+
+	trigger, err := makeTrigger(ctl, cfg, value, current)
+	if err != nil {
+		return err
+	}
+	ctl.EmitTrigger(c, trigger)
+
+	// Force save to ensure the trigger is actually emitted.
+	if err := ctl.Save(c); err != nil {
+		// At this point, the trigger has not been sent, so bail now and don't
+		// save the new value.
+		return err
+	}
+	state.Value = current
+	if err := ds.Put(c, state); err != nil {
+		return transient.Tag.Apply(err)
+	}
+	ctl.State().Status = task.StatusSucceeded
+	return nil
+}
+
+// AbortTask is part of Manager interface.
+func (m TaskManager) AbortTask(c context.Context, ctl task.Controller) error {
+	return nil
+}
+
+// ExamineNotification is part of Manager interface.
+func (m TaskManager) ExamineNotification(c context.Context, msg *pubsub.PubsubMessage) string {
+	return ""
+}
+
+// HandleNotification is part of Manager interface.
+func (m TaskManager) HandleNotification(c context.Context, ctl task.Controller, msg *pubsub.PubsubMessage) error {
+	return errors.New("not implemented")
+}
+
+// HandleTimer is part of Manager interface.
+func (m TaskManager) HandleTimer(c context.Context, ctl task.Controller, name string, payload []byte) error {
+	return errors.New("not implemented")
+}
+
+// GetDebugState is part of Manager interface.
+func (m TaskManager) GetDebugState(c context.Context, ctl task.ControllerReadOnly) (*internal.DebugManagerState, error) {
+	return nil, fmt.Errorf("no debug state")
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// fetchValue fetches the JSON document and selects the watched value in it.
+func fetchValue(c context.Context, cfg *messages.HttpPollerTask) (any, error) {
+	path, err := parseJSONPath(cfg.JsonPath)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad JSON path %q", cfg.JsonPath).Err()
+	}
+
+	fc, cancel := clock.WithTimeout(c, fetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(fc, "GET", cfg.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	client := &http.Client{Transport: urlfetch.Get(c)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, transient.Tag.Apply(errors.Annotate(err, "failed to fetch %q", cfg.Url).Err())
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests:
+		return nil, errors.Reason("failed to fetch %q: HTTP %d", cfg.Url, resp.StatusCode).Tag(transient.Tag).Err()
+	case resp.StatusCode != http.StatusOK:
+		return nil, errors.Reason("failed to fetch %q: HTTP %d", cfg.Url, resp.StatusCode).Err()
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize+1))
+	switch {
+	case err != nil:
+		return nil, transient.Tag.Apply(errors.Annotate(err, "failed to read the response").Err())
+	case len(body) > maxDocumentSize:
+		return nil, errors.Reason("the response is larger than %d bytes", maxDocumentSize).Err()
+	}
+
+	// Keep numbers as json.Number to avoid losing precision of big integers,
+	// which would hide their changes.
+	var doc any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, errors.Annotate(err, "the response is not a valid JSON").Err()
+	}
+	if dec.More() {
+		return nil, errors.Reason("the response is not a valid JSON: unexpected data after the top-level value").Err()
+	}
+	return evalJSONPath(doc, path)
+}
+
+// maxExactInt is the maximum integer that float64 can represent exactly, along
+// with all smaller integers.
+const maxExactInt = 1 << 53
+
+// propertyValue converts a value produced by fetchValue to a value accepted by
+// structpb.NewValue.
+//
+// Numbers are converted to float64. Integers that can't be represented as
+// float64 exactly are converted to strings with their decimal representation.
+func propertyValue(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			if i > maxExactInt || i < -maxExactInt {
+				return v.String()
+			}
+			return float64(i)
+		}
+		if f, err := v.Float64(); err == nil {
+			if !strings.ContainsAny(v.String(), ".eE") {
+				return v.String() // an integer that doesn't fit into int64
+			}
+			return f
+		}
+		return v.String()
+	case []any:
+		out := make([]any, len(v))
+		for i, elem := range v {
+			out[i] = propertyValue(elem)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, elem := range v {
+			out[k] = propertyValue(elem)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// makeTrigger returns a trigger emitted when the watched value changes.
+//
+// Big integers in the value are passed as strings in the trigger properties,
+// see propertyValue.
+func makeTrigger(ctl task.Controller, cfg *messages.HttpPollerTask, value any, serialized []byte) (*internal.Trigger, error) {
+	props, err := structpb.NewStruct(map[string]any{
+		"http_poller": map[string]any{
+			"url":       cfg.Url,
+			"json_path": jsonPathOrDefault(cfg.JsonPath),
+			"value":     propertyValue(value),
+		},
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to convert the value to properties").Err()
+	}
+
+	title := string(serialized)
+	if len(title) > maxTitleLen {
+		// Don't cut a multi-byte UTF-8 character in half.
+		cut := maxTitleLen - 3
+		for cut > 0 && !utf8.RuneStart(title[cut]) {
+			cut--
+		}
+		title = title[:cut] + "..."
+	}
+	return &internal.Trigger{
+		Id:    fmt.Sprintf("http_poller:%s:%d", ctl.JobID(), ctl.InvocationID()),
+		Title: title,
+		Url:   cfg.Url,
+		Payload: &internal.Trigger_Buildbucket{
+			Buildbucket: &api.BuildbucketTrigger{Properties: props},
+		},
+	}, nil
+}
+
+func jsonPathOrDefault(path string) string {
+	if path == "" {
+		return "$"
+	}
+	return path
+}
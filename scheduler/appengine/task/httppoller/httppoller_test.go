@@ -0,0 +1,280 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httppoller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/config/validation"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/urlfetch"
+
+	"go.chromium.org/luci/scheduler/appengine/messages"
+	"go.chromium.org/luci/scheduler/appengine/task"
+	"go.chromium.org/luci/scheduler/appengine/task/utils/tasktest"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+var _ task.Manager = (*TaskManager)(nil)
+
+func TestJSONPath(t *testing.T) {
+	t.Parallel()
+
+	Convey("JSON path", t, func() {
+		var doc any
+		So(json.Unmarshal([]byte(`{"a": {"b": [1, {"c": "d"}]}}`), &doc), ShouldBeNil)
+
+		eval := func(path string) (any, error) {
+			parsed, err := parseJSONPath(path)
+			So(err, ShouldBeNil)
+			return evalJSONPath(doc, parsed)
+		}
+
+		Convey("Works", func() {
+			v, err := eval("$.a.b[1].c")
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, "d")
+
+			v, err = eval("$.a.b[0]")
+			So(err, ShouldBeNil)
+			So(v, ShouldEqual, 1)
+
+			v, err = eval("")
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, doc)
+		})
+
+		Convey("Missing values", func() {
+			_, err := eval("$.a.x")
+			So(err, ShouldErrLike, "$.a.x: no such field")
+			_, err = eval("$.a.b[2]")
+			So(err, ShouldErrLike, "$.a.b[2]: index out of range")
+			_, err = eval("$.a[0]")
+			So(err, ShouldErrLike, "$.a: not an array")
+			_, err = eval("$.a.b.c")
+			So(err, ShouldErrLike, "$.a.b: not an object")
+		})
+
+		Convey("Bad syntax", func() {
+			for path, msg := range map[string]string{
+				"a.b":     `must start with "$"`,
+				"$..a":    "empty field name",
+				"$.a[1":   `unclosed "["`,
+				"$.a[-1]": "bad array index",
+				"$a":      "unexpected",
+			} {
+				_, err := parseJSONPath(path)
+				So(err, ShouldErrLike, msg)
+			}
+		})
+	})
+}
+
+func TestLaunchTask(t *testing.T) {
+	t.Parallel()
+
+	Convey("LaunchTask", t, func() {
+		c := memory.Use(context.Background())
+		c = urlfetch.Set(c, http.DefaultTransport)
+
+		doc := `{"tag_name": "v1", "assets": []}`
+		status := http.StatusOK
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			fmt.Fprint(w, doc)
+		}))
+		defer ts.Close()
+
+		cfg := &messages.HttpPollerTask{
+			Url:      ts.URL + "/releases/latest",
+			JsonPath: "$.tag_name",
+		}
+		m := TaskManager{}
+
+		invID := int64(0)
+		launch := func() (*tasktest.TestController, error) {
+			invID++
+			ctl := &tasktest.TestController{
+				TaskMessage:   cfg,
+				SaveCallback:  func() error { return nil },
+				OverrideJobID: "proj/poller",
+				OverrideInvID: invID,
+			}
+			return ctl, m.LaunchTask(c, ctl)
+		}
+
+		Convey("Emits a trigger when the value changes", func() {
+			ctl, err := launch()
+			So(err, ShouldBeNil)
+			So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+			So(ctl.Triggers, ShouldHaveLength, 1)
+			So(ctl.Triggers[0].Id, ShouldEqual, "http_poller:proj/poller:1")
+			So(ctl.Triggers[0].Title, ShouldEqual, `"v1"`)
+			So(ctl.Triggers[0].Url, ShouldEqual, cfg.Url)
+			props := ctl.Triggers[0].GetBuildbucket().GetProperties().AsMap()
+			So(props, ShouldResemble, map[string]any{
+				"http_poller": map[string]any{
+					"url":       cfg.Url,
+					"json_path": "$.tag_name",
+					"value":     "v1",
+				},
+			})
+
+			Convey("No changes", func() {
+				doc = `{"assets": [1], "tag_name": "v1"}`
+				ctl, err := launch()
+				So(err, ShouldBeNil)
+				So(ctl.TaskState.Status, ShouldEqual, task.StatusSucceeded)
+				So(ctl.Triggers, ShouldBeEmpty)
+			})
+
+			Convey("Changed", func() {
+				doc = `{"tag_name": "v2"}`
+				ctl, err := launch()
+				So(err, ShouldBeNil)
+				So(ctl.Triggers, ShouldHaveLength, 1)
+				So(ctl.Triggers[0].Id, ShouldEqual, "http_poller:proj/poller:2")
+				So(ctl.Triggers[0].Title, ShouldEqual, `"v2"`)
+			})
+
+			Convey("Failed save doesn't update state", func() {
+				doc = `{"tag_name": "v2"}`
+				ctl := &tasktest.TestController{
+					TaskMessage:   cfg,
+					SaveCallback:  func() error { return fmt.Errorf("boom") },
+					OverrideJobID: "proj/poller",
+				}
+				So(m.LaunchTask(c, ctl), ShouldErrLike, "boom")
+
+				ctl, err := launch()
+				So(err, ShouldBeNil)
+				So(ctl.Triggers, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("Whole document", func() {
+			cfg.JsonPath = ""
+			ctl, err := launch()
+			So(err, ShouldBeNil)
+			So(ctl.Triggers[0].Title, ShouldEqual, `{"assets":[],"tag_name":"v1"}`)
+		})
+
+		Convey("Big integers", func() {
+			cfg.JsonPath = "$.id"
+			doc = `{"id": 9007199254740993}`
+			ctl, err := launch()
+			So(err, ShouldBeNil)
+			So(ctl.Triggers[0].Title, ShouldEqual, "9007199254740993")
+			props := ctl.Triggers[0].GetBuildbucket().GetProperties().AsMap()
+			So(props["http_poller"].(map[string]any)["value"], ShouldEqual, "9007199254740993")
+
+			// The change is detected even though the float64 value is the same.
+			doc = `{"id": 9007199254740992}`
+			ctl, err = launch()
+			So(err, ShouldBeNil)
+			So(ctl.Triggers, ShouldHaveLength, 1)
+			props = ctl.Triggers[0].GetBuildbucket().GetProperties().AsMap()
+			So(props["http_poller"].(map[string]any)["value"], ShouldEqual, float64(9007199254740992))
+		})
+
+		Convey("Long values", func() {
+			cfg.JsonPath = "$.text"
+			doc = `{"text": "a` + strings.Repeat("ф", 100) + `"}`
+			ctl, err := launch()
+			So(err, ShouldBeNil)
+			title := ctl.Triggers[0].Title
+			So(utf8.ValidString(title), ShouldBeTrue)
+			So(len(title), ShouldBeLessThanOrEqualTo, maxTitleLen)
+			So(title, ShouldEqual, `"a`+strings.Repeat("ф", 47)+"...")
+		})
+
+		Convey("Missing value", func() {
+			cfg.JsonPath = "$.name"
+			_, err := launch()
+			So(err, ShouldErrLike, "$.name: no such field")
+		})
+
+		Convey("Not a JSON", func() {
+			doc = "<html>"
+			_, err := launch()
+			So(err, ShouldErrLike, "not a valid JSON")
+		})
+
+		Convey("Transient HTTP error", func() {
+			status = http.StatusBadGateway
+			_, err := launch()
+			So(err, ShouldErrLike, "HTTP 502")
+			So(transient.Tag.In(err), ShouldBeTrue)
+		})
+
+		Convey("Fatal HTTP error", func() {
+			status = http.StatusForbidden
+			_, err := launch()
+			So(err, ShouldErrLike, "HTTP 403")
+			So(transient.Tag.In(err), ShouldBeFalse)
+		})
+	})
+}
+
+func TestValidateProtoMessage(t *testing.T) {
+	t.Parallel()
+
+	Convey("ValidateProtoMessage", t, func() {
+		ctx := &validation.Context{Context: context.Background()}
+		m := TaskManager{}
+		validate := func(msg proto.Message) error {
+			m.ValidateProtoMessage(ctx, msg, "some-project:some-realm")
+			return ctx.Finalize()
+		}
+
+		Convey("Valid", func() {
+			So(validate(&messages.HttpPollerTask{
+				Url:      "https://api.github.com/repos/owner/repo/releases/latest",
+				JsonPath: "$.tag_name",
+			}), ShouldBeNil)
+		})
+
+		Convey("Wrong type", func() {
+			So(validate(&messages.GitTask{}), ShouldErrLike, "wrong type")
+		})
+
+		Convey("No URL", func() {
+			So(validate(&messages.HttpPollerTask{}), ShouldErrLike, "field 'url' is required")
+		})
+
+		Convey("Not https", func() {
+			So(validate(&messages.HttpPollerTask{Url: "http://example.com"}), ShouldErrLike, "not an https:// URL")
+		})
+
+		Convey("Bad JSON path", func() {
+			So(validate(&messages.HttpPollerTask{
+				Url:      "https://example.com",
+				JsonPath: "tag_name",
+			}), ShouldErrLike, "bad JSON path")
+		})
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httppoller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathElem is a single step of a parsed JSON path: either a field name of an
+// object or an index of an array.
+type pathElem struct {
+	field string
+	index int // used only if field is ""
+}
+
+func (e pathElem) String() string {
+	if e.field != "" {
+		return "." + e.field
+	}
+	return fmt.Sprintf("[%d]", e.index)
+}
+
+// parseJSONPath parses a subset of JSONPath syntax: "$" optionally followed by
+// ".<field>" and "[<index>]" steps, e.g. "$.items[0].version".
+//
+// An empty path is the same as "$".
+func parseJSONPath(path string) ([]pathElem, error) {
+	if path == "" || path == "$" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("must start with \"$\"")
+	}
+	var elems []pathElem
+	rest := path[1:]
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("empty field name at %q", rest)
+			}
+			elems = append(elems, pathElem{field: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unclosed \"[\" at %q", rest)
+			}
+			idx, err := strconv.Atoi(rest[1:end])
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("bad array index %q, must be a non-negative integer", rest[1:end])
+			}
+			elems = append(elems, pathElem{index: idx})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q, expecting \".\" or \"[\"", rest)
+		}
+	}
+	return elems, nil
+}
+
+// evalJSONPath selects a value from a JSON document decoded with encoding/json.
+func evalJSONPath(doc any, path []pathElem) (any, error) {
+	cur := doc
+	for i, e := range path {
+		if e.field != "" {
+			obj, ok := cur.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: not an object", formatPath(path[:i]))
+			}
+			if cur, ok = obj[e.field]; !ok {
+				return nil, fmt.Errorf("%s: no such field", formatPath(path[:i+1]))
+			}
+		} else {
+			arr, ok := cur.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: not an array", formatPath(path[:i]))
+			}
+			if e.index >= len(arr) {
+				return nil, fmt.Errorf("%s: index out of range, the array has %d elements", formatPath(path[:i+1]), len(arr))
+			}
+			cur = arr[e.index]
+		}
+	}
+	return cur, nil
+}
+
+// formatPath converts a parsed path back to a string.
+func formatPath(path []pathElem) string {
+	sb := strings.Builder{}
+	sb.WriteString("$")
+	for _, e := range path {
+		sb.WriteString(e.String())
+	}
+	return sb.String()
+}
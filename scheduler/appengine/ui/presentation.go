@@ -118,6 +118,12 @@ func makeJob(c context.Context, j *engine.Job, log *engine.JobTriageLog) *schedu
 		nextRun = "not scheduled yet"
 	}
 
+	// Spell out timezone and blackout windows, if any.
+	scheduleDesc := j.Schedule
+	if sched, err := schedule.Parse(j.Schedule, 0); err == nil {
+		scheduleDesc = presentation.DescribeSchedule(sched, now)
+	}
+
 	pausedBy := "unknown"
 	if j.PausedOrResumedBy != "" {
 		if j.PausedOrResumedBy.Kind() == identity.User {
@@ -161,7 +167,7 @@ func makeJob(c context.Context, j *engine.Job, log *engine.JobTriageLog) *schedu
 	out := &schedulerJob{
 		ProjectID:      j.ProjectID,
 		JobName:        j.JobName(),
-		Schedule:       j.Schedule,
+		Schedule:       scheduleDesc,
 		Definition:     taskToText(j.Task),
 		Policy:         policyToText(j.TriggeringPolicyRaw),
 		Revision:       j.Revision,
@@ -255,6 +261,7 @@ type invocation struct {
 	Tags             []string
 	IncomingTriggers []trigger
 	OutgoingTriggers []trigger
+	CompletionJobs   []completionJob
 	Started          string
 	Duration         string
 	Status           string
@@ -321,6 +328,10 @@ func makeInvocation(j *schedulerJob, i *engine.Invocation) *invocation {
 	if err != nil {
 		panic(errors.Annotate(err, "failed to deserialize outgoing triggers").Err())
 	}
+	compTriggers, err := i.CompletionTriggers()
+	if err != nil {
+		panic(errors.Annotate(err, "failed to deserialize completion triggers").Err())
+	}
 
 	return &invocation{
 		ProjectID:        j.ProjectID,
@@ -335,6 +346,7 @@ func makeInvocation(j *schedulerJob, i *engine.Invocation) *invocation {
 		Tags:             i.Tags,
 		IncomingTriggers: makeTriggerList(j.now, incTriggers),
 		OutgoingTriggers: makeTriggerList(j.now, outTriggers),
+		CompletionJobs:   makeCompletionJobs(i, compTriggers, outTriggers),
 		Started:          humanize.RelTime(i.Started, j.now, "ago", "from now"),
 		Duration:         duration,
 		Status:           string(status),
@@ -379,6 +391,47 @@ func makeTriggerList(now time.Time, list []*internal.Trigger) []trigger {
 	return out
 }
 
+// completionJob is UI representation of a job triggered when an invocation
+// finishes.
+type completionJob struct {
+	JobID     string
+	URL       string
+	Statuses  string
+	Triggered bool
+}
+
+// makeCompletionJobs builds UI presentation of jobs triggered when the given
+// invocation finishes.
+//
+// A job is reported as triggered only if the completion trigger was actually
+// emitted, i.e. it is among the outgoing triggers of the invocation.
+func makeCompletionJobs(i *engine.Invocation, list []*internal.CompletionTrigger, outgoing []*internal.Trigger) []completionJob {
+	emitted := false
+	completionID := engine.CompletionTriggerID(i)
+	for _, t := range outgoing {
+		if t.Id == completionID {
+			emitted = true
+			break
+		}
+	}
+	out := make([]completionJob, len(list))
+	for idx, t := range list {
+		out[idx] = completionJob{
+			JobID:    t.JobId,
+			URL:      "/jobs/" + t.JobId,
+			Statuses: strings.Join(t.Statuses, ", "),
+		}
+		if emitted {
+			for _, s := range t.Statuses {
+				if s == string(i.Status) {
+					out[idx].Triggered = true
+				}
+			}
+		}
+	}
+	return out
+}
+
 // makeJSONFromProtoStruct reformats serialized protobuf.Struct as JSON.
 //
 // If the blob is empty, returns empty string. If the blob is not valid proto
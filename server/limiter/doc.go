@@ -14,7 +14,8 @@
 
 // Package limiter implements load shedding for servers.
 //
-// Currently only supports setting a hard limit on a number of concurrently
-// processed requests. Over-engineered to support more features in the future
-// without significantly altering its API.
+// Supports setting a hard limit on a number of concurrently processed requests
+// and limits on request rates per peer and per call. Rate limits are enforced
+// across all server replicas if Redis is configured (see server/redisconn),
+// falling back to per-replica limits if it is not or it is unavailable.
 package limiter
@@ -30,6 +30,7 @@ func NewServerInterceptor(l *Limiter) grpcutil.UnifiedServerInterceptor {
 		done, err := l.CheckRequest(ctx, &RequestInfo{
 			CallLabel: fullMethod,
 			PeerLabel: PeerLabelFromAuthState(ctx),
+			PeerID:    PeerIDFromAuthState(ctx),
 		})
 		if err != nil {
 			return status.Error(codes.Unavailable, err.Error())
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"sync/atomic"
+	"time"
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
@@ -61,6 +62,17 @@ type Options struct {
 	Name                  string // used for metric fields, logs and error messages
 	AdvisoryMode          bool   // if true, don't actually reject requests, just log
 	MaxConcurrentRequests int64  // a hard limit on a number of concurrent requests
+
+	// Rate limits are enforced across all processes that use limiters with the
+	// same name and the same Redis (see server/redisconn). If Redis is not
+	// configured or unavailable, they are enforced by each process separately.
+	// Redis is synced in background, so the limits across processes are
+	// approximate: a process may admit a few requests over the limit before it
+	// learns about requests handled by other processes.
+
+	MaxRequestsPerPeer int64         // a limit on a number of requests from a single peer per RateWindow (0 = unlimited)
+	MaxRequestsPerCall int64         // a limit on a number of requests to a single call per RateWindow (0 = unlimited)
+	RateWindow         time.Duration // a time window for rate limits (default is 1 sec)
 }
 
 // Limiter is a stateful runtime object that decides whether to accept or reject
@@ -75,9 +87,10 @@ type Options struct {
 //
 // All methods are safe for concurrent use.
 type Limiter struct {
-	opts        Options // options passed to New, as is
-	titleForLog string  // how the limiter is named in logs and error replies
-	concurrency int64   // atomic int with number of current in-flight requests
+	opts        Options      // options passed to New, as is
+	titleForLog string       // how the limiter is named in logs and error replies
+	concurrency int64        // atomic int with number of current in-flight requests
+	rates       *rateCounter // counts requests for rate limits or nil if none
 }
 
 // RequestInfo holds information about a single inbound request.
@@ -92,9 +105,13 @@ type Limiter struct {
 // they will be used only as labels in internal maps and metric fields. Their
 // internal structure and meaning are not important to the limiter, but the
 // cardinality of the set of their possible values must be reasonably bounded.
+//
+// `PeerID` is used only to enforce per-peer rate limits and may have unbounded
+// cardinality. If empty, `PeerLabel` is used instead.
 type RequestInfo struct {
 	CallLabel string // an RPC or an endpoint being called (if known)
 	PeerLabel string // who's making the request (if known), see also peer.go
+	PeerID    string // identifies the peer for per-peer rate limits, see also peer.go
 }
 
 // New returns a new limiter.
@@ -104,10 +121,23 @@ func New(opts Options) (*Limiter, error) {
 	if opts.MaxConcurrentRequests <= 0 {
 		return nil, errors.New("max concurrent requests must be positive")
 	}
-	return &Limiter{
+	if opts.MaxRequestsPerPeer < 0 || opts.MaxRequestsPerCall < 0 {
+		return nil, errors.New("rate limits must be non-negative")
+	}
+	if opts.RateWindow < 0 {
+		return nil, errors.New("rate window must be non-negative")
+	}
+	if opts.RateWindow == 0 {
+		opts.RateWindow = time.Second
+	}
+	l := &Limiter{
 		opts:        opts,
 		titleForLog: fmt.Sprintf("%s<=%d", opts.Name, opts.MaxConcurrentRequests),
-	}, nil
+	}
+	if opts.MaxRequestsPerPeer > 0 || opts.MaxRequestsPerCall > 0 {
+		l.rates = newRateCounter(opts.Name, opts.RateWindow)
+	}
+	return l, nil
 }
 
 // ReportMetrics updates all limiter's gauge metrics to match the current state.
@@ -127,6 +157,12 @@ func (l *Limiter) ReportMetrics(ctx context.Context) {
 // If it succeeds, the request should be processed as usual, and the returned
 // callback called afterwards to notify the limiter the processing is done.
 func (l *Limiter) CheckRequest(ctx context.Context, ri *RequestInfo) (done func(), err error) {
+	if reason := l.checkRate(ctx, ri); reason != "" {
+		if err := l.reject(ctx, ri, reason); !l.opts.AdvisoryMode {
+			return nil, err
+		}
+	}
+
 	// TODO(vadimsh): This is the simplest limiter implementation possible. It
 	// will likely learn more tricks once we understand what features we need.
 	for {
@@ -146,6 +182,43 @@ func (l *Limiter) CheckRequest(ctx context.Context, ri *RequestInfo) (done func(
 	}
 }
 
+// checkRate counts the request towards the rate limits.
+//
+// Returns the rejection reason if some rate limit is exceeded or "" if not.
+func (l *Limiter) checkRate(ctx context.Context, ri *RequestInfo) string {
+	if l.rates == nil {
+		return ""
+	}
+
+	var names, reasons []string
+	var limits []int64
+	if l.opts.MaxRequestsPerPeer > 0 {
+		peer := ri.PeerID
+		if peer == "" {
+			peer = ri.PeerLabel
+		}
+		names = append(names, "peer:"+peer)
+		reasons = append(reasons, "peer rate")
+		limits = append(limits, l.opts.MaxRequestsPerPeer)
+	}
+	if l.opts.MaxRequestsPerCall > 0 {
+		names = append(names, "call:"+ri.CallLabel)
+		reasons = append(reasons, "call rate")
+		limits = append(limits, l.opts.MaxRequestsPerCall)
+	}
+
+	counts, local := l.rates.incr(ctx, names)
+	for i, count := range counts {
+		if count > limits[i] {
+			if local {
+				return reasons[i] + " (local)"
+			}
+			return reasons[i]
+		}
+	}
+	return ""
+}
+
 // reject is called when the request is rejected (either for real or in
 // advisory mode).
 //
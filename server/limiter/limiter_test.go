@@ -18,9 +18,16 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/clock/testclock"
 	"go.chromium.org/luci/common/tsmon"
 
+	"go.chromium.org/luci/server/redisconn"
+
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/common/testing/assertions"
 )
@@ -90,6 +97,120 @@ func TestMaxConcurrencyLimit(t *testing.T) {
 	})
 }
 
+func TestRateLimits(t *testing.T) {
+	t.Parallel()
+
+	Convey("Works", t, func() {
+		const limiterName = "test-limiter"
+
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+		ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+
+		request := func(l *Limiter, call, peer string) error {
+			done, err := l.CheckRequest(ctx, &RequestInfo{
+				CallLabel: call,
+				PeerLabel: "peer",
+				PeerID:    peer,
+			})
+			if err == nil {
+				done()
+			}
+			return err
+		}
+
+		Convey("Without Redis", func() {
+			l, err := New(Options{
+				Name:                  limiterName,
+				MaxConcurrentRequests: 100,
+				MaxRequestsPerPeer:    3,
+			})
+			So(err, ShouldBeNil)
+
+			for i := 0; i < 3; i++ {
+				So(request(l, "call", "peer-1"), ShouldBeNil)
+			}
+			So(request(l, "call", "peer-1"), ShouldErrLike, "peer rate (local) limit: the server limit reached")
+			So(request(l, "call", "peer-2"), ShouldBeNil)
+			So(rejectedCounter.Get(ctx, limiterName, "call", "peer", "peer rate (local)"), ShouldEqual, 1)
+
+			// The next window.
+			tc.Add(time.Second)
+			So(request(l, "call", "peer-1"), ShouldBeNil)
+		})
+
+		Convey("In advisory mode", func() {
+			l, err := New(Options{
+				Name:                  limiterName,
+				MaxConcurrentRequests: 100,
+				MaxRequestsPerCall:    1,
+				AdvisoryMode:          true,
+			})
+			So(err, ShouldBeNil)
+
+			So(request(l, "call", "peer"), ShouldBeNil)
+			So(request(l, "call", "peer"), ShouldBeNil)
+			So(rejectedCounter.Get(ctx, limiterName, "call", "peer", "call rate (local)"), ShouldEqual, 1)
+		})
+
+		Convey("With Redis", func() {
+			s, err := miniredis.Run()
+			So(err, ShouldBeNil)
+			defer s.Close()
+
+			addr := s.Addr()
+			ctx = redisconn.UsePool(ctx, &redis.Pool{
+				Dial: func() (redis.Conn, error) {
+					return redis.Dial("tcp", addr)
+				},
+			})
+
+			// Two replicas share the budget.
+			replicas := make([]*Limiter, 2)
+			for i := range replicas {
+				replicas[i], err = New(Options{
+					Name:                  limiterName,
+					MaxConcurrentRequests: 100,
+					MaxRequestsPerCall:    4,
+				})
+				So(err, ShouldBeNil)
+				replicas[i].rates.syncInterval = 0 // sync on every request
+			}
+			synced := func(err error) error {
+				for _, l := range replicas {
+					l.rates.wg.Wait()
+				}
+				return err
+			}
+
+			for i := 0; i < 3; i++ {
+				So(synced(request(replicas[0], "call", "peer")), ShouldBeNil)
+			}
+			// Each replica learns about requests to other replicas only when it
+			// syncs, so the first requests after a sync may use a stale count.
+			So(synced(request(replicas[1], "call", "peer")), ShouldBeNil)
+			So(synced(request(replicas[1], "call", "peer")), ShouldErrLike, "call rate limit")
+			So(synced(request(replicas[0], "call", "peer")), ShouldBeNil)
+			So(synced(request(replicas[0], "call", "peer")), ShouldErrLike, "call rate limit")
+			So(rejectedCounter.Get(ctx, limiterName, "call", "peer", "call rate"), ShouldEqual, 2)
+
+			// Other calls have their own budget.
+			So(synced(request(replicas[0], "another", "peer")), ShouldBeNil)
+
+			// The next window.
+			tc.Add(time.Second)
+			So(synced(request(replicas[1], "call", "peer")), ShouldBeNil)
+
+			Convey("Falls back to local limits if Redis is down", func() {
+				s.Close()
+				for i := 0; i < 4; i++ {
+					So(synced(request(replicas[0], "call", "peer")), ShouldBeNil)
+				}
+				So(synced(request(replicas[0], "call", "peer")), ShouldErrLike, "call rate (local) limit")
+			})
+		})
+	})
+}
+
 func makeConcurrentRequests(ctx context.Context, l *Limiter, count int, block chan struct{}, wg *sync.WaitGroup) (accepted, rejected int) {
 	verdicts := make(chan error) // nil if accepted, non-nil if rejected
 
@@ -24,6 +24,7 @@ import (
 	"go.chromium.org/luci/common/tsmon"
 
 	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/redisconn"
 )
 
 // ModuleName can be used to refer to this module when declaring dependencies.
@@ -35,7 +36,9 @@ const defaultMaxConcurrentRPCs = 100000 // e.g. unlimited
 // default limiters applied to all routes/services in the server.
 type ModuleOptions struct {
 	MaxConcurrentRPCs int64 // limit on a number of incoming concurrent RPCs (default is 100000, i.e. unlimited)
-	AdvisoryMode      bool  // if set, don't enforce MaxConcurrentRPCs, but still report violations
+	MaxPeerRPCRate    int64 // limit on RPCs per second from a single peer across all replicas (default is 0, i.e. unlimited)
+	MaxMethodRPCRate  int64 // limit on RPCs per second to a single method across all replicas (default is 0, i.e. unlimited)
+	AdvisoryMode      bool  // if set, don't enforce the limits, but still report violations
 }
 
 // Register registers the command line flags.
@@ -49,11 +52,25 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 		o.MaxConcurrentRPCs,
 		fmt.Sprintf("Limit on a number of incoming concurrent RPCs (default is %d)", o.MaxConcurrentRPCs),
 	)
+	f.Int64Var(
+		&o.MaxPeerRPCRate,
+		"limiter-max-peer-rpc-rate",
+		o.MaxPeerRPCRate,
+		"Limit on a number of incoming RPCs per second from a single peer, enforced across all replicas via Redis "+
+			"if it is configured and per replica otherwise (default is 0, i.e. unlimited)",
+	)
+	f.Int64Var(
+		&o.MaxMethodRPCRate,
+		"limiter-max-method-rpc-rate",
+		o.MaxMethodRPCRate,
+		"Limit on a number of incoming RPCs per second to a single RPC method, enforced across all replicas via Redis "+
+			"if it is configured and per replica otherwise (default is 0, i.e. unlimited)",
+	)
 	f.BoolVar(
 		&o.AdvisoryMode,
 		"limiter-advisory-mode",
 		o.AdvisoryMode,
-		"If set, don't enforce -limiter-max-concurrent-rpcs, but still report violations",
+		"If set, don't enforce -limiter-max-* limits, but still report violations",
 	)
 }
 
@@ -90,7 +107,9 @@ func (*serverModule) Name() module.Name {
 
 // Dependencies is part of module.Module interface.
 func (*serverModule) Dependencies() []module.Dependency {
-	return nil
+	return []module.Dependency{
+		module.OptionalDependency(redisconn.ModuleName), // for distributed rate limits
+	}
 }
 
 // Initialize is part of module.Module interface.
@@ -105,6 +124,8 @@ func (m *serverModule) Initialize(ctx context.Context, host module.Host, opts mo
 		Name:                  "rpc",
 		AdvisoryMode:          m.opts.AdvisoryMode,
 		MaxConcurrentRequests: m.opts.MaxConcurrentRPCs,
+		MaxRequestsPerPeer:    m.opts.MaxPeerRPCRate,
+		MaxRequestsPerCall:    m.opts.MaxMethodRPCRate,
 	})
 	if err != nil {
 		return nil, err
@@ -38,3 +38,21 @@ func PeerLabelFromAuthState(ctx context.Context) string {
 	}
 	return "unknown"
 }
+
+// PeerIDFromAuthState looks at the auth.State in the context and derives
+// a peer ID for per-peer rate limits from it.
+//
+// Returns the peer identity for authenticated peers, "ip:<address>" for
+// anonymous ones and "" if there's no auth.State.
+func PeerIDFromAuthState(ctx context.Context) string {
+	if s := auth.GetState(ctx); s != nil {
+		if id := s.PeerIdentity(); id != identity.AnonymousIdentity {
+			return string(id)
+		}
+		if ip := s.PeerIP(); ip != nil {
+			return "ip:" + ip.String()
+		}
+		return string(identity.AnonymousIdentity)
+	}
+	return ""
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/redisconn"
+)
+
+const (
+	// redisTimeout limits how long a sync with Redis can take.
+	redisTimeout = 100 * time.Millisecond
+
+	// redisRetryDelay is how long to use local counters after a Redis error
+	// before trying Redis again.
+	redisRetryDelay = 5 * time.Second
+
+	// defaultSyncInterval is how often local counts are pushed to Redis.
+	defaultSyncInterval = 100 * time.Millisecond
+)
+
+// incrScript increments all counters in KEYS by corresponding ARGV[i+1] and
+// returns their new values.
+//
+// Sets TTL of new counters to ARGV[1] ms.
+var incrScript = redis.NewScript(-1, `
+local ret = {}
+for i, key in ipairs(KEYS) do
+  ret[i] = redis.call('INCRBY', key, ARGV[i+1])
+  if ret[i] == tonumber(ARGV[i+1]) then
+    redis.call('PEXPIRE', key, ARGV[1])
+  end
+end
+return ret
+`)
+
+// rateCounter counts requests in fixed time windows.
+//
+// If Redis is configured in the context, the counters are stored there and
+// are thus shared by all processes that use the same Redis and the same
+// counter names. If Redis isn't configured or unavailable, uses counters
+// local to the process.
+//
+// Redis is never called on the request path. Requests are counted locally and
+// the counts are pushed to Redis in background at most once per syncInterval,
+// fetching the totals across all processes in return. Thus the counts lag
+// behind the real ones by about syncInterval plus the Redis latency.
+type rateCounter struct {
+	prefix       string        // prefix for Redis keys
+	window       time.Duration // length of the window
+	syncInterval time.Duration // how often to sync with Redis
+
+	m              sync.Mutex
+	curWindow      int64            // index of the window with the counts below
+	synced         map[string]int64 // counter name => count as of the last sync
+	inflight       map[string]int64 // counter name => count being pushed to Redis
+	pending        map[string]int64 // counter name => count not pushed yet
+	syncing        bool             // true if there's a sync in progress
+	lastSync       time.Time        // when the last sync started
+	redisUp        bool             // true if the last sync succeeded
+	redisDownUntil time.Time        // when to try using Redis again
+
+	wg sync.WaitGroup // tracks background syncs, used in tests
+}
+
+func newRateCounter(name string, window time.Duration) *rateCounter {
+	return &rateCounter{
+		prefix:       fmt.Sprintf("luci.limiter.%s:", name),
+		window:       window,
+		syncInterval: defaultSyncInterval,
+	}
+}
+
+// incr increments the given counters in the current window and returns their
+// values.
+//
+// `local` is true if the counts are local to this process.
+func (r *rateCounter) incr(ctx context.Context, names []string) (counts []int64, local bool) {
+	now := clock.Now(ctx)
+	window := now.UnixNano() / int64(r.window)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.curWindow != window || r.pending == nil {
+		r.curWindow = window
+		r.synced = map[string]int64{}
+		r.inflight = map[string]int64{}
+		r.pending = make(map[string]int64, len(names))
+	}
+	counts = make([]int64, len(names))
+	for i, name := range names {
+		r.pending[name]++
+		counts[i] = r.synced[name] + r.inflight[name] + r.pending[name]
+	}
+	local = !r.redisUp
+
+	if !r.syncing && now.Sub(r.lastSync) >= r.syncInterval && now.After(r.redisDownUntil) {
+		r.syncing = true
+		r.lastSync = now
+		r.inflight, r.pending = r.pending, map[string]int64{}
+		deltas := make(map[string]int64, len(r.inflight))
+		for name, delta := range r.inflight {
+			deltas[name] = delta
+		}
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.sync(context.WithoutCancel(ctx), window, deltas)
+		}()
+	}
+
+	return counts, local
+}
+
+// sync pushes the counts to Redis and stores the totals returned by it.
+func (r *rateCounter) sync(ctx context.Context, window int64, deltas map[string]int64) {
+	totals, err := r.incrRedis(ctx, window, deltas)
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.syncing = false
+
+	switch {
+	case err == nil:
+		r.redisUp = true
+	case errors.Is(err, redisconn.ErrNotConfigured):
+		// Use local counters, no need to log it.
+		r.redisUp = false
+		r.redisDownUntil = clock.Now(ctx).Add(redisRetryDelay)
+	default:
+		logging.Warningf(ctx, "Falling back to local rate limits for %s: %s", redisRetryDelay, err)
+		r.redisUp = false
+		r.redisDownUntil = clock.Now(ctx).Add(redisRetryDelay)
+	}
+
+	if r.curWindow != window {
+		return // the window is over, its counts are no longer needed
+	}
+	for name, delta := range r.inflight {
+		if total, ok := totals[name]; ok {
+			r.synced[name] = total
+		} else {
+			r.synced[name] += delta // keep counting locally
+		}
+	}
+	r.inflight = map[string]int64{}
+}
+
+func (r *rateCounter) incrRedis(ctx context.Context, window int64, deltas map[string]int64) (map[string]int64, error) {
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// This is a network timeout, it should not depend on the mocked clock.
+	ctx, cancel := context.WithTimeout(ctx, redisTimeout)
+	defer cancel()
+
+	names := make([]string, 0, len(deltas))
+	for name := range deltas {
+		names = append(names, name)
+	}
+	args := make(redis.Args, 0, 2*len(names)+2)
+	args = args.Add(len(names))
+	for _, name := range names {
+		args = args.Add(fmt.Sprintf("%s%s:%d", r.prefix, name, window))
+	}
+	args = args.Add((2 * r.window).Milliseconds())
+	for _, name := range names {
+		args = args.Add(deltas[name])
+	}
+
+	counts, err := redis.Int64s(incrScript.DoContext(ctx, conn, args...))
+	if err != nil {
+		return nil, errors.Annotate(err, "incrementing counters in Redis").Err()
+	}
+	totals := make(map[string]int64, len(names))
+	for i, name := range names {
+		totals[name] = counts[i]
+	}
+	return totals, nil
+}
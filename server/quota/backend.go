@@ -0,0 +1,214 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/proto/msgpackpb"
+
+	"go.chromium.org/luci/server/quota/internal/memory"
+	"go.chromium.org/luci/server/quota/internal/quotakeys"
+	"go.chromium.org/luci/server/quota/quotapb"
+	"go.chromium.org/luci/server/redisconn"
+)
+
+const (
+	// RedisBackend stores quota state in Redis, see redisconn.
+	//
+	// This is the default.
+	RedisBackend = "redis"
+
+	// MemoryBackend stores quota state in the process memory.
+	//
+	// The state is lost when the process exits and isn't shared between
+	// processes, so this is suitable only for single-replica servers and tests.
+	MemoryBackend = "memory"
+)
+
+// backend stores quota Accounts and Policies.
+type backend interface {
+	// applyOps atomically applies all ops in `input`.
+	//
+	// `keys` are all the keys `input` refers to.
+	applyOps(ctx context.Context, input *quotapb.UpdateAccountsInput, keys []string) (*quotapb.ApplyOpsResponse, error)
+
+	// getAccounts returns Accounts with the given keys, nil for missing ones.
+	getAccounts(ctx context.Context, keys []string) ([]*quotapb.Account, error)
+
+	// loadPolicies stores the policy config unless it already exists.
+	loadPolicies(ctx context.Context, cfgIDKey string, cfg *quotapb.PolicyConfig) error
+}
+
+var backendKey = "holds a quota backend"
+
+// UseMemoryBackend returns a context which makes the quota library store all
+// its state in the process memory instead of Redis.
+//
+// Each call creates a new empty storage. This is what the quota server module
+// does when configured with MemoryBackend; it is also useful in tests, which
+// then don't need Redis and quotatestmonkeypatch.
+func UseMemoryBackend(ctx context.Context) context.Context {
+	return context.WithValue(ctx, &backendKey, memoryBackend{memory.NewStore()})
+}
+
+// getBackend returns the backend installed in the context, or the Redis
+// backend if none.
+func getBackend(ctx context.Context) backend {
+	if b, ok := ctx.Value(&backendKey).(backend); ok {
+		return b
+	}
+	return redisBackend{}
+}
+
+// redisBackend runs UpdateAccountsScript in Redis.
+type redisBackend struct{}
+
+func withRedisConn(ctx context.Context, cb func(redis.Conn) error) (err error) {
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		err = errors.Annotate(err, "quota: unable to get redis connection").Err()
+		return
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logging.Errorf(ctx, "quota: unable to close redis connection: %s", err)
+		}
+	}()
+	return cb(conn)
+}
+
+func (redisBackend) applyOps(ctx context.Context, inputMsg *quotapb.UpdateAccountsInput, keys []string) (*quotapb.ApplyOpsResponse, error) {
+	input, err := msgpackpb.Marshal(
+		inputMsg, msgpackpb.Deterministic,
+		msgpackpb.WithStringInternTable(keys))
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to marshal UpdateAccountsInput").Err()
+	}
+
+	fullArgs := make(redis.Args, 0, len(keys)+2)
+	fullArgs = fullArgs.Add(len(keys))
+	fullArgs = fullArgs.AddFlat(keys)
+	fullArgs = fullArgs.Add(string(input))
+
+	resp := &quotapb.ApplyOpsResponse{}
+	err = withRedisConn(ctx, func(conn redis.Conn) error {
+		respRaw, err := redis.String(UpdateAccountsScript.DoContext(ctx, conn, fullArgs...))
+		if err != nil {
+			return errors.Annotate(err, "running UpdateAccountsScript").Err()
+		}
+		return msgpackpb.Unmarshal(msgpack.RawMessage(respRaw), resp)
+	})
+	return resp, err
+}
+
+func (redisBackend) getAccounts(ctx context.Context, keys []string) ([]*quotapb.Account, error) {
+	args := make(redis.Args, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, key)
+	}
+
+	ret := make([]*quotapb.Account, len(keys))
+	err := withRedisConn(ctx, func(conn redis.Conn) error {
+		accountsRaw, err := redis.Strings(conn.Do("MGET", args...))
+		if err != nil {
+			return errors.Annotate(err, "running MGET").Err()
+		}
+
+		for i, accountRaw := range accountsRaw {
+			if accountRaw == "" {
+				continue
+			}
+
+			account := &quotapb.Account{}
+			if err := msgpackpb.Unmarshal(msgpack.RawMessage(accountRaw), account); err != nil {
+				return err
+			}
+
+			ret[i] = account
+		}
+
+		return nil
+	})
+	return ret, err
+}
+
+func (redisBackend) loadPolicies(ctx context.Context, cfgIDKey string, cfg *quotapb.PolicyConfig) error {
+	return withRedisConn(ctx, func(conn redis.Conn) error {
+		// If this thing exists, we're done.
+		exists, err := redis.Bool(conn.Do("EXISTS", cfgIDKey))
+		if err != nil {
+			return errors.Annotate(err, "unable to check existance of policy config %q", cfgIDKey).Err()
+		}
+		if exists {
+			return nil
+		}
+
+		// At this point we'll call HSET; If we're racing, it's OK because the
+		// application has ensured us that (namespace, versionScheme, version)
+		// always maps to the same Config.
+		args := make(redis.Args, 0, 1+2+(len(cfg.Policies)*2))
+		args = args.Add(cfgIDKey)
+		tsBytes, err := msgpackpb.Marshal(timestamppb.New(clock.Now(ctx)), msgpackpb.Deterministic)
+		if err != nil {
+			return errors.Annotate(err, "serializing timestamp").Err()
+		}
+		args = args.Add("~loaded_time", string(tsBytes))
+
+		for i, entry := range cfg.Policies {
+			polBytes, err := msgpackpb.Marshal(entry.Policy, msgpackpb.Deterministic, msgpackpb.DisallowUnknownFields)
+			if err != nil {
+				return errors.Annotate(err, "serializing cfg.Policies[%d]", i).Err()
+			}
+			args = args.Add(quotakeys.PolicyKey(entry.Key), string(polBytes))
+		}
+
+		_, err = conn.Do("HSET", args...)
+		return errors.Annotate(err, "unable to load policy config").Err()
+	})
+}
+
+// memoryBackend keeps the state in a memory.Store.
+type memoryBackend struct {
+	store *memory.Store
+}
+
+func (b memoryBackend) applyOps(ctx context.Context, input *quotapb.UpdateAccountsInput, keys []string) (*quotapb.ApplyOpsResponse, error) {
+	resp, err := b.store.ApplyOps(ctx, input)
+	if err != nil {
+		return nil, errors.Annotate(err, "applying ops in memory").Err()
+	}
+	return resp, nil
+}
+
+func (b memoryBackend) getAccounts(ctx context.Context, keys []string) ([]*quotapb.Account, error) {
+	return b.store.GetAccounts(ctx, keys), nil
+}
+
+func (b memoryBackend) loadPolicies(ctx context.Context, cfgIDKey string, cfg *quotapb.PolicyConfig) error {
+	policies := make(map[string]*quotapb.Policy, len(cfg.Policies))
+	for _, entry := range cfg.Policies {
+		policies[quotakeys.PolicyKey(entry.Key)] = entry.Policy
+	}
+	b.store.LoadPolicyConfig(cfgIDKey, policies)
+	return nil
+}
@@ -31,6 +31,12 @@
 // to other datastores or to allow the application to make a tradeoff between
 // accuracy and latency.
 //
+// Servers with a single replica can instead keep all the quota state in the
+// process memory by configuring the server module with MemoryBackend (or
+// `-quota-backend memory`). This backend implements exactly the same semantics
+// as the Redis one, but the state is lost on restart. Tests can use
+// UseMemoryBackend to avoid Redis entirely.
+//
 // # Data Model
 //
 // There are 2 different types of entities managed by the quota libary: Policies
@@ -184,3 +184,86 @@ func TestFullFlow(t *testing.T) {
 
 	})
 }
+
+func TestMemoryBackend(t *testing.T) {
+	Convey(`MemoryBackend`, t, func() {
+		tc := testclock.New(testclock.TestRecentTimeUTC.Round(time.Microsecond))
+		ctx := clock.Set(context.Background(), tc)
+		ctx = quota.UseMemoryBackend(ctx)
+
+		polKey := &quotapb.PolicyKey{Namespace: "ns1", Name: "cool people", ResourceType: "qps"}
+		policyConfigID, err := integrationTestApp.LoadPoliciesAuto(ctx, "@internal:integrationTestApp", &quotapb.PolicyConfig{
+			Policies: []*quotapb.PolicyConfig_Entry{
+				{
+					Key: polKey,
+					Policy: &quotapb.Policy{
+						Default: 10,
+						Limit:   100,
+						Refill: &quotapb.Policy_Refill{
+							Units:    1,
+							Interval: 3,
+						},
+					},
+				},
+			},
+		})
+		So(err, ShouldBeNil)
+
+		accountID := integrationTestApp.AccountID("project:realm", "cvgroup1", "username", "qps")
+		op := func(delta int64) []*quotapb.Op {
+			return []*quotapb.Op{
+				{
+					AccountId:  accountID,
+					PolicyId:   &quotapb.PolicyID{Config: policyConfigID, Key: polKey},
+					RelativeTo: quotapb.Op_CURRENT_BALANCE,
+					Delta:      delta,
+				},
+			}
+		}
+
+		rsp, err := quota.ApplyOps(ctx, "somereq", nil, op(-5))
+		So(err, ShouldBeNil)
+		So(rsp, ShouldResembleProto, &quotapb.ApplyOpsResponse{
+			Results: []*quotapb.OpResult{
+				{NewBalance: 5, AccountStatus: quotapb.OpResult_CREATED},
+			},
+			OriginallySet: timestamppb.New(clock.Now(ctx)),
+		})
+
+		Convey(`deduplicates requests`, func() {
+			tc.Add(time.Minute)
+			again, err := quota.ApplyOps(ctx, "somereq", nil, op(-5))
+			So(err, ShouldBeNil)
+			So(again, ShouldResembleProto, rsp)
+
+			_, err = quota.ApplyOps(ctx, "somereq", nil, op(-6))
+			So(err, ShouldErrLike, "REQUEST_HASH")
+		})
+
+		Convey(`refills`, func() {
+			tc.Add(time.Minute)
+			rsp, err := quota.ApplyOps(ctx, "", nil, op(0))
+			So(err, ShouldBeNil)
+			So(rsp.Results[0].PreviousBalance, ShouldEqual, 25)
+			So(rsp.Results[0].NewBalance, ShouldEqual, 25)
+		})
+
+		Convey(`fails atomically`, func() {
+			ops := append(op(-1), &quotapb.Op{
+				AccountId:  integrationTestApp.AccountID("project:realm", "cvgroup1", "other", "qps"),
+				PolicyId:   &quotapb.PolicyID{Config: policyConfigID, Key: polKey},
+				RelativeTo: quotapb.Op_CURRENT_BALANCE,
+				Delta:      -11,
+			})
+			rsp, err := quota.ApplyOps(ctx, "", nil, ops)
+			So(err, ShouldEqual, quota.ErrQuotaApply)
+			So(rsp.Results[0].Status, ShouldEqual, quotapb.OpResult_SUCCESS)
+			So(rsp.Results[1].Status, ShouldEqual, quotapb.OpResult_ERR_UNDERFLOW)
+
+			res, err := quota.GetAccounts(ctx, []*quotapb.AccountID{accountID, ops[1].AccountId})
+			So(err, ShouldBeNil)
+			So(res.Accounts[0].Account.Balance, ShouldEqual, 5)
+			So(res.Accounts[1].Account, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,354 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/quota/quotapb"
+)
+
+// maxBalance is the largest absolute value of an Account balance.
+//
+// Lua represents all numbers as doubles, so the Redis implementation refuses to
+// store balances which can't be represented precisely.
+const maxBalance = 1<<53 - 1
+
+const (
+	alreadyExists = quotapb.OpResult_ALREADY_EXISTS
+	created       = quotapb.OpResult_CREATED
+)
+
+// update is the state of a single ApplyOps call.
+//
+// It corresponds to a single run of update-accounts.lua.
+type update struct {
+	store    *Store
+	now      time.Time
+	accounts map[string]*account // account key => account touched by this update
+}
+
+// account is an Account being updated.
+//
+// It corresponds to an entry of Account.CACHE in account.lua.
+type account struct {
+	key    string
+	pb     *quotapb.Account
+	status quotapb.OpResult_AccountStatus
+	now    time.Time
+}
+
+// getAccount loads the Account, applying its refill policy if it exists.
+//
+// Returns the same *account for all calls with the same key.
+func (u *update) getAccount(key string) (*account, error) {
+	if key == "" {
+		return nil, errors.New("getAccount called with ''")
+	}
+	if a, ok := u.accounts[key]; ok {
+		return a, nil
+	}
+
+	a := &account{key: key, now: u.now}
+	if pb := u.store.loadAccount(key, u.now); pb != nil {
+		a.pb = proto.Clone(pb).(*quotapb.Account)
+		a.status = alreadyExists
+	} else {
+		a.pb = &quotapb.Account{UpdatedTs: timestamppb.New(u.now)}
+		a.status = created
+	}
+	u.accounts[key] = a
+
+	// Refill under the existing policy exactly once, when the Account is loaded.
+	// Any following refill in this update must be calculated relative to now.
+	if a.status == alreadyExists {
+		if err := a.applyRefill(); err != nil {
+			return nil, errors.Annotate(err, "account %q", key).Err()
+		}
+		a.pb.UpdatedTs = timestamppb.New(u.now)
+	}
+	return a, nil
+}
+
+// getPolicy returns the policy referenced by `ref` or nil if it doesn't exist.
+func (u *update) getPolicy(ref *quotapb.PolicyRef) *quotapb.Policy {
+	if ref.Config == "" && ref.Key == "" {
+		return nil
+	}
+	return u.store.loadPolicy(ref)
+}
+
+// applyOps applies all ops to the Accounts in memory of this update.
+//
+// Returns the response and whether all ops succeeded. Returns an error if the
+// whole update must fail.
+func (u *update) applyOps(ops []*quotapb.RawOp) (*quotapb.ApplyOpsResponse, bool, error) {
+	resp := &quotapb.ApplyOpsResponse{Results: make([]*quotapb.OpResult, len(ops))}
+	allOK := true
+	for i, op := range ops {
+		a, err := u.getAccount(op.AccountRef)
+		if err != nil {
+			return nil, false, err
+		}
+
+		result := &quotapb.OpResult{
+			Status:        quotapb.OpResult_SUCCESS, // applyOp can overwrite this.
+			AccountStatus: a.status,
+			// PreviousBalanceAdjusted is updated by applyOp if the policy limit
+			// delta is applied.
+			PreviousBalance:         a.pb.Balance,
+			PreviousBalanceAdjusted: a.pb.Balance,
+		}
+		resp.Results[i] = result
+
+		if err := u.applyOp(a, op, result); err != nil {
+			result.Status = quotapb.OpResult_ERR_UNKNOWN
+			result.StatusMsg = err.Error()
+		}
+
+		if result.Status == quotapb.OpResult_SUCCESS {
+			result.NewBalance = a.pb.Balance
+		} else {
+			allOK = false
+		}
+	}
+	return resp, allOK, nil
+}
+
+// commit writes all Accounts touched by this update to the store.
+func (u *update) commit() error {
+	for _, a := range u.accounts {
+		if b := a.pb.Balance; b > maxBalance || b < -maxBalance {
+			return errors.Reason("account %q: balance %d is out of range", a.key, b).Err()
+		}
+	}
+	for key, a := range u.accounts {
+		entry := &accountEntry{pb: a.pb}
+		if lifetime := a.pb.Policy.GetLifetime(); lifetime != nil {
+			if ttl := millis(lifetime); ttl > 0 {
+				entry.expiry = u.now.Add(ttl)
+			}
+		}
+		u.store.accounts[key] = entry
+	}
+	return nil
+}
+
+// applyOp applies a single op to the Account.
+//
+// Errors in the op are reported via `result`. Returned errors are unexpected
+// and are reported as ERR_UNKNOWN. In both cases, the Account may be partially
+// modified, exactly as it happens in account.lua.
+func (u *update) applyOp(a *account, op *quotapb.RawOp, result *quotapb.OpResult) error {
+	ignoreBounds := op.Options&uint32(quotapb.Op_IGNORE_POLICY_BOUNDS) != 0
+	noCap := op.Options&uint32(quotapb.Op_DO_NOT_CAP_PROPOSED) != 0
+	withPolicyLimitDelta := op.Options&uint32(quotapb.Op_WITH_POLICY_LIMIT_DELTA) != 0
+
+	if op.PolicyRef != nil {
+		policy := u.getPolicy(op.PolicyRef)
+		if policy == nil {
+			result.Status = quotapb.OpResult_ERR_UNKNOWN_POLICY
+			return nil
+		}
+		if err := a.setPolicy(op.PolicyRef, policy, result, withPolicyLimitDelta); err != nil {
+			return err
+		}
+	}
+	policy := a.pb.Policy
+
+	if ignoreBounds && noCap {
+		return errors.New("IGNORE_POLICY_BOUNDS and DO_NOT_CAP_PROPOSED both set")
+	}
+
+	current := a.pb.Balance
+
+	// Step 1: figure out what value the op wants to set the Account to.
+	proposed, status, err := computeProposed(op, a.status != alreadyExists, current, policy)
+	switch {
+	case err != nil:
+		return err
+	case status != quotapb.OpResult_SUCCESS:
+		result.Status = status
+		return nil
+	}
+
+	limit := int64(policy.GetLimit())
+	if !noCap && !ignoreBounds {
+		if policy == nil {
+			// The Lua implementation fails on `math.min(proposed, nil)` here.
+			return errors.New("cannot cap the proposed balance of an Account without a policy")
+		}
+		proposed = min(proposed, limit)
+	}
+
+	// Step 2: figure out how to apply the proposed value.
+	switch {
+	case ignoreBounds:
+		a.pb.Balance = proposed
+	case policy == nil:
+		result.Status = quotapb.OpResult_ERR_POLICY_REQUIRED
+		return nil
+	case proposed >= 0 && proposed <= limit:
+		infinite, err := isInfiniteRefill(policy)
+		if err != nil {
+			return err
+		}
+		if infinite {
+			// An in-bounds value with an infinite refill policy replenishes
+			// immediately.
+			a.pb.Balance = limit
+		} else {
+			a.pb.Balance = proposed
+		}
+	default:
+		// Out of bounds values are allowed only if they bring the balance back
+		// towards [0, limit].
+		if proposed < 0 && proposed < current {
+			result.Status = quotapb.OpResult_ERR_UNDERFLOW
+			return nil
+		}
+		if proposed > limit && proposed > current {
+			result.Status = quotapb.OpResult_ERR_OVERFLOW
+			return nil
+		}
+		a.pb.Balance = proposed
+	}
+
+	// The op has been applied; the Account exists for any subsequent ops.
+	a.status = alreadyExists
+	return nil
+}
+
+// computeProposed computes the new, proposed, balance value for an Account.
+//
+// Returns a non-SUCCESS status if the op wants to compute something relative
+// to a value which is unknown.
+func computeProposed(op *quotapb.RawOp, newAccount bool, current int64, policy *quotapb.Policy) (int64, quotapb.OpResult_OpStatus, error) {
+	if op.RelativeTo == quotapb.Op_ZERO {
+		return op.Delta, quotapb.OpResult_SUCCESS, nil
+	}
+	if policy == nil && newAccount {
+		return 0, quotapb.OpResult_ERR_POLICY_REQUIRED, nil
+	}
+	if op.RelativeTo == quotapb.Op_CURRENT_BALANCE {
+		return current + op.Delta, quotapb.OpResult_SUCCESS, nil
+	}
+	if policy == nil {
+		return 0, quotapb.OpResult_ERR_POLICY_REQUIRED, nil
+	}
+	switch op.RelativeTo {
+	case quotapb.Op_LIMIT:
+		return int64(policy.Limit) + op.Delta, quotapb.OpResult_SUCCESS, nil
+	case quotapb.Op_DEFAULT:
+		return int64(policy.Default) + op.Delta, quotapb.OpResult_SUCCESS, nil
+	}
+	return 0, quotapb.OpResult_SUCCESS, errors.Reason("invalid `relative_to` value: %s", op.RelativeTo).Err()
+}
+
+// isInfiniteRefill returns true if the policy refills the Account infinitely
+// fast, i.e. has a refill with zero interval and positive units.
+//
+// Returns an error for a zero interval with non-positive units.
+func isInfiniteRefill(policy *quotapb.Policy) (bool, error) {
+	refill := policy.GetRefill()
+	if refill == nil || refill.Interval != 0 {
+		return false, nil
+	}
+	if refill.Units <= 0 {
+		return false, errors.New("invalid zero-interval refill policy")
+	}
+	return true, nil
+}
+
+// applyRefill applies the refill policy of the Account for the time passed
+// since its last update.
+func (a *account) applyRefill() error {
+	policy := a.pb.Policy
+	if policy.GetRefill() == nil {
+		return nil
+	}
+	limit := int64(policy.Limit)
+	cur := a.pb.Balance
+
+	infinite, err := isInfiniteRefill(policy)
+	switch {
+	case err != nil:
+		return err
+	case infinite:
+		a.pb.Balance = max(cur, limit)
+		return nil
+	}
+
+	// Offset shifts midnight forwards by pushing all the timestamps backwards.
+	// Nanos can be ignored, since intervals and offsets are whole seconds.
+	interval := int64(policy.Refill.Interval)
+	offset := int64(policy.Refill.Offset)
+	updated := a.pb.UpdatedTs.GetSeconds() - offset
+	now := a.now.Unix() - offset
+
+	// The first refill event after updated and the last one before now.
+	first := updated - floorMod(updated, interval) + interval
+	last := now - floorMod(now, interval)
+	if last < first {
+		return nil
+	}
+
+	delta := ((last-first)/interval + 1) * policy.Refill.Units
+	switch {
+	case delta > 0 && cur < limit:
+		a.pb.Balance = min(cur+delta, limit)
+	case delta < 0 && cur > 0:
+		a.pb.Balance = max(cur+delta, 0)
+	}
+	return nil
+}
+
+// setPolicy sets the policy of the Account, initializing the balance of new
+// Accounts and applying the refill.
+func (a *account) setPolicy(ref *quotapb.PolicyRef, policy *quotapb.Policy, result *quotapb.OpResult, withPolicyLimitDelta bool) error {
+	if a.pb.PolicyRef == nil || a.pb.PolicyRef.Config != ref.Config || a.pb.PolicyRef.Key != ref.Key {
+		// Carry over the change of the limit to the balance.
+		if withPolicyLimitDelta && a.pb.PolicyRef != nil {
+			a.pb.Balance += int64(policy.Limit) - int64(a.pb.Policy.GetLimit())
+			result.PreviousBalanceAdjusted = a.pb.Balance
+		}
+		a.pb.Policy = proto.Clone(policy).(*quotapb.Policy)
+		a.pb.PolicyRef = &quotapb.PolicyRef{Config: ref.Config, Key: ref.Key}
+		a.pb.PolicyChangeTs = timestamppb.New(a.now)
+	}
+
+	// The Account didn't exist before; set the initial value.
+	if a.status != alreadyExists {
+		a.pb.Balance = int64(policy.Default)
+	}
+
+	// If the policy is infinite, this sets the balance to the limit.
+	return a.applyRefill()
+}
+
+// floorMod is the modulo operation as defined in Lua, i.e. with the result
+// having the sign of the divisor.
+func floorMod(a, b int64) int64 {
+	m := a % b
+	if m != 0 && (m < 0) != (b < 0) {
+		m += b
+	}
+	return m
+}
@@ -0,0 +1,464 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+	lua "github.com/yuin/gopher-lua"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/proto/msgpackpb"
+
+	"go.chromium.org/luci/server/quota/quotapb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// T0_SEC from luatest/testdata/test_fixtures.lua.
+const t0Sec = 1670834486
+
+// luaHarness runs update-accounts.lua logic on top of the fake redis from
+// luatest/testdata/test_fixtures.lua.
+const luaHarness = `
+function loadPolicy(config, key, raw)
+  redis.call("HSET", config, key, raw)
+end
+
+function applyOps(seconds, nanos, raw)
+  -- Each call emulates a separate run of update-accounts.lua.
+  G.Policy.CACHE = {}
+  G.Account.CACHE = {}
+  G.Utils.NOW.seconds = seconds
+  G.Utils.NOW.nanos = nanos
+
+  local req = PB.unmarshal("go.chromium.org.luci.server.quota.quotapb.UpdateAccountsInput", raw)
+  local ret = G.Utils.WithRequestID(req, function()
+    return G.Account.ApplyOps(req.ops)
+  end)
+  if type(ret) == "table" then
+    return nil, ret.error
+  end
+  return ret, nil
+end
+
+function getAccount(key)
+  return redis.DATA[key], redis.TTL[key]
+end
+`
+
+// luaStore runs the Lua implementation of the quota library.
+type luaStore struct {
+	L *lua.LState
+}
+
+func newLuaStore() *luaStore {
+	L := lua.NewState(lua.Options{})
+	So(L.DoFile("test_fixtures.lua"), ShouldBeNil)
+	So(L.DoString(luaHarness), ShouldBeNil)
+	return &luaStore{L}
+}
+
+func (s *luaStore) call(fn string, nret int, args ...lua.LValue) ([]lua.LValue, error) {
+	err := s.L.CallByParam(lua.P{Fn: s.L.GetGlobal(fn), NRet: nret, Protect: true}, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]lua.LValue, nret)
+	for i := range ret {
+		ret[i] = s.L.Get(i - nret)
+	}
+	s.L.Pop(nret)
+	return ret, nil
+}
+
+func (s *luaStore) loadPolicy(config, key string, p *quotapb.Policy) {
+	raw, err := msgpackpb.Marshal(p, msgpackpb.Deterministic)
+	So(err, ShouldBeNil)
+	_, err = s.call("loadPolicy", 0, lua.LString(config), lua.LString(key), lua.LString(raw))
+	So(err, ShouldBeNil)
+}
+
+func (s *luaStore) applyOps(now time.Time, input *quotapb.UpdateAccountsInput) (*quotapb.ApplyOpsResponse, error) {
+	raw, err := msgpackpb.Marshal(input, msgpackpb.Deterministic)
+	So(err, ShouldBeNil)
+	ret, err := s.call("applyOps", 2, lua.LNumber(now.Unix()), lua.LNumber(now.Nanosecond()), lua.LString(raw))
+	if err != nil {
+		return nil, err
+	}
+	if msg, ok := ret[1].(lua.LString); ok {
+		return nil, errorString(msg)
+	}
+	resp := &quotapb.ApplyOpsResponse{}
+	So(msgpackpb.Unmarshal(msgpack.RawMessage(ret[0].(lua.LString)), resp), ShouldBeNil)
+	return resp, nil
+}
+
+// getAccount returns the stored Account and its TTL.
+func (s *luaStore) getAccount(key string) (*quotapb.Account, time.Duration) {
+	ret, err := s.call("getAccount", 2, lua.LString(key))
+	So(err, ShouldBeNil)
+	raw, ok := ret[0].(lua.LString)
+	if !ok {
+		return nil, 0
+	}
+	account := &quotapb.Account{}
+	So(msgpackpb.Unmarshal(msgpack.RawMessage(raw), account), ShouldBeNil)
+	var ttl time.Duration
+	if ms, ok := ret[1].(lua.LNumber); ok {
+		ttl = time.Duration(ms) * time.Millisecond
+	}
+	return account, ttl
+}
+
+type errorString string
+
+func (e errorString) Error() string { return string(e) }
+
+// getAccount returns the stored Account and its TTL.
+func (s *Store) getAccount(key string, now time.Time) (*quotapb.Account, time.Duration) {
+	entry, ok := s.accounts[key]
+	if !ok {
+		return nil, 0
+	}
+	var ttl time.Duration
+	if !entry.expiry.IsZero() {
+		ttl = entry.expiry.Sub(now)
+	}
+	return entry.pb, ttl
+}
+
+type step struct {
+	advance time.Duration
+	input   *quotapb.UpdateAccountsInput
+}
+
+type scenario struct {
+	name     string
+	policies map[string]map[string]*quotapb.Policy // config => key => policy
+	steps    []step
+}
+
+func ref(config, key string) *quotapb.PolicyRef {
+	return &quotapb.PolicyRef{Config: config, Key: key}
+}
+
+func op(account string, ref *quotapb.PolicyRef, relativeTo quotapb.Op_RelativeTo, delta int64, options ...quotapb.Op_Options) *quotapb.RawOp {
+	ret := &quotapb.RawOp{
+		AccountRef: account,
+		PolicyRef:  ref,
+		RelativeTo: relativeTo,
+		Delta:      delta,
+	}
+	for _, o := range options {
+		ret.Options |= uint32(o)
+	}
+	return ret
+}
+
+func ops(ops ...*quotapb.RawOp) step {
+	return step{input: &quotapb.UpdateAccountsInput{Ops: ops}}
+}
+
+func later(advance time.Duration, ops ...*quotapb.RawOp) step {
+	return step{advance: advance, input: &quotapb.UpdateAccountsInput{Ops: ops}}
+}
+
+func request(key, hash string, hashScheme uint32, ops ...*quotapb.RawOp) step {
+	return step{input: &quotapb.UpdateAccountsInput{
+		RequestKey:    key,
+		RequestKeyTtl: durationpb.New(time.Hour),
+		HashScheme:    hashScheme,
+		Hash:          hash,
+		Ops:           ops,
+	}}
+}
+
+// boundsSteps ports the tables of testAccountApplyOp*Policy in
+// luatest/testdata/account_test.lua.
+//
+// Each step sets the balance to `cur` ignoring the policy and then applies
+// `delta` relative to zero with `options`.
+func boundsSteps(policy *quotapb.PolicyRef, cases [][3]int64) []step {
+	steps := []step{ops(op("acct", policy, quotapb.Op_ZERO, 0, ignore))}
+	for _, c := range cases {
+		steps = append(steps, ops(
+			op("acct", nil, quotapb.Op_ZERO, c[0], ignore),
+			op("acct", nil, quotapb.Op_ZERO, c[1], quotapb.Op_Options(c[2])),
+		))
+	}
+	return steps
+}
+
+const (
+	ignore     = quotapb.Op_IGNORE_POLICY_BOUNDS
+	noCap      = quotapb.Op_DO_NOT_CAP_PROPOSED
+	limitDelta = quotapb.Op_WITH_POLICY_LIMIT_DELTA
+)
+
+var scenarios = []scenario{
+	{
+		name: "ApplyOps OK",
+		policies: map[string]map[string]*quotapb.Policy{
+			"policy_config": {
+				"one": {Default: 10, Limit: 100},
+				"two": {Default: 20, Limit: 200},
+			},
+		},
+		steps: []step{
+			ops(
+				op("acct1", ref("policy_config", "one"), quotapb.Op_CURRENT_BALANCE, 100),
+				op("acct2", ref("policy_config", "two"), quotapb.Op_CURRENT_BALANCE, 100),
+			),
+			ops(
+				op("acct1", nil, quotapb.Op_CURRENT_BALANCE, -30),
+				op("acct2", ref("policy_config", "one"), quotapb.Op_CURRENT_BALANCE, 0),
+				op("acct1", nil, quotapb.Op_DEFAULT, 5),
+				op("acct2", nil, quotapb.Op_LIMIT, -1),
+			),
+		},
+	},
+	{
+		name: "ApplyOps fail",
+		policies: map[string]map[string]*quotapb.Policy{
+			"policy_config": {
+				"one": {Default: 10, Limit: 100},
+			},
+		},
+		steps: []step{
+			ops(
+				op("acct1", nil, quotapb.Op_CURRENT_BALANCE, 1000, noCap, ignore),
+				op("acct2", ref("policy_config", "one"), quotapb.Op_CURRENT_BALANCE, 1000, noCap),
+				op("acct3", ref("policy_config", "one"), quotapb.Op_CURRENT_BALANCE, -1000),
+				op("acct4", ref("nope", "missing"), quotapb.Op_CURRENT_BALANCE, 100),
+				op("acct5", nil, quotapb.Op_CURRENT_BALANCE, 100),
+				op("acct6", nil, quotapb.Op_ZERO, 100),
+				op("acct7", nil, quotapb.Op_ZERO, 100, noCap),
+				op("acct8", nil, quotapb.Op_LIMIT, 100, ignore),
+				op("acct9", ref("", ""), quotapb.Op_ZERO, 100, ignore),
+			),
+			// Nothing from the failed request was stored.
+			ops(
+				op("acct2", ref("policy_config", "one"), quotapb.Op_CURRENT_BALANCE, 0),
+				op("acct1", nil, quotapb.Op_ZERO, 7, ignore),
+			),
+		},
+	},
+	{
+		name: "Finite policy",
+		policies: map[string]map[string]*quotapb.Policy{
+			"policy_key": {"policy_name": {Limit: 1000}},
+		},
+		steps: boundsSteps(ref("policy_key", "policy_name"), [][3]int64{
+			{20, 300, 0}, {20, 5, 0}, {20, 1000, 0}, {20, 0, 0},
+			{-100, 300, 0}, {-100, -50, 0}, {-100, 0, 0}, {-100, 1000, 0},
+			{2000, 300, 0}, {2000, 1000, 0}, {2000, 0, 0},
+			{2000, 1500, 0}, {2000, 1500, int64(noCap)},
+			{20, -1, 0}, {-100, -200, 0}, {20, 1001, int64(noCap)}, {1500, 2000, int64(noCap)},
+		}),
+	},
+	{
+		name: "Infinite policy",
+		policies: map[string]map[string]*quotapb.Policy{
+			"policy_key": {"policy_name": {Limit: 1000, Refill: &quotapb.Policy_Refill{Units: 1}}},
+		},
+		steps: boundsSteps(ref("policy_key", "policy_name"), [][3]int64{
+			{1000, 0, 0}, {1000, 1000, 0}, {1000, 500, 0}, {1000, 1234, 0},
+			{2000, 1234, int64(noCap)},
+			{1000, -1000, 0}, {1000, 1001, int64(noCap)}, {1000, -1, 0},
+		}),
+	},
+	{
+		name: "Zero policy",
+		policies: map[string]map[string]*quotapb.Policy{
+			"policy_key": {"policy_name": {}},
+		},
+		steps: boundsSteps(ref("policy_key", "policy_name"), [][3]int64{
+			{0, 0, 0}, {100, 1, 0}, {100, 1, int64(noCap)},
+			{2000, 1000, 0}, {2000, 1000, int64(noCap)}, {-2000, -1000, 0},
+			{0, 1000, int64(noCap)}, {-100, 1, int64(noCap)}, {0, 1, int64(noCap)}, {0, -1, 0},
+		}),
+	},
+	{
+		name: "Policy limit delta",
+		policies: map[string]map[string]*quotapb.Policy{
+			"config1": {"key1": {Limit: 5}},
+			"config2": {"key2": {Limit: 10}},
+			"config3": {"key3": {Limit: 5}},
+			"config4": {"key4": {Limit: 5}},
+		},
+		steps: []step{
+			// No adjustment for a new account.
+			ops(op("acct", ref("config1", "key1"), quotapb.Op_ZERO, 3, limitDelta)),
+			ops(op("acct", ref("config2", "key2"), quotapb.Op_CURRENT_BALANCE, -2, limitDelta)),
+			ops(op("acct", ref("config3", "key3"), quotapb.Op_CURRENT_BALANCE, 1, limitDelta)),
+			ops(op("acct", ref("config4", "key4"), quotapb.Op_CURRENT_BALANCE, 1, limitDelta)),
+			ops(op("acct", ref("config4", "key4"), quotapb.Op_CURRENT_BALANCE, 1, limitDelta)),
+			ops(op("acct", ref("config2", "key2"), quotapb.Op_CURRENT_BALANCE, 0)),
+		},
+	},
+	{
+		name: "Refill",
+		policies: map[string]map[string]*quotapb.Policy{
+			"config": {
+				"up":   {Default: 3, Limit: 100, Refill: &quotapb.Policy_Refill{Units: 7, Interval: 60, Offset: 30}},
+				"down": {Default: 90, Limit: 100, Refill: &quotapb.Policy_Refill{Units: -2, Interval: 10}},
+				"inf":  {Default: 0, Limit: 50, Refill: &quotapb.Policy_Refill{Units: 1}},
+			},
+		},
+		steps: []step{
+			ops(
+				op("up", ref("config", "up"), quotapb.Op_CURRENT_BALANCE, 0),
+				op("down", ref("config", "down"), quotapb.Op_CURRENT_BALANCE, 0),
+				op("inf", ref("config", "inf"), quotapb.Op_CURRENT_BALANCE, -20),
+			),
+			later(10*time.Second, op("up", nil, quotapb.Op_CURRENT_BALANCE, 0), op("down", nil, quotapb.Op_CURRENT_BALANCE, 0)),
+			later(45*time.Second, op("up", nil, quotapb.Op_CURRENT_BALANCE, -1), op("down", nil, quotapb.Op_CURRENT_BALANCE, 0)),
+			later(time.Hour, op("up", nil, quotapb.Op_CURRENT_BALANCE, 0), op("down", nil, quotapb.Op_CURRENT_BALANCE, 0)),
+			later(time.Second, op("inf", nil, quotapb.Op_CURRENT_BALANCE, -60), op("inf", nil, quotapb.Op_CURRENT_BALANCE, -10)),
+			later(1500*time.Millisecond,
+				op("inf", nil, quotapb.Op_ZERO, 80, ignore),
+				op("down", ref("config", "up"), quotapb.Op_CURRENT_BALANCE, 1),
+				op("up", ref("config", "down"), quotapb.Op_CURRENT_BALANCE, 1),
+			),
+			later(2*time.Minute, op("down", nil, quotapb.Op_CURRENT_BALANCE, 0), op("up", nil, quotapb.Op_CURRENT_BALANCE, 0)),
+		},
+	},
+	{
+		name: "Lifetime",
+		policies: map[string]map[string]*quotapb.Policy{
+			"config": {
+				"short": {Default: 5, Limit: 10, Lifetime: durationpb.New(90 * time.Second)},
+				"long":  {Default: 5, Limit: 10, Lifetime: durationpb.New(48 * time.Hour)},
+			},
+		},
+		steps: []step{
+			ops(op("acct1", ref("config", "short"), quotapb.Op_CURRENT_BALANCE, 1)),
+			later(time.Second, op("acct1", ref("config", "long"), quotapb.Op_CURRENT_BALANCE, 1)),
+			later(time.Second, op("acct2", ref("config", "short"), quotapb.Op_CURRENT_BALANCE, 1)),
+		},
+	},
+	{
+		name: "Request deduplication",
+		policies: map[string]map[string]*quotapb.Policy{
+			"config": {"policy": {Default: 5, Limit: 10}},
+		},
+		steps: []step{
+			// Failed requests are not remembered.
+			request("req", "hash", 1, op("acct", ref("config", "policy"), quotapb.Op_CURRENT_BALANCE, -6)),
+			request("req", "hash", 1, op("acct", ref("config", "policy"), quotapb.Op_CURRENT_BALANCE, -1)),
+			request("req", "hash", 1, op("acct", ref("config", "policy"), quotapb.Op_CURRENT_BALANCE, -1)),
+			request("req", "other hash", 1, op("acct", ref("config", "policy"), quotapb.Op_CURRENT_BALANCE, -2)),
+			request("req", "other hash", 2, op("acct", ref("config", "policy"), quotapb.Op_CURRENT_BALANCE, -2)),
+			request("another", "hash", 1, op("acct", ref("config", "policy"), quotapb.Op_CURRENT_BALANCE, -1)),
+		},
+	},
+	{
+		name: "Extreme balance",
+		steps: []step{
+			ops(op("acct", nil, quotapb.Op_ZERO, 1, ignore)),
+			ops(op("acct", nil, quotapb.Op_ZERO, maxBalance+1, ignore)),
+			ops(op("acct", nil, quotapb.Op_ZERO, -maxBalance-1, ignore)),
+			ops(op("acct", nil, quotapb.Op_CURRENT_BALANCE, 1, ignore)),
+		},
+	},
+}
+
+// normalize drops status messages of unknown errors, since the ones produced
+// by Lua contain source code locations.
+func normalize(resp *quotapb.ApplyOpsResponse) {
+	for _, r := range resp.GetResults() {
+		if r.Status == quotapb.OpResult_ERR_UNKNOWN {
+			So(r.StatusMsg, ShouldNotBeEmpty)
+			r.StatusMsg = ""
+		}
+	}
+}
+
+func TestConsistentWithLua(t *testing.T) {
+	// test_fixtures.lua loads other Lua files relative to its own directory.
+	if err := os.Chdir("../luatest/testdata"); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.Chdir("../../memory"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	Convey(`Consistent with the Lua implementation`, t, func() {
+		for _, sc := range scenarios {
+			Convey(sc.name, func() {
+				tc := testclock.New(time.Unix(t0Sec, 0).UTC())
+				ctx := clock.Set(context.Background(), tc)
+
+				mem := NewStore()
+				ls := newLuaStore()
+				defer ls.L.Close()
+
+				for config, policies := range sc.policies {
+					mem.LoadPolicyConfig(config, policies)
+					for key, p := range policies {
+						ls.loadPolicy(config, key, p)
+					}
+				}
+
+				accounts := stringset.New(0)
+				for i, st := range sc.steps {
+					touched := stringset.New(len(st.input.Ops))
+					for _, op := range st.input.Ops {
+						touched.Add(op.AccountRef)
+						accounts.Add(op.AccountRef)
+					}
+					tc.Add(st.advance)
+					now := clock.Now(ctx)
+
+					memResp, memErr := mem.ApplyOps(ctx, st.input)
+					luaResp, luaErr := ls.applyOps(now, st.input)
+					if luaErr != nil {
+						SoMsg(fmt.Sprintf("step %d", i), memErr, ShouldNotBeNil)
+						if memErr == ErrRequestHash {
+							So(luaErr, ShouldErrLike, "REQUEST_HASH")
+						}
+						continue
+					}
+					So(memErr, ShouldBeNil)
+					normalize(memResp)
+					normalize(luaResp)
+					SoMsg(fmt.Sprintf("step %d", i), memResp, ShouldResembleProto, luaResp)
+
+					for _, key := range accounts.ToSortedSlice() {
+						memAccount, memTTL := mem.getAccount(key, now)
+						luaAccount, luaTTL := ls.getAccount(key)
+						SoMsg(key, memAccount, ShouldResembleProto, luaAccount)
+						// The fake redis remembers the TTL as it was set.
+						if touched.Has(key) {
+							SoMsg(key, memTTL, ShouldEqual, luaTTL)
+						}
+					}
+				}
+			})
+		}
+	})
+}
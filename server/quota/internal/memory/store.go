@@ -0,0 +1,221 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memory implements quota Accounts and Policies stored in the process
+// memory.
+//
+// It is a pure-Go port of the Lua scripts in
+// go.chromium.org/luci/server/quota/internal/lua, which implement the same
+// semantics on top of Redis. Any change to the behavior of one of them must be
+// reflected in the other; the luatest package verifies that both produce
+// identical results.
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/quota/quotapb"
+)
+
+// ErrRequestHash is returned by ApplyOps if the request key was already used
+// by a request with different content.
+var ErrRequestHash = errors.New("REQUEST_HASH")
+
+// defaultRequestKeyTTL is used if UpdateAccountsInput.RequestKeyTtl is unset.
+const defaultRequestKeyTTL = 2 * time.Hour
+
+// Store holds quota Accounts, PolicyConfigs and request deduplication entries.
+//
+// All ApplyOps calls are serialized, which makes them atomic the same way
+// a Lua script run by Redis is.
+//
+// Entries with a lifetime are removed lazily, when they are accessed after
+// expiration.
+type Store struct {
+	m        sync.Mutex
+	accounts map[string]*accountEntry              // account key => entry
+	configs  map[string]map[string]*quotapb.Policy // policy config key => policy key => policy
+	requests map[string]*requestEntry              // request dedup key => entry
+}
+
+type accountEntry struct {
+	pb     *quotapb.Account
+	expiry time.Time // zero if never expires
+}
+
+type requestEntry struct {
+	hashScheme uint32
+	hash       string
+	response   *quotapb.ApplyOpsResponse
+	expiry     time.Time // zero if never expires
+}
+
+// NewStore returns an empty Store.
+func NewStore() *Store {
+	return &Store{
+		accounts: map[string]*accountEntry{},
+		configs:  map[string]map[string]*quotapb.Policy{},
+		requests: map[string]*requestEntry{},
+	}
+}
+
+// now returns the current time with the precision of the Redis TIME command.
+func now(ctx context.Context) time.Time {
+	return clock.Now(ctx).UTC().Truncate(time.Microsecond)
+}
+
+// expired is true if the deadline is set and has passed.
+func expired(deadline, now time.Time) bool {
+	return !deadline.IsZero() && !now.Before(deadline)
+}
+
+// LoadPolicyConfig stores policies of the policy config with the given key.
+//
+// `policies` maps policy keys (as in PolicyRef.Key) to policies. If a config
+// with this key is already loaded, this is a noop.
+func (s *Store) LoadPolicyConfig(configKey string, policies map[string]*quotapb.Policy) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if _, ok := s.configs[configKey]; ok {
+		return
+	}
+	cfg := make(map[string]*quotapb.Policy, len(policies))
+	for key, p := range policies {
+		cfg[key] = proto.Clone(p).(*quotapb.Policy)
+	}
+	s.configs[configKey] = cfg
+}
+
+// GetAccounts returns stored Accounts for the given account keys.
+//
+// The returned slice has an entry for each key; it is nil if the Account
+// doesn't exist. Like in the Redis implementation, the Accounts are returned as
+// of their last update, without applying the refill policy.
+func (s *Store) GetAccounts(ctx context.Context, keys []string) []*quotapb.Account {
+	now := now(ctx)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	ret := make([]*quotapb.Account, len(keys))
+	for i, key := range keys {
+		if pb := s.loadAccount(key, now); pb != nil {
+			ret[i] = proto.Clone(pb).(*quotapb.Account)
+		}
+	}
+	return ret
+}
+
+// ApplyOps applies all ops in `input` atomically.
+//
+// Either all ops succeed and all the affected Accounts are updated, or none of
+// them are. Per-op results are reported in the response.
+//
+// If input.RequestKey is set and a request with this key has already succeeded,
+// returns the response of that request without applying anything, or
+// ErrRequestHash if the requests differ.
+//
+// Returns an error (and applies nothing) in the same cases as the Lua script
+// would fail with an error, e.g. if a balance leaves the range of values
+// representable by Lua.
+func (s *Store) ApplyOps(ctx context.Context, input *quotapb.UpdateAccountsInput) (*quotapb.ApplyOpsResponse, error) {
+	now := now(ctx)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if key := input.RequestKey; key != "" {
+		if req, ok := s.requests[key]; ok {
+			switch {
+			case expired(req.expiry, now):
+				delete(s.requests, key)
+			case req.hashScheme != input.HashScheme, req.hash == input.Hash:
+				return proto.Clone(req.response).(*quotapb.ApplyOpsResponse), nil
+			default:
+				return nil, ErrRequestHash
+			}
+		}
+	}
+
+	u := &update{
+		store:    s,
+		now:      now,
+		accounts: map[string]*account{},
+	}
+	resp, allOK, err := u.applyOps(input.Ops)
+	if err != nil {
+		return nil, err
+	}
+	if !allOK {
+		return resp, nil
+	}
+	if err := u.commit(); err != nil {
+		return nil, err
+	}
+	resp.OriginallySet = timestamppb.New(now)
+
+	if key := input.RequestKey; key != "" {
+		entry := &requestEntry{
+			hashScheme: input.HashScheme,
+			hash:       input.Hash,
+			response:   proto.Clone(resp).(*quotapb.ApplyOpsResponse),
+		}
+		ttl := defaultRequestKeyTTL
+		if input.RequestKeyTtl != nil {
+			ttl = millis(input.RequestKeyTtl)
+		}
+		if ttl > 0 {
+			entry.expiry = now.Add(ttl)
+		}
+		s.requests[key] = entry
+	}
+	return resp, nil
+}
+
+// loadAccount returns the stored Account or nil if it doesn't exist.
+//
+// Must be called under the lock.
+func (s *Store) loadAccount(key string, now time.Time) *quotapb.Account {
+	entry, ok := s.accounts[key]
+	if !ok {
+		return nil
+	}
+	if expired(entry.expiry, now) {
+		delete(s.accounts, key)
+		return nil
+	}
+	return entry.pb
+}
+
+// loadPolicy returns the stored Policy or nil if it doesn't exist.
+//
+// Must be called under the lock.
+func (s *Store) loadPolicy(ref *quotapb.PolicyRef) *quotapb.Policy {
+	return s.configs[ref.GetConfig()][ref.GetKey()]
+}
+
+// millis truncates the duration to milliseconds, like Utils.Millis does.
+func millis(d *durationpb.Duration) time.Duration {
+	return d.AsDuration().Truncate(time.Millisecond)
+}
@@ -20,10 +20,6 @@ import (
 	"encoding/ascii85"
 	"time"
 
-	"github.com/gomodule/redigo/redis"
-	"google.golang.org/protobuf/types/known/timestamppb"
-
-	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/proto/msgpackpb"
 
@@ -120,36 +116,5 @@ func (a *Application) loadPolicies(ctx context.Context, cid *quotapb.PolicyConfi
 
 	cfgIDKey := quotakeys.PolicyConfigID(cid)
 
-	return withRedisConn(ctx, func(conn redis.Conn) error {
-		// If this thing exists, we're done.
-		exists, err := redis.Bool(conn.Do("EXISTS", cfgIDKey))
-		if err != nil {
-			return errors.Annotate(err, "unable to check existance of policy config %q", cfgIDKey).Err()
-		}
-		if exists {
-			return nil
-		}
-
-		// At this point we'll call HSET; If we're racing, it's OK because the
-		// application has ensured us that (namespace, versionScheme, version)
-		// always maps to the same Config.
-		args := make(redis.Args, 0, 1+2+(len(cfg.Policies)*2))
-		args = args.Add(cfgIDKey)
-		tsBytes, err := msgpackpb.Marshal(timestamppb.New(clock.Now(ctx)), msgpackpb.Deterministic)
-		if err != nil {
-			return errors.Annotate(err, "serializing timestamp").Err()
-		}
-		args = args.Add("~loaded_time", string(tsBytes))
-
-		for i, entry := range cfg.Policies {
-			polBytes, err := msgpackpb.Marshal(entry.Policy, msgpackpb.Deterministic, msgpackpb.DisallowUnknownFields)
-			if err != nil {
-				return errors.Annotate(err, "serializing cfg.Policies[%d]", i).Err()
-			}
-			args = args.Add(quotakeys.PolicyKey(entry.Key), string(polBytes))
-		}
-
-		_, err = conn.Do("HSET", args...)
-		return errors.Annotate(err, "unable to load policy config").Err()
-	})
+	return getBackend(ctx).loadPolicies(ctx, cfgIDKey, cfg)
 }
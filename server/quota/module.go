@@ -17,11 +17,9 @@ package quota
 import (
 	"context"
 	"flag"
-
-	"github.com/gomodule/redigo/redis"
+	"fmt"
 
 	"go.chromium.org/luci/common/errors"
-	"go.chromium.org/luci/common/logging"
 
 	"go.chromium.org/luci/server/module"
 	"go.chromium.org/luci/server/quota/quotapb"
@@ -40,39 +38,29 @@ type quotaModule struct {
 	opts *ModuleOptions
 }
 
-var stateKey = "holds a *quotaModuleState"
-
-type quotaModuleState struct {
-	pool *redis.Pool
-}
-
 // Dependencies returns required and optional dependencies for this module.
 // Implements module.Module.
-func (*quotaModule) Dependencies() []module.Dependency {
+func (m *quotaModule) Dependencies() []module.Dependency {
+	if m.opts.Backend == MemoryBackend {
+		return nil
+	}
 	return []module.Dependency{
 		module.RequiredDependency(redisconn.ModuleName),
 	}
 }
 
-func withRedisConn(ctx context.Context, cb func(redis.Conn) error) (err error) {
-	conn, err := redisconn.Get(ctx)
-	if err != nil {
-		err = errors.Annotate(err, "quota: unable to get redis connection").Err()
-		return
-	}
-	defer func() {
-		if err := conn.Close(); err != nil {
-			logging.Errorf(ctx, "quota: unable to close redis connection: %s", err)
-		}
-	}()
-	return cb(conn)
-}
-
 // Initialize initializes this module by setting ModuleOptions in the context
 // and optionally registering the admin service.
 //
 // Implements module.Module.
 func (m *quotaModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
+	switch m.opts.Backend {
+	case RedisBackend:
+	case MemoryBackend:
+		ctx = UseMemoryBackend(ctx)
+	default:
+		return ctx, errors.Reason("unknown quota backend %q", m.opts.Backend).Err()
+	}
 	quotapb.RegisterAdminServer(host, &quotaAdmin{})
 	return ctx, nil
 }
@@ -85,22 +73,46 @@ func (*quotaModule) Name() module.Name {
 
 // ModuleOptions is a set of configuration options for the quota module.
 type ModuleOptions struct {
-	// TODO(iannucci): add option to select alternate database?
+	// Backend is where the quota state is stored: RedisBackend or
+	// MemoryBackend.
+	//
+	// Default is RedisBackend.
+	Backend string
 }
 
 // Register adds command line flags for these module options to the given
 // *flag.FlagSet. Mutates module options by initializing defaults.
 func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	if o.Backend == "" {
+		o.Backend = RedisBackend
+	}
+	f.StringVar(
+		&o.Backend,
+		"quota-backend",
+		o.Backend,
+		fmt.Sprintf(`Where to store the quota state: %q or %q (process memory, for single-replica servers).`, RedisBackend, MemoryBackend),
+	)
 }
 
 // NewModule returns a module.Module for the quota library initialized from the
 // given *ModuleOptions.
 func NewModule(opts *ModuleOptions) module.Module {
-	return &quotaModule{}
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	if opts.Backend == "" {
+		opts.Backend = RedisBackend
+	}
+	return &quotaModule{opts: opts}
 }
 
 // NewModuleFromFlags returns a module.Module for the quota library which can be
 // initialized from command line flags.
+//
+// Calling this function registers flags in flag.CommandLine. They are usually
+// parsed in server.Main(...).
 func NewModuleFromFlags() module.Module {
-	return NewModule(nil)
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
 }
@@ -20,8 +20,6 @@ import (
 	"encoding/hex"
 	"time"
 
-	"github.com/gomodule/redigo/redis"
-	"github.com/vmihailenco/msgpack/v5"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.chromium.org/luci/common/data/stringset"
@@ -145,36 +143,16 @@ func ApplyOps(ctx context.Context, requestID string, requestTTL *durationpb.Dura
 	if err != nil {
 		return nil, err
 	}
-	input, err := msgpackpb.Marshal(
-		inputMsg, msgpackpb.Deterministic,
-		msgpackpb.WithStringInternTable(keys))
+	resp, err := getBackend(ctx).applyOps(ctx, inputMsg, keys)
 	if err != nil {
-		return nil, errors.Annotate(err, "failed to marshal UpdateAccountsInput").Err()
+		return resp, err
 	}
-
-	fullArgs := make(redis.Args, 0, len(keys)+2)
-	fullArgs = fullArgs.Add(len(keys))
-	fullArgs = fullArgs.AddFlat(keys)
-	fullArgs = fullArgs.Add(string(input))
-
-	resp := &quotapb.ApplyOpsResponse{}
-	err = withRedisConn(ctx, func(conn redis.Conn) error {
-		respRaw, err := redis.String(UpdateAccountsScript.DoContext(ctx, conn, fullArgs...))
-		if err != nil {
-			return errors.Annotate(err, "running UpdateAccountsScript").Err()
-		}
-		if err := msgpackpb.Unmarshal(msgpack.RawMessage(respRaw), resp); err != nil {
-			return err
-		}
-		for _, result := range resp.Results {
-			if result.Status != quotapb.OpResult_SUCCESS {
-				err = ErrQuotaApply
-				break
-			}
+	for _, result := range resp.Results {
+		if result.Status != quotapb.OpResult_SUCCESS {
+			return resp, ErrQuotaApply
 		}
-		return err
-	})
-	return resp, err
+	}
+	return resp, nil
 }
 
 // GetAccounts fetches the list of requested accounts. If the account does not
@@ -186,9 +164,9 @@ func GetAccounts(ctx context.Context, accounts []*quotapb.AccountID) (*quotapb.G
 		return resp, nil
 	}
 
-	args := make(redis.Args, 0, len(accounts))
+	keys := make([]string, 0, len(accounts))
 	for _, accountID := range accounts {
-		args = append(args, quotakeys.AccountKey(accountID))
+		keys = append(keys, quotakeys.AccountKey(accountID))
 
 		// rsp contains an entry for all the requested accounts, in spite of their existence.
 		resp.Accounts = append(resp.Accounts, &quotapb.GetAccountsResponse_AccountState{
@@ -196,31 +174,13 @@ func GetAccounts(ctx context.Context, accounts []*quotapb.AccountID) (*quotapb.G
 		})
 	}
 
-	err := withRedisConn(ctx, func(conn redis.Conn) error {
-		accountsRaw, err := redis.Strings(conn.Do("MGET", args...))
-		if err != nil {
-			return errors.Annotate(err, "running MGET").Err()
-		}
-
-		for i, accountRaw := range accountsRaw {
-			if accountRaw == "" {
-				continue
-			}
-
-			account := &quotapb.Account{}
-			if err := msgpackpb.Unmarshal(msgpack.RawMessage(accountRaw), account); err != nil {
-				return err
-			}
-
-			resp.Accounts[i].Account = account
-		}
-
-		return nil
-	})
-
+	states, err := getBackend(ctx).getAccounts(ctx, keys)
 	if err != nil {
 		return nil, errors.Annotate(err, "failed to query accounts").Err()
 	}
+	for i, account := range states {
+		resp.Accounts[i].Account = account
+	}
 
 	return resp, nil
 }
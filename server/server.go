@@ -197,6 +197,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
@@ -220,6 +221,7 @@ import (
 	tsmoncommon "go.chromium.org/luci/common/tsmon"
 	"go.chromium.org/luci/common/tsmon/metric"
 	"go.chromium.org/luci/common/tsmon/monitor"
+	"go.chromium.org/luci/common/tsmon/otelbridge"
 	"go.chromium.org/luci/common/tsmon/target"
 	"go.chromium.org/luci/grpc/discovery"
 	"go.chromium.org/luci/grpc/grpcmon"
@@ -366,6 +368,8 @@ type Options struct {
 	TsMonJobName       string        // job name of tsmon target
 	TsMonFlushInterval time.Duration // how often to flush metrics
 	TsMonFlushTimeout  time.Duration // timeout for flushing
+	TsMonPrometheus    bool          // if true, serve metrics to Prometheus on the admin port
+	TsMonOTel          bool          // if true, export metrics via TsMonProducer()
 
 	ProfilingProbability float64 // an [0; 1.0] float with a chance to enable Cloud Profiler in the process
 	ProfilingServiceID   string  // service name to associated with profiles in Cloud Profiler
@@ -570,6 +574,18 @@ func (o *Options) Register(f *flag.FlagSet) {
 		o.TsMonFlushTimeout,
 		fmt.Sprintf("Timeout for tsmon flush. Default to %s if < 1s or unset. Must be shorter than --ts-mon-flush-interval.", o.TsMonFlushTimeout),
 	)
+	f.BoolVar(
+		&o.TsMonPrometheus,
+		"ts-mon-prometheus",
+		o.TsMonPrometheus,
+		"If set, serve tsmon metrics in OpenMetrics format via /metrics on the admin port. They are updated on each flush.",
+	)
+	f.BoolVar(
+		&o.TsMonOTel,
+		"ts-mon-otel",
+		o.TsMonOTel,
+		"If set, export tsmon metrics through OpenTelemetry readers that use the server's TsMonProducer(). They are updated on each flush.",
+	)
 	f.Float64Var(
 		&o.ProfilingProbability,
 		"profiling-probability",
@@ -915,6 +931,8 @@ type Server struct {
 	cleanup  []func(context.Context)
 
 	tsmon      *tsmon.State                  // manages flushing of tsmon metrics
+	prometheus *monitor.PrometheusMonitor    // serves tsmon metrics to Prometheus if enabled
+	otelbridge *otelbridge.Monitor           // exports tsmon metrics via OpenTelemetry if enabled
 	propagator propagation.TextMapPropagator // knows how to propagate trace headers
 
 	cloudTS     oauth2.TokenSource // source of cloud-scoped tokens for Cloud APIs
@@ -1287,6 +1305,25 @@ func (s *Server) newRouter(opts PortOptions) *router.Router {
 	return r
 }
 
+// TsMonProducer returns an OpenTelemetry metric producer that exports tsmon
+// metrics, or nil if -ts-mon-otel is not set.
+//
+// Attach it to an OpenTelemetry reader to export tsmon metrics along with
+// OpenTelemetry ones, e.g.:
+//
+//	if p := srv.TsMonProducer(); p != nil {
+//	  reader := sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithProducer(p))
+//	  ...
+//	}
+//
+// The producer reports metrics as of the last tsmon flush.
+func (s *Server) TsMonProducer() sdkmetric.Producer {
+	if s.otelbridge == nil {
+		return nil
+	}
+	return s.otelbridge
+}
+
 // RunInBackground launches the given callback in a separate goroutine right
 // before starting the serving loop.
 //
@@ -2496,6 +2533,19 @@ func (s *Server) initTSMon() error {
 		customMonitor = monitor.NewNilMonitor()
 	}
 
+	// Prometheus scrapes metrics through the admin port.
+	if s.Options.TsMonPrometheus {
+		if s.Options.AdminAddr == "-" {
+			return errors.Reason("-ts-mon-prometheus requires the admin port, but it is disabled").Err()
+		}
+		s.prometheus = monitor.NewPrometheusMonitor()
+	}
+
+	// OpenTelemetry readers pull metrics via TsMonProducer().
+	if s.Options.TsMonOTel {
+		s.otelbridge = otelbridge.NewMonitor()
+	}
+
 	interval := int(s.Options.TsMonFlushInterval.Seconds())
 	if interval == 0 {
 		interval = int(defaultTsMonFlushInterval.Seconds())
@@ -2509,6 +2559,8 @@ func (s *Server) initTSMon() error {
 	}
 	s.tsmon = &tsmon.State{
 		CustomMonitor: customMonitor,
+		Prometheus:    s.prometheus,
+		OpenTelemetry: s.otelbridge,
 		Settings: &tsmon.Settings{
 			Enabled:            true,
 			ProdXAccount:       s.Options.TsMonAccount,
@@ -2885,6 +2937,13 @@ func (s *Server) initAdminPort() error {
 	})
 	portal.InstallHandlers(routes, withAdminSecret, portal.AssumeTrustedPort)
 
+	// Serve tsmon metrics to Prometheus, if enabled.
+	if s.prometheus != nil {
+		routes.GET("/metrics", nil, func(c *router.Context) {
+			s.prometheus.ServeHTTP(c.Writer, c.Request)
+		})
+	}
+
 	// Install pprof endpoints on the admin port. Note that they must not be
 	// exposed via the main serving port, since they do no authentication and
 	// may leak internal information. Also note that pprof handlers rely on
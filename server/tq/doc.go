@@ -44,6 +44,10 @@
 //
 //	import _ "go.chromium.org/luci/server/tq/txn/spanner"
 //
+// For PostgreSQL (transactions must be started via postgres.ReadWriteTransaction):
+//
+//	import "go.chromium.org/luci/server/tq/txn/postgres"
+//
 // The exact location of the import doesn't matter as long as the package is
 // present in the import tree of the binary. If your tests use transactional
 // tasks, they'll need to import the corresponding packages as well.
@@ -74,4 +78,9 @@
 //	  SerializedParts ARRAY<STRING(MAX)>,
 //	  ExpiresAt TIMESTAMP NOT NULL,
 //	) PRIMARY KEY (SectionID ASC, LeaseID ASC);
+//
+// # Using the sweeper with PostgreSQL
+//
+// Create tables defined in server/tq/txn/postgres/init_db.sql in your database
+// and install the *sql.DB into the server context via postgres.UseDB.
 package tq
@@ -0,0 +1,74 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres contains Transactional Enqueue support for PostgreSQL.
+//
+// Importing this package adds PostgreSQL transactions support to server/tq's
+// AddTask. Works only for transactions initiated via ReadWriteTransaction from
+// this package, which runs them through the *sql.DB installed in the context
+// via UseDB. The package uses only database/sql, so any PostgreSQL driver will
+// do; the binary must import one.
+//
+// The database must have tables defined in init_db.sql.
+//
+// Usage:
+//
+//	import "go.chromium.org/luci/server/tq/txn/postgres"
+//
+//	server.Main(nil, modules, func(srv *server.Server) error {
+//	  db, err := sql.Open("postgres", dsn)
+//	  if err != nil {
+//	    return err
+//	  }
+//	  srv.Context = postgres.UseDB(srv.Context, db)
+//	  ...
+//	})
+//
+//	err := postgres.ReadWriteTransaction(ctx, nil, func(ctx context.Context) error {
+//	  if _, err := postgres.Tx(ctx).ExecContext(ctx, "..."); err != nil {
+//	    return err
+//	  }
+//	  return tq.AddTask(ctx, &tq.Task{...})
+//	})
+package postgres
+
+import (
+	"context"
+
+	"go.chromium.org/luci/server/tq/internal/db"
+	"go.chromium.org/luci/server/tq/internal/lessor"
+)
+
+var impl pgDB
+
+func init() {
+	db.Register(db.Impl{
+		Kind: impl.Kind(),
+		ProbeForTxn: func(ctx context.Context) db.DB {
+			if Tx(ctx) != nil {
+				return impl
+			}
+			return nil
+		},
+		NonTxn: func(ctx context.Context) db.DB {
+			return impl
+		},
+	})
+}
+
+func init() {
+	lessor.Register("postgres", func(context.Context) (lessor.Lessor, error) {
+		return &pgLessor{}, nil
+	})
+}
@@ -0,0 +1,32 @@
+-- Copyright 2024 The LUCI Authors.
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--      http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+--------------------------------------------------------------------------------
+-- This script initializes PostgreSQL tables required by tq.
+
+-- Reminder IDs are compared bytewise by the sweeper, hence the "C" collation.
+CREATE TABLE tq_reminders (
+    id TEXT COLLATE "C" NOT NULL PRIMARY KEY,
+    fresh_until TIMESTAMPTZ NOT NULL,
+    payload BYTEA NOT NULL
+);
+
+-- serialized_parts is a comma-separated list of partitions.
+CREATE TABLE tq_leases (
+    section_id TEXT NOT NULL,
+    lease_id BIGINT NOT NULL,
+    serialized_parts TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (section_id, lease_id)
+);
@@ -0,0 +1,174 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+
+	"go.chromium.org/luci/server/tq/internal/reminder"
+)
+
+// tableName is the name of the table that user must create in their database
+// prior to using this package, see init_db.sql.
+//
+// If you ever need to change this, change also user-visible server/tq doc.
+const tableName = "tq_reminders"
+
+// queryer is implemented by both *sql.DB and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// conn returns the current transaction, if any, or the database.
+func conn(ctx context.Context) (queryer, error) {
+	if tx := Tx(ctx); tx != nil {
+		return tx, nil
+	}
+	if db := GetDB(ctx); db != nil {
+		return db, nil
+	}
+	return nil, errors.Reason("no PostgreSQL database in the context, see UseDB").Err()
+}
+
+type pgDB struct{}
+
+func (pgDB) Kind() string {
+	return "postgres"
+}
+
+func (pgDB) Defer(ctx context.Context, cb func(context.Context)) {
+	Defer(ctx, cb)
+}
+
+func (pgDB) SaveReminder(ctx context.Context, r *reminder.Reminder) error {
+	q, err := conn(ctx)
+	if err != nil {
+		return err
+	}
+	payload := r.RawPayload
+	if payload == nil {
+		payload = []byte{}
+	}
+	_, err = q.ExecContext(ctx,
+		"INSERT INTO "+tableName+" (id, fresh_until, payload) VALUES ($1, $2, $3)",
+		r.ID, r.FreshUntil.UTC(), payload)
+	if err != nil {
+		return errors.Annotate(err, "failed to save the Reminder %s", r.ID).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+func (pgDB) DeleteReminder(ctx context.Context, r *reminder.Reminder) error {
+	q, err := conn(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = q.ExecContext(ctx, "DELETE FROM "+tableName+" WHERE id = $1", r.ID)
+	if err != nil {
+		return errors.Annotate(err, "failed to delete the Reminder %s", r.ID).Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+func (pgDB) FetchRemindersMeta(ctx context.Context, low string, high string, limit int) (res []*reminder.Reminder, err error) {
+	q, err := conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := q.QueryContext(ctx,
+		"SELECT id, fresh_until FROM "+tableName+" WHERE id >= $1 AND id < $2 ORDER BY id LIMIT $3",
+		low, high, limit)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch Reminder keys").Tag(transient.Tag).Err()
+	}
+	defer rows.Close()
+	for rows.Next() {
+		r := &reminder.Reminder{}
+		if err := rows.Scan(&r.ID, &r.FreshUntil); err != nil {
+			return res, errors.Annotate(err, "failed to fetch Reminder keys").Tag(transient.Tag).Err()
+		}
+		r.FreshUntil = r.FreshUntil.UTC()
+		res = append(res, r)
+	}
+	if err := rows.Err(); err != nil && err != context.DeadlineExceeded {
+		return res, errors.Annotate(err, "failed to fetch Reminder keys").Tag(transient.Tag).Err()
+	}
+	return res, nil
+}
+
+func (pgDB) FetchReminderRawPayloads(ctx context.Context, batch []*reminder.Reminder) ([]*reminder.Reminder, error) {
+	if len(batch) == 0 {
+		return batch, nil
+	}
+	q, err := conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*reminder.Reminder, len(batch))
+	params := make([]string, len(batch))
+	args := make([]any, len(batch))
+	for i, r := range batch {
+		byID[r.ID] = r
+		params[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = r.ID
+	}
+
+	rows, err := q.QueryContext(ctx,
+		"SELECT id, fresh_until, payload FROM "+tableName+" WHERE id IN ("+strings.Join(params, ", ")+")",
+		args...)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch Reminders").Tag(transient.Tag).Err()
+	}
+	defer rows.Close()
+
+	found := make(map[string]bool, len(batch))
+	for rows.Next() {
+		var id string
+		var freshUntil time.Time
+		var payload []byte
+		if err = rows.Scan(&id, &freshUntil, &payload); err != nil {
+			break
+		}
+		if r := byID[id]; r != nil {
+			r.FreshUntil = freshUntil.UTC()
+			r.RawPayload = payload
+			found[id] = true
+		}
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+
+	// Return the fetched Reminders in the original order.
+	out := batch[:0]
+	for _, r := range batch {
+		if found[r.ID] {
+			out = append(out, r)
+		}
+	}
+	if err != nil {
+		return out, errors.Annotate(err, "failed to fetch Reminders").Tag(transient.Tag).Err()
+	}
+	return out, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build postgres
+
+// Tests in this file run against a real PostgreSQL database, see dsnEnvVar.
+//
+// They depend on github.com/lib/pq, which the module doesn't require, so they
+// are behind the "postgres" build tag. Run them with:
+//
+//	go test -mod=mod -tags postgres ./server/tq/txn/postgres/
+//
+// This adds the driver to the local go.mod. Don't commit that change.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/tq/internal/partition"
+	"go.chromium.org/luci/server/tq/internal/testutil"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// dsnEnvVar is the env var with the DSN of a PostgreSQL database to run tests
+// against, e.g. "postgres://localhost/tqtest?sslmode=disable".
+//
+// The database must be initialized with init_db.sql. All data in tq tables is
+// deleted by the tests.
+const dsnEnvVar = "TQ_POSTGRES_TEST_DSN"
+
+// testContext returns a context with a clean test database or skips the test.
+func testContext(t *testing.T) context.Context {
+	dsn := os.Getenv(dsnEnvVar)
+	if dsn == "" {
+		t.Skipf("env var %s is not set", dsnEnvVar)
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("failed to open the database: %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec("TRUNCATE tq_reminders, tq_leases"); err != nil {
+		t.Fatalf("failed to clean up the database: %s", err)
+	}
+	return UseDB(context.Background(), db)
+}
+
+func TestDB(t *testing.T) {
+	testutil.RunDBAcceptance(testContext(t), impl, t)
+}
+
+func TestTransactionsWithDB(t *testing.T) {
+	ctx := testContext(t)
+
+	Convey("ReadWriteTransaction", t, func() {
+		count := func() (n int) {
+			So(GetDB(ctx).QueryRow("SELECT COUNT(*) FROM tq_reminders").Scan(&n), ShouldBeNil)
+			return
+		}
+		insert := func(ctx context.Context) {
+			_, err := Tx(ctx).ExecContext(ctx,
+				"INSERT INTO tq_reminders (id, fresh_until, payload) VALUES ('a', NOW(), '')")
+			So(err, ShouldBeNil)
+		}
+
+		Convey("Commits and calls deferred callbacks", func() {
+			var calls []string
+			err := ReadWriteTransaction(ctx, nil, func(ctx context.Context) error {
+				So(Tx(ctx), ShouldNotBeNil)
+				So(func() { ReadWriteTransaction(ctx, nil, nil) }, ShouldPanic)
+				insert(ctx)
+				Defer(ctx, func(ctx context.Context) {
+					So(Tx(ctx), ShouldBeNil)
+					calls = append(calls, "1")
+				})
+				Defer(ctx, func(ctx context.Context) { calls = append(calls, "2") })
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(calls, ShouldResemble, []string{"2", "1"})
+			So(count(), ShouldEqual, 1)
+		})
+
+		Convey("Rolls back on errors", func() {
+			boom := errors.New("boom")
+			called := false
+			err := ReadWriteTransaction(ctx, nil, func(ctx context.Context) error {
+				insert(ctx)
+				Defer(ctx, func(ctx context.Context) { called = true })
+				return boom
+			})
+			So(err, ShouldEqual, boom)
+			So(called, ShouldBeFalse)
+			So(count(), ShouldEqual, 0)
+		})
+	})
+}
+
+func TestLeasing(t *testing.T) {
+	ctx := testContext(t)
+
+	Convey("Leasing works", t, func() {
+		now := clock.Now(ctx).UTC().Truncate(time.Microsecond)
+		ctx, tclock := testclock.UseTime(ctx, now)
+		lessor := pgLessor{}
+
+		var outer partition.SortedPartitions
+		err := lessor.WithLease(ctx, "section", partition.FromInts(10, 40), time.Minute,
+			func(ctx context.Context, parts partition.SortedPartitions) {
+				outer = parts
+
+				// Only the remaining part of the keyspace is available.
+				var inner partition.SortedPartitions
+				err := lessor.WithLease(ctx, "section", partition.FromInts(0, 50), time.Minute,
+					func(_ context.Context, parts partition.SortedPartitions) {
+						inner = parts
+					})
+				So(err, ShouldBeNil)
+				So(inner, ShouldResemble, partition.SortedPartitions{
+					partition.FromInts(0, 10),
+					partition.FromInts(40, 50),
+				})
+
+				// Other sections are independent.
+				err = lessor.WithLease(ctx, "another", partition.FromInts(0, 50), time.Minute,
+					func(_ context.Context, parts partition.SortedPartitions) {
+						inner = parts
+					})
+				So(err, ShouldBeNil)
+				So(inner, ShouldResemble, partition.SortedPartitions{partition.FromInts(0, 50)})
+
+				// Expired leases are ignored and deleted.
+				tclock.Add(2 * time.Minute)
+				err = lessor.WithLease(ctx, "section", partition.FromInts(0, 50), time.Minute,
+					func(_ context.Context, parts partition.SortedPartitions) {
+						inner = parts
+					})
+				So(err, ShouldBeNil)
+				So(inner, ShouldResemble, partition.SortedPartitions{partition.FromInts(0, 50)})
+			})
+		So(err, ShouldBeNil)
+		So(outer, ShouldResemble, partition.SortedPartitions{partition.FromInts(10, 40)})
+
+		all, err := loadAll(ctx, "section")
+		So(err, ShouldBeNil)
+		So(all, ShouldHaveLength, 0)
+	})
+}
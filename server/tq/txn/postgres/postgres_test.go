@@ -0,0 +1,167 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/tq/internal/partition"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestTransactions(t *testing.T) {
+	t.Parallel()
+
+	Convey("Without database", t, func() {
+		ctx := context.Background()
+
+		So(func() { ReadWriteTransaction(ctx, nil, func(context.Context) error { return nil }) }, ShouldPanic)
+		So(func() { Defer(ctx, func(context.Context) {}) }, ShouldPanic)
+		So(Tx(ctx), ShouldBeNil)
+		_, err := impl.FetchRemindersMeta(ctx, "00", "ff", 1)
+		So(err, ShouldErrLike, "no PostgreSQL database in the context")
+	})
+}
+
+func TestTransactionsWithFakeDriver(t *testing.T) {
+	t.Parallel()
+
+	Convey("ReadWriteTransaction", t, func() {
+		fake := &fakeConnector{}
+		db := sql.OpenDB(fake)
+		defer db.Close()
+		ctx := UseDB(context.Background(), db)
+
+		Convey("Commits and calls deferred callbacks", func() {
+			var calls []string
+			err := ReadWriteTransaction(ctx, nil, func(ctx context.Context) error {
+				So(Tx(ctx), ShouldNotBeNil)
+				So(func() { ReadWriteTransaction(ctx, nil, nil) }, ShouldPanic)
+				Defer(ctx, func(ctx context.Context) {
+					So(Tx(ctx), ShouldBeNil)
+					calls = append(calls, "1")
+				})
+				Defer(ctx, func(ctx context.Context) { calls = append(calls, "2") })
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(calls, ShouldResemble, []string{"2", "1"})
+			So(fake.calls(), ShouldResemble, []string{"begin", "commit"})
+		})
+
+		Convey("Rolls back on errors", func() {
+			boom := errors.New("boom")
+			called := false
+			err := ReadWriteTransaction(ctx, nil, func(ctx context.Context) error {
+				Defer(ctx, func(ctx context.Context) { called = true })
+				return boom
+			})
+			So(err, ShouldEqual, boom)
+			So(called, ShouldBeFalse)
+			So(fake.calls(), ShouldResemble, []string{"begin", "rollback"})
+		})
+
+		Convey("Rolls back on panics", func() {
+			called := false
+			So(func() {
+				ReadWriteTransaction(ctx, nil, func(ctx context.Context) error {
+					Defer(ctx, func(ctx context.Context) { called = true })
+					panic("boom")
+				})
+			}, ShouldPanicWith, "boom")
+			So(called, ShouldBeFalse)
+			So(fake.calls(), ShouldResemble, []string{"begin", "rollback"})
+		})
+	})
+}
+
+func TestAvailableForLease(t *testing.T) {
+	t.Parallel()
+
+	Convey("availableForLease", t, func() {
+		ctx, _ := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		now := clock.Now(ctx)
+
+		all := []*lease{
+			{LeaseID: 1, SerializedParts: []string{"a_f"}, ExpiresAt: now.Add(time.Minute)},
+			{LeaseID: 5, SerializedParts: []string{"10_20"}, ExpiresAt: now.Add(-time.Minute)},
+			{LeaseID: 3, SerializedParts: []string{"20_25", "30_35"}, ExpiresAt: now.Add(time.Minute)},
+		}
+		So(maxLeaseID(all), ShouldEqual, 5)
+
+		active, expired := activeAndExpired(ctx, all)
+		So(active, ShouldHaveLength, 2)
+		So(expired, ShouldHaveLength, 1)
+		So(expired[0].LeaseID, ShouldEqual, 5)
+
+		parts, err := availableForLease(partition.FromInts(0, 0x40), active)
+		So(err, ShouldBeNil)
+		So(parts, ShouldResemble, partition.SortedPartitions{
+			partition.FromInts(0, 0xa),
+			partition.FromInts(0xf, 0x20),
+			partition.FromInts(0x25, 0x30),
+			partition.FromInts(0x35, 0x40),
+		})
+	})
+}
+
+// fakeConnector is a database/sql connector that records transaction calls.
+//
+// It doesn't support queries.
+type fakeConnector struct {
+	m   sync.Mutex
+	log []string
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn{c}, nil }
+func (c *fakeConnector) Driver() driver.Driver                        { return fakeDriver{} }
+
+func (c *fakeConnector) record(call string) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.log = append(c.log, call)
+}
+
+func (c *fakeConnector) calls() []string {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return append([]string(nil), c.log...)
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return nil, errors.New("not supported") }
+
+type fakeConn struct{ c *fakeConnector }
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)         { c.c.record("begin"); return fakeTx(c), nil }
+
+type fakeTx struct{ c *fakeConnector }
+
+func (t fakeTx) Commit() error   { t.c.record("commit"); return nil }
+func (t fakeTx) Rollback() error { t.c.record("rollback"); return nil }
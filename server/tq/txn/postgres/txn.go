@@ -0,0 +1,141 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+)
+
+var dbContextKey = "go.chromium.org/luci/server/tq/txn/postgres:db"
+var txnContextKey = "go.chromium.org/luci/server/tq/txn/postgres:txn"
+
+// UseDB returns a context with the given database installed.
+//
+// It is used by ReadWriteTransaction and by the sweeper, which reads and
+// deletes reminders outside of transactions.
+func UseDB(ctx context.Context, db *sql.DB) context.Context {
+	return context.WithValue(ctx, &dbContextKey, db)
+}
+
+// GetDB returns the database installed in the context via UseDB or nil.
+func GetDB(ctx context.Context) *sql.DB {
+	db, _ := ctx.Value(&dbContextKey).(*sql.DB)
+	return db
+}
+
+// ReadWriteTransaction runs `cb` in a transaction of the database installed in
+// the context.
+//
+// The callback receives a context carrying the transaction, see Tx. Tasks
+// added via tq.AddTask with this context are enqueued only if the transaction
+// commits.
+//
+// The transaction is committed if the callback returns nil and rolled back if
+// it returns an error or panics. Unlike the Spanner and Datastore libraries,
+// the transaction is not retried on conflicts: which errors are retriable
+// depends on the isolation level and the driver, so this is left to the caller.
+//
+// Callbacks registered via Defer are executed after a successful commit.
+//
+// Panics if there's no database in the context or if the context is already
+// transactional.
+func ReadWriteTransaction(ctx context.Context, opts *sql.TxOptions, cb func(ctx context.Context) error) error {
+	db := GetDB(ctx)
+	if db == nil {
+		panic("no PostgreSQL database in the context, see UseDB")
+	}
+	if Tx(ctx) != nil {
+		panic("nested PostgreSQL transactions are not allowed")
+	}
+
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return errors.Annotate(err, "failed to begin a transaction").Err()
+	}
+	// Roll back if the callback fails or panics.
+	done := false
+	defer func() {
+		if !done {
+			if rerr := tx.Rollback(); rerr != nil && !errors.Is(rerr, sql.ErrTxDone) {
+				logging.Warningf(ctx, "Failed to roll back the transaction: %s", rerr)
+			}
+		}
+	}()
+
+	state := &txnState{tx: tx}
+	if err := cb(context.WithValue(ctx, &txnContextKey, state)); err != nil {
+		return err
+	}
+	done = true
+	if err := tx.Commit(); err != nil {
+		return errors.Annotate(err, "failed to commit the transaction").Err()
+	}
+	state.execCBs(ctx)
+	return nil
+}
+
+// Tx returns the current transaction or nil if the context is not
+// transactional.
+func Tx(ctx context.Context) *sql.Tx {
+	if state := getTxnState(ctx); state != nil {
+		return state.tx
+	}
+	return nil
+}
+
+// Defer schedules `cb` for execution when the current transaction successfully
+// commits.
+//
+// Callbacks are executed sequentially in the reverse order they were deferred.
+// They receive the non-transactional version of the context initially passed
+// to ReadWriteTransaction. There's no guarantee they are called if the process
+// crashes right after the commit.
+//
+// Panics if the given context is not transactional.
+func Defer(ctx context.Context, cb func(context.Context)) {
+	state := getTxnState(ctx)
+	if state == nil {
+		panic("not a PostgreSQL transactional context")
+	}
+	state.m.Lock()
+	state.cbs = append(state.cbs, cb)
+	state.m.Unlock()
+}
+
+type txnState struct {
+	tx *sql.Tx
+
+	m   sync.Mutex
+	cbs []func(context.Context)
+}
+
+func (s *txnState) execCBs(ctx context.Context) {
+	s.m.Lock()
+	cbs := s.cbs
+	s.m.Unlock()
+	for i := len(cbs) - 1; i >= 0; i-- {
+		cbs[i](ctx)
+	}
+}
+
+func getTxnState(ctx context.Context) *txnState {
+	s, _ := ctx.Value(&txnContextKey).(*txnState)
+	return s
+}
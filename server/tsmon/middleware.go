@@ -26,6 +26,7 @@ import (
 	"go.chromium.org/luci/common/tsmon"
 	"go.chromium.org/luci/common/tsmon/metric"
 	"go.chromium.org/luci/common/tsmon/monitor"
+	"go.chromium.org/luci/common/tsmon/otelbridge"
 	"go.chromium.org/luci/common/tsmon/runtimestats"
 	"go.chromium.org/luci/common/tsmon/store"
 	"go.chromium.org/luci/common/tsmon/target"
@@ -78,6 +79,18 @@ type State struct {
 	// the debug mode.
 	CustomMonitor monitor.Monitor
 
+	// Prometheus, if not nil, additionally receives all flushed metrics.
+	//
+	// It serves them to Prometheus scrapers. The main monitor (CustomMonitor
+	// or the default one) still receives all metrics as well.
+	Prometheus *monitor.PrometheusMonitor
+
+	// OpenTelemetry, if not nil, additionally receives all flushed metrics.
+	//
+	// It exports them through OpenTelemetry readers it is attached to as
+	// a producer. The main monitor still receives all metrics as well.
+	OpenTelemetry *otelbridge.Monitor
+
 	// FlushInMiddleware is true to make Middleware(...) periodically
 	// synchronously send metrics to the backend after handling a request.
 	//
@@ -256,6 +269,12 @@ func (s *State) enableTSMon(ctx context.Context, prodXAccount string) {
 			mon = prodx
 		}
 	}
+	if s.Prometheus != nil {
+		mon = &teeMonitor{main: mon, extra: s.Prometheus}
+	}
+	if s.OpenTelemetry != nil {
+		mon = &teeMonitor{main: mon, extra: s.OpenTelemetry}
+	}
 	s.state.SetMonitor(mon)
 }
 
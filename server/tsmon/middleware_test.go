@@ -25,6 +25,7 @@ import (
 	"go.chromium.org/luci/common/tsmon"
 	"go.chromium.org/luci/common/tsmon/metric"
 	"go.chromium.org/luci/common/tsmon/monitor"
+	"go.chromium.org/luci/common/tsmon/otelbridge"
 	"go.chromium.org/luci/common/tsmon/store"
 	"go.chromium.org/luci/common/tsmon/target"
 
@@ -146,6 +147,40 @@ func TestMiddleware(t *testing.T) {
 			So(state.flushRetry, ShouldEqual, lastRetry*4)
 		})
 	})
+
+	Convey("With Prometheus", t, func() {
+		c, clock := buildTestContext()
+		state, fake, _ := buildTestState()
+		prom := monitor.NewPrometheusMonitor()
+		state.Prometheus = prom
+
+		state.nextFlush = clock.Now()
+		runMiddlware(c, state, incrMetric)
+
+		// Both monitors got the metric.
+		So(fake.Cells, ShouldHaveLength, 1)
+		rec := httptest.NewRecorder()
+		prom.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil).WithContext(c))
+		So(rec.Body.String(), ShouldContainSubstring, "\ntest_metric_total 1\n")
+	})
+
+	Convey("With OpenTelemetry", t, func() {
+		c, clock := buildTestContext()
+		state, fake, _ := buildTestState()
+		bridge := otelbridge.NewMonitor()
+		state.OpenTelemetry = bridge
+
+		state.nextFlush = clock.Now()
+		runMiddlware(c, state, incrMetric)
+
+		// Both monitors got the metric.
+		So(fake.Cells, ShouldHaveLength, 1)
+		sms, err := bridge.Produce(c)
+		So(err, ShouldBeNil)
+		So(sms, ShouldHaveLength, 1)
+		So(sms[0].Metrics, ShouldHaveLength, 1)
+		So(sms[0].Metrics[0].Name, ShouldEqual, "test_metric")
+	})
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -23,6 +23,7 @@ import (
 
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/tsmon/monitor"
+	"go.chromium.org/luci/common/tsmon/types"
 	"go.chromium.org/luci/grpc/grpcmon"
 
 	"go.chromium.org/luci/server/auth"
@@ -54,3 +55,27 @@ func NewProdXMonitor(ctx context.Context, chunkSize int, account string) (monito
 	}
 	return monitor.NewGRPCMonitor(ctx, chunkSize, conn), nil
 }
+
+// teeMonitor sends metrics to the main monitor and to an extra monitor.
+//
+// The extra monitor must not chunk metrics: it receives chunks of the main
+// monitor.
+type teeMonitor struct {
+	main  monitor.Monitor
+	extra monitor.Monitor
+}
+
+func (m *teeMonitor) ChunkSize() int {
+	return m.main.ChunkSize()
+}
+
+func (m *teeMonitor) Send(ctx context.Context, cells []types.Cell) error {
+	if err := m.extra.Send(ctx, cells); err != nil {
+		return err
+	}
+	return m.main.Send(ctx, cells)
+}
+
+func (m *teeMonitor) Close() error {
+	return errors.Flatten(errors.NewMultiError(m.main.Close(), m.extra.Close()))
+}